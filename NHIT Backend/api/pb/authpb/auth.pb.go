@@ -1303,16 +1303,20 @@ func (x *ValidateTokenRequest) GetToken() string {
 }
 
 type ValidateTokenResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	OrgId         string                 `protobuf:"bytes,6,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Roles         []string               `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
-	Permissions   []string               `protobuf:"bytes,8,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	ExpiresAt     int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Valid       bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email       string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	TenantId    string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OrgId       string                 `protobuf:"bytes,6,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Roles       []string               `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions []string               `protobuf:"bytes,8,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ExpiresAt   int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Approval delegations in force for the user, honoured on top of permissions
+	Delegations []*DelegatedGrant `protobuf:"bytes,10,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// Roles assigned to the user within one organization, project or department
+	ScopedGrants  []*ScopedGrant `protobuf:"bytes,11,rep,name=scoped_grants,json=scopedGrants,proto3" json:"scoped_grants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1410,6 +1414,198 @@ func (x *ValidateTokenResponse) GetExpiresAt() int64 {
 	return 0
 }
 
+func (x *ValidateTokenResponse) GetDelegations() []*DelegatedGrant {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+func (x *ValidateTokenResponse) GetScopedGrants() []*ScopedGrant {
+	if x != nil {
+		return x.ScopedGrants
+	}
+	return nil
+}
+
+// Permissions of a role assigned to a user for a single scope
+type ScopedGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RoleName      string                 `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	OrgId         string                 `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`          // Set for project scoped assignments
+	DepartmentId  string                 `protobuf:"bytes,6,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"` // Set for department scoped assignments
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScopedGrant) Reset() {
+	*x = ScopedGrant{}
+	mi := &file_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScopedGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScopedGrant) ProtoMessage() {}
+
+func (x *ScopedGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScopedGrant.ProtoReflect.Descriptor instead.
+func (*ScopedGrant) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScopedGrant) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *ScopedGrant) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *ScopedGrant) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ScopedGrant) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ScopedGrant) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ScopedGrant) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+// Permissions a user holds on behalf of another user while a delegation is in force
+type DelegatedGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DelegationId  string                 `protobuf:"bytes,1,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id,omitempty"`
+	DelegatorId   string                 `protobuf:"bytes,2,opt,name=delegator_id,json=delegatorId,proto3" json:"delegator_id,omitempty"`
+	DelegatorName string                 `protobuf:"bytes,3,opt,name=delegator_name,json=delegatorName,proto3" json:"delegator_name,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	OrgId         string                 `protobuf:"bytes,5,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`             // Empty when valid in every organization
+	ProjectId     string                 `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Empty when valid for every project
+	EndsAt        int64                  `protobuf:"varint,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelegatedGrant) Reset() {
+	*x = DelegatedGrant{}
+	mi := &file_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegatedGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegatedGrant) ProtoMessage() {}
+
+func (x *DelegatedGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegatedGrant.ProtoReflect.Descriptor instead.
+func (*DelegatedGrant) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DelegatedGrant) GetDelegationId() string {
+	if x != nil {
+		return x.DelegationId
+	}
+	return ""
+}
+
+func (x *DelegatedGrant) GetDelegatorId() string {
+	if x != nil {
+		return x.DelegatorId
+	}
+	return ""
+}
+
+func (x *DelegatedGrant) GetDelegatorName() string {
+	if x != nil {
+		return x.DelegatorName
+	}
+	return ""
+}
+
+func (x *DelegatedGrant) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *DelegatedGrant) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DelegatedGrant) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DelegatedGrant) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
 type InitiateSSORequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -1423,7 +1619,7 @@ type InitiateSSORequest struct {
 
 func (x *InitiateSSORequest) Reset() {
 	*x = InitiateSSORequest{}
-	mi := &file_auth_proto_msgTypes[20]
+	mi := &file_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1435,7 +1631,7 @@ func (x *InitiateSSORequest) String() string {
 func (*InitiateSSORequest) ProtoMessage() {}
 
 func (x *InitiateSSORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[20]
+	mi := &file_auth_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1644,7 @@ func (x *InitiateSSORequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateSSORequest.ProtoReflect.Descriptor instead.
 func (*InitiateSSORequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{20}
+	return file_auth_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *InitiateSSORequest) GetTenantId() string {
@@ -1496,7 +1692,7 @@ type InitiateSSOResponse struct {
 
 func (x *InitiateSSOResponse) Reset() {
 	*x = InitiateSSOResponse{}
-	mi := &file_auth_proto_msgTypes[21]
+	mi := &file_auth_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1508,7 +1704,7 @@ func (x *InitiateSSOResponse) String() string {
 func (*InitiateSSOResponse) ProtoMessage() {}
 
 func (x *InitiateSSOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[21]
+	mi := &file_auth_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,7 +1717,7 @@ func (x *InitiateSSOResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateSSOResponse.ProtoReflect.Descriptor instead.
 func (*InitiateSSOResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{21}
+	return file_auth_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *InitiateSSOResponse) GetAuthUrl() string {
@@ -1552,7 +1748,7 @@ type CompleteSSORequest struct {
 
 func (x *CompleteSSORequest) Reset() {
 	*x = CompleteSSORequest{}
-	mi := &file_auth_proto_msgTypes[22]
+	mi := &file_auth_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1564,7 +1760,7 @@ func (x *CompleteSSORequest) String() string {
 func (*CompleteSSORequest) ProtoMessage() {}
 
 func (x *CompleteSSORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[22]
+	mi := &file_auth_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,7 +1773,7 @@ func (x *CompleteSSORequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteSSORequest.ProtoReflect.Descriptor instead.
 func (*CompleteSSORequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{22}
+	return file_auth_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CompleteSSORequest) GetTenantId() string {
@@ -1636,7 +1832,7 @@ type InitiateSSOLogoutRequest struct {
 
 func (x *InitiateSSOLogoutRequest) Reset() {
 	*x = InitiateSSOLogoutRequest{}
-	mi := &file_auth_proto_msgTypes[23]
+	mi := &file_auth_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1648,7 +1844,7 @@ func (x *InitiateSSOLogoutRequest) String() string {
 func (*InitiateSSOLogoutRequest) ProtoMessage() {}
 
 func (x *InitiateSSOLogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[23]
+	mi := &file_auth_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,7 +1857,7 @@ func (x *InitiateSSOLogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateSSOLogoutRequest.ProtoReflect.Descriptor instead.
 func (*InitiateSSOLogoutRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{23}
+	return file_auth_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *InitiateSSOLogoutRequest) GetTenantId() string {
@@ -1716,7 +1912,7 @@ type InitiateSSOLogoutResponse struct {
 
 func (x *InitiateSSOLogoutResponse) Reset() {
 	*x = InitiateSSOLogoutResponse{}
-	mi := &file_auth_proto_msgTypes[24]
+	mi := &file_auth_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1728,7 +1924,7 @@ func (x *InitiateSSOLogoutResponse) String() string {
 func (*InitiateSSOLogoutResponse) ProtoMessage() {}
 
 func (x *InitiateSSOLogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[24]
+	mi := &file_auth_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +1937,7 @@ func (x *InitiateSSOLogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateSSOLogoutResponse.ProtoReflect.Descriptor instead.
 func (*InitiateSSOLogoutResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{24}
+	return file_auth_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *InitiateSSOLogoutResponse) GetLogoutUrl() string {
@@ -1770,7 +1966,7 @@ type CompleteSSOLogoutRequest struct {
 
 func (x *CompleteSSOLogoutRequest) Reset() {
 	*x = CompleteSSOLogoutRequest{}
-	mi := &file_auth_proto_msgTypes[25]
+	mi := &file_auth_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1782,7 +1978,7 @@ func (x *CompleteSSOLogoutRequest) String() string {
 func (*CompleteSSOLogoutRequest) ProtoMessage() {}
 
 func (x *CompleteSSOLogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[25]
+	mi := &file_auth_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,7 +1991,7 @@ func (x *CompleteSSOLogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteSSOLogoutRequest.ProtoReflect.Descriptor instead.
 func (*CompleteSSOLogoutRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{25}
+	return file_auth_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CompleteSSOLogoutRequest) GetTenantId() string {
@@ -1835,7 +2031,7 @@ type SendVerificationEmailRequest struct {
 
 func (x *SendVerificationEmailRequest) Reset() {
 	*x = SendVerificationEmailRequest{}
-	mi := &file_auth_proto_msgTypes[26]
+	mi := &file_auth_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1847,7 +2043,7 @@ func (x *SendVerificationEmailRequest) String() string {
 func (*SendVerificationEmailRequest) ProtoMessage() {}
 
 func (x *SendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[26]
+	mi := &file_auth_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1860,7 +2056,7 @@ func (x *SendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendVerificationEmailRequest.ProtoReflect.Descriptor instead.
 func (*SendVerificationEmailRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{26}
+	return file_auth_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SendVerificationEmailRequest) GetUserId() string {
@@ -1879,7 +2075,7 @@ type SendVerificationEmailResponse struct {
 
 func (x *SendVerificationEmailResponse) Reset() {
 	*x = SendVerificationEmailResponse{}
-	mi := &file_auth_proto_msgTypes[27]
+	mi := &file_auth_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1891,7 +2087,7 @@ func (x *SendVerificationEmailResponse) String() string {
 func (*SendVerificationEmailResponse) ProtoMessage() {}
 
 func (x *SendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[27]
+	mi := &file_auth_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,7 +2100,7 @@ func (x *SendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendVerificationEmailResponse.ProtoReflect.Descriptor instead.
 func (*SendVerificationEmailResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{27}
+	return file_auth_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SendVerificationEmailResponse) GetSuccess() bool {
@@ -1923,7 +2119,7 @@ type SendPasswordResetEmailRequest struct {
 
 func (x *SendPasswordResetEmailRequest) Reset() {
 	*x = SendPasswordResetEmailRequest{}
-	mi := &file_auth_proto_msgTypes[28]
+	mi := &file_auth_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1935,7 +2131,7 @@ func (x *SendPasswordResetEmailRequest) String() string {
 func (*SendPasswordResetEmailRequest) ProtoMessage() {}
 
 func (x *SendPasswordResetEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[28]
+	mi := &file_auth_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1948,7 +2144,7 @@ func (x *SendPasswordResetEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendPasswordResetEmailRequest.ProtoReflect.Descriptor instead.
 func (*SendPasswordResetEmailRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{28}
+	return file_auth_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SendPasswordResetEmailRequest) GetEmail() string {
@@ -1967,7 +2163,7 @@ type SendPasswordResetEmailResponse struct {
 
 func (x *SendPasswordResetEmailResponse) Reset() {
 	*x = SendPasswordResetEmailResponse{}
-	mi := &file_auth_proto_msgTypes[29]
+	mi := &file_auth_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1979,7 +2175,7 @@ func (x *SendPasswordResetEmailResponse) String() string {
 func (*SendPasswordResetEmailResponse) ProtoMessage() {}
 
 func (x *SendPasswordResetEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[29]
+	mi := &file_auth_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1992,7 +2188,7 @@ func (x *SendPasswordResetEmailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendPasswordResetEmailResponse.ProtoReflect.Descriptor instead.
 func (*SendPasswordResetEmailResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{29}
+	return file_auth_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SendPasswordResetEmailResponse) GetSuccess() bool {
@@ -2015,7 +2211,7 @@ type SwitchOrganizationRequest struct {
 
 func (x *SwitchOrganizationRequest) Reset() {
 	*x = SwitchOrganizationRequest{}
-	mi := &file_auth_proto_msgTypes[30]
+	mi := &file_auth_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2027,7 +2223,7 @@ func (x *SwitchOrganizationRequest) String() string {
 func (*SwitchOrganizationRequest) ProtoMessage() {}
 
 func (x *SwitchOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[30]
+	mi := &file_auth_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,7 +2236,7 @@ func (x *SwitchOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*SwitchOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{30}
+	return file_auth_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SwitchOrganizationRequest) GetOrgId() string {
@@ -2057,58 +2253,872 @@ func (x *SwitchOrganizationRequest) GetOrgName() string {
 	return ""
 }
 
-var File_auth_proto protoreflect.FileDescriptor
+// ====================
+// Session Management
+// ====================
+type SessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"` // True for the session making the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_auth_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"auth.proto\x1a\x1cgoogle/api/annotations.proto\"\x8e\x01\n" +
-	"\x13RegisterUserRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x14\n" +
-	"\x05roles\x18\x05 \x03(\tR\x05roles\"\x91\x01\n" +
-	"\x14RegisterUserResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
-	"\x05roles\x18\x04 \x03(\tR\x05roles\x12 \n" +
-	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"\\\n" +
-	"\x12VerifyEmailRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
-	"\x12verification_token\x18\x02 \x01(\tR\x11verificationToken\"/\n" +
-	"\x13VerifyEmailResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"q\n" +
-	"\x15ForgotPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1a\n" +
-	"\x06org_id\x18\x03 \x01(\tH\x00R\x05orgId\x88\x01\x01B\t\n" +
-	"\a_org_id\"2\n" +
-	"\x16ForgotPasswordResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x96\x01\n" +
-	"\x1bResetPasswordByTokenRequest\x12\x10\n" +
-	"\x03otp\x18\x01 \x01(\tR\x03otp\x12!\n" +
-	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12\x1b\n" +
-	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x1a\n" +
-	"\x06org_id\x18\x04 \x01(\tH\x00R\x05orgId\x88\x01\x01B\t\n" +
-	"\a_org_id\"R\n" +
-	"\x1cResetPasswordByTokenResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"M\n" +
-	"\x18ForgotPasswordOTPRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"O\n" +
-	"\x19ForgotPasswordOTPResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"\x8a\x01\n" +
-	" VerifyOTPAndResetPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x10\n" +
-	"\x03otp\x18\x03 \x01(\tR\x03otp\x12!\n" +
-	"\fnew_password\x18\x04 \x01(\tR\vnewPassword\"W\n" +
-	"!VerifyOTPAndResetPasswordResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListMySessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsRequest) Reset() {
+	*x = ListMySessionsRequest{}
+	mi := &file_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsRequest) ProtoMessage() {}
+
+func (x *ListMySessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMySessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{34}
+}
+
+type ListMySessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsResponse) Reset() {
+	*x = ListMySessionsResponse{}
+	mi := &file_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsResponse) ProtoMessage() {}
+
+func (x *ListMySessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMySessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListMySessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevokeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RevokeAllOtherSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsRequest) Reset() {
+	*x = RevokeAllOtherSessionsRequest{}
+	mi := &file_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{38}
+}
+
+// Admin force-logout of another user in the same tenant
+type RevokeUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionsRequest) Reset() {
+	*x = RevokeUserSessionsRequest{}
+	mi := &file_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RevokeUserSessionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RevokedCount  int32                  `protobuf:"varint,2,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionsResponse) Reset() {
+	*x = RevokeSessionsResponse{}
+	mi := &file_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RevokeSessionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeSessionsResponse) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// ====================
+// Password Policy
+// ====================
+type PasswordPolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	MinLength        int32                  `protobuf:"varint,2,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	RequireUppercase bool                   `protobuf:"varint,3,opt,name=require_uppercase,json=requireUppercase,proto3" json:"require_uppercase,omitempty"`
+	RequireLowercase bool                   `protobuf:"varint,4,opt,name=require_lowercase,json=requireLowercase,proto3" json:"require_lowercase,omitempty"`
+	RequireDigit     bool                   `protobuf:"varint,5,opt,name=require_digit,json=requireDigit,proto3" json:"require_digit,omitempty"`
+	RequireSpecial   bool                   `protobuf:"varint,6,opt,name=require_special,json=requireSpecial,proto3" json:"require_special,omitempty"`
+	ExpiryDays       int32                  `protobuf:"varint,7,opt,name=expiry_days,json=expiryDays,proto3" json:"expiry_days,omitempty"`          // 0 disables expiry
+	HistoryDepth     int32                  `protobuf:"varint,8,opt,name=history_depth,json=historyDepth,proto3" json:"history_depth,omitempty"`    // Number of previous passwords that cannot be reused
+	CheckBreached    bool                   `protobuf:"varint,9,opt,name=check_breached,json=checkBreached,proto3" json:"check_breached,omitempty"` // Reject passwords found in the breached password list
+	UpdatedAt        string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	mi := &file_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicy) ProtoMessage() {}
+
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PasswordPolicy) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *PasswordPolicy) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetRequireUppercase() bool {
+	if x != nil {
+		return x.RequireUppercase
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireLowercase() bool {
+	if x != nil {
+		return x.RequireLowercase
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireDigit() bool {
+	if x != nil {
+		return x.RequireDigit
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetRequireSpecial() bool {
+	if x != nil {
+		return x.RequireSpecial
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetExpiryDays() int32 {
+	if x != nil {
+		return x.ExpiryDays
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetHistoryDepth() int32 {
+	if x != nil {
+		return x.HistoryDepth
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetCheckBreached() bool {
+	if x != nil {
+		return x.CheckBreached
+	}
+	return false
+}
+
+func (x *PasswordPolicy) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetPasswordPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPasswordPolicyRequest) Reset() {
+	*x = GetPasswordPolicyRequest{}
+	mi := &file_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *GetPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{42}
+}
+
+// Updates the policy of the caller's tenant
+type UpdatePasswordPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *PasswordPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePasswordPolicyRequest) Reset() {
+	*x = UpdatePasswordPolicyRequest{}
+	mi := &file_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePasswordPolicyRequest) ProtoMessage() {}
+
+func (x *UpdatePasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdatePasswordPolicyRequest) GetPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Sets a new password when login was refused because the password expired
+type ChangeExpiredPasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Login           string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"` // email
+	CurrentPassword string                 `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeExpiredPasswordRequest) Reset() {
+	*x = ChangeExpiredPasswordRequest{}
+	mi := &file_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeExpiredPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeExpiredPasswordRequest) ProtoMessage() {}
+
+func (x *ChangeExpiredPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeExpiredPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeExpiredPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChangeExpiredPasswordRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ChangeExpiredPasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangeExpiredPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangeExpiredPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeExpiredPasswordResponse) Reset() {
+	*x = ChangeExpiredPasswordResponse{}
+	mi := &file_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeExpiredPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeExpiredPasswordResponse) ProtoMessage() {}
+
+func (x *ChangeExpiredPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeExpiredPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangeExpiredPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ChangeExpiredPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChangeExpiredPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Checks a password chosen by a user who does not exist yet
+type ValidatePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePasswordRequest) Reset() {
+	*x = ValidatePasswordRequest{}
+	mi := &file_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePasswordRequest) ProtoMessage() {}
+
+func (x *ValidatePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ValidatePasswordRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ValidatePasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ValidatePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Why the password was rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePasswordResponse) Reset() {
+	*x = ValidatePasswordResponse{}
+	mi := &file_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePasswordResponse) ProtoMessage() {}
+
+func (x *ValidatePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ValidatePasswordResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidatePasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_auth_proto protoreflect.FileDescriptor
+
+const file_auth_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"auth.proto\x1a\x1cgoogle/api/annotations.proto\"\x8e\x01\n" +
+	"\x13RegisterUserRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\"\x91\x01\n" +
+	"\x14RegisterUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"\\\n" +
+	"\x12VerifyEmailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\x12verification_token\x18\x02 \x01(\tR\x11verificationToken\"/\n" +
+	"\x13VerifyEmailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"q\n" +
+	"\x15ForgotPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1a\n" +
+	"\x06org_id\x18\x03 \x01(\tH\x00R\x05orgId\x88\x01\x01B\t\n" +
+	"\a_org_id\"2\n" +
+	"\x16ForgotPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x96\x01\n" +
+	"\x1bResetPasswordByTokenRequest\x12\x10\n" +
+	"\x03otp\x18\x01 \x01(\tR\x03otp\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x1a\n" +
+	"\x06org_id\x18\x04 \x01(\tH\x00R\x05orgId\x88\x01\x01B\t\n" +
+	"\a_org_id\"R\n" +
+	"\x1cResetPasswordByTokenResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"M\n" +
+	"\x18ForgotPasswordOTPRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"O\n" +
+	"\x19ForgotPasswordOTPResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"\x8a\x01\n" +
+	" VerifyOTPAndResetPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x10\n" +
+	"\x03otp\x18\x03 \x01(\tR\x03otp\x12!\n" +
+	"\fnew_password\x18\x04 \x01(\tR\vnewPassword\"W\n" +
+	"!VerifyOTPAndResetPasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\"x\n" +
 	"\x10UserLoginRequest\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
@@ -2147,7 +3157,7 @@ const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"session_id\x18\x05 \x01(\tR\tsessionId\",\n" +
 	"\x14ValidateTokenRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\xfb\x01\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xe1\x02\n" +
 	"\x15ValidateTokenResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -2158,7 +3168,27 @@ const file_auth_proto_rawDesc = "" +
 	"\x05roles\x18\a \x03(\tR\x05roles\x12 \n" +
 	"\vpermissions\x18\b \x03(\tR\vpermissions\x12\x1d\n" +
 	"\n" +
-	"expires_at\x18\t \x01(\x03R\texpiresAt\"\xab\x01\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\x121\n" +
+	"\vdelegations\x18\n" +
+	" \x03(\v2\x0f.DelegatedGrantR\vdelegations\x121\n" +
+	"\rscoped_grants\x18\v \x03(\v2\f.ScopedGrantR\fscopedGrants\"\xcc\x01\n" +
+	"\vScopedGrant\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x1b\n" +
+	"\trole_name\x18\x02 \x01(\tR\broleName\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x12\x15\n" +
+	"\x06org_id\x18\x04 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tR\tprojectId\x12#\n" +
+	"\rdepartment_id\x18\x06 \x01(\tR\fdepartmentId\"\xf0\x01\n" +
+	"\x0eDelegatedGrant\x12#\n" +
+	"\rdelegation_id\x18\x01 \x01(\tR\fdelegationId\x12!\n" +
+	"\fdelegator_id\x18\x02 \x01(\tR\vdelegatorId\x12%\n" +
+	"\x0edelegator_name\x18\x03 \x01(\tR\rdelegatorName\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\x12\x15\n" +
+	"\x06org_id\x18\x05 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x06 \x01(\tR\tprojectId\x12\x17\n" +
+	"\aends_at\x18\a \x01(\x03R\x06endsAt\"\xab\x01\n" +
 	"\x12InitiateSSORequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
 	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12(\n" +
@@ -2201,7 +3231,66 @@ const file_auth_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
 	"\x19SwitchOrganizationRequest\x12\x15\n" +
 	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x19\n" +
-	"\borg_name\x18\x02 \x01(\tR\aorgName*6\n" +
+	"\borg_name\x18\x02 \x01(\tR\aorgName\"\xe4\x01\n" +
+	"\vSessionInfo\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_seen_at\x18\x05 \x01(\tR\n" +
+	"lastSeenAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"\x17\n" +
+	"\x15ListMySessionsRequest\"B\n" +
+	"\x16ListMySessionsResponse\x12(\n" +
+	"\bsessions\x18\x01 \x03(\v2\f.SessionInfoR\bsessions\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1f\n" +
+	"\x1dRevokeAllOtherSessionsRequest\"4\n" +
+	"\x19RevokeUserSessionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"W\n" +
+	"\x16RevokeSessionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rrevoked_count\x18\x02 \x01(\x05R\frevokedCount\"\x80\x03\n" +
+	"\x0ePasswordPolicy\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x02 \x01(\x05R\tminLength\x12+\n" +
+	"\x11require_uppercase\x18\x03 \x01(\bR\x10requireUppercase\x12+\n" +
+	"\x11require_lowercase\x18\x04 \x01(\bR\x10requireLowercase\x12#\n" +
+	"\rrequire_digit\x18\x05 \x01(\bR\frequireDigit\x12'\n" +
+	"\x0frequire_special\x18\x06 \x01(\bR\x0erequireSpecial\x12\x1f\n" +
+	"\vexpiry_days\x18\a \x01(\x05R\n" +
+	"expiryDays\x12#\n" +
+	"\rhistory_depth\x18\b \x01(\x05R\fhistoryDepth\x12%\n" +
+	"\x0echeck_breached\x18\t \x01(\bR\rcheckBreached\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"\x1a\n" +
+	"\x18GetPasswordPolicyRequest\"F\n" +
+	"\x1bUpdatePasswordPolicyRequest\x12'\n" +
+	"\x06policy\x18\x01 \x01(\v2\x0f.PasswordPolicyR\x06policy\"\x82\x01\n" +
+	"\x1cChangeExpiredPasswordRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12)\n" +
+	"\x10current_password\x18\x02 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"S\n" +
+	"\x1dChangeExpiredPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"R\n" +
+	"\x17ValidatePasswordRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"J\n" +
+	"\x18ValidatePasswordResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*6\n" +
 	"\bUserRole\x12\x19\n" +
 	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vSUPER_ADMIN\x10\x01*F\n" +
@@ -2209,7 +3298,7 @@ const file_auth_proto_rawDesc = "" +
 	"\x18SSO_PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06GOOGLE\x10\x01\x12\r\n" +
-	"\tMICROSOFT\x10\x022\xd8\x0e\n" +
+	"\tMICROSOFT\x10\x022\xd9\x15\n" +
 	"\vAuthService\x12]\n" +
 	"\fRegisterUser\x12\x14.RegisterUserRequest\x1a\x15.RegisterUserResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/register\x12^\n" +
 	"\vVerifyEmail\x12\x13.VerifyEmailRequest\x1a\x14.VerifyEmailResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/auth/verify-email\x12j\n" +
@@ -2227,7 +3316,15 @@ const file_auth_proto_rawDesc = "" +
 	"\x16SendPasswordResetEmail\x12\x1e.SendPasswordResetEmailRequest\x1a\x1f.SendPasswordResetEmailResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/auth/send-reset-email\x12{\n" +
 	"\x15ForgotPasswordWithOTP\x12\x19.ForgotPasswordOTPRequest\x1a\x1a.ForgotPasswordOTPResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/auth/forgot-password-otp\x12\x95\x01\n" +
 	"\x19VerifyOTPAndResetPassword\x12!.VerifyOTPAndResetPasswordRequest\x1a\".VerifyOTPAndResetPasswordResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/auth/verify-otp-reset-password\x12q\n" +
-	"\x12SwitchOrganization\x12\x1a.SwitchOrganizationRequest\x1a\x12.UserLoginResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/auth/switch-organizationB2Z0github.com/ShristiRnr/NHIT_Backend/api/pb/authpbb\x06proto3"
+	"\x12SwitchOrganization\x12\x1a.SwitchOrganizationRequest\x1a\x12.UserLoginResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/auth/switch-organization\x12`\n" +
+	"\x0eListMySessions\x12\x16.ListMySessionsRequest\x1a\x17.ListMySessionsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/auth/sessions\x12j\n" +
+	"\rRevokeSession\x12\x15.RevokeSessionRequest\x1a\x16.RevokeSessionResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/auth/sessions/{session_id}\x12\x81\x01\n" +
+	"\x16RevokeAllOtherSessions\x12\x1e.RevokeAllOtherSessionsRequest\x1a\x17.RevokeSessionsResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/auth/sessions/revoke-others\x12\x82\x01\n" +
+	"\x12RevokeUserSessions\x12\x1a.RevokeUserSessionsRequest\x1a\x17.RevokeSessionsResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/auth/users/{user_id}/sessions/revoke\x12\x87\x01\n" +
+	"\x15ChangeExpiredPassword\x12\x1d.ChangeExpiredPasswordRequest\x1a\x1e.ChangeExpiredPasswordResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/auth/change-expired-password\x12e\n" +
+	"\x11GetPasswordPolicy\x12\x19.GetPasswordPolicyRequest\x1a\x0f.PasswordPolicy\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/auth/password-policy\x12n\n" +
+	"\x14UpdatePasswordPolicy\x12\x1c.UpdatePasswordPolicyRequest\x1a\x0f.PasswordPolicy\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/auth/password-policy\x12G\n" +
+	"\x10ValidatePassword\x12\x18.ValidatePasswordRequest\x1a\x19.ValidatePasswordResponseB2Z0github.com/ShristiRnr/NHIT_Backend/api/pb/authpbb\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -2242,7 +3339,7 @@ func file_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_auth_proto_goTypes = []any{
 	(UserRole)(0),                             // 0: UserRole
 	(SSOProvider)(0),                          // 1: SSOProvider
@@ -2266,62 +3363,99 @@ var file_auth_proto_goTypes = []any{
 	(*RefreshTokenResponse)(nil),              // 19: RefreshTokenResponse
 	(*ValidateTokenRequest)(nil),              // 20: ValidateTokenRequest
 	(*ValidateTokenResponse)(nil),             // 21: ValidateTokenResponse
-	(*InitiateSSORequest)(nil),                // 22: InitiateSSORequest
-	(*InitiateSSOResponse)(nil),               // 23: InitiateSSOResponse
-	(*CompleteSSORequest)(nil),                // 24: CompleteSSORequest
-	(*InitiateSSOLogoutRequest)(nil),          // 25: InitiateSSOLogoutRequest
-	(*InitiateSSOLogoutResponse)(nil),         // 26: InitiateSSOLogoutResponse
-	(*CompleteSSOLogoutRequest)(nil),          // 27: CompleteSSOLogoutRequest
-	(*SendVerificationEmailRequest)(nil),      // 28: SendVerificationEmailRequest
-	(*SendVerificationEmailResponse)(nil),     // 29: SendVerificationEmailResponse
-	(*SendPasswordResetEmailRequest)(nil),     // 30: SendPasswordResetEmailRequest
-	(*SendPasswordResetEmailResponse)(nil),    // 31: SendPasswordResetEmailResponse
-	(*SwitchOrganizationRequest)(nil),         // 32: SwitchOrganizationRequest
+	(*ScopedGrant)(nil),                       // 22: ScopedGrant
+	(*DelegatedGrant)(nil),                    // 23: DelegatedGrant
+	(*InitiateSSORequest)(nil),                // 24: InitiateSSORequest
+	(*InitiateSSOResponse)(nil),               // 25: InitiateSSOResponse
+	(*CompleteSSORequest)(nil),                // 26: CompleteSSORequest
+	(*InitiateSSOLogoutRequest)(nil),          // 27: InitiateSSOLogoutRequest
+	(*InitiateSSOLogoutResponse)(nil),         // 28: InitiateSSOLogoutResponse
+	(*CompleteSSOLogoutRequest)(nil),          // 29: CompleteSSOLogoutRequest
+	(*SendVerificationEmailRequest)(nil),      // 30: SendVerificationEmailRequest
+	(*SendVerificationEmailResponse)(nil),     // 31: SendVerificationEmailResponse
+	(*SendPasswordResetEmailRequest)(nil),     // 32: SendPasswordResetEmailRequest
+	(*SendPasswordResetEmailResponse)(nil),    // 33: SendPasswordResetEmailResponse
+	(*SwitchOrganizationRequest)(nil),         // 34: SwitchOrganizationRequest
+	(*SessionInfo)(nil),                       // 35: SessionInfo
+	(*ListMySessionsRequest)(nil),             // 36: ListMySessionsRequest
+	(*ListMySessionsResponse)(nil),            // 37: ListMySessionsResponse
+	(*RevokeSessionRequest)(nil),              // 38: RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),             // 39: RevokeSessionResponse
+	(*RevokeAllOtherSessionsRequest)(nil),     // 40: RevokeAllOtherSessionsRequest
+	(*RevokeUserSessionsRequest)(nil),         // 41: RevokeUserSessionsRequest
+	(*RevokeSessionsResponse)(nil),            // 42: RevokeSessionsResponse
+	(*PasswordPolicy)(nil),                    // 43: PasswordPolicy
+	(*GetPasswordPolicyRequest)(nil),          // 44: GetPasswordPolicyRequest
+	(*UpdatePasswordPolicyRequest)(nil),       // 45: UpdatePasswordPolicyRequest
+	(*ChangeExpiredPasswordRequest)(nil),      // 46: ChangeExpiredPasswordRequest
+	(*ChangeExpiredPasswordResponse)(nil),     // 47: ChangeExpiredPasswordResponse
+	(*ValidatePasswordRequest)(nil),           // 48: ValidatePasswordRequest
+	(*ValidatePasswordResponse)(nil),          // 49: ValidatePasswordResponse
 }
 var file_auth_proto_depIdxs = []int32{
-	1,  // 0: InitiateSSORequest.provider:type_name -> SSOProvider
-	1,  // 1: CompleteSSORequest.provider:type_name -> SSOProvider
-	1,  // 2: InitiateSSOLogoutRequest.provider:type_name -> SSOProvider
-	1,  // 3: CompleteSSOLogoutRequest.provider:type_name -> SSOProvider
-	2,  // 4: AuthService.RegisterUser:input_type -> RegisterUserRequest
-	4,  // 5: AuthService.VerifyEmail:input_type -> VerifyEmailRequest
-	6,  // 6: AuthService.ForgotPassword:input_type -> ForgotPasswordRequest
-	8,  // 7: AuthService.ResetPasswordByToken:input_type -> ResetPasswordByTokenRequest
-	14, // 8: AuthService.Login:input_type -> UserLoginRequest
-	16, // 9: AuthService.Logout:input_type -> UserLogoutRequest
-	18, // 10: AuthService.RefreshToken:input_type -> RefreshTokenRequest
-	20, // 11: AuthService.ValidateToken:input_type -> ValidateTokenRequest
-	22, // 12: AuthService.InitiateSSO:input_type -> InitiateSSORequest
-	24, // 13: AuthService.CompleteSSO:input_type -> CompleteSSORequest
-	25, // 14: AuthService.InitiateSSOLogout:input_type -> InitiateSSOLogoutRequest
-	27, // 15: AuthService.CompleteSSOLogout:input_type -> CompleteSSOLogoutRequest
-	28, // 16: AuthService.SendVerificationEmail:input_type -> SendVerificationEmailRequest
-	30, // 17: AuthService.SendPasswordResetEmail:input_type -> SendPasswordResetEmailRequest
-	10, // 18: AuthService.ForgotPasswordWithOTP:input_type -> ForgotPasswordOTPRequest
-	12, // 19: AuthService.VerifyOTPAndResetPassword:input_type -> VerifyOTPAndResetPasswordRequest
-	32, // 20: AuthService.SwitchOrganization:input_type -> SwitchOrganizationRequest
-	3,  // 21: AuthService.RegisterUser:output_type -> RegisterUserResponse
-	5,  // 22: AuthService.VerifyEmail:output_type -> VerifyEmailResponse
-	7,  // 23: AuthService.ForgotPassword:output_type -> ForgotPasswordResponse
-	9,  // 24: AuthService.ResetPasswordByToken:output_type -> ResetPasswordByTokenResponse
-	15, // 25: AuthService.Login:output_type -> UserLoginResponse
-	17, // 26: AuthService.Logout:output_type -> UserLogoutResponse
-	19, // 27: AuthService.RefreshToken:output_type -> RefreshTokenResponse
-	21, // 28: AuthService.ValidateToken:output_type -> ValidateTokenResponse
-	23, // 29: AuthService.InitiateSSO:output_type -> InitiateSSOResponse
-	15, // 30: AuthService.CompleteSSO:output_type -> UserLoginResponse
-	26, // 31: AuthService.InitiateSSOLogout:output_type -> InitiateSSOLogoutResponse
-	17, // 32: AuthService.CompleteSSOLogout:output_type -> UserLogoutResponse
-	29, // 33: AuthService.SendVerificationEmail:output_type -> SendVerificationEmailResponse
-	31, // 34: AuthService.SendPasswordResetEmail:output_type -> SendPasswordResetEmailResponse
-	11, // 35: AuthService.ForgotPasswordWithOTP:output_type -> ForgotPasswordOTPResponse
-	13, // 36: AuthService.VerifyOTPAndResetPassword:output_type -> VerifyOTPAndResetPasswordResponse
-	15, // 37: AuthService.SwitchOrganization:output_type -> UserLoginResponse
-	21, // [21:38] is the sub-list for method output_type
-	4,  // [4:21] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	23, // 0: ValidateTokenResponse.delegations:type_name -> DelegatedGrant
+	22, // 1: ValidateTokenResponse.scoped_grants:type_name -> ScopedGrant
+	1,  // 2: InitiateSSORequest.provider:type_name -> SSOProvider
+	1,  // 3: CompleteSSORequest.provider:type_name -> SSOProvider
+	1,  // 4: InitiateSSOLogoutRequest.provider:type_name -> SSOProvider
+	1,  // 5: CompleteSSOLogoutRequest.provider:type_name -> SSOProvider
+	35, // 6: ListMySessionsResponse.sessions:type_name -> SessionInfo
+	43, // 7: UpdatePasswordPolicyRequest.policy:type_name -> PasswordPolicy
+	2,  // 8: AuthService.RegisterUser:input_type -> RegisterUserRequest
+	4,  // 9: AuthService.VerifyEmail:input_type -> VerifyEmailRequest
+	6,  // 10: AuthService.ForgotPassword:input_type -> ForgotPasswordRequest
+	8,  // 11: AuthService.ResetPasswordByToken:input_type -> ResetPasswordByTokenRequest
+	14, // 12: AuthService.Login:input_type -> UserLoginRequest
+	16, // 13: AuthService.Logout:input_type -> UserLogoutRequest
+	18, // 14: AuthService.RefreshToken:input_type -> RefreshTokenRequest
+	20, // 15: AuthService.ValidateToken:input_type -> ValidateTokenRequest
+	24, // 16: AuthService.InitiateSSO:input_type -> InitiateSSORequest
+	26, // 17: AuthService.CompleteSSO:input_type -> CompleteSSORequest
+	27, // 18: AuthService.InitiateSSOLogout:input_type -> InitiateSSOLogoutRequest
+	29, // 19: AuthService.CompleteSSOLogout:input_type -> CompleteSSOLogoutRequest
+	30, // 20: AuthService.SendVerificationEmail:input_type -> SendVerificationEmailRequest
+	32, // 21: AuthService.SendPasswordResetEmail:input_type -> SendPasswordResetEmailRequest
+	10, // 22: AuthService.ForgotPasswordWithOTP:input_type -> ForgotPasswordOTPRequest
+	12, // 23: AuthService.VerifyOTPAndResetPassword:input_type -> VerifyOTPAndResetPasswordRequest
+	34, // 24: AuthService.SwitchOrganization:input_type -> SwitchOrganizationRequest
+	36, // 25: AuthService.ListMySessions:input_type -> ListMySessionsRequest
+	38, // 26: AuthService.RevokeSession:input_type -> RevokeSessionRequest
+	40, // 27: AuthService.RevokeAllOtherSessions:input_type -> RevokeAllOtherSessionsRequest
+	41, // 28: AuthService.RevokeUserSessions:input_type -> RevokeUserSessionsRequest
+	46, // 29: AuthService.ChangeExpiredPassword:input_type -> ChangeExpiredPasswordRequest
+	44, // 30: AuthService.GetPasswordPolicy:input_type -> GetPasswordPolicyRequest
+	45, // 31: AuthService.UpdatePasswordPolicy:input_type -> UpdatePasswordPolicyRequest
+	48, // 32: AuthService.ValidatePassword:input_type -> ValidatePasswordRequest
+	3,  // 33: AuthService.RegisterUser:output_type -> RegisterUserResponse
+	5,  // 34: AuthService.VerifyEmail:output_type -> VerifyEmailResponse
+	7,  // 35: AuthService.ForgotPassword:output_type -> ForgotPasswordResponse
+	9,  // 36: AuthService.ResetPasswordByToken:output_type -> ResetPasswordByTokenResponse
+	15, // 37: AuthService.Login:output_type -> UserLoginResponse
+	17, // 38: AuthService.Logout:output_type -> UserLogoutResponse
+	19, // 39: AuthService.RefreshToken:output_type -> RefreshTokenResponse
+	21, // 40: AuthService.ValidateToken:output_type -> ValidateTokenResponse
+	25, // 41: AuthService.InitiateSSO:output_type -> InitiateSSOResponse
+	15, // 42: AuthService.CompleteSSO:output_type -> UserLoginResponse
+	28, // 43: AuthService.InitiateSSOLogout:output_type -> InitiateSSOLogoutResponse
+	17, // 44: AuthService.CompleteSSOLogout:output_type -> UserLogoutResponse
+	31, // 45: AuthService.SendVerificationEmail:output_type -> SendVerificationEmailResponse
+	33, // 46: AuthService.SendPasswordResetEmail:output_type -> SendPasswordResetEmailResponse
+	11, // 47: AuthService.ForgotPasswordWithOTP:output_type -> ForgotPasswordOTPResponse
+	13, // 48: AuthService.VerifyOTPAndResetPassword:output_type -> VerifyOTPAndResetPasswordResponse
+	15, // 49: AuthService.SwitchOrganization:output_type -> UserLoginResponse
+	37, // 50: AuthService.ListMySessions:output_type -> ListMySessionsResponse
+	39, // 51: AuthService.RevokeSession:output_type -> RevokeSessionResponse
+	42, // 52: AuthService.RevokeAllOtherSessions:output_type -> RevokeSessionsResponse
+	42, // 53: AuthService.RevokeUserSessions:output_type -> RevokeSessionsResponse
+	47, // 54: AuthService.ChangeExpiredPassword:output_type -> ChangeExpiredPasswordResponse
+	43, // 55: AuthService.GetPasswordPolicy:output_type -> PasswordPolicy
+	43, // 56: AuthService.UpdatePasswordPolicy:output_type -> PasswordPolicy
+	49, // 57: AuthService.ValidatePassword:output_type -> ValidatePasswordResponse
+	33, // [33:58] is the sub-list for method output_type
+	8,  // [8:33] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -2337,7 +3471,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   31,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
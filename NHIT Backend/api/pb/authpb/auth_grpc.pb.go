@@ -36,6 +36,14 @@ const (
 	AuthService_ForgotPasswordWithOTP_FullMethodName     = "/AuthService/ForgotPasswordWithOTP"
 	AuthService_VerifyOTPAndResetPassword_FullMethodName = "/AuthService/VerifyOTPAndResetPassword"
 	AuthService_SwitchOrganization_FullMethodName        = "/AuthService/SwitchOrganization"
+	AuthService_ListMySessions_FullMethodName            = "/AuthService/ListMySessions"
+	AuthService_RevokeSession_FullMethodName             = "/AuthService/RevokeSession"
+	AuthService_RevokeAllOtherSessions_FullMethodName    = "/AuthService/RevokeAllOtherSessions"
+	AuthService_RevokeUserSessions_FullMethodName        = "/AuthService/RevokeUserSessions"
+	AuthService_ChangeExpiredPassword_FullMethodName     = "/AuthService/ChangeExpiredPassword"
+	AuthService_GetPasswordPolicy_FullMethodName         = "/AuthService/GetPasswordPolicy"
+	AuthService_UpdatePasswordPolicy_FullMethodName      = "/AuthService/UpdatePasswordPolicy"
+	AuthService_ValidatePassword_FullMethodName          = "/AuthService/ValidatePassword"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -63,6 +71,15 @@ type AuthServiceClient interface {
 	ForgotPasswordWithOTP(ctx context.Context, in *ForgotPasswordOTPRequest, opts ...grpc.CallOption) (*ForgotPasswordOTPResponse, error)
 	VerifyOTPAndResetPassword(ctx context.Context, in *VerifyOTPAndResetPasswordRequest, opts ...grpc.CallOption) (*VerifyOTPAndResetPasswordResponse, error)
 	SwitchOrganization(ctx context.Context, in *SwitchOrganizationRequest, opts ...grpc.CallOption) (*UserLoginResponse, error)
+	ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	ChangeExpiredPassword(ctx context.Context, in *ChangeExpiredPasswordRequest, opts ...grpc.CallOption) (*ChangeExpiredPasswordResponse, error)
+	GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error)
+	UpdatePasswordPolicy(ctx context.Context, in *UpdatePasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error)
+	// Checks a new user's password against their tenant's policy; called by other services only
+	ValidatePassword(ctx context.Context, in *ValidatePasswordRequest, opts ...grpc.CallOption) (*ValidatePasswordResponse, error)
 }
 
 type authServiceClient struct {
@@ -243,6 +260,86 @@ func (c *authServiceClient) SwitchOrganization(ctx context.Context, in *SwitchOr
 	return out, nil
 }
 
+func (c *authServiceClient) ListMySessions(ctx context.Context, in *ListMySessionsRequest, opts ...grpc.CallOption) (*ListMySessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMySessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListMySessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeAllOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangeExpiredPassword(ctx context.Context, in *ChangeExpiredPasswordRequest, opts ...grpc.CallOption) (*ChangeExpiredPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeExpiredPasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangeExpiredPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetPasswordPolicy(ctx context.Context, in *GetPasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordPolicy)
+	err := c.cc.Invoke(ctx, AuthService_GetPasswordPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdatePasswordPolicy(ctx context.Context, in *UpdatePasswordPolicyRequest, opts ...grpc.CallOption) (*PasswordPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordPolicy)
+	err := c.cc.Invoke(ctx, AuthService_UpdatePasswordPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ValidatePassword(ctx context.Context, in *ValidatePasswordRequest, opts ...grpc.CallOption) (*ValidatePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatePasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ValidatePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -268,6 +365,15 @@ type AuthServiceServer interface {
 	ForgotPasswordWithOTP(context.Context, *ForgotPasswordOTPRequest) (*ForgotPasswordOTPResponse, error)
 	VerifyOTPAndResetPassword(context.Context, *VerifyOTPAndResetPasswordRequest) (*VerifyOTPAndResetPasswordResponse, error)
 	SwitchOrganization(context.Context, *SwitchOrganizationRequest) (*UserLoginResponse, error)
+	ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsRequest) (*RevokeSessionsResponse, error)
+	RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*RevokeSessionsResponse, error)
+	ChangeExpiredPassword(context.Context, *ChangeExpiredPasswordRequest) (*ChangeExpiredPasswordResponse, error)
+	GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*PasswordPolicy, error)
+	UpdatePasswordPolicy(context.Context, *UpdatePasswordPolicyRequest) (*PasswordPolicy, error)
+	// Checks a new user's password against their tenant's policy; called by other services only
+	ValidatePassword(context.Context, *ValidatePasswordRequest) (*ValidatePasswordResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -329,6 +435,30 @@ func (UnimplementedAuthServiceServer) VerifyOTPAndResetPassword(context.Context,
 func (UnimplementedAuthServiceServer) SwitchOrganization(context.Context, *SwitchOrganizationRequest) (*UserLoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwitchOrganization not implemented")
 }
+func (UnimplementedAuthServiceServer) ListMySessions(context.Context, *ListMySessionsRequest) (*ListMySessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMySessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangeExpiredPassword(context.Context, *ChangeExpiredPasswordRequest) (*ChangeExpiredPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeExpiredPassword not implemented")
+}
+func (UnimplementedAuthServiceServer) GetPasswordPolicy(context.Context, *GetPasswordPolicyRequest) (*PasswordPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPasswordPolicy not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdatePasswordPolicy(context.Context, *UpdatePasswordPolicyRequest) (*PasswordPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePasswordPolicy not implemented")
+}
+func (UnimplementedAuthServiceServer) ValidatePassword(context.Context, *ValidatePasswordRequest) (*ValidatePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePassword not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -656,6 +786,150 @@ func _AuthService_SwitchOrganization_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ListMySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListMySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListMySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListMySessions(ctx, req.(*ListMySessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeAllOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeAllOtherSessions(ctx, req.(*RevokeAllOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeUserSessions(ctx, req.(*RevokeUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangeExpiredPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeExpiredPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangeExpiredPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangeExpiredPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangeExpiredPassword(ctx, req.(*ChangeExpiredPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetPasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPasswordPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetPasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetPasswordPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetPasswordPolicy(ctx, req.(*GetPasswordPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdatePasswordPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePasswordPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdatePasswordPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdatePasswordPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdatePasswordPolicy(ctx, req.(*UpdatePasswordPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ValidatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ValidatePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ValidatePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ValidatePassword(ctx, req.(*ValidatePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -731,6 +1005,38 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwitchOrganization",
 			Handler:    _AuthService_SwitchOrganization_Handler,
 		},
+		{
+			MethodName: "ListMySessions",
+			Handler:    _AuthService_ListMySessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllOtherSessions",
+			Handler:    _AuthService_RevokeAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSessions",
+			Handler:    _AuthService_RevokeUserSessions_Handler,
+		},
+		{
+			MethodName: "ChangeExpiredPassword",
+			Handler:    _AuthService_ChangeExpiredPassword_Handler,
+		},
+		{
+			MethodName: "GetPasswordPolicy",
+			Handler:    _AuthService_GetPasswordPolicy_Handler,
+		},
+		{
+			MethodName: "UpdatePasswordPolicy",
+			Handler:    _AuthService_UpdatePasswordPolicy_Handler,
+		},
+		{
+			MethodName: "ValidatePassword",
+			Handler:    _AuthService_ValidatePassword_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
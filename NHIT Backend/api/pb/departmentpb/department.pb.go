@@ -26,15 +26,70 @@ const (
 // =====================
 // Department Entity
 // =====================
+type DepartmentStatus int32
+
+const (
+	DepartmentStatus_DEPARTMENT_STATUS_UNSPECIFIED DepartmentStatus = 0
+	DepartmentStatus_DEPARTMENT_STATUS_ACTIVE      DepartmentStatus = 1
+	DepartmentStatus_DEPARTMENT_STATUS_INACTIVE    DepartmentStatus = 2
+)
+
+// Enum value maps for DepartmentStatus.
+var (
+	DepartmentStatus_name = map[int32]string{
+		0: "DEPARTMENT_STATUS_UNSPECIFIED",
+		1: "DEPARTMENT_STATUS_ACTIVE",
+		2: "DEPARTMENT_STATUS_INACTIVE",
+	}
+	DepartmentStatus_value = map[string]int32{
+		"DEPARTMENT_STATUS_UNSPECIFIED": 0,
+		"DEPARTMENT_STATUS_ACTIVE":      1,
+		"DEPARTMENT_STATUS_INACTIVE":    2,
+	}
+)
+
+func (x DepartmentStatus) Enum() *DepartmentStatus {
+	p := new(DepartmentStatus)
+	*p = x
+	return p
+}
+
+func (x DepartmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepartmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_department_proto_enumTypes[0].Descriptor()
+}
+
+func (DepartmentStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_department_proto_enumTypes[0]
+}
+
+func (x DepartmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepartmentStatus.Descriptor instead.
+func (DepartmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{0}
+}
+
 type Department struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentId       string                 `protobuf:"bytes,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Empty for a top-level department
+	HeadUserId     string                 `protobuf:"bytes,7,opt,name=head_user_id,json=headUserId,proto3" json:"head_user_id,omitempty"`
+	CostCentreCode string                 `protobuf:"bytes,8,opt,name=cost_centre_code,json=costCentreCode,proto3" json:"cost_centre_code,omitempty"`
+	Status         DepartmentStatus       `protobuf:"varint,9,opt,name=status,proto3,enum=departments.DepartmentStatus" json:"status,omitempty"`
+	ClosedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	ClosedReason   string                 `protobuf:"bytes,11,opt,name=closed_reason,json=closedReason,proto3" json:"closed_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Department) Reset() {
@@ -102,20 +157,118 @@ func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Department) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Department) GetHeadUserId() string {
+	if x != nil {
+		return x.HeadUserId
+	}
+	return ""
+}
+
+func (x *Department) GetCostCentreCode() string {
+	if x != nil {
+		return x.CostCentreCode
+	}
+	return ""
+}
+
+func (x *Department) GetStatus() DepartmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DepartmentStatus_DEPARTMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Department) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Department) GetClosedReason() string {
+	if x != nil {
+		return x.ClosedReason
+	}
+	return ""
+}
+
+type DepartmentTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	Children      []*DepartmentTreeNode  `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentTreeNode) Reset() {
+	*x = DepartmentTreeNode{}
+	mi := &file_api_proto_department_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentTreeNode) ProtoMessage() {}
+
+func (x *DepartmentTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_department_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentTreeNode.ProtoReflect.Descriptor instead.
+func (*DepartmentTreeNode) Descriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DepartmentTreeNode) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+func (x *DepartmentTreeNode) GetChildren() []*DepartmentTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 // =====================
 // Requests
 // =====================
 type CreateDepartmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId       string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	HeadUserId     string                 `protobuf:"bytes,4,opt,name=head_user_id,json=headUserId,proto3" json:"head_user_id,omitempty"`
+	CostCentreCode string                 `protobuf:"bytes,5,opt,name=cost_centre_code,json=costCentreCode,proto3" json:"cost_centre_code,omitempty"`
+	ValidateOnly   bool                   `protobuf:"varint,6,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"` // Run every check but create nothing
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateDepartmentRequest) Reset() {
 	*x = CreateDepartmentRequest{}
-	mi := &file_api_proto_department_proto_msgTypes[1]
+	mi := &file_api_proto_department_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -127,7 +280,7 @@ func (x *CreateDepartmentRequest) String() string {
 func (*CreateDepartmentRequest) ProtoMessage() {}
 
 func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[1]
+	mi := &file_api_proto_department_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -140,7 +293,7 @@ func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDepartmentRequest.ProtoReflect.Descriptor instead.
 func (*CreateDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateDepartmentRequest) GetName() string {
@@ -157,6 +310,34 @@ func (x *CreateDepartmentRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateDepartmentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetHeadUserId() string {
+	if x != nil {
+		return x.HeadUserId
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetCostCentreCode() string {
+	if x != nil {
+		return x.CostCentreCode
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
 type GetDepartmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -166,7 +347,7 @@ type GetDepartmentRequest struct {
 
 func (x *GetDepartmentRequest) Reset() {
 	*x = GetDepartmentRequest{}
-	mi := &file_api_proto_department_proto_msgTypes[2]
+	mi := &file_api_proto_department_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +359,7 @@ func (x *GetDepartmentRequest) String() string {
 func (*GetDepartmentRequest) ProtoMessage() {}
 
 func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[2]
+	mi := &file_api_proto_department_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +372,7 @@ func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
 func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetDepartmentRequest) GetId() string {
@@ -202,17 +383,19 @@ func (x *GetDepartmentRequest) GetId() string {
 }
 
 type UpdateDepartmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	HeadUserId     *string                `protobuf:"bytes,4,opt,name=head_user_id,json=headUserId,proto3,oneof" json:"head_user_id,omitempty"`             // Empty string removes the head
+	CostCentreCode *string                `protobuf:"bytes,5,opt,name=cost_centre_code,json=costCentreCode,proto3,oneof" json:"cost_centre_code,omitempty"` // Empty string removes the code
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateDepartmentRequest) Reset() {
 	*x = UpdateDepartmentRequest{}
-	mi := &file_api_proto_department_proto_msgTypes[3]
+	mi := &file_api_proto_department_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +407,7 @@ func (x *UpdateDepartmentRequest) String() string {
 func (*UpdateDepartmentRequest) ProtoMessage() {}
 
 func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[3]
+	mi := &file_api_proto_department_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +420,7 @@ func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDepartmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateDepartmentRequest) GetId() string {
@@ -261,6 +444,20 @@ func (x *UpdateDepartmentRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateDepartmentRequest) GetHeadUserId() string {
+	if x != nil && x.HeadUserId != nil {
+		return *x.HeadUserId
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequest) GetCostCentreCode() string {
+	if x != nil && x.CostCentreCode != nil {
+		return *x.CostCentreCode
+	}
+	return ""
+}
+
 type DeleteDepartmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -270,7 +467,7 @@ type DeleteDepartmentRequest struct {
 
 func (x *DeleteDepartmentRequest) Reset() {
 	*x = DeleteDepartmentRequest{}
-	mi := &file_api_proto_department_proto_msgTypes[4]
+	mi := &file_api_proto_department_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +479,7 @@ func (x *DeleteDepartmentRequest) String() string {
 func (*DeleteDepartmentRequest) ProtoMessage() {}
 
 func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[4]
+	mi := &file_api_proto_department_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +492,7 @@ func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteDepartmentRequest) GetId() string {
@@ -309,13 +506,14 @@ type ListDepartmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status        DepartmentStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=departments.DepartmentStatus" json:"status,omitempty"` // Unspecified lists every department
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListDepartmentsRequest) Reset() {
 	*x = ListDepartmentsRequest{}
-	mi := &file_api_proto_department_proto_msgTypes[5]
+	mi := &file_api_proto_department_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +525,7 @@ func (x *ListDepartmentsRequest) String() string {
 func (*ListDepartmentsRequest) ProtoMessage() {}
 
 func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[5]
+	mi := &file_api_proto_department_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +538,7 @@ func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDepartmentsRequest.ProtoReflect.Descriptor instead.
 func (*ListDepartmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListDepartmentsRequest) GetPage() int32 {
@@ -357,6 +555,221 @@ func (x *ListDepartmentsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListDepartmentsRequest) GetStatus() DepartmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DepartmentStatus_DEPARTMENT_STATUS_UNSPECIFIED
+}
+
+type GetDepartmentTreeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RootId          string                 `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"` // Empty for every top-level department
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetDepartmentTreeRequest) Reset() {
+	*x = GetDepartmentTreeRequest{}
+	mi := &file_api_proto_department_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentTreeRequest) ProtoMessage() {}
+
+func (x *GetDepartmentTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_department_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentTreeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDepartmentTreeRequest) GetRootId() string {
+	if x != nil {
+		return x.RootId
+	}
+	return ""
+}
+
+func (x *GetDepartmentTreeRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type MoveDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewParentId   string                 `protobuf:"bytes,2,opt,name=new_parent_id,json=newParentId,proto3" json:"new_parent_id,omitempty"` // Empty makes it top-level; cannot be the department or below it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveDepartmentRequest) Reset() {
+	*x = MoveDepartmentRequest{}
+	mi := &file_api_proto_department_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveDepartmentRequest) ProtoMessage() {}
+
+func (x *MoveDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_department_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*MoveDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoveDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveDepartmentRequest) GetNewParentId() string {
+	if x != nil {
+		return x.NewParentId
+	}
+	return ""
+}
+
+type CloseDepartmentRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReassignToDepartmentId string                 `protobuf:"bytes,2,opt,name=reassign_to_department_id,json=reassignToDepartmentId,proto3" json:"reassign_to_department_id,omitempty"` // Required while users are still assigned
+	Reason                 string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CloseDepartmentRequest) Reset() {
+	*x = CloseDepartmentRequest{}
+	mi := &file_api_proto_department_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseDepartmentRequest) ProtoMessage() {}
+
+func (x *CloseDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_department_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*CloseDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloseDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloseDepartmentRequest) GetReassignToDepartmentId() string {
+	if x != nil {
+		return x.ReassignToDepartmentId
+	}
+	return ""
+}
+
+func (x *CloseDepartmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReopenDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenDepartmentRequest) Reset() {
+	*x = ReopenDepartmentRequest{}
+	mi := &file_api_proto_department_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenDepartmentRequest) ProtoMessage() {}
+
+func (x *ReopenDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_department_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*ReopenDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReopenDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 // =====================
 // Pagination Metadata
 // =====================
@@ -372,7 +785,7 @@ type PaginationMetadata struct {
 
 func (x *PaginationMetadata) Reset() {
 	*x = PaginationMetadata{}
-	mi := &file_api_proto_department_proto_msgTypes[6]
+	mi := &file_api_proto_department_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -384,7 +797,7 @@ func (x *PaginationMetadata) String() string {
 func (*PaginationMetadata) ProtoMessage() {}
 
 func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[6]
+	mi := &file_api_proto_department_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +810,7 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
 func (*PaginationMetadata) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PaginationMetadata) GetCurrentPage() int32 {
@@ -441,7 +854,7 @@ type DepartmentResponse struct {
 
 func (x *DepartmentResponse) Reset() {
 	*x = DepartmentResponse{}
-	mi := &file_api_proto_department_proto_msgTypes[7]
+	mi := &file_api_proto_department_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +866,7 @@ func (x *DepartmentResponse) String() string {
 func (*DepartmentResponse) ProtoMessage() {}
 
 func (x *DepartmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[7]
+	mi := &file_api_proto_department_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +879,7 @@ func (x *DepartmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepartmentResponse.ProtoReflect.Descriptor instead.
 func (*DepartmentResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DepartmentResponse) GetDepartment() *Department {
@@ -494,7 +907,7 @@ type ListDepartmentsResponse struct {
 
 func (x *ListDepartmentsResponse) Reset() {
 	*x = ListDepartmentsResponse{}
-	mi := &file_api_proto_department_proto_msgTypes[8]
+	mi := &file_api_proto_department_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +919,7 @@ func (x *ListDepartmentsResponse) String() string {
 func (*ListDepartmentsResponse) ProtoMessage() {}
 
 func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[8]
+	mi := &file_api_proto_department_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +932,7 @@ func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDepartmentsResponse.ProtoReflect.Descriptor instead.
 func (*ListDepartmentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListDepartmentsResponse) GetDepartments() []*Department {
@@ -553,7 +966,7 @@ type DeleteDepartmentResponse struct {
 
 func (x *DeleteDepartmentResponse) Reset() {
 	*x = DeleteDepartmentResponse{}
-	mi := &file_api_proto_department_proto_msgTypes[9]
+	mi := &file_api_proto_department_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -565,7 +978,7 @@ func (x *DeleteDepartmentResponse) String() string {
 func (*DeleteDepartmentResponse) ProtoMessage() {}
 
 func (x *DeleteDepartmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_department_proto_msgTypes[9]
+	mi := &file_api_proto_department_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +991,7 @@ func (x *DeleteDepartmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDepartmentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDepartmentResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_department_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_department_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteDepartmentResponse) GetSuccess() bool {
@@ -595,11 +1008,123 @@ func (x *DeleteDepartmentResponse) GetMessage() string {
 	return ""
 }
 
+type GetDepartmentTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*DepartmentTreeNode  `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Departments in the tree
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentTreeResponse) Reset() {
+	*x = GetDepartmentTreeResponse{}
+	mi := &file_api_proto_department_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentTreeResponse) ProtoMessage() {}
+
+func (x *GetDepartmentTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_department_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetDepartmentTreeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDepartmentTreeResponse) GetRoots() []*DepartmentTreeNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+func (x *GetDepartmentTreeResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CloseDepartmentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Department      *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	ReassignedUsers int64                  `protobuf:"varint,2,opt,name=reassigned_users,json=reassignedUsers,proto3" json:"reassigned_users,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CloseDepartmentResponse) Reset() {
+	*x = CloseDepartmentResponse{}
+	mi := &file_api_proto_department_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseDepartmentResponse) ProtoMessage() {}
+
+func (x *CloseDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_department_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*CloseDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_department_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CloseDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+func (x *CloseDepartmentResponse) GetReassignedUsers() int64 {
+	if x != nil {
+		return x.ReassignedUsers
+	}
+	return 0
+}
+
+func (x *CloseDepartmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_api_proto_department_proto protoreflect.FileDescriptor
 
 const file_api_proto_department_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/proto/department.proto\x12\vdepartments\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xc8\x01\n" +
+	"\x1aapi/proto/department.proto\x12\vdepartments\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xc6\x03\n" +
 	"\n" +
 	"Department\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -608,21 +1133,57 @@ const file_api_proto_department_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"O\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tparent_id\x18\x06 \x01(\tR\bparentId\x12 \n" +
+	"\fhead_user_id\x18\a \x01(\tR\n" +
+	"headUserId\x12(\n" +
+	"\x10cost_centre_code\x18\b \x01(\tR\x0ecostCentreCode\x125\n" +
+	"\x06status\x18\t \x01(\x0e2\x1d.departments.DepartmentStatusR\x06status\x127\n" +
+	"\tclosed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12#\n" +
+	"\rclosed_reason\x18\v \x01(\tR\fclosedReason\"\x8a\x01\n" +
+	"\x12DepartmentTreeNode\x127\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x17.departments.DepartmentR\n" +
+	"department\x12;\n" +
+	"\bchildren\x18\x02 \x03(\v2\x1f.departments.DepartmentTreeNodeR\bchildren\"\xdd\x01\n" +
 	"\x17CreateDepartmentRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"&\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12 \n" +
+	"\fhead_user_id\x18\x04 \x01(\tR\n" +
+	"headUserId\x12(\n" +
+	"\x10cost_centre_code\x18\x05 \x01(\tR\x0ecostCentreCode\x12#\n" +
+	"\rvalidate_only\x18\x06 \x01(\bR\fvalidateOnly\"&\n" +
 	"\x14GetDepartmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xdb\x01\n" +
 	"\x17UpdateDepartmentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\")\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
+	"\fhead_user_id\x18\x04 \x01(\tH\x00R\n" +
+	"headUserId\x88\x01\x01\x12-\n" +
+	"\x10cost_centre_code\x18\x05 \x01(\tH\x01R\x0ecostCentreCode\x88\x01\x01B\x0f\n" +
+	"\r_head_user_idB\x13\n" +
+	"\x11_cost_centre_code\")\n" +
 	"\x17DeleteDepartmentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x80\x01\n" +
 	"\x16ListDepartmentsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x96\x01\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1d.departments.DepartmentStatusR\x06status\"^\n" +
+	"\x18GetDepartmentTreeRequest\x12\x17\n" +
+	"\aroot_id\x18\x01 \x01(\tR\x06rootId\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"K\n" +
+	"\x15MoveDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rnew_parent_id\x18\x02 \x01(\tR\vnewParentId\"{\n" +
+	"\x16CloseDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\x19reassign_to_department_id\x18\x02 \x01(\tR\x16reassignToDepartmentId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\")\n" +
+	"\x17ReopenDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x96\x01\n" +
 	"\x12PaginationMetadata\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
@@ -644,13 +1205,31 @@ const file_api_proto_department_proto_rawDesc = "" +
 	"pagination\"N\n" +
 	"\x18DeleteDepartmentResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x84\x05\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"s\n" +
+	"\x19GetDepartmentTreeResponse\x125\n" +
+	"\x05roots\x18\x01 \x03(\v2\x1f.departments.DepartmentTreeNodeR\x05roots\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x97\x01\n" +
+	"\x17CloseDepartmentResponse\x127\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x17.departments.DepartmentR\n" +
+	"department\x12)\n" +
+	"\x10reassigned_users\x18\x02 \x01(\x03R\x0freassignedUsers\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*s\n" +
+	"\x10DepartmentStatus\x12!\n" +
+	"\x1dDEPARTMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DEPARTMENT_STATUS_ACTIVE\x10\x01\x12\x1e\n" +
+	"\x1aDEPARTMENT_STATUS_INACTIVE\x10\x022\x9e\t\n" +
 	"\x11DepartmentService\x12y\n" +
 	"\x10CreateDepartment\x12$.departments.CreateDepartmentRequest\x1a\x1f.departments.DepartmentResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/departments\x12u\n" +
 	"\rGetDepartment\x12!.departments.GetDepartmentRequest\x1a\x1f.departments.DepartmentResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/departments/{id}\x12~\n" +
 	"\x10UpdateDepartment\x12$.departments.UpdateDepartmentRequest\x1a\x1f.departments.DepartmentResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/api/v1/departments/{id}\x12\x81\x01\n" +
 	"\x10DeleteDepartment\x12$.departments.DeleteDepartmentRequest\x1a%.departments.DeleteDepartmentResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/departments/{id}\x12y\n" +
-	"\x0fListDepartments\x12#.departments.ListDepartmentsRequest\x1a$.departments.ListDepartmentsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/departmentsBEZCgithub.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb;departmentpbb\x06proto3"
+	"\x0fListDepartments\x12#.departments.ListDepartmentsRequest\x1a$.departments.ListDepartmentsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/departments\x12\x84\x01\n" +
+	"\x11GetDepartmentTree\x12%.departments.GetDepartmentTreeRequest\x1a&.departments.GetDepartmentTreeResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/departments/tree\x12\x7f\n" +
+	"\x0eMoveDepartment\x12\".departments.MoveDepartmentRequest\x1a\x1f.departments.DepartmentResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/departments/{id}/move\x12\x87\x01\n" +
+	"\x0fCloseDepartment\x12#.departments.CloseDepartmentRequest\x1a$.departments.CloseDepartmentResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/departments/{id}/close\x12\x85\x01\n" +
+	"\x10ReopenDepartment\x12$.departments.ReopenDepartmentRequest\x1a\x1f.departments.DepartmentResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/departments/{id}/reopenBEZCgithub.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb;departmentpbb\x06proto3"
 
 var (
 	file_api_proto_department_proto_rawDescOnce sync.Once
@@ -664,41 +1243,65 @@ func file_api_proto_department_proto_rawDescGZIP() []byte {
 	return file_api_proto_department_proto_rawDescData
 }
 
-var file_api_proto_department_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_proto_department_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_department_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_api_proto_department_proto_goTypes = []any{
-	(*Department)(nil),               // 0: departments.Department
-	(*CreateDepartmentRequest)(nil),  // 1: departments.CreateDepartmentRequest
-	(*GetDepartmentRequest)(nil),     // 2: departments.GetDepartmentRequest
-	(*UpdateDepartmentRequest)(nil),  // 3: departments.UpdateDepartmentRequest
-	(*DeleteDepartmentRequest)(nil),  // 4: departments.DeleteDepartmentRequest
-	(*ListDepartmentsRequest)(nil),   // 5: departments.ListDepartmentsRequest
-	(*PaginationMetadata)(nil),       // 6: departments.PaginationMetadata
-	(*DepartmentResponse)(nil),       // 7: departments.DepartmentResponse
-	(*ListDepartmentsResponse)(nil),  // 8: departments.ListDepartmentsResponse
-	(*DeleteDepartmentResponse)(nil), // 9: departments.DeleteDepartmentResponse
-	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(DepartmentStatus)(0),             // 0: departments.DepartmentStatus
+	(*Department)(nil),                // 1: departments.Department
+	(*DepartmentTreeNode)(nil),        // 2: departments.DepartmentTreeNode
+	(*CreateDepartmentRequest)(nil),   // 3: departments.CreateDepartmentRequest
+	(*GetDepartmentRequest)(nil),      // 4: departments.GetDepartmentRequest
+	(*UpdateDepartmentRequest)(nil),   // 5: departments.UpdateDepartmentRequest
+	(*DeleteDepartmentRequest)(nil),   // 6: departments.DeleteDepartmentRequest
+	(*ListDepartmentsRequest)(nil),    // 7: departments.ListDepartmentsRequest
+	(*GetDepartmentTreeRequest)(nil),  // 8: departments.GetDepartmentTreeRequest
+	(*MoveDepartmentRequest)(nil),     // 9: departments.MoveDepartmentRequest
+	(*CloseDepartmentRequest)(nil),    // 10: departments.CloseDepartmentRequest
+	(*ReopenDepartmentRequest)(nil),   // 11: departments.ReopenDepartmentRequest
+	(*PaginationMetadata)(nil),        // 12: departments.PaginationMetadata
+	(*DepartmentResponse)(nil),        // 13: departments.DepartmentResponse
+	(*ListDepartmentsResponse)(nil),   // 14: departments.ListDepartmentsResponse
+	(*DeleteDepartmentResponse)(nil),  // 15: departments.DeleteDepartmentResponse
+	(*GetDepartmentTreeResponse)(nil), // 16: departments.GetDepartmentTreeResponse
+	(*CloseDepartmentResponse)(nil),   // 17: departments.CloseDepartmentResponse
+	(*timestamppb.Timestamp)(nil),     // 18: google.protobuf.Timestamp
 }
 var file_api_proto_department_proto_depIdxs = []int32{
-	10, // 0: departments.Department.created_at:type_name -> google.protobuf.Timestamp
-	10, // 1: departments.Department.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: departments.DepartmentResponse.department:type_name -> departments.Department
-	0,  // 3: departments.ListDepartmentsResponse.departments:type_name -> departments.Department
-	6,  // 4: departments.ListDepartmentsResponse.pagination:type_name -> departments.PaginationMetadata
-	1,  // 5: departments.DepartmentService.CreateDepartment:input_type -> departments.CreateDepartmentRequest
-	2,  // 6: departments.DepartmentService.GetDepartment:input_type -> departments.GetDepartmentRequest
-	3,  // 7: departments.DepartmentService.UpdateDepartment:input_type -> departments.UpdateDepartmentRequest
-	4,  // 8: departments.DepartmentService.DeleteDepartment:input_type -> departments.DeleteDepartmentRequest
-	5,  // 9: departments.DepartmentService.ListDepartments:input_type -> departments.ListDepartmentsRequest
-	7,  // 10: departments.DepartmentService.CreateDepartment:output_type -> departments.DepartmentResponse
-	7,  // 11: departments.DepartmentService.GetDepartment:output_type -> departments.DepartmentResponse
-	7,  // 12: departments.DepartmentService.UpdateDepartment:output_type -> departments.DepartmentResponse
-	9,  // 13: departments.DepartmentService.DeleteDepartment:output_type -> departments.DeleteDepartmentResponse
-	8,  // 14: departments.DepartmentService.ListDepartments:output_type -> departments.ListDepartmentsResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	18, // 0: departments.Department.created_at:type_name -> google.protobuf.Timestamp
+	18, // 1: departments.Department.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: departments.Department.status:type_name -> departments.DepartmentStatus
+	18, // 3: departments.Department.closed_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: departments.DepartmentTreeNode.department:type_name -> departments.Department
+	2,  // 5: departments.DepartmentTreeNode.children:type_name -> departments.DepartmentTreeNode
+	0,  // 6: departments.ListDepartmentsRequest.status:type_name -> departments.DepartmentStatus
+	1,  // 7: departments.DepartmentResponse.department:type_name -> departments.Department
+	1,  // 8: departments.ListDepartmentsResponse.departments:type_name -> departments.Department
+	12, // 9: departments.ListDepartmentsResponse.pagination:type_name -> departments.PaginationMetadata
+	2,  // 10: departments.GetDepartmentTreeResponse.roots:type_name -> departments.DepartmentTreeNode
+	1,  // 11: departments.CloseDepartmentResponse.department:type_name -> departments.Department
+	3,  // 12: departments.DepartmentService.CreateDepartment:input_type -> departments.CreateDepartmentRequest
+	4,  // 13: departments.DepartmentService.GetDepartment:input_type -> departments.GetDepartmentRequest
+	5,  // 14: departments.DepartmentService.UpdateDepartment:input_type -> departments.UpdateDepartmentRequest
+	6,  // 15: departments.DepartmentService.DeleteDepartment:input_type -> departments.DeleteDepartmentRequest
+	7,  // 16: departments.DepartmentService.ListDepartments:input_type -> departments.ListDepartmentsRequest
+	8,  // 17: departments.DepartmentService.GetDepartmentTree:input_type -> departments.GetDepartmentTreeRequest
+	9,  // 18: departments.DepartmentService.MoveDepartment:input_type -> departments.MoveDepartmentRequest
+	10, // 19: departments.DepartmentService.CloseDepartment:input_type -> departments.CloseDepartmentRequest
+	11, // 20: departments.DepartmentService.ReopenDepartment:input_type -> departments.ReopenDepartmentRequest
+	13, // 21: departments.DepartmentService.CreateDepartment:output_type -> departments.DepartmentResponse
+	13, // 22: departments.DepartmentService.GetDepartment:output_type -> departments.DepartmentResponse
+	13, // 23: departments.DepartmentService.UpdateDepartment:output_type -> departments.DepartmentResponse
+	15, // 24: departments.DepartmentService.DeleteDepartment:output_type -> departments.DeleteDepartmentResponse
+	14, // 25: departments.DepartmentService.ListDepartments:output_type -> departments.ListDepartmentsResponse
+	16, // 26: departments.DepartmentService.GetDepartmentTree:output_type -> departments.GetDepartmentTreeResponse
+	13, // 27: departments.DepartmentService.MoveDepartment:output_type -> departments.DepartmentResponse
+	17, // 28: departments.DepartmentService.CloseDepartment:output_type -> departments.CloseDepartmentResponse
+	13, // 29: departments.DepartmentService.ReopenDepartment:output_type -> departments.DepartmentResponse
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_department_proto_init() }
@@ -706,18 +1309,20 @@ func file_api_proto_department_proto_init() {
 	if File_api_proto_department_proto != nil {
 		return
 	}
+	file_api_proto_department_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_department_proto_rawDesc), len(file_api_proto_department_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_proto_department_proto_goTypes,
 		DependencyIndexes: file_api_proto_department_proto_depIdxs,
+		EnumInfos:         file_api_proto_department_proto_enumTypes,
 		MessageInfos:      file_api_proto_department_proto_msgTypes,
 	}.Build()
 	File_api_proto_department_proto = out.File
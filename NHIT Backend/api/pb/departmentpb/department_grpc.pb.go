@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DepartmentService_CreateDepartment_FullMethodName = "/departments.DepartmentService/CreateDepartment"
-	DepartmentService_GetDepartment_FullMethodName    = "/departments.DepartmentService/GetDepartment"
-	DepartmentService_UpdateDepartment_FullMethodName = "/departments.DepartmentService/UpdateDepartment"
-	DepartmentService_DeleteDepartment_FullMethodName = "/departments.DepartmentService/DeleteDepartment"
-	DepartmentService_ListDepartments_FullMethodName  = "/departments.DepartmentService/ListDepartments"
+	DepartmentService_CreateDepartment_FullMethodName  = "/departments.DepartmentService/CreateDepartment"
+	DepartmentService_GetDepartment_FullMethodName     = "/departments.DepartmentService/GetDepartment"
+	DepartmentService_UpdateDepartment_FullMethodName  = "/departments.DepartmentService/UpdateDepartment"
+	DepartmentService_DeleteDepartment_FullMethodName  = "/departments.DepartmentService/DeleteDepartment"
+	DepartmentService_ListDepartments_FullMethodName   = "/departments.DepartmentService/ListDepartments"
+	DepartmentService_GetDepartmentTree_FullMethodName = "/departments.DepartmentService/GetDepartmentTree"
+	DepartmentService_MoveDepartment_FullMethodName    = "/departments.DepartmentService/MoveDepartment"
+	DepartmentService_CloseDepartment_FullMethodName   = "/departments.DepartmentService/CloseDepartment"
+	DepartmentService_ReopenDepartment_FullMethodName  = "/departments.DepartmentService/ReopenDepartment"
 )
 
 // DepartmentServiceClient is the client API for DepartmentService service.
@@ -39,6 +43,13 @@ type DepartmentServiceClient interface {
 	UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...grpc.CallOption) (*DepartmentResponse, error)
 	DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...grpc.CallOption) (*DeleteDepartmentResponse, error)
 	ListDepartments(ctx context.Context, in *ListDepartmentsRequest, opts ...grpc.CallOption) (*ListDepartmentsResponse, error)
+	// Departments of the caller's organization as a tree
+	GetDepartmentTree(ctx context.Context, in *GetDepartmentTreeRequest, opts ...grpc.CallOption) (*GetDepartmentTreeResponse, error)
+	// Moves a department and the departments below it under another parent
+	MoveDepartment(ctx context.Context, in *MoveDepartmentRequest, opts ...grpc.CallOption) (*DepartmentResponse, error)
+	// Marks a department inactive, moving its users to another department
+	CloseDepartment(ctx context.Context, in *CloseDepartmentRequest, opts ...grpc.CallOption) (*CloseDepartmentResponse, error)
+	ReopenDepartment(ctx context.Context, in *ReopenDepartmentRequest, opts ...grpc.CallOption) (*DepartmentResponse, error)
 }
 
 type departmentServiceClient struct {
@@ -99,6 +110,46 @@ func (c *departmentServiceClient) ListDepartments(ctx context.Context, in *ListD
 	return out, nil
 }
 
+func (c *departmentServiceClient) GetDepartmentTree(ctx context.Context, in *GetDepartmentTreeRequest, opts ...grpc.CallOption) (*GetDepartmentTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDepartmentTreeResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_GetDepartmentTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) MoveDepartment(ctx context.Context, in *MoveDepartmentRequest, opts ...grpc.CallOption) (*DepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_MoveDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) CloseDepartment(ctx context.Context, in *CloseDepartmentRequest, opts ...grpc.CallOption) (*CloseDepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseDepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_CloseDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) ReopenDepartment(ctx context.Context, in *ReopenDepartmentRequest, opts ...grpc.CallOption) (*DepartmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepartmentResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_ReopenDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DepartmentServiceServer is the server API for DepartmentService service.
 // All implementations must embed UnimplementedDepartmentServiceServer
 // for forward compatibility.
@@ -112,6 +163,13 @@ type DepartmentServiceServer interface {
 	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*DepartmentResponse, error)
 	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error)
 	ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error)
+	// Departments of the caller's organization as a tree
+	GetDepartmentTree(context.Context, *GetDepartmentTreeRequest) (*GetDepartmentTreeResponse, error)
+	// Moves a department and the departments below it under another parent
+	MoveDepartment(context.Context, *MoveDepartmentRequest) (*DepartmentResponse, error)
+	// Marks a department inactive, moving its users to another department
+	CloseDepartment(context.Context, *CloseDepartmentRequest) (*CloseDepartmentResponse, error)
+	ReopenDepartment(context.Context, *ReopenDepartmentRequest) (*DepartmentResponse, error)
 	mustEmbedUnimplementedDepartmentServiceServer()
 }
 
@@ -137,6 +195,18 @@ func (UnimplementedDepartmentServiceServer) DeleteDepartment(context.Context, *D
 func (UnimplementedDepartmentServiceServer) ListDepartments(context.Context, *ListDepartmentsRequest) (*ListDepartmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDepartments not implemented")
 }
+func (UnimplementedDepartmentServiceServer) GetDepartmentTree(context.Context, *GetDepartmentTreeRequest) (*GetDepartmentTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentTree not implemented")
+}
+func (UnimplementedDepartmentServiceServer) MoveDepartment(context.Context, *MoveDepartmentRequest) (*DepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) CloseDepartment(context.Context, *CloseDepartmentRequest) (*CloseDepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) ReopenDepartment(context.Context, *ReopenDepartmentRequest) (*DepartmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenDepartment not implemented")
+}
 func (UnimplementedDepartmentServiceServer) mustEmbedUnimplementedDepartmentServiceServer() {}
 func (UnimplementedDepartmentServiceServer) testEmbeddedByValue()                           {}
 
@@ -248,6 +318,78 @@ func _DepartmentService_ListDepartments_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepartmentService_GetDepartmentTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepartmentTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).GetDepartmentTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_GetDepartmentTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).GetDepartmentTree(ctx, req.(*GetDepartmentTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_MoveDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).MoveDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_MoveDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).MoveDepartment(ctx, req.(*MoveDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_CloseDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).CloseDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_CloseDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).CloseDepartment(ctx, req.(*CloseDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_ReopenDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenDepartmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).ReopenDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_ReopenDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).ReopenDepartment(ctx, req.(*ReopenDepartmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DepartmentService_ServiceDesc is the grpc.ServiceDesc for DepartmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -275,6 +417,22 @@ var DepartmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDepartments",
 			Handler:    _DepartmentService_ListDepartments_Handler,
 		},
+		{
+			MethodName: "GetDepartmentTree",
+			Handler:    _DepartmentService_GetDepartmentTree_Handler,
+		},
+		{
+			MethodName: "MoveDepartment",
+			Handler:    _DepartmentService_MoveDepartment_Handler,
+		},
+		{
+			MethodName: "CloseDepartment",
+			Handler:    _DepartmentService_CloseDepartment_Handler,
+		},
+		{
+			MethodName: "ReopenDepartment",
+			Handler:    _DepartmentService_ReopenDepartment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/department.proto",
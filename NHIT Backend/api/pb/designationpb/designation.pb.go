@@ -23,16 +23,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Type of approval a financial power covers, as on green notes
+type ApprovalFor int32
+
+const (
+	ApprovalFor_APPROVAL_FOR_ANY     ApprovalFor = 0
+	ApprovalFor_APPROVAL_FOR_INVOICE ApprovalFor = 1
+	ApprovalFor_APPROVAL_FOR_ADVANCE ApprovalFor = 2
+	ApprovalFor_APPROVAL_FOR_ADHOC   ApprovalFor = 3
+)
+
+// Enum value maps for ApprovalFor.
+var (
+	ApprovalFor_name = map[int32]string{
+		0: "APPROVAL_FOR_ANY",
+		1: "APPROVAL_FOR_INVOICE",
+		2: "APPROVAL_FOR_ADVANCE",
+		3: "APPROVAL_FOR_ADHOC",
+	}
+	ApprovalFor_value = map[string]int32{
+		"APPROVAL_FOR_ANY":     0,
+		"APPROVAL_FOR_INVOICE": 1,
+		"APPROVAL_FOR_ADVANCE": 2,
+		"APPROVAL_FOR_ADHOC":   3,
+	}
+)
+
+func (x ApprovalFor) Enum() *ApprovalFor {
+	p := new(ApprovalFor)
+	*p = x
+	return p
+}
+
+func (x ApprovalFor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalFor) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_designation_proto_enumTypes[0].Descriptor()
+}
+
+func (ApprovalFor) Type() protoreflect.EnumType {
+	return &file_api_proto_designation_proto_enumTypes[0]
+}
+
+func (x ApprovalFor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalFor.Descriptor instead.
+func (ApprovalFor) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{0}
+}
+
 // Designation entity
 type Designation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Grade           string                 `protobuf:"bytes,6,opt,name=grade,proto3" json:"grade,omitempty"`                                            // e.g. "M3"
+	Level           int32                  `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`                                           // Higher is more senior
+	FinancialPowers []*FinancialPower      `protobuf:"bytes,8,rep,name=financial_powers,json=financialPowers,proto3" json:"financial_powers,omitempty"` // Set on single-designation responses
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Designation) Reset() {
@@ -100,18 +156,106 @@ func (x *Designation) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Designation) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *Designation) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Designation) GetFinancialPowers() []*FinancialPower {
+	if x != nil {
+		return x.FinancialPowers
+	}
+	return nil
+}
+
+// One entry of the delegation-of-financial-powers matrix: the most a
+// designation may approve for an expense category and approval type. The
+// most specific entry applies; empty category and ANY match everything.
+type FinancialPower struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseCategory string                 `protobuf:"bytes,1,opt,name=expense_category,json=expenseCategory,proto3" json:"expense_category,omitempty"`
+	ApprovalFor     ApprovalFor            `protobuf:"varint,2,opt,name=approval_for,json=approvalFor,proto3,enum=designations.ApprovalFor" json:"approval_for,omitempty"`
+	MaxAmount       string                 `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // decimal rupees, e.g. "500000.00"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FinancialPower) Reset() {
+	*x = FinancialPower{}
+	mi := &file_api_proto_designation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinancialPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinancialPower) ProtoMessage() {}
+
+func (x *FinancialPower) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_designation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinancialPower.ProtoReflect.Descriptor instead.
+func (*FinancialPower) Descriptor() ([]byte, []int) {
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FinancialPower) GetExpenseCategory() string {
+	if x != nil {
+		return x.ExpenseCategory
+	}
+	return ""
+}
+
+func (x *FinancialPower) GetApprovalFor() ApprovalFor {
+	if x != nil {
+		return x.ApprovalFor
+	}
+	return ApprovalFor_APPROVAL_FOR_ANY
+}
+
+func (x *FinancialPower) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
 // Create Designation
 type CreateDesignationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Grade           string                 `protobuf:"bytes,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	Level           int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	FinancialPowers []*FinancialPower      `protobuf:"bytes,5,rep,name=financial_powers,json=financialPowers,proto3" json:"financial_powers,omitempty"`
+	ValidateOnly    bool                   `protobuf:"varint,6,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"` // Run every check but create nothing
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateDesignationRequest) Reset() {
 	*x = CreateDesignationRequest{}
-	mi := &file_api_proto_designation_proto_msgTypes[1]
+	mi := &file_api_proto_designation_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -123,7 +267,7 @@ func (x *CreateDesignationRequest) String() string {
 func (*CreateDesignationRequest) ProtoMessage() {}
 
 func (x *CreateDesignationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[1]
+	mi := &file_api_proto_designation_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -136,7 +280,7 @@ func (x *CreateDesignationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDesignationRequest.ProtoReflect.Descriptor instead.
 func (*CreateDesignationRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateDesignationRequest) GetName() string {
@@ -153,6 +297,34 @@ func (x *CreateDesignationRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateDesignationRequest) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *CreateDesignationRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CreateDesignationRequest) GetFinancialPowers() []*FinancialPower {
+	if x != nil {
+		return x.FinancialPowers
+	}
+	return nil
+}
+
+func (x *CreateDesignationRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
 type DesignationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Designation   *Designation           `protobuf:"bytes,1,opt,name=designation,proto3" json:"designation,omitempty"`
@@ -163,7 +335,7 @@ type DesignationResponse struct {
 
 func (x *DesignationResponse) Reset() {
 	*x = DesignationResponse{}
-	mi := &file_api_proto_designation_proto_msgTypes[2]
+	mi := &file_api_proto_designation_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +347,7 @@ func (x *DesignationResponse) String() string {
 func (*DesignationResponse) ProtoMessage() {}
 
 func (x *DesignationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[2]
+	mi := &file_api_proto_designation_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +360,7 @@ func (x *DesignationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DesignationResponse.ProtoReflect.Descriptor instead.
 func (*DesignationResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DesignationResponse) GetDesignation() *Designation {
@@ -215,7 +387,7 @@ type GetDesignationRequest struct {
 
 func (x *GetDesignationRequest) Reset() {
 	*x = GetDesignationRequest{}
-	mi := &file_api_proto_designation_proto_msgTypes[3]
+	mi := &file_api_proto_designation_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -227,7 +399,7 @@ func (x *GetDesignationRequest) String() string {
 func (*GetDesignationRequest) ProtoMessage() {}
 
 func (x *GetDesignationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[3]
+	mi := &file_api_proto_designation_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +412,7 @@ func (x *GetDesignationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDesignationRequest.ProtoReflect.Descriptor instead.
 func (*GetDesignationRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetDesignationRequest) GetId() string {
@@ -256,13 +428,15 @@ type UpdateDesignationRequest struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Grade         *string                `protobuf:"bytes,4,opt,name=grade,proto3,oneof" json:"grade,omitempty"`  // Left unchanged when unset
+	Level         *int32                 `protobuf:"varint,5,opt,name=level,proto3,oneof" json:"level,omitempty"` // Left unchanged when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateDesignationRequest) Reset() {
 	*x = UpdateDesignationRequest{}
-	mi := &file_api_proto_designation_proto_msgTypes[4]
+	mi := &file_api_proto_designation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -274,7 +448,7 @@ func (x *UpdateDesignationRequest) String() string {
 func (*UpdateDesignationRequest) ProtoMessage() {}
 
 func (x *UpdateDesignationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[4]
+	mi := &file_api_proto_designation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +461,7 @@ func (x *UpdateDesignationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDesignationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDesignationRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateDesignationRequest) GetId() string {
@@ -311,6 +485,20 @@ func (x *UpdateDesignationRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateDesignationRequest) GetGrade() string {
+	if x != nil && x.Grade != nil {
+		return *x.Grade
+	}
+	return ""
+}
+
+func (x *UpdateDesignationRequest) GetLevel() int32 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
 // Pagination metadata
 type PaginationMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -324,7 +512,7 @@ type PaginationMetadata struct {
 
 func (x *PaginationMetadata) Reset() {
 	*x = PaginationMetadata{}
-	mi := &file_api_proto_designation_proto_msgTypes[5]
+	mi := &file_api_proto_designation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +524,7 @@ func (x *PaginationMetadata) String() string {
 func (*PaginationMetadata) ProtoMessage() {}
 
 func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[5]
+	mi := &file_api_proto_designation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +537,7 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
 func (*PaginationMetadata) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PaginationMetadata) GetCurrentPage() int32 {
@@ -390,7 +578,7 @@ type DeleteDesignationRequest struct {
 
 func (x *DeleteDesignationRequest) Reset() {
 	*x = DeleteDesignationRequest{}
-	mi := &file_api_proto_designation_proto_msgTypes[6]
+	mi := &file_api_proto_designation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -402,7 +590,7 @@ func (x *DeleteDesignationRequest) String() string {
 func (*DeleteDesignationRequest) ProtoMessage() {}
 
 func (x *DeleteDesignationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[6]
+	mi := &file_api_proto_designation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +603,7 @@ func (x *DeleteDesignationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDesignationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDesignationRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteDesignationRequest) GetId() string {
@@ -435,7 +623,7 @@ type DeleteDesignationResponse struct {
 
 func (x *DeleteDesignationResponse) Reset() {
 	*x = DeleteDesignationResponse{}
-	mi := &file_api_proto_designation_proto_msgTypes[7]
+	mi := &file_api_proto_designation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +635,7 @@ func (x *DeleteDesignationResponse) String() string {
 func (*DeleteDesignationResponse) ProtoMessage() {}
 
 func (x *DeleteDesignationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[7]
+	mi := &file_api_proto_designation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +648,7 @@ func (x *DeleteDesignationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDesignationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDesignationResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteDesignationResponse) GetSuccess() bool {
@@ -488,7 +676,7 @@ type ListDesignationsRequest struct {
 
 func (x *ListDesignationsRequest) Reset() {
 	*x = ListDesignationsRequest{}
-	mi := &file_api_proto_designation_proto_msgTypes[8]
+	mi := &file_api_proto_designation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +688,7 @@ func (x *ListDesignationsRequest) String() string {
 func (*ListDesignationsRequest) ProtoMessage() {}
 
 func (x *ListDesignationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[8]
+	mi := &file_api_proto_designation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +701,7 @@ func (x *ListDesignationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDesignationsRequest.ProtoReflect.Descriptor instead.
 func (*ListDesignationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListDesignationsRequest) GetPage() int32 {
@@ -540,7 +728,7 @@ type ListDesignationsResponse struct {
 
 func (x *ListDesignationsResponse) Reset() {
 	*x = ListDesignationsResponse{}
-	mi := &file_api_proto_designation_proto_msgTypes[9]
+	mi := &file_api_proto_designation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +740,7 @@ func (x *ListDesignationsResponse) String() string {
 func (*ListDesignationsResponse) ProtoMessage() {}
 
 func (x *ListDesignationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_designation_proto_msgTypes[9]
+	mi := &file_api_proto_designation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +753,7 @@ func (x *ListDesignationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDesignationsResponse.ProtoReflect.Descriptor instead.
 func (*ListDesignationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_designation_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListDesignationsResponse) GetDesignations() []*Designation {
@@ -582,11 +770,220 @@ func (x *ListDesignationsResponse) GetPagination() *PaginationMetadata {
 	return nil
 }
 
+// Financial powers
+type SetFinancialPowersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DesignationId   string                 `protobuf:"bytes,1,opt,name=designation_id,json=designationId,proto3" json:"designation_id,omitempty"`
+	FinancialPowers []*FinancialPower      `protobuf:"bytes,2,rep,name=financial_powers,json=financialPowers,proto3" json:"financial_powers,omitempty"` // Replaces the designation's matrix
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetFinancialPowersRequest) Reset() {
+	*x = SetFinancialPowersRequest{}
+	mi := &file_api_proto_designation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFinancialPowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFinancialPowersRequest) ProtoMessage() {}
+
+func (x *SetFinancialPowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_designation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFinancialPowersRequest.ProtoReflect.Descriptor instead.
+func (*SetFinancialPowersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetFinancialPowersRequest) GetDesignationId() string {
+	if x != nil {
+		return x.DesignationId
+	}
+	return ""
+}
+
+func (x *SetFinancialPowersRequest) GetFinancialPowers() []*FinancialPower {
+	if x != nil {
+		return x.FinancialPowers
+	}
+	return nil
+}
+
+type ListApprovingDesignationsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Amount          string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"` // decimal rupees
+	ExpenseCategory string                 `protobuf:"bytes,2,opt,name=expense_category,json=expenseCategory,proto3" json:"expense_category,omitempty"`
+	ApprovalFor     ApprovalFor            `protobuf:"varint,3,opt,name=approval_for,json=approvalFor,proto3,enum=designations.ApprovalFor" json:"approval_for,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListApprovingDesignationsRequest) Reset() {
+	*x = ListApprovingDesignationsRequest{}
+	mi := &file_api_proto_designation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovingDesignationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovingDesignationsRequest) ProtoMessage() {}
+
+func (x *ListApprovingDesignationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_designation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovingDesignationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovingDesignationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListApprovingDesignationsRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ListApprovingDesignationsRequest) GetExpenseCategory() string {
+	if x != nil {
+		return x.ExpenseCategory
+	}
+	return ""
+}
+
+func (x *ListApprovingDesignationsRequest) GetApprovalFor() ApprovalFor {
+	if x != nil {
+		return x.ApprovalFor
+	}
+	return ApprovalFor_APPROVAL_FOR_ANY
+}
+
+type ApprovingDesignation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Designation    *Designation           `protobuf:"bytes,1,opt,name=designation,proto3" json:"designation,omitempty"`
+	FinancialPower *FinancialPower        `protobuf:"bytes,2,opt,name=financial_power,json=financialPower,proto3" json:"financial_power,omitempty"` // The matrix entry that applies
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApprovingDesignation) Reset() {
+	*x = ApprovingDesignation{}
+	mi := &file_api_proto_designation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovingDesignation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovingDesignation) ProtoMessage() {}
+
+func (x *ApprovingDesignation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_designation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovingDesignation.ProtoReflect.Descriptor instead.
+func (*ApprovingDesignation) Descriptor() ([]byte, []int) {
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApprovingDesignation) GetDesignation() *Designation {
+	if x != nil {
+		return x.Designation
+	}
+	return nil
+}
+
+func (x *ApprovingDesignation) GetFinancialPower() *FinancialPower {
+	if x != nil {
+		return x.FinancialPower
+	}
+	return nil
+}
+
+type ListApprovingDesignationsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Designations  []*ApprovingDesignation `protobuf:"bytes,1,rep,name=designations,proto3" json:"designations,omitempty"` // Most junior first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovingDesignationsResponse) Reset() {
+	*x = ListApprovingDesignationsResponse{}
+	mi := &file_api_proto_designation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovingDesignationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovingDesignationsResponse) ProtoMessage() {}
+
+func (x *ListApprovingDesignationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_designation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovingDesignationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovingDesignationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_designation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListApprovingDesignationsResponse) GetDesignations() []*ApprovingDesignation {
+	if x != nil {
+		return x.Designations
+	}
+	return nil
+}
+
 var File_api_proto_designation_proto protoreflect.FileDescriptor
 
 const file_api_proto_designation_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/proto/designation.proto\x12\fdesignations\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xc9\x01\n" +
+	"\x1bapi/proto/designation.proto\x12\fdesignations\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xbe\x02\n" +
 	"\vDesignation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -594,19 +991,35 @@ const file_api_proto_designation_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"P\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05grade\x18\x06 \x01(\tR\x05grade\x12\x14\n" +
+	"\x05level\x18\a \x01(\x05R\x05level\x12G\n" +
+	"\x10financial_powers\x18\b \x03(\v2\x1c.designations.FinancialPowerR\x0ffinancialPowers\"\x98\x01\n" +
+	"\x0eFinancialPower\x12)\n" +
+	"\x10expense_category\x18\x01 \x01(\tR\x0fexpenseCategory\x12<\n" +
+	"\fapproval_for\x18\x02 \x01(\x0e2\x19.designations.ApprovalForR\vapprovalFor\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x03 \x01(\tR\tmaxAmount\"\xea\x01\n" +
 	"\x18CreateDesignationRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"l\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05grade\x18\x03 \x01(\tR\x05grade\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12G\n" +
+	"\x10financial_powers\x18\x05 \x03(\v2\x1c.designations.FinancialPowerR\x0ffinancialPowers\x12#\n" +
+	"\rvalidate_only\x18\x06 \x01(\bR\fvalidateOnly\"l\n" +
 	"\x13DesignationResponse\x12;\n" +
 	"\vdesignation\x18\x01 \x01(\v2\x19.designations.DesignationR\vdesignation\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"'\n" +
 	"\x15GetDesignationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xaa\x01\n" +
 	"\x18UpdateDesignationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x96\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\x05grade\x18\x04 \x01(\tH\x00R\x05grade\x88\x01\x01\x12\x19\n" +
+	"\x05level\x18\x05 \x01(\x05H\x01R\x05level\x88\x01\x01B\b\n" +
+	"\x06_gradeB\b\n" +
+	"\x06_level\"\x96\x01\n" +
 	"\x12PaginationMetadata\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
@@ -626,13 +1039,32 @@ const file_api_proto_designation_proto_rawDesc = "" +
 	"\fdesignations\x18\x01 \x03(\v2\x19.designations.DesignationR\fdesignations\x12@\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2 .designations.PaginationMetadataR\n" +
-	"pagination2\xa4\x05\n" +
+	"pagination\"\x8b\x01\n" +
+	"\x19SetFinancialPowersRequest\x12%\n" +
+	"\x0edesignation_id\x18\x01 \x01(\tR\rdesignationId\x12G\n" +
+	"\x10financial_powers\x18\x02 \x03(\v2\x1c.designations.FinancialPowerR\x0ffinancialPowers\"\xa3\x01\n" +
+	" ListApprovingDesignationsRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12)\n" +
+	"\x10expense_category\x18\x02 \x01(\tR\x0fexpenseCategory\x12<\n" +
+	"\fapproval_for\x18\x03 \x01(\x0e2\x19.designations.ApprovalForR\vapprovalFor\"\x9a\x01\n" +
+	"\x14ApprovingDesignation\x12;\n" +
+	"\vdesignation\x18\x01 \x01(\v2\x19.designations.DesignationR\vdesignation\x12E\n" +
+	"\x0ffinancial_power\x18\x02 \x01(\v2\x1c.designations.FinancialPowerR\x0efinancialPower\"k\n" +
+	"!ListApprovingDesignationsResponse\x12F\n" +
+	"\fdesignations\x18\x01 \x03(\v2\".designations.ApprovingDesignationR\fdesignations*o\n" +
+	"\vApprovalFor\x12\x14\n" +
+	"\x10APPROVAL_FOR_ANY\x10\x00\x12\x18\n" +
+	"\x14APPROVAL_FOR_INVOICE\x10\x01\x12\x18\n" +
+	"\x14APPROVAL_FOR_ADVANCE\x10\x02\x12\x16\n" +
+	"\x12APPROVAL_FOR_ADHOC\x10\x032\xf1\a\n" +
 	"\x12DesignationService\x12\x7f\n" +
 	"\x11CreateDesignation\x12&.designations.CreateDesignationRequest\x1a!.designations.DesignationResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/designations\x12{\n" +
 	"\x0eGetDesignation\x12#.designations.GetDesignationRequest\x1a!.designations.DesignationResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/designations/{id}\x12\x84\x01\n" +
 	"\x11UpdateDesignation\x12&.designations.UpdateDesignationRequest\x1a!.designations.DesignationResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/v1/designations/{id}\x12\x87\x01\n" +
 	"\x11DeleteDesignation\x12&.designations.DeleteDesignationRequest\x1a'.designations.DeleteDesignationResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/designations/{id}\x12\x7f\n" +
-	"\x10ListDesignations\x12%.designations.ListDesignationsRequest\x1a&.designations.ListDesignationsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/designationsBGZEgithub.com/ShristiRnr/NHIT_Backend/api/pb/designationpb;designationpbb\x06proto3"
+	"\x10ListDesignations\x12%.designations.ListDesignationsRequest\x1a&.designations.ListDesignationsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/designations\x12\xa3\x01\n" +
+	"\x12SetFinancialPowers\x12'.designations.SetFinancialPowersRequest\x1a!.designations.DesignationResponse\"A\x82\xd3\xe4\x93\x02;:\x01*\x1a6/api/v1/designations/{designation_id}/financial-powers\x12\xa4\x01\n" +
+	"\x19ListApprovingDesignations\x12..designations.ListApprovingDesignationsRequest\x1a/.designations.ListApprovingDesignationsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/designations/approversBGZEgithub.com/ShristiRnr/NHIT_Backend/api/pb/designationpb;designationpbb\x06proto3"
 
 var (
 	file_api_proto_designation_proto_rawDescOnce sync.Once
@@ -646,41 +1078,60 @@ func file_api_proto_designation_proto_rawDescGZIP() []byte {
 	return file_api_proto_designation_proto_rawDescData
 }
 
-var file_api_proto_designation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_proto_designation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_designation_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_api_proto_designation_proto_goTypes = []any{
-	(*Designation)(nil),               // 0: designations.Designation
-	(*CreateDesignationRequest)(nil),  // 1: designations.CreateDesignationRequest
-	(*DesignationResponse)(nil),       // 2: designations.DesignationResponse
-	(*GetDesignationRequest)(nil),     // 3: designations.GetDesignationRequest
-	(*UpdateDesignationRequest)(nil),  // 4: designations.UpdateDesignationRequest
-	(*PaginationMetadata)(nil),        // 5: designations.PaginationMetadata
-	(*DeleteDesignationRequest)(nil),  // 6: designations.DeleteDesignationRequest
-	(*DeleteDesignationResponse)(nil), // 7: designations.DeleteDesignationResponse
-	(*ListDesignationsRequest)(nil),   // 8: designations.ListDesignationsRequest
-	(*ListDesignationsResponse)(nil),  // 9: designations.ListDesignationsResponse
-	(*timestamppb.Timestamp)(nil),     // 10: google.protobuf.Timestamp
+	(ApprovalFor)(0),                          // 0: designations.ApprovalFor
+	(*Designation)(nil),                       // 1: designations.Designation
+	(*FinancialPower)(nil),                    // 2: designations.FinancialPower
+	(*CreateDesignationRequest)(nil),          // 3: designations.CreateDesignationRequest
+	(*DesignationResponse)(nil),               // 4: designations.DesignationResponse
+	(*GetDesignationRequest)(nil),             // 5: designations.GetDesignationRequest
+	(*UpdateDesignationRequest)(nil),          // 6: designations.UpdateDesignationRequest
+	(*PaginationMetadata)(nil),                // 7: designations.PaginationMetadata
+	(*DeleteDesignationRequest)(nil),          // 8: designations.DeleteDesignationRequest
+	(*DeleteDesignationResponse)(nil),         // 9: designations.DeleteDesignationResponse
+	(*ListDesignationsRequest)(nil),           // 10: designations.ListDesignationsRequest
+	(*ListDesignationsResponse)(nil),          // 11: designations.ListDesignationsResponse
+	(*SetFinancialPowersRequest)(nil),         // 12: designations.SetFinancialPowersRequest
+	(*ListApprovingDesignationsRequest)(nil),  // 13: designations.ListApprovingDesignationsRequest
+	(*ApprovingDesignation)(nil),              // 14: designations.ApprovingDesignation
+	(*ListApprovingDesignationsResponse)(nil), // 15: designations.ListApprovingDesignationsResponse
+	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
 }
 var file_api_proto_designation_proto_depIdxs = []int32{
-	10, // 0: designations.Designation.created_at:type_name -> google.protobuf.Timestamp
-	10, // 1: designations.Designation.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: designations.DesignationResponse.designation:type_name -> designations.Designation
-	0,  // 3: designations.ListDesignationsResponse.designations:type_name -> designations.Designation
-	5,  // 4: designations.ListDesignationsResponse.pagination:type_name -> designations.PaginationMetadata
-	1,  // 5: designations.DesignationService.CreateDesignation:input_type -> designations.CreateDesignationRequest
-	3,  // 6: designations.DesignationService.GetDesignation:input_type -> designations.GetDesignationRequest
-	4,  // 7: designations.DesignationService.UpdateDesignation:input_type -> designations.UpdateDesignationRequest
-	6,  // 8: designations.DesignationService.DeleteDesignation:input_type -> designations.DeleteDesignationRequest
-	8,  // 9: designations.DesignationService.ListDesignations:input_type -> designations.ListDesignationsRequest
-	2,  // 10: designations.DesignationService.CreateDesignation:output_type -> designations.DesignationResponse
-	2,  // 11: designations.DesignationService.GetDesignation:output_type -> designations.DesignationResponse
-	2,  // 12: designations.DesignationService.UpdateDesignation:output_type -> designations.DesignationResponse
-	7,  // 13: designations.DesignationService.DeleteDesignation:output_type -> designations.DeleteDesignationResponse
-	9,  // 14: designations.DesignationService.ListDesignations:output_type -> designations.ListDesignationsResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	16, // 0: designations.Designation.created_at:type_name -> google.protobuf.Timestamp
+	16, // 1: designations.Designation.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: designations.Designation.financial_powers:type_name -> designations.FinancialPower
+	0,  // 3: designations.FinancialPower.approval_for:type_name -> designations.ApprovalFor
+	2,  // 4: designations.CreateDesignationRequest.financial_powers:type_name -> designations.FinancialPower
+	1,  // 5: designations.DesignationResponse.designation:type_name -> designations.Designation
+	1,  // 6: designations.ListDesignationsResponse.designations:type_name -> designations.Designation
+	7,  // 7: designations.ListDesignationsResponse.pagination:type_name -> designations.PaginationMetadata
+	2,  // 8: designations.SetFinancialPowersRequest.financial_powers:type_name -> designations.FinancialPower
+	0,  // 9: designations.ListApprovingDesignationsRequest.approval_for:type_name -> designations.ApprovalFor
+	1,  // 10: designations.ApprovingDesignation.designation:type_name -> designations.Designation
+	2,  // 11: designations.ApprovingDesignation.financial_power:type_name -> designations.FinancialPower
+	14, // 12: designations.ListApprovingDesignationsResponse.designations:type_name -> designations.ApprovingDesignation
+	3,  // 13: designations.DesignationService.CreateDesignation:input_type -> designations.CreateDesignationRequest
+	5,  // 14: designations.DesignationService.GetDesignation:input_type -> designations.GetDesignationRequest
+	6,  // 15: designations.DesignationService.UpdateDesignation:input_type -> designations.UpdateDesignationRequest
+	8,  // 16: designations.DesignationService.DeleteDesignation:input_type -> designations.DeleteDesignationRequest
+	10, // 17: designations.DesignationService.ListDesignations:input_type -> designations.ListDesignationsRequest
+	12, // 18: designations.DesignationService.SetFinancialPowers:input_type -> designations.SetFinancialPowersRequest
+	13, // 19: designations.DesignationService.ListApprovingDesignations:input_type -> designations.ListApprovingDesignationsRequest
+	4,  // 20: designations.DesignationService.CreateDesignation:output_type -> designations.DesignationResponse
+	4,  // 21: designations.DesignationService.GetDesignation:output_type -> designations.DesignationResponse
+	4,  // 22: designations.DesignationService.UpdateDesignation:output_type -> designations.DesignationResponse
+	9,  // 23: designations.DesignationService.DeleteDesignation:output_type -> designations.DeleteDesignationResponse
+	11, // 24: designations.DesignationService.ListDesignations:output_type -> designations.ListDesignationsResponse
+	4,  // 25: designations.DesignationService.SetFinancialPowers:output_type -> designations.DesignationResponse
+	15, // 26: designations.DesignationService.ListApprovingDesignations:output_type -> designations.ListApprovingDesignationsResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_designation_proto_init() }
@@ -688,18 +1139,20 @@ func file_api_proto_designation_proto_init() {
 	if File_api_proto_designation_proto != nil {
 		return
 	}
+	file_api_proto_designation_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_designation_proto_rawDesc), len(file_api_proto_designation_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_proto_designation_proto_goTypes,
 		DependencyIndexes: file_api_proto_designation_proto_depIdxs,
+		EnumInfos:         file_api_proto_designation_proto_enumTypes,
 		MessageInfos:      file_api_proto_designation_proto_msgTypes,
 	}.Build()
 	File_api_proto_designation_proto = out.File
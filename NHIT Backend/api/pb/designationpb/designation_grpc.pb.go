@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DesignationService_CreateDesignation_FullMethodName = "/designations.DesignationService/CreateDesignation"
-	DesignationService_GetDesignation_FullMethodName    = "/designations.DesignationService/GetDesignation"
-	DesignationService_UpdateDesignation_FullMethodName = "/designations.DesignationService/UpdateDesignation"
-	DesignationService_DeleteDesignation_FullMethodName = "/designations.DesignationService/DeleteDesignation"
-	DesignationService_ListDesignations_FullMethodName  = "/designations.DesignationService/ListDesignations"
+	DesignationService_CreateDesignation_FullMethodName         = "/designations.DesignationService/CreateDesignation"
+	DesignationService_GetDesignation_FullMethodName            = "/designations.DesignationService/GetDesignation"
+	DesignationService_UpdateDesignation_FullMethodName         = "/designations.DesignationService/UpdateDesignation"
+	DesignationService_DeleteDesignation_FullMethodName         = "/designations.DesignationService/DeleteDesignation"
+	DesignationService_ListDesignations_FullMethodName          = "/designations.DesignationService/ListDesignations"
+	DesignationService_SetFinancialPowers_FullMethodName        = "/designations.DesignationService/SetFinancialPowers"
+	DesignationService_ListApprovingDesignations_FullMethodName = "/designations.DesignationService/ListApprovingDesignations"
 )
 
 // DesignationServiceClient is the client API for DesignationService service.
@@ -39,6 +41,10 @@ type DesignationServiceClient interface {
 	DeleteDesignation(ctx context.Context, in *DeleteDesignationRequest, opts ...grpc.CallOption) (*DeleteDesignationResponse, error)
 	// List designations with pagination and filters
 	ListDesignations(ctx context.Context, in *ListDesignationsRequest, opts ...grpc.CallOption) (*ListDesignationsResponse, error)
+	// Replace the delegation-of-financial-powers matrix of a designation
+	SetFinancialPowers(ctx context.Context, in *SetFinancialPowersRequest, opts ...grpc.CallOption) (*DesignationResponse, error)
+	// Designations of the organization whose financial powers cover an amount
+	ListApprovingDesignations(ctx context.Context, in *ListApprovingDesignationsRequest, opts ...grpc.CallOption) (*ListApprovingDesignationsResponse, error)
 }
 
 type designationServiceClient struct {
@@ -99,6 +105,26 @@ func (c *designationServiceClient) ListDesignations(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *designationServiceClient) SetFinancialPowers(ctx context.Context, in *SetFinancialPowersRequest, opts ...grpc.CallOption) (*DesignationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DesignationResponse)
+	err := c.cc.Invoke(ctx, DesignationService_SetFinancialPowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *designationServiceClient) ListApprovingDesignations(ctx context.Context, in *ListApprovingDesignationsRequest, opts ...grpc.CallOption) (*ListApprovingDesignationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovingDesignationsResponse)
+	err := c.cc.Invoke(ctx, DesignationService_ListApprovingDesignations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DesignationServiceServer is the server API for DesignationService service.
 // All implementations must embed UnimplementedDesignationServiceServer
 // for forward compatibility.
@@ -112,6 +138,10 @@ type DesignationServiceServer interface {
 	DeleteDesignation(context.Context, *DeleteDesignationRequest) (*DeleteDesignationResponse, error)
 	// List designations with pagination and filters
 	ListDesignations(context.Context, *ListDesignationsRequest) (*ListDesignationsResponse, error)
+	// Replace the delegation-of-financial-powers matrix of a designation
+	SetFinancialPowers(context.Context, *SetFinancialPowersRequest) (*DesignationResponse, error)
+	// Designations of the organization whose financial powers cover an amount
+	ListApprovingDesignations(context.Context, *ListApprovingDesignationsRequest) (*ListApprovingDesignationsResponse, error)
 	mustEmbedUnimplementedDesignationServiceServer()
 }
 
@@ -137,6 +167,12 @@ func (UnimplementedDesignationServiceServer) DeleteDesignation(context.Context,
 func (UnimplementedDesignationServiceServer) ListDesignations(context.Context, *ListDesignationsRequest) (*ListDesignationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDesignations not implemented")
 }
+func (UnimplementedDesignationServiceServer) SetFinancialPowers(context.Context, *SetFinancialPowersRequest) (*DesignationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFinancialPowers not implemented")
+}
+func (UnimplementedDesignationServiceServer) ListApprovingDesignations(context.Context, *ListApprovingDesignationsRequest) (*ListApprovingDesignationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovingDesignations not implemented")
+}
 func (UnimplementedDesignationServiceServer) mustEmbedUnimplementedDesignationServiceServer() {}
 func (UnimplementedDesignationServiceServer) testEmbeddedByValue()                            {}
 
@@ -248,6 +284,42 @@ func _DesignationService_ListDesignations_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DesignationService_SetFinancialPowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFinancialPowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DesignationServiceServer).SetFinancialPowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DesignationService_SetFinancialPowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DesignationServiceServer).SetFinancialPowers(ctx, req.(*SetFinancialPowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DesignationService_ListApprovingDesignations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovingDesignationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DesignationServiceServer).ListApprovingDesignations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DesignationService_ListApprovingDesignations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DesignationServiceServer).ListApprovingDesignations(ctx, req.(*ListApprovingDesignationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DesignationService_ServiceDesc is the grpc.ServiceDesc for DesignationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -275,6 +347,14 @@ var DesignationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDesignations",
 			Handler:    _DesignationService_ListDesignations_Handler,
 		},
+		{
+			MethodName: "SetFinancialPowers",
+			Handler:    _DesignationService_SetFinancialPowers_Handler,
+		},
+		{
+			MethodName: "ListApprovingDesignations",
+			Handler:    _DesignationService_ListApprovingDesignations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/designation.proto",
@@ -73,6 +73,257 @@ func (OrganizationStatus) EnumDescriptor() ([]byte, []int) {
 	return file_organization_proto_rawDescGZIP(), []int{0}
 }
 
+// ====================
+// Organization Settings
+// ====================
+// A child organization inherits each settings section from its nearest
+// ancestor that sets it, down to the system defaults. A section is
+// overridden as a whole. Password rules are not an organization setting: they
+// are the tenant's password policy, kept by auth-service.
+type OrganizationSettingSection int32
+
+const (
+	OrganizationSettingSection_SETTING_SECTION_UNSPECIFIED    OrganizationSettingSection = 0
+	OrganizationSettingSection_SETTING_SECTION_APPROVAL_RULES OrganizationSettingSection = 1
+	OrganizationSettingSection_SETTING_SECTION_TDS_DEFAULTS   OrganizationSettingSection = 2
+	OrganizationSettingSection_SETTING_SECTION_LOGO           OrganizationSettingSection = 4
+)
+
+// Enum value maps for OrganizationSettingSection.
+var (
+	OrganizationSettingSection_name = map[int32]string{
+		0: "SETTING_SECTION_UNSPECIFIED",
+		1: "SETTING_SECTION_APPROVAL_RULES",
+		2: "SETTING_SECTION_TDS_DEFAULTS",
+		4: "SETTING_SECTION_LOGO",
+	}
+	OrganizationSettingSection_value = map[string]int32{
+		"SETTING_SECTION_UNSPECIFIED":    0,
+		"SETTING_SECTION_APPROVAL_RULES": 1,
+		"SETTING_SECTION_TDS_DEFAULTS":   2,
+		"SETTING_SECTION_LOGO":           4,
+	}
+)
+
+func (x OrganizationSettingSection) Enum() *OrganizationSettingSection {
+	p := new(OrganizationSettingSection)
+	*p = x
+	return p
+}
+
+func (x OrganizationSettingSection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrganizationSettingSection) Descriptor() protoreflect.EnumDescriptor {
+	return file_organization_proto_enumTypes[1].Descriptor()
+}
+
+func (OrganizationSettingSection) Type() protoreflect.EnumType {
+	return &file_organization_proto_enumTypes[1]
+}
+
+func (x OrganizationSettingSection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrganizationSettingSection.Descriptor instead.
+func (OrganizationSettingSection) EnumDescriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{1}
+}
+
+// ====================
+// Onboarding Messages
+// ====================
+// An onboarding runs its steps in order and records each one. A step that
+// fails on a transient error pauses the onboarding; any other failure, or a
+// cancel, rolls back the completed steps in reverse order.
+type OnboardingStatus int32
+
+const (
+	OnboardingStatus_ONBOARDING_STATUS_UNSPECIFIED         OnboardingStatus = 0
+	OnboardingStatus_ONBOARDING_STATUS_RUNNING             OnboardingStatus = 1
+	OnboardingStatus_ONBOARDING_STATUS_PAUSED              OnboardingStatus = 2 // A step failed transiently; resume or cancel
+	OnboardingStatus_ONBOARDING_STATUS_COMPLETED           OnboardingStatus = 3
+	OnboardingStatus_ONBOARDING_STATUS_COMPENSATING        OnboardingStatus = 4
+	OnboardingStatus_ONBOARDING_STATUS_COMPENSATED         OnboardingStatus = 5 // Rolled back; nothing was left behind
+	OnboardingStatus_ONBOARDING_STATUS_COMPENSATION_FAILED OnboardingStatus = 6 // Rollback stopped part way; resume to retry it
+)
+
+// Enum value maps for OnboardingStatus.
+var (
+	OnboardingStatus_name = map[int32]string{
+		0: "ONBOARDING_STATUS_UNSPECIFIED",
+		1: "ONBOARDING_STATUS_RUNNING",
+		2: "ONBOARDING_STATUS_PAUSED",
+		3: "ONBOARDING_STATUS_COMPLETED",
+		4: "ONBOARDING_STATUS_COMPENSATING",
+		5: "ONBOARDING_STATUS_COMPENSATED",
+		6: "ONBOARDING_STATUS_COMPENSATION_FAILED",
+	}
+	OnboardingStatus_value = map[string]int32{
+		"ONBOARDING_STATUS_UNSPECIFIED":         0,
+		"ONBOARDING_STATUS_RUNNING":             1,
+		"ONBOARDING_STATUS_PAUSED":              2,
+		"ONBOARDING_STATUS_COMPLETED":           3,
+		"ONBOARDING_STATUS_COMPENSATING":        4,
+		"ONBOARDING_STATUS_COMPENSATED":         5,
+		"ONBOARDING_STATUS_COMPENSATION_FAILED": 6,
+	}
+)
+
+func (x OnboardingStatus) Enum() *OnboardingStatus {
+	p := new(OnboardingStatus)
+	*p = x
+	return p
+}
+
+func (x OnboardingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnboardingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_organization_proto_enumTypes[2].Descriptor()
+}
+
+func (OnboardingStatus) Type() protoreflect.EnumType {
+	return &file_organization_proto_enumTypes[2]
+}
+
+func (x OnboardingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnboardingStatus.Descriptor instead.
+func (OnboardingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{2}
+}
+
+type OnboardingStepName int32
+
+const (
+	OnboardingStepName_ONBOARDING_STEP_UNSPECIFIED        OnboardingStepName = 0
+	OnboardingStepName_ONBOARDING_STEP_TENANT             OnboardingStepName = 1 // Tenant with its super admin user and SUPER_ADMIN role
+	OnboardingStepName_ONBOARDING_STEP_ORGANIZATION       OnboardingStepName = 2
+	OnboardingStepName_ONBOARDING_STEP_SUPER_ADMIN_ROLE   OnboardingStepName = 3 // Looks up the tenant's SUPER_ADMIN role
+	OnboardingStepName_ONBOARDING_STEP_USER               OnboardingStepName = 4 // Adds the super admin to the organization
+	OnboardingStepName_ONBOARDING_STEP_DEPARTMENTS        OnboardingStepName = 5
+	OnboardingStepName_ONBOARDING_STEP_DESIGNATIONS       OnboardingStepName = 6
+	OnboardingStepName_ONBOARDING_STEP_PROJECTS           OnboardingStepName = 7
+	OnboardingStepName_ONBOARDING_STEP_APPROVAL_TEMPLATES OnboardingStepName = 8 // Approval rules of the organization
+)
+
+// Enum value maps for OnboardingStepName.
+var (
+	OnboardingStepName_name = map[int32]string{
+		0: "ONBOARDING_STEP_UNSPECIFIED",
+		1: "ONBOARDING_STEP_TENANT",
+		2: "ONBOARDING_STEP_ORGANIZATION",
+		3: "ONBOARDING_STEP_SUPER_ADMIN_ROLE",
+		4: "ONBOARDING_STEP_USER",
+		5: "ONBOARDING_STEP_DEPARTMENTS",
+		6: "ONBOARDING_STEP_DESIGNATIONS",
+		7: "ONBOARDING_STEP_PROJECTS",
+		8: "ONBOARDING_STEP_APPROVAL_TEMPLATES",
+	}
+	OnboardingStepName_value = map[string]int32{
+		"ONBOARDING_STEP_UNSPECIFIED":        0,
+		"ONBOARDING_STEP_TENANT":             1,
+		"ONBOARDING_STEP_ORGANIZATION":       2,
+		"ONBOARDING_STEP_SUPER_ADMIN_ROLE":   3,
+		"ONBOARDING_STEP_USER":               4,
+		"ONBOARDING_STEP_DEPARTMENTS":        5,
+		"ONBOARDING_STEP_DESIGNATIONS":       6,
+		"ONBOARDING_STEP_PROJECTS":           7,
+		"ONBOARDING_STEP_APPROVAL_TEMPLATES": 8,
+	}
+)
+
+func (x OnboardingStepName) Enum() *OnboardingStepName {
+	p := new(OnboardingStepName)
+	*p = x
+	return p
+}
+
+func (x OnboardingStepName) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnboardingStepName) Descriptor() protoreflect.EnumDescriptor {
+	return file_organization_proto_enumTypes[3].Descriptor()
+}
+
+func (OnboardingStepName) Type() protoreflect.EnumType {
+	return &file_organization_proto_enumTypes[3]
+}
+
+func (x OnboardingStepName) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnboardingStepName.Descriptor instead.
+func (OnboardingStepName) EnumDescriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{3}
+}
+
+type OnboardingStepStatus int32
+
+const (
+	OnboardingStepStatus_ONBOARDING_STEP_STATUS_UNSPECIFIED OnboardingStepStatus = 0
+	OnboardingStepStatus_ONBOARDING_STEP_STATUS_PENDING     OnboardingStepStatus = 1
+	OnboardingStepStatus_ONBOARDING_STEP_STATUS_RUNNING     OnboardingStepStatus = 2
+	OnboardingStepStatus_ONBOARDING_STEP_STATUS_DONE        OnboardingStepStatus = 3
+	OnboardingStepStatus_ONBOARDING_STEP_STATUS_FAILED      OnboardingStepStatus = 4
+	OnboardingStepStatus_ONBOARDING_STEP_STATUS_COMPENSATED OnboardingStepStatus = 5
+)
+
+// Enum value maps for OnboardingStepStatus.
+var (
+	OnboardingStepStatus_name = map[int32]string{
+		0: "ONBOARDING_STEP_STATUS_UNSPECIFIED",
+		1: "ONBOARDING_STEP_STATUS_PENDING",
+		2: "ONBOARDING_STEP_STATUS_RUNNING",
+		3: "ONBOARDING_STEP_STATUS_DONE",
+		4: "ONBOARDING_STEP_STATUS_FAILED",
+		5: "ONBOARDING_STEP_STATUS_COMPENSATED",
+	}
+	OnboardingStepStatus_value = map[string]int32{
+		"ONBOARDING_STEP_STATUS_UNSPECIFIED": 0,
+		"ONBOARDING_STEP_STATUS_PENDING":     1,
+		"ONBOARDING_STEP_STATUS_RUNNING":     2,
+		"ONBOARDING_STEP_STATUS_DONE":        3,
+		"ONBOARDING_STEP_STATUS_FAILED":      4,
+		"ONBOARDING_STEP_STATUS_COMPENSATED": 5,
+	}
+)
+
+func (x OnboardingStepStatus) Enum() *OnboardingStepStatus {
+	p := new(OnboardingStepStatus)
+	*p = x
+	return p
+}
+
+func (x OnboardingStepStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnboardingStepStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_organization_proto_enumTypes[4].Descriptor()
+}
+
+func (OnboardingStepStatus) Type() protoreflect.EnumType {
+	return &file_organization_proto_enumTypes[4]
+}
+
+func (x OnboardingStepStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnboardingStepStatus.Descriptor instead.
+func (OnboardingStepStatus) EnumDescriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{4}
+}
+
 // ====================
 // Super Admin (Parent Only)
 // ====================
@@ -263,6 +514,10 @@ type Organization struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CreatedBy       string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	StatusReason    string                 `protobuf:"bytes,15,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`    // Why it was last deactivated, deleted or restored
+	DeactivatedAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"` // Unset while activated
+	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`             // Unset unless soft deleted
+	PurgeAfter      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`          // End of the retention window of a deleted organization
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -395,6 +650,34 @@ func (x *Organization) GetCreatedBy() string {
 	return ""
 }
 
+func (x *Organization) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Organization) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
 // ====================
 // Create Organization Request
 // ====================
@@ -788,9 +1071,12 @@ func (x *UpdateOrganizationRequest) GetStatus() OrganizationStatus {
 	return OrganizationStatus_activated
 }
 
+// Soft deletes the organization: it is deactivated at once and can be
+// restored until purge_after, after which PurgeOrganization removes it
 type DeleteOrganizationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"` // ID to delete
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -832,10 +1118,18 @@ func (x *DeleteOrganizationRequest) GetOrgId() string {
 	return ""
 }
 
+func (x *DeleteOrganizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type DeleteOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PurgeAfter    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -884,6 +1178,13 @@ func (x *DeleteOrganizationResponse) GetMessage() string {
 	return ""
 }
 
+func (x *DeleteOrganizationResponse) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
 type ListOrganizationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // Pagination page number
@@ -1386,122 +1687,1922 @@ func (x *UploadLogoResponse) GetUploadedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_organization_proto protoreflect.FileDescriptor
+// ====================
+// Hierarchy Messages
+// ====================
+type OrganizationTreeNode struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Organization  *Organization           `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Depth         int32                   `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"` // 0 for the requested organization
+	Children      []*OrganizationTreeNode `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_organization_proto_rawDesc = "" +
-	"\n" +
-	"\x12organization.proto\x12\rorganizations\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"Y\n" +
-	"\x11SuperAdminDetails\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"\x94\x02\n" +
-	"\aProject\x12\x1d\n" +
-	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
-	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12!\n" +
-	"\fproject_name\x18\x04 \x01(\tR\vprojectName\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa7\x04\n" +
-	"\fOrganization\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\"\n" +
-	"\rparent_org_id\x18\x03 \x01(\tR\vparentOrgId\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
-	"\x04code\x18\x05 \x01(\tR\x04code\x12#\n" +
-	"\rdatabase_name\x18\x06 \x01(\tR\fdatabaseName\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\x12\x12\n" +
-	"\x04logo\x18\b \x01(\tR\x04logo\x12A\n" +
-	"\vsuper_admin\x18\t \x01(\v2 .organizations.SuperAdminDetailsR\n" +
-	"superAdmin\x12)\n" +
-	"\x10initial_projects\x18\n" +
-	" \x03(\tR\x0finitialProjects\x129\n" +
-	"\x06status\x18\v \x01(\x0e2!.organizations.OrganizationStatusR\x06status\x129\n" +
-	"\n" +
-	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\x0e \x01(\tR\tcreatedBy\"\xc6\x02\n" +
-	"\x19CreateOrganizationRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04logo\x18\x04 \x01(\tR\x04logo\x12\"\n" +
-	"\rparent_org_id\x18\x05 \x01(\tR\vparentOrgId\x12A\n" +
-	"\vsuper_admin\x18\x06 \x01(\v2 .organizations.SuperAdminDetailsR\n" +
-	"superAdmin\x12)\n" +
-	"\x10initial_projects\x18\a \x03(\tR\x0finitialProjects\x129\n" +
-	"\x06status\x18\b \x01(\x0e2!.organizations.OrganizationStatusR\x06status\"q\n" +
-	"\x14OrganizationResponse\x12?\n" +
-	"\forganization\x18\x01 \x01(\v2\x1b.organizations.OrganizationR\forganization\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
-	"\x16GetOrganizationRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\";\n" +
-	"\"GetOrganizationWithProjectsRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\x9a\x01\n" +
-	"#GetOrganizationWithProjectsResponse\x12?\n" +
-	"\forganization\x18\x01 \x01(\v2\x1b.organizations.OrganizationR\forganization\x122\n" +
-	"\bprojects\x18\x02 \x03(\v2\x16.organizations.ProjectR\bprojects\"\xcb\x01\n" +
-	"\x19UpdateOrganizationRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\tR\x04code\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04logo\x18\x05 \x01(\tR\x04logo\x129\n" +
-	"\x06status\x18\x06 \x01(\x0e2!.organizations.OrganizationStatusR\x06status\"2\n" +
-	"\x19DeleteOrganizationRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"P\n" +
-	"\x1aDeleteOrganizationResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"K\n" +
-	"\x18ListOrganizationsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"p\n" +
-	" ListOrganizationsByTenantRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"t\n" +
-	"\x1dListChildOrganizationsRequest\x12\"\n" +
-	"\rparent_org_id\x18\x01 \x01(\tR\vparentOrgId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"2\n" +
-	"\x1cGetOrganizationByCodeRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\"\x96\x01\n" +
-	"\x12PaginationMetadata\x12!\n" +
-	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
-	"\vtotal_items\x18\x03 \x01(\x05R\n" +
-	"totalItems\x12\x1f\n" +
-	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"\xc2\x01\n" +
-	"\x19ListOrganizationsResponse\x12A\n" +
-	"\rorganizations\x18\x01 \x03(\v2\x1b.organizations.OrganizationR\rorganizations\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x12A\n" +
-	"\n" +
-	"pagination\x18\x03 \x01(\v2!.organizations.PaginationMetadataR\n" +
-	"pagination\"M\n" +
-	"\x11UploadLogoRequest\x12\x15\n" +
-	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12!\n" +
-	"\ffile_content\x18\x02 \x01(\fR\vfileContent\"\xa0\x02\n" +
-	"\x12UploadLogoResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
-	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x19\n" +
-	"\blogo_url\x18\x04 \x01(\tR\alogoUrl\x12\x1b\n" +
-	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x1b\n" +
-	"\tmime_type\x18\x06 \x01(\tR\bmimeType\x12\x1b\n" +
-	"\tfile_size\x18\a \x01(\x03R\bfileSize\x12;\n" +
-	"\vuploaded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"uploadedAt*4\n" +
+func (x *OrganizationTreeNode) Reset() {
+	*x = OrganizationTreeNode{}
+	mi := &file_organization_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationTreeNode) ProtoMessage() {}
+
+func (x *OrganizationTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationTreeNode.ProtoReflect.Descriptor instead.
+func (*OrganizationTreeNode) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OrganizationTreeNode) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *OrganizationTreeNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *OrganizationTreeNode) GetChildren() []*OrganizationTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetOrganizationTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	MaxDepth      int32                  `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // 0 → whole subtree
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationTreeRequest) Reset() {
+	*x = GetOrganizationTreeRequest{}
+	mi := &file_organization_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationTreeRequest) ProtoMessage() {}
+
+func (x *GetOrganizationTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationTreeRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetOrganizationTreeRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetOrganizationTreeRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type GetOrganizationTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          *OrganizationTreeNode  `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // Organizations in the tree, root included
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationTreeResponse) Reset() {
+	*x = GetOrganizationTreeResponse{}
+	mi := &file_organization_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationTreeResponse) ProtoMessage() {}
+
+func (x *GetOrganizationTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationTreeResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOrganizationTreeResponse) GetRoot() *OrganizationTreeNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *GetOrganizationTreeResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetOrganizationAncestorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationAncestorsRequest) Reset() {
+	*x = GetOrganizationAncestorsRequest{}
+	mi := &file_organization_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationAncestorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationAncestorsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationAncestorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationAncestorsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationAncestorsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetOrganizationAncestorsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type GetOrganizationAncestorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ancestors     []*Organization        `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"` // Top-level organization first, parent last
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationAncestorsResponse) Reset() {
+	*x = GetOrganizationAncestorsResponse{}
+	mi := &file_organization_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationAncestorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationAncestorsResponse) ProtoMessage() {}
+
+func (x *GetOrganizationAncestorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationAncestorsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationAncestorsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetOrganizationAncestorsResponse) GetAncestors() []*Organization {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+type MoveOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrgId          string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	NewParentOrgId string                 `protobuf:"bytes,2,opt,name=new_parent_org_id,json=newParentOrgId,proto3" json:"new_parent_org_id,omitempty"` // Cannot be the organization or one of its descendants
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MoveOrganizationRequest) Reset() {
+	*x = MoveOrganizationRequest{}
+	mi := &file_organization_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveOrganizationRequest) ProtoMessage() {}
+
+func (x *MoveOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*MoveOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MoveOrganizationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *MoveOrganizationRequest) GetNewParentOrgId() string {
+	if x != nil {
+		return x.NewParentOrgId
+	}
+	return ""
+}
+
+type ApprovalRules struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MinApprovers      int32                  `protobuf:"varint,1,opt,name=min_approvers,json=minApprovers,proto3" json:"min_approvers,omitempty"`                  // Approvals a note needs, at least 1
+	RequireSequential bool                   `protobuf:"varint,2,opt,name=require_sequential,json=requireSequential,proto3" json:"require_sequential,omitempty"`   // Approvers act in level order
+	AllowSelfApproval bool                   `protobuf:"varint,3,opt,name=allow_self_approval,json=allowSelfApproval,proto3" json:"allow_self_approval,omitempty"` // Makers may approve their own notes
+	AutoApproveBelow  float64                `protobuf:"fixed64,4,opt,name=auto_approve_below,json=autoApproveBelow,proto3" json:"auto_approve_below,omitempty"`   // Notes below this amount skip approval; 0 → never
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ApprovalRules) Reset() {
+	*x = ApprovalRules{}
+	mi := &file_organization_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalRules) ProtoMessage() {}
+
+func (x *ApprovalRules) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalRules.ProtoReflect.Descriptor instead.
+func (*ApprovalRules) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApprovalRules) GetMinApprovers() int32 {
+	if x != nil {
+		return x.MinApprovers
+	}
+	return 0
+}
+
+func (x *ApprovalRules) GetRequireSequential() bool {
+	if x != nil {
+		return x.RequireSequential
+	}
+	return false
+}
+
+func (x *ApprovalRules) GetAllowSelfApproval() bool {
+	if x != nil {
+		return x.AllowSelfApproval
+	}
+	return false
+}
+
+func (x *ApprovalRules) GetAutoApproveBelow() float64 {
+	if x != nil {
+		return x.AutoApproveBelow
+	}
+	return 0
+}
+
+type TdsDefaults struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DefaultSection string                 `protobuf:"bytes,1,opt,name=default_section,json=defaultSection,proto3" json:"default_section,omitempty"` // TDS section applied when a vendor has none, e.g. "194C"
+	AutoDeduct     bool                   `protobuf:"varint,2,opt,name=auto_deduct,json=autoDeduct,proto3" json:"auto_deduct,omitempty"`            // Compute TDS on new payment notes
+	RateOverride   float64                `protobuf:"fixed64,3,opt,name=rate_override,json=rateOverride,proto3" json:"rate_override,omitempty"`     // Percentage used instead of the section rate; 0 → section rate
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TdsDefaults) Reset() {
+	*x = TdsDefaults{}
+	mi := &file_organization_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TdsDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdsDefaults) ProtoMessage() {}
+
+func (x *TdsDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdsDefaults.ProtoReflect.Descriptor instead.
+func (*TdsDefaults) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TdsDefaults) GetDefaultSection() string {
+	if x != nil {
+		return x.DefaultSection
+	}
+	return ""
+}
+
+func (x *TdsDefaults) GetAutoDeduct() bool {
+	if x != nil {
+		return x.AutoDeduct
+	}
+	return false
+}
+
+func (x *TdsDefaults) GetRateOverride() float64 {
+	if x != nil {
+		return x.RateOverride
+	}
+	return 0
+}
+
+// Unset sections are inherited
+type OrganizationSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalRules *ApprovalRules         `protobuf:"bytes,1,opt,name=approval_rules,json=approvalRules,proto3" json:"approval_rules,omitempty"`
+	TdsDefaults   *TdsDefaults           `protobuf:"bytes,2,opt,name=tds_defaults,json=tdsDefaults,proto3" json:"tds_defaults,omitempty"`
+	Logo          *string                `protobuf:"bytes,4,opt,name=logo,proto3,oneof" json:"logo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationSettings) Reset() {
+	*x = OrganizationSettings{}
+	mi := &file_organization_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationSettings) ProtoMessage() {}
+
+func (x *OrganizationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationSettings) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrganizationSettings) GetApprovalRules() *ApprovalRules {
+	if x != nil {
+		return x.ApprovalRules
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetTdsDefaults() *TdsDefaults {
+	if x != nil {
+		return x.TdsDefaults
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetLogo() string {
+	if x != nil && x.Logo != nil {
+		return *x.Logo
+	}
+	return ""
+}
+
+type GetOrganizationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationSettingsRequest) Reset() {
+	*x = GetOrganizationSettingsRequest{}
+	mi := &file_organization_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationSettingsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetOrganizationSettingsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Sections set in settings are overridden; sections listed in inherit go
+// back to the inherited value; others are left as they are
+type UpdateOrganizationSettingsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	OrgId         string                       `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Settings      *OrganizationSettings        `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Inherit       []OrganizationSettingSection `protobuf:"varint,3,rep,packed,name=inherit,proto3,enum=organizations.OrganizationSettingSection" json:"inherit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationSettingsRequest) Reset() {
+	*x = UpdateOrganizationSettingsRequest{}
+	mi := &file_organization_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateOrganizationSettingsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationSettingsRequest) GetSettings() *OrganizationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationSettingsRequest) GetInherit() []OrganizationSettingSection {
+	if x != nil {
+		return x.Inherit
+	}
+	return nil
+}
+
+type OrganizationSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Settings      *OrganizationSettings  `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationSettingsResponse) Reset() {
+	*x = OrganizationSettingsResponse{}
+	mi := &file_organization_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationSettingsResponse) ProtoMessage() {}
+
+func (x *OrganizationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*OrganizationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OrganizationSettingsResponse) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *OrganizationSettingsResponse) GetSettings() *OrganizationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *OrganizationSettingsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OrganizationSettingSource struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Section       OrganizationSettingSection `protobuf:"varint,1,opt,name=section,proto3,enum=organizations.OrganizationSettingSection" json:"section,omitempty"`
+	SourceOrgId   string                     `protobuf:"bytes,2,opt,name=source_org_id,json=sourceOrgId,proto3" json:"source_org_id,omitempty"` // EMPTY → system default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationSettingSource) Reset() {
+	*x = OrganizationSettingSource{}
+	mi := &file_organization_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationSettingSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationSettingSource) ProtoMessage() {}
+
+func (x *OrganizationSettingSource) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationSettingSource.ProtoReflect.Descriptor instead.
+func (*OrganizationSettingSource) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OrganizationSettingSource) GetSection() OrganizationSettingSection {
+	if x != nil {
+		return x.Section
+	}
+	return OrganizationSettingSection_SETTING_SECTION_UNSPECIFIED
+}
+
+func (x *OrganizationSettingSource) GetSourceOrgId() string {
+	if x != nil {
+		return x.SourceOrgId
+	}
+	return ""
+}
+
+type EffectiveOrganizationSettingsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	OrgId         string                       `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Settings      *OrganizationSettings        `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"` // Every section set
+	Sources       []*OrganizationSettingSource `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectiveOrganizationSettingsResponse) Reset() {
+	*x = EffectiveOrganizationSettingsResponse{}
+	mi := &file_organization_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectiveOrganizationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveOrganizationSettingsResponse) ProtoMessage() {}
+
+func (x *EffectiveOrganizationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveOrganizationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*EffectiveOrganizationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EffectiveOrganizationSettingsResponse) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *EffectiveOrganizationSettingsResponse) GetSettings() *OrganizationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *EffectiveOrganizationSettingsResponse) GetSources() []*OrganizationSettingSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+// ====================
+// Lifecycle Messages
+// ====================
+type ChangeOrganizationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeOrganizationStatusRequest) Reset() {
+	*x = ChangeOrganizationStatusRequest{}
+	mi := &file_organization_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeOrganizationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeOrganizationStatusRequest) ProtoMessage() {}
+
+func (x *ChangeOrganizationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeOrganizationStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeOrganizationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChangeOrganizationStatusRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ChangeOrganizationStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ChangeOrganizationStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Organization   *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	AffectedOrgIds []string               `protobuf:"bytes,2,rep,name=affected_org_ids,json=affectedOrgIds,proto3" json:"affected_org_ids,omitempty"` // Every organization whose status changed
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeOrganizationStatusResponse) Reset() {
+	*x = ChangeOrganizationStatusResponse{}
+	mi := &file_organization_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeOrganizationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeOrganizationStatusResponse) ProtoMessage() {}
+
+func (x *ChangeOrganizationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeOrganizationStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeOrganizationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChangeOrganizationStatusResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *ChangeOrganizationStatusResponse) GetAffectedOrgIds() []string {
+	if x != nil {
+		return x.AffectedOrgIds
+	}
+	return nil
+}
+
+func (x *ChangeOrganizationStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OrganizationDependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`   // Service that owns the records
+	Resource      string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"` // e.g. "projects", "departments"
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Blocking      bool                   `protobuf:"varint,4,opt,name=blocking,proto3" json:"blocking,omitempty"` // false → removed together with the organization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationDependency) Reset() {
+	*x = OrganizationDependency{}
+	mi := &file_organization_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationDependency) ProtoMessage() {}
+
+func (x *OrganizationDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationDependency.ProtoReflect.Descriptor instead.
+func (*OrganizationDependency) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OrganizationDependency) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *OrganizationDependency) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *OrganizationDependency) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *OrganizationDependency) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+type GetOrganizationDependenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationDependenciesRequest) Reset() {
+	*x = GetOrganizationDependenciesRequest{}
+	mi := &file_organization_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationDependenciesRequest) ProtoMessage() {}
+
+func (x *GetOrganizationDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetOrganizationDependenciesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type GetOrganizationDependenciesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	OrgId         string                    `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Dependencies  []*OrganizationDependency `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	CanPurge      bool                      `protobuf:"varint,3,opt,name=can_purge,json=canPurge,proto3" json:"can_purge,omitempty"` // No blocking dependencies left
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationDependenciesResponse) Reset() {
+	*x = GetOrganizationDependenciesResponse{}
+	mi := &file_organization_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationDependenciesResponse) ProtoMessage() {}
+
+func (x *GetOrganizationDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetOrganizationDependenciesResponse) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetOrganizationDependenciesResponse) GetDependencies() []*OrganizationDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *GetOrganizationDependenciesResponse) GetCanPurge() bool {
+	if x != nil {
+		return x.CanPurge
+	}
+	return false
+}
+
+type PurgeOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeOrganizationRequest) Reset() {
+	*x = PurgeOrganizationRequest{}
+	mi := &file_organization_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeOrganizationRequest) ProtoMessage() {}
+
+func (x *PurgeOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*PurgeOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PurgeOrganizationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type PurgeOrganizationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Success       bool                      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Dependencies  []*OrganizationDependency `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeOrganizationResponse) Reset() {
+	*x = PurgeOrganizationResponse{}
+	mi := &file_organization_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeOrganizationResponse) ProtoMessage() {}
+
+func (x *PurgeOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*PurgeOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PurgeOrganizationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PurgeOrganizationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PurgeOrganizationResponse) GetDependencies() []*OrganizationDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type StartOnboardingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantName     string                 `protobuf:"bytes,1,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`
+	SuperAdmin     *SuperAdminDetails     `protobuf:"bytes,2,opt,name=super_admin,json=superAdmin,proto3" json:"super_admin,omitempty"`
+	OrgName        string                 `protobuf:"bytes,3,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	OrgCode        string                 `protobuf:"bytes,4,opt,name=org_code,json=orgCode,proto3" json:"org_code,omitempty"`
+	OrgDescription string                 `protobuf:"bytes,5,opt,name=org_description,json=orgDescription,proto3" json:"org_description,omitempty"`
+	OrgLogo        string                 `protobuf:"bytes,6,opt,name=org_logo,json=orgLogo,proto3" json:"org_logo,omitempty"`
+	Departments    []string               `protobuf:"bytes,7,rep,name=departments,proto3" json:"departments,omitempty"`                           // EMPTY → default departments
+	Designations   []string               `protobuf:"bytes,8,rep,name=designations,proto3" json:"designations,omitempty"`                         // EMPTY → default designations
+	Projects       []string               `protobuf:"bytes,9,rep,name=projects,proto3" json:"projects,omitempty"`                                 // EMPTY → no projects
+	ApprovalRules  *ApprovalRules         `protobuf:"bytes,10,opt,name=approval_rules,json=approvalRules,proto3" json:"approval_rules,omitempty"` // unset → system default
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartOnboardingRequest) Reset() {
+	*x = StartOnboardingRequest{}
+	mi := &file_organization_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOnboardingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOnboardingRequest) ProtoMessage() {}
+
+func (x *StartOnboardingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOnboardingRequest.ProtoReflect.Descriptor instead.
+func (*StartOnboardingRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StartOnboardingRequest) GetTenantName() string {
+	if x != nil {
+		return x.TenantName
+	}
+	return ""
+}
+
+func (x *StartOnboardingRequest) GetSuperAdmin() *SuperAdminDetails {
+	if x != nil {
+		return x.SuperAdmin
+	}
+	return nil
+}
+
+func (x *StartOnboardingRequest) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *StartOnboardingRequest) GetOrgCode() string {
+	if x != nil {
+		return x.OrgCode
+	}
+	return ""
+}
+
+func (x *StartOnboardingRequest) GetOrgDescription() string {
+	if x != nil {
+		return x.OrgDescription
+	}
+	return ""
+}
+
+func (x *StartOnboardingRequest) GetOrgLogo() string {
+	if x != nil {
+		return x.OrgLogo
+	}
+	return ""
+}
+
+func (x *StartOnboardingRequest) GetDepartments() []string {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+func (x *StartOnboardingRequest) GetDesignations() []string {
+	if x != nil {
+		return x.Designations
+	}
+	return nil
+}
+
+func (x *StartOnboardingRequest) GetProjects() []string {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *StartOnboardingRequest) GetApprovalRules() *ApprovalRules {
+	if x != nil {
+		return x.ApprovalRules
+	}
+	return nil
+}
+
+type GetOnboardingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStatusRequest) Reset() {
+	*x = GetOnboardingStatusRequest{}
+	mi := &file_organization_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStatusRequest) ProtoMessage() {}
+
+func (x *GetOnboardingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetOnboardingStatusRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+// The super admin password is asked for again because it is never stored
+type OnboardingActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnboardingActionRequest) Reset() {
+	*x = OnboardingActionRequest{}
+	mi := &file_organization_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingActionRequest) ProtoMessage() {}
+
+func (x *OnboardingActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingActionRequest.ProtoReflect.Descriptor instead.
+func (*OnboardingActionRequest) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OnboardingActionRequest) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *OnboardingActionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type OnboardingStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          OnboardingStepName     `protobuf:"varint,1,opt,name=step,proto3,enum=organizations.OnboardingStepName" json:"step,omitempty"`
+	Status        OnboardingStepStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=organizations.OnboardingStepStatus" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnboardingStep) Reset() {
+	*x = OnboardingStep{}
+	mi := &file_organization_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingStep) ProtoMessage() {}
+
+func (x *OnboardingStep) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingStep.ProtoReflect.Descriptor instead.
+func (*OnboardingStep) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OnboardingStep) GetStep() OnboardingStepName {
+	if x != nil {
+		return x.Step
+	}
+	return OnboardingStepName_ONBOARDING_STEP_UNSPECIFIED
+}
+
+func (x *OnboardingStep) GetStatus() OnboardingStepStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OnboardingStepStatus_ONBOARDING_STEP_STATUS_UNSPECIFIED
+}
+
+func (x *OnboardingStep) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OnboardingStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OnboardingStep) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *OnboardingStep) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type Onboarding struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SagaId           string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	Status           OnboardingStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=organizations.OnboardingStatus" json:"status,omitempty"`
+	CurrentStep      OnboardingStepName     `protobuf:"varint,3,opt,name=current_step,json=currentStep,proto3,enum=organizations.OnboardingStepName" json:"current_step,omitempty"` // Step running, paused on or rolled back last
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                                                                       // Why the onboarding paused or rolled back
+	TenantId         string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OrgId            string                 `protobuf:"bytes,6,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	SuperAdminUserId string                 `protobuf:"bytes,7,opt,name=super_admin_user_id,json=superAdminUserId,proto3" json:"super_admin_user_id,omitempty"`
+	Steps            []*OnboardingStep      `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Onboarding) Reset() {
+	*x = Onboarding{}
+	mi := &file_organization_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Onboarding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Onboarding) ProtoMessage() {}
+
+func (x *Onboarding) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Onboarding.ProtoReflect.Descriptor instead.
+func (*Onboarding) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Onboarding) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *Onboarding) GetStatus() OnboardingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OnboardingStatus_ONBOARDING_STATUS_UNSPECIFIED
+}
+
+func (x *Onboarding) GetCurrentStep() OnboardingStepName {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return OnboardingStepName_ONBOARDING_STEP_UNSPECIFIED
+}
+
+func (x *Onboarding) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Onboarding) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Onboarding) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Onboarding) GetSuperAdminUserId() string {
+	if x != nil {
+		return x.SuperAdminUserId
+	}
+	return ""
+}
+
+func (x *Onboarding) GetSteps() []*OnboardingStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Onboarding) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Onboarding) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Onboarding) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type OnboardingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onboarding    *Onboarding            `protobuf:"bytes,1,opt,name=onboarding,proto3" json:"onboarding,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnboardingResponse) Reset() {
+	*x = OnboardingResponse{}
+	mi := &file_organization_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnboardingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnboardingResponse) ProtoMessage() {}
+
+func (x *OnboardingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnboardingResponse.ProtoReflect.Descriptor instead.
+func (*OnboardingResponse) Descriptor() ([]byte, []int) {
+	return file_organization_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *OnboardingResponse) GetOnboarding() *Onboarding {
+	if x != nil {
+		return x.Onboarding
+	}
+	return nil
+}
+
+func (x *OnboardingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_organization_proto protoreflect.FileDescriptor
+
+const file_organization_proto_rawDesc = "" +
+	"\n" +
+	"\x12organization.proto\x12\rorganizations\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"Y\n" +
+	"\x11SuperAdminDetails\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"\x94\x02\n" +
+	"\aProject\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12!\n" +
+	"\fproject_name\x18\x04 \x01(\tR\vprojectName\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\x06\n" +
+	"\fOrganization\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\"\n" +
+	"\rparent_org_id\x18\x03 \x01(\tR\vparentOrgId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\tR\x04code\x12#\n" +
+	"\rdatabase_name\x18\x06 \x01(\tR\fdatabaseName\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x12\n" +
+	"\x04logo\x18\b \x01(\tR\x04logo\x12A\n" +
+	"\vsuper_admin\x18\t \x01(\v2 .organizations.SuperAdminDetailsR\n" +
+	"superAdmin\x12)\n" +
+	"\x10initial_projects\x18\n" +
+	" \x03(\tR\x0finitialProjects\x129\n" +
+	"\x06status\x18\v \x01(\x0e2!.organizations.OrganizationStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\tR\tcreatedBy\x12#\n" +
+	"\rstatus_reason\x18\x0f \x01(\tR\fstatusReason\x12A\n" +
+	"\x0edeactivated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\rdeactivatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12;\n" +
+	"\vpurge_after\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"purgeAfter\"\xc6\x02\n" +
+	"\x19CreateOrganizationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04logo\x18\x04 \x01(\tR\x04logo\x12\"\n" +
+	"\rparent_org_id\x18\x05 \x01(\tR\vparentOrgId\x12A\n" +
+	"\vsuper_admin\x18\x06 \x01(\v2 .organizations.SuperAdminDetailsR\n" +
+	"superAdmin\x12)\n" +
+	"\x10initial_projects\x18\a \x03(\tR\x0finitialProjects\x129\n" +
+	"\x06status\x18\b \x01(\x0e2!.organizations.OrganizationStatusR\x06status\"q\n" +
+	"\x14OrganizationResponse\x12?\n" +
+	"\forganization\x18\x01 \x01(\v2\x1b.organizations.OrganizationR\forganization\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
+	"\x16GetOrganizationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\";\n" +
+	"\"GetOrganizationWithProjectsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\x9a\x01\n" +
+	"#GetOrganizationWithProjectsResponse\x12?\n" +
+	"\forganization\x18\x01 \x01(\v2\x1b.organizations.OrganizationR\forganization\x122\n" +
+	"\bprojects\x18\x02 \x03(\v2\x16.organizations.ProjectR\bprojects\"\xcb\x01\n" +
+	"\x19UpdateOrganizationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04logo\x18\x05 \x01(\tR\x04logo\x129\n" +
+	"\x06status\x18\x06 \x01(\x0e2!.organizations.OrganizationStatusR\x06status\"J\n" +
+	"\x19DeleteOrganizationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x1aDeleteOrganizationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\vpurge_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"purgeAfter\"K\n" +
+	"\x18ListOrganizationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"p\n" +
+	" ListOrganizationsByTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"t\n" +
+	"\x1dListChildOrganizationsRequest\x12\"\n" +
+	"\rparent_org_id\x18\x01 \x01(\tR\vparentOrgId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"2\n" +
+	"\x1cGetOrganizationByCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x96\x01\n" +
+	"\x12PaginationMetadata\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_items\x18\x03 \x01(\x05R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
+	"totalPages\"\xc2\x01\n" +
+	"\x19ListOrganizationsResponse\x12A\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x1b.organizations.OrganizationR\rorganizations\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12A\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2!.organizations.PaginationMetadataR\n" +
+	"pagination\"M\n" +
+	"\x11UploadLogoRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12!\n" +
+	"\ffile_content\x18\x02 \x01(\fR\vfileContent\"\xa0\x02\n" +
+	"\x12UploadLogoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\blogo_url\x18\x04 \x01(\tR\alogoUrl\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tmime_type\x18\x06 \x01(\tR\bmimeType\x12\x1b\n" +
+	"\tfile_size\x18\a \x01(\x03R\bfileSize\x12;\n" +
+	"\vuploaded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"uploadedAt\"\xae\x01\n" +
+	"\x14OrganizationTreeNode\x12?\n" +
+	"\forganization\x18\x01 \x01(\v2\x1b.organizations.OrganizationR\forganization\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\x12?\n" +
+	"\bchildren\x18\x03 \x03(\v2#.organizations.OrganizationTreeNodeR\bchildren\"P\n" +
+	"\x1aGetOrganizationTreeRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
+	"\tmax_depth\x18\x02 \x01(\x05R\bmaxDepth\"w\n" +
+	"\x1bGetOrganizationTreeResponse\x127\n" +
+	"\x04root\x18\x01 \x01(\v2#.organizations.OrganizationTreeNodeR\x04root\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"8\n" +
+	"\x1fGetOrganizationAncestorsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"]\n" +
+	" GetOrganizationAncestorsResponse\x129\n" +
+	"\tancestors\x18\x01 \x03(\v2\x1b.organizations.OrganizationR\tancestors\"[\n" +
+	"\x17MoveOrganizationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12)\n" +
+	"\x11new_parent_org_id\x18\x02 \x01(\tR\x0enewParentOrgId\"\xc1\x01\n" +
+	"\rApprovalRules\x12#\n" +
+	"\rmin_approvers\x18\x01 \x01(\x05R\fminApprovers\x12-\n" +
+	"\x12require_sequential\x18\x02 \x01(\bR\x11requireSequential\x12.\n" +
+	"\x13allow_self_approval\x18\x03 \x01(\bR\x11allowSelfApproval\x12,\n" +
+	"\x12auto_approve_below\x18\x04 \x01(\x01R\x10autoApproveBelow\"|\n" +
+	"\vTdsDefaults\x12'\n" +
+	"\x0fdefault_section\x18\x01 \x01(\tR\x0edefaultSection\x12\x1f\n" +
+	"\vauto_deduct\x18\x02 \x01(\bR\n" +
+	"autoDeduct\x12#\n" +
+	"\rrate_override\x18\x03 \x01(\x01R\frateOverride\"\xd3\x01\n" +
+	"\x14OrganizationSettings\x12C\n" +
+	"\x0eapproval_rules\x18\x01 \x01(\v2\x1c.organizations.ApprovalRulesR\rapprovalRules\x12=\n" +
+	"\ftds_defaults\x18\x02 \x01(\v2\x1a.organizations.TdsDefaultsR\vtdsDefaults\x12\x17\n" +
+	"\x04logo\x18\x04 \x01(\tH\x00R\x04logo\x88\x01\x01B\a\n" +
+	"\x05_logoJ\x04\b\x03\x10\x04R\x0fpassword_policy\"7\n" +
+	"\x1eGetOrganizationSettingsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\xc0\x01\n" +
+	"!UpdateOrganizationSettingsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12?\n" +
+	"\bsettings\x18\x02 \x01(\v2#.organizations.OrganizationSettingsR\bsettings\x12C\n" +
+	"\ainherit\x18\x03 \x03(\x0e2).organizations.OrganizationSettingSectionR\ainherit\"\x90\x01\n" +
+	"\x1cOrganizationSettingsResponse\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12?\n" +
+	"\bsettings\x18\x02 \x01(\v2#.organizations.OrganizationSettingsR\bsettings\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x84\x01\n" +
+	"\x19OrganizationSettingSource\x12C\n" +
+	"\asection\x18\x01 \x01(\x0e2).organizations.OrganizationSettingSectionR\asection\x12\"\n" +
+	"\rsource_org_id\x18\x02 \x01(\tR\vsourceOrgId\"\xc3\x01\n" +
+	"%EffectiveOrganizationSettingsResponse\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12?\n" +
+	"\bsettings\x18\x02 \x01(\v2#.organizations.OrganizationSettingsR\bsettings\x12B\n" +
+	"\asources\x18\x03 \x03(\v2(.organizations.OrganizationSettingSourceR\asources\"P\n" +
+	"\x1fChangeOrganizationStatusRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xa7\x01\n" +
+	" ChangeOrganizationStatusResponse\x12?\n" +
+	"\forganization\x18\x01 \x01(\v2\x1b.organizations.OrganizationR\forganization\x12(\n" +
+	"\x10affected_org_ids\x18\x02 \x03(\tR\x0eaffectedOrgIds\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x80\x01\n" +
+	"\x16OrganizationDependency\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1a\n" +
+	"\bblocking\x18\x04 \x01(\bR\bblocking\";\n" +
+	"\"GetOrganizationDependenciesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\xa4\x01\n" +
+	"#GetOrganizationDependenciesResponse\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12I\n" +
+	"\fdependencies\x18\x02 \x03(\v2%.organizations.OrganizationDependencyR\fdependencies\x12\x1b\n" +
+	"\tcan_purge\x18\x03 \x01(\bR\bcanPurge\"1\n" +
+	"\x18PurgeOrganizationRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"\x9a\x01\n" +
+	"\x19PurgeOrganizationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12I\n" +
+	"\fdependencies\x18\x03 \x03(\v2%.organizations.OrganizationDependencyR\fdependencies\"\x9d\x03\n" +
+	"\x16StartOnboardingRequest\x12\x1f\n" +
+	"\vtenant_name\x18\x01 \x01(\tR\n" +
+	"tenantName\x12A\n" +
+	"\vsuper_admin\x18\x02 \x01(\v2 .organizations.SuperAdminDetailsR\n" +
+	"superAdmin\x12\x19\n" +
+	"\borg_name\x18\x03 \x01(\tR\aorgName\x12\x19\n" +
+	"\borg_code\x18\x04 \x01(\tR\aorgCode\x12'\n" +
+	"\x0forg_description\x18\x05 \x01(\tR\x0eorgDescription\x12\x19\n" +
+	"\borg_logo\x18\x06 \x01(\tR\aorgLogo\x12 \n" +
+	"\vdepartments\x18\a \x03(\tR\vdepartments\x12\"\n" +
+	"\fdesignations\x18\b \x03(\tR\fdesignations\x12\x1a\n" +
+	"\bprojects\x18\t \x03(\tR\bprojects\x12C\n" +
+	"\x0eapproval_rules\x18\n" +
+	" \x01(\v2\x1c.organizations.ApprovalRulesR\rapprovalRules\"5\n" +
+	"\x1aGetOnboardingStatusRequest\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\"N\n" +
+	"\x17OnboardingActionRequest\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xae\x02\n" +
+	"\x0eOnboardingStep\x125\n" +
+	"\x04step\x18\x01 \x01(\x0e2!.organizations.OnboardingStepNameR\x04step\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2#.organizations.OnboardingStepStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\x87\x04\n" +
+	"\n" +
+	"Onboarding\x12\x17\n" +
+	"\asaga_id\x18\x01 \x01(\tR\x06sagaId\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.organizations.OnboardingStatusR\x06status\x12D\n" +
+	"\fcurrent_step\x18\x03 \x01(\x0e2!.organizations.OnboardingStepNameR\vcurrentStep\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06org_id\x18\x06 \x01(\tR\x05orgId\x12-\n" +
+	"\x13super_admin_user_id\x18\a \x01(\tR\x10superAdminUserId\x123\n" +
+	"\x05steps\x18\b \x03(\v2\x1d.organizations.OnboardingStepR\x05steps\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"i\n" +
+	"\x12OnboardingResponse\x129\n" +
+	"\n" +
+	"onboarding\x18\x01 \x01(\v2\x19.organizations.OnboardingR\n" +
+	"onboarding\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*4\n" +
 	"\x12OrganizationStatus\x12\r\n" +
 	"\tactivated\x10\x00\x12\x0f\n" +
-	"\vdeactivated\x10\x012\x8d\f\n" +
+	"\vdeactivated\x10\x01*\xc4\x01\n" +
+	"\x1aOrganizationSettingSection\x12\x1f\n" +
+	"\x1bSETTING_SECTION_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eSETTING_SECTION_APPROVAL_RULES\x10\x01\x12 \n" +
+	"\x1cSETTING_SECTION_TDS_DEFAULTS\x10\x02\x12\x18\n" +
+	"\x14SETTING_SECTION_LOGO\x10\x04\"\x04\b\x03\x10\x03*\x1fSETTING_SECTION_PASSWORD_POLICY*\x85\x02\n" +
+	"\x10OnboardingStatus\x12!\n" +
+	"\x1dONBOARDING_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ONBOARDING_STATUS_RUNNING\x10\x01\x12\x1c\n" +
+	"\x18ONBOARDING_STATUS_PAUSED\x10\x02\x12\x1f\n" +
+	"\x1bONBOARDING_STATUS_COMPLETED\x10\x03\x12\"\n" +
+	"\x1eONBOARDING_STATUS_COMPENSATING\x10\x04\x12!\n" +
+	"\x1dONBOARDING_STATUS_COMPENSATED\x10\x05\x12)\n" +
+	"%ONBOARDING_STATUS_COMPENSATION_FAILED\x10\x06*\xbc\x02\n" +
+	"\x12OnboardingStepName\x12\x1f\n" +
+	"\x1bONBOARDING_STEP_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ONBOARDING_STEP_TENANT\x10\x01\x12 \n" +
+	"\x1cONBOARDING_STEP_ORGANIZATION\x10\x02\x12$\n" +
+	" ONBOARDING_STEP_SUPER_ADMIN_ROLE\x10\x03\x12\x18\n" +
+	"\x14ONBOARDING_STEP_USER\x10\x04\x12\x1f\n" +
+	"\x1bONBOARDING_STEP_DEPARTMENTS\x10\x05\x12 \n" +
+	"\x1cONBOARDING_STEP_DESIGNATIONS\x10\x06\x12\x1c\n" +
+	"\x18ONBOARDING_STEP_PROJECTS\x10\a\x12&\n" +
+	"\"ONBOARDING_STEP_APPROVAL_TEMPLATES\x10\b*\xf2\x01\n" +
+	"\x14OnboardingStepStatus\x12&\n" +
+	"\"ONBOARDING_STEP_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eONBOARDING_STEP_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1eONBOARDING_STEP_STATUS_RUNNING\x10\x02\x12\x1f\n" +
+	"\x1bONBOARDING_STEP_STATUS_DONE\x10\x03\x12!\n" +
+	"\x1dONBOARDING_STEP_STATUS_FAILED\x10\x04\x12&\n" +
+	"\"ONBOARDING_STEP_STATUS_COMPENSATED\x10\x052\x92\x1f\n" +
 	"\x13OrganizationService\x12\x85\x01\n" +
 	"\x12CreateOrganization\x12(.organizations.CreateOrganizationRequest\x1a#.organizations.OrganizationResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/organizations\x12\x85\x01\n" +
 	"\x11ListOrganizations\x12'.organizations.ListOrganizationsRequest\x1a(.organizations.ListOrganizationsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/organizations\x12\xa9\x01\n" +
@@ -1511,8 +3612,23 @@ const file_organization_proto_rawDesc = "" +
 	"\x1bGetOrganizationWithProjects\x121.organizations.GetOrganizationWithProjectsRequest\x1a2.organizations.GetOrganizationWithProjectsResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/organizations/{org_id}/with-projects\x12\x94\x01\n" +
 	"\x15GetOrganizationByCode\x12+.organizations.GetOrganizationByCodeRequest\x1a#.organizations.OrganizationResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/organizations/code/{code}\x12\x8e\x01\n" +
 	"\x12UpdateOrganization\x12(.organizations.UpdateOrganizationRequest\x1a#.organizations.OrganizationResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/organizations/{org_id}\x12\x91\x01\n" +
-	"\x12DeleteOrganization\x12(.organizations.DeleteOrganizationRequest\x1a).organizations.DeleteOrganizationResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1/organizations/{org_id}\x12\x8d\x01\n" +
-	"\x16UploadOrganizationLogo\x12 .organizations.UploadLogoRequest\x1a!.organizations.UploadLogoResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/organizations/{org_id}/logoB:Z8github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpbb\x06proto3"
+	"\x12DeleteOrganization\x12(.organizations.DeleteOrganizationRequest\x1a).organizations.DeleteOrganizationResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1/organizations/{org_id}\x12\xaf\x01\n" +
+	"\x16DeactivateOrganization\x12..organizations.ChangeOrganizationStatusRequest\x1a/.organizations.ChangeOrganizationStatusResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/organizations/{org_id}/deactivate\x12\xab\x01\n" +
+	"\x14ActivateOrganization\x12..organizations.ChangeOrganizationStatusRequest\x1a/.organizations.ChangeOrganizationStatusResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/organizations/{org_id}/activate\x12\xa9\x01\n" +
+	"\x13RestoreOrganization\x12..organizations.ChangeOrganizationStatusRequest\x1a/.organizations.ChangeOrganizationStatusResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/organizations/{org_id}/restore\x12\xb9\x01\n" +
+	"\x1bGetOrganizationDependencies\x121.organizations.GetOrganizationDependenciesRequest\x1a2.organizations.GetOrganizationDependenciesResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/organizations/{org_id}/dependencies\x12\x94\x01\n" +
+	"\x11PurgeOrganization\x12'.organizations.PurgeOrganizationRequest\x1a(.organizations.PurgeOrganizationResponse\",\x82\xd3\xe4\x93\x02&*$/api/v1/organizations/{org_id}/purge\x12\x8d\x01\n" +
+	"\x16UploadOrganizationLogo\x12 .organizations.UploadLogoRequest\x1a!.organizations.UploadLogoResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/organizations/{org_id}/logo\x12\x99\x01\n" +
+	"\x13GetOrganizationTree\x12).organizations.GetOrganizationTreeRequest\x1a*.organizations.GetOrganizationTreeResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/organizations/{org_id}/tree\x12\xad\x01\n" +
+	"\x18GetOrganizationAncestors\x12..organizations.GetOrganizationAncestorsRequest\x1a/.organizations.GetOrganizationAncestorsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/organizations/{org_id}/ancestors\x12\x8f\x01\n" +
+	"\x10MoveOrganization\x12&.organizations.MoveOrganizationRequest\x1a#.organizations.OrganizationResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/organizations/{org_id}/move\x12\xa6\x01\n" +
+	"\x17GetOrganizationSettings\x12-.organizations.GetOrganizationSettingsRequest\x1a+.organizations.OrganizationSettingsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/organizations/{org_id}/settings\x12\xaf\x01\n" +
+	"\x1aUpdateOrganizationSettings\x120.organizations.UpdateOrganizationSettingsRequest\x1a+.organizations.OrganizationSettingsResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/api/v1/organizations/{org_id}/settings\x12\xc2\x01\n" +
+	" GetEffectiveOrganizationSettings\x12-.organizations.GetOrganizationSettingsRequest\x1a4.organizations.EffectiveOrganizationSettingsResponse\"9\x82\xd3\xe4\x93\x023\x121/api/v1/organizations/{org_id}/settings/effective\x12z\n" +
+	"\x0fStartOnboarding\x12%.organizations.StartOnboardingRequest\x1a!.organizations.OnboardingResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/onboarding\x12\x89\x01\n" +
+	"\x13GetOnboardingStatus\x12).organizations.GetOnboardingStatusRequest\x1a!.organizations.OnboardingResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/onboarding/{saga_id}\x12\x8d\x01\n" +
+	"\x10ResumeOnboarding\x12&.organizations.OnboardingActionRequest\x1a!.organizations.OnboardingResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/onboarding/{saga_id}/resume\x12\x8d\x01\n" +
+	"\x10CancelOnboarding\x12&.organizations.OnboardingActionRequest\x1a!.organizations.OnboardingResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/onboarding/{saga_id}/cancelB:Z8github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpbb\x06proto3"
 
 var (
 	file_organization_proto_rawDescOnce sync.Once
@@ -1526,72 +3642,165 @@ func file_organization_proto_rawDescGZIP() []byte {
 	return file_organization_proto_rawDescData
 }
 
-var file_organization_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_organization_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_organization_proto_goTypes = []any{
-	(OrganizationStatus)(0),                     // 0: organizations.OrganizationStatus
-	(*SuperAdminDetails)(nil),                   // 1: organizations.SuperAdminDetails
-	(*Project)(nil),                             // 2: organizations.Project
-	(*Organization)(nil),                        // 3: organizations.Organization
-	(*CreateOrganizationRequest)(nil),           // 4: organizations.CreateOrganizationRequest
-	(*OrganizationResponse)(nil),                // 5: organizations.OrganizationResponse
-	(*GetOrganizationRequest)(nil),              // 6: organizations.GetOrganizationRequest
-	(*GetOrganizationWithProjectsRequest)(nil),  // 7: organizations.GetOrganizationWithProjectsRequest
-	(*GetOrganizationWithProjectsResponse)(nil), // 8: organizations.GetOrganizationWithProjectsResponse
-	(*UpdateOrganizationRequest)(nil),           // 9: organizations.UpdateOrganizationRequest
-	(*DeleteOrganizationRequest)(nil),           // 10: organizations.DeleteOrganizationRequest
-	(*DeleteOrganizationResponse)(nil),          // 11: organizations.DeleteOrganizationResponse
-	(*ListOrganizationsRequest)(nil),            // 12: organizations.ListOrganizationsRequest
-	(*ListOrganizationsByTenantRequest)(nil),    // 13: organizations.ListOrganizationsByTenantRequest
-	(*ListChildOrganizationsRequest)(nil),       // 14: organizations.ListChildOrganizationsRequest
-	(*GetOrganizationByCodeRequest)(nil),        // 15: organizations.GetOrganizationByCodeRequest
-	(*PaginationMetadata)(nil),                  // 16: organizations.PaginationMetadata
-	(*ListOrganizationsResponse)(nil),           // 17: organizations.ListOrganizationsResponse
-	(*UploadLogoRequest)(nil),                   // 18: organizations.UploadLogoRequest
-	(*UploadLogoResponse)(nil),                  // 19: organizations.UploadLogoResponse
-	(*timestamppb.Timestamp)(nil),               // 20: google.protobuf.Timestamp
+	(OrganizationStatus)(0),                       // 0: organizations.OrganizationStatus
+	(OrganizationSettingSection)(0),               // 1: organizations.OrganizationSettingSection
+	(OnboardingStatus)(0),                         // 2: organizations.OnboardingStatus
+	(OnboardingStepName)(0),                       // 3: organizations.OnboardingStepName
+	(OnboardingStepStatus)(0),                     // 4: organizations.OnboardingStepStatus
+	(*SuperAdminDetails)(nil),                     // 5: organizations.SuperAdminDetails
+	(*Project)(nil),                               // 6: organizations.Project
+	(*Organization)(nil),                          // 7: organizations.Organization
+	(*CreateOrganizationRequest)(nil),             // 8: organizations.CreateOrganizationRequest
+	(*OrganizationResponse)(nil),                  // 9: organizations.OrganizationResponse
+	(*GetOrganizationRequest)(nil),                // 10: organizations.GetOrganizationRequest
+	(*GetOrganizationWithProjectsRequest)(nil),    // 11: organizations.GetOrganizationWithProjectsRequest
+	(*GetOrganizationWithProjectsResponse)(nil),   // 12: organizations.GetOrganizationWithProjectsResponse
+	(*UpdateOrganizationRequest)(nil),             // 13: organizations.UpdateOrganizationRequest
+	(*DeleteOrganizationRequest)(nil),             // 14: organizations.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),            // 15: organizations.DeleteOrganizationResponse
+	(*ListOrganizationsRequest)(nil),              // 16: organizations.ListOrganizationsRequest
+	(*ListOrganizationsByTenantRequest)(nil),      // 17: organizations.ListOrganizationsByTenantRequest
+	(*ListChildOrganizationsRequest)(nil),         // 18: organizations.ListChildOrganizationsRequest
+	(*GetOrganizationByCodeRequest)(nil),          // 19: organizations.GetOrganizationByCodeRequest
+	(*PaginationMetadata)(nil),                    // 20: organizations.PaginationMetadata
+	(*ListOrganizationsResponse)(nil),             // 21: organizations.ListOrganizationsResponse
+	(*UploadLogoRequest)(nil),                     // 22: organizations.UploadLogoRequest
+	(*UploadLogoResponse)(nil),                    // 23: organizations.UploadLogoResponse
+	(*OrganizationTreeNode)(nil),                  // 24: organizations.OrganizationTreeNode
+	(*GetOrganizationTreeRequest)(nil),            // 25: organizations.GetOrganizationTreeRequest
+	(*GetOrganizationTreeResponse)(nil),           // 26: organizations.GetOrganizationTreeResponse
+	(*GetOrganizationAncestorsRequest)(nil),       // 27: organizations.GetOrganizationAncestorsRequest
+	(*GetOrganizationAncestorsResponse)(nil),      // 28: organizations.GetOrganizationAncestorsResponse
+	(*MoveOrganizationRequest)(nil),               // 29: organizations.MoveOrganizationRequest
+	(*ApprovalRules)(nil),                         // 30: organizations.ApprovalRules
+	(*TdsDefaults)(nil),                           // 31: organizations.TdsDefaults
+	(*OrganizationSettings)(nil),                  // 32: organizations.OrganizationSettings
+	(*GetOrganizationSettingsRequest)(nil),        // 33: organizations.GetOrganizationSettingsRequest
+	(*UpdateOrganizationSettingsRequest)(nil),     // 34: organizations.UpdateOrganizationSettingsRequest
+	(*OrganizationSettingsResponse)(nil),          // 35: organizations.OrganizationSettingsResponse
+	(*OrganizationSettingSource)(nil),             // 36: organizations.OrganizationSettingSource
+	(*EffectiveOrganizationSettingsResponse)(nil), // 37: organizations.EffectiveOrganizationSettingsResponse
+	(*ChangeOrganizationStatusRequest)(nil),       // 38: organizations.ChangeOrganizationStatusRequest
+	(*ChangeOrganizationStatusResponse)(nil),      // 39: organizations.ChangeOrganizationStatusResponse
+	(*OrganizationDependency)(nil),                // 40: organizations.OrganizationDependency
+	(*GetOrganizationDependenciesRequest)(nil),    // 41: organizations.GetOrganizationDependenciesRequest
+	(*GetOrganizationDependenciesResponse)(nil),   // 42: organizations.GetOrganizationDependenciesResponse
+	(*PurgeOrganizationRequest)(nil),              // 43: organizations.PurgeOrganizationRequest
+	(*PurgeOrganizationResponse)(nil),             // 44: organizations.PurgeOrganizationResponse
+	(*StartOnboardingRequest)(nil),                // 45: organizations.StartOnboardingRequest
+	(*GetOnboardingStatusRequest)(nil),            // 46: organizations.GetOnboardingStatusRequest
+	(*OnboardingActionRequest)(nil),               // 47: organizations.OnboardingActionRequest
+	(*OnboardingStep)(nil),                        // 48: organizations.OnboardingStep
+	(*Onboarding)(nil),                            // 49: organizations.Onboarding
+	(*OnboardingResponse)(nil),                    // 50: organizations.OnboardingResponse
+	(*timestamppb.Timestamp)(nil),                 // 51: google.protobuf.Timestamp
 }
 var file_organization_proto_depIdxs = []int32{
-	20, // 0: organizations.Project.created_at:type_name -> google.protobuf.Timestamp
-	20, // 1: organizations.Project.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: organizations.Organization.super_admin:type_name -> organizations.SuperAdminDetails
+	51, // 0: organizations.Project.created_at:type_name -> google.protobuf.Timestamp
+	51, // 1: organizations.Project.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 2: organizations.Organization.super_admin:type_name -> organizations.SuperAdminDetails
 	0,  // 3: organizations.Organization.status:type_name -> organizations.OrganizationStatus
-	20, // 4: organizations.Organization.created_at:type_name -> google.protobuf.Timestamp
-	20, // 5: organizations.Organization.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: organizations.CreateOrganizationRequest.super_admin:type_name -> organizations.SuperAdminDetails
-	0,  // 7: organizations.CreateOrganizationRequest.status:type_name -> organizations.OrganizationStatus
-	3,  // 8: organizations.OrganizationResponse.organization:type_name -> organizations.Organization
-	3,  // 9: organizations.GetOrganizationWithProjectsResponse.organization:type_name -> organizations.Organization
-	2,  // 10: organizations.GetOrganizationWithProjectsResponse.projects:type_name -> organizations.Project
-	0,  // 11: organizations.UpdateOrganizationRequest.status:type_name -> organizations.OrganizationStatus
-	3,  // 12: organizations.ListOrganizationsResponse.organizations:type_name -> organizations.Organization
-	16, // 13: organizations.ListOrganizationsResponse.pagination:type_name -> organizations.PaginationMetadata
-	20, // 14: organizations.UploadLogoResponse.uploaded_at:type_name -> google.protobuf.Timestamp
-	4,  // 15: organizations.OrganizationService.CreateOrganization:input_type -> organizations.CreateOrganizationRequest
-	12, // 16: organizations.OrganizationService.ListOrganizations:input_type -> organizations.ListOrganizationsRequest
-	13, // 17: organizations.OrganizationService.ListOrganizationsByTenant:input_type -> organizations.ListOrganizationsByTenantRequest
-	14, // 18: organizations.OrganizationService.ListChildOrganizations:input_type -> organizations.ListChildOrganizationsRequest
-	6,  // 19: organizations.OrganizationService.GetOrganization:input_type -> organizations.GetOrganizationRequest
-	7,  // 20: organizations.OrganizationService.GetOrganizationWithProjects:input_type -> organizations.GetOrganizationWithProjectsRequest
-	15, // 21: organizations.OrganizationService.GetOrganizationByCode:input_type -> organizations.GetOrganizationByCodeRequest
-	9,  // 22: organizations.OrganizationService.UpdateOrganization:input_type -> organizations.UpdateOrganizationRequest
-	10, // 23: organizations.OrganizationService.DeleteOrganization:input_type -> organizations.DeleteOrganizationRequest
-	18, // 24: organizations.OrganizationService.UploadOrganizationLogo:input_type -> organizations.UploadLogoRequest
-	5,  // 25: organizations.OrganizationService.CreateOrganization:output_type -> organizations.OrganizationResponse
-	17, // 26: organizations.OrganizationService.ListOrganizations:output_type -> organizations.ListOrganizationsResponse
-	17, // 27: organizations.OrganizationService.ListOrganizationsByTenant:output_type -> organizations.ListOrganizationsResponse
-	17, // 28: organizations.OrganizationService.ListChildOrganizations:output_type -> organizations.ListOrganizationsResponse
-	5,  // 29: organizations.OrganizationService.GetOrganization:output_type -> organizations.OrganizationResponse
-	8,  // 30: organizations.OrganizationService.GetOrganizationWithProjects:output_type -> organizations.GetOrganizationWithProjectsResponse
-	5,  // 31: organizations.OrganizationService.GetOrganizationByCode:output_type -> organizations.OrganizationResponse
-	5,  // 32: organizations.OrganizationService.UpdateOrganization:output_type -> organizations.OrganizationResponse
-	11, // 33: organizations.OrganizationService.DeleteOrganization:output_type -> organizations.DeleteOrganizationResponse
-	19, // 34: organizations.OrganizationService.UploadOrganizationLogo:output_type -> organizations.UploadLogoResponse
-	25, // [25:35] is the sub-list for method output_type
-	15, // [15:25] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	51, // 4: organizations.Organization.created_at:type_name -> google.protobuf.Timestamp
+	51, // 5: organizations.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 6: organizations.Organization.deactivated_at:type_name -> google.protobuf.Timestamp
+	51, // 7: organizations.Organization.deleted_at:type_name -> google.protobuf.Timestamp
+	51, // 8: organizations.Organization.purge_after:type_name -> google.protobuf.Timestamp
+	5,  // 9: organizations.CreateOrganizationRequest.super_admin:type_name -> organizations.SuperAdminDetails
+	0,  // 10: organizations.CreateOrganizationRequest.status:type_name -> organizations.OrganizationStatus
+	7,  // 11: organizations.OrganizationResponse.organization:type_name -> organizations.Organization
+	7,  // 12: organizations.GetOrganizationWithProjectsResponse.organization:type_name -> organizations.Organization
+	6,  // 13: organizations.GetOrganizationWithProjectsResponse.projects:type_name -> organizations.Project
+	0,  // 14: organizations.UpdateOrganizationRequest.status:type_name -> organizations.OrganizationStatus
+	51, // 15: organizations.DeleteOrganizationResponse.purge_after:type_name -> google.protobuf.Timestamp
+	7,  // 16: organizations.ListOrganizationsResponse.organizations:type_name -> organizations.Organization
+	20, // 17: organizations.ListOrganizationsResponse.pagination:type_name -> organizations.PaginationMetadata
+	51, // 18: organizations.UploadLogoResponse.uploaded_at:type_name -> google.protobuf.Timestamp
+	7,  // 19: organizations.OrganizationTreeNode.organization:type_name -> organizations.Organization
+	24, // 20: organizations.OrganizationTreeNode.children:type_name -> organizations.OrganizationTreeNode
+	24, // 21: organizations.GetOrganizationTreeResponse.root:type_name -> organizations.OrganizationTreeNode
+	7,  // 22: organizations.GetOrganizationAncestorsResponse.ancestors:type_name -> organizations.Organization
+	30, // 23: organizations.OrganizationSettings.approval_rules:type_name -> organizations.ApprovalRules
+	31, // 24: organizations.OrganizationSettings.tds_defaults:type_name -> organizations.TdsDefaults
+	32, // 25: organizations.UpdateOrganizationSettingsRequest.settings:type_name -> organizations.OrganizationSettings
+	1,  // 26: organizations.UpdateOrganizationSettingsRequest.inherit:type_name -> organizations.OrganizationSettingSection
+	32, // 27: organizations.OrganizationSettingsResponse.settings:type_name -> organizations.OrganizationSettings
+	1,  // 28: organizations.OrganizationSettingSource.section:type_name -> organizations.OrganizationSettingSection
+	32, // 29: organizations.EffectiveOrganizationSettingsResponse.settings:type_name -> organizations.OrganizationSettings
+	36, // 30: organizations.EffectiveOrganizationSettingsResponse.sources:type_name -> organizations.OrganizationSettingSource
+	7,  // 31: organizations.ChangeOrganizationStatusResponse.organization:type_name -> organizations.Organization
+	40, // 32: organizations.GetOrganizationDependenciesResponse.dependencies:type_name -> organizations.OrganizationDependency
+	40, // 33: organizations.PurgeOrganizationResponse.dependencies:type_name -> organizations.OrganizationDependency
+	5,  // 34: organizations.StartOnboardingRequest.super_admin:type_name -> organizations.SuperAdminDetails
+	30, // 35: organizations.StartOnboardingRequest.approval_rules:type_name -> organizations.ApprovalRules
+	3,  // 36: organizations.OnboardingStep.step:type_name -> organizations.OnboardingStepName
+	4,  // 37: organizations.OnboardingStep.status:type_name -> organizations.OnboardingStepStatus
+	51, // 38: organizations.OnboardingStep.started_at:type_name -> google.protobuf.Timestamp
+	51, // 39: organizations.OnboardingStep.finished_at:type_name -> google.protobuf.Timestamp
+	2,  // 40: organizations.Onboarding.status:type_name -> organizations.OnboardingStatus
+	3,  // 41: organizations.Onboarding.current_step:type_name -> organizations.OnboardingStepName
+	48, // 42: organizations.Onboarding.steps:type_name -> organizations.OnboardingStep
+	51, // 43: organizations.Onboarding.created_at:type_name -> google.protobuf.Timestamp
+	51, // 44: organizations.Onboarding.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 45: organizations.Onboarding.completed_at:type_name -> google.protobuf.Timestamp
+	49, // 46: organizations.OnboardingResponse.onboarding:type_name -> organizations.Onboarding
+	8,  // 47: organizations.OrganizationService.CreateOrganization:input_type -> organizations.CreateOrganizationRequest
+	16, // 48: organizations.OrganizationService.ListOrganizations:input_type -> organizations.ListOrganizationsRequest
+	17, // 49: organizations.OrganizationService.ListOrganizationsByTenant:input_type -> organizations.ListOrganizationsByTenantRequest
+	18, // 50: organizations.OrganizationService.ListChildOrganizations:input_type -> organizations.ListChildOrganizationsRequest
+	10, // 51: organizations.OrganizationService.GetOrganization:input_type -> organizations.GetOrganizationRequest
+	11, // 52: organizations.OrganizationService.GetOrganizationWithProjects:input_type -> organizations.GetOrganizationWithProjectsRequest
+	19, // 53: organizations.OrganizationService.GetOrganizationByCode:input_type -> organizations.GetOrganizationByCodeRequest
+	13, // 54: organizations.OrganizationService.UpdateOrganization:input_type -> organizations.UpdateOrganizationRequest
+	14, // 55: organizations.OrganizationService.DeleteOrganization:input_type -> organizations.DeleteOrganizationRequest
+	38, // 56: organizations.OrganizationService.DeactivateOrganization:input_type -> organizations.ChangeOrganizationStatusRequest
+	38, // 57: organizations.OrganizationService.ActivateOrganization:input_type -> organizations.ChangeOrganizationStatusRequest
+	38, // 58: organizations.OrganizationService.RestoreOrganization:input_type -> organizations.ChangeOrganizationStatusRequest
+	41, // 59: organizations.OrganizationService.GetOrganizationDependencies:input_type -> organizations.GetOrganizationDependenciesRequest
+	43, // 60: organizations.OrganizationService.PurgeOrganization:input_type -> organizations.PurgeOrganizationRequest
+	22, // 61: organizations.OrganizationService.UploadOrganizationLogo:input_type -> organizations.UploadLogoRequest
+	25, // 62: organizations.OrganizationService.GetOrganizationTree:input_type -> organizations.GetOrganizationTreeRequest
+	27, // 63: organizations.OrganizationService.GetOrganizationAncestors:input_type -> organizations.GetOrganizationAncestorsRequest
+	29, // 64: organizations.OrganizationService.MoveOrganization:input_type -> organizations.MoveOrganizationRequest
+	33, // 65: organizations.OrganizationService.GetOrganizationSettings:input_type -> organizations.GetOrganizationSettingsRequest
+	34, // 66: organizations.OrganizationService.UpdateOrganizationSettings:input_type -> organizations.UpdateOrganizationSettingsRequest
+	33, // 67: organizations.OrganizationService.GetEffectiveOrganizationSettings:input_type -> organizations.GetOrganizationSettingsRequest
+	45, // 68: organizations.OrganizationService.StartOnboarding:input_type -> organizations.StartOnboardingRequest
+	46, // 69: organizations.OrganizationService.GetOnboardingStatus:input_type -> organizations.GetOnboardingStatusRequest
+	47, // 70: organizations.OrganizationService.ResumeOnboarding:input_type -> organizations.OnboardingActionRequest
+	47, // 71: organizations.OrganizationService.CancelOnboarding:input_type -> organizations.OnboardingActionRequest
+	9,  // 72: organizations.OrganizationService.CreateOrganization:output_type -> organizations.OrganizationResponse
+	21, // 73: organizations.OrganizationService.ListOrganizations:output_type -> organizations.ListOrganizationsResponse
+	21, // 74: organizations.OrganizationService.ListOrganizationsByTenant:output_type -> organizations.ListOrganizationsResponse
+	21, // 75: organizations.OrganizationService.ListChildOrganizations:output_type -> organizations.ListOrganizationsResponse
+	9,  // 76: organizations.OrganizationService.GetOrganization:output_type -> organizations.OrganizationResponse
+	12, // 77: organizations.OrganizationService.GetOrganizationWithProjects:output_type -> organizations.GetOrganizationWithProjectsResponse
+	9,  // 78: organizations.OrganizationService.GetOrganizationByCode:output_type -> organizations.OrganizationResponse
+	9,  // 79: organizations.OrganizationService.UpdateOrganization:output_type -> organizations.OrganizationResponse
+	15, // 80: organizations.OrganizationService.DeleteOrganization:output_type -> organizations.DeleteOrganizationResponse
+	39, // 81: organizations.OrganizationService.DeactivateOrganization:output_type -> organizations.ChangeOrganizationStatusResponse
+	39, // 82: organizations.OrganizationService.ActivateOrganization:output_type -> organizations.ChangeOrganizationStatusResponse
+	39, // 83: organizations.OrganizationService.RestoreOrganization:output_type -> organizations.ChangeOrganizationStatusResponse
+	42, // 84: organizations.OrganizationService.GetOrganizationDependencies:output_type -> organizations.GetOrganizationDependenciesResponse
+	44, // 85: organizations.OrganizationService.PurgeOrganization:output_type -> organizations.PurgeOrganizationResponse
+	23, // 86: organizations.OrganizationService.UploadOrganizationLogo:output_type -> organizations.UploadLogoResponse
+	26, // 87: organizations.OrganizationService.GetOrganizationTree:output_type -> organizations.GetOrganizationTreeResponse
+	28, // 88: organizations.OrganizationService.GetOrganizationAncestors:output_type -> organizations.GetOrganizationAncestorsResponse
+	9,  // 89: organizations.OrganizationService.MoveOrganization:output_type -> organizations.OrganizationResponse
+	35, // 90: organizations.OrganizationService.GetOrganizationSettings:output_type -> organizations.OrganizationSettingsResponse
+	35, // 91: organizations.OrganizationService.UpdateOrganizationSettings:output_type -> organizations.OrganizationSettingsResponse
+	37, // 92: organizations.OrganizationService.GetEffectiveOrganizationSettings:output_type -> organizations.EffectiveOrganizationSettingsResponse
+	50, // 93: organizations.OrganizationService.StartOnboarding:output_type -> organizations.OnboardingResponse
+	50, // 94: organizations.OrganizationService.GetOnboardingStatus:output_type -> organizations.OnboardingResponse
+	50, // 95: organizations.OrganizationService.ResumeOnboarding:output_type -> organizations.OnboardingResponse
+	50, // 96: organizations.OrganizationService.CancelOnboarding:output_type -> organizations.OnboardingResponse
+	72, // [72:97] is the sub-list for method output_type
+	47, // [47:72] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_organization_proto_init() }
@@ -1599,13 +3808,14 @@ func file_organization_proto_init() {
 	if File_organization_proto != nil {
 		return
 	}
+	file_organization_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organization_proto_rawDesc), len(file_organization_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   19,
+			NumEnums:      5,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
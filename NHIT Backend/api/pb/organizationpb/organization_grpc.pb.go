@@ -19,16 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrganizationService_CreateOrganization_FullMethodName          = "/organizations.OrganizationService/CreateOrganization"
-	OrganizationService_ListOrganizations_FullMethodName           = "/organizations.OrganizationService/ListOrganizations"
-	OrganizationService_ListOrganizationsByTenant_FullMethodName   = "/organizations.OrganizationService/ListOrganizationsByTenant"
-	OrganizationService_ListChildOrganizations_FullMethodName      = "/organizations.OrganizationService/ListChildOrganizations"
-	OrganizationService_GetOrganization_FullMethodName             = "/organizations.OrganizationService/GetOrganization"
-	OrganizationService_GetOrganizationWithProjects_FullMethodName = "/organizations.OrganizationService/GetOrganizationWithProjects"
-	OrganizationService_GetOrganizationByCode_FullMethodName       = "/organizations.OrganizationService/GetOrganizationByCode"
-	OrganizationService_UpdateOrganization_FullMethodName          = "/organizations.OrganizationService/UpdateOrganization"
-	OrganizationService_DeleteOrganization_FullMethodName          = "/organizations.OrganizationService/DeleteOrganization"
-	OrganizationService_UploadOrganizationLogo_FullMethodName      = "/organizations.OrganizationService/UploadOrganizationLogo"
+	OrganizationService_CreateOrganization_FullMethodName               = "/organizations.OrganizationService/CreateOrganization"
+	OrganizationService_ListOrganizations_FullMethodName                = "/organizations.OrganizationService/ListOrganizations"
+	OrganizationService_ListOrganizationsByTenant_FullMethodName        = "/organizations.OrganizationService/ListOrganizationsByTenant"
+	OrganizationService_ListChildOrganizations_FullMethodName           = "/organizations.OrganizationService/ListChildOrganizations"
+	OrganizationService_GetOrganization_FullMethodName                  = "/organizations.OrganizationService/GetOrganization"
+	OrganizationService_GetOrganizationWithProjects_FullMethodName      = "/organizations.OrganizationService/GetOrganizationWithProjects"
+	OrganizationService_GetOrganizationByCode_FullMethodName            = "/organizations.OrganizationService/GetOrganizationByCode"
+	OrganizationService_UpdateOrganization_FullMethodName               = "/organizations.OrganizationService/UpdateOrganization"
+	OrganizationService_DeleteOrganization_FullMethodName               = "/organizations.OrganizationService/DeleteOrganization"
+	OrganizationService_DeactivateOrganization_FullMethodName           = "/organizations.OrganizationService/DeactivateOrganization"
+	OrganizationService_ActivateOrganization_FullMethodName             = "/organizations.OrganizationService/ActivateOrganization"
+	OrganizationService_RestoreOrganization_FullMethodName              = "/organizations.OrganizationService/RestoreOrganization"
+	OrganizationService_GetOrganizationDependencies_FullMethodName      = "/organizations.OrganizationService/GetOrganizationDependencies"
+	OrganizationService_PurgeOrganization_FullMethodName                = "/organizations.OrganizationService/PurgeOrganization"
+	OrganizationService_UploadOrganizationLogo_FullMethodName           = "/organizations.OrganizationService/UploadOrganizationLogo"
+	OrganizationService_GetOrganizationTree_FullMethodName              = "/organizations.OrganizationService/GetOrganizationTree"
+	OrganizationService_GetOrganizationAncestors_FullMethodName         = "/organizations.OrganizationService/GetOrganizationAncestors"
+	OrganizationService_MoveOrganization_FullMethodName                 = "/organizations.OrganizationService/MoveOrganization"
+	OrganizationService_GetOrganizationSettings_FullMethodName          = "/organizations.OrganizationService/GetOrganizationSettings"
+	OrganizationService_UpdateOrganizationSettings_FullMethodName       = "/organizations.OrganizationService/UpdateOrganizationSettings"
+	OrganizationService_GetEffectiveOrganizationSettings_FullMethodName = "/organizations.OrganizationService/GetEffectiveOrganizationSettings"
+	OrganizationService_StartOnboarding_FullMethodName                  = "/organizations.OrganizationService/StartOnboarding"
+	OrganizationService_GetOnboardingStatus_FullMethodName              = "/organizations.OrganizationService/GetOnboardingStatus"
+	OrganizationService_ResumeOnboarding_FullMethodName                 = "/organizations.OrganizationService/ResumeOnboarding"
+	OrganizationService_CancelOnboarding_FullMethodName                 = "/organizations.OrganizationService/CancelOnboarding"
 )
 
 // OrganizationServiceClient is the client API for OrganizationService service.
@@ -57,8 +72,42 @@ type OrganizationServiceClient interface {
 	GetOrganizationByCode(ctx context.Context, in *GetOrganizationByCodeRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
 	UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
 	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
+	// Deactivates an organization and every active organization below it,
+	// blocking logins and new transactions in them
+	DeactivateOrganization(ctx context.Context, in *ChangeOrganizationStatusRequest, opts ...grpc.CallOption) (*ChangeOrganizationStatusResponse, error)
+	// Activates an organization and the organizations its deactivation deactivated
+	ActivateOrganization(ctx context.Context, in *ChangeOrganizationStatusRequest, opts ...grpc.CallOption) (*ChangeOrganizationStatusResponse, error)
+	// Brings back a soft deleted organization within its retention window
+	RestoreOrganization(ctx context.Context, in *ChangeOrganizationStatusRequest, opts ...grpc.CallOption) (*ChangeOrganizationStatusResponse, error)
+	// Records in this and other services that still reference an organization
+	GetOrganizationDependencies(ctx context.Context, in *GetOrganizationDependenciesRequest, opts ...grpc.CallOption) (*GetOrganizationDependenciesResponse, error)
+	// Removes a soft deleted organization for good once its retention window
+	// has passed and nothing depends on it
+	PurgeOrganization(ctx context.Context, in *PurgeOrganizationRequest, opts ...grpc.CallOption) (*PurgeOrganizationResponse, error)
 	// Upload Organization Logo
 	UploadOrganizationLogo(ctx context.Context, in *UploadLogoRequest, opts ...grpc.CallOption) (*UploadLogoResponse, error)
+	// Whole subtree below an organization, the organization itself at the root
+	GetOrganizationTree(ctx context.Context, in *GetOrganizationTreeRequest, opts ...grpc.CallOption) (*GetOrganizationTreeResponse, error)
+	// Path from the top-level organization down to the organization's parent
+	GetOrganizationAncestors(ctx context.Context, in *GetOrganizationAncestorsRequest, opts ...grpc.CallOption) (*GetOrganizationAncestorsResponse, error)
+	// Moves an organization and its subtree under another parent in the same tenant
+	MoveOrganization(ctx context.Context, in *MoveOrganizationRequest, opts ...grpc.CallOption) (*OrganizationResponse, error)
+	// Settings the organization overrides itself
+	GetOrganizationSettings(ctx context.Context, in *GetOrganizationSettingsRequest, opts ...grpc.CallOption) (*OrganizationSettingsResponse, error)
+	UpdateOrganizationSettings(ctx context.Context, in *UpdateOrganizationSettingsRequest, opts ...grpc.CallOption) (*OrganizationSettingsResponse, error)
+	// Settings in force for an organization after inheritance
+	GetEffectiveOrganizationSettings(ctx context.Context, in *GetOrganizationSettingsRequest, opts ...grpc.CallOption) (*EffectiveOrganizationSettingsResponse, error)
+	// Onboards a new customer step by step: tenant, organization, super admin
+	// role and membership, default departments, designations and projects,
+	// then approval rules
+	StartOnboarding(ctx context.Context, in *StartOnboardingRequest, opts ...grpc.CallOption) (*OnboardingResponse, error)
+	// Progress of an onboarding and each of its steps
+	GetOnboardingStatus(ctx context.Context, in *GetOnboardingStatusRequest, opts ...grpc.CallOption) (*OnboardingResponse, error)
+	// Retries a paused onboarding from its failed step, or finishes rolling
+	// back one whose rollback stopped part way
+	ResumeOnboarding(ctx context.Context, in *OnboardingActionRequest, opts ...grpc.CallOption) (*OnboardingResponse, error)
+	// Gives up a paused onboarding and rolls back its completed steps
+	CancelOnboarding(ctx context.Context, in *OnboardingActionRequest, opts ...grpc.CallOption) (*OnboardingResponse, error)
 }
 
 type organizationServiceClient struct {
@@ -159,6 +208,56 @@ func (c *organizationServiceClient) DeleteOrganization(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *organizationServiceClient) DeactivateOrganization(ctx context.Context, in *ChangeOrganizationStatusRequest, opts ...grpc.CallOption) (*ChangeOrganizationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeOrganizationStatusResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_DeactivateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ActivateOrganization(ctx context.Context, in *ChangeOrganizationStatusRequest, opts ...grpc.CallOption) (*ChangeOrganizationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeOrganizationStatusResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ActivateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RestoreOrganization(ctx context.Context, in *ChangeOrganizationStatusRequest, opts ...grpc.CallOption) (*ChangeOrganizationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeOrganizationStatusResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_RestoreOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetOrganizationDependencies(ctx context.Context, in *GetOrganizationDependenciesRequest, opts ...grpc.CallOption) (*GetOrganizationDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationDependenciesResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrganizationDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) PurgeOrganization(ctx context.Context, in *PurgeOrganizationRequest, opts ...grpc.CallOption) (*PurgeOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeOrganizationResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_PurgeOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationServiceClient) UploadOrganizationLogo(ctx context.Context, in *UploadLogoRequest, opts ...grpc.CallOption) (*UploadLogoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadLogoResponse)
@@ -169,6 +268,106 @@ func (c *organizationServiceClient) UploadOrganizationLogo(ctx context.Context,
 	return out, nil
 }
 
+func (c *organizationServiceClient) GetOrganizationTree(ctx context.Context, in *GetOrganizationTreeRequest, opts ...grpc.CallOption) (*GetOrganizationTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationTreeResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrganizationTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetOrganizationAncestors(ctx context.Context, in *GetOrganizationAncestorsRequest, opts ...grpc.CallOption) (*GetOrganizationAncestorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationAncestorsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrganizationAncestors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) MoveOrganization(ctx context.Context, in *MoveOrganizationRequest, opts ...grpc.CallOption) (*OrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_MoveOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetOrganizationSettings(ctx context.Context, in *GetOrganizationSettingsRequest, opts ...grpc.CallOption) (*OrganizationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationSettingsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrganizationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateOrganizationSettings(ctx context.Context, in *UpdateOrganizationSettingsRequest, opts ...grpc.CallOption) (*OrganizationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationSettingsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_UpdateOrganizationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetEffectiveOrganizationSettings(ctx context.Context, in *GetOrganizationSettingsRequest, opts ...grpc.CallOption) (*EffectiveOrganizationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EffectiveOrganizationSettingsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetEffectiveOrganizationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) StartOnboarding(ctx context.Context, in *StartOnboardingRequest, opts ...grpc.CallOption) (*OnboardingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnboardingResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_StartOnboarding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetOnboardingStatus(ctx context.Context, in *GetOnboardingStatusRequest, opts ...grpc.CallOption) (*OnboardingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnboardingResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOnboardingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ResumeOnboarding(ctx context.Context, in *OnboardingActionRequest, opts ...grpc.CallOption) (*OnboardingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnboardingResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ResumeOnboarding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CancelOnboarding(ctx context.Context, in *OnboardingActionRequest, opts ...grpc.CallOption) (*OnboardingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OnboardingResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_CancelOnboarding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationServiceServer is the server API for OrganizationService service.
 // All implementations must embed UnimplementedOrganizationServiceServer
 // for forward compatibility.
@@ -195,8 +394,42 @@ type OrganizationServiceServer interface {
 	GetOrganizationByCode(context.Context, *GetOrganizationByCodeRequest) (*OrganizationResponse, error)
 	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*OrganizationResponse, error)
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
+	// Deactivates an organization and every active organization below it,
+	// blocking logins and new transactions in them
+	DeactivateOrganization(context.Context, *ChangeOrganizationStatusRequest) (*ChangeOrganizationStatusResponse, error)
+	// Activates an organization and the organizations its deactivation deactivated
+	ActivateOrganization(context.Context, *ChangeOrganizationStatusRequest) (*ChangeOrganizationStatusResponse, error)
+	// Brings back a soft deleted organization within its retention window
+	RestoreOrganization(context.Context, *ChangeOrganizationStatusRequest) (*ChangeOrganizationStatusResponse, error)
+	// Records in this and other services that still reference an organization
+	GetOrganizationDependencies(context.Context, *GetOrganizationDependenciesRequest) (*GetOrganizationDependenciesResponse, error)
+	// Removes a soft deleted organization for good once its retention window
+	// has passed and nothing depends on it
+	PurgeOrganization(context.Context, *PurgeOrganizationRequest) (*PurgeOrganizationResponse, error)
 	// Upload Organization Logo
 	UploadOrganizationLogo(context.Context, *UploadLogoRequest) (*UploadLogoResponse, error)
+	// Whole subtree below an organization, the organization itself at the root
+	GetOrganizationTree(context.Context, *GetOrganizationTreeRequest) (*GetOrganizationTreeResponse, error)
+	// Path from the top-level organization down to the organization's parent
+	GetOrganizationAncestors(context.Context, *GetOrganizationAncestorsRequest) (*GetOrganizationAncestorsResponse, error)
+	// Moves an organization and its subtree under another parent in the same tenant
+	MoveOrganization(context.Context, *MoveOrganizationRequest) (*OrganizationResponse, error)
+	// Settings the organization overrides itself
+	GetOrganizationSettings(context.Context, *GetOrganizationSettingsRequest) (*OrganizationSettingsResponse, error)
+	UpdateOrganizationSettings(context.Context, *UpdateOrganizationSettingsRequest) (*OrganizationSettingsResponse, error)
+	// Settings in force for an organization after inheritance
+	GetEffectiveOrganizationSettings(context.Context, *GetOrganizationSettingsRequest) (*EffectiveOrganizationSettingsResponse, error)
+	// Onboards a new customer step by step: tenant, organization, super admin
+	// role and membership, default departments, designations and projects,
+	// then approval rules
+	StartOnboarding(context.Context, *StartOnboardingRequest) (*OnboardingResponse, error)
+	// Progress of an onboarding and each of its steps
+	GetOnboardingStatus(context.Context, *GetOnboardingStatusRequest) (*OnboardingResponse, error)
+	// Retries a paused onboarding from its failed step, or finishes rolling
+	// back one whose rollback stopped part way
+	ResumeOnboarding(context.Context, *OnboardingActionRequest) (*OnboardingResponse, error)
+	// Gives up a paused onboarding and rolls back its completed steps
+	CancelOnboarding(context.Context, *OnboardingActionRequest) (*OnboardingResponse, error)
 	mustEmbedUnimplementedOrganizationServiceServer()
 }
 
@@ -234,9 +467,54 @@ func (UnimplementedOrganizationServiceServer) UpdateOrganization(context.Context
 func (UnimplementedOrganizationServiceServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganization not implemented")
 }
+func (UnimplementedOrganizationServiceServer) DeactivateOrganization(context.Context, *ChangeOrganizationStatusRequest) (*ChangeOrganizationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ActivateOrganization(context.Context, *ChangeOrganizationStatusRequest) (*ChangeOrganizationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RestoreOrganization(context.Context, *ChangeOrganizationStatusRequest) (*ChangeOrganizationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetOrganizationDependencies(context.Context, *GetOrganizationDependenciesRequest) (*GetOrganizationDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationDependencies not implemented")
+}
+func (UnimplementedOrganizationServiceServer) PurgeOrganization(context.Context, *PurgeOrganizationRequest) (*PurgeOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOrganization not implemented")
+}
 func (UnimplementedOrganizationServiceServer) UploadOrganizationLogo(context.Context, *UploadLogoRequest) (*UploadLogoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadOrganizationLogo not implemented")
 }
+func (UnimplementedOrganizationServiceServer) GetOrganizationTree(context.Context, *GetOrganizationTreeRequest) (*GetOrganizationTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationTree not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetOrganizationAncestors(context.Context, *GetOrganizationAncestorsRequest) (*GetOrganizationAncestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationAncestors not implemented")
+}
+func (UnimplementedOrganizationServiceServer) MoveOrganization(context.Context, *MoveOrganizationRequest) (*OrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetOrganizationSettings(context.Context, *GetOrganizationSettingsRequest) (*OrganizationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationSettings not implemented")
+}
+func (UnimplementedOrganizationServiceServer) UpdateOrganizationSettings(context.Context, *UpdateOrganizationSettingsRequest) (*OrganizationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationSettings not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetEffectiveOrganizationSettings(context.Context, *GetOrganizationSettingsRequest) (*EffectiveOrganizationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveOrganizationSettings not implemented")
+}
+func (UnimplementedOrganizationServiceServer) StartOnboarding(context.Context, *StartOnboardingRequest) (*OnboardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOnboarding not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetOnboardingStatus(context.Context, *GetOnboardingStatusRequest) (*OnboardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnboardingStatus not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ResumeOnboarding(context.Context, *OnboardingActionRequest) (*OnboardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeOnboarding not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CancelOnboarding(context.Context, *OnboardingActionRequest) (*OnboardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOnboarding not implemented")
+}
 func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
 func (UnimplementedOrganizationServiceServer) testEmbeddedByValue()                             {}
 
@@ -420,6 +698,96 @@ func _OrganizationService_DeleteOrganization_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationService_DeactivateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeOrganizationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeactivateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DeactivateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeactivateOrganization(ctx, req.(*ChangeOrganizationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ActivateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeOrganizationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ActivateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ActivateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ActivateOrganization(ctx, req.(*ChangeOrganizationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RestoreOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeOrganizationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RestoreOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RestoreOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RestoreOrganization(ctx, req.(*ChangeOrganizationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetOrganizationDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetOrganizationDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOrganizationDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOrganizationDependencies(ctx, req.(*GetOrganizationDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_PurgeOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).PurgeOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_PurgeOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).PurgeOrganization(ctx, req.(*PurgeOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrganizationService_UploadOrganizationLogo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadLogoRequest)
 	if err := dec(in); err != nil {
@@ -438,6 +806,186 @@ func _OrganizationService_UploadOrganizationLogo_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationService_GetOrganizationTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetOrganizationTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOrganizationTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOrganizationTree(ctx, req.(*GetOrganizationTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetOrganizationAncestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationAncestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetOrganizationAncestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOrganizationAncestors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOrganizationAncestors(ctx, req.(*GetOrganizationAncestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_MoveOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).MoveOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_MoveOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).MoveOrganization(ctx, req.(*MoveOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetOrganizationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetOrganizationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOrganizationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOrganizationSettings(ctx, req.(*GetOrganizationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateOrganizationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateOrganizationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_UpdateOrganizationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateOrganizationSettings(ctx, req.(*UpdateOrganizationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetEffectiveOrganizationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetEffectiveOrganizationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetEffectiveOrganizationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetEffectiveOrganizationSettings(ctx, req.(*GetOrganizationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_StartOnboarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOnboardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).StartOnboarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_StartOnboarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).StartOnboarding(ctx, req.(*StartOnboardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetOnboardingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnboardingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetOnboardingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOnboardingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOnboardingStatus(ctx, req.(*GetOnboardingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ResumeOnboarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnboardingActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ResumeOnboarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ResumeOnboarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ResumeOnboarding(ctx, req.(*OnboardingActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CancelOnboarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnboardingActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CancelOnboarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CancelOnboarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CancelOnboarding(ctx, req.(*OnboardingActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,10 +1029,70 @@ var OrganizationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOrganization",
 			Handler:    _OrganizationService_DeleteOrganization_Handler,
 		},
+		{
+			MethodName: "DeactivateOrganization",
+			Handler:    _OrganizationService_DeactivateOrganization_Handler,
+		},
+		{
+			MethodName: "ActivateOrganization",
+			Handler:    _OrganizationService_ActivateOrganization_Handler,
+		},
+		{
+			MethodName: "RestoreOrganization",
+			Handler:    _OrganizationService_RestoreOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganizationDependencies",
+			Handler:    _OrganizationService_GetOrganizationDependencies_Handler,
+		},
+		{
+			MethodName: "PurgeOrganization",
+			Handler:    _OrganizationService_PurgeOrganization_Handler,
+		},
 		{
 			MethodName: "UploadOrganizationLogo",
 			Handler:    _OrganizationService_UploadOrganizationLogo_Handler,
 		},
+		{
+			MethodName: "GetOrganizationTree",
+			Handler:    _OrganizationService_GetOrganizationTree_Handler,
+		},
+		{
+			MethodName: "GetOrganizationAncestors",
+			Handler:    _OrganizationService_GetOrganizationAncestors_Handler,
+		},
+		{
+			MethodName: "MoveOrganization",
+			Handler:    _OrganizationService_MoveOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganizationSettings",
+			Handler:    _OrganizationService_GetOrganizationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationSettings",
+			Handler:    _OrganizationService_UpdateOrganizationSettings_Handler,
+		},
+		{
+			MethodName: "GetEffectiveOrganizationSettings",
+			Handler:    _OrganizationService_GetEffectiveOrganizationSettings_Handler,
+		},
+		{
+			MethodName: "StartOnboarding",
+			Handler:    _OrganizationService_StartOnboarding_Handler,
+		},
+		{
+			MethodName: "GetOnboardingStatus",
+			Handler:    _OrganizationService_GetOnboardingStatus_Handler,
+		},
+		{
+			MethodName: "ResumeOnboarding",
+			Handler:    _OrganizationService_ResumeOnboarding_Handler,
+		},
+		{
+			MethodName: "CancelOnboarding",
+			Handler:    _OrganizationService_CancelOnboarding_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organization.proto",
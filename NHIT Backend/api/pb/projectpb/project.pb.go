@@ -23,18 +23,183 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Enums
+type ProjectStatus int32
+
+const (
+	ProjectStatus_PROJECT_STATUS_UNSPECIFIED ProjectStatus = 0
+	ProjectStatus_PROJECT_STATUS_ACTIVE      ProjectStatus = 1
+	ProjectStatus_PROJECT_STATUS_CLOSED      ProjectStatus = 2
+	ProjectStatus_PROJECT_STATUS_ARCHIVED    ProjectStatus = 3
+)
+
+// Enum value maps for ProjectStatus.
+var (
+	ProjectStatus_name = map[int32]string{
+		0: "PROJECT_STATUS_UNSPECIFIED",
+		1: "PROJECT_STATUS_ACTIVE",
+		2: "PROJECT_STATUS_CLOSED",
+		3: "PROJECT_STATUS_ARCHIVED",
+	}
+	ProjectStatus_value = map[string]int32{
+		"PROJECT_STATUS_UNSPECIFIED": 0,
+		"PROJECT_STATUS_ACTIVE":      1,
+		"PROJECT_STATUS_CLOSED":      2,
+		"PROJECT_STATUS_ARCHIVED":    3,
+	}
+)
+
+func (x ProjectStatus) Enum() *ProjectStatus {
+	p := new(ProjectStatus)
+	*p = x
+	return p
+}
+
+func (x ProjectStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_project_proto_enumTypes[0].Descriptor()
+}
+
+func (ProjectStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_project_proto_enumTypes[0]
+}
+
+func (x ProjectStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectStatus.Descriptor instead.
+func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{0}
+}
+
+type BudgetCommitmentStatus int32
+
+const (
+	BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_UNSPECIFIED BudgetCommitmentStatus = 0
+	BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_COMMITTED   BudgetCommitmentStatus = 1 // reserved on submission
+	BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_ACTUAL      BudgetCommitmentStatus = 2 // approved spend
+	BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_RELEASED    BudgetCommitmentStatus = 3 // rejected or cancelled
+)
+
+// Enum value maps for BudgetCommitmentStatus.
+var (
+	BudgetCommitmentStatus_name = map[int32]string{
+		0: "BUDGET_COMMITMENT_STATUS_UNSPECIFIED",
+		1: "BUDGET_COMMITMENT_STATUS_COMMITTED",
+		2: "BUDGET_COMMITMENT_STATUS_ACTUAL",
+		3: "BUDGET_COMMITMENT_STATUS_RELEASED",
+	}
+	BudgetCommitmentStatus_value = map[string]int32{
+		"BUDGET_COMMITMENT_STATUS_UNSPECIFIED": 0,
+		"BUDGET_COMMITMENT_STATUS_COMMITTED":   1,
+		"BUDGET_COMMITMENT_STATUS_ACTUAL":      2,
+		"BUDGET_COMMITMENT_STATUS_RELEASED":    3,
+	}
+)
+
+func (x BudgetCommitmentStatus) Enum() *BudgetCommitmentStatus {
+	p := new(BudgetCommitmentStatus)
+	*p = x
+	return p
+}
+
+func (x BudgetCommitmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BudgetCommitmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_project_proto_enumTypes[1].Descriptor()
+}
+
+func (BudgetCommitmentStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_project_proto_enumTypes[1]
+}
+
+func (x BudgetCommitmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BudgetCommitmentStatus.Descriptor instead.
+func (BudgetCommitmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{1}
+}
+
+type ProjectMemberRole int32
+
+const (
+	ProjectMemberRole_PROJECT_MEMBER_ROLE_UNSPECIFIED ProjectMemberRole = 0
+	ProjectMemberRole_PROJECT_MEMBER_ROLE_MANAGER     ProjectMemberRole = 1
+	ProjectMemberRole_PROJECT_MEMBER_ROLE_MEMBER      ProjectMemberRole = 2
+)
+
+// Enum value maps for ProjectMemberRole.
+var (
+	ProjectMemberRole_name = map[int32]string{
+		0: "PROJECT_MEMBER_ROLE_UNSPECIFIED",
+		1: "PROJECT_MEMBER_ROLE_MANAGER",
+		2: "PROJECT_MEMBER_ROLE_MEMBER",
+	}
+	ProjectMemberRole_value = map[string]int32{
+		"PROJECT_MEMBER_ROLE_UNSPECIFIED": 0,
+		"PROJECT_MEMBER_ROLE_MANAGER":     1,
+		"PROJECT_MEMBER_ROLE_MEMBER":      2,
+	}
+)
+
+func (x ProjectMemberRole) Enum() *ProjectMemberRole {
+	p := new(ProjectMemberRole)
+	*p = x
+	return p
+}
+
+func (x ProjectMemberRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectMemberRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_project_proto_enumTypes[2].Descriptor()
+}
+
+func (ProjectMemberRole) Type() protoreflect.EnumType {
+	return &file_api_proto_project_proto_enumTypes[2]
+}
+
+func (x ProjectMemberRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectMemberRole.Descriptor instead.
+func (ProjectMemberRole) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{2}
+}
+
 // Messages
 type Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	ProjectName   string                 `protobuf:"bytes,5,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId        string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TenantId         string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OrgId            string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ProjectName      string                 `protobuf:"bytes,5,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ProjectCode      string                 `protobuf:"bytes,9,opt,name=project_code,json=projectCode,proto3" json:"project_code,omitempty"`
+	Description      string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Status           ProjectStatus          `protobuf:"varint,11,opt,name=status,proto3,enum=project.ProjectStatus" json:"status,omitempty"`
+	StartDate        string                 `protobuf:"bytes,12,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                      // YYYY-MM-DD
+	EndDate          string                 `protobuf:"bytes,13,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                            // YYYY-MM-DD
+	SanctionedBudget string                 `protobuf:"bytes,14,opt,name=sanctioned_budget,json=sanctionedBudget,proto3" json:"sanctioned_budget,omitempty"` // decimal rupees, e.g. "1250000.00"
+	BudgetHeads      []*BudgetHead          `protobuf:"bytes,15,rep,name=budget_heads,json=budgetHeads,proto3" json:"budget_heads,omitempty"`
+	Members          []*ProjectMember       `protobuf:"bytes,16,rep,name=members,proto3" json:"members,omitempty"`
+	StatusReason     string                 `protobuf:"bytes,17,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	ClosedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -81,64 +246,1357 @@ func (x *Project) GetTenantId() string {
 	return ""
 }
 
-func (x *Project) GetOrgId() string {
-	if x != nil {
-		return x.OrgId
-	}
-	return ""
+func (x *Project) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Project) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *Project) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetProjectCode() string {
+	if x != nil {
+		return x.ProjectCode
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetStatus() ProjectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func (x *Project) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Project) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Project) GetSanctionedBudget() string {
+	if x != nil {
+		return x.SanctionedBudget
+	}
+	return ""
+}
+
+func (x *Project) GetBudgetHeads() []*BudgetHead {
+	if x != nil {
+		return x.BudgetHeads
+	}
+	return nil
+}
+
+func (x *Project) GetMembers() []*ProjectMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Project) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Project) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Project) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+// BudgetHead is the part of the sanctioned budget earmarked for an expense
+// category in a financial year; green notes are matched to it by their
+// expense category. Committed, actual and available are read-only.
+type BudgetHead struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Category         string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	SanctionedAmount string                 `protobuf:"bytes,2,opt,name=sanctioned_amount,json=sanctionedAmount,proto3" json:"sanctioned_amount,omitempty"` // decimal rupees
+	FinancialYear    string                 `protobuf:"bytes,3,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`          // e.g. "2025-26", April to March
+	CommittedAmount  string                 `protobuf:"bytes,4,opt,name=committed_amount,json=committedAmount,proto3" json:"committed_amount,omitempty"`    // reserved by documents awaiting approval
+	ActualAmount     string                 `protobuf:"bytes,5,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`             // spent by approved documents
+	AvailableAmount  string                 `protobuf:"bytes,6,opt,name=available_amount,json=availableAmount,proto3" json:"available_amount,omitempty"`    // sanctioned - committed - actual, negative when over budget
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BudgetHead) Reset() {
+	*x = BudgetHead{}
+	mi := &file_api_proto_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetHead) ProtoMessage() {}
+
+func (x *BudgetHead) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetHead.ProtoReflect.Descriptor instead.
+func (*BudgetHead) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BudgetHead) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetHead) GetSanctionedAmount() string {
+	if x != nil {
+		return x.SanctionedAmount
+	}
+	return ""
+}
+
+func (x *BudgetHead) GetFinancialYear() string {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return ""
+}
+
+func (x *BudgetHead) GetCommittedAmount() string {
+	if x != nil {
+		return x.CommittedAmount
+	}
+	return ""
+}
+
+func (x *BudgetHead) GetActualAmount() string {
+	if x != nil {
+		return x.ActualAmount
+	}
+	return ""
+}
+
+func (x *BudgetHead) GetAvailableAmount() string {
+	if x != nil {
+		return x.AvailableAmount
+	}
+	return ""
+}
+
+type BudgetCommitment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommitmentId  string                 `protobuf:"bytes,1,opt,name=commitment_id,json=commitmentId,proto3" json:"commitment_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	FinancialYear string                 `protobuf:"bytes,3,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	SourceType    string                 `protobuf:"bytes,5,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // e.g. "GREENNOTE"
+	SourceId      string                 `protobuf:"bytes,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"` // decimal rupees
+	Status        BudgetCommitmentStatus `protobuf:"varint,8,opt,name=status,proto3,enum=project.BudgetCommitmentStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetCommitment) Reset() {
+	*x = BudgetCommitment{}
+	mi := &file_api_proto_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetCommitment) ProtoMessage() {}
+
+func (x *BudgetCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetCommitment.ProtoReflect.Descriptor instead.
+func (*BudgetCommitment) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BudgetCommitment) GetCommitmentId() string {
+	if x != nil {
+		return x.CommitmentId
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetFinancialYear() string {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetStatus() BudgetCommitmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_UNSPECIFIED
+}
+
+func (x *BudgetCommitment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BudgetCommitment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BudgetCommitment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ProjectMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ProjectMemberRole      `protobuf:"varint,2,opt,name=role,proto3,enum=project.ProjectMemberRole" json:"role,omitempty"`
+	AddedBy       string                 `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectMember) Reset() {
+	*x = ProjectMember{}
+	mi := &file_api_proto_project_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectMember) ProtoMessage() {}
+
+func (x *ProjectMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
+func (*ProjectMember) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetRole() ProjectMemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectMemberRole_PROJECT_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *ProjectMember) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *ProjectMember) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+// Request/Response Messages
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ListProjectsByOrganizationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrgId           string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Page            int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status          ProjectStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=project.ProjectStatus" json:"status,omitempty"`               // filter by status
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // archived projects are skipped unless set or status is ARCHIVED
+	ProjectName     string                 `protobuf:"bytes,6,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`              // exact name match, case-insensitive
+	ProjectCode     string                 `protobuf:"bytes,7,opt,name=project_code,json=projectCode,proto3" json:"project_code,omitempty"`              // exact code match, case-insensitive
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProjectsByOrganizationRequest) Reset() {
+	*x = ListProjectsByOrganizationRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsByOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsByOrganizationRequest) ProtoMessage() {}
+
+func (x *ListProjectsByOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsByOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsByOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListProjectsByOrganizationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListProjectsByOrganizationRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProjectsByOrganizationRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectsByOrganizationRequest) GetStatus() ProjectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func (x *ListProjectsByOrganizationRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListProjectsByOrganizationRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *ListProjectsByOrganizationRequest) GetProjectCode() string {
+	if x != nil {
+		return x.ProjectCode
+	}
+	return ""
+}
+
+type PaginationMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaginationMetadata) Reset() {
+	*x = PaginationMetadata{}
+	mi := &file_api_proto_project_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationMetadata) ProtoMessage() {}
+
+func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
+func (*PaginationMetadata) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PaginationMetadata) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type ListProjectsByOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsByOrganizationResponse) Reset() {
+	*x = ListProjectsByOrganizationResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsByOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsByOrganizationResponse) ProtoMessage() {}
+
+func (x *ListProjectsByOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsByOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsByOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListProjectsByOrganizationResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ListProjectsByOrganizationResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListProjectsByOrganizationResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ProjectName   string                 `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ProjectCode   string                 `protobuf:"bytes,5,opt,name=project_code,json=projectCode,proto3" json:"project_code,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	StartDate     string                 `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD
+	EndDate       string                 `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateProjectRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetProjectCode() string {
+	if x != nil {
+		return x.ProjectCode
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type UpdateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName   *string                `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3,oneof" json:"project_name,omitempty"`
+	ProjectCode   *string                `protobuf:"bytes,3,opt,name=project_code,json=projectCode,proto3,oneof" json:"project_code,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	StartDate     *string                `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // YYYY-MM-DD, empty clears it
+	EndDate       *string                `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // YYYY-MM-DD, empty clears it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetProjectName() string {
+	if x != nil && x.ProjectName != nil {
+		return *x.ProjectName
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetProjectCode() string {
+	if x != nil && x.ProjectCode != nil {
+		return *x.ProjectCode
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type UpdateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectResponse) Reset() {
+	*x = UpdateProjectResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectResponse) ProtoMessage() {}
+
+func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ChangeProjectStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeProjectStatusRequest) Reset() {
+	*x = ChangeProjectStatusRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeProjectStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeProjectStatusRequest) ProtoMessage() {}
+
+func (x *ChangeProjectStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeProjectStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeProjectStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangeProjectStatusRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ChangeProjectStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ChangeProjectStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeProjectStatusResponse) Reset() {
+	*x = ChangeProjectStatusResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeProjectStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeProjectStatusResponse) ProtoMessage() {}
+
+func (x *ChangeProjectStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeProjectStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeProjectStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChangeProjectStatusResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type DeleteProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteProjectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetProjectBudgetRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId        string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SanctionedBudget string                 `protobuf:"bytes,2,opt,name=sanctioned_budget,json=sanctionedBudget,proto3" json:"sanctioned_budget,omitempty"` // decimal rupees, across all financial years
+	BudgetHeads      []*BudgetHead          `protobuf:"bytes,3,rep,name=budget_heads,json=budgetHeads,proto3" json:"budget_heads,omitempty"`                // replaces the heads of the financial year
+	FinancialYear    string                 `protobuf:"bytes,4,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`          // defaults to the current financial year
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetProjectBudgetRequest) Reset() {
+	*x = SetProjectBudgetRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProjectBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectBudgetRequest) ProtoMessage() {}
+
+func (x *SetProjectBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetProjectBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetProjectBudgetRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetProjectBudgetRequest) GetSanctionedBudget() string {
+	if x != nil {
+		return x.SanctionedBudget
+	}
+	return ""
+}
+
+func (x *SetProjectBudgetRequest) GetBudgetHeads() []*BudgetHead {
+	if x != nil {
+		return x.BudgetHeads
+	}
+	return nil
+}
+
+func (x *SetProjectBudgetRequest) GetFinancialYear() string {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return ""
+}
+
+type SetProjectBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProjectBudgetResponse) Reset() {
+	*x = SetProjectBudgetResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProjectBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectBudgetResponse) ProtoMessage() {}
+
+func (x *SetProjectBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetProjectBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetProjectBudgetResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type AddProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ProjectMemberRole      `protobuf:"varint,3,opt,name=role,proto3,enum=project.ProjectMemberRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProjectMemberRequest) Reset() {
+	*x = AddProjectMemberRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Project) GetProjectName() string {
+func (*AddProjectMemberRequest) ProtoMessage() {}
+
+func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[19]
 	if x != nil {
-		return x.ProjectName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Project) GetCreatedBy() string {
+// Deprecated: Use AddProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddProjectMemberRequest) GetProjectId() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AddProjectMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *AddProjectMemberRequest) GetRole() ProjectMemberRole {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Role
 	}
-	return nil
+	return ProjectMemberRole_PROJECT_MEMBER_ROLE_UNSPECIFIED
 }
 
-// Request/Response Messages
-type GetProjectRequest struct {
+type RemoveProjectMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectRequest) Reset() {
-	*x = GetProjectRequest{}
-	mi := &file_api_proto_project_proto_msgTypes[1]
+func (x *RemoveProjectMemberRequest) Reset() {
+	*x = RemoveProjectMemberRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectRequest) String() string {
+func (x *RemoveProjectMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectRequest) ProtoMessage() {}
+func (*RemoveProjectMemberRequest) ProtoMessage() {}
 
-func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_project_proto_msgTypes[1]
+func (x *RemoveProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,40 +1607,47 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
-func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_project_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RemoveProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetProjectRequest) GetProjectId() string {
+func (x *RemoveProjectMemberRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-type GetProjectResponse struct {
+func (x *RemoveProjectMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ProjectMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectResponse) Reset() {
-	*x = GetProjectResponse{}
-	mi := &file_api_proto_project_proto_msgTypes[2]
+func (x *ProjectMemberResponse) Reset() {
+	*x = ProjectMemberResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectResponse) String() string {
+func (x *ProjectMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectResponse) ProtoMessage() {}
+func (*ProjectMemberResponse) ProtoMessage() {}
 
-func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_project_proto_msgTypes[2]
+func (x *ProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,42 +1658,45 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_project_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*ProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetProjectResponse) GetProject() *Project {
+func (x *ProjectMemberResponse) GetProject() *Project {
 	if x != nil {
 		return x.Project
 	}
 	return nil
 }
 
-type ListProjectsByOrganizationRequest struct {
+type ReserveBudgetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	FinancialYear string                 `protobuf:"bytes,2,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"` // defaults to the current financial year
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	SourceType    string                 `protobuf:"bytes,4,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	SourceId      string                 `protobuf:"bytes,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"` // decimal rupees
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsByOrganizationRequest) Reset() {
-	*x = ListProjectsByOrganizationRequest{}
-	mi := &file_api_proto_project_proto_msgTypes[3]
+func (x *ReserveBudgetRequest) Reset() {
+	*x = ReserveBudgetRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsByOrganizationRequest) String() string {
+func (x *ReserveBudgetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsByOrganizationRequest) ProtoMessage() {}
+func (*ReserveBudgetRequest) ProtoMessage() {}
 
-func (x *ListProjectsByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_project_proto_msgTypes[3]
+func (x *ReserveBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,57 +1707,76 @@ func (x *ListProjectsByOrganizationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsByOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectsByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_project_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ReserveBudgetRequest.ProtoReflect.Descriptor instead.
+func (*ReserveBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListProjectsByOrganizationRequest) GetOrgId() string {
+func (x *ReserveBudgetRequest) GetProjectId() string {
 	if x != nil {
-		return x.OrgId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *ListProjectsByOrganizationRequest) GetPage() int32 {
+func (x *ReserveBudgetRequest) GetFinancialYear() string {
 	if x != nil {
-		return x.Page
+		return x.FinancialYear
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProjectsByOrganizationRequest) GetPageSize() int32 {
+func (x *ReserveBudgetRequest) GetCategory() string {
 	if x != nil {
-		return x.PageSize
+		return x.Category
 	}
-	return 0
+	return ""
 }
 
-type PaginationMetadata struct {
+func (x *ReserveBudgetRequest) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *ReserveBudgetRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ReserveBudgetRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ConvertBudgetCommitmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	SourceType    string                 `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMetadata) Reset() {
-	*x = PaginationMetadata{}
-	mi := &file_api_proto_project_proto_msgTypes[4]
+func (x *ConvertBudgetCommitmentRequest) Reset() {
+	*x = ConvertBudgetCommitmentRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMetadata) String() string {
+func (x *ConvertBudgetCommitmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationMetadata) ProtoMessage() {}
+func (*ConvertBudgetCommitmentRequest) ProtoMessage() {}
 
-func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_project_proto_msgTypes[4]
+func (x *ConvertBudgetCommitmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,63 +1787,110 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
-func (*PaginationMetadata) Descriptor() ([]byte, []int) {
-	return file_api_proto_project_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ConvertBudgetCommitmentRequest.ProtoReflect.Descriptor instead.
+func (*ConvertBudgetCommitmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PaginationMetadata) GetCurrentPage() int32 {
+func (x *ConvertBudgetCommitmentRequest) GetSourceType() string {
 	if x != nil {
-		return x.CurrentPage
+		return x.SourceType
 	}
-	return 0
+	return ""
 }
 
-func (x *PaginationMetadata) GetPageSize() int32 {
+func (x *ConvertBudgetCommitmentRequest) GetSourceId() string {
 	if x != nil {
-		return x.PageSize
+		return x.SourceId
 	}
-	return 0
+	return ""
 }
 
-func (x *PaginationMetadata) GetTotalItems() int32 {
+type ReleaseBudgetCommitmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceType    string                 `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseBudgetCommitmentRequest) Reset() {
+	*x = ReleaseBudgetCommitmentRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseBudgetCommitmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBudgetCommitmentRequest) ProtoMessage() {}
+
+func (x *ReleaseBudgetCommitmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[24]
 	if x != nil {
-		return x.TotalItems
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PaginationMetadata) GetTotalPages() int32 {
+// Deprecated: Use ReleaseBudgetCommitmentRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseBudgetCommitmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReleaseBudgetCommitmentRequest) GetSourceType() string {
 	if x != nil {
-		return x.TotalPages
+		return x.SourceType
 	}
-	return 0
+	return ""
 }
 
-type ListProjectsByOrganizationResponse struct {
+func (x *ReleaseBudgetCommitmentRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ReleaseBudgetCommitmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BudgetCommitmentResponse carries the commitment and the position of its
+// budget head after the change
+type BudgetCommitmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Commitment    *BudgetCommitment      `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Position      *BudgetHead            `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsByOrganizationResponse) Reset() {
-	*x = ListProjectsByOrganizationResponse{}
-	mi := &file_api_proto_project_proto_msgTypes[5]
+func (x *BudgetCommitmentResponse) Reset() {
+	*x = BudgetCommitmentResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsByOrganizationResponse) String() string {
+func (x *BudgetCommitmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsByOrganizationResponse) ProtoMessage() {}
+func (*BudgetCommitmentResponse) ProtoMessage() {}
 
-func (x *ListProjectsByOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_project_proto_msgTypes[5]
+func (x *BudgetCommitmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,57 +1901,49 @@ func (x *ListProjectsByOrganizationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsByOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectsByOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_project_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BudgetCommitmentResponse.ProtoReflect.Descriptor instead.
+func (*BudgetCommitmentResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListProjectsByOrganizationResponse) GetProjects() []*Project {
+func (x *BudgetCommitmentResponse) GetCommitment() *BudgetCommitment {
 	if x != nil {
-		return x.Projects
+		return x.Commitment
 	}
 	return nil
 }
 
-func (x *ListProjectsByOrganizationResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListProjectsByOrganizationResponse) GetPagination() *PaginationMetadata {
+func (x *BudgetCommitmentResponse) GetPosition() *BudgetHead {
 	if x != nil {
-		return x.Pagination
+		return x.Position
 	}
 	return nil
 }
 
-type CreateProjectRequest struct {
+type GetBudgetPositionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	ProjectName   string                 `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	FinancialYear string                 `protobuf:"bytes,2,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"` // defaults to the current financial year
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                                // all heads when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	mi := &file_api_proto_project_proto_msgTypes[6]
+func (x *GetBudgetPositionRequest) Reset() {
+	*x = GetBudgetPositionRequest{}
+	mi := &file_api_proto_project_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectRequest) String() string {
+func (x *GetBudgetPositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*GetBudgetPositionRequest) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_project_proto_msgTypes[6]
+func (x *GetBudgetPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,61 +1954,56 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_project_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *CreateProjectRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
+// Deprecated: Use GetBudgetPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetPositionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateProjectRequest) GetOrgId() string {
+func (x *GetBudgetPositionRequest) GetProjectId() string {
 	if x != nil {
-		return x.OrgId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *CreateProjectRequest) GetProjectName() string {
+func (x *GetBudgetPositionRequest) GetFinancialYear() string {
 	if x != nil {
-		return x.ProjectName
+		return x.FinancialYear
 	}
 	return ""
 }
 
-func (x *CreateProjectRequest) GetCreatedBy() string {
+func (x *GetBudgetPositionRequest) GetCategory() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Category
 	}
 	return ""
 }
 
-type CreateProjectResponse struct {
+type GetBudgetPositionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	FinancialYear string                 `protobuf:"bytes,2,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`
+	Heads         []*BudgetHead          `protobuf:"bytes,3,rep,name=heads,proto3" json:"heads,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
-	mi := &file_api_proto_project_proto_msgTypes[7]
+func (x *GetBudgetPositionResponse) Reset() {
+	*x = GetBudgetPositionResponse{}
+	mi := &file_api_proto_project_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectResponse) String() string {
+func (x *GetBudgetPositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
+func (*GetBudgetPositionResponse) ProtoMessage() {}
 
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_project_proto_msgTypes[7]
+func (x *GetBudgetPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_project_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,14 +2014,28 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_project_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetBudgetPositionResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetPositionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_project_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateProjectResponse) GetProject() *Project {
+func (x *GetBudgetPositionResponse) GetProjectId() string {
 	if x != nil {
-		return x.Project
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetBudgetPositionResponse) GetFinancialYear() string {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return ""
+}
+
+func (x *GetBudgetPositionResponse) GetHeads() []*BudgetHead {
+	if x != nil {
+		return x.Heads
 	}
 	return nil
 }
@@ -509,7 +2044,7 @@ var File_api_proto_project_proto protoreflect.FileDescriptor
 
 const file_api_proto_project_proto_rawDesc = "" +
 	"\n" +
-	"\x17api/proto/project.proto\x12\aproject\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x02\n" +
+	"\x17api/proto/project.proto\x12\aproject\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\x05\n" +
 	"\aProject\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1b\n" +
@@ -521,16 +2056,64 @@ const file_api_proto_project_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"2\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\fproject_code\x18\t \x01(\tR\vprojectCode\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12.\n" +
+	"\x06status\x18\v \x01(\x0e2\x16.project.ProjectStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\f \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\r \x01(\tR\aendDate\x12+\n" +
+	"\x11sanctioned_budget\x18\x0e \x01(\tR\x10sanctionedBudget\x126\n" +
+	"\fbudget_heads\x18\x0f \x03(\v2\x13.project.BudgetHeadR\vbudgetHeads\x120\n" +
+	"\amembers\x18\x10 \x03(\v2\x16.project.ProjectMemberR\amembers\x12#\n" +
+	"\rstatus_reason\x18\x11 \x01(\tR\fstatusReason\x127\n" +
+	"\tclosed_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12;\n" +
+	"\varchived_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"\xf7\x01\n" +
+	"\n" +
+	"BudgetHead\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12+\n" +
+	"\x11sanctioned_amount\x18\x02 \x01(\tR\x10sanctionedAmount\x12%\n" +
+	"\x0efinancial_year\x18\x03 \x01(\tR\rfinancialYear\x12)\n" +
+	"\x10committed_amount\x18\x04 \x01(\tR\x0fcommittedAmount\x12#\n" +
+	"\ractual_amount\x18\x05 \x01(\tR\factualAmount\x12)\n" +
+	"\x10available_amount\x18\x06 \x01(\tR\x0favailableAmount\"\xb6\x03\n" +
+	"\x10BudgetCommitment\x12#\n" +
+	"\rcommitment_id\x18\x01 \x01(\tR\fcommitmentId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12%\n" +
+	"\x0efinancial_year\x18\x03 \x01(\tR\rfinancialYear\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vsource_type\x18\x05 \x01(\tR\n" +
+	"sourceType\x12\x1b\n" +
+	"\tsource_id\x18\x06 \x01(\tR\bsourceId\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x127\n" +
+	"\x06status\x18\b \x01(\x0e2\x1f.project.BudgetCommitmentStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaa\x01\n" +
+	"\rProjectMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1a.project.ProjectMemberRoleR\x04role\x12\x19\n" +
+	"\badded_by\x18\x03 \x01(\tR\aaddedBy\x125\n" +
+	"\badded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"2\n" +
 	"\x11GetProjectRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\"@\n" +
 	"\x12GetProjectResponse\x12*\n" +
-	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject\"k\n" +
+	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject\"\x8c\x02\n" +
 	"!ListProjectsByOrganizationRequest\x12\x15\n" +
 	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x96\x01\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.project.ProjectStatusR\x06status\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived\x12!\n" +
+	"\fproject_name\x18\x06 \x01(\tR\vprojectName\x12!\n" +
+	"\fproject_code\x18\a \x01(\tR\vprojectCode\"\x96\x01\n" +
 	"\x12PaginationMetadata\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
@@ -544,20 +2127,130 @@ const file_api_proto_project_proto_rawDesc = "" +
 	"totalCount\x12;\n" +
 	"\n" +
 	"pagination\x18\x03 \x01(\v2\x1b.project.PaginationMetadataR\n" +
-	"pagination\"\x8c\x01\n" +
+	"pagination\"\x8b\x02\n" +
 	"\x14CreateProjectRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
 	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12!\n" +
 	"\fproject_name\x18\x03 \x01(\tR\vprojectName\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x04 \x01(\tR\tcreatedBy\"C\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12!\n" +
+	"\fproject_code\x18\x05 \x01(\tR\vprojectCode\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\a \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\b \x01(\tR\aendDate\"C\n" +
 	"\x15CreateProjectResponse\x12*\n" +
-	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject2\x94\x03\n" +
+	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject\"\xbe\x02\n" +
+	"\x14UpdateProjectRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12&\n" +
+	"\fproject_name\x18\x02 \x01(\tH\x00R\vprojectName\x88\x01\x01\x12&\n" +
+	"\fproject_code\x18\x03 \x01(\tH\x01R\vprojectCode\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tH\x03R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x06 \x01(\tH\x04R\aendDate\x88\x01\x01B\x0f\n" +
+	"\r_project_nameB\x0f\n" +
+	"\r_project_codeB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"C\n" +
+	"\x15UpdateProjectResponse\x12*\n" +
+	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject\"S\n" +
+	"\x1aChangeProjectStatusRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"I\n" +
+	"\x1bChangeProjectStatusResponse\x12*\n" +
+	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject\"5\n" +
+	"\x14DeleteProjectRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"1\n" +
+	"\x15DeleteProjectResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc4\x01\n" +
+	"\x17SetProjectBudgetRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12+\n" +
+	"\x11sanctioned_budget\x18\x02 \x01(\tR\x10sanctionedBudget\x126\n" +
+	"\fbudget_heads\x18\x03 \x03(\v2\x13.project.BudgetHeadR\vbudgetHeads\x12%\n" +
+	"\x0efinancial_year\x18\x04 \x01(\tR\rfinancialYear\"F\n" +
+	"\x18SetProjectBudgetResponse\x12*\n" +
+	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject\"\x81\x01\n" +
+	"\x17AddProjectMemberRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12.\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1a.project.ProjectMemberRoleR\x04role\"T\n" +
+	"\x1aRemoveProjectMemberRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"C\n" +
+	"\x15ProjectMemberResponse\x12*\n" +
+	"\aproject\x18\x01 \x01(\v2\x10.project.ProjectR\aproject\"\xce\x01\n" +
+	"\x14ReserveBudgetRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12%\n" +
+	"\x0efinancial_year\x18\x02 \x01(\tR\rfinancialYear\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vsource_type\x18\x04 \x01(\tR\n" +
+	"sourceType\x12\x1b\n" +
+	"\tsource_id\x18\x05 \x01(\tR\bsourceId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\"^\n" +
+	"\x1eConvertBudgetCommitmentRequest\x12\x1f\n" +
+	"\vsource_type\x18\x01 \x01(\tR\n" +
+	"sourceType\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\"v\n" +
+	"\x1eReleaseBudgetCommitmentRequest\x12\x1f\n" +
+	"\vsource_type\x18\x01 \x01(\tR\n" +
+	"sourceType\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x86\x01\n" +
+	"\x18BudgetCommitmentResponse\x129\n" +
+	"\n" +
+	"commitment\x18\x01 \x01(\v2\x19.project.BudgetCommitmentR\n" +
+	"commitment\x12/\n" +
+	"\bposition\x18\x02 \x01(\v2\x13.project.BudgetHeadR\bposition\"|\n" +
+	"\x18GetBudgetPositionRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12%\n" +
+	"\x0efinancial_year\x18\x02 \x01(\tR\rfinancialYear\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"\x8c\x01\n" +
+	"\x19GetBudgetPositionResponse\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12%\n" +
+	"\x0efinancial_year\x18\x02 \x01(\tR\rfinancialYear\x12)\n" +
+	"\x05heads\x18\x03 \x03(\v2\x13.project.BudgetHeadR\x05heads*\x82\x01\n" +
+	"\rProjectStatus\x12\x1e\n" +
+	"\x1aPROJECT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PROJECT_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15PROJECT_STATUS_CLOSED\x10\x02\x12\x1b\n" +
+	"\x17PROJECT_STATUS_ARCHIVED\x10\x03*\xb6\x01\n" +
+	"\x16BudgetCommitmentStatus\x12(\n" +
+	"$BUDGET_COMMITMENT_STATUS_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"BUDGET_COMMITMENT_STATUS_COMMITTED\x10\x01\x12#\n" +
+	"\x1fBUDGET_COMMITMENT_STATUS_ACTUAL\x10\x02\x12%\n" +
+	"!BUDGET_COMMITMENT_STATUS_RELEASED\x10\x03*y\n" +
+	"\x11ProjectMemberRole\x12#\n" +
+	"\x1fPROJECT_MEMBER_ROLE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bPROJECT_MEMBER_ROLE_MANAGER\x10\x01\x12\x1e\n" +
+	"\x1aPROJECT_MEMBER_ROLE_MEMBER\x10\x022\xdf\x10\n" +
 	"\x0eProjectService\x12l\n" +
 	"\n" +
 	"GetProject\x12\x1a.project.GetProjectRequest\x1a\x1b.project.GetProjectResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/projects/{project_id}\x12\xa6\x01\n" +
 	"\x1aListProjectsByOrganization\x12*.project.ListProjectsByOrganizationRequest\x1a+.project.ListProjectsByOrganizationResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/organizations/{org_id}/projects\x12k\n" +
-	"\rCreateProject\x12\x1d.project.CreateProjectRequest\x1a\x1e.project.CreateProjectResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/projectsB5Z3github.com/ShristiRnr/NHIT_Backend/api/pb/projectpbb\x06proto3"
+	"\rCreateProject\x12\x1d.project.CreateProjectRequest\x1a\x1e.project.CreateProjectResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/projects\x12x\n" +
+	"\rUpdateProject\x12\x1d.project.UpdateProjectRequest\x1a\x1e.project.UpdateProjectResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/projects/{project_id}\x12\x89\x01\n" +
+	"\fCloseProject\x12#.project.ChangeProjectStatusRequest\x1a$.project.ChangeProjectStatusResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/projects/{project_id}/close\x12\x8b\x01\n" +
+	"\rReopenProject\x12#.project.ChangeProjectStatusRequest\x1a$.project.ChangeProjectStatusResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/projects/{project_id}/reopen\x12\x8d\x01\n" +
+	"\x0eArchiveProject\x12#.project.ChangeProjectStatusRequest\x1a$.project.ChangeProjectStatusResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/projects/{project_id}/archive\x12u\n" +
+	"\rDeleteProject\x12\x1d.project.DeleteProjectRequest\x1a\x1e.project.DeleteProjectResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/projects/{project_id}\x12\x88\x01\n" +
+	"\x10SetProjectBudget\x12 .project.SetProjectBudgetRequest\x1a!.project.SetProjectBudgetResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/v1/projects/{project_id}/budget\x12\x86\x01\n" +
+	"\x10AddProjectMember\x12 .project.AddProjectMemberRequest\x1a\x1e.project.ProjectMemberResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/projects/{project_id}/members\x12\x93\x01\n" +
+	"\x13RemoveProjectMember\x12#.project.RemoveProjectMemberRequest\x1a\x1e.project.ProjectMemberResponse\"7\x82\xd3\xe4\x93\x021*//api/v1/projects/{project_id}/members/{user_id}\x12\x8e\x01\n" +
+	"\rReserveBudget\x12\x1d.project.ReserveBudgetRequest\x1a!.project.BudgetCommitmentResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/projects/{project_id}/budget/commitments\x12\xad\x01\n" +
+	"\x17ConvertBudgetCommitment\x12'.project.ConvertBudgetCommitmentRequest\x1a!.project.BudgetCommitmentResponse\"F\x82\xd3\xe4\x93\x02@:\x01*\";/api/v1/budget-commitments/{source_type}/{source_id}/actual\x12\xae\x01\n" +
+	"\x17ReleaseBudgetCommitment\x12'.project.ReleaseBudgetCommitmentRequest\x1a!.project.BudgetCommitmentResponse\"G\x82\xd3\xe4\x93\x02A:\x01*\"</api/v1/budget-commitments/{source_type}/{source_id}/release\x12\x91\x01\n" +
+	"\x11GetBudgetPosition\x12!.project.GetBudgetPositionRequest\x1a\".project.GetBudgetPositionResponse\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/projects/{project_id}/budget/positionB5Z3github.com/ShristiRnr/NHIT_Backend/api/pb/projectpbb\x06proto3"
 
 var (
 	file_api_proto_project_proto_rawDescOnce sync.Once
@@ -571,36 +2264,104 @@ func file_api_proto_project_proto_rawDescGZIP() []byte {
 	return file_api_proto_project_proto_rawDescData
 }
 
-var file_api_proto_project_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_project_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_project_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_api_proto_project_proto_goTypes = []any{
-	(*Project)(nil),                            // 0: project.Project
-	(*GetProjectRequest)(nil),                  // 1: project.GetProjectRequest
-	(*GetProjectResponse)(nil),                 // 2: project.GetProjectResponse
-	(*ListProjectsByOrganizationRequest)(nil),  // 3: project.ListProjectsByOrganizationRequest
-	(*PaginationMetadata)(nil),                 // 4: project.PaginationMetadata
-	(*ListProjectsByOrganizationResponse)(nil), // 5: project.ListProjectsByOrganizationResponse
-	(*CreateProjectRequest)(nil),               // 6: project.CreateProjectRequest
-	(*CreateProjectResponse)(nil),              // 7: project.CreateProjectResponse
-	(*timestamppb.Timestamp)(nil),              // 8: google.protobuf.Timestamp
+	(ProjectStatus)(0),                         // 0: project.ProjectStatus
+	(BudgetCommitmentStatus)(0),                // 1: project.BudgetCommitmentStatus
+	(ProjectMemberRole)(0),                     // 2: project.ProjectMemberRole
+	(*Project)(nil),                            // 3: project.Project
+	(*BudgetHead)(nil),                         // 4: project.BudgetHead
+	(*BudgetCommitment)(nil),                   // 5: project.BudgetCommitment
+	(*ProjectMember)(nil),                      // 6: project.ProjectMember
+	(*GetProjectRequest)(nil),                  // 7: project.GetProjectRequest
+	(*GetProjectResponse)(nil),                 // 8: project.GetProjectResponse
+	(*ListProjectsByOrganizationRequest)(nil),  // 9: project.ListProjectsByOrganizationRequest
+	(*PaginationMetadata)(nil),                 // 10: project.PaginationMetadata
+	(*ListProjectsByOrganizationResponse)(nil), // 11: project.ListProjectsByOrganizationResponse
+	(*CreateProjectRequest)(nil),               // 12: project.CreateProjectRequest
+	(*CreateProjectResponse)(nil),              // 13: project.CreateProjectResponse
+	(*UpdateProjectRequest)(nil),               // 14: project.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),              // 15: project.UpdateProjectResponse
+	(*ChangeProjectStatusRequest)(nil),         // 16: project.ChangeProjectStatusRequest
+	(*ChangeProjectStatusResponse)(nil),        // 17: project.ChangeProjectStatusResponse
+	(*DeleteProjectRequest)(nil),               // 18: project.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),              // 19: project.DeleteProjectResponse
+	(*SetProjectBudgetRequest)(nil),            // 20: project.SetProjectBudgetRequest
+	(*SetProjectBudgetResponse)(nil),           // 21: project.SetProjectBudgetResponse
+	(*AddProjectMemberRequest)(nil),            // 22: project.AddProjectMemberRequest
+	(*RemoveProjectMemberRequest)(nil),         // 23: project.RemoveProjectMemberRequest
+	(*ProjectMemberResponse)(nil),              // 24: project.ProjectMemberResponse
+	(*ReserveBudgetRequest)(nil),               // 25: project.ReserveBudgetRequest
+	(*ConvertBudgetCommitmentRequest)(nil),     // 26: project.ConvertBudgetCommitmentRequest
+	(*ReleaseBudgetCommitmentRequest)(nil),     // 27: project.ReleaseBudgetCommitmentRequest
+	(*BudgetCommitmentResponse)(nil),           // 28: project.BudgetCommitmentResponse
+	(*GetBudgetPositionRequest)(nil),           // 29: project.GetBudgetPositionRequest
+	(*GetBudgetPositionResponse)(nil),          // 30: project.GetBudgetPositionResponse
+	(*timestamppb.Timestamp)(nil),              // 31: google.protobuf.Timestamp
 }
 var file_api_proto_project_proto_depIdxs = []int32{
-	8, // 0: project.Project.created_at:type_name -> google.protobuf.Timestamp
-	8, // 1: project.Project.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: project.GetProjectResponse.project:type_name -> project.Project
-	0, // 3: project.ListProjectsByOrganizationResponse.projects:type_name -> project.Project
-	4, // 4: project.ListProjectsByOrganizationResponse.pagination:type_name -> project.PaginationMetadata
-	0, // 5: project.CreateProjectResponse.project:type_name -> project.Project
-	1, // 6: project.ProjectService.GetProject:input_type -> project.GetProjectRequest
-	3, // 7: project.ProjectService.ListProjectsByOrganization:input_type -> project.ListProjectsByOrganizationRequest
-	6, // 8: project.ProjectService.CreateProject:input_type -> project.CreateProjectRequest
-	2, // 9: project.ProjectService.GetProject:output_type -> project.GetProjectResponse
-	5, // 10: project.ProjectService.ListProjectsByOrganization:output_type -> project.ListProjectsByOrganizationResponse
-	7, // 11: project.ProjectService.CreateProject:output_type -> project.CreateProjectResponse
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	31, // 0: project.Project.created_at:type_name -> google.protobuf.Timestamp
+	31, // 1: project.Project.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: project.Project.status:type_name -> project.ProjectStatus
+	4,  // 3: project.Project.budget_heads:type_name -> project.BudgetHead
+	6,  // 4: project.Project.members:type_name -> project.ProjectMember
+	31, // 5: project.Project.closed_at:type_name -> google.protobuf.Timestamp
+	31, // 6: project.Project.archived_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: project.BudgetCommitment.status:type_name -> project.BudgetCommitmentStatus
+	31, // 8: project.BudgetCommitment.created_at:type_name -> google.protobuf.Timestamp
+	31, // 9: project.BudgetCommitment.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 10: project.ProjectMember.role:type_name -> project.ProjectMemberRole
+	31, // 11: project.ProjectMember.added_at:type_name -> google.protobuf.Timestamp
+	3,  // 12: project.GetProjectResponse.project:type_name -> project.Project
+	0,  // 13: project.ListProjectsByOrganizationRequest.status:type_name -> project.ProjectStatus
+	3,  // 14: project.ListProjectsByOrganizationResponse.projects:type_name -> project.Project
+	10, // 15: project.ListProjectsByOrganizationResponse.pagination:type_name -> project.PaginationMetadata
+	3,  // 16: project.CreateProjectResponse.project:type_name -> project.Project
+	3,  // 17: project.UpdateProjectResponse.project:type_name -> project.Project
+	3,  // 18: project.ChangeProjectStatusResponse.project:type_name -> project.Project
+	4,  // 19: project.SetProjectBudgetRequest.budget_heads:type_name -> project.BudgetHead
+	3,  // 20: project.SetProjectBudgetResponse.project:type_name -> project.Project
+	2,  // 21: project.AddProjectMemberRequest.role:type_name -> project.ProjectMemberRole
+	3,  // 22: project.ProjectMemberResponse.project:type_name -> project.Project
+	5,  // 23: project.BudgetCommitmentResponse.commitment:type_name -> project.BudgetCommitment
+	4,  // 24: project.BudgetCommitmentResponse.position:type_name -> project.BudgetHead
+	4,  // 25: project.GetBudgetPositionResponse.heads:type_name -> project.BudgetHead
+	7,  // 26: project.ProjectService.GetProject:input_type -> project.GetProjectRequest
+	9,  // 27: project.ProjectService.ListProjectsByOrganization:input_type -> project.ListProjectsByOrganizationRequest
+	12, // 28: project.ProjectService.CreateProject:input_type -> project.CreateProjectRequest
+	14, // 29: project.ProjectService.UpdateProject:input_type -> project.UpdateProjectRequest
+	16, // 30: project.ProjectService.CloseProject:input_type -> project.ChangeProjectStatusRequest
+	16, // 31: project.ProjectService.ReopenProject:input_type -> project.ChangeProjectStatusRequest
+	16, // 32: project.ProjectService.ArchiveProject:input_type -> project.ChangeProjectStatusRequest
+	18, // 33: project.ProjectService.DeleteProject:input_type -> project.DeleteProjectRequest
+	20, // 34: project.ProjectService.SetProjectBudget:input_type -> project.SetProjectBudgetRequest
+	22, // 35: project.ProjectService.AddProjectMember:input_type -> project.AddProjectMemberRequest
+	23, // 36: project.ProjectService.RemoveProjectMember:input_type -> project.RemoveProjectMemberRequest
+	25, // 37: project.ProjectService.ReserveBudget:input_type -> project.ReserveBudgetRequest
+	26, // 38: project.ProjectService.ConvertBudgetCommitment:input_type -> project.ConvertBudgetCommitmentRequest
+	27, // 39: project.ProjectService.ReleaseBudgetCommitment:input_type -> project.ReleaseBudgetCommitmentRequest
+	29, // 40: project.ProjectService.GetBudgetPosition:input_type -> project.GetBudgetPositionRequest
+	8,  // 41: project.ProjectService.GetProject:output_type -> project.GetProjectResponse
+	11, // 42: project.ProjectService.ListProjectsByOrganization:output_type -> project.ListProjectsByOrganizationResponse
+	13, // 43: project.ProjectService.CreateProject:output_type -> project.CreateProjectResponse
+	15, // 44: project.ProjectService.UpdateProject:output_type -> project.UpdateProjectResponse
+	17, // 45: project.ProjectService.CloseProject:output_type -> project.ChangeProjectStatusResponse
+	17, // 46: project.ProjectService.ReopenProject:output_type -> project.ChangeProjectStatusResponse
+	17, // 47: project.ProjectService.ArchiveProject:output_type -> project.ChangeProjectStatusResponse
+	19, // 48: project.ProjectService.DeleteProject:output_type -> project.DeleteProjectResponse
+	21, // 49: project.ProjectService.SetProjectBudget:output_type -> project.SetProjectBudgetResponse
+	24, // 50: project.ProjectService.AddProjectMember:output_type -> project.ProjectMemberResponse
+	24, // 51: project.ProjectService.RemoveProjectMember:output_type -> project.ProjectMemberResponse
+	28, // 52: project.ProjectService.ReserveBudget:output_type -> project.BudgetCommitmentResponse
+	28, // 53: project.ProjectService.ConvertBudgetCommitment:output_type -> project.BudgetCommitmentResponse
+	28, // 54: project.ProjectService.ReleaseBudgetCommitment:output_type -> project.BudgetCommitmentResponse
+	30, // 55: project.ProjectService.GetBudgetPosition:output_type -> project.GetBudgetPositionResponse
+	41, // [41:56] is the sub-list for method output_type
+	26, // [26:41] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_project_proto_init() }
@@ -608,18 +2369,20 @@ func file_api_proto_project_proto_init() {
 	if File_api_proto_project_proto != nil {
 		return
 	}
+	file_api_proto_project_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_project_proto_rawDesc), len(file_api_proto_project_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_proto_project_proto_goTypes,
 		DependencyIndexes: file_api_proto_project_proto_depIdxs,
+		EnumInfos:         file_api_proto_project_proto_enumTypes,
 		MessageInfos:      file_api_proto_project_proto_msgTypes,
 	}.Build()
 	File_api_proto_project_proto = out.File
@@ -22,6 +22,18 @@ const (
 	ProjectService_GetProject_FullMethodName                 = "/project.ProjectService/GetProject"
 	ProjectService_ListProjectsByOrganization_FullMethodName = "/project.ProjectService/ListProjectsByOrganization"
 	ProjectService_CreateProject_FullMethodName              = "/project.ProjectService/CreateProject"
+	ProjectService_UpdateProject_FullMethodName              = "/project.ProjectService/UpdateProject"
+	ProjectService_CloseProject_FullMethodName               = "/project.ProjectService/CloseProject"
+	ProjectService_ReopenProject_FullMethodName              = "/project.ProjectService/ReopenProject"
+	ProjectService_ArchiveProject_FullMethodName             = "/project.ProjectService/ArchiveProject"
+	ProjectService_DeleteProject_FullMethodName              = "/project.ProjectService/DeleteProject"
+	ProjectService_SetProjectBudget_FullMethodName           = "/project.ProjectService/SetProjectBudget"
+	ProjectService_AddProjectMember_FullMethodName           = "/project.ProjectService/AddProjectMember"
+	ProjectService_RemoveProjectMember_FullMethodName        = "/project.ProjectService/RemoveProjectMember"
+	ProjectService_ReserveBudget_FullMethodName              = "/project.ProjectService/ReserveBudget"
+	ProjectService_ConvertBudgetCommitment_FullMethodName    = "/project.ProjectService/ConvertBudgetCommitment"
+	ProjectService_ReleaseBudgetCommitment_FullMethodName    = "/project.ProjectService/ReleaseBudgetCommitment"
+	ProjectService_GetBudgetPosition_FullMethodName          = "/project.ProjectService/GetBudgetPosition"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -36,6 +48,32 @@ type ProjectServiceClient interface {
 	ListProjectsByOrganization(ctx context.Context, in *ListProjectsByOrganizationRequest, opts ...grpc.CallOption) (*ListProjectsByOrganizationResponse, error)
 	// Create project synchronously
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	// Update project details (name, code, description, dates)
+	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
+	// Close a project; closed projects accept no new green notes
+	CloseProject(ctx context.Context, in *ChangeProjectStatusRequest, opts ...grpc.CallOption) (*ChangeProjectStatusResponse, error)
+	// Reopen a closed project
+	ReopenProject(ctx context.Context, in *ChangeProjectStatusRequest, opts ...grpc.CallOption) (*ChangeProjectStatusResponse, error)
+	// Archive a closed project; archived projects are hidden from listings by default
+	ArchiveProject(ctx context.Context, in *ChangeProjectStatusRequest, opts ...grpc.CallOption) (*ChangeProjectStatusResponse, error)
+	// Delete a project that never committed budget; projects with ledger
+	// entries are archived instead
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	// Set the sanctioned budget and the category heads of one financial year
+	SetProjectBudget(ctx context.Context, in *SetProjectBudgetRequest, opts ...grpc.CallOption) (*SetProjectBudgetResponse, error)
+	// Add a manager or member to a project, or change their role
+	AddProjectMember(ctx context.Context, in *AddProjectMemberRequest, opts ...grpc.CallOption) (*ProjectMemberResponse, error)
+	// Remove a manager or member from a project
+	RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*ProjectMemberResponse, error)
+	// Reserve budget for a submitted document (e.g. a green note) against a
+	// budget head; resubmitting the same document updates its reservation
+	ReserveBudget(ctx context.Context, in *ReserveBudgetRequest, opts ...grpc.CallOption) (*BudgetCommitmentResponse, error)
+	// Turn a document's reservation into actual spend once it is approved
+	ConvertBudgetCommitment(ctx context.Context, in *ConvertBudgetCommitmentRequest, opts ...grpc.CallOption) (*BudgetCommitmentResponse, error)
+	// Release a document's reservation or spend when it is rejected or cancelled
+	ReleaseBudgetCommitment(ctx context.Context, in *ReleaseBudgetCommitmentRequest, opts ...grpc.CallOption) (*BudgetCommitmentResponse, error)
+	// Sanctioned, committed and actual amounts per budget head of a financial year
+	GetBudgetPosition(ctx context.Context, in *GetBudgetPositionRequest, opts ...grpc.CallOption) (*GetBudgetPositionResponse, error)
 }
 
 type projectServiceClient struct {
@@ -76,6 +114,126 @@ func (c *projectServiceClient) CreateProject(ctx context.Context, in *CreateProj
 	return out, nil
 }
 
+func (c *projectServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_UpdateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CloseProject(ctx context.Context, in *ChangeProjectStatusRequest, opts ...grpc.CallOption) (*ChangeProjectStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeProjectStatusResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CloseProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ReopenProject(ctx context.Context, in *ChangeProjectStatusRequest, opts ...grpc.CallOption) (*ChangeProjectStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeProjectStatusResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ReopenProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ArchiveProject(ctx context.Context, in *ChangeProjectStatusRequest, opts ...grpc.CallOption) (*ChangeProjectStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeProjectStatusResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ArchiveProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) SetProjectBudget(ctx context.Context, in *SetProjectBudgetRequest, opts ...grpc.CallOption) (*SetProjectBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProjectBudgetResponse)
+	err := c.cc.Invoke(ctx, ProjectService_SetProjectBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) AddProjectMember(ctx context.Context, in *AddProjectMemberRequest, opts ...grpc.CallOption) (*ProjectMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectMemberResponse)
+	err := c.cc.Invoke(ctx, ProjectService_AddProjectMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*ProjectMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectMemberResponse)
+	err := c.cc.Invoke(ctx, ProjectService_RemoveProjectMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ReserveBudget(ctx context.Context, in *ReserveBudgetRequest, opts ...grpc.CallOption) (*BudgetCommitmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetCommitmentResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ReserveBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ConvertBudgetCommitment(ctx context.Context, in *ConvertBudgetCommitmentRequest, opts ...grpc.CallOption) (*BudgetCommitmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetCommitmentResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ConvertBudgetCommitment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ReleaseBudgetCommitment(ctx context.Context, in *ReleaseBudgetCommitmentRequest, opts ...grpc.CallOption) (*BudgetCommitmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetCommitmentResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ReleaseBudgetCommitment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetBudgetPosition(ctx context.Context, in *GetBudgetPositionRequest, opts ...grpc.CallOption) (*GetBudgetPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBudgetPositionResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetBudgetPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility.
@@ -88,6 +246,32 @@ type ProjectServiceServer interface {
 	ListProjectsByOrganization(context.Context, *ListProjectsByOrganizationRequest) (*ListProjectsByOrganizationResponse, error)
 	// Create project synchronously
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// Update project details (name, code, description, dates)
+	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	// Close a project; closed projects accept no new green notes
+	CloseProject(context.Context, *ChangeProjectStatusRequest) (*ChangeProjectStatusResponse, error)
+	// Reopen a closed project
+	ReopenProject(context.Context, *ChangeProjectStatusRequest) (*ChangeProjectStatusResponse, error)
+	// Archive a closed project; archived projects are hidden from listings by default
+	ArchiveProject(context.Context, *ChangeProjectStatusRequest) (*ChangeProjectStatusResponse, error)
+	// Delete a project that never committed budget; projects with ledger
+	// entries are archived instead
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	// Set the sanctioned budget and the category heads of one financial year
+	SetProjectBudget(context.Context, *SetProjectBudgetRequest) (*SetProjectBudgetResponse, error)
+	// Add a manager or member to a project, or change their role
+	AddProjectMember(context.Context, *AddProjectMemberRequest) (*ProjectMemberResponse, error)
+	// Remove a manager or member from a project
+	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*ProjectMemberResponse, error)
+	// Reserve budget for a submitted document (e.g. a green note) against a
+	// budget head; resubmitting the same document updates its reservation
+	ReserveBudget(context.Context, *ReserveBudgetRequest) (*BudgetCommitmentResponse, error)
+	// Turn a document's reservation into actual spend once it is approved
+	ConvertBudgetCommitment(context.Context, *ConvertBudgetCommitmentRequest) (*BudgetCommitmentResponse, error)
+	// Release a document's reservation or spend when it is rejected or cancelled
+	ReleaseBudgetCommitment(context.Context, *ReleaseBudgetCommitmentRequest) (*BudgetCommitmentResponse, error)
+	// Sanctioned, committed and actual amounts per budget head of a financial year
+	GetBudgetPosition(context.Context, *GetBudgetPositionRequest) (*GetBudgetPositionResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -107,6 +291,42 @@ func (UnimplementedProjectServiceServer) ListProjectsByOrganization(context.Cont
 func (UnimplementedProjectServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
 }
+func (UnimplementedProjectServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (UnimplementedProjectServiceServer) CloseProject(context.Context, *ChangeProjectStatusRequest) (*ChangeProjectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseProject not implemented")
+}
+func (UnimplementedProjectServiceServer) ReopenProject(context.Context, *ChangeProjectStatusRequest) (*ChangeProjectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenProject not implemented")
+}
+func (UnimplementedProjectServiceServer) ArchiveProject(context.Context, *ChangeProjectStatusRequest) (*ChangeProjectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveProject not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedProjectServiceServer) SetProjectBudget(context.Context, *SetProjectBudgetRequest) (*SetProjectBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectBudget not implemented")
+}
+func (UnimplementedProjectServiceServer) AddProjectMember(context.Context, *AddProjectMemberRequest) (*ProjectMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectMember not implemented")
+}
+func (UnimplementedProjectServiceServer) RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*ProjectMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectMember not implemented")
+}
+func (UnimplementedProjectServiceServer) ReserveBudget(context.Context, *ReserveBudgetRequest) (*BudgetCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveBudget not implemented")
+}
+func (UnimplementedProjectServiceServer) ConvertBudgetCommitment(context.Context, *ConvertBudgetCommitmentRequest) (*BudgetCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertBudgetCommitment not implemented")
+}
+func (UnimplementedProjectServiceServer) ReleaseBudgetCommitment(context.Context, *ReleaseBudgetCommitmentRequest) (*BudgetCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseBudgetCommitment not implemented")
+}
+func (UnimplementedProjectServiceServer) GetBudgetPosition(context.Context, *GetBudgetPositionRequest) (*GetBudgetPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetPosition not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
 
@@ -182,6 +402,222 @@ func _ProjectService_CreateProject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CloseProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeProjectStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CloseProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CloseProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CloseProject(ctx, req.(*ChangeProjectStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ReopenProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeProjectStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ReopenProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ReopenProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ReopenProject(ctx, req.(*ChangeProjectStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ArchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeProjectStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ArchiveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ArchiveProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ArchiveProject(ctx, req.(*ChangeProjectStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_SetProjectBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).SetProjectBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_SetProjectBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).SetProjectBudget(ctx, req.(*SetProjectBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_AddProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).AddProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_AddProjectMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).AddProjectMember(ctx, req.(*AddProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RemoveProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RemoveProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_RemoveProjectMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RemoveProjectMember(ctx, req.(*RemoveProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ReserveBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ReserveBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ReserveBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ReserveBudget(ctx, req.(*ReserveBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ConvertBudgetCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertBudgetCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ConvertBudgetCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ConvertBudgetCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ConvertBudgetCommitment(ctx, req.(*ConvertBudgetCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ReleaseBudgetCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseBudgetCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ReleaseBudgetCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ReleaseBudgetCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ReleaseBudgetCommitment(ctx, req.(*ReleaseBudgetCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetBudgetPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetBudgetPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetBudgetPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetBudgetPosition(ctx, req.(*GetBudgetPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +637,54 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateProject",
 			Handler:    _ProjectService_CreateProject_Handler,
 		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _ProjectService_UpdateProject_Handler,
+		},
+		{
+			MethodName: "CloseProject",
+			Handler:    _ProjectService_CloseProject_Handler,
+		},
+		{
+			MethodName: "ReopenProject",
+			Handler:    _ProjectService_ReopenProject_Handler,
+		},
+		{
+			MethodName: "ArchiveProject",
+			Handler:    _ProjectService_ArchiveProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _ProjectService_DeleteProject_Handler,
+		},
+		{
+			MethodName: "SetProjectBudget",
+			Handler:    _ProjectService_SetProjectBudget_Handler,
+		},
+		{
+			MethodName: "AddProjectMember",
+			Handler:    _ProjectService_AddProjectMember_Handler,
+		},
+		{
+			MethodName: "RemoveProjectMember",
+			Handler:    _ProjectService_RemoveProjectMember_Handler,
+		},
+		{
+			MethodName: "ReserveBudget",
+			Handler:    _ProjectService_ReserveBudget_Handler,
+		},
+		{
+			MethodName: "ConvertBudgetCommitment",
+			Handler:    _ProjectService_ConvertBudgetCommitment_Handler,
+		},
+		{
+			MethodName: "ReleaseBudgetCommitment",
+			Handler:    _ProjectService_ReleaseBudgetCommitment_Handler,
+		},
+		{
+			MethodName: "GetBudgetPosition",
+			Handler:    _ProjectService_GetBudgetPosition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/project.proto",
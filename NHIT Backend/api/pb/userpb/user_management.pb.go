@@ -1408,8 +1408,14 @@ type UserResponse struct {
 	DepartmentName  string                 `protobuf:"bytes,8,opt,name=department_name,json=departmentName,proto3" json:"department_name,omitempty"`
 	DesignationName string                 `protobuf:"bytes,9,opt,name=designation_name,json=designationName,proto3" json:"designation_name,omitempty"`
 	IsActive        bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Banking Information (account number and IFSC are masked unless the
+	// caller holds the reveal-bank-details permission)
+	AccountHolderName string `protobuf:"bytes,11,opt,name=account_holder_name,json=accountHolderName,proto3" json:"account_holder_name,omitempty"`
+	BankName          string `protobuf:"bytes,12,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	BankAccountNumber string `protobuf:"bytes,13,opt,name=bank_account_number,json=bankAccountNumber,proto3" json:"bank_account_number,omitempty"`
+	IfscCode          string `protobuf:"bytes,14,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UserResponse) Reset() {
@@ -1512,6 +1518,34 @@ func (x *UserResponse) GetIsActive() bool {
 	return false
 }
 
+func (x *UserResponse) GetAccountHolderName() string {
+	if x != nil {
+		return x.AccountHolderName
+	}
+	return ""
+}
+
+func (x *UserResponse) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *UserResponse) GetBankAccountNumber() string {
+	if x != nil {
+		return x.BankAccountNumber
+	}
+	return ""
+}
+
+func (x *UserResponse) GetIfscCode() string {
+	if x != nil {
+		return x.IfscCode
+	}
+	return ""
+}
+
 // ====================
 // Tenant
 // ====================
@@ -1619,6 +1653,58 @@ func (x *GetTenantRequest) GetTenantId() string {
 	return ""
 }
 
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // Super Admin password
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_user_management_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 type TenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -1631,7 +1717,7 @@ type TenantResponse struct {
 
 func (x *TenantResponse) Reset() {
 	*x = TenantResponse{}
-	mi := &file_user_management_proto_msgTypes[20]
+	mi := &file_user_management_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1643,7 +1729,7 @@ func (x *TenantResponse) String() string {
 func (*TenantResponse) ProtoMessage() {}
 
 func (x *TenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[20]
+	mi := &file_user_management_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,7 +1742,7 @@ func (x *TenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantResponse.ProtoReflect.Descriptor instead.
 func (*TenantResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{20}
+	return file_user_management_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TenantResponse) GetTenantId() string {
@@ -1701,7 +1787,7 @@ type CreateUserLoginHistoryRequest struct {
 
 func (x *CreateUserLoginHistoryRequest) Reset() {
 	*x = CreateUserLoginHistoryRequest{}
-	mi := &file_user_management_proto_msgTypes[21]
+	mi := &file_user_management_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1713,7 +1799,7 @@ func (x *CreateUserLoginHistoryRequest) String() string {
 func (*CreateUserLoginHistoryRequest) ProtoMessage() {}
 
 func (x *CreateUserLoginHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[21]
+	mi := &file_user_management_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1726,7 +1812,7 @@ func (x *CreateUserLoginHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserLoginHistoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserLoginHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{21}
+	return file_user_management_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateUserLoginHistoryRequest) GetUserId() string {
@@ -1763,7 +1849,7 @@ type UserLoginHistoryResponse struct {
 
 func (x *UserLoginHistoryResponse) Reset() {
 	*x = UserLoginHistoryResponse{}
-	mi := &file_user_management_proto_msgTypes[22]
+	mi := &file_user_management_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1775,7 +1861,7 @@ func (x *UserLoginHistoryResponse) String() string {
 func (*UserLoginHistoryResponse) ProtoMessage() {}
 
 func (x *UserLoginHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[22]
+	mi := &file_user_management_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,7 +1874,7 @@ func (x *UserLoginHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLoginHistoryResponse.ProtoReflect.Descriptor instead.
 func (*UserLoginHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{22}
+	return file_user_management_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UserLoginHistoryResponse) GetHistoryId() string {
@@ -1836,7 +1922,7 @@ type ListUserLoginHistoriesRequest struct {
 
 func (x *ListUserLoginHistoriesRequest) Reset() {
 	*x = ListUserLoginHistoriesRequest{}
-	mi := &file_user_management_proto_msgTypes[23]
+	mi := &file_user_management_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1848,7 +1934,7 @@ func (x *ListUserLoginHistoriesRequest) String() string {
 func (*ListUserLoginHistoriesRequest) ProtoMessage() {}
 
 func (x *ListUserLoginHistoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[23]
+	mi := &file_user_management_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,7 +1947,7 @@ func (x *ListUserLoginHistoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserLoginHistoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListUserLoginHistoriesRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{23}
+	return file_user_management_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListUserLoginHistoriesRequest) GetUserId() string {
@@ -1888,7 +1974,7 @@ type ListUserLoginHistoriesResponse struct {
 
 func (x *ListUserLoginHistoriesResponse) Reset() {
 	*x = ListUserLoginHistoriesResponse{}
-	mi := &file_user_management_proto_msgTypes[24]
+	mi := &file_user_management_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1900,7 +1986,7 @@ func (x *ListUserLoginHistoriesResponse) String() string {
 func (*ListUserLoginHistoriesResponse) ProtoMessage() {}
 
 func (x *ListUserLoginHistoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[24]
+	mi := &file_user_management_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1913,7 +1999,7 @@ func (x *ListUserLoginHistoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserLoginHistoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListUserLoginHistoriesResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{24}
+	return file_user_management_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListUserLoginHistoriesResponse) GetHistories() []*UserLoginHistoryResponse {
@@ -1943,7 +2029,7 @@ type PageRequest struct {
 
 func (x *PageRequest) Reset() {
 	*x = PageRequest{}
-	mi := &file_user_management_proto_msgTypes[25]
+	mi := &file_user_management_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1955,7 +2041,7 @@ func (x *PageRequest) String() string {
 func (*PageRequest) ProtoMessage() {}
 
 func (x *PageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[25]
+	mi := &file_user_management_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +2054,7 @@ func (x *PageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
 func (*PageRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{25}
+	return file_user_management_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PageRequest) GetPage() int32 {
@@ -1997,7 +2083,7 @@ type PaginationMetadata struct {
 
 func (x *PaginationMetadata) Reset() {
 	*x = PaginationMetadata{}
-	mi := &file_user_management_proto_msgTypes[26]
+	mi := &file_user_management_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2009,7 +2095,7 @@ func (x *PaginationMetadata) String() string {
 func (*PaginationMetadata) ProtoMessage() {}
 
 func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[26]
+	mi := &file_user_management_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,7 +2108,7 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
 func (*PaginationMetadata) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{26}
+	return file_user_management_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PaginationMetadata) GetPage() int32 {
@@ -2067,7 +2153,7 @@ type ListUsersPaginatedRequest struct {
 
 func (x *ListUsersPaginatedRequest) Reset() {
 	*x = ListUsersPaginatedRequest{}
-	mi := &file_user_management_proto_msgTypes[27]
+	mi := &file_user_management_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2079,7 +2165,7 @@ func (x *ListUsersPaginatedRequest) String() string {
 func (*ListUsersPaginatedRequest) ProtoMessage() {}
 
 func (x *ListUsersPaginatedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[27]
+	mi := &file_user_management_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2092,7 +2178,7 @@ func (x *ListUsersPaginatedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersPaginatedRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersPaginatedRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{27}
+	return file_user_management_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ListUsersPaginatedRequest) GetTenantId() string {
@@ -2128,7 +2214,7 @@ type ListUsersPaginatedResponse struct {
 
 func (x *ListUsersPaginatedResponse) Reset() {
 	*x = ListUsersPaginatedResponse{}
-	mi := &file_user_management_proto_msgTypes[28]
+	mi := &file_user_management_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2140,7 +2226,7 @@ func (x *ListUsersPaginatedResponse) String() string {
 func (*ListUsersPaginatedResponse) ProtoMessage() {}
 
 func (x *ListUsersPaginatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[28]
+	mi := &file_user_management_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +2239,7 @@ func (x *ListUsersPaginatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersPaginatedResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersPaginatedResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{28}
+	return file_user_management_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ListUsersPaginatedResponse) GetUsers() []*User {
@@ -2193,7 +2279,7 @@ type CountUsersByTenantRequest struct {
 
 func (x *CountUsersByTenantRequest) Reset() {
 	*x = CountUsersByTenantRequest{}
-	mi := &file_user_management_proto_msgTypes[29]
+	mi := &file_user_management_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2205,7 +2291,7 @@ func (x *CountUsersByTenantRequest) String() string {
 func (*CountUsersByTenantRequest) ProtoMessage() {}
 
 func (x *CountUsersByTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[29]
+	mi := &file_user_management_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2304,7 @@ func (x *CountUsersByTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountUsersByTenantRequest.ProtoReflect.Descriptor instead.
 func (*CountUsersByTenantRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{29}
+	return file_user_management_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CountUsersByTenantRequest) GetTenantId() string {
@@ -2237,7 +2323,7 @@ type CountUsersByTenantResponse struct {
 
 func (x *CountUsersByTenantResponse) Reset() {
 	*x = CountUsersByTenantResponse{}
-	mi := &file_user_management_proto_msgTypes[30]
+	mi := &file_user_management_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2249,7 +2335,7 @@ func (x *CountUsersByTenantResponse) String() string {
 func (*CountUsersByTenantResponse) ProtoMessage() {}
 
 func (x *CountUsersByTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[30]
+	mi := &file_user_management_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,7 +2348,7 @@ func (x *CountUsersByTenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountUsersByTenantResponse.ProtoReflect.Descriptor instead.
 func (*CountUsersByTenantResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{30}
+	return file_user_management_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CountUsersByTenantResponse) GetCount() int64 {
@@ -2286,7 +2372,7 @@ type DeactivateUserRequest struct {
 
 func (x *DeactivateUserRequest) Reset() {
 	*x = DeactivateUserRequest{}
-	mi := &file_user_management_proto_msgTypes[31]
+	mi := &file_user_management_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2298,7 +2384,7 @@ func (x *DeactivateUserRequest) String() string {
 func (*DeactivateUserRequest) ProtoMessage() {}
 
 func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[31]
+	mi := &file_user_management_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,7 +2397,7 @@ func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
 func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{31}
+	return file_user_management_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeactivateUserRequest) GetUserId() string {
@@ -2345,7 +2431,7 @@ type ReactivateUserRequest struct {
 
 func (x *ReactivateUserRequest) Reset() {
 	*x = ReactivateUserRequest{}
-	mi := &file_user_management_proto_msgTypes[32]
+	mi := &file_user_management_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2357,7 +2443,7 @@ func (x *ReactivateUserRequest) String() string {
 func (*ReactivateUserRequest) ProtoMessage() {}
 
 func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[32]
+	mi := &file_user_management_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,7 +2456,7 @@ func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
 func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{32}
+	return file_user_management_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ReactivateUserRequest) GetUserId() string {
@@ -2402,7 +2488,7 @@ type ActivityLog struct {
 
 func (x *ActivityLog) Reset() {
 	*x = ActivityLog{}
-	mi := &file_user_management_proto_msgTypes[33]
+	mi := &file_user_management_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2414,7 +2500,7 @@ func (x *ActivityLog) String() string {
 func (*ActivityLog) ProtoMessage() {}
 
 func (x *ActivityLog) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[33]
+	mi := &file_user_management_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +2513,7 @@ func (x *ActivityLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityLog.ProtoReflect.Descriptor instead.
 func (*ActivityLog) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{33}
+	return file_user_management_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ActivityLog) GetId() int32 {
@@ -2468,7 +2554,7 @@ type CreateActivityLogRequest struct {
 
 func (x *CreateActivityLogRequest) Reset() {
 	*x = CreateActivityLogRequest{}
-	mi := &file_user_management_proto_msgTypes[34]
+	mi := &file_user_management_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2480,7 +2566,7 @@ func (x *CreateActivityLogRequest) String() string {
 func (*CreateActivityLogRequest) ProtoMessage() {}
 
 func (x *CreateActivityLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[34]
+	mi := &file_user_management_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2493,7 +2579,7 @@ func (x *CreateActivityLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateActivityLogRequest.ProtoReflect.Descriptor instead.
 func (*CreateActivityLogRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{34}
+	return file_user_management_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateActivityLogRequest) GetName() string {
@@ -2522,7 +2608,7 @@ type ActivityLogResponse struct {
 
 func (x *ActivityLogResponse) Reset() {
 	*x = ActivityLogResponse{}
-	mi := &file_user_management_proto_msgTypes[35]
+	mi := &file_user_management_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2534,7 +2620,7 @@ func (x *ActivityLogResponse) String() string {
 func (*ActivityLogResponse) ProtoMessage() {}
 
 func (x *ActivityLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[35]
+	mi := &file_user_management_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2547,7 +2633,7 @@ func (x *ActivityLogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityLogResponse.ProtoReflect.Descriptor instead.
 func (*ActivityLogResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{35}
+	return file_user_management_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ActivityLogResponse) GetId() int32 {
@@ -2587,7 +2673,7 @@ type ListActivityLogsRequest struct {
 
 func (x *ListActivityLogsRequest) Reset() {
 	*x = ListActivityLogsRequest{}
-	mi := &file_user_management_proto_msgTypes[36]
+	mi := &file_user_management_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2599,7 +2685,7 @@ func (x *ListActivityLogsRequest) String() string {
 func (*ListActivityLogsRequest) ProtoMessage() {}
 
 func (x *ListActivityLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[36]
+	mi := &file_user_management_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2612,7 +2698,7 @@ func (x *ListActivityLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivityLogsRequest.ProtoReflect.Descriptor instead.
 func (*ListActivityLogsRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{36}
+	return file_user_management_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListActivityLogsRequest) GetPage() *PageRequest {
@@ -2632,7 +2718,7 @@ type ListActivityLogsResponse struct {
 
 func (x *ListActivityLogsResponse) Reset() {
 	*x = ListActivityLogsResponse{}
-	mi := &file_user_management_proto_msgTypes[37]
+	mi := &file_user_management_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2644,7 +2730,7 @@ func (x *ListActivityLogsResponse) String() string {
 func (*ListActivityLogsResponse) ProtoMessage() {}
 
 func (x *ListActivityLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[37]
+	mi := &file_user_management_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2657,7 +2743,7 @@ func (x *ListActivityLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivityLogsResponse.ProtoReflect.Descriptor instead.
 func (*ListActivityLogsResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{37}
+	return file_user_management_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListActivityLogsResponse) GetLogs() []*ActivityLog {
@@ -2693,7 +2779,7 @@ type Notification struct {
 
 func (x *Notification) Reset() {
 	*x = Notification{}
-	mi := &file_user_management_proto_msgTypes[38]
+	mi := &file_user_management_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2705,7 +2791,7 @@ func (x *Notification) String() string {
 func (*Notification) ProtoMessage() {}
 
 func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[38]
+	mi := &file_user_management_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2718,7 +2804,7 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Notification.ProtoReflect.Descriptor instead.
 func (*Notification) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{38}
+	return file_user_management_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *Notification) GetNotificationId() string {
@@ -2789,7 +2875,7 @@ type CreateNotificationRequest struct {
 
 func (x *CreateNotificationRequest) Reset() {
 	*x = CreateNotificationRequest{}
-	mi := &file_user_management_proto_msgTypes[39]
+	mi := &file_user_management_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2801,7 +2887,7 @@ func (x *CreateNotificationRequest) String() string {
 func (*CreateNotificationRequest) ProtoMessage() {}
 
 func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[39]
+	mi := &file_user_management_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2814,7 +2900,7 @@ func (x *CreateNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNotificationRequest.ProtoReflect.Descriptor instead.
 func (*CreateNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{39}
+	return file_user_management_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CreateNotificationRequest) GetRecipientId() string {
@@ -2860,7 +2946,7 @@ type NotificationResponse struct {
 
 func (x *NotificationResponse) Reset() {
 	*x = NotificationResponse{}
-	mi := &file_user_management_proto_msgTypes[40]
+	mi := &file_user_management_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2872,7 +2958,7 @@ func (x *NotificationResponse) String() string {
 func (*NotificationResponse) ProtoMessage() {}
 
 func (x *NotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[40]
+	mi := &file_user_management_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2885,7 +2971,7 @@ func (x *NotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationResponse.ProtoReflect.Descriptor instead.
 func (*NotificationResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{40}
+	return file_user_management_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *NotificationResponse) GetNotificationId() string {
@@ -2948,7 +3034,7 @@ type ListNotificationsRequest struct {
 
 func (x *ListNotificationsRequest) Reset() {
 	*x = ListNotificationsRequest{}
-	mi := &file_user_management_proto_msgTypes[41]
+	mi := &file_user_management_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2960,7 +3046,7 @@ func (x *ListNotificationsRequest) String() string {
 func (*ListNotificationsRequest) ProtoMessage() {}
 
 func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[41]
+	mi := &file_user_management_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2973,7 +3059,7 @@ func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{41}
+	return file_user_management_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListNotificationsRequest) GetUserId() string {
@@ -3007,7 +3093,7 @@ type ListNotificationsResponse struct {
 
 func (x *ListNotificationsResponse) Reset() {
 	*x = ListNotificationsResponse{}
-	mi := &file_user_management_proto_msgTypes[42]
+	mi := &file_user_management_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3019,7 +3105,7 @@ func (x *ListNotificationsResponse) String() string {
 func (*ListNotificationsResponse) ProtoMessage() {}
 
 func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[42]
+	mi := &file_user_management_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,7 +3118,7 @@ func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{42}
+	return file_user_management_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListNotificationsResponse) GetNotifications() []*Notification {
@@ -3058,7 +3144,7 @@ type MarkNotificationAsReadRequest struct {
 
 func (x *MarkNotificationAsReadRequest) Reset() {
 	*x = MarkNotificationAsReadRequest{}
-	mi := &file_user_management_proto_msgTypes[43]
+	mi := &file_user_management_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3070,7 +3156,7 @@ func (x *MarkNotificationAsReadRequest) String() string {
 func (*MarkNotificationAsReadRequest) ProtoMessage() {}
 
 func (x *MarkNotificationAsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[43]
+	mi := &file_user_management_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3083,7 +3169,7 @@ func (x *MarkNotificationAsReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkNotificationAsReadRequest.ProtoReflect.Descriptor instead.
 func (*MarkNotificationAsReadRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{43}
+	return file_user_management_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *MarkNotificationAsReadRequest) GetNotificationId() string {
@@ -3109,7 +3195,7 @@ type ListRolesByOrganizationRequest struct {
 
 func (x *ListRolesByOrganizationRequest) Reset() {
 	*x = ListRolesByOrganizationRequest{}
-	mi := &file_user_management_proto_msgTypes[44]
+	mi := &file_user_management_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3121,7 +3207,7 @@ func (x *ListRolesByOrganizationRequest) String() string {
 func (*ListRolesByOrganizationRequest) ProtoMessage() {}
 
 func (x *ListRolesByOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[44]
+	mi := &file_user_management_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,7 +3220,7 @@ func (x *ListRolesByOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRolesByOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ListRolesByOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{44}
+	return file_user_management_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ListRolesByOrganizationRequest) GetOrgId() string {
@@ -3184,7 +3270,7 @@ type CloneRoleRequest struct {
 
 func (x *CloneRoleRequest) Reset() {
 	*x = CloneRoleRequest{}
-	mi := &file_user_management_proto_msgTypes[45]
+	mi := &file_user_management_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3196,7 +3282,7 @@ func (x *CloneRoleRequest) String() string {
 func (*CloneRoleRequest) ProtoMessage() {}
 
 func (x *CloneRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[45]
+	mi := &file_user_management_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3209,7 +3295,7 @@ func (x *CloneRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloneRoleRequest.ProtoReflect.Descriptor instead.
 func (*CloneRoleRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{45}
+	return file_user_management_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CloneRoleRequest) GetSourceRoleId() string {
@@ -3250,7 +3336,7 @@ type ListPermissionsRequest struct {
 
 func (x *ListPermissionsRequest) Reset() {
 	*x = ListPermissionsRequest{}
-	mi := &file_user_management_proto_msgTypes[46]
+	mi := &file_user_management_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3262,7 +3348,7 @@ func (x *ListPermissionsRequest) String() string {
 func (*ListPermissionsRequest) ProtoMessage() {}
 
 func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[46]
+	mi := &file_user_management_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3275,7 +3361,7 @@ func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{46}
+	return file_user_management_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListPermissionsRequest) GetModule() string {
@@ -3301,7 +3387,7 @@ type ListPermissionsResponse struct {
 
 func (x *ListPermissionsResponse) Reset() {
 	*x = ListPermissionsResponse{}
-	mi := &file_user_management_proto_msgTypes[47]
+	mi := &file_user_management_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3313,7 +3399,7 @@ func (x *ListPermissionsResponse) String() string {
 func (*ListPermissionsResponse) ProtoMessage() {}
 
 func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[47]
+	mi := &file_user_management_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3326,7 +3412,7 @@ func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{47}
+	return file_user_management_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ListPermissionsResponse) GetPermissions() []*PermissionResponse {
@@ -3345,7 +3431,7 @@ type GetPermissionsByModuleRequest struct {
 
 func (x *GetPermissionsByModuleRequest) Reset() {
 	*x = GetPermissionsByModuleRequest{}
-	mi := &file_user_management_proto_msgTypes[48]
+	mi := &file_user_management_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3357,7 +3443,7 @@ func (x *GetPermissionsByModuleRequest) String() string {
 func (*GetPermissionsByModuleRequest) ProtoMessage() {}
 
 func (x *GetPermissionsByModuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[48]
+	mi := &file_user_management_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3370,7 +3456,7 @@ func (x *GetPermissionsByModuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionsByModuleRequest.ProtoReflect.Descriptor instead.
 func (*GetPermissionsByModuleRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{48}
+	return file_user_management_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetPermissionsByModuleRequest) GetModule() string {
@@ -3393,7 +3479,7 @@ type CreateCustomPermissionRequest struct {
 
 func (x *CreateCustomPermissionRequest) Reset() {
 	*x = CreateCustomPermissionRequest{}
-	mi := &file_user_management_proto_msgTypes[49]
+	mi := &file_user_management_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3405,7 +3491,7 @@ func (x *CreateCustomPermissionRequest) String() string {
 func (*CreateCustomPermissionRequest) ProtoMessage() {}
 
 func (x *CreateCustomPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[49]
+	mi := &file_user_management_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,7 +3504,7 @@ func (x *CreateCustomPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCustomPermissionRequest.ProtoReflect.Descriptor instead.
 func (*CreateCustomPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{49}
+	return file_user_management_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CreateCustomPermissionRequest) GetName() string {
@@ -3470,7 +3556,7 @@ type PermissionResponse struct {
 
 func (x *PermissionResponse) Reset() {
 	*x = PermissionResponse{}
-	mi := &file_user_management_proto_msgTypes[50]
+	mi := &file_user_management_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3482,7 +3568,7 @@ func (x *PermissionResponse) String() string {
 func (*PermissionResponse) ProtoMessage() {}
 
 func (x *PermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[50]
+	mi := &file_user_management_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3495,7 +3581,7 @@ func (x *PermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionResponse.ProtoReflect.Descriptor instead.
 func (*PermissionResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{50}
+	return file_user_management_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *PermissionResponse) GetPermissionId() string {
@@ -3558,7 +3644,7 @@ type AddUserToOrganizationRequest struct {
 
 func (x *AddUserToOrganizationRequest) Reset() {
 	*x = AddUserToOrganizationRequest{}
-	mi := &file_user_management_proto_msgTypes[51]
+	mi := &file_user_management_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3570,7 +3656,7 @@ func (x *AddUserToOrganizationRequest) String() string {
 func (*AddUserToOrganizationRequest) ProtoMessage() {}
 
 func (x *AddUserToOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[51]
+	mi := &file_user_management_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3583,7 +3669,7 @@ func (x *AddUserToOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserToOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*AddUserToOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{51}
+	return file_user_management_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AddUserToOrganizationRequest) GetUserId() string {
@@ -3647,7 +3733,7 @@ type RemoveUserFromOrganizationRequest struct {
 
 func (x *RemoveUserFromOrganizationRequest) Reset() {
 	*x = RemoveUserFromOrganizationRequest{}
-	mi := &file_user_management_proto_msgTypes[52]
+	mi := &file_user_management_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3659,7 +3745,7 @@ func (x *RemoveUserFromOrganizationRequest) String() string {
 func (*RemoveUserFromOrganizationRequest) ProtoMessage() {}
 
 func (x *RemoveUserFromOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[52]
+	mi := &file_user_management_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3672,7 +3758,7 @@ func (x *RemoveUserFromOrganizationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RemoveUserFromOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*RemoveUserFromOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{52}
+	return file_user_management_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *RemoveUserFromOrganizationRequest) GetUserId() string {
@@ -3712,7 +3798,7 @@ type ListUserOrganizationsRequest struct {
 
 func (x *ListUserOrganizationsRequest) Reset() {
 	*x = ListUserOrganizationsRequest{}
-	mi := &file_user_management_proto_msgTypes[53]
+	mi := &file_user_management_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3724,7 +3810,7 @@ func (x *ListUserOrganizationsRequest) String() string {
 func (*ListUserOrganizationsRequest) ProtoMessage() {}
 
 func (x *ListUserOrganizationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[53]
+	mi := &file_user_management_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3737,7 +3823,7 @@ func (x *ListUserOrganizationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserOrganizationsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserOrganizationsRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{53}
+	return file_user_management_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ListUserOrganizationsRequest) GetUserId() string {
@@ -3756,7 +3842,7 @@ type ListUserOrganizationsResponse struct {
 
 func (x *ListUserOrganizationsResponse) Reset() {
 	*x = ListUserOrganizationsResponse{}
-	mi := &file_user_management_proto_msgTypes[54]
+	mi := &file_user_management_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3768,58 +3854,1786 @@ func (x *ListUserOrganizationsResponse) String() string {
 func (*ListUserOrganizationsResponse) ProtoMessage() {}
 
 func (x *ListUserOrganizationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[54]
+	mi := &file_user_management_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListUserOrganizationsResponse) GetOrganizations() []*UserOrganizationInfo {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+type UserOrganizationInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrgId            string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	OrgName          string                 `protobuf:"bytes,2,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	RoleName         string                 `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	DepartmentName   string                 `protobuf:"bytes,4,opt,name=department_name,json=departmentName,proto3" json:"department_name,omitempty"`
+	DesignationName  string                 `protobuf:"bytes,5,opt,name=designation_name,json=designationName,proto3" json:"designation_name,omitempty"`
+	ProjectNames     []string               `protobuf:"bytes,6,rep,name=project_names,json=projectNames,proto3" json:"project_names,omitempty"`
+	IsCurrentContext bool                   `protobuf:"varint,7,opt,name=is_current_context,json=isCurrentContext,proto3" json:"is_current_context,omitempty"` // Currently active organization
+	JoinedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserOrganizationInfo) Reset() {
+	*x = UserOrganizationInfo{}
+	mi := &file_user_management_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrganizationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrganizationInfo) ProtoMessage() {}
+
+func (x *UserOrganizationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrganizationInfo.ProtoReflect.Descriptor instead.
+func (*UserOrganizationInfo) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UserOrganizationInfo) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UserOrganizationInfo) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *UserOrganizationInfo) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *UserOrganizationInfo) GetDepartmentName() string {
+	if x != nil {
+		return x.DepartmentName
+	}
+	return ""
+}
+
+func (x *UserOrganizationInfo) GetDesignationName() string {
+	if x != nil {
+		return x.DesignationName
+	}
+	return ""
+}
+
+func (x *UserOrganizationInfo) GetProjectNames() []string {
+	if x != nil {
+		return x.ProjectNames
+	}
+	return nil
+}
+
+func (x *UserOrganizationInfo) GetIsCurrentContext() bool {
+	if x != nil {
+		return x.IsCurrentContext
+	}
+	return false
+}
+
+func (x *UserOrganizationInfo) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// ====================
+// Invitations
+// ====================
+type InviteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                      // Optional display name for the invitee
+	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`       // Defaults to the caller's current organization
+	RoleIds       []string               `protobuf:"bytes,4,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"` // At least one; the first is the membership role
+	DepartmentId  string                 `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	DesignationId string                 `protobuf:"bytes,6,opt,name=designation_id,json=designationId,proto3" json:"designation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
+	mi := &file_user_management_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserRequest) ProtoMessage() {}
+
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *InviteUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *InviteUserRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetDesignationId() string {
+	if x != nil {
+		return x.DesignationId
+	}
+	return ""
+}
+
+type InvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	OrgId         string                 `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	OrgName       string                 `protobuf:"bytes,5,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,6,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,7,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	DesignationId string                 `protobuf:"bytes,8,opt,name=designation_id,json=designationId,proto3" json:"designation_id,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // pending, accepted, revoked, expired
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitationResponse) Reset() {
+	*x = InvitationResponse{}
+	mi := &file_user_management_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationResponse) ProtoMessage() {}
+
+func (x *InvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationResponse.ProtoReflect.Descriptor instead.
+func (*InvitationResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *InvitationResponse) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *InvitationResponse) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetDesignationId() string {
+	if x != nil {
+		return x.DesignationId
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *InvitationResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"` // Defaults to the caller's current organization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_user_management_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListInvitationsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*InvitationResponse  `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_user_management_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*InvitationResponse {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_user_management_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+// Looks up a pending invitation from the signed link token
+type GetInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationRequest) Reset() {
+	*x = GetInvitationRequest{}
+	mi := &file_user_management_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationRequest) ProtoMessage() {}
+
+func (x *GetInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                    // Required for new users unless set on the invitation
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`            // Required for new users unless use_sso is set
+	UseSso        bool                   `protobuf:"varint,4,opt,name=use_sso,json=useSso,proto3" json:"use_sso,omitempty"` // Sign in through SSO with the invited email instead of a password
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_user_management_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetUseSso() bool {
+	if x != nil {
+		return x.UseSso
+	}
+	return false
+}
+
+// ====================
+// Dropdown Messages for Create User Form
+// ====================
+type GetDropdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"` // Organization ID to filter by (from JWT context)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDropdownRequest) Reset() {
+	*x = GetDropdownRequest{}
+	mi := &file_user_management_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDropdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDropdownRequest) ProtoMessage() {}
+
+func (x *GetDropdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDropdownRequest.ProtoReflect.Descriptor instead.
+func (*GetDropdownRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetDropdownRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type DropdownItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropdownItem) Reset() {
+	*x = DropdownItem{}
+	mi := &file_user_management_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropdownItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropdownItem) ProtoMessage() {}
+
+func (x *DropdownItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropdownItem.ProtoReflect.Descriptor instead.
+func (*DropdownItem) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DropdownItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DropdownItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DepartmentsDropdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*DropdownItem        `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepartmentsDropdownResponse) Reset() {
+	*x = DepartmentsDropdownResponse{}
+	mi := &file_user_management_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepartmentsDropdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentsDropdownResponse) ProtoMessage() {}
+
+func (x *DepartmentsDropdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentsDropdownResponse.ProtoReflect.Descriptor instead.
+func (*DepartmentsDropdownResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DepartmentsDropdownResponse) GetDepartments() []*DropdownItem {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+type DesignationsDropdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Designations  []*DropdownItem        `protobuf:"bytes,1,rep,name=designations,proto3" json:"designations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DesignationsDropdownResponse) Reset() {
+	*x = DesignationsDropdownResponse{}
+	mi := &file_user_management_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DesignationsDropdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DesignationsDropdownResponse) ProtoMessage() {}
+
+func (x *DesignationsDropdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DesignationsDropdownResponse.ProtoReflect.Descriptor instead.
+func (*DesignationsDropdownResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DesignationsDropdownResponse) GetDesignations() []*DropdownItem {
+	if x != nil {
+		return x.Designations
+	}
+	return nil
+}
+
+type RolesDropdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*DropdownItem        `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolesDropdownResponse) Reset() {
+	*x = RolesDropdownResponse{}
+	mi := &file_user_management_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolesDropdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesDropdownResponse) ProtoMessage() {}
+
+func (x *RolesDropdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesDropdownResponse.ProtoReflect.Descriptor instead.
+func (*RolesDropdownResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RolesDropdownResponse) GetRoles() []*DropdownItem {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// ====================
+// Bulk Import
+// ====================
+type ImportFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileContent   []byte                 `protobuf:"bytes,1,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // csv or xlsx; derived from file_name when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFile) Reset() {
+	*x = ImportFile{}
+	mi := &file_user_management_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFile) ProtoMessage() {}
+
+func (x *ImportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFile.ProtoReflect.Descriptor instead.
+func (*ImportFile) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ImportFile) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+func (x *ImportFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportFile) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Departments are created first, then designations, then users, whose
+// department, designation and roles are resolved by name. Re-running an
+// import is safe: departments and designations are matched by name and users
+// by email.
+type BulkImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// XLSX workbook with Departments, Designations and Users sheets, used
+	// instead of the separate files
+	Workbook        *ImportFile `protobuf:"bytes,1,opt,name=workbook,proto3" json:"workbook,omitempty"`
+	Departments     *ImportFile `protobuf:"bytes,2,opt,name=departments,proto3" json:"departments,omitempty"`                                 // name, description, cost_centre_code, parent
+	Designations    *ImportFile `protobuf:"bytes,3,opt,name=designations,proto3" json:"designations,omitempty"`                               // name, description, grade, level
+	Users           *ImportFile `protobuf:"bytes,4,opt,name=users,proto3" json:"users,omitempty"`                                             // name, email, department, designation, roles (separated by ;)
+	OrgId           string      `protobuf:"bytes,5,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`                                // Defaults to the caller's current organization
+	DryRun          bool        `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                            // Validate every row and write nothing
+	SendInvitations bool        `protobuf:"varint,7,opt,name=send_invitations,json=sendInvitations,proto3" json:"send_invitations,omitempty"` // Invite new users by email instead of creating their accounts
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BulkImportRequest) Reset() {
+	*x = BulkImportRequest{}
+	mi := &file_user_management_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportRequest) ProtoMessage() {}
+
+func (x *BulkImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportRequest.ProtoReflect.Descriptor instead.
+func (*BulkImportRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *BulkImportRequest) GetWorkbook() *ImportFile {
+	if x != nil {
+		return x.Workbook
+	}
+	return nil
+}
+
+func (x *BulkImportRequest) GetDepartments() *ImportFile {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+func (x *BulkImportRequest) GetDesignations() *ImportFile {
+	if x != nil {
+		return x.Designations
+	}
+	return nil
+}
+
+func (x *BulkImportRequest) GetUsers() *ImportFile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BulkImportRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *BulkImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkImportRequest) GetSendInvitations() bool {
+	if x != nil {
+		return x.SendInvitations
+	}
+	return false
+}
+
+type BulkImportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sheet         string                 `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`                           // departments, designations or users
+	RowNumber     int32                  `protobuf:"varint,2,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"` // As numbered in the file, the header being row 1
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`                               // Name or email
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                         // created, updated, unchanged, invited or failed
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`                                 // Empty for failed rows and new records in a dry run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportRow) Reset() {
+	*x = BulkImportRow{}
+	mi := &file_user_management_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportRow) ProtoMessage() {}
+
+func (x *BulkImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportRow.ProtoReflect.Descriptor instead.
+func (*BulkImportRow) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *BulkImportRow) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *BulkImportRow) GetRowNumber() int32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *BulkImportRow) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BulkImportRow) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BulkImportRow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BulkImportError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sheet         string                 `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`
+	RowNumber     int32                  `protobuf:"varint,2,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportError) Reset() {
+	*x = BulkImportError{}
+	mi := &file_user_management_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportError) ProtoMessage() {}
+
+func (x *BulkImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportError.ProtoReflect.Descriptor instead.
+func (*BulkImportError) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BulkImportError) GetSheet() string {
+	if x != nil {
+		return x.Sheet
+	}
+	return ""
+}
+
+func (x *BulkImportError) GetRowNumber() int32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *BulkImportError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *BulkImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BulkImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Rows          []*BulkImportRow       `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Errors        []*BulkImportError     `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	TotalRows     int32                  `protobuf:"varint,4,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	FailedRows    int32                  `protobuf:"varint,5,opt,name=failed_rows,json=failedRows,proto3" json:"failed_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportResponse) Reset() {
+	*x = BulkImportResponse{}
+	mi := &file_user_management_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportResponse) ProtoMessage() {}
+
+func (x *BulkImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportResponse.ProtoReflect.Descriptor instead.
+func (*BulkImportResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BulkImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkImportResponse) GetRows() []*BulkImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *BulkImportResponse) GetErrors() []*BulkImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *BulkImportResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *BulkImportResponse) GetFailedRows() int32 {
+	if x != nil {
+		return x.FailedRows
+	}
+	return 0
+}
+
+// ====================
+// Approval Delegations
+// ====================
+type DelegationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DelegationId  string                 `protobuf:"bytes,1,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id,omitempty"`
+	DelegatorId   string                 `protobuf:"bytes,2,opt,name=delegator_id,json=delegatorId,proto3" json:"delegator_id,omitempty"` // User whose permissions are delegated
+	DelegatorName string                 `protobuf:"bytes,3,opt,name=delegator_name,json=delegatorName,proto3" json:"delegator_name,omitempty"`
+	DelegateId    string                 `protobuf:"bytes,4,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"` // User acting on the delegator's behalf
+	DelegateName  string                 `protobuf:"bytes,5,opt,name=delegate_name,json=delegateName,proto3" json:"delegate_name,omitempty"`
+	Permissions   []string               `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	OrgId         string                 `protobuf:"bytes,7,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`             // Empty when valid in every organization
+	ProjectId     string                 `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Empty when valid for every project
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"` // scheduled, active, expired or revoked
+	CreatedBy     string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelegationResponse) Reset() {
+	*x = DelegationResponse{}
+	mi := &file_user_management_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationResponse) ProtoMessage() {}
+
+func (x *DelegationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationResponse.ProtoReflect.Descriptor instead.
+func (*DelegationResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DelegationResponse) GetDelegationId() string {
+	if x != nil {
+		return x.DelegationId
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetDelegatorId() string {
+	if x != nil {
+		return x.DelegatorId
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetDelegatorName() string {
+	if x != nil {
+		return x.DelegatorName
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetDelegateName() string {
+	if x != nil {
+		return x.DelegateName
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *DelegationResponse) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *DelegationResponse) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *DelegationResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *DelegationResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DelegationResponse) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type CreateDelegationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the caller; delegating for someone else needs manage-delegations
+	DelegatorId string `protobuf:"bytes,1,opt,name=delegator_id,json=delegatorId,proto3" json:"delegator_id,omitempty"`
+	DelegateId  string `protobuf:"bytes,2,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	// Approval permissions to delegate; empty delegates all the delegator holds
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	OrgId         string                 `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`             // Limits the delegation to one organization
+	ProjectId     string                 `protobuf:"bytes,5,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Limits the delegation to one project of org_id
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`    // Defaults to now
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"` // e.g. "Annual leave"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDelegationRequest) Reset() {
+	*x = CreateDelegationRequest{}
+	mi := &file_user_management_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDelegationRequest) ProtoMessage() {}
+
+func (x *CreateDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDelegationRequest.ProtoReflect.Descriptor instead.
+func (*CreateDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateDelegationRequest) GetDelegatorId() string {
+	if x != nil {
+		return x.DelegatorId
+	}
+	return ""
+}
+
+func (x *CreateDelegationRequest) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *CreateDelegationRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CreateDelegationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateDelegationRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateDelegationRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateDelegationRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateDelegationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Without manage-delegations only delegations the caller gives or receives
+// are listed
+type ListDelegationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DelegatorId   string                 `protobuf:"bytes,1,opt,name=delegator_id,json=delegatorId,proto3" json:"delegator_id,omitempty"`
+	DelegateId    string                 `protobuf:"bytes,2,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only delegations in force now
+	Permission    string                 `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`                    // Only delegations covering this permission
+	OrgId         string                 `protobuf:"bytes,5,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`                 // Only delegations valid in this organization
+	ProjectId     string                 `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`     // Only delegations valid for this project
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDelegationsRequest) Reset() {
+	*x = ListDelegationsRequest{}
+	mi := &file_user_management_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegationsRequest) ProtoMessage() {}
+
+func (x *ListDelegationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListDelegationsRequest) GetDelegatorId() string {
+	if x != nil {
+		return x.DelegatorId
+	}
+	return ""
+}
+
+func (x *ListDelegationsRequest) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *ListDelegationsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListDelegationsRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ListDelegationsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListDelegationsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type ListDelegationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delegations   []*DelegationResponse  `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDelegationsResponse) Reset() {
+	*x = ListDelegationsResponse{}
+	mi := &file_user_management_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegationsResponse) ProtoMessage() {}
+
+func (x *ListDelegationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListDelegationsResponse) GetDelegations() []*DelegationResponse {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+type RevokeDelegationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DelegationId  string                 `protobuf:"bytes,1,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDelegationRequest) Reset() {
+	*x = RevokeDelegationRequest{}
+	mi := &file_user_management_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDelegationRequest) ProtoMessage() {}
+
+func (x *RevokeDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDelegationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RevokeDelegationRequest) GetDelegationId() string {
+	if x != nil {
+		return x.DelegationId
+	}
+	return ""
+}
+
+// ====================
+// Scoped Roles & Access Checks
+// ====================
+type ScopedRoleAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	RoleName      string                 `protobuf:"bytes,4,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`              // Permissions of the role
+	ScopeType     string                 `protobuf:"bytes,6,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"` // ORGANIZATION, PROJECT or DEPARTMENT
+	OrgId         string                 `protobuf:"bytes,7,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,8,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`       // The organization, or a project or department of it
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset when the assignment does not expire
+	GrantedBy     string                 `protobuf:"bytes,10,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScopedRoleAssignment) Reset() {
+	*x = ScopedRoleAssignment{}
+	mi := &file_user_management_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScopedRoleAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScopedRoleAssignment) ProtoMessage() {}
+
+func (x *ScopedRoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScopedRoleAssignment.ProtoReflect.Descriptor instead.
+func (*ScopedRoleAssignment) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ScopedRoleAssignment) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *ScopedRoleAssignment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScopedRoleAssignment) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *ScopedRoleAssignment) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *ScopedRoleAssignment) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ScopedRoleAssignment) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *ScopedRoleAssignment) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ScopedRoleAssignment) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *ScopedRoleAssignment) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExpiresAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListUserOrganizationsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserOrganizationsResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{54}
+func (x *ScopedRoleAssignment) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
 }
 
-func (x *ListUserOrganizationsResponse) GetOrganizations() []*UserOrganizationInfo {
+func (x *ScopedRoleAssignment) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Organizations
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UserOrganizationInfo struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrgId            string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
-	OrgName          string                 `protobuf:"bytes,2,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
-	RoleName         string                 `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
-	DepartmentName   string                 `protobuf:"bytes,4,opt,name=department_name,json=departmentName,proto3" json:"department_name,omitempty"`
-	DesignationName  string                 `protobuf:"bytes,5,opt,name=designation_name,json=designationName,proto3" json:"designation_name,omitempty"`
-	ProjectNames     []string               `protobuf:"bytes,6,rep,name=project_names,json=projectNames,proto3" json:"project_names,omitempty"`
-	IsCurrentContext bool                   `protobuf:"varint,7,opt,name=is_current_context,json=isCurrentContext,proto3" json:"is_current_context,omitempty"` // Currently active organization
-	JoinedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type AssignScopedRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`          // Must be usable in org_id
+	ScopeType     string                 `protobuf:"bytes,3,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"` // ORGANIZATION, PROJECT or DEPARTMENT
+	OrgId         string                 `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`             // Defaults to the caller's organization
+	ScopeId       string                 `protobuf:"bytes,5,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`       // Project or department; ignored for ORGANIZATION
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserOrganizationInfo) Reset() {
-	*x = UserOrganizationInfo{}
-	mi := &file_user_management_proto_msgTypes[55]
+func (x *AssignScopedRoleRequest) Reset() {
+	*x = AssignScopedRoleRequest{}
+	mi := &file_user_management_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserOrganizationInfo) String() string {
+func (x *AssignScopedRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserOrganizationInfo) ProtoMessage() {}
+func (*AssignScopedRoleRequest) ProtoMessage() {}
 
-func (x *UserOrganizationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[55]
+func (x *AssignScopedRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3830,92 +5644,76 @@ func (x *UserOrganizationInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserOrganizationInfo.ProtoReflect.Descriptor instead.
-func (*UserOrganizationInfo) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use AssignScopedRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignScopedRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UserOrganizationInfo) GetOrgId() string {
+func (x *AssignScopedRoleRequest) GetUserId() string {
 	if x != nil {
-		return x.OrgId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UserOrganizationInfo) GetOrgName() string {
+func (x *AssignScopedRoleRequest) GetRoleId() string {
 	if x != nil {
-		return x.OrgName
+		return x.RoleId
 	}
 	return ""
 }
 
-func (x *UserOrganizationInfo) GetRoleName() string {
+func (x *AssignScopedRoleRequest) GetScopeType() string {
 	if x != nil {
-		return x.RoleName
+		return x.ScopeType
 	}
 	return ""
 }
 
-func (x *UserOrganizationInfo) GetDepartmentName() string {
+func (x *AssignScopedRoleRequest) GetOrgId() string {
 	if x != nil {
-		return x.DepartmentName
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *UserOrganizationInfo) GetDesignationName() string {
+func (x *AssignScopedRoleRequest) GetScopeId() string {
 	if x != nil {
-		return x.DesignationName
+		return x.ScopeId
 	}
 	return ""
 }
 
-func (x *UserOrganizationInfo) GetProjectNames() []string {
-	if x != nil {
-		return x.ProjectNames
-	}
-	return nil
-}
-
-func (x *UserOrganizationInfo) GetIsCurrentContext() bool {
-	if x != nil {
-		return x.IsCurrentContext
-	}
-	return false
-}
-
-func (x *UserOrganizationInfo) GetJoinedAt() *timestamppb.Timestamp {
+func (x *AssignScopedRoleRequest) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.JoinedAt
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-// ====================
-// Dropdown Messages for Create User Form
-// ====================
-type GetDropdownRequest struct {
+type ListScopedRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"` // Organization ID to filter by (from JWT context)
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Skip expired assignments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDropdownRequest) Reset() {
-	*x = GetDropdownRequest{}
-	mi := &file_user_management_proto_msgTypes[56]
+func (x *ListScopedRolesRequest) Reset() {
+	*x = ListScopedRolesRequest{}
+	mi := &file_user_management_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDropdownRequest) String() string {
+func (x *ListScopedRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDropdownRequest) ProtoMessage() {}
+func (*ListScopedRolesRequest) ProtoMessage() {}
 
-func (x *GetDropdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[56]
+func (x *ListScopedRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3926,41 +5724,47 @@ func (x *GetDropdownRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDropdownRequest.ProtoReflect.Descriptor instead.
-func (*GetDropdownRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ListScopedRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListScopedRolesRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetDropdownRequest) GetOrgId() string {
+func (x *ListScopedRolesRequest) GetUserId() string {
 	if x != nil {
-		return x.OrgId
+		return x.UserId
 	}
 	return ""
 }
 
-type DropdownItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+func (x *ListScopedRolesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListScopedRolesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Assignments   []*ScopedRoleAssignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DropdownItem) Reset() {
-	*x = DropdownItem{}
-	mi := &file_user_management_proto_msgTypes[57]
+func (x *ListScopedRolesResponse) Reset() {
+	*x = ListScopedRolesResponse{}
+	mi := &file_user_management_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DropdownItem) String() string {
+func (x *ListScopedRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DropdownItem) ProtoMessage() {}
+func (*ListScopedRolesResponse) ProtoMessage() {}
 
-func (x *DropdownItem) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[57]
+func (x *ListScopedRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3971,47 +5775,41 @@ func (x *DropdownItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DropdownItem.ProtoReflect.Descriptor instead.
-func (*DropdownItem) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *DropdownItem) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListScopedRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListScopedRolesResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DropdownItem) GetName() string {
+func (x *ListScopedRolesResponse) GetAssignments() []*ScopedRoleAssignment {
 	if x != nil {
-		return x.Name
+		return x.Assignments
 	}
-	return ""
+	return nil
 }
 
-type DepartmentsDropdownResponse struct {
+type RevokeScopedRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Departments   []*DropdownItem        `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AssignmentId  string                 `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepartmentsDropdownResponse) Reset() {
-	*x = DepartmentsDropdownResponse{}
-	mi := &file_user_management_proto_msgTypes[58]
+func (x *RevokeScopedRoleRequest) Reset() {
+	*x = RevokeScopedRoleRequest{}
+	mi := &file_user_management_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepartmentsDropdownResponse) String() string {
+func (x *RevokeScopedRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepartmentsDropdownResponse) ProtoMessage() {}
+func (*RevokeScopedRoleRequest) ProtoMessage() {}
 
-func (x *DepartmentsDropdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[58]
+func (x *RevokeScopedRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4022,40 +5820,52 @@ func (x *DepartmentsDropdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepartmentsDropdownResponse.ProtoReflect.Descriptor instead.
-func (*DepartmentsDropdownResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use RevokeScopedRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeScopedRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DepartmentsDropdownResponse) GetDepartments() []*DropdownItem {
+func (x *RevokeScopedRoleRequest) GetUserId() string {
 	if x != nil {
-		return x.Departments
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type DesignationsDropdownResponse struct {
+func (x *RevokeScopedRoleRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+// Checking another user's access needs view-user
+type CheckAccessRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Designations  []*DropdownItem        `protobuf:"bytes,1,rep,name=designations,proto3" json:"designations,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Defaults to the caller
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`               // Permission key, e.g. "approve-green-notes"
+	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`    // Defaults to the caller's organization
+	ProjectId     string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DesignationsDropdownResponse) Reset() {
-	*x = DesignationsDropdownResponse{}
-	mi := &file_user_management_proto_msgTypes[59]
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	mi := &file_user_management_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DesignationsDropdownResponse) String() string {
+func (x *CheckAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DesignationsDropdownResponse) ProtoMessage() {}
+func (*CheckAccessRequest) ProtoMessage() {}
 
-func (x *DesignationsDropdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[59]
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4066,40 +5876,72 @@ func (x *DesignationsDropdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DesignationsDropdownResponse.ProtoReflect.Descriptor instead.
-func (*DesignationsDropdownResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DesignationsDropdownResponse) GetDesignations() []*DropdownItem {
+func (x *CheckAccessRequest) GetUserId() string {
 	if x != nil {
-		return x.Designations
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type RolesDropdownResponse struct {
+func (x *CheckAccessRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+type CheckAccessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roles         []*DropdownItem        `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                             // role, scoped-role or delegation that allowed it
+	SourceId      string                 `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`         // Role name, assignment ID or delegation ID
+	OnBehalfOf    string                 `protobuf:"bytes,5,opt,name=on_behalf_of,json=onBehalfOf,proto3" json:"on_behalf_of,omitempty"` // Delegator, when allowed through a delegation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RolesDropdownResponse) Reset() {
-	*x = RolesDropdownResponse{}
-	mi := &file_user_management_proto_msgTypes[60]
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	mi := &file_user_management_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RolesDropdownResponse) String() string {
+func (x *CheckAccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RolesDropdownResponse) ProtoMessage() {}
+func (*CheckAccessResponse) ProtoMessage() {}
 
-func (x *RolesDropdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[60]
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4110,16 +5952,44 @@ func (x *RolesDropdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RolesDropdownResponse.ProtoReflect.Descriptor instead.
-func (*RolesDropdownResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *RolesDropdownResponse) GetRoles() []*DropdownItem {
+func (x *CheckAccessResponse) GetAllowed() bool {
 	if x != nil {
-		return x.Roles
+		return x.Allowed
 	}
-	return nil
+	return false
+}
+
+func (x *CheckAccessResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetOnBehalfOf() string {
+	if x != nil {
+		return x.OnBehalfOf
+	}
+	return ""
 }
 
 // ====================
@@ -4138,7 +6008,7 @@ type UploadSignatureRequest struct {
 
 func (x *UploadSignatureRequest) Reset() {
 	*x = UploadSignatureRequest{}
-	mi := &file_user_management_proto_msgTypes[61]
+	mi := &file_user_management_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4150,7 +6020,7 @@ func (x *UploadSignatureRequest) String() string {
 func (*UploadSignatureRequest) ProtoMessage() {}
 
 func (x *UploadSignatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[61]
+	mi := &file_user_management_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4163,7 +6033,7 @@ func (x *UploadSignatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadSignatureRequest.ProtoReflect.Descriptor instead.
 func (*UploadSignatureRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{61}
+	return file_user_management_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *UploadSignatureRequest) GetUserId() string {
@@ -4217,7 +6087,7 @@ type UploadSignatureResponse struct {
 
 func (x *UploadSignatureResponse) Reset() {
 	*x = UploadSignatureResponse{}
-	mi := &file_user_management_proto_msgTypes[62]
+	mi := &file_user_management_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4229,7 +6099,7 @@ func (x *UploadSignatureResponse) String() string {
 func (*UploadSignatureResponse) ProtoMessage() {}
 
 func (x *UploadSignatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_proto_msgTypes[62]
+	mi := &file_user_management_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4242,7 +6112,7 @@ func (x *UploadSignatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadSignatureResponse.ProtoReflect.Descriptor instead.
 func (*UploadSignatureResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_proto_rawDescGZIP(), []int{62}
+	return file_user_management_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *UploadSignatureResponse) GetSuccess() bool {
@@ -4436,7 +6306,7 @@ const file_user_management_proto_rawDesc = "" +
 	"pagination\"C\n" +
 	"\x12AssignRolesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05roles\x18\x02 \x03(\tR\x05roles\"\xc6\x02\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"\xe0\x03\n" +
 	"\fUserResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -4448,13 +6318,20 @@ const file_user_management_proto_rawDesc = "" +
 	"\x0fdepartment_name\x18\b \x01(\tR\x0edepartmentName\x12)\n" +
 	"\x10designation_name\x18\t \x01(\tR\x0fdesignationName\x12\x1b\n" +
 	"\tis_active\x18\n" +
-	" \x01(\bR\bisActive\"[\n" +
+	" \x01(\bR\bisActive\x12.\n" +
+	"\x13account_holder_name\x18\v \x01(\tR\x11accountHolderName\x12\x1b\n" +
+	"\tbank_name\x18\f \x01(\tR\bbankName\x12.\n" +
+	"\x13bank_account_number\x18\r \x01(\tR\x11bankAccountNumber\x12\x1b\n" +
+	"\tifsc_code\x18\x0e \x01(\tR\bifscCode\"[\n" +
 	"\x13CreateTenantRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\"/\n" +
 	"\x10GetTenantRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"s\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"N\n" +
+	"\x13DeleteTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"s\n" +
 	"\x0eTenantResponse\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -4632,7 +6509,42 @@ const file_user_management_proto_rawDesc = "" +
 	"\x10designation_name\x18\x05 \x01(\tR\x0fdesignationName\x12#\n" +
 	"\rproject_names\x18\x06 \x03(\tR\fprojectNames\x12,\n" +
 	"\x12is_current_context\x18\a \x01(\bR\x10isCurrentContext\x127\n" +
-	"\tjoined_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"+\n" +
+	"\tjoined_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xbb\x01\n" +
+	"\x11InviteUserRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x19\n" +
+	"\brole_ids\x18\x04 \x03(\tR\aroleIds\x12#\n" +
+	"\rdepartment_id\x18\x05 \x01(\tR\fdepartmentId\x12%\n" +
+	"\x0edesignation_id\x18\x06 \x01(\tR\rdesignationId\"\x8a\x03\n" +
+	"\x12InvitationResponse\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x15\n" +
+	"\x06org_id\x18\x04 \x01(\tR\x05orgId\x12\x19\n" +
+	"\borg_name\x18\x05 \x01(\tR\aorgName\x12\x19\n" +
+	"\brole_ids\x18\x06 \x03(\tR\aroleIds\x12#\n" +
+	"\rdepartment_id\x18\a \x01(\tR\fdepartmentId\x12%\n" +
+	"\x0edesignation_id\x18\b \x01(\tR\rdesignationId\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"/\n" +
+	"\x16ListInvitationsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"P\n" +
+	"\x17ListInvitationsResponse\x125\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x13.InvitationResponseR\vinvitations\">\n" +
+	"\x17RevokeInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\",\n" +
+	"\x14GetInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"x\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x17\n" +
+	"\ause_sso\x18\x04 \x01(\bR\x06useSso\"+\n" +
 	"\x12GetDropdownRequest\x12\x15\n" +
 	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"2\n" +
 	"\fDropdownItem\x12\x0e\n" +
@@ -4643,7 +6555,139 @@ const file_user_management_proto_rawDesc = "" +
 	"\x1cDesignationsDropdownResponse\x121\n" +
 	"\fdesignations\x18\x01 \x03(\v2\r.DropdownItemR\fdesignations\"<\n" +
 	"\x15RolesDropdownResponse\x12#\n" +
-	"\x05roles\x18\x01 \x03(\v2\r.DropdownItemR\x05roles\"\xbc\x01\n" +
+	"\x05roles\x18\x01 \x03(\v2\r.DropdownItemR\x05roles\"d\n" +
+	"\n" +
+	"ImportFile\x12!\n" +
+	"\ffile_content\x18\x01 \x01(\fR\vfileContent\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"\x9a\x02\n" +
+	"\x11BulkImportRequest\x12'\n" +
+	"\bworkbook\x18\x01 \x01(\v2\v.ImportFileR\bworkbook\x12-\n" +
+	"\vdepartments\x18\x02 \x01(\v2\v.ImportFileR\vdepartments\x12/\n" +
+	"\fdesignations\x18\x03 \x01(\v2\v.ImportFileR\fdesignations\x12!\n" +
+	"\x05users\x18\x04 \x01(\v2\v.ImportFileR\x05users\x12\x15\n" +
+	"\x06org_id\x18\x05 \x01(\tR\x05orgId\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12)\n" +
+	"\x10send_invitations\x18\a \x01(\bR\x0fsendInvitations\"~\n" +
+	"\rBulkImportRow\x12\x14\n" +
+	"\x05sheet\x18\x01 \x01(\tR\x05sheet\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x02 \x01(\x05R\trowNumber\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\"v\n" +
+	"\x0fBulkImportError\x12\x14\n" +
+	"\x05sheet\x18\x01 \x01(\tR\x05sheet\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x02 \x01(\x05R\trowNumber\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xbb\x01\n" +
+	"\x12BulkImportResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\"\n" +
+	"\x04rows\x18\x02 \x03(\v2\x0e.BulkImportRowR\x04rows\x12(\n" +
+	"\x06errors\x18\x03 \x03(\v2\x10.BulkImportErrorR\x06errors\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x04 \x01(\x05R\ttotalRows\x12\x1f\n" +
+	"\vfailed_rows\x18\x05 \x01(\x05R\n" +
+	"failedRows\"\xd4\x04\n" +
+	"\x12DelegationResponse\x12#\n" +
+	"\rdelegation_id\x18\x01 \x01(\tR\fdelegationId\x12!\n" +
+	"\fdelegator_id\x18\x02 \x01(\tR\vdelegatorId\x12%\n" +
+	"\x0edelegator_name\x18\x03 \x01(\tR\rdelegatorName\x12\x1f\n" +
+	"\vdelegate_id\x18\x04 \x01(\tR\n" +
+	"delegateId\x12#\n" +
+	"\rdelegate_name\x18\x05 \x01(\tR\fdelegateName\x12 \n" +
+	"\vpermissions\x18\x06 \x03(\tR\vpermissions\x12\x15\n" +
+	"\x06org_id\x18\a \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\b \x01(\tR\tprojectId\x127\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"\xbb\x02\n" +
+	"\x17CreateDelegationRequest\x12!\n" +
+	"\fdelegator_id\x18\x01 \x01(\tR\vdelegatorId\x12\x1f\n" +
+	"\vdelegate_id\x18\x02 \x01(\tR\n" +
+	"delegateId\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x12\x15\n" +
+	"\x06org_id\x18\x04 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x05 \x01(\tR\tprojectId\x127\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\"\xd3\x01\n" +
+	"\x16ListDelegationsRequest\x12!\n" +
+	"\fdelegator_id\x18\x01 \x01(\tR\vdelegatorId\x12\x1f\n" +
+	"\vdelegate_id\x18\x02 \x01(\tR\n" +
+	"delegateId\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\x12\x15\n" +
+	"\x06org_id\x18\x05 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x06 \x01(\tR\tprojectId\"P\n" +
+	"\x17ListDelegationsResponse\x125\n" +
+	"\vdelegations\x18\x01 \x03(\v2\x13.DelegationResponseR\vdelegations\">\n" +
+	"\x17RevokeDelegationRequest\x12#\n" +
+	"\rdelegation_id\x18\x01 \x01(\tR\fdelegationId\"\x92\x03\n" +
+	"\x14ScopedRoleAssignment\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\trole_name\x18\x04 \x01(\tR\broleName\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x06 \x01(\tR\tscopeType\x12\x15\n" +
+	"\x06org_id\x18\a \x01(\tR\x05orgId\x12\x19\n" +
+	"\bscope_id\x18\b \x01(\tR\ascopeId\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\n" +
+	" \x01(\tR\tgrantedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd7\x01\n" +
+	"\x17AssignScopedRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x03 \x01(\tR\tscopeType\x12\x15\n" +
+	"\x06org_id\x18\x04 \x01(\tR\x05orgId\x12\x19\n" +
+	"\bscope_id\x18\x05 \x01(\tR\ascopeId\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"R\n" +
+	"\x16ListScopedRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"R\n" +
+	"\x17ListScopedRolesResponse\x127\n" +
+	"\vassignments\x18\x01 \x03(\v2\x15.ScopedRoleAssignmentR\vassignments\"W\n" +
+	"\x17RevokeScopedRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rassignment_id\x18\x02 \x01(\tR\fassignmentId\"\xa0\x01\n" +
+	"\x12CheckAccessRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\tR\tprojectId\x12#\n" +
+	"\rdepartment_id\x18\x05 \x01(\tR\fdepartmentId\"\x9e\x01\n" +
+	"\x13CheckAccessResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\tR\bsourceId\x12 \n" +
+	"\fon_behalf_of\x18\x05 \x01(\tR\n" +
+	"onBehalfOf\"\xbc\x01\n" +
 	"\x16UploadSignatureRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\ffile_content\x18\x02 \x01(\fR\vfileContent\x12%\n" +
@@ -4659,10 +6703,11 @@ const file_user_management_proto_rawDesc = "" +
 	"\tmime_type\x18\x06 \x01(\tR\bmimeType\x12\x1b\n" +
 	"\tfile_size\x18\a \x01(\x03R\bfileSize\x12;\n" +
 	"\vuploaded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"uploadedAt2\xb7\x1b\n" +
+	"uploadedAt2\xfd&\n" +
 	"\x0eUserManagement\x12Q\n" +
 	"\fCreateTenant\x12\x14.CreateTenantRequest\x1a\x0f.TenantResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/tenants\x12T\n" +
-	"\tGetTenant\x12\x11.GetTenantRequest\x1a\x0f.TenantResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/tenants/{tenant_id}\x12I\n" +
+	"\tGetTenant\x12\x11.GetTenantRequest\x1a\x0f.TenantResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/tenants/{tenant_id}\x12k\n" +
+	"\fDeleteTenant\x12\x14.DeleteTenantRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/tenants/{tenant_id}/delete\x12I\n" +
 	"\n" +
 	"CreateRole\x12\x12.CreateRoleRequest\x1a\r.RoleResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/roles\x12I\n" +
 	"\tListRoles\x12\x11.ListRolesRequest\x1a\x12.ListRolesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/roles\x12N\n" +
@@ -4697,7 +6742,22 @@ const file_user_management_proto_rawDesc = "" +
 	"\x16MarkNotificationAsRead\x12\x1e.MarkNotificationAsReadRequest\x1a\x15.NotificationResponse\x12w\n" +
 	"\x15AddUserToOrganization\x12\x1d.AddUserToOrganizationRequest\x1a\r.UserResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/users/{user_id}/organizations\x12\x90\x01\n" +
 	"\x1aRemoveUserFromOrganization\x12\".RemoveUserFromOrganizationRequest\x1a\x16.google.protobuf.Empty\"6\x82\xd3\xe4\x93\x020*./api/v1/users/{user_id}/organizations/{org_id}\x12\x85\x01\n" +
-	"\x15ListUserOrganizations\x12\x1d.ListUserOrganizationsRequest\x1a\x1e.ListUserOrganizationsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/users/{user_id}/organizations\x12x\n" +
+	"\x15ListUserOrganizations\x12\x1d.ListUserOrganizationsRequest\x1a\x1e.ListUserOrganizationsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/users/{user_id}/organizations\x12U\n" +
+	"\n" +
+	"InviteUser\x12\x12.InviteUserRequest\x1a\x13.InvitationResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/invitations\x12a\n" +
+	"\x0fListInvitations\x12\x17.ListInvitationsRequest\x1a\x18.ListInvitationsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/invitations\x12q\n" +
+	"\x10RevokeInvitation\x12\x18.RevokeInvitationRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%*#/api/v1/invitations/{invitation_id}\x12_\n" +
+	"\rGetInvitation\x12\x15.GetInvitationRequest\x1a\x13.InvitationResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/invitations/lookup\x12b\n" +
+	"\x10AcceptInvitation\x12\x18.AcceptInvitationRequest\x1a\r.UserResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/invitations/accept\x12[\n" +
+	"\n" +
+	"BulkImport\x12\x12.BulkImportRequest\x1a\x13.BulkImportResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/users/bulk-import\x12a\n" +
+	"\x10CreateDelegation\x12\x18.CreateDelegationRequest\x1a\x13.DelegationResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/delegations\x12a\n" +
+	"\x0fListDelegations\x12\x17.ListDelegationsRequest\x1a\x18.ListDelegationsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/delegations\x12x\n" +
+	"\x10RevokeDelegation\x12\x18.RevokeDelegationRequest\x1a\x13.DelegationResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/delegations/{delegation_id}/revoke\x12t\n" +
+	"\x10AssignScopedRole\x12\x18.AssignScopedRoleRequest\x1a\x15.ScopedRoleAssignment\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/users/{user_id}/scoped-roles\x12r\n" +
+	"\x0fListScopedRoles\x12\x17.ListScopedRolesRequest\x1a\x18.ListScopedRolesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/users/{user_id}/scoped-roles\x12\x82\x01\n" +
+	"\x10RevokeScopedRole\x12\x18.RevokeScopedRoleRequest\x1a\x16.google.protobuf.Empty\"<\x82\xd3\xe4\x93\x026*4/api/v1/users/{user_id}/scoped-roles/{assignment_id}\x12Y\n" +
+	"\vCheckAccess\x12\x13.CheckAccessRequest\x1a\x14.CheckAccessResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/access/check\x12x\n" +
 	"\x16GetDepartmentsDropdown\x12\x13.GetDropdownRequest\x1a\x1c.DepartmentsDropdownResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/users/dropdowns/departments\x12{\n" +
 	"\x17GetDesignationsDropdown\x12\x13.GetDropdownRequest\x1a\x1d.DesignationsDropdownResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/users/dropdowns/designations\x12f\n" +
 	"\x10GetRolesDropdown\x12\x13.GetDropdownRequest\x1a\x16.RolesDropdownResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/users/dropdowns/roles\x12v\n" +
@@ -4717,7 +6777,7 @@ func file_user_management_proto_rawDescGZIP() []byte {
 	return file_user_management_proto_rawDescData
 }
 
-var file_user_management_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_user_management_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_user_management_proto_goTypes = []any{
 	(*Role)(nil),                              // 0: Role
 	(*Permission)(nil),                        // 1: Permission
@@ -4739,167 +6799,240 @@ var file_user_management_proto_goTypes = []any{
 	(*UserResponse)(nil),                      // 17: UserResponse
 	(*CreateTenantRequest)(nil),               // 18: CreateTenantRequest
 	(*GetTenantRequest)(nil),                  // 19: GetTenantRequest
-	(*TenantResponse)(nil),                    // 20: TenantResponse
-	(*CreateUserLoginHistoryRequest)(nil),     // 21: CreateUserLoginHistoryRequest
-	(*UserLoginHistoryResponse)(nil),          // 22: UserLoginHistoryResponse
-	(*ListUserLoginHistoriesRequest)(nil),     // 23: ListUserLoginHistoriesRequest
-	(*ListUserLoginHistoriesResponse)(nil),    // 24: ListUserLoginHistoriesResponse
-	(*PageRequest)(nil),                       // 25: PageRequest
-	(*PaginationMetadata)(nil),                // 26: PaginationMetadata
-	(*ListUsersPaginatedRequest)(nil),         // 27: ListUsersPaginatedRequest
-	(*ListUsersPaginatedResponse)(nil),        // 28: ListUsersPaginatedResponse
-	(*CountUsersByTenantRequest)(nil),         // 29: CountUsersByTenantRequest
-	(*CountUsersByTenantResponse)(nil),        // 30: CountUsersByTenantResponse
-	(*DeactivateUserRequest)(nil),             // 31: DeactivateUserRequest
-	(*ReactivateUserRequest)(nil),             // 32: ReactivateUserRequest
-	(*ActivityLog)(nil),                       // 33: ActivityLog
-	(*CreateActivityLogRequest)(nil),          // 34: CreateActivityLogRequest
-	(*ActivityLogResponse)(nil),               // 35: ActivityLogResponse
-	(*ListActivityLogsRequest)(nil),           // 36: ListActivityLogsRequest
-	(*ListActivityLogsResponse)(nil),          // 37: ListActivityLogsResponse
-	(*Notification)(nil),                      // 38: Notification
-	(*CreateNotificationRequest)(nil),         // 39: CreateNotificationRequest
-	(*NotificationResponse)(nil),              // 40: NotificationResponse
-	(*ListNotificationsRequest)(nil),          // 41: ListNotificationsRequest
-	(*ListNotificationsResponse)(nil),         // 42: ListNotificationsResponse
-	(*MarkNotificationAsReadRequest)(nil),     // 43: MarkNotificationAsReadRequest
-	(*ListRolesByOrganizationRequest)(nil),    // 44: ListRolesByOrganizationRequest
-	(*CloneRoleRequest)(nil),                  // 45: CloneRoleRequest
-	(*ListPermissionsRequest)(nil),            // 46: ListPermissionsRequest
-	(*ListPermissionsResponse)(nil),           // 47: ListPermissionsResponse
-	(*GetPermissionsByModuleRequest)(nil),     // 48: GetPermissionsByModuleRequest
-	(*CreateCustomPermissionRequest)(nil),     // 49: CreateCustomPermissionRequest
-	(*PermissionResponse)(nil),                // 50: PermissionResponse
-	(*AddUserToOrganizationRequest)(nil),      // 51: AddUserToOrganizationRequest
-	(*RemoveUserFromOrganizationRequest)(nil), // 52: RemoveUserFromOrganizationRequest
-	(*ListUserOrganizationsRequest)(nil),      // 53: ListUserOrganizationsRequest
-	(*ListUserOrganizationsResponse)(nil),     // 54: ListUserOrganizationsResponse
-	(*UserOrganizationInfo)(nil),              // 55: UserOrganizationInfo
-	(*GetDropdownRequest)(nil),                // 56: GetDropdownRequest
-	(*DropdownItem)(nil),                      // 57: DropdownItem
-	(*DepartmentsDropdownResponse)(nil),       // 58: DepartmentsDropdownResponse
-	(*DesignationsDropdownResponse)(nil),      // 59: DesignationsDropdownResponse
-	(*RolesDropdownResponse)(nil),             // 60: RolesDropdownResponse
-	(*UploadSignatureRequest)(nil),            // 61: UploadSignatureRequest
-	(*UploadSignatureResponse)(nil),           // 62: UploadSignatureResponse
-	(*timestamppb.Timestamp)(nil),             // 63: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                     // 64: google.protobuf.Empty
+	(*DeleteTenantRequest)(nil),               // 20: DeleteTenantRequest
+	(*TenantResponse)(nil),                    // 21: TenantResponse
+	(*CreateUserLoginHistoryRequest)(nil),     // 22: CreateUserLoginHistoryRequest
+	(*UserLoginHistoryResponse)(nil),          // 23: UserLoginHistoryResponse
+	(*ListUserLoginHistoriesRequest)(nil),     // 24: ListUserLoginHistoriesRequest
+	(*ListUserLoginHistoriesResponse)(nil),    // 25: ListUserLoginHistoriesResponse
+	(*PageRequest)(nil),                       // 26: PageRequest
+	(*PaginationMetadata)(nil),                // 27: PaginationMetadata
+	(*ListUsersPaginatedRequest)(nil),         // 28: ListUsersPaginatedRequest
+	(*ListUsersPaginatedResponse)(nil),        // 29: ListUsersPaginatedResponse
+	(*CountUsersByTenantRequest)(nil),         // 30: CountUsersByTenantRequest
+	(*CountUsersByTenantResponse)(nil),        // 31: CountUsersByTenantResponse
+	(*DeactivateUserRequest)(nil),             // 32: DeactivateUserRequest
+	(*ReactivateUserRequest)(nil),             // 33: ReactivateUserRequest
+	(*ActivityLog)(nil),                       // 34: ActivityLog
+	(*CreateActivityLogRequest)(nil),          // 35: CreateActivityLogRequest
+	(*ActivityLogResponse)(nil),               // 36: ActivityLogResponse
+	(*ListActivityLogsRequest)(nil),           // 37: ListActivityLogsRequest
+	(*ListActivityLogsResponse)(nil),          // 38: ListActivityLogsResponse
+	(*Notification)(nil),                      // 39: Notification
+	(*CreateNotificationRequest)(nil),         // 40: CreateNotificationRequest
+	(*NotificationResponse)(nil),              // 41: NotificationResponse
+	(*ListNotificationsRequest)(nil),          // 42: ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),         // 43: ListNotificationsResponse
+	(*MarkNotificationAsReadRequest)(nil),     // 44: MarkNotificationAsReadRequest
+	(*ListRolesByOrganizationRequest)(nil),    // 45: ListRolesByOrganizationRequest
+	(*CloneRoleRequest)(nil),                  // 46: CloneRoleRequest
+	(*ListPermissionsRequest)(nil),            // 47: ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),           // 48: ListPermissionsResponse
+	(*GetPermissionsByModuleRequest)(nil),     // 49: GetPermissionsByModuleRequest
+	(*CreateCustomPermissionRequest)(nil),     // 50: CreateCustomPermissionRequest
+	(*PermissionResponse)(nil),                // 51: PermissionResponse
+	(*AddUserToOrganizationRequest)(nil),      // 52: AddUserToOrganizationRequest
+	(*RemoveUserFromOrganizationRequest)(nil), // 53: RemoveUserFromOrganizationRequest
+	(*ListUserOrganizationsRequest)(nil),      // 54: ListUserOrganizationsRequest
+	(*ListUserOrganizationsResponse)(nil),     // 55: ListUserOrganizationsResponse
+	(*UserOrganizationInfo)(nil),              // 56: UserOrganizationInfo
+	(*InviteUserRequest)(nil),                 // 57: InviteUserRequest
+	(*InvitationResponse)(nil),                // 58: InvitationResponse
+	(*ListInvitationsRequest)(nil),            // 59: ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),           // 60: ListInvitationsResponse
+	(*RevokeInvitationRequest)(nil),           // 61: RevokeInvitationRequest
+	(*GetInvitationRequest)(nil),              // 62: GetInvitationRequest
+	(*AcceptInvitationRequest)(nil),           // 63: AcceptInvitationRequest
+	(*GetDropdownRequest)(nil),                // 64: GetDropdownRequest
+	(*DropdownItem)(nil),                      // 65: DropdownItem
+	(*DepartmentsDropdownResponse)(nil),       // 66: DepartmentsDropdownResponse
+	(*DesignationsDropdownResponse)(nil),      // 67: DesignationsDropdownResponse
+	(*RolesDropdownResponse)(nil),             // 68: RolesDropdownResponse
+	(*ImportFile)(nil),                        // 69: ImportFile
+	(*BulkImportRequest)(nil),                 // 70: BulkImportRequest
+	(*BulkImportRow)(nil),                     // 71: BulkImportRow
+	(*BulkImportError)(nil),                   // 72: BulkImportError
+	(*BulkImportResponse)(nil),                // 73: BulkImportResponse
+	(*DelegationResponse)(nil),                // 74: DelegationResponse
+	(*CreateDelegationRequest)(nil),           // 75: CreateDelegationRequest
+	(*ListDelegationsRequest)(nil),            // 76: ListDelegationsRequest
+	(*ListDelegationsResponse)(nil),           // 77: ListDelegationsResponse
+	(*RevokeDelegationRequest)(nil),           // 78: RevokeDelegationRequest
+	(*ScopedRoleAssignment)(nil),              // 79: ScopedRoleAssignment
+	(*AssignScopedRoleRequest)(nil),           // 80: AssignScopedRoleRequest
+	(*ListScopedRolesRequest)(nil),            // 81: ListScopedRolesRequest
+	(*ListScopedRolesResponse)(nil),           // 82: ListScopedRolesResponse
+	(*RevokeScopedRoleRequest)(nil),           // 83: RevokeScopedRoleRequest
+	(*CheckAccessRequest)(nil),                // 84: CheckAccessRequest
+	(*CheckAccessResponse)(nil),               // 85: CheckAccessResponse
+	(*UploadSignatureRequest)(nil),            // 86: UploadSignatureRequest
+	(*UploadSignatureResponse)(nil),           // 87: UploadSignatureResponse
+	(*timestamppb.Timestamp)(nil),             // 88: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 89: google.protobuf.Empty
 }
 var file_user_management_proto_depIdxs = []int32{
-	63, // 0: Role.created_at:type_name -> google.protobuf.Timestamp
-	63, // 1: Role.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 2: ListRolesResponse.roles:type_name -> RoleResponse
-	26, // 3: ListRolesResponse.pagination:type_name -> PaginationMetadata
-	63, // 4: User.email_verified_at:type_name -> google.protobuf.Timestamp
-	63, // 5: User.last_login_at:type_name -> google.protobuf.Timestamp
-	63, // 6: User.last_logout_at:type_name -> google.protobuf.Timestamp
-	63, // 7: User.created_at:type_name -> google.protobuf.Timestamp
-	63, // 8: User.updated_at:type_name -> google.protobuf.Timestamp
-	63, // 9: User.deactivated_at:type_name -> google.protobuf.Timestamp
-	9,  // 10: ListUsersResponse.users:type_name -> User
-	26, // 11: ListUsersResponse.pagination:type_name -> PaginationMetadata
-	63, // 12: UserLoginHistoryResponse.login_time:type_name -> google.protobuf.Timestamp
-	25, // 13: ListUserLoginHistoriesRequest.page:type_name -> PageRequest
-	22, // 14: ListUserLoginHistoriesResponse.histories:type_name -> UserLoginHistoryResponse
-	26, // 15: ListUserLoginHistoriesResponse.pagination:type_name -> PaginationMetadata
-	9,  // 16: ListUsersPaginatedResponse.users:type_name -> User
-	63, // 17: ActivityLog.created_at:type_name -> google.protobuf.Timestamp
-	63, // 18: ActivityLogResponse.created_at:type_name -> google.protobuf.Timestamp
-	25, // 19: ListActivityLogsRequest.page:type_name -> PageRequest
-	33, // 20: ListActivityLogsResponse.logs:type_name -> ActivityLog
-	26, // 21: ListActivityLogsResponse.pagination:type_name -> PaginationMetadata
-	63, // 22: Notification.created_at:type_name -> google.protobuf.Timestamp
-	63, // 23: Notification.read_at:type_name -> google.protobuf.Timestamp
-	63, // 24: NotificationResponse.created_at:type_name -> google.protobuf.Timestamp
-	25, // 25: ListNotificationsRequest.page:type_name -> PageRequest
-	38, // 26: ListNotificationsResponse.notifications:type_name -> Notification
-	26, // 27: ListNotificationsResponse.pagination:type_name -> PaginationMetadata
-	50, // 28: ListPermissionsResponse.permissions:type_name -> PermissionResponse
-	55, // 29: ListUserOrganizationsResponse.organizations:type_name -> UserOrganizationInfo
-	63, // 30: UserOrganizationInfo.joined_at:type_name -> google.protobuf.Timestamp
-	57, // 31: DepartmentsDropdownResponse.departments:type_name -> DropdownItem
-	57, // 32: DesignationsDropdownResponse.designations:type_name -> DropdownItem
-	57, // 33: RolesDropdownResponse.roles:type_name -> DropdownItem
-	63, // 34: UploadSignatureResponse.uploaded_at:type_name -> google.protobuf.Timestamp
-	18, // 35: UserManagement.CreateTenant:input_type -> CreateTenantRequest
-	19, // 36: UserManagement.GetTenant:input_type -> GetTenantRequest
-	2,  // 37: UserManagement.CreateRole:input_type -> CreateRoleRequest
-	7,  // 38: UserManagement.ListRoles:input_type -> ListRolesRequest
-	44, // 39: UserManagement.ListRolesByOrganization:input_type -> ListRolesByOrganizationRequest
-	4,  // 40: UserManagement.GetRole:input_type -> GetRoleRequest
-	3,  // 41: UserManagement.UpdateRole:input_type -> UpdateRoleRequest
-	5,  // 42: UserManagement.DeleteRole:input_type -> DeleteRoleRequest
-	45, // 43: UserManagement.CloneRole:input_type -> CloneRoleRequest
-	46, // 44: UserManagement.ListPermissions:input_type -> ListPermissionsRequest
-	48, // 45: UserManagement.GetPermissionsByModule:input_type -> GetPermissionsByModuleRequest
-	49, // 46: UserManagement.CreateCustomPermission:input_type -> CreateCustomPermissionRequest
-	10, // 47: UserManagement.CreateUser:input_type -> CreateUserRequest
-	13, // 48: UserManagement.GetUser:input_type -> GetUserRequest
-	14, // 49: UserManagement.ListUsers:input_type -> ListUsersRequest
-	11, // 50: UserManagement.UpdateUser:input_type -> UpdateUserRequest
-	12, // 51: UserManagement.DeleteUser:input_type -> DeleteUserRequest
-	16, // 52: UserManagement.AssignRolesToUser:input_type -> AssignRolesRequest
-	13, // 53: UserManagement.ListRolesOfUser:input_type -> GetUserRequest
-	21, // 54: UserManagement.CreateUserLoginHistory:input_type -> CreateUserLoginHistoryRequest
-	23, // 55: UserManagement.ListUserLoginHistories:input_type -> ListUserLoginHistoriesRequest
-	31, // 56: UserManagement.DeactivateUser:input_type -> DeactivateUserRequest
-	32, // 57: UserManagement.ReactivateUser:input_type -> ReactivateUserRequest
-	34, // 58: UserManagement.CreateActivityLog:input_type -> CreateActivityLogRequest
-	36, // 59: UserManagement.ListActivityLogs:input_type -> ListActivityLogsRequest
-	39, // 60: UserManagement.CreateNotification:input_type -> CreateNotificationRequest
-	41, // 61: UserManagement.ListNotifications:input_type -> ListNotificationsRequest
-	43, // 62: UserManagement.MarkNotificationAsRead:input_type -> MarkNotificationAsReadRequest
-	51, // 63: UserManagement.AddUserToOrganization:input_type -> AddUserToOrganizationRequest
-	52, // 64: UserManagement.RemoveUserFromOrganization:input_type -> RemoveUserFromOrganizationRequest
-	53, // 65: UserManagement.ListUserOrganizations:input_type -> ListUserOrganizationsRequest
-	56, // 66: UserManagement.GetDepartmentsDropdown:input_type -> GetDropdownRequest
-	56, // 67: UserManagement.GetDesignationsDropdown:input_type -> GetDropdownRequest
-	56, // 68: UserManagement.GetRolesDropdown:input_type -> GetDropdownRequest
-	61, // 69: UserManagement.UploadUserSignature:input_type -> UploadSignatureRequest
-	27, // 70: UserManagement.ListUsersPaginated:input_type -> ListUsersPaginatedRequest
-	29, // 71: UserManagement.CountUsersByTenant:input_type -> CountUsersByTenantRequest
-	20, // 72: UserManagement.CreateTenant:output_type -> TenantResponse
-	20, // 73: UserManagement.GetTenant:output_type -> TenantResponse
-	6,  // 74: UserManagement.CreateRole:output_type -> RoleResponse
-	8,  // 75: UserManagement.ListRoles:output_type -> ListRolesResponse
-	8,  // 76: UserManagement.ListRolesByOrganization:output_type -> ListRolesResponse
-	6,  // 77: UserManagement.GetRole:output_type -> RoleResponse
-	6,  // 78: UserManagement.UpdateRole:output_type -> RoleResponse
-	64, // 79: UserManagement.DeleteRole:output_type -> google.protobuf.Empty
-	6,  // 80: UserManagement.CloneRole:output_type -> RoleResponse
-	47, // 81: UserManagement.ListPermissions:output_type -> ListPermissionsResponse
-	47, // 82: UserManagement.GetPermissionsByModule:output_type -> ListPermissionsResponse
-	50, // 83: UserManagement.CreateCustomPermission:output_type -> PermissionResponse
-	17, // 84: UserManagement.CreateUser:output_type -> UserResponse
-	17, // 85: UserManagement.GetUser:output_type -> UserResponse
-	15, // 86: UserManagement.ListUsers:output_type -> ListUsersResponse
-	17, // 87: UserManagement.UpdateUser:output_type -> UserResponse
-	64, // 88: UserManagement.DeleteUser:output_type -> google.protobuf.Empty
-	17, // 89: UserManagement.AssignRolesToUser:output_type -> UserResponse
-	8,  // 90: UserManagement.ListRolesOfUser:output_type -> ListRolesResponse
-	22, // 91: UserManagement.CreateUserLoginHistory:output_type -> UserLoginHistoryResponse
-	24, // 92: UserManagement.ListUserLoginHistories:output_type -> ListUserLoginHistoriesResponse
-	17, // 93: UserManagement.DeactivateUser:output_type -> UserResponse
-	17, // 94: UserManagement.ReactivateUser:output_type -> UserResponse
-	35, // 95: UserManagement.CreateActivityLog:output_type -> ActivityLogResponse
-	37, // 96: UserManagement.ListActivityLogs:output_type -> ListActivityLogsResponse
-	40, // 97: UserManagement.CreateNotification:output_type -> NotificationResponse
-	42, // 98: UserManagement.ListNotifications:output_type -> ListNotificationsResponse
-	40, // 99: UserManagement.MarkNotificationAsRead:output_type -> NotificationResponse
-	17, // 100: UserManagement.AddUserToOrganization:output_type -> UserResponse
-	64, // 101: UserManagement.RemoveUserFromOrganization:output_type -> google.protobuf.Empty
-	54, // 102: UserManagement.ListUserOrganizations:output_type -> ListUserOrganizationsResponse
-	58, // 103: UserManagement.GetDepartmentsDropdown:output_type -> DepartmentsDropdownResponse
-	59, // 104: UserManagement.GetDesignationsDropdown:output_type -> DesignationsDropdownResponse
-	60, // 105: UserManagement.GetRolesDropdown:output_type -> RolesDropdownResponse
-	62, // 106: UserManagement.UploadUserSignature:output_type -> UploadSignatureResponse
-	28, // 107: UserManagement.ListUsersPaginated:output_type -> ListUsersPaginatedResponse
-	30, // 108: UserManagement.CountUsersByTenant:output_type -> CountUsersByTenantResponse
-	72, // [72:109] is the sub-list for method output_type
-	35, // [35:72] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	88,  // 0: Role.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 1: Role.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 2: ListRolesResponse.roles:type_name -> RoleResponse
+	27,  // 3: ListRolesResponse.pagination:type_name -> PaginationMetadata
+	88,  // 4: User.email_verified_at:type_name -> google.protobuf.Timestamp
+	88,  // 5: User.last_login_at:type_name -> google.protobuf.Timestamp
+	88,  // 6: User.last_logout_at:type_name -> google.protobuf.Timestamp
+	88,  // 7: User.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 8: User.updated_at:type_name -> google.protobuf.Timestamp
+	88,  // 9: User.deactivated_at:type_name -> google.protobuf.Timestamp
+	9,   // 10: ListUsersResponse.users:type_name -> User
+	27,  // 11: ListUsersResponse.pagination:type_name -> PaginationMetadata
+	88,  // 12: UserLoginHistoryResponse.login_time:type_name -> google.protobuf.Timestamp
+	26,  // 13: ListUserLoginHistoriesRequest.page:type_name -> PageRequest
+	23,  // 14: ListUserLoginHistoriesResponse.histories:type_name -> UserLoginHistoryResponse
+	27,  // 15: ListUserLoginHistoriesResponse.pagination:type_name -> PaginationMetadata
+	9,   // 16: ListUsersPaginatedResponse.users:type_name -> User
+	88,  // 17: ActivityLog.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 18: ActivityLogResponse.created_at:type_name -> google.protobuf.Timestamp
+	26,  // 19: ListActivityLogsRequest.page:type_name -> PageRequest
+	34,  // 20: ListActivityLogsResponse.logs:type_name -> ActivityLog
+	27,  // 21: ListActivityLogsResponse.pagination:type_name -> PaginationMetadata
+	88,  // 22: Notification.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 23: Notification.read_at:type_name -> google.protobuf.Timestamp
+	88,  // 24: NotificationResponse.created_at:type_name -> google.protobuf.Timestamp
+	26,  // 25: ListNotificationsRequest.page:type_name -> PageRequest
+	39,  // 26: ListNotificationsResponse.notifications:type_name -> Notification
+	27,  // 27: ListNotificationsResponse.pagination:type_name -> PaginationMetadata
+	51,  // 28: ListPermissionsResponse.permissions:type_name -> PermissionResponse
+	56,  // 29: ListUserOrganizationsResponse.organizations:type_name -> UserOrganizationInfo
+	88,  // 30: UserOrganizationInfo.joined_at:type_name -> google.protobuf.Timestamp
+	88,  // 31: InvitationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	88,  // 32: InvitationResponse.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 33: ListInvitationsResponse.invitations:type_name -> InvitationResponse
+	65,  // 34: DepartmentsDropdownResponse.departments:type_name -> DropdownItem
+	65,  // 35: DesignationsDropdownResponse.designations:type_name -> DropdownItem
+	65,  // 36: RolesDropdownResponse.roles:type_name -> DropdownItem
+	69,  // 37: BulkImportRequest.workbook:type_name -> ImportFile
+	69,  // 38: BulkImportRequest.departments:type_name -> ImportFile
+	69,  // 39: BulkImportRequest.designations:type_name -> ImportFile
+	69,  // 40: BulkImportRequest.users:type_name -> ImportFile
+	71,  // 41: BulkImportResponse.rows:type_name -> BulkImportRow
+	72,  // 42: BulkImportResponse.errors:type_name -> BulkImportError
+	88,  // 43: DelegationResponse.starts_at:type_name -> google.protobuf.Timestamp
+	88,  // 44: DelegationResponse.ends_at:type_name -> google.protobuf.Timestamp
+	88,  // 45: DelegationResponse.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 46: DelegationResponse.revoked_at:type_name -> google.protobuf.Timestamp
+	88,  // 47: CreateDelegationRequest.starts_at:type_name -> google.protobuf.Timestamp
+	88,  // 48: CreateDelegationRequest.ends_at:type_name -> google.protobuf.Timestamp
+	74,  // 49: ListDelegationsResponse.delegations:type_name -> DelegationResponse
+	88,  // 50: ScopedRoleAssignment.expires_at:type_name -> google.protobuf.Timestamp
+	88,  // 51: ScopedRoleAssignment.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 52: AssignScopedRoleRequest.expires_at:type_name -> google.protobuf.Timestamp
+	79,  // 53: ListScopedRolesResponse.assignments:type_name -> ScopedRoleAssignment
+	88,  // 54: UploadSignatureResponse.uploaded_at:type_name -> google.protobuf.Timestamp
+	18,  // 55: UserManagement.CreateTenant:input_type -> CreateTenantRequest
+	19,  // 56: UserManagement.GetTenant:input_type -> GetTenantRequest
+	20,  // 57: UserManagement.DeleteTenant:input_type -> DeleteTenantRequest
+	2,   // 58: UserManagement.CreateRole:input_type -> CreateRoleRequest
+	7,   // 59: UserManagement.ListRoles:input_type -> ListRolesRequest
+	45,  // 60: UserManagement.ListRolesByOrganization:input_type -> ListRolesByOrganizationRequest
+	4,   // 61: UserManagement.GetRole:input_type -> GetRoleRequest
+	3,   // 62: UserManagement.UpdateRole:input_type -> UpdateRoleRequest
+	5,   // 63: UserManagement.DeleteRole:input_type -> DeleteRoleRequest
+	46,  // 64: UserManagement.CloneRole:input_type -> CloneRoleRequest
+	47,  // 65: UserManagement.ListPermissions:input_type -> ListPermissionsRequest
+	49,  // 66: UserManagement.GetPermissionsByModule:input_type -> GetPermissionsByModuleRequest
+	50,  // 67: UserManagement.CreateCustomPermission:input_type -> CreateCustomPermissionRequest
+	10,  // 68: UserManagement.CreateUser:input_type -> CreateUserRequest
+	13,  // 69: UserManagement.GetUser:input_type -> GetUserRequest
+	14,  // 70: UserManagement.ListUsers:input_type -> ListUsersRequest
+	11,  // 71: UserManagement.UpdateUser:input_type -> UpdateUserRequest
+	12,  // 72: UserManagement.DeleteUser:input_type -> DeleteUserRequest
+	16,  // 73: UserManagement.AssignRolesToUser:input_type -> AssignRolesRequest
+	13,  // 74: UserManagement.ListRolesOfUser:input_type -> GetUserRequest
+	22,  // 75: UserManagement.CreateUserLoginHistory:input_type -> CreateUserLoginHistoryRequest
+	24,  // 76: UserManagement.ListUserLoginHistories:input_type -> ListUserLoginHistoriesRequest
+	32,  // 77: UserManagement.DeactivateUser:input_type -> DeactivateUserRequest
+	33,  // 78: UserManagement.ReactivateUser:input_type -> ReactivateUserRequest
+	35,  // 79: UserManagement.CreateActivityLog:input_type -> CreateActivityLogRequest
+	37,  // 80: UserManagement.ListActivityLogs:input_type -> ListActivityLogsRequest
+	40,  // 81: UserManagement.CreateNotification:input_type -> CreateNotificationRequest
+	42,  // 82: UserManagement.ListNotifications:input_type -> ListNotificationsRequest
+	44,  // 83: UserManagement.MarkNotificationAsRead:input_type -> MarkNotificationAsReadRequest
+	52,  // 84: UserManagement.AddUserToOrganization:input_type -> AddUserToOrganizationRequest
+	53,  // 85: UserManagement.RemoveUserFromOrganization:input_type -> RemoveUserFromOrganizationRequest
+	54,  // 86: UserManagement.ListUserOrganizations:input_type -> ListUserOrganizationsRequest
+	57,  // 87: UserManagement.InviteUser:input_type -> InviteUserRequest
+	59,  // 88: UserManagement.ListInvitations:input_type -> ListInvitationsRequest
+	61,  // 89: UserManagement.RevokeInvitation:input_type -> RevokeInvitationRequest
+	62,  // 90: UserManagement.GetInvitation:input_type -> GetInvitationRequest
+	63,  // 91: UserManagement.AcceptInvitation:input_type -> AcceptInvitationRequest
+	70,  // 92: UserManagement.BulkImport:input_type -> BulkImportRequest
+	75,  // 93: UserManagement.CreateDelegation:input_type -> CreateDelegationRequest
+	76,  // 94: UserManagement.ListDelegations:input_type -> ListDelegationsRequest
+	78,  // 95: UserManagement.RevokeDelegation:input_type -> RevokeDelegationRequest
+	80,  // 96: UserManagement.AssignScopedRole:input_type -> AssignScopedRoleRequest
+	81,  // 97: UserManagement.ListScopedRoles:input_type -> ListScopedRolesRequest
+	83,  // 98: UserManagement.RevokeScopedRole:input_type -> RevokeScopedRoleRequest
+	84,  // 99: UserManagement.CheckAccess:input_type -> CheckAccessRequest
+	64,  // 100: UserManagement.GetDepartmentsDropdown:input_type -> GetDropdownRequest
+	64,  // 101: UserManagement.GetDesignationsDropdown:input_type -> GetDropdownRequest
+	64,  // 102: UserManagement.GetRolesDropdown:input_type -> GetDropdownRequest
+	86,  // 103: UserManagement.UploadUserSignature:input_type -> UploadSignatureRequest
+	28,  // 104: UserManagement.ListUsersPaginated:input_type -> ListUsersPaginatedRequest
+	30,  // 105: UserManagement.CountUsersByTenant:input_type -> CountUsersByTenantRequest
+	21,  // 106: UserManagement.CreateTenant:output_type -> TenantResponse
+	21,  // 107: UserManagement.GetTenant:output_type -> TenantResponse
+	89,  // 108: UserManagement.DeleteTenant:output_type -> google.protobuf.Empty
+	6,   // 109: UserManagement.CreateRole:output_type -> RoleResponse
+	8,   // 110: UserManagement.ListRoles:output_type -> ListRolesResponse
+	8,   // 111: UserManagement.ListRolesByOrganization:output_type -> ListRolesResponse
+	6,   // 112: UserManagement.GetRole:output_type -> RoleResponse
+	6,   // 113: UserManagement.UpdateRole:output_type -> RoleResponse
+	89,  // 114: UserManagement.DeleteRole:output_type -> google.protobuf.Empty
+	6,   // 115: UserManagement.CloneRole:output_type -> RoleResponse
+	48,  // 116: UserManagement.ListPermissions:output_type -> ListPermissionsResponse
+	48,  // 117: UserManagement.GetPermissionsByModule:output_type -> ListPermissionsResponse
+	51,  // 118: UserManagement.CreateCustomPermission:output_type -> PermissionResponse
+	17,  // 119: UserManagement.CreateUser:output_type -> UserResponse
+	17,  // 120: UserManagement.GetUser:output_type -> UserResponse
+	15,  // 121: UserManagement.ListUsers:output_type -> ListUsersResponse
+	17,  // 122: UserManagement.UpdateUser:output_type -> UserResponse
+	89,  // 123: UserManagement.DeleteUser:output_type -> google.protobuf.Empty
+	17,  // 124: UserManagement.AssignRolesToUser:output_type -> UserResponse
+	8,   // 125: UserManagement.ListRolesOfUser:output_type -> ListRolesResponse
+	23,  // 126: UserManagement.CreateUserLoginHistory:output_type -> UserLoginHistoryResponse
+	25,  // 127: UserManagement.ListUserLoginHistories:output_type -> ListUserLoginHistoriesResponse
+	17,  // 128: UserManagement.DeactivateUser:output_type -> UserResponse
+	17,  // 129: UserManagement.ReactivateUser:output_type -> UserResponse
+	36,  // 130: UserManagement.CreateActivityLog:output_type -> ActivityLogResponse
+	38,  // 131: UserManagement.ListActivityLogs:output_type -> ListActivityLogsResponse
+	41,  // 132: UserManagement.CreateNotification:output_type -> NotificationResponse
+	43,  // 133: UserManagement.ListNotifications:output_type -> ListNotificationsResponse
+	41,  // 134: UserManagement.MarkNotificationAsRead:output_type -> NotificationResponse
+	17,  // 135: UserManagement.AddUserToOrganization:output_type -> UserResponse
+	89,  // 136: UserManagement.RemoveUserFromOrganization:output_type -> google.protobuf.Empty
+	55,  // 137: UserManagement.ListUserOrganizations:output_type -> ListUserOrganizationsResponse
+	58,  // 138: UserManagement.InviteUser:output_type -> InvitationResponse
+	60,  // 139: UserManagement.ListInvitations:output_type -> ListInvitationsResponse
+	89,  // 140: UserManagement.RevokeInvitation:output_type -> google.protobuf.Empty
+	58,  // 141: UserManagement.GetInvitation:output_type -> InvitationResponse
+	17,  // 142: UserManagement.AcceptInvitation:output_type -> UserResponse
+	73,  // 143: UserManagement.BulkImport:output_type -> BulkImportResponse
+	74,  // 144: UserManagement.CreateDelegation:output_type -> DelegationResponse
+	77,  // 145: UserManagement.ListDelegations:output_type -> ListDelegationsResponse
+	74,  // 146: UserManagement.RevokeDelegation:output_type -> DelegationResponse
+	79,  // 147: UserManagement.AssignScopedRole:output_type -> ScopedRoleAssignment
+	82,  // 148: UserManagement.ListScopedRoles:output_type -> ListScopedRolesResponse
+	89,  // 149: UserManagement.RevokeScopedRole:output_type -> google.protobuf.Empty
+	85,  // 150: UserManagement.CheckAccess:output_type -> CheckAccessResponse
+	66,  // 151: UserManagement.GetDepartmentsDropdown:output_type -> DepartmentsDropdownResponse
+	67,  // 152: UserManagement.GetDesignationsDropdown:output_type -> DesignationsDropdownResponse
+	68,  // 153: UserManagement.GetRolesDropdown:output_type -> RolesDropdownResponse
+	87,  // 154: UserManagement.UploadUserSignature:output_type -> UploadSignatureResponse
+	29,  // 155: UserManagement.ListUsersPaginated:output_type -> ListUsersPaginatedResponse
+	31,  // 156: UserManagement.CountUsersByTenant:output_type -> CountUsersByTenantResponse
+	106, // [106:157] is the sub-list for method output_type
+	55,  // [55:106] is the sub-list for method input_type
+	55,  // [55:55] is the sub-list for extension type_name
+	55,  // [55:55] is the sub-list for extension extendee
+	0,   // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_user_management_proto_init() }
@@ -4914,7 +7047,7 @@ func file_user_management_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_management_proto_rawDesc), len(file_user_management_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   63,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
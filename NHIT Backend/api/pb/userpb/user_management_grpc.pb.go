@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	UserManagement_CreateTenant_FullMethodName               = "/UserManagement/CreateTenant"
 	UserManagement_GetTenant_FullMethodName                  = "/UserManagement/GetTenant"
+	UserManagement_DeleteTenant_FullMethodName               = "/UserManagement/DeleteTenant"
 	UserManagement_CreateRole_FullMethodName                 = "/UserManagement/CreateRole"
 	UserManagement_ListRoles_FullMethodName                  = "/UserManagement/ListRoles"
 	UserManagement_ListRolesByOrganization_FullMethodName    = "/UserManagement/ListRolesByOrganization"
@@ -51,6 +52,19 @@ const (
 	UserManagement_AddUserToOrganization_FullMethodName      = "/UserManagement/AddUserToOrganization"
 	UserManagement_RemoveUserFromOrganization_FullMethodName = "/UserManagement/RemoveUserFromOrganization"
 	UserManagement_ListUserOrganizations_FullMethodName      = "/UserManagement/ListUserOrganizations"
+	UserManagement_InviteUser_FullMethodName                 = "/UserManagement/InviteUser"
+	UserManagement_ListInvitations_FullMethodName            = "/UserManagement/ListInvitations"
+	UserManagement_RevokeInvitation_FullMethodName           = "/UserManagement/RevokeInvitation"
+	UserManagement_GetInvitation_FullMethodName              = "/UserManagement/GetInvitation"
+	UserManagement_AcceptInvitation_FullMethodName           = "/UserManagement/AcceptInvitation"
+	UserManagement_BulkImport_FullMethodName                 = "/UserManagement/BulkImport"
+	UserManagement_CreateDelegation_FullMethodName           = "/UserManagement/CreateDelegation"
+	UserManagement_ListDelegations_FullMethodName            = "/UserManagement/ListDelegations"
+	UserManagement_RevokeDelegation_FullMethodName           = "/UserManagement/RevokeDelegation"
+	UserManagement_AssignScopedRole_FullMethodName           = "/UserManagement/AssignScopedRole"
+	UserManagement_ListScopedRoles_FullMethodName            = "/UserManagement/ListScopedRoles"
+	UserManagement_RevokeScopedRole_FullMethodName           = "/UserManagement/RevokeScopedRole"
+	UserManagement_CheckAccess_FullMethodName                = "/UserManagement/CheckAccess"
 	UserManagement_GetDepartmentsDropdown_FullMethodName     = "/UserManagement/GetDepartmentsDropdown"
 	UserManagement_GetDesignationsDropdown_FullMethodName    = "/UserManagement/GetDesignationsDropdown"
 	UserManagement_GetRolesDropdown_FullMethodName           = "/UserManagement/GetRolesDropdown"
@@ -70,6 +84,9 @@ type UserManagementClient interface {
 	// Tenant / Super Admin
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*TenantResponse, error)
+	// DeleteTenant removes a tenant that has no organizations, with its users
+	// and roles. Used to roll back a failed onboarding.
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Role Management (Dynamic)
 	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
 	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
@@ -110,6 +127,26 @@ type UserManagementClient interface {
 	AddUserToOrganization(ctx context.Context, in *AddUserToOrganizationRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	RemoveUserFromOrganization(ctx context.Context, in *RemoveUserFromOrganizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListUserOrganizations(ctx context.Context, in *ListUserOrganizationsRequest, opts ...grpc.CallOption) (*ListUserOrganizationsResponse, error)
+	// Invitations
+	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InvitationResponse, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetInvitation(ctx context.Context, in *GetInvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Bulk import of departments, designations and users (CSV or XLSX)
+	BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error)
+	// Approval delegations: a user hands some or all of their approval
+	// permissions to another user for a date range
+	CreateDelegation(ctx context.Context, in *CreateDelegationRequest, opts ...grpc.CallOption) (*DelegationResponse, error)
+	ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error)
+	RevokeDelegation(ctx context.Context, in *RevokeDelegationRequest, opts ...grpc.CallOption) (*DelegationResponse, error)
+	// Scoped role assignments: a role valid only in one organization, project
+	// or department, on top of the roles a user holds everywhere
+	AssignScopedRole(ctx context.Context, in *AssignScopedRoleRequest, opts ...grpc.CallOption) (*ScopedRoleAssignment, error)
+	ListScopedRoles(ctx context.Context, in *ListScopedRolesRequest, opts ...grpc.CallOption) (*ListScopedRolesResponse, error)
+	RevokeScopedRole(ctx context.Context, in *RevokeScopedRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Policy query: may a user perform an action on a resource
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
 	// Dropdown endpoints for Create User form
 	GetDepartmentsDropdown(ctx context.Context, in *GetDropdownRequest, opts ...grpc.CallOption) (*DepartmentsDropdownResponse, error)
 	GetDesignationsDropdown(ctx context.Context, in *GetDropdownRequest, opts ...grpc.CallOption) (*DesignationsDropdownResponse, error)
@@ -149,6 +186,16 @@ func (c *userManagementClient) GetTenant(ctx context.Context, in *GetTenantReque
 	return out, nil
 }
 
+func (c *userManagementClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserManagement_DeleteTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userManagementClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RoleResponse)
@@ -439,6 +486,136 @@ func (c *userManagementClient) ListUserOrganizations(ctx context.Context, in *Li
 	return out, nil
 }
 
+func (c *userManagementClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvitationResponse)
+	err := c.cc.Invoke(ctx, UserManagement_InviteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, UserManagement_ListInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserManagement_RevokeInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) GetInvitation(ctx context.Context, in *GetInvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvitationResponse)
+	err := c.cc.Invoke(ctx, UserManagement_GetInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserManagement_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) BulkImport(ctx context.Context, in *BulkImportRequest, opts ...grpc.CallOption) (*BulkImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkImportResponse)
+	err := c.cc.Invoke(ctx, UserManagement_BulkImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) CreateDelegation(ctx context.Context, in *CreateDelegationRequest, opts ...grpc.CallOption) (*DelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelegationResponse)
+	err := c.cc.Invoke(ctx, UserManagement_CreateDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDelegationsResponse)
+	err := c.cc.Invoke(ctx, UserManagement_ListDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) RevokeDelegation(ctx context.Context, in *RevokeDelegationRequest, opts ...grpc.CallOption) (*DelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelegationResponse)
+	err := c.cc.Invoke(ctx, UserManagement_RevokeDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) AssignScopedRole(ctx context.Context, in *AssignScopedRoleRequest, opts ...grpc.CallOption) (*ScopedRoleAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScopedRoleAssignment)
+	err := c.cc.Invoke(ctx, UserManagement_AssignScopedRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) ListScopedRoles(ctx context.Context, in *ListScopedRolesRequest, opts ...grpc.CallOption) (*ListScopedRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScopedRolesResponse)
+	err := c.cc.Invoke(ctx, UserManagement_ListScopedRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) RevokeScopedRole(ctx context.Context, in *RevokeScopedRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserManagement_RevokeScopedRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAccessResponse)
+	err := c.cc.Invoke(ctx, UserManagement_CheckAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userManagementClient) GetDepartmentsDropdown(ctx context.Context, in *GetDropdownRequest, opts ...grpc.CallOption) (*DepartmentsDropdownResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DepartmentsDropdownResponse)
@@ -510,6 +687,9 @@ type UserManagementServer interface {
 	// Tenant / Super Admin
 	CreateTenant(context.Context, *CreateTenantRequest) (*TenantResponse, error)
 	GetTenant(context.Context, *GetTenantRequest) (*TenantResponse, error)
+	// DeleteTenant removes a tenant that has no organizations, with its users
+	// and roles. Used to roll back a failed onboarding.
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*emptypb.Empty, error)
 	// Role Management (Dynamic)
 	CreateRole(context.Context, *CreateRoleRequest) (*RoleResponse, error)
 	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
@@ -550,6 +730,26 @@ type UserManagementServer interface {
 	AddUserToOrganization(context.Context, *AddUserToOrganizationRequest) (*UserResponse, error)
 	RemoveUserFromOrganization(context.Context, *RemoveUserFromOrganizationRequest) (*emptypb.Empty, error)
 	ListUserOrganizations(context.Context, *ListUserOrganizationsRequest) (*ListUserOrganizationsResponse, error)
+	// Invitations
+	InviteUser(context.Context, *InviteUserRequest) (*InvitationResponse, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error)
+	GetInvitation(context.Context, *GetInvitationRequest) (*InvitationResponse, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*UserResponse, error)
+	// Bulk import of departments, designations and users (CSV or XLSX)
+	BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error)
+	// Approval delegations: a user hands some or all of their approval
+	// permissions to another user for a date range
+	CreateDelegation(context.Context, *CreateDelegationRequest) (*DelegationResponse, error)
+	ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error)
+	RevokeDelegation(context.Context, *RevokeDelegationRequest) (*DelegationResponse, error)
+	// Scoped role assignments: a role valid only in one organization, project
+	// or department, on top of the roles a user holds everywhere
+	AssignScopedRole(context.Context, *AssignScopedRoleRequest) (*ScopedRoleAssignment, error)
+	ListScopedRoles(context.Context, *ListScopedRolesRequest) (*ListScopedRolesResponse, error)
+	RevokeScopedRole(context.Context, *RevokeScopedRoleRequest) (*emptypb.Empty, error)
+	// Policy query: may a user perform an action on a resource
+	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
 	// Dropdown endpoints for Create User form
 	GetDepartmentsDropdown(context.Context, *GetDropdownRequest) (*DepartmentsDropdownResponse, error)
 	GetDesignationsDropdown(context.Context, *GetDropdownRequest) (*DesignationsDropdownResponse, error)
@@ -575,6 +775,9 @@ func (UnimplementedUserManagementServer) CreateTenant(context.Context, *CreateTe
 func (UnimplementedUserManagementServer) GetTenant(context.Context, *GetTenantRequest) (*TenantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
 }
+func (UnimplementedUserManagementServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
 func (UnimplementedUserManagementServer) CreateRole(context.Context, *CreateRoleRequest) (*RoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
 }
@@ -662,6 +865,45 @@ func (UnimplementedUserManagementServer) RemoveUserFromOrganization(context.Cont
 func (UnimplementedUserManagementServer) ListUserOrganizations(context.Context, *ListUserOrganizationsRequest) (*ListUserOrganizationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserOrganizations not implemented")
 }
+func (UnimplementedUserManagementServer) InviteUser(context.Context, *InviteUserRequest) (*InvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUser not implemented")
+}
+func (UnimplementedUserManagementServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedUserManagementServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedUserManagementServer) GetInvitation(context.Context, *GetInvitationRequest) (*InvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitation not implemented")
+}
+func (UnimplementedUserManagementServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedUserManagementServer) BulkImport(context.Context, *BulkImportRequest) (*BulkImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImport not implemented")
+}
+func (UnimplementedUserManagementServer) CreateDelegation(context.Context, *CreateDelegationRequest) (*DelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDelegation not implemented")
+}
+func (UnimplementedUserManagementServer) ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelegations not implemented")
+}
+func (UnimplementedUserManagementServer) RevokeDelegation(context.Context, *RevokeDelegationRequest) (*DelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDelegation not implemented")
+}
+func (UnimplementedUserManagementServer) AssignScopedRole(context.Context, *AssignScopedRoleRequest) (*ScopedRoleAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignScopedRole not implemented")
+}
+func (UnimplementedUserManagementServer) ListScopedRoles(context.Context, *ListScopedRolesRequest) (*ListScopedRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScopedRoles not implemented")
+}
+func (UnimplementedUserManagementServer) RevokeScopedRole(context.Context, *RevokeScopedRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeScopedRole not implemented")
+}
+func (UnimplementedUserManagementServer) CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
 func (UnimplementedUserManagementServer) GetDepartmentsDropdown(context.Context, *GetDropdownRequest) (*DepartmentsDropdownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDepartmentsDropdown not implemented")
 }
@@ -737,6 +979,24 @@ func _UserManagement_GetTenant_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManagement_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserManagement_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRoleRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1519,240 @@ func _UserManagement_ListUserOrganizations_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManagement_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).InviteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_InviteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).InviteUser(ctx, req.(*InviteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_GetInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).GetInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_GetInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).GetInvitation(ctx, req.(*GetInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_BulkImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).BulkImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_BulkImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).BulkImport(ctx, req.(*BulkImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_CreateDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).CreateDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_CreateDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).CreateDelegation(ctx, req.(*CreateDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_ListDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).ListDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_ListDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).ListDelegations(ctx, req.(*ListDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_RevokeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).RevokeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_RevokeDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).RevokeDelegation(ctx, req.(*RevokeDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_AssignScopedRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignScopedRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).AssignScopedRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_AssignScopedRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).AssignScopedRole(ctx, req.(*AssignScopedRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_ListScopedRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScopedRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).ListScopedRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_ListScopedRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).ListScopedRoles(ctx, req.(*ListScopedRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_RevokeScopedRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeScopedRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).RevokeScopedRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_RevokeScopedRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).RevokeScopedRole(ctx, req.(*RevokeScopedRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagement_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserManagement_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserManagement_GetDepartmentsDropdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDropdownRequest)
 	if err := dec(in); err != nil {
@@ -1382,6 +1876,10 @@ var UserManagement_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenant",
 			Handler:    _UserManagement_GetTenant_Handler,
 		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _UserManagement_DeleteTenant_Handler,
+		},
 		{
 			MethodName: "CreateRole",
 			Handler:    _UserManagement_CreateRole_Handler,
@@ -1498,6 +1996,58 @@ var UserManagement_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUserOrganizations",
 			Handler:    _UserManagement_ListUserOrganizations_Handler,
 		},
+		{
+			MethodName: "InviteUser",
+			Handler:    _UserManagement_InviteUser_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _UserManagement_ListInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _UserManagement_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "GetInvitation",
+			Handler:    _UserManagement_GetInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _UserManagement_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "BulkImport",
+			Handler:    _UserManagement_BulkImport_Handler,
+		},
+		{
+			MethodName: "CreateDelegation",
+			Handler:    _UserManagement_CreateDelegation_Handler,
+		},
+		{
+			MethodName: "ListDelegations",
+			Handler:    _UserManagement_ListDelegations_Handler,
+		},
+		{
+			MethodName: "RevokeDelegation",
+			Handler:    _UserManagement_RevokeDelegation_Handler,
+		},
+		{
+			MethodName: "AssignScopedRole",
+			Handler:    _UserManagement_AssignScopedRole_Handler,
+		},
+		{
+			MethodName: "ListScopedRoles",
+			Handler:    _UserManagement_ListScopedRoles_Handler,
+		},
+		{
+			MethodName: "RevokeScopedRole",
+			Handler:    _UserManagement_RevokeScopedRole_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _UserManagement_CheckAccess_Handler,
+		},
 		{
 			MethodName: "GetDepartmentsDropdown",
 			Handler:    _UserManagement_GetDepartmentsDropdown_Handler,
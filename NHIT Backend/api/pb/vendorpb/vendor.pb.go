@@ -582,24 +582,26 @@ func (x *Vendor) GetSignatureUrl() string {
 }
 
 type VendorAccount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	VendorId      string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
-	AccountName   string                 `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	AccountNumber string                 `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	AccountType   *string                `protobuf:"bytes,5,opt,name=account_type,json=accountType,proto3,oneof" json:"account_type,omitempty"`
-	NameOfBank    string                 `protobuf:"bytes,6,opt,name=name_of_bank,json=nameOfBank,proto3" json:"name_of_bank,omitempty"`
-	BranchName    *string                `protobuf:"bytes,7,opt,name=branch_name,json=branchName,proto3,oneof" json:"branch_name,omitempty"`
-	IfscCode      string                 `protobuf:"bytes,8,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
-	SwiftCode     *string                `protobuf:"bytes,9,opt,name=swift_code,json=swiftCode,proto3,oneof" json:"swift_code,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,10,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	IsActive      bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Remarks       *string                `protobuf:"bytes,12,opt,name=remarks,proto3,oneof" json:"remarks,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VendorId       string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	AccountName    string                 `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountNumber  string                 `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountType    *string                `protobuf:"bytes,5,opt,name=account_type,json=accountType,proto3,oneof" json:"account_type,omitempty"`
+	NameOfBank     string                 `protobuf:"bytes,6,opt,name=name_of_bank,json=nameOfBank,proto3" json:"name_of_bank,omitempty"`
+	BranchName     *string                `protobuf:"bytes,7,opt,name=branch_name,json=branchName,proto3,oneof" json:"branch_name,omitempty"`
+	IfscCode       string                 `protobuf:"bytes,8,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
+	SwiftCode      *string                `protobuf:"bytes,9,opt,name=swift_code,json=swiftCode,proto3,oneof" json:"swift_code,omitempty"`
+	IsPrimary      bool                   `protobuf:"varint,10,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	IsActive       bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Remarks        *string                `protobuf:"bytes,12,opt,name=remarks,proto3,oneof" json:"remarks,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ApprovalStatus string                 `protobuf:"bytes,16,opt,name=approval_status,json=approvalStatus,proto3" json:"approval_status,omitempty"`
+	PayableFrom    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=payable_from,json=payableFrom,proto3,oneof" json:"payable_from,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *VendorAccount) Reset() {
@@ -737,6 +739,252 @@ func (x *VendorAccount) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *VendorAccount) GetApprovalStatus() string {
+	if x != nil {
+		return x.ApprovalStatus
+	}
+	return ""
+}
+
+func (x *VendorAccount) GetPayableFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PayableFrom
+	}
+	return nil
+}
+
+type BankDetailsSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountType   *string                `protobuf:"bytes,3,opt,name=account_type,json=accountType,proto3,oneof" json:"account_type,omitempty"`
+	NameOfBank    string                 `protobuf:"bytes,4,opt,name=name_of_bank,json=nameOfBank,proto3" json:"name_of_bank,omitempty"`
+	BranchName    *string                `protobuf:"bytes,5,opt,name=branch_name,json=branchName,proto3,oneof" json:"branch_name,omitempty"`
+	IfscCode      string                 `protobuf:"bytes,6,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
+	SwiftCode     *string                `protobuf:"bytes,7,opt,name=swift_code,json=swiftCode,proto3,oneof" json:"swift_code,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,8,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BankDetailsSnapshot) Reset() {
+	*x = BankDetailsSnapshot{}
+	mi := &file_api_proto_vendor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankDetailsSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankDetailsSnapshot) ProtoMessage() {}
+
+func (x *BankDetailsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankDetailsSnapshot.ProtoReflect.Descriptor instead.
+func (*BankDetailsSnapshot) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BankDetailsSnapshot) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *BankDetailsSnapshot) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *BankDetailsSnapshot) GetAccountType() string {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return ""
+}
+
+func (x *BankDetailsSnapshot) GetNameOfBank() string {
+	if x != nil {
+		return x.NameOfBank
+	}
+	return ""
+}
+
+func (x *BankDetailsSnapshot) GetBranchName() string {
+	if x != nil && x.BranchName != nil {
+		return *x.BranchName
+	}
+	return ""
+}
+
+func (x *BankDetailsSnapshot) GetIfscCode() string {
+	if x != nil {
+		return x.IfscCode
+	}
+	return ""
+}
+
+func (x *BankDetailsSnapshot) GetSwiftCode() string {
+	if x != nil && x.SwiftCode != nil {
+		return *x.SwiftCode
+	}
+	return ""
+}
+
+func (x *BankDetailsSnapshot) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type VendorAccountChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VendorId      string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ChangeType    string                 `protobuf:"bytes,4,opt,name=change_type,json=changeType,proto3" json:"change_type,omitempty"`
+	OldValues     *BankDetailsSnapshot   `protobuf:"bytes,5,opt,name=old_values,json=oldValues,proto3" json:"old_values,omitempty"`
+	NewValues     *BankDetailsSnapshot   `protobuf:"bytes,6,opt,name=new_values,json=newValues,proto3" json:"new_values,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ReviewedBy    *string                `protobuf:"bytes,10,opt,name=reviewed_by,json=reviewedBy,proto3,oneof" json:"reviewed_by,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=reviewed_at,json=reviewedAt,proto3,oneof" json:"reviewed_at,omitempty"`
+	ReviewRemarks *string                `protobuf:"bytes,12,opt,name=review_remarks,json=reviewRemarks,proto3,oneof" json:"review_remarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorAccountChange) Reset() {
+	*x = VendorAccountChange{}
+	mi := &file_api_proto_vendor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorAccountChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorAccountChange) ProtoMessage() {}
+
+func (x *VendorAccountChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorAccountChange.ProtoReflect.Descriptor instead.
+func (*VendorAccountChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VendorAccountChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VendorAccountChange) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *VendorAccountChange) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *VendorAccountChange) GetChangeType() string {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ""
+}
+
+func (x *VendorAccountChange) GetOldValues() *BankDetailsSnapshot {
+	if x != nil {
+		return x.OldValues
+	}
+	return nil
+}
+
+func (x *VendorAccountChange) GetNewValues() *BankDetailsSnapshot {
+	if x != nil {
+		return x.NewValues
+	}
+	return nil
+}
+
+func (x *VendorAccountChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VendorAccountChange) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *VendorAccountChange) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *VendorAccountChange) GetReviewedBy() string {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *VendorAccountChange) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *VendorAccountChange) GetReviewRemarks() string {
+	if x != nil && x.ReviewRemarks != nil {
+		return *x.ReviewRemarks
+	}
+	return ""
+}
+
 type BankingDetails struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
@@ -753,7 +1001,7 @@ type BankingDetails struct {
 
 func (x *BankingDetails) Reset() {
 	*x = BankingDetails{}
-	mi := &file_api_proto_vendor_proto_msgTypes[2]
+	mi := &file_api_proto_vendor_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +1013,7 @@ func (x *BankingDetails) String() string {
 func (*BankingDetails) ProtoMessage() {}
 
 func (x *BankingDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[2]
+	mi := &file_api_proto_vendor_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +1026,7 @@ func (x *BankingDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BankingDetails.ProtoReflect.Descriptor instead.
 func (*BankingDetails) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BankingDetails) GetAccountName() string {
@@ -879,13 +1127,16 @@ type CreateVendorRequest struct {
 	NameOfBank             *string                `protobuf:"bytes,40,opt,name=name_of_bank,proto3,oneof" json:"name_of_bank,omitempty"`
 	IfscCode               *string                `protobuf:"bytes,41,opt,name=ifsc_code,proto3,oneof" json:"ifsc_code,omitempty"`
 	Address                *string                `protobuf:"bytes,42,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// Create even if a vendor with the same PAN or name exists.
+	// GSTIN and bank account matches are always rejected.
+	AllowDuplicate bool `protobuf:"varint,43,opt,name=allow_duplicate,proto3" json:"allow_duplicate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateVendorRequest) Reset() {
 	*x = CreateVendorRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[3]
+	mi := &file_api_proto_vendor_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +1148,7 @@ func (x *CreateVendorRequest) String() string {
 func (*CreateVendorRequest) ProtoMessage() {}
 
 func (x *CreateVendorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[3]
+	mi := &file_api_proto_vendor_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1161,7 @@ func (x *CreateVendorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVendorRequest.ProtoReflect.Descriptor instead.
 func (*CreateVendorRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateVendorRequest) GetTenantId() string {
@@ -1186,6 +1437,13 @@ func (x *CreateVendorRequest) GetAddress() string {
 	return ""
 }
 
+func (x *CreateVendorRequest) GetAllowDuplicate() bool {
+	if x != nil {
+		return x.AllowDuplicate
+	}
+	return false
+}
+
 type GetVendorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -1196,7 +1454,7 @@ type GetVendorRequest struct {
 
 func (x *GetVendorRequest) Reset() {
 	*x = GetVendorRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[4]
+	mi := &file_api_proto_vendor_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1208,7 +1466,7 @@ func (x *GetVendorRequest) String() string {
 func (*GetVendorRequest) ProtoMessage() {}
 
 func (x *GetVendorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[4]
+	mi := &file_api_proto_vendor_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1479,7 @@ func (x *GetVendorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVendorRequest.ProtoReflect.Descriptor instead.
 func (*GetVendorRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetVendorRequest) GetTenantId() string {
@@ -1248,7 +1506,7 @@ type GetVendorByCodeRequest struct {
 
 func (x *GetVendorByCodeRequest) Reset() {
 	*x = GetVendorByCodeRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[5]
+	mi := &file_api_proto_vendor_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1260,7 +1518,7 @@ func (x *GetVendorByCodeRequest) String() string {
 func (*GetVendorByCodeRequest) ProtoMessage() {}
 
 func (x *GetVendorByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[5]
+	mi := &file_api_proto_vendor_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1273,7 +1531,7 @@ func (x *GetVendorByCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVendorByCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetVendorByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetVendorByCodeRequest) GetTenantId() string {
@@ -1336,7 +1594,7 @@ type UpdateVendorRequest struct {
 
 func (x *UpdateVendorRequest) Reset() {
 	*x = UpdateVendorRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[6]
+	mi := &file_api_proto_vendor_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1348,7 +1606,7 @@ func (x *UpdateVendorRequest) String() string {
 func (*UpdateVendorRequest) ProtoMessage() {}
 
 func (x *UpdateVendorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[6]
+	mi := &file_api_proto_vendor_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,7 +1619,7 @@ func (x *UpdateVendorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVendorRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVendorRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateVendorRequest) GetTenantId() string {
@@ -1640,7 +1898,7 @@ type DeleteVendorRequest struct {
 
 func (x *DeleteVendorRequest) Reset() {
 	*x = DeleteVendorRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[7]
+	mi := &file_api_proto_vendor_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1652,7 +1910,7 @@ func (x *DeleteVendorRequest) String() string {
 func (*DeleteVendorRequest) ProtoMessage() {}
 
 func (x *DeleteVendorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[7]
+	mi := &file_api_proto_vendor_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1665,7 +1923,7 @@ func (x *DeleteVendorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVendorRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVendorRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteVendorRequest) GetTenantId() string {
@@ -1701,7 +1959,7 @@ type ListVendorsRequest struct {
 
 func (x *ListVendorsRequest) Reset() {
 	*x = ListVendorsRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[8]
+	mi := &file_api_proto_vendor_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1713,7 +1971,7 @@ func (x *ListVendorsRequest) String() string {
 func (*ListVendorsRequest) ProtoMessage() {}
 
 func (x *ListVendorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[8]
+	mi := &file_api_proto_vendor_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1726,7 +1984,7 @@ func (x *ListVendorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVendorsRequest.ProtoReflect.Descriptor instead.
 func (*ListVendorsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListVendorsRequest) GetTenantId() string {
@@ -1818,7 +2076,7 @@ type PaginationMetadata struct {
 
 func (x *PaginationMetadata) Reset() {
 	*x = PaginationMetadata{}
-	mi := &file_api_proto_vendor_proto_msgTypes[9]
+	mi := &file_api_proto_vendor_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1830,7 +2088,7 @@ func (x *PaginationMetadata) String() string {
 func (*PaginationMetadata) ProtoMessage() {}
 
 func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[9]
+	mi := &file_api_proto_vendor_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,7 +2101,7 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
 func (*PaginationMetadata) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PaginationMetadata) GetCurrentPage() int32 {
@@ -1884,7 +2142,7 @@ type GenerateVendorCodeRequest struct {
 
 func (x *GenerateVendorCodeRequest) Reset() {
 	*x = GenerateVendorCodeRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[10]
+	mi := &file_api_proto_vendor_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1896,7 +2154,7 @@ func (x *GenerateVendorCodeRequest) String() string {
 func (*GenerateVendorCodeRequest) ProtoMessage() {}
 
 func (x *GenerateVendorCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[10]
+	mi := &file_api_proto_vendor_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1909,7 +2167,7 @@ func (x *GenerateVendorCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateVendorCodeRequest.ProtoReflect.Descriptor instead.
 func (*GenerateVendorCodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{10}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GenerateVendorCodeRequest) GetVendorName() string {
@@ -1937,7 +2195,7 @@ type UpdateVendorCodeRequest struct {
 
 func (x *UpdateVendorCodeRequest) Reset() {
 	*x = UpdateVendorCodeRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[11]
+	mi := &file_api_proto_vendor_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1949,7 +2207,7 @@ func (x *UpdateVendorCodeRequest) String() string {
 func (*UpdateVendorCodeRequest) ProtoMessage() {}
 
 func (x *UpdateVendorCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[11]
+	mi := &file_api_proto_vendor_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1962,7 +2220,7 @@ func (x *UpdateVendorCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVendorCodeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVendorCodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateVendorCodeRequest) GetTenantId() string {
@@ -1996,7 +2254,7 @@ type RegenerateVendorCodeRequest struct {
 
 func (x *RegenerateVendorCodeRequest) Reset() {
 	*x = RegenerateVendorCodeRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[12]
+	mi := &file_api_proto_vendor_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2008,7 +2266,7 @@ func (x *RegenerateVendorCodeRequest) String() string {
 func (*RegenerateVendorCodeRequest) ProtoMessage() {}
 
 func (x *RegenerateVendorCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[12]
+	mi := &file_api_proto_vendor_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,7 +2279,7 @@ func (x *RegenerateVendorCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegenerateVendorCodeRequest.ProtoReflect.Descriptor instead.
 func (*RegenerateVendorCodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{12}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RegenerateVendorCodeRequest) GetTenantId() string {
@@ -2057,7 +2315,7 @@ type CreateVendorAccountRequest struct {
 
 func (x *CreateVendorAccountRequest) Reset() {
 	*x = CreateVendorAccountRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[13]
+	mi := &file_api_proto_vendor_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2069,7 +2327,7 @@ func (x *CreateVendorAccountRequest) String() string {
 func (*CreateVendorAccountRequest) ProtoMessage() {}
 
 func (x *CreateVendorAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[13]
+	mi := &file_api_proto_vendor_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2082,7 +2340,7 @@ func (x *CreateVendorAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVendorAccountRequest.ProtoReflect.Descriptor instead.
 func (*CreateVendorAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{13}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateVendorAccountRequest) GetVendorId() string {
@@ -2171,7 +2429,7 @@ type GetVendorAccountsRequest struct {
 
 func (x *GetVendorAccountsRequest) Reset() {
 	*x = GetVendorAccountsRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[14]
+	mi := &file_api_proto_vendor_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2183,7 +2441,7 @@ func (x *GetVendorAccountsRequest) String() string {
 func (*GetVendorAccountsRequest) ProtoMessage() {}
 
 func (x *GetVendorAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[14]
+	mi := &file_api_proto_vendor_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2196,7 +2454,7 @@ func (x *GetVendorAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVendorAccountsRequest.ProtoReflect.Descriptor instead.
 func (*GetVendorAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{14}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetVendorAccountsRequest) GetVendorId() string {
@@ -2216,7 +2474,7 @@ type GetVendorBankingDetailsRequest struct {
 
 func (x *GetVendorBankingDetailsRequest) Reset() {
 	*x = GetVendorBankingDetailsRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[15]
+	mi := &file_api_proto_vendor_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2228,7 +2486,7 @@ func (x *GetVendorBankingDetailsRequest) String() string {
 func (*GetVendorBankingDetailsRequest) ProtoMessage() {}
 
 func (x *GetVendorBankingDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[15]
+	mi := &file_api_proto_vendor_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,7 +2499,7 @@ func (x *GetVendorBankingDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVendorBankingDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetVendorBankingDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{15}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetVendorBankingDetailsRequest) GetVendorId() string {
@@ -2278,7 +2536,7 @@ type UpdateVendorAccountRequest struct {
 
 func (x *UpdateVendorAccountRequest) Reset() {
 	*x = UpdateVendorAccountRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[16]
+	mi := &file_api_proto_vendor_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2290,7 +2548,7 @@ func (x *UpdateVendorAccountRequest) String() string {
 func (*UpdateVendorAccountRequest) ProtoMessage() {}
 
 func (x *UpdateVendorAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[16]
+	mi := &file_api_proto_vendor_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2303,7 +2561,7 @@ func (x *UpdateVendorAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVendorAccountRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVendorAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{16}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateVendorAccountRequest) GetAccountId() string {
@@ -2399,7 +2657,7 @@ type DeleteVendorAccountRequest struct {
 
 func (x *DeleteVendorAccountRequest) Reset() {
 	*x = DeleteVendorAccountRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[17]
+	mi := &file_api_proto_vendor_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2411,7 +2669,7 @@ func (x *DeleteVendorAccountRequest) String() string {
 func (*DeleteVendorAccountRequest) ProtoMessage() {}
 
 func (x *DeleteVendorAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[17]
+	mi := &file_api_proto_vendor_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2424,7 +2682,7 @@ func (x *DeleteVendorAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVendorAccountRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVendorAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{17}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteVendorAccountRequest) GetAccountId() string {
@@ -2443,7 +2701,7 @@ type ToggleAccountStatusRequest struct {
 
 func (x *ToggleAccountStatusRequest) Reset() {
 	*x = ToggleAccountStatusRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[18]
+	mi := &file_api_proto_vendor_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2455,7 +2713,7 @@ func (x *ToggleAccountStatusRequest) String() string {
 func (*ToggleAccountStatusRequest) ProtoMessage() {}
 
 func (x *ToggleAccountStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[18]
+	mi := &file_api_proto_vendor_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,7 +2726,7 @@ func (x *ToggleAccountStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToggleAccountStatusRequest.ProtoReflect.Descriptor instead.
 func (*ToggleAccountStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{18}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ToggleAccountStatusRequest) GetAccountId() string {
@@ -2478,29 +2736,31 @@ func (x *ToggleAccountStatusRequest) GetAccountId() string {
 	return ""
 }
 
-// Response messages
-type VendorResponse struct {
+type ListVendorAccountChangesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vendor        *Vendor                `protobuf:"bytes,1,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Status        *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	VendorId      *string                `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3,oneof" json:"vendor_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VendorResponse) Reset() {
-	*x = VendorResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[19]
+func (x *ListVendorAccountChangesRequest) Reset() {
+	*x = ListVendorAccountChangesRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VendorResponse) String() string {
+func (x *ListVendorAccountChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VendorResponse) ProtoMessage() {}
+func (*ListVendorAccountChangesRequest) ProtoMessage() {}
 
-func (x *VendorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[19]
+func (x *ListVendorAccountChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2511,42 +2771,62 @@ func (x *VendorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VendorResponse.ProtoReflect.Descriptor instead.
-func (*VendorResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListVendorAccountChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListVendorAccountChangesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *VendorResponse) GetVendor() *Vendor {
+func (x *ListVendorAccountChangesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListVendorAccountChangesRequest) GetVendorId() string {
+	if x != nil && x.VendorId != nil {
+		return *x.VendorId
+	}
+	return ""
+}
+
+func (x *ListVendorAccountChangesRequest) GetPage() int32 {
 	if x != nil {
-		return x.Vendor
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type ListVendorsResponse struct {
+func (x *ListVendorAccountChangesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ReviewVendorAccountChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vendors       []*Vendor              `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Remarks       *string                `protobuf:"bytes,2,opt,name=remarks,proto3,oneof" json:"remarks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVendorsResponse) Reset() {
-	*x = ListVendorsResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[20]
+func (x *ReviewVendorAccountChangeRequest) Reset() {
+	*x = ReviewVendorAccountChangeRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVendorsResponse) String() string {
+func (x *ReviewVendorAccountChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVendorsResponse) ProtoMessage() {}
+func (*ReviewVendorAccountChangeRequest) ProtoMessage() {}
 
-func (x *ListVendorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[20]
+func (x *ReviewVendorAccountChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,54 +2837,4852 @@ func (x *ListVendorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVendorsResponse.ProtoReflect.Descriptor instead.
-func (*ListVendorsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ReviewVendorAccountChangeRequest.ProtoReflect.Descriptor instead.
+func (*ReviewVendorAccountChangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListVendorsResponse) GetVendors() []*Vendor {
+func (x *ReviewVendorAccountChangeRequest) GetChangeId() string {
 	if x != nil {
-		return x.Vendors
+		return x.ChangeId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListVendorsResponse) GetTotalCount() int64 {
+func (x *ReviewVendorAccountChangeRequest) GetRemarks() string {
+	if x != nil && x.Remarks != nil {
+		return *x.Remarks
+	}
+	return ""
+}
+
+type VerifyPayeeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountNumber string                 `protobuf:"bytes,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	IfscCode      string                 `protobuf:"bytes,2,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPayeeAccountRequest) Reset() {
+	*x = VerifyPayeeAccountRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPayeeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPayeeAccountRequest) ProtoMessage() {}
+
+func (x *VerifyPayeeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[23]
 	if x != nil {
-		return x.TotalCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListVendorsResponse) GetPagination() *PaginationMetadata {
+// Deprecated: Use VerifyPayeeAccountRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPayeeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerifyPayeeAccountRequest) GetAccountNumber() string {
 	if x != nil {
-		return x.Pagination
+		return x.AccountNumber
 	}
-	return nil
+	return ""
 }
 
-type GenerateVendorCodeResponse struct {
+func (x *VerifyPayeeAccountRequest) GetIfscCode() string {
+	if x != nil {
+		return x.IfscCode
+	}
+	return ""
+}
+
+type FindDuplicateVendorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VendorCode    string                 `protobuf:"bytes,1,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	VendorName    string                 `protobuf:"bytes,1,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	Pan           string                 `protobuf:"bytes,2,opt,name=pan,proto3" json:"pan,omitempty"`
+	Gstin         *string                `protobuf:"bytes,3,opt,name=gstin,proto3,oneof" json:"gstin,omitempty"`
+	AccountNumber *string                `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3,oneof" json:"account_number,omitempty"`
+	IfscCode      *string                `protobuf:"bytes,5,opt,name=ifsc_code,json=ifscCode,proto3,oneof" json:"ifsc_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateVendorsRequest) Reset() {
+	*x = FindDuplicateVendorsRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateVendorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateVendorsRequest) ProtoMessage() {}
+
+func (x *FindDuplicateVendorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateVendorsRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicateVendorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FindDuplicateVendorsRequest) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *FindDuplicateVendorsRequest) GetPan() string {
+	if x != nil {
+		return x.Pan
+	}
+	return ""
+}
+
+func (x *FindDuplicateVendorsRequest) GetGstin() string {
+	if x != nil && x.Gstin != nil {
+		return *x.Gstin
+	}
+	return ""
+}
+
+func (x *FindDuplicateVendorsRequest) GetAccountNumber() string {
+	if x != nil && x.AccountNumber != nil {
+		return *x.AccountNumber
+	}
+	return ""
+}
+
+func (x *FindDuplicateVendorsRequest) GetIfscCode() string {
+	if x != nil && x.IfscCode != nil {
+		return *x.IfscCode
+	}
+	return ""
+}
+
+type ImportVendorsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FileContent []byte                 `protobuf:"bytes,1,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	FileName    string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// csv or xlsx; derived from file_name when empty
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Validate only, report per-row errors and write nothing
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Import vendors whose PAN or name matches an existing vendor
+	AllowDuplicates bool `protobuf:"varint,5,opt,name=allow_duplicates,json=allowDuplicates,proto3" json:"allow_duplicates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportVendorsRequest) Reset() {
+	*x = ImportVendorsRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVendorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVendorsRequest) ProtoMessage() {}
+
+func (x *ImportVendorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVendorsRequest.ProtoReflect.Descriptor instead.
+func (*ImportVendorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportVendorsRequest) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+func (x *ImportVendorsRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportVendorsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportVendorsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportVendorsRequest) GetAllowDuplicates() bool {
+	if x != nil {
+		return x.AllowDuplicates
+	}
+	return false
+}
+
+type ExportVendorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv (default) or xlsx
+	Format        string  `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Search        *string `protobuf:"bytes,2,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	IsActive      *bool   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	AccountType   *string `protobuf:"bytes,4,opt,name=account_type,json=accountType,proto3,oneof" json:"account_type,omitempty"`
+	Project       *string `protobuf:"bytes,5,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportVendorsRequest) Reset() {
+	*x = ExportVendorsRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportVendorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVendorsRequest) ProtoMessage() {}
+
+func (x *ExportVendorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVendorsRequest.ProtoReflect.Descriptor instead.
+func (*ExportVendorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportVendorsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportVendorsRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ExportVendorsRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ExportVendorsRequest) GetAccountType() string {
+	if x != nil && x.AccountType != nil {
+		return *x.AccountType
+	}
+	return ""
+}
+
+func (x *ExportVendorsRequest) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+type MergeVendorsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SurvivorVendorId string                 `protobuf:"bytes,1,opt,name=survivor_vendor_id,json=survivorVendorId,proto3" json:"survivor_vendor_id,omitempty"`
+	MergedVendorId   string                 `protobuf:"bytes,2,opt,name=merged_vendor_id,json=mergedVendorId,proto3" json:"merged_vendor_id,omitempty"`
+	Reason           *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MergeVendorsRequest) Reset() {
+	*x = MergeVendorsRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeVendorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeVendorsRequest) ProtoMessage() {}
+
+func (x *MergeVendorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeVendorsRequest.ProtoReflect.Descriptor instead.
+func (*MergeVendorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MergeVendorsRequest) GetSurvivorVendorId() string {
+	if x != nil {
+		return x.SurvivorVendorId
+	}
+	return ""
+}
+
+func (x *MergeVendorsRequest) GetMergedVendorId() string {
+	if x != nil {
+		return x.MergedVendorId
+	}
+	return ""
+}
+
+func (x *MergeVendorsRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// Response messages
+type VendorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vendor        *Vendor                `protobuf:"bytes,1,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorResponse) Reset() {
+	*x = VendorResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorResponse) ProtoMessage() {}
+
+func (x *VendorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorResponse.ProtoReflect.Descriptor instead.
+func (*VendorResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VendorResponse) GetVendor() *Vendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return nil
+}
+
+type ListVendorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vendors       []*Vendor              `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVendorsResponse) Reset() {
+	*x = ListVendorsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVendorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVendorsResponse) ProtoMessage() {}
+
+func (x *ListVendorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVendorsResponse.ProtoReflect.Descriptor instead.
+func (*ListVendorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListVendorsResponse) GetVendors() []*Vendor {
+	if x != nil {
+		return x.Vendors
+	}
+	return nil
+}
+
+func (x *ListVendorsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListVendorsResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GenerateVendorCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorCode    string                 `protobuf:"bytes,1,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateVendorCodeResponse) Reset() {
+	*x = GenerateVendorCodeResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateVendorCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateVendorCodeResponse) ProtoMessage() {}
+
+func (x *GenerateVendorCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateVendorCodeResponse.ProtoReflect.Descriptor instead.
+func (*GenerateVendorCodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GenerateVendorCodeResponse) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+type VendorAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *VendorAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorAccountResponse) Reset() {
+	*x = VendorAccountResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorAccountResponse) ProtoMessage() {}
+
+func (x *VendorAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorAccountResponse.ProtoReflect.Descriptor instead.
+func (*VendorAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VendorAccountResponse) GetAccount() *VendorAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetVendorAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*VendorAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVendorAccountsResponse) Reset() {
+	*x = GetVendorAccountsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVendorAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVendorAccountsResponse) ProtoMessage() {}
+
+func (x *GetVendorAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVendorAccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetVendorAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetVendorAccountsResponse) GetAccounts() []*VendorAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type BankingDetailsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BankingDetails *BankingDetails        `protobuf:"bytes,1,opt,name=banking_details,json=bankingDetails,proto3" json:"banking_details,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BankingDetailsResponse) Reset() {
+	*x = BankingDetailsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankingDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankingDetailsResponse) ProtoMessage() {}
+
+func (x *BankingDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankingDetailsResponse.ProtoReflect.Descriptor instead.
+func (*BankingDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BankingDetailsResponse) GetBankingDetails() *BankingDetails {
+	if x != nil {
+		return x.BankingDetails
+	}
+	return nil
+}
+
+type ListVendorAccountChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*VendorAccountChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVendorAccountChangesResponse) Reset() {
+	*x = ListVendorAccountChangesResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVendorAccountChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVendorAccountChangesResponse) ProtoMessage() {}
+
+func (x *ListVendorAccountChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVendorAccountChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListVendorAccountChangesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListVendorAccountChangesResponse) GetChanges() []*VendorAccountChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListVendorAccountChangesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListVendorAccountChangesResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type VendorAccountChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *VendorAccountChange   `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorAccountChangeResponse) Reset() {
+	*x = VendorAccountChangeResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorAccountChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorAccountChangeResponse) ProtoMessage() {}
+
+func (x *VendorAccountChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorAccountChangeResponse.ProtoReflect.Descriptor instead.
+func (*VendorAccountChangeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VendorAccountChangeResponse) GetChange() *VendorAccountChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type VerifyPayeeAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payable       bool                   `protobuf:"varint,1,opt,name=payable,proto3" json:"payable,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	VendorId      *string                `protobuf:"bytes,3,opt,name=vendor_id,json=vendorId,proto3,oneof" json:"vendor_id,omitempty"`
+	AccountId     *string                `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	PayableFrom   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=payable_from,json=payableFrom,proto3,oneof" json:"payable_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPayeeAccountResponse) Reset() {
+	*x = VerifyPayeeAccountResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPayeeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPayeeAccountResponse) ProtoMessage() {}
+
+func (x *VerifyPayeeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPayeeAccountResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPayeeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VerifyPayeeAccountResponse) GetPayable() bool {
+	if x != nil {
+		return x.Payable
+	}
+	return false
+}
+
+func (x *VerifyPayeeAccountResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VerifyPayeeAccountResponse) GetVendorId() string {
+	if x != nil && x.VendorId != nil {
+		return *x.VendorId
+	}
+	return ""
+}
+
+func (x *VerifyPayeeAccountResponse) GetAccountId() string {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return ""
+}
+
+func (x *VerifyPayeeAccountResponse) GetPayableFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PayableFrom
+	}
+	return nil
+}
+
+type DuplicateVendorMatch struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	VendorId   string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	VendorCode string                 `protobuf:"bytes,2,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	VendorName string                 `protobuf:"bytes,3,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	MatchedOn  []string               `protobuf:"bytes,4,rep,name=matched_on,json=matchedOn,proto3" json:"matched_on,omitempty"`
+	// GSTIN or bank account match: creation is rejected even with allow_duplicate
+	Blocking      bool `protobuf:"varint,5,opt,name=blocking,proto3" json:"blocking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateVendorMatch) Reset() {
+	*x = DuplicateVendorMatch{}
+	mi := &file_api_proto_vendor_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateVendorMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateVendorMatch) ProtoMessage() {}
+
+func (x *DuplicateVendorMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateVendorMatch.ProtoReflect.Descriptor instead.
+func (*DuplicateVendorMatch) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DuplicateVendorMatch) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *DuplicateVendorMatch) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *DuplicateVendorMatch) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *DuplicateVendorMatch) GetMatchedOn() []string {
+	if x != nil {
+		return x.MatchedOn
+	}
+	return nil
+}
+
+func (x *DuplicateVendorMatch) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+type FindDuplicateVendorsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Matches       []*DuplicateVendorMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateVendorsResponse) Reset() {
+	*x = FindDuplicateVendorsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateVendorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateVendorsResponse) ProtoMessage() {}
+
+func (x *FindDuplicateVendorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateVendorsResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicateVendorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FindDuplicateVendorsResponse) GetMatches() []*DuplicateVendorMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type VendorImportError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RowNumber     int32                  `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorImportError) Reset() {
+	*x = VendorImportError{}
+	mi := &file_api_proto_vendor_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorImportError) ProtoMessage() {}
+
+func (x *VendorImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorImportError.ProtoReflect.Descriptor instead.
+func (*VendorImportError) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VendorImportError) GetRowNumber() int32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *VendorImportError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *VendorImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportVendorsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ValidRows int32                  `protobuf:"varint,2,opt,name=valid_rows,json=validRows,proto3" json:"valid_rows,omitempty"`
+	DryRun    bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// False when dry_run is set or any row has errors; nothing was written then
+	Committed        bool                 `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	Errors           []*VendorImportError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	CreatedVendorIds []string             `protobuf:"bytes,6,rep,name=created_vendor_ids,json=createdVendorIds,proto3" json:"created_vendor_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportVendorsResponse) Reset() {
+	*x = ImportVendorsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVendorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVendorsResponse) ProtoMessage() {}
+
+func (x *ImportVendorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVendorsResponse.ProtoReflect.Descriptor instead.
+func (*ImportVendorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportVendorsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportVendorsResponse) GetValidRows() int32 {
+	if x != nil {
+		return x.ValidRows
+	}
+	return 0
+}
+
+func (x *ImportVendorsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportVendorsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *ImportVendorsResponse) GetErrors() []*VendorImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportVendorsResponse) GetCreatedVendorIds() []string {
+	if x != nil {
+		return x.CreatedVendorIds
+	}
+	return nil
+}
+
+type ExportVendorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileContent   []byte                 `protobuf:"bytes,1,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	RowCount      int32                  `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportVendorsResponse) Reset() {
+	*x = ExportVendorsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportVendorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVendorsResponse) ProtoMessage() {}
+
+func (x *ExportVendorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVendorsResponse.ProtoReflect.Descriptor instead.
+func (*ExportVendorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportVendorsResponse) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+func (x *ExportVendorsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportVendorsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportVendorsResponse) GetRowCount() int32 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+type VendorMerge struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SurvivorVendorId string                 `protobuf:"bytes,2,opt,name=survivor_vendor_id,json=survivorVendorId,proto3" json:"survivor_vendor_id,omitempty"`
+	MergedVendorId   string                 `protobuf:"bytes,3,opt,name=merged_vendor_id,json=mergedVendorId,proto3" json:"merged_vendor_id,omitempty"`
+	MergedVendorCode string                 `protobuf:"bytes,4,opt,name=merged_vendor_code,json=mergedVendorCode,proto3" json:"merged_vendor_code,omitempty"`
+	MergedVendorName string                 `protobuf:"bytes,5,opt,name=merged_vendor_name,json=mergedVendorName,proto3" json:"merged_vendor_name,omitempty"`
+	AccountsMoved    int32                  `protobuf:"varint,6,opt,name=accounts_moved,json=accountsMoved,proto3" json:"accounts_moved,omitempty"`
+	Reason           *string                `protobuf:"bytes,7,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	MergedBy         string                 `protobuf:"bytes,8,opt,name=merged_by,json=mergedBy,proto3" json:"merged_by,omitempty"`
+	MergedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VendorMerge) Reset() {
+	*x = VendorMerge{}
+	mi := &file_api_proto_vendor_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorMerge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorMerge) ProtoMessage() {}
+
+func (x *VendorMerge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorMerge.ProtoReflect.Descriptor instead.
+func (*VendorMerge) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VendorMerge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VendorMerge) GetSurvivorVendorId() string {
+	if x != nil {
+		return x.SurvivorVendorId
+	}
+	return ""
+}
+
+func (x *VendorMerge) GetMergedVendorId() string {
+	if x != nil {
+		return x.MergedVendorId
+	}
+	return ""
+}
+
+func (x *VendorMerge) GetMergedVendorCode() string {
+	if x != nil {
+		return x.MergedVendorCode
+	}
+	return ""
+}
+
+func (x *VendorMerge) GetMergedVendorName() string {
+	if x != nil {
+		return x.MergedVendorName
+	}
+	return ""
+}
+
+func (x *VendorMerge) GetAccountsMoved() int32 {
+	if x != nil {
+		return x.AccountsMoved
+	}
+	return 0
+}
+
+func (x *VendorMerge) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *VendorMerge) GetMergedBy() string {
+	if x != nil {
+		return x.MergedBy
+	}
+	return ""
+}
+
+func (x *VendorMerge) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type MergeVendorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merge         *VendorMerge           `protobuf:"bytes,1,opt,name=merge,proto3" json:"merge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeVendorsResponse) Reset() {
+	*x = MergeVendorsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeVendorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeVendorsResponse) ProtoMessage() {}
+
+func (x *MergeVendorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeVendorsResponse.ProtoReflect.Descriptor instead.
+func (*MergeVendorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MergeVendorsResponse) GetMerge() *VendorMerge {
+	if x != nil {
+		return x.Merge
+	}
+	return nil
+}
+
+// Money is an exact rupee amount with two decimal places. value is the
+// decimal string ("1234.50"); units and nanos carry the same amount like
+// google.type.Money. On input value wins over units/nanos when both are set.
+//
+// Amount doubles have a Money twin named <field>_exact. Requests may set
+// either; when the twin is set the double is ignored. Responses always set
+// both, so clients reading doubles keep working.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_api_proto_vendor_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Money) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+// Additional messages for VendorAccountController methods
+// MSME invoice tracked against its statutory due date. Dates are YYYY-MM-DD.
+type MSMEInvoice struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VendorId               string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	VendorCode             string                 `protobuf:"bytes,3,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	VendorName             string                 `protobuf:"bytes,4,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	MsmeClassification     string                 `protobuf:"bytes,5,opt,name=msme_classification,json=msmeClassification,proto3" json:"msme_classification,omitempty"`
+	MsmeRegistrationNumber string                 `protobuf:"bytes,6,opt,name=msme_registration_number,json=msmeRegistrationNumber,proto3" json:"msme_registration_number,omitempty"`
+	InvoiceNumber          string                 `protobuf:"bytes,7,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	InvoiceDate            string                 `protobuf:"bytes,8,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
+	AcceptanceDate         *string                `protobuf:"bytes,9,opt,name=acceptance_date,json=acceptanceDate,proto3,oneof" json:"acceptance_date,omitempty"`
+	AgreedCreditDays       *int32                 `protobuf:"varint,10,opt,name=agreed_credit_days,json=agreedCreditDays,proto3,oneof" json:"agreed_credit_days,omitempty"`
+	InvoiceAmount          float64                `protobuf:"fixed64,11,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
+	DueDate                string                 `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	GreenNoteId            *string                `protobuf:"bytes,13,opt,name=green_note_id,json=greenNoteId,proto3,oneof" json:"green_note_id,omitempty"`
+	PaymentNoteId          *string                `protobuf:"bytes,14,opt,name=payment_note_id,json=paymentNoteId,proto3,oneof" json:"payment_note_id,omitempty"`
+	PaidDate               *string                `protobuf:"bytes,15,opt,name=paid_date,json=paidDate,proto3,oneof" json:"paid_date,omitempty"`
+	PaymentReference       *string                `protobuf:"bytes,16,opt,name=payment_reference,json=paymentReference,proto3,oneof" json:"payment_reference,omitempty"`
+	// OPEN, AT_RISK, OVERDUE, PAID or PAID_LATE
+	Status string `protobuf:"bytes,17,opt,name=status,proto3" json:"status,omitempty"`
+	// Negative while days remain before the due date
+	DaysPastDue int32 `protobuf:"varint,18,opt,name=days_past_due,json=daysPastDue,proto3" json:"days_past_due,omitempty"`
+	// Section 16 interest owed on late payment
+	InterestLiability      float64                `protobuf:"fixed64,19,opt,name=interest_liability,json=interestLiability,proto3" json:"interest_liability,omitempty"`
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	InvoiceAmountExact     *Money                 `protobuf:"bytes,21,opt,name=invoice_amount_exact,json=invoiceAmountExact,proto3" json:"invoice_amount_exact,omitempty"`
+	InterestLiabilityExact *Money                 `protobuf:"bytes,22,opt,name=interest_liability_exact,json=interestLiabilityExact,proto3" json:"interest_liability_exact,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MSMEInvoice) Reset() {
+	*x = MSMEInvoice{}
+	mi := &file_api_proto_vendor_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSMEInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSMEInvoice) ProtoMessage() {}
+
+func (x *MSMEInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSMEInvoice.ProtoReflect.Descriptor instead.
+func (*MSMEInvoice) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MSMEInvoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetMsmeClassification() string {
+	if x != nil {
+		return x.MsmeClassification
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetMsmeRegistrationNumber() string {
+	if x != nil {
+		return x.MsmeRegistrationNumber
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetInvoiceDate() string {
+	if x != nil {
+		return x.InvoiceDate
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetAcceptanceDate() string {
+	if x != nil && x.AcceptanceDate != nil {
+		return *x.AcceptanceDate
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetAgreedCreditDays() int32 {
+	if x != nil && x.AgreedCreditDays != nil {
+		return *x.AgreedCreditDays
+	}
+	return 0
+}
+
+func (x *MSMEInvoice) GetInvoiceAmount() float64 {
+	if x != nil {
+		return x.InvoiceAmount
+	}
+	return 0
+}
+
+func (x *MSMEInvoice) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetGreenNoteId() string {
+	if x != nil && x.GreenNoteId != nil {
+		return *x.GreenNoteId
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetPaymentNoteId() string {
+	if x != nil && x.PaymentNoteId != nil {
+		return *x.PaymentNoteId
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetPaidDate() string {
+	if x != nil && x.PaidDate != nil {
+		return *x.PaidDate
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetPaymentReference() string {
+	if x != nil && x.PaymentReference != nil {
+		return *x.PaymentReference
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MSMEInvoice) GetDaysPastDue() int32 {
+	if x != nil {
+		return x.DaysPastDue
+	}
+	return 0
+}
+
+func (x *MSMEInvoice) GetInterestLiability() float64 {
+	if x != nil {
+		return x.InterestLiability
+	}
+	return 0
+}
+
+func (x *MSMEInvoice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MSMEInvoice) GetInvoiceAmountExact() *Money {
+	if x != nil {
+		return x.InvoiceAmountExact
+	}
+	return nil
+}
+
+func (x *MSMEInvoice) GetInterestLiabilityExact() *Money {
+	if x != nil {
+		return x.InterestLiabilityExact
+	}
+	return nil
+}
+
+type RegisterMSMEInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	InvoiceNumber string                 `protobuf:"bytes,2,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	InvoiceDate   string                 `protobuf:"bytes,3,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
+	// Defaults to invoice_date
+	AcceptanceDate *string `protobuf:"bytes,4,opt,name=acceptance_date,json=acceptanceDate,proto3,oneof" json:"acceptance_date,omitempty"`
+	// Written credit agreement, capped at 45 days; 15 days when absent
+	AgreedCreditDays   *int32  `protobuf:"varint,5,opt,name=agreed_credit_days,json=agreedCreditDays,proto3,oneof" json:"agreed_credit_days,omitempty"`
+	InvoiceAmount      float64 `protobuf:"fixed64,6,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
+	GreenNoteId        *string `protobuf:"bytes,7,opt,name=green_note_id,json=greenNoteId,proto3,oneof" json:"green_note_id,omitempty"`
+	PaymentNoteId      *string `protobuf:"bytes,8,opt,name=payment_note_id,json=paymentNoteId,proto3,oneof" json:"payment_note_id,omitempty"`
+	InvoiceAmountExact *Money  `protobuf:"bytes,9,opt,name=invoice_amount_exact,json=invoiceAmountExact,proto3" json:"invoice_amount_exact,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RegisterMSMEInvoiceRequest) Reset() {
+	*x = RegisterMSMEInvoiceRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterMSMEInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMSMEInvoiceRequest) ProtoMessage() {}
+
+func (x *RegisterMSMEInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMSMEInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterMSMEInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetInvoiceDate() string {
+	if x != nil {
+		return x.InvoiceDate
+	}
+	return ""
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetAcceptanceDate() string {
+	if x != nil && x.AcceptanceDate != nil {
+		return *x.AcceptanceDate
+	}
+	return ""
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetAgreedCreditDays() int32 {
+	if x != nil && x.AgreedCreditDays != nil {
+		return *x.AgreedCreditDays
+	}
+	return 0
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetInvoiceAmount() float64 {
+	if x != nil {
+		return x.InvoiceAmount
+	}
+	return 0
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetGreenNoteId() string {
+	if x != nil && x.GreenNoteId != nil {
+		return *x.GreenNoteId
+	}
+	return ""
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetPaymentNoteId() string {
+	if x != nil && x.PaymentNoteId != nil {
+		return *x.PaymentNoteId
+	}
+	return ""
+}
+
+func (x *RegisterMSMEInvoiceRequest) GetInvoiceAmountExact() *Money {
+	if x != nil {
+		return x.InvoiceAmountExact
+	}
+	return nil
+}
+
+type RecordMSMEInvoicePaymentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId        string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	PaidDate         string                 `protobuf:"bytes,2,opt,name=paid_date,json=paidDate,proto3" json:"paid_date,omitempty"`
+	PaymentNoteId    *string                `protobuf:"bytes,3,opt,name=payment_note_id,json=paymentNoteId,proto3,oneof" json:"payment_note_id,omitempty"`
+	PaymentReference *string                `protobuf:"bytes,4,opt,name=payment_reference,json=paymentReference,proto3,oneof" json:"payment_reference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecordMSMEInvoicePaymentRequest) Reset() {
+	*x = RecordMSMEInvoicePaymentRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordMSMEInvoicePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMSMEInvoicePaymentRequest) ProtoMessage() {}
+
+func (x *RecordMSMEInvoicePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMSMEInvoicePaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordMSMEInvoicePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RecordMSMEInvoicePaymentRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *RecordMSMEInvoicePaymentRequest) GetPaidDate() string {
+	if x != nil {
+		return x.PaidDate
+	}
+	return ""
+}
+
+func (x *RecordMSMEInvoicePaymentRequest) GetPaymentNoteId() string {
+	if x != nil && x.PaymentNoteId != nil {
+		return *x.PaymentNoteId
+	}
+	return ""
+}
+
+func (x *RecordMSMEInvoicePaymentRequest) GetPaymentReference() string {
+	if x != nil && x.PaymentReference != nil {
+		return *x.PaymentReference
+	}
+	return ""
+}
+
+type ListMSMEInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	VendorId      *string                `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3,oneof" json:"vendor_id,omitempty"`
+	GreenNoteId   *string                `protobuf:"bytes,3,opt,name=green_note_id,json=greenNoteId,proto3,oneof" json:"green_note_id,omitempty"`
+	PaymentNoteId *string                `protobuf:"bytes,4,opt,name=payment_note_id,json=paymentNoteId,proto3,oneof" json:"payment_note_id,omitempty"`
+	// Date on which status and interest are evaluated; defaults to today
+	AsOf          *string `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+	Page          int32   `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32   `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMSMEInvoicesRequest) Reset() {
+	*x = ListMSMEInvoicesRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMSMEInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMSMEInvoicesRequest) ProtoMessage() {}
+
+func (x *ListMSMEInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMSMEInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListMSMEInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMSMEInvoicesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListMSMEInvoicesRequest) GetVendorId() string {
+	if x != nil && x.VendorId != nil {
+		return *x.VendorId
+	}
+	return ""
+}
+
+func (x *ListMSMEInvoicesRequest) GetGreenNoteId() string {
+	if x != nil && x.GreenNoteId != nil {
+		return *x.GreenNoteId
+	}
+	return ""
+}
+
+func (x *ListMSMEInvoicesRequest) GetPaymentNoteId() string {
+	if x != nil && x.PaymentNoteId != nil {
+		return *x.PaymentNoteId
+	}
+	return ""
+}
+
+func (x *ListMSMEInvoicesRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+func (x *ListMSMEInvoicesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMSMEInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type MSMEInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *MSMEInvoice           `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MSMEInvoiceResponse) Reset() {
+	*x = MSMEInvoiceResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSMEInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSMEInvoiceResponse) ProtoMessage() {}
+
+func (x *MSMEInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSMEInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*MSMEInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MSMEInvoiceResponse) GetInvoice() *MSMEInvoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type ListMSMEInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*MSMEInvoice         `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMSMEInvoicesResponse) Reset() {
+	*x = ListMSMEInvoicesResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMSMEInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMSMEInvoicesResponse) ProtoMessage() {}
+
+func (x *ListMSMEInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMSMEInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListMSMEInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMSMEInvoicesResponse) GetInvoices() []*MSMEInvoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *ListMSMEInvoicesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListMSMEInvoicesResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetMSMEOutstandingReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to today
+	AsOf *string `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3,oneof" json:"as_of,omitempty"`
+	// Defaults to the start of the MSME-1 half year (1 April or 1 October) containing as_of
+	PeriodStart   *string `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3,oneof" json:"period_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMSMEOutstandingReportRequest) Reset() {
+	*x = GetMSMEOutstandingReportRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMSMEOutstandingReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMSMEOutstandingReportRequest) ProtoMessage() {}
+
+func (x *GetMSMEOutstandingReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMSMEOutstandingReportRequest.ProtoReflect.Descriptor instead.
+func (*GetMSMEOutstandingReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetMSMEOutstandingReportRequest) GetAsOf() string {
+	if x != nil && x.AsOf != nil {
+		return *x.AsOf
+	}
+	return ""
+}
+
+func (x *GetMSMEOutstandingReportRequest) GetPeriodStart() string {
+	if x != nil && x.PeriodStart != nil {
+		return *x.PeriodStart
+	}
+	return ""
+}
+
+type MSMEVendorOutstanding struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	VendorId               string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	VendorCode             string                 `protobuf:"bytes,2,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	VendorName             string                 `protobuf:"bytes,3,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	MsmeClassification     string                 `protobuf:"bytes,4,opt,name=msme_classification,json=msmeClassification,proto3" json:"msme_classification,omitempty"`
+	MsmeRegistrationNumber string                 `protobuf:"bytes,5,opt,name=msme_registration_number,json=msmeRegistrationNumber,proto3" json:"msme_registration_number,omitempty"`
+	OutstandingInvoices    int32                  `protobuf:"varint,6,opt,name=outstanding_invoices,json=outstandingInvoices,proto3" json:"outstanding_invoices,omitempty"`
+	OutstandingAmount      float64                `protobuf:"fixed64,7,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	OverdueInvoices        int32                  `protobuf:"varint,8,opt,name=overdue_invoices,json=overdueInvoices,proto3" json:"overdue_invoices,omitempty"`
+	OverdueAmount          float64                `protobuf:"fixed64,9,opt,name=overdue_amount,json=overdueAmount,proto3" json:"overdue_amount,omitempty"`
+	PaidLateInvoices       int32                  `protobuf:"varint,10,opt,name=paid_late_invoices,json=paidLateInvoices,proto3" json:"paid_late_invoices,omitempty"`
+	PaidLateAmount         float64                `protobuf:"fixed64,11,opt,name=paid_late_amount,json=paidLateAmount,proto3" json:"paid_late_amount,omitempty"`
+	InterestLiability      float64                `protobuf:"fixed64,12,opt,name=interest_liability,json=interestLiability,proto3" json:"interest_liability,omitempty"`
+	OldestDueDate          *string                `protobuf:"bytes,13,opt,name=oldest_due_date,json=oldestDueDate,proto3,oneof" json:"oldest_due_date,omitempty"`
+	OutstandingAmountExact *Money                 `protobuf:"bytes,14,opt,name=outstanding_amount_exact,json=outstandingAmountExact,proto3" json:"outstanding_amount_exact,omitempty"`
+	OverdueAmountExact     *Money                 `protobuf:"bytes,15,opt,name=overdue_amount_exact,json=overdueAmountExact,proto3" json:"overdue_amount_exact,omitempty"`
+	PaidLateAmountExact    *Money                 `protobuf:"bytes,16,opt,name=paid_late_amount_exact,json=paidLateAmountExact,proto3" json:"paid_late_amount_exact,omitempty"`
+	InterestLiabilityExact *Money                 `protobuf:"bytes,17,opt,name=interest_liability_exact,json=interestLiabilityExact,proto3" json:"interest_liability_exact,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MSMEVendorOutstanding) Reset() {
+	*x = MSMEVendorOutstanding{}
+	mi := &file_api_proto_vendor_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSMEVendorOutstanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSMEVendorOutstanding) ProtoMessage() {}
+
+func (x *MSMEVendorOutstanding) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSMEVendorOutstanding.ProtoReflect.Descriptor instead.
+func (*MSMEVendorOutstanding) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MSMEVendorOutstanding) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *MSMEVendorOutstanding) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *MSMEVendorOutstanding) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *MSMEVendorOutstanding) GetMsmeClassification() string {
+	if x != nil {
+		return x.MsmeClassification
+	}
+	return ""
+}
+
+func (x *MSMEVendorOutstanding) GetMsmeRegistrationNumber() string {
+	if x != nil {
+		return x.MsmeRegistrationNumber
+	}
+	return ""
+}
+
+func (x *MSMEVendorOutstanding) GetOutstandingInvoices() int32 {
+	if x != nil {
+		return x.OutstandingInvoices
+	}
+	return 0
+}
+
+func (x *MSMEVendorOutstanding) GetOutstandingAmount() float64 {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return 0
+}
+
+func (x *MSMEVendorOutstanding) GetOverdueInvoices() int32 {
+	if x != nil {
+		return x.OverdueInvoices
+	}
+	return 0
+}
+
+func (x *MSMEVendorOutstanding) GetOverdueAmount() float64 {
+	if x != nil {
+		return x.OverdueAmount
+	}
+	return 0
+}
+
+func (x *MSMEVendorOutstanding) GetPaidLateInvoices() int32 {
+	if x != nil {
+		return x.PaidLateInvoices
+	}
+	return 0
+}
+
+func (x *MSMEVendorOutstanding) GetPaidLateAmount() float64 {
+	if x != nil {
+		return x.PaidLateAmount
+	}
+	return 0
+}
+
+func (x *MSMEVendorOutstanding) GetInterestLiability() float64 {
+	if x != nil {
+		return x.InterestLiability
+	}
+	return 0
+}
+
+func (x *MSMEVendorOutstanding) GetOldestDueDate() string {
+	if x != nil && x.OldestDueDate != nil {
+		return *x.OldestDueDate
+	}
+	return ""
+}
+
+func (x *MSMEVendorOutstanding) GetOutstandingAmountExact() *Money {
+	if x != nil {
+		return x.OutstandingAmountExact
+	}
+	return nil
+}
+
+func (x *MSMEVendorOutstanding) GetOverdueAmountExact() *Money {
+	if x != nil {
+		return x.OverdueAmountExact
+	}
+	return nil
+}
+
+func (x *MSMEVendorOutstanding) GetPaidLateAmountExact() *Money {
+	if x != nil {
+		return x.PaidLateAmountExact
+	}
+	return nil
+}
+
+func (x *MSMEVendorOutstanding) GetInterestLiabilityExact() *Money {
+	if x != nil {
+		return x.InterestLiabilityExact
+	}
+	return nil
+}
+
+type MSMEOutstandingReportResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AsOf        string                 `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	PeriodStart string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// RBI bank rate (percent per annum) used for interest
+	BankRate                    float64                  `protobuf:"fixed64,3,opt,name=bank_rate,json=bankRate,proto3" json:"bank_rate,omitempty"`
+	Vendors                     []*MSMEVendorOutstanding `protobuf:"bytes,4,rep,name=vendors,proto3" json:"vendors,omitempty"`
+	TotalOutstandingAmount      float64                  `protobuf:"fixed64,5,opt,name=total_outstanding_amount,json=totalOutstandingAmount,proto3" json:"total_outstanding_amount,omitempty"`
+	TotalOverdueAmount          float64                  `protobuf:"fixed64,6,opt,name=total_overdue_amount,json=totalOverdueAmount,proto3" json:"total_overdue_amount,omitempty"`
+	TotalPaidLateAmount         float64                  `protobuf:"fixed64,7,opt,name=total_paid_late_amount,json=totalPaidLateAmount,proto3" json:"total_paid_late_amount,omitempty"`
+	TotalInterestLiability      float64                  `protobuf:"fixed64,8,opt,name=total_interest_liability,json=totalInterestLiability,proto3" json:"total_interest_liability,omitempty"`
+	TotalOutstandingAmountExact *Money                   `protobuf:"bytes,9,opt,name=total_outstanding_amount_exact,json=totalOutstandingAmountExact,proto3" json:"total_outstanding_amount_exact,omitempty"`
+	TotalOverdueAmountExact     *Money                   `protobuf:"bytes,10,opt,name=total_overdue_amount_exact,json=totalOverdueAmountExact,proto3" json:"total_overdue_amount_exact,omitempty"`
+	TotalPaidLateAmountExact    *Money                   `protobuf:"bytes,11,opt,name=total_paid_late_amount_exact,json=totalPaidLateAmountExact,proto3" json:"total_paid_late_amount_exact,omitempty"`
+	TotalInterestLiabilityExact *Money                   `protobuf:"bytes,12,opt,name=total_interest_liability_exact,json=totalInterestLiabilityExact,proto3" json:"total_interest_liability_exact,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *MSMEOutstandingReportResponse) Reset() {
+	*x = MSMEOutstandingReportResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSMEOutstandingReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSMEOutstandingReportResponse) ProtoMessage() {}
+
+func (x *MSMEOutstandingReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSMEOutstandingReportResponse.ProtoReflect.Descriptor instead.
+func (*MSMEOutstandingReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MSMEOutstandingReportResponse) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+func (x *MSMEOutstandingReportResponse) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *MSMEOutstandingReportResponse) GetBankRate() float64 {
+	if x != nil {
+		return x.BankRate
+	}
+	return 0
+}
+
+func (x *MSMEOutstandingReportResponse) GetVendors() []*MSMEVendorOutstanding {
+	if x != nil {
+		return x.Vendors
+	}
+	return nil
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalOutstandingAmount() float64 {
+	if x != nil {
+		return x.TotalOutstandingAmount
+	}
+	return 0
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalOverdueAmount() float64 {
+	if x != nil {
+		return x.TotalOverdueAmount
+	}
+	return 0
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalPaidLateAmount() float64 {
+	if x != nil {
+		return x.TotalPaidLateAmount
+	}
+	return 0
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalInterestLiability() float64 {
+	if x != nil {
+		return x.TotalInterestLiability
+	}
+	return 0
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalOutstandingAmountExact() *Money {
+	if x != nil {
+		return x.TotalOutstandingAmountExact
+	}
+	return nil
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalOverdueAmountExact() *Money {
+	if x != nil {
+		return x.TotalOverdueAmountExact
+	}
+	return nil
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalPaidLateAmountExact() *Money {
+	if x != nil {
+		return x.TotalPaidLateAmountExact
+	}
+	return nil
+}
+
+func (x *MSMEOutstandingReportResponse) GetTotalInterestLiabilityExact() *Money {
+	if x != nil {
+		return x.TotalInterestLiabilityExact
+	}
+	return nil
+}
+
+// ====================
+// Vendor Portal Messages
+// ====================
+type VendorPortalUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VendorId      string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LinkedBy      string                 `protobuf:"bytes,4,opt,name=linked_by,json=linkedBy,proto3" json:"linked_by,omitempty"`
+	LinkedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorPortalUser) Reset() {
+	*x = VendorPortalUser{}
+	mi := &file_api_proto_vendor_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorPortalUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorPortalUser) ProtoMessage() {}
+
+func (x *VendorPortalUser) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorPortalUser.ProtoReflect.Descriptor instead.
+func (*VendorPortalUser) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *VendorPortalUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VendorPortalUser) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *VendorPortalUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VendorPortalUser) GetLinkedBy() string {
+	if x != nil {
+		return x.LinkedBy
+	}
+	return ""
+}
+
+func (x *VendorPortalUser) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+type VendorInvoiceDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvoiceId     string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ObjectPath    string                 `protobuf:"bytes,6,opt,name=object_path,json=objectPath,proto3" json:"object_path,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,7,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	UploadedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorInvoiceDocument) Reset() {
+	*x = VendorInvoiceDocument{}
+	mi := &file_api_proto_vendor_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorInvoiceDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorInvoiceDocument) ProtoMessage() {}
+
+func (x *VendorInvoiceDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorInvoiceDocument.ProtoReflect.Descriptor instead.
+func (*VendorInvoiceDocument) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *VendorInvoiceDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VendorInvoiceDocument) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *VendorInvoiceDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *VendorInvoiceDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *VendorInvoiceDocument) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VendorInvoiceDocument) GetObjectPath() string {
+	if x != nil {
+		return x.ObjectPath
+	}
+	return ""
+}
+
+func (x *VendorInvoiceDocument) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *VendorInvoiceDocument) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+type VendorInvoice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VendorId      string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	InvoiceNumber string                 `protobuf:"bytes,3,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	// YYYY-MM-DD
+	InvoiceDate   string  `protobuf:"bytes,4,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
+	InvoiceAmount float64 `protobuf:"fixed64,5,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
+	PoNumber      *string `protobuf:"bytes,6,opt,name=po_number,json=poNumber,proto3,oneof" json:"po_number,omitempty"`
+	Description   *string `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// SUBMITTED, UNDER_REVIEW, APPROVED, REJECTED or PAID
+	Status             string                   `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	GreenNoteId        *string                  `protobuf:"bytes,9,opt,name=green_note_id,json=greenNoteId,proto3,oneof" json:"green_note_id,omitempty"`
+	PaymentNoteId      *string                  `protobuf:"bytes,10,opt,name=payment_note_id,json=paymentNoteId,proto3,oneof" json:"payment_note_id,omitempty"`
+	UtrNumber          *string                  `protobuf:"bytes,11,opt,name=utr_number,json=utrNumber,proto3,oneof" json:"utr_number,omitempty"`
+	PaidAmount         *float64                 `protobuf:"fixed64,12,opt,name=paid_amount,json=paidAmount,proto3,oneof" json:"paid_amount,omitempty"`
+	PaidAt             *timestamppb.Timestamp   `protobuf:"bytes,13,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	RejectionReason    *string                  `protobuf:"bytes,14,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
+	SubmittedBy        string                   `protobuf:"bytes,15,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	SubmittedAt        *timestamppb.Timestamp   `protobuf:"bytes,16,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp   `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Documents          []*VendorInvoiceDocument `protobuf:"bytes,18,rep,name=documents,proto3" json:"documents,omitempty"`
+	InvoiceAmountExact *Money                   `protobuf:"bytes,19,opt,name=invoice_amount_exact,json=invoiceAmountExact,proto3" json:"invoice_amount_exact,omitempty"`
+	PaidAmountExact    *Money                   `protobuf:"bytes,20,opt,name=paid_amount_exact,json=paidAmountExact,proto3" json:"paid_amount_exact,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VendorInvoice) Reset() {
+	*x = VendorInvoice{}
+	mi := &file_api_proto_vendor_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorInvoice) ProtoMessage() {}
+
+func (x *VendorInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorInvoice.ProtoReflect.Descriptor instead.
+func (*VendorInvoice) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VendorInvoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetInvoiceDate() string {
+	if x != nil {
+		return x.InvoiceDate
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetInvoiceAmount() float64 {
+	if x != nil {
+		return x.InvoiceAmount
+	}
+	return 0
+}
+
+func (x *VendorInvoice) GetPoNumber() string {
+	if x != nil && x.PoNumber != nil {
+		return *x.PoNumber
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetGreenNoteId() string {
+	if x != nil && x.GreenNoteId != nil {
+		return *x.GreenNoteId
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetPaymentNoteId() string {
+	if x != nil && x.PaymentNoteId != nil {
+		return *x.PaymentNoteId
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetUtrNumber() string {
+	if x != nil && x.UtrNumber != nil {
+		return *x.UtrNumber
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetPaidAmount() float64 {
+	if x != nil && x.PaidAmount != nil {
+		return *x.PaidAmount
+	}
+	return 0
+}
+
+func (x *VendorInvoice) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *VendorInvoice) GetRejectionReason() string {
+	if x != nil && x.RejectionReason != nil {
+		return *x.RejectionReason
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *VendorInvoice) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *VendorInvoice) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *VendorInvoice) GetDocuments() []*VendorInvoiceDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *VendorInvoice) GetInvoiceAmountExact() *Money {
+	if x != nil {
+		return x.InvoiceAmountExact
+	}
+	return nil
+}
+
+func (x *VendorInvoice) GetPaidAmountExact() *Money {
+	if x != nil {
+		return x.PaidAmountExact
+	}
+	return nil
+}
+
+type GetPortalProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortalProfileRequest) Reset() {
+	*x = GetPortalProfileRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortalProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortalProfileRequest) ProtoMessage() {}
+
+func (x *GetPortalProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortalProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetPortalProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{57}
+}
+
+type UpdatePortalContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorMobile  *string                `protobuf:"bytes,1,opt,name=vendor_mobile,json=vendorMobile,proto3,oneof" json:"vendor_mobile,omitempty"`
+	Address       *string                `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	CityName      *string                `protobuf:"bytes,3,opt,name=city_name,json=cityName,proto3,oneof" json:"city_name,omitempty"`
+	StateName     *string                `protobuf:"bytes,4,opt,name=state_name,json=stateName,proto3,oneof" json:"state_name,omitempty"`
+	CountryName   *string                `protobuf:"bytes,5,opt,name=country_name,json=countryName,proto3,oneof" json:"country_name,omitempty"`
+	Pin           *string                `protobuf:"bytes,6,opt,name=pin,proto3,oneof" json:"pin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePortalContactRequest) Reset() {
+	*x = UpdatePortalContactRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePortalContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePortalContactRequest) ProtoMessage() {}
+
+func (x *UpdatePortalContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePortalContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePortalContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdatePortalContactRequest) GetVendorMobile() string {
+	if x != nil && x.VendorMobile != nil {
+		return *x.VendorMobile
+	}
+	return ""
+}
+
+func (x *UpdatePortalContactRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdatePortalContactRequest) GetCityName() string {
+	if x != nil && x.CityName != nil {
+		return *x.CityName
+	}
+	return ""
+}
+
+func (x *UpdatePortalContactRequest) GetStateName() string {
+	if x != nil && x.StateName != nil {
+		return *x.StateName
+	}
+	return ""
+}
+
+func (x *UpdatePortalContactRequest) GetCountryName() string {
+	if x != nil && x.CountryName != nil {
+		return *x.CountryName
+	}
+	return ""
+}
+
+func (x *UpdatePortalContactRequest) GetPin() string {
+	if x != nil && x.Pin != nil {
+		return *x.Pin
+	}
+	return ""
+}
+
+type SubmitPortalInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceNumber string                 `protobuf:"bytes,1,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	// YYYY-MM-DD
+	InvoiceDate        string  `protobuf:"bytes,2,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
+	InvoiceAmount      float64 `protobuf:"fixed64,3,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
+	PoNumber           *string `protobuf:"bytes,4,opt,name=po_number,json=poNumber,proto3,oneof" json:"po_number,omitempty"`
+	Description        *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	InvoiceAmountExact *Money  `protobuf:"bytes,6,opt,name=invoice_amount_exact,json=invoiceAmountExact,proto3" json:"invoice_amount_exact,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubmitPortalInvoiceRequest) Reset() {
+	*x = SubmitPortalInvoiceRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPortalInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPortalInvoiceRequest) ProtoMessage() {}
+
+func (x *SubmitPortalInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPortalInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPortalInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SubmitPortalInvoiceRequest) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *SubmitPortalInvoiceRequest) GetInvoiceDate() string {
+	if x != nil {
+		return x.InvoiceDate
+	}
+	return ""
+}
+
+func (x *SubmitPortalInvoiceRequest) GetInvoiceAmount() float64 {
+	if x != nil {
+		return x.InvoiceAmount
+	}
+	return 0
+}
+
+func (x *SubmitPortalInvoiceRequest) GetPoNumber() string {
+	if x != nil && x.PoNumber != nil {
+		return *x.PoNumber
+	}
+	return ""
+}
+
+func (x *SubmitPortalInvoiceRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SubmitPortalInvoiceRequest) GetInvoiceAmountExact() *Money {
+	if x != nil {
+		return x.InvoiceAmountExact
+	}
+	return nil
+}
+
+type UploadPortalInvoiceDocumentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// PDF, PNG or JPEG, up to 10 MB
+	FileName      string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileContent   []byte `protobuf:"bytes,3,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPortalInvoiceDocumentRequest) Reset() {
+	*x = UploadPortalInvoiceDocumentRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPortalInvoiceDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPortalInvoiceDocumentRequest) ProtoMessage() {}
+
+func (x *UploadPortalInvoiceDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPortalInvoiceDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadPortalInvoiceDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UploadPortalInvoiceDocumentRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *UploadPortalInvoiceDocumentRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadPortalInvoiceDocumentRequest) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+type VendorInvoiceDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *VendorInvoiceDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorInvoiceDocumentResponse) Reset() {
+	*x = VendorInvoiceDocumentResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorInvoiceDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorInvoiceDocumentResponse) ProtoMessage() {}
+
+func (x *VendorInvoiceDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorInvoiceDocumentResponse.ProtoReflect.Descriptor instead.
+func (*VendorInvoiceDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *VendorInvoiceDocumentResponse) GetDocument() *VendorInvoiceDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ListPortalInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortalInvoicesRequest) Reset() {
+	*x = ListPortalInvoicesRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortalInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortalInvoicesRequest) ProtoMessage() {}
+
+func (x *ListPortalInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortalInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListPortalInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListPortalInvoicesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListPortalInvoicesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPortalInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetPortalInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortalInvoiceRequest) Reset() {
+	*x = GetPortalInvoiceRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortalInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortalInvoiceRequest) ProtoMessage() {}
+
+func (x *GetPortalInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortalInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetPortalInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPortalInvoiceRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+type VendorInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *VendorInvoice         `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorInvoiceResponse) Reset() {
+	*x = VendorInvoiceResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorInvoiceResponse) ProtoMessage() {}
+
+func (x *VendorInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*VendorInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *VendorInvoiceResponse) GetInvoice() *VendorInvoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type ListVendorInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*VendorInvoice       `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVendorInvoicesResponse) Reset() {
+	*x = ListVendorInvoicesResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVendorInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVendorInvoicesResponse) ProtoMessage() {}
+
+func (x *ListVendorInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVendorInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListVendorInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListVendorInvoicesResponse) GetInvoices() []*VendorInvoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *ListVendorInvoicesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListVendorInvoicesResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type LinkVendorUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkVendorUserRequest) Reset() {
+	*x = LinkVendorUserRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkVendorUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkVendorUserRequest) ProtoMessage() {}
+
+func (x *LinkVendorUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkVendorUserRequest.ProtoReflect.Descriptor instead.
+func (*LinkVendorUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LinkVendorUserRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *LinkVendorUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type VendorPortalUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *VendorPortalUser      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorPortalUserResponse) Reset() {
+	*x = VendorPortalUserResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorPortalUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorPortalUserResponse) ProtoMessage() {}
+
+func (x *VendorPortalUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorPortalUserResponse.ProtoReflect.Descriptor instead.
+func (*VendorPortalUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *VendorPortalUserResponse) GetUser() *VendorPortalUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UnlinkVendorUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkVendorUserRequest) Reset() {
+	*x = UnlinkVendorUserRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkVendorUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkVendorUserRequest) ProtoMessage() {}
+
+func (x *UnlinkVendorUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkVendorUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkVendorUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UnlinkVendorUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListVendorPortalUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVendorPortalUsersRequest) Reset() {
+	*x = ListVendorPortalUsersRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVendorPortalUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVendorPortalUsersRequest) ProtoMessage() {}
+
+func (x *ListVendorPortalUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVendorPortalUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListVendorPortalUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListVendorPortalUsersRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+type ListVendorPortalUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*VendorPortalUser    `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVendorPortalUsersResponse) Reset() {
+	*x = ListVendorPortalUsersResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVendorPortalUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVendorPortalUsersResponse) ProtoMessage() {}
+
+func (x *ListVendorPortalUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVendorPortalUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListVendorPortalUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListVendorPortalUsersResponse) GetUsers() []*VendorPortalUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ListVendorInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      *string                `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3,oneof" json:"vendor_id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVendorInvoicesRequest) Reset() {
+	*x = ListVendorInvoicesRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVendorInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVendorInvoicesRequest) ProtoMessage() {}
+
+func (x *ListVendorInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVendorInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListVendorInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListVendorInvoicesRequest) GetVendorId() string {
+	if x != nil && x.VendorId != nil {
+		return *x.VendorId
+	}
+	return ""
+}
+
+func (x *ListVendorInvoicesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListVendorInvoicesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListVendorInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UpdateVendorInvoiceStatusRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// Leave empty to only link notes or record payment details
+	Status        *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	GreenNoteId   *string `protobuf:"bytes,3,opt,name=green_note_id,json=greenNoteId,proto3,oneof" json:"green_note_id,omitempty"`
+	PaymentNoteId *string `protobuf:"bytes,4,opt,name=payment_note_id,json=paymentNoteId,proto3,oneof" json:"payment_note_id,omitempty"`
+	// Required when status is PAID
+	UtrNumber  *string  `protobuf:"bytes,5,opt,name=utr_number,json=utrNumber,proto3,oneof" json:"utr_number,omitempty"`
+	PaidAmount *float64 `protobuf:"fixed64,6,opt,name=paid_amount,json=paidAmount,proto3,oneof" json:"paid_amount,omitempty"`
+	// YYYY-MM-DD; defaults to today when status becomes PAID
+	PaidDate        *string `protobuf:"bytes,7,opt,name=paid_date,json=paidDate,proto3,oneof" json:"paid_date,omitempty"`
+	RejectionReason *string `protobuf:"bytes,8,opt,name=rejection_reason,json=rejectionReason,proto3,oneof" json:"rejection_reason,omitempty"`
+	PaidAmountExact *Money  `protobuf:"bytes,9,opt,name=paid_amount_exact,json=paidAmountExact,proto3" json:"paid_amount_exact,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) Reset() {
+	*x = UpdateVendorInvoiceStatusRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVendorInvoiceStatusRequest) ProtoMessage() {}
+
+func (x *UpdateVendorInvoiceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVendorInvoiceStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVendorInvoiceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetGreenNoteId() string {
+	if x != nil && x.GreenNoteId != nil {
+		return *x.GreenNoteId
+	}
+	return ""
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetPaymentNoteId() string {
+	if x != nil && x.PaymentNoteId != nil {
+		return *x.PaymentNoteId
+	}
+	return ""
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetUtrNumber() string {
+	if x != nil && x.UtrNumber != nil {
+		return *x.UtrNumber
+	}
+	return ""
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetPaidAmount() float64 {
+	if x != nil && x.PaidAmount != nil {
+		return *x.PaidAmount
+	}
+	return 0
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetPaidDate() string {
+	if x != nil && x.PaidDate != nil {
+		return *x.PaidDate
+	}
+	return ""
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetRejectionReason() string {
+	if x != nil && x.RejectionReason != nil {
+		return *x.RejectionReason
+	}
+	return ""
+}
+
+func (x *UpdateVendorInvoiceStatusRequest) GetPaidAmountExact() *Money {
+	if x != nil {
+		return x.PaidAmountExact
+	}
+	return nil
+}
+
+// ====================
+// TDS Messages
+// ====================
+type TDSSection struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Code        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Percent; individuals and HUFs
+	RateIndividual float64 `protobuf:"fixed64,3,opt,name=rate_individual,json=rateIndividual,proto3" json:"rate_individual,omitempty"`
+	RateOther      float64 `protobuf:"fixed64,4,opt,name=rate_other,json=rateOther,proto3" json:"rate_other,omitempty"`
+	// Section 206AA floor without a valid PAN
+	RateNoPan float64 `protobuf:"fixed64,5,opt,name=rate_no_pan,json=rateNoPan,proto3" json:"rate_no_pan,omitempty"`
+	// 0 means no threshold
+	SingleThreshold float64 `protobuf:"fixed64,6,opt,name=single_threshold,json=singleThreshold,proto3" json:"single_threshold,omitempty"`
+	AnnualThreshold float64 `protobuf:"fixed64,7,opt,name=annual_threshold,json=annualThreshold,proto3" json:"annual_threshold,omitempty"`
+	// Deduct only on the aggregate above annual_threshold (194Q)
+	DeductOnExcess       bool                   `protobuf:"varint,8,opt,name=deduct_on_excess,json=deductOnExcess,proto3" json:"deduct_on_excess,omitempty"`
+	IsActive             bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SingleThresholdExact *Money                 `protobuf:"bytes,11,opt,name=single_threshold_exact,json=singleThresholdExact,proto3" json:"single_threshold_exact,omitempty"`
+	AnnualThresholdExact *Money                 `protobuf:"bytes,12,opt,name=annual_threshold_exact,json=annualThresholdExact,proto3" json:"annual_threshold_exact,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TDSSection) Reset() {
+	*x = TDSSection{}
+	mi := &file_api_proto_vendor_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TDSSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDSSection) ProtoMessage() {}
+
+func (x *TDSSection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDSSection.ProtoReflect.Descriptor instead.
+func (*TDSSection) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TDSSection) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TDSSection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TDSSection) GetRateIndividual() float64 {
+	if x != nil {
+		return x.RateIndividual
+	}
+	return 0
+}
+
+func (x *TDSSection) GetRateOther() float64 {
+	if x != nil {
+		return x.RateOther
+	}
+	return 0
+}
+
+func (x *TDSSection) GetRateNoPan() float64 {
+	if x != nil {
+		return x.RateNoPan
+	}
+	return 0
+}
+
+func (x *TDSSection) GetSingleThreshold() float64 {
+	if x != nil {
+		return x.SingleThreshold
+	}
+	return 0
+}
+
+func (x *TDSSection) GetAnnualThreshold() float64 {
+	if x != nil {
+		return x.AnnualThreshold
+	}
+	return 0
+}
+
+func (x *TDSSection) GetDeductOnExcess() bool {
+	if x != nil {
+		return x.DeductOnExcess
+	}
+	return false
+}
+
+func (x *TDSSection) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TDSSection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TDSSection) GetSingleThresholdExact() *Money {
+	if x != nil {
+		return x.SingleThresholdExact
+	}
+	return nil
+}
+
+func (x *TDSSection) GetAnnualThresholdExact() *Money {
+	if x != nil {
+		return x.AnnualThresholdExact
+	}
+	return nil
+}
+
+type ListTDSSectionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTDSSectionsRequest) Reset() {
+	*x = ListTDSSectionsRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTDSSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTDSSectionsRequest) ProtoMessage() {}
+
+func (x *ListTDSSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTDSSectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTDSSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListTDSSectionsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListTDSSectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*TDSSection          `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTDSSectionsResponse) Reset() {
+	*x = ListTDSSectionsResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTDSSectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTDSSectionsResponse) ProtoMessage() {}
+
+func (x *ListTDSSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTDSSectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTDSSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListTDSSectionsResponse) GetSections() []*TDSSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type UpsertTDSSectionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RateIndividual float64                `protobuf:"fixed64,3,opt,name=rate_individual,json=rateIndividual,proto3" json:"rate_individual,omitempty"`
+	RateOther      float64                `protobuf:"fixed64,4,opt,name=rate_other,json=rateOther,proto3" json:"rate_other,omitempty"`
+	// Defaults to 20
+	RateNoPan            float64 `protobuf:"fixed64,5,opt,name=rate_no_pan,json=rateNoPan,proto3" json:"rate_no_pan,omitempty"`
+	SingleThreshold      float64 `protobuf:"fixed64,6,opt,name=single_threshold,json=singleThreshold,proto3" json:"single_threshold,omitempty"`
+	AnnualThreshold      float64 `protobuf:"fixed64,7,opt,name=annual_threshold,json=annualThreshold,proto3" json:"annual_threshold,omitempty"`
+	DeductOnExcess       bool    `protobuf:"varint,8,opt,name=deduct_on_excess,json=deductOnExcess,proto3" json:"deduct_on_excess,omitempty"`
+	IsActive             bool    `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	SingleThresholdExact *Money  `protobuf:"bytes,10,opt,name=single_threshold_exact,json=singleThresholdExact,proto3" json:"single_threshold_exact,omitempty"`
+	AnnualThresholdExact *Money  `protobuf:"bytes,11,opt,name=annual_threshold_exact,json=annualThresholdExact,proto3" json:"annual_threshold_exact,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpsertTDSSectionRequest) Reset() {
+	*x = UpsertTDSSectionRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertTDSSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTDSSectionRequest) ProtoMessage() {}
+
+func (x *UpsertTDSSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTDSSectionRequest.ProtoReflect.Descriptor instead.
+func (*UpsertTDSSectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpsertTDSSectionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpsertTDSSectionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpsertTDSSectionRequest) GetRateIndividual() float64 {
+	if x != nil {
+		return x.RateIndividual
+	}
+	return 0
+}
+
+func (x *UpsertTDSSectionRequest) GetRateOther() float64 {
+	if x != nil {
+		return x.RateOther
+	}
+	return 0
+}
+
+func (x *UpsertTDSSectionRequest) GetRateNoPan() float64 {
+	if x != nil {
+		return x.RateNoPan
+	}
+	return 0
+}
+
+func (x *UpsertTDSSectionRequest) GetSingleThreshold() float64 {
+	if x != nil {
+		return x.SingleThreshold
+	}
+	return 0
+}
+
+func (x *UpsertTDSSectionRequest) GetAnnualThreshold() float64 {
+	if x != nil {
+		return x.AnnualThreshold
+	}
+	return 0
+}
+
+func (x *UpsertTDSSectionRequest) GetDeductOnExcess() bool {
+	if x != nil {
+		return x.DeductOnExcess
+	}
+	return false
+}
+
+func (x *UpsertTDSSectionRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpsertTDSSectionRequest) GetSingleThresholdExact() *Money {
+	if x != nil {
+		return x.SingleThresholdExact
+	}
+	return nil
+}
+
+func (x *UpsertTDSSectionRequest) GetAnnualThresholdExact() *Money {
+	if x != nil {
+		return x.AnnualThresholdExact
+	}
+	return nil
+}
+
+type TDSSectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Section       *TDSSection            `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TDSSectionResponse) Reset() {
+	*x = TDSSectionResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TDSSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDSSectionResponse) ProtoMessage() {}
+
+func (x *TDSSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDSSectionResponse.ProtoReflect.Descriptor instead.
+func (*TDSSectionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TDSSectionResponse) GetSection() *TDSSection {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+type TDSCertificate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VendorId          string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	SectionCode       string                 `protobuf:"bytes,3,opt,name=section_code,json=sectionCode,proto3" json:"section_code,omitempty"`
+	CertificateNumber string                 `protobuf:"bytes,4,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	// 0 for a nil deduction certificate
+	Rate float64 `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	// YYYY-MM-DD
+	ValidFrom        string                 `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo          string                 `protobuf:"bytes,7,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	AmountLimit      float64                `protobuf:"fixed64,8,opt,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	AmountUsed       float64                `protobuf:"fixed64,9,opt,name=amount_used,json=amountUsed,proto3" json:"amount_used,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AmountLimitExact *Money                 `protobuf:"bytes,12,opt,name=amount_limit_exact,json=amountLimitExact,proto3" json:"amount_limit_exact,omitempty"`
+	AmountUsedExact  *Money                 `protobuf:"bytes,13,opt,name=amount_used_exact,json=amountUsedExact,proto3" json:"amount_used_exact,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TDSCertificate) Reset() {
+	*x = TDSCertificate{}
+	mi := &file_api_proto_vendor_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TDSCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDSCertificate) ProtoMessage() {}
+
+func (x *TDSCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDSCertificate.ProtoReflect.Descriptor instead.
+func (*TDSCertificate) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TDSCertificate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TDSCertificate) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *TDSCertificate) GetSectionCode() string {
+	if x != nil {
+		return x.SectionCode
+	}
+	return ""
+}
+
+func (x *TDSCertificate) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *TDSCertificate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TDSCertificate) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *TDSCertificate) GetValidTo() string {
+	if x != nil {
+		return x.ValidTo
+	}
+	return ""
+}
+
+func (x *TDSCertificate) GetAmountLimit() float64 {
+	if x != nil {
+		return x.AmountLimit
+	}
+	return 0
+}
+
+func (x *TDSCertificate) GetAmountUsed() float64 {
+	if x != nil {
+		return x.AmountUsed
+	}
+	return 0
+}
+
+func (x *TDSCertificate) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TDSCertificate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TDSCertificate) GetAmountLimitExact() *Money {
+	if x != nil {
+		return x.AmountLimitExact
+	}
+	return nil
+}
+
+func (x *TDSCertificate) GetAmountUsedExact() *Money {
+	if x != nil {
+		return x.AmountUsedExact
+	}
+	return nil
+}
+
+type CreateTDSCertificateRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	VendorId          string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	SectionCode       string                 `protobuf:"bytes,2,opt,name=section_code,json=sectionCode,proto3" json:"section_code,omitempty"`
+	CertificateNumber string                 `protobuf:"bytes,3,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	Rate              float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	ValidFrom         string                 `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo           string                 `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	AmountLimit       float64                `protobuf:"fixed64,7,opt,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	AmountLimitExact  *Money                 `protobuf:"bytes,8,opt,name=amount_limit_exact,json=amountLimitExact,proto3" json:"amount_limit_exact,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateTDSCertificateRequest) Reset() {
+	*x = CreateTDSCertificateRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTDSCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTDSCertificateRequest) ProtoMessage() {}
+
+func (x *CreateTDSCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTDSCertificateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTDSCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateTDSCertificateRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *CreateTDSCertificateRequest) GetSectionCode() string {
+	if x != nil {
+		return x.SectionCode
+	}
+	return ""
+}
+
+func (x *CreateTDSCertificateRequest) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *CreateTDSCertificateRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CreateTDSCertificateRequest) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *CreateTDSCertificateRequest) GetValidTo() string {
+	if x != nil {
+		return x.ValidTo
+	}
+	return ""
+}
+
+func (x *CreateTDSCertificateRequest) GetAmountLimit() float64 {
+	if x != nil {
+		return x.AmountLimit
+	}
+	return 0
+}
+
+func (x *CreateTDSCertificateRequest) GetAmountLimitExact() *Money {
+	if x != nil {
+		return x.AmountLimitExact
+	}
+	return nil
+}
+
+type TDSCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   *TDSCertificate        `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TDSCertificateResponse) Reset() {
+	*x = TDSCertificateResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TDSCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDSCertificateResponse) ProtoMessage() {}
+
+func (x *TDSCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDSCertificateResponse.ProtoReflect.Descriptor instead.
+func (*TDSCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TDSCertificateResponse) GetCertificate() *TDSCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type ListTDSCertificatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTDSCertificatesRequest) Reset() {
+	*x = ListTDSCertificatesRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTDSCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTDSCertificatesRequest) ProtoMessage() {}
+
+func (x *ListTDSCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTDSCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTDSCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListTDSCertificatesRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+type ListTDSCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*TDSCertificate      `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTDSCertificatesResponse) Reset() {
+	*x = ListTDSCertificatesResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTDSCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTDSCertificatesResponse) ProtoMessage() {}
+
+func (x *ListTDSCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTDSCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTDSCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListTDSCertificatesResponse) GetCertificates() []*TDSCertificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+type TDSDeduction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	SectionCode   string                 `protobuf:"bytes,2,opt,name=section_code,json=sectionCode,proto3" json:"section_code,omitempty"`
+	FinancialYear string                 `protobuf:"bytes,3,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`
+	ReferenceId   *string                `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3,oneof" json:"reference_id,omitempty"`
+	PaymentDate   string                 `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Vendor's aggregate under the section in the financial year before this payment
+	AggregateBefore float64 `protobuf:"fixed64,7,opt,name=aggregate_before,json=aggregateBefore,proto3" json:"aggregate_before,omitempty"`
+	TaxableAmount   float64 `protobuf:"fixed64,8,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount,omitempty"`
+	// Rate on the taxable amount not covered by a certificate
+	Rate float64 `protobuf:"fixed64,9,opt,name=rate,proto3" json:"rate,omitempty"`
+	// BELOW_THRESHOLD, NORMAL, NO_PAN_206AA, NON_FILER_206AB or LOWER_DEDUCTION_CERTIFICATE
+	Rule              string  `protobuf:"bytes,10,opt,name=rule,proto3" json:"rule,omitempty"`
+	CertificateId     *string `protobuf:"bytes,11,opt,name=certificate_id,json=certificateId,proto3,oneof" json:"certificate_id,omitempty"`
+	CertificateAmount float64 `protobuf:"fixed64,12,opt,name=certificate_amount,json=certificateAmount,proto3" json:"certificate_amount,omitempty"`
+	CertificateRate   float64 `protobuf:"fixed64,13,opt,name=certificate_rate,json=certificateRate,proto3" json:"certificate_rate,omitempty"`
+	// Rounded to the rupee
+	TdsAmount float64 `protobuf:"fixed64,14,opt,name=tds_amount,json=tdsAmount,proto3" json:"tds_amount,omitempty"`
+	// tds_amount as a percentage of amount
+	EffectiveRate          float64 `protobuf:"fixed64,15,opt,name=effective_rate,json=effectiveRate,proto3" json:"effective_rate,omitempty"`
+	AmountExact            *Money  `protobuf:"bytes,16,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
+	AggregateBeforeExact   *Money  `protobuf:"bytes,17,opt,name=aggregate_before_exact,json=aggregateBeforeExact,proto3" json:"aggregate_before_exact,omitempty"`
+	TaxableAmountExact     *Money  `protobuf:"bytes,18,opt,name=taxable_amount_exact,json=taxableAmountExact,proto3" json:"taxable_amount_exact,omitempty"`
+	CertificateAmountExact *Money  `protobuf:"bytes,19,opt,name=certificate_amount_exact,json=certificateAmountExact,proto3" json:"certificate_amount_exact,omitempty"`
+	TdsAmountExact         *Money  `protobuf:"bytes,20,opt,name=tds_amount_exact,json=tdsAmountExact,proto3" json:"tds_amount_exact,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TDSDeduction) Reset() {
+	*x = TDSDeduction{}
+	mi := &file_api_proto_vendor_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TDSDeduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDSDeduction) ProtoMessage() {}
+
+func (x *TDSDeduction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDSDeduction.ProtoReflect.Descriptor instead.
+func (*TDSDeduction) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TDSDeduction) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *TDSDeduction) GetSectionCode() string {
+	if x != nil {
+		return x.SectionCode
+	}
+	return ""
+}
+
+func (x *TDSDeduction) GetFinancialYear() string {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return ""
+}
+
+func (x *TDSDeduction) GetReferenceId() string {
+	if x != nil && x.ReferenceId != nil {
+		return *x.ReferenceId
+	}
+	return ""
+}
+
+func (x *TDSDeduction) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+func (x *TDSDeduction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetAggregateBefore() float64 {
+	if x != nil {
+		return x.AggregateBefore
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetTaxableAmount() float64 {
+	if x != nil {
+		return x.TaxableAmount
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *TDSDeduction) GetCertificateId() string {
+	if x != nil && x.CertificateId != nil {
+		return *x.CertificateId
+	}
+	return ""
+}
+
+func (x *TDSDeduction) GetCertificateAmount() float64 {
+	if x != nil {
+		return x.CertificateAmount
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetCertificateRate() float64 {
+	if x != nil {
+		return x.CertificateRate
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetTdsAmount() float64 {
+	if x != nil {
+		return x.TdsAmount
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetEffectiveRate() float64 {
+	if x != nil {
+		return x.EffectiveRate
+	}
+	return 0
+}
+
+func (x *TDSDeduction) GetAmountExact() *Money {
+	if x != nil {
+		return x.AmountExact
+	}
+	return nil
+}
+
+func (x *TDSDeduction) GetAggregateBeforeExact() *Money {
+	if x != nil {
+		return x.AggregateBeforeExact
+	}
+	return nil
+}
+
+func (x *TDSDeduction) GetTaxableAmountExact() *Money {
+	if x != nil {
+		return x.TaxableAmountExact
+	}
+	return nil
+}
+
+func (x *TDSDeduction) GetCertificateAmountExact() *Money {
+	if x != nil {
+		return x.CertificateAmountExact
+	}
+	return nil
+}
+
+func (x *TDSDeduction) GetTdsAmountExact() *Money {
+	if x != nil {
+		return x.TdsAmountExact
+	}
+	return nil
+}
+
+type CalculateTDSRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of vendor_id or vendor_code
+	VendorId    *string `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3,oneof" json:"vendor_id,omitempty"`
+	VendorCode  *string `protobuf:"bytes,2,opt,name=vendor_code,json=vendorCode,proto3,oneof" json:"vendor_code,omitempty"`
+	SectionCode string  `protobuf:"bytes,3,opt,name=section_code,json=sectionCode,proto3" json:"section_code,omitempty"`
+	Amount      float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// YYYY-MM-DD; defaults to today
+	PaymentDate   *string `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3,oneof" json:"payment_date,omitempty"`
+	AmountExact   *Money  `protobuf:"bytes,6,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateTDSRequest) Reset() {
+	*x = CalculateTDSRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTDSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTDSRequest) ProtoMessage() {}
+
+func (x *CalculateTDSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTDSRequest.ProtoReflect.Descriptor instead.
+func (*CalculateTDSRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CalculateTDSRequest) GetVendorId() string {
+	if x != nil && x.VendorId != nil {
+		return *x.VendorId
+	}
+	return ""
+}
+
+func (x *CalculateTDSRequest) GetVendorCode() string {
+	if x != nil && x.VendorCode != nil {
+		return *x.VendorCode
+	}
+	return ""
+}
+
+func (x *CalculateTDSRequest) GetSectionCode() string {
+	if x != nil {
+		return x.SectionCode
+	}
+	return ""
+}
+
+func (x *CalculateTDSRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CalculateTDSRequest) GetPaymentDate() string {
+	if x != nil && x.PaymentDate != nil {
+		return *x.PaymentDate
+	}
+	return ""
+}
+
+func (x *CalculateTDSRequest) GetAmountExact() *Money {
+	if x != nil {
+		return x.AmountExact
+	}
+	return nil
+}
+
+type RecordTDSDeductionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	VendorId    *string                `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3,oneof" json:"vendor_id,omitempty"`
+	VendorCode  *string                `protobuf:"bytes,2,opt,name=vendor_code,json=vendorCode,proto3,oneof" json:"vendor_code,omitempty"`
+	SectionCode string                 `protobuf:"bytes,3,opt,name=section_code,json=sectionCode,proto3" json:"section_code,omitempty"`
+	Amount      float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentDate *string                `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3,oneof" json:"payment_date,omitempty"`
+	// Caller's reference, e.g. "payment-note:PN-0001"
+	ReferenceId   string `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	AmountExact   *Money `protobuf:"bytes,7,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordTDSDeductionRequest) Reset() {
+	*x = RecordTDSDeductionRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordTDSDeductionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordTDSDeductionRequest) ProtoMessage() {}
+
+func (x *RecordTDSDeductionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordTDSDeductionRequest.ProtoReflect.Descriptor instead.
+func (*RecordTDSDeductionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RecordTDSDeductionRequest) GetVendorId() string {
+	if x != nil && x.VendorId != nil {
+		return *x.VendorId
+	}
+	return ""
+}
+
+func (x *RecordTDSDeductionRequest) GetVendorCode() string {
+	if x != nil && x.VendorCode != nil {
+		return *x.VendorCode
+	}
+	return ""
+}
+
+func (x *RecordTDSDeductionRequest) GetSectionCode() string {
+	if x != nil {
+		return x.SectionCode
+	}
+	return ""
+}
+
+func (x *RecordTDSDeductionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordTDSDeductionRequest) GetPaymentDate() string {
+	if x != nil && x.PaymentDate != nil {
+		return *x.PaymentDate
+	}
+	return ""
+}
+
+func (x *RecordTDSDeductionRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RecordTDSDeductionRequest) GetAmountExact() *Money {
+	if x != nil {
+		return x.AmountExact
+	}
+	return nil
+}
+
+type TDSDeductionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deduction     *TDSDeduction          `protobuf:"bytes,1,opt,name=deduction,proto3" json:"deduction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TDSDeductionResponse) Reset() {
+	*x = TDSDeductionResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TDSDeductionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDSDeductionResponse) ProtoMessage() {}
+
+func (x *TDSDeductionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDSDeductionResponse.ProtoReflect.Descriptor instead.
+func (*TDSDeductionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TDSDeductionResponse) GetDeduction() *TDSDeduction {
+	if x != nil {
+		return x.Deduction
+	}
+	return nil
+}
+
+type ReverseTDSDeductionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceId   string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateVendorCodeResponse) Reset() {
-	*x = GenerateVendorCodeResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[21]
+func (x *ReverseTDSDeductionRequest) Reset() {
+	*x = ReverseTDSDeductionRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateVendorCodeResponse) String() string {
+func (x *ReverseTDSDeductionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateVendorCodeResponse) ProtoMessage() {}
+func (*ReverseTDSDeductionRequest) ProtoMessage() {}
 
-func (x *GenerateVendorCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[21]
+func (x *ReverseTDSDeductionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,40 +7693,42 @@ func (x *GenerateVendorCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateVendorCodeResponse.ProtoReflect.Descriptor instead.
-func (*GenerateVendorCodeResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ReverseTDSDeductionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseTDSDeductionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GenerateVendorCodeResponse) GetVendorCode() string {
+func (x *ReverseTDSDeductionRequest) GetReferenceId() string {
 	if x != nil {
-		return x.VendorCode
+		return x.ReferenceId
 	}
 	return ""
 }
 
-type VendorAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *VendorAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+type GetVendorTDSSummaryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	VendorId string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	// e.g. 2026-27; defaults to the current financial year
+	FinancialYear *string `protobuf:"bytes,2,opt,name=financial_year,json=financialYear,proto3,oneof" json:"financial_year,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VendorAccountResponse) Reset() {
-	*x = VendorAccountResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[22]
+func (x *GetVendorTDSSummaryRequest) Reset() {
+	*x = GetVendorTDSSummaryRequest{}
+	mi := &file_api_proto_vendor_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VendorAccountResponse) String() string {
+func (x *GetVendorTDSSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VendorAccountResponse) ProtoMessage() {}
+func (*GetVendorTDSSummaryRequest) ProtoMessage() {}
 
-func (x *VendorAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[22]
+func (x *GetVendorTDSSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,40 +7739,58 @@ func (x *VendorAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VendorAccountResponse.ProtoReflect.Descriptor instead.
-func (*VendorAccountResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetVendorTDSSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetVendorTDSSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *VendorAccountResponse) GetAccount() *VendorAccount {
+func (x *GetVendorTDSSummaryRequest) GetVendorId() string {
 	if x != nil {
-		return x.Account
+		return x.VendorId
 	}
-	return nil
+	return ""
 }
 
-type GetVendorAccountsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []*VendorAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetVendorTDSSummaryRequest) GetFinancialYear() string {
+	if x != nil && x.FinancialYear != nil {
+		return *x.FinancialYear
+	}
+	return ""
 }
 
-func (x *GetVendorAccountsResponse) Reset() {
-	*x = GetVendorAccountsResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[23]
+type TDSSectionSummary struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SectionCode          string                 `protobuf:"bytes,1,opt,name=section_code,json=sectionCode,proto3" json:"section_code,omitempty"`
+	Deductions           int32                  `protobuf:"varint,2,opt,name=deductions,proto3" json:"deductions,omitempty"`
+	AggregateAmount      float64                `protobuf:"fixed64,3,opt,name=aggregate_amount,json=aggregateAmount,proto3" json:"aggregate_amount,omitempty"`
+	TaxableAmount        float64                `protobuf:"fixed64,4,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount,omitempty"`
+	TdsAmount            float64                `protobuf:"fixed64,5,opt,name=tds_amount,json=tdsAmount,proto3" json:"tds_amount,omitempty"`
+	SingleThreshold      float64                `protobuf:"fixed64,6,opt,name=single_threshold,json=singleThreshold,proto3" json:"single_threshold,omitempty"`
+	AnnualThreshold      float64                `protobuf:"fixed64,7,opt,name=annual_threshold,json=annualThreshold,proto3" json:"annual_threshold,omitempty"`
+	AggregateAmountExact *Money                 `protobuf:"bytes,8,opt,name=aggregate_amount_exact,json=aggregateAmountExact,proto3" json:"aggregate_amount_exact,omitempty"`
+	TaxableAmountExact   *Money                 `protobuf:"bytes,9,opt,name=taxable_amount_exact,json=taxableAmountExact,proto3" json:"taxable_amount_exact,omitempty"`
+	TdsAmountExact       *Money                 `protobuf:"bytes,10,opt,name=tds_amount_exact,json=tdsAmountExact,proto3" json:"tds_amount_exact,omitempty"`
+	SingleThresholdExact *Money                 `protobuf:"bytes,11,opt,name=single_threshold_exact,json=singleThresholdExact,proto3" json:"single_threshold_exact,omitempty"`
+	AnnualThresholdExact *Money                 `protobuf:"bytes,12,opt,name=annual_threshold_exact,json=annualThresholdExact,proto3" json:"annual_threshold_exact,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TDSSectionSummary) Reset() {
+	*x = TDSSectionSummary{}
+	mi := &file_api_proto_vendor_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVendorAccountsResponse) String() string {
+func (x *TDSSectionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVendorAccountsResponse) ProtoMessage() {}
+func (*TDSSectionSummary) ProtoMessage() {}
 
-func (x *GetVendorAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[23]
+func (x *TDSSectionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,40 +7801,119 @@ func (x *GetVendorAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVendorAccountsResponse.ProtoReflect.Descriptor instead.
-func (*GetVendorAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use TDSSectionSummary.ProtoReflect.Descriptor instead.
+func (*TDSSectionSummary) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetVendorAccountsResponse) GetAccounts() []*VendorAccount {
+func (x *TDSSectionSummary) GetSectionCode() string {
 	if x != nil {
-		return x.Accounts
+		return x.SectionCode
+	}
+	return ""
+}
+
+func (x *TDSSectionSummary) GetDeductions() int32 {
+	if x != nil {
+		return x.Deductions
+	}
+	return 0
+}
+
+func (x *TDSSectionSummary) GetAggregateAmount() float64 {
+	if x != nil {
+		return x.AggregateAmount
+	}
+	return 0
+}
+
+func (x *TDSSectionSummary) GetTaxableAmount() float64 {
+	if x != nil {
+		return x.TaxableAmount
+	}
+	return 0
+}
+
+func (x *TDSSectionSummary) GetTdsAmount() float64 {
+	if x != nil {
+		return x.TdsAmount
+	}
+	return 0
+}
+
+func (x *TDSSectionSummary) GetSingleThreshold() float64 {
+	if x != nil {
+		return x.SingleThreshold
+	}
+	return 0
+}
+
+func (x *TDSSectionSummary) GetAnnualThreshold() float64 {
+	if x != nil {
+		return x.AnnualThreshold
+	}
+	return 0
+}
+
+func (x *TDSSectionSummary) GetAggregateAmountExact() *Money {
+	if x != nil {
+		return x.AggregateAmountExact
 	}
 	return nil
 }
 
-type BankingDetailsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	BankingDetails *BankingDetails        `protobuf:"bytes,1,opt,name=banking_details,json=bankingDetails,proto3" json:"banking_details,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *TDSSectionSummary) GetTaxableAmountExact() *Money {
+	if x != nil {
+		return x.TaxableAmountExact
+	}
+	return nil
 }
 
-func (x *BankingDetailsResponse) Reset() {
-	*x = BankingDetailsResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[24]
+func (x *TDSSectionSummary) GetTdsAmountExact() *Money {
+	if x != nil {
+		return x.TdsAmountExact
+	}
+	return nil
+}
+
+func (x *TDSSectionSummary) GetSingleThresholdExact() *Money {
+	if x != nil {
+		return x.SingleThresholdExact
+	}
+	return nil
+}
+
+func (x *TDSSectionSummary) GetAnnualThresholdExact() *Money {
+	if x != nil {
+		return x.AnnualThresholdExact
+	}
+	return nil
+}
+
+type VendorTDSSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	FinancialYear string                 `protobuf:"bytes,2,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`
+	Sections      []*TDSSectionSummary   `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorTDSSummaryResponse) Reset() {
+	*x = VendorTDSSummaryResponse{}
+	mi := &file_api_proto_vendor_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BankingDetailsResponse) String() string {
+func (x *VendorTDSSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BankingDetailsResponse) ProtoMessage() {}
+func (*VendorTDSSummaryResponse) ProtoMessage() {}
 
-func (x *BankingDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[24]
+func (x *VendorTDSSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_vendor_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,19 +7924,32 @@ func (x *BankingDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BankingDetailsResponse.ProtoReflect.Descriptor instead.
-func (*BankingDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use VendorTDSSummaryResponse.ProtoReflect.Descriptor instead.
+func (*VendorTDSSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *BankingDetailsResponse) GetBankingDetails() *BankingDetails {
+func (x *VendorTDSSummaryResponse) GetVendorId() string {
 	if x != nil {
-		return x.BankingDetails
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *VendorTDSSummaryResponse) GetFinancialYear() string {
+	if x != nil {
+		return x.FinancialYear
+	}
+	return ""
+}
+
+func (x *VendorTDSSummaryResponse) GetSections() []*TDSSectionSummary {
+	if x != nil {
+		return x.Sections
 	}
 	return nil
 }
 
-// Additional messages for VendorAccountController methods
 type GetVendorAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
@@ -2770,7 +7960,7 @@ type GetVendorAccountRequest struct {
 
 func (x *GetVendorAccountRequest) Reset() {
 	*x = GetVendorAccountRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[25]
+	mi := &file_api_proto_vendor_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2782,7 +7972,7 @@ func (x *GetVendorAccountRequest) String() string {
 func (*GetVendorAccountRequest) ProtoMessage() {}
 
 func (x *GetVendorAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[25]
+	mi := &file_api_proto_vendor_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,7 +7985,7 @@ func (x *GetVendorAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVendorAccountRequest.ProtoReflect.Descriptor instead.
 func (*GetVendorAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{25}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *GetVendorAccountRequest) GetAccountId() string {
@@ -2821,7 +8011,7 @@ type SetPrimaryAccountRequest struct {
 
 func (x *SetPrimaryAccountRequest) Reset() {
 	*x = SetPrimaryAccountRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[26]
+	mi := &file_api_proto_vendor_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2833,7 +8023,7 @@ func (x *SetPrimaryAccountRequest) String() string {
 func (*SetPrimaryAccountRequest) ProtoMessage() {}
 
 func (x *SetPrimaryAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[26]
+	mi := &file_api_proto_vendor_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2846,7 +8036,7 @@ func (x *SetPrimaryAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetPrimaryAccountRequest.ProtoReflect.Descriptor instead.
 func (*SetPrimaryAccountRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{26}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *SetPrimaryAccountRequest) GetAccountId() string {
@@ -2865,7 +8055,7 @@ type GetProjectsDropdownRequest struct {
 
 func (x *GetProjectsDropdownRequest) Reset() {
 	*x = GetProjectsDropdownRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[27]
+	mi := &file_api_proto_vendor_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2877,7 +8067,7 @@ func (x *GetProjectsDropdownRequest) String() string {
 func (*GetProjectsDropdownRequest) ProtoMessage() {}
 
 func (x *GetProjectsDropdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[27]
+	mi := &file_api_proto_vendor_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2890,7 +8080,7 @@ func (x *GetProjectsDropdownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectsDropdownRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectsDropdownRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{27}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{93}
 }
 
 type ProjectDropdownItem struct {
@@ -2903,7 +8093,7 @@ type ProjectDropdownItem struct {
 
 func (x *ProjectDropdownItem) Reset() {
 	*x = ProjectDropdownItem{}
-	mi := &file_api_proto_vendor_proto_msgTypes[28]
+	mi := &file_api_proto_vendor_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2915,7 +8105,7 @@ func (x *ProjectDropdownItem) String() string {
 func (*ProjectDropdownItem) ProtoMessage() {}
 
 func (x *ProjectDropdownItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[28]
+	mi := &file_api_proto_vendor_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2928,7 +8118,7 @@ func (x *ProjectDropdownItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectDropdownItem.ProtoReflect.Descriptor instead.
 func (*ProjectDropdownItem) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{28}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *ProjectDropdownItem) GetId() string {
@@ -2954,7 +8144,7 @@ type GetProjectsDropdownResponse struct {
 
 func (x *GetProjectsDropdownResponse) Reset() {
 	*x = GetProjectsDropdownResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[29]
+	mi := &file_api_proto_vendor_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2966,7 +8156,7 @@ func (x *GetProjectsDropdownResponse) String() string {
 func (*GetProjectsDropdownResponse) ProtoMessage() {}
 
 func (x *GetProjectsDropdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[29]
+	mi := &file_api_proto_vendor_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2979,7 +8169,7 @@ func (x *GetProjectsDropdownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectsDropdownResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectsDropdownResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{29}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *GetProjectsDropdownResponse) GetProjects() []*ProjectDropdownItem {
@@ -3002,7 +8192,7 @@ type UploadVendorSignatureRequest struct {
 
 func (x *UploadVendorSignatureRequest) Reset() {
 	*x = UploadVendorSignatureRequest{}
-	mi := &file_api_proto_vendor_proto_msgTypes[30]
+	mi := &file_api_proto_vendor_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +8204,7 @@ func (x *UploadVendorSignatureRequest) String() string {
 func (*UploadVendorSignatureRequest) ProtoMessage() {}
 
 func (x *UploadVendorSignatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[30]
+	mi := &file_api_proto_vendor_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,7 +8217,7 @@ func (x *UploadVendorSignatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadVendorSignatureRequest.ProtoReflect.Descriptor instead.
 func (*UploadVendorSignatureRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{30}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *UploadVendorSignatureRequest) GetVendorId() string {
@@ -3060,7 +8250,7 @@ type UploadVendorSignatureResponse struct {
 
 func (x *UploadVendorSignatureResponse) Reset() {
 	*x = UploadVendorSignatureResponse{}
-	mi := &file_api_proto_vendor_proto_msgTypes[31]
+	mi := &file_api_proto_vendor_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3072,7 +8262,7 @@ func (x *UploadVendorSignatureResponse) String() string {
 func (*UploadVendorSignatureResponse) ProtoMessage() {}
 
 func (x *UploadVendorSignatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_vendor_proto_msgTypes[31]
+	mi := &file_api_proto_vendor_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3085,7 +8275,7 @@ func (x *UploadVendorSignatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadVendorSignatureResponse.ProtoReflect.Descriptor instead.
 func (*UploadVendorSignatureResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_vendor_proto_rawDescGZIP(), []int{31}
+	return file_api_proto_vendor_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *UploadVendorSignatureResponse) GetSuccess() bool {
@@ -3240,7 +8430,7 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"_ifsc_codeB\n" +
 	"\n" +
 	"\b_addressB\x10\n" +
-	"\x0e_signature_url\"\xe3\x04\n" +
+	"\x0e_signature_url\"\xe1\x05\n" +
 	"\rVendorAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tvendor_id\x18\x02 \x01(\tR\bvendorId\x12!\n" +
@@ -3264,12 +8454,54 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12'\n" +
+	"\x0fapproval_status\x18\x10 \x01(\tR\x0eapprovalStatus\x12B\n" +
+	"\fpayable_from\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\vpayableFrom\x88\x01\x01B\x0f\n" +
 	"\r_account_typeB\x0e\n" +
 	"\f_branch_nameB\r\n" +
 	"\v_swift_codeB\n" +
 	"\n" +
-	"\b_remarks\"\xe6\x02\n" +
+	"\b_remarksB\x0f\n" +
+	"\r_payable_from\"\xdf\x02\n" +
+	"\x13BankDetailsSnapshot\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12&\n" +
+	"\faccount_type\x18\x03 \x01(\tH\x00R\vaccountType\x88\x01\x01\x12 \n" +
+	"\fname_of_bank\x18\x04 \x01(\tR\n" +
+	"nameOfBank\x12$\n" +
+	"\vbranch_name\x18\x05 \x01(\tH\x01R\n" +
+	"branchName\x88\x01\x01\x12\x1b\n" +
+	"\tifsc_code\x18\x06 \x01(\tR\bifscCode\x12\"\n" +
+	"\n" +
+	"swift_code\x18\a \x01(\tH\x02R\tswiftCode\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\b \x01(\bR\tisPrimaryB\x0f\n" +
+	"\r_account_typeB\x0e\n" +
+	"\f_branch_nameB\r\n" +
+	"\v_swift_code\"\xc1\x04\n" +
+	"\x13VendorAccountChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tvendor_id\x18\x02 \x01(\tR\bvendorId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vchange_type\x18\x04 \x01(\tR\n" +
+	"changeType\x12=\n" +
+	"\n" +
+	"old_values\x18\x05 \x01(\v2\x1e.vendor.v1.BankDetailsSnapshotR\toldValues\x12=\n" +
+	"\n" +
+	"new_values\x18\x06 \x01(\v2\x1e.vendor.v1.BankDetailsSnapshotR\tnewValues\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12=\n" +
+	"\frequested_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12$\n" +
+	"\vreviewed_by\x18\n" +
+	" \x01(\tH\x00R\n" +
+	"reviewedBy\x88\x01\x01\x12@\n" +
+	"\vreviewed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"reviewedAt\x88\x01\x01\x12*\n" +
+	"\x0ereview_remarks\x18\f \x01(\tH\x02R\rreviewRemarks\x88\x01\x01B\x0e\n" +
+	"\f_reviewed_byB\x0e\n" +
+	"\f_reviewed_atB\x11\n" +
+	"\x0f_review_remarks\"\xe6\x02\n" +
 	"\x0eBankingDetails\x12!\n" +
 	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12%\n" +
 	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12&\n" +
@@ -3286,7 +8518,7 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"\f_branch_nameB\r\n" +
 	"\v_swift_codeB\n" +
 	"\n" +
-	"\b_remarks\"\xe2\x0f\n" +
+	"\b_remarks\"\x8c\x10\n" +
 	"\x13CreateVendorRequest\x12\x1c\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\ttenant_id\x12 \n" +
 	"\vvendor_name\x18\x02 \x01(\tR\vvendor_name\x12\"\n" +
@@ -3336,7 +8568,8 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"\x0eaccount_number\x18' \x01(\tH\x19R\x0eaccount_number\x88\x01\x01\x12'\n" +
 	"\fname_of_bank\x18( \x01(\tH\x1aR\fname_of_bank\x88\x01\x01\x12!\n" +
 	"\tifsc_code\x18) \x01(\tH\x1bR\tifsc_code\x88\x01\x01\x12\x1d\n" +
-	"\aaddress\x18* \x01(\tH\x1cR\aaddress\x88\x01\x01B\x10\n" +
+	"\aaddress\x18* \x01(\tH\x1cR\aaddress\x88\x01\x01\x12(\n" +
+	"\x0fallow_duplicate\x18+ \x01(\bR\x0fallow_duplicateB\x10\n" +
 	"\x0e_vendor_mobileB\x13\n" +
 	"\x11_vendor_nick_nameB\x10\n" +
 	"\x0e_activity_typeB\b\n" +
@@ -3574,7 +8807,57 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"account_id\x18\x01 \x01(\tR\taccountId\";\n" +
 	"\x1aToggleAccountStatusRequest\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\";\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"\xaa\x01\n" +
+	"\x1fListVendorAccountChangesRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12 \n" +
+	"\tvendor_id\x18\x02 \x01(\tH\x01R\bvendorId\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSizeB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_vendor_id\"j\n" +
+	" ReviewVendorAccountChangeRequest\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12\x1d\n" +
+	"\aremarks\x18\x02 \x01(\tH\x00R\aremarks\x88\x01\x01B\n" +
+	"\n" +
+	"\b_remarks\"_\n" +
+	"\x19VerifyPayeeAccountRequest\x12%\n" +
+	"\x0eaccount_number\x18\x01 \x01(\tR\raccountNumber\x12\x1b\n" +
+	"\tifsc_code\x18\x02 \x01(\tR\bifscCode\"\xe4\x01\n" +
+	"\x1bFindDuplicateVendorsRequest\x12\x1f\n" +
+	"\vvendor_name\x18\x01 \x01(\tR\n" +
+	"vendorName\x12\x10\n" +
+	"\x03pan\x18\x02 \x01(\tR\x03pan\x12\x19\n" +
+	"\x05gstin\x18\x03 \x01(\tH\x00R\x05gstin\x88\x01\x01\x12*\n" +
+	"\x0eaccount_number\x18\x04 \x01(\tH\x01R\raccountNumber\x88\x01\x01\x12 \n" +
+	"\tifsc_code\x18\x05 \x01(\tH\x02R\bifscCode\x88\x01\x01B\b\n" +
+	"\x06_gstinB\x11\n" +
+	"\x0f_account_numberB\f\n" +
+	"\n" +
+	"_ifsc_code\"\xb2\x01\n" +
+	"\x14ImportVendorsRequest\x12!\n" +
+	"\ffile_content\x18\x01 \x01(\fR\vfileContent\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12)\n" +
+	"\x10allow_duplicates\x18\x05 \x01(\bR\x0fallowDuplicates\"\xea\x01\n" +
+	"\x14ExportVendorsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x1b\n" +
+	"\x06search\x18\x02 \x01(\tH\x00R\x06search\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x01R\bisActive\x88\x01\x01\x12&\n" +
+	"\faccount_type\x18\x04 \x01(\tH\x02R\vaccountType\x88\x01\x01\x12\x1d\n" +
+	"\aproject\x18\x05 \x01(\tH\x03R\aproject\x88\x01\x01B\t\n" +
+	"\a_searchB\f\n" +
+	"\n" +
+	"_is_activeB\x0f\n" +
+	"\r_account_typeB\n" +
+	"\n" +
+	"\b_project\"\x95\x01\n" +
+	"\x13MergeVendorsRequest\x12,\n" +
+	"\x12survivor_vendor_id\x18\x01 \x01(\tR\x10survivorVendorId\x12(\n" +
+	"\x10merged_vendor_id\x18\x02 \x01(\tR\x0emergedVendorId\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\";\n" +
 	"\x0eVendorResponse\x12)\n" +
 	"\x06vendor\x18\x01 \x01(\v2\x11.vendor.v1.VendorR\x06vendor\"\xa2\x01\n" +
 	"\x13ListVendorsResponse\x12+\n" +
@@ -3592,7 +8875,489 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"\x19GetVendorAccountsResponse\x124\n" +
 	"\baccounts\x18\x01 \x03(\v2\x18.vendor.v1.VendorAccountR\baccounts\"\\\n" +
 	"\x16BankingDetailsResponse\x12B\n" +
-	"\x0fbanking_details\x18\x01 \x01(\v2\x19.vendor.v1.BankingDetailsR\x0ebankingDetails\"h\n" +
+	"\x0fbanking_details\x18\x01 \x01(\v2\x19.vendor.v1.BankingDetailsR\x0ebankingDetails\"\xbc\x01\n" +
+	" ListVendorAccountChangesResponse\x128\n" +
+	"\achanges\x18\x01 \x03(\v2\x1e.vendor.v1.VendorAccountChangeR\achanges\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12=\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1d.vendor.v1.PaginationMetadataR\n" +
+	"pagination\"U\n" +
+	"\x1bVendorAccountChangeResponse\x126\n" +
+	"\x06change\x18\x01 \x01(\v2\x1e.vendor.v1.VendorAccountChangeR\x06change\"\x86\x02\n" +
+	"\x1aVerifyPayeeAccountResponse\x12\x18\n" +
+	"\apayable\x18\x01 \x01(\bR\apayable\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12 \n" +
+	"\tvendor_id\x18\x03 \x01(\tH\x00R\bvendorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\tH\x01R\taccountId\x88\x01\x01\x12B\n" +
+	"\fpayable_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vpayableFrom\x88\x01\x01B\f\n" +
+	"\n" +
+	"_vendor_idB\r\n" +
+	"\v_account_idB\x0f\n" +
+	"\r_payable_from\"\xb0\x01\n" +
+	"\x14DuplicateVendorMatch\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12\x1f\n" +
+	"\vvendor_code\x18\x02 \x01(\tR\n" +
+	"vendorCode\x12\x1f\n" +
+	"\vvendor_name\x18\x03 \x01(\tR\n" +
+	"vendorName\x12\x1d\n" +
+	"\n" +
+	"matched_on\x18\x04 \x03(\tR\tmatchedOn\x12\x1a\n" +
+	"\bblocking\x18\x05 \x01(\bR\bblocking\"Y\n" +
+	"\x1cFindDuplicateVendorsResponse\x129\n" +
+	"\amatches\x18\x01 \x03(\v2\x1f.vendor.v1.DuplicateVendorMatchR\amatches\"b\n" +
+	"\x11VendorImportError\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x01 \x01(\x05R\trowNumber\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xf0\x01\n" +
+	"\x15ImportVendorsResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12\x1d\n" +
+	"\n" +
+	"valid_rows\x18\x02 \x01(\x05R\tvalidRows\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\tcommitted\x18\x04 \x01(\bR\tcommitted\x124\n" +
+	"\x06errors\x18\x05 \x03(\v2\x1c.vendor.v1.VendorImportErrorR\x06errors\x12,\n" +
+	"\x12created_vendor_ids\x18\x06 \x03(\tR\x10createdVendorIds\"\x97\x01\n" +
+	"\x15ExportVendorsResponse\x12!\n" +
+	"\ffile_content\x18\x01 \x01(\fR\vfileContent\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\trow_count\x18\x04 \x01(\x05R\browCount\"\xf6\x02\n" +
+	"\vVendorMerge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12survivor_vendor_id\x18\x02 \x01(\tR\x10survivorVendorId\x12(\n" +
+	"\x10merged_vendor_id\x18\x03 \x01(\tR\x0emergedVendorId\x12,\n" +
+	"\x12merged_vendor_code\x18\x04 \x01(\tR\x10mergedVendorCode\x12,\n" +
+	"\x12merged_vendor_name\x18\x05 \x01(\tR\x10mergedVendorName\x12%\n" +
+	"\x0eaccounts_moved\x18\x06 \x01(\x05R\raccountsMoved\x12\x1b\n" +
+	"\x06reason\x18\a \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x1b\n" +
+	"\tmerged_by\x18\b \x01(\tR\bmergedBy\x127\n" +
+	"\tmerged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAtB\t\n" +
+	"\a_reason\"D\n" +
+	"\x14MergeVendorsResponse\x12,\n" +
+	"\x05merge\x18\x01 \x01(\v2\x16.vendor.v1.VendorMergeR\x05merge\"I\n" +
+	"\x05Money\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"\xa9\b\n" +
+	"\vMSMEInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tvendor_id\x18\x02 \x01(\tR\bvendorId\x12\x1f\n" +
+	"\vvendor_code\x18\x03 \x01(\tR\n" +
+	"vendorCode\x12\x1f\n" +
+	"\vvendor_name\x18\x04 \x01(\tR\n" +
+	"vendorName\x12/\n" +
+	"\x13msme_classification\x18\x05 \x01(\tR\x12msmeClassification\x128\n" +
+	"\x18msme_registration_number\x18\x06 \x01(\tR\x16msmeRegistrationNumber\x12%\n" +
+	"\x0einvoice_number\x18\a \x01(\tR\rinvoiceNumber\x12!\n" +
+	"\finvoice_date\x18\b \x01(\tR\vinvoiceDate\x12,\n" +
+	"\x0facceptance_date\x18\t \x01(\tH\x00R\x0eacceptanceDate\x88\x01\x01\x121\n" +
+	"\x12agreed_credit_days\x18\n" +
+	" \x01(\x05H\x01R\x10agreedCreditDays\x88\x01\x01\x12%\n" +
+	"\x0einvoice_amount\x18\v \x01(\x01R\rinvoiceAmount\x12\x19\n" +
+	"\bdue_date\x18\f \x01(\tR\adueDate\x12'\n" +
+	"\rgreen_note_id\x18\r \x01(\tH\x02R\vgreenNoteId\x88\x01\x01\x12+\n" +
+	"\x0fpayment_note_id\x18\x0e \x01(\tH\x03R\rpaymentNoteId\x88\x01\x01\x12 \n" +
+	"\tpaid_date\x18\x0f \x01(\tH\x04R\bpaidDate\x88\x01\x01\x120\n" +
+	"\x11payment_reference\x18\x10 \x01(\tH\x05R\x10paymentReference\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x11 \x01(\tR\x06status\x12\"\n" +
+	"\rdays_past_due\x18\x12 \x01(\x05R\vdaysPastDue\x12-\n" +
+	"\x12interest_liability\x18\x13 \x01(\x01R\x11interestLiability\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\x14invoice_amount_exact\x18\x15 \x01(\v2\x10.vendor.v1.MoneyR\x12invoiceAmountExact\x12J\n" +
+	"\x18interest_liability_exact\x18\x16 \x01(\v2\x10.vendor.v1.MoneyR\x16interestLiabilityExactB\x12\n" +
+	"\x10_acceptance_dateB\x15\n" +
+	"\x13_agreed_credit_daysB\x10\n" +
+	"\x0e_green_note_idB\x12\n" +
+	"\x10_payment_note_idB\f\n" +
+	"\n" +
+	"_paid_dateB\x14\n" +
+	"\x12_payment_reference\"\xf6\x03\n" +
+	"\x1aRegisterMSMEInvoiceRequest\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12%\n" +
+	"\x0einvoice_number\x18\x02 \x01(\tR\rinvoiceNumber\x12!\n" +
+	"\finvoice_date\x18\x03 \x01(\tR\vinvoiceDate\x12,\n" +
+	"\x0facceptance_date\x18\x04 \x01(\tH\x00R\x0eacceptanceDate\x88\x01\x01\x121\n" +
+	"\x12agreed_credit_days\x18\x05 \x01(\x05H\x01R\x10agreedCreditDays\x88\x01\x01\x12%\n" +
+	"\x0einvoice_amount\x18\x06 \x01(\x01R\rinvoiceAmount\x12'\n" +
+	"\rgreen_note_id\x18\a \x01(\tH\x02R\vgreenNoteId\x88\x01\x01\x12+\n" +
+	"\x0fpayment_note_id\x18\b \x01(\tH\x03R\rpaymentNoteId\x88\x01\x01\x12B\n" +
+	"\x14invoice_amount_exact\x18\t \x01(\v2\x10.vendor.v1.MoneyR\x12invoiceAmountExactB\x12\n" +
+	"\x10_acceptance_dateB\x15\n" +
+	"\x13_agreed_credit_daysB\x10\n" +
+	"\x0e_green_note_idB\x12\n" +
+	"\x10_payment_note_id\"\xe6\x01\n" +
+	"\x1fRecordMSMEInvoicePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12\x1b\n" +
+	"\tpaid_date\x18\x02 \x01(\tR\bpaidDate\x12+\n" +
+	"\x0fpayment_note_id\x18\x03 \x01(\tH\x00R\rpaymentNoteId\x88\x01\x01\x120\n" +
+	"\x11payment_reference\x18\x04 \x01(\tH\x01R\x10paymentReference\x88\x01\x01B\x12\n" +
+	"\x10_payment_note_idB\x14\n" +
+	"\x12_payment_reference\"\xc2\x02\n" +
+	"\x17ListMSMEInvoicesRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12 \n" +
+	"\tvendor_id\x18\x02 \x01(\tH\x01R\bvendorId\x88\x01\x01\x12'\n" +
+	"\rgreen_note_id\x18\x03 \x01(\tH\x02R\vgreenNoteId\x88\x01\x01\x12+\n" +
+	"\x0fpayment_note_id\x18\x04 \x01(\tH\x03R\rpaymentNoteId\x88\x01\x01\x12\x18\n" +
+	"\x05as_of\x18\x05 \x01(\tH\x04R\x04asOf\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSizeB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_vendor_idB\x10\n" +
+	"\x0e_green_note_idB\x12\n" +
+	"\x10_payment_note_idB\b\n" +
+	"\x06_as_of\"G\n" +
+	"\x13MSMEInvoiceResponse\x120\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x16.vendor.v1.MSMEInvoiceR\ainvoice\"\xae\x01\n" +
+	"\x18ListMSMEInvoicesResponse\x122\n" +
+	"\binvoices\x18\x01 \x03(\v2\x16.vendor.v1.MSMEInvoiceR\binvoices\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12=\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1d.vendor.v1.PaginationMetadataR\n" +
+	"pagination\"~\n" +
+	"\x1fGetMSMEOutstandingReportRequest\x12\x18\n" +
+	"\x05as_of\x18\x01 \x01(\tH\x00R\x04asOf\x88\x01\x01\x12&\n" +
+	"\fperiod_start\x18\x02 \x01(\tH\x01R\vperiodStart\x88\x01\x01B\b\n" +
+	"\x06_as_ofB\x0f\n" +
+	"\r_period_start\"\x80\a\n" +
+	"\x15MSMEVendorOutstanding\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12\x1f\n" +
+	"\vvendor_code\x18\x02 \x01(\tR\n" +
+	"vendorCode\x12\x1f\n" +
+	"\vvendor_name\x18\x03 \x01(\tR\n" +
+	"vendorName\x12/\n" +
+	"\x13msme_classification\x18\x04 \x01(\tR\x12msmeClassification\x128\n" +
+	"\x18msme_registration_number\x18\x05 \x01(\tR\x16msmeRegistrationNumber\x121\n" +
+	"\x14outstanding_invoices\x18\x06 \x01(\x05R\x13outstandingInvoices\x12-\n" +
+	"\x12outstanding_amount\x18\a \x01(\x01R\x11outstandingAmount\x12)\n" +
+	"\x10overdue_invoices\x18\b \x01(\x05R\x0foverdueInvoices\x12%\n" +
+	"\x0eoverdue_amount\x18\t \x01(\x01R\roverdueAmount\x12,\n" +
+	"\x12paid_late_invoices\x18\n" +
+	" \x01(\x05R\x10paidLateInvoices\x12(\n" +
+	"\x10paid_late_amount\x18\v \x01(\x01R\x0epaidLateAmount\x12-\n" +
+	"\x12interest_liability\x18\f \x01(\x01R\x11interestLiability\x12+\n" +
+	"\x0foldest_due_date\x18\r \x01(\tH\x00R\roldestDueDate\x88\x01\x01\x12J\n" +
+	"\x18outstanding_amount_exact\x18\x0e \x01(\v2\x10.vendor.v1.MoneyR\x16outstandingAmountExact\x12B\n" +
+	"\x14overdue_amount_exact\x18\x0f \x01(\v2\x10.vendor.v1.MoneyR\x12overdueAmountExact\x12E\n" +
+	"\x16paid_late_amount_exact\x18\x10 \x01(\v2\x10.vendor.v1.MoneyR\x13paidLateAmountExact\x12J\n" +
+	"\x18interest_liability_exact\x18\x11 \x01(\v2\x10.vendor.v1.MoneyR\x16interestLiabilityExactB\x12\n" +
+	"\x10_oldest_due_date\"\xda\x05\n" +
+	"\x1dMSMEOutstandingReportResponse\x12\x13\n" +
+	"\x05as_of\x18\x01 \x01(\tR\x04asOf\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\tR\vperiodStart\x12\x1b\n" +
+	"\tbank_rate\x18\x03 \x01(\x01R\bbankRate\x12:\n" +
+	"\avendors\x18\x04 \x03(\v2 .vendor.v1.MSMEVendorOutstandingR\avendors\x128\n" +
+	"\x18total_outstanding_amount\x18\x05 \x01(\x01R\x16totalOutstandingAmount\x120\n" +
+	"\x14total_overdue_amount\x18\x06 \x01(\x01R\x12totalOverdueAmount\x123\n" +
+	"\x16total_paid_late_amount\x18\a \x01(\x01R\x13totalPaidLateAmount\x128\n" +
+	"\x18total_interest_liability\x18\b \x01(\x01R\x16totalInterestLiability\x12U\n" +
+	"\x1etotal_outstanding_amount_exact\x18\t \x01(\v2\x10.vendor.v1.MoneyR\x1btotalOutstandingAmountExact\x12M\n" +
+	"\x1atotal_overdue_amount_exact\x18\n" +
+	" \x01(\v2\x10.vendor.v1.MoneyR\x17totalOverdueAmountExact\x12P\n" +
+	"\x1ctotal_paid_late_amount_exact\x18\v \x01(\v2\x10.vendor.v1.MoneyR\x18totalPaidLateAmountExact\x12U\n" +
+	"\x1etotal_interest_liability_exact\x18\f \x01(\v2\x10.vendor.v1.MoneyR\x1btotalInterestLiabilityExact\"\xae\x01\n" +
+	"\x10VendorPortalUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tvendor_id\x18\x02 \x01(\tR\bvendorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tlinked_by\x18\x04 \x01(\tR\blinkedBy\x127\n" +
+	"\tlinked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\"\xa4\x02\n" +
+	"\x15VendorInvoiceDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12\x1f\n" +
+	"\vobject_path\x18\x06 \x01(\tR\n" +
+	"objectPath\x12\x1f\n" +
+	"\vuploaded_by\x18\a \x01(\tR\n" +
+	"uploadedBy\x12;\n" +
+	"\vuploaded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"uploadedAt\"\xea\a\n" +
+	"\rVendorInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tvendor_id\x18\x02 \x01(\tR\bvendorId\x12%\n" +
+	"\x0einvoice_number\x18\x03 \x01(\tR\rinvoiceNumber\x12!\n" +
+	"\finvoice_date\x18\x04 \x01(\tR\vinvoiceDate\x12%\n" +
+	"\x0einvoice_amount\x18\x05 \x01(\x01R\rinvoiceAmount\x12 \n" +
+	"\tpo_number\x18\x06 \x01(\tH\x00R\bpoNumber\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12'\n" +
+	"\rgreen_note_id\x18\t \x01(\tH\x02R\vgreenNoteId\x88\x01\x01\x12+\n" +
+	"\x0fpayment_note_id\x18\n" +
+	" \x01(\tH\x03R\rpaymentNoteId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"utr_number\x18\v \x01(\tH\x04R\tutrNumber\x88\x01\x01\x12$\n" +
+	"\vpaid_amount\x18\f \x01(\x01H\x05R\n" +
+	"paidAmount\x88\x01\x01\x123\n" +
+	"\apaid_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12.\n" +
+	"\x10rejection_reason\x18\x0e \x01(\tH\x06R\x0frejectionReason\x88\x01\x01\x12!\n" +
+	"\fsubmitted_by\x18\x0f \x01(\tR\vsubmittedBy\x12=\n" +
+	"\fsubmitted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\tdocuments\x18\x12 \x03(\v2 .vendor.v1.VendorInvoiceDocumentR\tdocuments\x12B\n" +
+	"\x14invoice_amount_exact\x18\x13 \x01(\v2\x10.vendor.v1.MoneyR\x12invoiceAmountExact\x12<\n" +
+	"\x11paid_amount_exact\x18\x14 \x01(\v2\x10.vendor.v1.MoneyR\x0fpaidAmountExactB\f\n" +
+	"\n" +
+	"_po_numberB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_green_note_idB\x12\n" +
+	"\x10_payment_note_idB\r\n" +
+	"\v_utr_numberB\x0e\n" +
+	"\f_paid_amountB\x13\n" +
+	"\x11_rejection_reason\"\x19\n" +
+	"\x17GetPortalProfileRequest\"\xbe\x02\n" +
+	"\x1aUpdatePortalContactRequest\x12(\n" +
+	"\rvendor_mobile\x18\x01 \x01(\tH\x00R\fvendorMobile\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x02 \x01(\tH\x01R\aaddress\x88\x01\x01\x12 \n" +
+	"\tcity_name\x18\x03 \x01(\tH\x02R\bcityName\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"state_name\x18\x04 \x01(\tH\x03R\tstateName\x88\x01\x01\x12&\n" +
+	"\fcountry_name\x18\x05 \x01(\tH\x04R\vcountryName\x88\x01\x01\x12\x15\n" +
+	"\x03pin\x18\x06 \x01(\tH\x05R\x03pin\x88\x01\x01B\x10\n" +
+	"\x0e_vendor_mobileB\n" +
+	"\n" +
+	"\b_addressB\f\n" +
+	"\n" +
+	"_city_nameB\r\n" +
+	"\v_state_nameB\x0f\n" +
+	"\r_country_nameB\x06\n" +
+	"\x04_pin\"\xb8\x02\n" +
+	"\x1aSubmitPortalInvoiceRequest\x12%\n" +
+	"\x0einvoice_number\x18\x01 \x01(\tR\rinvoiceNumber\x12!\n" +
+	"\finvoice_date\x18\x02 \x01(\tR\vinvoiceDate\x12%\n" +
+	"\x0einvoice_amount\x18\x03 \x01(\x01R\rinvoiceAmount\x12 \n" +
+	"\tpo_number\x18\x04 \x01(\tH\x00R\bpoNumber\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x01R\vdescription\x88\x01\x01\x12B\n" +
+	"\x14invoice_amount_exact\x18\x06 \x01(\v2\x10.vendor.v1.MoneyR\x12invoiceAmountExactB\f\n" +
+	"\n" +
+	"_po_numberB\x0e\n" +
+	"\f_description\"\x83\x01\n" +
+	"\"UploadPortalInvoiceDocumentRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\ffile_content\x18\x03 \x01(\fR\vfileContent\"]\n" +
+	"\x1dVendorInvoiceDocumentResponse\x12<\n" +
+	"\bdocument\x18\x01 \x01(\v2 .vendor.v1.VendorInvoiceDocumentR\bdocument\"t\n" +
+	"\x19ListPortalInvoicesRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSizeB\t\n" +
+	"\a_status\"8\n" +
+	"\x17GetPortalInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\"K\n" +
+	"\x15VendorInvoiceResponse\x122\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x18.vendor.v1.VendorInvoiceR\ainvoice\"\xb2\x01\n" +
+	"\x1aListVendorInvoicesResponse\x124\n" +
+	"\binvoices\x18\x01 \x03(\v2\x18.vendor.v1.VendorInvoiceR\binvoices\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12=\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1d.vendor.v1.PaginationMetadataR\n" +
+	"pagination\"M\n" +
+	"\x15LinkVendorUserRequest\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"K\n" +
+	"\x18VendorPortalUserResponse\x12/\n" +
+	"\x04user\x18\x01 \x01(\v2\x1b.vendor.v1.VendorPortalUserR\x04user\"2\n" +
+	"\x17UnlinkVendorUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"\x1cListVendorPortalUsersRequest\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\"R\n" +
+	"\x1dListVendorPortalUsersResponse\x121\n" +
+	"\x05users\x18\x01 \x03(\v2\x1b.vendor.v1.VendorPortalUserR\x05users\"\xa4\x01\n" +
+	"\x19ListVendorInvoicesRequest\x12 \n" +
+	"\tvendor_id\x18\x01 \x01(\tH\x00R\bvendorId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSizeB\f\n" +
+	"\n" +
+	"_vendor_idB\t\n" +
+	"\a_status\"\x81\x04\n" +
+	" UpdateVendorInvoiceStatusRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x00R\x06status\x88\x01\x01\x12'\n" +
+	"\rgreen_note_id\x18\x03 \x01(\tH\x01R\vgreenNoteId\x88\x01\x01\x12+\n" +
+	"\x0fpayment_note_id\x18\x04 \x01(\tH\x02R\rpaymentNoteId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"utr_number\x18\x05 \x01(\tH\x03R\tutrNumber\x88\x01\x01\x12$\n" +
+	"\vpaid_amount\x18\x06 \x01(\x01H\x04R\n" +
+	"paidAmount\x88\x01\x01\x12 \n" +
+	"\tpaid_date\x18\a \x01(\tH\x05R\bpaidDate\x88\x01\x01\x12.\n" +
+	"\x10rejection_reason\x18\b \x01(\tH\x06R\x0frejectionReason\x88\x01\x01\x12<\n" +
+	"\x11paid_amount_exact\x18\t \x01(\v2\x10.vendor.v1.MoneyR\x0fpaidAmountExactB\t\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_green_note_idB\x12\n" +
+	"\x10_payment_note_idB\r\n" +
+	"\v_utr_numberB\x0e\n" +
+	"\f_paid_amountB\f\n" +
+	"\n" +
+	"_paid_dateB\x13\n" +
+	"\x11_rejection_reason\"\x92\x04\n" +
+	"\n" +
+	"TDSSection\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
+	"\x0frate_individual\x18\x03 \x01(\x01R\x0erateIndividual\x12\x1d\n" +
+	"\n" +
+	"rate_other\x18\x04 \x01(\x01R\trateOther\x12\x1e\n" +
+	"\vrate_no_pan\x18\x05 \x01(\x01R\trateNoPan\x12)\n" +
+	"\x10single_threshold\x18\x06 \x01(\x01R\x0fsingleThreshold\x12)\n" +
+	"\x10annual_threshold\x18\a \x01(\x01R\x0fannualThreshold\x12(\n" +
+	"\x10deduct_on_excess\x18\b \x01(\bR\x0edeductOnExcess\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12F\n" +
+	"\x16single_threshold_exact\x18\v \x01(\v2\x10.vendor.v1.MoneyR\x14singleThresholdExact\x12F\n" +
+	"\x16annual_threshold_exact\x18\f \x01(\v2\x10.vendor.v1.MoneyR\x14annualThresholdExact\"C\n" +
+	"\x16ListTDSSectionsRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"L\n" +
+	"\x17ListTDSSectionsResponse\x121\n" +
+	"\bsections\x18\x01 \x03(\v2\x15.vendor.v1.TDSSectionR\bsections\"\xe4\x03\n" +
+	"\x17UpsertTDSSectionRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
+	"\x0frate_individual\x18\x03 \x01(\x01R\x0erateIndividual\x12\x1d\n" +
+	"\n" +
+	"rate_other\x18\x04 \x01(\x01R\trateOther\x12\x1e\n" +
+	"\vrate_no_pan\x18\x05 \x01(\x01R\trateNoPan\x12)\n" +
+	"\x10single_threshold\x18\x06 \x01(\x01R\x0fsingleThreshold\x12)\n" +
+	"\x10annual_threshold\x18\a \x01(\x01R\x0fannualThreshold\x12(\n" +
+	"\x10deduct_on_excess\x18\b \x01(\bR\x0edeductOnExcess\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x12F\n" +
+	"\x16single_threshold_exact\x18\n" +
+	" \x01(\v2\x10.vendor.v1.MoneyR\x14singleThresholdExact\x12F\n" +
+	"\x16annual_threshold_exact\x18\v \x01(\v2\x10.vendor.v1.MoneyR\x14annualThresholdExact\"E\n" +
+	"\x12TDSSectionResponse\x12/\n" +
+	"\asection\x18\x01 \x01(\v2\x15.vendor.v1.TDSSectionR\asection\"\xf9\x03\n" +
+	"\x0eTDSCertificate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tvendor_id\x18\x02 \x01(\tR\bvendorId\x12!\n" +
+	"\fsection_code\x18\x03 \x01(\tR\vsectionCode\x12-\n" +
+	"\x12certificate_number\x18\x04 \x01(\tR\x11certificateNumber\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\x01R\x04rate\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x06 \x01(\tR\tvalidFrom\x12\x19\n" +
+	"\bvalid_to\x18\a \x01(\tR\avalidTo\x12!\n" +
+	"\famount_limit\x18\b \x01(\x01R\vamountLimit\x12\x1f\n" +
+	"\vamount_used\x18\t \x01(\x01R\n" +
+	"amountUsed\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\x12amount_limit_exact\x18\f \x01(\v2\x10.vendor.v1.MoneyR\x10amountLimitExact\x12<\n" +
+	"\x11amount_used_exact\x18\r \x01(\v2\x10.vendor.v1.MoneyR\x0famountUsedExact\"\xbd\x02\n" +
+	"\x1bCreateTDSCertificateRequest\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12!\n" +
+	"\fsection_code\x18\x02 \x01(\tR\vsectionCode\x12-\n" +
+	"\x12certificate_number\x18\x03 \x01(\tR\x11certificateNumber\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\tR\tvalidFrom\x12\x19\n" +
+	"\bvalid_to\x18\x06 \x01(\tR\avalidTo\x12!\n" +
+	"\famount_limit\x18\a \x01(\x01R\vamountLimit\x12>\n" +
+	"\x12amount_limit_exact\x18\b \x01(\v2\x10.vendor.v1.MoneyR\x10amountLimitExact\"U\n" +
+	"\x16TDSCertificateResponse\x12;\n" +
+	"\vcertificate\x18\x01 \x01(\v2\x19.vendor.v1.TDSCertificateR\vcertificate\"9\n" +
+	"\x1aListTDSCertificatesRequest\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\"\\\n" +
+	"\x1bListTDSCertificatesResponse\x12=\n" +
+	"\fcertificates\x18\x01 \x03(\v2\x19.vendor.v1.TDSCertificateR\fcertificates\"\x8b\a\n" +
+	"\fTDSDeduction\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12!\n" +
+	"\fsection_code\x18\x02 \x01(\tR\vsectionCode\x12%\n" +
+	"\x0efinancial_year\x18\x03 \x01(\tR\rfinancialYear\x12&\n" +
+	"\freference_id\x18\x04 \x01(\tH\x00R\vreferenceId\x88\x01\x01\x12!\n" +
+	"\fpayment_date\x18\x05 \x01(\tR\vpaymentDate\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12)\n" +
+	"\x10aggregate_before\x18\a \x01(\x01R\x0faggregateBefore\x12%\n" +
+	"\x0etaxable_amount\x18\b \x01(\x01R\rtaxableAmount\x12\x12\n" +
+	"\x04rate\x18\t \x01(\x01R\x04rate\x12\x12\n" +
+	"\x04rule\x18\n" +
+	" \x01(\tR\x04rule\x12*\n" +
+	"\x0ecertificate_id\x18\v \x01(\tH\x01R\rcertificateId\x88\x01\x01\x12-\n" +
+	"\x12certificate_amount\x18\f \x01(\x01R\x11certificateAmount\x12)\n" +
+	"\x10certificate_rate\x18\r \x01(\x01R\x0fcertificateRate\x12\x1d\n" +
+	"\n" +
+	"tds_amount\x18\x0e \x01(\x01R\ttdsAmount\x12%\n" +
+	"\x0eeffective_rate\x18\x0f \x01(\x01R\reffectiveRate\x123\n" +
+	"\famount_exact\x18\x10 \x01(\v2\x10.vendor.v1.MoneyR\vamountExact\x12F\n" +
+	"\x16aggregate_before_exact\x18\x11 \x01(\v2\x10.vendor.v1.MoneyR\x14aggregateBeforeExact\x12B\n" +
+	"\x14taxable_amount_exact\x18\x12 \x01(\v2\x10.vendor.v1.MoneyR\x12taxableAmountExact\x12J\n" +
+	"\x18certificate_amount_exact\x18\x13 \x01(\v2\x10.vendor.v1.MoneyR\x16certificateAmountExact\x12:\n" +
+	"\x10tds_amount_exact\x18\x14 \x01(\v2\x10.vendor.v1.MoneyR\x0etdsAmountExactB\x0f\n" +
+	"\r_reference_idB\x11\n" +
+	"\x0f_certificate_id\"\xa4\x02\n" +
+	"\x13CalculateTDSRequest\x12 \n" +
+	"\tvendor_id\x18\x01 \x01(\tH\x00R\bvendorId\x88\x01\x01\x12$\n" +
+	"\vvendor_code\x18\x02 \x01(\tH\x01R\n" +
+	"vendorCode\x88\x01\x01\x12!\n" +
+	"\fsection_code\x18\x03 \x01(\tR\vsectionCode\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12&\n" +
+	"\fpayment_date\x18\x05 \x01(\tH\x02R\vpaymentDate\x88\x01\x01\x123\n" +
+	"\famount_exact\x18\x06 \x01(\v2\x10.vendor.v1.MoneyR\vamountExactB\f\n" +
+	"\n" +
+	"_vendor_idB\x0e\n" +
+	"\f_vendor_codeB\x0f\n" +
+	"\r_payment_date\"\xcd\x02\n" +
+	"\x19RecordTDSDeductionRequest\x12 \n" +
+	"\tvendor_id\x18\x01 \x01(\tH\x00R\bvendorId\x88\x01\x01\x12$\n" +
+	"\vvendor_code\x18\x02 \x01(\tH\x01R\n" +
+	"vendorCode\x88\x01\x01\x12!\n" +
+	"\fsection_code\x18\x03 \x01(\tR\vsectionCode\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12&\n" +
+	"\fpayment_date\x18\x05 \x01(\tH\x02R\vpaymentDate\x88\x01\x01\x12!\n" +
+	"\freference_id\x18\x06 \x01(\tR\vreferenceId\x123\n" +
+	"\famount_exact\x18\a \x01(\v2\x10.vendor.v1.MoneyR\vamountExactB\f\n" +
+	"\n" +
+	"_vendor_idB\x0e\n" +
+	"\f_vendor_codeB\x0f\n" +
+	"\r_payment_date\"M\n" +
+	"\x14TDSDeductionResponse\x125\n" +
+	"\tdeduction\x18\x01 \x01(\v2\x17.vendor.v1.TDSDeductionR\tdeduction\"?\n" +
+	"\x1aReverseTDSDeductionRequest\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\"x\n" +
+	"\x1aGetVendorTDSSummaryRequest\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12*\n" +
+	"\x0efinancial_year\x18\x02 \x01(\tH\x00R\rfinancialYear\x88\x01\x01B\x11\n" +
+	"\x0f_financial_year\"\xf5\x04\n" +
+	"\x11TDSSectionSummary\x12!\n" +
+	"\fsection_code\x18\x01 \x01(\tR\vsectionCode\x12\x1e\n" +
+	"\n" +
+	"deductions\x18\x02 \x01(\x05R\n" +
+	"deductions\x12)\n" +
+	"\x10aggregate_amount\x18\x03 \x01(\x01R\x0faggregateAmount\x12%\n" +
+	"\x0etaxable_amount\x18\x04 \x01(\x01R\rtaxableAmount\x12\x1d\n" +
+	"\n" +
+	"tds_amount\x18\x05 \x01(\x01R\ttdsAmount\x12)\n" +
+	"\x10single_threshold\x18\x06 \x01(\x01R\x0fsingleThreshold\x12)\n" +
+	"\x10annual_threshold\x18\a \x01(\x01R\x0fannualThreshold\x12F\n" +
+	"\x16aggregate_amount_exact\x18\b \x01(\v2\x10.vendor.v1.MoneyR\x14aggregateAmountExact\x12B\n" +
+	"\x14taxable_amount_exact\x18\t \x01(\v2\x10.vendor.v1.MoneyR\x12taxableAmountExact\x12:\n" +
+	"\x10tds_amount_exact\x18\n" +
+	" \x01(\v2\x10.vendor.v1.MoneyR\x0etdsAmountExact\x12F\n" +
+	"\x16single_threshold_exact\x18\v \x01(\v2\x10.vendor.v1.MoneyR\x14singleThresholdExact\x12F\n" +
+	"\x16annual_threshold_exact\x18\f \x01(\v2\x10.vendor.v1.MoneyR\x14annualThresholdExact\"\x98\x01\n" +
+	"\x18VendorTDSSummaryResponse\x12\x1b\n" +
+	"\tvendor_id\x18\x01 \x01(\tR\bvendorId\x12%\n" +
+	"\x0efinancial_year\x18\x02 \x01(\tR\rfinancialYear\x128\n" +
+	"\bsections\x18\x03 \x03(\v2\x1c.vendor.v1.TDSSectionSummaryR\bsections\"h\n" +
 	"\x17GetVendorAccountRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12 \n" +
@@ -3635,7 +9400,7 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"\x05MICRO\x10\x01\x12\t\n" +
 	"\x05SMALL\x10\x02\x12\n" +
 	"\n" +
-	"\x06MEDIUM\x10\x032\xf2\x11\n" +
+	"\x06MEDIUM\x10\x032\xad5\n" +
 	"\rVendorService\x12e\n" +
 	"\fCreateVendor\x12\x1e.vendor.v1.CreateVendorRequest\x1a\x19.vendor.v1.VendorResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/vendors\x12h\n" +
 	"\tGetVendor\x12\x1b.vendor.v1.GetVendorRequest\x1a\x19.vendor.v1.VendorResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/vendors/{vendor_id}\x12{\n" +
@@ -3651,7 +9416,38 @@ const file_api_proto_vendor_proto_rawDesc = "" +
 	"\x17GetVendorBankingDetails\x12).vendor.v1.GetVendorBankingDetailsRequest\x1a!.vendor.v1.BankingDetailsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/vendors/{vendor_id}/banking-details\x12\x90\x01\n" +
 	"\x13UpdateVendorAccount\x12%.vendor.v1.UpdateVendorAccountRequest\x1a .vendor.v1.VendorAccountResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/vendors/accounts/{account_id}\x12\x83\x01\n" +
 	"\x13DeleteVendorAccount\x12%.vendor.v1.DeleteVendorAccountRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02'*%/api/v1/vendors/accounts/{account_id}\x12\x9e\x01\n" +
-	"\x13ToggleAccountStatus\x12%.vendor.v1.ToggleAccountStatusRequest\x1a .vendor.v1.VendorAccountResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/api/v1/vendors/accounts/{account_id}/toggle-status\x12\x90\x01\n" +
+	"\x13ToggleAccountStatus\x12%.vendor.v1.ToggleAccountStatusRequest\x1a .vendor.v1.VendorAccountResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/api/v1/vendors/accounts/{account_id}/toggle-status\x12\x9c\x01\n" +
+	"\x18ListVendorAccountChanges\x12*.vendor.v1.ListVendorAccountChangesRequest\x1a+.vendor.v1.ListVendorAccountChangesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/vendors/account-changes\x12\xb1\x01\n" +
+	"\x1aApproveVendorAccountChange\x12+.vendor.v1.ReviewVendorAccountChangeRequest\x1a&.vendor.v1.VendorAccountChangeResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/api/v1/vendors/account-changes/{change_id}/approve\x12\xaf\x01\n" +
+	"\x19RejectVendorAccountChange\x12+.vendor.v1.ReviewVendorAccountChangeRequest\x1a&.vendor.v1.VendorAccountChangeResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/vendors/account-changes/{change_id}/reject\x12\x93\x01\n" +
+	"\x12VerifyPayeeAccount\x12$.vendor.v1.VerifyPayeeAccountRequest\x1a%.vendor.v1.VerifyPayeeAccountResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/vendors/accounts/verify-payee\x12\x8e\x01\n" +
+	"\x14FindDuplicateVendors\x12&.vendor.v1.FindDuplicateVendorsRequest\x1a'.vendor.v1.FindDuplicateVendorsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/vendors/duplicates\x12\x86\x01\n" +
+	"\fMergeVendors\x12\x1e.vendor.v1.MergeVendorsRequest\x1a\x1f.vendor.v1.MergeVendorsResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/vendors/{survivor_vendor_id}/merge\x12u\n" +
+	"\rImportVendors\x12\x1f.vendor.v1.ImportVendorsRequest\x1a .vendor.v1.ImportVendorsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/vendors/import\x12r\n" +
+	"\rExportVendors\x12\x1f.vendor.v1.ExportVendorsRequest\x1a .vendor.v1.ExportVendorsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/vendors/export\x12\x92\x01\n" +
+	"\x13RegisterMSMEInvoice\x12%.vendor.v1.RegisterMSMEInvoiceRequest\x1a\x1e.vendor.v1.MSMEInvoiceResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/vendors/{vendor_id}/msme-invoices\x12\xa5\x01\n" +
+	"\x18RecordMSMEInvoicePayment\x12*.vendor.v1.RecordMSMEInvoicePaymentRequest\x1a\x1e.vendor.v1.MSMEInvoiceResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/vendors/msme-invoices/{invoice_id}/payment\x12\x82\x01\n" +
+	"\x10ListMSMEInvoices\x12\".vendor.v1.ListMSMEInvoicesRequest\x1a#.vendor.v1.ListMSMEInvoicesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/vendors/msme-invoices\x12\xaa\x01\n" +
+	"\x18GetMSMEOutstandingReport\x12*.vendor.v1.GetMSMEOutstandingReportRequest\x1a(.vendor.v1.MSMEOutstandingReportResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/vendors/msme-invoices/outstanding-report\x12x\n" +
+	"\x10GetPortalProfile\x12\".vendor.v1.GetPortalProfileRequest\x1a\x19.vendor.v1.VendorResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/vendor-portal/profile\x12\x89\x01\n" +
+	"\x13UpdatePortalContact\x12%.vendor.v1.UpdatePortalContactRequest\x1a\x19.vendor.v1.VendorResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/vendor-portal/profile/contact\x12\x89\x01\n" +
+	"\x13SubmitPortalInvoice\x12%.vendor.v1.SubmitPortalInvoiceRequest\x1a .vendor.v1.VendorInvoiceResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/vendor-portal/invoices\x12\xb8\x01\n" +
+	"\x1bUploadPortalInvoiceDocument\x12-.vendor.v1.UploadPortalInvoiceDocumentRequest\x1a(.vendor.v1.VendorInvoiceDocumentResponse\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/vendor-portal/invoices/{invoice_id}/documents\x12\x89\x01\n" +
+	"\x12ListPortalInvoices\x12$.vendor.v1.ListPortalInvoicesRequest\x1a%.vendor.v1.ListVendorInvoicesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/vendor-portal/invoices\x12\x8d\x01\n" +
+	"\x10GetPortalInvoice\x12\".vendor.v1.GetPortalInvoiceRequest\x1a .vendor.v1.VendorInvoiceResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/vendor-portal/invoices/{invoice_id}\x12\x8c\x01\n" +
+	"\x0eLinkVendorUser\x12 .vendor.v1.LinkVendorUserRequest\x1a#.vendor.v1.VendorPortalUserResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/vendors/{vendor_id}/portal-users\x12~\n" +
+	"\x10UnlinkVendorUser\x12\".vendor.v1.UnlinkVendorUserRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(*&/api/v1/vendors/portal-users/{user_id}\x12\x9c\x01\n" +
+	"\x15ListVendorPortalUsers\x12'.vendor.v1.ListVendorPortalUsersRequest\x1a(.vendor.v1.ListVendorPortalUsersResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/vendors/{vendor_id}/portal-users\x12\x83\x01\n" +
+	"\x12ListVendorInvoices\x12$.vendor.v1.ListVendorInvoicesRequest\x1a%.vendor.v1.ListVendorInvoicesResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/vendors/invoices\x12\xa3\x01\n" +
+	"\x19UpdateVendorInvoiceStatus\x12+.vendor.v1.UpdateVendorInvoiceStatusRequest\x1a .vendor.v1.VendorInvoiceResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/vendors/invoices/{invoice_id}/status\x12~\n" +
+	"\x0fListTDSSections\x12!.vendor.v1.ListTDSSectionsRequest\x1a\".vendor.v1.ListTDSSectionsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/vendors/tds/sections\x12\x85\x01\n" +
+	"\x10UpsertTDSSection\x12\".vendor.v1.UpsertTDSSectionRequest\x1a\x1d.vendor.v1.TDSSectionResponse\".\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/v1/vendors/tds/sections/{code}\x12\x9a\x01\n" +
+	"\x14CreateTDSCertificate\x12&.vendor.v1.CreateTDSCertificateRequest\x1a!.vendor.v1.TDSCertificateResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/vendors/{vendor_id}/tds-certificates\x12\x9a\x01\n" +
+	"\x13ListTDSCertificates\x12%.vendor.v1.ListTDSCertificatesRequest\x1a&.vendor.v1.ListTDSCertificatesResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/vendors/{vendor_id}/tds-certificates\x12y\n" +
+	"\fCalculateTDS\x12\x1e.vendor.v1.CalculateTDSRequest\x1a\x1f.vendor.v1.TDSDeductionResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/vendors/tds/calculate\x12\x86\x01\n" +
+	"\x12RecordTDSDeduction\x12$.vendor.v1.RecordTDSDeductionRequest\x1a\x1f.vendor.v1.TDSDeductionResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/vendors/tds/deductions\x12\x8b\x01\n" +
+	"\x13ReverseTDSDeduction\x12%.vendor.v1.ReverseTDSDeductionRequest\x1a\x16.google.protobuf.Empty\"5\x82\xd3\xe4\x93\x02/*-/api/v1/vendors/tds/deductions/{reference_id}\x12\x92\x01\n" +
+	"\x13GetVendorTDSSummary\x12%.vendor.v1.GetVendorTDSSummaryRequest\x1a#.vendor.v1.VendorTDSSummaryResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/vendors/{vendor_id}/tds-summary\x12\x90\x01\n" +
 	"\x13GetProjectsDropdown\x12%.vendor.v1.GetProjectsDropdownRequest\x1a&.vendor.v1.GetProjectsDropdownResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/vendors/dropdowns/projects\x12\x9c\x01\n" +
 	"\x15UploadVendorSignature\x12'.vendor.v1.UploadVendorSignatureRequest\x1a(.vendor.v1.UploadVendorSignatureResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/vendors/{vendor_id}/signatureB4Z2github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpbb\x06proto3"
 
@@ -3668,100 +9464,300 @@ func file_api_proto_vendor_proto_rawDescGZIP() []byte {
 }
 
 var file_api_proto_vendor_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_proto_vendor_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_api_proto_vendor_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_api_proto_vendor_proto_goTypes = []any{
-	(AccountType)(0),                       // 0: vendor.v1.AccountType
-	(VendorStatus)(0),                      // 1: vendor.v1.VendorStatus
-	(MSMEClassification)(0),                // 2: vendor.v1.MSMEClassification
-	(*Vendor)(nil),                         // 3: vendor.v1.Vendor
-	(*VendorAccount)(nil),                  // 4: vendor.v1.VendorAccount
-	(*BankingDetails)(nil),                 // 5: vendor.v1.BankingDetails
-	(*CreateVendorRequest)(nil),            // 6: vendor.v1.CreateVendorRequest
-	(*GetVendorRequest)(nil),               // 7: vendor.v1.GetVendorRequest
-	(*GetVendorByCodeRequest)(nil),         // 8: vendor.v1.GetVendorByCodeRequest
-	(*UpdateVendorRequest)(nil),            // 9: vendor.v1.UpdateVendorRequest
-	(*DeleteVendorRequest)(nil),            // 10: vendor.v1.DeleteVendorRequest
-	(*ListVendorsRequest)(nil),             // 11: vendor.v1.ListVendorsRequest
-	(*PaginationMetadata)(nil),             // 12: vendor.v1.PaginationMetadata
-	(*GenerateVendorCodeRequest)(nil),      // 13: vendor.v1.GenerateVendorCodeRequest
-	(*UpdateVendorCodeRequest)(nil),        // 14: vendor.v1.UpdateVendorCodeRequest
-	(*RegenerateVendorCodeRequest)(nil),    // 15: vendor.v1.RegenerateVendorCodeRequest
-	(*CreateVendorAccountRequest)(nil),     // 16: vendor.v1.CreateVendorAccountRequest
-	(*GetVendorAccountsRequest)(nil),       // 17: vendor.v1.GetVendorAccountsRequest
-	(*GetVendorBankingDetailsRequest)(nil), // 18: vendor.v1.GetVendorBankingDetailsRequest
-	(*UpdateVendorAccountRequest)(nil),     // 19: vendor.v1.UpdateVendorAccountRequest
-	(*DeleteVendorAccountRequest)(nil),     // 20: vendor.v1.DeleteVendorAccountRequest
-	(*ToggleAccountStatusRequest)(nil),     // 21: vendor.v1.ToggleAccountStatusRequest
-	(*VendorResponse)(nil),                 // 22: vendor.v1.VendorResponse
-	(*ListVendorsResponse)(nil),            // 23: vendor.v1.ListVendorsResponse
-	(*GenerateVendorCodeResponse)(nil),     // 24: vendor.v1.GenerateVendorCodeResponse
-	(*VendorAccountResponse)(nil),          // 25: vendor.v1.VendorAccountResponse
-	(*GetVendorAccountsResponse)(nil),      // 26: vendor.v1.GetVendorAccountsResponse
-	(*BankingDetailsResponse)(nil),         // 27: vendor.v1.BankingDetailsResponse
-	(*GetVendorAccountRequest)(nil),        // 28: vendor.v1.GetVendorAccountRequest
-	(*SetPrimaryAccountRequest)(nil),       // 29: vendor.v1.SetPrimaryAccountRequest
-	(*GetProjectsDropdownRequest)(nil),     // 30: vendor.v1.GetProjectsDropdownRequest
-	(*ProjectDropdownItem)(nil),            // 31: vendor.v1.ProjectDropdownItem
-	(*GetProjectsDropdownResponse)(nil),    // 32: vendor.v1.GetProjectsDropdownResponse
-	(*UploadVendorSignatureRequest)(nil),   // 33: vendor.v1.UploadVendorSignatureRequest
-	(*UploadVendorSignatureResponse)(nil),  // 34: vendor.v1.UploadVendorSignatureResponse
-	(*timestamppb.Timestamp)(nil),          // 35: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 36: google.protobuf.Empty
+	(AccountType)(0),                           // 0: vendor.v1.AccountType
+	(VendorStatus)(0),                          // 1: vendor.v1.VendorStatus
+	(MSMEClassification)(0),                    // 2: vendor.v1.MSMEClassification
+	(*Vendor)(nil),                             // 3: vendor.v1.Vendor
+	(*VendorAccount)(nil),                      // 4: vendor.v1.VendorAccount
+	(*BankDetailsSnapshot)(nil),                // 5: vendor.v1.BankDetailsSnapshot
+	(*VendorAccountChange)(nil),                // 6: vendor.v1.VendorAccountChange
+	(*BankingDetails)(nil),                     // 7: vendor.v1.BankingDetails
+	(*CreateVendorRequest)(nil),                // 8: vendor.v1.CreateVendorRequest
+	(*GetVendorRequest)(nil),                   // 9: vendor.v1.GetVendorRequest
+	(*GetVendorByCodeRequest)(nil),             // 10: vendor.v1.GetVendorByCodeRequest
+	(*UpdateVendorRequest)(nil),                // 11: vendor.v1.UpdateVendorRequest
+	(*DeleteVendorRequest)(nil),                // 12: vendor.v1.DeleteVendorRequest
+	(*ListVendorsRequest)(nil),                 // 13: vendor.v1.ListVendorsRequest
+	(*PaginationMetadata)(nil),                 // 14: vendor.v1.PaginationMetadata
+	(*GenerateVendorCodeRequest)(nil),          // 15: vendor.v1.GenerateVendorCodeRequest
+	(*UpdateVendorCodeRequest)(nil),            // 16: vendor.v1.UpdateVendorCodeRequest
+	(*RegenerateVendorCodeRequest)(nil),        // 17: vendor.v1.RegenerateVendorCodeRequest
+	(*CreateVendorAccountRequest)(nil),         // 18: vendor.v1.CreateVendorAccountRequest
+	(*GetVendorAccountsRequest)(nil),           // 19: vendor.v1.GetVendorAccountsRequest
+	(*GetVendorBankingDetailsRequest)(nil),     // 20: vendor.v1.GetVendorBankingDetailsRequest
+	(*UpdateVendorAccountRequest)(nil),         // 21: vendor.v1.UpdateVendorAccountRequest
+	(*DeleteVendorAccountRequest)(nil),         // 22: vendor.v1.DeleteVendorAccountRequest
+	(*ToggleAccountStatusRequest)(nil),         // 23: vendor.v1.ToggleAccountStatusRequest
+	(*ListVendorAccountChangesRequest)(nil),    // 24: vendor.v1.ListVendorAccountChangesRequest
+	(*ReviewVendorAccountChangeRequest)(nil),   // 25: vendor.v1.ReviewVendorAccountChangeRequest
+	(*VerifyPayeeAccountRequest)(nil),          // 26: vendor.v1.VerifyPayeeAccountRequest
+	(*FindDuplicateVendorsRequest)(nil),        // 27: vendor.v1.FindDuplicateVendorsRequest
+	(*ImportVendorsRequest)(nil),               // 28: vendor.v1.ImportVendorsRequest
+	(*ExportVendorsRequest)(nil),               // 29: vendor.v1.ExportVendorsRequest
+	(*MergeVendorsRequest)(nil),                // 30: vendor.v1.MergeVendorsRequest
+	(*VendorResponse)(nil),                     // 31: vendor.v1.VendorResponse
+	(*ListVendorsResponse)(nil),                // 32: vendor.v1.ListVendorsResponse
+	(*GenerateVendorCodeResponse)(nil),         // 33: vendor.v1.GenerateVendorCodeResponse
+	(*VendorAccountResponse)(nil),              // 34: vendor.v1.VendorAccountResponse
+	(*GetVendorAccountsResponse)(nil),          // 35: vendor.v1.GetVendorAccountsResponse
+	(*BankingDetailsResponse)(nil),             // 36: vendor.v1.BankingDetailsResponse
+	(*ListVendorAccountChangesResponse)(nil),   // 37: vendor.v1.ListVendorAccountChangesResponse
+	(*VendorAccountChangeResponse)(nil),        // 38: vendor.v1.VendorAccountChangeResponse
+	(*VerifyPayeeAccountResponse)(nil),         // 39: vendor.v1.VerifyPayeeAccountResponse
+	(*DuplicateVendorMatch)(nil),               // 40: vendor.v1.DuplicateVendorMatch
+	(*FindDuplicateVendorsResponse)(nil),       // 41: vendor.v1.FindDuplicateVendorsResponse
+	(*VendorImportError)(nil),                  // 42: vendor.v1.VendorImportError
+	(*ImportVendorsResponse)(nil),              // 43: vendor.v1.ImportVendorsResponse
+	(*ExportVendorsResponse)(nil),              // 44: vendor.v1.ExportVendorsResponse
+	(*VendorMerge)(nil),                        // 45: vendor.v1.VendorMerge
+	(*MergeVendorsResponse)(nil),               // 46: vendor.v1.MergeVendorsResponse
+	(*Money)(nil),                              // 47: vendor.v1.Money
+	(*MSMEInvoice)(nil),                        // 48: vendor.v1.MSMEInvoice
+	(*RegisterMSMEInvoiceRequest)(nil),         // 49: vendor.v1.RegisterMSMEInvoiceRequest
+	(*RecordMSMEInvoicePaymentRequest)(nil),    // 50: vendor.v1.RecordMSMEInvoicePaymentRequest
+	(*ListMSMEInvoicesRequest)(nil),            // 51: vendor.v1.ListMSMEInvoicesRequest
+	(*MSMEInvoiceResponse)(nil),                // 52: vendor.v1.MSMEInvoiceResponse
+	(*ListMSMEInvoicesResponse)(nil),           // 53: vendor.v1.ListMSMEInvoicesResponse
+	(*GetMSMEOutstandingReportRequest)(nil),    // 54: vendor.v1.GetMSMEOutstandingReportRequest
+	(*MSMEVendorOutstanding)(nil),              // 55: vendor.v1.MSMEVendorOutstanding
+	(*MSMEOutstandingReportResponse)(nil),      // 56: vendor.v1.MSMEOutstandingReportResponse
+	(*VendorPortalUser)(nil),                   // 57: vendor.v1.VendorPortalUser
+	(*VendorInvoiceDocument)(nil),              // 58: vendor.v1.VendorInvoiceDocument
+	(*VendorInvoice)(nil),                      // 59: vendor.v1.VendorInvoice
+	(*GetPortalProfileRequest)(nil),            // 60: vendor.v1.GetPortalProfileRequest
+	(*UpdatePortalContactRequest)(nil),         // 61: vendor.v1.UpdatePortalContactRequest
+	(*SubmitPortalInvoiceRequest)(nil),         // 62: vendor.v1.SubmitPortalInvoiceRequest
+	(*UploadPortalInvoiceDocumentRequest)(nil), // 63: vendor.v1.UploadPortalInvoiceDocumentRequest
+	(*VendorInvoiceDocumentResponse)(nil),      // 64: vendor.v1.VendorInvoiceDocumentResponse
+	(*ListPortalInvoicesRequest)(nil),          // 65: vendor.v1.ListPortalInvoicesRequest
+	(*GetPortalInvoiceRequest)(nil),            // 66: vendor.v1.GetPortalInvoiceRequest
+	(*VendorInvoiceResponse)(nil),              // 67: vendor.v1.VendorInvoiceResponse
+	(*ListVendorInvoicesResponse)(nil),         // 68: vendor.v1.ListVendorInvoicesResponse
+	(*LinkVendorUserRequest)(nil),              // 69: vendor.v1.LinkVendorUserRequest
+	(*VendorPortalUserResponse)(nil),           // 70: vendor.v1.VendorPortalUserResponse
+	(*UnlinkVendorUserRequest)(nil),            // 71: vendor.v1.UnlinkVendorUserRequest
+	(*ListVendorPortalUsersRequest)(nil),       // 72: vendor.v1.ListVendorPortalUsersRequest
+	(*ListVendorPortalUsersResponse)(nil),      // 73: vendor.v1.ListVendorPortalUsersResponse
+	(*ListVendorInvoicesRequest)(nil),          // 74: vendor.v1.ListVendorInvoicesRequest
+	(*UpdateVendorInvoiceStatusRequest)(nil),   // 75: vendor.v1.UpdateVendorInvoiceStatusRequest
+	(*TDSSection)(nil),                         // 76: vendor.v1.TDSSection
+	(*ListTDSSectionsRequest)(nil),             // 77: vendor.v1.ListTDSSectionsRequest
+	(*ListTDSSectionsResponse)(nil),            // 78: vendor.v1.ListTDSSectionsResponse
+	(*UpsertTDSSectionRequest)(nil),            // 79: vendor.v1.UpsertTDSSectionRequest
+	(*TDSSectionResponse)(nil),                 // 80: vendor.v1.TDSSectionResponse
+	(*TDSCertificate)(nil),                     // 81: vendor.v1.TDSCertificate
+	(*CreateTDSCertificateRequest)(nil),        // 82: vendor.v1.CreateTDSCertificateRequest
+	(*TDSCertificateResponse)(nil),             // 83: vendor.v1.TDSCertificateResponse
+	(*ListTDSCertificatesRequest)(nil),         // 84: vendor.v1.ListTDSCertificatesRequest
+	(*ListTDSCertificatesResponse)(nil),        // 85: vendor.v1.ListTDSCertificatesResponse
+	(*TDSDeduction)(nil),                       // 86: vendor.v1.TDSDeduction
+	(*CalculateTDSRequest)(nil),                // 87: vendor.v1.CalculateTDSRequest
+	(*RecordTDSDeductionRequest)(nil),          // 88: vendor.v1.RecordTDSDeductionRequest
+	(*TDSDeductionResponse)(nil),               // 89: vendor.v1.TDSDeductionResponse
+	(*ReverseTDSDeductionRequest)(nil),         // 90: vendor.v1.ReverseTDSDeductionRequest
+	(*GetVendorTDSSummaryRequest)(nil),         // 91: vendor.v1.GetVendorTDSSummaryRequest
+	(*TDSSectionSummary)(nil),                  // 92: vendor.v1.TDSSectionSummary
+	(*VendorTDSSummaryResponse)(nil),           // 93: vendor.v1.VendorTDSSummaryResponse
+	(*GetVendorAccountRequest)(nil),            // 94: vendor.v1.GetVendorAccountRequest
+	(*SetPrimaryAccountRequest)(nil),           // 95: vendor.v1.SetPrimaryAccountRequest
+	(*GetProjectsDropdownRequest)(nil),         // 96: vendor.v1.GetProjectsDropdownRequest
+	(*ProjectDropdownItem)(nil),                // 97: vendor.v1.ProjectDropdownItem
+	(*GetProjectsDropdownResponse)(nil),        // 98: vendor.v1.GetProjectsDropdownResponse
+	(*UploadVendorSignatureRequest)(nil),       // 99: vendor.v1.UploadVendorSignatureRequest
+	(*UploadVendorSignatureResponse)(nil),      // 100: vendor.v1.UploadVendorSignatureResponse
+	(*timestamppb.Timestamp)(nil),              // 101: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                      // 102: google.protobuf.Empty
 }
 var file_api_proto_vendor_proto_depIdxs = []int32{
-	35, // 0: vendor.v1.Vendor.msme_start_date:type_name -> google.protobuf.Timestamp
-	35, // 1: vendor.v1.Vendor.msme_end_date:type_name -> google.protobuf.Timestamp
-	35, // 2: vendor.v1.Vendor.created_at:type_name -> google.protobuf.Timestamp
-	35, // 3: vendor.v1.Vendor.updated_at:type_name -> google.protobuf.Timestamp
-	35, // 4: vendor.v1.VendorAccount.created_at:type_name -> google.protobuf.Timestamp
-	35, // 5: vendor.v1.VendorAccount.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 6: vendor.v1.VendorResponse.vendor:type_name -> vendor.v1.Vendor
-	3,  // 7: vendor.v1.ListVendorsResponse.vendors:type_name -> vendor.v1.Vendor
-	12, // 8: vendor.v1.ListVendorsResponse.pagination:type_name -> vendor.v1.PaginationMetadata
-	4,  // 9: vendor.v1.VendorAccountResponse.account:type_name -> vendor.v1.VendorAccount
-	4,  // 10: vendor.v1.GetVendorAccountsResponse.accounts:type_name -> vendor.v1.VendorAccount
-	5,  // 11: vendor.v1.BankingDetailsResponse.banking_details:type_name -> vendor.v1.BankingDetails
-	31, // 12: vendor.v1.GetProjectsDropdownResponse.projects:type_name -> vendor.v1.ProjectDropdownItem
-	35, // 13: vendor.v1.UploadVendorSignatureResponse.uploaded_at:type_name -> google.protobuf.Timestamp
-	6,  // 14: vendor.v1.VendorService.CreateVendor:input_type -> vendor.v1.CreateVendorRequest
-	7,  // 15: vendor.v1.VendorService.GetVendor:input_type -> vendor.v1.GetVendorRequest
-	8,  // 16: vendor.v1.VendorService.GetVendorByCode:input_type -> vendor.v1.GetVendorByCodeRequest
-	9,  // 17: vendor.v1.VendorService.UpdateVendor:input_type -> vendor.v1.UpdateVendorRequest
-	10, // 18: vendor.v1.VendorService.DeleteVendor:input_type -> vendor.v1.DeleteVendorRequest
-	11, // 19: vendor.v1.VendorService.ListVendors:input_type -> vendor.v1.ListVendorsRequest
-	13, // 20: vendor.v1.VendorService.GenerateVendorCode:input_type -> vendor.v1.GenerateVendorCodeRequest
-	14, // 21: vendor.v1.VendorService.UpdateVendorCode:input_type -> vendor.v1.UpdateVendorCodeRequest
-	15, // 22: vendor.v1.VendorService.RegenerateVendorCode:input_type -> vendor.v1.RegenerateVendorCodeRequest
-	16, // 23: vendor.v1.VendorService.CreateVendorAccount:input_type -> vendor.v1.CreateVendorAccountRequest
-	17, // 24: vendor.v1.VendorService.GetVendorAccounts:input_type -> vendor.v1.GetVendorAccountsRequest
-	18, // 25: vendor.v1.VendorService.GetVendorBankingDetails:input_type -> vendor.v1.GetVendorBankingDetailsRequest
-	19, // 26: vendor.v1.VendorService.UpdateVendorAccount:input_type -> vendor.v1.UpdateVendorAccountRequest
-	20, // 27: vendor.v1.VendorService.DeleteVendorAccount:input_type -> vendor.v1.DeleteVendorAccountRequest
-	21, // 28: vendor.v1.VendorService.ToggleAccountStatus:input_type -> vendor.v1.ToggleAccountStatusRequest
-	30, // 29: vendor.v1.VendorService.GetProjectsDropdown:input_type -> vendor.v1.GetProjectsDropdownRequest
-	33, // 30: vendor.v1.VendorService.UploadVendorSignature:input_type -> vendor.v1.UploadVendorSignatureRequest
-	22, // 31: vendor.v1.VendorService.CreateVendor:output_type -> vendor.v1.VendorResponse
-	22, // 32: vendor.v1.VendorService.GetVendor:output_type -> vendor.v1.VendorResponse
-	22, // 33: vendor.v1.VendorService.GetVendorByCode:output_type -> vendor.v1.VendorResponse
-	22, // 34: vendor.v1.VendorService.UpdateVendor:output_type -> vendor.v1.VendorResponse
-	36, // 35: vendor.v1.VendorService.DeleteVendor:output_type -> google.protobuf.Empty
-	23, // 36: vendor.v1.VendorService.ListVendors:output_type -> vendor.v1.ListVendorsResponse
-	24, // 37: vendor.v1.VendorService.GenerateVendorCode:output_type -> vendor.v1.GenerateVendorCodeResponse
-	22, // 38: vendor.v1.VendorService.UpdateVendorCode:output_type -> vendor.v1.VendorResponse
-	22, // 39: vendor.v1.VendorService.RegenerateVendorCode:output_type -> vendor.v1.VendorResponse
-	25, // 40: vendor.v1.VendorService.CreateVendorAccount:output_type -> vendor.v1.VendorAccountResponse
-	26, // 41: vendor.v1.VendorService.GetVendorAccounts:output_type -> vendor.v1.GetVendorAccountsResponse
-	27, // 42: vendor.v1.VendorService.GetVendorBankingDetails:output_type -> vendor.v1.BankingDetailsResponse
-	25, // 43: vendor.v1.VendorService.UpdateVendorAccount:output_type -> vendor.v1.VendorAccountResponse
-	36, // 44: vendor.v1.VendorService.DeleteVendorAccount:output_type -> google.protobuf.Empty
-	25, // 45: vendor.v1.VendorService.ToggleAccountStatus:output_type -> vendor.v1.VendorAccountResponse
-	32, // 46: vendor.v1.VendorService.GetProjectsDropdown:output_type -> vendor.v1.GetProjectsDropdownResponse
-	34, // 47: vendor.v1.VendorService.UploadVendorSignature:output_type -> vendor.v1.UploadVendorSignatureResponse
-	31, // [31:48] is the sub-list for method output_type
-	14, // [14:31] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	101, // 0: vendor.v1.Vendor.msme_start_date:type_name -> google.protobuf.Timestamp
+	101, // 1: vendor.v1.Vendor.msme_end_date:type_name -> google.protobuf.Timestamp
+	101, // 2: vendor.v1.Vendor.created_at:type_name -> google.protobuf.Timestamp
+	101, // 3: vendor.v1.Vendor.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 4: vendor.v1.VendorAccount.created_at:type_name -> google.protobuf.Timestamp
+	101, // 5: vendor.v1.VendorAccount.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 6: vendor.v1.VendorAccount.payable_from:type_name -> google.protobuf.Timestamp
+	5,   // 7: vendor.v1.VendorAccountChange.old_values:type_name -> vendor.v1.BankDetailsSnapshot
+	5,   // 8: vendor.v1.VendorAccountChange.new_values:type_name -> vendor.v1.BankDetailsSnapshot
+	101, // 9: vendor.v1.VendorAccountChange.requested_at:type_name -> google.protobuf.Timestamp
+	101, // 10: vendor.v1.VendorAccountChange.reviewed_at:type_name -> google.protobuf.Timestamp
+	3,   // 11: vendor.v1.VendorResponse.vendor:type_name -> vendor.v1.Vendor
+	3,   // 12: vendor.v1.ListVendorsResponse.vendors:type_name -> vendor.v1.Vendor
+	14,  // 13: vendor.v1.ListVendorsResponse.pagination:type_name -> vendor.v1.PaginationMetadata
+	4,   // 14: vendor.v1.VendorAccountResponse.account:type_name -> vendor.v1.VendorAccount
+	4,   // 15: vendor.v1.GetVendorAccountsResponse.accounts:type_name -> vendor.v1.VendorAccount
+	7,   // 16: vendor.v1.BankingDetailsResponse.banking_details:type_name -> vendor.v1.BankingDetails
+	6,   // 17: vendor.v1.ListVendorAccountChangesResponse.changes:type_name -> vendor.v1.VendorAccountChange
+	14,  // 18: vendor.v1.ListVendorAccountChangesResponse.pagination:type_name -> vendor.v1.PaginationMetadata
+	6,   // 19: vendor.v1.VendorAccountChangeResponse.change:type_name -> vendor.v1.VendorAccountChange
+	101, // 20: vendor.v1.VerifyPayeeAccountResponse.payable_from:type_name -> google.protobuf.Timestamp
+	40,  // 21: vendor.v1.FindDuplicateVendorsResponse.matches:type_name -> vendor.v1.DuplicateVendorMatch
+	42,  // 22: vendor.v1.ImportVendorsResponse.errors:type_name -> vendor.v1.VendorImportError
+	101, // 23: vendor.v1.VendorMerge.merged_at:type_name -> google.protobuf.Timestamp
+	45,  // 24: vendor.v1.MergeVendorsResponse.merge:type_name -> vendor.v1.VendorMerge
+	101, // 25: vendor.v1.MSMEInvoice.created_at:type_name -> google.protobuf.Timestamp
+	47,  // 26: vendor.v1.MSMEInvoice.invoice_amount_exact:type_name -> vendor.v1.Money
+	47,  // 27: vendor.v1.MSMEInvoice.interest_liability_exact:type_name -> vendor.v1.Money
+	47,  // 28: vendor.v1.RegisterMSMEInvoiceRequest.invoice_amount_exact:type_name -> vendor.v1.Money
+	48,  // 29: vendor.v1.MSMEInvoiceResponse.invoice:type_name -> vendor.v1.MSMEInvoice
+	48,  // 30: vendor.v1.ListMSMEInvoicesResponse.invoices:type_name -> vendor.v1.MSMEInvoice
+	14,  // 31: vendor.v1.ListMSMEInvoicesResponse.pagination:type_name -> vendor.v1.PaginationMetadata
+	47,  // 32: vendor.v1.MSMEVendorOutstanding.outstanding_amount_exact:type_name -> vendor.v1.Money
+	47,  // 33: vendor.v1.MSMEVendorOutstanding.overdue_amount_exact:type_name -> vendor.v1.Money
+	47,  // 34: vendor.v1.MSMEVendorOutstanding.paid_late_amount_exact:type_name -> vendor.v1.Money
+	47,  // 35: vendor.v1.MSMEVendorOutstanding.interest_liability_exact:type_name -> vendor.v1.Money
+	55,  // 36: vendor.v1.MSMEOutstandingReportResponse.vendors:type_name -> vendor.v1.MSMEVendorOutstanding
+	47,  // 37: vendor.v1.MSMEOutstandingReportResponse.total_outstanding_amount_exact:type_name -> vendor.v1.Money
+	47,  // 38: vendor.v1.MSMEOutstandingReportResponse.total_overdue_amount_exact:type_name -> vendor.v1.Money
+	47,  // 39: vendor.v1.MSMEOutstandingReportResponse.total_paid_late_amount_exact:type_name -> vendor.v1.Money
+	47,  // 40: vendor.v1.MSMEOutstandingReportResponse.total_interest_liability_exact:type_name -> vendor.v1.Money
+	101, // 41: vendor.v1.VendorPortalUser.linked_at:type_name -> google.protobuf.Timestamp
+	101, // 42: vendor.v1.VendorInvoiceDocument.uploaded_at:type_name -> google.protobuf.Timestamp
+	101, // 43: vendor.v1.VendorInvoice.paid_at:type_name -> google.protobuf.Timestamp
+	101, // 44: vendor.v1.VendorInvoice.submitted_at:type_name -> google.protobuf.Timestamp
+	101, // 45: vendor.v1.VendorInvoice.updated_at:type_name -> google.protobuf.Timestamp
+	58,  // 46: vendor.v1.VendorInvoice.documents:type_name -> vendor.v1.VendorInvoiceDocument
+	47,  // 47: vendor.v1.VendorInvoice.invoice_amount_exact:type_name -> vendor.v1.Money
+	47,  // 48: vendor.v1.VendorInvoice.paid_amount_exact:type_name -> vendor.v1.Money
+	47,  // 49: vendor.v1.SubmitPortalInvoiceRequest.invoice_amount_exact:type_name -> vendor.v1.Money
+	58,  // 50: vendor.v1.VendorInvoiceDocumentResponse.document:type_name -> vendor.v1.VendorInvoiceDocument
+	59,  // 51: vendor.v1.VendorInvoiceResponse.invoice:type_name -> vendor.v1.VendorInvoice
+	59,  // 52: vendor.v1.ListVendorInvoicesResponse.invoices:type_name -> vendor.v1.VendorInvoice
+	14,  // 53: vendor.v1.ListVendorInvoicesResponse.pagination:type_name -> vendor.v1.PaginationMetadata
+	57,  // 54: vendor.v1.VendorPortalUserResponse.user:type_name -> vendor.v1.VendorPortalUser
+	57,  // 55: vendor.v1.ListVendorPortalUsersResponse.users:type_name -> vendor.v1.VendorPortalUser
+	47,  // 56: vendor.v1.UpdateVendorInvoiceStatusRequest.paid_amount_exact:type_name -> vendor.v1.Money
+	101, // 57: vendor.v1.TDSSection.updated_at:type_name -> google.protobuf.Timestamp
+	47,  // 58: vendor.v1.TDSSection.single_threshold_exact:type_name -> vendor.v1.Money
+	47,  // 59: vendor.v1.TDSSection.annual_threshold_exact:type_name -> vendor.v1.Money
+	76,  // 60: vendor.v1.ListTDSSectionsResponse.sections:type_name -> vendor.v1.TDSSection
+	47,  // 61: vendor.v1.UpsertTDSSectionRequest.single_threshold_exact:type_name -> vendor.v1.Money
+	47,  // 62: vendor.v1.UpsertTDSSectionRequest.annual_threshold_exact:type_name -> vendor.v1.Money
+	76,  // 63: vendor.v1.TDSSectionResponse.section:type_name -> vendor.v1.TDSSection
+	101, // 64: vendor.v1.TDSCertificate.created_at:type_name -> google.protobuf.Timestamp
+	47,  // 65: vendor.v1.TDSCertificate.amount_limit_exact:type_name -> vendor.v1.Money
+	47,  // 66: vendor.v1.TDSCertificate.amount_used_exact:type_name -> vendor.v1.Money
+	47,  // 67: vendor.v1.CreateTDSCertificateRequest.amount_limit_exact:type_name -> vendor.v1.Money
+	81,  // 68: vendor.v1.TDSCertificateResponse.certificate:type_name -> vendor.v1.TDSCertificate
+	81,  // 69: vendor.v1.ListTDSCertificatesResponse.certificates:type_name -> vendor.v1.TDSCertificate
+	47,  // 70: vendor.v1.TDSDeduction.amount_exact:type_name -> vendor.v1.Money
+	47,  // 71: vendor.v1.TDSDeduction.aggregate_before_exact:type_name -> vendor.v1.Money
+	47,  // 72: vendor.v1.TDSDeduction.taxable_amount_exact:type_name -> vendor.v1.Money
+	47,  // 73: vendor.v1.TDSDeduction.certificate_amount_exact:type_name -> vendor.v1.Money
+	47,  // 74: vendor.v1.TDSDeduction.tds_amount_exact:type_name -> vendor.v1.Money
+	47,  // 75: vendor.v1.CalculateTDSRequest.amount_exact:type_name -> vendor.v1.Money
+	47,  // 76: vendor.v1.RecordTDSDeductionRequest.amount_exact:type_name -> vendor.v1.Money
+	86,  // 77: vendor.v1.TDSDeductionResponse.deduction:type_name -> vendor.v1.TDSDeduction
+	47,  // 78: vendor.v1.TDSSectionSummary.aggregate_amount_exact:type_name -> vendor.v1.Money
+	47,  // 79: vendor.v1.TDSSectionSummary.taxable_amount_exact:type_name -> vendor.v1.Money
+	47,  // 80: vendor.v1.TDSSectionSummary.tds_amount_exact:type_name -> vendor.v1.Money
+	47,  // 81: vendor.v1.TDSSectionSummary.single_threshold_exact:type_name -> vendor.v1.Money
+	47,  // 82: vendor.v1.TDSSectionSummary.annual_threshold_exact:type_name -> vendor.v1.Money
+	92,  // 83: vendor.v1.VendorTDSSummaryResponse.sections:type_name -> vendor.v1.TDSSectionSummary
+	97,  // 84: vendor.v1.GetProjectsDropdownResponse.projects:type_name -> vendor.v1.ProjectDropdownItem
+	101, // 85: vendor.v1.UploadVendorSignatureResponse.uploaded_at:type_name -> google.protobuf.Timestamp
+	8,   // 86: vendor.v1.VendorService.CreateVendor:input_type -> vendor.v1.CreateVendorRequest
+	9,   // 87: vendor.v1.VendorService.GetVendor:input_type -> vendor.v1.GetVendorRequest
+	10,  // 88: vendor.v1.VendorService.GetVendorByCode:input_type -> vendor.v1.GetVendorByCodeRequest
+	11,  // 89: vendor.v1.VendorService.UpdateVendor:input_type -> vendor.v1.UpdateVendorRequest
+	12,  // 90: vendor.v1.VendorService.DeleteVendor:input_type -> vendor.v1.DeleteVendorRequest
+	13,  // 91: vendor.v1.VendorService.ListVendors:input_type -> vendor.v1.ListVendorsRequest
+	15,  // 92: vendor.v1.VendorService.GenerateVendorCode:input_type -> vendor.v1.GenerateVendorCodeRequest
+	16,  // 93: vendor.v1.VendorService.UpdateVendorCode:input_type -> vendor.v1.UpdateVendorCodeRequest
+	17,  // 94: vendor.v1.VendorService.RegenerateVendorCode:input_type -> vendor.v1.RegenerateVendorCodeRequest
+	18,  // 95: vendor.v1.VendorService.CreateVendorAccount:input_type -> vendor.v1.CreateVendorAccountRequest
+	19,  // 96: vendor.v1.VendorService.GetVendorAccounts:input_type -> vendor.v1.GetVendorAccountsRequest
+	20,  // 97: vendor.v1.VendorService.GetVendorBankingDetails:input_type -> vendor.v1.GetVendorBankingDetailsRequest
+	21,  // 98: vendor.v1.VendorService.UpdateVendorAccount:input_type -> vendor.v1.UpdateVendorAccountRequest
+	22,  // 99: vendor.v1.VendorService.DeleteVendorAccount:input_type -> vendor.v1.DeleteVendorAccountRequest
+	23,  // 100: vendor.v1.VendorService.ToggleAccountStatus:input_type -> vendor.v1.ToggleAccountStatusRequest
+	24,  // 101: vendor.v1.VendorService.ListVendorAccountChanges:input_type -> vendor.v1.ListVendorAccountChangesRequest
+	25,  // 102: vendor.v1.VendorService.ApproveVendorAccountChange:input_type -> vendor.v1.ReviewVendorAccountChangeRequest
+	25,  // 103: vendor.v1.VendorService.RejectVendorAccountChange:input_type -> vendor.v1.ReviewVendorAccountChangeRequest
+	26,  // 104: vendor.v1.VendorService.VerifyPayeeAccount:input_type -> vendor.v1.VerifyPayeeAccountRequest
+	27,  // 105: vendor.v1.VendorService.FindDuplicateVendors:input_type -> vendor.v1.FindDuplicateVendorsRequest
+	30,  // 106: vendor.v1.VendorService.MergeVendors:input_type -> vendor.v1.MergeVendorsRequest
+	28,  // 107: vendor.v1.VendorService.ImportVendors:input_type -> vendor.v1.ImportVendorsRequest
+	29,  // 108: vendor.v1.VendorService.ExportVendors:input_type -> vendor.v1.ExportVendorsRequest
+	49,  // 109: vendor.v1.VendorService.RegisterMSMEInvoice:input_type -> vendor.v1.RegisterMSMEInvoiceRequest
+	50,  // 110: vendor.v1.VendorService.RecordMSMEInvoicePayment:input_type -> vendor.v1.RecordMSMEInvoicePaymentRequest
+	51,  // 111: vendor.v1.VendorService.ListMSMEInvoices:input_type -> vendor.v1.ListMSMEInvoicesRequest
+	54,  // 112: vendor.v1.VendorService.GetMSMEOutstandingReport:input_type -> vendor.v1.GetMSMEOutstandingReportRequest
+	60,  // 113: vendor.v1.VendorService.GetPortalProfile:input_type -> vendor.v1.GetPortalProfileRequest
+	61,  // 114: vendor.v1.VendorService.UpdatePortalContact:input_type -> vendor.v1.UpdatePortalContactRequest
+	62,  // 115: vendor.v1.VendorService.SubmitPortalInvoice:input_type -> vendor.v1.SubmitPortalInvoiceRequest
+	63,  // 116: vendor.v1.VendorService.UploadPortalInvoiceDocument:input_type -> vendor.v1.UploadPortalInvoiceDocumentRequest
+	65,  // 117: vendor.v1.VendorService.ListPortalInvoices:input_type -> vendor.v1.ListPortalInvoicesRequest
+	66,  // 118: vendor.v1.VendorService.GetPortalInvoice:input_type -> vendor.v1.GetPortalInvoiceRequest
+	69,  // 119: vendor.v1.VendorService.LinkVendorUser:input_type -> vendor.v1.LinkVendorUserRequest
+	71,  // 120: vendor.v1.VendorService.UnlinkVendorUser:input_type -> vendor.v1.UnlinkVendorUserRequest
+	72,  // 121: vendor.v1.VendorService.ListVendorPortalUsers:input_type -> vendor.v1.ListVendorPortalUsersRequest
+	74,  // 122: vendor.v1.VendorService.ListVendorInvoices:input_type -> vendor.v1.ListVendorInvoicesRequest
+	75,  // 123: vendor.v1.VendorService.UpdateVendorInvoiceStatus:input_type -> vendor.v1.UpdateVendorInvoiceStatusRequest
+	77,  // 124: vendor.v1.VendorService.ListTDSSections:input_type -> vendor.v1.ListTDSSectionsRequest
+	79,  // 125: vendor.v1.VendorService.UpsertTDSSection:input_type -> vendor.v1.UpsertTDSSectionRequest
+	82,  // 126: vendor.v1.VendorService.CreateTDSCertificate:input_type -> vendor.v1.CreateTDSCertificateRequest
+	84,  // 127: vendor.v1.VendorService.ListTDSCertificates:input_type -> vendor.v1.ListTDSCertificatesRequest
+	87,  // 128: vendor.v1.VendorService.CalculateTDS:input_type -> vendor.v1.CalculateTDSRequest
+	88,  // 129: vendor.v1.VendorService.RecordTDSDeduction:input_type -> vendor.v1.RecordTDSDeductionRequest
+	90,  // 130: vendor.v1.VendorService.ReverseTDSDeduction:input_type -> vendor.v1.ReverseTDSDeductionRequest
+	91,  // 131: vendor.v1.VendorService.GetVendorTDSSummary:input_type -> vendor.v1.GetVendorTDSSummaryRequest
+	96,  // 132: vendor.v1.VendorService.GetProjectsDropdown:input_type -> vendor.v1.GetProjectsDropdownRequest
+	99,  // 133: vendor.v1.VendorService.UploadVendorSignature:input_type -> vendor.v1.UploadVendorSignatureRequest
+	31,  // 134: vendor.v1.VendorService.CreateVendor:output_type -> vendor.v1.VendorResponse
+	31,  // 135: vendor.v1.VendorService.GetVendor:output_type -> vendor.v1.VendorResponse
+	31,  // 136: vendor.v1.VendorService.GetVendorByCode:output_type -> vendor.v1.VendorResponse
+	31,  // 137: vendor.v1.VendorService.UpdateVendor:output_type -> vendor.v1.VendorResponse
+	102, // 138: vendor.v1.VendorService.DeleteVendor:output_type -> google.protobuf.Empty
+	32,  // 139: vendor.v1.VendorService.ListVendors:output_type -> vendor.v1.ListVendorsResponse
+	33,  // 140: vendor.v1.VendorService.GenerateVendorCode:output_type -> vendor.v1.GenerateVendorCodeResponse
+	31,  // 141: vendor.v1.VendorService.UpdateVendorCode:output_type -> vendor.v1.VendorResponse
+	31,  // 142: vendor.v1.VendorService.RegenerateVendorCode:output_type -> vendor.v1.VendorResponse
+	34,  // 143: vendor.v1.VendorService.CreateVendorAccount:output_type -> vendor.v1.VendorAccountResponse
+	35,  // 144: vendor.v1.VendorService.GetVendorAccounts:output_type -> vendor.v1.GetVendorAccountsResponse
+	36,  // 145: vendor.v1.VendorService.GetVendorBankingDetails:output_type -> vendor.v1.BankingDetailsResponse
+	34,  // 146: vendor.v1.VendorService.UpdateVendorAccount:output_type -> vendor.v1.VendorAccountResponse
+	102, // 147: vendor.v1.VendorService.DeleteVendorAccount:output_type -> google.protobuf.Empty
+	34,  // 148: vendor.v1.VendorService.ToggleAccountStatus:output_type -> vendor.v1.VendorAccountResponse
+	37,  // 149: vendor.v1.VendorService.ListVendorAccountChanges:output_type -> vendor.v1.ListVendorAccountChangesResponse
+	38,  // 150: vendor.v1.VendorService.ApproveVendorAccountChange:output_type -> vendor.v1.VendorAccountChangeResponse
+	38,  // 151: vendor.v1.VendorService.RejectVendorAccountChange:output_type -> vendor.v1.VendorAccountChangeResponse
+	39,  // 152: vendor.v1.VendorService.VerifyPayeeAccount:output_type -> vendor.v1.VerifyPayeeAccountResponse
+	41,  // 153: vendor.v1.VendorService.FindDuplicateVendors:output_type -> vendor.v1.FindDuplicateVendorsResponse
+	46,  // 154: vendor.v1.VendorService.MergeVendors:output_type -> vendor.v1.MergeVendorsResponse
+	43,  // 155: vendor.v1.VendorService.ImportVendors:output_type -> vendor.v1.ImportVendorsResponse
+	44,  // 156: vendor.v1.VendorService.ExportVendors:output_type -> vendor.v1.ExportVendorsResponse
+	52,  // 157: vendor.v1.VendorService.RegisterMSMEInvoice:output_type -> vendor.v1.MSMEInvoiceResponse
+	52,  // 158: vendor.v1.VendorService.RecordMSMEInvoicePayment:output_type -> vendor.v1.MSMEInvoiceResponse
+	53,  // 159: vendor.v1.VendorService.ListMSMEInvoices:output_type -> vendor.v1.ListMSMEInvoicesResponse
+	56,  // 160: vendor.v1.VendorService.GetMSMEOutstandingReport:output_type -> vendor.v1.MSMEOutstandingReportResponse
+	31,  // 161: vendor.v1.VendorService.GetPortalProfile:output_type -> vendor.v1.VendorResponse
+	31,  // 162: vendor.v1.VendorService.UpdatePortalContact:output_type -> vendor.v1.VendorResponse
+	67,  // 163: vendor.v1.VendorService.SubmitPortalInvoice:output_type -> vendor.v1.VendorInvoiceResponse
+	64,  // 164: vendor.v1.VendorService.UploadPortalInvoiceDocument:output_type -> vendor.v1.VendorInvoiceDocumentResponse
+	68,  // 165: vendor.v1.VendorService.ListPortalInvoices:output_type -> vendor.v1.ListVendorInvoicesResponse
+	67,  // 166: vendor.v1.VendorService.GetPortalInvoice:output_type -> vendor.v1.VendorInvoiceResponse
+	70,  // 167: vendor.v1.VendorService.LinkVendorUser:output_type -> vendor.v1.VendorPortalUserResponse
+	102, // 168: vendor.v1.VendorService.UnlinkVendorUser:output_type -> google.protobuf.Empty
+	73,  // 169: vendor.v1.VendorService.ListVendorPortalUsers:output_type -> vendor.v1.ListVendorPortalUsersResponse
+	68,  // 170: vendor.v1.VendorService.ListVendorInvoices:output_type -> vendor.v1.ListVendorInvoicesResponse
+	67,  // 171: vendor.v1.VendorService.UpdateVendorInvoiceStatus:output_type -> vendor.v1.VendorInvoiceResponse
+	78,  // 172: vendor.v1.VendorService.ListTDSSections:output_type -> vendor.v1.ListTDSSectionsResponse
+	80,  // 173: vendor.v1.VendorService.UpsertTDSSection:output_type -> vendor.v1.TDSSectionResponse
+	83,  // 174: vendor.v1.VendorService.CreateTDSCertificate:output_type -> vendor.v1.TDSCertificateResponse
+	85,  // 175: vendor.v1.VendorService.ListTDSCertificates:output_type -> vendor.v1.ListTDSCertificatesResponse
+	89,  // 176: vendor.v1.VendorService.CalculateTDS:output_type -> vendor.v1.TDSDeductionResponse
+	89,  // 177: vendor.v1.VendorService.RecordTDSDeduction:output_type -> vendor.v1.TDSDeductionResponse
+	102, // 178: vendor.v1.VendorService.ReverseTDSDeduction:output_type -> google.protobuf.Empty
+	93,  // 179: vendor.v1.VendorService.GetVendorTDSSummary:output_type -> vendor.v1.VendorTDSSummaryResponse
+	98,  // 180: vendor.v1.VendorService.GetProjectsDropdown:output_type -> vendor.v1.GetProjectsDropdownResponse
+	100, // 181: vendor.v1.VendorService.UploadVendorSignature:output_type -> vendor.v1.UploadVendorSignatureResponse
+	134, // [134:182] is the sub-list for method output_type
+	86,  // [86:134] is the sub-list for method input_type
+	86,  // [86:86] is the sub-list for extension type_name
+	86,  // [86:86] is the sub-list for extension extendee
+	0,   // [0:86] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_vendor_proto_init() }
@@ -3773,19 +9769,44 @@ func file_api_proto_vendor_proto_init() {
 	file_api_proto_vendor_proto_msgTypes[1].OneofWrappers = []any{}
 	file_api_proto_vendor_proto_msgTypes[2].OneofWrappers = []any{}
 	file_api_proto_vendor_proto_msgTypes[3].OneofWrappers = []any{}
-	file_api_proto_vendor_proto_msgTypes[6].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[4].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[5].OneofWrappers = []any{}
 	file_api_proto_vendor_proto_msgTypes[8].OneofWrappers = []any{}
-	file_api_proto_vendor_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[10].OneofWrappers = []any{}
 	file_api_proto_vendor_proto_msgTypes[15].OneofWrappers = []any{}
-	file_api_proto_vendor_proto_msgTypes[16].OneofWrappers = []any{}
-	file_api_proto_vendor_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[18].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[21].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[24].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[26].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[36].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[42].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[45].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[46].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[48].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[51].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[52].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[56].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[58].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[59].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[71].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[72].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[83].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[84].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[85].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[88].OneofWrappers = []any{}
+	file_api_proto_vendor_proto_msgTypes[91].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_vendor_proto_rawDesc), len(file_api_proto_vendor_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   32,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
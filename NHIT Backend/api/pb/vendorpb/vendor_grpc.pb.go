@@ -20,23 +20,54 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VendorService_CreateVendor_FullMethodName            = "/vendor.v1.VendorService/CreateVendor"
-	VendorService_GetVendor_FullMethodName               = "/vendor.v1.VendorService/GetVendor"
-	VendorService_GetVendorByCode_FullMethodName         = "/vendor.v1.VendorService/GetVendorByCode"
-	VendorService_UpdateVendor_FullMethodName            = "/vendor.v1.VendorService/UpdateVendor"
-	VendorService_DeleteVendor_FullMethodName            = "/vendor.v1.VendorService/DeleteVendor"
-	VendorService_ListVendors_FullMethodName             = "/vendor.v1.VendorService/ListVendors"
-	VendorService_GenerateVendorCode_FullMethodName      = "/vendor.v1.VendorService/GenerateVendorCode"
-	VendorService_UpdateVendorCode_FullMethodName        = "/vendor.v1.VendorService/UpdateVendorCode"
-	VendorService_RegenerateVendorCode_FullMethodName    = "/vendor.v1.VendorService/RegenerateVendorCode"
-	VendorService_CreateVendorAccount_FullMethodName     = "/vendor.v1.VendorService/CreateVendorAccount"
-	VendorService_GetVendorAccounts_FullMethodName       = "/vendor.v1.VendorService/GetVendorAccounts"
-	VendorService_GetVendorBankingDetails_FullMethodName = "/vendor.v1.VendorService/GetVendorBankingDetails"
-	VendorService_UpdateVendorAccount_FullMethodName     = "/vendor.v1.VendorService/UpdateVendorAccount"
-	VendorService_DeleteVendorAccount_FullMethodName     = "/vendor.v1.VendorService/DeleteVendorAccount"
-	VendorService_ToggleAccountStatus_FullMethodName     = "/vendor.v1.VendorService/ToggleAccountStatus"
-	VendorService_GetProjectsDropdown_FullMethodName     = "/vendor.v1.VendorService/GetProjectsDropdown"
-	VendorService_UploadVendorSignature_FullMethodName   = "/vendor.v1.VendorService/UploadVendorSignature"
+	VendorService_CreateVendor_FullMethodName                = "/vendor.v1.VendorService/CreateVendor"
+	VendorService_GetVendor_FullMethodName                   = "/vendor.v1.VendorService/GetVendor"
+	VendorService_GetVendorByCode_FullMethodName             = "/vendor.v1.VendorService/GetVendorByCode"
+	VendorService_UpdateVendor_FullMethodName                = "/vendor.v1.VendorService/UpdateVendor"
+	VendorService_DeleteVendor_FullMethodName                = "/vendor.v1.VendorService/DeleteVendor"
+	VendorService_ListVendors_FullMethodName                 = "/vendor.v1.VendorService/ListVendors"
+	VendorService_GenerateVendorCode_FullMethodName          = "/vendor.v1.VendorService/GenerateVendorCode"
+	VendorService_UpdateVendorCode_FullMethodName            = "/vendor.v1.VendorService/UpdateVendorCode"
+	VendorService_RegenerateVendorCode_FullMethodName        = "/vendor.v1.VendorService/RegenerateVendorCode"
+	VendorService_CreateVendorAccount_FullMethodName         = "/vendor.v1.VendorService/CreateVendorAccount"
+	VendorService_GetVendorAccounts_FullMethodName           = "/vendor.v1.VendorService/GetVendorAccounts"
+	VendorService_GetVendorBankingDetails_FullMethodName     = "/vendor.v1.VendorService/GetVendorBankingDetails"
+	VendorService_UpdateVendorAccount_FullMethodName         = "/vendor.v1.VendorService/UpdateVendorAccount"
+	VendorService_DeleteVendorAccount_FullMethodName         = "/vendor.v1.VendorService/DeleteVendorAccount"
+	VendorService_ToggleAccountStatus_FullMethodName         = "/vendor.v1.VendorService/ToggleAccountStatus"
+	VendorService_ListVendorAccountChanges_FullMethodName    = "/vendor.v1.VendorService/ListVendorAccountChanges"
+	VendorService_ApproveVendorAccountChange_FullMethodName  = "/vendor.v1.VendorService/ApproveVendorAccountChange"
+	VendorService_RejectVendorAccountChange_FullMethodName   = "/vendor.v1.VendorService/RejectVendorAccountChange"
+	VendorService_VerifyPayeeAccount_FullMethodName          = "/vendor.v1.VendorService/VerifyPayeeAccount"
+	VendorService_FindDuplicateVendors_FullMethodName        = "/vendor.v1.VendorService/FindDuplicateVendors"
+	VendorService_MergeVendors_FullMethodName                = "/vendor.v1.VendorService/MergeVendors"
+	VendorService_ImportVendors_FullMethodName               = "/vendor.v1.VendorService/ImportVendors"
+	VendorService_ExportVendors_FullMethodName               = "/vendor.v1.VendorService/ExportVendors"
+	VendorService_RegisterMSMEInvoice_FullMethodName         = "/vendor.v1.VendorService/RegisterMSMEInvoice"
+	VendorService_RecordMSMEInvoicePayment_FullMethodName    = "/vendor.v1.VendorService/RecordMSMEInvoicePayment"
+	VendorService_ListMSMEInvoices_FullMethodName            = "/vendor.v1.VendorService/ListMSMEInvoices"
+	VendorService_GetMSMEOutstandingReport_FullMethodName    = "/vendor.v1.VendorService/GetMSMEOutstandingReport"
+	VendorService_GetPortalProfile_FullMethodName            = "/vendor.v1.VendorService/GetPortalProfile"
+	VendorService_UpdatePortalContact_FullMethodName         = "/vendor.v1.VendorService/UpdatePortalContact"
+	VendorService_SubmitPortalInvoice_FullMethodName         = "/vendor.v1.VendorService/SubmitPortalInvoice"
+	VendorService_UploadPortalInvoiceDocument_FullMethodName = "/vendor.v1.VendorService/UploadPortalInvoiceDocument"
+	VendorService_ListPortalInvoices_FullMethodName          = "/vendor.v1.VendorService/ListPortalInvoices"
+	VendorService_GetPortalInvoice_FullMethodName            = "/vendor.v1.VendorService/GetPortalInvoice"
+	VendorService_LinkVendorUser_FullMethodName              = "/vendor.v1.VendorService/LinkVendorUser"
+	VendorService_UnlinkVendorUser_FullMethodName            = "/vendor.v1.VendorService/UnlinkVendorUser"
+	VendorService_ListVendorPortalUsers_FullMethodName       = "/vendor.v1.VendorService/ListVendorPortalUsers"
+	VendorService_ListVendorInvoices_FullMethodName          = "/vendor.v1.VendorService/ListVendorInvoices"
+	VendorService_UpdateVendorInvoiceStatus_FullMethodName   = "/vendor.v1.VendorService/UpdateVendorInvoiceStatus"
+	VendorService_ListTDSSections_FullMethodName             = "/vendor.v1.VendorService/ListTDSSections"
+	VendorService_UpsertTDSSection_FullMethodName            = "/vendor.v1.VendorService/UpsertTDSSection"
+	VendorService_CreateTDSCertificate_FullMethodName        = "/vendor.v1.VendorService/CreateTDSCertificate"
+	VendorService_ListTDSCertificates_FullMethodName         = "/vendor.v1.VendorService/ListTDSCertificates"
+	VendorService_CalculateTDS_FullMethodName                = "/vendor.v1.VendorService/CalculateTDS"
+	VendorService_RecordTDSDeduction_FullMethodName          = "/vendor.v1.VendorService/RecordTDSDeduction"
+	VendorService_ReverseTDSDeduction_FullMethodName         = "/vendor.v1.VendorService/ReverseTDSDeduction"
+	VendorService_GetVendorTDSSummary_FullMethodName         = "/vendor.v1.VendorService/GetVendorTDSSummary"
+	VendorService_GetProjectsDropdown_FullMethodName         = "/vendor.v1.VendorService/GetProjectsDropdown"
+	VendorService_UploadVendorSignature_FullMethodName       = "/vendor.v1.VendorService/UploadVendorSignature"
 )
 
 // VendorServiceClient is the client API for VendorService service.
@@ -63,6 +94,49 @@ type VendorServiceClient interface {
 	UpdateVendorAccount(ctx context.Context, in *UpdateVendorAccountRequest, opts ...grpc.CallOption) (*VendorAccountResponse, error)
 	DeleteVendorAccount(ctx context.Context, in *DeleteVendorAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ToggleAccountStatus(ctx context.Context, in *ToggleAccountStatusRequest, opts ...grpc.CallOption) (*VendorAccountResponse, error)
+	// Bank account change approval (maker-checker)
+	ListVendorAccountChanges(ctx context.Context, in *ListVendorAccountChangesRequest, opts ...grpc.CallOption) (*ListVendorAccountChangesResponse, error)
+	ApproveVendorAccountChange(ctx context.Context, in *ReviewVendorAccountChangeRequest, opts ...grpc.CallOption) (*VendorAccountChangeResponse, error)
+	RejectVendorAccountChange(ctx context.Context, in *ReviewVendorAccountChangeRequest, opts ...grpc.CallOption) (*VendorAccountChangeResponse, error)
+	// Used by payment processing to refuse payments to unapproved accounts
+	VerifyPayeeAccount(ctx context.Context, in *VerifyPayeeAccountRequest, opts ...grpc.CallOption) (*VerifyPayeeAccountResponse, error)
+	// Duplicate detection and merging
+	FindDuplicateVendors(ctx context.Context, in *FindDuplicateVendorsRequest, opts ...grpc.CallOption) (*FindDuplicateVendorsResponse, error)
+	MergeVendors(ctx context.Context, in *MergeVendorsRequest, opts ...grpc.CallOption) (*MergeVendorsResponse, error)
+	// Bulk import / export (CSV or XLSX)
+	ImportVendors(ctx context.Context, in *ImportVendorsRequest, opts ...grpc.CallOption) (*ImportVendorsResponse, error)
+	ExportVendors(ctx context.Context, in *ExportVendorsRequest, opts ...grpc.CallOption) (*ExportVendorsResponse, error)
+	// MSME payment-due tracking (MSMED Act, Sections 15 and 16)
+	RegisterMSMEInvoice(ctx context.Context, in *RegisterMSMEInvoiceRequest, opts ...grpc.CallOption) (*MSMEInvoiceResponse, error)
+	RecordMSMEInvoicePayment(ctx context.Context, in *RecordMSMEInvoicePaymentRequest, opts ...grpc.CallOption) (*MSMEInvoiceResponse, error)
+	ListMSMEInvoices(ctx context.Context, in *ListMSMEInvoicesRequest, opts ...grpc.CallOption) (*ListMSMEInvoicesResponse, error)
+	GetMSMEOutstandingReport(ctx context.Context, in *GetMSMEOutstandingReportRequest, opts ...grpc.CallOption) (*MSMEOutstandingReportResponse, error)
+	// Vendor self-service portal. Callers must hold the vendor-portal
+	// permission and be linked to a vendor; every call is scoped to that vendor.
+	GetPortalProfile(ctx context.Context, in *GetPortalProfileRequest, opts ...grpc.CallOption) (*VendorResponse, error)
+	UpdatePortalContact(ctx context.Context, in *UpdatePortalContactRequest, opts ...grpc.CallOption) (*VendorResponse, error)
+	SubmitPortalInvoice(ctx context.Context, in *SubmitPortalInvoiceRequest, opts ...grpc.CallOption) (*VendorInvoiceResponse, error)
+	UploadPortalInvoiceDocument(ctx context.Context, in *UploadPortalInvoiceDocumentRequest, opts ...grpc.CallOption) (*VendorInvoiceDocumentResponse, error)
+	ListPortalInvoices(ctx context.Context, in *ListPortalInvoicesRequest, opts ...grpc.CallOption) (*ListVendorInvoicesResponse, error)
+	GetPortalInvoice(ctx context.Context, in *GetPortalInvoiceRequest, opts ...grpc.CallOption) (*VendorInvoiceResponse, error)
+	// Vendor portal administration and invoice tracking for staff
+	LinkVendorUser(ctx context.Context, in *LinkVendorUserRequest, opts ...grpc.CallOption) (*VendorPortalUserResponse, error)
+	UnlinkVendorUser(ctx context.Context, in *UnlinkVendorUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListVendorPortalUsers(ctx context.Context, in *ListVendorPortalUsersRequest, opts ...grpc.CallOption) (*ListVendorPortalUsersResponse, error)
+	ListVendorInvoices(ctx context.Context, in *ListVendorInvoicesRequest, opts ...grpc.CallOption) (*ListVendorInvoicesResponse, error)
+	// Called by the green note and payment services as the invoice progresses
+	UpdateVendorInvoiceStatus(ctx context.Context, in *UpdateVendorInvoiceStatusRequest, opts ...grpc.CallOption) (*VendorInvoiceResponse, error)
+	// TDS: section master, Section 197 certificates and deductions
+	ListTDSSections(ctx context.Context, in *ListTDSSectionsRequest, opts ...grpc.CallOption) (*ListTDSSectionsResponse, error)
+	UpsertTDSSection(ctx context.Context, in *UpsertTDSSectionRequest, opts ...grpc.CallOption) (*TDSSectionResponse, error)
+	CreateTDSCertificate(ctx context.Context, in *CreateTDSCertificateRequest, opts ...grpc.CallOption) (*TDSCertificateResponse, error)
+	ListTDSCertificates(ctx context.Context, in *ListTDSCertificatesRequest, opts ...grpc.CallOption) (*ListTDSCertificatesResponse, error)
+	// Suggests the TDS on a payment without recording it
+	CalculateTDS(ctx context.Context, in *CalculateTDSRequest, opts ...grpc.CallOption) (*TDSDeductionResponse, error)
+	// Records the TDS on a payment against the vendor's thresholds; idempotent per reference_id
+	RecordTDSDeduction(ctx context.Context, in *RecordTDSDeductionRequest, opts ...grpc.CallOption) (*TDSDeductionResponse, error)
+	ReverseTDSDeduction(ctx context.Context, in *ReverseTDSDeductionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetVendorTDSSummary(ctx context.Context, in *GetVendorTDSSummaryRequest, opts ...grpc.CallOption) (*VendorTDSSummaryResponse, error)
 	// Dropdown endpoints
 	GetProjectsDropdown(ctx context.Context, in *GetProjectsDropdownRequest, opts ...grpc.CallOption) (*GetProjectsDropdownResponse, error)
 	// Upload Vendor Signature
@@ -227,6 +301,316 @@ func (c *vendorServiceClient) ToggleAccountStatus(ctx context.Context, in *Toggl
 	return out, nil
 }
 
+func (c *vendorServiceClient) ListVendorAccountChanges(ctx context.Context, in *ListVendorAccountChangesRequest, opts ...grpc.CallOption) (*ListVendorAccountChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVendorAccountChangesResponse)
+	err := c.cc.Invoke(ctx, VendorService_ListVendorAccountChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ApproveVendorAccountChange(ctx context.Context, in *ReviewVendorAccountChangeRequest, opts ...grpc.CallOption) (*VendorAccountChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorAccountChangeResponse)
+	err := c.cc.Invoke(ctx, VendorService_ApproveVendorAccountChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) RejectVendorAccountChange(ctx context.Context, in *ReviewVendorAccountChangeRequest, opts ...grpc.CallOption) (*VendorAccountChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorAccountChangeResponse)
+	err := c.cc.Invoke(ctx, VendorService_RejectVendorAccountChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) VerifyPayeeAccount(ctx context.Context, in *VerifyPayeeAccountRequest, opts ...grpc.CallOption) (*VerifyPayeeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPayeeAccountResponse)
+	err := c.cc.Invoke(ctx, VendorService_VerifyPayeeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) FindDuplicateVendors(ctx context.Context, in *FindDuplicateVendorsRequest, opts ...grpc.CallOption) (*FindDuplicateVendorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicateVendorsResponse)
+	err := c.cc.Invoke(ctx, VendorService_FindDuplicateVendors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) MergeVendors(ctx context.Context, in *MergeVendorsRequest, opts ...grpc.CallOption) (*MergeVendorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeVendorsResponse)
+	err := c.cc.Invoke(ctx, VendorService_MergeVendors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ImportVendors(ctx context.Context, in *ImportVendorsRequest, opts ...grpc.CallOption) (*ImportVendorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportVendorsResponse)
+	err := c.cc.Invoke(ctx, VendorService_ImportVendors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ExportVendors(ctx context.Context, in *ExportVendorsRequest, opts ...grpc.CallOption) (*ExportVendorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportVendorsResponse)
+	err := c.cc.Invoke(ctx, VendorService_ExportVendors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) RegisterMSMEInvoice(ctx context.Context, in *RegisterMSMEInvoiceRequest, opts ...grpc.CallOption) (*MSMEInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MSMEInvoiceResponse)
+	err := c.cc.Invoke(ctx, VendorService_RegisterMSMEInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) RecordMSMEInvoicePayment(ctx context.Context, in *RecordMSMEInvoicePaymentRequest, opts ...grpc.CallOption) (*MSMEInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MSMEInvoiceResponse)
+	err := c.cc.Invoke(ctx, VendorService_RecordMSMEInvoicePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ListMSMEInvoices(ctx context.Context, in *ListMSMEInvoicesRequest, opts ...grpc.CallOption) (*ListMSMEInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMSMEInvoicesResponse)
+	err := c.cc.Invoke(ctx, VendorService_ListMSMEInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) GetMSMEOutstandingReport(ctx context.Context, in *GetMSMEOutstandingReportRequest, opts ...grpc.CallOption) (*MSMEOutstandingReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MSMEOutstandingReportResponse)
+	err := c.cc.Invoke(ctx, VendorService_GetMSMEOutstandingReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) GetPortalProfile(ctx context.Context, in *GetPortalProfileRequest, opts ...grpc.CallOption) (*VendorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorResponse)
+	err := c.cc.Invoke(ctx, VendorService_GetPortalProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) UpdatePortalContact(ctx context.Context, in *UpdatePortalContactRequest, opts ...grpc.CallOption) (*VendorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorResponse)
+	err := c.cc.Invoke(ctx, VendorService_UpdatePortalContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) SubmitPortalInvoice(ctx context.Context, in *SubmitPortalInvoiceRequest, opts ...grpc.CallOption) (*VendorInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorInvoiceResponse)
+	err := c.cc.Invoke(ctx, VendorService_SubmitPortalInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) UploadPortalInvoiceDocument(ctx context.Context, in *UploadPortalInvoiceDocumentRequest, opts ...grpc.CallOption) (*VendorInvoiceDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorInvoiceDocumentResponse)
+	err := c.cc.Invoke(ctx, VendorService_UploadPortalInvoiceDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ListPortalInvoices(ctx context.Context, in *ListPortalInvoicesRequest, opts ...grpc.CallOption) (*ListVendorInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVendorInvoicesResponse)
+	err := c.cc.Invoke(ctx, VendorService_ListPortalInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) GetPortalInvoice(ctx context.Context, in *GetPortalInvoiceRequest, opts ...grpc.CallOption) (*VendorInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorInvoiceResponse)
+	err := c.cc.Invoke(ctx, VendorService_GetPortalInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) LinkVendorUser(ctx context.Context, in *LinkVendorUserRequest, opts ...grpc.CallOption) (*VendorPortalUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorPortalUserResponse)
+	err := c.cc.Invoke(ctx, VendorService_LinkVendorUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) UnlinkVendorUser(ctx context.Context, in *UnlinkVendorUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VendorService_UnlinkVendorUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ListVendorPortalUsers(ctx context.Context, in *ListVendorPortalUsersRequest, opts ...grpc.CallOption) (*ListVendorPortalUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVendorPortalUsersResponse)
+	err := c.cc.Invoke(ctx, VendorService_ListVendorPortalUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ListVendorInvoices(ctx context.Context, in *ListVendorInvoicesRequest, opts ...grpc.CallOption) (*ListVendorInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVendorInvoicesResponse)
+	err := c.cc.Invoke(ctx, VendorService_ListVendorInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) UpdateVendorInvoiceStatus(ctx context.Context, in *UpdateVendorInvoiceStatusRequest, opts ...grpc.CallOption) (*VendorInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorInvoiceResponse)
+	err := c.cc.Invoke(ctx, VendorService_UpdateVendorInvoiceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ListTDSSections(ctx context.Context, in *ListTDSSectionsRequest, opts ...grpc.CallOption) (*ListTDSSectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTDSSectionsResponse)
+	err := c.cc.Invoke(ctx, VendorService_ListTDSSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) UpsertTDSSection(ctx context.Context, in *UpsertTDSSectionRequest, opts ...grpc.CallOption) (*TDSSectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TDSSectionResponse)
+	err := c.cc.Invoke(ctx, VendorService_UpsertTDSSection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) CreateTDSCertificate(ctx context.Context, in *CreateTDSCertificateRequest, opts ...grpc.CallOption) (*TDSCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TDSCertificateResponse)
+	err := c.cc.Invoke(ctx, VendorService_CreateTDSCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ListTDSCertificates(ctx context.Context, in *ListTDSCertificatesRequest, opts ...grpc.CallOption) (*ListTDSCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTDSCertificatesResponse)
+	err := c.cc.Invoke(ctx, VendorService_ListTDSCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) CalculateTDS(ctx context.Context, in *CalculateTDSRequest, opts ...grpc.CallOption) (*TDSDeductionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TDSDeductionResponse)
+	err := c.cc.Invoke(ctx, VendorService_CalculateTDS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) RecordTDSDeduction(ctx context.Context, in *RecordTDSDeductionRequest, opts ...grpc.CallOption) (*TDSDeductionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TDSDeductionResponse)
+	err := c.cc.Invoke(ctx, VendorService_RecordTDSDeduction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) ReverseTDSDeduction(ctx context.Context, in *ReverseTDSDeductionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VendorService_ReverseTDSDeduction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) GetVendorTDSSummary(ctx context.Context, in *GetVendorTDSSummaryRequest, opts ...grpc.CallOption) (*VendorTDSSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VendorTDSSummaryResponse)
+	err := c.cc.Invoke(ctx, VendorService_GetVendorTDSSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vendorServiceClient) GetProjectsDropdown(ctx context.Context, in *GetProjectsDropdownRequest, opts ...grpc.CallOption) (*GetProjectsDropdownResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProjectsDropdownResponse)
@@ -271,6 +655,49 @@ type VendorServiceServer interface {
 	UpdateVendorAccount(context.Context, *UpdateVendorAccountRequest) (*VendorAccountResponse, error)
 	DeleteVendorAccount(context.Context, *DeleteVendorAccountRequest) (*emptypb.Empty, error)
 	ToggleAccountStatus(context.Context, *ToggleAccountStatusRequest) (*VendorAccountResponse, error)
+	// Bank account change approval (maker-checker)
+	ListVendorAccountChanges(context.Context, *ListVendorAccountChangesRequest) (*ListVendorAccountChangesResponse, error)
+	ApproveVendorAccountChange(context.Context, *ReviewVendorAccountChangeRequest) (*VendorAccountChangeResponse, error)
+	RejectVendorAccountChange(context.Context, *ReviewVendorAccountChangeRequest) (*VendorAccountChangeResponse, error)
+	// Used by payment processing to refuse payments to unapproved accounts
+	VerifyPayeeAccount(context.Context, *VerifyPayeeAccountRequest) (*VerifyPayeeAccountResponse, error)
+	// Duplicate detection and merging
+	FindDuplicateVendors(context.Context, *FindDuplicateVendorsRequest) (*FindDuplicateVendorsResponse, error)
+	MergeVendors(context.Context, *MergeVendorsRequest) (*MergeVendorsResponse, error)
+	// Bulk import / export (CSV or XLSX)
+	ImportVendors(context.Context, *ImportVendorsRequest) (*ImportVendorsResponse, error)
+	ExportVendors(context.Context, *ExportVendorsRequest) (*ExportVendorsResponse, error)
+	// MSME payment-due tracking (MSMED Act, Sections 15 and 16)
+	RegisterMSMEInvoice(context.Context, *RegisterMSMEInvoiceRequest) (*MSMEInvoiceResponse, error)
+	RecordMSMEInvoicePayment(context.Context, *RecordMSMEInvoicePaymentRequest) (*MSMEInvoiceResponse, error)
+	ListMSMEInvoices(context.Context, *ListMSMEInvoicesRequest) (*ListMSMEInvoicesResponse, error)
+	GetMSMEOutstandingReport(context.Context, *GetMSMEOutstandingReportRequest) (*MSMEOutstandingReportResponse, error)
+	// Vendor self-service portal. Callers must hold the vendor-portal
+	// permission and be linked to a vendor; every call is scoped to that vendor.
+	GetPortalProfile(context.Context, *GetPortalProfileRequest) (*VendorResponse, error)
+	UpdatePortalContact(context.Context, *UpdatePortalContactRequest) (*VendorResponse, error)
+	SubmitPortalInvoice(context.Context, *SubmitPortalInvoiceRequest) (*VendorInvoiceResponse, error)
+	UploadPortalInvoiceDocument(context.Context, *UploadPortalInvoiceDocumentRequest) (*VendorInvoiceDocumentResponse, error)
+	ListPortalInvoices(context.Context, *ListPortalInvoicesRequest) (*ListVendorInvoicesResponse, error)
+	GetPortalInvoice(context.Context, *GetPortalInvoiceRequest) (*VendorInvoiceResponse, error)
+	// Vendor portal administration and invoice tracking for staff
+	LinkVendorUser(context.Context, *LinkVendorUserRequest) (*VendorPortalUserResponse, error)
+	UnlinkVendorUser(context.Context, *UnlinkVendorUserRequest) (*emptypb.Empty, error)
+	ListVendorPortalUsers(context.Context, *ListVendorPortalUsersRequest) (*ListVendorPortalUsersResponse, error)
+	ListVendorInvoices(context.Context, *ListVendorInvoicesRequest) (*ListVendorInvoicesResponse, error)
+	// Called by the green note and payment services as the invoice progresses
+	UpdateVendorInvoiceStatus(context.Context, *UpdateVendorInvoiceStatusRequest) (*VendorInvoiceResponse, error)
+	// TDS: section master, Section 197 certificates and deductions
+	ListTDSSections(context.Context, *ListTDSSectionsRequest) (*ListTDSSectionsResponse, error)
+	UpsertTDSSection(context.Context, *UpsertTDSSectionRequest) (*TDSSectionResponse, error)
+	CreateTDSCertificate(context.Context, *CreateTDSCertificateRequest) (*TDSCertificateResponse, error)
+	ListTDSCertificates(context.Context, *ListTDSCertificatesRequest) (*ListTDSCertificatesResponse, error)
+	// Suggests the TDS on a payment without recording it
+	CalculateTDS(context.Context, *CalculateTDSRequest) (*TDSDeductionResponse, error)
+	// Records the TDS on a payment against the vendor's thresholds; idempotent per reference_id
+	RecordTDSDeduction(context.Context, *RecordTDSDeductionRequest) (*TDSDeductionResponse, error)
+	ReverseTDSDeduction(context.Context, *ReverseTDSDeductionRequest) (*emptypb.Empty, error)
+	GetVendorTDSSummary(context.Context, *GetVendorTDSSummaryRequest) (*VendorTDSSummaryResponse, error)
 	// Dropdown endpoints
 	GetProjectsDropdown(context.Context, *GetProjectsDropdownRequest) (*GetProjectsDropdownResponse, error)
 	// Upload Vendor Signature
@@ -330,6 +757,99 @@ func (UnimplementedVendorServiceServer) DeleteVendorAccount(context.Context, *De
 func (UnimplementedVendorServiceServer) ToggleAccountStatus(context.Context, *ToggleAccountStatusRequest) (*VendorAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleAccountStatus not implemented")
 }
+func (UnimplementedVendorServiceServer) ListVendorAccountChanges(context.Context, *ListVendorAccountChangesRequest) (*ListVendorAccountChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVendorAccountChanges not implemented")
+}
+func (UnimplementedVendorServiceServer) ApproveVendorAccountChange(context.Context, *ReviewVendorAccountChangeRequest) (*VendorAccountChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveVendorAccountChange not implemented")
+}
+func (UnimplementedVendorServiceServer) RejectVendorAccountChange(context.Context, *ReviewVendorAccountChangeRequest) (*VendorAccountChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectVendorAccountChange not implemented")
+}
+func (UnimplementedVendorServiceServer) VerifyPayeeAccount(context.Context, *VerifyPayeeAccountRequest) (*VerifyPayeeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPayeeAccount not implemented")
+}
+func (UnimplementedVendorServiceServer) FindDuplicateVendors(context.Context, *FindDuplicateVendorsRequest) (*FindDuplicateVendorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicateVendors not implemented")
+}
+func (UnimplementedVendorServiceServer) MergeVendors(context.Context, *MergeVendorsRequest) (*MergeVendorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeVendors not implemented")
+}
+func (UnimplementedVendorServiceServer) ImportVendors(context.Context, *ImportVendorsRequest) (*ImportVendorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportVendors not implemented")
+}
+func (UnimplementedVendorServiceServer) ExportVendors(context.Context, *ExportVendorsRequest) (*ExportVendorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportVendors not implemented")
+}
+func (UnimplementedVendorServiceServer) RegisterMSMEInvoice(context.Context, *RegisterMSMEInvoiceRequest) (*MSMEInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMSMEInvoice not implemented")
+}
+func (UnimplementedVendorServiceServer) RecordMSMEInvoicePayment(context.Context, *RecordMSMEInvoicePaymentRequest) (*MSMEInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMSMEInvoicePayment not implemented")
+}
+func (UnimplementedVendorServiceServer) ListMSMEInvoices(context.Context, *ListMSMEInvoicesRequest) (*ListMSMEInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMSMEInvoices not implemented")
+}
+func (UnimplementedVendorServiceServer) GetMSMEOutstandingReport(context.Context, *GetMSMEOutstandingReportRequest) (*MSMEOutstandingReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMSMEOutstandingReport not implemented")
+}
+func (UnimplementedVendorServiceServer) GetPortalProfile(context.Context, *GetPortalProfileRequest) (*VendorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortalProfile not implemented")
+}
+func (UnimplementedVendorServiceServer) UpdatePortalContact(context.Context, *UpdatePortalContactRequest) (*VendorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePortalContact not implemented")
+}
+func (UnimplementedVendorServiceServer) SubmitPortalInvoice(context.Context, *SubmitPortalInvoiceRequest) (*VendorInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPortalInvoice not implemented")
+}
+func (UnimplementedVendorServiceServer) UploadPortalInvoiceDocument(context.Context, *UploadPortalInvoiceDocumentRequest) (*VendorInvoiceDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPortalInvoiceDocument not implemented")
+}
+func (UnimplementedVendorServiceServer) ListPortalInvoices(context.Context, *ListPortalInvoicesRequest) (*ListVendorInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPortalInvoices not implemented")
+}
+func (UnimplementedVendorServiceServer) GetPortalInvoice(context.Context, *GetPortalInvoiceRequest) (*VendorInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortalInvoice not implemented")
+}
+func (UnimplementedVendorServiceServer) LinkVendorUser(context.Context, *LinkVendorUserRequest) (*VendorPortalUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkVendorUser not implemented")
+}
+func (UnimplementedVendorServiceServer) UnlinkVendorUser(context.Context, *UnlinkVendorUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkVendorUser not implemented")
+}
+func (UnimplementedVendorServiceServer) ListVendorPortalUsers(context.Context, *ListVendorPortalUsersRequest) (*ListVendorPortalUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVendorPortalUsers not implemented")
+}
+func (UnimplementedVendorServiceServer) ListVendorInvoices(context.Context, *ListVendorInvoicesRequest) (*ListVendorInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVendorInvoices not implemented")
+}
+func (UnimplementedVendorServiceServer) UpdateVendorInvoiceStatus(context.Context, *UpdateVendorInvoiceStatusRequest) (*VendorInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVendorInvoiceStatus not implemented")
+}
+func (UnimplementedVendorServiceServer) ListTDSSections(context.Context, *ListTDSSectionsRequest) (*ListTDSSectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTDSSections not implemented")
+}
+func (UnimplementedVendorServiceServer) UpsertTDSSection(context.Context, *UpsertTDSSectionRequest) (*TDSSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTDSSection not implemented")
+}
+func (UnimplementedVendorServiceServer) CreateTDSCertificate(context.Context, *CreateTDSCertificateRequest) (*TDSCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTDSCertificate not implemented")
+}
+func (UnimplementedVendorServiceServer) ListTDSCertificates(context.Context, *ListTDSCertificatesRequest) (*ListTDSCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTDSCertificates not implemented")
+}
+func (UnimplementedVendorServiceServer) CalculateTDS(context.Context, *CalculateTDSRequest) (*TDSDeductionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateTDS not implemented")
+}
+func (UnimplementedVendorServiceServer) RecordTDSDeduction(context.Context, *RecordTDSDeductionRequest) (*TDSDeductionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordTDSDeduction not implemented")
+}
+func (UnimplementedVendorServiceServer) ReverseTDSDeduction(context.Context, *ReverseTDSDeductionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTDSDeduction not implemented")
+}
+func (UnimplementedVendorServiceServer) GetVendorTDSSummary(context.Context, *GetVendorTDSSummaryRequest) (*VendorTDSSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVendorTDSSummary not implemented")
+}
 func (UnimplementedVendorServiceServer) GetProjectsDropdown(context.Context, *GetProjectsDropdownRequest) (*GetProjectsDropdownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProjectsDropdown not implemented")
 }
@@ -627,17 +1147,575 @@ func _VendorService_ToggleAccountStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VendorService_GetProjectsDropdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectsDropdownRequest)
+func _VendorService_ListVendorAccountChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVendorAccountChangesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VendorServiceServer).GetProjectsDropdown(ctx, in)
+		return srv.(VendorServiceServer).ListVendorAccountChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VendorService_GetProjectsDropdown_FullMethodName,
+		FullMethod: VendorService_ListVendorAccountChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ListVendorAccountChanges(ctx, req.(*ListVendorAccountChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ApproveVendorAccountChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVendorAccountChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ApproveVendorAccountChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ApproveVendorAccountChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ApproveVendorAccountChange(ctx, req.(*ReviewVendorAccountChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_RejectVendorAccountChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVendorAccountChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).RejectVendorAccountChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_RejectVendorAccountChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).RejectVendorAccountChange(ctx, req.(*ReviewVendorAccountChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_VerifyPayeeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPayeeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).VerifyPayeeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_VerifyPayeeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).VerifyPayeeAccount(ctx, req.(*VerifyPayeeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_FindDuplicateVendors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicateVendorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).FindDuplicateVendors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_FindDuplicateVendors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).FindDuplicateVendors(ctx, req.(*FindDuplicateVendorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_MergeVendors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeVendorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).MergeVendors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_MergeVendors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).MergeVendors(ctx, req.(*MergeVendorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ImportVendors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportVendorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ImportVendors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ImportVendors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ImportVendors(ctx, req.(*ImportVendorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ExportVendors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportVendorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ExportVendors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ExportVendors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ExportVendors(ctx, req.(*ExportVendorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_RegisterMSMEInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMSMEInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).RegisterMSMEInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_RegisterMSMEInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).RegisterMSMEInvoice(ctx, req.(*RegisterMSMEInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_RecordMSMEInvoicePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMSMEInvoicePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).RecordMSMEInvoicePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_RecordMSMEInvoicePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).RecordMSMEInvoicePayment(ctx, req.(*RecordMSMEInvoicePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ListMSMEInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMSMEInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ListMSMEInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ListMSMEInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ListMSMEInvoices(ctx, req.(*ListMSMEInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_GetMSMEOutstandingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMSMEOutstandingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).GetMSMEOutstandingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_GetMSMEOutstandingReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).GetMSMEOutstandingReport(ctx, req.(*GetMSMEOutstandingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_GetPortalProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortalProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).GetPortalProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_GetPortalProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).GetPortalProfile(ctx, req.(*GetPortalProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_UpdatePortalContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePortalContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).UpdatePortalContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_UpdatePortalContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).UpdatePortalContact(ctx, req.(*UpdatePortalContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_SubmitPortalInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPortalInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).SubmitPortalInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_SubmitPortalInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).SubmitPortalInvoice(ctx, req.(*SubmitPortalInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_UploadPortalInvoiceDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPortalInvoiceDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).UploadPortalInvoiceDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_UploadPortalInvoiceDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).UploadPortalInvoiceDocument(ctx, req.(*UploadPortalInvoiceDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ListPortalInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortalInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ListPortalInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ListPortalInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ListPortalInvoices(ctx, req.(*ListPortalInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_GetPortalInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortalInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).GetPortalInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_GetPortalInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).GetPortalInvoice(ctx, req.(*GetPortalInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_LinkVendorUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkVendorUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).LinkVendorUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_LinkVendorUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).LinkVendorUser(ctx, req.(*LinkVendorUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_UnlinkVendorUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkVendorUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).UnlinkVendorUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_UnlinkVendorUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).UnlinkVendorUser(ctx, req.(*UnlinkVendorUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ListVendorPortalUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVendorPortalUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ListVendorPortalUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ListVendorPortalUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ListVendorPortalUsers(ctx, req.(*ListVendorPortalUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ListVendorInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVendorInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ListVendorInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ListVendorInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ListVendorInvoices(ctx, req.(*ListVendorInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_UpdateVendorInvoiceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVendorInvoiceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).UpdateVendorInvoiceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_UpdateVendorInvoiceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).UpdateVendorInvoiceStatus(ctx, req.(*UpdateVendorInvoiceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ListTDSSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTDSSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ListTDSSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ListTDSSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ListTDSSections(ctx, req.(*ListTDSSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_UpsertTDSSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTDSSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).UpsertTDSSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_UpsertTDSSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).UpsertTDSSection(ctx, req.(*UpsertTDSSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_CreateTDSCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTDSCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).CreateTDSCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_CreateTDSCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).CreateTDSCertificate(ctx, req.(*CreateTDSCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ListTDSCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTDSCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ListTDSCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ListTDSCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ListTDSCertificates(ctx, req.(*ListTDSCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_CalculateTDS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateTDSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).CalculateTDS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_CalculateTDS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).CalculateTDS(ctx, req.(*CalculateTDSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_RecordTDSDeduction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTDSDeductionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).RecordTDSDeduction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_RecordTDSDeduction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).RecordTDSDeduction(ctx, req.(*RecordTDSDeductionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_ReverseTDSDeduction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTDSDeductionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).ReverseTDSDeduction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_ReverseTDSDeduction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).ReverseTDSDeduction(ctx, req.(*ReverseTDSDeductionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_GetVendorTDSSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVendorTDSSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).GetVendorTDSSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_GetVendorTDSSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).GetVendorTDSSummary(ctx, req.(*GetVendorTDSSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_GetProjectsDropdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectsDropdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).GetProjectsDropdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_GetProjectsDropdown_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(VendorServiceServer).GetProjectsDropdown(ctx, req.(*GetProjectsDropdownRequest))
@@ -730,6 +1808,130 @@ var VendorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleAccountStatus",
 			Handler:    _VendorService_ToggleAccountStatus_Handler,
 		},
+		{
+			MethodName: "ListVendorAccountChanges",
+			Handler:    _VendorService_ListVendorAccountChanges_Handler,
+		},
+		{
+			MethodName: "ApproveVendorAccountChange",
+			Handler:    _VendorService_ApproveVendorAccountChange_Handler,
+		},
+		{
+			MethodName: "RejectVendorAccountChange",
+			Handler:    _VendorService_RejectVendorAccountChange_Handler,
+		},
+		{
+			MethodName: "VerifyPayeeAccount",
+			Handler:    _VendorService_VerifyPayeeAccount_Handler,
+		},
+		{
+			MethodName: "FindDuplicateVendors",
+			Handler:    _VendorService_FindDuplicateVendors_Handler,
+		},
+		{
+			MethodName: "MergeVendors",
+			Handler:    _VendorService_MergeVendors_Handler,
+		},
+		{
+			MethodName: "ImportVendors",
+			Handler:    _VendorService_ImportVendors_Handler,
+		},
+		{
+			MethodName: "ExportVendors",
+			Handler:    _VendorService_ExportVendors_Handler,
+		},
+		{
+			MethodName: "RegisterMSMEInvoice",
+			Handler:    _VendorService_RegisterMSMEInvoice_Handler,
+		},
+		{
+			MethodName: "RecordMSMEInvoicePayment",
+			Handler:    _VendorService_RecordMSMEInvoicePayment_Handler,
+		},
+		{
+			MethodName: "ListMSMEInvoices",
+			Handler:    _VendorService_ListMSMEInvoices_Handler,
+		},
+		{
+			MethodName: "GetMSMEOutstandingReport",
+			Handler:    _VendorService_GetMSMEOutstandingReport_Handler,
+		},
+		{
+			MethodName: "GetPortalProfile",
+			Handler:    _VendorService_GetPortalProfile_Handler,
+		},
+		{
+			MethodName: "UpdatePortalContact",
+			Handler:    _VendorService_UpdatePortalContact_Handler,
+		},
+		{
+			MethodName: "SubmitPortalInvoice",
+			Handler:    _VendorService_SubmitPortalInvoice_Handler,
+		},
+		{
+			MethodName: "UploadPortalInvoiceDocument",
+			Handler:    _VendorService_UploadPortalInvoiceDocument_Handler,
+		},
+		{
+			MethodName: "ListPortalInvoices",
+			Handler:    _VendorService_ListPortalInvoices_Handler,
+		},
+		{
+			MethodName: "GetPortalInvoice",
+			Handler:    _VendorService_GetPortalInvoice_Handler,
+		},
+		{
+			MethodName: "LinkVendorUser",
+			Handler:    _VendorService_LinkVendorUser_Handler,
+		},
+		{
+			MethodName: "UnlinkVendorUser",
+			Handler:    _VendorService_UnlinkVendorUser_Handler,
+		},
+		{
+			MethodName: "ListVendorPortalUsers",
+			Handler:    _VendorService_ListVendorPortalUsers_Handler,
+		},
+		{
+			MethodName: "ListVendorInvoices",
+			Handler:    _VendorService_ListVendorInvoices_Handler,
+		},
+		{
+			MethodName: "UpdateVendorInvoiceStatus",
+			Handler:    _VendorService_UpdateVendorInvoiceStatus_Handler,
+		},
+		{
+			MethodName: "ListTDSSections",
+			Handler:    _VendorService_ListTDSSections_Handler,
+		},
+		{
+			MethodName: "UpsertTDSSection",
+			Handler:    _VendorService_UpsertTDSSection_Handler,
+		},
+		{
+			MethodName: "CreateTDSCertificate",
+			Handler:    _VendorService_CreateTDSCertificate_Handler,
+		},
+		{
+			MethodName: "ListTDSCertificates",
+			Handler:    _VendorService_ListTDSCertificates_Handler,
+		},
+		{
+			MethodName: "CalculateTDS",
+			Handler:    _VendorService_CalculateTDS_Handler,
+		},
+		{
+			MethodName: "RecordTDSDeduction",
+			Handler:    _VendorService_RecordTDSDeduction_Handler,
+		},
+		{
+			MethodName: "ReverseTDSDeduction",
+			Handler:    _VendorService_ReverseTDSDeduction_Handler,
+		},
+		{
+			MethodName: "GetVendorTDSSummary",
+			Handler:    _VendorService_GetVendorTDSSummary_Handler,
+		},
 		{
 			MethodName: "GetProjectsDropdown",
 			Handler:    _VendorService_GetProjectsDropdown_Handler,
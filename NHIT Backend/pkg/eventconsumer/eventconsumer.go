@@ -0,0 +1,243 @@
+// Package eventconsumer consumes the enveloped domain events services publish
+// on Kafka (vendor.merged, vendor.invoice.submitted, ...).
+//
+// Offsets are committed only once a message has been handled. A message that
+// keeps failing is retried with backoff up to MaxAttempts times, then written
+// to the dead-letter topic and skipped, so one bad message cannot block its
+// partition. Malformed messages, unknown event types and other schema
+// versions are logged and skipped without retrying.
+package eventconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Defaults used when Config leaves a field unset
+const (
+	DefaultMaxAttempts  = 5
+	DefaultRetryInitial = time.Second
+	DefaultRetryMax     = 30 * time.Second
+)
+
+// DeadLetterSuffix is appended to the topic name when Config.DeadLetterTopic
+// is not set.
+const DeadLetterSuffix = ".dlq"
+
+// Envelope wraps every event on a domain events topic
+type Envelope struct {
+	EventID       string          `json:"event_id"`
+	EventType     string          `json:"event_type"`
+	SchemaVersion int             `json:"schema_version"`
+	TenantID      string          `json:"tenant_id"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// Config describes the topic to consume and how failures are handled.
+type Config struct {
+	Brokers []string
+	Topic   string
+	GroupID string
+
+	// SchemaVersion is the envelope schema version the handlers understand.
+	SchemaVersion int
+
+	// MaxAttempts is how often a failing message is tried before it is
+	// dead-lettered. Defaults to DefaultMaxAttempts.
+	MaxAttempts int
+
+	// RetryInitial and RetryMax bound the backoff between attempts.
+	RetryInitial time.Duration
+	RetryMax     time.Duration
+
+	// DeadLetterTopic receives messages that failed MaxAttempts times.
+	// Defaults to Topic + DeadLetterSuffix.
+	DeadLetterTopic string
+
+	// Decode reads a message value into an Envelope, for topics whose
+	// events are wrapped differently. Defaults to decoding the JSON Envelope.
+	Decode func(value []byte) (Envelope, error)
+}
+
+// Handler applies one event. A returned error is retried.
+type Handler func(ctx context.Context, env Envelope) error
+
+// Consumer dispatches the events of one topic to handlers by event type.
+type Consumer struct {
+	cfg        Config
+	reader     *kafka.Reader
+	deadLetter *kafka.Writer
+	handlers   map[string]Handler
+}
+
+// New creates a consumer of cfg.Topic. It returns nil when no brokers or
+// topic are configured.
+func New(cfg Config) *Consumer {
+	if len(cfg.Brokers) == 0 || cfg.Topic == "" {
+		return nil
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.RetryInitial <= 0 {
+		cfg.RetryInitial = DefaultRetryInitial
+	}
+	if cfg.RetryMax <= 0 {
+		cfg.RetryMax = DefaultRetryMax
+	}
+	if cfg.DeadLetterTopic == "" {
+		cfg.DeadLetterTopic = cfg.Topic + DeadLetterSuffix
+	}
+	if cfg.Decode == nil {
+		cfg.Decode = decodeEnvelope
+	}
+
+	return &Consumer{
+		cfg: cfg,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  cfg.Brokers,
+			Topic:    cfg.Topic,
+			GroupID:  cfg.GroupID,
+			MinBytes: 1,
+			MaxBytes: 10e6, // 10MB
+		}),
+		deadLetter: &kafka.Writer{
+			Addr:                   kafka.TCP(cfg.Brokers...),
+			Topic:                  cfg.DeadLetterTopic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Handle registers h for events of eventType, replacing any earlier handler.
+func (c *Consumer) Handle(eventType string, h Handler) {
+	c.handlers[eventType] = h
+}
+
+// On registers fn for events of eventType, decoding the payload into T.
+// Payloads that do not decode are logged and skipped.
+func On[T any](c *Consumer, eventType string, fn func(ctx context.Context, tenantID string, evt T) error) {
+	c.Handle(eventType, func(ctx context.Context, env Envelope) error {
+		var evt T
+		if err := json.Unmarshal(env.Payload, &evt); err != nil {
+			log.Printf("❌ Skipping malformed %s event %s: %v", env.EventType, env.EventID, err)
+			return nil
+		}
+		return fn(ctx, env.TenantID, evt)
+	})
+}
+
+// Start consumes events until ctx is cancelled. It should be run in a
+// goroutine.
+func (c *Consumer) Start(ctx context.Context) {
+	log.Printf("🚀 Starting Kafka Consumer for %s...", c.cfg.Topic)
+	defer func() {
+		if err := c.reader.Close(); err != nil {
+			log.Printf("Failed to close Kafka reader: %v", err)
+		}
+		if err := c.deadLetter.Close(); err != nil {
+			log.Printf("Failed to close Kafka dead-letter writer: %v", err)
+		}
+	}()
+
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("⚠️ Error reading %s: %v", c.cfg.Topic, err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		if !c.process(ctx, m) {
+			return
+		}
+
+		if err := c.reader.CommitMessages(ctx, m); err != nil && ctx.Err() == nil {
+			log.Printf("⚠️ Failed to commit %s offset %d: %v", c.cfg.Topic, m.Offset, err)
+		}
+	}
+}
+
+// process handles m, retrying failures with backoff and dead-lettering the
+// message after MaxAttempts. It returns false only when ctx was cancelled
+// before m was dealt with, in which case its offset must not be committed.
+func (c *Consumer) process(ctx context.Context, m kafka.Message) bool {
+	backoff := c.cfg.RetryInitial
+	for attempt := 1; ; attempt++ {
+		err := c.handleMessage(ctx, m)
+		if err == nil {
+			return true
+		}
+		if attempt >= c.cfg.MaxAttempts {
+			log.Printf("❌ Giving up on %s offset %d after %d attempts: %v", c.cfg.Topic, m.Offset, attempt, err)
+			c.sendToDeadLetter(ctx, m, attempt, err)
+			return ctx.Err() == nil
+		}
+		log.Printf("❌ Failed to apply %s offset %d (attempt %d/%d), retrying in %s: %v", c.cfg.Topic, m.Offset, attempt, c.cfg.MaxAttempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, c.cfg.RetryMax)
+	}
+}
+
+// handleMessage decodes the envelope and runs its handler. Malformed, unknown
+// and other-version events are logged and skipped; only failures worth
+// retrying are returned.
+func (c *Consumer) handleMessage(ctx context.Context, m kafka.Message) error {
+	env, err := c.cfg.Decode(m.Value)
+	if err != nil {
+		log.Printf("❌ Skipping malformed event on %s at offset %d: %v", c.cfg.Topic, m.Offset, err)
+		return nil
+	}
+	if env.SchemaVersion != c.cfg.SchemaVersion {
+		log.Printf("⚠️ Skipping %s event %s with schema version %d", env.EventType, env.EventID, env.SchemaVersion)
+		return nil
+	}
+
+	h, ok := c.handlers[env.EventType]
+	if !ok {
+		return nil
+	}
+	return h(ctx, env)
+}
+
+func decodeEnvelope(value []byte) (Envelope, error) {
+	var env Envelope
+	err := json.Unmarshal(value, &env)
+	return env, err
+}
+
+// sendToDeadLetter copies m to the dead-letter topic with the failure in its
+// headers. When that write fails too the message is logged in full, as its
+// offset is committed either way.
+func (c *Consumer) sendToDeadLetter(ctx context.Context, m kafka.Message, attempts int, cause error) {
+	headers := append([]kafka.Header{}, m.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: "dlq-source-topic", Value: []byte(m.Topic)},
+		kafka.Header{Key: "dlq-source-partition", Value: []byte(strconv.Itoa(m.Partition))},
+		kafka.Header{Key: "dlq-source-offset", Value: []byte(strconv.FormatInt(m.Offset, 10))},
+		kafka.Header{Key: "dlq-attempts", Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: "dlq-error", Value: []byte(cause.Error())},
+	)
+
+	err := c.deadLetter.WriteMessages(ctx, kafka.Message{Key: m.Key, Value: m.Value, Headers: headers})
+	if err != nil {
+		log.Printf("❌ Failed to dead-letter %s offset %d to %s, dropping it: %v; message: %s", c.cfg.Topic, m.Offset, c.cfg.DeadLetterTopic, err, m.Value)
+		return
+	}
+	log.Printf("📮 %s offset %d moved to %s", c.cfg.Topic, m.Offset, c.cfg.DeadLetterTopic)
+}
@@ -0,0 +1,242 @@
+package fieldcrypt
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// DefaultBatchSize is the number of rows a backfill updates per transaction
+// when BackfillOptions.BatchSize is not set.
+const DefaultBatchSize = 500
+
+// Table lists the encrypted columns of a table. Key is its primary key column.
+type Table struct {
+	Name    string
+	Key     string
+	Columns []string
+}
+
+// IndexTable names a column whose blind index is kept in IndexColumn.
+type IndexTable struct {
+	Name        string
+	Key         string
+	Column      string
+	IndexColumn string
+}
+
+// BackfillOptions controls a backfill run.
+type BackfillOptions struct {
+	// BatchSize is the number of rows locked and updated per transaction.
+	BatchSize int
+	// DryRun reads and encrypts every pending row but writes nothing, so a
+	// run can be checked against the configured keys before it changes data.
+	DryRun bool
+}
+
+func (o BackfillOptions) batchSize() int {
+	if o.BatchSize <= 0 {
+		return DefaultBatchSize
+	}
+	return o.BatchSize
+}
+
+// BackfillResult counts the rows a backfill changed, or would have changed
+// in a dry run.
+type BackfillResult struct {
+	Rows    int
+	Batches int
+}
+
+// Backfill encrypts the legacy plaintext values of t's columns. Rows are
+// processed in key order, BatchSize rows per transaction, so a large table is
+// never locked as a whole and an interrupted run resumes where it stopped.
+// It is idempotent: encrypted values are left untouched.
+func Backfill(ctx context.Context, db *sql.DB, e *Encryptor, t Table, opts BackfillOptions) (BackfillResult, error) {
+	var pending, sets []string
+	for i, c := range t.Columns {
+		pending = append(pending, fmt.Sprintf("(%s IS NOT NULL AND %s <> '' AND %s NOT LIKE '%s%%')", c, c, c, prefix))
+		sets = append(sets, fmt.Sprintf("%s = $%d", c, i+2))
+	}
+	b := batcher{
+		db:      db,
+		table:   t.Name,
+		key:     t.Key,
+		columns: t.Columns,
+		pending: strings.Join(pending, " OR "),
+		update:  fmt.Sprintf("UPDATE %s SET %s WHERE %s = $1", t.Name, strings.Join(sets, ", "), t.Key),
+	}
+
+	return b.run(ctx, opts, func(values []sql.NullString) ([]any, error) {
+		args := make([]any, len(values))
+		for i, v := range values {
+			if !v.Valid {
+				continue
+			}
+			enc, err := e.Encrypt(ctx, v.String)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = enc
+		}
+		return args, nil
+	})
+}
+
+// BackfillIndex fills t's blind index column where it is missing, decrypting
+// the indexed column first if it is already encrypted.
+func BackfillIndex(ctx context.Context, db *sql.DB, e *Encryptor, t IndexTable, opts BackfillOptions) (BackfillResult, error) {
+	b := batcher{
+		db:      db,
+		table:   t.Name,
+		key:     t.Key,
+		columns: []string{t.Column},
+		pending: fmt.Sprintf("%s IS NULL AND %s IS NOT NULL AND %s <> ''", t.IndexColumn, t.Column, t.Column),
+		update:  fmt.Sprintf("UPDATE %s SET %s = $2 WHERE %s = $1", t.Name, t.IndexColumn, t.Key),
+	}
+
+	return b.run(ctx, opts, func(values []sql.NullString) ([]any, error) {
+		plaintext, err := e.Decrypt(ctx, values[0].String)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := e.BlindIndex(ctx, plaintext)
+		if err != nil {
+			return nil, err
+		}
+		return []any{hash}, nil
+	})
+}
+
+// BackfillAll encrypts every table, then fills every blind index, logging
+// the outcome of each. It stops at the first failure; already committed
+// batches stay committed and a re-run picks up the remaining rows.
+func BackfillAll(ctx context.Context, db *sql.DB, e *Encryptor, tables []Table, indexes []IndexTable, opts BackfillOptions) error {
+	verb := "encrypted"
+	if opts.DryRun {
+		verb = "would encrypt"
+		log.Println("🧪 Dry run: no rows will be changed")
+	}
+	for _, t := range tables {
+		res, err := Backfill(ctx, db, e, t, opts)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt %s: %w", t.Name, err)
+		}
+		log.Printf("✅ %s: %s %d rows in %d batches", t.Name, verb, res.Rows, res.Batches)
+	}
+
+	verb = "indexed"
+	if opts.DryRun {
+		verb = "would index"
+	}
+	for _, t := range indexes {
+		res, err := BackfillIndex(ctx, db, e, t, opts)
+		if err != nil {
+			return fmt.Errorf("failed to index %s.%s: %w", t.Name, t.Column, err)
+		}
+		log.Printf("✅ %s: %s %d %s values in %d batches", t.Name, verb, res.Rows, t.Column, res.Batches)
+	}
+	return nil
+}
+
+// batcher walks the rows of a table matching pending in key order and
+// rewrites them with update, whose first argument is the row key.
+type batcher struct {
+	db      *sql.DB
+	table   string
+	key     string
+	columns []string
+	pending string
+	update  string
+}
+
+type pendingRow struct {
+	key    string
+	values []sql.NullString
+}
+
+func (b batcher) run(ctx context.Context, opts BackfillOptions, transform func([]sql.NullString) ([]any, error)) (BackfillResult, error) {
+	var result BackfillResult
+	after := ""
+	for {
+		n, last, err := b.batch(ctx, opts, after, transform)
+		if err != nil {
+			return result, fmt.Errorf("%s: batch after %q: %w", b.table, after, err)
+		}
+		if n == 0 {
+			return result, nil
+		}
+		result.Rows += n
+		result.Batches++
+		after = last
+	}
+}
+
+// batch processes up to BatchSize pending rows with a key greater than after
+// in one transaction and returns how many it processed and the last key seen.
+func (b batcher) batch(ctx context.Context, opts BackfillOptions, after string, transform func([]sql.NullString) ([]any, error)) (int, string, error) {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("SELECT %s::text, %s FROM %s WHERE (%s)", b.key, strings.Join(b.columns, ", "), b.table, b.pending)
+	args := []any{}
+	if after != "" {
+		query += fmt.Sprintf(" AND %s > $1", b.key)
+		args = append(args, after)
+	}
+	query += fmt.Sprintf(" ORDER BY %s LIMIT %d", b.key, opts.batchSize())
+	if !opts.DryRun {
+		query += " FOR UPDATE"
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to select rows: %w", err)
+	}
+
+	var batch []pendingRow
+	for rows.Next() {
+		r := pendingRow{values: make([]sql.NullString, len(b.columns))}
+		dest := []any{&r.key}
+		for i := range r.values {
+			dest = append(dest, &r.values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			rows.Close()
+			return 0, "", fmt.Errorf("failed to scan row: %w", err)
+		}
+		batch = append(batch, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, "", err
+	}
+	if len(batch) == 0 {
+		return 0, "", nil
+	}
+
+	for _, r := range batch {
+		values, err := transform(r.values)
+		if err != nil {
+			return 0, "", fmt.Errorf("row %s: %w", r.key, err)
+		}
+		if opts.DryRun {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, b.update, append([]any{r.key}, values...)...); err != nil {
+			return 0, "", fmt.Errorf("failed to update row %s: %w", r.key, err)
+		}
+	}
+
+	if !opts.DryRun {
+		if err := tx.Commit(); err != nil {
+			return 0, "", fmt.Errorf("failed to commit: %w", err)
+		}
+	}
+	return len(batch), batch[len(batch)-1].key, nil
+}
@@ -0,0 +1,3 @@
+module github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt
+
+go 1.22
@@ -0,0 +1,198 @@
+package fieldcrypt
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// KeyManager issues and unwraps data encryption keys (DEKs).
+// Implementations back onto a KMS (AWS KMS, GCP KMS, Vault transit, ...);
+// LocalKeyManager is provided for development and tests.
+type KeyManager interface {
+	// GenerateDataKey returns a fresh plaintext DEK, the same key wrapped by
+	// the master key, and the identifier of the master key used.
+	GenerateDataKey(ctx context.Context) (plaintext, wrapped []byte, keyID string, err error)
+	// DecryptDataKey unwraps a DEK previously produced by GenerateDataKey.
+	DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+	// IndexKey returns the HMAC key used for blind indexes. It must stay the
+	// same across master key rotations, otherwise existing indexes stop matching.
+	IndexKey(ctx context.Context) ([]byte, error)
+}
+
+const dataKeySize = 32
+
+// devMasterKeySeed derives the master key used when no key file is configured.
+// It is NOT secret and must never be used outside local development.
+const devMasterKeySeed = "nhit-dev-field-encryption-key"
+
+// ErrUnknownKey is returned when a value was wrapped by a master key the
+// key manager does not hold.
+var ErrUnknownKey = errors.New("fieldcrypt: unknown master key")
+
+// LocalKeyManager wraps DEKs with AES-256-GCM master keys held in memory.
+// The first key is used for new data; the remaining keys are kept so that
+// values wrapped before a rotation can still be read. The blind index key is
+// derived from the last (oldest) key, so rotate by prepending new keys.
+type LocalKeyManager struct {
+	activeID string
+	keys     map[string][]byte
+	indexKey []byte
+}
+
+// NewLocalKeyManager builds a key manager from raw 32-byte master keys.
+// The first key becomes the active key.
+func NewLocalKeyManager(masterKeys ...[]byte) (*LocalKeyManager, error) {
+	if len(masterKeys) == 0 {
+		return nil, errors.New("fieldcrypt: at least one master key is required")
+	}
+
+	km := &LocalKeyManager{keys: make(map[string][]byte, len(masterKeys))}
+	for i, key := range masterKeys {
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("fieldcrypt: master key %d must be %d bytes, got %d", i, dataKeySize, len(key))
+		}
+		id := keyIDFor(key)
+		km.keys[id] = key
+		if i == 0 {
+			km.activeID = id
+		}
+	}
+
+	mac := hmac.New(sha256.New, masterKeys[len(masterKeys)-1])
+	mac.Write([]byte("fieldcrypt-blind-index"))
+	km.indexKey = mac.Sum(nil)
+	return km, nil
+}
+
+// LoadLocalKeyManager reads master keys from a file containing one base64 or
+// hex encoded 32-byte key per line. Blank lines and lines starting with '#'
+// are ignored. The first key is the active key.
+func LoadLocalKeyManager(path string) (*LocalKeyManager, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	var keys [][]byte
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := decodeKey(line)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return NewLocalKeyManager(keys...)
+}
+
+// NewLocalKeyManagerFromEnv loads the key file named by FIELD_ENCRYPTION_KEY_FILE.
+// When the variable is unset it falls back to a fixed development key and
+// logs a warning.
+func NewLocalKeyManagerFromEnv() (*LocalKeyManager, error) {
+	if path := os.Getenv("FIELD_ENCRYPTION_KEY_FILE"); path != "" {
+		return LoadLocalKeyManager(path)
+	}
+
+	log.Println("⚠️  FIELD_ENCRYPTION_KEY_FILE not set, using development field encryption key")
+	devKey := sha256.Sum256([]byte(devMasterKeySeed))
+	return NewLocalKeyManager(devKey[:])
+}
+
+// GenerateDataKey implements KeyManager.
+func (m *LocalKeyManager) GenerateDataKey(ctx context.Context) ([]byte, []byte, string, error) {
+	dek := make([]byte, dataKeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, nil, "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	wrapped, err := seal(m.keys[m.activeID], dek)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	return dek, wrapped, m.activeID, nil
+}
+
+// DecryptDataKey implements KeyManager.
+func (m *LocalKeyManager) DecryptDataKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := m.keys[keyID]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	dek, err := open(key, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	return dek, nil
+}
+
+// IndexKey implements KeyManager.
+func (m *LocalKeyManager) IndexKey(ctx context.Context) ([]byte, error) {
+	return m.indexKey, nil
+}
+
+func keyIDFor(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+func decodeKey(s string) ([]byte, error) {
+	if key, err := base64.StdEncoding.DecodeString(s); err == nil && len(key) == dataKeySize {
+		return key, nil
+	}
+	if key, err := hex.DecodeString(s); err == nil && len(key) == dataKeySize {
+		return key, nil
+	}
+	return nil, fmt.Errorf("fieldcrypt: master key must be a base64 or hex encoded %d-byte value", dataKeySize)
+}
+
+// seal encrypts plaintext with AES-GCM and returns nonce||ciphertext.
+func seal(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open reverses seal.
+func open(key, sealed []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
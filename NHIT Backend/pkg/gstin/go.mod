@@ -0,0 +1,3 @@
+module github.com/ShristiRnr/NHIT_Backend/pkg/gstin
+
+go 1.22
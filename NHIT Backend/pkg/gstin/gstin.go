@@ -0,0 +1,127 @@
+// Package gstin validates GST identification numbers and maps GST state codes
+// to state names.
+//
+// A GSTIN is a 2-digit state code, the 10-character PAN of the holder, an
+// entity code (registration number under the same PAN), the fixed letter Z
+// and a mod-36 check character.
+package gstin
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var gstinRegex = regexp.MustCompile(`^[0-9]{2}[A-Z]{5}[0-9]{4}[A-Z][0-9A-Z]Z[0-9A-Z]$`)
+
+const gstinCharset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var (
+	ErrInvalidFormat     = errors.New("invalid GSTIN format")
+	ErrInvalidStateCode  = errors.New("invalid GSTIN state code")
+	ErrInvalidEntityCode = errors.New("invalid GSTIN entity code")
+	ErrInvalidChecksum   = errors.New("invalid GSTIN checksum")
+)
+
+// stateCodes maps GST state codes to state / union territory names
+var stateCodes = map[string]string{
+	"01": "Jammu and Kashmir",
+	"02": "Himachal Pradesh",
+	"03": "Punjab",
+	"04": "Chandigarh",
+	"05": "Uttarakhand",
+	"06": "Haryana",
+	"07": "Delhi",
+	"08": "Rajasthan",
+	"09": "Uttar Pradesh",
+	"10": "Bihar",
+	"11": "Sikkim",
+	"12": "Arunachal Pradesh",
+	"13": "Nagaland",
+	"14": "Manipur",
+	"15": "Mizoram",
+	"16": "Tripura",
+	"17": "Meghalaya",
+	"18": "Assam",
+	"19": "West Bengal",
+	"20": "Jharkhand",
+	"21": "Odisha",
+	"22": "Chhattisgarh",
+	"23": "Madhya Pradesh",
+	"24": "Gujarat",
+	"25": "Daman and Diu",
+	"26": "Dadra and Nagar Haveli and Daman and Diu",
+	"27": "Maharashtra",
+	"28": "Andhra Pradesh (Old)",
+	"29": "Karnataka",
+	"30": "Goa",
+	"31": "Lakshadweep",
+	"32": "Kerala",
+	"33": "Tamil Nadu",
+	"34": "Puducherry",
+	"35": "Andaman and Nicobar Islands",
+	"36": "Telangana",
+	"37": "Andhra Pradesh",
+	"38": "Ladakh",
+	"97": "Other Territory",
+	"99": "Centre Jurisdiction",
+}
+
+// Normalize upper-cases a GSTIN and strips surrounding whitespace
+func Normalize(gstin string) string {
+	return strings.ToUpper(strings.TrimSpace(gstin))
+}
+
+// Validate checks format, state code, entity code and check character of a
+// normalized GSTIN
+func Validate(gstin string) error {
+	if !gstinRegex.MatchString(gstin) {
+		return ErrInvalidFormat
+	}
+	if _, ok := stateCodes[gstin[:2]]; !ok {
+		return ErrInvalidStateCode
+	}
+	if gstin[12] == '0' {
+		return ErrInvalidEntityCode
+	}
+	if checkChar(gstin[:14]) != gstin[14] {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// PAN returns the PAN embedded in a valid GSTIN
+func PAN(gstin string) string {
+	return gstin[2:12]
+}
+
+// StateName returns the state or union territory of a GST state code
+func StateName(code string) (string, bool) {
+	name, ok := stateCodes[code]
+	return name, ok
+}
+
+// State returns the state name encoded in a GSTIN's first two digits
+func State(gstin string) (string, bool) {
+	gstin = Normalize(gstin)
+	if len(gstin) < 2 {
+		return "", false
+	}
+	return StateName(gstin[:2])
+}
+
+// checkChar computes the GSTIN check character over the first 14
+// characters: alternate weights 1 and 2, fold each product to base 36 and
+// take the complement of the sum modulo 36.
+func checkChar(body string) byte {
+	sum := 0
+	for i := 0; i < len(body); i++ {
+		factor := 1
+		if i%2 == 1 {
+			factor = 2
+		}
+		product := strings.IndexByte(gstinCharset, body[i]) * factor
+		sum += product/36 + product%36
+	}
+	return gstinCharset[(36-sum%36)%36]
+}
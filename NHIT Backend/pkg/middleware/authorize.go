@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
+)
+
+// departmentScoped is implemented by requests that name a department; with
+// projectScoped it gives the resource a call claims to act on
+type departmentScoped interface {
+	GetDepartmentId() string
+}
+
+// DecisionRecord is one authorization decision, logged whether allowed or denied
+type DecisionRecord struct {
+	UserID   string
+	Method   string // gRPC method, or the action for checks made by services
+	Resource policy.Resource
+	Decision policy.Decision
+}
+
+// DecisionLogger receives every decision of the interceptor and of Authorize,
+// e.g. to ship decisions to an audit store
+type DecisionLogger func(DecisionRecord)
+
+// PrintDecision is the default DecisionLogger; it prints decisions to stdout
+func PrintDecision(r DecisionRecord) {
+	if r.Decision.Allowed {
+		fmt.Printf("✅ Access granted: User %s %s on %s, %s\n",
+			r.UserID, r.Method, r.Resource, r.Decision.Reason)
+		return
+	}
+	fmt.Printf("⚠️  Access denied: User %s %s on %s, %s\n",
+		r.UserID, r.Method, r.Resource, r.Decision.Reason)
+}
+
+// LogDecision passes a decision made by a service to the logger the
+// interceptor was configured with
+func LogDecision(ctx context.Context, r DecisionRecord) {
+	logger, ok := ctx.Value("decision_logger").(DecisionLogger)
+	if !ok || logger == nil {
+		logger = PrintDecision
+	}
+	logger(r)
+}
+
+// requestResource returns the resource a request claims to act on. The
+// organization is always the one the token was issued for; an org_id in the
+// request never changes it. Project and department come from the request, so
+// they only admit the call: handlers check the stored resource with Authorize
+// before acting on it.
+func requestResource(req interface{}, tokenOrgID string) policy.Resource {
+	res := policy.Resource{OrgID: tokenOrgID}
+	if r, ok := req.(projectScoped); ok {
+		res.ProjectID = r.GetProjectId()
+	}
+	if r, ok := req.(departmentScoped); ok {
+		res.DepartmentID = r.GetDepartmentId()
+	}
+	return res
+}
+
+// newSubject builds the policy subject of a validated token: role permissions
+// first, then scoped role assignments, then delegations
+func newSubject(userID string, roles, permissions []string, scoped []*authpb.ScopedGrant, delegations []*authpb.DelegatedGrant) policy.Subject {
+	sub := policy.Subject{UserID: userID, Roles: roles}
+	if len(permissions) > 0 {
+		sub.Grants = append(sub.Grants, policy.Grant{
+			Source:      policy.SourceRole,
+			Permissions: permissions,
+		})
+	}
+	for _, g := range scoped {
+		sub.Grants = append(sub.Grants, policy.Grant{
+			Source:       policy.SourceScopedRole,
+			SourceID:     g.RoleName + " (" + g.AssignmentId + ")",
+			Permissions:  g.Permissions,
+			OrgID:        g.OrgId,
+			ProjectID:    g.ProjectId,
+			DepartmentID: g.DepartmentId,
+		})
+	}
+	for _, g := range delegations {
+		sub.Grants = append(sub.Grants, delegationGrant(g))
+	}
+	return sub
+}
+
+// SubjectFromContext returns the policy subject of the caller
+func SubjectFromContext(ctx context.Context) policy.Subject {
+	userID, _ := ctx.Value("user_id").(string)
+	roles, _ := ctx.Value("roles").([]string)
+	perms, _ := ctx.Value("permissions").([]string)
+	return newSubject(userID, roles, perms, GetScopedGrantsFromContext(ctx), GetDelegationsFromContext(ctx))
+}
+
+// GetScopedGrantsFromContext returns the caller's scoped role assignments
+func GetScopedGrantsFromContext(ctx context.Context) []*authpb.ScopedGrant {
+	grants, _ := ctx.Value("scoped_grants").([]*authpb.ScopedGrant)
+	return grants
+}
+
+// Authorize decides whether the caller may perform any of the actions on a
+// stored resource and logs the decision. res comes from the loaded record,
+// never from request fields. An empty organization means the caller's; a
+// resource in another organization than the token's is denied to all but
+// SUPER_ADMIN.
+func Authorize(ctx context.Context, res policy.Resource, actions ...string) policy.Decision {
+	orgID, _ := GetOrgIDFromContext(ctx)
+	if res.OrgID == "" {
+		res.OrgID = orgID
+	}
+	sub := SubjectFromContext(ctx)
+	var decision policy.Decision
+	if res.OrgID != orgID && !sub.SuperAdmin() {
+		decision = policy.Decision{
+			Action: strings.Join(actions, ","),
+			Reason: fmt.Sprintf("resource is outside the token's organization %q", orgID),
+		}
+	} else {
+		decision = policy.Evaluate(sub, actions, res)
+	}
+	LogDecision(ctx, DecisionRecord{UserID: sub.UserID, Method: strings.Join(actions, ","), Resource: res, Decision: decision})
+	return decision
+}
+
+// Can reports whether the caller may perform any of the actions on a stored resource
+func Can(ctx context.Context, res policy.Resource, actions ...string) bool {
+	return Authorize(ctx, res, actions...).Allowed
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -48,3 +49,14 @@ func GetUserNameFromContext(ctx context.Context) (string, error) {
 	}
 	return name, nil
 }
+
+// HasPermission reports whether the caller holds the given permission in the
+// caller's organization: through roles, a role assigned for the organization,
+// or a delegation not limited to a project. Project and department assignments
+// need Can. SUPER_ADMIN implicitly holds every
+// permission, mirroring the interceptor. The check is not logged; use Authorize
+// for decisions that belong in the decision log.
+func HasPermission(ctx context.Context, permission string) bool {
+	orgID, _ := GetOrgIDFromContext(ctx)
+	return policy.Evaluate(SubjectFromContext(ctx), []string{permission}, policy.Resource{OrgID: orgID}).Allowed
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
+)
+
+// projectScoped is implemented by requests that name a project; project
+// limited delegations apply only to such requests
+type projectScoped interface {
+	GetProjectId() string
+}
+
+// delegationGrant returns the policy grant of a delegation
+func delegationGrant(grant *authpb.DelegatedGrant) policy.Grant {
+	return policy.Grant{
+		Source:      policy.SourceDelegation,
+		SourceID:    grant.DelegationId,
+		Permissions: grant.Permissions,
+		OrgID:       grant.OrgId,
+		ProjectID:   grant.ProjectId,
+		OnBehalfOf:  grant.DelegatorId,
+	}
+}
+
+// findDelegation returns the delegation with the given ID
+func findDelegation(grants []*authpb.DelegatedGrant, delegationID string) *authpb.DelegatedGrant {
+	for _, grant := range grants {
+		if grant.DelegationId == delegationID {
+			return grant
+		}
+	}
+	return nil
+}
+
+// GetDelegationsFromContext returns the approval delegations in force for the caller
+func GetDelegationsFromContext(ctx context.Context) []*authpb.DelegatedGrant {
+	grants, _ := ctx.Value("delegations").([]*authpb.DelegatedGrant)
+	return grants
+}
+
+// GetOnBehalfOfFromContext returns the delegation the interceptor admitted the
+// call under, when the caller lacked the method's permissions themselves
+func GetOnBehalfOfFromContext(ctx context.Context) (*authpb.DelegatedGrant, bool) {
+	grant, ok := ctx.Value("on_behalf_of").(*authpb.DelegatedGrant)
+	return grant, ok && grant != nil
+}
@@ -0,0 +1,148 @@
+// Package policy decides whether a user may perform an action on a resource.
+//
+// A user holds grants: sets of permissions valid within a scope of
+// organization, project and department. Role permissions carried by the token
+// are unscoped, scoped role assignments are limited to one organization,
+// project or department, and delegations are limited to what the delegator
+// chose. The interceptor and services evaluate the same grants, so a check in
+// a handler agrees with the check made before it ran.
+package policy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sources of a grant
+const (
+	SourceRole       = "role"
+	SourceScopedRole = "scoped-role"
+	SourceDelegation = "delegation"
+)
+
+// SuperAdminRole holds every permission on every resource
+const SuperAdminRole = "SUPER_ADMIN"
+
+// Resource holds the attributes of what an action is performed on. Empty
+// attributes are unknown.
+type Resource struct {
+	OrgID        string
+	ProjectID    string
+	DepartmentID string
+}
+
+func (r Resource) String() string {
+	var parts []string
+	if r.OrgID != "" {
+		parts = append(parts, "org="+r.OrgID)
+	}
+	if r.ProjectID != "" {
+		parts = append(parts, "project="+r.ProjectID)
+	}
+	if r.DepartmentID != "" {
+		parts = append(parts, "department="+r.DepartmentID)
+	}
+	if len(parts) == 0 {
+		return "{}"
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// Grant is a set of permissions valid within a scope. An empty scope attribute
+// matches any resource; a set one matches only resources with that attribute,
+// so a project grant never applies to a request that names no project.
+type Grant struct {
+	Source       string
+	SourceID     string // role name, scoped assignment ID or delegation ID
+	Permissions  []string
+	OrgID        string
+	ProjectID    string
+	DepartmentID string
+	OnBehalfOf   string // delegator of a delegation grant
+}
+
+// Scoped reports whether the grant is limited to a project or department
+func (g Grant) Scoped() bool {
+	return g.ProjectID != "" || g.DepartmentID != ""
+}
+
+// Covers reports whether the grant allows the action on the resource
+func (g Grant) Covers(action string, res Resource) bool {
+	if g.OrgID != "" && g.OrgID != res.OrgID {
+		return false
+	}
+	if g.ProjectID != "" && g.ProjectID != res.ProjectID {
+		return false
+	}
+	if g.DepartmentID != "" && g.DepartmentID != res.DepartmentID {
+		return false
+	}
+	for _, p := range g.Permissions {
+		if p == action {
+			return true
+		}
+	}
+	return false
+}
+
+func (g Grant) describe() string {
+	desc := g.Source
+	if g.SourceID != "" {
+		desc += " " + g.SourceID
+	}
+	if g.OnBehalfOf != "" {
+		desc += " on behalf of " + g.OnBehalfOf
+	}
+	return desc
+}
+
+// Subject is the user a decision is made for
+type Subject struct {
+	UserID string
+	Roles  []string
+	Grants []Grant
+}
+
+// SuperAdmin reports whether the subject holds the SUPER_ADMIN role
+func (s Subject) SuperAdmin() bool {
+	for _, r := range s.Roles {
+		if r == SuperAdminRole {
+			return true
+		}
+	}
+	return false
+}
+
+// Decision is the outcome of evaluating a subject's grants
+type Decision struct {
+	Allowed bool
+	Action  string // the action allowed, or the actions asked for when denied
+	Grant   *Grant // the grant that allowed the action; nil for SUPER_ADMIN
+	Reason  string
+}
+
+// Evaluate decides whether the subject may perform any of the actions on the
+// resource. Grants are tried in order, so callers list the user's own grants
+// before delegations to prefer acting in their own right.
+func Evaluate(sub Subject, actions []string, res Resource) Decision {
+	if sub.SuperAdmin() {
+		return Decision{Allowed: true, Action: strings.Join(actions, ","), Reason: "super admin"}
+	}
+	for i := range sub.Grants {
+		grant := &sub.Grants[i]
+		for _, action := range actions {
+			if grant.Covers(action, res) {
+				return Decision{
+					Allowed: true,
+					Action:  action,
+					Grant:   grant,
+					Reason:  "granted by " + grant.describe(),
+				}
+			}
+		}
+	}
+	return Decision{
+		Action: strings.Join(actions, ","),
+		Reason: fmt.Sprintf("no grant covers %v on %s", actions, res),
+	}
+}
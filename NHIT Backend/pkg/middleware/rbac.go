@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -17,6 +18,7 @@ type RBACInterceptor struct {
 	authClient    authpb.AuthServiceClient
 	permissionMap map[string][]string
 	publicMethods map[string]bool
+	decisionLog   DecisionLogger
 }
 
 // NewRBACInterceptor creates a new RBAC interceptor
@@ -25,9 +27,16 @@ func NewRBACInterceptor(authClient authpb.AuthServiceClient) *RBACInterceptor {
 		authClient:    authClient,
 		permissionMap: make(map[string][]string),
 		publicMethods: make(map[string]bool),
+		decisionLog:   PrintDecision,
 	}
 }
 
+// SetDecisionLogger replaces the logger that receives the interceptor's
+// decisions and those of Authorize in the handlers it admits
+func (i *RBACInterceptor) SetDecisionLogger(logger DecisionLogger) {
+	i.decisionLog = logger
+}
+
 // RegisterPermissions registers required permissions for a method
 func (i *RBACInterceptor) RegisterPermissions(method string, permissions []string) {
 	i.permissionMap[method] = permissions
@@ -76,28 +85,33 @@ func (i *RBACInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
 		}
 
-		// Check if user is SUPER_ADMIN (bypass permission checks)
-		isSuperAdmin := false
-		for _, role := range validation.Roles {
-			if role == "SUPER_ADMIN" {
-				isSuperAdmin = true
-				break
+		// Evaluate the method's permissions against the caller's grants on the
+		// resource the request names. SUPER_ADMIN bypasses the check; a caller
+		// without the permissions may hold them for the organization, project
+		// or department, or act on behalf of a user who delegated them.
+		var onBehalfOf *authpb.DelegatedGrant
+		requiredPerms, hasPermissions := i.permissionMap[info.FullMethod]
+		if hasPermissions && len(requiredPerms) > 0 {
+			sub := newSubject(validation.UserId, validation.Roles, validation.Permissions, validation.ScopedGrants, validation.Delegations)
+			res := requestResource(req, validation.OrgId)
+			decision := policy.Evaluate(sub, requiredPerms, res)
+			i.decisionLog(DecisionRecord{UserID: validation.UserId, Method: info.FullMethod, Resource: res, Decision: decision})
+			if !decision.Allowed {
+				return nil, status.Errorf(codes.PermissionDenied,
+					"insufficient permissions. Required: %v", requiredPerms)
 			}
-		}
-
-		// Check permissions if not super admin
-		if !isSuperAdmin {
-			requiredPerms, hasPermissions := i.permissionMap[info.FullMethod]
-			if hasPermissions && len(requiredPerms) > 0 {
-				if !i.hasRequiredPermissions(validation.Permissions, requiredPerms) {
-					return nil, status.Errorf(codes.PermissionDenied, 
-						"insufficient permissions. Required: %v", requiredPerms)
-				}
+			if decision.Grant != nil && decision.Grant.Source == policy.SourceDelegation {
+				onBehalfOf = findDelegation(validation.Delegations, decision.Grant.SourceID)
+				fmt.Printf("🤝 User %s calling %s on behalf of %s (delegation %s)\n",
+					validation.UserId, info.FullMethod, onBehalfOf.DelegatorId, onBehalfOf.DelegationId)
 			}
 		}
 
 		// Add user context
 		ctx = i.addUserContext(ctx, validation)
+		if onBehalfOf != nil {
+			ctx = context.WithValue(ctx, "on_behalf_of", onBehalfOf)
+		}
 
 		// Call handler
 		return handler(ctx, req)
@@ -118,22 +132,6 @@ func extractToken(md metadata.MD) string {
 	return token
 }
 
-// hasRequiredPermissions checks if user has at least one of the required permissions
-func (i *RBACInterceptor) hasRequiredPermissions(userPerms, requiredPerms []string) bool {
-	permSet := make(map[string]bool)
-	for _, p := range userPerms {
-		permSet[p] = true
-	}
-
-	for _, req := range requiredPerms {
-		if permSet[req] {
-			return true // User has this permission
-		}
-	}
-
-	return false // User doesn't have any required permission
-}
-
 // addUserContext adds user information to context
 func (i *RBACInterceptor) addUserContext(ctx context.Context, validation *authpb.ValidateTokenResponse) context.Context {
 	ctx = context.WithValue(ctx, "user_id", validation.UserId)
@@ -148,6 +146,9 @@ func (i *RBACInterceptor) addUserContext(ctx context.Context, validation *authpb
 	// Store roles and permissions in context for additional checks
 	ctx = context.WithValue(ctx, "roles", validation.Roles)
 	ctx = context.WithValue(ctx, "permissions", validation.Permissions)
+	ctx = context.WithValue(ctx, "scoped_grants", validation.ScopedGrants)
+	ctx = context.WithValue(ctx, "delegations", validation.Delegations)
+	ctx = context.WithValue(ctx, "decision_logger", i.decisionLog)
 	
 	return ctx
 }
@@ -0,0 +1,3 @@
+module github.com/ShristiRnr/NHIT_Backend/pkg/money
+
+go 1.22
@@ -0,0 +1,387 @@
+// Package money provides an exact decimal type for rupee amounts.
+//
+// An Amount holds a whole number of paise, the precision of the DECIMAL(20,2)
+// columns money is stored in, so additions and subtractions are exact.
+// Multiplying by a rate (TDS, GST) is done on big.Rat and brought back to
+// paise with an explicit RoundingMode.
+//
+// Amounts travel as decimal strings ("1234.50") in SQL and JSON, and as
+// units plus nanos (like google.type.Money) in protos. FromFloat exists only
+// for clients and columns that still carry float64.
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of decimal places an Amount keeps.
+const Scale = 2
+
+const (
+	paisePerRupee = 100
+	nanosPerPaisa = 10_000_000
+	nanosPerUnit  = 1_000_000_000
+)
+
+var (
+	// ErrInvalid is returned for strings and values that are not a decimal amount.
+	ErrInvalid = errors.New("money: invalid amount")
+	// ErrPrecision is returned by Parse when the value has more than two
+	// significant decimal places; use ParseRound to round instead.
+	ErrPrecision = errors.New("money: more than 2 decimal places")
+	// ErrOverflow is returned when a value does not fit in an Amount.
+	ErrOverflow = errors.New("money: amount out of range")
+)
+
+// Amount is an exact rupee amount. The zero value is ₹0.00.
+type Amount struct {
+	paise int64
+}
+
+// Zero is ₹0.00.
+var Zero = Amount{}
+
+// FromPaise returns an Amount of p paise.
+func FromPaise(p int64) Amount {
+	return Amount{paise: p}
+}
+
+// FromRupees returns an Amount of r whole rupees.
+func FromRupees(r int64) Amount {
+	return Amount{paise: r * paisePerRupee}
+}
+
+// Parse parses a decimal string such as "1234.5", "-0.05" or "1,00,000.00".
+// Values with more than two non-zero decimal places are rejected with
+// ErrPrecision. An empty string parses as Zero.
+func Parse(s string) (Amount, error) {
+	r, err := parseRat(s)
+	if err != nil {
+		return Zero, err
+	}
+	scaled := new(big.Rat).Mul(r, big.NewRat(paisePerRupee, 1))
+	if !scaled.IsInt() {
+		return Zero, fmt.Errorf("%w: %q", ErrPrecision, s)
+	}
+	return fromInt(scaled.Num())
+}
+
+// ParseRound parses a decimal string like Parse and rounds any extra decimal
+// places with mode.
+func ParseRound(s string, mode RoundingMode) (Amount, error) {
+	r, err := parseRat(s)
+	if err != nil {
+		return Zero, err
+	}
+	return FromRat(r, mode)
+}
+
+// MustParse is like Parse but panics on error. It is meant for constants.
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// FromRat rounds a rational number of rupees to paise with mode.
+func FromRat(r *big.Rat, mode RoundingMode) (Amount, error) {
+	scaled := new(big.Rat).Mul(r, big.NewRat(paisePerRupee, 1))
+	return fromInt(roundRat(scaled, mode))
+}
+
+// FromFloat converts a legacy float64 amount. The float is read through its
+// shortest decimal representation, so 0.1+0.2 style artefacts such as
+// 1234.5699999999999 come back as the 1234.57 the client meant, and only
+// genuinely finer values are rounded with mode.
+func FromFloat(f float64, mode RoundingMode) (Amount, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Zero, fmt.Errorf("%w: %v", ErrInvalid, f)
+	}
+	return ParseRound(strconv.FormatFloat(f, 'f', -1, 64), mode)
+}
+
+// FromUnitsNanos converts a proto units/nanos pair. Extra precision in nanos
+// is rounded with mode. units and nanos must not have opposite signs.
+func FromUnitsNanos(units int64, nanos int32, mode RoundingMode) (Amount, error) {
+	if nanos <= -nanosPerUnit || nanos >= nanosPerUnit || (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return Zero, fmt.Errorf("%w: units %d nanos %d", ErrInvalid, units, nanos)
+	}
+	r := new(big.Rat).SetFrac(
+		new(big.Int).Add(new(big.Int).Mul(big.NewInt(units), big.NewInt(nanosPerUnit)), big.NewInt(int64(nanos))),
+		big.NewInt(nanosPerUnit),
+	)
+	return FromRat(r, mode)
+}
+
+// Sum adds amounts.
+func Sum(amounts ...Amount) Amount {
+	var total Amount
+	for _, a := range amounts {
+		total = total.Add(a)
+	}
+	return total
+}
+
+// SumChecked adds amounts, returning ErrOverflow if the total does not fit in
+// an Amount.
+func SumChecked(amounts ...Amount) (Amount, error) {
+	var total Amount
+	for _, a := range amounts {
+		var err error
+		if total, err = total.AddChecked(a); err != nil {
+			return Zero, err
+		}
+	}
+	return total, nil
+}
+
+// Paise returns the amount in paise.
+func (a Amount) Paise() int64 { return a.paise }
+
+// Rupees returns the whole rupees and the remaining paise, both carrying the
+// sign of the amount.
+func (a Amount) Rupees() (rupees, paise int64) {
+	return a.paise / paisePerRupee, a.paise % paisePerRupee
+}
+
+// UnitsNanos returns the amount as a proto units/nanos pair.
+func (a Amount) UnitsNanos() (units int64, nanos int32) {
+	rupees, paise := a.Rupees()
+	return rupees, int32(paise * nanosPerPaisa)
+}
+
+// Float64 returns the nearest float64. It exists for clients that still read
+// double fields and must not be used for arithmetic.
+func (a Amount) Float64() float64 {
+	f, _ := strconv.ParseFloat(a.String(), 64)
+	return f
+}
+
+// Rat returns the amount in rupees as a big.Rat.
+func (a Amount) Rat() *big.Rat {
+	return big.NewRat(a.paise, paisePerRupee)
+}
+
+// String formats the amount with exactly two decimal places, e.g. "-1234.50".
+func (a Amount) String() string {
+	sign := ""
+	p := a.paise
+	if p < 0 {
+		sign = "-"
+	}
+	rupees, paise := p/paisePerRupee, p%paisePerRupee
+	if rupees < 0 {
+		rupees = -rupees
+	}
+	if paise < 0 {
+		paise = -paise
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, rupees, paise)
+}
+
+func (a Amount) IsZero() bool     { return a.paise == 0 }
+func (a Amount) IsNegative() bool { return a.paise < 0 }
+func (a Amount) Sign() int {
+	switch {
+	case a.paise < 0:
+		return -1
+	case a.paise > 0:
+		return 1
+	}
+	return 0
+}
+
+// Cmp returns -1, 0 or +1 as a is less than, equal to or greater than b.
+func (a Amount) Cmp(b Amount) int {
+	switch {
+	case a.paise < b.paise:
+		return -1
+	case a.paise > b.paise:
+		return 1
+	}
+	return 0
+}
+
+func (a Amount) Equal(b Amount) bool { return a.paise == b.paise }
+func (a Amount) Neg() Amount         { return Amount{paise: -a.paise} }
+
+// Add returns a+b. It wraps around on overflow like int64 arithmetic; use
+// AddChecked for amounts taken from requests or stored data.
+func (a Amount) Add(b Amount) Amount { return Amount{paise: a.paise + b.paise} }
+
+// Sub returns a-b. It wraps around on overflow like int64 arithmetic; use
+// SubChecked for amounts taken from requests or stored data.
+func (a Amount) Sub(b Amount) Amount { return Amount{paise: a.paise - b.paise} }
+
+// AddChecked returns a+b, or ErrOverflow if the sum does not fit in an Amount.
+func (a Amount) AddChecked(b Amount) (Amount, error) {
+	sum := a.paise + b.paise
+	if (b.paise > 0 && sum < a.paise) || (b.paise < 0 && sum > a.paise) {
+		return Zero, ErrOverflow
+	}
+	return Amount{paise: sum}, nil
+}
+
+// SubChecked returns a-b, or ErrOverflow if the difference does not fit in an
+// Amount.
+func (a Amount) SubChecked(b Amount) (Amount, error) {
+	diff := a.paise - b.paise
+	if (b.paise > 0 && diff > a.paise) || (b.paise < 0 && diff < a.paise) {
+		return Zero, ErrOverflow
+	}
+	return Amount{paise: diff}, nil
+}
+
+// Abs returns the absolute value of a.
+func (a Amount) Abs() Amount {
+	if a.paise < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// Mul multiplies the amount by a whole number. Like Add it wraps around on
+// overflow; use MulChecked when the product is not known to fit.
+func (a Amount) Mul(n int64) Amount { return Amount{paise: a.paise * n} }
+
+// MulChecked multiplies the amount by a whole number, or returns ErrOverflow
+// if the product does not fit in an Amount.
+func (a Amount) MulChecked(n int64) (Amount, error) {
+	return fromInt(new(big.Int).Mul(big.NewInt(a.paise), big.NewInt(n)))
+}
+
+// MulRat multiplies the amount by r and rounds the result with mode. It
+// returns ErrOverflow if the result does not fit in an Amount.
+func (a Amount) MulRat(r *big.Rat, mode RoundingMode) (Amount, error) {
+	return FromRat(new(big.Rat).Mul(a.Rat(), r), mode)
+}
+
+// Percent returns pct percent of the amount, rounded with mode. The rate is
+// read through its shortest decimal representation, so 7.5 means exactly 7.5.
+func (a Amount) Percent(pct float64, mode RoundingMode) (Amount, error) {
+	return a.MulRat(Rate(pct), mode)
+}
+
+// Rate converts a percentage to the exact fraction it denotes, e.g. 7.5 to 3/40.
+func Rate(pct float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(pct, 'f', -1, 64))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r.Quo(r, big.NewRat(100, 1))
+}
+
+// RoundToRupee rounds the amount to whole rupees with mode. It returns
+// ErrOverflow only for amounts within a rupee of the range limits.
+func (a Amount) RoundToRupee(mode RoundingMode) (Amount, error) {
+	rupees := roundRat(a.Rat(), mode)
+	return fromInt(rupees.Mul(rupees, big.NewInt(paisePerRupee)))
+}
+
+// Value implements driver.Valuer, storing the amount as a decimal string.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan implements sql.Scanner for DECIMAL, text and integer columns. NULL
+// scans as Zero.
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = Zero
+		return nil
+	case []byte:
+		return a.scanString(string(v))
+	case string:
+		return a.scanString(v)
+	case int64:
+		*a = FromRupees(v)
+		return nil
+	case float64:
+		out, err := FromFloat(v, HalfUp)
+		if err != nil {
+			return err
+		}
+		*a = out
+		return nil
+	}
+	return fmt.Errorf("%w: cannot scan %T", ErrInvalid, src)
+}
+
+func (a *Amount) scanString(s string) error {
+	out, err := ParseRound(s, HalfUp)
+	if err != nil {
+		return err
+	}
+	*a = out
+	return nil
+}
+
+// MarshalJSON encodes the amount as a decimal string.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts a decimal string or a JSON number. Numbers are parsed
+// from their literal text, never through float64.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		*a = Zero
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	out, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = out
+	return nil
+}
+
+// parseRat parses a decimal string in rupees. Grouping commas, a leading ₹
+// and surrounding spaces are ignored; exponents are not accepted.
+func parseRat(s string) (*big.Rat, error) {
+	clean := strings.TrimSpace(s)
+	clean = strings.TrimPrefix(clean, "₹")
+	clean = strings.ReplaceAll(clean, ",", "")
+	if clean == "" {
+		return new(big.Rat), nil
+	}
+
+	body := strings.TrimLeft(clean, "+-")
+	if len(clean)-len(body) > 1 || body == "" || body == "." || strings.Count(body, ".") > 1 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	for _, c := range body {
+		if c != '.' && (c < '0' || c > '9') {
+			return nil, fmt.Errorf("%w: %q", ErrInvalid, s)
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(clean)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	return r, nil
+}
+
+func fromInt(paise *big.Int) (Amount, error) {
+	if !paise.IsInt64() {
+		return Zero, ErrOverflow
+	}
+	return Amount{paise: paise.Int64()}, nil
+}
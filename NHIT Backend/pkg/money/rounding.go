@@ -0,0 +1,93 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// RoundingMode decides how a value with more precision than an Amount holds
+// is brought to whole paise (or whole rupees, see RoundToRupee).
+type RoundingMode int
+
+const (
+	// HalfUp rounds to the nearest value, halves away from zero. This is the
+	// commercial rounding used on invoices and bank letters.
+	HalfUp RoundingMode = iota
+	// HalfEven rounds to the nearest value, halves to the even neighbour.
+	HalfEven
+	// Down truncates towards zero.
+	Down
+	// Up rounds away from zero.
+	Up
+)
+
+// RoundingEnv is the environment variable services read their rounding mode from.
+const RoundingEnv = "MONEY_ROUNDING_MODE"
+
+var roundingNames = map[RoundingMode]string{
+	HalfUp:   "half-up",
+	HalfEven: "half-even",
+	Down:     "down",
+	Up:       "up",
+}
+
+func (m RoundingMode) String() string {
+	if name, ok := roundingNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
+
+// ParseRoundingMode parses "half-up", "half-even", "down" or "up"
+// (underscores and case are ignored). An empty string selects HalfUp.
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	s = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), "_", "-"))
+	if s == "" {
+		return HalfUp, nil
+	}
+	for mode, name := range roundingNames {
+		if name == s {
+			return mode, nil
+		}
+	}
+	return HalfUp, fmt.Errorf("money: unknown rounding mode %q", s)
+}
+
+// RoundingFromEnv returns the rounding mode configured in MONEY_ROUNDING_MODE,
+// HalfUp when it is unset.
+func RoundingFromEnv() (RoundingMode, error) {
+	return ParseRoundingMode(os.Getenv(RoundingEnv))
+}
+
+// roundRat rounds r to an integer using mode.
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	num, den := r.Num(), r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+
+	// away is +1 or -1, the direction of rounding away from zero
+	away := big.NewInt(int64(num.Sign()))
+	switch mode {
+	case Down:
+		return q
+	case Up:
+		return q.Add(q, away)
+	}
+
+	// compare the remainder with half of the denominator
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	switch twice.Cmp(den) {
+	case 1:
+		return q.Add(q, away)
+	case 0:
+		if mode == HalfUp || q.Bit(0) == 1 {
+			return q.Add(q, away)
+		}
+	}
+	return q
+}
@@ -0,0 +1,234 @@
+// Package spreadsheet reads and writes tabular files (CSV and XLSX) as rows of strings.
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Supported file formats
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// ErrUnsupportedFormat is returned for formats other than csv and xlsx
+var ErrUnsupportedFormat = errors.New("unsupported file format, expected csv or xlsx")
+
+// MaxRows limits the number of data rows read from one file
+const MaxRows = 5000
+
+// DetectFormat returns the format named explicitly, or derived from the file name's extension
+func DetectFormat(format, fileName string) (string, error) {
+	format = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(format), "."))
+	if format == "" {
+		if i := strings.LastIndex(fileName, "."); i >= 0 {
+			format = strings.ToLower(fileName[i+1:])
+		}
+	}
+	switch format {
+	case FormatCSV, FormatXLSX:
+		return format, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the MIME type of a format
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// Read returns the rows of a CSV file or of the first sheet of an XLSX
+// workbook. The first row is the header. Cells are trimmed.
+func Read(format string, data []byte) ([][]string, error) {
+	var rows [][]string
+	var err error
+
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(data)
+	case FormatXLSX:
+		rows, err = readXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cleanRows(rows)
+}
+
+// ReadSheets returns the rows of each named sheet of an XLSX workbook, keyed
+// by the name as given. Sheet names match case-insensitively and missing
+// sheets are left out.
+func ReadSheets(data []byte, names ...string) (map[string][][]string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX: %w", err)
+	}
+	defer f.Close()
+
+	sheets := make(map[string][][]string, len(names))
+	for _, sheet := range f.GetSheetList() {
+		for _, name := range names {
+			if !strings.EqualFold(strings.TrimSpace(sheet), name) {
+				continue
+			}
+			rows, err := f.GetRows(sheet)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read sheet %s: %w", sheet, err)
+			}
+			if sheets[name], err = cleanRows(rows); err != nil {
+				return nil, fmt.Errorf("sheet %s: %w", sheet, err)
+			}
+		}
+	}
+	return sheets, nil
+}
+
+// Write encodes rows, the first being the header, as a CSV file or an XLSX
+// workbook whose single sheet is named sheet
+func Write(format, sheet string, rows [][]string) ([]byte, error) {
+	switch format {
+	case FormatCSV:
+		return writeCSV(rows)
+	case FormatXLSX:
+		return writeXLSX(sheet, rows)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func cleanRows(rows [][]string) ([][]string, error) {
+	if len(rows) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if len(rows)-1 > MaxRows {
+		return nil, fmt.Errorf("file has %d rows, at most %d are allowed", len(rows)-1, MaxRows)
+	}
+	for _, row := range rows {
+		for i := range row {
+			row[i] = unescapeFormula(strings.TrimSpace(row[i]))
+		}
+	}
+	return rows, nil
+}
+
+// escapeFormula prefixes values that a spreadsheet would evaluate as a
+// formula with an apostrophe. CSV cells are not typed, so data such as
+// "=HYPERLINK(...)" would otherwise run when the export is opened.
+func escapeFormula(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// unescapeFormula reverses escapeFormula
+func unescapeFormula(v string) string {
+	if len(v) > 1 && v[0] == '\'' && strings.ContainsRune("=+-@\t\r", rune(v[1])) {
+		return v[1:]
+	}
+	return v
+}
+
+func readCSV(data []byte) ([][]string, error) {
+	// Excel prefixes UTF-8 CSV files with a byte order mark
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	var rows [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		rows = append(rows, record)
+	}
+	return rows, nil
+}
+
+func readXLSX(data []byte) ([][]string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX: %w", err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errors.New("workbook has no sheets")
+	}
+	rows, err := f.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sheet %s: %w", sheets[0], err)
+	}
+	return rows, nil
+}
+
+func writeCSV(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	escaped := make([][]string, len(rows))
+	for i, row := range rows {
+		escaped[i] = make([]string, len(row))
+		for j, v := range row {
+			escaped[i][j] = escapeFormula(v)
+		}
+	}
+	if err := w.WriteAll(escaped); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeXLSX(sheetName string, rows [][]string) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(f.GetSheetName(0), sheetName); err != nil {
+		return nil, err
+	}
+
+	sw, err := f.NewStreamWriter(sheetName)
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range rows {
+		cells := make([]interface{}, len(row))
+		for j, v := range row {
+			cells[j] = v
+		}
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, err
+		}
+		if err := sw.SetRow(cell, cells); err != nil {
+			return nil, fmt.Errorf("failed to write row %d: %w", i+1, err)
+		}
+	}
+	if err := sw.Flush(); err != nil {
+		return nil, err
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write XLSX: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -175,8 +175,16 @@ func main() {
 	}
 	log.Printf("✅ Registered Payment Service -> %s", paymentServiceEndpoint)
 
+	// Auth client used to reject tokens of revoked sessions before they reach a service
+	authConn, err := grpc.NewClient(authServiceEndpoint, opts...)
+	if err != nil {
+		log.Fatalf("Failed to create auth service client: %v", err)
+	}
+	defer authConn.Close()
+	authClient := authpb.NewAuthServiceClient(authConn)
+
 	// Add CORS middleware
-	handler := cors(mux)
+	handler := cors(requireActiveSession(authClient, mux))
 
 	// Start HTTP server
 	port := ":8083"
@@ -234,6 +242,30 @@ func cors(h http.Handler) http.Handler {
 	})
 }
 
+// requireActiveSession validates bearer tokens against auth-service so that a revoked
+// session is rejected immediately instead of when its JWT expires. Auth endpoints are
+// skipped: they are either public or validated by auth-service itself.
+func requireActiveSession(authClient authpb.AuthServiceClient, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+		if tokenString == authHeader || strings.HasPrefix(r.URL.Path, "/api/v1/auth/") {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		validation, err := authClient.ValidateToken(r.Context(), &authpb.ValidateTokenRequest{Token: tokenString})
+		if err != nil || !validation.Valid {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":16,"message":"session expired or revoked"}`))
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
 func getEnv(key, fallback string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -15,6 +15,7 @@ require (
 	google.golang.org/grpc v1.77.0
 	nhit-note v0.0.0
 	nhit-note/api/pb/paymentnotepb v0.0.0-00010101000000-000000000000
+	nhit-note/api/pb/paymentpb v0.0.0-00010101000000-000000000000
 )
 
 replace (
@@ -39,4 +40,5 @@ require (
 	google.golang.org/genproto/googleapis/api v0.0.0-20251124214823-79d6a2a48846 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba // indirect
 	google.golang.org/protobuf v1.36.10 // indirect
+	nhit-note/api/pb/common v0.0.0 // indirect
 )
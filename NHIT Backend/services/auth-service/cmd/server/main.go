@@ -5,12 +5,14 @@ import (
 	"log"
 	"net"
 	"os"
+	"strings"
 	"time"
 
 	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
 	organizationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
 	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
 	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/adapters/grpc"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/adapters/kafka"
 	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/adapters/notifier"
 	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/adapters/organization"
 	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/adapters/repository"
@@ -36,6 +38,7 @@ func main() {
 	}
 
 	ssoConfig := config.LoadSSOConfig()
+	sessionConfig := config.LoadSessionConfig()
 
 	databaseURL := os.Getenv("DATABASE_URL")
 	if databaseURL == "" {
@@ -107,6 +110,20 @@ func main() {
 	passwordResetRepo := repository.NewPasswordResetRepository(pool)
 	emailVerificationRepo := repository.NewEmailVerificationRepository(pool)
 	userRepo := repository.NewUserRepository(pool)
+	refreshFamilyRepo := repository.NewRefreshTokenFamilyRepository(pool)
+	securityEventRepo := repository.NewSecurityEventRepository(pool)
+	passwordPolicyRepo := repository.NewPasswordPolicyRepository(pool)
+	passwordHistoryRepo := repository.NewPasswordHistoryRepository(pool)
+	orgAccessRepo := repository.NewOrganizationAccessRepository(pool)
+	delegationRepo := repository.NewDelegationRepository(pool)
+	scopedGrantRepo := repository.NewScopedGrantRepository(pool)
+
+	// Load the local breached password hash list (optional)
+	breachedPasswords, err := utils.LoadBreachedPasswordList(os.Getenv("BREACHED_PASSWORDS_FILE"))
+	if err != nil {
+		log.Fatalf("Failed to load breached password list: %v", err)
+	}
+	log.Printf("✅ Loaded %d breached password hashes", breachedPasswords.Size())
 
 	// Initialize JWT manager
 	jwtSecret := os.Getenv("JWT_SECRET")
@@ -145,8 +162,32 @@ func main() {
 		userServiceClient,  // Pass User Service gRPC client
 		orgClientAdapter,   // Pass Organization Service client
 		ssoConfig,          // Pass SSO Config
+		refreshFamilyRepo,
+		securityEventRepo,
+		sessionConfig,
+		passwordPolicyRepo,
+		passwordHistoryRepo,
+		breachedPasswords,
+		orgAccessRepo,
+		delegationRepo,
+		scopedGrantRepo,
 	)
 
+	// Keep organization access in sync with organization-service so logins and
+	// tokens of deactivated or deleted organizations are refused
+	kafkaBrokers := os.Getenv("KAFKA_BROKERS")
+	if kafkaBrokers == "" {
+		kafkaBrokers = "localhost:9092"
+	}
+	orgEventConsumer := kafka.NewOrganizationEventConsumer(strings.Split(kafkaBrokers, ","), "organization.events", "auth-service-organization-access", nil)
+	defer orgEventConsumer.Close()
+	go func() {
+		if err := orgEventConsumer.Subscribe(ctx, authService.ApplyOrganizationStatus); err != nil {
+			log.Printf("⚠️ Organization event consumer exited: %v", err)
+		}
+	}()
+	log.Printf("✅ Organization event consumer started on %s", kafkaBrokers)
+
 	// Initialize auth interceptor (middleware)
 	authInterceptor := middleware.NewAuthInterceptor(authService)
 
@@ -179,6 +220,7 @@ func main() {
 	log.Printf("✅ Auth Service listening on port %s", serverPort)
 	log.Printf("📧 Email service: Mock (for development)")
 	log.Printf("🔐 JWT: Access token expires in %v, Refresh token expires in %v", accessTokenDuration, refreshTokenDuration)
+	log.Printf("🔐 Sessions: absolute lifetime %v", sessionConfig.AbsoluteLifetime)
 	log.Println("🎉 Auth Service is ready!")
 
 	if err := grpcServer.Serve(lis); err != nil {
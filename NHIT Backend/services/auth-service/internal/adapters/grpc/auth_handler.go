@@ -95,7 +95,7 @@ func (h *AuthHandler) Login(ctx context.Context, req *authpb.UserLoginRequest) (
 		// Auto-detect tenant by looking up user globally
 		response, err := h.authService.LoginGlobal(ctx, req.Login, req.Password, nil)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "login failed: %v", err)
+			return nil, loginError(err)
 		}
 
 		orgIDStr := ""
@@ -137,7 +137,7 @@ func (h *AuthHandler) Login(ctx context.Context, req *authpb.UserLoginRequest) (
 
 	response, err := h.authService.Login(ctx, req.Login, req.Password, tenantID, orgID)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "login failed: %v", err)
+		return nil, loginError(err)
 	}
 
 	orgIDStr := ""
@@ -241,7 +241,7 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, req *authpb.RefreshToken
 
 	response, err := h.authService.RefreshToken(ctx, req.RefreshToken, tenantID, orgID)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "failed to refresh token: %v", err)
+		return nil, reissueError(err, codes.Unauthenticated, "failed to refresh token")
 	}
 
 	logMsg := fmt.Sprintf("Session refreshed with ID: %s", response.SessionID)
@@ -282,6 +282,37 @@ func (h *AuthHandler) ValidateToken(ctx context.Context, req *authpb.ValidateTok
 	if validation.OrgID != nil {
 		resp.OrgId = validation.OrgID.String()
 	}
+	for _, d := range validation.Delegations {
+		grant := &authpb.DelegatedGrant{
+			DelegationId:  d.DelegationID.String(),
+			DelegatorId:   d.DelegatorID.String(),
+			DelegatorName: d.DelegatorName,
+			Permissions:   d.Permissions,
+			EndsAt:        d.EndsAt.Unix(),
+		}
+		if d.OrgID != nil {
+			grant.OrgId = d.OrgID.String()
+		}
+		if d.ProjectID != nil {
+			grant.ProjectId = d.ProjectID.String()
+		}
+		resp.Delegations = append(resp.Delegations, grant)
+	}
+	for _, g := range validation.ScopedGrants {
+		grant := &authpb.ScopedGrant{
+			AssignmentId: g.AssignmentID.String(),
+			RoleName:     g.RoleName,
+			Permissions:  g.Permissions,
+			OrgId:        g.OrgID.String(),
+		}
+		if g.ProjectID != nil {
+			grant.ProjectId = g.ProjectID.String()
+		}
+		if g.DepartmentID != nil {
+			grant.DepartmentId = g.DepartmentID.String()
+		}
+		resp.ScopedGrants = append(resp.ScopedGrants, grant)
+	}
 
 	return resp, nil
 }
@@ -459,7 +490,7 @@ func (h *AuthHandler) SwitchOrganization(ctx context.Context, req *authpb.Switch
 
 	response, err := h.authService.SwitchOrganization(ctx, userID, orgID, tenantID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to switch organization: %v", err)
+		return nil, reissueError(err, codes.Internal, "failed to switch organization")
 	}
 
 	orgIDStr := ""
@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/utils"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// loginError maps a login failure to a gRPC status; an expired password is reported as
+// FailedPrecondition so clients can redirect to ChangeExpiredPassword
+func loginError(err error) error {
+	if errors.Is(err, utils.ErrPasswordChangeRequired) {
+		return status.Error(codes.FailedPrecondition, "password expired: change it via change-expired-password before logging in")
+	}
+	if errors.Is(err, domain.ErrOrganizationInactive) {
+		return status.Errorf(codes.PermissionDenied, "login failed: %v", err)
+	}
+	return status.Errorf(codes.Unauthenticated, "login failed: %v", err)
+}
+
+// reissueError maps a failure to issue new tokens for an existing session; an expired
+// password is reported as on login, anything else with the given code
+func reissueError(err error, code codes.Code, msg string) error {
+	if errors.Is(err, utils.ErrPasswordChangeRequired) {
+		return loginError(err)
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
+
+// changePasswordError maps a password change failure to a gRPC status: wrong
+// credentials and rejected passwords are the client's, anything else is ours
+func changePasswordError(err error) error {
+	switch {
+	case errors.Is(err, utils.ErrInvalidCredentials):
+		return status.Errorf(codes.Unauthenticated, "failed to change password: %v", err)
+	case errors.Is(err, utils.ErrAccountDeactivated):
+		return status.Errorf(codes.PermissionDenied, "failed to change password: %v", err)
+	case errors.Is(err, utils.ErrPasswordPolicyViolation),
+		errors.Is(err, utils.ErrPasswordBreached),
+		errors.Is(err, utils.ErrPasswordReused):
+		return status.Errorf(codes.InvalidArgument, "failed to change password: %v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to change password: %v", err)
+}
+
+func toPasswordPolicyProto(policy *domain.PasswordPolicy) *authpb.PasswordPolicy {
+	updatedAt := ""
+	if !policy.UpdatedAt.IsZero() {
+		updatedAt = policy.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return &authpb.PasswordPolicy{
+		TenantId:         policy.TenantID.String(),
+		MinLength:        int32(policy.MinLength),
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireDigit:     policy.RequireDigit,
+		RequireSpecial:   policy.RequireSpecial,
+		ExpiryDays:       int32(policy.ExpiryDays),
+		HistoryDepth:     int32(policy.HistoryDepth),
+		CheckBreached:    policy.CheckBreached,
+		UpdatedAt:        updatedAt,
+	}
+}
+
+// ChangeExpiredPassword sets a new password for a user whose password has expired
+func (h *AuthHandler) ChangeExpiredPassword(ctx context.Context, req *authpb.ChangeExpiredPasswordRequest) (*authpb.ChangeExpiredPasswordResponse, error) {
+	if req.Login == "" || req.CurrentPassword == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "login, current_password and new_password are required")
+	}
+
+	if err := h.authService.ChangeExpiredPassword(ctx, req.Login, req.CurrentPassword, req.NewPassword); err != nil {
+		return nil, changePasswordError(err)
+	}
+
+	return &authpb.ChangeExpiredPasswordResponse{
+		Success: true,
+		Message: "Password changed successfully, please log in again",
+	}, nil
+}
+
+// GetPasswordPolicy returns the password policy of the caller's tenant
+func (h *AuthHandler) GetPasswordPolicy(ctx context.Context, req *authpb.GetPasswordPolicyRequest) (*authpb.PasswordPolicy, error) {
+	_, tenantID, _, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := h.authService.GetPasswordPolicy(ctx, tenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get password policy: %v", err)
+	}
+
+	return toPasswordPolicyProto(policy), nil
+}
+
+// UpdatePasswordPolicy replaces the password policy of the caller's tenant
+func (h *AuthHandler) UpdatePasswordPolicy(ctx context.Context, req *authpb.UpdatePasswordPolicyRequest) (*authpb.PasswordPolicy, error) {
+	_, tenantID, _, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	policy, err := h.authService.UpdatePasswordPolicy(ctx, &domain.PasswordPolicy{
+		TenantID:         tenantID,
+		MinLength:        int(req.Policy.MinLength),
+		RequireUppercase: req.Policy.RequireUppercase,
+		RequireLowercase: req.Policy.RequireLowercase,
+		RequireDigit:     req.Policy.RequireDigit,
+		RequireSpecial:   req.Policy.RequireSpecial,
+		ExpiryDays:       int(req.Policy.ExpiryDays),
+		HistoryDepth:     int(req.Policy.HistoryDepth),
+		CheckBreached:    req.Policy.CheckBreached,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update password policy: %v", err)
+	}
+
+	return toPasswordPolicyProto(policy), nil
+}
+
+// ValidatePassword checks a password chosen by a user who does not exist yet, such as
+// an invitee, against the tenant's policy. A rejected password is reported in the
+// response rather than as an error.
+func (h *AuthHandler) ValidatePassword(ctx context.Context, req *authpb.ValidatePasswordRequest) (*authpb.ValidatePasswordResponse, error) {
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant_id")
+	}
+
+	err = h.authService.ValidatePassword(ctx, tenantID, req.Password)
+	switch {
+	case err == nil:
+		return &authpb.ValidatePasswordResponse{Valid: true}, nil
+	case errors.Is(err, utils.ErrPasswordPolicyViolation), errors.Is(err, utils.ErrPasswordBreached):
+		return &authpb.ValidatePasswordResponse{Valid: false, Message: err.Error()}, nil
+	}
+	return nil, status.Errorf(codes.Internal, "failed to validate password: %v", err)
+}
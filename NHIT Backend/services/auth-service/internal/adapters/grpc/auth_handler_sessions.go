@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/utils"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// callerFromContext returns the user, tenant and session set by the auth interceptor
+func callerFromContext(ctx context.Context) (userID, tenantID, sessionID uuid.UUID, err error) {
+	userIDStr, _ := ctx.Value("user_id").(string)
+	userID, err = uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.Unauthenticated, "missing user_id in context")
+	}
+
+	tenantIDStr, _ := ctx.Value("tenant_id").(string)
+	tenantID, err = uuid.Parse(tenantIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.Unauthenticated, "missing tenant_id in context")
+	}
+
+	sessionIDStr, _ := ctx.Value("session_id").(string)
+	sessionID, _ = uuid.Parse(sessionIDStr)
+
+	return userID, tenantID, sessionID, nil
+}
+
+// ListMySessions lists the active sessions (devices) of the calling user
+func (h *AuthHandler) ListMySessions(ctx context.Context, req *authpb.ListMySessionsRequest) (*authpb.ListMySessionsResponse, error) {
+	userID, _, currentSessionID, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.authService.GetActiveSessions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	resp := &authpb.ListMySessionsResponse{Sessions: make([]*authpb.SessionInfo, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &authpb.SessionInfo{
+			SessionId:  session.SessionID.String(),
+			IpAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  session.CreatedAt.Format(time.RFC3339),
+			LastSeenAt: session.LastSeenAt.Format(time.RFC3339),
+			ExpiresAt:  session.ExpiresAt.Format(time.RFC3339),
+			Current:    session.SessionID == currentSessionID,
+		})
+	}
+
+	return resp, nil
+}
+
+// RevokeSession revokes one of the calling user's sessions
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *authpb.RevokeSessionRequest) (*authpb.RevokeSessionResponse, error) {
+	userID, _, _, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session_id: %v", err)
+	}
+
+	if err := h.authService.RevokeSession(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, utils.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+	}
+
+	return &authpb.RevokeSessionResponse{Success: true}, nil
+}
+
+// RevokeAllOtherSessions signs the calling user out of every other device
+func (h *AuthHandler) RevokeAllOtherSessions(ctx context.Context, req *authpb.RevokeAllOtherSessionsRequest) (*authpb.RevokeSessionsResponse, error) {
+	userID, _, currentSessionID, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := h.authService.RevokeAllOtherSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
+	}
+
+	return &authpb.RevokeSessionsResponse{Success: true, RevokedCount: int32(revoked)}, nil
+}
+
+// RevokeUserSessions force-logs-out another user of the caller's tenant (admin only)
+func (h *AuthHandler) RevokeUserSessions(ctx context.Context, req *authpb.RevokeUserSessionsRequest) (*authpb.RevokeSessionsResponse, error) {
+	_, tenantID, _, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetUserID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	revoked, err := h.authService.RevokeUserSessions(ctx, tenantID, targetUserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke user sessions: %v", err)
+	}
+
+	return &authpb.RevokeSessionsResponse{Success: true, RevokedCount: int32(revoked)}, nil
+}
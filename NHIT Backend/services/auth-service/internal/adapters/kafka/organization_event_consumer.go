@@ -0,0 +1,78 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/segmentio/kafka-go"
+)
+
+// OrganizationEventConsumer reads organization-service's organization.events
+// and hands lifecycle status changes to the handler. organization.created and
+// other events are skipped.
+type OrganizationEventConsumer struct {
+	reader *kafka.Reader
+	logger *log.Logger
+}
+
+// NewOrganizationEventConsumer creates a consumer of the organization events topic
+func NewOrganizationEventConsumer(brokers []string, topic string, groupID string, logger *log.Logger) *OrganizationEventConsumer {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &OrganizationEventConsumer{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  brokers,
+			GroupID:  groupID,
+			Topic:    topic,
+			MinBytes: 1,
+			MaxBytes: 10e6, // 10MB
+		}),
+		logger: logger,
+	}
+}
+
+// Subscribe handles status changes until ctx is done. Messages the handler
+// fails on are not committed, so they are retried.
+func (c *OrganizationEventConsumer) Subscribe(ctx context.Context, handler func(ctx context.Context, event *domain.OrganizationStatusChangedEvent) error) error {
+	c.logger.Printf("🎯 Starting organization event consumer for topic: %s", c.reader.Config().Topic)
+
+	for {
+		msg, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				c.logger.Println("🛑 Organization event consumer stopped")
+				return nil
+			}
+			c.logger.Printf("⚠️ Error fetching organization event: %v", err)
+			continue
+		}
+
+		var event domain.OrganizationStatusChangedEvent
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			c.logger.Printf("❌ Failed to unmarshal organization event: %v", err)
+			c.reader.CommitMessages(ctx, msg) // Commit even on error to avoid reprocessing
+			continue
+		}
+		if event.Status == "" || event.EventType == "organization.created" || !strings.HasPrefix(event.EventType, "organization.") {
+			c.reader.CommitMessages(ctx, msg) // not a status change
+			continue
+		}
+
+		if err := handler(ctx, &event); err != nil {
+			c.logger.Printf("❌ Failed to handle %s of organization %s: %v", event.EventType, event.OrgID, err)
+			continue
+		}
+		if err := c.reader.CommitMessages(ctx, msg); err != nil {
+			c.logger.Printf("⚠️ Failed to commit organization event: %v", err)
+		}
+	}
+}
+
+// Close closes the reader
+func (c *OrganizationEventConsumer) Close() error {
+	return c.reader.Close()
+}
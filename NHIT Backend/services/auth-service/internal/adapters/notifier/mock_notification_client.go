@@ -51,3 +51,14 @@ func (c *MockNotificationClient) SendOtpPasswordResetEmail(ctx context.Context,
 
 	return nil
 }
+
+// SendSecurityAlertEmail sends a security alert email using the mock client
+func (c *MockNotificationClient) SendSecurityAlertEmail(ctx context.Context, email, name, eventType, ipAddress, userAgent string) error {
+	if !c.IsEnabled {
+		return fmt.Errorf("mock notification client is disabled")
+	}
+
+	log.Printf("MOCK: Sending security alert (%s) to %s (%s) - IP: %s, User-Agent: %s", eventType, name, email, ipAddress, userAgent)
+
+	return nil
+}
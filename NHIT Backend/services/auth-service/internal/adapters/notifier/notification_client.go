@@ -169,3 +169,49 @@ func (c *RealNotificationClient) SendOtpPasswordResetEmail(ctx context.Context,
 	log.Printf("Password reset OTP email sent to %s", email)
 	return nil
 }
+
+// SendSecurityAlertEmail warns a user that their account was used suspiciously
+func (c *RealNotificationClient) SendSecurityAlertEmail(ctx context.Context, email, name, eventType, ipAddress, userAgent string) error {
+	// Build notification data
+	data := map[string]interface{}{
+		"name":         name,
+		"event_type":   eventType,
+		"ip_address":   ipAddress,
+		"user_agent":   userAgent,
+		"occurred_at":  time.Now().Format(time.RFC1123),
+		"current_year": fmt.Sprintf("%d", time.Now().Year()),
+	}
+
+	// Build request payload
+	req := SendNotificationRequest{
+		To:           email,
+		TemplateName: "security-alert",
+		Data:         data,
+	}
+
+	// Send the request
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/v1/notifications", c.BaseURL)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("notification service returned non-OK status: %d", resp.StatusCode)
+	}
+
+	log.Printf("Security alert email sent to %s", email)
+	return nil
+}
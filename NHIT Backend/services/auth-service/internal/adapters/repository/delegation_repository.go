@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type delegationRepository struct {
+	db *pgxpool.Pool
+}
+
+// Ensure delegationRepository implements ports.DelegationRepository at compile time
+var _ ports.DelegationRepository = (*delegationRepository)(nil)
+
+func NewDelegationRepository(db *pgxpool.Pool) ports.DelegationRepository {
+	return &delegationRepository{db: db}
+}
+
+func (r *delegationRepository) ListActiveForDelegate(ctx context.Context, tenantID, delegateID uuid.UUID) ([]domain.DelegatedGrant, error) {
+	// permissions is narrowed to what the delegator still holds through roles
+	// usable in the delegation's organization, so a delegator who has since
+	// lost a role no longer passes it on
+	query := `
+		SELECT d.delegation_id, d.delegator_id, u.name,
+		       ARRAY(
+		           SELECT p FROM unnest(d.permissions) AS p
+		           WHERE EXISTS (
+		               SELECT 1
+		               FROM user_roles ur
+		               JOIN roles r ON r.role_id = ur.role_id
+		               WHERE ur.user_id = d.delegator_id
+		                 AND (d.org_id IS NULL OR r.parent_org_id IS NULL OR r.parent_org_id = d.org_id)
+		                 AND (r.name = 'SUPER_ADMIN' OR p = ANY(r.permissions))
+		           )
+		       ),
+		       d.org_id, d.project_id, d.ends_at
+		FROM approval_delegations d
+		JOIN users u ON u.user_id = d.delegator_id
+		WHERE d.tenant_id = $1
+		  AND d.delegate_id = $2
+		  AND d.revoked_at IS NULL
+		  AND d.starts_at <= NOW()
+		  AND d.ends_at > NOW()
+		  AND u.is_active
+		ORDER BY d.ends_at
+	`
+
+	rows, err := r.db.Query(ctx, query, tenantID, delegateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delegations: %w", err)
+	}
+	defer rows.Close()
+
+	var grants []domain.DelegatedGrant
+	for rows.Next() {
+		var g domain.DelegatedGrant
+		if err := rows.Scan(&g.DelegationID, &g.DelegatorID, &g.DelegatorName, &g.Permissions, &g.OrgID, &g.ProjectID, &g.EndsAt); err != nil {
+			return nil, fmt.Errorf("failed to scan delegation: %w", err)
+		}
+		if len(g.Permissions) == 0 {
+			continue
+		}
+		grants = append(grants, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate delegations: %w", err)
+	}
+
+	return grants, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type organizationAccessRepository struct {
+	db *pgxpool.Pool
+}
+
+// Ensure organizationAccessRepository implements ports.OrganizationAccessRepository at compile time
+var _ ports.OrganizationAccessRepository = (*organizationAccessRepository)(nil)
+
+func NewOrganizationAccessRepository(db *pgxpool.Pool) ports.OrganizationAccessRepository {
+	return &organizationAccessRepository{db: db}
+}
+
+func (r *organizationAccessRepository) Get(ctx context.Context, orgID uuid.UUID) (*domain.OrganizationAccess, error) {
+	query := `
+		SELECT org_id, status, reason, changed_at
+		FROM organization_access
+		WHERE org_id = $1
+	`
+
+	access := &domain.OrganizationAccess{}
+	err := r.db.QueryRow(ctx, query, orgID).Scan(
+		&access.OrgID,
+		&access.Status,
+		&access.Reason,
+		&access.ChangedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization access: %w", err)
+	}
+
+	return access, nil
+}
+
+// Apply upserts one row per organization; an event older than the stored
+// change, e.g. redelivered out of order, leaves the row alone
+func (r *organizationAccessRepository) Apply(ctx context.Context, orgIDs []uuid.UUID, status, reason string, changedAt time.Time) error {
+	query := `
+		INSERT INTO organization_access (org_id, status, reason, changed_at, updated_at)
+		SELECT id, $2, $3, $4, NOW()
+		FROM UNNEST($1::uuid[]) AS id
+		ON CONFLICT (org_id) DO UPDATE
+		SET status = EXCLUDED.status,
+		    reason = EXCLUDED.reason,
+		    changed_at = EXCLUDED.changed_at,
+		    updated_at = NOW()
+		WHERE organization_access.changed_at <= EXCLUDED.changed_at
+	`
+
+	if _, err := r.db.Exec(ctx, query, orgIDs, status, reason, changedAt); err != nil {
+		return fmt.Errorf("failed to apply organization access: %w", err)
+	}
+
+	return nil
+}
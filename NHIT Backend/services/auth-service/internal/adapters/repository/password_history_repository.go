@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type passwordHistoryRepository struct {
+	db *pgxpool.Pool
+}
+
+// Ensure passwordHistoryRepository implements ports.PasswordHistoryRepository at compile time
+var _ ports.PasswordHistoryRepository = (*passwordHistoryRepository)(nil)
+
+func NewPasswordHistoryRepository(db *pgxpool.Pool) ports.PasswordHistoryRepository {
+	return &passwordHistoryRepository{db: db}
+}
+
+func (r *passwordHistoryRepository) Add(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	query := `
+		INSERT INTO password_history (user_id, password_hash, created_at)
+		VALUES ($1, $2, NOW())
+	`
+
+	if _, err := r.db.Exec(ctx, query, userID, passwordHash); err != nil {
+		return fmt.Errorf("failed to add password history: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecent returns the most recent password hashes of a user, newest first
+func (r *passwordHistoryRepository) GetRecent(ctx context.Context, userID uuid.UUID, limit int) ([]string, error) {
+	query := `
+		SELECT password_hash
+		FROM password_history
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password history: %w", err)
+	}
+	defer rows.Close()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, fmt.Errorf("failed to scan password history: %w", err)
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate password history: %w", err)
+	}
+
+	return hashes, nil
+}
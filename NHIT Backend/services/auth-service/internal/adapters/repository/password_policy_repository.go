@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type passwordPolicyRepository struct {
+	db *pgxpool.Pool
+}
+
+// Ensure passwordPolicyRepository implements ports.PasswordPolicyRepository at compile time
+var _ ports.PasswordPolicyRepository = (*passwordPolicyRepository)(nil)
+
+func NewPasswordPolicyRepository(db *pgxpool.Pool) ports.PasswordPolicyRepository {
+	return &passwordPolicyRepository{db: db}
+}
+
+func (r *passwordPolicyRepository) GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*domain.PasswordPolicy, error) {
+	query := `
+		SELECT tenant_id, min_length, require_uppercase, require_lowercase, require_digit, require_special,
+		       expiry_days, history_depth, check_breached, updated_at
+		FROM password_policies
+		WHERE tenant_id = $1
+	`
+
+	policy := &domain.PasswordPolicy{}
+	err := r.db.QueryRow(ctx, query, tenantID).Scan(
+		&policy.TenantID,
+		&policy.MinLength,
+		&policy.RequireUppercase,
+		&policy.RequireLowercase,
+		&policy.RequireDigit,
+		&policy.RequireSpecial,
+		&policy.ExpiryDays,
+		&policy.HistoryDepth,
+		&policy.CheckBreached,
+		&policy.UpdatedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return domain.DefaultPasswordPolicy(tenantID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func (r *passwordPolicyRepository) Upsert(ctx context.Context, policy *domain.PasswordPolicy) (*domain.PasswordPolicy, error) {
+	query := `
+		INSERT INTO password_policies (tenant_id, min_length, require_uppercase, require_lowercase, require_digit,
+		                               require_special, expiry_days, history_depth, check_breached, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+		ON CONFLICT (tenant_id) DO UPDATE SET
+			min_length = EXCLUDED.min_length,
+			require_uppercase = EXCLUDED.require_uppercase,
+			require_lowercase = EXCLUDED.require_lowercase,
+			require_digit = EXCLUDED.require_digit,
+			require_special = EXCLUDED.require_special,
+			expiry_days = EXCLUDED.expiry_days,
+			history_depth = EXCLUDED.history_depth,
+			check_breached = EXCLUDED.check_breached,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		policy.TenantID,
+		policy.MinLength,
+		policy.RequireUppercase,
+		policy.RequireLowercase,
+		policy.RequireDigit,
+		policy.RequireSpecial,
+		policy.ExpiryDays,
+		policy.HistoryDepth,
+		policy.CheckBreached,
+		time.Now(),
+	).Scan(&policy.UpdatedAt)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to save password policy: %w", err)
+	}
+
+	return policy, nil
+}
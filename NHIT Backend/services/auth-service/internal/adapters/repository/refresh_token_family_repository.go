@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type refreshTokenFamilyRepository struct {
+	db *pgxpool.Pool
+}
+
+// Ensure refreshTokenFamilyRepository implements ports.RefreshTokenFamilyRepository at compile time
+var _ ports.RefreshTokenFamilyRepository = (*refreshTokenFamilyRepository)(nil)
+
+func NewRefreshTokenFamilyRepository(db *pgxpool.Pool) ports.RefreshTokenFamilyRepository {
+	return &refreshTokenFamilyRepository{db: db}
+}
+
+func (r *refreshTokenFamilyRepository) Create(ctx context.Context, family *domain.RefreshTokenFamily) error {
+	query := `
+		INSERT INTO refresh_token_families (family_id, user_id, session_id, created_at, absolute_expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.Exec(
+		ctx,
+		query,
+		family.FamilyID,
+		family.UserID,
+		family.SessionID,
+		family.CreatedAt,
+		family.AbsoluteExpiresAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+func (r *refreshTokenFamilyRepository) GetByID(ctx context.Context, familyID uuid.UUID) (*domain.RefreshTokenFamily, error) {
+	query := `
+		SELECT family_id, user_id, session_id, created_at, absolute_expires_at, revoked_at, COALESCE(revoked_reason, '')
+		FROM refresh_token_families
+		WHERE family_id = $1
+	`
+
+	family := &domain.RefreshTokenFamily{}
+	err := r.db.QueryRow(ctx, query, familyID).Scan(
+		&family.FamilyID,
+		&family.UserID,
+		&family.SessionID,
+		&family.CreatedAt,
+		&family.AbsoluteExpiresAt,
+		&family.RevokedAt,
+		&family.RevokedReason,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("refresh token family not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token family: %w", err)
+	}
+
+	return family, nil
+}
+
+func (r *refreshTokenFamilyRepository) Revoke(ctx context.Context, familyID uuid.UUID, reason string) error {
+	query := `
+		UPDATE refresh_token_families
+		SET revoked_at = NOW(), revoked_reason = $2
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+
+	_, err := r.db.Exec(ctx, query, familyID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
+
+func (r *refreshTokenFamilyRepository) Adopt(ctx context.Context, token string, family *domain.RefreshTokenFamily) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO refresh_token_families (family_id, user_id, session_id, created_at, absolute_expires_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		family.FamilyID,
+		family.UserID,
+		family.SessionID,
+		family.CreatedAt,
+		family.AbsoluteExpiresAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create refresh token family: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, `
+		UPDATE refresh_tokens SET family_id = $2
+		WHERE token = $1 AND family_id IS NULL AND used_at IS NULL`,
+		token, family.FamilyID)
+	if err != nil {
+		return false, fmt.Errorf("failed to adopt refresh token: %w", err)
+	}
+	if result.RowsAffected() != 1 {
+		return false, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit refresh token family: %w", err)
+	}
+	return true, nil
+}
@@ -20,8 +20,8 @@ func NewRefreshTokenRepository(db *pgxpool.Pool) *refreshTokenRepository {
 
 func (r *refreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
 	query := `
-		INSERT INTO refresh_tokens (token, user_id, expires_at, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO refresh_tokens (token, user_id, session_id, family_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
 	_, err := r.db.Exec(
@@ -29,6 +29,8 @@ func (r *refreshTokenRepository) Create(ctx context.Context, token *domain.Refre
 		query,
 		token.Token,
 		token.UserID,
+		token.SessionID,
+		token.FamilyID,
 		token.ExpiresAt,
 		token.CreatedAt,
 	)
@@ -44,7 +46,7 @@ func (r *refreshTokenRepository) GetUserIDByToken(ctx context.Context, token str
 	query := `
 		SELECT user_id
 		FROM refresh_tokens
-		WHERE token = $1 AND expires_at > NOW()
+		WHERE token = $1 AND expires_at > NOW() AND used_at IS NULL
 	`
 
 	var userID uuid.UUID
@@ -60,6 +62,76 @@ func (r *refreshTokenRepository) GetUserIDByToken(ctx context.Context, token str
 	return userID, nil
 }
 
+func (r *refreshTokenRepository) GetByToken(ctx context.Context, token string) (*domain.RefreshToken, error) {
+	query := `
+		SELECT token, user_id, session_id, family_id, expires_at, created_at, used_at
+		FROM refresh_tokens
+		WHERE token = $1 AND expires_at > NOW()
+	`
+
+	refreshToken := &domain.RefreshToken{}
+	err := r.db.QueryRow(ctx, query, token).Scan(
+		&refreshToken.Token,
+		&refreshToken.UserID,
+		&refreshToken.SessionID,
+		&refreshToken.FamilyID,
+		&refreshToken.ExpiresAt,
+		&refreshToken.CreatedAt,
+		&refreshToken.UsedAt,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("refresh token not found or expired")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return refreshToken, nil
+}
+
+// MarkUsed flags a token as rotated. It returns false when the token had already been
+// used, which makes concurrent replays of the same token detectable.
+func (r *refreshTokenRepository) MarkUsed(ctx context.Context, token string) (bool, error) {
+	query := `UPDATE refresh_tokens SET used_at = NOW() WHERE token = $1 AND used_at IS NULL`
+
+	result, err := r.db.Exec(ctx, query, token)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+func (r *refreshTokenRepository) GetSessionIDsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT DISTINCT session_id
+		FROM refresh_tokens
+		WHERE family_id = $1 AND session_id IS NOT NULL
+	`
+
+	rows, err := r.db.Query(ctx, query, familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get family sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessionIDs []uuid.UUID
+	for rows.Next() {
+		var sessionID uuid.UUID
+		if err := rows.Scan(&sessionID); err != nil {
+			return nil, fmt.Errorf("failed to scan family session: %w", err)
+		}
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate family sessions: %w", err)
+	}
+
+	return sessionIDs, nil
+}
+
 func (r *refreshTokenRepository) Delete(ctx context.Context, token string) error {
 	query := `DELETE FROM refresh_tokens WHERE token = $1`
 
@@ -81,3 +153,14 @@ func (r *refreshTokenRepository) DeleteByUserID(ctx context.Context, userID uuid
 
 	return nil
 }
+
+func (r *refreshTokenRepository) DeleteBySessionID(ctx context.Context, sessionID uuid.UUID) error {
+	query := `DELETE FROM refresh_tokens WHERE session_id = $1`
+
+	_, err := r.db.Exec(ctx, query, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to delete session refresh tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type scopedGrantRepository struct {
+	db *pgxpool.Pool
+}
+
+// Ensure scopedGrantRepository implements ports.ScopedGrantRepository at compile time
+var _ ports.ScopedGrantRepository = (*scopedGrantRepository)(nil)
+
+func NewScopedGrantRepository(db *pgxpool.Pool) ports.ScopedGrantRepository {
+	return &scopedGrantRepository{db: db}
+}
+
+func (r *scopedGrantRepository) ListActiveForUser(ctx context.Context, tenantID, userID uuid.UUID) ([]domain.ScopedGrant, error) {
+	query := `
+		SELECT s.assignment_id, r.name, r.permissions, s.org_id,
+		       CASE WHEN s.scope_type = 'PROJECT' THEN s.scope_id END,
+		       CASE WHEN s.scope_type = 'DEPARTMENT' THEN s.scope_id END
+		FROM user_role_scopes s
+		JOIN roles r ON r.role_id = s.role_id
+		WHERE s.tenant_id = $1
+		  AND s.user_id = $2
+		  AND (s.expires_at IS NULL OR s.expires_at > NOW())
+		ORDER BY s.created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, tenantID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scoped roles: %w", err)
+	}
+	defer rows.Close()
+
+	var grants []domain.ScopedGrant
+	for rows.Next() {
+		var g domain.ScopedGrant
+		if err := rows.Scan(&g.AssignmentID, &g.RoleName, &g.Permissions, &g.OrgID, &g.ProjectID, &g.DepartmentID); err != nil {
+			return nil, fmt.Errorf("failed to scan scoped role: %w", err)
+		}
+		grants = append(grants, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scoped roles: %w", err)
+	}
+
+	return grants, nil
+}
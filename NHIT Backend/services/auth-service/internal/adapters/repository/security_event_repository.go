@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type securityEventRepository struct {
+	db *pgxpool.Pool
+}
+
+// Ensure securityEventRepository implements ports.SecurityEventRepository at compile time
+var _ ports.SecurityEventRepository = (*securityEventRepository)(nil)
+
+func NewSecurityEventRepository(db *pgxpool.Pool) ports.SecurityEventRepository {
+	return &securityEventRepository{db: db}
+}
+
+func (r *securityEventRepository) Create(ctx context.Context, event *domain.SecurityEvent) error {
+	query := `
+		INSERT INTO security_events (event_id, user_id, event_type, family_id, session_id, ip_address, user_agent, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.Exec(
+		ctx,
+		query,
+		event.EventID,
+		event.UserID,
+		event.EventType,
+		event.FamilyID,
+		event.SessionID,
+		event.IPAddress,
+		event.UserAgent,
+		event.Details,
+		event.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create security event: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
@@ -22,11 +23,27 @@ func NewSessionRepository(db *pgxpool.Pool) ports.SessionRepository {
 	return &sessionRepository{db: db}
 }
 
+// lastSeenResolution limits how often last_seen_at is written for a busy session
+const lastSeenResolution = time.Minute
+
+// liveSession matches sessions a device can still use: sessions.expires_at only
+// covers the access token, so a session also lives while it holds an unrotated
+// refresh token of a family that is neither revoked nor past its lifetime
+const liveSession = `(s.expires_at > NOW() OR EXISTS (
+			SELECT 1
+			FROM refresh_tokens rt
+			LEFT JOIN refresh_token_families f ON f.family_id = rt.family_id
+			WHERE rt.session_id = s.session_id
+			  AND rt.expires_at > NOW()
+			  AND rt.used_at IS NULL
+			  AND (rt.family_id IS NULL OR (f.revoked_at IS NULL AND f.absolute_expires_at > NOW()))
+		))`
+
 func (r *sessionRepository) Create(ctx context.Context, session *domain.Session) (*domain.Session, error) {
 	query := `
-		INSERT INTO sessions (session_id, user_id, session_token, created_at, expires_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING session_id, user_id, session_token, created_at, expires_at
+		INSERT INTO sessions (session_id, user_id, session_token, ip_address, user_agent, created_at, expires_at, last_seen_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $6)
+		RETURNING session_id, user_id, session_token, ip_address, user_agent, created_at, expires_at, last_seen_at
 	`
 
 	err := r.db.QueryRow(
@@ -35,14 +52,19 @@ func (r *sessionRepository) Create(ctx context.Context, session *domain.Session)
 		session.SessionID,
 		session.UserID,
 		session.SessionToken,
+		session.IPAddress,
+		session.UserAgent,
 		session.CreatedAt,
 		session.ExpiresAt,
 	).Scan(
 		&session.SessionID,
 		&session.UserID,
 		&session.SessionToken,
+		&session.IPAddress,
+		&session.UserAgent,
 		&session.CreatedAt,
 		&session.ExpiresAt,
+		&session.LastSeenAt,
 	)
 
 	if err != nil {
@@ -52,11 +74,12 @@ func (r *sessionRepository) Create(ctx context.Context, session *domain.Session)
 	return session, nil
 }
 
+// GetByID returns a session whether or not its access token has expired
 func (r *sessionRepository) GetByID(ctx context.Context, sessionID uuid.UUID) (*domain.Session, error) {
 	query := `
-		SELECT session_id, user_id, session_token, created_at, expires_at
+		SELECT session_id, user_id, session_token, ip_address, user_agent, created_at, expires_at, last_seen_at
 		FROM sessions
-		WHERE session_id = $1 AND expires_at > NOW()
+		WHERE session_id = $1
 	`
 
 	session := &domain.Session{}
@@ -64,8 +87,11 @@ func (r *sessionRepository) GetByID(ctx context.Context, sessionID uuid.UUID) (*
 		&session.SessionID,
 		&session.UserID,
 		&session.SessionToken,
+		&session.IPAddress,
+		&session.UserAgent,
 		&session.CreatedAt,
 		&session.ExpiresAt,
+		&session.LastSeenAt,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -80,7 +106,7 @@ func (r *sessionRepository) GetByID(ctx context.Context, sessionID uuid.UUID) (*
 
 func (r *sessionRepository) GetByToken(ctx context.Context, token string) (*domain.Session, error) {
 	query := `
-		SELECT session_id, user_id, session_token, created_at, expires_at
+		SELECT session_id, user_id, session_token, ip_address, user_agent, created_at, expires_at, last_seen_at
 		FROM sessions
 		WHERE session_token = $1 AND expires_at > NOW()
 	`
@@ -90,8 +116,11 @@ func (r *sessionRepository) GetByToken(ctx context.Context, token string) (*doma
 		&session.SessionID,
 		&session.UserID,
 		&session.SessionToken,
+		&session.IPAddress,
+		&session.UserAgent,
 		&session.CreatedAt,
 		&session.ExpiresAt,
+		&session.LastSeenAt,
 	)
 
 	if err == pgx.ErrNoRows {
@@ -104,6 +133,42 @@ func (r *sessionRepository) GetByToken(ctx context.Context, token string) (*doma
 	return session, nil
 }
 
+// UpdateToken rotates the access token of an existing session (used on refresh)
+func (r *sessionRepository) UpdateToken(ctx context.Context, sessionID uuid.UUID, token string, expiresAt time.Time) error {
+	query := `
+		UPDATE sessions
+		SET session_token = $2, expires_at = $3, last_seen_at = NOW()
+		WHERE session_id = $1
+	`
+
+	result, err := r.db.Exec(ctx, query, sessionID, token, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to update session token: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("session not found")
+	}
+
+	return nil
+}
+
+// Touch records activity on a session, writing at most once per lastSeenResolution
+func (r *sessionRepository) Touch(ctx context.Context, sessionID uuid.UUID) error {
+	query := `
+		UPDATE sessions
+		SET last_seen_at = NOW()
+		WHERE session_id = $1 AND last_seen_at < NOW() - make_interval(secs => $2)
+	`
+
+	_, err := r.db.Exec(ctx, query, sessionID, lastSeenResolution.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to update session last seen: %w", err)
+	}
+
+	return nil
+}
+
 func (r *sessionRepository) Delete(ctx context.Context, sessionID uuid.UUID) error {
 	query := `DELETE FROM sessions WHERE session_id = $1`
 
@@ -121,12 +186,14 @@ func (r *sessionRepository) Delete(ctx context.Context, sessionID uuid.UUID) err
 	return nil
 }
 
+// GetByUserID returns the user's live sessions, including those whose access
+// token expired but which can still be refreshed
 func (r *sessionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
 	query := `
-		SELECT session_id, user_id, session_token, created_at, expires_at
-		FROM sessions
-		WHERE user_id = $1 AND expires_at > NOW()
-		ORDER BY created_at DESC
+		SELECT s.session_id, s.user_id, s.session_token, s.ip_address, s.user_agent, s.created_at, s.expires_at, s.last_seen_at
+		FROM sessions s
+		WHERE s.user_id = $1 AND ` + liveSession + `
+		ORDER BY s.last_seen_at DESC
 	`
 
 	rows, err := r.db.Query(ctx, query, userID)
@@ -142,8 +209,11 @@ func (r *sessionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (
 			&session.SessionID,
 			&session.UserID,
 			&session.SessionToken,
+			&session.IPAddress,
+			&session.UserAgent,
 			&session.CreatedAt,
 			&session.ExpiresAt,
+			&session.LastSeenAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan session: %w", err)
@@ -158,6 +228,33 @@ func (r *sessionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (
 	return sessions, nil
 }
 
+// DeleteOthers deletes every session of the user but keepSessionID, together
+// with every refresh token not issued for keepSessionID, in one statement. It
+// returns how many live sessions it ended.
+func (r *sessionRepository) DeleteOthers(ctx context.Context, userID, keepSessionID uuid.UUID) (int, error) {
+	query := `
+		WITH live AS (
+			SELECT COUNT(*) AS count
+			FROM sessions s
+			WHERE s.user_id = $1 AND s.session_id <> $2 AND ` + liveSession + `
+		), tokens AS (
+			DELETE FROM refresh_tokens
+			WHERE user_id = $1 AND session_id IS DISTINCT FROM $2
+		), deleted AS (
+			DELETE FROM sessions
+			WHERE user_id = $1 AND session_id <> $2
+		)
+		SELECT count FROM live
+	`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, userID, keepSessionID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to delete other sessions: %w", err)
+	}
+
+	return count, nil
+}
+
 func (r *sessionRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
 	query := `DELETE FROM sessions WHERE user_id = $1`
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -24,7 +25,7 @@ func NewUserRepository(db *pgxpool.Pool) ports.UserRepository {
 
 func (r *userRepository) GetByEmail(ctx context.Context, tenantID uuid.UUID, email string) (*ports.UserData, error) {
 	query := `
-		SELECT user_id, tenant_id, email, name, password, email_verified_at, is_active
+		SELECT user_id, tenant_id, email, name, password, email_verified_at, is_active, password_changed_at, must_change_password
 		FROM users
 		WHERE tenant_id = $1 AND email = $2
 	`
@@ -38,10 +39,12 @@ func (r *userRepository) GetByEmail(ctx context.Context, tenantID uuid.UUID, ema
 		&user.Password,
 		&user.EmailVerifiedAt,
 		&user.IsActive,
+		&user.PasswordChangedAt,
+		&user.MustChangePassword,
 	)
 
 	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+		return nil, domain.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
@@ -91,7 +94,7 @@ func (r *userRepository) Create(ctx context.Context, user *ports.UserData) (*por
 // GetByEmailGlobal gets user by email across all tenants - for tenant-agnostic login
 func (r *userRepository) GetByEmailGlobal(ctx context.Context, email string) (*ports.UserData, error) {
 	query := `
-		SELECT user_id, tenant_id, email, name, password, email_verified_at, is_active
+		SELECT user_id, tenant_id, email, name, password, email_verified_at, is_active, password_changed_at, must_change_password
 		FROM users
 		WHERE email = $1
 		LIMIT 1
@@ -106,10 +109,12 @@ func (r *userRepository) GetByEmailGlobal(ctx context.Context, email string) (*p
 		&user.Password,
 		&user.EmailVerifiedAt,
 		&user.IsActive,
+		&user.PasswordChangedAt,
+		&user.MustChangePassword,
 	)
 
 	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+		return nil, domain.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
@@ -121,7 +126,7 @@ func (r *userRepository) GetByEmailGlobal(ctx context.Context, email string) (*p
 func (r *userRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, hashedPassword string) error {
 	query := `
 		UPDATE users
-		SET password = $1, updated_at = $2
+		SET password = $1, password_changed_at = $2, must_change_password = FALSE, updated_at = $2
 		WHERE user_id = $3
 	`
 
@@ -133,7 +138,27 @@ func (r *userRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, h
 	rows := result.RowsAffected()
 
 	if rows == 0 {
-		return fmt.Errorf("user not found")
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// SetMustChangePassword flags (or clears) a forced password change on next login
+func (r *userRepository) SetMustChangePassword(ctx context.Context, userID uuid.UUID, mustChange bool) error {
+	query := `
+		UPDATE users
+		SET must_change_password = $1, updated_at = $2
+		WHERE user_id = $3
+	`
+
+	result, err := r.db.Exec(ctx, query, mustChange, time.Now(), userID)
+	if err != nil {
+		return fmt.Errorf("failed to update must change password: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
 	}
 
 	return nil
@@ -184,7 +209,7 @@ func (r *userRepository) VerifyEmail(ctx context.Context, userID uuid.UUID) erro
 	rows := result.RowsAffected()
 
 	if rows == 0 {
-		return fmt.Errorf("user not found")
+		return domain.ErrUserNotFound
 	}
 
 	return nil
@@ -201,7 +226,7 @@ func (r *userRepository) Delete(ctx context.Context, userID uuid.UUID) error {
 	rows := result.RowsAffected()
 
 	if rows == 0 {
-		return fmt.Errorf("user not found")
+		return domain.ErrUserNotFound
 	}
 
 	return nil
@@ -209,7 +234,7 @@ func (r *userRepository) Delete(ctx context.Context, userID uuid.UUID) error {
 
 func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*ports.UserData, error) {
 	query := `
-		SELECT user_id, tenant_id, email, name, password, email_verified_at, is_active
+		SELECT user_id, tenant_id, email, name, password, email_verified_at, is_active, password_changed_at, must_change_password
 		FROM users
 		WHERE user_id = $1
 	`
@@ -223,10 +248,12 @@ func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*ports.
 		&user.Password,
 		&user.EmailVerifiedAt,
 		&user.IsActive,
+		&user.PasswordChangedAt,
+		&user.MustChangePassword,
 	)
 
 	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("user not found")
+		return nil, domain.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user by ID: %w", err)
@@ -4,7 +4,9 @@ import (
 	"bufio"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // SSOConfig holds the configuration for SSO providers
@@ -54,6 +56,26 @@ func LoadSSOConfig() *SSOConfig {
 	}
 }
 
+// SessionConfig holds session lifetime settings
+type SessionConfig struct {
+	// AbsoluteLifetime caps how long a login can be kept alive by refresh token rotation
+	AbsoluteLifetime time.Duration
+}
+
+// LoadSessionConfig loads session settings from environment variables
+func LoadSessionConfig() *SessionConfig {
+	lifetime := 30 * 24 * time.Hour
+	if v := os.Getenv("SESSION_ABSOLUTE_LIFETIME_HOURS"); v != "" {
+		if hours, err := strconv.Atoi(v); err == nil && hours > 0 {
+			lifetime = time.Duration(hours) * time.Hour
+		} else {
+			log.Printf("⚠️  Invalid SESSION_ABSOLUTE_LIFETIME_HOURS %q, using default %v", v, lifetime)
+		}
+	}
+
+	return &SessionConfig{AbsoluteLifetime: lifetime}
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
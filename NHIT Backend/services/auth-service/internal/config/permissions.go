@@ -5,6 +5,12 @@ func GetPermissionMap() map[string][]string {
 	return map[string][]string{
 		// Organization Switching
 		"/AuthService/SwitchOrganization": {"switch-organizations"},
+
+		// Session Management (own sessions only need a valid login)
+		"/AuthService/RevokeUserSessions": {"edit-user"},
+
+		// Tenant password policy
+		"/AuthService/UpdatePasswordPolicy": {"system-configuration"},
 		
 		// User Management (if exposed via Auth Service, though usually in User Service)
 		// "/AuthService/Logout": {"logout"}, // Logout usually just requires being logged in, no specific permission
@@ -29,5 +35,7 @@ func GetPublicMethods() []string {
 		"/AuthService/VerifyOTPAndResetPassword",
 		"/AuthService/SendVerificationEmail",
 		"/AuthService/RefreshToken",
+		"/AuthService/ChangeExpiredPassword",
+		"/AuthService/ValidatePassword",
 	}
 }
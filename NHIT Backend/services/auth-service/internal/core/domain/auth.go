@@ -1,26 +1,98 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// ErrUserNotFound is returned when a user does not exist
+var ErrUserNotFound = errors.New("user not found")
+
 // Session represents a user session
 type Session struct {
 	SessionID    uuid.UUID
 	UserID       uuid.UUID
 	SessionToken string
+	IPAddress    string
+	UserAgent    string
 	CreatedAt    time.Time
 	ExpiresAt    time.Time
+	LastSeenAt   time.Time
 }
 
 // RefreshToken represents a refresh token
 type RefreshToken struct {
 	Token     string
 	UserID    uuid.UUID
+	SessionID *uuid.UUID // Session the token was issued for (nil for legacy tokens)
+	FamilyID  *uuid.UUID // Rotation chain the token belongs to (nil for legacy tokens)
 	ExpiresAt time.Time
 	CreatedAt time.Time
+	UsedAt    *time.Time // Set once the token has been rotated
+}
+
+// RefreshTokenFamily is the chain of refresh tokens issued for a single login
+type RefreshTokenFamily struct {
+	FamilyID          uuid.UUID
+	UserID            uuid.UUID
+	SessionID         *uuid.UUID
+	CreatedAt         time.Time
+	AbsoluteExpiresAt time.Time
+	RevokedAt         *time.Time
+	RevokedReason     string
+}
+
+// SecurityEventRefreshTokenReuse is recorded when an already-rotated refresh token is presented
+const SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+
+// SecurityEvent records a security-relevant authentication event
+type SecurityEvent struct {
+	EventID   uuid.UUID
+	UserID    uuid.UUID
+	EventType string
+	FamilyID  *uuid.UUID
+	SessionID *uuid.UUID
+	IPAddress string
+	UserAgent string
+	Details   string
+	CreatedAt time.Time
+}
+
+// PasswordPolicy holds the password rules of a tenant
+type PasswordPolicy struct {
+	TenantID         uuid.UUID
+	MinLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSpecial   bool
+	ExpiryDays       int // 0 disables expiry
+	HistoryDepth     int // 0 disables the reuse check
+	CheckBreached    bool
+	UpdatedAt        time.Time
+}
+
+// DefaultPasswordPolicy returns the policy applied to tenants that have not configured one
+func DefaultPasswordPolicy(tenantID uuid.UUID) *PasswordPolicy {
+	return &PasswordPolicy{
+		TenantID:         tenantID,
+		MinLength:        8,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+		CheckBreached:    true,
+	}
+}
+
+// PasswordExpired reports whether a password last changed at changedAt has expired under the policy
+func (p *PasswordPolicy) PasswordExpired(changedAt time.Time) bool {
+	if p.ExpiryDays <= 0 || changedAt.IsZero() {
+		return false
+	}
+	return time.Now().After(changedAt.AddDate(0, 0, p.ExpiryDays))
 }
 
 // PasswordReset represents a password reset request
@@ -71,13 +143,41 @@ type LoginResponse struct {
 
 // TokenValidation represents a validated token
 type TokenValidation struct {
-	Valid       bool
-	UserID      uuid.UUID
-	Email       string
-	Name        string
-	TenantID    uuid.UUID
-	OrgID       *uuid.UUID
-	Roles       []string
-	Permissions []string
-	ExpiresAt   time.Time
+	Valid        bool
+	UserID       uuid.UUID
+	Email        string
+	Name         string
+	TenantID     uuid.UUID
+	OrgID        *uuid.UUID
+	Roles        []string
+	Permissions  []string
+	ExpiresAt    time.Time
+	SessionID    uuid.UUID
+	Delegations  []DelegatedGrant // Approval delegations in force for the user
+	ScopedGrants []ScopedGrant    // Roles assigned for one organization, project or department
+}
+
+// DelegatedGrant is an approval delegation in force for a user, read from
+// user-service's approval_delegations. OrgID and ProjectID are nil when the
+// delegation is not limited to one.
+type DelegatedGrant struct {
+	DelegationID  uuid.UUID
+	DelegatorID   uuid.UUID
+	DelegatorName string
+	Permissions   []string
+	OrgID         *uuid.UUID
+	ProjectID     *uuid.UUID
+	EndsAt        time.Time
+}
+
+// ScopedGrant is a role assigned to a user for a single organization, project
+// or department, read from user-service's user_role_scopes. ProjectID and
+// DepartmentID are nil unless the assignment is limited to one.
+type ScopedGrant struct {
+	AssignmentID uuid.UUID
+	RoleName     string
+	Permissions  []string
+	OrgID        uuid.UUID
+	ProjectID    *uuid.UUID
+	DepartmentID *uuid.UUID
 }
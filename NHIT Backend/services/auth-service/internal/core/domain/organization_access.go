@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrOrganizationInactive is returned when logging in to, or using a token of,
+// an organization that is deactivated or deleted
+var ErrOrganizationInactive = errors.New("organization is deactivated or deleted")
+
+// OrganizationAccessActive is the only status that lets users into an organization
+const OrganizationAccessActive = "ACTIVE"
+
+// OrganizationAccess is the last known status of an organization
+type OrganizationAccess struct {
+	OrgID     uuid.UUID
+	Status    string
+	Reason    string
+	ChangedAt time.Time
+}
+
+// Blocked reports whether users are kept out of the organization
+func (a *OrganizationAccess) Blocked() bool {
+	return a != nil && a.Status != OrganizationAccessActive
+}
+
+// OrganizationStatusChangedEvent is organization-service's lifecycle event on
+// organization.events. OrgIDs lists every organization the change applied to.
+type OrganizationStatusChangedEvent struct {
+	EventID   string    `json:"event_id"`
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+	TenantID  string    `json:"tenant_id"`
+	OrgID     string    `json:"org_id"`
+	OrgIDs    []string  `json:"org_ids"`
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason"`
+	ChangedBy string    `json:"changed_by"`
+}
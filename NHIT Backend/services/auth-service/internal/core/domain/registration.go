@@ -40,36 +40,6 @@ type Role struct {
 	UpdatedAt    time.Time
 }
 
-// RegistrationRequest represents a new user registration request
-type RegistrationRequest struct {
-	Name             string
-	Email            string
-	Password         string
-	OrganizationName string
-	OrganizationCode string
-	PhoneNumber      *string
-}
-
-// RegistrationResponse represents the response after successful registration
-type RegistrationResponse struct {
-	UserID           uuid.UUID
-	Email            string
-	Name             string
-	OrganizationID   uuid.UUID
-	OrganizationName string
-	IsSuperAdmin     bool
-	Token            string
-	RefreshToken     string
-	Message          string
-}
-
-// SuperAdminRole represents the super admin role details
-type SuperAdminRole struct {
-	RoleID      uuid.UUID
-	RoleName    string
-	Permissions []string
-}
-
 // LoginHistory represents a login record
 type LoginHistory struct {
 	HistoryID     uuid.UUID
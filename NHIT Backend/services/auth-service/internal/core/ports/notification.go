@@ -14,4 +14,7 @@ type NotificationClient interface {
 
 	// SendOtpPasswordResetEmail sends a password reset email with an OTP code
 	SendOtpPasswordResetEmail(ctx context.Context, email, name, otp string) error
+
+	// SendSecurityAlertEmail warns a user about a security event on their account
+	SendSecurityAlertEmail(ctx context.Context, email, name, eventType, ipAddress, userAgent string) error
 }
@@ -14,14 +14,81 @@ type SessionRepository interface {
 	GetByID(ctx context.Context, sessionID uuid.UUID) (*domain.Session, error)
 	GetByToken(ctx context.Context, token string) (*domain.Session, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error)
+	UpdateToken(ctx context.Context, sessionID uuid.UUID, token string, expiresAt time.Time) error
+	Touch(ctx context.Context, sessionID uuid.UUID) error
 	Delete(ctx context.Context, sessionID uuid.UUID) error
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+	// DeleteOthers deletes the user's sessions and refresh tokens except those of
+	// keepSessionID, returning how many live sessions it ended
+	DeleteOthers(ctx context.Context, userID, keepSessionID uuid.UUID) (int, error)
 }
 
 // RefreshTokenRepository defines the interface for refresh token operations
 type RefreshTokenRepository interface {
 	Create(ctx context.Context, token *domain.RefreshToken) error
 	GetUserIDByToken(ctx context.Context, token string) (uuid.UUID, error)
+	GetByToken(ctx context.Context, token string) (*domain.RefreshToken, error)
+	MarkUsed(ctx context.Context, token string) (bool, error)
+	GetSessionIDsByFamilyID(ctx context.Context, familyID uuid.UUID) ([]uuid.UUID, error)
 	Delete(ctx context.Context, token string) error
+	DeleteBySessionID(ctx context.Context, sessionID uuid.UUID) error
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+}
+
+// RefreshTokenFamilyRepository defines the interface for refresh token family operations
+type RefreshTokenFamilyRepository interface {
+	Create(ctx context.Context, family *domain.RefreshTokenFamily) error
+	GetByID(ctx context.Context, familyID uuid.UUID) (*domain.RefreshTokenFamily, error)
+	Revoke(ctx context.Context, familyID uuid.UUID, reason string) error
+	// Adopt creates the family and records it on a legacy refresh token in one
+	// transaction. It returns false, creating nothing, when the token already
+	// belongs to a family or has been rotated.
+	Adopt(ctx context.Context, token string, family *domain.RefreshTokenFamily) (bool, error)
+}
+
+// SecurityEventRepository defines the interface for recording security events
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *domain.SecurityEvent) error
+}
+
+// PasswordPolicyRepository defines the interface for tenant password policy operations
+type PasswordPolicyRepository interface {
+	// GetByTenantID returns the tenant's policy, or the default policy if none is configured
+	GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*domain.PasswordPolicy, error)
+	Upsert(ctx context.Context, policy *domain.PasswordPolicy) (*domain.PasswordPolicy, error)
+}
+
+// PasswordHistoryRepository defines the interface for previous password hashes
+type PasswordHistoryRepository interface {
+	Add(ctx context.Context, userID uuid.UUID, passwordHash string) error
+	GetRecent(ctx context.Context, userID uuid.UUID, limit int) ([]string, error)
+}
+
+// OrganizationAccessRepository defines the interface for the organization
+// statuses received from organization-service
+type OrganizationAccessRepository interface {
+	// Get returns the organization's last known status, or nil if it never changed
+	Get(ctx context.Context, orgID uuid.UUID) (*domain.OrganizationAccess, error)
+	// Apply records the status of every organization in orgIDs, skipping
+	// organizations that already hold a newer change
+	Apply(ctx context.Context, orgIDs []uuid.UUID, status, reason string, changedAt time.Time) error
+}
+
+// DelegationRepository defines the interface for reading the approval
+// delegations managed by user-service
+type DelegationRepository interface {
+	// ListActiveForDelegate returns the delegations in force now for the
+	// delegate whose delegator is still active, each limited to the
+	// permissions the delegator currently holds
+	ListActiveForDelegate(ctx context.Context, tenantID, delegateID uuid.UUID) ([]domain.DelegatedGrant, error)
+}
+
+// ScopedGrantRepository defines the interface for reading the scoped role
+// assignments managed by user-service
+type ScopedGrantRepository interface {
+	// ListActiveForUser returns the user's scoped role assignments that have
+	// not expired
+	ListActiveForUser(ctx context.Context, tenantID, userID uuid.UUID) ([]domain.ScopedGrant, error)
 }
 
 // PasswordResetRepository defines the interface for password reset operations
@@ -54,6 +121,7 @@ type UserRepository interface {
 	GetByEmailGlobal(ctx context.Context, email string) (*UserData, error)
 	GetByID(ctx context.Context, userID uuid.UUID) (*UserData, error)
 	UpdatePassword(ctx context.Context, userID uuid.UUID, hashedPassword string) error
+	SetMustChangePassword(ctx context.Context, userID uuid.UUID, mustChange bool) error
 	UpdateLastLogin(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error
 	UpdateLastLogout(ctx context.Context, userID uuid.UUID) error
 	VerifyEmail(ctx context.Context, userID uuid.UUID) error
@@ -71,6 +139,9 @@ type UserData struct {
 	Password        string
 	EmailVerifiedAt *time.Time
 	IsActive        bool
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	// PasswordChangedAt and MustChangePassword drive password expiry
+	PasswordChangedAt  time.Time
+	MustChangePassword bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
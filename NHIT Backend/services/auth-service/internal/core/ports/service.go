@@ -36,7 +36,19 @@ type AuthService interface {
 	VerifyOTPAndResetPassword(ctx context.Context, email, otp, newPassword string, tenantID uuid.UUID) error
 	VerifyOTPAndResetPasswordByEmail(ctx context.Context, email, otp, newPassword string) error // Fetches tenant_id from email
 
+	// Password Policy
+	ChangeExpiredPassword(ctx context.Context, email, currentPassword, newPassword string) error
+	GetPasswordPolicy(ctx context.Context, tenantID uuid.UUID) (*domain.PasswordPolicy, error)
+	UpdatePasswordPolicy(ctx context.Context, policy *domain.PasswordPolicy) (*domain.PasswordPolicy, error)
+	ValidatePassword(ctx context.Context, tenantID uuid.UUID, password string) error
+
 	// Session Management
 	InvalidateAllSessions(ctx context.Context, userID uuid.UUID) error
 	GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	RevokeAllOtherSessions(ctx context.Context, userID, currentSessionID uuid.UUID) (int, error)
+	RevokeUserSessions(ctx context.Context, tenantID, userID uuid.UUID) (int, error) // Admin force-logout
+
+	// Organization Access
+	ApplyOrganizationStatus(ctx context.Context, event *domain.OrganizationStatusChangedEvent) error
 }
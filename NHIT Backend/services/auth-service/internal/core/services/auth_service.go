@@ -30,6 +30,15 @@ type authService struct {
 	userServiceClient     userpb.UserManagementClient     // gRPC client for User Service
 	orgClient             ports.OrganizationServiceClient // gRPC client for Organization Service
 	ssoConfig             *config.SSOConfig
+	refreshFamilyRepo     ports.RefreshTokenFamilyRepository
+	securityEventRepo     ports.SecurityEventRepository
+	sessionConfig         *config.SessionConfig
+	passwordPolicyRepo    ports.PasswordPolicyRepository
+	passwordHistoryRepo   ports.PasswordHistoryRepository
+	breachedPasswords     *utils.BreachedPasswordList
+	orgAccessRepo         ports.OrganizationAccessRepository
+	delegationRepo        ports.DelegationRepository
+	scopedGrantRepo       ports.ScopedGrantRepository
 }
 
 // NewAuthService creates a new auth service
@@ -46,6 +55,15 @@ func NewAuthService(
 	userServiceClient userpb.UserManagementClient,
 	orgClient ports.OrganizationServiceClient,
 	ssoConfig *config.SSOConfig,
+	refreshFamilyRepo ports.RefreshTokenFamilyRepository,
+	securityEventRepo ports.SecurityEventRepository,
+	sessionConfig *config.SessionConfig,
+	passwordPolicyRepo ports.PasswordPolicyRepository,
+	passwordHistoryRepo ports.PasswordHistoryRepository,
+	breachedPasswords *utils.BreachedPasswordList,
+	orgAccessRepo ports.OrganizationAccessRepository,
+	delegationRepo ports.DelegationRepository,
+	scopedGrantRepo ports.ScopedGrantRepository,
 ) ports.AuthService {
 	return &authService{
 		userRepo:              userRepo,
@@ -60,6 +78,15 @@ func NewAuthService(
 		userServiceClient:     userServiceClient,
 		orgClient:             orgClient,
 		ssoConfig:             ssoConfig,
+		refreshFamilyRepo:     refreshFamilyRepo,
+		securityEventRepo:     securityEventRepo,
+		sessionConfig:         sessionConfig,
+		passwordPolicyRepo:    passwordPolicyRepo,
+		passwordHistoryRepo:   passwordHistoryRepo,
+		breachedPasswords:     breachedPasswords,
+		orgAccessRepo:         orgAccessRepo,
+		delegationRepo:        delegationRepo,
+		scopedGrantRepo:       scopedGrantRepo,
 	}
 }
 
@@ -75,8 +102,8 @@ func firstMetadataValue(md metadata.MD, keys ...string) string {
 
 // Register registers a new user
 func (s *authService) Register(ctx context.Context, tenantID uuid.UUID, orgID *uuid.UUID, name, email, password string, roles []string) (*domain.LoginResponse, error) {
-	// Validate password strength
-	if err := utils.ValidatePasswordStrength(password); err != nil {
+	// Validate password against the tenant's policy
+	if _, err := s.validateNewPassword(ctx, tenantID, uuid.Nil, password); err != nil {
 		return nil, fmt.Errorf("password validation failed: %w", err)
 	}
 
@@ -114,6 +141,7 @@ func (s *authService) Register(ctx context.Context, tenantID uuid.UUID, orgID *u
 	}
 
 	log.Printf("✅ User created via User Service: %s (%s)", userResp.UserId, email)
+	s.recordPasswordChange(ctx, userID, hashedPassword)
 
 	// Send verification email
 	verificationToken, err := s.emailVerificationRepo.Create(ctx, userID, time.Now().Add(24*time.Hour))
@@ -143,24 +171,14 @@ func (s *authService) Register(ctx context.Context, tenantID uuid.UUID, orgID *u
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	// Store refresh token
-	if err := s.refreshTokenRepo.Create(ctx, &domain.RefreshToken{
-		Token:     refreshToken,
-		UserID:    userID,
-		ExpiresAt: time.Unix(refreshExpiresAt, 0),
-		CreatedAt: time.Now(),
-	}); err != nil {
+	// Store refresh token as the start of a new rotation family, bound to the session created below
+	sessionID := uuid.New()
+	if err := s.startRefreshTokenFamily(ctx, refreshToken, userID, sessionID, time.Unix(refreshExpiresAt, 0)); err != nil {
 		return nil, fmt.Errorf("failed to store refresh token: %w", err)
 	}
 
 	// Create session
-	session := &domain.Session{
-		SessionID:    uuid.New(),
-		UserID:       userID,
-		SessionToken: accessToken,
-		CreatedAt:    time.Now(),
-		ExpiresAt:    time.Unix(accessExpiresAt, 0),
-	}
+	session := newSession(ctx, sessionID, userID, accessToken, time.Unix(accessExpiresAt, 0))
 	if _, err := s.sessionRepo.Create(ctx, session); err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
@@ -202,6 +220,11 @@ func (s *authService) LoginGlobal(ctx context.Context, email, password string, o
 		return nil, fmt.Errorf("user account is deactivated")
 	}
 
+	// Expired passwords must be changed before a new session is issued
+	if err := s.checkPasswordAge(ctx, user); err != nil {
+		return nil, err
+	}
+
 	// Check if email is verified unless user is super admin
 	if user.EmailVerifiedAt == nil {
 		roles, err := s.userServiceClient.ListRolesOfUser(ctx, &userpb.GetUserRequest{
@@ -237,24 +260,13 @@ func (s *authService) LoginGlobal(ctx context.Context, email, password string, o
 		if err != nil {
 			fmt.Printf("⚠️  Failed to list user organizations for user %s: %v\n", user.UserID, err)
 		} else if len(orgsResp.Organizations) > 0 {
-			var selected *userpb.UserOrganizationInfo
-			for _, o := range orgsResp.Organizations {
-				if o.IsCurrentContext {
-					selected = o
-					break
-				}
-			}
-			if selected == nil {
-				selected = orgsResp.Organizations[0]
-			}
-			if selected.OrgId != "" {
-				if parsedOrgID, err := uuid.Parse(selected.OrgId); err != nil {
-					fmt.Printf("⚠️  Invalid org_id %q for user %s: %v\n", selected.OrgId, user.UserID, err)
-				} else {
-					orgID = &parsedOrgID
-				}
+			orgID = s.selectLoginOrganization(ctx, user.UserID, orgsResp.Organizations)
+			if orgID == nil {
+				return nil, domain.ErrOrganizationInactive
 			}
 		}
+	} else if err := s.checkOrganizationAccess(ctx, *orgID); err != nil {
+		return nil, err
 	}
 
 	if orgID == nil {
@@ -298,24 +310,14 @@ func (s *authService) LoginGlobal(ctx context.Context, email, password string, o
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	// Store refresh token
-	if err := s.refreshTokenRepo.Create(ctx, &domain.RefreshToken{
-		Token:     refreshToken,
-		UserID:    user.UserID,
-		ExpiresAt: time.Unix(refreshExpiresAt, 0),
-		CreatedAt: time.Now(),
-	}); err != nil {
+	// Store refresh token as the start of a new rotation family, bound to the session created below
+	sessionID := uuid.New()
+	if err := s.startRefreshTokenFamily(ctx, refreshToken, user.UserID, sessionID, time.Unix(refreshExpiresAt, 0)); err != nil {
 		return nil, fmt.Errorf("failed to store refresh token: %w", err)
 	}
 
 	// Create session
-	session := &domain.Session{
-		SessionID:    uuid.New(),
-		UserID:       user.UserID,
-		SessionToken: accessToken,
-		CreatedAt:    time.Now(),
-		ExpiresAt:    time.Unix(accessExpiresAt, 0),
-	}
+	session := newSession(ctx, sessionID, user.UserID, accessToken, time.Unix(accessExpiresAt, 0))
 	createdSession, err := s.sessionRepo.Create(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
@@ -388,6 +390,11 @@ func (s *authService) Login(ctx context.Context, email, password string, tenantI
 		return nil, fmt.Errorf("user account is deactivated")
 	}
 
+	// Expired passwords must be changed before a new session is issued
+	if err := s.checkPasswordAge(ctx, user); err != nil {
+		return nil, err
+	}
+
 	// Check if email is verified unless user is super admin
 	if user.EmailVerifiedAt == nil {
 		roles, err := s.userServiceClient.ListRolesOfUser(ctx, &userpb.GetUserRequest{
@@ -423,24 +430,13 @@ func (s *authService) Login(ctx context.Context, email, password string, tenantI
 		if err != nil {
 			fmt.Printf("⚠️  Failed to list user organizations for user %s: %v\n", user.UserID, err)
 		} else if len(orgsResp.Organizations) > 0 {
-			var selected *userpb.UserOrganizationInfo
-			for _, o := range orgsResp.Organizations {
-				if o.IsCurrentContext {
-					selected = o
-					break
-				}
-			}
-			if selected == nil {
-				selected = orgsResp.Organizations[0]
-			}
-			if selected.OrgId != "" {
-				if parsedOrgID, err := uuid.Parse(selected.OrgId); err != nil {
-					fmt.Printf("⚠️  Invalid org_id %q for user %s: %v\n", selected.OrgId, user.UserID, err)
-				} else {
-					orgID = &parsedOrgID
-				}
+			orgID = s.selectLoginOrganization(ctx, user.UserID, orgsResp.Organizations)
+			if orgID == nil {
+				return nil, domain.ErrOrganizationInactive
 			}
 		}
+	} else if err := s.checkOrganizationAccess(ctx, *orgID); err != nil {
+		return nil, err
 	}
 
 	if orgID == nil {
@@ -484,24 +480,14 @@ func (s *authService) Login(ctx context.Context, email, password string, tenantI
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	// Store refresh token
-	if err := s.refreshTokenRepo.Create(ctx, &domain.RefreshToken{
-		Token:     refreshToken,
-		UserID:    user.UserID,
-		ExpiresAt: time.Unix(refreshExpiresAt, 0),
-		CreatedAt: time.Now(),
-	}); err != nil {
+	// Store refresh token as the start of a new rotation family, bound to the session created below
+	sessionID := uuid.New()
+	if err := s.startRefreshTokenFamily(ctx, refreshToken, user.UserID, sessionID, time.Unix(refreshExpiresAt, 0)); err != nil {
 		return nil, fmt.Errorf("failed to store refresh token: %w", err)
 	}
 
 	// Create session
-	session := &domain.Session{
-		SessionID:    uuid.New(),
-		UserID:       user.UserID,
-		SessionToken: accessToken,
-		CreatedAt:    time.Now(),
-		ExpiresAt:    time.Unix(accessExpiresAt, 0),
-	}
+	session := newSession(ctx, sessionID, user.UserID, accessToken, time.Unix(accessExpiresAt, 0))
 	createdSession, err := s.sessionRepo.Create(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
@@ -591,7 +577,7 @@ func (s *authService) Logout(ctx context.Context, userID uuid.UUID, refreshToken
 	}
 
 	fmt.Printf("Logout complete for user %s: Invalidated %d session(s)\n", userID, invalidatedSessions)
-	
+
 	// Update last logout time
 	if err := s.userRepo.UpdateLastLogout(ctx, userID); err != nil {
 		fmt.Printf("⚠️  Failed to update last logout: %v\n", err)
@@ -614,24 +600,48 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string, ten
 	}
 
 	// Check if refresh token exists in database
-	storedUserID, err := s.refreshTokenRepo.GetUserIDByToken(ctx, refreshToken)
+	storedToken, err := s.refreshTokenRepo.GetByToken(ctx, refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("refresh token not found or expired")
 	}
 
-	if storedUserID != userID {
+	if storedToken.UserID != userID {
 		return nil, fmt.Errorf("refresh token user ID mismatch")
 	}
 
+	// Reject tokens of revoked or expired families and detect replays of rotated tokens
+	family, err := s.checkRefreshTokenFamily(ctx, storedToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark the presented token as rotated; losing this race means it was replayed concurrently
+	rotated, err := s.refreshTokenRepo.MarkUsed(ctx, refreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if !rotated {
+		s.handleRefreshTokenReuse(ctx, storedToken, &family.FamilyID)
+		return nil, utils.ErrRefreshTokenReused
+	}
+
 	// Get user details by ID from the refresh token
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
+	// A password that expired since login blocks the session as well
+	if err := s.checkPasswordAge(ctx, user); err != nil {
+		return nil, err
+	}
+
 	// Generate new tokens
 	orgIDStr := ""
 	if orgID != nil {
+		if err := s.checkOrganizationAccess(ctx, *orgID); err != nil {
+			return nil, err
+		}
 		orgIDStr = orgID.String()
 	}
 
@@ -670,55 +680,39 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string, ten
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	// Delete old refresh token
-	if err := s.refreshTokenRepo.Delete(ctx, refreshToken); err != nil {
-		fmt.Printf("⚠️  Failed to delete old refresh token: %v\n", err)
+	// Keep the device's session when the refresh token is bound to one; legacy
+	// tokens without a session get a fresh session instead
+	var createdSession *domain.Session
+	if storedToken.SessionID != nil {
+		if err := s.sessionRepo.UpdateToken(ctx, *storedToken.SessionID, accessToken, time.Unix(accessExpiresAt, 0)); err != nil {
+			fmt.Printf("⚠️  Failed to rotate session %s, creating a new one: %v\n", *storedToken.SessionID, err)
+		} else {
+			createdSession = &domain.Session{SessionID: *storedToken.SessionID}
+		}
+	}
+	if createdSession == nil {
+		session := newSession(ctx, uuid.New(), user.UserID, accessToken, time.Unix(accessExpiresAt, 0))
+		createdSession, err = s.sessionRepo.Create(ctx, session)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create session: %w", err)
+		}
 	}
 
-	// Store new refresh token
+	// Store new refresh token in the same family; it never outlives the family's absolute lifetime
+	if family.AbsoluteExpiresAt.Unix() < refreshExpiresAt {
+		refreshExpiresAt = family.AbsoluteExpiresAt.Unix()
+	}
 	if err := s.refreshTokenRepo.Create(ctx, &domain.RefreshToken{
 		Token:     newRefreshToken,
 		UserID:    user.UserID,
+		SessionID: &createdSession.SessionID,
+		FamilyID:  &family.FamilyID,
 		ExpiresAt: time.Unix(refreshExpiresAt, 0),
 		CreatedAt: time.Now(),
 	}); err != nil {
 		return nil, fmt.Errorf("failed to store new refresh token: %w", err)
 	}
 
-	// Handle existing sessions
-	// First, check if there are any active sessions
-	existingSessions, err := s.sessionRepo.GetByUserID(ctx, userID)
-	if err != nil {
-		fmt.Printf("⚠️  Error checking existing sessions: %v\n", err)
-		// Continue even if there's an error checking sessions
-	}
-
-	// Create new session regardless of existing ones
-	session := &domain.Session{
-		SessionID:    uuid.New(),
-		UserID:       user.UserID,
-		SessionToken: accessToken,
-		CreatedAt:    time.Now(),
-		ExpiresAt:    time.Unix(accessExpiresAt, 0),
-	}
-	createdSession, err := s.sessionRepo.Create(ctx, session)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
-	}
-
-	// Log the session refresh activity for debugging purposes
-	expiredSessionsCount := 0
-	for _, existingSession := range existingSessions {
-		if existingSession.ExpiresAt.Before(time.Now()) {
-			expiredSessionsCount++
-		}
-	}
-
-	if len(existingSessions) > 0 {
-		fmt.Printf("Refreshed session for user %s: Found %d existing sessions (%d expired)\n",
-			userID, len(existingSessions), expiredSessionsCount)
-	}
-
 	// Update last login time
 	if err := s.userRepo.UpdateLastLogin(ctx, user.UserID, "", ""); err != nil {
 		fmt.Printf("⚠️  Failed to update last login during refresh: %v\n", err)
@@ -750,7 +744,7 @@ func (s *authService) ValidateToken(ctx context.Context, token string) (*domain.
 		return &domain.TokenValidation{Valid: false}, fmt.Errorf("invalid token: %w", err)
 	}
 
-	// Check if session exists and is valid
+	// Check if session exists and is valid (revoked sessions are deleted)
 	session, err := s.sessionRepo.GetByToken(ctx, token)
 	if err != nil {
 		return &domain.TokenValidation{Valid: false}, fmt.Errorf("session not found")
@@ -760,12 +754,20 @@ func (s *authService) ValidateToken(ctx context.Context, token string) (*domain.
 		return &domain.TokenValidation{Valid: false}, fmt.Errorf("session expired")
 	}
 
+	if err := s.sessionRepo.Touch(ctx, session.SessionID); err != nil {
+		log.Printf("⚠️  Failed to update last seen for session %s: %v", session.SessionID, err)
+	}
+
 	userID, _ := uuid.Parse(claims.UserID)
 	tenantID, _ := uuid.Parse(claims.TenantID)
 	var orgID *uuid.UUID
 	if claims.OrgID != "" {
 		oid, _ := uuid.Parse(claims.OrgID)
 		orgID = &oid
+		// Tokens stop working as soon as their organization is deactivated or deleted
+		if err := s.checkOrganizationAccess(ctx, oid); err != nil {
+			return &domain.TokenValidation{Valid: false}, err
+		}
 	}
 
 	expiresAt := time.Now()
@@ -773,16 +775,37 @@ func (s *authService) ValidateToken(ctx context.Context, token string) (*domain.
 		expiresAt = claims.RegisteredClaims.ExpiresAt.Time
 	}
 
+	// Delegations and scoped role assignments are read on every validation
+	// rather than baked into the token, so they take effect and end without a
+	// new login
+	var delegations []domain.DelegatedGrant
+	if s.delegationRepo != nil {
+		delegations, err = s.delegationRepo.ListActiveForDelegate(ctx, tenantID, userID)
+		if err != nil {
+			log.Printf("⚠️  Failed to load delegations for user %s: %v", userID, err)
+		}
+	}
+	var scopedGrants []domain.ScopedGrant
+	if s.scopedGrantRepo != nil {
+		scopedGrants, err = s.scopedGrantRepo.ListActiveForUser(ctx, tenantID, userID)
+		if err != nil {
+			log.Printf("⚠️  Failed to load scoped roles for user %s: %v", userID, err)
+		}
+	}
+
 	return &domain.TokenValidation{
-		Valid:       true,
-		UserID:      userID,
-		Email:       claims.Email,
-		Name:        claims.Name,
-		TenantID:    tenantID,
-		OrgID:       orgID,
-		Roles:       claims.Roles,
-		Permissions: claims.Permissions,
-		ExpiresAt:   expiresAt,
+		Valid:        true,
+		UserID:       userID,
+		Email:        claims.Email,
+		Name:         claims.Name,
+		TenantID:     tenantID,
+		OrgID:        orgID,
+		Roles:        claims.Roles,
+		Permissions:  claims.Permissions,
+		ExpiresAt:    expiresAt,
+		SessionID:    session.SessionID,
+		Delegations:  delegations,
+		ScopedGrants: scopedGrants,
 	}, nil
 }
 
@@ -895,11 +918,6 @@ func (s *authService) ForgotPassword(ctx context.Context, email string) error {
 
 // ResetPasswordByToken resets a user's password using a reset token
 func (s *authService) ResetPasswordByToken(ctx context.Context, token, newPassword string) error {
-	// Validate password strength
-	if err := utils.ValidatePasswordStrength(newPassword); err != nil {
-		return fmt.Errorf("password validation failed: %w", err)
-	}
-
 	tokenUUID, err := uuid.Parse(token)
 	if err != nil {
 		return fmt.Errorf("invalid reset token format")
@@ -916,26 +934,32 @@ func (s *authService) ResetPasswordByToken(ctx context.Context, token, newPasswo
 		return fmt.Errorf("reset token has expired")
 	}
 
-	// Hash new password
-	hashedPassword, err := utils.HashPassword(newPassword)
+	// Fetch user details for the tenant policy and cross-table sync
+	user, err := s.userRepo.GetByID(ctx, resetRecord.UserID)
 	if err != nil {
-		return fmt.Errorf("failed to hash password: %w", err)
+		return fmt.Errorf("failed to fetch user for sync: %w", err)
 	}
 
-	// Fetch user details to get email for cross-table sync
-	user, err := s.userRepo.GetByID(ctx, resetRecord.UserID)
+	// Validate password against the tenant's policy
+	if _, err := s.validateNewPassword(ctx, user.TenantID, user.UserID, newPassword); err != nil {
+		return fmt.Errorf("password validation failed: %w", err)
+	}
+
+	// Hash new password
+	hashedPassword, err := utils.HashPassword(newPassword)
 	if err != nil {
-		return fmt.Errorf("failed to fetch user for sync: %w", err)
+		return fmt.Errorf("failed to hash password: %w", err)
 	}
 
 	// Update password in users table
 	if err := s.userRepo.UpdatePassword(ctx, resetRecord.UserID, hashedPassword); err != nil {
 		return fmt.Errorf("failed to update password: %w", err)
 	}
+	s.recordPasswordChange(ctx, resetRecord.UserID, hashedPassword)
 
 	// TRIGGER SYNC: Update password in tenants and organizations tables if email matches
 	log.Printf("Syncing password for %s across platforms (token-based)...", user.Email)
-	
+
 	if err := s.userRepo.UpdateTenantPassword(ctx, user.Email, hashedPassword); err != nil {
 		log.Printf("⚠️  Failed to sync tenant password for %s: %v", user.Email, err)
 	}
@@ -956,30 +980,6 @@ func (s *authService) ResetPasswordByToken(ctx context.Context, token, newPasswo
 	return nil
 }
 
-// InvalidateAllSessions invalidates all sessions for a user
-func (s *authService) InvalidateAllSessions(ctx context.Context, userID uuid.UUID) error {
-	// Get all sessions for user
-	sessions, err := s.GetActiveSessions(ctx, userID)
-	if err != nil {
-		return fmt.Errorf("failed to get sessions: %w", err)
-	}
-
-	// Delete each session
-	for _, session := range sessions {
-		if err := s.sessionRepo.Delete(ctx, session.SessionID); err != nil {
-			fmt.Printf("⚠️  Failed to delete session %s: %v\n", session.SessionID, err)
-		}
-	}
-
-	return nil
-}
-
-// GetActiveSessions gets all active sessions for a user
-func (s *authService) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
-	// Use sessionRepo's GetByUserID method
-	return s.sessionRepo.GetByUserID(ctx, userID)
-}
-
 // SwitchOrganization switches a user to a different organization within the same tenant
 func (s *authService) SwitchOrganization(ctx context.Context, userID uuid.UUID, newOrgID uuid.UUID, tenantID uuid.UUID) (*domain.LoginResponse, error) {
 	// Verify user exists and belongs to the tenant
@@ -992,6 +992,10 @@ func (s *authService) SwitchOrganization(ctx context.Context, userID uuid.UUID,
 		return nil, fmt.Errorf("user does not belong to the specified tenant")
 	}
 
+	if err := s.checkPasswordAge(ctx, user); err != nil {
+		return nil, err
+	}
+
 	// Verify the organization exists and belongs to the SAME tenant (critical validation)
 	org, err := s.orgClient.GetOrganization(ctx, newOrgID)
 	if err != nil {
@@ -1002,6 +1006,10 @@ func (s *authService) SwitchOrganization(ctx context.Context, userID uuid.UUID,
 		return nil, fmt.Errorf("organization does not belong to the specified tenant - cross-tenant switching not allowed")
 	}
 
+	if err := s.checkOrganizationAccess(ctx, newOrgID); err != nil {
+		return nil, err
+	}
+
 	// Additional validation: ensure user has access to organizations within the same tenant
 	// Get user's current organizations to validate switching rights
 	userOrgs, err := s.orgClient.ListUserOrganizations(ctx, userID)
@@ -1067,13 +1075,7 @@ func (s *authService) SwitchOrganization(ctx context.Context, userID uuid.UUID,
 	sessionID := uuid.New()
 	expiresAt := time.Now().Add(24 * time.Hour) // 24 hour session
 
-	session := &domain.Session{
-		SessionID:    sessionID,
-		UserID:       userID,
-		SessionToken: token, // Store the actual token!
-		CreatedAt:    time.Now(),
-		ExpiresAt:    expiresAt,
-	}
+	session := newSession(ctx, sessionID, userID, token, expiresAt) // Store the actual token!
 
 	createdSession, err := s.sessionRepo.Create(ctx, session)
 	if err != nil {
@@ -1085,15 +1087,8 @@ func (s *authService) SwitchOrganization(ctx context.Context, userID uuid.UUID,
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	// Store refresh token
-	refreshTokenRecord := &domain.RefreshToken{
-		Token:     refreshToken,
-		UserID:    userID,
-		ExpiresAt: time.Unix(refreshExpiresAt, 0),
-		CreatedAt: time.Now(),
-	}
-
-	if err := s.refreshTokenRepo.Create(ctx, refreshTokenRecord); err != nil {
+	// Store refresh token as the start of a new rotation family
+	if err := s.startRefreshTokenFamily(ctx, refreshToken, userID, createdSession.SessionID, time.Unix(refreshExpiresAt, 0)); err != nil {
 		return nil, fmt.Errorf("failed to store refresh token: %w", err)
 	}
 
@@ -1164,7 +1159,7 @@ func (s *authService) CompleteSSO(ctx context.Context, provider, code string) (*
 	// 3. Login or Register
 	// Logic: Try to login if user exists. If not, fail (or auto-register if enabled).
 	// For production strictness, we search Globally.
-	
+
 	// We use LoginGlobal logic here but bypass password check
 	// Check if user exists by email globally
 	user, err := s.userRepo.GetByEmailGlobal(ctx, userInfo.Email)
@@ -1175,7 +1170,7 @@ func (s *authService) CompleteSSO(ctx context.Context, provider, code string) (*
 	}
 
 	// User found - Proceed to Login
-	
+
 	// Ensure email is verified (implicitly yes since it came from SSO, but let's be safe)
 	if user.EmailVerifiedAt == nil {
 		// Auto-verify email since identity provider verified it
@@ -1195,20 +1190,9 @@ func (s *authService) CompleteSSO(ctx context.Context, provider, code string) (*
 		UserId: user.UserID.String(),
 	})
 	if err == nil && len(orgsResp.Organizations) > 0 {
-		var selected *userpb.UserOrganizationInfo
-		for _, o := range orgsResp.Organizations {
-			if o.IsCurrentContext {
-				selected = o
-				break
-			}
-		}
-		if selected == nil {
-			selected = orgsResp.Organizations[0]
-		}
-		if selected.OrgId != "" {
-			if parsedOrgID, err := uuid.Parse(selected.OrgId); err == nil {
-				orgID = &parsedOrgID
-			}
+		orgID = s.selectLoginOrganization(ctx, user.UserID, orgsResp.Organizations)
+		if orgID == nil {
+			return nil, domain.ErrOrganizationInactive
 		}
 	}
 
@@ -1225,10 +1209,16 @@ func (s *authService) CompleteSSO(ctx context.Context, provider, code string) (*
 	if err == nil {
 		permSet := make(map[string]struct{})
 		for _, r := range rolesResp.Roles {
-			if r.Name != "" { roleNames = append(roleNames, r.Name) }
-			for _, p := range r.Permissions { permSet[p] = struct{}{} }
+			if r.Name != "" {
+				roleNames = append(roleNames, r.Name)
+			}
+			for _, p := range r.Permissions {
+				permSet[p] = struct{}{}
+			}
+		}
+		for p := range permSet {
+			permissions = append(permissions, p)
 		}
-		for p := range permSet { permissions = append(permissions, p) }
 	}
 
 	accessToken, accessExpiresAt, err := s.jwtManager.GenerateAccessToken(
@@ -1243,24 +1233,14 @@ func (s *authService) CompleteSSO(ctx context.Context, provider, code string) (*
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	// Store refresh token
-	if err := s.refreshTokenRepo.Create(ctx, &domain.RefreshToken{
-		Token:     refreshToken,
-		UserID:    user.UserID,
-		ExpiresAt: time.Unix(refreshExpiresAt, 0),
-		CreatedAt: time.Now(),
-	}); err != nil {
+	// Store refresh token as the start of a new rotation family, bound to the session created below
+	sessionID := uuid.New()
+	if err := s.startRefreshTokenFamily(ctx, refreshToken, user.UserID, sessionID, time.Unix(refreshExpiresAt, 0)); err != nil {
 		return nil, fmt.Errorf("failed to store refresh token: %w", err)
 	}
 
 	// Create session
-	session := &domain.Session{
-		SessionID:    uuid.New(),
-		UserID:       user.UserID,
-		SessionToken: accessToken,
-		CreatedAt:    time.Now(),
-		ExpiresAt:    time.Unix(accessExpiresAt, 0),
-	}
+	session := newSession(ctx, sessionID, user.UserID, accessToken, time.Unix(accessExpiresAt, 0))
 	createdSession, err := s.sessionRepo.Create(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
@@ -1283,4 +1263,3 @@ func (s *authService) CompleteSSO(ctx context.Context, provider, code string) (*
 		SessionID:        createdSession.SessionID,
 	}, nil
 }
-
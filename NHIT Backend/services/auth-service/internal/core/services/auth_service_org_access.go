@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+// ApplyOrganizationStatus records an organization lifecycle event from
+// organization-service. Every organization in the event takes its status.
+func (s *authService) ApplyOrganizationStatus(ctx context.Context, event *domain.OrganizationStatusChangedEvent) error {
+	if s.orgAccessRepo == nil || event.Status == "" {
+		return nil
+	}
+
+	ids := event.OrgIDs
+	if len(ids) == 0 && event.OrgID != "" {
+		ids = []string{event.OrgID}
+	}
+	orgIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			log.Printf("⚠️  Skipping invalid org_id %q in %s event: %v", id, event.EventType, err)
+			continue
+		}
+		orgIDs = append(orgIDs, parsed)
+	}
+	if len(orgIDs) == 0 {
+		return nil
+	}
+
+	changedAt := event.Timestamp
+	if changedAt.IsZero() {
+		changedAt = time.Now().UTC()
+	}
+	if err := s.orgAccessRepo.Apply(ctx, orgIDs, event.Status, event.Reason, changedAt); err != nil {
+		return err
+	}
+	log.Printf("🏢 %d organization(s) now %s after %s of %s", len(orgIDs), event.Status, event.EventType, event.OrgID)
+	return nil
+}
+
+// checkOrganizationAccess refuses organizations that are deactivated or deleted
+func (s *authService) checkOrganizationAccess(ctx context.Context, orgID uuid.UUID) error {
+	if s.orgAccessRepo == nil {
+		return nil
+	}
+	access, err := s.orgAccessRepo.Get(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if access.Blocked() {
+		if access.Reason != "" {
+			return fmt.Errorf("%w: %s", domain.ErrOrganizationInactive, access.Reason)
+		}
+		return domain.ErrOrganizationInactive
+	}
+	return nil
+}
+
+// selectLoginOrganization picks the organization a login lands in: the user's
+// current context if it is accessible, otherwise their first accessible one
+func (s *authService) selectLoginOrganization(ctx context.Context, userID uuid.UUID, orgs []*userpb.UserOrganizationInfo) *uuid.UUID {
+	var selected *uuid.UUID
+	for _, o := range orgs {
+		if o.OrgId == "" {
+			continue
+		}
+		parsedOrgID, err := uuid.Parse(o.OrgId)
+		if err != nil {
+			fmt.Printf("⚠️  Invalid org_id %q for user %s: %v\n", o.OrgId, userID, err)
+			continue
+		}
+		if err := s.checkOrganizationAccess(ctx, parsedOrgID); err != nil {
+			continue
+		}
+		if o.IsCurrentContext {
+			return &parsedOrgID
+		}
+		if selected == nil {
+			selected = &parsedOrgID
+		}
+	}
+	return selected
+}
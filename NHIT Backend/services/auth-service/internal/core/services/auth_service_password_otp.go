@@ -101,8 +101,8 @@ func (s *authService) VerifyOTPAndResetPassword(ctx context.Context, email, otp,
 		return fmt.Errorf("user not found")
 	}
 
-	// Validate password strength
-	if err := utils.ValidatePasswordStrength(newPassword); err != nil {
+	// Validate password against the tenant's policy
+	if _, err := s.validateNewPassword(ctx, tenantID, user.UserID, newPassword); err != nil {
 		return fmt.Errorf("password validation failed: %w", err)
 	}
 
@@ -127,6 +127,7 @@ func (s *authService) VerifyOTPAndResetPassword(ctx context.Context, email, otp,
 	if err := s.userRepo.UpdatePassword(ctx, user.UserID, hashedPassword); err != nil {
 		return fmt.Errorf("failed to update password: %w", err)
 	}
+	s.recordPasswordChange(ctx, user.UserID, hashedPassword)
 
 	// TRIGGER SYNC: Update password in tenants and organizations tables if email matches
 	// This ensures consistency across the platform as requested
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/ports"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/utils"
+	"github.com/google/uuid"
+)
+
+// maxPasswordHistoryDepth bounds how many previous passwords a tenant may forbid reusing
+const maxPasswordHistoryDepth = 24
+
+// validateNewPassword checks a new password against the tenant's policy: composition rules,
+// the breached password list and, for an existing user, their recent passwords.
+// Pass uuid.Nil as userID when the user does not exist yet.
+func (s *authService) validateNewPassword(ctx context.Context, tenantID, userID uuid.UUID, password string) (*domain.PasswordPolicy, error) {
+	policy, err := s.passwordPolicyRepo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := utils.PasswordRules{
+		MinLength:        policy.MinLength,
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireDigit:     policy.RequireDigit,
+		RequireSpecial:   policy.RequireSpecial,
+	}
+	if err := utils.ValidatePasswordRules(password, rules); err != nil {
+		return nil, fmt.Errorf("%w: %v", utils.ErrPasswordPolicyViolation, err)
+	}
+
+	if policy.CheckBreached && s.breachedPasswords.Contains(password) {
+		return nil, utils.ErrPasswordBreached
+	}
+
+	if userID != uuid.Nil && policy.HistoryDepth > 0 {
+		previous, err := s.passwordHistoryRepo.GetRecent(ctx, userID, policy.HistoryDepth)
+		if err != nil {
+			return nil, err
+		}
+		for _, hash := range previous {
+			if utils.VerifyPassword(hash, password) == nil {
+				return nil, utils.ErrPasswordReused
+			}
+		}
+	}
+
+	return policy, nil
+}
+
+// recordPasswordChange keeps the new hash in the user's password history
+func (s *authService) recordPasswordChange(ctx context.Context, userID uuid.UUID, hashedPassword string) {
+	if err := s.passwordHistoryRepo.Add(ctx, userID, hashedPassword); err != nil {
+		log.Printf("⚠️  Failed to record password history for user %s: %v", userID, err)
+	}
+}
+
+// checkPasswordAge rejects a login whose password has expired or has been flagged for change.
+// An expired password is flagged so the user stays blocked until it is changed.
+func (s *authService) checkPasswordAge(ctx context.Context, user *ports.UserData) error {
+	if user.MustChangePassword {
+		return utils.ErrPasswordChangeRequired
+	}
+
+	policy, err := s.passwordPolicyRepo.GetByTenantID(ctx, user.TenantID)
+	if err != nil {
+		log.Printf("⚠️  Failed to load password policy for tenant %s: %v", user.TenantID, err)
+		return nil
+	}
+
+	if policy.PasswordExpired(user.PasswordChangedAt) {
+		if err := s.userRepo.SetMustChangePassword(ctx, user.UserID, true); err != nil {
+			log.Printf("⚠️  Failed to flag password change for user %s: %v", user.UserID, err)
+		}
+		return utils.ErrPasswordChangeRequired
+	}
+
+	return nil
+}
+
+// ChangeExpiredPassword lets a user whose password has expired set a new one with their current password
+func (s *authService) ChangeExpiredPassword(ctx context.Context, email, currentPassword, newPassword string) error {
+	user, err := s.userRepo.GetByEmailGlobal(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return utils.ErrInvalidCredentials
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := utils.VerifyPassword(user.Password, currentPassword); err != nil {
+		return utils.ErrInvalidCredentials
+	}
+
+	if !user.IsActive {
+		return utils.ErrAccountDeactivated
+	}
+
+	if utils.VerifyPassword(user.Password, newPassword) == nil {
+		return utils.ErrPasswordReused
+	}
+
+	if _, err := s.validateNewPassword(ctx, user.TenantID, user.UserID, newPassword); err != nil {
+		return fmt.Errorf("password validation failed: %w", err)
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, user.UserID, hashedPassword); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	s.recordPasswordChange(ctx, user.UserID, hashedPassword)
+
+	if err := s.userRepo.UpdateTenantPassword(ctx, user.Email, hashedPassword); err != nil {
+		log.Printf("⚠️  Failed to sync tenant password for %s: %v", user.Email, err)
+	}
+
+	if err := s.userRepo.UpdateOrganizationSuperAdminPassword(ctx, user.Email, hashedPassword); err != nil {
+		log.Printf("⚠️  Failed to sync organization super admin password for %s: %v", user.Email, err)
+	}
+
+	if err := s.InvalidateAllSessions(ctx, user.UserID); err != nil {
+		fmt.Printf("⚠️  Failed to invalidate sessions: %v\n", err)
+	}
+
+	return nil
+}
+
+// ValidatePassword checks the password of a user who does not exist yet against their tenant's policy
+func (s *authService) ValidatePassword(ctx context.Context, tenantID uuid.UUID, password string) error {
+	_, err := s.validateNewPassword(ctx, tenantID, uuid.Nil, password)
+	return err
+}
+
+// GetPasswordPolicy returns the password policy of a tenant
+func (s *authService) GetPasswordPolicy(ctx context.Context, tenantID uuid.UUID) (*domain.PasswordPolicy, error) {
+	return s.passwordPolicyRepo.GetByTenantID(ctx, tenantID)
+}
+
+// UpdatePasswordPolicy stores the password policy of a tenant
+func (s *authService) UpdatePasswordPolicy(ctx context.Context, policy *domain.PasswordPolicy) (*domain.PasswordPolicy, error) {
+	if policy.MinLength < utils.MinPasswordLength {
+		return nil, fmt.Errorf("min_length must be at least %d", utils.MinPasswordLength)
+	}
+	if policy.MinLength > 128 {
+		return nil, fmt.Errorf("min_length must not exceed 128")
+	}
+	if policy.ExpiryDays < 0 {
+		return nil, fmt.Errorf("expiry_days must not be negative")
+	}
+	if policy.HistoryDepth < 0 || policy.HistoryDepth > maxPasswordHistoryDepth {
+		return nil, fmt.Errorf("history_depth must be between 0 and %d", maxPasswordHistoryDepth)
+	}
+
+	updated, err := s.passwordPolicyRepo.Upsert(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("🔐 Password policy updated for tenant %s", policy.TenantID)
+	return updated, nil
+}
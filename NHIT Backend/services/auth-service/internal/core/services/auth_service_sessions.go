@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/utils"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// newSession builds a session for the given access token, capturing the client IP
+// and user agent forwarded by the gateway
+func newSession(ctx context.Context, sessionID, userID uuid.UUID, accessToken string, expiresAt time.Time) *domain.Session {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &domain.Session{
+		SessionID:    sessionID,
+		UserID:       userID,
+		SessionToken: accessToken,
+		IPAddress:    firstMetadataValue(md, "x-forwarded-for", "x-real-ip", "remote-addr"),
+		UserAgent:    firstMetadataValue(md, "grpcgateway-user-agent", "x-user-agent", "user-agent"),
+		CreatedAt:    time.Now(),
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// revokeSession deletes a session together with the refresh tokens issued for it,
+// so the device can neither use its access token nor obtain a new one. The tokens
+// go first: a session left without them cannot be revived.
+func (s *authService) revokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.refreshTokenRepo.DeleteBySessionID(ctx, sessionID); err != nil {
+		return err
+	}
+	return s.sessionRepo.Delete(ctx, sessionID)
+}
+
+// InvalidateAllSessions invalidates all sessions for a user
+func (s *authService) InvalidateAllSessions(ctx context.Context, userID uuid.UUID) error {
+	if err := s.sessionRepo.DeleteByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	if err := s.refreshTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete refresh tokens: %w", err)
+	}
+
+	return nil
+}
+
+// GetActiveSessions gets the sessions of a user that can still be used or refreshed
+func (s *authService) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	// Use sessionRepo's GetByUserID method
+	return s.sessionRepo.GetByUserID(ctx, userID)
+}
+
+// RevokeSession revokes one of the user's own sessions
+func (s *authService) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	session, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return utils.ErrSessionNotFound
+	}
+
+	if err := s.revokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	log.Printf("🔒 User %s revoked session %s", userID, sessionID)
+	return nil
+}
+
+// RevokeAllOtherSessions revokes every session and refresh token of the user except
+// those of the current session, including sessions whose access token has expired
+func (s *authService) RevokeAllOtherSessions(ctx context.Context, userID, currentSessionID uuid.UUID) (int, error) {
+	revoked, err := s.sessionRepo.DeleteOthers(ctx, userID, currentSessionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	log.Printf("🔒 User %s revoked %d other session(s)", userID, revoked)
+	return revoked, nil
+}
+
+// RevokeUserSessions force-logs-out a user of the caller's tenant from every device
+func (s *authService) RevokeUserSessions(ctx context.Context, tenantID, userID uuid.UUID) (int, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.TenantID != tenantID {
+		return 0, domain.ErrUserNotFound
+	}
+
+	sessions, err := s.GetActiveSessions(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	if err := s.InvalidateAllSessions(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	log.Printf("🔒 Force logout of user %s: revoked %d session(s)", userID, len(sessions))
+	return len(sessions), nil
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/auth-service/internal/utils"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// Reasons recorded when a refresh token family is revoked
+const (
+	familyRevokedReuse    = "reuse_detected"
+	familyRevokedLifetime = "absolute_lifetime"
+)
+
+// startRefreshTokenFamily stores the first refresh token of a new login
+func (s *authService) startRefreshTokenFamily(ctx context.Context, refreshToken string, userID, sessionID uuid.UUID, expiresAt time.Time) error {
+	family := &domain.RefreshTokenFamily{
+		FamilyID:          uuid.New(),
+		UserID:            userID,
+		SessionID:         &sessionID,
+		CreatedAt:         time.Now(),
+		AbsoluteExpiresAt: time.Now().Add(s.sessionConfig.AbsoluteLifetime),
+	}
+	if err := s.refreshFamilyRepo.Create(ctx, family); err != nil {
+		return err
+	}
+
+	return s.refreshTokenRepo.Create(ctx, &domain.RefreshToken{
+		Token:     refreshToken,
+		UserID:    userID,
+		SessionID: &sessionID,
+		FamilyID:  &family.FamilyID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	})
+}
+
+// checkRefreshTokenFamily returns the family of a presented refresh token, rejecting
+// revoked families, families past their absolute lifetime and already-rotated tokens.
+// Tokens issued before families existed are adopted into a new family, which is
+// stored on the token so a later replay of it is detected like any other.
+func (s *authService) checkRefreshTokenFamily(ctx context.Context, token *domain.RefreshToken) (*domain.RefreshTokenFamily, error) {
+	if token.FamilyID == nil {
+		if err := s.adoptRefreshTokenFamily(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+
+	family, err := s.refreshFamilyRepo.GetByID(ctx, *token.FamilyID)
+	if err != nil {
+		return nil, fmt.Errorf("refresh token not found or expired")
+	}
+
+	if family.RevokedAt != nil {
+		return nil, utils.ErrRefreshTokenRevoked
+	}
+
+	if token.UsedAt != nil {
+		s.handleRefreshTokenReuse(ctx, token, &family.FamilyID)
+		return nil, utils.ErrRefreshTokenReused
+	}
+
+	if time.Now().After(family.AbsoluteExpiresAt) {
+		if err := s.revokeRefreshTokenFamily(ctx, family.FamilyID, familyRevokedLifetime); err != nil {
+			log.Printf("⚠️  Failed to revoke expired refresh token family %s: %v", family.FamilyID, err)
+		}
+		return nil, utils.ErrSessionLifetimeExceeded
+	}
+
+	return family, nil
+}
+
+// adoptRefreshTokenFamily moves a legacy refresh token into a new family and
+// sets token.FamilyID. A legacy token that was already rotated is a replay.
+func (s *authService) adoptRefreshTokenFamily(ctx context.Context, token *domain.RefreshToken) error {
+	if token.UsedAt != nil {
+		s.handleRefreshTokenReuse(ctx, token, nil)
+		return utils.ErrRefreshTokenReused
+	}
+
+	family := &domain.RefreshTokenFamily{
+		FamilyID:          uuid.New(),
+		UserID:            token.UserID,
+		SessionID:         token.SessionID,
+		CreatedAt:         time.Now(),
+		AbsoluteExpiresAt: token.CreatedAt.Add(s.sessionConfig.AbsoluteLifetime),
+	}
+	adopted, err := s.refreshFamilyRepo.Adopt(ctx, token.Token, family)
+	if err != nil {
+		return err
+	}
+	if adopted {
+		token.FamilyID = &family.FamilyID
+		return nil
+	}
+
+	// Lost a race with a concurrent presentation of the same token: it was
+	// either adopted there, in which case the family checks apply, or rotated
+	current, err := s.refreshTokenRepo.GetByToken(ctx, token.Token)
+	if err != nil {
+		return fmt.Errorf("refresh token not found or expired")
+	}
+	if current.FamilyID == nil {
+		s.handleRefreshTokenReuse(ctx, current, nil)
+		return utils.ErrRefreshTokenReused
+	}
+	*token = *current
+	return nil
+}
+
+// revokeRefreshTokenFamily revokes a family and every session its tokens were issued for
+func (s *authService) revokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, reason string) error {
+	if err := s.refreshFamilyRepo.Revoke(ctx, familyID, reason); err != nil {
+		return err
+	}
+
+	sessionIDs, err := s.refreshTokenRepo.GetSessionIDsByFamilyID(ctx, familyID)
+	if err != nil {
+		return err
+	}
+
+	for _, sessionID := range sessionIDs {
+		if err := s.revokeSession(ctx, sessionID); err != nil {
+			log.Printf("⚠️  Failed to revoke session %s of family %s: %v", sessionID, familyID, err)
+		}
+	}
+
+	return nil
+}
+
+// handleRefreshTokenReuse reacts to a replayed refresh token: the whole family is revoked,
+// or the token's session for a legacy token without one, a security event is recorded
+// and the user is notified. Failures are logged only, the caller rejects the request
+// regardless.
+func (s *authService) handleRefreshTokenReuse(ctx context.Context, token *domain.RefreshToken, familyID *uuid.UUID) {
+	familyIDStr := ""
+	if familyID != nil {
+		familyIDStr = familyID.String()
+		log.Printf("🚨 Refresh token reuse detected for user %s (family %s)", token.UserID, familyIDStr)
+		if err := s.revokeRefreshTokenFamily(ctx, *familyID, familyRevokedReuse); err != nil {
+			log.Printf("⚠️  Failed to revoke refresh token family %s: %v", familyIDStr, err)
+		}
+	} else {
+		log.Printf("🚨 Reuse of legacy refresh token detected for user %s", token.UserID)
+		if token.SessionID != nil {
+			if err := s.revokeSession(ctx, *token.SessionID); err != nil {
+				log.Printf("⚠️  Failed to revoke session %s: %v", *token.SessionID, err)
+			}
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	event := &domain.SecurityEvent{
+		EventID:   uuid.New(),
+		UserID:    token.UserID,
+		EventType: domain.SecurityEventRefreshTokenReuse,
+		FamilyID:  familyID,
+		SessionID: token.SessionID,
+		IPAddress: firstMetadataValue(md, "x-forwarded-for", "x-real-ip", "remote-addr"),
+		UserAgent: firstMetadataValue(md, "grpcgateway-user-agent", "x-user-agent", "user-agent"),
+		Details:   "an already-rotated refresh token was presented; the login has been revoked",
+		CreatedAt: time.Now(),
+	}
+	if err := s.securityEventRepo.Create(ctx, event); err != nil {
+		log.Printf("⚠️  Failed to record security event: %v", err)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		log.Printf("⚠️  Failed to load user %s for security alert: %v", token.UserID, err)
+		return
+	}
+
+	if s.notificationClient != nil {
+		if err := s.notificationClient.SendSecurityAlertEmail(ctx, user.Email, user.Name, event.EventType, event.IPAddress, event.UserAgent); err != nil {
+			log.Printf("⚠️  Failed to send security alert to %s: %v", user.Email, err)
+		}
+	}
+
+	if s.kafkaPublisher != nil {
+		eventData := map[string]interface{}{
+			"event_id":    event.EventID.String(),
+			"event_type":  event.EventType,
+			"user_id":     user.UserID.String(),
+			"tenant_id":   user.TenantID.String(),
+			"family_id":   familyIDStr,
+			"ip_address":  event.IPAddress,
+			"user_agent":  event.UserAgent,
+			"occurred_at": event.CreatedAt.Format(time.RFC3339),
+		}
+		if err := s.kafkaPublisher.Publish(ctx, "user.security_event", eventData); err != nil {
+			log.Printf("⚠️  Failed to publish security event: %v", err)
+		}
+	}
+}
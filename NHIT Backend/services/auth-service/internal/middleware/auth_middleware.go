@@ -90,6 +90,7 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		ctx = context.WithValue(ctx, "tenant_id", validation.TenantID.String())
 		ctx = context.WithValue(ctx, "roles", validation.Roles)
 		ctx = context.WithValue(ctx, "permissions", validation.Permissions)
+		ctx = context.WithValue(ctx, "session_id", validation.SessionID.String())
 
 		// Check RBAC permissions
 		if !interceptor.publicMethods[info.FullMethod] {
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// BreachedPasswordList is an in-memory set of SHA-1 hashes of known breached passwords.
+// The source file holds one upper-case hex SHA-1 per line; the "HASH:COUNT" format of
+// downloaded breach corpora is accepted as well.
+type BreachedPasswordList struct {
+	hashes map[string]struct{}
+}
+
+// LoadBreachedPasswordList reads a hash list from path. An empty path yields an empty list.
+func LoadBreachedPasswordList(path string) (*BreachedPasswordList, error) {
+	list := &BreachedPasswordList{hashes: make(map[string]struct{})}
+	if path == "" {
+		return list, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password list: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if idx := strings.IndexByte(line, ':'); idx >= 0 {
+			line = line[:idx]
+		}
+		list.hashes[strings.ToUpper(line)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password list: %w", err)
+	}
+
+	return list, nil
+}
+
+// Size returns the number of hashes in the list
+func (l *BreachedPasswordList) Size() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.hashes)
+}
+
+// Contains reports whether the password appears in the list
+func (l *BreachedPasswordList) Contains(password string) bool {
+	if l == nil || len(l.hashes) == 0 {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(password))
+	_, found := l.hashes[strings.ToUpper(hex.EncodeToString(sum[:]))]
+	return found
+}
@@ -14,4 +14,34 @@ var (
 	
 	// ErrInvalidToken is returned when token is invalid
 	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrSessionNotFound is returned when a session does not exist or belongs to another user
+	ErrSessionNotFound = errors.New("session not found")
+
+	// ErrRefreshTokenReused is returned when an already-rotated refresh token is presented again
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected; all sessions of this login have been revoked")
+
+	// ErrRefreshTokenRevoked is returned when the refresh token's family has been revoked
+	ErrRefreshTokenRevoked = errors.New("refresh token has been revoked")
+
+	// ErrSessionLifetimeExceeded is returned when a login has reached its absolute lifetime
+	ErrSessionLifetimeExceeded = errors.New("session lifetime exceeded, please log in again")
+
+	// ErrPasswordBreached is returned when a password appears in the breached password list
+	ErrPasswordBreached = errors.New("password has appeared in a data breach, please choose a different one")
+
+	// ErrPasswordReused is returned when a password matches one of the user's recent passwords
+	ErrPasswordReused = errors.New("password was used recently, please choose a different one")
+
+	// ErrInvalidCredentials is returned when a login and password do not match
+	ErrInvalidCredentials = errors.New("invalid email or password")
+
+	// ErrAccountDeactivated is returned when a deactivated user tries to sign in or change their password
+	ErrAccountDeactivated = errors.New("user account is deactivated")
+
+	// ErrPasswordPolicyViolation is returned when a new password breaks the tenant's password rules
+	ErrPasswordPolicyViolation = errors.New("password does not meet the password policy")
+
+	// ErrPasswordChangeRequired is returned on login when the password has expired or must be changed
+	ErrPasswordChangeRequired = errors.New("password change required")
 )
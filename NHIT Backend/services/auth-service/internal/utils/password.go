@@ -39,10 +39,37 @@ func VerifyPassword(hashedPassword, password string) error {
 	return nil
 }
 
-// ValidatePasswordStrength validates password strength
+// PasswordRules describes the composition rules a password must satisfy
+type PasswordRules struct {
+	MinLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSpecial   bool
+}
+
+// DefaultPasswordRules are the rules applied when no tenant policy is configured
+var DefaultPasswordRules = PasswordRules{
+	MinLength:        MinPasswordLength,
+	RequireUppercase: true,
+	RequireLowercase: true,
+	RequireDigit:     true,
+	RequireSpecial:   true,
+}
+
+// ValidatePasswordStrength validates password strength against the default rules
 func ValidatePasswordStrength(password string) error {
-	if len(password) < MinPasswordLength {
-		return fmt.Errorf("password must be at least %d characters long", MinPasswordLength)
+	return ValidatePasswordRules(password, DefaultPasswordRules)
+}
+
+// ValidatePasswordRules validates a password against the given rules
+func ValidatePasswordRules(password string, rules PasswordRules) error {
+	minLength := rules.MinLength
+	if minLength < MinPasswordLength {
+		minLength = MinPasswordLength
+	}
+	if len(password) < minLength {
+		return fmt.Errorf("password must be at least %d characters long", minLength)
 	}
 
 	hasUpper := false
@@ -68,16 +95,16 @@ func ValidatePasswordStrength(password string) error {
 		}
 	}
 
-	if !hasUpper {
+	if rules.RequireUppercase && !hasUpper {
 		return fmt.Errorf("password must contain at least one uppercase letter")
 	}
-	if !hasLower {
+	if rules.RequireLowercase && !hasLower {
 		return fmt.Errorf("password must contain at least one lowercase letter")
 	}
-	if !hasDigit {
+	if rules.RequireDigit && !hasDigit {
 		return fmt.Errorf("password must contain at least one digit")
 	}
-	if !hasSpecial {
+	if rules.RequireSpecial && !hasSpecial {
 		return fmt.Errorf("password must contain at least one special character")
 	}
 
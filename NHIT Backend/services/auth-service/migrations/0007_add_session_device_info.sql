@@ -0,0 +1,19 @@
+-- Session device tracking to support listing and revoking sessions
+
+-- Add device / client columns to sessions
+ALTER TABLE sessions
+ADD COLUMN IF NOT EXISTS ip_address VARCHAR(64) NOT NULL DEFAULT '',
+ADD COLUMN IF NOT EXISTS user_agent TEXT NOT NULL DEFAULT '',
+ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+-- Link refresh tokens to the session they were issued for
+ALTER TABLE refresh_tokens
+ADD COLUMN IF NOT EXISTS session_id UUID NULL;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_last_seen_at ON sessions(last_seen_at);
+
+COMMENT ON COLUMN sessions.ip_address IS 'Client IP address captured when the session was created';
+COMMENT ON COLUMN sessions.user_agent IS 'Client user agent captured when the session was created';
+COMMENT ON COLUMN sessions.last_seen_at IS 'Last time a token of this session was validated';
+COMMENT ON COLUMN refresh_tokens.session_id IS 'Session the refresh token belongs to; revoking the session deletes it';
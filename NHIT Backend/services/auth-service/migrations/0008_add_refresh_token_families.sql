@@ -0,0 +1,41 @@
+-- Refresh token families for rotation reuse detection
+
+-- A family is the chain of refresh tokens issued to one login
+CREATE TABLE IF NOT EXISTS refresh_token_families (
+    family_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    session_id UUID NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    absolute_expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+    revoked_reason VARCHAR(50) NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_token_families_user_id ON refresh_token_families(user_id);
+
+-- Rotated tokens are kept (marked used) so that a replay can be detected
+ALTER TABLE refresh_tokens
+ADD COLUMN IF NOT EXISTS family_id UUID NULL REFERENCES refresh_token_families(family_id) ON DELETE CASCADE,
+ADD COLUMN IF NOT EXISTS used_at TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens(family_id);
+
+-- Security events (refresh token reuse, forced logouts, ...)
+CREATE TABLE IF NOT EXISTS security_events (
+    event_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    family_id UUID NULL,
+    session_id UUID NULL,
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_events_user_id ON security_events(user_id, created_at DESC);
+
+COMMENT ON TABLE refresh_token_families IS 'Chains of rotated refresh tokens; reuse of a rotated token revokes the whole family';
+COMMENT ON COLUMN refresh_token_families.absolute_expires_at IS 'Hard session lifetime, independent of rotation';
+COMMENT ON COLUMN refresh_tokens.used_at IS 'Set when the token has been rotated; presenting it again is a reuse';
+COMMENT ON TABLE security_events IS 'Audit trail of security-relevant authentication events';
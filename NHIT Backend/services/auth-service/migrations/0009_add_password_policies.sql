@@ -0,0 +1,37 @@
+-- Tenant password policies and password history
+
+-- One policy per tenant; tenants without a row use the built-in default
+CREATE TABLE IF NOT EXISTS password_policies (
+    tenant_id UUID PRIMARY KEY,
+    min_length INT NOT NULL DEFAULT 8,
+    require_uppercase BOOLEAN NOT NULL DEFAULT TRUE,
+    require_lowercase BOOLEAN NOT NULL DEFAULT TRUE,
+    require_digit BOOLEAN NOT NULL DEFAULT TRUE,
+    require_special BOOLEAN NOT NULL DEFAULT TRUE,
+    expiry_days INT NOT NULL DEFAULT 0,
+    history_depth INT NOT NULL DEFAULT 0,
+    check_breached BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Previous password hashes, used to prevent reuse
+CREATE TABLE IF NOT EXISTS password_history (
+    history_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_history_user_id ON password_history(user_id, created_at DESC);
+
+-- Password age and forced change on next login
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+ADD COLUMN IF NOT EXISTS must_change_password BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON TABLE password_policies IS 'Per-tenant password rules enforced on registration and password changes';
+COMMENT ON COLUMN password_policies.expiry_days IS 'Days after which a password must be changed; 0 disables expiry';
+COMMENT ON COLUMN password_policies.history_depth IS 'Number of previous passwords that may not be reused; 0 disables the check';
+COMMENT ON TABLE password_history IS 'Hashes of previous user passwords';
+COMMENT ON COLUMN users.must_change_password IS 'Set when the user has to change their password before logging in again';
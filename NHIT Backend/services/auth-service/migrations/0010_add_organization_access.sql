@@ -0,0 +1,12 @@
+-- Organization access, kept in sync from organization-service's organization.events
+
+-- One row per organization whose status has changed; organizations without a
+-- row are active. Logins, refreshes and token validation are refused while
+-- the status is anything but ACTIVE.
+CREATE TABLE IF NOT EXISTS organization_access (
+    org_id UUID PRIMARY KEY,
+    status VARCHAR(20) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -13,7 +13,6 @@ import (
 	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
 	grpcHandler "github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/adapters/grpc"
 	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/adapters/repository"
-	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/adapters/repository/sqlc/generated"
 	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/config"
 	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/core/services"
 	"google.golang.org/grpc"
@@ -60,11 +59,8 @@ func main() {
 	}
 	log.Println("✅ Database connection established (Pool: Max=20, Min=5)")
 
-	// Initialize sqlc queries with local SQLC
-	queries := sqlc.New(pool)
-
 	// Initialize repositories
-	departmentRepo := repository.NewDepartmentRepository(queries)
+	departmentRepo := repository.NewDepartmentRepository(pool)
 
 	// Initialize services
 	departmentService := services.NewDepartmentService(departmentRepo)
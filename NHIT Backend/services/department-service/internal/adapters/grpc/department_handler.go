@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"strings"
 
@@ -72,15 +73,36 @@ func (h *DepartmentHandler) CreateDepartment(ctx context.Context, req *departmen
 	// User said "org_id is not fetching... and not storing". So we should store it.
 	// We pass it to service (which passes to repo).
 
+	parentID, err := optionalUUID(req.ParentId, "parent_id")
+	if err != nil {
+		return nil, err
+	}
+	headUserID, err := optionalUUID(req.HeadUserId, "head_user_id")
+	if err != nil {
+		return nil, err
+	}
+
 	// Create department
-	dept, err := h.service.CreateDepartment(ctx, req.Name, req.Description, orgID)
+	dept, err := h.service.CreateDepartment(ctx, ports.DepartmentInput{
+		Name:           req.Name,
+		Description:    req.Description,
+		OrgID:          orgID,
+		ParentID:       parentID,
+		HeadUserID:     headUserID,
+		CostCentreCode: req.CostCentreCode,
+		ValidateOnly:   req.ValidateOnly,
+	})
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	return &departmentpb.DepartmentResponse{
+	resp := &departmentpb.DepartmentResponse{
 		Department: domainToProto(dept),
-	}, nil
+	}
+	if req.ValidateOnly {
+		resp.Message = "department is valid and was not created"
+	}
+	return resp, nil
 }
 
 // GetDepartment retrieves a department by ID
@@ -118,8 +140,20 @@ func (h *DepartmentHandler) UpdateDepartment(ctx context.Context, req *departmen
 		return nil, status.Error(codes.InvalidArgument, "department description is required")
 	}
 
+	update := ports.DepartmentUpdate{
+		Name:           req.Name,
+		Description:    req.Description,
+		CostCentreCode: req.CostCentreCode,
+	}
+	if req.HeadUserId != nil {
+		update.ClearHead = *req.HeadUserId == ""
+		if update.HeadUserID, err = optionalUUID(*req.HeadUserId, "head_user_id"); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update department
-	dept, err := h.service.UpdateDepartment(ctx, id, req.Name, req.Description)
+	dept, err := h.service.UpdateDepartment(ctx, id, update)
 	if err != nil {
 		return nil, handleError(err)
 	}
@@ -162,8 +196,16 @@ func (h *DepartmentHandler) ListDepartments(ctx context.Context, req *department
 
 	orgID := getOrgIDFromContext(ctx)
 
+	var statusFilter domain.DepartmentStatus
+	switch req.Status {
+	case departmentpb.DepartmentStatus_DEPARTMENT_STATUS_ACTIVE:
+		statusFilter = domain.DepartmentStatusActive
+	case departmentpb.DepartmentStatus_DEPARTMENT_STATUS_INACTIVE:
+		statusFilter = domain.DepartmentStatusInactive
+	}
+
 	// List departments
-	departments, total, err := h.service.ListDepartments(ctx, orgID, page, pageSize)
+	departments, total, err := h.service.ListDepartments(ctx, orgID, statusFilter, page, pageSize)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to list departments")
 	}
@@ -189,32 +231,166 @@ func (h *DepartmentHandler) ListDepartments(ctx context.Context, req *department
 	}, nil
 }
 
+// GetDepartmentTree returns the caller's organization's departments as a tree
+func (h *DepartmentHandler) GetDepartmentTree(ctx context.Context, req *departmentpb.GetDepartmentTreeRequest) (*departmentpb.GetDepartmentTreeResponse, error) {
+	rootID, err := optionalUUID(req.RootId, "root_id")
+	if err != nil {
+		return nil, err
+	}
+
+	roots, total, err := h.service.GetDepartmentTree(ctx, getOrgIDFromContext(ctx), rootID, req.IncludeInactive)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	protoRoots := make([]*departmentpb.DepartmentTreeNode, len(roots))
+	for i, root := range roots {
+		protoRoots[i] = nodeToProto(root)
+	}
+	return &departmentpb.GetDepartmentTreeResponse{
+		Roots:      protoRoots,
+		TotalCount: total,
+	}, nil
+}
+
+// MoveDepartment moves a department under another parent
+func (h *DepartmentHandler) MoveDepartment(ctx context.Context, req *departmentpb.MoveDepartmentRequest) (*departmentpb.DepartmentResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid department ID")
+	}
+	parentID, err := optionalUUID(req.NewParentId, "new_parent_id")
+	if err != nil {
+		return nil, err
+	}
+
+	dept, err := h.service.MoveDepartment(ctx, id, parentID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &departmentpb.DepartmentResponse{
+		Department: domainToProto(dept),
+		Message:    "Department moved successfully",
+	}, nil
+}
+
+// CloseDepartment closes a department, reassigning its users
+func (h *DepartmentHandler) CloseDepartment(ctx context.Context, req *departmentpb.CloseDepartmentRequest) (*departmentpb.CloseDepartmentResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid department ID")
+	}
+	reassignTo, err := optionalUUID(req.ReassignToDepartmentId, "reassign_to_department_id")
+	if err != nil {
+		return nil, err
+	}
+
+	dept, moved, err := h.service.CloseDepartment(ctx, id, reassignTo, req.Reason)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &departmentpb.CloseDepartmentResponse{
+		Department:      domainToProto(dept),
+		ReassignedUsers: moved,
+		Message:         "Department closed successfully",
+	}, nil
+}
+
+// ReopenDepartment makes a closed department active again
+func (h *DepartmentHandler) ReopenDepartment(ctx context.Context, req *departmentpb.ReopenDepartmentRequest) (*departmentpb.DepartmentResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid department ID")
+	}
+
+	dept, err := h.service.ReopenDepartment(ctx, id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &departmentpb.DepartmentResponse{
+		Department: domainToProto(dept),
+		Message:    "Department reopened successfully",
+	}, nil
+}
+
+// optionalUUID parses an ID that may be left empty
+func optionalUUID(value, field string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return &id, nil
+}
+
+func nodeToProto(node *domain.DepartmentNode) *departmentpb.DepartmentTreeNode {
+	out := &departmentpb.DepartmentTreeNode{
+		Department: domainToProto(node.Department),
+		Children:   make([]*departmentpb.DepartmentTreeNode, len(node.Children)),
+	}
+	for i, child := range node.Children {
+		out.Children[i] = nodeToProto(child)
+	}
+	return out
+}
+
 // domainToProto converts domain model to proto model
 func domainToProto(dept *domain.Department) *departmentpb.Department {
-	return &departmentpb.Department{
-		Id:          dept.ID.String(),
-		Name:        dept.Name,
-		Description: dept.Description,
-		CreatedAt:   timestamppb.New(dept.CreatedAt),
-		UpdatedAt:   timestamppb.New(dept.UpdatedAt),
+	out := &departmentpb.Department{
+		Id:             dept.ID.String(),
+		Name:           dept.Name,
+		Description:    dept.Description,
+		CreatedAt:      timestamppb.New(dept.CreatedAt),
+		UpdatedAt:      timestamppb.New(dept.UpdatedAt),
+		CostCentreCode: dept.CostCentreCode,
+		Status:         departmentpb.DepartmentStatus_DEPARTMENT_STATUS_ACTIVE,
+		ClosedReason:   dept.ClosedReason,
+	}
+	if dept.ParentID != nil {
+		out.ParentId = dept.ParentID.String()
+	}
+	if dept.HeadUserID != nil {
+		out.HeadUserId = dept.HeadUserID.String()
+	}
+	if !dept.IsActive() {
+		out.Status = departmentpb.DepartmentStatus_DEPARTMENT_STATUS_INACTIVE
+	}
+	if dept.ClosedAt != nil {
+		out.ClosedAt = timestamppb.New(*dept.ClosedAt)
 	}
+	return out
 }
 
 // handleError converts domain errors to gRPC status errors
 func handleError(err error) error {
-	switch err {
-	case domain.ErrDepartmentNotFound:
+	switch {
+	case errors.Is(err, domain.ErrDepartmentNotFound), errors.Is(err, domain.ErrParentDepartmentNotFound):
 		return status.Error(codes.NotFound, err.Error())
-	case domain.ErrDepartmentAlreadyExists:
+	case errors.Is(err, domain.ErrDepartmentAlreadyExists), errors.Is(err, domain.ErrCostCentreCodeTaken):
 		return status.Error(codes.AlreadyExists, err.Error())
-	case domain.ErrDepartmentHasUsers:
+	case errors.Is(err, domain.ErrDepartmentHasUsers),
+		errors.Is(err, domain.ErrDepartmentHasNotes),
+		errors.Is(err, domain.ErrDepartmentHasChildren),
+		errors.Is(err, domain.ErrDepartmentInactive),
+		errors.Is(err, domain.ErrDepartmentAlreadyActive),
+		errors.Is(err, domain.ErrReassignmentRequired):
 		return status.Error(codes.FailedPrecondition, err.Error())
-	case domain.ErrInvalidDepartmentID:
+	case errors.Is(err, domain.ErrInvalidDepartmentID),
+		errors.Is(err, domain.ErrDepartmentCycle),
+		errors.Is(err, domain.ErrInvalidReassignTarget),
+		errors.Is(err, domain.ErrDepartmentHeadNotMember):
 		return status.Error(codes.InvalidArgument, err.Error())
-	case domain.ErrDepartmentNameRequired,
-		domain.ErrDepartmentNameTooLong,
-		domain.ErrDepartmentDescriptionRequired,
-		domain.ErrDepartmentDescriptionTooLong:
+	case errors.Is(err, domain.ErrDepartmentNameRequired),
+		errors.Is(err, domain.ErrDepartmentNameTooLong),
+		errors.Is(err, domain.ErrDepartmentDescriptionRequired),
+		errors.Is(err, domain.ErrDepartmentDescriptionTooLong),
+		errors.Is(err, domain.ErrCostCentreCodeTooLong),
+		errors.Is(err, domain.ErrCostCentreCodeInvalid):
 		return status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
@@ -2,7 +2,12 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/google/uuid"
 	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/adapters/repository/sqlc/generated"
 	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/core/domain"
@@ -10,13 +15,15 @@ import (
 )
 
 type departmentRepository struct {
+	pool    *pgxpool.Pool
 	queries *sqlc.Queries
 }
 
 // NewDepartmentRepository creates a new department repository
-func NewDepartmentRepository(queries *sqlc.Queries) ports.DepartmentRepository {
+func NewDepartmentRepository(pool *pgxpool.Pool) ports.DepartmentRepository {
 	return &departmentRepository{
-		queries: queries,
+		pool:    pool,
+		queries: sqlc.New(pool),
 	}
 }
 
@@ -30,12 +37,15 @@ func (r *departmentRepository) Create(ctx context.Context, department *domain.De
 	}
 
 	dbDept, err := r.queries.CreateDepartment(ctx, sqlc.CreateDepartmentParams{
-		Name:        department.Name,
-		Description: department.Description,
-		OrgID:       orgID,
+		Name:           department.Name,
+		Description:    department.Description,
+		OrgID:          orgID,
+		ParentID:       orgIDParam(department.ParentID),
+		HeadUserID:     orgIDParam(department.HeadUserID),
+		CostCentreCode: optionalString(department.CostCentreCode),
 	})
 	if err != nil {
-		return nil, err
+		return nil, uniqueViolation(err)
 	}
 
 	return dbToDomain(dbDept), nil
@@ -51,9 +61,12 @@ func (r *departmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*doma
 	return dbToDomain(dbDept), nil
 }
 
-// GetByName retrieves a department by Name
-func (r *departmentRepository) GetByName(ctx context.Context, name string) (*domain.Department, error) {
-	dbDept, err := r.queries.GetDepartmentByName(ctx, name)
+// GetByName retrieves a department by Name within an organization
+func (r *departmentRepository) GetByName(ctx context.Context, name string, orgID *uuid.UUID) (*domain.Department, error) {
+	dbDept, err := r.queries.GetDepartmentByName(ctx, sqlc.GetDepartmentByNameParams{
+		Name:  name,
+		OrgID: orgIDParam(orgID),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -62,13 +75,39 @@ func (r *departmentRepository) GetByName(ctx context.Context, name string) (*dom
 }
 
 // Update updates an existing department
+// A rename records the previous name in the same transaction, so notes raised
+// under it keep counting as references to the department.
 func (r *departmentRepository) Update(ctx context.Context, department *domain.Department) (*domain.Department, error) {
-	dbDept, err := r.queries.UpdateDepartment(ctx, sqlc.UpdateDepartmentParams{
-		ID:          department.ID,
-		Name:        department.Name,
-		Description: department.Description,
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	current, err := q.GetDepartmentByID(ctx, department.ID)
+	if err != nil {
+		return nil, err
+	}
+	dbDept, err := q.UpdateDepartment(ctx, sqlc.UpdateDepartmentParams{
+		ID:             department.ID,
+		Name:           department.Name,
+		Description:    department.Description,
+		HeadUserID:     orgIDParam(department.HeadUserID),
+		CostCentreCode: optionalString(department.CostCentreCode),
 	})
 	if err != nil {
+		return nil, uniqueViolation(err)
+	}
+	if !strings.EqualFold(strings.TrimSpace(current.Name), strings.TrimSpace(dbDept.Name)) {
+		if err := q.RecordFormerDepartmentName(ctx, sqlc.RecordFormerDepartmentNameParams{
+			DepartmentID: current.ID,
+			Name:         current.Name,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -77,11 +116,17 @@ func (r *departmentRepository) Update(ctx context.Context, department *domain.De
 
 // Delete deletes a department by ID
 func (r *departmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.queries.DeleteDepartment(ctx, id)
+	err := r.queries.DeleteDepartment(ctx, id)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		// A sub-department was created since the check
+		return domain.ErrDepartmentHasChildren
+	}
+	return err
 }
 
 // List retrieves departments with pagination
-func (r *departmentRepository) List(ctx context.Context, orgID *uuid.UUID, page, pageSize int32) ([]*domain.Department, int32, error) {
+func (r *departmentRepository) List(ctx context.Context, orgID *uuid.UUID, status domain.DepartmentStatus, page, pageSize int32) ([]*domain.Department, int32, error) {
 	offset := (page - 1) * pageSize
 
 	var orgIDParam pgtype.UUID
@@ -90,19 +135,24 @@ func (r *departmentRepository) List(ctx context.Context, orgID *uuid.UUID, page,
 	} else {
 		orgIDParam = pgtype.UUID{Valid: false}
 	}
+	statusParam := optionalString(string(status))
 
 	// Get departments
 	dbDepts, err := r.queries.ListDepartments(ctx, sqlc.ListDepartmentsParams{
-		Column1: orgIDParam,
-		Limit:   pageSize,
-		Offset:  offset,
+		OrgID:      orgIDParam,
+		Status:     statusParam,
+		PageLimit:  pageSize,
+		PageOffset: offset,
 	})
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get total count
-	total, err := r.queries.CountDepartments(ctx, orgIDParam)
+	total, err := r.queries.CountDepartments(ctx, sqlc.CountDepartmentsParams{
+		OrgID:  orgIDParam,
+		Status: statusParam,
+	})
 	if err != nil {
 		return nil, 0, err
 	}
@@ -116,9 +166,19 @@ func (r *departmentRepository) List(ctx context.Context, orgID *uuid.UUID, page,
 	return departments, int32(total), nil
 }
 
-// Exists checks if department exists with name
-func (r *departmentRepository) Exists(ctx context.Context, name string) (bool, error) {
-	return r.queries.DepartmentExists(ctx, name)
+// Exists checks if department exists with name within an organization
+func (r *departmentRepository) Exists(ctx context.Context, name string, orgID *uuid.UUID) (bool, error) {
+	return r.queries.DepartmentExists(ctx, sqlc.DepartmentExistsParams{
+		Name:  name,
+		OrgID: orgIDParam(orgID),
+	})
+}
+
+func orgIDParam(orgID *uuid.UUID) pgtype.UUID {
+	if orgID == nil {
+		return pgtype.UUID{Valid: false}
+	}
+	return pgtype.UUID{Bytes: *orgID, Valid: true}
 }
 
 // ExistsByID checks if department exists
@@ -126,26 +186,15 @@ func (r *departmentRepository) ExistsByID(ctx context.Context, id uuid.UUID) (bo
 	return r.queries.DepartmentExistsByID(ctx, id)
 }
 
-// CountUsersByDepartment returns the number of users in a department
-// TODO: This should ideally make a gRPC call to user-service, or we rely on user-service to check before delete.
-// For now, since this is a department repository, we might not have direct access to users table if databases are separated.
-// Assuming microservices architecture, department service shouldn't query users table directly unless it replicates data.
-// However, looking at the error `missing method CountUsersByDepartment`, the interface expects it.
-// If this service owns users table (monolith) or shared DB, we can query. If not, this method is problematic in repo layer.
-// Given strict instructions "EXISTING LOGICS KO NHI KHARAB KARNA HAI", I'll mock it or return 0 if no query exists,
-// or check if there is a `CountUsersByDepartment` query generated in sqlc.
-
-func (r *departmentRepository) CountUsersByDepartment(ctx context.Context, departmentID uuid.UUID) (int32, error) {
-	// The User reported "missing method", implying it WAS there or expected.
-	// Since I don't see `CountUsersByDepartment` in sqlc queries for department service (it manages departments, not users),
-	// this method likely belongs to a service that can talk to user-service.
-	// But `repository` is low level.
-	// If the interface requires it, I must implement it.
-	// Returning 0 for now to fix interface compliance, assuming logic is handled elsewhere or this is a legacy leftover.
-	return 0, nil
+// CostCentreCodeExists checks if another department of the organization uses the cost centre code
+func (r *departmentRepository) CostCentreCodeExists(ctx context.Context, code string, orgID *uuid.UUID, excludeID uuid.UUID) (bool, error) {
+	return r.queries.CostCentreCodeExists(ctx, sqlc.CostCentreCodeExistsParams{
+		CostCentreCode: code,
+		OrgID:          orgIDParam(orgID),
+		ExcludeID:      excludeID,
+	})
 }
 
-
 // dbToDomain converts database model to domain model
 func dbToDomain(dbDept *sqlc.Department) *domain.Department {
 	var orgID *uuid.UUID
@@ -154,13 +203,53 @@ func dbToDomain(dbDept *sqlc.Department) *domain.Department {
 		orgID = &id
 	}
 
-	return &domain.Department{
+	dept := &domain.Department{
 		ID:          dbDept.ID,
 		OrgID:       orgID,
+		ParentID:    uuidPtr(dbDept.ParentID),
+		HeadUserID:  uuidPtr(dbDept.HeadUserID),
 		Name:        dbDept.Name,
 		Description: dbDept.Description,
+		Status:      domain.DepartmentStatus(dbDept.Status),
 		CreatedAt:   dbDept.CreatedAt.Time,
 		UpdatedAt:   dbDept.UpdatedAt.Time,
 	}
+	if dbDept.CostCentreCode != nil {
+		dept.CostCentreCode = *dbDept.CostCentreCode
+	}
+	if dbDept.ClosedAt.Valid {
+		closedAt := dbDept.ClosedAt.Time
+		dept.ClosedAt = &closedAt
+	}
+	if dbDept.ClosedReason != nil {
+		dept.ClosedReason = *dbDept.ClosedReason
+	}
+	return dept
 }
 
+func uuidPtr(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// uniqueViolation maps a clash on the per-organization name or cost centre index
+func uniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		if strings.Contains(pgErr.ConstraintName, "cost_centre") {
+			return domain.ErrCostCentreCodeTaken
+		}
+		return domain.ErrDepartmentAlreadyExists
+	}
+	return err
+}
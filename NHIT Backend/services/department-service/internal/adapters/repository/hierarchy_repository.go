@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/adapters/repository/sqlc/generated"
+	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Users, memberships and invitations live in user-service's tables, green
+// notes in greennote-service's and payment notes in paymentnote-service's
+// tables of the shared database. A table that has not been migrated yet holds
+// no references. Notes name their department as text, so they are matched by
+// the department's current and former names.
+
+const (
+	countDepartmentUsers       = `SELECT COUNT(*) FROM users WHERE department_id = $1`
+	countDepartmentMembers     = `SELECT COUNT(*) FROM user_organizations WHERE department_id = $1`
+	countDepartmentInvitations = `SELECT COUNT(*) FROM user_invitations
+		WHERE department_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()`
+	countDepartmentGreenNotes = `SELECT COUNT(*) FROM green_notes
+		WHERE org_id IS NOT DISTINCT FROM $1 AND LOWER(TRIM(department_name)) = ANY($2::text[])`
+	// Payment notes carry no organization; one raised from a green note
+	// belongs to that note's organization
+	countDepartmentPaymentNotes = `SELECT COUNT(*) FROM payment_notes p
+		WHERE LOWER(TRIM(p.department)) = ANY($2::text[])
+		  AND (p.green_note_id IS NULL OR EXISTS (
+		      SELECT 1 FROM green_notes g
+		      WHERE g.id::text = p.green_note_id AND g.org_id IS NOT DISTINCT FROM $1))`
+
+	reassignDepartmentUsers       = `UPDATE users SET department_id = $2, updated_at = NOW() WHERE department_id = $1`
+	reassignDepartmentMembers     = `UPDATE user_organizations SET department_id = $2, updated_at = NOW() WHERE department_id = $1`
+	reassignDepartmentInvitations = `UPDATE user_invitations SET department_id = $2, updated_at = NOW()
+		WHERE department_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL`
+)
+
+// ListByOrganization returns every department of an organization
+func (r *departmentRepository) ListByOrganization(ctx context.Context, orgID *uuid.UUID) ([]*domain.Department, error) {
+	dbDepts, err := r.queries.ListOrganizationDepartments(ctx, orgIDParam(orgID))
+	if err != nil {
+		return nil, err
+	}
+	departments := make([]*domain.Department, len(dbDepts))
+	for i, dbDept := range dbDepts {
+		departments[i] = dbToDomain(dbDept)
+	}
+	return departments, nil
+}
+
+// Move re-parents a department. Moves within an organization are serialized
+// so two concurrent moves cannot close a cycle between them.
+func (r *departmentRepository) Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*domain.Department, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	current, err := q.GetDepartmentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.LockDepartmentHierarchy(ctx, hierarchyKey(current.OrgID)); err != nil {
+		return nil, err
+	}
+	if parentID != nil {
+		cycle, err := q.IsDepartmentInSubtree(ctx, sqlc.IsDepartmentInSubtreeParams{
+			RootID:      id,
+			CandidateID: orgIDParam(parentID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if cycle {
+			return nil, domain.ErrDepartmentCycle
+		}
+	}
+
+	moved, err := q.SetDepartmentParent(ctx, sqlc.SetDepartmentParentParams{
+		ParentID: orgIDParam(parentID),
+		ID:       id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return dbToDomain(moved), nil
+}
+
+// CountChildren counts the departments directly below a department
+func (r *departmentRepository) CountChildren(ctx context.Context, id uuid.UUID) (int64, int64, error) {
+	row, err := r.queries.CountChildDepartments(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		return 0, 0, err
+	}
+	return row.Total, row.Active, nil
+}
+
+// CountReferences counts the users and notes that still point at a department.
+// Notes name their department, so they are matched by name within the organization.
+func (r *departmentRepository) CountReferences(ctx context.Context, department *domain.Department) (domain.DepartmentReferences, error) {
+	var refs domain.DepartmentReferences
+	var err error
+	if refs.Users, err = r.countIfTable(ctx, "users", countDepartmentUsers, department.ID); err != nil {
+		return refs, err
+	}
+	if refs.Members, err = r.countIfTable(ctx, "user_organizations", countDepartmentMembers, department.ID); err != nil {
+		return refs, err
+	}
+	if refs.Invitations, err = r.countIfTable(ctx, "user_invitations", countDepartmentInvitations, department.ID); err != nil {
+		return refs, err
+	}
+
+	former, err := r.queries.ListFormerDepartmentNames(ctx, department.ID)
+	if err != nil {
+		return refs, err
+	}
+	names := []string{strings.ToLower(strings.TrimSpace(department.Name))}
+	for _, name := range former {
+		names = append(names, strings.ToLower(strings.TrimSpace(name)))
+	}
+	if refs.GreenNotes, err = r.countIfTable(ctx, "green_notes", countDepartmentGreenNotes, orgIDParam(department.OrgID), names); err != nil {
+		return refs, err
+	}
+	if refs.PaymentNotes, err = r.countIfTable(ctx, "payment_notes", countDepartmentPaymentNotes, orgIDParam(department.OrgID), names); err != nil {
+		return refs, err
+	}
+	return refs, nil
+}
+
+// countIfTable runs a count query when table exists. A query on payment_notes
+// also reads green_notes, so both must exist.
+func (r *departmentRepository) countIfTable(ctx context.Context, table, query string, args ...any) (int64, error) {
+	tables := []string{table}
+	if table == "payment_notes" {
+		tables = append(tables, "green_notes")
+	}
+	for _, t := range tables {
+		var exists bool
+		if err := r.pool.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, t).Scan(&exists); err != nil {
+			return 0, err
+		}
+		if !exists {
+			return 0, nil
+		}
+	}
+	var count int64
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count %s: %w", table, err)
+	}
+	return count, nil
+}
+
+// Close moves the department's users to reassignTo and marks it inactive in
+// one transaction, so no user is left on a closed department
+func (r *departmentRepository) Close(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID, reason string) (*domain.Department, int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	current, err := q.GetDepartmentByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := q.LockDepartmentHierarchy(ctx, hierarchyKey(current.OrgID)); err != nil {
+		return nil, 0, err
+	}
+
+	var moved int64
+	statements := []struct{ table, reassign, count string }{
+		{"users", reassignDepartmentUsers, countDepartmentUsers},
+		{"user_organizations", reassignDepartmentMembers, countDepartmentMembers},
+		{"user_invitations", reassignDepartmentInvitations, countDepartmentInvitations},
+	}
+	for _, st := range statements {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, st.table).Scan(&exists); err != nil {
+			return nil, 0, err
+		}
+		if !exists {
+			continue
+		}
+		if reassignTo == nil {
+			var count int64
+			if err := tx.QueryRow(ctx, st.count, id).Scan(&count); err != nil {
+				return nil, 0, fmt.Errorf("count %s: %w", st.table, err)
+			}
+			if count > 0 {
+				return nil, 0, fmt.Errorf("%w: %d in %s", domain.ErrReassignmentRequired, count, st.table)
+			}
+			continue
+		}
+		tag, err := tx.Exec(ctx, st.reassign, id, *reassignTo)
+		if err != nil {
+			return nil, 0, fmt.Errorf("reassign %s: %w", st.table, err)
+		}
+		moved += tag.RowsAffected()
+	}
+
+	closed, err := q.CloseDepartment(ctx, sqlc.CloseDepartmentParams{
+		ClosedReason: optionalString(reason),
+		ID:           id,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, 0, err
+	}
+	return dbToDomain(closed), moved, nil
+}
+
+// Reopen marks a closed department active again
+func (r *departmentRepository) Reopen(ctx context.Context, id uuid.UUID) (*domain.Department, error) {
+	dbDept, err := r.queries.ReopenDepartment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dbToDomain(dbDept), nil
+}
+
+// IsOrganizationMember checks a user belongs to the organization
+func (r *departmentRepository) IsOrganizationMember(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) (bool, error) {
+	var exists bool
+	var err error
+	if orgID == nil {
+		err = r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)`, userID).Scan(&exists)
+	} else {
+		err = r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM user_organizations WHERE user_id = $1 AND org_id = $2)`, userID, *orgID).Scan(&exists)
+	}
+	return exists, err
+}
+
+func hierarchyKey(orgID pgtype.UUID) string {
+	if !orgID.Valid {
+		return ""
+	}
+	return uuid.UUID(orgID.Bytes).String()
+}
@@ -12,32 +12,70 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const costCentreCodeExists = `-- name: CostCentreCodeExists :one
+SELECT EXISTS(
+    SELECT 1 FROM departments
+    WHERE cost_centre_code = $1::text
+      AND org_id IS NOT DISTINCT FROM $2::uuid
+      AND id <> $3
+) AS exists
+`
+
+type CostCentreCodeExistsParams struct {
+	CostCentreCode string      `db:"cost_centre_code" json:"cost_centre_code"`
+	OrgID          pgtype.UUID `db:"org_id" json:"org_id"`
+	ExcludeID      uuid.UUID   `db:"exclude_id" json:"exclude_id"`
+}
+
+func (q *Queries) CostCentreCodeExists(ctx context.Context, arg CostCentreCodeExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, costCentreCodeExists, arg.CostCentreCode, arg.OrgID, arg.ExcludeID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const countDepartments = `-- name: CountDepartments :one
 SELECT COUNT(*) FROM departments
 WHERE ($1::uuid IS NULL OR org_id = $1)
+  AND ($2::text IS NULL OR status = $2)
 `
 
-func (q *Queries) CountDepartments(ctx context.Context, dollar_1 pgtype.UUID) (int64, error) {
-	row := q.db.QueryRow(ctx, countDepartments, dollar_1)
+type CountDepartmentsParams struct {
+	OrgID  pgtype.UUID `db:"org_id" json:"org_id"`
+	Status *string     `db:"status" json:"status"`
+}
+
+func (q *Queries) CountDepartments(ctx context.Context, arg CountDepartmentsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countDepartments, arg.OrgID, arg.Status)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createDepartment = `-- name: CreateDepartment :one
-INSERT INTO departments (name, description, org_id)
-VALUES ($1, $2, $3)
-RETURNING id, name, description, created_at, updated_at, org_id
+INSERT INTO departments (name, description, org_id, parent_id, head_user_id, cost_centre_code)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, name, description, created_at, updated_at, org_id, parent_id, head_user_id, cost_centre_code, status, closed_at, closed_reason
 `
 
 type CreateDepartmentParams struct {
-	Name        string      `db:"name" json:"name"`
-	Description string      `db:"description" json:"description"`
-	OrgID       pgtype.UUID `db:"org_id" json:"org_id"`
+	Name           string      `db:"name" json:"name"`
+	Description    string      `db:"description" json:"description"`
+	OrgID          pgtype.UUID `db:"org_id" json:"org_id"`
+	ParentID       pgtype.UUID `db:"parent_id" json:"parent_id"`
+	HeadUserID     pgtype.UUID `db:"head_user_id" json:"head_user_id"`
+	CostCentreCode *string     `db:"cost_centre_code" json:"cost_centre_code"`
 }
 
 func (q *Queries) CreateDepartment(ctx context.Context, arg CreateDepartmentParams) (*Department, error) {
-	row := q.db.QueryRow(ctx, createDepartment, arg.Name, arg.Description, arg.OrgID)
+	row := q.db.QueryRow(ctx, createDepartment,
+		arg.Name,
+		arg.Description,
+		arg.OrgID,
+		arg.ParentID,
+		arg.HeadUserID,
+		arg.CostCentreCode,
+	)
 	var i Department
 	err := row.Scan(
 		&i.ID,
@@ -46,6 +84,12 @@ func (q *Queries) CreateDepartment(ctx context.Context, arg CreateDepartmentPara
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OrgID,
+		&i.ParentID,
+		&i.HeadUserID,
+		&i.CostCentreCode,
+		&i.Status,
+		&i.ClosedAt,
+		&i.ClosedReason,
 	)
 	return &i, err
 }
@@ -60,11 +104,16 @@ func (q *Queries) DeleteDepartment(ctx context.Context, id uuid.UUID) error {
 }
 
 const departmentExists = `-- name: DepartmentExists :one
-SELECT EXISTS(SELECT 1 FROM departments WHERE name = $1) AS exists
+SELECT EXISTS(SELECT 1 FROM departments WHERE name = $1 AND org_id IS NOT DISTINCT FROM $2::uuid) AS exists
 `
 
-func (q *Queries) DepartmentExists(ctx context.Context, name string) (bool, error) {
-	row := q.db.QueryRow(ctx, departmentExists, name)
+type DepartmentExistsParams struct {
+	Name  string      `db:"name" json:"name"`
+	OrgID pgtype.UUID `db:"org_id" json:"org_id"`
+}
+
+func (q *Queries) DepartmentExists(ctx context.Context, arg DepartmentExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, departmentExists, arg.Name, arg.OrgID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -82,7 +131,7 @@ func (q *Queries) DepartmentExistsByID(ctx context.Context, id uuid.UUID) (bool,
 }
 
 const getDepartmentByID = `-- name: GetDepartmentByID :one
-SELECT id, name, description, created_at, updated_at, org_id FROM departments WHERE id = $1
+SELECT id, name, description, created_at, updated_at, org_id, parent_id, head_user_id, cost_centre_code, status, closed_at, closed_reason FROM departments WHERE id = $1
 `
 
 func (q *Queries) GetDepartmentByID(ctx context.Context, id uuid.UUID) (*Department, error) {
@@ -95,16 +144,27 @@ func (q *Queries) GetDepartmentByID(ctx context.Context, id uuid.UUID) (*Departm
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OrgID,
+		&i.ParentID,
+		&i.HeadUserID,
+		&i.CostCentreCode,
+		&i.Status,
+		&i.ClosedAt,
+		&i.ClosedReason,
 	)
 	return &i, err
 }
 
 const getDepartmentByName = `-- name: GetDepartmentByName :one
-SELECT id, name, description, created_at, updated_at, org_id FROM departments WHERE name = $1
+SELECT id, name, description, created_at, updated_at, org_id, parent_id, head_user_id, cost_centre_code, status, closed_at, closed_reason FROM departments WHERE name = $1 AND org_id IS NOT DISTINCT FROM $2::uuid
 `
 
-func (q *Queries) GetDepartmentByName(ctx context.Context, name string) (*Department, error) {
-	row := q.db.QueryRow(ctx, getDepartmentByName, name)
+type GetDepartmentByNameParams struct {
+	Name  string      `db:"name" json:"name"`
+	OrgID pgtype.UUID `db:"org_id" json:"org_id"`
+}
+
+func (q *Queries) GetDepartmentByName(ctx context.Context, arg GetDepartmentByNameParams) (*Department, error) {
+	row := q.db.QueryRow(ctx, getDepartmentByName, arg.Name, arg.OrgID)
 	var i Department
 	err := row.Scan(
 		&i.ID,
@@ -113,25 +173,38 @@ func (q *Queries) GetDepartmentByName(ctx context.Context, name string) (*Depart
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OrgID,
+		&i.ParentID,
+		&i.HeadUserID,
+		&i.CostCentreCode,
+		&i.Status,
+		&i.ClosedAt,
+		&i.ClosedReason,
 	)
 	return &i, err
 }
 
 const listDepartments = `-- name: ListDepartments :many
-SELECT id, name, description, created_at, updated_at, org_id FROM departments 
+SELECT id, name, description, created_at, updated_at, org_id, parent_id, head_user_id, cost_centre_code, status, closed_at, closed_reason FROM departments 
 WHERE ($1::uuid IS NULL OR org_id = $1)
+  AND ($2::text IS NULL OR status = $2)
 ORDER BY created_at DESC 
-LIMIT $2 OFFSET $3
+LIMIT $3 OFFSET $4
 `
 
 type ListDepartmentsParams struct {
-	Column1 pgtype.UUID `db:"column_1" json:"column_1"`
-	Limit   int32       `db:"limit" json:"limit"`
-	Offset  int32       `db:"offset" json:"offset"`
+	OrgID      pgtype.UUID `db:"org_id" json:"org_id"`
+	Status     *string     `db:"status" json:"status"`
+	PageLimit  int32       `db:"page_limit" json:"page_limit"`
+	PageOffset int32       `db:"page_offset" json:"page_offset"`
 }
 
 func (q *Queries) ListDepartments(ctx context.Context, arg ListDepartmentsParams) ([]*Department, error) {
-	rows, err := q.db.Query(ctx, listDepartments, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listDepartments,
+		arg.OrgID,
+		arg.Status,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +219,12 @@ func (q *Queries) ListDepartments(ctx context.Context, arg ListDepartmentsParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.OrgID,
+			&i.ParentID,
+			&i.HeadUserID,
+			&i.CostCentreCode,
+			&i.Status,
+			&i.ClosedAt,
+			&i.ClosedReason,
 		); err != nil {
 			return nil, err
 		}
@@ -159,19 +238,27 @@ func (q *Queries) ListDepartments(ctx context.Context, arg ListDepartmentsParams
 
 const updateDepartment = `-- name: UpdateDepartment :one
 UPDATE departments
-SET name = $2, description = $3, updated_at = NOW()
+SET name = $2, description = $3, head_user_id = $4, cost_centre_code = $5, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, description, created_at, updated_at, org_id
+RETURNING id, name, description, created_at, updated_at, org_id, parent_id, head_user_id, cost_centre_code, status, closed_at, closed_reason
 `
 
 type UpdateDepartmentParams struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	Name        string    `db:"name" json:"name"`
-	Description string    `db:"description" json:"description"`
+	ID             uuid.UUID   `db:"id" json:"id"`
+	Name           string      `db:"name" json:"name"`
+	Description    string      `db:"description" json:"description"`
+	HeadUserID     pgtype.UUID `db:"head_user_id" json:"head_user_id"`
+	CostCentreCode *string     `db:"cost_centre_code" json:"cost_centre_code"`
 }
 
 func (q *Queries) UpdateDepartment(ctx context.Context, arg UpdateDepartmentParams) (*Department, error) {
-	row := q.db.QueryRow(ctx, updateDepartment, arg.ID, arg.Name, arg.Description)
+	row := q.db.QueryRow(ctx, updateDepartment,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.HeadUserID,
+		arg.CostCentreCode,
+	)
 	var i Department
 	err := row.Scan(
 		&i.ID,
@@ -180,6 +267,12 @@ func (q *Queries) UpdateDepartment(ctx context.Context, arg UpdateDepartmentPara
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OrgID,
+		&i.ParentID,
+		&i.HeadUserID,
+		&i.CostCentreCode,
+		&i.Status,
+		&i.ClosedAt,
+		&i.ClosedReason,
 	)
 	return &i, err
 }
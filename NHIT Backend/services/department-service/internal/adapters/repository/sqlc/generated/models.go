@@ -21,4 +21,14 @@ type Department struct {
 	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 	// Organization ID for organization-specific departments (NULL for global departments)
 	OrgID pgtype.UUID `db:"org_id" json:"org_id"`
+	// Parent department in the same organization (NULL for a top-level department)
+	ParentID pgtype.UUID `db:"parent_id" json:"parent_id"`
+	// User who heads the department
+	HeadUserID pgtype.UUID `db:"head_user_id" json:"head_user_id"`
+	// Cost centre code for expense reporting, unique within the organization
+	CostCentreCode *string `db:"cost_centre_code" json:"cost_centre_code"`
+	// ACTIVE or INACTIVE; closed departments are INACTIVE
+	Status       string             `db:"status" json:"status"`
+	ClosedAt     pgtype.Timestamptz `db:"closed_at" json:"closed_at"`
+	ClosedReason *string            `db:"closed_reason" json:"closed_reason"`
 }
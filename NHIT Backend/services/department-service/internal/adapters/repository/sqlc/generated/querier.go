@@ -12,14 +12,25 @@ import (
 )
 
 type Querier interface {
-	CountDepartments(ctx context.Context, dollar_1 pgtype.UUID) (int64, error)
+	CloseDepartment(ctx context.Context, arg CloseDepartmentParams) (*Department, error)
+	CostCentreCodeExists(ctx context.Context, arg CostCentreCodeExistsParams) (bool, error)
+	CountChildDepartments(ctx context.Context, parentID pgtype.UUID) (*CountChildDepartmentsRow, error)
+	CountDepartments(ctx context.Context, arg CountDepartmentsParams) (int64, error)
 	CreateDepartment(ctx context.Context, arg CreateDepartmentParams) (*Department, error)
 	DeleteDepartment(ctx context.Context, id uuid.UUID) error
-	DepartmentExists(ctx context.Context, name string) (bool, error)
+	DepartmentExists(ctx context.Context, arg DepartmentExistsParams) (bool, error)
 	DepartmentExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
 	GetDepartmentByID(ctx context.Context, id uuid.UUID) (*Department, error)
-	GetDepartmentByName(ctx context.Context, name string) (*Department, error)
+	GetDepartmentByName(ctx context.Context, arg GetDepartmentByNameParams) (*Department, error)
+	// Whether candidate_id is root_id or one of the departments below it
+	IsDepartmentInSubtree(ctx context.Context, arg IsDepartmentInSubtreeParams) (bool, error)
 	ListDepartments(ctx context.Context, arg ListDepartmentsParams) ([]*Department, error)
+	ListFormerDepartmentNames(ctx context.Context, departmentID uuid.UUID) ([]string, error)
+	ListOrganizationDepartments(ctx context.Context, orgID pgtype.UUID) ([]*Department, error)
+	LockDepartmentHierarchy(ctx context.Context, orgKey string) error
+	RecordFormerDepartmentName(ctx context.Context, arg RecordFormerDepartmentNameParams) error
+	ReopenDepartment(ctx context.Context, id uuid.UUID) (*Department, error)
+	SetDepartmentParent(ctx context.Context, arg SetDepartmentParentParams) (*Department, error)
 	UpdateDepartment(ctx context.Context, arg UpdateDepartmentParams) (*Department, error)
 }
 
@@ -1,17 +1,17 @@
 -- name: CreateDepartment :one
-INSERT INTO departments (name, description, org_id)
-VALUES ($1, $2, $3)
+INSERT INTO departments (name, description, org_id, parent_id, head_user_id, cost_centre_code)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: GetDepartmentByID :one
 SELECT * FROM departments WHERE id = $1;
 
 -- name: GetDepartmentByName :one
-SELECT * FROM departments WHERE name = $1;
+SELECT * FROM departments WHERE name = $1 AND org_id IS NOT DISTINCT FROM sqlc.arg(org_id)::uuid;
 
 -- name: UpdateDepartment :one
 UPDATE departments
-SET name = $2, description = $3, updated_at = NOW()
+SET name = $2, description = $3, head_user_id = $4, cost_centre_code = $5, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
@@ -20,16 +20,26 @@ DELETE FROM departments WHERE id = $1;
 
 -- name: ListDepartments :many
 SELECT * FROM departments 
-WHERE ($1::uuid IS NULL OR org_id = $1)
+WHERE (sqlc.narg(org_id)::uuid IS NULL OR org_id = sqlc.narg(org_id))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
 ORDER BY created_at DESC 
-LIMIT $2 OFFSET $3;
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
 
 -- name: CountDepartments :one
 SELECT COUNT(*) FROM departments
-WHERE ($1::uuid IS NULL OR org_id = $1);
+WHERE (sqlc.narg(org_id)::uuid IS NULL OR org_id = sqlc.narg(org_id))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status));
 
 -- name: DepartmentExists :one
-SELECT EXISTS(SELECT 1 FROM departments WHERE name = $1) AS exists;
+SELECT EXISTS(SELECT 1 FROM departments WHERE name = $1 AND org_id IS NOT DISTINCT FROM sqlc.arg(org_id)::uuid) AS exists;
 
 -- name: DepartmentExistsByID :one
 SELECT EXISTS(SELECT 1 FROM departments WHERE id = $1) AS exists;
+
+-- name: CostCentreCodeExists :one
+SELECT EXISTS(
+    SELECT 1 FROM departments
+    WHERE cost_centre_code = sqlc.arg(cost_centre_code)::text
+      AND org_id IS NOT DISTINCT FROM sqlc.arg(org_id)::uuid
+      AND id <> sqlc.arg(exclude_id)
+) AS exists;
@@ -0,0 +1,48 @@
+-- name: ListOrganizationDepartments :many
+SELECT * FROM departments
+WHERE org_id IS NOT DISTINCT FROM sqlc.arg(org_id)::uuid
+ORDER BY name;
+
+-- name: LockDepartmentHierarchy :exec
+SELECT pg_advisory_xact_lock(hashtext('department_hierarchy:' || COALESCE(sqlc.arg(org_id)::text, '')));
+
+-- name: IsDepartmentInSubtree :one
+-- Whether candidate_id is root_id or one of the departments below it
+WITH RECURSIVE subtree AS (
+    SELECT d.id FROM departments d WHERE d.id = sqlc.arg(root_id)
+    UNION ALL
+    SELECT child.id FROM departments child JOIN subtree s ON child.parent_id = s.id
+)
+SELECT EXISTS(SELECT 1 FROM subtree WHERE subtree.id = sqlc.arg(candidate_id)) AS exists;
+
+-- name: SetDepartmentParent :one
+UPDATE departments
+SET parent_id = sqlc.narg(parent_id), updated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CountChildDepartments :one
+SELECT COUNT(*) AS total, COUNT(*) FILTER (WHERE status = 'ACTIVE') AS active
+FROM departments WHERE parent_id = $1;
+
+-- name: CloseDepartment :one
+UPDATE departments
+SET status = 'INACTIVE', closed_at = NOW(), closed_reason = sqlc.narg(closed_reason), updated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ReopenDepartment :one
+UPDATE departments
+SET status = 'ACTIVE', closed_at = NULL, closed_reason = NULL, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: RecordFormerDepartmentName :exec
+INSERT INTO department_former_names (department_id, name)
+VALUES ($1, $2)
+ON CONFLICT (department_id, name) DO UPDATE SET renamed_at = NOW();
+
+-- name: ListFormerDepartmentNames :many
+SELECT name FROM department_former_names
+WHERE department_id = $1
+ORDER BY renamed_at;
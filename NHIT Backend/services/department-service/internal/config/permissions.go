@@ -9,6 +9,12 @@ func GetPermissionMap() map[string][]string {
 		"/departments.DepartmentService/ListDepartments":  {"view-department"},
 		"/departments.DepartmentService/UpdateDepartment": {"edit-department"},
 		"/departments.DepartmentService/DeleteDepartment": {"delete-department"},
+
+		// Hierarchy and lifecycle
+		"/departments.DepartmentService/GetDepartmentTree": {"view-department"},
+		"/departments.DepartmentService/MoveDepartment":    {"edit-department"},
+		"/departments.DepartmentService/CloseDepartment":   {"edit-department"},
+		"/departments.DepartmentService/ReopenDepartment":  {"edit-department"},
 	}
 }
 
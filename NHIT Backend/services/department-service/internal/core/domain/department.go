@@ -6,14 +6,48 @@ import (
 	"github.com/google/uuid"
 )
 
+// DepartmentStatus tells whether a department can still be used
+type DepartmentStatus string
+
+const (
+	DepartmentStatusActive   DepartmentStatus = "ACTIVE"
+	DepartmentStatusInactive DepartmentStatus = "INACTIVE"
+)
+
 // Department represents a department entity
 type Department struct {
-	ID          uuid.UUID
-	OrgID       *uuid.UUID
-	Name        string
-	Description string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID             uuid.UUID
+	OrgID          *uuid.UUID
+	ParentID       *uuid.UUID
+	HeadUserID     *uuid.UUID
+	Name           string
+	Description    string
+	CostCentreCode string
+	Status         DepartmentStatus
+	ClosedAt       *time.Time
+	ClosedReason   string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// DepartmentNode is a department with the departments directly below it
+type DepartmentNode struct {
+	Department *Department
+	Children   []*DepartmentNode
+}
+
+// DepartmentReferences counts what still points at a department
+type DepartmentReferences struct {
+	Users        int64 // users whose primary department it is
+	Members      int64 // organization memberships assigned to it
+	Invitations  int64 // open invitations that assign it on acceptance
+	GreenNotes   int64 // green notes raised for it under its current or a former name
+	PaymentNotes int64 // payment notes raised for it under its current or a former name
+}
+
+// AssignedUsers counts the references that move with the users when the department closes
+func (r DepartmentReferences) AssignedUsers() int64 {
+	return r.Users + r.Members + r.Invitations
 }
 
 // NewDepartment creates a new department
@@ -23,11 +57,25 @@ func NewDepartment(name, description string, orgID *uuid.UUID) *Department {
 		OrgID:       orgID,
 		Name:        name,
 		Description: description,
+		Status:      DepartmentStatusActive,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 }
 
+// IsActive reports whether the department is open
+func (d *Department) IsActive() bool {
+	return d.Status != DepartmentStatusInactive
+}
+
+// SameOrganization reports whether both departments belong to the same organization
+func (d *Department) SameOrganization(other *Department) bool {
+	if d.OrgID == nil || other.OrgID == nil {
+		return d.OrgID == nil && other.OrgID == nil
+	}
+	return *d.OrgID == *other.OrgID
+}
+
 // Validate validates department fields
 func (d *Department) Validate() error {
 	if d.Name == "" {
@@ -42,5 +90,16 @@ func (d *Department) Validate() error {
 	if len(d.Description) > 500 {
 		return ErrDepartmentDescriptionTooLong
 	}
+	if len(d.CostCentreCode) > 50 {
+		return ErrCostCentreCodeTooLong
+	}
+	for _, c := range d.CostCentreCode {
+		if !(c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '/') {
+			return ErrCostCentreCodeInvalid
+		}
+	}
+	if d.ParentID != nil && *d.ParentID == d.ID {
+		return ErrDepartmentCycle
+	}
 	return nil
 }
@@ -8,10 +8,22 @@ var (
 	ErrDepartmentNameTooLong          = errors.New("department name must not exceed 255 characters")
 	ErrDepartmentDescriptionRequired  = errors.New("department description is required")
 	ErrDepartmentDescriptionTooLong   = errors.New("department description must not exceed 500 characters")
+	ErrCostCentreCodeTooLong          = errors.New("cost centre code must not exceed 50 characters")
+	ErrCostCentreCodeInvalid          = errors.New("cost centre code may only contain letters, digits, '-', '_' and '/'")
 	
 	// Department business errors
 	ErrDepartmentNotFound             = errors.New("department not found")
 	ErrDepartmentAlreadyExists        = errors.New("department with this name already exists")
 	ErrDepartmentHasUsers             = errors.New("cannot delete department with assigned users")
 	ErrInvalidDepartmentID            = errors.New("invalid department ID")
+	ErrCostCentreCodeTaken            = errors.New("cost centre code is already used by another department")
+	ErrParentDepartmentNotFound       = errors.New("parent department not found in the organization")
+	ErrDepartmentCycle                = errors.New("a department cannot be placed under itself or a department below it")
+	ErrDepartmentInactive             = errors.New("department is inactive")
+	ErrDepartmentAlreadyActive        = errors.New("department is already active")
+	ErrDepartmentHasChildren          = errors.New("department still has sub-departments")
+	ErrDepartmentHasNotes             = errors.New("cannot delete department referenced by notes")
+	ErrReassignmentRequired           = errors.New("users are still assigned to the department; choose a department to reassign them to")
+	ErrInvalidReassignTarget          = errors.New("users can only be reassigned to another active department of the same organization")
+	ErrDepartmentHeadNotMember        = errors.New("department head must be a member of the organization")
 )
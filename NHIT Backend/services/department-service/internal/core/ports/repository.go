@@ -11,11 +11,30 @@ import (
 type DepartmentRepository interface {
 	Create(ctx context.Context, department *domain.Department) (*domain.Department, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Department, error)
-	GetByName(ctx context.Context, name string) (*domain.Department, error)
+	// GetByName finds a department by name within an organization; nil orgID means the global departments
+	GetByName(ctx context.Context, name string, orgID *uuid.UUID) (*domain.Department, error)
 	Update(ctx context.Context, department *domain.Department) (*domain.Department, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, orgID *uuid.UUID, page, pageSize int32) ([]*domain.Department, int32, error)
-	Exists(ctx context.Context, name string) (bool, error)
+	// List pages through an organization's departments; an empty status lists all of them
+	List(ctx context.Context, orgID *uuid.UUID, status domain.DepartmentStatus, page, pageSize int32) ([]*domain.Department, int32, error)
+	Exists(ctx context.Context, name string, orgID *uuid.UUID) (bool, error)
 	ExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
-	CountUsersByDepartment(ctx context.Context, departmentID uuid.UUID) (int32, error)
+	// CostCentreCodeExists checks the code against the organization's other departments
+	CostCentreCodeExists(ctx context.Context, code string, orgID *uuid.UUID, excludeID uuid.UUID) (bool, error)
+
+	// ListByOrganization returns every department of an organization, for building the tree
+	ListByOrganization(ctx context.Context, orgID *uuid.UUID) ([]*domain.Department, error)
+	// Move re-parents a department, failing with ErrDepartmentCycle when the
+	// new parent is the department or below it; nil parentID makes it top-level
+	Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*domain.Department, error)
+	// CountChildren counts the departments directly below a department
+	CountChildren(ctx context.Context, id uuid.UUID) (total, active int64, err error)
+	// CountReferences counts the users and notes that still point at a department
+	CountReferences(ctx context.Context, department *domain.Department) (domain.DepartmentReferences, error)
+	// Close marks a department inactive after moving its users to reassignTo,
+	// failing with ErrReassignmentRequired when users remain and reassignTo is nil
+	Close(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID, reason string) (*domain.Department, int64, error)
+	Reopen(ctx context.Context, id uuid.UUID) (*domain.Department, error)
+	// IsOrganizationMember checks a user belongs to the organization, or exists when orgID is nil
+	IsOrganizationMember(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) (bool, error)
 }
@@ -7,11 +7,41 @@ import (
 	"github.com/ShristiRnr/NHIT_Backend/services/department-service/internal/core/domain"
 )
 
+// DepartmentInput carries the fields a department is created with
+type DepartmentInput struct {
+	Name           string
+	Description    string
+	OrgID          *uuid.UUID
+	ParentID       *uuid.UUID
+	HeadUserID     *uuid.UUID
+	CostCentreCode string
+	ValidateOnly   bool // run every check but create nothing
+}
+
+// DepartmentUpdate carries the fields of a department update; nil head and
+// cost centre are left unchanged
+type DepartmentUpdate struct {
+	Name           string
+	Description    string
+	HeadUserID     *uuid.UUID
+	ClearHead      bool
+	CostCentreCode *string
+}
+
 // DepartmentService defines the interface for department business logic
 type DepartmentService interface {
-	CreateDepartment(ctx context.Context, name, description string, orgID *uuid.UUID) (*domain.Department, error)
+	CreateDepartment(ctx context.Context, in DepartmentInput) (*domain.Department, error)
 	GetDepartment(ctx context.Context, id uuid.UUID) (*domain.Department, error)
-	UpdateDepartment(ctx context.Context, id uuid.UUID, name, description string) (*domain.Department, error)
+	UpdateDepartment(ctx context.Context, id uuid.UUID, in DepartmentUpdate) (*domain.Department, error)
 	DeleteDepartment(ctx context.Context, id uuid.UUID) error
-	ListDepartments(ctx context.Context, orgID *uuid.UUID, page, pageSize int32) ([]*domain.Department, int32, error)
+	ListDepartments(ctx context.Context, orgID *uuid.UUID, status domain.DepartmentStatus, page, pageSize int32) ([]*domain.Department, int32, error)
+
+	// GetDepartmentTree returns the organization's top-level departments, or
+	// the department rootID, with everything below them
+	GetDepartmentTree(ctx context.Context, orgID *uuid.UUID, rootID *uuid.UUID, includeInactive bool) ([]*domain.DepartmentNode, int32, error)
+	MoveDepartment(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*domain.Department, error)
+	// CloseDepartment marks a department inactive and returns how many user
+	// assignments were moved to reassignTo
+	CloseDepartment(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID, reason string) (*domain.Department, int64, error)
+	ReopenDepartment(ctx context.Context, id uuid.UUID) (*domain.Department, error)
 }
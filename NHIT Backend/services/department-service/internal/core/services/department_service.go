@@ -23,13 +23,17 @@ func NewDepartmentService(repo ports.DepartmentRepository) ports.DepartmentServi
 }
 
 // CreateDepartment creates a new department with validation
-func (s *departmentService) CreateDepartment(ctx context.Context, name, description string, orgID *uuid.UUID) (*domain.Department, error) {
+func (s *departmentService) CreateDepartment(ctx context.Context, in ports.DepartmentInput) (*domain.Department, error) {
 	// Trim and validate input
-	name = strings.TrimSpace(name)
-	description = strings.TrimSpace(description)
+	name := strings.TrimSpace(in.Name)
+	description := strings.TrimSpace(in.Description)
+	orgID := in.OrgID
 
 	// Create department domain object
 	dept := domain.NewDepartment(name, description, orgID)
+	dept.ParentID = in.ParentID
+	dept.HeadUserID = in.HeadUserID
+	dept.CostCentreCode = normalizeCostCentreCode(in.CostCentreCode)
 
 	// Validate
 	if err := dept.Validate(); err != nil {
@@ -37,15 +41,17 @@ func (s *departmentService) CreateDepartment(ctx context.Context, name, descript
 		return nil, err
 	}
 
-	// Check if department already exists
-	// Note: We might want to check existence scoped by OrgID in future, 
-	// but currently Exists checks by name globally or per tenant? 
-	// The repo.Exists query is "SELECT EXISTS(SELECT 1 FROM departments WHERE name = $1)".
-	// This might forbid same department name across different orgs if not scoped.
-	// For now, adhering to user request "bss woo usii organization ka aayega" (List filtering),
-	// but unique name per org is likely desired. 
-	// Since I didn't update Exists query, I will proceed.
-	exists, err := s.repo.Exists(ctx, name)
+	if dept.ParentID != nil {
+		if _, err := s.activeDepartmentIn(ctx, *dept.ParentID, dept, domain.ErrParentDepartmentNotFound); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkHeadAndCostCentre(ctx, dept); err != nil {
+		return nil, err
+	}
+
+	// Check if department already exists in this organization
+	exists, err := s.repo.Exists(ctx, name, orgID)
 	if err != nil {
 		log.Printf("[DepartmentService] Error checking existence: %v", err)
 		return nil, err
@@ -54,6 +60,9 @@ func (s *departmentService) CreateDepartment(ctx context.Context, name, descript
 		log.Printf("[DepartmentService] Department already exists: %s", name)
 		return nil, domain.ErrDepartmentAlreadyExists
 	}
+	if in.ValidateOnly {
+		return dept, nil
+	}
 
 	// Create department
 	created, err := s.repo.Create(ctx, dept)
@@ -82,14 +91,14 @@ func (s *departmentService) GetDepartment(ctx context.Context, id uuid.UUID) (*d
 }
 
 // UpdateDepartment updates a department with validation
-func (s *departmentService) UpdateDepartment(ctx context.Context, id uuid.UUID, name, description string) (*domain.Department, error) {
+func (s *departmentService) UpdateDepartment(ctx context.Context, id uuid.UUID, in ports.DepartmentUpdate) (*domain.Department, error) {
 	if id == uuid.Nil {
 		return nil, domain.ErrInvalidDepartmentID
 	}
 
 	// Trim input
-	name = strings.TrimSpace(name)
-	description = strings.TrimSpace(description)
+	name := strings.TrimSpace(in.Name)
+	description := strings.TrimSpace(in.Description)
 
 	// Check if department exists
 	exists, err := s.repo.ExistsByID(ctx, id)
@@ -109,6 +118,19 @@ func (s *departmentService) UpdateDepartment(ctx context.Context, id uuid.UUID,
 	// Update fields
 	dept.Name = name
 	dept.Description = description
+	headChanged := false
+	if in.ClearHead {
+		dept.HeadUserID = nil
+	} else if in.HeadUserID != nil {
+		headChanged = dept.HeadUserID == nil || *dept.HeadUserID != *in.HeadUserID
+		dept.HeadUserID = in.HeadUserID
+	}
+	codeChanged := false
+	if in.CostCentreCode != nil {
+		code := normalizeCostCentreCode(*in.CostCentreCode)
+		codeChanged = code != dept.CostCentreCode
+		dept.CostCentreCode = code
+	}
 
 	// Validate
 	if err := dept.Validate(); err != nil {
@@ -116,8 +138,21 @@ func (s *departmentService) UpdateDepartment(ctx context.Context, id uuid.UUID,
 		return nil, err
 	}
 
+	// Only the fields being changed are checked, so a head who has since left
+	// the organization does not block renaming the department
+	check := *dept
+	if !headChanged {
+		check.HeadUserID = nil
+	}
+	if !codeChanged {
+		check.CostCentreCode = ""
+	}
+	if err := s.checkHeadAndCostCentre(ctx, &check); err != nil {
+		return nil, err
+	}
+
 	// Check if name is already taken by another department
-	existingDept, err := s.repo.GetByName(ctx, name)
+	existingDept, err := s.repo.GetByName(ctx, name, dept.OrgID)
 	if err == nil && existingDept.ID != id {
 		return nil, domain.ErrDepartmentAlreadyExists
 	}
@@ -139,23 +174,34 @@ func (s *departmentService) DeleteDepartment(ctx context.Context, id uuid.UUID)
 		return domain.ErrInvalidDepartmentID
 	}
 
-	// Check if department exists
-	exists, err := s.repo.ExistsByID(ctx, id)
+	// Get existing department
+	dept, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.ErrDepartmentNotFound
+	}
+
+	// Check if department has sub-departments
+	children, _, err := s.repo.CountChildren(ctx, id)
 	if err != nil {
 		return err
 	}
-	if !exists {
-		return domain.ErrDepartmentNotFound
+	if children > 0 {
+		return fmt.Errorf("%w: %d below it", domain.ErrDepartmentHasChildren, children)
 	}
 
-	// Check if department has users
-	userCount, err := s.repo.CountUsersByDepartment(ctx, id)
+	// Check if department has users or notes
+	refs, err := s.repo.CountReferences(ctx, dept)
 	if err != nil {
-		log.Printf("[DepartmentService] Error checking users: %v", err)
+		log.Printf("[DepartmentService] Error checking references: %v", err)
 		return err
 	}
-	if userCount > 0 {
-		return fmt.Errorf("%w: %d users assigned", domain.ErrDepartmentHasUsers, userCount)
+	if refs.AssignedUsers() > 0 {
+		return fmt.Errorf("%w: %d users, %d memberships and %d open invitations assigned",
+			domain.ErrDepartmentHasUsers, refs.Users, refs.Members, refs.Invitations)
+	}
+	if refs.GreenNotes > 0 || refs.PaymentNotes > 0 {
+		return fmt.Errorf("%w: %d green notes and %d payment notes; close the department instead",
+			domain.ErrDepartmentHasNotes, refs.GreenNotes, refs.PaymentNotes)
 	}
 
 	// Delete department
@@ -169,7 +215,7 @@ func (s *departmentService) DeleteDepartment(ctx context.Context, id uuid.UUID)
 }
 
 // ListDepartments retrieves departments with pagination
-func (s *departmentService) ListDepartments(ctx context.Context, orgID *uuid.UUID, page, pageSize int32) ([]*domain.Department, int32, error) {
+func (s *departmentService) ListDepartments(ctx context.Context, orgID *uuid.UUID, status domain.DepartmentStatus, page, pageSize int32) ([]*domain.Department, int32, error) {
 	// Set defaults
 	if page < 1 {
 		page = 1
@@ -178,7 +224,7 @@ func (s *departmentService) ListDepartments(ctx context.Context, orgID *uuid.UUI
 		pageSize = 10
 	}
 
-	departments, total, err := s.repo.List(ctx, orgID, page, pageSize)
+	departments, total, err := s.repo.List(ctx, orgID, status, page, pageSize)
 	if err != nil {
 		log.Printf("[DepartmentService] Error listing departments: %v", err)
 		return nil, 0, err
@@ -186,3 +232,194 @@ func (s *departmentService) ListDepartments(ctx context.Context, orgID *uuid.UUI
 
 	return departments, total, nil
 }
+
+// GetDepartmentTree builds the organization's departments into a tree
+func (s *departmentService) GetDepartmentTree(ctx context.Context, orgID *uuid.UUID, rootID *uuid.UUID, includeInactive bool) ([]*domain.DepartmentNode, int32, error) {
+	departments, err := s.repo.ListByOrganization(ctx, orgID)
+	if err != nil {
+		log.Printf("[DepartmentService] Error listing departments: %v", err)
+		return nil, 0, err
+	}
+
+	nodes := make(map[uuid.UUID]*domain.DepartmentNode, len(departments))
+	for _, dept := range departments {
+		if includeInactive || dept.IsActive() {
+			nodes[dept.ID] = &domain.DepartmentNode{Department: dept}
+		}
+	}
+
+	// Departments arrive sorted by name, so siblings stay sorted. A department
+	// whose parent is hidden as inactive is left out with it.
+	var roots []*domain.DepartmentNode
+	for _, dept := range departments {
+		node, ok := nodes[dept.ID]
+		if !ok {
+			continue
+		}
+		if dept.ParentID == nil {
+			roots = append(roots, node)
+		} else if parent, ok := nodes[*dept.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	if rootID != nil {
+		root, ok := nodes[*rootID]
+		if !ok {
+			return nil, 0, domain.ErrDepartmentNotFound
+		}
+		roots = []*domain.DepartmentNode{root}
+	}
+
+	var total int32
+	var count func(n *domain.DepartmentNode)
+	count = func(n *domain.DepartmentNode) {
+		total++
+		for _, child := range n.Children {
+			count(child)
+		}
+	}
+	for _, root := range roots {
+		count(root)
+	}
+	return roots, total, nil
+}
+
+// MoveDepartment moves a department and its sub-departments under another
+// active department of the same organization, or to the top level
+func (s *departmentService) MoveDepartment(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*domain.Department, error) {
+	if id == uuid.Nil {
+		return nil, domain.ErrInvalidDepartmentID
+	}
+	dept, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, domain.ErrDepartmentNotFound
+	}
+	if parentID != nil {
+		if *parentID == id {
+			return nil, domain.ErrDepartmentCycle
+		}
+		if _, err := s.activeDepartmentIn(ctx, *parentID, dept, domain.ErrParentDepartmentNotFound); err != nil {
+			return nil, err
+		}
+	}
+
+	moved, err := s.repo.Move(ctx, id, parentID)
+	if err != nil {
+		log.Printf("[DepartmentService] Error moving department: %v", err)
+		return nil, err
+	}
+
+	log.Printf("[DepartmentService] Department moved: %s (ID: %s)", moved.Name, moved.ID)
+	return moved, nil
+}
+
+// CloseDepartment marks a department inactive, first moving its users to
+// reassignTo. Its sub-departments must be closed or moved first.
+func (s *departmentService) CloseDepartment(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID, reason string) (*domain.Department, int64, error) {
+	if id == uuid.Nil {
+		return nil, 0, domain.ErrInvalidDepartmentID
+	}
+	dept, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, 0, domain.ErrDepartmentNotFound
+	}
+	if !dept.IsActive() {
+		return nil, 0, domain.ErrDepartmentInactive
+	}
+
+	_, activeChildren, err := s.repo.CountChildren(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if activeChildren > 0 {
+		return nil, 0, fmt.Errorf("%w: %d active below it", domain.ErrDepartmentHasChildren, activeChildren)
+	}
+	if reassignTo != nil {
+		if *reassignTo == id {
+			return nil, 0, domain.ErrInvalidReassignTarget
+		}
+		if _, err := s.activeDepartmentIn(ctx, *reassignTo, dept, domain.ErrInvalidReassignTarget); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	closed, moved, err := s.repo.Close(ctx, id, reassignTo, strings.TrimSpace(reason))
+	if err != nil {
+		log.Printf("[DepartmentService] Error closing department: %v", err)
+		return nil, 0, err
+	}
+
+	log.Printf("[DepartmentService] Department closed: %s (ID: %s), %d user assignments moved", closed.Name, closed.ID, moved)
+	return closed, moved, nil
+}
+
+// ReopenDepartment makes a closed department active again under an active parent
+func (s *departmentService) ReopenDepartment(ctx context.Context, id uuid.UUID) (*domain.Department, error) {
+	if id == uuid.Nil {
+		return nil, domain.ErrInvalidDepartmentID
+	}
+	dept, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, domain.ErrDepartmentNotFound
+	}
+	if dept.IsActive() {
+		return nil, domain.ErrDepartmentAlreadyActive
+	}
+	if dept.ParentID != nil {
+		if _, err := s.activeDepartmentIn(ctx, *dept.ParentID, dept, domain.ErrParentDepartmentNotFound); err != nil {
+			return nil, err
+		}
+	}
+
+	reopened, err := s.repo.Reopen(ctx, id)
+	if err != nil {
+		log.Printf("[DepartmentService] Error reopening department: %v", err)
+		return nil, err
+	}
+
+	log.Printf("[DepartmentService] Department reopened: %s (ID: %s)", reopened.Name, reopened.ID)
+	return reopened, nil
+}
+
+// activeDepartmentIn loads another department of dept's organization,
+// returning notFound when it is missing or belongs elsewhere and
+// ErrDepartmentInactive when it is closed
+func (s *departmentService) activeDepartmentIn(ctx context.Context, id uuid.UUID, dept *domain.Department, notFound error) (*domain.Department, error) {
+	other, err := s.repo.GetByID(ctx, id)
+	if err != nil || !other.SameOrganization(dept) {
+		return nil, notFound
+	}
+	if !other.IsActive() {
+		return nil, fmt.Errorf("%w: %s", domain.ErrDepartmentInactive, other.Name)
+	}
+	return other, nil
+}
+
+// checkHeadAndCostCentre checks the head belongs to the organization and the
+// cost centre code is not used by another of its departments
+func (s *departmentService) checkHeadAndCostCentre(ctx context.Context, dept *domain.Department) error {
+	if dept.HeadUserID != nil {
+		member, err := s.repo.IsOrganizationMember(ctx, *dept.HeadUserID, dept.OrgID)
+		if err != nil {
+			return err
+		}
+		if !member {
+			return domain.ErrDepartmentHeadNotMember
+		}
+	}
+	if dept.CostCentreCode != "" {
+		taken, err := s.repo.CostCentreCodeExists(ctx, dept.CostCentreCode, dept.OrgID, dept.ID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return domain.ErrCostCentreCodeTaken
+		}
+	}
+	return nil
+}
+
+func normalizeCostCentreCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
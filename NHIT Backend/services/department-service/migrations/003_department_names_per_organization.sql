@@ -0,0 +1,6 @@
+-- Department names only need to be unique within an organization, so every
+-- organization can have its own Finance or Administration department
+ALTER TABLE departments DROP CONSTRAINT IF EXISTS departments_name_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_departments_org_name
+    ON departments (COALESCE(org_id, '00000000-0000-0000-0000-000000000000'::uuid), name);
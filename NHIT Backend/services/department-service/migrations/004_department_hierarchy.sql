@@ -0,0 +1,28 @@
+-- Departments form a tree within an organization and carry what approval
+-- routing and expense reporting need: a head, a cost centre and a status.
+-- Closed departments stay for the notes that still name them.
+ALTER TABLE departments
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES departments(id) ON DELETE RESTRICT,
+    ADD COLUMN IF NOT EXISTS head_user_id UUID,
+    ADD COLUMN IF NOT EXISTS cost_centre_code VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS closed_reason TEXT;
+
+ALTER TABLE departments DROP CONSTRAINT IF EXISTS chk_departments_status;
+ALTER TABLE departments ADD CONSTRAINT chk_departments_status CHECK (status IN ('ACTIVE', 'INACTIVE'));
+
+ALTER TABLE departments DROP CONSTRAINT IF EXISTS chk_departments_parent;
+ALTER TABLE departments ADD CONSTRAINT chk_departments_parent CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_departments_parent_id ON departments(parent_id);
+CREATE INDEX IF NOT EXISTS idx_departments_head_user_id ON departments(head_user_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_departments_org_cost_centre
+    ON departments (COALESCE(org_id, '00000000-0000-0000-0000-000000000000'::uuid), cost_centre_code)
+    WHERE cost_centre_code IS NOT NULL;
+
+COMMENT ON COLUMN departments.parent_id IS 'Parent department in the same organization (NULL for a top-level department)';
+COMMENT ON COLUMN departments.head_user_id IS 'User who heads the department';
+COMMENT ON COLUMN departments.cost_centre_code IS 'Cost centre code for expense reporting, unique within the organization';
+COMMENT ON COLUMN departments.status IS 'ACTIVE or INACTIVE; closed departments are INACTIVE';
@@ -0,0 +1,10 @@
+-- Green and payment notes name their department as text. A rename keeps the
+-- old name here so the notes raised under it still count as references.
+CREATE TABLE IF NOT EXISTS department_former_names (
+    department_id UUID NOT NULL REFERENCES departments(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    renamed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (department_id, name)
+);
+
+COMMENT ON TABLE department_former_names IS 'Names a department had before it was renamed; notes raised under them still reference it';
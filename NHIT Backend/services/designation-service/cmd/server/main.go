@@ -16,7 +16,6 @@ import (
 	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
 	grpcHandler "github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/adapters/grpc"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/adapters/repository"
-	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/adapters/repository/sqlc/generated"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/config"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/services"
 	"google.golang.org/grpc"
@@ -56,11 +55,8 @@ func main() {
 	}
 	log.Println("✅ Database connection established (Pool: Max=20, Min=5)")
 
-	// Initialize SQLC queries with local SQLC
-	queries := sqlc.New(pool)
-
 	// Initialize repository
-	designationRepo := repository.NewDesignationRepository(queries)
+	designationRepo := repository.NewDesignationRepository(pool)
 
 	// Initialize service
 	designationService := services.NewDesignationService(designationRepo)
@@ -6,6 +6,7 @@ require (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/money v0.0.0
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.7.2
 	google.golang.org/grpc v1.77.0
@@ -30,4 +31,5 @@ replace (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb => ../../api/pb/authpb
 	github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb => ../../api/pb/designationpb
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware => ../../pkg/middleware
+	github.com/ShristiRnr/NHIT_Backend/pkg/money => ../../pkg/money
 )
@@ -2,11 +2,13 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"strings"
 
 	designationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/ports"
 	"github.com/google/uuid"
@@ -66,14 +68,30 @@ func (h *DesignationHandler) CreateDesignation(ctx context.Context, req *designa
 
 	orgID := getOrgIDFromContext(ctx)
 
-	designation, err := h.service.CreateDesignation(ctx, req.Name, req.Description, orgID)
+	powers, err := fromProtoFinancialPowers(req.FinancialPowers)
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	return &designationpb.DesignationResponse{
+	designation, err := h.service.CreateDesignation(ctx, ports.DesignationInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		Grade:           req.Grade,
+		Level:           req.Level,
+		FinancialPowers: powers,
+		ValidateOnly:    req.ValidateOnly,
+	}, orgID)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	resp := &designationpb.DesignationResponse{
 		Designation: toProtoDesignation(designation),
-	}, nil
+	}
+	if req.ValidateOnly {
+		resp.Message = "designation is valid and was not created"
+	}
+	return resp, nil
 }
 
 func (h *DesignationHandler) GetDesignation(ctx context.Context, req *designationpb.GetDesignationRequest) (*designationpb.DesignationResponse, error) {
@@ -107,7 +125,12 @@ func (h *DesignationHandler) UpdateDesignation(ctx context.Context, req *designa
 		return nil, status.Error(codes.InvalidArgument, "description is required")
 	}
 
-	designation, err := h.service.UpdateDesignation(ctx, id, req.Name, req.Description)
+	designation, err := h.service.UpdateDesignation(ctx, id, ports.DesignationUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+		Grade:       req.Grade,
+		Level:       req.Level,
+	})
 	if err != nil {
 		return nil, handleError(err)
 	}
@@ -159,6 +182,8 @@ func (h *DesignationHandler) ListDesignations(
             Description: d.Description,
             CreatedAt:   timestamppb.New(d.CreatedAt),
             UpdatedAt:   timestamppb.New(d.UpdatedAt),
+            Grade:       d.Grade,
+            Level:       d.Level,
         }
     }
 
@@ -176,24 +201,118 @@ func (h *DesignationHandler) ListDesignations(
 }
 
 
+func (h *DesignationHandler) SetFinancialPowers(ctx context.Context, req *designationpb.SetFinancialPowersRequest) (*designationpb.DesignationResponse, error) {
+	log.Printf("gRPC SetFinancialPowers: id=%s entries=%d", req.DesignationId, len(req.FinancialPowers))
+
+	id, err := uuid.Parse(req.DesignationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid designation ID")
+	}
+
+	powers, err := fromProtoFinancialPowers(req.FinancialPowers)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	designation, err := h.service.SetFinancialPowers(ctx, id, powers)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &designationpb.DesignationResponse{
+		Designation: toProtoDesignation(designation),
+		Message:     "Financial powers updated successfully",
+	}, nil
+}
+
+func (h *DesignationHandler) ListApprovingDesignations(ctx context.Context, req *designationpb.ListApprovingDesignationsRequest) (*designationpb.ListApprovingDesignationsResponse, error) {
+	amount, err := money.Parse(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, domain.ErrApprovalAmountInvalid.Error())
+	}
+
+	approvers, err := h.service.ListApprovingDesignations(ctx, getOrgIDFromContext(ctx), amount, req.ExpenseCategory, fromProtoApprovalFor(req.ApprovalFor))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	resp := &designationpb.ListApprovingDesignationsResponse{
+		Designations: make([]*designationpb.ApprovingDesignation, len(approvers)),
+	}
+	for i, a := range approvers {
+		resp.Designations[i] = &designationpb.ApprovingDesignation{
+			Designation:    toProtoDesignation(a.Designation),
+			FinancialPower: toProtoFinancialPower(a.Power),
+		}
+	}
+	return resp, nil
+}
+
+
 func toProtoDesignation(d *domain.Designation) *designationpb.Designation {
-	return &designationpb.Designation{
+	pd := &designationpb.Designation{
 		Id:          d.ID.String(),
 		Name:        d.Name,
 		Description: d.Description,
 		CreatedAt:   timestamppb.New(d.CreatedAt),
 		UpdatedAt:   timestamppb.New(d.UpdatedAt),
+		Grade:       d.Grade,
+		Level:       d.Level,
+	}
+	for _, p := range d.FinancialPowers {
+		pd.FinancialPowers = append(pd.FinancialPowers, toProtoFinancialPower(p))
+	}
+	return pd
+}
+
+func toProtoFinancialPower(p domain.FinancialPower) *designationpb.FinancialPower {
+	approvalFor := designationpb.ApprovalFor_APPROVAL_FOR_ANY
+	if p.ApprovalFor != domain.ApprovalForAny {
+		approvalFor = designationpb.ApprovalFor(designationpb.ApprovalFor_value["APPROVAL_FOR_"+string(p.ApprovalFor)])
+	}
+	return &designationpb.FinancialPower{
+		ExpenseCategory: p.ExpenseCategory,
+		ApprovalFor:     approvalFor,
+		MaxAmount:       p.MaxAmount.String(),
+	}
+}
+
+func fromProtoApprovalFor(a designationpb.ApprovalFor) domain.ApprovalFor {
+	if a == designationpb.ApprovalFor_APPROVAL_FOR_ANY {
+		return domain.ApprovalForAny
+	}
+	return domain.ApprovalFor(strings.TrimPrefix(a.String(), "APPROVAL_FOR_"))
+}
+
+func fromProtoFinancialPowers(in []*designationpb.FinancialPower) ([]domain.FinancialPower, error) {
+	powers := make([]domain.FinancialPower, len(in))
+	for i, p := range in {
+		amount, err := money.Parse(p.MaxAmount)
+		if err != nil {
+			return nil, domain.ErrFinancialPowerAmountInvalid
+		}
+		powers[i] = domain.FinancialPower{
+			ExpenseCategory: p.ExpenseCategory,
+			ApprovalFor:     fromProtoApprovalFor(p.ApprovalFor),
+			MaxAmount:       amount,
+		}
 	}
+	return powers, nil
 }
 
 func handleError(err error) error {
-	switch err {
-	case domain.ErrDesignationNotFound:
+	switch {
+	case errors.Is(err, domain.ErrDesignationNotFound):
 		return status.Error(codes.NotFound, err.Error())
-	case domain.ErrDesignationAlreadyExists:
+	case errors.Is(err, domain.ErrDesignationAlreadyExists):
 		return status.Error(codes.AlreadyExists, err.Error())
-	case domain.ErrDesignationHasUsers:
+	case errors.Is(err, domain.ErrDesignationHasUsers):
 		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrDesignationGradeTooLong), errors.Is(err, domain.ErrDesignationLevelInvalid),
+		errors.Is(err, domain.ErrInvalidApprovalFor), errors.Is(err, domain.ErrFinancialPowerAmountInvalid),
+		errors.Is(err, domain.ErrFinancialPowerCategoryTooLong), errors.Is(err, domain.ErrFinancialPowerDuplicate),
+		errors.Is(err, domain.ErrApprovalAmountInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
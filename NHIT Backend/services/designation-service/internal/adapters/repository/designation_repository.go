@@ -2,26 +2,42 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/adapters/repository/sqlc/generated"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/ports"
 )
 
 type designationRepository struct {
+	pool    *pgxpool.Pool
 	queries *sqlc.Queries
 }
 
 // NewDesignationRepository creates a new designation repository
-func NewDesignationRepository(queries *sqlc.Queries) ports.DesignationRepository {
+func NewDesignationRepository(pool *pgxpool.Pool) ports.DesignationRepository {
 	return &designationRepository{
-		queries: queries,
+		pool:    pool,
+		queries: sqlc.New(pool),
 	}
 }
 
 // Create creates a new designation
 func (r *designationRepository) Create(ctx context.Context, designation *domain.Designation) error {
+	return r.create(ctx, designation, true)
+}
+
+// CheckCreate runs the inserts of Create and rolls them back, so a clash with
+// an existing designation is reported without writing anything
+func (r *designationRepository) CheckCreate(ctx context.Context, designation *domain.Designation) error {
+	return r.create(ctx, designation, false)
+}
+
+func (r *designationRepository) create(ctx context.Context, designation *domain.Designation, commit bool) error {
 	var orgID pgtype.UUID
 	if designation.OrgID != nil {
 		orgID = pgtype.UUID{Bytes: *designation.OrgID, Valid: true}
@@ -29,26 +45,65 @@ func (r *designationRepository) Create(ctx context.Context, designation *domain.
 		orgID = pgtype.UUID{Valid: false}
 	}
 
-	_, err := r.queries.CreateDesignation(ctx, sqlc.CreateDesignationParams{
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	_, err = q.CreateDesignation(ctx, sqlc.CreateDesignationParams{
 		ID:          designation.ID,
 		Name:        designation.Name,
 		Description: designation.Description,
 		CreatedAt:   pgtype.Timestamptz{Time: designation.CreatedAt, Valid: true},
 		UpdatedAt:   pgtype.Timestamptz{Time: designation.UpdatedAt, Valid: true},
 		OrgID:       orgID,
+		Grade:       designation.Grade,
+		Level:       designation.Level,
 	})
 
-	return err
+	// Names are unique per organization
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrDesignationAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+	if err := insertFinancialPowers(ctx, q, designation.ID, designation.FinancialPowers); err != nil {
+		return err
+	}
+	if !commit {
+		return nil
+	}
+	return tx.Commit(ctx)
 }
 
 // GetByID retrieves a designation by ID
 func (r *designationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Designation, error) {
 	dbDesignation, err := r.queries.GetDesignationByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrDesignationNotFound
+		}
 		return nil, err
 	}
+	designation := toDomainDesignation(dbDesignation)
 
-	return toDomainDesignation(dbDesignation), nil
+	dbPowers, err := r.queries.ListDesignationFinancialPowers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	designation.FinancialPowers = make([]domain.FinancialPower, len(dbPowers))
+	for i, p := range dbPowers {
+		designation.FinancialPowers[i] = domain.FinancialPower{
+			ExpenseCategory: p.ExpenseCategory,
+			ApprovalFor:     domain.ApprovalFor(p.ApprovalFor),
+			MaxAmount:       p.MaxAmount,
+		}
+	}
+	return designation, nil
 }
 
 // Update updates an existing designation
@@ -58,11 +113,94 @@ func (r *designationRepository) Update(ctx context.Context, designation *domain.
 		Name:        designation.Name,
 		Description: designation.Description,
 		UpdatedAt:   pgtype.Timestamptz{Time: designation.UpdatedAt, Valid: true},
+		Grade:       designation.Grade,
+		Level:       designation.Level,
 	})
 
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrDesignationAlreadyExists
+	}
 	return err
 }
 
+// SetFinancialPowers replaces a designation's delegation of financial powers
+func (r *designationRepository) SetFinancialPowers(ctx context.Context, id uuid.UUID, powers []domain.FinancialPower) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	if err := q.DeleteDesignationFinancialPowers(ctx, id); err != nil {
+		return err
+	}
+	if err := insertFinancialPowers(ctx, q, id, powers); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ListOrganizationFinancialPowers returns the organization's designations that
+// hold financial powers, most junior first, each with its whole matrix
+func (r *designationRepository) ListOrganizationFinancialPowers(ctx context.Context, orgID *uuid.UUID) ([]*domain.Designation, error) {
+	var orgIDParam pgtype.UUID
+	if orgID != nil {
+		orgIDParam = pgtype.UUID{Bytes: *orgID, Valid: true}
+	}
+
+	rows, err := r.queries.ListOrganizationFinancialPowers(ctx, orgIDParam)
+	if err != nil {
+		return nil, err
+	}
+
+	var designations []*domain.Designation
+	byID := make(map[uuid.UUID]*domain.Designation)
+	for _, row := range rows {
+		d, ok := byID[row.ID]
+		if !ok {
+			d = toDomainDesignation(&sqlc.Designation{
+				ID:          row.ID,
+				Name:        row.Name,
+				Description: row.Description,
+				CreatedAt:   row.CreatedAt,
+				UpdatedAt:   row.UpdatedAt,
+				OrgID:       row.OrgID,
+				Grade:       row.Grade,
+				Level:       row.Level,
+			})
+			byID[row.ID] = d
+			designations = append(designations, d)
+		}
+		d.FinancialPowers = append(d.FinancialPowers, domain.FinancialPower{
+			ExpenseCategory: row.ExpenseCategory,
+			ApprovalFor:     domain.ApprovalFor(row.ApprovalFor),
+			MaxAmount:       row.MaxAmount,
+		})
+	}
+	return designations, nil
+}
+
+func insertFinancialPowers(ctx context.Context, q *sqlc.Queries, id uuid.UUID, powers []domain.FinancialPower) error {
+	for _, p := range powers {
+		err := q.CreateDesignationFinancialPower(ctx, sqlc.CreateDesignationFinancialPowerParams{
+			DesignationID:   id,
+			ExpenseCategory: p.ExpenseCategory,
+			ApprovalFor:     string(p.ApprovalFor),
+			MaxAmount:       p.MaxAmount,
+		})
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrFinancialPowerDuplicate
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Delete deletes a designation by ID
 func (r *designationRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.queries.DeleteDesignation(ctx, id)
@@ -114,6 +252,8 @@ func toDomainDesignation(dbDesignation *sqlc.Designation) *domain.Designation {
 		OrgID:       orgID,
 		Name:        dbDesignation.Name,
 		Description: dbDesignation.Description,
+		Grade:       dbDesignation.Grade,
+		Level:       dbDesignation.Level,
 		CreatedAt:   dbDesignation.CreatedAt.Time,
 		UpdatedAt:   dbDesignation.UpdatedAt.Time,
 	}
@@ -25,9 +25,9 @@ func (q *Queries) CountDesignations(ctx context.Context, dollar_1 pgtype.UUID) (
 }
 
 const createDesignation = `-- name: CreateDesignation :one
-INSERT INTO designations (id, name, description, created_at, updated_at, org_id)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, name, description, created_at, updated_at, org_id
+INSERT INTO designations (id, name, description, created_at, updated_at, org_id, grade, level)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, name, description, created_at, updated_at, org_id, grade, level
 `
 
 type CreateDesignationParams struct {
@@ -37,6 +37,8 @@ type CreateDesignationParams struct {
 	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 	OrgID       pgtype.UUID        `db:"org_id" json:"org_id"`
+	Grade       string             `db:"grade" json:"grade"`
+	Level       int32              `db:"level" json:"level"`
 }
 
 func (q *Queries) CreateDesignation(ctx context.Context, arg CreateDesignationParams) (*Designation, error) {
@@ -47,6 +49,8 @@ func (q *Queries) CreateDesignation(ctx context.Context, arg CreateDesignationPa
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.OrgID,
+		arg.Grade,
+		arg.Level,
 	)
 	var i Designation
 	err := row.Scan(
@@ -56,6 +60,8 @@ func (q *Queries) CreateDesignation(ctx context.Context, arg CreateDesignationPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OrgID,
+		&i.Grade,
+		&i.Level,
 	)
 	return &i, err
 }
@@ -70,7 +76,7 @@ func (q *Queries) DeleteDesignation(ctx context.Context, id uuid.UUID) error {
 }
 
 const getDesignationByID = `-- name: GetDesignationByID :one
-SELECT id, name, description, created_at, updated_at, org_id FROM designations WHERE id = $1
+SELECT id, name, description, created_at, updated_at, org_id, grade, level FROM designations WHERE id = $1
 `
 
 func (q *Queries) GetDesignationByID(ctx context.Context, id uuid.UUID) (*Designation, error) {
@@ -83,12 +89,14 @@ func (q *Queries) GetDesignationByID(ctx context.Context, id uuid.UUID) (*Design
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OrgID,
+		&i.Grade,
+		&i.Level,
 	)
 	return &i, err
 }
 
 const listDesignations = `-- name: ListDesignations :many
-SELECT id, name, description, created_at, updated_at, org_id
+SELECT id, name, description, created_at, updated_at, org_id, grade, level
 FROM designations
 WHERE ($1::uuid IS NULL OR org_id = $1)
 ORDER BY name ASC
@@ -117,6 +125,8 @@ func (q *Queries) ListDesignations(ctx context.Context, arg ListDesignationsPara
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.OrgID,
+			&i.Grade,
+			&i.Level,
 		); err != nil {
 			return nil, err
 		}
@@ -130,9 +140,9 @@ func (q *Queries) ListDesignations(ctx context.Context, arg ListDesignationsPara
 
 const updateDesignation = `-- name: UpdateDesignation :one
 UPDATE designations
-SET name = $2, description = $3, updated_at = $4
+SET name = $2, description = $3, updated_at = $4, grade = $5, level = $6
 WHERE id = $1
-RETURNING id, name, description, created_at, updated_at, org_id
+RETURNING id, name, description, created_at, updated_at, org_id, grade, level
 `
 
 type UpdateDesignationParams struct {
@@ -140,6 +150,8 @@ type UpdateDesignationParams struct {
 	Name        string             `db:"name" json:"name"`
 	Description string             `db:"description" json:"description"`
 	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	Grade       string             `db:"grade" json:"grade"`
+	Level       int32              `db:"level" json:"level"`
 }
 
 func (q *Queries) UpdateDesignation(ctx context.Context, arg UpdateDesignationParams) (*Designation, error) {
@@ -148,6 +160,8 @@ func (q *Queries) UpdateDesignation(ctx context.Context, arg UpdateDesignationPa
 		arg.Name,
 		arg.Description,
 		arg.UpdatedAt,
+		arg.Grade,
+		arg.Level,
 	)
 	var i Designation
 	err := row.Scan(
@@ -157,6 +171,8 @@ func (q *Queries) UpdateDesignation(ctx context.Context, arg UpdateDesignationPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OrgID,
+		&i.Grade,
+		&i.Level,
 	)
 	return &i, err
 }
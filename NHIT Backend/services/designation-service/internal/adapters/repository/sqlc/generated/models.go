@@ -5,6 +5,7 @@
 package sqlc
 
 import (
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -20,4 +21,21 @@ type Designation struct {
 	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 	// Organization ID for organization-specific designations (NULL for global designations)
 	OrgID pgtype.UUID `db:"org_id" json:"org_id"`
+	// Grade label, e.g. M3
+	Grade string `db:"grade" json:"grade"`
+	// Seniority level; higher is more senior
+	Level int32 `db:"level" json:"level"`
+}
+
+// Delegation of financial powers matrix of each designation
+type DesignationFinancialPower struct {
+	ID            uuid.UUID `db:"id" json:"id"`
+	DesignationID uuid.UUID `db:"designation_id" json:"designation_id"`
+	// Expense category the limit applies to; empty for every category
+	ExpenseCategory string `db:"expense_category" json:"expense_category"`
+	// INVOICE, ADVANCE or ADHOC; empty for every approval type
+	ApprovalFor string `db:"approval_for" json:"approval_for"`
+	// Largest amount in rupees the designation may approve
+	MaxAmount money.Amount       `db:"max_amount" json:"max_amount"`
+	CreatedAt pgtype.Timestamptz `db:"created_at" json:"created_at"`
 }
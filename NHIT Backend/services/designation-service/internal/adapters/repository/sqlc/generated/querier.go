@@ -14,9 +14,13 @@ import (
 type Querier interface {
 	CountDesignations(ctx context.Context, dollar_1 pgtype.UUID) (int64, error)
 	CreateDesignation(ctx context.Context, arg CreateDesignationParams) (*Designation, error)
+	CreateDesignationFinancialPower(ctx context.Context, arg CreateDesignationFinancialPowerParams) error
 	DeleteDesignation(ctx context.Context, id uuid.UUID) error
+	DeleteDesignationFinancialPowers(ctx context.Context, designationID uuid.UUID) error
 	GetDesignationByID(ctx context.Context, id uuid.UUID) (*Designation, error)
+	ListDesignationFinancialPowers(ctx context.Context, designationID uuid.UUID) ([]*DesignationFinancialPower, error)
 	ListDesignations(ctx context.Context, arg ListDesignationsParams) ([]*Designation, error)
+	ListOrganizationFinancialPowers(ctx context.Context, orgID pgtype.UUID) ([]*ListOrganizationFinancialPowersRow, error)
 	UpdateDesignation(ctx context.Context, arg UpdateDesignationParams) (*Designation, error)
 }
 
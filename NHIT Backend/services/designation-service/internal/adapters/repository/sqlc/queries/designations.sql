@@ -1,6 +1,6 @@
 -- name: CreateDesignation :one
-INSERT INTO designations (id, name, description, created_at, updated_at, org_id)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO designations (id, name, description, created_at, updated_at, org_id, grade, level)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING *;
 
 -- name: GetDesignationByID :one
@@ -8,7 +8,7 @@ SELECT * FROM designations WHERE id = $1;
 
 -- name: UpdateDesignation :one
 UPDATE designations
-SET name = $2, description = $3, updated_at = $4
+SET name = $2, description = $3, updated_at = $4, grade = $5, level = $6
 WHERE id = $1
 RETURNING *;
 
@@ -0,0 +1,19 @@
+-- name: DeleteDesignationFinancialPowers :exec
+DELETE FROM designation_financial_powers WHERE designation_id = $1;
+
+-- name: CreateDesignationFinancialPower :exec
+INSERT INTO designation_financial_powers (designation_id, expense_category, approval_for, max_amount)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListDesignationFinancialPowers :many
+SELECT * FROM designation_financial_powers
+WHERE designation_id = $1
+ORDER BY expense_category, approval_for;
+
+-- name: ListOrganizationFinancialPowers :many
+SELECT d.id, d.name, d.description, d.created_at, d.updated_at, d.org_id, d.grade, d.level,
+       p.expense_category, p.approval_for, p.max_amount
+FROM designation_financial_powers p
+JOIN designations d ON d.id = p.designation_id
+WHERE d.org_id IS NOT DISTINCT FROM sqlc.arg(org_id)::uuid
+ORDER BY d.level, d.name;
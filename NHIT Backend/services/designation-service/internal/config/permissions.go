@@ -9,6 +9,10 @@ func GetPermissionMap() map[string][]string {
 		"/designations.DesignationService/ListDesignations":  {"view-designation"},
 		"/designations.DesignationService/UpdateDesignation": {"edit-designation"},
 		"/designations.DesignationService/DeleteDesignation": {"delete-designation"},
+
+		// Delegation of financial powers
+		"/designations.DesignationService/SetFinancialPowers":        {"edit-designation"},
+		"/designations.DesignationService/ListApprovingDesignations": {"view-designation"},
 	}
 }
 
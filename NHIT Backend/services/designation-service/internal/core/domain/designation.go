@@ -14,8 +14,14 @@ type Designation struct {
 	OrgID       *uuid.UUID
 	Name        string
 	Description string
+	Grade       string
+	Level       int32 // higher is more senior
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	// FinancialPowers is the designation's delegation of financial powers
+	// matrix; it is only loaded for single designations
+	FinancialPowers []FinancialPower
 }
 
 // NewDesignation creates a new designation with validation
@@ -68,6 +74,21 @@ func (d *Designation) Update(name, description string) error {
 	return nil
 }
 
+// SetGrade sets the grade label and seniority level
+func (d *Designation) SetGrade(grade string, level int32) error {
+	grade = strings.TrimSpace(grade)
+	if len(grade) > 50 {
+		return ErrDesignationGradeTooLong
+	}
+	if level < 0 {
+		return ErrDesignationLevelInvalid
+	}
+	d.Grade = grade
+	d.Level = level
+	d.UpdatedAt = time.Now()
+	return nil
+}
+
 // ValidateDesignationName validates the designation name
 func ValidateDesignationName(name string) error {
 	if name == "" {
@@ -26,4 +26,13 @@ var (
 	ErrMaxHierarchyDepth        = errors.New("maximum hierarchy depth exceeded (max 5 levels)")
 	ErrCannotDeactivateWithUsers = errors.New("cannot deactivate designation with assigned users")
 	ErrSlugAlreadyExists        = errors.New("designation with this slug already exists")
+
+	// Grade and financial power errors
+	ErrDesignationGradeTooLong       = errors.New("designation grade must not exceed 50 characters")
+	ErrDesignationLevelInvalid       = errors.New("designation level must not be negative")
+	ErrInvalidApprovalFor            = errors.New("approval type must be INVOICE, ADVANCE or ADHOC")
+	ErrFinancialPowerAmountInvalid   = errors.New("financial power amount must be a non-negative rupee amount")
+	ErrFinancialPowerCategoryTooLong = errors.New("financial power expense category must not exceed 100 characters")
+	ErrFinancialPowerDuplicate       = errors.New("financial powers list the same expense category and approval type twice")
+	ErrApprovalAmountInvalid         = errors.New("amount to approve must be a positive rupee amount")
 )
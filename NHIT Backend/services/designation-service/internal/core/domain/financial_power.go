@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+)
+
+// ApprovalFor is the type of approval a financial power covers
+type ApprovalFor string
+
+const (
+	ApprovalForAny     ApprovalFor = ""
+	ApprovalForInvoice ApprovalFor = "INVOICE"
+	ApprovalForAdvance ApprovalFor = "ADVANCE"
+	ApprovalForAdhoc   ApprovalFor = "ADHOC"
+)
+
+// FinancialPower is one entry of a delegation of financial powers matrix:
+// the most a designation may approve for an expense category and approval
+// type. An empty category or ApprovalForAny matches every category or type.
+type FinancialPower struct {
+	ExpenseCategory string
+	ApprovalFor     ApprovalFor
+	MaxAmount       money.Amount
+}
+
+// ApprovingDesignation is a designation whose powers cover an amount, with
+// the matrix entry that applies
+type ApprovingDesignation struct {
+	Designation *Designation
+	Power       FinancialPower
+}
+
+// matches reports whether the entry covers the category and approval type
+func (p FinancialPower) matches(category string, approvalFor ApprovalFor) bool {
+	if p.ExpenseCategory != "" && !strings.EqualFold(p.ExpenseCategory, category) {
+		return false
+	}
+	return p.ApprovalFor == ApprovalForAny || p.ApprovalFor == approvalFor
+}
+
+// specificity ranks entries so a category-specific limit beats an approval
+// type one, which beats the catch-all
+func (p FinancialPower) specificity() int {
+	rank := 0
+	if p.ExpenseCategory != "" {
+		rank += 2
+	}
+	if p.ApprovalFor != ApprovalForAny {
+		rank++
+	}
+	return rank
+}
+
+// ApplicablePower returns the most specific entry of powers covering the
+// category and approval type
+func ApplicablePower(powers []FinancialPower, category string, approvalFor ApprovalFor) (FinancialPower, bool) {
+	var best FinancialPower
+	found := false
+	for _, p := range powers {
+		if !p.matches(strings.TrimSpace(category), approvalFor) {
+			continue
+		}
+		if !found || p.specificity() > best.specificity() {
+			best = p
+			found = true
+		}
+	}
+	return best, found
+}
+
+// ParseApprovalFor reads an approval type; empty means any type
+func ParseApprovalFor(s string) (ApprovalFor, error) {
+	switch a := ApprovalFor(strings.ToUpper(strings.TrimSpace(s))); a {
+	case ApprovalForAny, ApprovalForInvoice, ApprovalForAdvance, ApprovalForAdhoc:
+		return a, nil
+	}
+	return "", ErrInvalidApprovalFor
+}
+
+// NormalizeFinancialPowers trims the entries and rejects negative limits and
+// entries that cover the same category and approval type twice
+func NormalizeFinancialPowers(powers []FinancialPower) ([]FinancialPower, error) {
+	out := make([]FinancialPower, 0, len(powers))
+	seen := make(map[string]bool, len(powers))
+	for _, p := range powers {
+		p.ExpenseCategory = strings.TrimSpace(p.ExpenseCategory)
+		if len(p.ExpenseCategory) > 100 {
+			return nil, ErrFinancialPowerCategoryTooLong
+		}
+		if _, err := ParseApprovalFor(string(p.ApprovalFor)); err != nil {
+			return nil, err
+		}
+		if p.MaxAmount.IsNegative() {
+			return nil, ErrFinancialPowerAmountInvalid
+		}
+		key := strings.ToLower(p.ExpenseCategory) + "|" + string(p.ApprovalFor)
+		if seen[key] {
+			return nil, fmt.Errorf("%w: %q %s", ErrFinancialPowerDuplicate, p.ExpenseCategory, p.ApprovalFor)
+		}
+		seen[key] = true
+		out = append(out, p)
+	}
+	return out, nil
+}
@@ -3,23 +3,51 @@ package ports
 import (
 	"context"
 	"github.com/google/uuid"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/domain"
 )
 
 // DesignationRepository defines the interface for designation data persistence
 type DesignationRepository interface {
 	Create(ctx context.Context, designation *domain.Designation) error
+	CheckCreate(ctx context.Context, designation *domain.Designation) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Designation, error)
 	Update(ctx context.Context, designation *domain.Designation) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, orgID *uuid.UUID, page, pageSize int32) ([]*domain.Designation, int64, error)
+
+	// Delegation of financial powers
+	SetFinancialPowers(ctx context.Context, id uuid.UUID, powers []domain.FinancialPower) error
+	ListOrganizationFinancialPowers(ctx context.Context, orgID *uuid.UUID) ([]*domain.Designation, error)
+}
+
+// DesignationInput carries the fields of a new designation
+type DesignationInput struct {
+	Name            string
+	Description     string
+	Grade           string
+	Level           int32
+	FinancialPowers []domain.FinancialPower
+	ValidateOnly    bool // run every check but create nothing
+}
+
+// DesignationUpdate carries the fields of a designation update; nil grade
+// fields are left unchanged
+type DesignationUpdate struct {
+	Name        string
+	Description string
+	Grade       *string
+	Level       *int32
 }
 
 // DesignationService defines the interface for designation business logic
 type DesignationService interface {
-	CreateDesignation(ctx context.Context, name, description string, orgID *uuid.UUID) (*domain.Designation, error)
+	CreateDesignation(ctx context.Context, in DesignationInput, orgID *uuid.UUID) (*domain.Designation, error)
 	GetDesignation(ctx context.Context, id uuid.UUID) (*domain.Designation, error)
-	UpdateDesignation(ctx context.Context, id uuid.UUID, name, description string) (*domain.Designation, error)
+	UpdateDesignation(ctx context.Context, id uuid.UUID, in DesignationUpdate) (*domain.Designation, error)
 	DeleteDesignation(ctx context.Context, id uuid.UUID) error
 	ListDesignations(ctx context.Context, orgID *uuid.UUID, page, pageSize int32) ([]*domain.Designation, int64, error)
+
+	SetFinancialPowers(ctx context.Context, id uuid.UUID, powers []domain.FinancialPower) (*domain.Designation, error)
+	ListApprovingDesignations(ctx context.Context, orgID *uuid.UUID, amount money.Amount, expenseCategory string, approvalFor domain.ApprovalFor) ([]*domain.ApprovingDesignation, error)
 }
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"sort"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/designation-service/internal/core/ports"
 	"github.com/google/uuid"
@@ -16,12 +18,24 @@ func NewDesignationService(repo ports.DesignationRepository) ports.DesignationSe
 	return &designationService{repo: repo}
 }
 
-func (s *designationService) CreateDesignation(ctx context.Context, name, description string, orgID *uuid.UUID) (*domain.Designation, error) {
+func (s *designationService) CreateDesignation(ctx context.Context, in ports.DesignationInput, orgID *uuid.UUID) (*domain.Designation, error) {
 	// domain.NewDesignation sets ID, CreatedAt, UpdatedAt
-	d, err := domain.NewDesignation(name, description, orgID)
+	d, err := domain.NewDesignation(in.Name, in.Description, orgID)
 	if err != nil {
 		return nil, err
 	}
+	if err := d.SetGrade(in.Grade, in.Level); err != nil {
+		return nil, err
+	}
+	if d.FinancialPowers, err = domain.NormalizeFinancialPowers(in.FinancialPowers); err != nil {
+		return nil, err
+	}
+	if in.ValidateOnly {
+		if err := s.repo.CheckCreate(ctx, d); err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
 	if err := s.repo.Create(ctx, d); err != nil {
 		return nil, err
 	}
@@ -32,12 +46,22 @@ func (s *designationService) GetDesignation(ctx context.Context, id uuid.UUID) (
 	return s.repo.GetByID(ctx, id)
 }
 
-func (s *designationService) UpdateDesignation(ctx context.Context, id uuid.UUID, name, description string) (*domain.Designation, error) {
+func (s *designationService) UpdateDesignation(ctx context.Context, id uuid.UUID, in ports.DesignationUpdate) (*domain.Designation, error) {
 	d, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	if err := d.Update(name, description); err != nil {
+	if err := d.Update(in.Name, in.Description); err != nil {
+		return nil, err
+	}
+	grade, level := d.Grade, d.Level
+	if in.Grade != nil {
+		grade = *in.Grade
+	}
+	if in.Level != nil {
+		level = *in.Level
+	}
+	if err := d.SetGrade(grade, level); err != nil {
 		return nil, err
 	}
 	if err := s.repo.Update(ctx, d); err != nil {
@@ -61,3 +85,52 @@ func (s *designationService) ListDesignations(ctx context.Context, orgID *uuid.U
 	}
 	return list, total, nil
 }
+
+func (s *designationService) SetFinancialPowers(ctx context.Context, id uuid.UUID, powers []domain.FinancialPower) (*domain.Designation, error) {
+	powers, err := domain.NormalizeFinancialPowers(powers)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetFinancialPowers(ctx, id, powers); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListApprovingDesignations returns the organization's designations whose
+// delegated powers cover the amount, most junior first. Each designation is
+// judged by its most specific matrix entry for the category and approval type,
+// so a lower category limit overrides a higher catch-all one.
+func (s *designationService) ListApprovingDesignations(ctx context.Context, orgID *uuid.UUID, amount money.Amount, expenseCategory string, approvalFor domain.ApprovalFor) ([]*domain.ApprovingDesignation, error) {
+	if amount.Sign() <= 0 {
+		return nil, domain.ErrApprovalAmountInvalid
+	}
+	if _, err := domain.ParseApprovalFor(string(approvalFor)); err != nil {
+		return nil, err
+	}
+
+	designations, err := s.repo.ListOrganizationFinancialPowers(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := make([]*domain.ApprovingDesignation, 0, len(designations))
+	for _, d := range designations {
+		power, ok := domain.ApplicablePower(d.FinancialPowers, expenseCategory, approvalFor)
+		if !ok || power.MaxAmount.Cmp(amount) < 0 {
+			continue
+		}
+		approvers = append(approvers, &domain.ApprovingDesignation{Designation: d, Power: power})
+	}
+	sort.SliceStable(approvers, func(i, j int) bool {
+		a, b := approvers[i], approvers[j]
+		if a.Designation.Level != b.Designation.Level {
+			return a.Designation.Level < b.Designation.Level
+		}
+		return a.Power.MaxAmount.Cmp(b.Power.MaxAmount) < 0
+	})
+	return approvers, nil
+}
@@ -0,0 +1,6 @@
+-- Designation names only need to be unique within an organization, so every
+-- organization can have its own Manager or Executive designation
+DROP INDEX IF EXISTS idx_designations_name_lower;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_designations_org_name_lower
+    ON designations (COALESCE(org_id, '00000000-0000-0000-0000-000000000000'::uuid), LOWER(name));
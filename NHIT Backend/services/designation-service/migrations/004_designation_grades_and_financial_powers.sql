@@ -0,0 +1,28 @@
+-- Designations carry a grade and a seniority level, and a delegation of
+-- financial powers matrix: the most each designation may approve per expense
+-- category and approval type. The approval workflow picks approvers from it.
+ALTER TABLE designations
+    ADD COLUMN IF NOT EXISTS grade VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS level INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE designations DROP CONSTRAINT IF EXISTS chk_designations_level;
+ALTER TABLE designations ADD CONSTRAINT chk_designations_level CHECK (level >= 0);
+
+CREATE TABLE IF NOT EXISTS designation_financial_powers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    designation_id UUID NOT NULL REFERENCES designations(id) ON DELETE CASCADE,
+    expense_category VARCHAR(100) NOT NULL DEFAULT '',
+    approval_for VARCHAR(20) NOT NULL DEFAULT '' CHECK (approval_for IN ('', 'INVOICE', 'ADVANCE', 'ADHOC')),
+    max_amount NUMERIC(20, 2) NOT NULL CHECK (max_amount >= 0),
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_designation_financial_powers_entry
+    ON designation_financial_powers (designation_id, LOWER(expense_category), approval_for);
+
+COMMENT ON COLUMN designations.grade IS 'Grade label, e.g. M3';
+COMMENT ON COLUMN designations.level IS 'Seniority level; higher is more senior';
+COMMENT ON TABLE designation_financial_powers IS 'Delegation of financial powers matrix of each designation';
+COMMENT ON COLUMN designation_financial_powers.expense_category IS 'Expense category the limit applies to; empty for every category';
+COMMENT ON COLUMN designation_financial_powers.approval_for IS 'INVOICE, ADVANCE or ADHOC; empty for every approval type';
+COMMENT ON COLUMN designation_financial_powers.max_amount IS 'Largest amount in rupees the designation may approve';
@@ -4,20 +4,33 @@ import (
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"time"
 
 	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	departmentpb "github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb"
+	designationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb"
 	organizationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
+	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
 	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/dependencies"
 	grpcHandler "github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/grpc"
 	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/kafka"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/onboarding"
 	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/repository"
 	orgConfig "github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/services"
 	"github.com/ShristiRnr/NHIT_Backend/services/shared/config"
 	"github.com/ShristiRnr/NHIT_Backend/services/shared/database"
 	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/storage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	greennotepb "nhit-note/api/pb/greennotepb"
+	paymentnotepb "nhit-note/api/pb/paymentnotepb"
+	paymentpb "nhit-note/api/pb/paymentpb"
 )
 
 func main() {
@@ -83,8 +96,109 @@ func main() {
 		log.Printf("⚠️ Failed to initialize MinIO client for organizations: %v", err)
 	}
 
+	// Connect to the services holding an organization's records for the dependency check before a purge
+	projectServiceURL := os.Getenv("PROJECT_SERVICE_URL")
+	if projectServiceURL == "" {
+		projectServiceURL = "localhost:50057"
+	}
+	projectConn, err := grpc.Dial(projectServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to project service at %s: %v", projectServiceURL, err)
+	}
+	defer projectConn.Close()
+
+	departmentServiceURL := os.Getenv("DEPARTMENT_SERVICE_URL")
+	if departmentServiceURL == "" {
+		departmentServiceURL = "localhost:50054"
+	}
+	departmentConn, err := grpc.Dial(departmentServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to department service at %s: %v", departmentServiceURL, err)
+	}
+	defer departmentConn.Close()
+
+	greennoteServiceURL := os.Getenv("GREENNOTE_SERVICE_URL")
+	if greennoteServiceURL == "" {
+		greennoteServiceURL = "localhost:50059"
+	}
+	greennoteConn, err := grpc.Dial(greennoteServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to green note service at %s: %v", greennoteServiceURL, err)
+	}
+	defer greennoteConn.Close()
+
+	paymentnoteServiceURL := os.Getenv("PAYMENTNOTE_SERVICE_URL")
+	if paymentnoteServiceURL == "" {
+		paymentnoteServiceURL = "localhost:50053"
+	}
+	paymentnoteConn, err := grpc.Dial(paymentnoteServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to payment note service at %s: %v", paymentnoteServiceURL, err)
+	}
+	defer paymentnoteConn.Close()
+
+	paymentServiceURL := os.Getenv("PAYMENT_SERVICE_URL")
+	if paymentServiceURL == "" {
+		paymentServiceURL = "localhost:50054"
+	}
+	paymentConn, err := grpc.Dial(paymentServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to payment service at %s: %v", paymentServiceURL, err)
+	}
+	defer paymentConn.Close()
+
+	dependencyChecker := dependencies.NewGRPCDependencyChecker(
+		projectpb.NewProjectServiceClient(projectConn),
+		departmentpb.NewDepartmentServiceClient(departmentConn),
+		greennotepb.NewGreenNoteServiceClient(greennoteConn),
+		paymentnotepb.NewPaymentNoteServiceClient(paymentnoteConn),
+		paymentpb.NewPaymentServiceClient(paymentConn),
+	)
+	log.Println("✅ Dependency checker initialized", projectServiceURL, departmentServiceURL,
+		greennoteServiceURL, paymentnoteServiceURL, paymentServiceURL)
+
+	// Deleted organizations can be restored for ORG_RETENTION_DAYS before they can be purged
+	retention := domain.DefaultRetention
+	if days, err := strconv.Atoi(os.Getenv("ORG_RETENTION_DAYS")); err == nil && days >= 0 {
+		retention = time.Duration(days) * 24 * time.Hour
+	}
+
+	// Connect to User and Designation services for onboarding; project and
+	// department connections are shared with the dependency checker
+	userConn, err := grpc.Dial(cfg.UserServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to user service at %s: %v", cfg.UserServiceURL, err)
+	}
+	defer userConn.Close()
+
+	designationServiceURL := os.Getenv("DESIGNATION_SERVICE_URL")
+	if designationServiceURL == "" {
+		designationServiceURL = "localhost:50055"
+	}
+	designationConn, err := grpc.Dial(designationServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to designation service at %s: %v", designationServiceURL, err)
+	}
+	defer designationConn.Close()
+
+	onboardingGateway := onboarding.NewGRPCGateway(
+		userpb.NewUserManagementClient(userConn),
+		authClient,
+		departmentpb.NewDepartmentServiceClient(departmentConn),
+		designationpb.NewDesignationServiceClient(designationConn),
+		projectpb.NewProjectServiceClient(projectConn),
+	)
+
+	// One onboarding run, forward or rollback, may take up to ONBOARDING_TIMEOUT_SECONDS
+	onboardingTimeout := services.DefaultOnboardingTimeout
+	if secs, err := strconv.Atoi(os.Getenv("ONBOARDING_TIMEOUT_SECONDS")); err == nil && secs > 0 {
+		onboardingTimeout = time.Duration(secs) * time.Second
+	}
+	onboardingService := services.NewOnboardingService(orgRepo, onboardingGateway, onboardingTimeout)
+	log.Println("✅ Onboarding service initialized", cfg.UserServiceURL, designationServiceURL)
+
 	// Initialize gRPC handlers (Adapters Layer) and pass DB pool, auth client, kafka publisher and minio client
-	orgHandler := grpcHandler.NewOrganizationHandler(orgRepo, conn, authClient, kafkaPublisher, minioClient)
+	orgHandler := grpcHandler.NewOrganizationHandler(orgRepo, conn, authClient, kafkaPublisher, minioClient, dependencyChecker, retention, onboardingService)
 	log.Println("✅ gRPC handlers initialized")
 
 	// Initialize RBAC interceptor
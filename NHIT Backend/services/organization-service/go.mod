@@ -4,8 +4,11 @@ go 1.24.2
 
 require (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/api/pb/userpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/services/shared v0.0.0
 	github.com/google/uuid v1.6.0
@@ -14,6 +17,9 @@ require (
 	golang.org/x/crypto v0.43.0
 	google.golang.org/grpc v1.77.0
 	google.golang.org/protobuf v1.36.10
+	nhit-note v0.0.0
+	nhit-note/api/pb/paymentnotepb v0.0.0-00010101000000-000000000000
+	nhit-note/api/pb/paymentpb v0.0.0-00010101000000-000000000000
 )
 
 require (
@@ -40,12 +46,21 @@ require (
 	google.golang.org/genproto/googleapis/api v0.0.0-20251124214823-79d6a2a48846 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
+	nhit-note/api/pb/common v0.0.0 // indirect
 )
 
 replace (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb => ../../api/pb/authpb
+	github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb => ../../api/pb/departmentpb
+	github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb => ../../api/pb/designationpb
 	github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb => ../../api/pb/organizationpb
 	github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb => ../../api/pb/projectpb
+	github.com/ShristiRnr/NHIT_Backend/api/pb/userpb => ../../api/pb/userpb
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware => ../../pkg/middleware
 	github.com/ShristiRnr/NHIT_Backend/services/shared => ../shared
+	nhit-note => ../../../Nhit-Note
+	nhit-note/api/pb/common => ../../../Nhit-Note/api/pb/common
+	nhit-note/api/pb/greennotepb => ../../../Nhit-Note/api/pb/greennotepb
+	nhit-note/api/pb/paymentnotepb => ../../../Nhit-Note/api/pb/paymentnotepb
+	nhit-note/api/pb/paymentpb => ../../../Nhit-Note/api/pb/paymentpb
 )
@@ -0,0 +1,100 @@
+package dependencies
+
+import (
+	"context"
+	"fmt"
+
+	departmentpb "github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb"
+	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
+	"google.golang.org/grpc/metadata"
+	greennotepb "nhit-note/api/pb/greennotepb"
+	paymentnotepb "nhit-note/api/pb/paymentnotepb"
+	paymentpb "nhit-note/api/pb/paymentpb"
+)
+
+// GRPCDependencyChecker asks the services holding an organization's records
+// how many they have. Green notes, payment notes and payments are counted
+// themselves rather than through projects: they name their project, so they
+// outlive it.
+type GRPCDependencyChecker struct {
+	projects     projectpb.ProjectServiceClient
+	departments  departmentpb.DepartmentServiceClient
+	greenNotes   greennotepb.GreenNoteServiceClient
+	paymentNotes paymentnotepb.PaymentNoteServiceClient
+	payments     paymentpb.PaymentServiceClient
+}
+
+// NewGRPCDependencyChecker creates a dependency checker over the given clients
+func NewGRPCDependencyChecker(
+	projects projectpb.ProjectServiceClient,
+	departments departmentpb.DepartmentServiceClient,
+	greenNotes greennotepb.GreenNoteServiceClient,
+	paymentNotes paymentnotepb.PaymentNoteServiceClient,
+	payments paymentpb.PaymentServiceClient,
+) ports.DependencyChecker {
+	return &GRPCDependencyChecker{
+		projects:     projects,
+		departments:  departments,
+		greenNotes:   greenNotes,
+		paymentNotes: paymentNotes,
+		payments:     payments,
+	}
+}
+
+func (c *GRPCDependencyChecker) CountDependencies(ctx context.Context, orgID string) ([]domain.OrganizationDependency, error) {
+	ctx = outgoingContext(ctx, orgID)
+
+	projects, err := c.projects.ListProjectsByOrganization(ctx, &projectpb.ListProjectsByOrganizationRequest{
+		OrgId:           orgID,
+		Page:            1,
+		PageSize:        1,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("project-service: %w", err)
+	}
+
+	departments, err := c.departments.ListDepartments(ctx, &departmentpb.ListDepartmentsRequest{
+		Page:     1,
+		PageSize: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("department-service: %w", err)
+	}
+
+	greenNotes, err := c.greenNotes.CountOrganizationGreenNotes(ctx, &greennotepb.CountOrganizationGreenNotesRequest{OrgId: orgID})
+	if err != nil {
+		return nil, fmt.Errorf("greennote-service: %w", err)
+	}
+
+	paymentNotes, err := c.paymentNotes.CountOrganizationPaymentNotes(ctx, &paymentnotepb.CountOrganizationPaymentNotesRequest{OrgId: orgID})
+	if err != nil {
+		return nil, fmt.Errorf("paymentnote-service: %w", err)
+	}
+
+	payments, err := c.payments.CountOrganizationPayments(ctx, &paymentpb.CountOrganizationPaymentsRequest{OrgId: orgID})
+	if err != nil {
+		return nil, fmt.Errorf("payment-service: %w", err)
+	}
+
+	return []domain.OrganizationDependency{
+		{Service: "project-service", Resource: "projects", Count: int64(projects.GetTotalCount()), Blocking: true},
+		{Service: "department-service", Resource: "departments", Count: int64(departments.GetTotalCount()), Blocking: true},
+		{Service: "greennote-service", Resource: "green_notes", Count: greenNotes.GetCount(), Blocking: true},
+		{Service: "paymentnote-service", Resource: "payment_notes", Count: paymentNotes.GetCount(), Blocking: true},
+		{Service: "payment-service", Resource: "payments", Count: payments.GetCount(), Blocking: true},
+	}, nil
+}
+
+// outgoingContext forwards the caller's metadata and scopes the call to orgID
+// for services that read the organization from metadata
+func outgoingContext(ctx context.Context, orgID string) context.Context {
+	md := metadata.MD{}
+	if in, ok := metadata.FromIncomingContext(ctx); ok {
+		md = in.Copy()
+	}
+	md.Set("x-org-id", orgID)
+	return metadata.NewOutgoingContext(ctx, md)
+}
@@ -0,0 +1,281 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// GetOrganizationTree
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) GetOrganizationTree(ctx context.Context, req *pb.GetOrganizationTreeRequest) (*pb.GetOrganizationTreeResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	if req.MaxDepth < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_depth cannot be negative")
+	}
+
+	orgs, err := h.repo.ListOrganizationSubtree(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load organization tree: %v", err)
+	}
+	if len(orgs) == 0 {
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+
+	// Rows come ordered by depth, so every parent is placed before its children
+	root := &pb.OrganizationTreeNode{Organization: mapModelToProto(orgs[0])}
+	nodes := map[string]*pb.OrganizationTreeNode{orgs[0].OrgID: root}
+	for _, o := range orgs[1:] {
+		if _, seen := nodes[o.OrgID]; seen || o.ParentOrgID == nil {
+			continue
+		}
+		parent, ok := nodes[*o.ParentOrgID]
+		if !ok || (req.MaxDepth > 0 && parent.Depth >= req.MaxDepth) {
+			continue
+		}
+		node := &pb.OrganizationTreeNode{Organization: mapModelToProto(o), Depth: parent.Depth + 1}
+		parent.Children = append(parent.Children, node)
+		nodes[o.OrgID] = node
+	}
+
+	return &pb.GetOrganizationTreeResponse{
+		Root:       root,
+		TotalCount: int32(len(nodes)),
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetOrganizationAncestors
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) GetOrganizationAncestors(ctx context.Context, req *pb.GetOrganizationAncestorsRequest) (*pb.GetOrganizationAncestorsResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	if _, err := h.repo.GetOrganizationByID(ctx, req.OrgId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+
+	ancestors, err := h.repo.ListOrganizationAncestors(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load ancestors: %v", err)
+	}
+
+	pbOrgs := make([]*pb.Organization, 0, len(ancestors))
+	for _, o := range ancestors {
+		pbOrgs = append(pbOrgs, mapModelToProto(o))
+	}
+	return &pb.GetOrganizationAncestorsResponse{Ancestors: pbOrgs}, nil
+}
+
+// ----------------------------------------------------------------------------
+// MoveOrganization
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) MoveOrganization(ctx context.Context, req *pb.MoveOrganizationRequest) (*pb.OrganizationResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(req.NewParentOrgId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "new_parent_org_id must be a valid UUID")
+	}
+
+	if parent, err := h.repo.GetOrganizationByID(ctx, req.NewParentOrgId); err == nil && parent.DeletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "cannot move an organization under a deleted organization")
+	}
+
+	moved, err := h.repo.MoveOrganization(ctx, req.OrgId, req.NewParentOrgId)
+	if err != nil {
+		return nil, hierarchyError(err, "failed to move organization")
+	}
+	h.logger.Printf("🌳 Organization %s moved under %s", req.OrgId, req.NewParentOrgId)
+
+	return &pb.OrganizationResponse{
+		Organization: mapModelToProto(moved),
+		Message:      "organization moved",
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetOrganizationSettings
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) GetOrganizationSettings(ctx context.Context, req *pb.GetOrganizationSettingsRequest) (*pb.OrganizationSettingsResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	settings, err := h.repo.GetOrganizationSettings(ctx, req.OrgId)
+	if err != nil {
+		return nil, hierarchyError(err, "failed to load organization settings")
+	}
+	return &pb.OrganizationSettingsResponse{
+		OrgId:    req.OrgId,
+		Settings: settingsToProto(settings),
+		Message:  "ok",
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// UpdateOrganizationSettings
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) UpdateOrganizationSettings(ctx context.Context, req *pb.UpdateOrganizationSettingsRequest) (*pb.OrganizationSettingsResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	inherit := make([]domain.SettingSection, 0, len(req.Inherit))
+	for _, s := range req.Inherit {
+		section, ok := settingSectionFromProto(s)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid settings section %v", s)
+		}
+		inherit = append(inherit, section)
+	}
+
+	settings, err := h.repo.GetOrganizationSettings(ctx, req.OrgId)
+	if err != nil {
+		return nil, hierarchyError(err, "failed to load organization settings")
+	}
+	if err := settings.Merge(settingsFromProto(req.Settings), inherit); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updatedBy, _ := middleware.GetUserIDFromContext(ctx)
+	if err := h.repo.SaveOrganizationSettings(ctx, settings, updatedBy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save organization settings: %v", err)
+	}
+	h.logger.Printf("⚙️ Organization %s settings updated by %s", req.OrgId, updatedBy)
+
+	return &pb.OrganizationSettingsResponse{
+		OrgId:    req.OrgId,
+		Settings: settingsToProto(settings),
+		Message:  "organization settings updated",
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetEffectiveOrganizationSettings
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) GetEffectiveOrganizationSettings(ctx context.Context, req *pb.GetOrganizationSettingsRequest) (*pb.EffectiveOrganizationSettingsResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	path, err := h.repo.ListOrganizationSettingsPath(ctx, req.OrgId)
+	if err != nil {
+		return nil, hierarchyError(err, "failed to load organization settings")
+	}
+
+	eff := domain.ResolveSettings(path)
+	sources := make([]*pb.OrganizationSettingSource, 0, len(domain.SettingSections))
+	for _, section := range domain.SettingSections {
+		sources = append(sources, &pb.OrganizationSettingSource{
+			Section:     settingSectionToProto(section),
+			SourceOrgId: eff.Sources[section],
+		})
+	}
+	return &pb.EffectiveOrganizationSettingsResponse{
+		OrgId:    req.OrgId,
+		Settings: settingsToProto(eff.OrganizationSettings),
+		Sources:  sources,
+	}, nil
+}
+
+// ------------------ helpers ------------------
+
+func validateOrgID(orgID string) error {
+	if orgID == "" {
+		return status.Error(codes.InvalidArgument, "org_id required")
+	}
+	if _, err := uuid.Parse(orgID); err != nil {
+		return status.Error(codes.InvalidArgument, "org_id must be a valid UUID")
+	}
+	return nil
+}
+
+// hierarchyError maps repository and domain errors to gRPC status errors
+func hierarchyError(err error, msg string) error {
+	switch {
+	case errors.Is(err, ports.ErrNotFound):
+		return status.Error(codes.NotFound, "organization not found")
+	case errors.Is(err, domain.ErrOrganizationCycle), errors.Is(err, domain.ErrCrossTenantMove),
+		errors.Is(err, domain.ErrOrganizationDeleted), errors.Is(err, domain.ErrOrganizationNotDeleted),
+		errors.Is(err, domain.ErrParentInactive), errors.Is(err, domain.ErrHasLiveChildren),
+		errors.Is(err, domain.ErrRetentionNotElapsed), errors.Is(err, domain.ErrHasDependencies):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+func settingsToProto(s domain.OrganizationSettings) *pb.OrganizationSettings {
+	out := &pb.OrganizationSettings{Logo: s.Logo}
+	if r := s.ApprovalRules; r != nil {
+		out.ApprovalRules = &pb.ApprovalRules{
+			MinApprovers:      r.MinApprovers,
+			RequireSequential: r.RequireSequential,
+			AllowSelfApproval: r.AllowSelfApproval,
+			AutoApproveBelow:  r.AutoApproveBelow,
+		}
+	}
+	if t := s.TDSDefaults; t != nil {
+		out.TdsDefaults = &pb.TdsDefaults{
+			DefaultSection: t.DefaultSection,
+			AutoDeduct:     t.AutoDeduct,
+			RateOverride:   t.RateOverride,
+		}
+	}
+	return out
+}
+
+func settingsFromProto(s *pb.OrganizationSettings) domain.OrganizationSettings {
+	var out domain.OrganizationSettings
+	if s == nil {
+		return out
+	}
+	out.Logo = s.Logo
+	if r := s.ApprovalRules; r != nil {
+		out.ApprovalRules = &domain.ApprovalRules{
+			MinApprovers:      r.MinApprovers,
+			RequireSequential: r.RequireSequential,
+			AllowSelfApproval: r.AllowSelfApproval,
+			AutoApproveBelow:  r.AutoApproveBelow,
+		}
+	}
+	if t := s.TdsDefaults; t != nil {
+		out.TDSDefaults = &domain.TDSDefaults{
+			DefaultSection: t.DefaultSection,
+			AutoDeduct:     t.AutoDeduct,
+			RateOverride:   t.RateOverride,
+		}
+	}
+	return out
+}
+
+func settingSectionFromProto(s pb.OrganizationSettingSection) (domain.SettingSection, bool) {
+	switch s {
+	case pb.OrganizationSettingSection_SETTING_SECTION_APPROVAL_RULES:
+		return domain.SettingApprovalRules, true
+	case pb.OrganizationSettingSection_SETTING_SECTION_TDS_DEFAULTS:
+		return domain.SettingTDSDefaults, true
+	case pb.OrganizationSettingSection_SETTING_SECTION_LOGO:
+		return domain.SettingLogo, true
+	}
+	return "", false
+}
+
+func settingSectionToProto(s domain.SettingSection) pb.OrganizationSettingSection {
+	switch s {
+	case domain.SettingApprovalRules:
+		return pb.OrganizationSettingSection_SETTING_SECTION_APPROVAL_RULES
+	case domain.SettingTDSDefaults:
+		return pb.OrganizationSettingSection_SETTING_SECTION_TDS_DEFAULTS
+	case domain.SettingLogo:
+		return pb.OrganizationSettingSection_SETTING_SECTION_LOGO
+	}
+	return pb.OrganizationSettingSection_SETTING_SECTION_UNSPECIFIED
+}
@@ -0,0 +1,321 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// organizationEventsTopic carries organization lifecycle events. auth-service
+// consumes the status changes to block logins and tokens of organizations
+// that are not active, which stops new transactions in every service.
+const organizationEventsTopic = "organization.events"
+
+// ----------------------------------------------------------------------------
+// DeactivateOrganization
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) DeactivateOrganization(ctx context.Context, req *pb.ChangeOrganizationStatusRequest) (*pb.ChangeOrganizationStatusResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	org, err := h.repo.GetOrganizationByID(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+
+	ids, err := h.deactivate(ctx, org, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return h.statusChangeResponse(ctx, req.OrgId, ids, "organization deactivated", "organization already deactivated")
+}
+
+// ----------------------------------------------------------------------------
+// ActivateOrganization
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) ActivateOrganization(ctx context.Context, req *pb.ChangeOrganizationStatusRequest) (*pb.ChangeOrganizationStatusResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	org, err := h.repo.GetOrganizationByID(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+
+	ids, err := h.activate(ctx, org, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return h.statusChangeResponse(ctx, req.OrgId, ids, "organization activated", "organization already activated")
+}
+
+// ----------------------------------------------------------------------------
+// RestoreOrganization
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) RestoreOrganization(ctx context.Context, req *pb.ChangeOrganizationStatusRequest) (*pb.ChangeOrganizationStatusResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	org, err := h.repo.GetOrganizationByID(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+	if org.DeletedAt == nil {
+		return nil, hierarchyError(domain.ErrOrganizationNotDeleted, "")
+	}
+
+	// Under a deleted parent the organization would be unreachable; under a
+	// deactivated one it comes back deactivated
+	reactivate := true
+	if org.ParentOrgID != nil {
+		parent, err := h.repo.GetOrganizationByID(ctx, *org.ParentOrgID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load parent organization: %v", err)
+		}
+		if parent.DeletedAt != nil {
+			return nil, status.Error(codes.FailedPrecondition, "parent organization is deleted; restore it first")
+		}
+		reactivate = parent.Status == pb.OrganizationStatus_activated
+	}
+
+	restored, err := h.repo.RestoreOrganization(ctx, req.OrgId, req.Reason, reactivate)
+	if err != nil {
+		return nil, hierarchyError(err, "failed to restore organization")
+	}
+
+	eventStatus := domain.OrganizationStatusDeactivated
+	if restored.Status == pb.OrganizationStatus_activated {
+		eventStatus = domain.OrganizationStatusActive
+	}
+	h.publishStatusChange(ctx, domain.EventOrganizationRestored, eventStatus, restored, []string{restored.OrgID}, req.Reason)
+	h.logger.Printf("♻️ Organization %s restored (%s)", req.OrgId, eventStatus)
+
+	return &pb.ChangeOrganizationStatusResponse{
+		Organization:   mapModelToProto(restored),
+		AffectedOrgIds: []string{restored.OrgID},
+		Message:        "organization restored",
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetOrganizationDependencies
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) GetOrganizationDependencies(ctx context.Context, req *pb.GetOrganizationDependenciesRequest) (*pb.GetOrganizationDependenciesResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	if _, err := h.repo.GetOrganizationByID(ctx, req.OrgId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+
+	deps, err := h.dependencies(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetOrganizationDependenciesResponse{
+		OrgId:        req.OrgId,
+		Dependencies: dependenciesToProto(deps),
+		CanPurge:     domain.CanPurge(deps),
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// PurgeOrganization
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) PurgeOrganization(ctx context.Context, req *pb.PurgeOrganizationRequest) (*pb.PurgeOrganizationResponse, error) {
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	org, err := h.repo.GetOrganizationByID(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+	if org.DeletedAt == nil {
+		return nil, hierarchyError(domain.ErrOrganizationNotDeleted, "")
+	}
+	if org.PurgeAfter != nil && time.Now().UTC().Before(*org.PurgeAfter) {
+		return nil, hierarchyError(fmt.Errorf("%w: it can be purged after %s", domain.ErrRetentionNotElapsed, org.PurgeAfter.Format(time.RFC3339)), "")
+	}
+
+	deps, err := h.dependencies(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.CanPurge(deps) {
+		return nil, hierarchyError(fmt.Errorf("%w: %s", domain.ErrHasDependencies, describeBlocking(deps)), "")
+	}
+
+	if err := h.repo.PurgeOrganization(ctx, req.OrgId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge organization: %v", err)
+	}
+	h.publishStatusChange(ctx, domain.EventOrganizationPurged, domain.OrganizationStatusPurged, org, []string{org.OrgID}, "")
+	h.logger.Printf("🗑️ Organization %s purged", req.OrgId)
+
+	return &pb.PurgeOrganizationResponse{
+		Success:      true,
+		Message:      "organization purged",
+		Dependencies: dependenciesToProto(deps),
+	}, nil
+}
+
+// ------------------ helpers ------------------
+
+// deactivate deactivates org and its active descendants. Callers cannot
+// deactivate the organization they are signed in to, which would end their
+// own session.
+func (h *OrganizationHandler) deactivate(ctx context.Context, org ports.OrganizationModel, reason string) ([]string, error) {
+	if err := h.checkDeactivate(ctx, org); err != nil {
+		return nil, err
+	}
+
+	ids, err := h.repo.DeactivateOrganization(ctx, org.OrgID, reason)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to deactivate organization: %v", err)
+	}
+	h.statusChanged(ctx, org, pb.OrganizationStatus_deactivated, ids, reason)
+	return ids, nil
+}
+
+func (h *OrganizationHandler) checkDeactivate(ctx context.Context, org ports.OrganizationModel) error {
+	if org.DeletedAt != nil {
+		return hierarchyError(domain.ErrOrganizationDeleted, "")
+	}
+	if callerOrg, ok := middleware.GetOrgIDFromContext(ctx); ok {
+		subtree, err := h.repo.ListOrganizationSubtree(ctx, org.OrgID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load organization tree: %v", err)
+		}
+		for _, o := range subtree {
+			if o.OrgID == callerOrg && o.Status == pb.OrganizationStatus_activated {
+				return status.Error(codes.FailedPrecondition, "cannot deactivate the organization you are signed in to; switch to another organization first")
+			}
+		}
+	}
+	return nil
+}
+
+// activate activates org and the descendants its deactivation deactivated.
+// An organization cannot be active under an inactive parent.
+func (h *OrganizationHandler) activate(ctx context.Context, org ports.OrganizationModel, reason string) ([]string, error) {
+	if err := h.checkActivate(ctx, org); err != nil {
+		return nil, err
+	}
+
+	ids, err := h.repo.ActivateOrganization(ctx, org.OrgID, reason)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to activate organization: %v", err)
+	}
+	h.statusChanged(ctx, org, pb.OrganizationStatus_activated, ids, reason)
+	return ids, nil
+}
+
+func (h *OrganizationHandler) checkActivate(ctx context.Context, org ports.OrganizationModel) error {
+	if org.DeletedAt != nil {
+		return hierarchyError(domain.ErrOrganizationDeleted, "")
+	}
+	if org.ParentOrgID != nil {
+		parent, err := h.repo.GetOrganizationByID(ctx, *org.ParentOrgID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load parent organization: %v", err)
+		}
+		if parent.DeletedAt != nil || parent.Status != pb.OrganizationStatus_activated {
+			return hierarchyError(domain.ErrParentInactive, "")
+		}
+	}
+	return nil
+}
+
+// statusChanged publishes and logs moving org to newStatus, which changed the
+// organizations in ids
+func (h *OrganizationHandler) statusChanged(ctx context.Context, org ports.OrganizationModel, newStatus pb.OrganizationStatus, ids []string, reason string) {
+	if len(ids) == 0 {
+		return
+	}
+	if newStatus == pb.OrganizationStatus_deactivated {
+		h.publishStatusChange(ctx, domain.EventOrganizationDeactivated, domain.OrganizationStatusDeactivated, org, ids, reason)
+		h.logger.Printf("⏸️ Organization %s deactivated with %d organization(s)", org.OrgID, len(ids))
+		return
+	}
+	h.publishStatusChange(ctx, domain.EventOrganizationActivated, domain.OrganizationStatusActive, org, ids, reason)
+	h.logger.Printf("▶️ Organization %s activated with %d organization(s)", org.OrgID, len(ids))
+}
+
+func (h *OrganizationHandler) statusChangeResponse(ctx context.Context, orgID string, ids []string, changed, unchanged string) (*pb.ChangeOrganizationStatusResponse, error) {
+	org, err := h.repo.GetOrganizationByID(ctx, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reload organization: %v", err)
+	}
+	message := changed
+	if len(ids) == 0 {
+		message = unchanged
+	}
+	return &pb.ChangeOrganizationStatusResponse{
+		Organization:   mapModelToProto(org),
+		AffectedOrgIds: ids,
+		Message:        message,
+	}, nil
+}
+
+// dependencies counts what still references the organization here and in
+// other services. An unreachable service fails the check rather than being
+// counted as empty.
+func (h *OrganizationHandler) dependencies(ctx context.Context, orgID string) ([]domain.OrganizationDependency, error) {
+	local, err := h.repo.CountOrganizationDependents(ctx, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count organization dependents: %v", err)
+	}
+	deps := []domain.OrganizationDependency{
+		{Service: "organization-service", Resource: "child_organizations", Count: local.Children, Blocking: true},
+		{Service: "organization-service", Resource: "members", Count: local.Members, Blocking: false},
+	}
+	if h.dependencyChecker == nil {
+		return nil, status.Error(codes.Unavailable, "dependency checks across services are not configured")
+	}
+	remote, err := h.dependencyChecker.CountDependencies(ctx, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to check dependencies: %v", err)
+	}
+	return append(deps, remote...), nil
+}
+
+func (h *OrganizationHandler) publishStatusChange(ctx context.Context, eventType, eventStatus string, org ports.OrganizationModel, ids []string, reason string) {
+	if h.kafka == nil {
+		return
+	}
+	changedBy, _ := middleware.GetUserIDFromContext(ctx)
+	event := domain.NewOrganizationStatusChangedEvent(eventType, eventStatus, org.TenantID, org.OrgID, ids, reason, changedBy)
+	if err := h.kafka.Publish(ctx, organizationEventsTopic, event); err != nil {
+		h.logger.Printf("⚠️ Failed to publish %s for organization %s: %v", eventType, org.OrgID, err)
+	}
+}
+
+func dependenciesToProto(deps []domain.OrganizationDependency) []*pb.OrganizationDependency {
+	out := make([]*pb.OrganizationDependency, 0, len(deps))
+	for _, d := range deps {
+		out = append(out, &pb.OrganizationDependency{
+			Service:  d.Service,
+			Resource: d.Resource,
+			Count:    d.Count,
+			Blocking: d.Blocking,
+		})
+	}
+	return out
+}
+
+func describeBlocking(deps []domain.OrganizationDependency) string {
+	var parts []string
+	for _, d := range deps {
+		if d.Blocking && d.Count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s (%s)", d.Count, d.Resource, d.Service))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
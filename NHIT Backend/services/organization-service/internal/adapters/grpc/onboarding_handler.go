@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// StartOnboarding
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) StartOnboarding(ctx context.Context, req *pb.StartOnboardingRequest) (*pb.OnboardingResponse, error) {
+	if req.GetSuperAdmin() == nil {
+		return nil, status.Error(codes.InvalidArgument, domain.ErrInvalidOnboarding.Error())
+	}
+	in := domain.OnboardingRequest{
+		TenantName:      req.TenantName,
+		SuperAdminName:  req.SuperAdmin.Name,
+		SuperAdminEmail: req.SuperAdmin.Email,
+		OrgName:         req.OrgName,
+		OrgCode:         req.OrgCode,
+		OrgDescription:  req.OrgDescription,
+		OrgLogo:         req.OrgLogo,
+		Departments:     req.Departments,
+		Designations:    req.Designations,
+		Projects:        req.Projects,
+	}
+	if r := req.ApprovalRules; r != nil {
+		in.ApprovalRules = &domain.ApprovalRules{
+			MinApprovers:      r.MinApprovers,
+			RequireSequential: r.RequireSequential,
+			AllowSelfApproval: r.AllowSelfApproval,
+			AutoApproveBelow:  r.AutoApproveBelow,
+		}
+	}
+
+	saga, err := h.onboarding.StartOnboarding(ctx, in, req.SuperAdmin.Password)
+	return onboardingResponse(saga, err, "failed to run onboarding")
+}
+
+// ----------------------------------------------------------------------------
+// GetOnboardingStatus
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) GetOnboardingStatus(ctx context.Context, req *pb.GetOnboardingStatusRequest) (*pb.OnboardingResponse, error) {
+	if req.GetSagaId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "saga_id is required")
+	}
+	saga, err := h.onboarding.GetOnboarding(ctx, req.SagaId)
+	return onboardingResponse(saga, err, "failed to get onboarding")
+}
+
+// ----------------------------------------------------------------------------
+// ResumeOnboarding
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) ResumeOnboarding(ctx context.Context, req *pb.OnboardingActionRequest) (*pb.OnboardingResponse, error) {
+	if req.GetSagaId() == "" || req.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "saga_id and password are required")
+	}
+	saga, err := h.onboarding.ResumeOnboarding(ctx, req.SagaId, req.Password)
+	return onboardingResponse(saga, err, "failed to resume onboarding")
+}
+
+// ----------------------------------------------------------------------------
+// CancelOnboarding
+// ----------------------------------------------------------------------------
+func (h *OrganizationHandler) CancelOnboarding(ctx context.Context, req *pb.OnboardingActionRequest) (*pb.OnboardingResponse, error) {
+	if req.GetSagaId() == "" || req.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "saga_id and password are required")
+	}
+	saga, err := h.onboarding.CancelOnboarding(ctx, req.SagaId, req.Password)
+	return onboardingResponse(saga, err, "failed to cancel onboarding")
+}
+
+// onboardingResponse reports the onboarding as far as it got. A run that
+// could not record its progress is an error even though the onboarding exists.
+func onboardingResponse(saga *domain.OnboardingSaga, err error, msg string) (*pb.OnboardingResponse, error) {
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOnboardingNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrInvalidOnboarding), errors.Is(err, domain.ErrInvalidApprovalRules):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrOnboardingInProgress), errors.Is(err, domain.ErrOnboardingCodeTaken),
+			errors.Is(err, domain.ErrOnboardingTenantExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, domain.ErrOnboardingNotResumable):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrOnboardingBusy):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case errors.Is(err, domain.ErrOnboardingPassword):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if saga != nil {
+			return nil, status.Errorf(codes.Internal, "%s; onboarding %s can be resumed: %v", msg, saga.SagaID, err)
+		}
+		return nil, status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+
+	var message string
+	switch saga.Status {
+	case domain.OnboardingCompleted:
+		message = "onboarding completed"
+	case domain.OnboardingPaused:
+		message = "onboarding paused on a temporary failure; resume it to continue or cancel it to roll back"
+	case domain.OnboardingCompensated:
+		message = "onboarding rolled back"
+	case domain.OnboardingCompensationFailed:
+		message = "onboarding rollback failed; resume it to retry the rollback"
+	default:
+		message = "onboarding in progress"
+	}
+	return &pb.OnboardingResponse{Onboarding: onboardingToProto(saga), Message: message}, nil
+}
+
+func onboardingToProto(saga *domain.OnboardingSaga) *pb.Onboarding {
+	out := &pb.Onboarding{
+		SagaId:           saga.SagaID,
+		Status:           pb.OnboardingStatus(pb.OnboardingStatus_value["ONBOARDING_STATUS_"+string(saga.Status)]),
+		CurrentStep:      onboardingStepToProto(saga.CurrentStep),
+		Error:            saga.Error,
+		TenantId:         saga.TenantID,
+		OrgId:            saga.OrgID,
+		SuperAdminUserId: saga.SuperAdminUserID,
+		CreatedAt:        toProtoTs(saga.CreatedAt),
+		UpdatedAt:        toProtoTs(saga.UpdatedAt),
+		CompletedAt:      optionalProtoTs(saga.CompletedAt),
+	}
+	for _, step := range saga.Steps {
+		out.Steps = append(out.Steps, &pb.OnboardingStep{
+			Step:       onboardingStepToProto(step.Step),
+			Status:     pb.OnboardingStepStatus(pb.OnboardingStepStatus_value["ONBOARDING_STEP_STATUS_"+string(step.Status)]),
+			Attempts:   step.Attempts,
+			Error:      step.Error,
+			StartedAt:  optionalProtoTs(step.StartedAt),
+			FinishedAt: optionalProtoTs(step.FinishedAt),
+		})
+	}
+	return out
+}
+
+func onboardingStepToProto(step domain.OnboardingStep) pb.OnboardingStepName {
+	return pb.OnboardingStepName(pb.OnboardingStepName_value["ONBOARDING_STEP_"+string(step)])
+}
@@ -11,6 +11,8 @@ import (
 	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
 	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
 	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
 	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/storage"
 	"github.com/google/uuid"
@@ -32,18 +34,30 @@ type OrganizationHandler struct {
 	kafka      ports.KafkaPublisher
 	logger     *log.Logger
 	minioClient *storage.MinIOClient
+	// dependencyChecker counts records other services hold before a purge
+	dependencyChecker ports.DependencyChecker
+	// retention is how long a deleted organization can be restored
+	retention time.Duration
+	// onboarding runs customer onboardings as a saga
+	onboarding ports.OnboardingService
 	// optional: clock or logger
 }
 
 // NewOrganizationHandler constructor
-func NewOrganizationHandler(repo ports.Repository, db *pgxpool.Pool, authClient authpb.AuthServiceClient, kafka ports.KafkaPublisher, minioClient *storage.MinIOClient) *OrganizationHandler {
+func NewOrganizationHandler(repo ports.Repository, db *pgxpool.Pool, authClient authpb.AuthServiceClient, kafka ports.KafkaPublisher, minioClient *storage.MinIOClient, dependencyChecker ports.DependencyChecker, retention time.Duration, onboarding ports.OnboardingService) *OrganizationHandler {
+	if retention <= 0 {
+		retention = domain.DefaultRetention
+	}
 	return &OrganizationHandler{
-		repo:        repo,
-		db:          db,
-		authClient:  authClient,
-		kafka:       kafka,
-		logger:      log.Default(),
-		minioClient: minioClient,
+		repo:              repo,
+		db:                db,
+		authClient:        authClient,
+		kafka:             kafka,
+		logger:            log.Default(),
+		minioClient:       minioClient,
+		dependencyChecker: dependencyChecker,
+		retention:         retention,
+		onboarding:        onboarding,
 	}
 }
 
@@ -88,6 +102,10 @@ func mapModelToProto(m ports.OrganizationModel) *pb.Organization {
 		CreatedAt:       toProtoTs(m.CreatedAt),
 		UpdatedAt:       toProtoTs(m.UpdatedAt),
 		CreatedBy:       createdBy,
+		StatusReason:    safeStr(m.StatusReason),
+		DeactivatedAt:   optionalProtoTs(m.DeactivatedAt),
+		DeletedAt:       optionalProtoTs(m.DeletedAt),
+		PurgeAfter:      optionalProtoTs(m.PurgeAfter),
 	}
 }
 
@@ -105,6 +123,13 @@ func toProtoTs(t time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(t)
 }
 
+func optionalProtoTs(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
 // ----------------------------------------------------------------------------
 // CreateOrganization
 // ----------------------------------------------------------------------------
@@ -143,6 +168,9 @@ func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req *pb.Cr
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, "parent organization not found")
 		}
+		if parentOrg.DeletedAt != nil || parentOrg.Status != pb.OrganizationStatus_activated {
+			return nil, status.Error(codes.FailedPrecondition, "parent organization is deactivated or deleted")
+		}
 		tenantID = parentOrg.TenantID
 		parentPtr = &req.ParentOrgId
 		// Copy parent super admin details into the child organization for consistent createdBy behavior
@@ -503,6 +531,9 @@ func (h *OrganizationHandler) UpdateOrganization(ctx context.Context, req *pb.Up
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
 	}
+	if existing.DeletedAt != nil {
+		return nil, hierarchyError(domain.ErrOrganizationDeleted, "")
+	}
 
 	// update mutable fields
 	existing.Name = req.Name
@@ -515,13 +546,35 @@ func (h *OrganizationHandler) UpdateOrganization(ctx context.Context, req *pb.Up
 		l := req.Logo
 		existing.Logo = &l
 	}
-	existing.Status = req.Status
 	existing.UpdatedAt = time.Now().UTC()
 
-	updated, err := h.repo.UpdateOrganization(ctx, existing)
+	if req.Status == existing.Status {
+		updated, err := h.repo.UpdateOrganization(ctx, existing)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update org: %v", err)
+		}
+		return &pb.OrganizationResponse{
+			Organization: mapModelToProto(updated),
+			Message:      "organization updated",
+		}, nil
+	}
+
+	// Status changes cascade to child organizations; the edits and the
+	// cascade are saved together so neither applies without the other
+	if req.Status == pb.OrganizationStatus_deactivated {
+		err = h.checkDeactivate(ctx, existing)
+	} else {
+		err = h.checkActivate(ctx, existing)
+	}
+	if err != nil {
+		return nil, err
+	}
+	existing.Status = req.Status
+	updated, ids, err := h.repo.UpdateOrganizationStatus(ctx, existing, "")
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update org: %v", err)
 	}
+	h.statusChanged(ctx, updated, req.Status, ids, "")
 
 	return &pb.OrganizationResponse{
 		Organization: mapModelToProto(updated),
@@ -610,16 +663,41 @@ func (h *OrganizationHandler) fetchProjectsFromProjectService(ctx context.Contex
 // DeleteOrganization
 // ----------------------------------------------------------------------------
 func (h *OrganizationHandler) DeleteOrganization(ctx context.Context, req *pb.DeleteOrganizationRequest) (*pb.DeleteOrganizationResponse, error) {
-	if req.OrgId == "" {
-		return nil, status.Error(codes.InvalidArgument, "org_id required")
+	if err := validateOrgID(req.GetOrgId()); err != nil {
+		return nil, err
+	}
+	org, err := h.repo.GetOrganizationByID(ctx, req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
 	}
-	err := h.repo.DeleteOrganization(ctx, req.OrgId)
+	if org.DeletedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "organization is already deleted")
+	}
+	if callerOrg, ok := middleware.GetOrgIDFromContext(ctx); ok && callerOrg == req.OrgId {
+		return nil, status.Error(codes.FailedPrecondition, "cannot delete the organization you are signed in to; switch to another organization first")
+	}
+	_, liveChildren, err := h.repo.ListChildOrganizations(ctx, req.OrgId, 0, 1)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete org: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to check child organizations: %v", err)
 	}
+	if liveChildren > 0 {
+		return nil, hierarchyError(domain.ErrHasLiveChildren, "")
+	}
+
+	// Soft delete: the organization is deactivated at once and kept for the
+	// retention window so it can be restored; PurgeOrganization removes it
+	deletedBy, _ := middleware.GetUserIDFromContext(ctx)
+	deleted, err := h.repo.SoftDeleteOrganization(ctx, req.OrgId, deletedBy, req.Reason, time.Now().UTC().Add(h.retention))
+	if err != nil {
+		return nil, hierarchyError(err, "failed to delete org")
+	}
+	h.publishStatusChange(ctx, domain.EventOrganizationDeleted, domain.OrganizationStatusDeleted, deleted, []string{deleted.OrgID}, req.Reason)
+	h.logger.Printf("🗑️ Organization %s soft deleted, purge after %s", req.OrgId, deleted.PurgeAfter)
+
 	return &pb.DeleteOrganizationResponse{
-		Success: true,
-		Message: "organization deleted",
+		Success:    true,
+		Message:    "organization deleted; it can be restored until purge_after",
+		PurgeAfter: optionalProtoTs(deleted.PurgeAfter),
 	}, nil
 }
 
@@ -49,14 +49,7 @@ func (r *RealKafkaPublisher) Publish(ctx context.Context, topic string, message
 		return fmt.Errorf("failed to marshal message: %w", err)
 	}
 
-	msg := kafka.Message{
-		Topic: topic,
-		Value: data,
-		Key:   []byte("organization.created"),
-		Headers: []kafka.Header{
-			{Key: "event_type", Value: []byte("organization.created")},
-		},
-	}
+	msg := newMessage(topic, data)
 
 	if err := r.writer.WriteMessages(ctx, msg); err != nil {
 		r.logger.Printf("Failed to publish message to Kafka: %v", err)
@@ -77,14 +70,7 @@ func (r *RealKafkaPublisher) PublishBatch(ctx context.Context, topic string, mes
 			return fmt.Errorf("failed to marshal message %d: %w", i, err)
 		}
 
-		kafkaMessages[i] = kafka.Message{
-			Topic: topic,
-			Value: data,
-			Key:   []byte("organization.created"),
-			Headers: []kafka.Header{
-				{Key: "event_type", Value: []byte("organization.created")},
-			},
-		}
+		kafkaMessages[i] = newMessage(topic, data)
 	}
 
 	if err := r.writer.WriteMessages(ctx, kafkaMessages...); err != nil {
@@ -96,6 +82,32 @@ func (r *RealKafkaPublisher) PublishBatch(ctx context.Context, topic string, mes
 	return nil
 }
 
+// newMessage keys the message by organization so every event for one
+// organization lands on the same partition in order, and copies the event
+// type into the header consumers filter on
+func newMessage(topic string, data []byte) kafka.Message {
+	var envelope struct {
+		EventType string `json:"event_type"`
+		OrgID     string `json:"org_id"`
+	}
+	_ = json.Unmarshal(data, &envelope)
+	if envelope.EventType == "" {
+		envelope.EventType = "organization.created"
+	}
+	key := envelope.OrgID
+	if key == "" {
+		key = envelope.EventType
+	}
+	return kafka.Message{
+		Topic: topic,
+		Value: data,
+		Key:   []byte(key),
+		Headers: []kafka.Header{
+			{Key: "event_type", Value: []byte(envelope.EventType)},
+		},
+	}
+}
+
 // Close closes the Kafka publisher
 func (r *RealKafkaPublisher) Close() error {
 	if err := r.writer.Close(); err != nil {
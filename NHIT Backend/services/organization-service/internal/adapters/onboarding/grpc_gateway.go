@@ -0,0 +1,265 @@
+package onboarding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	departmentpb "github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb"
+	designationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb"
+	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// listPageSize is how many records are fetched per page when looking for a
+// record an earlier attempt already created
+const listPageSize = 100
+
+// GRPCGateway reaches user, auth, department, designation and project
+// services for the onboarding saga
+type GRPCGateway struct {
+	users        userpb.UserManagementClient
+	auth         authpb.AuthServiceClient
+	departments  departmentpb.DepartmentServiceClient
+	designations designationpb.DesignationServiceClient
+	projects     projectpb.ProjectServiceClient
+}
+
+// NewGRPCGateway creates an onboarding gateway over the given clients
+func NewGRPCGateway(
+	users userpb.UserManagementClient,
+	auth authpb.AuthServiceClient,
+	departments departmentpb.DepartmentServiceClient,
+	designations designationpb.DesignationServiceClient,
+	projects projectpb.ProjectServiceClient,
+) ports.OnboardingGateway {
+	return &GRPCGateway{
+		users:        users,
+		auth:         auth,
+		departments:  departments,
+		designations: designations,
+		projects:     projects,
+	}
+}
+
+func (g *GRPCGateway) CreateTenant(ctx context.Context, name, email, password string) (string, error) {
+	resp, err := g.users.CreateTenant(ctx, &userpb.CreateTenantRequest{Name: name, Email: email, Password: password})
+	if err != nil {
+		return "", classify("user-service", err)
+	}
+	return resp.GetTenantId(), nil
+}
+
+func (g *GRPCGateway) DeleteTenant(ctx context.Context, tenantID, password string) error {
+	_, err := g.users.DeleteTenant(ctx, &userpb.DeleteTenantRequest{TenantId: tenantID, Password: password})
+	return ignoreNotFound("user-service", err)
+}
+
+func (g *GRPCGateway) SignIn(ctx context.Context, email, password, tenantID, orgID string) (ports.OnboardingSession, error) {
+	resp, err := g.auth.Login(ctx, &authpb.UserLoginRequest{
+		Login:    email,
+		Password: password,
+		TenantId: tenantID,
+		OrgId:    orgID,
+	})
+	if err != nil {
+		return ports.OnboardingSession{}, classify("auth-service", err)
+	}
+	return ports.OnboardingSession{
+		Token:        resp.GetToken(),
+		RefreshToken: resp.GetRefreshToken(),
+		UserID:       resp.GetUserId(),
+		TenantID:     tenantID,
+		OrgID:        orgID,
+	}, nil
+}
+
+func (g *GRPCGateway) SignOut(ctx context.Context, session ports.OnboardingSession) error {
+	_, err := g.auth.Logout(authorized(ctx, session), &authpb.UserLogoutRequest{
+		UserId:       session.UserID,
+		RefreshToken: session.RefreshToken,
+	})
+	if err != nil {
+		return classify("auth-service", err)
+	}
+	return nil
+}
+
+func (g *GRPCGateway) SuperAdminRoleID(ctx context.Context, session ports.OnboardingSession) (string, error) {
+	resp, err := g.users.ListRolesOfUser(authorized(ctx, session), &userpb.GetUserRequest{UserId: session.UserID})
+	if err != nil {
+		return "", classify("user-service", err)
+	}
+	for _, role := range resp.GetRoles() {
+		if role.GetName() == "SUPER_ADMIN" {
+			return role.GetRoleId(), nil
+		}
+	}
+	return "", fmt.Errorf("user-service: user %s has no SUPER_ADMIN role", session.UserID)
+}
+
+func (g *GRPCGateway) AddMember(ctx context.Context, session ports.OnboardingSession, roleID string) error {
+	_, err := g.users.AddUserToOrganization(authorized(ctx, session), &userpb.AddUserToOrganizationRequest{
+		UserId:  session.UserID,
+		OrgId:   session.OrgID,
+		RoleId:  roleID,
+		AddedBy: session.UserID,
+	})
+	if err != nil {
+		return classify("user-service", err)
+	}
+	return nil
+}
+
+func (g *GRPCGateway) RemoveMember(ctx context.Context, session ports.OnboardingSession) error {
+	_, err := g.users.RemoveUserFromOrganization(authorized(ctx, session), &userpb.RemoveUserFromOrganizationRequest{
+		UserId:    session.UserID,
+		OrgId:     session.OrgID,
+		RemovedBy: session.UserID,
+		Reason:    "onboarding rolled back",
+	})
+	return ignoreNotFound("user-service", err)
+}
+
+func (g *GRPCGateway) CreateDepartment(ctx context.Context, session ports.OnboardingSession, name string) (string, error) {
+	ctx = authorized(ctx, session)
+	resp, err := g.departments.CreateDepartment(ctx, &departmentpb.CreateDepartmentRequest{
+		Name:        name,
+		Description: name + " department",
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return g.findDepartment(ctx, name)
+	}
+	if err != nil {
+		return "", classify("department-service", err)
+	}
+	return resp.GetDepartment().GetId(), nil
+}
+
+func (g *GRPCGateway) findDepartment(ctx context.Context, name string) (string, error) {
+	for page := int32(1); ; page++ {
+		resp, err := g.departments.ListDepartments(ctx, &departmentpb.ListDepartmentsRequest{Page: page, PageSize: listPageSize})
+		if err != nil {
+			return "", classify("department-service", err)
+		}
+		for _, d := range resp.GetDepartments() {
+			if strings.EqualFold(d.GetName(), name) {
+				return d.GetId(), nil
+			}
+		}
+		if page >= resp.GetPagination().GetTotalPages() {
+			return "", fmt.Errorf("department-service: department %q exists but was not found in the organization", name)
+		}
+	}
+}
+
+func (g *GRPCGateway) DeleteDepartment(ctx context.Context, session ports.OnboardingSession, departmentID string) error {
+	_, err := g.departments.DeleteDepartment(authorized(ctx, session), &departmentpb.DeleteDepartmentRequest{Id: departmentID})
+	return ignoreNotFound("department-service", err)
+}
+
+func (g *GRPCGateway) CreateDesignation(ctx context.Context, session ports.OnboardingSession, name string) (string, error) {
+	ctx = authorized(ctx, session)
+	resp, err := g.designations.CreateDesignation(ctx, &designationpb.CreateDesignationRequest{
+		Name:        name,
+		Description: name,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return g.findDesignation(ctx, name)
+	}
+	if err != nil {
+		return "", classify("designation-service", err)
+	}
+	return resp.GetDesignation().GetId(), nil
+}
+
+func (g *GRPCGateway) findDesignation(ctx context.Context, name string) (string, error) {
+	for page := int32(1); ; page++ {
+		resp, err := g.designations.ListDesignations(ctx, &designationpb.ListDesignationsRequest{Page: page, PageSize: listPageSize})
+		if err != nil {
+			return "", classify("designation-service", err)
+		}
+		for _, d := range resp.GetDesignations() {
+			if strings.EqualFold(d.GetName(), name) {
+				return d.GetId(), nil
+			}
+		}
+		if page >= resp.GetPagination().GetTotalPages() {
+			return "", fmt.Errorf("designation-service: designation %q exists but was not found in the organization", name)
+		}
+	}
+}
+
+func (g *GRPCGateway) DeleteDesignation(ctx context.Context, session ports.OnboardingSession, designationID string) error {
+	_, err := g.designations.DeleteDesignation(authorized(ctx, session), &designationpb.DeleteDesignationRequest{Id: designationID})
+	return ignoreNotFound("designation-service", err)
+}
+
+// CreateProject adopts a project of the same name, since project names are
+// not unique and an earlier attempt may have created it before failing
+func (g *GRPCGateway) CreateProject(ctx context.Context, session ports.OnboardingSession, name, createdBy string) (string, error) {
+	ctx = authorized(ctx, session)
+	existing, err := g.projects.ListProjectsByOrganization(ctx, &projectpb.ListProjectsByOrganizationRequest{
+		OrgId:           session.OrgID,
+		Page:            1,
+		PageSize:        listPageSize,
+		IncludeArchived: true,
+		ProjectName:     name,
+	})
+	if err != nil {
+		return "", classify("project-service", err)
+	}
+	for _, p := range existing.GetProjects() {
+		if strings.EqualFold(p.GetProjectName(), name) {
+			return p.GetProjectId(), nil
+		}
+	}
+
+	resp, err := g.projects.CreateProject(ctx, &projectpb.CreateProjectRequest{
+		TenantId:    session.TenantID,
+		OrgId:       session.OrgID,
+		ProjectName: name,
+		CreatedBy:   createdBy,
+	})
+	if err != nil {
+		return "", classify("project-service", err)
+	}
+	return resp.GetProject().GetProjectId(), nil
+}
+
+func (g *GRPCGateway) DeleteProject(ctx context.Context, session ports.OnboardingSession, projectID string) error {
+	_, err := g.projects.DeleteProject(authorized(ctx, session), &projectpb.DeleteProjectRequest{ProjectId: projectID})
+	return ignoreNotFound("project-service", err)
+}
+
+// authorized calls as the signed in super admin, scoped to the new
+// organization for services that read it from metadata
+func authorized(ctx context.Context, session ports.OnboardingSession) context.Context {
+	return metadata.AppendToOutgoingContext(ctx,
+		"authorization", "Bearer "+session.Token,
+		"x-org-id", session.OrgID,
+	)
+}
+
+// classify marks failures that may pass on retry as transient
+func classify(service string, err error) error {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted,
+		codes.Aborted, codes.Canceled, codes.Unauthenticated:
+		return fmt.Errorf("%s: %w: %s", service, domain.ErrOnboardingTransient, status.Convert(err).Message())
+	}
+	return fmt.Errorf("%s: %s", service, status.Convert(err).Message())
+}
+
+func ignoreNotFound(service string, err error) error {
+	if err == nil || status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return classify(service, err)
+}
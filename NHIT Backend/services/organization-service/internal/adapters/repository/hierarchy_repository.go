@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	db "github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/repository/sqlc/generated"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// List Subtree
+func (r *OrganizationRepository) ListOrganizationSubtree(ctx context.Context, orgID string) ([]ports.OrganizationModel, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListOrganizationSubtree(ctx, parsedID)
+	if err != nil {
+		return nil, err
+	}
+	return r.convertAll(rows), nil
+}
+
+// List Ancestors
+func (r *OrganizationRepository) ListOrganizationAncestors(ctx context.Context, orgID string) ([]ports.OrganizationModel, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListOrganizationAncestors(ctx, parsedID)
+	if err != nil {
+		return nil, err
+	}
+	return r.convertAll(rows), nil
+}
+
+// Move
+// Moves are serialized per tenant so two concurrent moves cannot close a
+// cycle that neither would on its own.
+func (r *OrganizationRepository) MoveOrganization(ctx context.Context, orgID, newParentOrgID string) (ports.OrganizationModel, error) {
+	id, err := uuid.Parse(orgID)
+	if err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	parentID, err := uuid.Parse(newParentOrgID)
+	if err != nil {
+		return ports.OrganizationModel{}, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.q.WithTx(tx)
+
+	org, err := q.GetOrganizationByID(ctx, id)
+	if err != nil {
+		return ports.OrganizationModel{}, notFound(err)
+	}
+	if err := q.LockOrganizationHierarchy(ctx, org.TenantID.String()); err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	parent, err := q.GetOrganizationByID(ctx, parentID)
+	if err != nil {
+		return ports.OrganizationModel{}, notFound(err)
+	}
+	if parent.TenantID != org.TenantID {
+		return ports.OrganizationModel{}, domain.ErrCrossTenantMove
+	}
+	if parentID == id {
+		return ports.OrganizationModel{}, domain.ErrOrganizationCycle
+	}
+	ancestors, err := q.ListOrganizationAncestors(ctx, parentID)
+	if err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	for _, a := range ancestors {
+		if a.OrgID == id {
+			return ports.OrganizationModel{}, domain.ErrOrganizationCycle
+		}
+	}
+
+	row, err := q.SetOrganizationParent(ctx, db.SetOrganizationParentParams{
+		OrgID:       id,
+		ParentOrgID: pgtype.UUID{Bytes: parentID, Valid: true},
+	})
+	if err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	return r.convertSQLC(row), nil
+}
+
+// Get Settings
+func (r *OrganizationRepository) GetOrganizationSettings(ctx context.Context, orgID string) (domain.OrganizationSettings, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return domain.OrganizationSettings{}, err
+	}
+	org, err := r.q.GetOrganizationByID(ctx, parsedID)
+	if err != nil {
+		return domain.OrganizationSettings{}, notFound(err)
+	}
+
+	settings := domain.OrganizationSettings{OrgID: orgID, Logo: org.Logo}
+	row, err := r.q.GetOrganizationSettings(ctx, parsedID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return settings, nil
+	}
+	if err != nil {
+		return domain.OrganizationSettings{}, err
+	}
+	if err := decodeSettings(row, &settings); err != nil {
+		return domain.OrganizationSettings{}, err
+	}
+	return settings, nil
+}
+
+// List Settings Path
+func (r *OrganizationRepository) ListOrganizationSettingsPath(ctx context.Context, orgID string) ([]domain.OrganizationSettings, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, err
+	}
+	org, err := r.q.GetOrganizationByID(ctx, parsedID)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	path, err := r.q.ListOrganizationAncestors(ctx, parsedID)
+	if err != nil {
+		return nil, err
+	}
+	path = append(path, org)
+
+	ids := make([]pgtype.UUID, 0, len(path))
+	for _, o := range path {
+		ids = append(ids, pgtype.UUID{Bytes: o.OrgID, Valid: true})
+	}
+	rows, err := r.q.ListOrganizationSettings(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byOrg := make(map[uuid.UUID]*db.OrganizationSetting, len(rows))
+	for _, row := range rows {
+		byOrg[row.OrgID] = row
+	}
+
+	result := make([]domain.OrganizationSettings, 0, len(path))
+	for _, o := range path {
+		settings := domain.OrganizationSettings{OrgID: o.OrgID.String(), Logo: o.Logo}
+		if row, ok := byOrg[o.OrgID]; ok {
+			if err := decodeSettings(row, &settings); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, settings)
+	}
+	return result, nil
+}
+
+// Save Settings
+func (r *OrganizationRepository) SaveOrganizationSettings(ctx context.Context, settings domain.OrganizationSettings, updatedBy string) error {
+	parsedID, err := uuid.Parse(settings.OrgID)
+	if err != nil {
+		return err
+	}
+	params := db.UpsertOrganizationSettingsParams{OrgID: parsedID}
+	if updatedBy != "" {
+		params.UpdatedBy = &updatedBy
+	}
+	if params.ApprovalRules, err = encodeSection(settings.ApprovalRules); err != nil {
+		return err
+	}
+	if params.TdsDefaults, err = encodeSection(settings.TDSDefaults); err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.q.WithTx(tx)
+
+	if _, err := q.UpsertOrganizationSettings(ctx, params); err != nil {
+		return err
+	}
+	if err := q.SetOrganizationLogo(ctx, db.SetOrganizationLogoParams{OrgID: parsedID, Logo: settings.Logo}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// Helpers
+func notFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ports.ErrNotFound
+	}
+	return err
+}
+
+func decodeSettings(row *db.OrganizationSetting, settings *domain.OrganizationSettings) error {
+	if row.ApprovalRules != nil {
+		settings.ApprovalRules = &domain.ApprovalRules{}
+		if err := json.Unmarshal(row.ApprovalRules, settings.ApprovalRules); err != nil {
+			return err
+		}
+	}
+	if row.TdsDefaults != nil {
+		settings.TDSDefaults = &domain.TDSDefaults{}
+		if err := json.Unmarshal(row.TdsDefaults, settings.TDSDefaults); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeSection marshals a settings section, nil for an inherited one
+func encodeSection[T any](section *T) ([]byte, error) {
+	if section == nil {
+		return nil, nil
+	}
+	return json.Marshal(section)
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
+	db "github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/repository/sqlc/generated"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Deactivate
+func (r *OrganizationRepository) DeactivateOrganization(ctx context.Context, orgID, reason string) ([]string, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := r.q.DeactivateOrganizationSubtree(ctx, db.DeactivateOrganizationSubtreeParams{
+		OrgID:        parsedID,
+		StatusReason: optionalString(reason),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uuidStrings(ids), nil
+}
+
+// Activate
+func (r *OrganizationRepository) ActivateOrganization(ctx context.Context, orgID, reason string) ([]string, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := r.q.ActivateOrganizationSubtree(ctx, db.ActivateOrganizationSubtreeParams{
+		OrgID:        parsedID,
+		StatusReason: optionalString(reason),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uuidStrings(ids), nil
+}
+
+// Update with status change
+// The subtree changes first so its status filters still see the old status.
+func (r *OrganizationRepository) UpdateOrganizationStatus(ctx context.Context, org ports.OrganizationModel, reason string) (ports.OrganizationModel, []string, error) {
+	parsedID, err := uuid.Parse(org.OrgID)
+	if err != nil {
+		return ports.OrganizationModel{}, nil, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return ports.OrganizationModel{}, nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.q.WithTx(tx)
+
+	var ids []uuid.UUID
+	if org.Status == pb.OrganizationStatus_deactivated {
+		ids, err = q.DeactivateOrganizationSubtree(ctx, db.DeactivateOrganizationSubtreeParams{
+			OrgID:        parsedID,
+			StatusReason: optionalString(reason),
+		})
+	} else {
+		ids, err = q.ActivateOrganizationSubtree(ctx, db.ActivateOrganizationSubtreeParams{
+			OrgID:        parsedID,
+			StatusReason: optionalString(reason),
+		})
+	}
+	if err != nil {
+		return ports.OrganizationModel{}, nil, err
+	}
+
+	row, err := q.UpdateOrganization(ctx, db.UpdateOrganizationParams{
+		OrgID:       parsedID,
+		Name:        org.Name,
+		Code:        org.Code,
+		Description: org.Description,
+		Logo:        org.Logo,
+		Status:      int16(org.Status),
+	})
+	if err != nil {
+		return ports.OrganizationModel{}, nil, notFound(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return ports.OrganizationModel{}, nil, err
+	}
+	return r.convertSQLC(row), uuidStrings(ids), nil
+}
+
+// Soft Delete
+func (r *OrganizationRepository) SoftDeleteOrganization(ctx context.Context, orgID, deletedBy, reason string, purgeAfter time.Time) (ports.OrganizationModel, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	row, err := r.q.SoftDeleteOrganization(ctx, db.SoftDeleteOrganizationParams{
+		OrgID:        parsedID,
+		DeletedBy:    optionalString(deletedBy),
+		PurgeAfter:   pgtype.Timestamp{Time: purgeAfter.UTC(), Valid: true},
+		StatusReason: optionalString(reason),
+	})
+	if err != nil {
+		return ports.OrganizationModel{}, notFound(err)
+	}
+	return r.convertSQLC(row), nil
+}
+
+// Restore
+// An organization that was already deactivated when it was deleted stays
+// deactivated.
+func (r *OrganizationRepository) RestoreOrganization(ctx context.Context, orgID, reason string, reactivate bool) (ports.OrganizationModel, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return ports.OrganizationModel{}, err
+	}
+	row, err := r.q.RestoreOrganization(ctx, db.RestoreOrganizationParams{
+		StatusReason: optionalString(reason),
+		Reactivate:   reactivate,
+		OrgID:        parsedID,
+	})
+	if err != nil {
+		return ports.OrganizationModel{}, notFound(err)
+	}
+	return r.convertSQLC(row), nil
+}
+
+// Count Dependents
+func (r *OrganizationRepository) CountOrganizationDependents(ctx context.Context, orgID string) (ports.OrganizationDependents, error) {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return ports.OrganizationDependents{}, err
+	}
+	row, err := r.q.CountOrganizationDependents(ctx, pgtype.UUID{Bytes: parsedID, Valid: true})
+	if err != nil {
+		return ports.OrganizationDependents{}, err
+	}
+	return ports.OrganizationDependents{Children: row.ChildCount, Members: row.MemberCount}, nil
+}
+
+// Purge
+func (r *OrganizationRepository) PurgeOrganization(ctx context.Context, orgID string) error {
+	parsedID, err := uuid.Parse(orgID)
+	if err != nil {
+		return err
+	}
+	return r.q.DeleteOrganization(ctx, parsedID)
+}
+
+// Helpers
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
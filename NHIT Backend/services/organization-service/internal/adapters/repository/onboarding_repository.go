@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	db "github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/repository/sqlc/generated"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Create Onboarding
+func (r *OrganizationRepository) CreateOnboarding(ctx context.Context, saga *domain.OnboardingSaga) error {
+	sagaID, err := uuid.Parse(saga.SagaID)
+	if err != nil {
+		return err
+	}
+	orgID, err := uuid.Parse(saga.OrgID)
+	if err != nil {
+		return err
+	}
+	request, err := json.Marshal(saga.Request)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.q.WithTx(tx)
+
+	err = q.CreateOnboardingSaga(ctx, db.CreateOnboardingSagaParams{
+		SagaID:          sagaID,
+		Status:          string(saga.Status),
+		CurrentStep:     string(saga.CurrentStep),
+		Request:         request,
+		SuperAdminEmail: saga.Request.SuperAdminEmail,
+		PasswordHash:    saga.PasswordHash,
+		OrgID:           orgID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrOnboardingInProgress
+		}
+		return err
+	}
+	for i, step := range saga.Steps {
+		err := q.CreateOnboardingSagaStep(ctx, db.CreateOnboardingSagaStepParams{
+			SagaID:   sagaID,
+			Step:     string(step.Step),
+			Position: int32(i + 1),
+			Status:   string(step.Status),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// Get Onboarding
+func (r *OrganizationRepository) GetOnboarding(ctx context.Context, sagaID string) (*domain.OnboardingSaga, error) {
+	parsedID, err := uuid.Parse(sagaID)
+	if err != nil {
+		return nil, domain.ErrOnboardingNotFound
+	}
+	row, err := r.q.GetOnboardingSaga(ctx, parsedID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrOnboardingNotFound
+		}
+		return nil, err
+	}
+	steps, err := r.q.ListOnboardingSagaSteps(ctx, parsedID)
+	if err != nil {
+		return nil, err
+	}
+
+	saga := &domain.OnboardingSaga{
+		SagaID:           row.SagaID.String(),
+		Status:           domain.OnboardingStatus(row.Status),
+		CurrentStep:      domain.OnboardingStep(row.CurrentStep),
+		PasswordHash:     row.PasswordHash,
+		TenantID:         stringFromPgUUID(row.TenantID),
+		OrgID:            row.OrgID.String(),
+		SuperAdminUserID: stringFromPgUUID(row.SuperAdminUserID),
+		RoleID:           stringFromPgUUID(row.RoleID),
+		Error:            stringValue(row.Error),
+		Steps:            make([]domain.OnboardingStepState, 0, len(steps)),
+		CreatedAt:        row.CreatedAt.Time,
+		UpdatedAt:        row.UpdatedAt.Time,
+		CompletedAt:      timePtrFromPgTimestamp(row.CompletedAt),
+	}
+	if err := json.Unmarshal(row.Request, &saga.Request); err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		state := domain.OnboardingStepState{
+			Step:       domain.OnboardingStep(step.Step),
+			Status:     domain.OnboardingStepStatus(step.Status),
+			Output:     map[string]string{},
+			Attempts:   step.Attempts,
+			Error:      stringValue(step.Error),
+			StartedAt:  timePtrFromPgTimestamp(step.StartedAt),
+			FinishedAt: timePtrFromPgTimestamp(step.FinishedAt),
+		}
+		if len(step.Output) > 0 {
+			if err := json.Unmarshal(step.Output, &state.Output); err != nil {
+				return nil, err
+			}
+		}
+		saga.Steps = append(saga.Steps, state)
+	}
+	return saga, nil
+}
+
+// Claim Onboarding
+func (r *OrganizationRepository) ClaimOnboarding(ctx context.Context, sagaID string, from []domain.OnboardingStatus, to domain.OnboardingStatus, staleBefore time.Time) (bool, error) {
+	parsedID, err := uuid.Parse(sagaID)
+	if err != nil {
+		return false, domain.ErrOnboardingNotFound
+	}
+	fromStatuses := make([]string, 0, len(from))
+	for _, status := range from {
+		fromStatuses = append(fromStatuses, string(status))
+	}
+	_, err = r.q.ClaimOnboardingSaga(ctx, db.ClaimOnboardingSagaParams{
+		Status:       string(to),
+		SagaID:       parsedID,
+		FromStatuses: fromStatuses,
+		StaleBefore:  pgtype.Timestamp{Time: staleBefore.UTC(), Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Save Onboarding
+func (r *OrganizationRepository) SaveOnboarding(ctx context.Context, saga *domain.OnboardingSaga) error {
+	sagaID, err := uuid.Parse(saga.SagaID)
+	if err != nil {
+		return err
+	}
+	tenantID, err := toPgUUID(&saga.TenantID)
+	if err != nil {
+		return err
+	}
+	userID, err := toPgUUID(&saga.SuperAdminUserID)
+	if err != nil {
+		return err
+	}
+	roleID, err := toPgUUID(&saga.RoleID)
+	if err != nil {
+		return err
+	}
+	err = r.q.UpdateOnboardingSaga(ctx, db.UpdateOnboardingSagaParams{
+		SagaID:           sagaID,
+		Status:           string(saga.Status),
+		CurrentStep:      string(saga.CurrentStep),
+		TenantID:         tenantID,
+		SuperAdminUserID: userID,
+		RoleID:           roleID,
+		Error:            optionalString(saga.Error),
+		CompletedAt:      pgTimestamp(saga.CompletedAt),
+	})
+	if err != nil {
+		return err
+	}
+	saga.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Save Onboarding Step
+func (r *OrganizationRepository) SaveOnboardingStep(ctx context.Context, sagaID string, step domain.OnboardingStepState) error {
+	parsedID, err := uuid.Parse(sagaID)
+	if err != nil {
+		return err
+	}
+	output := step.Output
+	if output == nil {
+		output = map[string]string{}
+	}
+	encoded, err := json.Marshal(output)
+	if err != nil {
+		return err
+	}
+	return r.q.UpdateOnboardingSagaStep(ctx, db.UpdateOnboardingSagaStepParams{
+		SagaID:     parsedID,
+		Status:     string(step.Status),
+		Output:     encoded,
+		Attempts:   step.Attempts,
+		Error:      optionalString(step.Error),
+		StartedAt:  pgTimestamp(step.StartedAt),
+		FinishedAt: pgTimestamp(step.FinishedAt),
+		Step:       string(step.Step),
+	})
+}
+
+// Find Tenant By Email
+// Tenants live in user-service's tables of the shared database.
+func (r *OrganizationRepository) FindTenantByEmail(ctx context.Context, email string) (string, error) {
+	var tenantID uuid.UUID
+	err := r.pool.QueryRow(ctx, `SELECT tenant_id FROM tenants WHERE LOWER(email) = LOWER($1)`, email).Scan(&tenantID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return tenantID.String(), nil
+}
+
+func stringFromPgUUID(id pgtype.UUID) string {
+	if !id.Valid {
+		return ""
+	}
+	return uuid.UUID(id.Bytes).String()
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func pgTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: t.UTC(), Valid: true}
+}
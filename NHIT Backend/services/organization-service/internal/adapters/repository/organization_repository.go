@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
 	db "github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/adapters/repository/sqlc/generated"
@@ -12,12 +13,14 @@ import (
 )
 
 type OrganizationRepository struct {
-	q *db.Queries
+	q    *db.Queries
+	pool *pgxpool.Pool
 }
 
 func NewOrganizationRepository(dbConn *pgxpool.Pool) ports.Repository {
 	return &OrganizationRepository{
-		q: db.New(dbConn),
+		q:    db.New(dbConn),
+		pool: dbConn,
 	}
 }
 
@@ -45,6 +48,14 @@ func stringPtrFromPgUUID(id pgtype.UUID) *string {
 	return &value
 }
 
+func timePtrFromPgTimestamp(ts pgtype.Timestamp) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	value := ts.Time
+	return &value
+}
+
 // Create
 func (r *OrganizationRepository) CreateOrganization(ctx context.Context, org ports.OrganizationModel) (ports.OrganizationModel, error) {
 	orgID, err := uuid.Parse(org.OrgID)
@@ -196,15 +207,6 @@ func (r *OrganizationRepository) UpdateOrganization(ctx context.Context, org por
 	return r.convertSQLC(row), nil
 }
 
-// Delete
-func (r *OrganizationRepository) DeleteOrganization(ctx context.Context, orgID string) error {
-	parsedID, err := uuid.Parse(orgID)
-	if err != nil {
-		return err
-	}
-	return r.q.DeleteOrganization(ctx, parsedID)
-}
-
 // Helpers
 func (r *OrganizationRepository) convertSQLC(row *db.Organization) ports.OrganizationModel {
 	if row == nil {
@@ -227,6 +229,12 @@ func (r *OrganizationRepository) convertSQLC(row *db.Organization) ports.Organiz
 		Status:          pb.OrganizationStatus(row.Status),
 		CreatedAt:       row.CreatedAt.Time,
 		UpdatedAt:       row.UpdatedAt.Time,
+		StatusReason:    row.StatusReason,
+		DeactivatedAt:   timePtrFromPgTimestamp(row.DeactivatedAt),
+		DeactivatedVia:  stringPtrFromPgUUID(row.DeactivatedVia),
+		DeletedAt:       timePtrFromPgTimestamp(row.DeletedAt),
+		DeletedBy:       row.DeletedBy,
+		PurgeAfter:      timePtrFromPgTimestamp(row.PurgeAfter),
 	}
 }
 
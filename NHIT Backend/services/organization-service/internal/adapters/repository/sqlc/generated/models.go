@@ -32,6 +32,30 @@ type Organization struct {
 	Status    int16            `db:"status" json:"status"`
 	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+	// Why the organization was last deactivated, deleted or restored.
+	StatusReason *string `db:"status_reason" json:"status_reason"`
+	// When the organization was deactivated; NULL while activated.
+	DeactivatedAt pgtype.Timestamp `db:"deactivated_at" json:"deactivated_at"`
+	// Organization whose deactivation or deletion deactivated this one; activating that organization reactivates this one.
+	DeactivatedVia pgtype.UUID `db:"deactivated_via" json:"deactivated_via"`
+	// Soft delete time; NULL for live organizations.
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+	// User who soft deleted the organization.
+	DeletedBy *string `db:"deleted_by" json:"deleted_by"`
+	// End of the retention window; the organization can be purged after this time.
+	PurgeAfter pgtype.Timestamp `db:"purge_after" json:"purge_after"`
+}
+
+// Settings sections an organization overrides; NULL sections are inherited from its ancestors.
+type OrganizationSetting struct {
+	OrgID uuid.UUID `db:"org_id" json:"org_id"`
+	// ApprovalRules as JSON; NULL inherits.
+	ApprovalRules []byte `db:"approval_rules" json:"approval_rules"`
+	// TdsDefaults as JSON; NULL inherits.
+	TdsDefaults []byte           `db:"tds_defaults" json:"tds_defaults"`
+	UpdatedBy   *string          `db:"updated_by" json:"updated_by"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 }
 
 // Junction table mapping users to organizations.
@@ -43,3 +67,38 @@ type UserOrganization struct {
 	JoinedAt         pgtype.Timestamp `db:"joined_at" json:"joined_at"`
 	UpdatedAt        pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 }
+
+// Customer onboardings run as a saga across user, organization, department, designation and project services.
+type OnboardingSaga struct {
+	SagaID uuid.UUID `db:"saga_id" json:"saga_id"`
+	Status string    `db:"status" json:"status"`
+	// Step running, paused on or rolled back last.
+	CurrentStep string `db:"current_step" json:"current_step"`
+	// OnboardingRequest as JSON, without the password.
+	Request         []byte `db:"request" json:"request"`
+	SuperAdminEmail string `db:"super_admin_email" json:"super_admin_email"`
+	// bcrypt hash of the super admin password, checked on resume and cancel.
+	PasswordHash string      `db:"password_hash" json:"password_hash"`
+	TenantID     pgtype.UUID `db:"tenant_id" json:"tenant_id"`
+	// ID the organization is created with, chosen up front so a retried step finds it.
+	OrgID            uuid.UUID        `db:"org_id" json:"org_id"`
+	SuperAdminUserID pgtype.UUID      `db:"super_admin_user_id" json:"super_admin_user_id"`
+	RoleID           pgtype.UUID      `db:"role_id" json:"role_id"`
+	Error            *string          `db:"error" json:"error"`
+	CreatedAt        pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+	CompletedAt      pgtype.Timestamp `db:"completed_at" json:"completed_at"`
+}
+
+// State of each onboarding step; output holds the IDs the step created.
+type OnboardingSagaStep struct {
+	SagaID     uuid.UUID        `db:"saga_id" json:"saga_id"`
+	Step       string           `db:"step" json:"step"`
+	Position   int32            `db:"position" json:"position"`
+	Status     string           `db:"status" json:"status"`
+	Output     []byte           `db:"output" json:"output"`
+	Attempts   int32            `db:"attempts" json:"attempts"`
+	Error      *string          `db:"error" json:"error"`
+	StartedAt  pgtype.Timestamp `db:"started_at" json:"started_at"`
+	FinishedAt pgtype.Timestamp `db:"finished_at" json:"finished_at"`
+}
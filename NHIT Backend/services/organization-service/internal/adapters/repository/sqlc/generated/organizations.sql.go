@@ -13,7 +13,7 @@ import (
 )
 
 const countChildOrganizations = `-- name: CountChildOrganizations :one
-SELECT COUNT(*) FROM organizations WHERE parent_org_id = $1
+SELECT COUNT(*) FROM organizations WHERE parent_org_id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) CountChildOrganizations(ctx context.Context, parentOrgID pgtype.UUID) (int64, error) {
@@ -24,7 +24,7 @@ func (q *Queries) CountChildOrganizations(ctx context.Context, parentOrgID pgtyp
 }
 
 const countOrganizations = `-- name: CountOrganizations :one
-SELECT COUNT(*) FROM organizations
+SELECT COUNT(*) FROM organizations WHERE deleted_at IS NULL
 `
 
 func (q *Queries) CountOrganizations(ctx context.Context) (int64, error) {
@@ -35,7 +35,7 @@ func (q *Queries) CountOrganizations(ctx context.Context) (int64, error) {
 }
 
 const countOrganizationsByTenant = `-- name: CountOrganizationsByTenant :one
-SELECT COUNT(*) FROM organizations WHERE tenant_id = $1
+SELECT COUNT(*) FROM organizations WHERE tenant_id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) CountOrganizationsByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error) {
@@ -57,7 +57,7 @@ INSERT INTO organizations (
     $9, $10, $11,
     $12, $13
 )
-RETURNING org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at
+RETURNING org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at, status_reason, deactivated_at, deactivated_via, deleted_at, deleted_by, purge_after
 `
 
 type CreateOrganizationParams struct {
@@ -109,6 +109,12 @@ func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganization
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.DeactivatedAt,
+		&i.DeactivatedVia,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
 	)
 	return &i, err
 }
@@ -123,7 +129,7 @@ func (q *Queries) DeleteOrganization(ctx context.Context, orgID uuid.UUID) error
 }
 
 const getOrganizationByCode = `-- name: GetOrganizationByCode :one
-SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at FROM organizations WHERE code = $1
+SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at, status_reason, deactivated_at, deactivated_via, deleted_at, deleted_by, purge_after FROM organizations WHERE code = $1
 `
 
 func (q *Queries) GetOrganizationByCode(ctx context.Context, code string) (*Organization, error) {
@@ -145,12 +151,18 @@ func (q *Queries) GetOrganizationByCode(ctx context.Context, code string) (*Orga
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.DeactivatedAt,
+		&i.DeactivatedVia,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
 	)
 	return &i, err
 }
 
 const getOrganizationByID = `-- name: GetOrganizationByID :one
-SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at FROM organizations WHERE org_id = $1
+SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at, status_reason, deactivated_at, deactivated_via, deleted_at, deleted_by, purge_after FROM organizations WHERE org_id = $1
 `
 
 func (q *Queries) GetOrganizationByID(ctx context.Context, orgID uuid.UUID) (*Organization, error) {
@@ -172,12 +184,18 @@ func (q *Queries) GetOrganizationByID(ctx context.Context, orgID uuid.UUID) (*Or
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.DeactivatedAt,
+		&i.DeactivatedVia,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
 	)
 	return &i, err
 }
 
 const listChildOrganizations = `-- name: ListChildOrganizations :many
-SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at FROM organizations WHERE parent_org_id = $1 ORDER BY created_at DESC OFFSET $2 LIMIT $3
+SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at, status_reason, deactivated_at, deactivated_via, deleted_at, deleted_by, purge_after FROM organizations WHERE parent_org_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC OFFSET $2 LIMIT $3
 `
 
 type ListChildOrganizationsParams struct {
@@ -211,6 +229,12 @@ func (q *Queries) ListChildOrganizations(ctx context.Context, arg ListChildOrgan
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StatusReason,
+			&i.DeactivatedAt,
+			&i.DeactivatedVia,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.PurgeAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -223,7 +247,7 @@ func (q *Queries) ListChildOrganizations(ctx context.Context, arg ListChildOrgan
 }
 
 const listOrganizations = `-- name: ListOrganizations :many
-SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at FROM organizations ORDER BY created_at DESC OFFSET $1 LIMIT $2
+SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at, status_reason, deactivated_at, deactivated_via, deleted_at, deleted_by, purge_after FROM organizations WHERE deleted_at IS NULL ORDER BY created_at DESC OFFSET $1 LIMIT $2
 `
 
 type ListOrganizationsParams struct {
@@ -256,6 +280,12 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StatusReason,
+			&i.DeactivatedAt,
+			&i.DeactivatedVia,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.PurgeAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -268,7 +298,7 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 }
 
 const listOrganizationsByTenant = `-- name: ListOrganizationsByTenant :many
-SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at FROM organizations WHERE tenant_id = $1 ORDER BY created_at DESC OFFSET $2 LIMIT $3
+SELECT org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at, status_reason, deactivated_at, deactivated_via, deleted_at, deleted_by, purge_after FROM organizations WHERE tenant_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC OFFSET $2 LIMIT $3
 `
 
 type ListOrganizationsByTenantParams struct {
@@ -302,6 +332,12 @@ func (q *Queries) ListOrganizationsByTenant(ctx context.Context, arg ListOrganiz
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StatusReason,
+			&i.DeactivatedAt,
+			&i.DeactivatedVia,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.PurgeAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -322,7 +358,7 @@ UPDATE organizations SET
     status = $6,
     updated_at = NOW()
 WHERE org_id = $1
-RETURNING org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at
+RETURNING org_id, tenant_id, parent_org_id, name, code, database_name, description, logo, super_admin_name, super_admin_email, super_admin_password, initial_projects, status, created_at, updated_at, status_reason, deactivated_at, deactivated_via, deleted_at, deleted_by, purge_after
 `
 
 type UpdateOrganizationParams struct {
@@ -360,6 +396,12 @@ func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganization
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.DeactivatedAt,
+		&i.DeactivatedVia,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.PurgeAfter,
 	)
 	return &i, err
 }
@@ -12,17 +12,37 @@ import (
 )
 
 type Querier interface {
+	ActivateOrganizationSubtree(ctx context.Context, arg ActivateOrganizationSubtreeParams) ([]uuid.UUID, error)
+	ClaimOnboardingSaga(ctx context.Context, arg ClaimOnboardingSagaParams) (uuid.UUID, error)
 	CountChildOrganizations(ctx context.Context, parentOrgID pgtype.UUID) (int64, error)
+	CountOrganizationDependents(ctx context.Context, orgID pgtype.UUID) (*CountOrganizationDependentsRow, error)
 	CountOrganizations(ctx context.Context) (int64, error)
 	CountOrganizationsByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	CreateOnboardingSaga(ctx context.Context, arg CreateOnboardingSagaParams) error
+	CreateOnboardingSagaStep(ctx context.Context, arg CreateOnboardingSagaStepParams) error
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (*Organization, error)
+	DeactivateOrganizationSubtree(ctx context.Context, arg DeactivateOrganizationSubtreeParams) ([]uuid.UUID, error)
 	DeleteOrganization(ctx context.Context, orgID uuid.UUID) error
+	GetOnboardingSaga(ctx context.Context, sagaID uuid.UUID) (*OnboardingSaga, error)
 	GetOrganizationByCode(ctx context.Context, code string) (*Organization, error)
 	GetOrganizationByID(ctx context.Context, orgID uuid.UUID) (*Organization, error)
+	GetOrganizationSettings(ctx context.Context, orgID uuid.UUID) (*OrganizationSetting, error)
 	ListChildOrganizations(ctx context.Context, arg ListChildOrganizationsParams) ([]*Organization, error)
+	ListOnboardingSagaSteps(ctx context.Context, sagaID uuid.UUID) ([]*OnboardingSagaStep, error)
+	ListOrganizationAncestors(ctx context.Context, orgID uuid.UUID) ([]*Organization, error)
+	ListOrganizationSettings(ctx context.Context, orgIds []pgtype.UUID) ([]*OrganizationSetting, error)
+	ListOrganizationSubtree(ctx context.Context, orgID uuid.UUID) ([]*Organization, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]*Organization, error)
 	ListOrganizationsByTenant(ctx context.Context, arg ListOrganizationsByTenantParams) ([]*Organization, error)
+	LockOrganizationHierarchy(ctx context.Context, tenantID string) error
+	RestoreOrganization(ctx context.Context, arg RestoreOrganizationParams) (*Organization, error)
+	SetOrganizationLogo(ctx context.Context, arg SetOrganizationLogoParams) error
+	SetOrganizationParent(ctx context.Context, arg SetOrganizationParentParams) (*Organization, error)
+	SoftDeleteOrganization(ctx context.Context, arg SoftDeleteOrganizationParams) (*Organization, error)
+	UpdateOnboardingSaga(ctx context.Context, arg UpdateOnboardingSagaParams) error
+	UpdateOnboardingSagaStep(ctx context.Context, arg UpdateOnboardingSagaStepParams) error
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (*Organization, error)
+	UpsertOrganizationSettings(ctx context.Context, arg UpsertOrganizationSettingsParams) (*OrganizationSetting, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,60 @@
+-- name: ListOrganizationSubtree :many
+WITH RECURSIVE subtree AS (
+    SELECT org_id, 0 AS depth FROM organizations WHERE org_id = $1
+    UNION ALL
+    SELECT o.org_id, s.depth + 1
+    FROM organizations o
+    JOIN subtree s ON o.parent_org_id = s.org_id
+    WHERE s.depth < 32 AND o.deleted_at IS NULL
+)
+SELECT organizations.* FROM organizations
+JOIN subtree ON subtree.org_id = organizations.org_id
+ORDER BY subtree.depth, organizations.name;
+
+-- name: ListOrganizationAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT parent_org_id AS org_id, 1 AS depth FROM organizations WHERE org_id = $1
+    UNION ALL
+    SELECT o.parent_org_id, a.depth + 1
+    FROM organizations o
+    JOIN ancestors a ON o.org_id = a.org_id
+    WHERE a.depth < 32
+)
+SELECT organizations.* FROM organizations
+JOIN ancestors ON ancestors.org_id = organizations.org_id
+ORDER BY ancestors.depth DESC;
+
+-- name: LockOrganizationHierarchy :exec
+SELECT pg_advisory_xact_lock(hashtext('organization_hierarchy:' || sqlc.arg(tenant_id)::text));
+
+-- name: SetOrganizationParent :one
+UPDATE organizations SET
+    parent_org_id = $2,
+    updated_at = NOW()
+WHERE org_id = $1
+RETURNING *;
+
+-- name: SetOrganizationLogo :exec
+UPDATE organizations SET
+    logo = $2,
+    updated_at = NOW()
+WHERE org_id = $1;
+
+-- name: GetOrganizationSettings :one
+SELECT * FROM organization_settings WHERE org_id = $1;
+
+-- name: ListOrganizationSettings :many
+SELECT * FROM organization_settings WHERE org_id = ANY(sqlc.arg(org_ids)::uuid[]);
+
+-- name: UpsertOrganizationSettings :one
+INSERT INTO organization_settings (
+    org_id, approval_rules, tds_defaults, updated_by
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (org_id) DO UPDATE SET
+    approval_rules = EXCLUDED.approval_rules,
+    tds_defaults = EXCLUDED.tds_defaults,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING *;
@@ -0,0 +1,69 @@
+-- name: DeactivateOrganizationSubtree :many
+WITH RECURSIVE subtree AS (
+    SELECT org_id, 0 AS depth FROM organizations WHERE org_id = sqlc.arg(org_id)
+    UNION ALL
+    SELECT o.org_id, s.depth + 1
+    FROM organizations o
+    JOIN subtree s ON o.parent_org_id = s.org_id
+    WHERE s.depth < 32
+)
+UPDATE organizations SET
+    status = 1,
+    status_reason = sqlc.arg(status_reason),
+    deactivated_at = NOW(),
+    deactivated_via = sqlc.arg(org_id),
+    updated_at = NOW()
+WHERE org_id IN (SELECT org_id FROM subtree) AND status = 0
+RETURNING org_id;
+
+-- name: ActivateOrganizationSubtree :many
+WITH RECURSIVE subtree AS (
+    SELECT org_id, 0 AS depth FROM organizations WHERE org_id = sqlc.arg(org_id)
+    UNION ALL
+    SELECT o.org_id, s.depth + 1
+    FROM organizations o
+    JOIN subtree s ON o.parent_org_id = s.org_id
+    WHERE s.depth < 32
+)
+UPDATE organizations SET
+    status = 0,
+    status_reason = sqlc.arg(status_reason),
+    deactivated_at = NULL,
+    deactivated_via = NULL,
+    updated_at = NOW()
+WHERE org_id IN (SELECT org_id FROM subtree)
+  AND deleted_at IS NULL
+  AND status = 1
+  AND (deactivated_via = sqlc.arg(org_id) OR org_id = sqlc.arg(org_id))
+RETURNING org_id;
+
+-- name: SoftDeleteOrganization :one
+UPDATE organizations SET
+    deleted_at = NOW(),
+    deleted_by = $2,
+    purge_after = $3,
+    status_reason = $4,
+    deactivated_at = CASE WHEN status = 0 THEN NOW() ELSE deactivated_at END,
+    deactivated_via = CASE WHEN status = 0 THEN org_id ELSE deactivated_via END,
+    status = 1,
+    updated_at = NOW()
+WHERE org_id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: RestoreOrganization :one
+UPDATE organizations SET
+    deleted_at = NULL,
+    deleted_by = NULL,
+    purge_after = NULL,
+    status_reason = sqlc.arg(status_reason),
+    status = CASE WHEN sqlc.arg(reactivate)::boolean AND deactivated_via = org_id THEN 0 ELSE status END,
+    deactivated_at = CASE WHEN sqlc.arg(reactivate)::boolean AND deactivated_via = org_id THEN NULL ELSE deactivated_at END,
+    deactivated_via = CASE WHEN sqlc.arg(reactivate)::boolean AND deactivated_via = org_id THEN NULL ELSE deactivated_via END,
+    updated_at = NOW()
+WHERE org_id = sqlc.arg(org_id) AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: CountOrganizationDependents :one
+SELECT
+    (SELECT COUNT(*) FROM organizations c WHERE c.parent_org_id = sqlc.arg(org_id)::uuid) AS child_count,
+    (SELECT COUNT(*) FROM user_organizations u WHERE u.org_id = sqlc.arg(org_id)::uuid) AS member_count;
@@ -0,0 +1,50 @@
+-- name: CreateOnboardingSaga :exec
+INSERT INTO onboarding_sagas (
+    saga_id, status, current_step, request, super_admin_email, password_hash, org_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: CreateOnboardingSagaStep :exec
+INSERT INTO onboarding_saga_steps (saga_id, step, position, status)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetOnboardingSaga :one
+SELECT * FROM onboarding_sagas WHERE saga_id = $1;
+
+-- name: ListOnboardingSagaSteps :many
+SELECT * FROM onboarding_saga_steps WHERE saga_id = $1 ORDER BY position;
+
+-- name: ClaimOnboardingSaga :one
+UPDATE onboarding_sagas SET
+    status = sqlc.arg(status),
+    updated_at = NOW()
+WHERE saga_id = sqlc.arg(saga_id)
+  AND status = ANY(sqlc.arg(from_statuses)::text[])
+  AND (status NOT IN ('RUNNING', 'COMPENSATING') OR updated_at < sqlc.arg(stale_before))
+RETURNING saga_id;
+
+-- name: UpdateOnboardingSaga :exec
+UPDATE onboarding_sagas SET
+    status = $2,
+    current_step = $3,
+    tenant_id = $4,
+    super_admin_user_id = $5,
+    role_id = $6,
+    error = $7,
+    completed_at = $8,
+    updated_at = NOW()
+WHERE saga_id = $1;
+
+-- name: UpdateOnboardingSagaStep :exec
+WITH progress AS (
+    UPDATE onboarding_sagas SET updated_at = NOW() WHERE onboarding_sagas.saga_id = sqlc.arg(saga_id)
+)
+UPDATE onboarding_saga_steps SET
+    status = sqlc.arg(status),
+    output = sqlc.arg(output),
+    attempts = sqlc.arg(attempts),
+    error = sqlc.arg(error),
+    started_at = sqlc.arg(started_at),
+    finished_at = sqlc.arg(finished_at)
+WHERE onboarding_saga_steps.saga_id = sqlc.arg(saga_id) AND step = sqlc.arg(step);
@@ -19,22 +19,22 @@ SELECT * FROM organizations WHERE org_id = $1;
 SELECT * FROM organizations WHERE code = $1;
 
 -- name: ListOrganizations :many
-SELECT * FROM organizations ORDER BY created_at DESC OFFSET $1 LIMIT $2;
+SELECT * FROM organizations WHERE deleted_at IS NULL ORDER BY created_at DESC OFFSET $1 LIMIT $2;
 
 -- name: ListOrganizationsByTenant :many
-SELECT * FROM organizations WHERE tenant_id = $1 ORDER BY created_at DESC OFFSET $2 LIMIT $3;
+SELECT * FROM organizations WHERE tenant_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC OFFSET $2 LIMIT $3;
 
 -- name: ListChildOrganizations :many
-SELECT * FROM organizations WHERE parent_org_id = $1 ORDER BY created_at DESC OFFSET $2 LIMIT $3;
+SELECT * FROM organizations WHERE parent_org_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC OFFSET $2 LIMIT $3;
 
 -- name: CountOrganizations :one
-SELECT COUNT(*) FROM organizations;
+SELECT COUNT(*) FROM organizations WHERE deleted_at IS NULL;
 
 -- name: CountOrganizationsByTenant :one
-SELECT COUNT(*) FROM organizations WHERE tenant_id = $1;
+SELECT COUNT(*) FROM organizations WHERE tenant_id = $1 AND deleted_at IS NULL;
 
 -- name: CountChildOrganizations :one
-SELECT COUNT(*) FROM organizations WHERE parent_org_id = $1;
+SELECT COUNT(*) FROM organizations WHERE parent_org_id = $1 AND deleted_at IS NULL;
 
 -- name: UpdateOrganization :one
 UPDATE organizations SET
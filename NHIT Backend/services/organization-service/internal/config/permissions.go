@@ -16,6 +16,21 @@ func GetPermissionMap() map[string][]string {
 		"/organizations.OrganizationService/RemoveUserFromOrganization": {"edit-organizations"},
 		"/organizations.OrganizationService/ListOrganizationUsers":    {"view-organizations"},
 		"/organizations.OrganizationService/GetUserOrganizations":     {"view-organizations"},
+
+		// Hierarchy and inherited settings
+		"/organizations.OrganizationService/GetOrganizationTree":              {"view-organizations"},
+		"/organizations.OrganizationService/GetOrganizationAncestors":         {"view-organizations"},
+		"/organizations.OrganizationService/MoveOrganization":                 {"edit-organizations"},
+		"/organizations.OrganizationService/GetOrganizationSettings":          {"view-organizations"},
+		"/organizations.OrganizationService/UpdateOrganizationSettings":       {"edit-organizations"},
+		"/organizations.OrganizationService/GetEffectiveOrganizationSettings": {"view-organizations"},
+
+		// Lifecycle: deactivation, soft delete, restore and purge
+		"/organizations.OrganizationService/DeactivateOrganization":      {"edit-organizations"},
+		"/organizations.OrganizationService/ActivateOrganization":        {"edit-organizations"},
+		"/organizations.OrganizationService/RestoreOrganization":         {"delete-organizations"},
+		"/organizations.OrganizationService/GetOrganizationDependencies": {"view-organizations"},
+		"/organizations.OrganizationService/PurgeOrganization":           {"delete-organizations"},
 	}
 }
 
@@ -23,10 +38,16 @@ func GetPermissionMap() map[string][]string {
 // Note: CreateOrganization is public because parent org creation (before login) 
 // doesn't require auth. The handler itself validates auth for child org creation.
 // Note: ListUserOrganizations is public to allow auth-service to fetch user orgs during login
+// Note: onboarding runs before the customer has an account; resume and cancel
+// check the super admin password instead
 func GetPublicMethods() []string {
 	return []string{
 		"/organizations.OrganizationService/CreateOrganization",
 		"/organizations.OrganizationService/ListUserOrganizations",
+		"/organizations.OrganizationService/StartOnboarding",
+		"/organizations.OrganizationService/GetOnboardingStatus",
+		"/organizations.OrganizationService/ResumeOnboarding",
+		"/organizations.OrganizationService/CancelOnboarding",
 	}
 }
 
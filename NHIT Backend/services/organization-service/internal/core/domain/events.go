@@ -31,3 +31,51 @@ func NewOrganizationCreatedEvent(tenantID, orgID, orgName, createdBy string, pro
 		CreatedBy: createdBy,
 	}
 }
+
+// Organization lifecycle event types. Consumers block logins and new
+// transactions for every org in OrgIDs while its status is not ACTIVE.
+const (
+	EventOrganizationDeactivated = "organization.deactivated"
+	EventOrganizationActivated   = "organization.activated"
+	EventOrganizationDeleted     = "organization.deleted"
+	EventOrganizationRestored    = "organization.restored"
+	EventOrganizationPurged      = "organization.purged"
+)
+
+// Organization lifecycle statuses carried by OrganizationStatusChangedEvent
+const (
+	OrganizationStatusActive      = "ACTIVE"
+	OrganizationStatusDeactivated = "DEACTIVATED"
+	OrganizationStatusDeleted     = "DELETED"
+	OrganizationStatusPurged      = "PURGED"
+)
+
+// OrganizationStatusChangedEvent represents an organization being deactivated,
+// activated, deleted, restored or purged. OrgIDs lists every organization the
+// change applied to, the organization itself included.
+type OrganizationStatusChangedEvent struct {
+	EventID   string    `json:"event_id"`
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+	TenantID  string    `json:"tenant_id"`
+	OrgID     string    `json:"org_id"`
+	OrgIDs    []string  `json:"org_ids"`
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason,omitempty"`
+	ChangedBy string    `json:"changed_by"`
+}
+
+// NewOrganizationStatusChangedEvent creates a new organization status changed event
+func NewOrganizationStatusChangedEvent(eventType, status, tenantID, orgID string, orgIDs []string, reason, changedBy string) *OrganizationStatusChangedEvent {
+	return &OrganizationStatusChangedEvent{
+		EventID:   uuid.New().String(),
+		EventType: eventType,
+		Timestamp: time.Now().UTC(),
+		TenantID:  tenantID,
+		OrgID:     orgID,
+		OrgIDs:    orgIDs,
+		Status:    status,
+		Reason:    reason,
+		ChangedBy: changedBy,
+	}
+}
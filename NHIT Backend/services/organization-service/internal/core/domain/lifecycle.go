@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrOrganizationDeleted    = errors.New("organization is deleted; restore it first")
+	ErrOrganizationNotDeleted = errors.New("organization is not deleted")
+	ErrParentInactive         = errors.New("parent organization is deactivated or deleted")
+	ErrHasLiveChildren        = errors.New("organization has child organizations that are not deleted")
+	ErrRetentionNotElapsed    = errors.New("organization is still within its retention window")
+	ErrHasDependencies        = errors.New("organization still has dependent records")
+)
+
+// DefaultRetention is how long a soft deleted organization is kept before it
+// can be purged
+const DefaultRetention = 30 * 24 * time.Hour
+
+// OrganizationDependency counts records of one kind that still reference an
+// organization. Blocking dependencies must be removed before a purge; the
+// others are removed with the organization.
+type OrganizationDependency struct {
+	Service  string
+	Resource string
+	Count    int64
+	Blocking bool
+}
+
+// CanPurge reports whether none of the dependencies block a purge
+func CanPurge(deps []OrganizationDependency) bool {
+	for _, d := range deps {
+		if d.Blocking && d.Count > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidOnboarding      = errors.New("tenant name, super admin name, email and password, and organization name and code are required")
+	ErrOnboardingNotFound     = errors.New("onboarding not found")
+	ErrOnboardingInProgress   = errors.New("an onboarding for this email is still in progress")
+	ErrOnboardingBusy         = errors.New("onboarding is being worked on; try again shortly")
+	ErrOnboardingNotResumable = errors.New("onboarding has finished and cannot be resumed or cancelled")
+	ErrOnboardingPassword     = errors.New("super admin password does not match the onboarding")
+	ErrOnboardingCodeTaken    = errors.New("organization code is already in use")
+	ErrOnboardingTenantExists = errors.New("a tenant is already registered with this email")
+	// ErrOnboardingTransient marks step failures worth retrying, such as an
+	// unreachable service; they pause the onboarding instead of rolling it back
+	ErrOnboardingTransient = errors.New("temporary failure")
+)
+
+// OnboardingLease is how long a running onboarding may go without progress
+// before it is treated as abandoned and can be resumed or cancelled
+const OnboardingLease = 5 * time.Minute
+
+type OnboardingStatus string
+
+const (
+	OnboardingRunning            OnboardingStatus = "RUNNING"
+	OnboardingPaused             OnboardingStatus = "PAUSED"
+	OnboardingCompleted          OnboardingStatus = "COMPLETED"
+	OnboardingCompensating       OnboardingStatus = "COMPENSATING"
+	OnboardingCompensated        OnboardingStatus = "COMPENSATED"
+	OnboardingCompensationFailed OnboardingStatus = "COMPENSATION_FAILED"
+)
+
+type OnboardingStep string
+
+const (
+	StepTenant            OnboardingStep = "TENANT"
+	StepOrganization      OnboardingStep = "ORGANIZATION"
+	StepSuperAdminRole    OnboardingStep = "SUPER_ADMIN_ROLE"
+	StepUser              OnboardingStep = "USER"
+	StepDepartments       OnboardingStep = "DEPARTMENTS"
+	StepDesignations      OnboardingStep = "DESIGNATIONS"
+	StepProjects          OnboardingStep = "PROJECTS"
+	StepApprovalTemplates OnboardingStep = "APPROVAL_TEMPLATES"
+)
+
+// OnboardingSteps lists the steps in the order they run; rollback runs them
+// in reverse
+var OnboardingSteps = []OnboardingStep{
+	StepTenant, StepOrganization, StepSuperAdminRole, StepUser,
+	StepDepartments, StepDesignations, StepProjects, StepApprovalTemplates,
+}
+
+type OnboardingStepStatus string
+
+const (
+	StepPending     OnboardingStepStatus = "PENDING"
+	StepRunning     OnboardingStepStatus = "RUNNING"
+	StepDone        OnboardingStepStatus = "DONE"
+	StepFailed      OnboardingStepStatus = "FAILED"
+	StepCompensated OnboardingStepStatus = "COMPENSATED"
+)
+
+// Defaults used when an onboarding names no departments or designations
+var (
+	DefaultDepartments  = []string{"Finance", "Accounts", "Administration", "Operations"}
+	DefaultDesignations = []string{"Director", "Manager", "Executive"}
+)
+
+// OnboardingRequest is what an onboarding was started with. The password is
+// never part of it.
+type OnboardingRequest struct {
+	TenantName      string         `json:"tenant_name"`
+	SuperAdminName  string         `json:"super_admin_name"`
+	SuperAdminEmail string         `json:"super_admin_email"`
+	OrgName         string         `json:"org_name"`
+	OrgCode         string         `json:"org_code"`
+	OrgDescription  string         `json:"org_description,omitempty"`
+	OrgLogo         string         `json:"org_logo,omitempty"`
+	Departments     []string       `json:"departments"`
+	Designations    []string       `json:"designations"`
+	Projects        []string       `json:"projects"`
+	ApprovalRules   *ApprovalRules `json:"approval_rules,omitempty"`
+}
+
+// Normalize trims the request, drops duplicate names, fills in the default
+// departments and designations and validates it
+func (r *OnboardingRequest) Normalize(password string) error {
+	r.TenantName = strings.TrimSpace(r.TenantName)
+	r.SuperAdminName = strings.TrimSpace(r.SuperAdminName)
+	r.SuperAdminEmail = strings.ToLower(strings.TrimSpace(r.SuperAdminEmail))
+	r.OrgName = strings.TrimSpace(r.OrgName)
+	r.OrgCode = strings.TrimSpace(r.OrgCode)
+	r.OrgDescription = strings.TrimSpace(r.OrgDescription)
+	r.OrgLogo = strings.TrimSpace(r.OrgLogo)
+	if r.TenantName == "" || r.SuperAdminName == "" || !strings.Contains(r.SuperAdminEmail, "@") ||
+		password == "" || r.OrgName == "" || r.OrgCode == "" {
+		return ErrInvalidOnboarding
+	}
+
+	r.Departments = uniqueNames(r.Departments)
+	if len(r.Departments) == 0 {
+		r.Departments = append([]string(nil), DefaultDepartments...)
+	}
+	r.Designations = uniqueNames(r.Designations)
+	if len(r.Designations) == 0 {
+		r.Designations = append([]string(nil), DefaultDesignations...)
+	}
+	r.Projects = uniqueNames(r.Projects)
+
+	if r.ApprovalRules != nil {
+		if err := r.ApprovalRules.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uniqueNames trims names and drops empty and case-insensitive duplicates
+func uniqueNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, name)
+	}
+	return out
+}
+
+// OnboardingStepState is the recorded state of one step. Output maps what
+// the step created to its ID, e.g. a department name to the department ID.
+type OnboardingStepState struct {
+	Step       OnboardingStep
+	Status     OnboardingStepStatus
+	Output     map[string]string
+	Attempts   int32
+	Error      string
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+// OnboardingSaga is an onboarding and the state of its steps, in order
+type OnboardingSaga struct {
+	SagaID           string
+	Status           OnboardingStatus
+	CurrentStep      OnboardingStep
+	Request          OnboardingRequest
+	PasswordHash     string
+	TenantID         string
+	OrgID            string
+	SuperAdminUserID string
+	RoleID           string
+	Error            string
+	Steps            []OnboardingStepState
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	CompletedAt      *time.Time
+}
+
+// NewOnboardingSaga creates a running onboarding with every step pending
+func NewOnboardingSaga(sagaID, orgID string, req OnboardingRequest, passwordHash string, now time.Time) *OnboardingSaga {
+	saga := &OnboardingSaga{
+		SagaID:       sagaID,
+		Status:       OnboardingRunning,
+		CurrentStep:  OnboardingSteps[0],
+		Request:      req,
+		PasswordHash: passwordHash,
+		OrgID:        orgID,
+		Steps:        make([]OnboardingStepState, 0, len(OnboardingSteps)),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	for _, step := range OnboardingSteps {
+		saga.Steps = append(saga.Steps, OnboardingStepState{Step: step, Status: StepPending, Output: map[string]string{}})
+	}
+	return saga
+}
+
+// Finished reports whether the onboarding completed or was rolled back
+func (s *OnboardingSaga) Finished() bool {
+	return s.Status == OnboardingCompleted || s.Status == OnboardingCompensated
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrOrganizationCycle    = errors.New("an organization cannot be moved under itself or one of its descendants")
+	ErrCrossTenantMove      = errors.New("an organization can only be moved under an organization of the same tenant")
+	ErrInvalidApprovalRules = errors.New("approval rules need at least one approver and a non-negative auto-approve amount")
+	ErrInvalidTDSDefaults   = errors.New("TDS rate override must be between 0 and 100")
+)
+
+// MaxHierarchyDepth bounds how far hierarchy queries walk up or down, so a
+// cycle left by bad data cannot make them loop forever
+const MaxHierarchyDepth = 32
+
+// SettingSection names a settings section that is inherited as a whole.
+// Password rules are not one: they are the tenant's password policy, kept and
+// enforced by auth-service.
+type SettingSection string
+
+const (
+	SettingApprovalRules SettingSection = "approval_rules"
+	SettingTDSDefaults   SettingSection = "tds_defaults"
+	SettingLogo          SettingSection = "logo"
+)
+
+// SettingSections lists every section in the order they are reported
+var SettingSections = []SettingSection{SettingApprovalRules, SettingTDSDefaults, SettingLogo}
+
+type ApprovalRules struct {
+	MinApprovers      int32   `json:"min_approvers"`
+	RequireSequential bool    `json:"require_sequential"`
+	AllowSelfApproval bool    `json:"allow_self_approval"`
+	AutoApproveBelow  float64 `json:"auto_approve_below"`
+}
+
+func (r *ApprovalRules) Validate() error {
+	if r.MinApprovers < 1 || r.AutoApproveBelow < 0 {
+		return ErrInvalidApprovalRules
+	}
+	return nil
+}
+
+type TDSDefaults struct {
+	DefaultSection string  `json:"default_section"`
+	AutoDeduct     bool    `json:"auto_deduct"`
+	RateOverride   float64 `json:"rate_override"`
+}
+
+func (t *TDSDefaults) Validate() error {
+	t.DefaultSection = strings.ToUpper(strings.TrimSpace(t.DefaultSection))
+	if t.RateOverride < 0 || t.RateOverride > 100 {
+		return ErrInvalidTDSDefaults
+	}
+	return nil
+}
+
+// OrganizationSettings are the sections an organization sets itself; nil
+// sections are inherited
+type OrganizationSettings struct {
+	OrgID         string
+	ApprovalRules *ApprovalRules
+	TDSDefaults   *TDSDefaults
+	Logo          *string
+}
+
+// Validate checks every section that is set
+func (s *OrganizationSettings) Validate() error {
+	if s.ApprovalRules != nil {
+		if err := s.ApprovalRules.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.TDSDefaults != nil {
+		if err := s.TDSDefaults.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge overrides the sections set in update and clears the sections listed
+// in inherit. A section cannot be both.
+func (s *OrganizationSettings) Merge(update OrganizationSettings, inherit []SettingSection) error {
+	for _, section := range inherit {
+		switch section {
+		case SettingApprovalRules:
+			if update.ApprovalRules != nil {
+				return fmt.Errorf("%s is both set and inherited", section)
+			}
+			s.ApprovalRules = nil
+		case SettingTDSDefaults:
+			if update.TDSDefaults != nil {
+				return fmt.Errorf("%s is both set and inherited", section)
+			}
+			s.TDSDefaults = nil
+		case SettingLogo:
+			if update.Logo != nil {
+				return fmt.Errorf("%s is both set and inherited", section)
+			}
+			s.Logo = nil
+		default:
+			return fmt.Errorf("unknown settings section %q", section)
+		}
+	}
+	if update.ApprovalRules != nil {
+		s.ApprovalRules = update.ApprovalRules
+	}
+	if update.TDSDefaults != nil {
+		s.TDSDefaults = update.TDSDefaults
+	}
+	if update.Logo != nil {
+		logo := strings.TrimSpace(*update.Logo)
+		s.Logo = &logo
+	}
+	return s.Validate()
+}
+
+// DefaultOrganizationSettings apply where no organization on the path sets a section
+func DefaultOrganizationSettings() OrganizationSettings {
+	logo := ""
+	return OrganizationSettings{
+		ApprovalRules: &ApprovalRules{
+			MinApprovers:      1,
+			RequireSequential: true,
+		},
+		TDSDefaults: &TDSDefaults{
+			AutoDeduct: true,
+		},
+		Logo: &logo,
+	}
+}
+
+// EffectiveSettings are the settings in force for an organization and the
+// organization each section came from, "" for the system default
+type EffectiveSettings struct {
+	OrganizationSettings
+	Sources map[SettingSection]string
+}
+
+// ResolveSettings resolves the settings of the last organization in path,
+// which runs from the top-level organization down. Each section comes from
+// the nearest organization that sets it.
+func ResolveSettings(path []OrganizationSettings) EffectiveSettings {
+	eff := EffectiveSettings{
+		OrganizationSettings: DefaultOrganizationSettings(),
+		Sources:              make(map[SettingSection]string, len(SettingSections)),
+	}
+	for _, section := range SettingSections {
+		eff.Sources[section] = ""
+	}
+	for _, s := range path {
+		if s.ApprovalRules != nil {
+			eff.ApprovalRules, eff.Sources[SettingApprovalRules] = s.ApprovalRules, s.OrgID
+		}
+		if s.TDSDefaults != nil {
+			eff.TDSDefaults, eff.Sources[SettingTDSDefaults] = s.TDSDefaults, s.OrgID
+		}
+		if s.Logo != nil && *s.Logo != "" {
+			eff.Logo, eff.Sources[SettingLogo] = s.Logo, s.OrgID
+		}
+	}
+	if len(path) > 0 {
+		eff.OrgID = path[len(path)-1].OrgID
+	}
+	return eff
+}
@@ -0,0 +1,40 @@
+package ports
+
+import "context"
+
+// OnboardingSession is the super admin's sign-in used for the onboarding
+// steps that need an authenticated caller
+type OnboardingSession struct {
+	Token        string
+	RefreshToken string
+	UserID       string
+	TenantID     string
+	OrgID        string
+}
+
+// OnboardingGateway reaches the services an onboarding creates records in.
+// Failures worth retrying wrap domain.ErrOnboardingTransient. Deletes of
+// records that are already gone succeed.
+type OnboardingGateway interface {
+	// CreateTenant creates the tenant with its super admin user and role
+	CreateTenant(ctx context.Context, name, email, password string) (string, error)
+	// DeleteTenant removes a tenant that no longer owns organizations or members
+	DeleteTenant(ctx context.Context, tenantID, password string) error
+
+	SignIn(ctx context.Context, email, password, tenantID, orgID string) (OnboardingSession, error)
+	SignOut(ctx context.Context, session OnboardingSession) error
+	// SuperAdminRoleID returns the ID of the signed in user's SUPER_ADMIN role
+	SuperAdminRoleID(ctx context.Context, session OnboardingSession) (string, error)
+
+	AddMember(ctx context.Context, session OnboardingSession, roleID string) error
+	RemoveMember(ctx context.Context, session OnboardingSession) error
+
+	// Create methods return the ID of the record, adopting one the
+	// organization already has under that name
+	CreateDepartment(ctx context.Context, session OnboardingSession, name string) (string, error)
+	DeleteDepartment(ctx context.Context, session OnboardingSession, departmentID string) error
+	CreateDesignation(ctx context.Context, session OnboardingSession, name string) (string, error)
+	DeleteDesignation(ctx context.Context, session OnboardingSession, designationID string) error
+	CreateProject(ctx context.Context, session OnboardingSession, name, createdBy string) (string, error)
+	DeleteProject(ctx context.Context, session OnboardingSession, projectID string) error
+}
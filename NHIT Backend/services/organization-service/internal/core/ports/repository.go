@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
 )
 
 // ErrNotFound indicates the requested organization record does not exist.
@@ -33,6 +34,20 @@ type OrganizationModel struct {
 	Status    pb.OrganizationStatus
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	// Lifecycle
+	StatusReason   *string
+	DeactivatedAt  *time.Time
+	DeactivatedVia *string
+	DeletedAt      *time.Time
+	DeletedBy      *string
+	PurgeAfter     *time.Time
+}
+
+// OrganizationDependents counts what still references an organization in this service
+type OrganizationDependents struct {
+	Children int64
+	Members  int64
 }
 
 // Pagination metadata returned by repository
@@ -70,7 +85,75 @@ type Repository interface {
 	UpdateOrganization(ctx context.Context, org OrganizationModel) (OrganizationModel, error)
 
 	// ================
-	// DELETE
+	// LIFECYCLE
+	// ================
+	// DeactivateOrganization deactivates the organization and every active
+	// organization below it, returning the IDs it deactivated
+	DeactivateOrganization(ctx context.Context, orgID, reason string) ([]string, error)
+	// ActivateOrganization activates the organization and the organizations
+	// below it that its deactivation deactivated, returning the IDs it activated
+	ActivateOrganization(ctx context.Context, orgID, reason string) ([]string, error)
+	// UpdateOrganizationStatus saves edits to the organization and, in the same
+	// transaction, moves it to org.Status the way Deactivate/ActivateOrganization
+	// do, returning the saved organization and the IDs whose status changed
+	UpdateOrganizationStatus(ctx context.Context, org OrganizationModel, reason string) (OrganizationModel, []string, error)
+	// SoftDeleteOrganization marks a live organization deleted and deactivated
+	// until purgeAfter; ports.ErrNotFound if it is missing or already deleted
+	SoftDeleteOrganization(ctx context.Context, orgID, deletedBy, reason string, purgeAfter time.Time) (OrganizationModel, error)
+	// RestoreOrganization brings back a soft deleted organization. With
+	// reactivate it is activated again if it was active when deleted.
+	// ports.ErrNotFound if it is not deleted.
+	RestoreOrganization(ctx context.Context, orgID, reason string, reactivate bool) (OrganizationModel, error)
+	// CountOrganizationDependents counts child organizations, deleted ones
+	// included, and members
+	CountOrganizationDependents(ctx context.Context, orgID string) (OrganizationDependents, error)
+	// PurgeOrganization removes the organization row for good
+	PurgeOrganization(ctx context.Context, orgID string) error
+
+	// ================
+	// HIERARCHY
+	// ================
+	// ListOrganizationSubtree returns the organization and everything below it, by depth
+	ListOrganizationSubtree(ctx context.Context, orgID string) ([]OrganizationModel, error)
+	// ListOrganizationAncestors returns the organization's ancestors, top-level organization first
+	ListOrganizationAncestors(ctx context.Context, orgID string) ([]OrganizationModel, error)
+	// MoveOrganization re-parents an organization, rejecting moves across
+	// tenants (domain.ErrCrossTenantMove) and into its own subtree (domain.ErrOrganizationCycle)
+	MoveOrganization(ctx context.Context, orgID, newParentOrgID string) (OrganizationModel, error)
+
+	// ================
+	// SETTINGS
+	// ================
+	// GetOrganizationSettings returns the sections the organization overrides, logo included
+	GetOrganizationSettings(ctx context.Context, orgID string) (domain.OrganizationSettings, error)
+	// ListOrganizationSettingsPath returns the overridden sections of the
+	// organization's ancestors and the organization itself, top-level organization first
+	ListOrganizationSettingsPath(ctx context.Context, orgID string) ([]domain.OrganizationSettings, error)
+	SaveOrganizationSettings(ctx context.Context, settings domain.OrganizationSettings, updatedBy string) error
+
 	// ================
-	DeleteOrganization(ctx context.Context, orgID string) error
+	// ONBOARDING
+	// ================
+	// CreateOnboarding stores a new onboarding with its steps;
+	// domain.ErrOnboardingInProgress if the email has an unfinished one
+	CreateOnboarding(ctx context.Context, saga *domain.OnboardingSaga) error
+	// GetOnboarding returns the onboarding with its steps; domain.ErrOnboardingNotFound if missing
+	GetOnboarding(ctx context.Context, sagaID string) (*domain.OnboardingSaga, error)
+	// ClaimOnboarding moves an onboarding in one of the from statuses to the
+	// to status. Running and compensating onboardings are only claimed once
+	// they made no progress since staleBefore. Reports whether it was claimed.
+	ClaimOnboarding(ctx context.Context, sagaID string, from []domain.OnboardingStatus, to domain.OnboardingStatus, staleBefore time.Time) (bool, error)
+	// SaveOnboarding saves the onboarding's status and the IDs it collected
+	SaveOnboarding(ctx context.Context, saga *domain.OnboardingSaga) error
+	// SaveOnboardingStep saves one step and counts as progress of the onboarding
+	SaveOnboardingStep(ctx context.Context, sagaID string, step domain.OnboardingStepState) error
+	// FindTenantByEmail returns the tenant whose super admin has this email, "" if none
+	FindTenantByEmail(ctx context.Context, email string) (string, error)
+}
+
+// DependencyChecker counts records other services still hold for an organization
+type DependencyChecker interface {
+	// CountDependencies returns one entry per kind of record; it fails if any
+	// service cannot be asked, so a purge never goes ahead on partial answers
+	CountDependencies(ctx context.Context, orgID string) ([]domain.OrganizationDependency, error)
 }
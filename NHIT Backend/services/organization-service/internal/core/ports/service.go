@@ -41,3 +41,18 @@ type OrganizationService interface {
 	// Delete
 	DeleteOrganization(ctx context.Context, orgID string) error
 }
+
+// OnboardingService runs a customer onboarding as a saga: tenant,
+// organization, super admin, departments, designations, projects and
+// approval templates, rolled back in reverse if a step fails for good
+type OnboardingService interface {
+	// StartOnboarding records the onboarding and runs it until it completes,
+	// pauses on a temporary failure or is rolled back
+	StartOnboarding(ctx context.Context, req domain.OnboardingRequest, password string) (*domain.OnboardingSaga, error)
+	GetOnboarding(ctx context.Context, sagaID string) (*domain.OnboardingSaga, error)
+	// ResumeOnboarding continues a paused or abandoned onboarding, or retries
+	// a rollback that failed
+	ResumeOnboarding(ctx context.Context, sagaID, password string) (*domain.OnboardingSaga, error)
+	// CancelOnboarding rolls back an onboarding that has not completed
+	CancelOnboarding(ctx context.Context, sagaID, password string) (*domain.OnboardingSaga, error)
+}
@@ -0,0 +1,507 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/organization-service/internal/core/ports"
+)
+
+// DefaultOnboardingTimeout bounds one run of an onboarding, forward or rollback
+const DefaultOnboardingTimeout = 2 * time.Minute
+
+// onboardingService runs onboardings step by step, recording each step
+// before and after it runs so a crashed or paused onboarding can be resumed
+// and a failed one rolled back from what was recorded
+type onboardingService struct {
+	repo    ports.Repository
+	gateway ports.OnboardingGateway
+	timeout time.Duration
+	logger  *log.Logger
+}
+
+// NewOnboardingService creates the onboarding saga service
+func NewOnboardingService(repo ports.Repository, gateway ports.OnboardingGateway, timeout time.Duration) ports.OnboardingService {
+	if timeout <= 0 {
+		timeout = DefaultOnboardingTimeout
+	}
+	return &onboardingService{repo: repo, gateway: gateway, timeout: timeout, logger: log.Default()}
+}
+
+// onboardingRun carries what one run needs but never stores: the super
+// admin password and their sign-in
+type onboardingRun struct {
+	saga     *domain.OnboardingSaga
+	password string
+	session  *ports.OnboardingSession
+}
+
+func (s *onboardingService) StartOnboarding(ctx context.Context, req domain.OnboardingRequest, password string) (*domain.OnboardingSaga, error) {
+	if err := req.Normalize(password); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetOrganizationByCode(ctx, req.OrgCode); err == nil {
+		return nil, domain.ErrOnboardingCodeTaken
+	}
+	tenantID, err := s.repo.FindTenantByEmail(ctx, req.SuperAdminEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check tenant: %w", err)
+	}
+	if tenantID != "" {
+		return nil, domain.ErrOnboardingTenantExists
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	saga := domain.NewOnboardingSaga(uuid.New().String(), uuid.New().String(), req, string(hash), time.Now().UTC())
+	if err := s.repo.CreateOnboarding(ctx, saga); err != nil {
+		return nil, err
+	}
+	s.logger.Printf("🚀 Onboarding %s started for %s (%s)", saga.SagaID, req.OrgName, req.SuperAdminEmail)
+
+	ctx, cancel := s.runContext(ctx)
+	defer cancel()
+	run := &onboardingRun{saga: saga, password: password}
+	err = s.forward(ctx, run)
+	return saga, err
+}
+
+func (s *onboardingService) GetOnboarding(ctx context.Context, sagaID string) (*domain.OnboardingSaga, error) {
+	return s.repo.GetOnboarding(ctx, sagaID)
+}
+
+func (s *onboardingService) ResumeOnboarding(ctx context.Context, sagaID, password string) (*domain.OnboardingSaga, error) {
+	saga, err := s.authorize(ctx, sagaID, password)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := saga.Status == domain.OnboardingCompensating || saga.Status == domain.OnboardingCompensationFailed
+	from := []domain.OnboardingStatus{domain.OnboardingPaused, domain.OnboardingRunning}
+	to := domain.OnboardingRunning
+	if rollback {
+		from = []domain.OnboardingStatus{domain.OnboardingCompensating, domain.OnboardingCompensationFailed}
+		to = domain.OnboardingCompensating
+	}
+	if err := s.claim(ctx, saga, from, to); err != nil {
+		return nil, err
+	}
+	s.logger.Printf("🔁 Onboarding %s resumed (%s)", saga.SagaID, to)
+
+	ctx, cancel := s.runContext(ctx)
+	defer cancel()
+	run := &onboardingRun{saga: saga, password: password}
+	if rollback {
+		err = s.compensate(ctx, run)
+	} else {
+		err = s.forward(ctx, run)
+	}
+	return saga, err
+}
+
+func (s *onboardingService) CancelOnboarding(ctx context.Context, sagaID, password string) (*domain.OnboardingSaga, error) {
+	saga, err := s.authorize(ctx, sagaID, password)
+	if err != nil {
+		return nil, err
+	}
+	from := []domain.OnboardingStatus{
+		domain.OnboardingPaused, domain.OnboardingRunning,
+		domain.OnboardingCompensating, domain.OnboardingCompensationFailed,
+	}
+	if err := s.claim(ctx, saga, from, domain.OnboardingCompensating); err != nil {
+		return nil, err
+	}
+	saga.Error = "cancelled"
+	s.logger.Printf("🛑 Onboarding %s cancelled, rolling back", saga.SagaID)
+
+	ctx, cancel := s.runContext(ctx)
+	defer cancel()
+	err = s.compensate(ctx, &onboardingRun{saga: saga, password: password})
+	return saga, err
+}
+
+// authorize loads an unfinished onboarding and checks the super admin password
+func (s *onboardingService) authorize(ctx context.Context, sagaID, password string) (*domain.OnboardingSaga, error) {
+	saga, err := s.repo.GetOnboarding(ctx, sagaID)
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(saga.PasswordHash), []byte(password)) != nil {
+		return nil, domain.ErrOnboardingPassword
+	}
+	if saga.Finished() {
+		return nil, domain.ErrOnboardingNotResumable
+	}
+	return saga, nil
+}
+
+// claim takes the onboarding over so no other run works on it at the same time
+func (s *onboardingService) claim(ctx context.Context, saga *domain.OnboardingSaga, from []domain.OnboardingStatus, to domain.OnboardingStatus) error {
+	claimed, err := s.repo.ClaimOnboarding(ctx, saga.SagaID, from, to, time.Now().UTC().Add(-domain.OnboardingLease))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return domain.ErrOnboardingBusy
+	}
+	saga.Status = to
+	return nil
+}
+
+// runContext detaches a run from the caller so a dropped request does not
+// stop it half way, while still bounding how long it may take
+func (s *onboardingService) runContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), s.timeout)
+}
+
+// forward runs the steps not done yet. A temporary failure pauses the
+// onboarding; any other failure rolls it back.
+func (s *onboardingService) forward(ctx context.Context, run *onboardingRun) error {
+	saga := run.saga
+	defer s.signOut(ctx, run)
+
+	for i := range saga.Steps {
+		step := &saga.Steps[i]
+		if step.Status == domain.StepDone {
+			continue
+		}
+		started := time.Now().UTC()
+		saga.CurrentStep = step.Step
+		step.Status = domain.StepRunning
+		step.Attempts++
+		step.Error = ""
+		step.StartedAt = &started
+		step.FinishedAt = nil
+		if err := s.repo.SaveOnboardingStep(ctx, saga.SagaID, *step); err != nil {
+			return err
+		}
+
+		stepErr := s.runStep(ctx, run, step)
+		finished := time.Now().UTC()
+		step.FinishedAt = &finished
+		if stepErr != nil {
+			step.Status = domain.StepFailed
+			step.Error = stepErr.Error()
+			if err := s.repo.SaveOnboardingStep(ctx, saga.SagaID, *step); err != nil {
+				return err
+			}
+			saga.Error = fmt.Sprintf("%s: %s", step.Step, stepErr)
+			if errors.Is(stepErr, domain.ErrOnboardingTransient) {
+				saga.Status = domain.OnboardingPaused
+				s.logger.Printf("⏸️ Onboarding %s paused at %s: %v", saga.SagaID, step.Step, stepErr)
+				return s.repo.SaveOnboarding(ctx, saga)
+			}
+			s.logger.Printf("❌ Onboarding %s failed at %s, rolling back: %v", saga.SagaID, step.Step, stepErr)
+			saga.Status = domain.OnboardingCompensating
+			if err := s.repo.SaveOnboarding(ctx, saga); err != nil {
+				return err
+			}
+			return s.compensate(ctx, run)
+		}
+
+		step.Status = domain.StepDone
+		if err := s.repo.SaveOnboardingStep(ctx, saga.SagaID, *step); err != nil {
+			return err
+		}
+		if err := s.repo.SaveOnboarding(ctx, saga); err != nil {
+			return err
+		}
+	}
+
+	completed := time.Now().UTC()
+	saga.Status = domain.OnboardingCompleted
+	saga.Error = ""
+	saga.CompletedAt = &completed
+	if err := s.repo.SaveOnboarding(ctx, saga); err != nil {
+		return err
+	}
+	s.logger.Printf("✅ Onboarding %s completed: tenant %s, organization %s", saga.SagaID, saga.TenantID, saga.OrgID)
+	return nil
+}
+
+func (s *onboardingService) runStep(ctx context.Context, run *onboardingRun, step *domain.OnboardingStepState) error {
+	saga := run.saga
+	req := saga.Request
+
+	switch step.Step {
+	case domain.StepTenant:
+		// A retry first looks for the tenant an earlier attempt created
+		tenantID := ""
+		if step.Attempts > 1 {
+			found, err := s.repo.FindTenantByEmail(ctx, req.SuperAdminEmail)
+			if err != nil {
+				return fmt.Errorf("%w: %v", domain.ErrOnboardingTransient, err)
+			}
+			tenantID = found
+		}
+		if tenantID == "" {
+			created, err := s.gateway.CreateTenant(ctx, req.TenantName, req.SuperAdminEmail, run.password)
+			if err != nil {
+				return err
+			}
+			tenantID = created
+		}
+		saga.TenantID = tenantID
+		step.Output["tenant_id"] = tenantID
+
+	case domain.StepOrganization:
+		if _, err := s.repo.GetOrganizationByID(ctx, saga.OrgID); err != nil {
+			now := time.Now().UTC()
+			_, err := s.repo.CreateOrganization(ctx, ports.OrganizationModel{
+				OrgID:           saga.OrgID,
+				TenantID:        saga.TenantID,
+				Name:            req.OrgName,
+				Code:            req.OrgCode,
+				DatabaseName:    fmt.Sprintf("%s_db", req.OrgCode),
+				Description:     optional(req.OrgDescription),
+				Logo:            optional(req.OrgLogo),
+				SuperAdminName:  optional(req.SuperAdminName),
+				SuperAdminEmail: optional(req.SuperAdminEmail),
+				InitialProjects: req.Projects,
+				Status:          pb.OrganizationStatus_activated,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create organization: %w", err)
+			}
+		}
+		step.Output["org_id"] = saga.OrgID
+
+	case domain.StepSuperAdminRole:
+		// CreateTenant already created the SUPER_ADMIN role and granted it;
+		// this step finds it for the membership
+		session, err := s.session(ctx, run)
+		if err != nil {
+			return err
+		}
+		roleID, err := s.gateway.SuperAdminRoleID(ctx, *session)
+		if err != nil {
+			return err
+		}
+		saga.SuperAdminUserID = session.UserID
+		saga.RoleID = roleID
+		step.Output["user_id"] = session.UserID
+		step.Output["role_id"] = roleID
+
+	case domain.StepUser:
+		session, err := s.session(ctx, run)
+		if err != nil {
+			return err
+		}
+		if err := s.gateway.AddMember(ctx, *session, saga.RoleID); err != nil {
+			return err
+		}
+		step.Output["user_id"] = session.UserID
+
+	case domain.StepDepartments:
+		return s.createEach(ctx, run, step, req.Departments, s.gateway.CreateDepartment)
+
+	case domain.StepDesignations:
+		return s.createEach(ctx, run, step, req.Designations, s.gateway.CreateDesignation)
+
+	case domain.StepProjects:
+		return s.createEach(ctx, run, step, req.Projects,
+			func(ctx context.Context, session ports.OnboardingSession, name string) (string, error) {
+				return s.gateway.CreateProject(ctx, session, name, req.TenantName)
+			})
+
+	case domain.StepApprovalTemplates:
+		rules := req.ApprovalRules
+		if rules == nil {
+			rules = domain.DefaultOrganizationSettings().ApprovalRules
+		}
+		settings, err := s.repo.GetOrganizationSettings(ctx, saga.OrgID)
+		if err != nil {
+			return fmt.Errorf("failed to load organization settings: %w", err)
+		}
+		settings.OrgID = saga.OrgID
+		settings.ApprovalRules = rules
+		if err := s.repo.SaveOrganizationSettings(ctx, settings, saga.SuperAdminUserID); err != nil {
+			return fmt.Errorf("failed to save approval rules: %w", err)
+		}
+
+	default:
+		return fmt.Errorf("unknown onboarding step %s", step.Step)
+	}
+	return nil
+}
+
+// createEach creates the named records one by one, saving the step after
+// each so a retry or rollback knows exactly what exists
+func (s *onboardingService) createEach(ctx context.Context, run *onboardingRun, step *domain.OnboardingStepState, names []string,
+	create func(ctx context.Context, session ports.OnboardingSession, name string) (string, error)) error {
+	session, err := s.session(ctx, run)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if step.Output[name] != "" {
+			continue
+		}
+		id, err := create(ctx, *session, name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		step.Output[name] = id
+		if err := s.repo.SaveOnboardingStep(ctx, run.saga.SagaID, *step); err != nil {
+			return fmt.Errorf("%w: %v", domain.ErrOnboardingTransient, err)
+		}
+	}
+	return nil
+}
+
+// compensate undoes, in reverse order, every step that ran. It stops at the
+// first undo that fails so nothing is removed from under a record that
+// still exists; resuming retries from there.
+func (s *onboardingService) compensate(ctx context.Context, run *onboardingRun) error {
+	saga := run.saga
+	defer s.signOut(ctx, run)
+
+	for i := len(saga.Steps) - 1; i >= 0; i-- {
+		step := &saga.Steps[i]
+		if step.Status == domain.StepPending || step.Status == domain.StepCompensated {
+			continue
+		}
+		saga.CurrentStep = step.Step
+		if err := s.undoStep(ctx, run, step); err != nil {
+			step.Error = fmt.Sprintf("rollback: %v", err)
+			if saveErr := s.repo.SaveOnboardingStep(ctx, saga.SagaID, *step); saveErr != nil {
+				return saveErr
+			}
+			saga.Status = domain.OnboardingCompensationFailed
+			saga.Error = fmt.Sprintf("rollback of %s failed: %v", step.Step, err)
+			s.logger.Printf("❌ Onboarding %s rollback failed at %s: %v", saga.SagaID, step.Step, err)
+			return s.repo.SaveOnboarding(ctx, saga)
+		}
+		finished := time.Now().UTC()
+		step.Status = domain.StepCompensated
+		step.FinishedAt = &finished
+		if err := s.repo.SaveOnboardingStep(ctx, saga.SagaID, *step); err != nil {
+			return err
+		}
+	}
+
+	completed := time.Now().UTC()
+	saga.Status = domain.OnboardingCompensated
+	saga.CompletedAt = &completed
+	if err := s.repo.SaveOnboarding(ctx, saga); err != nil {
+		return err
+	}
+	s.logger.Printf("↩️ Onboarding %s rolled back", saga.SagaID)
+	return nil
+}
+
+func (s *onboardingService) undoStep(ctx context.Context, run *onboardingRun, step *domain.OnboardingStepState) error {
+	saga := run.saga
+
+	switch step.Step {
+	case domain.StepProjects:
+		return s.deleteEach(ctx, run, step, s.gateway.DeleteProject)
+
+	case domain.StepDesignations:
+		return s.deleteEach(ctx, run, step, s.gateway.DeleteDesignation)
+
+	case domain.StepDepartments:
+		return s.deleteEach(ctx, run, step, s.gateway.DeleteDepartment)
+
+	case domain.StepUser:
+		session, err := s.session(ctx, run)
+		if err != nil {
+			return err
+		}
+		if err := s.gateway.RemoveMember(ctx, *session); err != nil {
+			return err
+		}
+		// Removing the membership ends the super admin's sessions
+		run.session = nil
+
+	case domain.StepOrganization:
+		// Members and settings go with the organization
+		if err := s.repo.PurgeOrganization(ctx, saga.OrgID); err != nil {
+			return fmt.Errorf("failed to remove organization: %w", err)
+		}
+
+	case domain.StepTenant:
+		tenantID := saga.TenantID
+		if tenantID == "" {
+			found, err := s.repo.FindTenantByEmail(ctx, saga.Request.SuperAdminEmail)
+			if err != nil {
+				return err
+			}
+			tenantID = found
+		}
+		if tenantID != "" {
+			if err := s.gateway.DeleteTenant(ctx, tenantID, run.password); err != nil {
+				return err
+			}
+		}
+
+	case domain.StepSuperAdminRole, domain.StepApprovalTemplates:
+		// The role goes with the tenant and the settings with the organization
+	}
+	return nil
+}
+
+// deleteEach removes the records a step created, saving the step after each
+func (s *onboardingService) deleteEach(ctx context.Context, run *onboardingRun, step *domain.OnboardingStepState,
+	remove func(ctx context.Context, session ports.OnboardingSession, id string) error) error {
+	if len(step.Output) == 0 {
+		return nil
+	}
+	session, err := s.session(ctx, run)
+	if err != nil {
+		return err
+	}
+	for name, id := range step.Output {
+		if err := remove(ctx, *session, id); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		delete(step.Output, name)
+		if err := s.repo.SaveOnboardingStep(ctx, run.saga.SagaID, *step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// session signs the super admin in to the new organization once per run
+func (s *onboardingService) session(ctx context.Context, run *onboardingRun) (*ports.OnboardingSession, error) {
+	if run.session != nil {
+		return run.session, nil
+	}
+	saga := run.saga
+	session, err := s.gateway.SignIn(ctx, saga.Request.SuperAdminEmail, run.password, saga.TenantID, saga.OrgID)
+	if err != nil {
+		return nil, fmt.Errorf("sign in as super admin: %w", err)
+	}
+	run.session = &session
+	return run.session, nil
+}
+
+func (s *onboardingService) signOut(ctx context.Context, run *onboardingRun) {
+	if run.session == nil {
+		return
+	}
+	if err := s.gateway.SignOut(ctx, *run.session); err != nil {
+		s.logger.Printf("⚠️ Failed to sign out of onboarding %s: %v", run.saga.SagaID, err)
+	}
+	run.session = nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
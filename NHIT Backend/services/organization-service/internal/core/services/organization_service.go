@@ -363,7 +363,7 @@ func (s *Service) UpdateOrganization(ctx context.Context, in ports.OrganizationM
 	return updated, nil
 }
 
-// DeleteOrganization implements deletion with validation.
+// DeleteOrganization soft deletes an organization with the default retention window.
 func (s *Service) DeleteOrganization(ctx context.Context, orgID string) error {
 	if strings.TrimSpace(orgID) == "" {
 		return fmt.Errorf("%w: org_id required", ErrValidation)
@@ -382,7 +382,7 @@ func (s *Service) DeleteOrganization(ctx context.Context, orgID string) error {
 		return fmt.Errorf("%w: cannot delete organization with child organizations", ErrValidation)
 	}
 
-	if err := s.repo.DeleteOrganization(ctx, orgID); err != nil {
+	if _, err := s.repo.SoftDeleteOrganization(ctx, orgID, "", "", time.Now().UTC().Add(domain.DefaultRetention)); err != nil {
 		s.logger.Printf("DeleteOrganization: repository delete error: %v", err)
 		return fmt.Errorf("failed to delete organization: %w", err)
 	}
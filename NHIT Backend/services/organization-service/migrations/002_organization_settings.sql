@@ -0,0 +1,38 @@
+-- ================================================
+-- Organization Settings Table
+-- ================================================
+-- One row per organization that overrides any settings section. A NULL
+-- section is inherited from the nearest ancestor that sets it. The logo
+-- section lives in organizations.logo. Password rules are the tenant's
+-- password policy in auth-service, not an organization setting.
+
+CREATE TABLE IF NOT EXISTS organization_settings (
+    org_id UUID PRIMARY KEY,
+
+    approval_rules JSONB,
+    tds_defaults JSONB,
+
+    updated_by VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (org_id) REFERENCES organizations(org_id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_organization_settings_updated_at
+    BEFORE UPDATE ON organization_settings
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Organizations cannot be their own parent; longer cycles are rejected by
+-- MoveOrganization
+ALTER TABLE organizations
+    ADD CONSTRAINT chk_organizations_not_own_parent CHECK (parent_org_id IS NULL OR parent_org_id <> org_id);
+
+-- ================================================
+-- Documentation Comments
+-- ================================================
+
+COMMENT ON TABLE organization_settings IS 'Settings sections an organization overrides; NULL sections are inherited from its ancestors.';
+
+COMMENT ON COLUMN organization_settings.approval_rules IS 'ApprovalRules as JSON; NULL inherits.';
+COMMENT ON COLUMN organization_settings.tds_defaults IS 'TdsDefaults as JSON; NULL inherits.';
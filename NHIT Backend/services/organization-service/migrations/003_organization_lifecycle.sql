@@ -0,0 +1,27 @@
+-- ================================================
+-- Organization Deactivation and Soft Delete
+-- ================================================
+
+ALTER TABLE organizations
+    ADD COLUMN IF NOT EXISTS status_reason TEXT,
+    ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS deactivated_via UUID,
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS deleted_by VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS purge_after TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_organizations_deleted_at ON organizations(deleted_at)
+    WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_organizations_deactivated_via ON organizations(deactivated_via)
+    WHERE deactivated_via IS NOT NULL;
+
+-- ================================================
+-- Documentation Comments
+-- ================================================
+
+COMMENT ON COLUMN organizations.status_reason IS 'Why the organization was last deactivated, deleted or restored.';
+COMMENT ON COLUMN organizations.deactivated_at IS 'When the organization was deactivated; NULL while activated.';
+COMMENT ON COLUMN organizations.deactivated_via IS 'Organization whose deactivation or deletion deactivated this one; activating that organization reactivates this one.';
+COMMENT ON COLUMN organizations.deleted_at IS 'Soft delete time; NULL for live organizations.';
+COMMENT ON COLUMN organizations.deleted_by IS 'User who soft deleted the organization.';
+COMMENT ON COLUMN organizations.purge_after IS 'End of the retention window; the organization can be purged after this time.';
@@ -0,0 +1,62 @@
+-- ================================================
+-- Onboarding Sagas
+-- ================================================
+-- One row per onboarding and one per step. Steps record the IDs they
+-- created in output so a resumed onboarding skips finished work and a
+-- rollback knows what to remove. The super admin password is only kept as
+-- a bcrypt hash, to check the password given on resume or cancel.
+
+CREATE TABLE IF NOT EXISTS onboarding_sagas (
+    saga_id UUID PRIMARY KEY,
+    status VARCHAR(30) NOT NULL CHECK (status IN (
+        'RUNNING', 'PAUSED', 'COMPLETED', 'COMPENSATING', 'COMPENSATED', 'COMPENSATION_FAILED'
+    )),
+    current_step VARCHAR(30) NOT NULL,
+
+    request JSONB NOT NULL,
+    super_admin_email VARCHAR(255) NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+
+    tenant_id UUID,
+    org_id UUID NOT NULL,
+    super_admin_user_id UUID,
+    role_id UUID,
+
+    error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+-- Only one unfinished onboarding per super admin email
+CREATE UNIQUE INDEX IF NOT EXISTS idx_onboarding_sagas_open_email ON onboarding_sagas(LOWER(super_admin_email))
+    WHERE status NOT IN ('COMPLETED', 'COMPENSATED');
+
+CREATE TABLE IF NOT EXISTS onboarding_saga_steps (
+    saga_id UUID NOT NULL REFERENCES onboarding_sagas(saga_id) ON DELETE CASCADE,
+    step VARCHAR(30) NOT NULL,
+    position INT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'DONE', 'FAILED', 'COMPENSATED')),
+    output JSONB NOT NULL DEFAULT '{}',
+    attempts INT NOT NULL DEFAULT 0,
+    error TEXT,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    PRIMARY KEY (saga_id, step)
+);
+
+CREATE TRIGGER update_onboarding_sagas_updated_at
+    BEFORE UPDATE ON onboarding_sagas
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- ================================================
+-- Documentation Comments
+-- ================================================
+
+COMMENT ON TABLE onboarding_sagas IS 'Customer onboardings run as a saga across user, organization, department, designation and project services.';
+COMMENT ON TABLE onboarding_saga_steps IS 'State of each onboarding step; output holds the IDs the step created.';
+
+COMMENT ON COLUMN onboarding_sagas.request IS 'OnboardingRequest as JSON, without the password.';
+COMMENT ON COLUMN onboarding_sagas.password_hash IS 'bcrypt hash of the super admin password, checked on resume and cancel.';
+COMMENT ON COLUMN onboarding_sagas.org_id IS 'ID the organization is created with, chosen up front so a retried step finds it.';
+COMMENT ON COLUMN onboarding_sagas.current_step IS 'Step running, paused on or rolled back last.';
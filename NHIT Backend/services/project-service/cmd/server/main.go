@@ -89,6 +89,16 @@ func main() {
 		}
 	}()
 
+	// Approval events drive the budget commitment ledger
+	approvalTopic := getEnv("KAFKA_TOPIC_APPROVAL_EVENTS", "approval_events")
+	approvalConsumer := kafkaAdapter.NewApprovalEventConsumer(kafkaBrokers, approvalTopic, "project-service-budget-group", nil)
+	defer approvalConsumer.Close()
+	go func() {
+		if err := projectService.StartApprovalEventConsumer(context.Background(), approvalConsumer); err != nil {
+			log.Printf("⚠️ Approval event consumer error: %v", err)
+		}
+	}()
+
 	log.Println("✅ Service components initialized")
 
 	// Start gRPC server
@@ -5,7 +5,9 @@ go 1.24.2
 require (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/money v0.0.0
 	github.com/google/uuid v1.6.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3
 	github.com/jackc/pgx/v5 v5.7.6
@@ -33,5 +35,7 @@ require (
 replace (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb => ../../api/pb/authpb
 	github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb => ../../api/pb/projectpb
+	github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer => ../../pkg/eventconsumer
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware => ../../pkg/middleware
+	github.com/ShristiRnr/NHIT_Backend/pkg/money => ../../pkg/money
 )
@@ -2,7 +2,10 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -10,6 +13,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/ports"
 )
@@ -37,6 +43,9 @@ func (h *projectHandler) GetProject(ctx context.Context, req *pb.GetProjectReque
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
 	}
+	if !middleware.Can(ctx, projectResource(project), "view-projects") {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions on project")
+	}
 
 	return &pb.GetProjectResponse{
 		Project: toProtoProject(project),
@@ -62,8 +71,17 @@ func (h *projectHandler) CreateProject(ctx context.Context, req *pb.CreateProjec
 		return nil, status.Error(codes.InvalidArgument, "project name is required")
 	}
 
-	project, err := h.service.CreateProject(ctx, tenantID, orgID, req.ProjectName, req.CreatedBy)
+	details := domain.ProjectDetails{
+		ProjectCode: &req.ProjectCode,
+		Description: &req.Description,
+		StartDate:   &req.StartDate,
+		EndDate:     &req.EndDate,
+	}
+	project, err := h.service.CreateProject(ctx, tenantID, orgID, req.ProjectName, req.CreatedBy, details)
 	if err != nil {
+		if code := projectErrorCode(err); code != codes.Internal {
+			return nil, status.Error(code, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create project: %v", err)
 	}
 
@@ -81,6 +99,9 @@ func (h *projectHandler) ListProjectsByOrganization(ctx context.Context, req *pb
 		fmt.Printf("DEBUG PROJECT HANDLER: Invalid OrgID format: %v\n", err)
 		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
 	}
+	if !middleware.Can(ctx, policy.Resource{OrgID: orgID.String()}, "view-projects") {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions on organization")
+	}
 
 	page := req.Page
 	if page < 1 {
@@ -91,7 +112,14 @@ func (h *projectHandler) ListProjectsByOrganization(ctx context.Context, req *pb
 		pageSize = 10
 	}
 
-	projects, totalCount, err := h.service.ListProjectsByOrganization(ctx, orgID, page, pageSize)
+	filter := domain.ProjectFilter{
+		OrgID:           orgID,
+		Status:          fromProtoStatus(req.Status),
+		IncludeArchived: req.IncludeArchived,
+		ProjectName:     req.ProjectName,
+		ProjectCode:     req.ProjectCode,
+	}
+	projects, totalCount, err := h.service.ListProjectsByOrganization(ctx, filter, page, pageSize)
 	if err != nil {
 		fmt.Printf("DEBUG PROJECT HANDLER: Service returned error: %v\n", err)
 		return nil, status.Errorf(codes.Internal, "failed to list projects: %v", err)
@@ -118,17 +146,373 @@ func (h *projectHandler) ListProjectsByOrganization(ctx context.Context, req *pb
 	}, nil
 }
 
+// UpdateProject changes a project's name, code, description or dates
+func (h *projectHandler) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.UpdateProjectResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, "edit-projects")
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := h.service.UpdateProject(ctx, projectID, domain.ProjectDetails{
+		ProjectName: req.ProjectName,
+		ProjectCode: req.ProjectCode,
+		Description: req.Description,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+	})
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	return &pb.UpdateProjectResponse{Project: toProtoProject(project)}, nil
+}
+
+// CloseProject closes an active project
+func (h *projectHandler) CloseProject(ctx context.Context, req *pb.ChangeProjectStatusRequest) (*pb.ChangeProjectStatusResponse, error) {
+	return h.changeStatus(ctx, req, "edit-projects", h.service.CloseProject)
+}
+
+// ReopenProject reopens a closed project
+func (h *projectHandler) ReopenProject(ctx context.Context, req *pb.ChangeProjectStatusRequest) (*pb.ChangeProjectStatusResponse, error) {
+	return h.changeStatus(ctx, req, "edit-projects", h.service.ReopenProject)
+}
+
+// ArchiveProject archives a project
+func (h *projectHandler) ArchiveProject(ctx context.Context, req *pb.ChangeProjectStatusRequest) (*pb.ChangeProjectStatusResponse, error) {
+	return h.changeStatus(ctx, req, "delete-projects", h.service.ArchiveProject)
+}
+
+// DeleteProject deletes a project without budget commitments
+func (h *projectHandler) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, "delete-projects")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteProject(ctx, projectID); err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	return &pb.DeleteProjectResponse{Success: true}, nil
+}
+
+func (h *projectHandler) changeStatus(ctx context.Context, req *pb.ChangeProjectStatusRequest, action string, change func(context.Context, uuid.UUID, string) (*domain.Project, error)) (*pb.ChangeProjectStatusResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, action)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := change(ctx, projectID, strings.TrimSpace(req.Reason))
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	return &pb.ChangeProjectStatusResponse{Project: toProtoProject(project)}, nil
+}
+
+// SetProjectBudget replaces a project's sanctioned budget and category heads
+func (h *projectHandler) SetProjectBudget(ctx context.Context, req *pb.SetProjectBudgetRequest) (*pb.SetProjectBudgetResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, "edit-projects")
+	if err != nil {
+		return nil, err
+	}
+
+	sanctioned, err := money.Parse(req.SanctionedBudget)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sanctioned budget %q: %v", req.SanctionedBudget, err)
+	}
+	heads := make([]domain.BudgetHead, 0, len(req.BudgetHeads))
+	for _, h := range req.BudgetHeads {
+		amount, err := money.Parse(h.SanctionedAmount)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid amount %q for budget head %q: %v", h.SanctionedAmount, h.Category, err)
+		}
+		heads = append(heads, domain.BudgetHead{Category: h.Category, SanctionedAmount: amount})
+	}
+
+	project, err := h.service.SetProjectBudget(ctx, projectID, req.FinancialYear, sanctioned, heads)
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	return &pb.SetProjectBudgetResponse{Project: toProtoProject(project)}, nil
+}
+
+// AddProjectMember adds a manager or member to a project
+func (h *projectHandler) AddProjectMember(ctx context.Context, req *pb.AddProjectMemberRequest) (*pb.ProjectMemberResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, "edit-projects")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+	role, err := fromProtoMemberRole(req.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addedBy, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		addedBy = "system"
+	}
+
+	project, err := h.service.AddProjectMember(ctx, projectID, userID, role, addedBy)
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	return &pb.ProjectMemberResponse{Project: toProtoProject(project)}, nil
+}
+
+// RemoveProjectMember removes a user from a project
+func (h *projectHandler) RemoveProjectMember(ctx context.Context, req *pb.RemoveProjectMemberRequest) (*pb.ProjectMemberResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, "edit-projects")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	project, err := h.service.RemoveProjectMember(ctx, projectID, userID)
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	return &pb.ProjectMemberResponse{Project: toProtoProject(project)}, nil
+}
+
+// ReserveBudget reserves budget for a submitted document against a budget head
+func (h *projectHandler) ReserveBudget(ctx context.Context, req *pb.ReserveBudgetRequest) (*pb.BudgetCommitmentResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, "create-note")
+	if err != nil {
+		return nil, err
+	}
+	amount, err := money.Parse(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount %q: %v", req.Amount, err)
+	}
+
+	commitment, position, err := h.service.ReserveBudget(ctx, projectID, req.FinancialYear, req.Category, req.SourceType, req.SourceId, amount)
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	return toCommitmentResponse(commitment, position), nil
+}
+
+// ConvertBudgetCommitment turns a document's reservation into actual spend
+func (h *projectHandler) ConvertBudgetCommitment(ctx context.Context, req *pb.ConvertBudgetCommitmentRequest) (*pb.BudgetCommitmentResponse, error) {
+	commitment, position, err := h.service.ConvertBudgetCommitment(ctx, req.SourceType, req.SourceId)
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+	return toCommitmentResponse(commitment, position), nil
+}
+
+// ReleaseBudgetCommitment gives a document's reservation or spend back
+func (h *projectHandler) ReleaseBudgetCommitment(ctx context.Context, req *pb.ReleaseBudgetCommitmentRequest) (*pb.BudgetCommitmentResponse, error) {
+	commitment, position, err := h.service.ReleaseBudgetCommitment(ctx, req.SourceType, req.SourceId, req.Reason)
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+	return toCommitmentResponse(commitment, position), nil
+}
+
+// GetBudgetPosition returns a financial year's budget heads with their ledger totals
+func (h *projectHandler) GetBudgetPosition(ctx context.Context, req *pb.GetBudgetPositionRequest) (*pb.GetBudgetPositionResponse, error) {
+	projectID, err := h.authorizeProject(ctx, req.ProjectId, "view-projects")
+	if err != nil {
+		return nil, err
+	}
+
+	fy, heads, err := h.service.GetBudgetPosition(ctx, projectID, req.FinancialYear, req.Category)
+	if err != nil {
+		return nil, status.Error(projectErrorCode(err), err.Error())
+	}
+
+	resp := &pb.GetBudgetPositionResponse{ProjectId: projectID.String(), FinancialYear: fy}
+	for _, head := range heads {
+		resp.Heads = append(resp.Heads, toProtoBudgetHead(head))
+	}
+	return resp, nil
+}
+
+// authorizeProject loads the project a request names and checks the caller
+// may perform the action on it, judged by the stored organization and project
+// rather than by request fields
+func (h *projectHandler) authorizeProject(ctx context.Context, rawID, action string) (uuid.UUID, error) {
+	projectID, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid project ID: %v", err)
+	}
+	project, err := h.service.GetProject(ctx, projectID)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.NotFound, "project not found: %v", err)
+	}
+	if !middleware.Can(ctx, projectResource(project), action) {
+		return uuid.Nil, status.Errorf(codes.PermissionDenied, "insufficient permissions on project. Required: %s", action)
+	}
+	return projectID, nil
+}
+
+func projectResource(p *domain.Project) policy.Resource {
+	return policy.Resource{OrgID: p.OrgID.String(), ProjectID: p.ProjectID.String()}
+}
+
+// projectErrorCode maps domain errors to gRPC codes
+func projectErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, domain.ErrProjectNotFound), errors.Is(err, domain.ErrProjectMemberNotFound),
+		errors.Is(err, domain.ErrCommitmentNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrDuplicateProjectCode):
+		return codes.AlreadyExists
+	case errors.Is(err, domain.ErrProjectArchived), errors.Is(err, domain.ErrInvalidStatusTransition),
+		errors.Is(err, domain.ErrLastProjectManager), errors.Is(err, domain.ErrBudgetHeadsExceedBudget),
+		errors.Is(err, domain.ErrProjectNotActive), errors.Is(err, domain.ErrCommitmentSettled),
+		errors.Is(err, domain.ErrCommitmentReleased), errors.Is(err, domain.ErrProjectHasCommitments):
+		return codes.FailedPrecondition
+	case errors.Is(err, domain.ErrInvalidProjectName), errors.Is(err, domain.ErrInvalidProjectCode),
+		errors.Is(err, domain.ErrInvalidProjectDate), errors.Is(err, domain.ErrInvalidDateRange),
+		errors.Is(err, domain.ErrNegativeBudget), errors.Is(err, domain.ErrInvalidBudgetHead),
+		errors.Is(err, domain.ErrDuplicateBudgetHead), errors.Is(err, domain.ErrInvalidMemberRole),
+		errors.Is(err, domain.ErrInvalidFinancialYear), errors.Is(err, domain.ErrInvalidCommitment):
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
 // Helper function to convert domain project to proto project
 func toProtoProject(p *domain.Project) *pb.Project {
 	project := &pb.Project{
-		ProjectId:   p.ProjectID.String(),
-		TenantId:    p.TenantID.String(),
-		OrgId:       p.OrgID.String(),
-		ProjectName: p.ProjectName,
-		CreatedBy:   p.CreatedBy,
-		CreatedAt:   timestamppb.New(p.CreatedAt),
-		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		ProjectId:        p.ProjectID.String(),
+		TenantId:         p.TenantID.String(),
+		OrgId:            p.OrgID.String(),
+		ProjectName:      p.ProjectName,
+		ProjectCode:      p.ProjectCode,
+		Description:      p.Description,
+		Status:           toProtoStatus(p.Status),
+		StartDate:        formatProjectDate(p.StartDate),
+		EndDate:          formatProjectDate(p.EndDate),
+		SanctionedBudget: p.SanctionedBudget.String(),
+		StatusReason:     p.StatusReason,
+		CreatedBy:        p.CreatedBy,
+		CreatedAt:        timestamppb.New(p.CreatedAt),
+		UpdatedAt:        timestamppb.New(p.UpdatedAt),
+	}
+	if p.ClosedAt != nil {
+		project.ClosedAt = timestamppb.New(*p.ClosedAt)
+	}
+	if p.ArchivedAt != nil {
+		project.ArchivedAt = timestamppb.New(*p.ArchivedAt)
+	}
+	for _, h := range p.BudgetHeads {
+		project.BudgetHeads = append(project.BudgetHeads, toProtoBudgetHead(h))
+	}
+	for _, m := range p.Members {
+		project.Members = append(project.Members, &pb.ProjectMember{
+			UserId:  m.UserID.String(),
+			Role:    toProtoMemberRole(m.Role),
+			AddedBy: m.AddedBy,
+			AddedAt: timestamppb.New(m.AddedAt),
+		})
 	}
 
 	return project
 }
+
+func toProtoBudgetHead(h domain.BudgetHead) *pb.BudgetHead {
+	return &pb.BudgetHead{
+		Category:         h.Category,
+		SanctionedAmount: h.SanctionedAmount.String(),
+		FinancialYear:    h.FinancialYear,
+		CommittedAmount:  h.CommittedAmount.String(),
+		ActualAmount:     h.ActualAmount.String(),
+		AvailableAmount:  h.Available().String(),
+	}
+}
+
+func toCommitmentResponse(c *domain.BudgetCommitment, position *domain.BudgetHead) *pb.BudgetCommitmentResponse {
+	return &pb.BudgetCommitmentResponse{
+		Commitment: &pb.BudgetCommitment{
+			CommitmentId:  c.CommitmentID.String(),
+			ProjectId:     c.ProjectID.String(),
+			FinancialYear: c.FinancialYear,
+			Category:      c.Category,
+			SourceType:    c.SourceType,
+			SourceId:      c.SourceID,
+			Amount:        c.Amount.String(),
+			Status:        toProtoCommitmentStatus(c.Status),
+			Reason:        c.Reason,
+			CreatedAt:     timestamppb.New(c.CreatedAt),
+			UpdatedAt:     timestamppb.New(c.UpdatedAt),
+		},
+		Position: toProtoBudgetHead(*position),
+	}
+}
+
+func toProtoCommitmentStatus(s domain.CommitmentStatus) pb.BudgetCommitmentStatus {
+	switch s {
+	case domain.CommitmentCommitted:
+		return pb.BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_COMMITTED
+	case domain.CommitmentActual:
+		return pb.BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_ACTUAL
+	case domain.CommitmentReleased:
+		return pb.BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_RELEASED
+	}
+	return pb.BudgetCommitmentStatus_BUDGET_COMMITMENT_STATUS_UNSPECIFIED
+}
+
+func formatProjectDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(domain.ProjectDateLayout)
+}
+
+func toProtoStatus(s domain.ProjectStatus) pb.ProjectStatus {
+	switch s {
+	case domain.ProjectStatusActive:
+		return pb.ProjectStatus_PROJECT_STATUS_ACTIVE
+	case domain.ProjectStatusClosed:
+		return pb.ProjectStatus_PROJECT_STATUS_CLOSED
+	case domain.ProjectStatusArchived:
+		return pb.ProjectStatus_PROJECT_STATUS_ARCHIVED
+	}
+	return pb.ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func fromProtoStatus(s pb.ProjectStatus) domain.ProjectStatus {
+	switch s {
+	case pb.ProjectStatus_PROJECT_STATUS_ACTIVE:
+		return domain.ProjectStatusActive
+	case pb.ProjectStatus_PROJECT_STATUS_CLOSED:
+		return domain.ProjectStatusClosed
+	case pb.ProjectStatus_PROJECT_STATUS_ARCHIVED:
+		return domain.ProjectStatusArchived
+	}
+	return ""
+}
+
+func toProtoMemberRole(r domain.ProjectMemberRole) pb.ProjectMemberRole {
+	switch r {
+	case domain.ProjectMemberRoleManager:
+		return pb.ProjectMemberRole_PROJECT_MEMBER_ROLE_MANAGER
+	case domain.ProjectMemberRoleMember:
+		return pb.ProjectMemberRole_PROJECT_MEMBER_ROLE_MEMBER
+	}
+	return pb.ProjectMemberRole_PROJECT_MEMBER_ROLE_UNSPECIFIED
+}
+
+func fromProtoMemberRole(r pb.ProjectMemberRole) (domain.ProjectMemberRole, error) {
+	switch r {
+	case pb.ProjectMemberRole_PROJECT_MEMBER_ROLE_MANAGER:
+		return domain.ProjectMemberRoleManager, nil
+	case pb.ProjectMemberRole_PROJECT_MEMBER_ROLE_MEMBER, pb.ProjectMemberRole_PROJECT_MEMBER_ROLE_UNSPECIFIED:
+		return domain.ProjectMemberRoleMember, nil
+	}
+	return "", domain.ErrInvalidMemberRole
+}
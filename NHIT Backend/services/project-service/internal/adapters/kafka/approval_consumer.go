@@ -0,0 +1,83 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer"
+	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/ports"
+)
+
+const (
+	approvalStatusChanged = "ApprovalStatusChanged"
+
+	// approvalSchemaVersion is stamped on decoded approval events, which
+	// carry no version of their own
+	approvalSchemaVersion = 1
+)
+
+// ApprovalEventConsumer reads approval-service's events and hands
+// ApprovalStatusChanged data to the handler as *domain.ApprovalStatusChangedEvent.
+// Failed events are retried with backoff, then dead-lettered.
+type ApprovalEventConsumer struct {
+	consumer *eventconsumer.Consumer
+	logger   *log.Logger
+}
+
+// NewApprovalEventConsumer creates a consumer of the approval events topic
+func NewApprovalEventConsumer(brokers []string, topic string, groupID string, logger *log.Logger) ports.KafkaConsumer {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &ApprovalEventConsumer{
+		consumer: eventconsumer.New(eventconsumer.Config{
+			Brokers:       brokers,
+			Topic:         topic,
+			GroupID:       groupID,
+			SchemaVersion: approvalSchemaVersion,
+			Decode:        decodeApprovalEvent,
+		}),
+		logger: logger,
+	}
+}
+
+// decodeApprovalEvent maps approval-service's {event_type, data} wrapper onto
+// an envelope
+func decodeApprovalEvent(value []byte) (eventconsumer.Envelope, error) {
+	var wrapper struct {
+		EventType string          `json:"event_type"`
+		Data      json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(value, &wrapper); err != nil {
+		return eventconsumer.Envelope{}, err
+	}
+	return eventconsumer.Envelope{
+		EventType:     wrapper.EventType,
+		SchemaVersion: approvalSchemaVersion,
+		Payload:       wrapper.Data,
+	}, nil
+}
+
+// Subscribe handles status changes until ctx is done. The offset of an event
+// is committed only once the handler succeeded or the event was dead-lettered.
+func (c *ApprovalEventConsumer) Subscribe(ctx context.Context, topic string, handler func(message interface{}) error) error {
+	if c.consumer == nil {
+		c.logger.Println("⚠️ No Kafka brokers configured, approval events are not consumed")
+		return nil
+	}
+	c.logger.Printf("🎯 Starting approval event consumer for topic: %s", topic)
+
+	eventconsumer.On(c.consumer, approvalStatusChanged, func(_ context.Context, _ string, event domain.ApprovalStatusChangedEvent) error {
+		return handler(&event)
+	})
+	c.consumer.Start(ctx)
+	c.logger.Println("🛑 Approval event consumer stopped")
+	return nil
+}
+
+// Close is a no-op; Subscribe closes the reader once ctx is done
+func (c *ApprovalEventConsumer) Close() error {
+	return nil
+}
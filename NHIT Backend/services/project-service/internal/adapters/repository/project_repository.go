@@ -2,15 +2,24 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/ports"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// projectColumns is the column list scanned by scanProject
+const projectColumns = `id, tenant_id, org_id, project_name, COALESCE(project_code, ''), description, status,
+			start_date, end_date, sanctioned_budget, status_reason, closed_at, archived_at,
+			created_by, created_at, updated_at`
+
 type projectRepository struct {
 	db *pgxpool.Pool
 }
@@ -46,21 +55,30 @@ func (r *projectRepository) Create(ctx context.Context, project *domain.Project)
 	}
 	fmt.Printf("DEBUG REPO: Using created_by value: '%s'\n", createdByValue)
 	
+	if project.Status == "" {
+		project.Status = domain.ProjectStatusActive
+	}
+
 	query := `
 		INSERT INTO projects (
-			id, tenant_id, org_id, project_name, created_by, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			id, tenant_id, org_id, project_name, project_code, description, status,
+			start_date, end_date, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at, updated_at
 	`
 	
 	var returnedID uuid.UUID
 	err := r.db.QueryRow(ctx, query,
 		project.ProjectID.String(), project.TenantID.String(), project.OrgID.String(),
-		project.ProjectName, createdByValue, project.CreatedAt, project.UpdatedAt,
+		project.ProjectName, project.ProjectCode, project.Description, string(project.Status),
+		project.StartDate, project.EndDate, createdByValue, project.CreatedAt, project.UpdatedAt,
 	).Scan(&returnedID, &project.CreatedAt, &project.UpdatedAt)
 	
 	if err != nil {
 		fmt.Printf("DEBUG REPO: Error creating project: %v\n", err)
+		if isUniqueViolation(err) {
+			return nil, domain.ErrDuplicateProjectCode
+		}
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
 	
@@ -72,47 +90,66 @@ func (r *projectRepository) Create(ctx context.Context, project *domain.Project)
 // GetByID retrieves a project by ID
 func (r *projectRepository) GetByID(ctx context.Context, projectID uuid.UUID) (*domain.Project, error) {
 	query := `
-		SELECT id, tenant_id, org_id, project_name, created_by,
-			created_at, updated_at
+		SELECT ` + projectColumns + `
 		FROM projects
 		WHERE id = $1
 	`
 	
-	project := &domain.Project{}
-	err := r.db.QueryRow(ctx, query, projectID).Scan(
-		&project.ProjectID, &project.TenantID, &project.OrgID, &project.ProjectName, &project.CreatedBy, &project.CreatedAt, &project.UpdatedAt,
-	)
-	
+	project, err := scanProject(r.db.QueryRow(ctx, query, projectID))
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("project not found")
+			return nil, domain.ErrProjectNotFound
 		}
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
+
+	if err := r.loadBudgetHeads(ctx, project); err != nil {
+		return nil, err
+	}
+	if err := r.loadMembers(ctx, project); err != nil {
+		return nil, err
+	}
 	
 	return project, nil
 }
 
-// ListByOrganization lists all projects for an organization with pagination
-func (r *projectRepository) ListByOrganization(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*domain.Project, int, error) {
+// ListByOrganization lists an organization's projects matching the filter with pagination
+func (r *projectRepository) ListByOrganization(ctx context.Context, filter domain.ProjectFilter, limit, offset int) ([]*domain.Project, int, error) {
+	conditions := []string{"org_id = $1"}
+	args := []interface{}{filter.OrgID.String()}
+	if filter.Status != "" {
+		args = append(args, string(filter.Status))
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	} else if !filter.IncludeArchived {
+		conditions = append(conditions, "status <> 'ARCHIVED'")
+	}
+	if name := strings.TrimSpace(filter.ProjectName); name != "" {
+		args = append(args, name)
+		conditions = append(conditions, fmt.Sprintf("LOWER(TRIM(project_name)) = LOWER($%d)", len(args)))
+	}
+	if code := strings.TrimSpace(filter.ProjectCode); code != "" {
+		args = append(args, code)
+		conditions = append(conditions, fmt.Sprintf("LOWER(project_code) = LOWER($%d)", len(args)))
+	}
+	where := strings.Join(conditions, " AND ")
+
 	// Get total count first
 	var totalCount int
-	countQuery := `SELECT COUNT(*) FROM projects WHERE org_id = $1`
-	err := r.db.QueryRow(ctx, countQuery, orgID.String()).Scan(&totalCount)
+	countQuery := `SELECT COUNT(*) FROM projects WHERE ` + where
+	err := r.db.QueryRow(ctx, countQuery, args...).Scan(&totalCount)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get project count: %w", err)
 	}
 
-	query := `
-		SELECT id, tenant_id, org_id, project_name, created_by,
-			created_at, updated_at
+	query := fmt.Sprintf(`
+		SELECT %s
 		FROM projects
-		WHERE org_id = $1
+		WHERE %s
 		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
-	`
+		LIMIT $%d OFFSET $%d
+	`, projectColumns, where, len(args)+1, len(args)+2)
 	
-	rows, err := r.db.Query(ctx, query, orgID.String(), limit, offset)
+	rows, err := r.db.Query(ctx, query, append(args, limit, offset)...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list projects: %w", err)
 	}
@@ -120,10 +157,7 @@ func (r *projectRepository) ListByOrganization(ctx context.Context, orgID uuid.U
 	
 	var projects []*domain.Project
 	for rows.Next() {
-		p := &domain.Project{}
-		err := rows.Scan(
-			&p.ProjectID, &p.TenantID, &p.OrgID, &p.ProjectName, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
-		)
+		p, err := scanProject(rows)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan project: %w", err)
 		}
@@ -135,4 +169,250 @@ func (r *projectRepository) ListByOrganization(ctx context.Context, orgID uuid.U
 	}
 	
 	return projects, totalCount, nil
-}
\ No newline at end of file
+}
+
+// Update saves the project's details and status
+func (r *projectRepository) Update(ctx context.Context, project *domain.Project) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE projects SET
+			project_name = $2, project_code = NULLIF($3, ''), description = $4, status = $5,
+			start_date = $6, end_date = $7, status_reason = $8, closed_at = $9, archived_at = $10,
+			updated_at = $11
+		WHERE id = $1
+	`, project.ProjectID, project.ProjectName, project.ProjectCode, project.Description, string(project.Status),
+		project.StartDate, project.EndDate, project.StatusReason, project.ClosedAt, project.ArchivedAt,
+		project.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrDuplicateProjectCode
+		}
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrProjectNotFound
+	}
+	return nil
+}
+
+// ReplaceBudget sets the sanctioned budget and replaces the heads of one financial year in one transaction
+func (r *projectRepository) ReplaceBudget(ctx context.Context, projectID uuid.UUID, financialYear string, sanctioned money.Amount, heads []domain.BudgetHead) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE projects SET sanctioned_budget = $2, updated_at = NOW() WHERE id = $1`, projectID, sanctioned)
+	if err != nil {
+		return fmt.Errorf("failed to update sanctioned budget: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrProjectNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM project_budget_heads WHERE project_id = $1 AND financial_year = $2`, projectID, financialYear); err != nil {
+		return fmt.Errorf("failed to clear budget heads: %w", err)
+	}
+	for _, h := range heads {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO project_budget_heads (project_id, financial_year, category, sanctioned_amount)
+			VALUES ($1, $2, $3, $4)
+		`, projectID, financialYear, h.Category, h.SanctionedAmount)
+		if err != nil {
+			return fmt.Errorf("failed to save budget head %q: %w", h.Category, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpsertMember adds a project member or updates their role
+func (r *projectRepository) UpsertMember(ctx context.Context, projectID uuid.UUID, member domain.ProjectMember) error {
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO project_members (project_id, user_id, role, added_by, added_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (project_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`, projectID, member.UserID, string(member.Role), member.AddedBy, member.AddedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save project member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember removes a project member
+// Delete removes a project with its budget heads and members unless it has
+// budget commitments
+func (r *projectRepository) Delete(ctx context.Context, projectID uuid.UUID) error {
+	tag, err := r.db.Exec(ctx, `
+		DELETE FROM projects
+		WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM project_budget_commitments WHERE project_id = $1)
+	`, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		if _, err := r.GetByID(ctx, projectID); err != nil {
+			return err
+		}
+		return domain.ErrProjectHasCommitments
+	}
+	return nil
+}
+
+func (r *projectRepository) RemoveMember(ctx context.Context, projectID, userID uuid.UUID) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM project_members WHERE project_id = $1 AND user_id = $2`, projectID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove project member: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrProjectMemberNotFound
+	}
+	return nil
+}
+
+func (r *projectRepository) loadBudgetHeads(ctx context.Context, project *domain.Project) error {
+	heads, err := r.budgetHeads(ctx, project.ProjectID, "", "", false)
+	if err != nil {
+		return fmt.Errorf("failed to load budget heads: %w", err)
+	}
+	project.BudgetHeads = heads
+	return nil
+}
+
+// GetCommitment returns the commitment of a source document
+func (r *projectRepository) GetCommitment(ctx context.Context, sourceType, sourceID string) (*domain.BudgetCommitment, error) {
+	c := &domain.BudgetCommitment{}
+	var status string
+	err := r.db.QueryRow(ctx, `
+		SELECT id, project_id, financial_year, category, source_type, source_id,
+			amount, status, reason, created_at, updated_at
+		FROM project_budget_commitments
+		WHERE source_type = $1 AND source_id = $2
+	`, sourceType, sourceID).Scan(
+		&c.CommitmentID, &c.ProjectID, &c.FinancialYear, &c.Category, &c.SourceType, &c.SourceID,
+		&c.Amount, &status, &c.Reason, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrCommitmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get budget commitment: %w", err)
+	}
+	c.Status = domain.CommitmentStatus(status)
+	return c, nil
+}
+
+// SaveCommitment inserts the commitment or updates the one of its source document
+func (r *projectRepository) SaveCommitment(ctx context.Context, c *domain.BudgetCommitment) error {
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO project_budget_commitments (
+			id, project_id, financial_year, category, source_type, source_id,
+			amount, status, reason, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (source_type, source_id) DO UPDATE SET
+			project_id = EXCLUDED.project_id, financial_year = EXCLUDED.financial_year,
+			category = EXCLUDED.category, amount = EXCLUDED.amount, status = EXCLUDED.status,
+			reason = EXCLUDED.reason, updated_at = EXCLUDED.updated_at
+	`, c.CommitmentID, c.ProjectID, c.FinancialYear, c.Category, c.SourceType, c.SourceID,
+		c.Amount, string(c.Status), c.Reason, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save budget commitment: %w", err)
+	}
+	return nil
+}
+
+// BudgetPositions returns the heads of a financial year with their ledger totals
+func (r *projectRepository) BudgetPositions(ctx context.Context, projectID uuid.UUID, financialYear, category string) ([]domain.BudgetHead, error) {
+	return r.budgetHeads(ctx, projectID, financialYear, category, true)
+}
+
+// budgetHeads reads heads with their committed and actual totals, optionally
+// narrowed to a financial year and category. withUnbudgeted adds categories
+// that have commitments but no head.
+func (r *projectRepository) budgetHeads(ctx context.Context, projectID uuid.UUID, financialYear, category string, withUnbudgeted bool) ([]domain.BudgetHead, error) {
+	join := "LEFT JOIN"
+	if withUnbudgeted {
+		join = "FULL JOIN"
+	}
+	rows, err := r.db.Query(ctx, `
+		WITH heads AS (
+			SELECT financial_year, category, sanctioned_amount
+			FROM project_budget_heads
+			WHERE project_id = $1
+				AND ($2 = '' OR financial_year = $2)
+				AND ($3 = '' OR LOWER(category) = LOWER($3))
+		), ledger AS (
+			SELECT financial_year, LOWER(category) AS category_key, MIN(category) AS category,
+				COALESCE(SUM(amount) FILTER (WHERE status = 'COMMITTED'), 0) AS committed,
+				COALESCE(SUM(amount) FILTER (WHERE status = 'ACTUAL'), 0) AS actual
+			FROM project_budget_commitments
+			WHERE project_id = $1 AND status <> 'RELEASED'
+				AND ($2 = '' OR financial_year = $2)
+				AND ($3 = '' OR LOWER(category) = LOWER($3))
+			GROUP BY financial_year, LOWER(category)
+		)
+		SELECT COALESCE(h.financial_year, l.financial_year), COALESCE(h.category, l.category),
+			COALESCE(h.sanctioned_amount, 0), COALESCE(l.committed, 0), COALESCE(l.actual, 0)
+		FROM heads h
+		`+join+` ledger l ON l.financial_year = h.financial_year AND l.category_key = LOWER(h.category)
+		ORDER BY 1, h.category IS NULL, 2
+	`, projectID, financialYear, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var heads []domain.BudgetHead
+	for rows.Next() {
+		var h domain.BudgetHead
+		if err := rows.Scan(&h.FinancialYear, &h.Category, &h.SanctionedAmount, &h.CommittedAmount, &h.ActualAmount); err != nil {
+			return nil, fmt.Errorf("failed to scan budget head: %w", err)
+		}
+		heads = append(heads, h)
+	}
+	return heads, rows.Err()
+}
+
+func (r *projectRepository) loadMembers(ctx context.Context, project *domain.Project) error {
+	rows, err := r.db.Query(ctx, `
+		SELECT user_id, role, added_by, added_at
+		FROM project_members
+		WHERE project_id = $1
+		ORDER BY role, added_at
+	`, project.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to load project members: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m domain.ProjectMember
+		var role string
+		if err := rows.Scan(&m.UserID, &role, &m.AddedBy, &m.AddedAt); err != nil {
+			return fmt.Errorf("failed to scan project member: %w", err)
+		}
+		m.Role = domain.ProjectMemberRole(role)
+		project.Members = append(project.Members, m)
+	}
+	return rows.Err()
+}
+
+func scanProject(row pgx.Row) (*domain.Project, error) {
+	p := &domain.Project{}
+	var status string
+	err := row.Scan(
+		&p.ProjectID, &p.TenantID, &p.OrgID, &p.ProjectName, &p.ProjectCode, &p.Description, &status,
+		&p.StartDate, &p.EndDate, &p.SanctionedBudget, &p.StatusReason, &p.ClosedAt, &p.ArchivedAt,
+		&p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.Status = domain.ProjectStatus(status)
+	return p, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -7,9 +7,24 @@ func GetPermissionMap() map[string][]string {
 		// Project CRUD operations
 		"/project.ProjectService/GetProject":    {"view-projects"},
 		"/project.ProjectService/ListProjects":  {"view-projects"},
-		"/project.ProjectService/UpdateProject": {"edit-project"},
-		"/project.ProjectService/DeleteProject": {"delete-project"},
+		"/project.ProjectService/UpdateProject": {"edit-projects"},
+		"/project.ProjectService/DeleteProject": {"delete-projects"},
 		"/project.ProjectService/ListProjectsByOrganization": {"view-projects"},
+
+		// Lifecycle, budget and members
+		"/project.ProjectService/CloseProject":        {"edit-projects"},
+		"/project.ProjectService/ReopenProject":       {"edit-projects"},
+		"/project.ProjectService/ArchiveProject":      {"delete-projects"},
+		"/project.ProjectService/SetProjectBudget":    {"edit-projects"},
+		"/project.ProjectService/AddProjectMember":    {"edit-projects"},
+		"/project.ProjectService/RemoveProjectMember": {"edit-projects"},
+
+		// Budget commitment ledger; documents reserve and release budget on
+		// behalf of the user submitting or cancelling them
+		"/project.ProjectService/ReserveBudget":           {"create-note"},
+		"/project.ProjectService/ConvertBudgetCommitment": {"approve-note", "edit-projects"},
+		"/project.ProjectService/ReleaseBudgetCommitment": {"delete-note", "edit-projects"},
+		"/project.ProjectService/GetBudgetPosition":       {"view-projects"},
 	}
 }
 
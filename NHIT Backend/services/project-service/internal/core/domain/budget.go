@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidFinancialYear = errors.New("financial year must look like 2025-26")
+	ErrInvalidCommitment    = errors.New("budget commitment needs a category, source type, source ID and a positive amount")
+	ErrCommitmentNotFound   = errors.New("budget commitment not found")
+	ErrCommitmentSettled    = errors.New("budget commitment is already actual spend")
+	ErrCommitmentReleased   = errors.New("budget commitment was released")
+	ErrProjectNotActive     = errors.New("budget can only be reserved on an active project")
+)
+
+// BudgetHead is the part of a project's sanctioned budget earmarked for an
+// expense category in a financial year, with what the commitment ledger has
+// reserved and spent against it
+type BudgetHead struct {
+	FinancialYear    string       `json:"financial_year" db:"financial_year"`
+	Category         string       `json:"category" db:"category"`
+	SanctionedAmount money.Amount `json:"sanctioned_amount" db:"sanctioned_amount"`
+	CommittedAmount  money.Amount `json:"committed_amount" db:"committed_amount"`
+	ActualAmount     money.Amount `json:"actual_amount" db:"actual_amount"`
+}
+
+// Available is what is left of the head, negative when it is overspent
+func (h BudgetHead) Available() money.Amount {
+	return h.SanctionedAmount.Sub(h.CommittedAmount).Sub(h.ActualAmount)
+}
+
+// CommitmentStatus is where a budget commitment is in the ledger
+type CommitmentStatus string
+
+const (
+	// CommitmentCommitted is budget reserved by a document awaiting approval
+	CommitmentCommitted CommitmentStatus = "COMMITTED"
+	// CommitmentActual is budget spent by an approved document
+	CommitmentActual CommitmentStatus = "ACTUAL"
+	// CommitmentReleased is budget given back by a rejected or cancelled document
+	CommitmentReleased CommitmentStatus = "RELEASED"
+)
+
+// BudgetCommitment is a document's claim on a budget head. Each source
+// document has at most one.
+type BudgetCommitment struct {
+	CommitmentID  uuid.UUID        `json:"commitment_id" db:"id"`
+	ProjectID     uuid.UUID        `json:"project_id" db:"project_id"`
+	FinancialYear string           `json:"financial_year" db:"financial_year"`
+	Category      string           `json:"category" db:"category"`
+	SourceType    string           `json:"source_type" db:"source_type"`
+	SourceID      string           `json:"source_id" db:"source_id"`
+	Amount        money.Amount     `json:"amount" db:"amount"`
+	Status        CommitmentStatus `json:"status" db:"status"`
+	Reason        string           `json:"reason" db:"reason"`
+	CreatedAt     time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// NewBudgetCommitment validates and builds a commitment
+func NewBudgetCommitment(projectID uuid.UUID, financialYear, category, sourceType, sourceID string, amount money.Amount) (*BudgetCommitment, error) {
+	category = strings.TrimSpace(category)
+	sourceType = strings.ToUpper(strings.TrimSpace(sourceType))
+	sourceID = strings.TrimSpace(sourceID)
+	if category == "" || len(category) > 255 || sourceType == "" || sourceID == "" || amount.Sign() <= 0 {
+		return nil, ErrInvalidCommitment
+	}
+	now := time.Now().UTC()
+	return &BudgetCommitment{
+		CommitmentID:  uuid.New(),
+		ProjectID:     projectID,
+		FinancialYear: financialYear,
+		Category:      category,
+		SourceType:    sourceType,
+		SourceID:      sourceID,
+		Amount:        amount,
+		Status:        CommitmentCommitted,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// Recommit moves an open or released commitment to a new head and amount, for
+// a document that is resubmitted
+func (c *BudgetCommitment) Recommit(next *BudgetCommitment) error {
+	if c.Status == CommitmentActual {
+		return ErrCommitmentSettled
+	}
+	c.ProjectID = next.ProjectID
+	c.FinancialYear = next.FinancialYear
+	c.Category = next.Category
+	c.Amount = next.Amount
+	c.Status = CommitmentCommitted
+	c.Reason = ""
+	c.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Convert turns the reservation into actual spend. Converting actual spend
+// again is a no-op, so redelivered approvals are harmless.
+func (c *BudgetCommitment) Convert() (changed bool, err error) {
+	switch c.Status {
+	case CommitmentActual:
+		return false, nil
+	case CommitmentReleased:
+		return false, ErrCommitmentReleased
+	}
+	c.Status = CommitmentActual
+	c.UpdatedAt = time.Now().UTC()
+	return true, nil
+}
+
+// Release gives the budget back. Releasing twice is a no-op.
+func (c *BudgetCommitment) Release(reason string) (changed bool) {
+	if c.Status == CommitmentReleased {
+		return false
+	}
+	c.Status = CommitmentReleased
+	c.Reason = reason
+	c.UpdatedAt = time.Now().UTC()
+	return true
+}
+
+// FinancialYearOf is the Indian financial year (April to March) t falls in,
+// e.g. "2025-26"
+func FinancialYearOf(t time.Time) string {
+	start := t.Year()
+	if t.Month() < time.April {
+		start--
+	}
+	return fmt.Sprintf("%d-%02d", start, (start+1)%100)
+}
+
+// ParseFinancialYear validates a financial year; an empty one is the current year
+func ParseFinancialYear(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return FinancialYearOf(time.Now()), nil
+	}
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 || len(parts[0]) != 4 || len(parts[1]) != 2 {
+		return "", ErrInvalidFinancialYear
+	}
+	start, err1 := strconv.Atoi(parts[0])
+	end, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || (start+1)%100 != end {
+		return "", ErrInvalidFinancialYear
+	}
+	return s, nil
+}
@@ -18,6 +18,16 @@ type OrganizationCreatedEvent struct {
 	CreatedBy string    `json:"created_by"`
 }
 
+// ApprovalStatusChangedEvent is the data of approval-service's
+// ApprovalStatusChanged event on the approval events topic
+type ApprovalStatusChangedEvent struct {
+	SourceID   string `json:"source_id"`   // e.g. the green note ID
+	SourceType string `json:"source_type"` // e.g. "GREENNOTE"
+	Status     string `json:"status"`      // "APPROVED", "REJECTED", "PENDING_LEVEL_2", ...
+	ActorID    string `json:"actor_id"`
+	Comments   string `json:"comments"`
+}
+
 // NewProjectFromEvent creates projects from organization created event
 func NewProjectFromEvent(event *OrganizationCreatedEvent) ([]*Project, error) {
 	var projects []*Project
@@ -42,6 +52,7 @@ func NewProjectFromEvent(event *OrganizationCreatedEvent) ([]*Project, error) {
 			TenantID:    tenantUUID,
 			OrgID:       orgUUID,
 			ProjectName: projectName,
+			Status:      ProjectStatusActive,
 			CreatedBy:   event.CreatedBy,
 			CreatedAt:   time.Now().UTC(),
 			UpdatedAt:   time.Now().UTC(),
@@ -2,53 +2,136 @@ package domain
 
 import (
 	"errors"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/google/uuid"
 )
 
 var (
-	ErrInvalidProjectName        = errors.New("project name must be between 3 and 255 characters")
+	ErrInvalidProjectName = errors.New("project name must be between 3 and 255 characters")
+	ErrInvalidProjectCode = errors.New("project code must be up to 50 letters, digits, '-', '_', '/' or '.'")
+	ErrInvalidProjectDate = errors.New("project dates must be in YYYY-MM-DD format")
+	ErrInvalidDateRange   = errors.New("project end date cannot be before its start date")
+
+	ErrProjectNotFound         = errors.New("project not found")
+	ErrDuplicateProjectCode    = errors.New("project code is already used in this organization")
+	ErrProjectArchived         = errors.New("archived projects cannot be changed")
+	ErrInvalidStatusTransition = errors.New("invalid project status transition")
+	ErrProjectHasCommitments   = errors.New("projects with budget commitments cannot be deleted; archive them instead")
+
+	ErrNegativeBudget          = errors.New("budget amounts cannot be negative")
+	ErrInvalidBudgetHead       = errors.New("budget head category must be between 1 and 255 characters")
+	ErrDuplicateBudgetHead     = errors.New("budget head categories must be unique")
+	ErrBudgetHeadsExceedBudget = errors.New("budget heads add up to more than the sanctioned budget")
+	ErrInvalidMemberRole       = errors.New("project member role must be MANAGER or MEMBER")
+	ErrProjectMemberNotFound   = errors.New("user is not a member of this project")
+	ErrLastProjectManager      = errors.New("an active project must keep at least one manager")
+)
+
+// ProjectDateLayout is the format of project start and end dates
+const ProjectDateLayout = "2006-01-02"
+
+var projectCodePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_./-]{0,49}$`)
+
+// ProjectStatus is where a project is in its lifecycle
+type ProjectStatus string
+
+const (
+	// ProjectStatusActive projects accept new green notes
+	ProjectStatusActive ProjectStatus = "ACTIVE"
+	// ProjectStatusClosed projects accept no new green notes but can be reopened
+	ProjectStatusClosed ProjectStatus = "CLOSED"
+	// ProjectStatusArchived projects are read-only and hidden from listings by default
+	ProjectStatusArchived ProjectStatus = "ARCHIVED"
+)
+
+// ProjectMemberRole is a user's role on a project
+type ProjectMemberRole string
+
+const (
+	ProjectMemberRoleManager ProjectMemberRole = "MANAGER"
+	ProjectMemberRoleMember  ProjectMemberRole = "MEMBER"
 )
 
+// ProjectMember is a user assigned to a project
+type ProjectMember struct {
+	UserID  uuid.UUID         `json:"user_id" db:"user_id"`
+	Role    ProjectMemberRole `json:"role" db:"role"`
+	AddedBy string            `json:"added_by" db:"added_by"`
+	AddedAt time.Time         `json:"added_at" db:"added_at"`
+}
+
 // Project represents a project entity in the system
 type Project struct {
-	ProjectID     uuid.UUID       `json:"project_id" db:"project_id"`
-	TenantID      uuid.UUID       `json:"tenant_id" db:"tenant_id"`
-	OrgID         uuid.UUID       `json:"org_id" db:"org_id"`
-	ProjectName   string          `json:"project_name" db:"project_name"`
-	CreatedBy     string      `json:"created_by" db:"created_by"`
-	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
-	UpdatedAt     time.Time       `json:"updated_at" db:"updated_at"`
+	ProjectID        uuid.UUID       `json:"project_id" db:"project_id"`
+	TenantID         uuid.UUID       `json:"tenant_id" db:"tenant_id"`
+	OrgID            uuid.UUID       `json:"org_id" db:"org_id"`
+	ProjectName      string          `json:"project_name" db:"project_name"`
+	ProjectCode      string          `json:"project_code" db:"project_code"`
+	Description      string          `json:"description" db:"description"`
+	Status           ProjectStatus   `json:"status" db:"status"`
+	StartDate        *time.Time      `json:"start_date,omitempty" db:"start_date"`
+	EndDate          *time.Time      `json:"end_date,omitempty" db:"end_date"`
+	SanctionedBudget money.Amount    `json:"sanctioned_budget" db:"sanctioned_budget"`
+	BudgetHeads      []BudgetHead    `json:"budget_heads,omitempty"`
+	Members          []ProjectMember `json:"members,omitempty"`
+	StatusReason     string          `json:"status_reason" db:"status_reason"`
+	ClosedAt         *time.Time      `json:"closed_at,omitempty" db:"closed_at"`
+	ArchivedAt       *time.Time      `json:"archived_at,omitempty" db:"archived_at"`
+	CreatedBy        string          `json:"created_by" db:"created_by"`
+	CreatedAt        time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at" db:"updated_at"`
 }
 
+// ProjectDetails are the optional fields a project can be created or updated
+// with. Dates are YYYY-MM-DD; in an update, nil leaves a field unchanged and
+// an empty date clears it.
+type ProjectDetails struct {
+	ProjectName *string
+	ProjectCode *string
+	Description *string
+	StartDate   *string
+	EndDate     *string
+}
+
+// ProjectFilter narrows ListByOrganization
+type ProjectFilter struct {
+	OrgID           uuid.UUID
+	Status          ProjectStatus // empty for any status
+	IncludeArchived bool          // archived projects are skipped unless set or Status is ARCHIVED
+	ProjectName     string        // exact match, case-insensitive
+	ProjectCode     string        // exact match, case-insensitive
+}
 
 // NewProject creates a new project with validation
 func NewProject(tenantID, orgID uuid.UUID, name, createdBy string) (*Project, error) {
-	
+
 	if tenantID == uuid.Nil {
 		return nil, errors.New("tenant ID cannot be empty")
 	}
-	
+
 	if orgID == uuid.Nil {
 		return nil, errors.New("organization ID cannot be empty")
 	}
-	
-	
+
 	project := &Project{
 		ProjectID:   uuid.New(),
 		TenantID:    tenantID,
 		OrgID:       orgID,
 		ProjectName: name,
+		Status:      ProjectStatusActive,
 		CreatedBy:   createdBy,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
-	
+
 	if err := project.Validate(); err != nil {
 		return nil, err
 	}
-	
+
 	return project, nil
 }
 
@@ -57,5 +140,165 @@ func (p *Project) Validate() error {
 	if len(p.ProjectName) < 3 || len(p.ProjectName) > 255 {
 		return ErrInvalidProjectName
 	}
+	if p.ProjectCode != "" && !projectCodePattern.MatchString(p.ProjectCode) {
+		return ErrInvalidProjectCode
+	}
+	if p.StartDate != nil && p.EndDate != nil && p.EndDate.Before(*p.StartDate) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
+
+// ApplyDetails sets the non-nil details on the project and validates it
+func (p *Project) ApplyDetails(d ProjectDetails) error {
+	if p.Status == ProjectStatusArchived {
+		return ErrProjectArchived
+	}
+	if d.ProjectName != nil {
+		p.ProjectName = strings.TrimSpace(*d.ProjectName)
+	}
+	if d.ProjectCode != nil {
+		p.ProjectCode = strings.TrimSpace(*d.ProjectCode)
+	}
+	if d.Description != nil {
+		p.Description = strings.TrimSpace(*d.Description)
+	}
+	if d.StartDate != nil {
+		start, err := ParseProjectDate(*d.StartDate)
+		if err != nil {
+			return err
+		}
+		p.StartDate = start
+	}
+	if d.EndDate != nil {
+		end, err := ParseProjectDate(*d.EndDate)
+		if err != nil {
+			return err
+		}
+		p.EndDate = end
+	}
+	p.UpdatedAt = time.Now()
+	return p.Validate()
+}
+
+// Close stops new green notes against an active project
+func (p *Project) Close(reason string, at time.Time) error {
+	if p.Status != ProjectStatusActive {
+		return ErrInvalidStatusTransition
+	}
+	p.Status = ProjectStatusClosed
+	p.StatusReason = reason
+	p.ClosedAt = &at
+	p.UpdatedAt = at
+	return nil
+}
+
+// Reopen makes a closed project active again
+func (p *Project) Reopen(reason string, at time.Time) error {
+	if p.Status != ProjectStatusClosed {
+		return ErrInvalidStatusTransition
+	}
+	p.Status = ProjectStatusActive
+	p.StatusReason = reason
+	p.ClosedAt = nil
+	p.UpdatedAt = at
+	return nil
+}
+
+// Archive retires an active or closed project. Archiving is final.
+func (p *Project) Archive(reason string, at time.Time) error {
+	if p.Status == ProjectStatusArchived {
+		return ErrInvalidStatusTransition
+	}
+	if p.ClosedAt == nil {
+		p.ClosedAt = &at
+	}
+	p.Status = ProjectStatusArchived
+	p.StatusReason = reason
+	p.ArchivedAt = &at
+	p.UpdatedAt = at
+	return nil
+}
+
+// SetBudget replaces the sanctioned budget and the heads of one financial
+// year. The heads of all years may leave part of the budget unallocated but
+// cannot exceed it.
+func (p *Project) SetBudget(financialYear string, sanctioned money.Amount, heads []BudgetHead) error {
+	if p.Status == ProjectStatusArchived {
+		return ErrProjectArchived
+	}
+	if sanctioned.IsNegative() {
+		return ErrNegativeBudget
+	}
+
+	seen := make(map[string]bool, len(heads))
+	var kept []BudgetHead
+	allocated := money.Zero
+	for _, h := range p.BudgetHeads {
+		if h.FinancialYear != financialYear {
+			kept = append(kept, h)
+			allocated = allocated.Add(h.SanctionedAmount)
+		}
+	}
+	for _, h := range heads {
+		category := strings.TrimSpace(h.Category)
+		if category == "" || len(category) > 255 {
+			return ErrInvalidBudgetHead
+		}
+		key := strings.ToLower(category)
+		if seen[key] {
+			return ErrDuplicateBudgetHead
+		}
+		seen[key] = true
+		if h.SanctionedAmount.IsNegative() {
+			return ErrNegativeBudget
+		}
+		allocated = allocated.Add(h.SanctionedAmount)
+		kept = append(kept, BudgetHead{FinancialYear: financialYear, Category: category, SanctionedAmount: h.SanctionedAmount})
+	}
+	if allocated.Cmp(sanctioned) > 0 {
+		return ErrBudgetHeadsExceedBudget
+	}
+
+	p.SanctionedBudget = sanctioned
+	p.BudgetHeads = kept
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// CanRemoveMember checks that removing the user keeps a manager on an active project
+func (p *Project) CanRemoveMember(userID uuid.UUID) error {
+	if p.Status == ProjectStatusArchived {
+		return ErrProjectArchived
+	}
+	var found *ProjectMember
+	managers := 0
+	for i := range p.Members {
+		if p.Members[i].UserID == userID {
+			found = &p.Members[i]
+		}
+		if p.Members[i].Role == ProjectMemberRoleManager {
+			managers++
+		}
+	}
+	if found == nil {
+		return ErrProjectMemberNotFound
+	}
+	if p.Status == ProjectStatusActive && found.Role == ProjectMemberRoleManager && managers == 1 {
+		return ErrLastProjectManager
+	}
 	return nil
 }
+
+// ParseProjectDate parses a YYYY-MM-DD date; an empty string is no date
+func ParseProjectDate(s string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(ProjectDateLayout, s)
+	if err != nil {
+		return nil, ErrInvalidProjectDate
+	}
+	return &t, nil
+}
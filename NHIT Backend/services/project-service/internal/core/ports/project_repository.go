@@ -3,14 +3,35 @@ package ports
 import (
 	"context"
 
-	"github.com/google/uuid"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/domain"
+	"github.com/google/uuid"
 )
 
 // ProjectRepository defines the interface for project data operations
 type ProjectRepository interface {
 	// Project CRUD operations
 	Create(ctx context.Context, project *domain.Project) (*domain.Project, error)
+	// GetByID returns the project with its budget heads and members
 	GetByID(ctx context.Context, projectID uuid.UUID) (*domain.Project, error)
-	ListByOrganization(ctx context.Context, orgID uuid.UUID, limit, offset int) ([]*domain.Project, int, error)
+	ListByOrganization(ctx context.Context, filter domain.ProjectFilter, limit, offset int) ([]*domain.Project, int, error)
+	// Update saves the project's details and status
+	Update(ctx context.Context, project *domain.Project) error
+	// Delete removes a project that has no budget commitments
+	Delete(ctx context.Context, projectID uuid.UUID) error
+
+	// Budget and members
+	// ReplaceBudget sets the sanctioned budget and replaces the heads of one financial year
+	ReplaceBudget(ctx context.Context, projectID uuid.UUID, financialYear string, sanctioned money.Amount, heads []domain.BudgetHead) error
+	UpsertMember(ctx context.Context, projectID uuid.UUID, member domain.ProjectMember) error
+	RemoveMember(ctx context.Context, projectID, userID uuid.UUID) error
+
+	// Commitment ledger
+	GetCommitment(ctx context.Context, sourceType, sourceID string) (*domain.BudgetCommitment, error)
+	// SaveCommitment inserts the commitment or updates the one of its source document
+	SaveCommitment(ctx context.Context, commitment *domain.BudgetCommitment) error
+	// BudgetPositions returns the heads of a financial year with their ledger
+	// totals, including categories with commitments but no head. An empty
+	// category returns every head.
+	BudgetPositions(ctx context.Context, projectID uuid.UUID, financialYear, category string) ([]domain.BudgetHead, error)
 }
@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/domain"
 	"github.com/google/uuid"
 )
@@ -10,10 +11,30 @@ import (
 // ProjectService defines the business logic interface for project operations
 type ProjectService interface {
 	// Project operations
-	CreateProject(ctx context.Context, tenantID, orgID uuid.UUID, name, createdBy string) (*domain.Project, error)
+	CreateProject(ctx context.Context, tenantID, orgID uuid.UUID, name, createdBy string, details domain.ProjectDetails) (*domain.Project, error)
 	GetProject(ctx context.Context, projectID uuid.UUID) (*domain.Project, error)
-	ListProjectsByOrganization(ctx context.Context, orgID uuid.UUID, page, pageSize int32) ([]*domain.Project, int, error)
+	ListProjectsByOrganization(ctx context.Context, filter domain.ProjectFilter, page, pageSize int32) ([]*domain.Project, int, error)
+	UpdateProject(ctx context.Context, projectID uuid.UUID, details domain.ProjectDetails) (*domain.Project, error)
+
+	// Lifecycle
+	CloseProject(ctx context.Context, projectID uuid.UUID, reason string) (*domain.Project, error)
+	ReopenProject(ctx context.Context, projectID uuid.UUID, reason string) (*domain.Project, error)
+	ArchiveProject(ctx context.Context, projectID uuid.UUID, reason string) (*domain.Project, error)
+	DeleteProject(ctx context.Context, projectID uuid.UUID) error
+
+	// Budget and members
+	SetProjectBudget(ctx context.Context, projectID uuid.UUID, financialYear string, sanctioned money.Amount, heads []domain.BudgetHead) (*domain.Project, error)
+	AddProjectMember(ctx context.Context, projectID, userID uuid.UUID, role domain.ProjectMemberRole, addedBy string) (*domain.Project, error)
+	RemoveProjectMember(ctx context.Context, projectID, userID uuid.UUID) (*domain.Project, error)
+
+	// Commitment ledger
+	ReserveBudget(ctx context.Context, projectID uuid.UUID, financialYear, category, sourceType, sourceID string, amount money.Amount) (*domain.BudgetCommitment, *domain.BudgetHead, error)
+	ConvertBudgetCommitment(ctx context.Context, sourceType, sourceID string) (*domain.BudgetCommitment, *domain.BudgetHead, error)
+	ReleaseBudgetCommitment(ctx context.Context, sourceType, sourceID, reason string) (*domain.BudgetCommitment, *domain.BudgetHead, error)
+	GetBudgetPosition(ctx context.Context, projectID uuid.UUID, financialYear, category string) (string, []domain.BudgetHead, error)
 
 	// Event handling
 	StartEventConsumer(ctx context.Context) error
+	// StartApprovalEventConsumer converts or releases commitments as approvals complete
+	StartApprovalEventConsumer(ctx context.Context, consumer KafkaConsumer) error
 }
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// ReserveBudget reserves budget for a submitted document against a budget
+// head. Reservations are not capped by the head: going over budget is
+// reported through the head's position, not refused.
+func (s *projectService) ReserveBudget(ctx context.Context, projectID uuid.UUID, financialYear, category, sourceType, sourceID string, amount money.Amount) (*domain.BudgetCommitment, *domain.BudgetHead, error) {
+	fy, err := domain.ParseFinancialYear(financialYear)
+	if err != nil {
+		return nil, nil, err
+	}
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if project.Status != domain.ProjectStatusActive {
+		return nil, nil, domain.ErrProjectNotActive
+	}
+
+	next, err := domain.NewBudgetCommitment(project.ProjectID, fy, headCategory(project, fy, category), sourceType, sourceID, amount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commitment, err := s.repo.GetCommitment(ctx, next.SourceType, next.SourceID)
+	switch {
+	case errors.Is(err, domain.ErrCommitmentNotFound):
+		commitment = next
+	case err != nil:
+		return nil, nil, err
+	default:
+		if err := commitment.Recommit(next); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := s.repo.SaveCommitment(ctx, commitment); err != nil {
+		return nil, nil, fmt.Errorf("failed to save budget commitment: %w", err)
+	}
+	s.logger.Printf("Reserved %s on project %s head %s/%s for %s %s",
+		commitment.Amount, project.ProjectID, fy, commitment.Category, commitment.SourceType, commitment.SourceID)
+	return s.withPosition(ctx, commitment)
+}
+
+// ConvertBudgetCommitment turns a document's reservation into actual spend
+func (s *projectService) ConvertBudgetCommitment(ctx context.Context, sourceType, sourceID string) (*domain.BudgetCommitment, *domain.BudgetHead, error) {
+	commitment, err := s.getOwnCommitment(ctx, sourceType, sourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	changed, err := commitment.Convert()
+	if err != nil {
+		return nil, nil, err
+	}
+	if changed {
+		if err := s.repo.SaveCommitment(ctx, commitment); err != nil {
+			return nil, nil, fmt.Errorf("failed to save budget commitment: %w", err)
+		}
+		s.logger.Printf("Converted %s for %s %s to actual spend", commitment.Amount, commitment.SourceType, commitment.SourceID)
+	}
+	return s.withPosition(ctx, commitment)
+}
+
+// ReleaseBudgetCommitment gives a document's reservation or spend back to its head
+func (s *projectService) ReleaseBudgetCommitment(ctx context.Context, sourceType, sourceID, reason string) (*domain.BudgetCommitment, *domain.BudgetHead, error) {
+	commitment, err := s.getOwnCommitment(ctx, sourceType, sourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if commitment.Release(strings.TrimSpace(reason)) {
+		if err := s.repo.SaveCommitment(ctx, commitment); err != nil {
+			return nil, nil, fmt.Errorf("failed to save budget commitment: %w", err)
+		}
+		s.logger.Printf("Released %s for %s %s", commitment.Amount, commitment.SourceType, commitment.SourceID)
+	}
+	return s.withPosition(ctx, commitment)
+}
+
+// GetBudgetPosition returns the heads of a financial year with their ledger totals
+func (s *projectService) GetBudgetPosition(ctx context.Context, projectID uuid.UUID, financialYear, category string) (string, []domain.BudgetHead, error) {
+	fy, err := domain.ParseFinancialYear(financialYear)
+	if err != nil {
+		return "", nil, err
+	}
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return "", nil, err
+	}
+	heads, err := s.repo.BudgetPositions(ctx, project.ProjectID, fy, strings.TrimSpace(category))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to load budget position: %w", err)
+	}
+	return fy, heads, nil
+}
+
+// HandleApprovalStatusChangedEvent converts a document's commitment once it is
+// fully approved and releases it when it is rejected. Documents without a
+// commitment are not budgeted and are ignored.
+func (s *projectService) HandleApprovalStatusChangedEvent(ctx context.Context, event *domain.ApprovalStatusChangedEvent) error {
+	var err error
+	switch strings.ToUpper(event.Status) {
+	case "APPROVED":
+		_, _, err = s.ConvertBudgetCommitment(ctx, event.SourceType, event.SourceID)
+	case "REJECTED":
+		_, _, err = s.ReleaseBudgetCommitment(ctx, event.SourceType, event.SourceID, "rejected: "+event.Comments)
+	default:
+		return nil
+	}
+	if errors.Is(err, domain.ErrCommitmentNotFound) || errors.Is(err, domain.ErrCommitmentReleased) {
+		s.logger.Printf("No open budget commitment for %s %s: %v", event.SourceType, event.SourceID, err)
+		return nil
+	}
+	return err
+}
+
+// StartApprovalEventConsumer consumes approval events until ctx is done
+func (s *projectService) StartApprovalEventConsumer(ctx context.Context, consumer ports.KafkaConsumer) error {
+	if consumer == nil {
+		s.logger.Println("Approval event consumer not configured, budget commitments will only change through the API")
+		return nil
+	}
+
+	return consumer.Subscribe(ctx, "approval_events", func(message interface{}) error {
+		event, ok := message.(*domain.ApprovalStatusChangedEvent)
+		if !ok {
+			s.logger.Printf("Received unexpected message type: %T", message)
+			return nil
+		}
+		return s.HandleApprovalStatusChangedEvent(ctx, event)
+	})
+}
+
+// getOwnCommitment loads a commitment, hiding those on other organizations'
+// projects from callers whose token carries an org
+func (s *projectService) getOwnCommitment(ctx context.Context, sourceType, sourceID string) (*domain.BudgetCommitment, error) {
+	commitment, err := s.repo.GetCommitment(ctx, strings.ToUpper(strings.TrimSpace(sourceType)), strings.TrimSpace(sourceID))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := middleware.GetOrgIDFromContext(ctx); ok {
+		if _, err := s.getOwnProject(ctx, commitment.ProjectID); err != nil {
+			if errors.Is(err, domain.ErrProjectNotFound) {
+				return nil, domain.ErrCommitmentNotFound
+			}
+			return nil, err
+		}
+	}
+	return commitment, nil
+}
+
+// withPosition pairs a commitment with the position of its head
+func (s *projectService) withPosition(ctx context.Context, commitment *domain.BudgetCommitment) (*domain.BudgetCommitment, *domain.BudgetHead, error) {
+	heads, err := s.repo.BudgetPositions(ctx, commitment.ProjectID, commitment.FinancialYear, commitment.Category)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load budget position: %w", err)
+	}
+	position := domain.BudgetHead{FinancialYear: commitment.FinancialYear, Category: commitment.Category}
+	if len(heads) > 0 {
+		position = heads[0]
+	}
+	return commitment, &position, nil
+}
+
+// headCategory spells a category the way the project's head for the year
+// does, so commitments group with their head
+func headCategory(project *domain.Project, financialYear, category string) string {
+	category = strings.TrimSpace(category)
+	for _, h := range project.BudgetHeads {
+		if h.FinancialYear == financialYear && strings.EqualFold(h.Category, category) {
+			return h.Category
+		}
+	}
+	return category
+}
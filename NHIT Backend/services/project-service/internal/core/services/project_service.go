@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/project-service/internal/core/ports"
 	"github.com/google/uuid"
@@ -29,13 +32,17 @@ func NewProjectService(repo ports.ProjectRepository, kafka ports.KafkaConsumer,
 }
 
 // CreateProject creates a new project with validation
-func (s *projectService) CreateProject(ctx context.Context, tenantID, orgID uuid.UUID, name, createdBy string) (*domain.Project, error) {
+func (s *projectService) CreateProject(ctx context.Context, tenantID, orgID uuid.UUID, name, createdBy string, details domain.ProjectDetails) (*domain.Project, error) {
 
 	// Create new project
 	project, err := domain.NewProject(tenantID, orgID, name, createdBy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
+	details.ProjectName = nil
+	if err := project.ApplyDetails(details); err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
 
 	// Save to repository
 	createdProject, err := s.repo.Create(ctx, project)
@@ -58,8 +65,8 @@ func (s *projectService) GetProject(ctx context.Context, projectID uuid.UUID) (*
 	return project, nil
 }
 
-// ListProjectsByOrganization lists all projects for an organization with pagination
-func (s *projectService) ListProjectsByOrganization(ctx context.Context, orgID uuid.UUID, page, pageSize int32) ([]*domain.Project, int, error) {
+// ListProjectsByOrganization lists an organization's projects matching the filter with pagination
+func (s *projectService) ListProjectsByOrganization(ctx context.Context, filter domain.ProjectFilter, page, pageSize int32) ([]*domain.Project, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -70,13 +77,151 @@ func (s *projectService) ListProjectsByOrganization(ctx context.Context, orgID u
 	limit := int(pageSize)
 	offset := int((page - 1) * pageSize)
 
-	projects, totalCount, err := s.repo.ListByOrganization(ctx, orgID, limit, offset)
+	projects, totalCount, err := s.repo.ListByOrganization(ctx, filter, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list projects: %w", err)
 	}
 	return projects, totalCount, nil
 }
 
+// UpdateProject changes a project's name, code, description or dates
+func (s *projectService) UpdateProject(ctx context.Context, projectID uuid.UUID, details domain.ProjectDetails) (*domain.Project, error) {
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.ApplyDetails(details); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, project); err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+	return project, nil
+}
+
+// CloseProject closes an active project so no new green notes can be raised against it
+func (s *projectService) CloseProject(ctx context.Context, projectID uuid.UUID, reason string) (*domain.Project, error) {
+	return s.changeStatus(ctx, projectID, func(p *domain.Project, at time.Time) error { return p.Close(reason, at) })
+}
+
+// ReopenProject makes a closed project active again
+func (s *projectService) ReopenProject(ctx context.Context, projectID uuid.UUID, reason string) (*domain.Project, error) {
+	return s.changeStatus(ctx, projectID, func(p *domain.Project, at time.Time) error { return p.Reopen(reason, at) })
+}
+
+// ArchiveProject archives a project; archived projects are read-only
+func (s *projectService) ArchiveProject(ctx context.Context, projectID uuid.UUID, reason string) (*domain.Project, error) {
+	return s.changeStatus(ctx, projectID, func(p *domain.Project, at time.Time) error { return p.Archive(reason, at) })
+}
+
+// DeleteProject removes a project that never committed budget, such as one
+// created by an onboarding that was rolled back
+func (s *projectService) DeleteProject(ctx context.Context, projectID uuid.UUID) error {
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, project.ProjectID); err != nil {
+		return err
+	}
+	s.logger.Printf("Project %s deleted", project.ProjectID)
+	return nil
+}
+
+func (s *projectService) changeStatus(ctx context.Context, projectID uuid.UUID, transition func(*domain.Project, time.Time) error) (*domain.Project, error) {
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	from := project.Status
+	if err := transition(project, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, project); err != nil {
+		return nil, fmt.Errorf("failed to update project status: %w", err)
+	}
+	s.logger.Printf("Project %s moved from %s to %s", project.ProjectID, from, project.Status)
+	return project, nil
+}
+
+// SetProjectBudget replaces a project's sanctioned budget and the category heads of a financial year
+func (s *projectService) SetProjectBudget(ctx context.Context, projectID uuid.UUID, financialYear string, sanctioned money.Amount, heads []domain.BudgetHead) (*domain.Project, error) {
+	fy, err := domain.ParseFinancialYear(financialYear)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.SetBudget(fy, sanctioned, heads); err != nil {
+		return nil, err
+	}
+	var yearHeads []domain.BudgetHead
+	for _, h := range project.BudgetHeads {
+		if h.FinancialYear == fy {
+			yearHeads = append(yearHeads, h)
+		}
+	}
+	if err := s.repo.ReplaceBudget(ctx, project.ProjectID, fy, project.SanctionedBudget, yearHeads); err != nil {
+		return nil, fmt.Errorf("failed to save project budget: %w", err)
+	}
+	return s.repo.GetByID(ctx, project.ProjectID)
+}
+
+// AddProjectMember adds a user to a project, or changes the role of an existing member
+func (s *projectService) AddProjectMember(ctx context.Context, projectID, userID uuid.UUID, role domain.ProjectMemberRole, addedBy string) (*domain.Project, error) {
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.Status == domain.ProjectStatusArchived {
+		return nil, domain.ErrProjectArchived
+	}
+	for _, m := range project.Members {
+		// Demoting a manager is removing them as a manager
+		if m.UserID == userID && m.Role == domain.ProjectMemberRoleManager && role != domain.ProjectMemberRoleManager {
+			if err := project.CanRemoveMember(userID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	member := domain.ProjectMember{UserID: userID, Role: role, AddedBy: addedBy, AddedAt: time.Now().UTC()}
+	if err := s.repo.UpsertMember(ctx, project.ProjectID, member); err != nil {
+		return nil, fmt.Errorf("failed to add project member: %w", err)
+	}
+	return s.repo.GetByID(ctx, project.ProjectID)
+}
+
+// RemoveProjectMember removes a user from a project
+func (s *projectService) RemoveProjectMember(ctx context.Context, projectID, userID uuid.UUID) (*domain.Project, error) {
+	project, err := s.getOwnProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.CanRemoveMember(userID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.RemoveMember(ctx, project.ProjectID, userID); err != nil {
+		return nil, fmt.Errorf("failed to remove project member: %w", err)
+	}
+	return s.repo.GetByID(ctx, project.ProjectID)
+}
+
+// getOwnProject loads a project for a change, hiding projects of other
+// organizations from callers whose token carries an org
+func (s *projectService) getOwnProject(ctx context.Context, projectID uuid.UUID) (*domain.Project, error) {
+	project, err := s.repo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if orgID, ok := middleware.GetOrgIDFromContext(ctx); ok && orgID != project.OrgID.String() {
+		return nil, domain.ErrProjectNotFound
+	}
+	return project, nil
+}
+
 // HandleOrganizationCreatedEvent processes organization created events
 func (s *projectService) HandleOrganizationCreatedEvent(ctx context.Context, event *domain.OrganizationCreatedEvent) error {
 	if len(event.Projects) == 0 {
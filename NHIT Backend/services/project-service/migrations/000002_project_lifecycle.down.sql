@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS project_members;
+DROP TABLE IF EXISTS project_budget_heads;
+
+DROP INDEX IF EXISTS idx_projects_org_status;
+DROP INDEX IF EXISTS idx_projects_org_code;
+
+ALTER TABLE projects
+    DROP CONSTRAINT IF EXISTS chk_projects_dates,
+    DROP CONSTRAINT IF EXISTS chk_projects_status,
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS closed_at,
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS sanctioned_budget,
+    DROP COLUMN IF EXISTS end_date,
+    DROP COLUMN IF EXISTS start_date,
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS project_code;
@@ -0,0 +1,40 @@
+-- Project lifecycle: codes, dates, status, budget heads and members
+ALTER TABLE projects
+    ADD COLUMN IF NOT EXISTS project_code VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    ADD COLUMN IF NOT EXISTS start_date DATE,
+    ADD COLUMN IF NOT EXISTS end_date DATE,
+    ADD COLUMN IF NOT EXISTS sanctioned_budget NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS status_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+ALTER TABLE projects
+    ADD CONSTRAINT chk_projects_status CHECK (status IN ('ACTIVE', 'CLOSED', 'ARCHIVED')),
+    ADD CONSTRAINT chk_projects_dates CHECK (end_date IS NULL OR start_date IS NULL OR end_date >= start_date);
+
+-- Project codes are unique within an organization
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_org_code ON projects(org_id, LOWER(project_code)) WHERE project_code IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_projects_org_status ON projects(org_id, status);
+
+-- Sanctioned budget split by expense category
+CREATE TABLE IF NOT EXISTS project_budget_heads (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    category VARCHAR(255) NOT NULL,
+    sanctioned_amount NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, category)
+);
+
+-- Project managers and members
+CREATE TABLE IF NOT EXISTS project_members (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('MANAGER', 'MEMBER')),
+    added_by VARCHAR(255) NOT NULL,
+    added_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_members_user ON project_members(user_id);
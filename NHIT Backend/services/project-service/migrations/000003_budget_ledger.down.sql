@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS project_budget_commitments;
+
+-- Heads of other financial years cannot fit the old key; keep the latest year
+DELETE FROM project_budget_heads h
+USING project_budget_heads newer
+WHERE h.project_id = newer.project_id AND h.category = newer.category
+    AND h.financial_year < newer.financial_year;
+
+ALTER TABLE project_budget_heads DROP CONSTRAINT IF EXISTS project_budget_heads_pkey;
+ALTER TABLE project_budget_heads ADD PRIMARY KEY (project_id, category);
+ALTER TABLE project_budget_heads DROP COLUMN IF EXISTS financial_year;
@@ -0,0 +1,39 @@
+-- Budget heads per financial year (April to March, e.g. '2025-26')
+ALTER TABLE project_budget_heads ADD COLUMN IF NOT EXISTS financial_year VARCHAR(7);
+
+UPDATE project_budget_heads
+SET financial_year = fy.start_year::TEXT || '-' || LPAD(((fy.start_year + 1) % 100)::TEXT, 2, '0')
+FROM (
+    SELECT project_id AS pid, category AS cat,
+        CASE WHEN EXTRACT(MONTH FROM created_at) >= 4
+            THEN EXTRACT(YEAR FROM created_at)::INT
+            ELSE EXTRACT(YEAR FROM created_at)::INT - 1
+        END AS start_year
+    FROM project_budget_heads
+) fy
+WHERE project_id = fy.pid AND category = fy.cat AND financial_year IS NULL;
+
+ALTER TABLE project_budget_heads ALTER COLUMN financial_year SET NOT NULL;
+ALTER TABLE project_budget_heads DROP CONSTRAINT IF EXISTS project_budget_heads_pkey;
+ALTER TABLE project_budget_heads ADD PRIMARY KEY (project_id, financial_year, category);
+
+-- Commitment ledger: a document reserves budget on submission (COMMITTED),
+-- turns it into spend on approval (ACTUAL) or gives it back (RELEASED)
+CREATE TABLE IF NOT EXISTS project_budget_commitments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    financial_year VARCHAR(7) NOT NULL,
+    category VARCHAR(255) NOT NULL,
+    source_type VARCHAR(50) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    amount NUMERIC(20, 2) NOT NULL CHECK (amount >= 0),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('COMMITTED', 'ACTUAL', 'RELEASED')),
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (source_type, source_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budget_commitments_head
+    ON project_budget_commitments(project_id, financial_year, LOWER(category))
+    WHERE status <> 'RELEASED';
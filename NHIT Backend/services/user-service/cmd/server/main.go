@@ -12,9 +12,13 @@ import (
 
 	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
 	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
 	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/authclient"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/directory"
 	grpcHandler "github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/grpc"
 	httpHandler "github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/http"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/notifier"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/repository"
 	sqlc "github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/repository/sqlc/generated"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/config"
@@ -74,17 +78,40 @@ func main() {
 	// Initialize sqlc queries with local SQLC
 	queries := sqlc.New(pool)
 
+	// Field encryption for bank details
+	keyManager, err := fieldcrypt.NewLocalKeyManagerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize field encryption: %v", err)
+	}
+	encryptor := fieldcrypt.NewEncryptor(keyManager)
+
 	// Initialize repositories
-	userRepo := repository.NewUserRepository(queries, pool)
+	userRepo := repository.NewUserRepository(queries, pool, encryptor)
 	tenantRepo := repository.NewTenantRepository(queries)
 	userRoleRepo := repository.NewUserRoleRepository(queries)
 	roleRepo := repository.NewRoleRepository(queries)
 	permissionRepo := repository.NewPermissionRepository(queries)
 	loginHistoryRepo := repository.NewLoginHistoryRepository(queries)
 	activityLogRepo := repository.NewActivityLogRepository(queries)
+	membershipRepo := repository.NewMembershipRepository(pool)
+	invitationRepo := repository.NewInvitationRepository(pool)
+	delegationRepo := repository.NewDelegationRepository(pool)
+	roleScopeRepo := repository.NewRoleScopeRepository(pool)
 
 	// Initialize services
 	userService := services.NewUserService(userRepo, tenantRepo, userRoleRepo, roleRepo, permissionRepo, loginHistoryRepo, activityLogRepo)
+	membershipService := services.NewMembershipService(membershipRepo, userRepo, userRoleRepo, roleRepo)
+
+	// Invitations are emailed through the notification service
+	notificationServiceURL := os.Getenv("NOTIFICATION_SERVICE_URL")
+	if notificationServiceURL == "" {
+		notificationServiceURL = "http://localhost:50060"
+	}
+	notificationClient := notifier.NewNotificationClient(notificationServiceURL)
+	invitationConfig, err := config.LoadInvitationConfig()
+	if err != nil {
+		log.Fatalf("Failed to load invitation config: %v", err)
+	}
 
 	// Connect to Auth Service for token validation / context
 	authServiceURL := os.Getenv("AUTH_SERVICE_URL")
@@ -98,6 +125,9 @@ func main() {
 	defer authConn.Close()
 	authClient := authpb.NewAuthServiceClient(authConn)
 
+	// New invitees' passwords are checked against the tenant policy kept by the auth service
+	invitationService := services.NewInvitationService(invitationRepo, membershipRepo, userService, userRepo, roleRepo, notificationClient, authclient.NewPasswordPolicyClient(authClient), invitationConfig)
+
 	// Connect to Department Service
 	departmentServiceURL := os.Getenv("DEPARTMENT_SERVICE_URL")
 	if departmentServiceURL == "" {
@@ -148,7 +178,13 @@ func main() {
 	}
 
 	// Initialize handlers (pass DB pool so handler can query user_organizations/organizations)
-	userGrpcHandler := grpcHandler.NewUserHandler(userService, pool, authClient, deptConn, desigConn, minioClient)
+	directoryClient := directory.NewDirectoryClient(deptConn, desigConn)
+	bulkImportService := services.NewBulkImportService(directoryClient, userService, membershipService, invitationService, userRepo, userRoleRepo, roleRepo, membershipRepo, invitationRepo)
+
+	delegationService := services.NewDelegationService(delegationRepo, userRepo, userRoleRepo, permissionRepo, membershipRepo)
+	accessService := services.NewAccessPolicyService(roleScopeRepo, userRepo, userRoleRepo, roleRepo, membershipRepo, delegationRepo, directoryClient)
+
+	userGrpcHandler := grpcHandler.NewUserHandler(userService, pool, authClient, deptConn, desigConn, minioClient, membershipService, invitationService, bulkImportService, delegationService, accessService)
 	tenantHttpHandler := httpHandler.NewTenantHTTPHandler(userService)
 
 	// Initialize RBAC interceptor for gRPC
@@ -7,7 +7,9 @@ require (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/userpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/spreadsheet v0.0.0
 	github.com/google/uuid v1.6.0
 	github.com/gorilla/mux v1.8.1
 	github.com/jackc/pgx/v5 v5.7.2
@@ -31,9 +33,15 @@ require (
 	github.com/minio/crc64nvme v1.1.0 // indirect
 	github.com/minio/md5-simd v1.1.2 // indirect
 	github.com/philhofer/fwd v1.2.0 // indirect
+	github.com/richardlehane/mscfb v1.0.4 // indirect
+	github.com/richardlehane/msoleps v1.0.4 // indirect
 	github.com/rogpeppe/go-internal v1.14.1 // indirect
 	github.com/rs/xid v1.6.0 // indirect
+	github.com/tiendc/go-deepcopy v1.6.0 // indirect
 	github.com/tinylib/msgp v1.3.0 // indirect
+	github.com/xuri/efp v0.0.1 // indirect
+	github.com/xuri/excelize/v2 v2.9.1 // indirect
+	github.com/xuri/nfp v0.0.1 // indirect
 	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
 	golang.org/x/sync v0.17.0 // indirect
 	golang.org/x/sys v0.37.0 // indirect
@@ -54,3 +62,7 @@ replace github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb => ../../api/pb/d
 replace github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb => ../../api/pb/designationpb
 
 replace github.com/ShristiRnr/NHIT_Backend/pkg/middleware => ../../pkg/middleware
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt => ../../pkg/fieldcrypt
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/spreadsheet => ../../pkg/spreadsheet
@@ -0,0 +1,39 @@
+package authclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// PasswordPolicyClient checks passwords through the auth service, which owns
+// the tenant password policy and the breached password list
+type PasswordPolicyClient struct {
+	auth authpb.AuthServiceClient
+}
+
+// Ensure PasswordPolicyClient implements ports.PasswordPolicyClient at compile time
+var _ ports.PasswordPolicyClient = (*PasswordPolicyClient)(nil)
+
+// NewPasswordPolicyClient creates a password policy client over the auth service client
+func NewPasswordPolicyClient(auth authpb.AuthServiceClient) *PasswordPolicyClient {
+	return &PasswordPolicyClient{auth: auth}
+}
+
+func (c *PasswordPolicyClient) ValidatePassword(ctx context.Context, tenantID uuid.UUID, password string) error {
+	resp, err := c.auth.ValidatePassword(ctx, &authpb.ValidatePasswordRequest{
+		TenantId: tenantID.String(),
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to validate password: %w", err)
+	}
+	if !resp.Valid {
+		return errors.New(resp.Message)
+	}
+	return nil
+}
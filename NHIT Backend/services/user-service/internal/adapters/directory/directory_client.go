@@ -0,0 +1,128 @@
+package directory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	departmentpb "github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb"
+	designationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// listPageSize is the page size used to read every department or designation
+const listPageSize = 100
+
+// DirectoryClient calls the department and designation services with the
+// caller's credentials, so their own permission checks apply
+type DirectoryClient struct {
+	departments  departmentpb.DepartmentServiceClient
+	designations designationpb.DesignationServiceClient
+}
+
+// Ensure DirectoryClient implements ports.DirectoryClient at compile time
+var _ ports.DirectoryClient = (*DirectoryClient)(nil)
+
+// NewDirectoryClient creates a directory client over the service connections
+func NewDirectoryClient(deptConn, desigConn *grpc.ClientConn) *DirectoryClient {
+	return &DirectoryClient{
+		departments:  departmentpb.NewDepartmentServiceClient(deptConn),
+		designations: designationpb.NewDesignationServiceClient(desigConn),
+	}
+}
+
+// outgoing forwards the caller's metadata and scopes the call to the organization
+func outgoing(ctx context.Context, orgID uuid.UUID) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set("x-org-id", orgID.String())
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// rowError reduces a create failure to the message the service gave, which is
+// reported against the row
+func rowError(err error) error {
+	return errors.New(status.Convert(err).Message())
+}
+
+func (c *DirectoryClient) ListDepartments(ctx context.Context, orgID uuid.UUID) ([]domain.DirectoryEntry, error) {
+	ctx = outgoing(ctx, orgID)
+	var entries []domain.DirectoryEntry
+	for page := int32(1); ; page++ {
+		resp, err := c.departments.ListDepartments(ctx, &departmentpb.ListDepartmentsRequest{Page: page, PageSize: listPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list departments: %w", err)
+		}
+		for _, d := range resp.Departments {
+			id, err := uuid.Parse(d.Id)
+			if err != nil {
+				return nil, fmt.Errorf("department-service returned invalid id %q", d.Id)
+			}
+			entries = append(entries, domain.DirectoryEntry{
+				ID:     id,
+				Name:   d.Name,
+				Active: d.Status != departmentpb.DepartmentStatus_DEPARTMENT_STATUS_INACTIVE,
+			})
+		}
+		if len(resp.Departments) < listPageSize || len(entries) >= int(resp.TotalCount) {
+			return entries, nil
+		}
+	}
+}
+
+func (c *DirectoryClient) CreateDepartment(ctx context.Context, orgID uuid.UUID, row domain.DepartmentImportRow, parentID *uuid.UUID, validateOnly bool) (uuid.UUID, error) {
+	req := &departmentpb.CreateDepartmentRequest{
+		Name:           row.Name,
+		Description:    row.Description,
+		CostCentreCode: row.CostCentreCode,
+		ValidateOnly:   validateOnly,
+	}
+	if parentID != nil {
+		req.ParentId = parentID.String()
+	}
+	resp, err := c.departments.CreateDepartment(outgoing(ctx, orgID), req)
+	if err != nil {
+		return uuid.Nil, rowError(err)
+	}
+	return uuid.Parse(resp.Department.GetId())
+}
+
+func (c *DirectoryClient) ListDesignations(ctx context.Context, orgID uuid.UUID) ([]domain.DirectoryEntry, error) {
+	ctx = outgoing(ctx, orgID)
+	var entries []domain.DirectoryEntry
+	for page := int32(1); ; page++ {
+		resp, err := c.designations.ListDesignations(ctx, &designationpb.ListDesignationsRequest{Page: page, PageSize: listPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list designations: %w", err)
+		}
+		for _, d := range resp.Designations {
+			id, err := uuid.Parse(d.Id)
+			if err != nil {
+				return nil, fmt.Errorf("designation-service returned invalid id %q", d.Id)
+			}
+			entries = append(entries, domain.DirectoryEntry{ID: id, Name: d.Name, Active: true})
+		}
+		if len(resp.Designations) < listPageSize || len(entries) >= int(resp.Pagination.GetTotalItems()) {
+			return entries, nil
+		}
+	}
+}
+
+func (c *DirectoryClient) CreateDesignation(ctx context.Context, orgID uuid.UUID, row domain.DesignationImportRow, validateOnly bool) (uuid.UUID, error) {
+	resp, err := c.designations.CreateDesignation(outgoing(ctx, orgID), &designationpb.CreateDesignationRequest{
+		Name:         row.Name,
+		Description:  row.Description,
+		Grade:        row.Grade,
+		Level:        row.Level,
+		ValidateOnly: validateOnly,
+	})
+	if err != nil {
+		return uuid.Nil, rowError(err)
+	}
+	return uuid.Parse(resp.Designation.GetId())
+}
@@ -0,0 +1,303 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/spreadsheet"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Workbook sheet names of a bulk import
+const (
+	departmentsSheetName  = "Departments"
+	designationsSheetName = "Designations"
+	usersSheetName        = "Users"
+)
+
+// Required columns of each bulk import sheet
+var requiredBulkImportColumns = map[string][]string{
+	domain.ImportSheetDepartments:  {"name"},
+	domain.ImportSheetDesignations: {"name"},
+	domain.ImportSheetUsers:        {"email", "department", "designation", "roles"},
+}
+
+// BulkImport creates an organization's departments, designations and users
+// from a workbook or from one file per sheet
+func (h *UserHandler) BulkImport(ctx context.Context, req *userpb.BulkImportRequest) (*userpb.BulkImportResponse, error) {
+	tenantID, callerID, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := orgFromRequestOrContext(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+
+	sheets, err := readBulkImportSheets(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	importerName, _ := middleware.GetUserNameFromContext(ctx)
+	imp := &domain.BulkImport{
+		TenantID:        tenantID,
+		OrgID:           orgID,
+		ImportedBy:      callerID,
+		ImporterName:    importerName,
+		DryRun:          req.DryRun,
+		SendInvitations: req.SendInvitations,
+	}
+	if records, ok := sheets[domain.ImportSheetDepartments]; ok {
+		if imp.Departments, err = parseDepartmentImportRows(records); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "departments: %v", err)
+		}
+	}
+	if records, ok := sheets[domain.ImportSheetDesignations]; ok {
+		if imp.Designations, err = parseDesignationImportRows(records); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "designations: %v", err)
+		}
+	}
+	if records, ok := sheets[domain.ImportSheetUsers]; ok {
+		if imp.Users, err = parseUserImportRows(records); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "users: %v", err)
+		}
+	}
+
+	result, err := h.bulkImportService.Import(ctx, imp)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "bulk import failed: %v", err)
+	}
+	return toPBBulkImportResponse(result), nil
+}
+
+// readBulkImportSheets returns the records of each sheet present in the
+// request, keyed by import sheet
+func readBulkImportSheets(req *userpb.BulkImportRequest) (map[string][][]string, error) {
+	files := map[string]*userpb.ImportFile{
+		domain.ImportSheetDepartments:  req.Departments,
+		domain.ImportSheetDesignations: req.Designations,
+		domain.ImportSheetUsers:        req.Users,
+	}
+	sheets := map[string][][]string{}
+
+	if len(req.GetWorkbook().GetFileContent()) > 0 {
+		for _, f := range files {
+			if len(f.GetFileContent()) > 0 {
+				return nil, fmt.Errorf("send either a workbook or one file per sheet, not both")
+			}
+		}
+		format, err := spreadsheet.DetectFormat(req.Workbook.Format, req.Workbook.FileName)
+		if err != nil {
+			return nil, err
+		}
+		if format != spreadsheet.FormatXLSX {
+			return nil, fmt.Errorf("workbook must be an xlsx file")
+		}
+		read, err := spreadsheet.ReadSheets(req.Workbook.FileContent, departmentsSheetName, designationsSheetName, usersSheetName)
+		if err != nil {
+			return nil, err
+		}
+		for name, sheet := range map[string]string{
+			departmentsSheetName:  domain.ImportSheetDepartments,
+			designationsSheetName: domain.ImportSheetDesignations,
+			usersSheetName:        domain.ImportSheetUsers,
+		} {
+			if records, ok := read[name]; ok {
+				sheets[sheet] = records
+			}
+		}
+		if len(sheets) == 0 {
+			return nil, fmt.Errorf("workbook has none of the sheets %s, %s, %s", departmentsSheetName, designationsSheetName, usersSheetName)
+		}
+		return sheets, nil
+	}
+
+	for sheet, f := range files {
+		if len(f.GetFileContent()) == 0 {
+			continue
+		}
+		format, err := spreadsheet.DetectFormat(f.Format, f.FileName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", sheet, err)
+		}
+		records, err := spreadsheet.Read(format, f.FileContent)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", sheet, err)
+		}
+		sheets[sheet] = records
+	}
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("no file to import")
+	}
+	return sheets, nil
+}
+
+// parseDepartmentImportRows maps departments sheet records to import rows.
+// Row numbers are 1-based file lines, the header being line 1.
+func parseDepartmentImportRows(records [][]string) ([]domain.DepartmentImportRow, error) {
+	index, err := bulkImportHeader(domain.ImportSheetDepartments, records[0])
+	if err != nil {
+		return nil, err
+	}
+	var rows []domain.DepartmentImportRow
+	for i, record := range records[1:] {
+		cells := bulkImportCells{index: index, record: record}
+		if cells.empty() {
+			continue
+		}
+		rows = append(rows, domain.DepartmentImportRow{
+			RowNumber:      i + 2,
+			Name:           cells.get("name"),
+			Description:    cells.get("description"),
+			CostCentreCode: cells.get("cost_centre_code"),
+			Parent:         cells.get("parent"),
+		})
+	}
+	return rows, nil
+}
+
+// parseDesignationImportRows maps designations sheet records to import rows
+func parseDesignationImportRows(records [][]string) ([]domain.DesignationImportRow, error) {
+	index, err := bulkImportHeader(domain.ImportSheetDesignations, records[0])
+	if err != nil {
+		return nil, err
+	}
+	var rows []domain.DesignationImportRow
+	for i, record := range records[1:] {
+		cells := bulkImportCells{index: index, record: record}
+		if cells.empty() {
+			continue
+		}
+		row := domain.DesignationImportRow{
+			RowNumber:   i + 2,
+			Name:        cells.get("name"),
+			Description: cells.get("description"),
+			Grade:       cells.get("grade"),
+		}
+		if v := cells.get("level"); v != "" {
+			level, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || level < 0 {
+				row.ParseErrors = append(row.ParseErrors, domain.ImportError{Field: "level", Message: fmt.Sprintf("expected a whole number, got %q", v)})
+			}
+			row.Level = int32(level)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseUserImportRows maps users sheet records to import rows. Roles are
+// separated by semicolons.
+func parseUserImportRows(records [][]string) ([]domain.UserImportRow, error) {
+	index, err := bulkImportHeader(domain.ImportSheetUsers, records[0])
+	if err != nil {
+		return nil, err
+	}
+	var rows []domain.UserImportRow
+	for i, record := range records[1:] {
+		cells := bulkImportCells{index: index, record: record}
+		if cells.empty() {
+			continue
+		}
+		row := domain.UserImportRow{
+			RowNumber:   i + 2,
+			Name:        cells.get("name"),
+			Email:       cells.get("email"),
+			Department:  cells.get("department"),
+			Designation: cells.get("designation"),
+		}
+		for _, role := range strings.Split(cells.get("roles"), ";") {
+			if role = strings.TrimSpace(role); role != "" {
+				row.Roles = append(row.Roles, role)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// bulkImportHeader indexes a header row by column name, lowercased with
+// spaces as underscores, and checks the sheet's required columns
+func bulkImportHeader(sheet string, header []string) (map[string]int, error) {
+	index := map[string]int{}
+	for i, name := range header {
+		key := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+		if _, dup := index[key]; !dup {
+			index[key] = i
+		}
+	}
+	var missing []string
+	for _, col := range requiredBulkImportColumns[sheet] {
+		if _, ok := index[col]; !ok {
+			missing = append(missing, col)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required columns: %s", strings.Join(missing, ", "))
+	}
+	return index, nil
+}
+
+// bulkImportCells reads named cells of one import record
+type bulkImportCells struct {
+	index  map[string]int
+	record []string
+}
+
+func (c bulkImportCells) get(col string) string {
+	if i, ok := c.index[col]; ok && i < len(c.record) {
+		return c.record[i]
+	}
+	return ""
+}
+
+func (c bulkImportCells) empty() bool {
+	for _, v := range c.record {
+		if v != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func toPBBulkImportResponse(result *domain.BulkImportResult) *userpb.BulkImportResponse {
+	resp := &userpb.BulkImportResponse{
+		DryRun:     result.DryRun,
+		Rows:       make([]*userpb.BulkImportRow, 0, len(result.Rows)),
+		Errors:     make([]*userpb.BulkImportError, 0, len(result.Errors)),
+		TotalRows:  int32(len(result.Rows)),
+		FailedRows: int32(result.FailedRows()),
+	}
+	for _, row := range result.Rows {
+		pbRow := &userpb.BulkImportRow{
+			Sheet:     row.Sheet,
+			RowNumber: int32(row.RowNumber),
+			Key:       row.Key,
+			Action:    row.Action,
+		}
+		if row.ID != nil {
+			pbRow.Id = row.ID.String()
+		}
+		resp.Rows = append(resp.Rows, pbRow)
+	}
+	for _, e := range result.Errors {
+		resp.Errors = append(resp.Errors, &userpb.BulkImportError{
+			Sheet:     e.Sheet,
+			RowNumber: int32(e.RowNumber),
+			Field:     e.Field,
+			Message:   e.Message,
+		})
+	}
+	return resp
+}
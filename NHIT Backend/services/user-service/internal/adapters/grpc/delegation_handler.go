@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+
+	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toPBDelegation(d *domain.Delegation) *userpb.DelegationResponse {
+	resp := &userpb.DelegationResponse{
+		DelegationId:  d.DelegationID.String(),
+		DelegatorId:   d.DelegatorID.String(),
+		DelegatorName: d.DelegatorName,
+		DelegateId:    d.DelegateID.String(),
+		DelegateName:  d.DelegateName,
+		Permissions:   d.Permissions,
+		OrgId:         safeUUIDStr(d.OrgID),
+		ProjectId:     safeUUIDStr(d.ProjectID),
+		StartsAt:      timestamppb.New(d.StartsAt),
+		EndsAt:        timestamppb.New(d.EndsAt),
+		Reason:        d.Reason,
+		Status:        d.Status(),
+		CreatedBy:     d.CreatedBy.String(),
+		CreatedAt:     timestamppb.New(d.CreatedAt),
+	}
+	if d.RevokedAt != nil {
+		resp.RevokedAt = timestamppb.New(*d.RevokedAt)
+	}
+	return resp
+}
+
+// CreateDelegation delegates approval permissions of the caller, or of another
+// user when the caller holds manage-delegations
+func (h *UserHandler) CreateDelegation(ctx context.Context, req *userpb.CreateDelegationRequest) (*userpb.DelegationResponse, error) {
+	tenantID, callerID, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorID := callerID
+	if req.DelegatorId != "" {
+		if delegatorID, err = uuid.Parse(req.DelegatorId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delegator_id: %v", err)
+		}
+	}
+	if delegatorID != callerID && !middleware.HasPermission(ctx, config.ManageDelegationsPermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "delegating for another user requires %s", config.ManageDelegationsPermission)
+	}
+	delegateID, err := uuid.Parse(req.DelegateId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegate_id: %v", err)
+	}
+	orgID, err := parseOptionalUUID(req.OrgId, "org_id")
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := parseOptionalUUID(req.ProjectId, "project_id")
+	if err != nil {
+		return nil, err
+	}
+
+	delegation := &domain.Delegation{
+		TenantID:    tenantID,
+		DelegatorID: delegatorID,
+		DelegateID:  delegateID,
+		Permissions: req.Permissions,
+		OrgID:       orgID,
+		ProjectID:   projectID,
+		Reason:      req.Reason,
+		CreatedBy:   callerID,
+	}
+	if req.StartsAt != nil {
+		delegation.StartsAt = req.StartsAt.AsTime()
+	}
+	if req.EndsAt != nil {
+		delegation.EndsAt = req.EndsAt.AsTime()
+	}
+
+	created, err := h.delegationService.CreateDelegation(ctx, delegation)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create delegation: %v", err)
+	}
+
+	return toPBDelegation(created), nil
+}
+
+// ListDelegations lists the tenant's delegations; without manage-delegations
+// only those the caller gives or receives
+func (h *UserHandler) ListDelegations(ctx context.Context, req *userpb.ListDelegationsRequest) (*userpb.ListDelegationsResponse, error) {
+	tenantID, callerID, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.DelegationFilter{
+		TenantID:   tenantID,
+		ActiveOnly: req.ActiveOnly,
+		Permission: req.Permission,
+	}
+	if filter.DelegatorID, err = parseOptionalUUID(req.DelegatorId, "delegator_id"); err != nil {
+		return nil, err
+	}
+	if filter.DelegateID, err = parseOptionalUUID(req.DelegateId, "delegate_id"); err != nil {
+		return nil, err
+	}
+	if filter.OrgID, err = parseOptionalUUID(req.OrgId, "org_id"); err != nil {
+		return nil, err
+	}
+	if filter.ProjectID, err = parseOptionalUUID(req.ProjectId, "project_id"); err != nil {
+		return nil, err
+	}
+	if !middleware.HasPermission(ctx, config.ManageDelegationsPermission) {
+		filter.Involving = &callerID
+	}
+
+	delegations, err := h.delegationService.ListDelegations(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list delegations: %v", err)
+	}
+
+	resp := &userpb.ListDelegationsResponse{Delegations: make([]*userpb.DelegationResponse, 0, len(delegations))}
+	for _, d := range delegations {
+		resp.Delegations = append(resp.Delegations, toPBDelegation(d))
+	}
+	return resp, nil
+}
+
+// RevokeDelegation ends a delegation before its end date
+func (h *UserHandler) RevokeDelegation(ctx context.Context, req *userpb.RevokeDelegationRequest) (*userpb.DelegationResponse, error) {
+	tenantID, callerID, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationID, err := uuid.Parse(req.DelegationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegation_id: %v", err)
+	}
+
+	canManage := middleware.HasPermission(ctx, config.ManageDelegationsPermission)
+	revoked, err := h.delegationService.RevokeDelegation(ctx, tenantID, delegationID, callerID, canManage)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to revoke delegation: %v", err)
+	}
+
+	return toPBDelegation(revoked), nil
+}
@@ -39,6 +39,7 @@ func (h *UserHandler) GetDepartmentsDropdown(ctx context.Context, req *userpb.Ge
 	deptResp, err := deptClient.ListDepartments(ctx, &departmentpb.ListDepartmentsRequest{
 		Page:     1,
 		PageSize: 1000, // Get all departments for dropdown
+		Status:   departmentpb.DepartmentStatus_DEPARTMENT_STATUS_ACTIVE,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch departments from department-service: %v", err)
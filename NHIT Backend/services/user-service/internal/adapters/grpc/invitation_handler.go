@@ -0,0 +1,324 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// callerTenantAndUser returns the tenant and user set on the context by the RBAC interceptor
+func callerTenantAndUser(ctx context.Context) (uuid.UUID, uuid.UUID, error) {
+	tenantIDStr, err := middleware.GetTenantIDFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	userIDStr, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	tenantID, err := uuid.Parse(tenantIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.Unauthenticated, "invalid tenant_id in context: %v", err)
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.Unauthenticated, "invalid user_id in context: %v", err)
+	}
+	return tenantID, userID, nil
+}
+
+// orgFromRequestOrContext parses the requested org_id, falling back to the caller's current organization
+func orgFromRequestOrContext(ctx context.Context, orgIDStr string) (uuid.UUID, error) {
+	if orgIDStr == "" {
+		ctxOrgID, ok := middleware.GetOrgIDFromContext(ctx)
+		if !ok {
+			return uuid.Nil, status.Error(codes.InvalidArgument, "org_id is required")
+		}
+		orgIDStr = ctxOrgID
+	}
+
+	orgID, err := uuid.Parse(orgIDStr)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid org_id: %v", err)
+	}
+	return orgID, nil
+}
+
+func parseOptionalUUID(value, field string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return &parsed, nil
+}
+
+func toPBInvitation(inv *domain.Invitation) *userpb.InvitationResponse {
+	roleIDs := make([]string, len(inv.RoleIDs))
+	for i, roleID := range inv.RoleIDs {
+		roleIDs[i] = roleID.String()
+	}
+
+	return &userpb.InvitationResponse{
+		InvitationId:  inv.InvitationID.String(),
+		Email:         inv.Email,
+		Name:          inv.Name,
+		OrgId:         inv.OrgID.String(),
+		OrgName:       inv.OrgName,
+		RoleIds:       roleIDs,
+		DepartmentId:  safeUUIDStr(inv.DepartmentID),
+		DesignationId: safeUUIDStr(inv.DesignationID),
+		Status:        inv.Status(),
+		ExpiresAt:     timestamppb.New(inv.ExpiresAt),
+		CreatedAt:     timestamppb.New(inv.CreatedAt),
+	}
+}
+
+// userResponseWithRoles builds a UserResponse including the user's current roles and permissions
+func (h *UserHandler) userResponseWithRoles(ctx context.Context, user *domain.User) *userpb.UserResponse {
+	var roleNames []string
+	var permissions []string
+
+	roles, err := h.userService.GetUserRoles(ctx, user.UserID)
+	if err == nil {
+		permMap := make(map[string]bool)
+		for _, r := range roles {
+			roleNames = append(roleNames, r.Name)
+			for _, p := range r.Permissions {
+				if !permMap[p] {
+					permissions = append(permissions, p)
+					permMap[p] = true
+				}
+			}
+		}
+	}
+
+	return domainUserToProto(user, roleNames, permissions)
+}
+
+// AddUserToOrganization adds an existing user of the tenant to an organization
+func (h *UserHandler) AddUserToOrganization(ctx context.Context, req *userpb.AddUserToOrganizationRequest) (*userpb.UserResponse, error) {
+	tenantID, callerID, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid org_id: %v", err)
+	}
+	roleID, err := uuid.Parse(req.RoleId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role_id: %v", err)
+	}
+	departmentID, err := parseOptionalUUID(req.DepartmentId, "department_id")
+	if err != nil {
+		return nil, err
+	}
+	designationID, err := parseOptionalUUID(req.DesignationId, "designation_id")
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(req.ProjectIds))
+	for _, p := range req.ProjectIds {
+		projectID, err := uuid.Parse(p)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id %q: %v", p, err)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+
+	err = h.membershipService.AddUserToOrganization(ctx, tenantID, &domain.UserOrganizationRole{
+		UserID:        userID,
+		OrgID:         orgID,
+		RoleID:        roleID,
+		DepartmentID:  departmentID,
+		DesignationID: designationID,
+		ProjectIDs:    projectIDs,
+		AssignedBy:    callerID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to add user to organization: %v", err)
+	}
+
+	user, err := h.userService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	return h.userResponseWithRoles(ctx, user), nil
+}
+
+// RemoveUserFromOrganization removes a user's membership of an organization
+func (h *UserHandler) RemoveUserFromOrganization(ctx context.Context, req *userpb.RemoveUserFromOrganizationRequest) (*emptypb.Empty, error) {
+	tenantID, callerID, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	orgID, err := uuid.Parse(req.OrgId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid org_id: %v", err)
+	}
+
+	if err := h.membershipService.RemoveUserFromOrganization(ctx, tenantID, userID, orgID); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to remove user from organization: %v", err)
+	}
+	log.Printf("🔒 User %s removed from organization %s by %s (reason: %s)", userID, orgID, callerID, req.Reason)
+
+	// Tokens carry the organization, so end the user's sessions to drop access immediately
+	if h.authClient != nil {
+		outCtx := ctx
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if token := firstMetadataValue(md, "authorization"); token != "" {
+				outCtx = metadata.AppendToOutgoingContext(ctx, "authorization", token)
+			}
+		}
+		if _, err := h.authClient.RevokeUserSessions(outCtx, &authpb.RevokeUserSessionsRequest{UserId: userID.String()}); err != nil {
+			log.Printf("⚠️ Failed to revoke sessions of user %s: %v", userID, err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// InviteUser invites an email address to join an organization
+func (h *UserHandler) InviteUser(ctx context.Context, req *userpb.InviteUserRequest) (*userpb.InvitationResponse, error) {
+	tenantID, callerID, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := orgFromRequestOrContext(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(req.RoleIds))
+	for _, r := range req.RoleIds {
+		roleID, err := uuid.Parse(r)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role_id %q: %v", r, err)
+		}
+		roleIDs = append(roleIDs, roleID)
+	}
+	departmentID, err := parseOptionalUUID(req.DepartmentId, "department_id")
+	if err != nil {
+		return nil, err
+	}
+	designationID, err := parseOptionalUUID(req.DesignationId, "designation_id")
+	if err != nil {
+		return nil, err
+	}
+
+	inviterName, _ := middleware.GetUserNameFromContext(ctx)
+
+	invitation, err := h.invitationService.InviteUser(ctx, &domain.Invitation{
+		TenantID:      tenantID,
+		OrgID:         orgID,
+		Email:         req.Email,
+		Name:          req.Name,
+		RoleIDs:       roleIDs,
+		DepartmentID:  departmentID,
+		DesignationID: designationID,
+		InvitedBy:     callerID,
+	}, inviterName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to invite user: %v", err)
+	}
+
+	return toPBInvitation(invitation), nil
+}
+
+// ListInvitations lists the invitations of an organization
+func (h *UserHandler) ListInvitations(ctx context.Context, req *userpb.ListInvitationsRequest) (*userpb.ListInvitationsResponse, error) {
+	tenantID, _, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := orgFromRequestOrContext(ctx, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := h.invitationService.ListInvitations(ctx, tenantID, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list invitations: %v", err)
+	}
+
+	resp := &userpb.ListInvitationsResponse{Invitations: make([]*userpb.InvitationResponse, 0, len(invitations))}
+	for _, inv := range invitations {
+		resp.Invitations = append(resp.Invitations, toPBInvitation(inv))
+	}
+	return resp, nil
+}
+
+// RevokeInvitation cancels a pending invitation
+func (h *UserHandler) RevokeInvitation(ctx context.Context, req *userpb.RevokeInvitationRequest) (*emptypb.Empty, error) {
+	tenantID, _, err := callerTenantAndUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := uuid.Parse(req.InvitationId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation_id: %v", err)
+	}
+
+	if err := h.invitationService.RevokeInvitation(ctx, tenantID, invitationID); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to revoke invitation: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetInvitation returns the pending invitation behind a signed link (public)
+func (h *UserHandler) GetInvitation(ctx context.Context, req *userpb.GetInvitationRequest) (*userpb.InvitationResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	invitation, err := h.invitationService.GetInvitationByToken(ctx, req.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	return toPBInvitation(invitation), nil
+}
+
+// AcceptInvitation accepts an invitation, creating the user if needed (public)
+func (h *UserHandler) AcceptInvitation(ctx context.Context, req *userpb.AcceptInvitationRequest) (*userpb.UserResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	user, err := h.invitationService.AcceptInvitation(ctx, req.Token, req.Name, req.Password, req.UseSso)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to accept invitation: %v", err)
+	}
+
+	return h.userResponseWithRoles(ctx, user), nil
+}
@@ -10,6 +10,10 @@ import (
 	deptpb "github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb"
 	desigpb "github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb"
 	userpb "github.com/ShristiRnr/NHIT_Backend/api/pb/userpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/config"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/storage"
@@ -33,17 +37,28 @@ type UserHandler struct {
 	deptConn    *grpc.ClientConn
 	desigConn   *grpc.ClientConn
 	minioClient *storage.MinIOClient
+
+	membershipService ports.MembershipService
+	invitationService ports.InvitationService
+	bulkImportService ports.BulkImportService
+	delegationService ports.DelegationService
+	accessService     ports.AccessPolicyService
 }
 
 // NewUserHandler creates a new gRPC user handler
-func NewUserHandler(userService ports.UserService, db *pgxpool.Pool, authClient authpb.AuthServiceClient, deptConn *grpc.ClientConn, desigConn *grpc.ClientConn, minioClient *storage.MinIOClient) *UserHandler {
+func NewUserHandler(userService ports.UserService, db *pgxpool.Pool, authClient authpb.AuthServiceClient, deptConn *grpc.ClientConn, desigConn *grpc.ClientConn, minioClient *storage.MinIOClient, membershipService ports.MembershipService, invitationService ports.InvitationService, bulkImportService ports.BulkImportService, delegationService ports.DelegationService, accessService ports.AccessPolicyService) *UserHandler {
 	return &UserHandler{
-		userService: userService,
-		db:          db,
-		authClient:  authClient,
-		deptConn:    deptConn,
-		desigConn:   desigConn,
-		minioClient: minioClient,
+		userService:       userService,
+		db:                db,
+		authClient:        authClient,
+		deptConn:          deptConn,
+		desigConn:         desigConn,
+		minioClient:       minioClient,
+		membershipService: membershipService,
+		invitationService: invitationService,
+		bulkImportService: bulkImportService,
+		delegationService: delegationService,
+		accessService:     accessService,
 	}
 }
 
@@ -317,7 +332,9 @@ func (h *UserHandler) GetUser(ctx context.Context, req *userpb.GetUserRequest) (
 		}
 	}
 
-	return domainUserToProto(user, roleNames, permissions), nil
+	resp := domainUserToProto(user, roleNames, permissions)
+	revealBankDetails(ctx, resp, user)
+	return resp, nil
 }
 
 func (h *UserHandler) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequest) (*userpb.UserResponse, error) {
@@ -326,6 +343,16 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
 	}
 
+	// A department named in the request only admitted the call; the caller
+	// must hold edit-user for the department the user is in now
+	existing, err := h.userService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+	if !middleware.Can(ctx, userResource(existing.DepartmentID), "edit-user") {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions on the user's department")
+	}
+
 	user := &domain.User{
 		UserID:   userID,
 		Name:     req.Name,
@@ -340,6 +367,9 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 			return nil, status.Errorf(codes.InvalidArgument, "invalid department_id: %v", err)
 		}
 		user.DepartmentID = &id
+		if !middleware.Can(ctx, userResource(user.DepartmentID), "edit-user") {
+			return nil, status.Error(codes.PermissionDenied, "insufficient permissions on the target department")
+		}
 	}
 
 	if req.DesignationId != "" {
@@ -388,6 +418,15 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 	return domainUserToProto(updatedUser, roleNames, permissions), nil
 }
 
+// userResource is the resource of a user in a department, in the caller's organization
+func userResource(departmentID *uuid.UUID) policy.Resource {
+	var res policy.Resource
+	if departmentID != nil {
+		res.DepartmentID = departmentID.String()
+	}
+	return res
+}
+
 // Helper function to convert domain user to protobuf user with full details
 func domainUserToProto(user *domain.User, roleNames []string, permissions []string) *userpb.UserResponse {
 	resp := &userpb.UserResponse{
@@ -404,9 +443,23 @@ func domainUserToProto(user *domain.User, roleNames []string, permissions []stri
 	if user.DesignationID != nil {
 		resp.DesignationId = user.DesignationID.String()
 	}
+	resp.AccountHolderName = stringPtrValue(user.AccountHolderName)
+	resp.BankName = stringPtrValue(user.BankName)
+	resp.BankAccountNumber = fieldcrypt.Mask(stringPtrValue(user.BankAccountNumber))
+	resp.IfscCode = fieldcrypt.Mask(stringPtrValue(user.IFSCCode))
 	return resp
 }
 
+// revealBankDetails replaces the masked bank details in resp with the full values
+// when the caller holds the reveal-bank-details permission.
+func revealBankDetails(ctx context.Context, resp *userpb.UserResponse, user *domain.User) {
+	if !middleware.HasPermission(ctx, config.RevealBankDetailsPermission) {
+		return
+	}
+	resp.BankAccountNumber = stringPtrValue(user.BankAccountNumber)
+	resp.IfscCode = stringPtrValue(user.IFSCCode)
+}
+
 func (h *UserHandler) DeleteUser(ctx context.Context, req *userpb.DeleteUserRequest) (*emptypb.Empty, error) {
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
@@ -1035,6 +1088,23 @@ func (h *UserHandler) GetTenant(ctx context.Context, req *userpb.GetTenantReques
 	}, nil
 }
 
+// DeleteTenant removes a tenant that never got an organization
+func (h *UserHandler) DeleteTenant(ctx context.Context, req *userpb.DeleteTenantRequest) (*emptypb.Empty, error) {
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tenant_id: %v", err)
+	}
+	if req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "password is required")
+	}
+
+	if err := h.userService.DeleteTenant(ctx, tenantID, req.Password); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to delete tenant: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // ListUserOrganizations returns organizations linked to a user.
 // It also lazily creates a mapping for super admin users by
 // linking any organizations where organizations.super_admin_email
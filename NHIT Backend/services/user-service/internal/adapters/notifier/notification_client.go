@@ -0,0 +1,80 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+)
+
+// NotificationClient sends emails through the notification service over HTTP
+type NotificationClient struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// Ensure NotificationClient implements ports.NotificationClient at compile time
+var _ ports.NotificationClient = (*NotificationClient)(nil)
+
+// NewNotificationClient creates a new notification client that communicates via HTTP
+func NewNotificationClient(baseURL string) *NotificationClient {
+	return &NotificationClient{
+		BaseURL: baseURL,
+		HTTPClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// sendNotificationRequest represents a request to send a notification
+type sendNotificationRequest struct {
+	To           string                 `json:"to"`
+	TemplateName string                 `json:"template_name"`
+	Data         map[string]interface{} `json:"data"`
+}
+
+// SendInvitationEmail sends the invitation link to an invitee
+func (c *NotificationClient) SendInvitationEmail(ctx context.Context, email, name, orgName, inviterName, link string, expiresAt time.Time) error {
+	req := sendNotificationRequest{
+		To:           email,
+		TemplateName: "user-invitation",
+		Data: map[string]interface{}{
+			"name":            name,
+			"org_name":        orgName,
+			"inviter_name":    inviterName,
+			"invitation_link": link,
+			"expires_at":      expiresAt.Format(time.RFC1123),
+			"current_year":    fmt.Sprintf("%d", time.Now().Year()),
+		},
+	}
+
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/v1/notifications", c.BaseURL)
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("notification service returned non-OK status: %d", resp.StatusCode)
+	}
+
+	log.Printf("📧 Invitation email sent to %s", email)
+	return nil
+}
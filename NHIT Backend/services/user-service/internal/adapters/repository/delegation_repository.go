@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type delegationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewDelegationRepository creates a new approval delegation repository
+func NewDelegationRepository(db *pgxpool.Pool) ports.DelegationRepository {
+	return &delegationRepository{db: db}
+}
+
+const delegationColumns = `
+	d.delegation_id, d.tenant_id, d.delegator_id, COALESCE(dr.name, ''), d.delegate_id, COALESCE(de.name, ''),
+	d.permissions, d.org_id, d.project_id, d.starts_at, d.ends_at, d.reason, d.created_by,
+	d.revoked_at, d.revoked_by, d.created_at, d.updated_at`
+
+const delegationFrom = `
+	FROM approval_delegations d
+	LEFT JOIN users dr ON dr.user_id = d.delegator_id
+	LEFT JOIN users de ON de.user_id = d.delegate_id`
+
+func scanDelegation(row pgx.Row) (*domain.Delegation, error) {
+	d := &domain.Delegation{}
+	err := row.Scan(
+		&d.DelegationID,
+		&d.TenantID,
+		&d.DelegatorID,
+		&d.DelegatorName,
+		&d.DelegateID,
+		&d.DelegateName,
+		&d.Permissions,
+		&d.OrgID,
+		&d.ProjectID,
+		&d.StartsAt,
+		&d.EndsAt,
+		&d.Reason,
+		&d.CreatedBy,
+		&d.RevokedAt,
+		&d.RevokedBy,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (r *delegationRepository) Create(ctx context.Context, delegation *domain.Delegation) (*domain.Delegation, error) {
+	query := `
+		INSERT INTO approval_delegations (tenant_id, delegator_id, delegate_id, permissions, org_id, project_id, starts_at, ends_at, reason, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING delegation_id`
+
+	var delegationID uuid.UUID
+	err := r.db.QueryRow(ctx, query,
+		delegation.TenantID,
+		delegation.DelegatorID,
+		delegation.DelegateID,
+		delegation.Permissions,
+		delegation.OrgID,
+		delegation.ProjectID,
+		delegation.StartsAt,
+		delegation.EndsAt,
+		delegation.Reason,
+		delegation.CreatedBy,
+	).Scan(&delegationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delegation: %w", err)
+	}
+
+	return r.GetByID(ctx, delegationID)
+}
+
+func (r *delegationRepository) GetByID(ctx context.Context, delegationID uuid.UUID) (*domain.Delegation, error) {
+	query := `SELECT ` + delegationColumns + delegationFrom + `
+		WHERE d.delegation_id = $1`
+
+	d, err := scanDelegation(r.db.QueryRow(ctx, query, delegationID))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("delegation not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegation: %w", err)
+	}
+
+	return d, nil
+}
+
+// List returns the tenant's delegations matching the filter, newest first. A
+// delegation without an organization or project matches any.
+func (r *delegationRepository) List(ctx context.Context, filter domain.DelegationFilter) ([]*domain.Delegation, error) {
+	query := `SELECT ` + delegationColumns + delegationFrom + `
+		WHERE d.tenant_id = $1
+		  AND ($2::uuid IS NULL OR d.delegator_id = $2)
+		  AND ($3::uuid IS NULL OR d.delegate_id = $3)
+		  AND ($4::uuid IS NULL OR d.delegator_id = $4 OR d.delegate_id = $4)
+		  AND (NOT $5 OR (d.revoked_at IS NULL AND d.starts_at <= NOW() AND d.ends_at > NOW()))
+		  AND ($6 = '' OR $6 = ANY(d.permissions))
+		  AND ($7::uuid IS NULL OR d.org_id IS NULL OR d.org_id = $7)
+		  AND ($8::uuid IS NULL OR d.project_id IS NULL OR d.project_id = $8)
+		ORDER BY d.created_at DESC`
+
+	rows, err := r.db.Query(ctx, query,
+		filter.TenantID,
+		filter.DelegatorID,
+		filter.DelegateID,
+		filter.Involving,
+		filter.ActiveOnly,
+		filter.Permission,
+		filter.OrgID,
+		filter.ProjectID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delegations: %w", err)
+	}
+	defer rows.Close()
+
+	var delegations []*domain.Delegation
+	for rows.Next() {
+		d, err := scanDelegation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan delegation: %w", err)
+		}
+		delegations = append(delegations, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate delegations: %w", err)
+	}
+
+	return delegations, nil
+}
+
+// Revoke ends a delegation that is not yet revoked or expired
+func (r *delegationRepository) Revoke(ctx context.Context, delegationID, revokedBy uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `
+		UPDATE approval_delegations
+		SET revoked_at = NOW(), revoked_by = $2, updated_at = NOW()
+		WHERE delegation_id = $1 AND revoked_at IS NULL AND ends_at > NOW()`,
+		delegationID, revokedBy)
+	if err != nil {
+		return fmt.Errorf("failed to revoke delegation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("delegation is already revoked or expired")
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type invitationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewInvitationRepository creates a new invitation repository
+func NewInvitationRepository(db *pgxpool.Pool) ports.InvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+const invitationColumns = `
+	i.invitation_id, i.tenant_id, i.org_id, COALESCE(o.name, ''), i.email, i.name, i.role_ids,
+	i.department_id, i.designation_id, i.invited_by, i.expires_at, i.accepted_at,
+	i.accepted_user_id, i.revoked_at, i.created_at, i.updated_at`
+
+func scanInvitation(row pgx.Row) (*domain.Invitation, error) {
+	inv := &domain.Invitation{}
+	err := row.Scan(
+		&inv.InvitationID,
+		&inv.TenantID,
+		&inv.OrgID,
+		&inv.OrgName,
+		&inv.Email,
+		&inv.Name,
+		&inv.RoleIDs,
+		&inv.DepartmentID,
+		&inv.DesignationID,
+		&inv.InvitedBy,
+		&inv.ExpiresAt,
+		&inv.AcceptedAt,
+		&inv.AcceptedUserID,
+		&inv.RevokedAt,
+		&inv.CreatedAt,
+		&inv.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// Create stores a new invitation. Expired invitations of the same email and
+// organization are revoked first so they no longer count as open; a pending
+// one is an error.
+func (r *invitationRepository) Create(ctx context.Context, invitation *domain.Invitation) (*domain.Invitation, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE user_invitations
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE org_id = $1 AND lower(email) = lower($2)
+		  AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at <= NOW()`,
+		invitation.OrgID, invitation.Email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close expired invitations: %w", err)
+	}
+
+	var pending bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM user_invitations
+			WHERE org_id = $1 AND lower(email) = lower($2) AND accepted_at IS NULL AND revoked_at IS NULL
+		)`, invitation.OrgID, invitation.Email).Scan(&pending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check open invitations: %w", err)
+	}
+	if pending {
+		return nil, fmt.Errorf("%s already has a pending invitation to this organization", invitation.Email)
+	}
+
+	query := `
+		INSERT INTO user_invitations (tenant_id, org_id, email, name, role_ids, department_id, designation_id, invited_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING invitation_id`
+
+	var invitationID uuid.UUID
+	err = tx.QueryRow(ctx, query,
+		invitation.TenantID,
+		invitation.OrgID,
+		invitation.Email,
+		invitation.Name,
+		invitation.RoleIDs,
+		invitation.DepartmentID,
+		invitation.DesignationID,
+		invitation.InvitedBy,
+		invitation.ExpiresAt,
+	).Scan(&invitationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit invitation: %w", err)
+	}
+
+	return r.GetByID(ctx, invitationID)
+}
+
+func (r *invitationRepository) GetByID(ctx context.Context, invitationID uuid.UUID) (*domain.Invitation, error) {
+	query := `SELECT ` + invitationColumns + `
+		FROM user_invitations i
+		LEFT JOIN organizations o ON o.org_id = i.org_id
+		WHERE i.invitation_id = $1`
+
+	inv, err := scanInvitation(r.db.QueryRow(ctx, query, invitationID))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("invitation not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	return inv, nil
+}
+
+func (r *invitationRepository) ListByOrganization(ctx context.Context, tenantID, orgID uuid.UUID) ([]*domain.Invitation, error) {
+	query := `SELECT ` + invitationColumns + `
+		FROM user_invitations i
+		LEFT JOIN organizations o ON o.org_id = i.org_id
+		WHERE i.tenant_id = $1 AND i.org_id = $2
+		ORDER BY i.created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, tenantID, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	defer rows.Close()
+
+	var invitations []*domain.Invitation
+	for rows.Next() {
+		inv, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+		invitations = append(invitations, inv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// Accept closes a pending invitation, adds the user to its organization and
+// grants its roles in one transaction. It fails if the invitation was already
+// used, revoked or has expired.
+func (r *invitationRepository) Accept(ctx context.Context, invitationID uuid.UUID, membership *domain.UserOrganizationRole, roleIDs []uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE user_invitations
+		SET accepted_at = NOW(), accepted_user_id = $2, updated_at = NOW()
+		WHERE invitation_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()`,
+		invitationID, membership.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("invitation is no longer pending")
+	}
+
+	projectIDs := membership.ProjectIDs
+	if projectIDs == nil {
+		projectIDs = []uuid.UUID{}
+	}
+	_, err = tx.Exec(ctx, upsertMembershipQuery,
+		membership.UserID, membership.OrgID, membership.RoleID, membership.DepartmentID,
+		membership.DesignationID, projectIDs, membership.AssignedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save organization membership: %w", err)
+	}
+
+	for _, roleID := range roleIDs {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO user_roles (user_id, role_id)
+			VALUES ($1, $2)
+			ON CONFLICT (user_id, role_id) DO NOTHING`,
+			membership.UserID, roleID)
+		if err != nil {
+			return fmt.Errorf("failed to assign role %s: %w", roleID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit invitation acceptance: %w", err)
+	}
+	return nil
+}
+
+func (r *invitationRepository) Revoke(ctx context.Context, invitationID uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `
+		UPDATE user_invitations
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE invitation_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL`,
+		invitationID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("invitation is no longer pending")
+	}
+	return nil
+}
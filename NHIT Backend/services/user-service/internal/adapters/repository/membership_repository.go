@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type membershipRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewMembershipRepository creates a new user-organization membership repository
+func NewMembershipRepository(db *pgxpool.Pool) ports.MembershipRepository {
+	return &membershipRepository{db: db}
+}
+
+// upsertMembershipQuery adds a user to an organization or updates their
+// assignment there. The first organization a user joins becomes their current
+// context.
+const upsertMembershipQuery = `
+	INSERT INTO user_organizations (user_id, org_id, role_id, department_id, designation_id, project_ids, added_by,
+	                                is_current_context, joined_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7,
+	        NOT EXISTS (SELECT 1 FROM user_organizations WHERE user_id = $1), NOW(), NOW())
+	ON CONFLICT (user_id, org_id) DO UPDATE SET
+		role_id = EXCLUDED.role_id,
+		department_id = EXCLUDED.department_id,
+		designation_id = EXCLUDED.designation_id,
+		project_ids = EXCLUDED.project_ids,
+		updated_at = NOW()`
+
+func (r *membershipRepository) Upsert(ctx context.Context, m *domain.UserOrganizationRole) error {
+	projectIDs := m.ProjectIDs
+	if projectIDs == nil {
+		projectIDs = []uuid.UUID{}
+	}
+
+	_, err := r.db.Exec(ctx, upsertMembershipQuery,
+		m.UserID, m.OrgID, m.RoleID, m.DepartmentID, m.DesignationID, projectIDs, m.AssignedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save organization membership: %w", err)
+	}
+	return nil
+}
+
+func (r *membershipRepository) Get(ctx context.Context, userID, orgID uuid.UUID) (*domain.UserOrganizationRole, error) {
+	m := &domain.UserOrganizationRole{}
+	var addedBy *uuid.UUID
+	err := r.db.QueryRow(ctx, `
+		SELECT user_id, org_id, role_id, department_id, designation_id, project_ids, added_by,
+		       is_current_context, joined_at, updated_at
+		FROM user_organizations
+		WHERE user_id = $1 AND org_id = $2`, userID, orgID).Scan(
+		&m.UserID,
+		&m.OrgID,
+		&m.RoleID,
+		&m.DepartmentID,
+		&m.DesignationID,
+		&m.ProjectIDs,
+		&addedBy,
+		&m.IsCurrentContext,
+		&m.AssignedAt,
+		&m.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user is not a member of this organization")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization membership: %w", err)
+	}
+	if addedBy != nil {
+		m.AssignedBy = *addedBy
+	}
+	return m, nil
+}
+
+func (r *membershipRepository) Remove(ctx context.Context, userID, orgID uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var wasCurrent bool
+	err = tx.QueryRow(ctx, `
+		DELETE FROM user_organizations
+		WHERE user_id = $1 AND org_id = $2
+		RETURNING is_current_context`, userID, orgID).Scan(&wasCurrent)
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("user is not a member of this organization")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove organization membership: %w", err)
+	}
+
+	if wasCurrent {
+		_, err = tx.Exec(ctx, `
+			UPDATE user_organizations
+			SET is_current_context = TRUE, updated_at = NOW()
+			WHERE user_id = $1 AND org_id = (
+				SELECT org_id FROM user_organizations WHERE user_id = $1 ORDER BY joined_at ASC LIMIT 1
+			)`, userID)
+		if err != nil {
+			return fmt.Errorf("failed to move current organization: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *membershipRepository) CountWithRole(ctx context.Context, userID, roleID uuid.UUID) (int, error) {
+	var count int
+	err := r.db.QueryRow(ctx, `
+		SELECT COUNT(*) FROM user_organizations WHERE user_id = $1 AND role_id = $2`,
+		userID, roleID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count memberships: %w", err)
+	}
+	return count, nil
+}
+
+func (r *membershipRepository) GetOrganization(ctx context.Context, orgID uuid.UUID) (uuid.UUID, string, error) {
+	var (
+		tenantID *uuid.UUID
+		name     string
+	)
+	err := r.db.QueryRow(ctx, `SELECT tenant_id, name FROM organizations WHERE org_id = $1`, orgID).Scan(&tenantID, &name)
+	if err == pgx.ErrNoRows {
+		return uuid.Nil, "", fmt.Errorf("organization not found")
+	}
+	if err != nil {
+		return uuid.Nil, "", fmt.Errorf("failed to get organization: %w", err)
+	}
+	if tenantID == nil {
+		return uuid.Nil, name, nil
+	}
+	return *tenantID, name, nil
+}
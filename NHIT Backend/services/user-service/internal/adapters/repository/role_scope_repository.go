@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type roleScopeRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewRoleScopeRepository creates a new scoped role assignment repository
+func NewRoleScopeRepository(db *pgxpool.Pool) ports.RoleScopeRepository {
+	return &roleScopeRepository{db: db}
+}
+
+const roleScopeColumns = `
+	s.assignment_id, s.tenant_id, s.user_id, s.role_id, r.name, r.permissions,
+	s.scope_type, s.org_id, s.scope_id, s.expires_at, s.granted_by, s.created_at`
+
+const roleScopeFrom = `
+	FROM user_role_scopes s
+	JOIN roles r ON r.role_id = s.role_id`
+
+func scanRoleScope(row pgx.Row) (*domain.ScopedRoleAssignment, error) {
+	a := &domain.ScopedRoleAssignment{}
+	err := row.Scan(
+		&a.AssignmentID,
+		&a.TenantID,
+		&a.UserID,
+		&a.RoleID,
+		&a.RoleName,
+		&a.Permissions,
+		&a.ScopeType,
+		&a.OrgID,
+		&a.ScopeID,
+		&a.ExpiresAt,
+		&a.GrantedBy,
+		&a.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *roleScopeRepository) Create(ctx context.Context, a *domain.ScopedRoleAssignment) (*domain.ScopedRoleAssignment, error) {
+	query := `
+		INSERT INTO user_role_scopes (tenant_id, user_id, role_id, scope_type, org_id, scope_id, expires_at, granted_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING assignment_id`
+
+	var assignmentID uuid.UUID
+	err := r.db.QueryRow(ctx, query,
+		a.TenantID,
+		a.UserID,
+		a.RoleID,
+		a.ScopeType,
+		a.OrgID,
+		a.ScopeID,
+		a.ExpiresAt,
+		a.GrantedBy,
+	).Scan(&assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign scoped role: %w", err)
+	}
+
+	return r.GetByID(ctx, assignmentID)
+}
+
+func (r *roleScopeRepository) GetByID(ctx context.Context, assignmentID uuid.UUID) (*domain.ScopedRoleAssignment, error) {
+	query := `SELECT ` + roleScopeColumns + roleScopeFrom + `
+		WHERE s.assignment_id = $1`
+
+	a, err := scanRoleScope(r.db.QueryRow(ctx, query, assignmentID))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("scoped role assignment not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scoped role assignment: %w", err)
+	}
+
+	return a, nil
+}
+
+// ListByUser returns the user's scoped role assignments, oldest first
+func (r *roleScopeRepository) ListByUser(ctx context.Context, userID uuid.UUID, activeOnly bool) ([]*domain.ScopedRoleAssignment, error) {
+	query := `SELECT ` + roleScopeColumns + roleScopeFrom + `
+		WHERE s.user_id = $1
+		  AND (NOT $2 OR s.expires_at IS NULL OR s.expires_at > NOW())
+		ORDER BY s.created_at`
+
+	rows, err := r.db.Query(ctx, query, userID, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scoped roles: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []*domain.ScopedRoleAssignment
+	for rows.Next() {
+		a, err := scanRoleScope(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scoped role: %w", err)
+		}
+		assignments = append(assignments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scoped roles: %w", err)
+	}
+
+	return assignments, nil
+}
+
+func (r *roleScopeRepository) Delete(ctx context.Context, assignmentID uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM user_role_scopes WHERE assignment_id = $1`, assignmentID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke scoped role: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("scoped role assignment not found")
+	}
+	return nil
+}
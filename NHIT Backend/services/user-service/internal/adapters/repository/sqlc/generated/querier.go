@@ -29,6 +29,9 @@ type Querier interface {
 	CreateUser(ctx context.Context, arg CreateUserParams) (*User, error)
 	CreateUserWithVerification(ctx context.Context, arg CreateUserWithVerificationParams) (*User, error)
 	DeleteActivityLog(ctx context.Context, id int32) error
+	// Deletes the tenant with its users and roles unless it owns an organization
+	// or one of its users belongs to one
+	DeleteEmptyTenant(ctx context.Context, tenantID pgtype.UUID) (int64, error)
 	DeleteLoginHistory(ctx context.Context, historyID uuid.UUID) error
 	DeleteOldLoginHistories(ctx context.Context, loginTime pgtype.Timestamptz) error
 	DeleteRole(ctx context.Context, roleID uuid.UUID) error
@@ -62,3 +62,34 @@ func (q *Queries) GetTenant(ctx context.Context, tenantID pgtype.UUID) (*Tenant,
 	)
 	return &i, err
 }
+
+const deleteEmptyTenant = `-- name: DeleteEmptyTenant :execrows
+WITH in_use AS (
+    SELECT 1 FROM organizations o WHERE o.tenant_id = $1
+    UNION ALL
+    SELECT 1 FROM user_organizations uo JOIN users u ON u.user_id = uo.user_id WHERE u.tenant_id = $1
+),
+deleted_user_roles AS (
+    DELETE FROM user_roles ur
+    USING users u
+    WHERE ur.user_id = u.user_id AND u.tenant_id = $1 AND NOT EXISTS (SELECT 1 FROM in_use)
+),
+deleted_users AS (
+    DELETE FROM users WHERE tenant_id = $1 AND NOT EXISTS (SELECT 1 FROM in_use)
+),
+deleted_roles AS (
+    DELETE FROM roles WHERE tenant_id = $1 AND NOT EXISTS (SELECT 1 FROM in_use)
+)
+DELETE FROM tenants
+WHERE tenant_id = $1 AND NOT EXISTS (SELECT 1 FROM in_use)
+`
+
+// Deletes the tenant with its users and roles unless it owns an organization
+// or one of its users belongs to one
+func (q *Queries) DeleteEmptyTenant(ctx context.Context, tenantID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEmptyTenant, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -44,6 +44,15 @@ func (r *tenantRepository) GetByID(ctx context.Context, tenantID uuid.UUID) (*do
     return toDomainTenant(dbTenant), nil
 }
 
+func (r *tenantRepository) DeleteIfEmpty(ctx context.Context, tenantID uuid.UUID) (bool, error) {
+    rows, err := r.queries.DeleteEmptyTenant(ctx, pgtype.UUID{Bytes: tenantID, Valid: true})
+    if err != nil {
+        return false, err
+    }
+
+    return rows > 0, nil
+}
+
 func toDomainTenant(dbTenant *sqlc.Tenant) *domain.Tenant {
     return &domain.Tenant{
         TenantID:  uuid.UUID(dbTenant.TenantID.Bytes),
@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/adapters/repository/sqlc/generated"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
@@ -15,14 +17,21 @@ import (
 type userRepository struct {
 	queries *sqlc.Queries
 	db      *pgxpool.Pool
+	crypt   *fieldcrypt.Encryptor
 }
 
-// NewUserRepository creates a new user repository
-func NewUserRepository(queries *sqlc.Queries, db *pgxpool.Pool) ports.UserRepository {
-	return &userRepository{queries: queries, db: db}
+// NewUserRepository creates a new user repository.
+// Bank account numbers and IFSC codes are encrypted with crypt before they are stored.
+func NewUserRepository(queries *sqlc.Queries, db *pgxpool.Pool, crypt *fieldcrypt.Encryptor) ports.UserRepository {
+	return &userRepository{queries: queries, db: db, crypt: crypt}
 }
 
 func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
+	bankAccountNumber, ifscCode, err := r.encryptBankDetails(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
 	// Helper for NullUUID
 	toNullUUID := func(u *uuid.UUID) uuid.NullUUID {
 		if u == nil {
@@ -42,8 +51,8 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain
 			DesignationID:     toNullUUID(user.DesignationID),
 			AccountHolderName: user.AccountHolderName,
 			BankName:          user.BankName,
-			BankAccountNumber: user.BankAccountNumber,
-			IfscCode:          user.IFSCCode,
+			BankAccountNumber: bankAccountNumber,
+			IfscCode:          ifscCode,
 			SignatureUrl:      user.SignatureURL,
 			IsActive:          user.IsActive,
 		}
@@ -53,7 +62,7 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain
 			return nil, err
 		}
 
-		return toDomainUser(dbUser), nil
+		return r.toDomain(ctx, dbUser)
 	}
 
 	params := sqlc.CreateUserParams{
@@ -65,8 +74,8 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain
 		DesignationID:     toNullUUID(user.DesignationID),
 		AccountHolderName: user.AccountHolderName,
 		BankName:          user.BankName,
-		BankAccountNumber: user.BankAccountNumber,
-		IfscCode:          user.IFSCCode,
+		BankAccountNumber: bankAccountNumber,
+		IfscCode:          ifscCode,
 		SignatureUrl:      user.SignatureURL,
 		IsActive:          user.IsActive,
 	}
@@ -76,7 +85,7 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) (*domain
 		return nil, err
 	}
 
-	return toDomainUser(dbUser), nil
+	return r.toDomain(ctx, dbUser)
 }
 
 func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
@@ -85,7 +94,7 @@ func (r *userRepository) GetByID(ctx context.Context, userID uuid.UUID) (*domain
 		return nil, err
 	}
 
-	return toDomainUser(dbUser), nil
+	return r.toDomain(ctx, dbUser)
 }
 
 func (r *userRepository) GetByEmail(ctx context.Context, tenantID uuid.UUID, email string) (*domain.User, error) {
@@ -99,10 +108,15 @@ func (r *userRepository) GetByEmail(ctx context.Context, tenantID uuid.UUID, ema
 		return nil, err
 	}
 
-	return toDomainUser(dbUser), nil
+	return r.toDomain(ctx, dbUser)
 }
 
 func (r *userRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
+	bankAccountNumber, ifscCode, err := r.encryptBankDetails(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
 	// Helper for NullUUID
 	toNullUUID := func(u *uuid.UUID) uuid.NullUUID {
 		if u == nil {
@@ -130,8 +144,8 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) (*domain
 		DesignationID:     toNullUUID(user.DesignationID),
 		AccountHolderName: user.AccountHolderName,
 		BankName:          user.BankName,
-		BankAccountNumber: user.BankAccountNumber,
-		IfscCode:          user.IFSCCode,
+		BankAccountNumber: bankAccountNumber,
+		IfscCode:          ifscCode,
 		SignatureUrl:      user.SignatureURL,
 		IsActive:          user.IsActive,
 		DeactivatedAt:     deactivatedAt,
@@ -143,7 +157,7 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) (*domain
 		return nil, err
 	}
 
-	return toDomainUser(dbUser), nil
+	return r.toDomain(ctx, dbUser)
 }
 
 func (r *userRepository) Delete(ctx context.Context, userID uuid.UUID) error {
@@ -170,7 +184,9 @@ func (r *userRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID, l
 
 	users := make([]*domain.User, len(dbUsers))
 	for i, dbUser := range dbUsers {
-		users[i] = toDomainUser(dbUser)
+		if users[i], err = r.toDomain(ctx, dbUser); err != nil {
+			return nil, 0, err
+		}
 	}
 
 	return users, total, nil
@@ -221,7 +237,11 @@ func (r *userRepository) ListByOrganization(ctx context.Context, tenantID, orgID
 		); err != nil {
 			return nil, 0, err
 		}
-		users = append(users, toDomainUser(&i))
+		user, err := r.toDomain(ctx, &i)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
 	}
 	
 	if err := rows.Err(); err != nil {
@@ -250,6 +270,33 @@ func (r *userRepository) UpdateLastLogin(ctx context.Context, userID uuid.UUID,
 	return err
 }
 
+// encryptBankDetails returns the storage form of the user's bank account number and IFSC code
+func (r *userRepository) encryptBankDetails(ctx context.Context, user *domain.User) (*string, *string, error) {
+	accountNumber, err := r.crypt.EncryptPtr(ctx, user.BankAccountNumber)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt bank account number: %w", err)
+	}
+	ifscCode, err := r.crypt.EncryptPtr(ctx, user.IFSCCode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt IFSC code: %w", err)
+	}
+	return accountNumber, ifscCode, nil
+}
+
+// toDomain converts a db user to a domain user, decrypting the bank details
+func (r *userRepository) toDomain(ctx context.Context, dbUser *sqlc.User) (*domain.User, error) {
+	user := toDomainUser(dbUser)
+
+	var err error
+	if user.BankAccountNumber, err = r.crypt.DecryptPtr(ctx, user.BankAccountNumber); err != nil {
+		return nil, fmt.Errorf("failed to decrypt bank account number: %w", err)
+	}
+	if user.IFSCCode, err = r.crypt.DecryptPtr(ctx, user.IFSCCode); err != nil {
+		return nil, fmt.Errorf("failed to decrypt IFSC code: %w", err)
+	}
+	return user, nil
+}
+
 // Helper functions to convert between db and domain models
 func toDomainUser(dbUser *sqlc.User) *domain.User {
 	var deptID *uuid.UUID
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// InvitationConfig holds the settings for signed invitation links
+type InvitationConfig struct {
+	SigningSecret []byte        // HMAC key used to sign invitation links
+	AcceptURL     string        // Frontend page that receives the token
+	TTL           time.Duration // How long an invitation stays valid
+}
+
+// LoadInvitationConfig loads invitation settings from environment variables.
+// The signing secret has no default: anyone who knows it can forge invitation links.
+func LoadInvitationConfig() (*InvitationConfig, error) {
+	secret := os.Getenv("INVITATION_SIGNING_SECRET")
+	if secret == "" {
+		return nil, fmt.Errorf("INVITATION_SIGNING_SECRET is not set")
+	}
+
+	acceptURL := os.Getenv("INVITATION_ACCEPT_URL")
+	if acceptURL == "" {
+		acceptURL = "http://localhost:3000/accept-invitation"
+	}
+
+	ttl := 72 * time.Hour
+	if hours, err := strconv.Atoi(os.Getenv("INVITATION_TTL_HOURS")); err == nil && hours > 0 {
+		ttl = time.Duration(hours) * time.Hour
+	}
+
+	return &InvitationConfig{
+		SigningSecret: []byte(secret),
+		AcceptURL:     acceptURL,
+		TTL:           ttl,
+	}, nil
+}
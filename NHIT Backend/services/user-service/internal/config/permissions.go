@@ -1,5 +1,13 @@
 package config
 
+// RevealBankDetailsPermission allows a caller to see full bank account numbers
+// and IFSC codes; everyone else receives masked values.
+const RevealBankDetailsPermission = "reveal-bank-details"
+
+// ManageDelegationsPermission allows a caller to create, list and revoke
+// approval delegations of other users. Anyone may delegate their own.
+const ManageDelegationsPermission = "manage-delegations"
+
 // GetPermissionMap returns the permission requirements for each RPC method
 // Note: Method paths are /UserManagement/MethodName because proto has no package declaration
 func GetPermissionMap() map[string][]string {
@@ -32,6 +40,23 @@ func GetPermissionMap() map[string][]string {
 		// User-Organization
 		"/UserManagement/AddUserToOrganization":      {"edit-organizations"}, // Matches organization-service
 		"/UserManagement/RemoveUserFromOrganization": {"edit-organizations"}, // Matches organization-service
+
+		// Invitations
+		"/UserManagement/InviteUser":       {"create-user"},
+		"/UserManagement/ListInvitations":  {"view-user"},
+		"/UserManagement/RevokeInvitation": {"create-user"},
+
+		// Bulk import; department and designation rows are checked again by their services
+		"/UserManagement/BulkImport": {"create-user"},
+
+		// Delegations are open to every user for their own approval
+		// permissions; the handlers check manage-delegations for anyone else's
+
+		// Scoped roles; CheckAccess is open for the caller's own access and
+		// checks view-user for anyone else's
+		"/UserManagement/AssignScopedRole": {"edit-user"},
+		"/UserManagement/ListScopedRoles":  {"view-user"},
+		"/UserManagement/RevokeScopedRole": {"edit-user"},
 		
 		// Activity Logs
 		"/UserManagement/ListActivityLogs": {"view-activity-logs"},
@@ -44,6 +69,7 @@ func GetPermissionMap() map[string][]string {
 func GetPublicMethods() []string {
 	return []string{
 		"/UserManagement/CreateTenant",           // Signup
+		"/UserManagement/DeleteTenant",           // Signup rollback; checks the super admin password
 		"/UserManagement/ListUserOrganizations",  // Login flow
 		"/UserManagement/ListRolesOfUser",        // Login flow
 		"/UserManagement/CreateUserLoginHistory", // Login flow
@@ -51,5 +77,7 @@ func GetPublicMethods() []string {
 		"/UserManagement/GetDepartmentsDropdown", // Dropdowns often need to be accessible or just generic view
 		"/UserManagement/GetDesignationsDropdown",
 		"/UserManagement/GetRolesDropdown",
+		"/UserManagement/GetInvitation",          // Invitation link lookup
+		"/UserManagement/AcceptInvitation",       // Invitee onboarding
 	}
 }
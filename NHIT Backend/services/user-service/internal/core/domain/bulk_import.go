@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// Bulk import sheets, in the order they are imported
+const (
+	ImportSheetDepartments  = "departments"
+	ImportSheetDesignations = "designations"
+	ImportSheetUsers        = "users"
+)
+
+// Bulk import row outcomes. In a dry run they say what the import would do.
+const (
+	ImportActionCreated   = "created"
+	ImportActionUpdated   = "updated"
+	ImportActionUnchanged = "unchanged"
+	ImportActionInvited   = "invited"
+	ImportActionFailed    = "failed"
+)
+
+// DepartmentImportRow is one parsed row of the departments sheet. Parent names
+// another department, existing or earlier in the sheet.
+type DepartmentImportRow struct {
+	RowNumber      int
+	Name           string
+	Description    string
+	CostCentreCode string
+	Parent         string
+	ParseErrors    []ImportError
+}
+
+// DesignationImportRow is one parsed row of the designations sheet
+type DesignationImportRow struct {
+	RowNumber   int
+	Name        string
+	Description string
+	Grade       string
+	Level       int32
+	ParseErrors []ImportError
+}
+
+// UserImportRow is one parsed row of the users sheet. Department, designation
+// and roles are names; the first role is the membership role.
+type UserImportRow struct {
+	RowNumber   int
+	Name        string
+	Email       string
+	Department  string
+	Designation string
+	Roles       []string
+	ParseErrors []ImportError
+}
+
+// BulkImport is a parsed bulk import of an organization's directory
+type BulkImport struct {
+	TenantID        uuid.UUID
+	OrgID           uuid.UUID
+	ImportedBy      uuid.UUID
+	ImporterName    string
+	Departments     []DepartmentImportRow
+	Designations    []DesignationImportRow
+	Users           []UserImportRow
+	DryRun          bool
+	SendInvitations bool
+}
+
+// ImportError is a problem with one row of an import
+type ImportError struct {
+	Sheet     string
+	RowNumber int
+	Field     string
+	Message   string
+}
+
+// ImportRowResult is what the import did, or would do, with one row. ID is nil
+// for failed rows and for records a dry run would create.
+type ImportRowResult struct {
+	Sheet     string
+	RowNumber int
+	Key       string
+	Action    string
+	ID        *uuid.UUID
+}
+
+// BulkImportResult reports the outcome of a bulk import. Rows are imported
+// independently, so a failed row does not stop the others; rows that depend on
+// a failed department or designation fail with it.
+type BulkImportResult struct {
+	DryRun bool
+	Rows   []ImportRowResult
+	Errors []ImportError
+}
+
+// AddRow records the outcome of a row
+func (r *BulkImportResult) AddRow(sheet string, row int, key, action string, id *uuid.UUID) {
+	r.Rows = append(r.Rows, ImportRowResult{Sheet: sheet, RowNumber: row, Key: key, Action: action, ID: id})
+}
+
+// Fail records a failed row with its errors
+func (r *BulkImportResult) Fail(sheet string, row int, key string, errs ...ImportError) {
+	r.AddRow(sheet, row, key, ImportActionFailed, nil)
+	for _, e := range errs {
+		e.Sheet, e.RowNumber = sheet, row
+		r.Errors = append(r.Errors, e)
+	}
+}
+
+// FailedRows counts the rows that failed
+func (r *BulkImportResult) FailedRows() int {
+	failed := 0
+	for _, row := range r.Rows {
+		if row.Action == ImportActionFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// DirectoryEntry is a department or designation of an organization as the
+// import sees it
+type DirectoryEntry struct {
+	ID     uuid.UUID
+	Name   string
+	Active bool
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delegation statuses (derived, not stored)
+const (
+	DelegationStatusScheduled = "scheduled"
+	DelegationStatusActive    = "active"
+	DelegationStatusExpired   = "expired"
+	DelegationStatusRevoked   = "revoked"
+)
+
+// Delegation hands some of a user's approval permissions to another user for
+// a period. OrgID and ProjectID narrow where the delegate may use them.
+type Delegation struct {
+	DelegationID  uuid.UUID
+	TenantID      uuid.UUID
+	DelegatorID   uuid.UUID
+	DelegatorName string // Filled on read
+	DelegateID    uuid.UUID
+	DelegateName  string // Filled on read
+	Permissions   []string
+	OrgID         *uuid.UUID
+	ProjectID     *uuid.UUID
+	StartsAt      time.Time
+	EndsAt        time.Time
+	Reason        string
+	CreatedBy     uuid.UUID
+	RevokedAt     *time.Time
+	RevokedBy     *uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Status returns the current state of the delegation
+func (d *Delegation) Status() string {
+	now := time.Now()
+	switch {
+	case d.RevokedAt != nil:
+		return DelegationStatusRevoked
+	case now.Before(d.StartsAt):
+		return DelegationStatusScheduled
+	case !now.Before(d.EndsAt):
+		return DelegationStatusExpired
+	default:
+		return DelegationStatusActive
+	}
+}
+
+// DelegationFilter selects delegations to list. Involving restricts the list
+// to delegations the user gives or receives.
+type DelegationFilter struct {
+	TenantID    uuid.UUID
+	DelegatorID *uuid.UUID
+	DelegateID  *uuid.UUID
+	Involving   *uuid.UUID
+	ActiveOnly  bool
+	Permission  string
+	OrgID       *uuid.UUID
+	ProjectID   *uuid.UUID
+}
+
+// IsApprovalPermission reports whether a permission can be delegated: approve
+// actions and the approval levels
+func IsApprovalPermission(p *Permission) bool {
+	return p.Action == "approve" || p.Module == "approvals"
+}
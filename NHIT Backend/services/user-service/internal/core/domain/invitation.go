@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invitation statuses (derived, not stored)
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusRevoked  = "revoked"
+	InvitationStatusExpired  = "expired"
+)
+
+// Invitation represents an invite of an email address to join an organization
+type Invitation struct {
+	InvitationID   uuid.UUID
+	TenantID       uuid.UUID
+	OrgID          uuid.UUID
+	OrgName        string // Filled on read
+	Email          string
+	Name           string
+	RoleIDs        []uuid.UUID // Roles granted on acceptance
+	DepartmentID   *uuid.UUID
+	DesignationID  *uuid.UUID
+	InvitedBy      uuid.UUID
+	ExpiresAt      time.Time
+	AcceptedAt     *time.Time
+	AcceptedUserID *uuid.UUID
+	RevokedAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Status returns the current state of the invitation
+func (i *Invitation) Status() string {
+	switch {
+	case i.AcceptedAt != nil:
+		return InvitationStatusAccepted
+	case i.RevokedAt != nil:
+		return InvitationStatusRevoked
+	case time.Now().After(i.ExpiresAt):
+		return InvitationStatusExpired
+	default:
+		return InvitationStatusPending
+	}
+}
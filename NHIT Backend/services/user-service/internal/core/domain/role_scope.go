@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scopes a role can be assigned for
+const (
+	RoleScopeOrganization = "ORGANIZATION"
+	RoleScopeProject      = "PROJECT"
+	RoleScopeDepartment   = "DEPARTMENT"
+)
+
+// IsRoleScopeType reports whether t is a known role scope
+func IsRoleScopeType(t string) bool {
+	switch t {
+	case RoleScopeOrganization, RoleScopeProject, RoleScopeDepartment:
+		return true
+	}
+	return false
+}
+
+// ScopedRoleAssignment grants a role's permissions to a user for one
+// organization, project or department only, unlike user_roles which apply
+// wherever the user signs in
+type ScopedRoleAssignment struct {
+	AssignmentID uuid.UUID
+	TenantID     uuid.UUID
+	UserID       uuid.UUID
+	RoleID       uuid.UUID
+	RoleName     string   // Filled on read
+	Permissions  []string // Filled on read
+	ScopeType    string
+	OrgID        uuid.UUID
+	ScopeID      uuid.UUID // OrgID itself, or a project or department of it
+	ExpiresAt    *time.Time
+	GrantedBy    uuid.UUID
+	CreatedAt    time.Time
+}
+
+// Expired reports whether the assignment no longer applies
+func (a *ScopedRoleAssignment) Expired() bool {
+	return a.ExpiresAt != nil && !time.Now().Before(*a.ExpiresAt)
+}
@@ -76,8 +76,11 @@ type UserOrganizationRole struct {
 	UserID           uuid.UUID
 	OrgID            uuid.UUID
 	RoleID           uuid.UUID
-	IsCurrentContext bool      // Whether this is active organization
-	AssignedBy       uuid.UUID // Super admin who assigned this role
+	DepartmentID     *uuid.UUID  // Department within this organization
+	DesignationID    *uuid.UUID  // Designation within this organization
+	ProjectIDs       []uuid.UUID // Projects within this organization
+	IsCurrentContext bool        // Whether this is active organization
+	AssignedBy       uuid.UUID   // Super admin who assigned this role
 	AssignedAt       time.Time
 	UpdatedAt        time.Time
 }
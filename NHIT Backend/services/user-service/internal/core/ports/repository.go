@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
 	"github.com/google/uuid"
@@ -23,6 +24,9 @@ type UserRepository interface {
 type TenantRepository interface {
 	Create(ctx context.Context, tenant *domain.Tenant) (*domain.Tenant, error)
 	GetByID(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error)
+	// DeleteIfEmpty deletes the tenant with its users and roles and reports
+	// false when the tenant still has organizations or members
+	DeleteIfEmpty(ctx context.Context, tenantID uuid.UUID) (bool, error)
 }
 
 // UserRoleRepository defines the interface for user-role operations
@@ -60,3 +64,64 @@ type ActivityLogRepository interface {
 	List(ctx context.Context, limit, offset int32) ([]*domain.ActivityLog, int64, error)
 	Count(ctx context.Context) (int64, error)
 }
+
+// InvitationRepository defines the interface for user invitation data operations
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *domain.Invitation) (*domain.Invitation, error)
+	GetByID(ctx context.Context, invitationID uuid.UUID) (*domain.Invitation, error)
+	ListByOrganization(ctx context.Context, tenantID, orgID uuid.UUID) ([]*domain.Invitation, error)
+	// Accept closes the invitation, saves the membership and grants the roles atomically
+	Accept(ctx context.Context, invitationID uuid.UUID, membership *domain.UserOrganizationRole, roleIDs []uuid.UUID) error
+	Revoke(ctx context.Context, invitationID uuid.UUID) error
+}
+
+// DelegationRepository defines the interface for approval delegation data operations
+type DelegationRepository interface {
+	Create(ctx context.Context, delegation *domain.Delegation) (*domain.Delegation, error)
+	GetByID(ctx context.Context, delegationID uuid.UUID) (*domain.Delegation, error)
+	List(ctx context.Context, filter domain.DelegationFilter) ([]*domain.Delegation, error)
+	Revoke(ctx context.Context, delegationID, revokedBy uuid.UUID) error
+}
+
+// RoleScopeRepository defines the interface for scoped role assignment data operations
+type RoleScopeRepository interface {
+	Create(ctx context.Context, assignment *domain.ScopedRoleAssignment) (*domain.ScopedRoleAssignment, error)
+	GetByID(ctx context.Context, assignmentID uuid.UUID) (*domain.ScopedRoleAssignment, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, activeOnly bool) ([]*domain.ScopedRoleAssignment, error)
+	Delete(ctx context.Context, assignmentID uuid.UUID) error
+}
+
+// MembershipRepository defines the interface for user-organization memberships
+type MembershipRepository interface {
+	// Upsert adds the user to the organization or updates their assignment there
+	Upsert(ctx context.Context, membership *domain.UserOrganizationRole) error
+	Get(ctx context.Context, userID, orgID uuid.UUID) (*domain.UserOrganizationRole, error)
+	// Remove deletes the membership and moves the current context to another organization if needed
+	Remove(ctx context.Context, userID, orgID uuid.UUID) error
+	// CountWithRole counts the user's memberships that grant the given role
+	CountWithRole(ctx context.Context, userID, roleID uuid.UUID) (int, error)
+	// GetOrganization returns the tenant and name of an organization
+	GetOrganization(ctx context.Context, orgID uuid.UUID) (tenantID uuid.UUID, name string, err error)
+}
+
+// NotificationClient defines the interface for sending user-facing emails
+type NotificationClient interface {
+	SendInvitationEmail(ctx context.Context, email, name, orgName, inviterName, link string, expiresAt time.Time) error
+}
+
+// PasswordPolicyClient checks passwords against the tenant password policy kept by the auth service
+type PasswordPolicyClient interface {
+	// ValidatePassword returns an error describing why the password was rejected
+	ValidatePassword(ctx context.Context, tenantID uuid.UUID, password string) error
+}
+
+// DirectoryClient reaches the department and designation services on behalf
+// of the caller, in the given organization
+type DirectoryClient interface {
+	ListDepartments(ctx context.Context, orgID uuid.UUID) ([]domain.DirectoryEntry, error)
+	// CreateDepartment creates the department, or with validateOnly only checks it could be
+	CreateDepartment(ctx context.Context, orgID uuid.UUID, row domain.DepartmentImportRow, parentID *uuid.UUID, validateOnly bool) (uuid.UUID, error)
+	ListDesignations(ctx context.Context, orgID uuid.UUID) ([]domain.DirectoryEntry, error)
+	// CreateDesignation creates the designation, or with validateOnly only checks it could be
+	CreateDesignation(ctx context.Context, orgID uuid.UUID, row domain.DesignationImportRow, validateOnly bool) (uuid.UUID, error)
+}
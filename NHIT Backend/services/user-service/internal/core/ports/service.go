@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
 	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
 	"github.com/google/uuid"
 )
@@ -46,4 +47,51 @@ type UserService interface {
 	// Tenant management
 	CreateTenant(ctx context.Context, name, email, password, role string) (*domain.Tenant, error)
 	GetTenant(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error)
+	DeleteTenant(ctx context.Context, tenantID uuid.UUID, password string) error
+}
+
+// MembershipService defines the interface for managing user-organization memberships
+type MembershipService interface {
+	AddUserToOrganization(ctx context.Context, tenantID uuid.UUID, membership *domain.UserOrganizationRole) error
+	RemoveUserFromOrganization(ctx context.Context, tenantID, userID, orgID uuid.UUID) error
+}
+
+// InvitationService defines the interface for invite-based onboarding
+type InvitationService interface {
+	// InviteUser creates an invitation and emails the signed acceptance link
+	InviteUser(ctx context.Context, invitation *domain.Invitation, inviterName string) (*domain.Invitation, error)
+	ListInvitations(ctx context.Context, tenantID, orgID uuid.UUID) ([]*domain.Invitation, error)
+	RevokeInvitation(ctx context.Context, tenantID, invitationID uuid.UUID) error
+	// GetInvitationByToken verifies a signed link and returns its pending invitation
+	GetInvitationByToken(ctx context.Context, token string) (*domain.Invitation, error)
+	// AcceptInvitation joins the invitee to the organization. A new user sets a password,
+	// or passes useSSO to sign in through SSO with the invited email instead.
+	AcceptInvitation(ctx context.Context, token, name, password string, useSSO bool) (*domain.User, error)
+}
+
+// DelegationService defines the interface for approval delegations
+type DelegationService interface {
+	// CreateDelegation validates and stores a delegation. An empty permission
+	// list delegates every approval permission the delegator holds.
+	CreateDelegation(ctx context.Context, delegation *domain.Delegation) (*domain.Delegation, error)
+	ListDelegations(ctx context.Context, filter domain.DelegationFilter) ([]*domain.Delegation, error)
+	// RevokeDelegation ends a delegation early. Only its delegator or delegate
+	// may revoke it unless canManage is set.
+	RevokeDelegation(ctx context.Context, tenantID, delegationID, actorID uuid.UUID, canManage bool) (*domain.Delegation, error)
+}
+
+// AccessPolicyService defines the interface for scoped role assignments and
+// access checks against them
+type AccessPolicyService interface {
+	AssignScopedRole(ctx context.Context, assignment *domain.ScopedRoleAssignment) (*domain.ScopedRoleAssignment, error)
+	ListScopedRoles(ctx context.Context, tenantID, userID uuid.UUID, activeOnly bool) ([]*domain.ScopedRoleAssignment, error)
+	RevokeScopedRole(ctx context.Context, tenantID, userID, assignmentID uuid.UUID) error
+	// CheckAccess decides whether the user may perform the action on the
+	// resource from their roles, scoped roles and delegations in force
+	CheckAccess(ctx context.Context, tenantID, userID uuid.UUID, action string, res policy.Resource) (policy.Decision, error)
+}
+
+// BulkImportService imports an organization's departments, designations and users
+type BulkImportService interface {
+	Import(ctx context.Context, imp *domain.BulkImport) (*domain.BulkImportResult, error)
 }
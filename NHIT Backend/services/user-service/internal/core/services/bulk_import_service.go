@@ -0,0 +1,463 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// maxImportRoles bounds the roles read to resolve role names
+const maxImportRoles = 1000
+
+type bulkImportService struct {
+	directory         ports.DirectoryClient
+	userService       ports.UserService
+	membershipService ports.MembershipService
+	invitationService ports.InvitationService
+	userRepo          ports.UserRepository
+	userRoleRepo      ports.UserRoleRepository
+	roleRepo          ports.RoleRepository
+	membershipRepo    ports.MembershipRepository
+	invitationRepo    ports.InvitationRepository
+}
+
+// NewBulkImportService creates a new bulk import service
+func NewBulkImportService(
+	directory ports.DirectoryClient,
+	userService ports.UserService,
+	membershipService ports.MembershipService,
+	invitationService ports.InvitationService,
+	userRepo ports.UserRepository,
+	userRoleRepo ports.UserRoleRepository,
+	roleRepo ports.RoleRepository,
+	membershipRepo ports.MembershipRepository,
+	invitationRepo ports.InvitationRepository,
+) ports.BulkImportService {
+	return &bulkImportService{
+		directory:         directory,
+		userService:       userService,
+		membershipService: membershipService,
+		invitationService: invitationService,
+		userRepo:          userRepo,
+		userRoleRepo:      userRoleRepo,
+		roleRepo:          roleRepo,
+		membershipRepo:    membershipRepo,
+		invitationRepo:    invitationRepo,
+	}
+}
+
+// importTarget is what the import knows of a department or designation name.
+// ID is nil for a record a dry run would create; failed records are kept so
+// rows referring to them can say why they fail.
+type importTarget struct {
+	ID        *uuid.UUID
+	Active    bool
+	FailedRow int
+}
+
+// nameIndex maps case-folded names to import targets
+type nameIndex map[string]importTarget
+
+func newNameIndex(entries []domain.DirectoryEntry) nameIndex {
+	index := make(nameIndex, len(entries))
+	for _, e := range entries {
+		id := e.ID
+		index.set(e.Name, importTarget{ID: &id, Active: e.Active})
+	}
+	return index
+}
+
+func (n nameIndex) get(name string) (importTarget, bool) {
+	t, ok := n[strings.ToLower(strings.TrimSpace(name))]
+	return t, ok
+}
+
+func (n nameIndex) set(name string, t importTarget) {
+	n[strings.ToLower(strings.TrimSpace(name))] = t
+}
+
+// resolve finds a referenced department or designation, reporting an error
+// against field when it cannot be used
+func (n nameIndex) resolve(kind, field, name string) (*uuid.UUID, *domain.ImportError) {
+	t, ok := n.get(name)
+	switch {
+	case !ok:
+		return nil, &domain.ImportError{Field: field, Message: fmt.Sprintf("%s %q not found", kind, name)}
+	case t.FailedRow > 0:
+		return nil, &domain.ImportError{Field: field, Message: fmt.Sprintf("%s %q failed to import on row %d", kind, name, t.FailedRow)}
+	case !t.Active:
+		return nil, &domain.ImportError{Field: field, Message: fmt.Sprintf("%s %q is closed", kind, name)}
+	}
+	return t.ID, nil
+}
+
+// Import creates the departments, then the designations, then the users of a
+// bulk import. Existing departments and designations are matched by name and
+// left as they are; existing users are matched by email and have their
+// membership of the organization brought in line with the file.
+func (s *bulkImportService) Import(ctx context.Context, imp *domain.BulkImport) (*domain.BulkImportResult, error) {
+	if _, err := checkOrganization(ctx, s.membershipRepo, imp.TenantID, imp.OrgID); err != nil {
+		return nil, err
+	}
+
+	result := &domain.BulkImportResult{DryRun: imp.DryRun}
+
+	existingDepartments, err := s.directory.ListDepartments(ctx, imp.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	departments := newNameIndex(existingDepartments)
+	s.importDepartments(ctx, imp, departments, result)
+
+	existingDesignations, err := s.directory.ListDesignations(ctx, imp.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	designations := newNameIndex(existingDesignations)
+	s.importDesignations(ctx, imp, designations, result)
+
+	if err := s.importUsers(ctx, imp, departments, designations, result); err != nil {
+		return nil, err
+	}
+
+	log.Printf("📥 Bulk import into organization %s by %s: %d rows, %d failed (dry run: %t)",
+		imp.OrgID, imp.ImportedBy, len(result.Rows), result.FailedRows(), imp.DryRun)
+	return result, nil
+}
+
+func (s *bulkImportService) importDepartments(ctx context.Context, imp *domain.BulkImport, departments nameIndex, result *domain.BulkImportResult) {
+	const sheet = domain.ImportSheetDepartments
+	seen := map[string]int{}
+
+	for _, row := range imp.Departments {
+		errs := append([]domain.ImportError(nil), row.ParseErrors...)
+		key := strings.ToLower(row.Name)
+		if row.Name == "" {
+			errs = append(errs, domain.ImportError{Field: "name", Message: "name is required"})
+		} else if first, dup := seen[key]; dup {
+			errs = append(errs, domain.ImportError{Field: "name", Message: fmt.Sprintf("duplicate of row %d", first)})
+		}
+		if len(errs) > 0 {
+			result.Fail(sheet, row.RowNumber, row.Name, errs...)
+			continue
+		}
+		seen[key] = row.RowNumber
+
+		if existing, ok := departments.get(row.Name); ok {
+			if !existing.Active {
+				result.Fail(sheet, row.RowNumber, row.Name, domain.ImportError{Field: "name", Message: "department exists but is closed; reopen it first"})
+				continue
+			}
+			result.AddRow(sheet, row.RowNumber, row.Name, domain.ImportActionUnchanged, existing.ID)
+			continue
+		}
+
+		var parentID *uuid.UUID
+		if row.Parent != "" {
+			id, perr := departments.resolve("parent department", "parent", row.Parent)
+			if perr != nil {
+				perr.Message += "; a parent must exist or come on an earlier row"
+				departments.set(row.Name, importTarget{FailedRow: row.RowNumber})
+				result.Fail(sheet, row.RowNumber, row.Name, *perr)
+				continue
+			}
+			parentID = id
+		}
+
+		id, err := s.directory.CreateDepartment(ctx, imp.OrgID, row, parentID, imp.DryRun)
+		if err != nil {
+			departments.set(row.Name, importTarget{FailedRow: row.RowNumber})
+			result.Fail(sheet, row.RowNumber, row.Name, domain.ImportError{Message: err.Error()})
+			continue
+		}
+		target := importTarget{Active: true}
+		if !imp.DryRun {
+			target.ID = &id
+		}
+		departments.set(row.Name, target)
+		result.AddRow(sheet, row.RowNumber, row.Name, domain.ImportActionCreated, target.ID)
+	}
+}
+
+func (s *bulkImportService) importDesignations(ctx context.Context, imp *domain.BulkImport, designations nameIndex, result *domain.BulkImportResult) {
+	const sheet = domain.ImportSheetDesignations
+	seen := map[string]int{}
+
+	for _, row := range imp.Designations {
+		errs := append([]domain.ImportError(nil), row.ParseErrors...)
+		key := strings.ToLower(row.Name)
+		if row.Name == "" {
+			errs = append(errs, domain.ImportError{Field: "name", Message: "name is required"})
+		} else if first, dup := seen[key]; dup {
+			errs = append(errs, domain.ImportError{Field: "name", Message: fmt.Sprintf("duplicate of row %d", first)})
+		}
+		if len(errs) > 0 {
+			result.Fail(sheet, row.RowNumber, row.Name, errs...)
+			continue
+		}
+		seen[key] = row.RowNumber
+
+		if existing, ok := designations.get(row.Name); ok {
+			result.AddRow(sheet, row.RowNumber, row.Name, domain.ImportActionUnchanged, existing.ID)
+			continue
+		}
+
+		id, err := s.directory.CreateDesignation(ctx, imp.OrgID, row, imp.DryRun)
+		if err != nil {
+			designations.set(row.Name, importTarget{FailedRow: row.RowNumber})
+			result.Fail(sheet, row.RowNumber, row.Name, domain.ImportError{Message: err.Error()})
+			continue
+		}
+		target := importTarget{Active: true}
+		if !imp.DryRun {
+			target.ID = &id
+		}
+		designations.set(row.Name, target)
+		result.AddRow(sheet, row.RowNumber, row.Name, domain.ImportActionCreated, target.ID)
+	}
+}
+
+// userAssignment is a users row resolved against the organization
+type userAssignment struct {
+	DepartmentID  *uuid.UUID
+	DesignationID *uuid.UUID
+	Roles         []*domain.Role
+}
+
+func (s *bulkImportService) importUsers(ctx context.Context, imp *domain.BulkImport, departments, designations nameIndex, result *domain.BulkImportResult) error {
+	const sheet = domain.ImportSheetUsers
+	if len(imp.Users) == 0 {
+		return nil
+	}
+
+	roles, err := s.organizationRoles(ctx, imp.TenantID, imp.OrgID)
+	if err != nil {
+		return err
+	}
+	invitations, err := s.invitationRepo.ListByOrganization(ctx, imp.TenantID, imp.OrgID)
+	if err != nil {
+		return fmt.Errorf("failed to list invitations: %w", err)
+	}
+	pending := map[string]*domain.Invitation{}
+	for _, inv := range invitations {
+		if inv.Status() == domain.InvitationStatusPending {
+			pending[strings.ToLower(inv.Email)] = inv
+		}
+	}
+
+	seen := map[string]int{}
+	for _, row := range imp.Users {
+		email := strings.ToLower(strings.TrimSpace(row.Email))
+		errs := append([]domain.ImportError(nil), row.ParseErrors...)
+		if email == "" || !strings.Contains(email, "@") {
+			errs = append(errs, domain.ImportError{Field: "email", Message: "a valid email is required"})
+		} else if first, dup := seen[email]; dup {
+			errs = append(errs, domain.ImportError{Field: "email", Message: fmt.Sprintf("duplicate of row %d", first)})
+		} else {
+			seen[email] = row.RowNumber
+		}
+
+		var assignment userAssignment
+		if row.Department == "" {
+			errs = append(errs, domain.ImportError{Field: "department", Message: "department is required"})
+		} else if id, rerr := departments.resolve("department", "department", row.Department); rerr != nil {
+			errs = append(errs, *rerr)
+		} else {
+			assignment.DepartmentID = id
+		}
+		if row.Designation == "" {
+			errs = append(errs, domain.ImportError{Field: "designation", Message: "designation is required"})
+		} else if id, rerr := designations.resolve("designation", "designation", row.Designation); rerr != nil {
+			errs = append(errs, *rerr)
+		} else {
+			assignment.DesignationID = id
+		}
+		if len(row.Roles) == 0 {
+			errs = append(errs, domain.ImportError{Field: "roles", Message: "at least one role is required"})
+		}
+		for _, name := range row.Roles {
+			role, ok := roles[strings.ToLower(name)]
+			if !ok {
+				errs = append(errs, domain.ImportError{Field: "roles", Message: fmt.Sprintf("role %q not found in this organization", name)})
+				continue
+			}
+			assignment.Roles = append(assignment.Roles, role)
+		}
+
+		if len(errs) > 0 {
+			result.Fail(sheet, row.RowNumber, row.Email, errs...)
+			continue
+		}
+
+		action, id, err := s.importUser(ctx, imp, row, email, assignment, pending[email])
+		if err != nil {
+			result.Fail(sheet, row.RowNumber, row.Email, domain.ImportError{Message: err.Error()})
+			continue
+		}
+		result.AddRow(sheet, row.RowNumber, row.Email, action, id)
+	}
+	return nil
+}
+
+// importUser brings one user in line with their row. Existing users of the
+// tenant join the organization directly; new users get an account, or an
+// invitation when the import sends them.
+func (s *bulkImportService) importUser(ctx context.Context, imp *domain.BulkImport, row domain.UserImportRow, email string, assignment userAssignment, invitation *domain.Invitation) (string, *uuid.UUID, error) {
+	existing, err := s.userRepo.GetByEmail(ctx, imp.TenantID, email)
+	if err == nil {
+		return s.updateMember(ctx, imp, existing, assignment)
+	}
+
+	if invitation != nil {
+		id := invitation.InvitationID
+		return domain.ImportActionUnchanged, &id, nil
+	}
+
+	if imp.SendInvitations {
+		if imp.DryRun {
+			return domain.ImportActionInvited, nil, nil
+		}
+		roleIDs := make([]uuid.UUID, len(assignment.Roles))
+		for i, role := range assignment.Roles {
+			roleIDs[i] = role.RoleID
+		}
+		created, err := s.invitationService.InviteUser(ctx, &domain.Invitation{
+			TenantID:      imp.TenantID,
+			OrgID:         imp.OrgID,
+			Email:         email,
+			Name:          row.Name,
+			RoleIDs:       roleIDs,
+			DepartmentID:  assignment.DepartmentID,
+			DesignationID: assignment.DesignationID,
+			InvitedBy:     imp.ImportedBy,
+		}, imp.ImporterName)
+		if err != nil {
+			return "", nil, err
+		}
+		return domain.ImportActionInvited, &created.InvitationID, nil
+	}
+
+	if strings.TrimSpace(row.Name) == "" {
+		return "", nil, fmt.Errorf("name is required to create an account")
+	}
+	if imp.DryRun {
+		return domain.ImportActionCreated, nil, nil
+	}
+
+	// Imported accounts have no known password; users sign in through SSO or
+	// set one with the forgotten password flow
+	password, err := randomPassword()
+	if err != nil {
+		return "", nil, err
+	}
+	user, err := s.userService.CreateUser(ctx, &domain.User{
+		TenantID:      imp.TenantID,
+		Name:          strings.TrimSpace(row.Name),
+		Email:         email,
+		Password:      password,
+		DepartmentID:  assignment.DepartmentID,
+		DesignationID: assignment.DesignationID,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if err := s.assign(ctx, imp, user.UserID, assignment); err != nil {
+		return "", nil, fmt.Errorf("account %s created but not added to the organization: %w", user.UserID, err)
+	}
+	return domain.ImportActionCreated, &user.UserID, nil
+}
+
+// updateMember adds an existing user to the organization or updates their
+// department, designation and roles there
+func (s *bulkImportService) updateMember(ctx context.Context, imp *domain.BulkImport, user *domain.User, assignment userAssignment) (string, *uuid.UUID, error) {
+	id := user.UserID
+	membership, err := s.membershipRepo.Get(ctx, user.UserID, imp.OrgID)
+	if err != nil {
+		if !imp.DryRun {
+			if err := s.assign(ctx, imp, user.UserID, assignment); err != nil {
+				return "", nil, err
+			}
+		}
+		return domain.ImportActionCreated, &id, nil
+	}
+
+	current, err := s.userRoleRepo.ListRolesByUser(ctx, user.UserID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	held := make(map[uuid.UUID]bool, len(current))
+	for _, role := range current {
+		held[role.RoleID] = true
+	}
+	unchanged := membership.RoleID == assignment.Roles[0].RoleID &&
+		sameUUID(membership.DepartmentID, assignment.DepartmentID) &&
+		sameUUID(membership.DesignationID, assignment.DesignationID)
+	for _, role := range assignment.Roles {
+		unchanged = unchanged && held[role.RoleID]
+	}
+	if unchanged {
+		return domain.ImportActionUnchanged, &id, nil
+	}
+
+	if !imp.DryRun {
+		if err := s.assign(ctx, imp, user.UserID, assignment); err != nil {
+			return "", nil, err
+		}
+	}
+	return domain.ImportActionUpdated, &id, nil
+}
+
+// assign makes the user a member of the organization with the first role and
+// grants the others
+func (s *bulkImportService) assign(ctx context.Context, imp *domain.BulkImport, userID uuid.UUID, assignment userAssignment) error {
+	err := s.membershipService.AddUserToOrganization(ctx, imp.TenantID, &domain.UserOrganizationRole{
+		UserID:        userID,
+		OrgID:         imp.OrgID,
+		RoleID:        assignment.Roles[0].RoleID,
+		DepartmentID:  assignment.DepartmentID,
+		DesignationID: assignment.DesignationID,
+		AssignedBy:    imp.ImportedBy,
+	})
+	if err != nil {
+		return err
+	}
+	for _, role := range assignment.Roles[1:] {
+		if err := s.userService.AssignRoleToUser(ctx, userID, role.RoleID); err != nil {
+			return fmt.Errorf("failed to assign role %s: %w", role.Name, err)
+		}
+	}
+	return nil
+}
+
+// organizationRoles indexes the roles usable in the organization by case-folded
+// name. A role scoped to the organization wins over a tenant role of the same name.
+func (s *bulkImportService) organizationRoles(ctx context.Context, tenantID, orgID uuid.UUID) (map[string]*domain.Role, error) {
+	roles, _, err := s.roleRepo.ListByTenant(ctx, tenantID, maxImportRoles, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	index := map[string]*domain.Role{}
+	for _, role := range roles {
+		if role.OrgID != nil && *role.OrgID != orgID {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(role.Name))
+		if current, ok := index[key]; ok && current.OrgID != nil {
+			continue
+		}
+		index[key] = role
+	}
+	return index, nil
+}
+
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
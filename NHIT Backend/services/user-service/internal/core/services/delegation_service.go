@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// maxDelegationPeriod bounds how long a single delegation may run
+const maxDelegationPeriod = 366 * 24 * time.Hour
+
+type delegationService struct {
+	delegationRepo ports.DelegationRepository
+	userRepo       ports.UserRepository
+	userRoleRepo   ports.UserRoleRepository
+	permissionRepo ports.PermissionRepository
+	membershipRepo ports.MembershipRepository
+}
+
+// NewDelegationService creates a new approval delegation service
+func NewDelegationService(
+	delegationRepo ports.DelegationRepository,
+	userRepo ports.UserRepository,
+	userRoleRepo ports.UserRoleRepository,
+	permissionRepo ports.PermissionRepository,
+	membershipRepo ports.MembershipRepository,
+) ports.DelegationService {
+	return &delegationService{
+		delegationRepo: delegationRepo,
+		userRepo:       userRepo,
+		userRoleRepo:   userRoleRepo,
+		permissionRepo: permissionRepo,
+		membershipRepo: membershipRepo,
+	}
+}
+
+func (s *delegationService) CreateDelegation(ctx context.Context, d *domain.Delegation) (*domain.Delegation, error) {
+	if d.DelegatorID == d.DelegateID {
+		return nil, fmt.Errorf("a user cannot delegate to themselves")
+	}
+
+	now := time.Now()
+	if d.StartsAt.IsZero() {
+		d.StartsAt = now
+	}
+	if d.EndsAt.IsZero() {
+		return nil, fmt.Errorf("ends_at is required")
+	}
+	if !d.EndsAt.After(d.StartsAt) {
+		return nil, fmt.Errorf("ends_at must be after starts_at")
+	}
+	if !d.EndsAt.After(now) {
+		return nil, fmt.Errorf("ends_at must be in the future")
+	}
+	if d.EndsAt.Sub(d.StartsAt) > maxDelegationPeriod {
+		return nil, fmt.Errorf("a delegation may run for at most %d days", int(maxDelegationPeriod.Hours()/24))
+	}
+	if d.ProjectID != nil && d.OrgID == nil {
+		return nil, fmt.Errorf("org_id is required with project_id")
+	}
+
+	delegator, err := s.userRepo.GetByID(ctx, d.DelegatorID)
+	if err != nil || delegator.TenantID != d.TenantID {
+		return nil, fmt.Errorf("delegator not found")
+	}
+	delegate, err := s.userRepo.GetByID(ctx, d.DelegateID)
+	if err != nil || delegate.TenantID != d.TenantID {
+		return nil, fmt.Errorf("delegate not found")
+	}
+	if !delegate.IsActive {
+		return nil, fmt.Errorf("delegate %s is deactivated", delegate.Name)
+	}
+
+	if d.OrgID != nil {
+		if _, err := checkOrganization(ctx, s.membershipRepo, d.TenantID, *d.OrgID); err != nil {
+			return nil, err
+		}
+		if _, err := s.membershipRepo.Get(ctx, d.DelegatorID, *d.OrgID); err != nil {
+			return nil, fmt.Errorf("%s is not a member of this organization", delegator.Name)
+		}
+		if _, err := s.membershipRepo.Get(ctx, d.DelegateID, *d.OrgID); err != nil {
+			return nil, fmt.Errorf("%s is not a member of this organization", delegate.Name)
+		}
+	}
+
+	held, err := s.approvalPermissionsOf(ctx, d.DelegatorID, d.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.Permissions) == 0 {
+		if len(held) == 0 {
+			return nil, fmt.Errorf("%s holds no approval permissions to delegate", delegator.Name)
+		}
+		for p := range held {
+			d.Permissions = append(d.Permissions, p)
+		}
+		sort.Strings(d.Permissions)
+	} else {
+		seen := make(map[string]bool, len(d.Permissions))
+		permissions := make([]string, 0, len(d.Permissions))
+		for _, p := range d.Permissions {
+			p = strings.TrimSpace(p)
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			if !held[p] {
+				return nil, fmt.Errorf("%s does not hold the approval permission %q", delegator.Name, p)
+			}
+			permissions = append(permissions, p)
+		}
+		d.Permissions = permissions
+	}
+
+	created, err := s.delegationRepo.Create(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("🤝 Delegation %s: %s delegated %v to %s until %s", created.DelegationID, created.DelegatorID, created.Permissions, created.DelegateID, created.EndsAt.Format(time.RFC3339))
+	return created, nil
+}
+
+// approvalPermissionsOf returns the approval permissions a user holds through
+// roles usable in the organization, or through any role when orgID is nil.
+// Delegated permissions are not included, so delegations do not chain.
+func (s *delegationService) approvalPermissionsOf(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) (map[string]bool, error) {
+	catalog, err := s.permissionRepo.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+	approval := make(map[string]bool)
+	for _, p := range catalog {
+		if domain.IsApprovalPermission(p) {
+			approval[p.Name] = true
+		}
+	}
+
+	roles, err := s.userRoleRepo.ListRolesByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	held := make(map[string]bool)
+	for _, role := range roles {
+		if orgID != nil && role.OrgID != nil && *role.OrgID != *orgID {
+			continue
+		}
+		// SUPER_ADMIN holds every permission implicitly, as in the RBAC interceptor
+		if role.Name == "SUPER_ADMIN" {
+			return approval, nil
+		}
+		for _, p := range role.Permissions {
+			if approval[p] {
+				held[p] = true
+			}
+		}
+	}
+	return held, nil
+}
+
+func (s *delegationService) ListDelegations(ctx context.Context, filter domain.DelegationFilter) ([]*domain.Delegation, error) {
+	return s.delegationRepo.List(ctx, filter)
+}
+
+func (s *delegationService) RevokeDelegation(ctx context.Context, tenantID, delegationID, actorID uuid.UUID, canManage bool) (*domain.Delegation, error) {
+	d, err := s.delegationRepo.GetByID(ctx, delegationID)
+	if err != nil || d.TenantID != tenantID {
+		return nil, fmt.Errorf("delegation not found")
+	}
+	if !canManage && actorID != d.DelegatorID && actorID != d.DelegateID {
+		return nil, fmt.Errorf("only the delegator or the delegate can revoke this delegation")
+	}
+
+	if err := s.delegationRepo.Revoke(ctx, delegationID, actorID); err != nil {
+		return nil, err
+	}
+
+	log.Printf("🛑 Delegation %s revoked by %s", delegationID, actorID)
+	return s.delegationRepo.GetByID(ctx, delegationID)
+}
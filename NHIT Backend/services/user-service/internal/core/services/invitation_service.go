@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type invitationService struct {
+	invitationRepo ports.InvitationRepository
+	membershipRepo ports.MembershipRepository
+	userService    ports.UserService
+	userRepo       ports.UserRepository
+	roleRepo       ports.RoleRepository
+	notifier       ports.NotificationClient
+	passwordPolicy ports.PasswordPolicyClient
+	cfg            *config.InvitationConfig
+}
+
+// NewInvitationService creates a new invitation service
+func NewInvitationService(
+	invitationRepo ports.InvitationRepository,
+	membershipRepo ports.MembershipRepository,
+	userService ports.UserService,
+	userRepo ports.UserRepository,
+	roleRepo ports.RoleRepository,
+	notifier ports.NotificationClient,
+	passwordPolicy ports.PasswordPolicyClient,
+	cfg *config.InvitationConfig,
+) ports.InvitationService {
+	return &invitationService{
+		invitationRepo: invitationRepo,
+		membershipRepo: membershipRepo,
+		userService:    userService,
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		notifier:       notifier,
+		passwordPolicy: passwordPolicy,
+		cfg:            cfg,
+	}
+}
+
+// signInvitation builds the link token "<invitation_id>.<expiry_unix>.<signature>"
+func (s *invitationService) signInvitation(inv *domain.Invitation) string {
+	payload := inv.InvitationID.String() + "." + strconv.FormatInt(inv.ExpiresAt.Unix(), 10)
+	mac := hmac.New(sha256.New, s.cfg.SigningSecret)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyInvitationToken checks the signature and expiry of a link token and returns the invitation ID
+func (s *invitationService) verifyInvitationToken(token string) (uuid.UUID, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return uuid.Nil, fmt.Errorf("invalid invitation link")
+	}
+
+	mac := hmac.New(sha256.New, s.cfg.SigningSecret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+		return uuid.Nil, fmt.Errorf("invalid invitation link")
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid invitation link")
+	}
+	if time.Now().Unix() > expiresAt {
+		return uuid.Nil, fmt.Errorf("invitation has expired")
+	}
+
+	invitationID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid invitation link")
+	}
+	return invitationID, nil
+}
+
+func (s *invitationService) InviteUser(ctx context.Context, invitation *domain.Invitation, inviterName string) (*domain.Invitation, error) {
+	invitation.Email = strings.ToLower(strings.TrimSpace(invitation.Email))
+	if invitation.Email == "" || !strings.Contains(invitation.Email, "@") {
+		return nil, fmt.Errorf("a valid email is required")
+	}
+	if len(invitation.RoleIDs) == 0 {
+		return nil, fmt.Errorf("at least one role is required")
+	}
+
+	orgName, err := checkOrganization(ctx, s.membershipRepo, invitation.TenantID, invitation.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, roleID := range invitation.RoleIDs {
+		if err := checkRole(ctx, s.roleRepo, invitation.TenantID, invitation.OrgID, roleID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Existing members do not need an invitation
+	if existing, err := s.userRepo.GetByEmail(ctx, invitation.TenantID, invitation.Email); err == nil {
+		if _, err := s.membershipRepo.Get(ctx, existing.UserID, invitation.OrgID); err == nil {
+			return nil, fmt.Errorf("%s is already a member of this organization", invitation.Email)
+		}
+	}
+
+	invitation.ExpiresAt = time.Now().Add(s.cfg.TTL)
+	created, err := s.invitationRepo.Create(ctx, invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	link := s.cfg.AcceptURL + "?token=" + url.QueryEscape(s.signInvitation(created))
+	if s.notifier != nil {
+		if err := s.notifier.SendInvitationEmail(ctx, created.Email, created.Name, orgName, inviterName, link, created.ExpiresAt); err != nil {
+			log.Printf("⚠️ Failed to send invitation email to %s: %v", created.Email, err)
+		}
+	}
+
+	log.Printf("✉️ Invitation %s sent to %s for organization %s", created.InvitationID, created.Email, created.OrgID)
+	return created, nil
+}
+
+func (s *invitationService) ListInvitations(ctx context.Context, tenantID, orgID uuid.UUID) ([]*domain.Invitation, error) {
+	return s.invitationRepo.ListByOrganization(ctx, tenantID, orgID)
+}
+
+func (s *invitationService) RevokeInvitation(ctx context.Context, tenantID, invitationID uuid.UUID) error {
+	invitation, err := s.invitationRepo.GetByID(ctx, invitationID)
+	if err != nil || invitation.TenantID != tenantID {
+		return fmt.Errorf("invitation not found")
+	}
+	return s.invitationRepo.Revoke(ctx, invitationID)
+}
+
+func (s *invitationService) GetInvitationByToken(ctx context.Context, token string) (*domain.Invitation, error) {
+	invitationID, err := s.verifyInvitationToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.invitationRepo.GetByID(ctx, invitationID)
+	if err != nil {
+		return nil, fmt.Errorf("invitation not found")
+	}
+
+	if status := invitation.Status(); status != domain.InvitationStatusPending {
+		return nil, fmt.Errorf("invitation is %s", status)
+	}
+	return invitation, nil
+}
+
+func (s *invitationService) AcceptInvitation(ctx context.Context, token, name, password string, useSSO bool) (*domain.User, error) {
+	invitation, err := s.GetInvitationByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, invitation.TenantID, invitation.Email)
+	if err != nil {
+		// New user: they choose a password, or sign in through SSO with the invited email
+		if useSSO {
+			password, err = randomPassword()
+			if err != nil {
+				return nil, err
+			}
+		} else if err := s.passwordPolicy.ValidatePassword(ctx, invitation.TenantID, password); err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(name) == "" {
+			name = invitation.Name
+		}
+		if strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("name is required")
+		}
+
+		// The invitation link proves ownership of the email, so CreateUser marks it verified
+		user, err = s.userService.CreateUser(ctx, &domain.User{
+			TenantID:      invitation.TenantID,
+			Name:          name,
+			Email:         invitation.Email,
+			Password:      password,
+			DepartmentID:  invitation.DepartmentID,
+			DesignationID: invitation.DesignationID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user: %w", err)
+		}
+		log.Printf("✅ User %s created from invitation %s", user.UserID, invitation.InvitationID)
+	}
+
+	// Roles may have been deleted or moved since the invitation was sent
+	if _, err := checkOrganization(ctx, s.membershipRepo, invitation.TenantID, invitation.OrgID); err != nil {
+		return nil, err
+	}
+	for _, roleID := range invitation.RoleIDs {
+		if err := checkRole(ctx, s.roleRepo, invitation.TenantID, invitation.OrgID, roleID); err != nil {
+			return nil, err
+		}
+	}
+
+	// A user created above is kept if this fails; accepting again finds them by email
+	membership := &domain.UserOrganizationRole{
+		UserID:        user.UserID,
+		OrgID:         invitation.OrgID,
+		RoleID:        invitation.RoleIDs[0],
+		DepartmentID:  invitation.DepartmentID,
+		DesignationID: invitation.DesignationID,
+		AssignedBy:    invitation.InvitedBy,
+	}
+	if err := s.invitationRepo.Accept(ctx, invitation.InvitationID, membership, invitation.RoleIDs); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ User %s joined organization %s through invitation %s", user.UserID, invitation.OrgID, invitation.InvitationID)
+	return user, nil
+}
+
+// randomPassword generates an unusable password for SSO-only accounts
+func randomPassword() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate password: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
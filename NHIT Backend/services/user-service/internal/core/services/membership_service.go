@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/user-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type membershipService struct {
+	membershipRepo ports.MembershipRepository
+	userRepo       ports.UserRepository
+	userRoleRepo   ports.UserRoleRepository
+	roleRepo       ports.RoleRepository
+}
+
+// NewMembershipService creates a new user-organization membership service
+func NewMembershipService(membershipRepo ports.MembershipRepository, userRepo ports.UserRepository, userRoleRepo ports.UserRoleRepository, roleRepo ports.RoleRepository) ports.MembershipService {
+	return &membershipService{
+		membershipRepo: membershipRepo,
+		userRepo:       userRepo,
+		userRoleRepo:   userRoleRepo,
+		roleRepo:       roleRepo,
+	}
+}
+
+// checkOrganization ensures the organization exists and belongs to the tenant
+func checkOrganization(ctx context.Context, repo ports.MembershipRepository, tenantID, orgID uuid.UUID) (string, error) {
+	orgTenantID, orgName, err := repo.GetOrganization(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+	if orgTenantID != tenantID {
+		return "", fmt.Errorf("organization not found")
+	}
+	return orgName, nil
+}
+
+// checkRole ensures the role is usable in the organization: a tenant role that is either
+// global to the tenant or scoped to this organization
+func checkRole(ctx context.Context, repo ports.RoleRepository, tenantID, orgID, roleID uuid.UUID) error {
+	role, err := repo.GetByID(ctx, roleID)
+	if err != nil {
+		return fmt.Errorf("role %s not found", roleID)
+	}
+	if role.TenantID != tenantID || (role.OrgID != nil && *role.OrgID != orgID) {
+		return fmt.Errorf("role %s is not available in this organization", roleID)
+	}
+	return nil
+}
+
+func (s *membershipService) AddUserToOrganization(ctx context.Context, tenantID uuid.UUID, membership *domain.UserOrganizationRole) error {
+	if _, err := checkOrganization(ctx, s.membershipRepo, tenantID, membership.OrgID); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, membership.UserID)
+	if err != nil || user.TenantID != tenantID {
+		return fmt.Errorf("user not found")
+	}
+
+	if err := checkRole(ctx, s.roleRepo, tenantID, membership.OrgID, membership.RoleID); err != nil {
+		return err
+	}
+
+	if err := s.membershipRepo.Upsert(ctx, membership); err != nil {
+		return err
+	}
+
+	if err := s.userRoleRepo.AssignRole(ctx, membership.UserID, membership.RoleID); err != nil {
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	log.Printf("✅ User %s added to organization %s", membership.UserID, membership.OrgID)
+	return nil
+}
+
+func (s *membershipService) RemoveUserFromOrganization(ctx context.Context, tenantID, userID, orgID uuid.UUID) error {
+	if _, err := checkOrganization(ctx, s.membershipRepo, tenantID, orgID); err != nil {
+		return err
+	}
+
+	membership, err := s.membershipRepo.Get(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.membershipRepo.Remove(ctx, userID, orgID); err != nil {
+		return err
+	}
+
+	// Drop the role unless another membership still grants it
+	if membership.RoleID != uuid.Nil {
+		remaining, err := s.membershipRepo.CountWithRole(ctx, userID, membership.RoleID)
+		if err != nil {
+			log.Printf("⚠️ Failed to check remaining memberships of user %s: %v", userID, err)
+		} else if remaining == 0 {
+			if err := s.userRoleRepo.RemoveRole(ctx, userID, membership.RoleID); err != nil {
+				log.Printf("⚠️ Failed to remove role %s from user %s: %v", membership.RoleID, userID, err)
+			}
+		}
+	}
+
+	log.Printf("✅ User %s removed from organization %s", userID, orgID)
+	return nil
+}
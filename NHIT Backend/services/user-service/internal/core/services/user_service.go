@@ -521,6 +521,31 @@ func (s *userService) GetTenant(ctx context.Context, tenantID uuid.UUID) (*domai
 	return tenant, nil
 }
 
+// DeleteTenant removes a tenant with its users and roles. The super admin
+// password is required and the tenant must not own or belong to an
+// organization, so only a tenant whose onboarding never finished can go.
+func (s *userService) DeleteTenant(ctx context.Context, tenantID uuid.UUID, password string) error {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("tenant not found")
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(tenant.Password), []byte(password)) != nil {
+		return fmt.Errorf("invalid tenant password")
+	}
+
+	deleted, err := s.tenantRepo.DeleteIfEmpty(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to delete tenant: %w", err)
+	}
+	if !deleted {
+		return fmt.Errorf("tenant still has organizations or members")
+	}
+
+	fmt.Printf("Deleted tenant %s with its users and roles\n", tenantID)
+	return nil
+}
+
 // hashPassword hashes a password using bcrypt
 func (s *userService) hashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -0,0 +1,39 @@
+-- Invite-based onboarding and organization memberships
+
+-- Pending / accepted invitations of an email address to an organization
+CREATE TABLE IF NOT EXISTS user_invitations (
+    invitation_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    org_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    role_ids UUID[] NOT NULL DEFAULT '{}',
+    department_id UUID,
+    designation_id UUID,
+    invited_by UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    accepted_user_id UUID,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_invitations_tenant_org ON user_invitations(tenant_id, org_id);
+CREATE INDEX IF NOT EXISTS idx_user_invitations_email ON user_invitations(lower(email));
+
+-- Only one open invitation per email and organization
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_invitations_open
+    ON user_invitations(org_id, lower(email))
+    WHERE accepted_at IS NULL AND revoked_at IS NULL;
+
+-- Per-organization assignment details of a membership
+ALTER TABLE user_organizations
+ADD COLUMN IF NOT EXISTS department_id UUID,
+ADD COLUMN IF NOT EXISTS designation_id UUID,
+ADD COLUMN IF NOT EXISTS project_ids UUID[] NOT NULL DEFAULT '{}',
+ADD COLUMN IF NOT EXISTS added_by UUID;
+
+COMMENT ON TABLE user_invitations IS 'Invitations sent to an email to join an organization; accepted through a signed, expiring link';
+COMMENT ON COLUMN user_invitations.role_ids IS 'Roles granted to the invitee when the invitation is accepted';
+COMMENT ON COLUMN user_organizations.project_ids IS 'Projects the member is assigned to within the organization';
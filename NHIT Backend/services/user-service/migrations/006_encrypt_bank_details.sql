@@ -0,0 +1,23 @@
+-- Store user bank account numbers and IFSC codes encrypted at rest.
+-- Values are written in the fieldcrypt envelope format
+-- (enc:v1:<key id>:<wrapped data key>:<ciphertext>), which does not fit the
+-- original VARCHAR limits.
+--
+-- Existing plaintext rows keep working (reads pass them through unchanged) and
+-- are encrypted by running: go run ./cmd/encrypt-bank-data
+
+ALTER TABLE users ALTER COLUMN bank_account_number TYPE TEXT;
+ALTER TABLE users ALTER COLUMN ifsc_code TYPE TEXT;
+
+-- Ciphertext is randomised per write, so these indexes can no longer be used for lookups
+DROP INDEX IF EXISTS idx_users_bank_account;
+DROP INDEX IF EXISTS idx_users_ifsc_code;
+
+COMMENT ON COLUMN users.bank_account_number IS 'Bank account number (envelope encrypted)';
+COMMENT ON COLUMN users.ifsc_code IS 'IFSC code of the bank branch (envelope encrypted)';
+
+-- Full bank details are masked in API responses unless the caller holds this permission
+INSERT INTO permissions (name, description, module, action, is_system_permission)
+VALUES
+    ('reveal-bank-details', 'View full bank account numbers and IFSC codes', 'banking', 'reveal', TRUE)
+ON CONFLICT (name) DO NOTHING;
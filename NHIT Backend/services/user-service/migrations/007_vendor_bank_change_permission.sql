@@ -0,0 +1,6 @@
+-- Vendor bank account changes (new accounts, changed bank details, primary
+-- account switches) must be approved by a different user holding this permission
+INSERT INTO permissions (name, description, module, action, is_system_permission)
+VALUES
+    ('approve-vendor-bank-changes', 'Approve or reject vendor bank account changes', 'vendors', 'approve', TRUE)
+ON CONFLICT (name) DO NOTHING;
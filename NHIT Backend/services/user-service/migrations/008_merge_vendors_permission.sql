@@ -0,0 +1,6 @@
+-- Merging a duplicate vendor into another moves its bank accounts and
+-- deactivates it, so it is restricted to holders of this permission
+INSERT INTO permissions (name, description, module, action, is_system_permission)
+VALUES
+    ('merge-vendors', 'Merge duplicate vendors', 'vendors', 'merge', TRUE)
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,6 @@
+-- Vendor portal APIs are restricted to logins linked to a vendor and holding
+-- this permission (typically through a tenant's VENDOR role)
+INSERT INTO permissions (name, description, module, action, is_system_permission)
+VALUES
+    ('vendor-portal', 'Use the vendor self-service portal', 'vendors', 'portal', TRUE)
+ON CONFLICT (name) DO NOTHING;
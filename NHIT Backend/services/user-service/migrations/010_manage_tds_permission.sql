@@ -0,0 +1,6 @@
+-- Maintaining the TDS section master and vendors' lower deduction
+-- certificates is restricted to finance/tax users
+INSERT INTO permissions (name, description, module, action, is_system_permission)
+VALUES
+    ('manage-tds', 'Maintain TDS sections and lower deduction certificates', 'vendors', 'manage-tds', TRUE)
+ON CONFLICT (name) DO NOTHING;
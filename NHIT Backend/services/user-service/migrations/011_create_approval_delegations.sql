@@ -0,0 +1,39 @@
+-- Approval delegations: while a user is away, another user may act on their
+-- approval permissions for a date range, optionally in one organization or project
+
+CREATE TABLE IF NOT EXISTS approval_delegations (
+    delegation_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    delegator_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    delegate_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    permissions TEXT[] NOT NULL,
+    org_id UUID,
+    project_id UUID,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_by UUID NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    revoked_by UUID,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT approval_delegations_distinct_users CHECK (delegator_id <> delegate_id),
+    CONSTRAINT approval_delegations_period CHECK (ends_at > starts_at),
+    CONSTRAINT approval_delegations_project_in_org CHECK (project_id IS NULL OR org_id IS NOT NULL)
+);
+
+-- Token validation looks up the delegations a user currently holds
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegate
+    ON approval_delegations(delegate_id, ends_at)
+    WHERE revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegator ON approval_delegations(delegator_id);
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_tenant ON approval_delegations(tenant_id);
+
+COMMENT ON TABLE approval_delegations IS 'Approval permissions a user hands to another user for a period; honoured by the RBAC interceptor through token validation';
+COMMENT ON COLUMN approval_delegations.permissions IS 'Approval permissions the delegator held when the delegation was created';
+
+-- Setting delegations on behalf of other users, e.g. for staff who left on short notice
+INSERT INTO permissions (name, description, module, action, is_system_permission)
+VALUES
+    ('manage-delegations', 'Create and revoke approval delegations on behalf of other users', 'users', 'manage-delegations', TRUE)
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,27 @@
+-- Scoped role assignments: a role granted to a user for one organization,
+-- project or department only. Roles in user_roles keep applying wherever the
+-- user signs in; these are evaluated by the policy engine against the
+-- organization, project or department a request acts on.
+
+CREATE TABLE IF NOT EXISTS user_role_scopes (
+    assignment_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles(role_id) ON DELETE CASCADE,
+    scope_type VARCHAR(20) NOT NULL,
+    org_id UUID NOT NULL,
+    scope_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ,
+    granted_by UUID NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT user_role_scopes_type CHECK (scope_type IN ('ORGANIZATION', 'PROJECT', 'DEPARTMENT')),
+    CONSTRAINT user_role_scopes_org CHECK (scope_type <> 'ORGANIZATION' OR scope_id = org_id),
+    CONSTRAINT user_role_scopes_unique UNIQUE (user_id, role_id, scope_type, scope_id)
+);
+
+-- Token validation looks up the assignments a user currently holds
+CREATE INDEX IF NOT EXISTS idx_user_role_scopes_user ON user_role_scopes(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_role_scopes_tenant ON user_role_scopes(tenant_id);
+
+COMMENT ON TABLE user_role_scopes IS 'Roles granted to a user for a single organization, project or department; honoured by the RBAC interceptor through token validation';
+COMMENT ON COLUMN user_role_scopes.scope_id IS 'The organization itself, or a project or department of org_id';
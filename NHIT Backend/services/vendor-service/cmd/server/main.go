@@ -8,16 +8,22 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
 	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
 	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/adapters"
 	grpcAdapter "github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/adapters/grpc"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/adapters/compliance"
+	kafkaAdapter "github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/adapters/kafka"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/adapters/repository"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/services"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/storage"
@@ -66,21 +72,70 @@ func main() {
 
 	// Initialize dependencies
 	logger := adapters.NewSimpleLogger()
-	publisher := adapters.NewNoOpEventPublisher()
+	coolingOffHours, err := strconv.Atoi(getEnv("VENDOR_ACCOUNT_COOLING_OFF_HOURS", "24"))
+	if err != nil {
+		log.Fatalf("Invalid VENDOR_ACCOUNT_COOLING_OFF_HOURS: %v", err)
+	}
+	// MSME payment tracking: RBI bank rate (percent) and days before the due date to flag invoices at risk
+	msmeBankRate, err := strconv.ParseFloat(getEnv("MSME_BANK_RATE", "5.75"), 64)
+	if err != nil {
+		log.Fatalf("Invalid MSME_BANK_RATE: %v", err)
+	}
+	msmeAtRiskDays, err := strconv.Atoi(getEnv("MSME_AT_RISK_DAYS", "7"))
+	if err != nil {
+		log.Fatalf("Invalid MSME_AT_RISK_DAYS: %v", err)
+	}
 	serviceConfig := ports.VendorServiceConfig{
 		EnableCodeGeneration: true,
 		DefaultVendorType:    "EXTERNAL",
 		MaxAccountsPerVendor: 10,
+		AccountCoolingOff:    time.Duration(coolingOffHours) * time.Hour,
+		MSME: domain.MSMEPolicy{
+			BankRate:   msmeBankRate,
+			AtRiskDays: msmeAtRiskDays,
+		},
 	}
 
+	// Field encryption for bank details
+	keyManager, err := fieldcrypt.NewLocalKeyManagerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize field encryption: %v", err)
+	}
+	encryptor := fieldcrypt.NewEncryptor(keyManager)
+
 	// Initialize repository
-	vendorRepo := repository.NewVendorRepository(pool)
+	vendorRepo := repository.NewVendorRepository(pool, encryptor)
 	log.Println("✅ Repository initialized")
 
+	// Vendor events are recorded in the outbox with each change and relayed to Kafka
+	publisher := adapters.NewNoOpEventPublisher()
+	if brokers := strings.TrimSpace(os.Getenv("KAFKA_BROKERS")); brokers != "" {
+		publisher = kafkaAdapter.NewEventPublisher(vendorRepo)
+		relay := kafkaAdapter.NewOutboxRelay(strings.Split(brokers, ","), getEnv("VENDOR_EVENTS_TOPIC", "vendor.events"), vendorRepo)
+		defer relay.Close()
+		go relay.Run(ctx)
+	} else {
+		log.Println("⚠️  KAFKA_BROKERS not set, vendor events will not be published")
+	}
+
 	// Initialize service
-	vendorService := services.NewVendorService(vendorRepo, logger, publisher, serviceConfig)
+	// GST defaulter list for the offline compliance lookup
+	var defaultedGSTINs []string
+	if list := strings.TrimSpace(os.Getenv("GST_DEFAULTED_GSTINS")); list != "" {
+		defaultedGSTINs = strings.Split(list, ",")
+	}
+	complianceLookup := compliance.NewOfflineLookup(defaultedGSTINs)
+
+	vendorService := services.NewVendorService(vendorRepo, logger, publisher, complianceLookup, serviceConfig)
 	log.Println("✅ Vendor service initialized")
 
+	// Periodically flag MSME invoices that are at risk or overdue
+	msmeScanInterval, err := time.ParseDuration(getEnv("MSME_SCAN_INTERVAL", "1h"))
+	if err != nil {
+		log.Fatalf("Invalid MSME_SCAN_INTERVAL: %v", err)
+	}
+	go runMSMEScanner(ctx, vendorService, msmeScanInterval)
+
 	// Initialize MinIO client for vendor documents
 	minioEndpoint := os.Getenv("MINIO_ENDPOINT")
 	if minioEndpoint == "" {
@@ -131,6 +186,23 @@ func main() {
 	log.Println("🛑 Shutting down servers...")
 }
 
+// runMSMEScanner flags at-risk and overdue MSME invoices every interval
+func runMSMEScanner(ctx context.Context, vendorService ports.VendorService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := vendorService.FlagMSMEInvoices(ctx, time.Now()); err != nil {
+			log.Printf("⚠️  MSME invoice scan failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func startGRPCServer(vendorHandler vendorpb.VendorServiceServer) error {
 	listener, err := net.Listen("tcp", grpcPort)
 	if err != nil {
@@ -6,10 +6,17 @@ require (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/gstin v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/money v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/spreadsheet v0.0.0
 	github.com/google/uuid v1.6.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3
 	github.com/jackc/pgx/v5 v5.7.6
+	github.com/minio/minio-go/v7 v7.0.97
+	github.com/segmentio/kafka-go v0.4.49
+	github.com/xuri/excelize/v2 v2.9.1
 	google.golang.org/grpc v1.77.0
 	google.golang.org/protobuf v1.36.10
 )
@@ -25,10 +32,15 @@ require (
 	github.com/klauspost/crc32 v1.3.0 // indirect
 	github.com/minio/crc64nvme v1.1.0 // indirect
 	github.com/minio/md5-simd v1.1.2 // indirect
-	github.com/minio/minio-go/v7 v7.0.97 // indirect
 	github.com/philhofer/fwd v1.2.0 // indirect
+	github.com/pierrec/lz4/v4 v4.1.15 // indirect
+	github.com/richardlehane/mscfb v1.0.4 // indirect
+	github.com/richardlehane/msoleps v1.0.4 // indirect
 	github.com/rs/xid v1.6.0 // indirect
+	github.com/tiendc/go-deepcopy v1.6.0 // indirect
 	github.com/tinylib/msgp v1.3.0 // indirect
+	github.com/xuri/efp v0.0.1 // indirect
+	github.com/xuri/nfp v0.0.1 // indirect
 	golang.org/x/crypto v0.43.0 // indirect
 	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
 	golang.org/x/sync v0.17.0 // indirect
@@ -44,5 +56,9 @@ replace (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/organizationpb => ../../api/pb/organizationpb
 	github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb => ../../api/pb/projectpb
 	github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb => ../../api/pb/vendorpb
+	github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt => ../../pkg/fieldcrypt
+	github.com/ShristiRnr/NHIT_Backend/pkg/gstin => ../../pkg/gstin
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware => ../../pkg/middleware
+	github.com/ShristiRnr/NHIT_Backend/pkg/money => ../../pkg/money
+	github.com/ShristiRnr/NHIT_Backend/pkg/spreadsheet => ../../pkg/spreadsheet
 )
@@ -0,0 +1,38 @@
+package compliance
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+)
+
+// OfflineLookup answers compliance lookups from a static list of GST
+// defaulters instead of calling the GST network. It cannot verify Section
+// 206AB status, so that flag is always reported as unknown.
+type OfflineLookup struct {
+	defaulters map[string]struct{}
+}
+
+// NewOfflineLookup creates a ports.ComplianceLookup that flags the given GSTINs as defaulted
+func NewOfflineLookup(defaultedGSTINs []string) *OfflineLookup {
+	defaulters := make(map[string]struct{}, len(defaultedGSTINs))
+	for _, gstin := range defaultedGSTINs {
+		if gstin = domain.NormalizeGSTIN(gstin); gstin != "" {
+			defaulters[gstin] = struct{}{}
+		}
+	}
+	return &OfflineLookup{defaulters: defaulters}
+}
+
+var _ ports.ComplianceLookup = (*OfflineLookup)(nil)
+
+// LookupCompliance implements ports.ComplianceLookup
+func (l *OfflineLookup) LookupCompliance(ctx context.Context, gstin *string, pan string) (*ports.ComplianceStatus, error) {
+	if gstin == nil || strings.TrimSpace(*gstin) == "" {
+		return &ports.ComplianceStatus{}, nil
+	}
+	_, defaulted := l.defaulters[domain.NormalizeGSTIN(*gstin)]
+	return &ports.ComplianceStatus{GSTDefaulted: &defaulted}, nil
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"strings"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestAmount reads an amount given as a Money twin or, from older clients,
+// as a double. The twin wins when set; doubles are read through their
+// shortest decimal form.
+func requestAmount(field string, legacy float64, exact *vendorpb.Money) (money.Amount, error) {
+	var (
+		a   money.Amount
+		err error
+	)
+	switch {
+	case exact == nil:
+		a, err = money.FromFloat(legacy, money.HalfUp)
+	case strings.TrimSpace(exact.Value) != "":
+		a, err = money.ParseRound(exact.Value, money.HalfUp)
+	default:
+		a, err = money.FromUnitsNanos(exact.Units, exact.Nanos, money.HalfUp)
+	}
+	if err != nil {
+		return money.Zero, status.Errorf(codes.InvalidArgument, "%s: %v", field, err)
+	}
+	return a, nil
+}
+
+// moneyProto returns the Money twin of an amount
+func moneyProto(a money.Amount) *vendorpb.Money {
+	units, nanos := a.UnitsNanos()
+	return &vendorpb.Money{Value: a.String(), Units: units, Nanos: nanos}
+}
@@ -0,0 +1,313 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const msmeDateLayout = "2006-01-02"
+
+// RegisterMSMEInvoice starts tracking an invoice of a micro or small enterprise vendor
+func (h *VendorGRPCHandler) RegisterMSMEInvoice(ctx context.Context, req *vendorpb.RegisterMSMEInvoiceRequest) (*vendorpb.MSMEInvoiceResponse, error) {
+	tenantUUID, userUUID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendorUUID, err := uuid.Parse(req.VendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+	}
+	invoiceDate, err := parseMSMEDate("invoice_date", req.InvoiceDate)
+	if err != nil {
+		return nil, err
+	}
+	acceptanceDate, err := parseOptionalMSMEDate("acceptance_date", req.AcceptanceDate)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := requestAmount("invoice_amount", req.InvoiceAmount, req.InvoiceAmountExact)
+	if err != nil {
+		return nil, err
+	}
+
+	params := domain.RegisterMSMEInvoiceParams{
+		TenantID:       tenantUUID,
+		VendorID:       vendorUUID,
+		InvoiceNumber:  req.InvoiceNumber,
+		InvoiceDate:    invoiceDate,
+		AcceptanceDate: acceptanceDate,
+		InvoiceAmount:  amount,
+		GreenNoteID:    req.GreenNoteId,
+		PaymentNoteID:  req.PaymentNoteId,
+		CreatedBy:      userUUID,
+	}
+	if req.AgreedCreditDays != nil {
+		days := int(*req.AgreedCreditDays)
+		params.AgreedCreditDays = &days
+	}
+
+	invoice, err := h.vendorService.RegisterMSMEInvoice(ctx, params)
+	if err != nil {
+		return nil, msmeError(err)
+	}
+	pi, err := toProtoMSMEInvoice(invoice, time.Now(), h.vendorService.MSMEPolicy())
+	if err != nil {
+		return nil, msmeError(err)
+	}
+	return &vendorpb.MSMEInvoiceResponse{Invoice: pi}, nil
+}
+
+// RecordMSMEInvoicePayment settles a tracked MSME invoice
+func (h *VendorGRPCHandler) RecordMSMEInvoicePayment(ctx context.Context, req *vendorpb.RecordMSMEInvoicePaymentRequest) (*vendorpb.MSMEInvoiceResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceUUID, err := uuid.Parse(req.InvoiceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invoice_id")
+	}
+	paidDate, err := parseMSMEDate("paid_date", req.PaidDate)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := h.vendorService.RecordMSMEInvoicePayment(ctx, tenantUUID, invoiceUUID, paidDate, req.PaymentNoteId, req.PaymentReference)
+	if err != nil {
+		return nil, msmeError(err)
+	}
+	pi, err := toProtoMSMEInvoice(invoice, paidDate, h.vendorService.MSMEPolicy())
+	if err != nil {
+		return nil, msmeError(err)
+	}
+	return &vendorpb.MSMEInvoiceResponse{Invoice: pi}, nil
+}
+
+// ListMSMEInvoices lists tracked MSME invoices with their status on as_of
+func (h *VendorGRPCHandler) ListMSMEInvoices(ctx context.Context, req *vendorpb.ListMSMEInvoicesRequest) (*vendorpb.ListMSMEInvoicesResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	asOf := time.Now()
+	if t, err := parseOptionalMSMEDate("as_of", req.AsOf); err != nil {
+		return nil, err
+	} else if t != nil {
+		asOf = *t
+	}
+
+	filters := ports.MSMEInvoiceFilters{
+		Status:        req.Status,
+		GreenNoteID:   req.GreenNoteId,
+		PaymentNoteID: req.PaymentNoteId,
+		AsOf:          asOf,
+		Limit:         int(pageSize),
+		Offset:        int((page - 1) * pageSize),
+	}
+	if req.Status != nil {
+		switch *req.Status {
+		case domain.MSMEInvoiceOpen, domain.MSMEInvoiceAtRisk, domain.MSMEInvoiceOverdue,
+			domain.MSMEInvoicePaid, domain.MSMEInvoicePaidLate:
+		default:
+			return nil, status.Error(codes.InvalidArgument, "invalid status")
+		}
+	}
+	if req.VendorId != nil && *req.VendorId != "" {
+		vendorUUID, err := uuid.Parse(*req.VendorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+		}
+		filters.VendorID = &vendorUUID
+	}
+
+	invoices, total, err := h.vendorService.ListMSMEInvoices(ctx, tenantUUID, filters)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	policy := h.vendorService.MSMEPolicy()
+	protoInvoices := make([]*vendorpb.MSMEInvoice, len(invoices))
+	for i, inv := range invoices {
+		if protoInvoices[i], err = toProtoMSMEInvoice(inv, asOf, policy); err != nil {
+			return nil, msmeError(err)
+		}
+	}
+
+	return &vendorpb.ListMSMEInvoicesResponse{
+		Invoices:   protoInvoices,
+		TotalCount: total,
+		Pagination: &vendorpb.PaginationMetadata{
+			CurrentPage: page,
+			PageSize:    pageSize,
+			TotalItems:  total,
+			TotalPages:  int32((total + int64(pageSize) - 1) / int64(pageSize)),
+		},
+	}, nil
+}
+
+// GetMSMEOutstandingReport reports MSME dues and interest liability per vendor
+func (h *VendorGRPCHandler) GetMSMEOutstandingReport(ctx context.Context, req *vendorpb.GetMSMEOutstandingReportRequest) (*vendorpb.MSMEOutstandingReportResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := time.Now()
+	if t, err := parseOptionalMSMEDate("as_of", req.AsOf); err != nil {
+		return nil, err
+	} else if t != nil {
+		asOf = *t
+	}
+	periodStart, err := parseOptionalMSMEDate("period_start", req.PeriodStart)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.vendorService.GetMSMEOutstandingReport(ctx, tenantUUID, asOf, periodStart)
+	if err != nil {
+		return nil, msmeError(err)
+	}
+
+	resp := &vendorpb.MSMEOutstandingReportResponse{
+		AsOf:                   report.AsOf.Format(msmeDateLayout),
+		PeriodStart:            report.PeriodStart.Format(msmeDateLayout),
+		BankRate:               report.BankRate,
+		Vendors:                make([]*vendorpb.MSMEVendorOutstanding, len(report.Vendors)),
+		TotalOutstandingAmount: report.TotalOutstandingAmount.Float64(),
+		TotalOverdueAmount:     report.TotalOverdueAmount.Float64(),
+		TotalPaidLateAmount:    report.TotalPaidLateAmount.Float64(),
+		TotalInterestLiability: report.TotalInterestLiability.Float64(),
+
+		TotalOutstandingAmountExact: moneyProto(report.TotalOutstandingAmount),
+		TotalOverdueAmountExact:     moneyProto(report.TotalOverdueAmount),
+		TotalPaidLateAmountExact:    moneyProto(report.TotalPaidLateAmount),
+		TotalInterestLiabilityExact: moneyProto(report.TotalInterestLiability),
+	}
+	for i, v := range report.Vendors {
+		resp.Vendors[i] = &vendorpb.MSMEVendorOutstanding{
+			VendorId:               v.VendorID.String(),
+			VendorCode:             v.VendorCode,
+			VendorName:             v.VendorName,
+			MsmeClassification:     v.MSMEClassification,
+			MsmeRegistrationNumber: v.MSMERegistrationNumber,
+			OutstandingInvoices:    int32(v.OutstandingInvoices),
+			OutstandingAmount:      v.OutstandingAmount.Float64(),
+			OverdueInvoices:        int32(v.OverdueInvoices),
+			OverdueAmount:          v.OverdueAmount.Float64(),
+			PaidLateInvoices:       int32(v.PaidLateInvoices),
+			PaidLateAmount:         v.PaidLateAmount.Float64(),
+			InterestLiability:      v.InterestLiability.Float64(),
+			OldestDueDate:          formatMSMEDatePtr(v.OldestDueDate),
+
+			OutstandingAmountExact: moneyProto(v.OutstandingAmount),
+			OverdueAmountExact:     moneyProto(v.OverdueAmount),
+			PaidLateAmountExact:    moneyProto(v.PaidLateAmount),
+			InterestLiabilityExact: moneyProto(v.InterestLiability),
+		}
+	}
+	return resp, nil
+}
+
+// msmeError maps MSME tracking errors to gRPC status codes
+func msmeError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInvoiceNumber), errors.Is(err, domain.ErrInvalidInvoiceAmount),
+		errors.Is(err, domain.ErrInvalidInvoiceDates), errors.Is(err, domain.ErrInvalidCreditDays),
+		errors.Is(err, domain.ErrInvalidReportPeriod):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrVendorNotFound), errors.Is(err, domain.ErrMSMEInvoiceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrMSMEInvoiceExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrVendorNotMSME), errors.Is(err, domain.ErrMSMEInvoiceAlreadyPaid):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, money.ErrOverflow):
+		return status.Error(codes.OutOfRange, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoMSMEInvoice(inv *domain.MSMEInvoice, asOf time.Time, policy domain.MSMEPolicy) (*vendorpb.MSMEInvoice, error) {
+	interest, err := inv.Interest(asOf, policy.BankRate)
+	if err != nil {
+		return nil, err
+	}
+	pi := &vendorpb.MSMEInvoice{
+		Id:                     inv.ID.String(),
+		VendorId:               inv.VendorID.String(),
+		VendorCode:             inv.VendorCode,
+		VendorName:             inv.VendorName,
+		MsmeClassification:     inv.MSMEClassification,
+		MsmeRegistrationNumber: inv.MSMERegistrationNumber,
+		InvoiceNumber:          inv.InvoiceNumber,
+		InvoiceDate:            inv.InvoiceDate.Format(msmeDateLayout),
+		AcceptanceDate:         formatMSMEDatePtr(inv.AcceptanceDate),
+		InvoiceAmount:          inv.InvoiceAmount.Float64(),
+		DueDate:                inv.DueDate.Format(msmeDateLayout),
+		GreenNoteId:            inv.GreenNoteID,
+		PaymentNoteId:          inv.PaymentNoteID,
+		PaidDate:               formatMSMEDatePtr(inv.PaidDate),
+		PaymentReference:       inv.PaymentReference,
+		Status:                 inv.Status(asOf, policy.AtRiskDays),
+		DaysPastDue:            int32(inv.DaysPastDue(asOf)),
+		InterestLiability:      interest.Float64(),
+		CreatedAt:              timestamppb.New(inv.CreatedAt),
+		InvoiceAmountExact:     moneyProto(inv.InvoiceAmount),
+		InterestLiabilityExact: moneyProto(interest),
+	}
+	if inv.AgreedCreditDays != nil {
+		days := int32(*inv.AgreedCreditDays)
+		pi.AgreedCreditDays = &days
+	}
+	return pi, nil
+}
+
+func parseMSMEDate(field, value string) (time.Time, error) {
+	t, err := time.Parse(msmeDateLayout, value)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s must be a date as YYYY-MM-DD", field)
+	}
+	return t, nil
+}
+
+func parseOptionalMSMEDate(field string, value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	t, err := parseMSMEDate(field, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func formatMSMEDatePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(msmeDateLayout)
+	return &s
+}
@@ -0,0 +1,356 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListTDSSections returns the TDS section master
+func (h *VendorGRPCHandler) ListTDSSections(ctx context.Context, req *vendorpb.ListTDSSectionsRequest) (*vendorpb.ListTDSSectionsResponse, error) {
+	if _, _, err := callerFromMetadata(ctx); err != nil {
+		return nil, err
+	}
+
+	sections, err := h.vendorService.ListTDSSections(ctx, req.IncludeInactive)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &vendorpb.ListTDSSectionsResponse{Sections: make([]*vendorpb.TDSSection, len(sections))}
+	for i, s := range sections {
+		resp.Sections[i] = toProtoTDSSection(s)
+	}
+	return resp, nil
+}
+
+// UpsertTDSSection creates a TDS section or changes its rates and thresholds
+func (h *VendorGRPCHandler) UpsertTDSSection(ctx context.Context, req *vendorpb.UpsertTDSSectionRequest) (*vendorpb.TDSSectionResponse, error) {
+	_, userUUID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !middleware.HasPermission(ctx, config.ManageTDSPermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission %s required", config.ManageTDSPermission)
+	}
+
+	singleThreshold, err := requestAmount("single_threshold", req.SingleThreshold, req.SingleThresholdExact)
+	if err != nil {
+		return nil, err
+	}
+	annualThreshold, err := requestAmount("annual_threshold", req.AnnualThreshold, req.AnnualThresholdExact)
+	if err != nil {
+		return nil, err
+	}
+
+	section, err := h.vendorService.UpsertTDSSection(ctx, &domain.TDSSection{
+		Code:            req.Code,
+		Description:     req.Description,
+		RateIndividual:  req.RateIndividual,
+		RateOther:       req.RateOther,
+		RateNoPAN:       req.RateNoPan,
+		SingleThreshold: singleThreshold,
+		AnnualThreshold: annualThreshold,
+		DeductOnExcess:  req.DeductOnExcess,
+		IsActive:        req.IsActive,
+	}, userUUID)
+	if err != nil {
+		return nil, tdsError(err)
+	}
+	return &vendorpb.TDSSectionResponse{Section: toProtoTDSSection(section)}, nil
+}
+
+// CreateTDSCertificate records a vendor's Section 197 lower or nil deduction certificate
+func (h *VendorGRPCHandler) CreateTDSCertificate(ctx context.Context, req *vendorpb.CreateTDSCertificateRequest) (*vendorpb.TDSCertificateResponse, error) {
+	tenantUUID, userUUID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !middleware.HasPermission(ctx, config.ManageTDSPermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission %s required", config.ManageTDSPermission)
+	}
+
+	vendorUUID, err := uuid.Parse(req.VendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+	}
+	validFrom, err := parseMSMEDate("valid_from", req.ValidFrom)
+	if err != nil {
+		return nil, err
+	}
+	validTo, err := parseMSMEDate("valid_to", req.ValidTo)
+	if err != nil {
+		return nil, err
+	}
+	amountLimit, err := requestAmount("amount_limit", req.AmountLimit, req.AmountLimitExact)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := h.vendorService.CreateTDSCertificate(ctx, domain.CreateTDSCertificateParams{
+		TenantID:          tenantUUID,
+		VendorID:          vendorUUID,
+		SectionCode:       req.SectionCode,
+		CertificateNumber: req.CertificateNumber,
+		Rate:              req.Rate,
+		ValidFrom:         validFrom,
+		ValidTo:           validTo,
+		AmountLimit:       amountLimit,
+		CreatedBy:         userUUID,
+	})
+	if err != nil {
+		return nil, tdsError(err)
+	}
+	return &vendorpb.TDSCertificateResponse{Certificate: toProtoTDSCertificate(cert)}, nil
+}
+
+// ListTDSCertificates lists a vendor's certificates with the amount used
+func (h *VendorGRPCHandler) ListTDSCertificates(ctx context.Context, req *vendorpb.ListTDSCertificatesRequest) (*vendorpb.ListTDSCertificatesResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendorUUID, err := uuid.Parse(req.VendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+	}
+	certs, err := h.vendorService.ListTDSCertificates(ctx, tenantUUID, vendorUUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &vendorpb.ListTDSCertificatesResponse{Certificates: make([]*vendorpb.TDSCertificate, len(certs))}
+	for i, c := range certs {
+		resp.Certificates[i] = toProtoTDSCertificate(c)
+	}
+	return resp, nil
+}
+
+// CalculateTDS suggests the TDS on a payment without recording it
+func (h *VendorGRPCHandler) CalculateTDS(ctx context.Context, req *vendorpb.CalculateTDSRequest) (*vendorpb.TDSDeductionResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tdsReq, err := toTDSRequest(tenantUUID, req.VendorId, req.VendorCode, req.SectionCode, req.Amount, req.AmountExact, req.PaymentDate)
+	if err != nil {
+		return nil, err
+	}
+	deduction, err := h.vendorService.CalculateTDS(ctx, tdsReq)
+	if err != nil {
+		return nil, tdsError(err)
+	}
+	return &vendorpb.TDSDeductionResponse{Deduction: toProtoTDSDeduction(deduction)}, nil
+}
+
+// RecordTDSDeduction records the TDS on a payment against the vendor's thresholds
+func (h *VendorGRPCHandler) RecordTDSDeduction(ctx context.Context, req *vendorpb.RecordTDSDeductionRequest) (*vendorpb.TDSDeductionResponse, error) {
+	tenantUUID, userUUID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tdsReq, err := toTDSRequest(tenantUUID, req.VendorId, req.VendorCode, req.SectionCode, req.Amount, req.AmountExact, req.PaymentDate)
+	if err != nil {
+		return nil, err
+	}
+	deduction, err := h.vendorService.RecordTDSDeduction(ctx, tdsReq, req.ReferenceId, userUUID)
+	if err != nil {
+		return nil, tdsError(err)
+	}
+	return &vendorpb.TDSDeductionResponse{Deduction: toProtoTDSDeduction(deduction)}, nil
+}
+
+// ReverseTDSDeduction removes a recorded deduction
+func (h *VendorGRPCHandler) ReverseTDSDeduction(ctx context.Context, req *vendorpb.ReverseTDSDeductionRequest) (*emptypb.Empty, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.vendorService.ReverseTDSDeduction(ctx, tenantUUID, req.ReferenceId); err != nil {
+		return nil, tdsError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetVendorTDSSummary totals a vendor's deductions per section for a financial year
+func (h *VendorGRPCHandler) GetVendorTDSSummary(ctx context.Context, req *vendorpb.GetVendorTDSSummaryRequest) (*vendorpb.VendorTDSSummaryResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendorUUID, err := uuid.Parse(req.VendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+	}
+	financialYear := req.GetFinancialYear()
+	summaries, err := h.vendorService.GetVendorTDSSummary(ctx, tenantUUID, vendorUUID, financialYear)
+	if err != nil {
+		return nil, tdsError(err)
+	}
+	if financialYear == "" {
+		financialYear = domain.FinancialYear(time.Now())
+	}
+
+	resp := &vendorpb.VendorTDSSummaryResponse{
+		VendorId:      vendorUUID.String(),
+		FinancialYear: financialYear,
+		Sections:      make([]*vendorpb.TDSSectionSummary, len(summaries)),
+	}
+	for i, s := range summaries {
+		resp.Sections[i] = &vendorpb.TDSSectionSummary{
+			SectionCode:     s.SectionCode,
+			Deductions:      int32(s.Deductions),
+			AggregateAmount: s.AggregateAmount.Float64(),
+			TaxableAmount:   s.TaxableAmount.Float64(),
+			TdsAmount:       s.TDSAmount.Float64(),
+			SingleThreshold: s.SingleThreshold.Float64(),
+			AnnualThreshold: s.AnnualThreshold.Float64(),
+
+			AggregateAmountExact: moneyProto(s.AggregateAmount),
+			TaxableAmountExact:   moneyProto(s.TaxableAmount),
+			TdsAmountExact:       moneyProto(s.TDSAmount),
+			SingleThresholdExact: moneyProto(s.SingleThreshold),
+			AnnualThresholdExact: moneyProto(s.AnnualThreshold),
+		}
+	}
+	return resp, nil
+}
+
+// toTDSRequest builds a TDS request from the vendor reference, section, amount and date fields
+func toTDSRequest(tenantID uuid.UUID, vendorID, vendorCode *string, sectionCode string, legacyAmount float64, exactAmount *vendorpb.Money, paymentDate *string) (ports.TDSRequest, error) {
+	req := ports.TDSRequest{TenantID: tenantID, SectionCode: sectionCode}
+	amount, err := requestAmount("amount", legacyAmount, exactAmount)
+	if err != nil {
+		return req, err
+	}
+	req.Amount = amount
+	switch {
+	case vendorID != nil && *vendorID != "":
+		id, err := uuid.Parse(*vendorID)
+		if err != nil {
+			return req, status.Error(codes.InvalidArgument, "invalid vendor_id")
+		}
+		req.VendorID = &id
+	case vendorCode != nil && *vendorCode != "":
+		req.VendorCode = *vendorCode
+	default:
+		return req, status.Error(codes.InvalidArgument, "vendor_id or vendor_code is required")
+	}
+
+	date, err := parseOptionalMSMEDate("payment_date", paymentDate)
+	if err != nil {
+		return req, err
+	}
+	if date != nil {
+		req.PaymentDate = *date
+	}
+	return req, nil
+}
+
+// tdsError maps TDS errors to gRPC status codes
+func tdsError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTDSSection), errors.Is(err, domain.ErrInvalidTDSRate),
+		errors.Is(err, domain.ErrInvalidTDSThreshold), errors.Is(err, domain.ErrInvalidTDSCertificate),
+		errors.Is(err, domain.ErrInvalidFinancialYear), errors.Is(err, domain.ErrInvalidTDSReference),
+		errors.Is(err, domain.ErrInvalidInvoiceAmount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrVendorNotFound), errors.Is(err, domain.ErrTDSSectionNotFound),
+		errors.Is(err, domain.ErrTDSDeductionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrTDSCertificateExists), errors.Is(err, domain.ErrTDSDeductionExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrTDSSectionInactive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, money.ErrOverflow):
+		return status.Error(codes.OutOfRange, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoTDSSection(s *domain.TDSSection) *vendorpb.TDSSection {
+	return &vendorpb.TDSSection{
+		Code:            s.Code,
+		Description:     s.Description,
+		RateIndividual:  s.RateIndividual,
+		RateOther:       s.RateOther,
+		RateNoPan:       s.RateNoPAN,
+		SingleThreshold: s.SingleThreshold.Float64(),
+		AnnualThreshold: s.AnnualThreshold.Float64(),
+		DeductOnExcess:  s.DeductOnExcess,
+		IsActive:        s.IsActive,
+		UpdatedAt:       timestamppb.New(s.UpdatedAt),
+
+		SingleThresholdExact: moneyProto(s.SingleThreshold),
+		AnnualThresholdExact: moneyProto(s.AnnualThreshold),
+	}
+}
+
+func toProtoTDSCertificate(c *domain.TDSCertificate) *vendorpb.TDSCertificate {
+	return &vendorpb.TDSCertificate{
+		Id:                c.ID.String(),
+		VendorId:          c.VendorID.String(),
+		SectionCode:       c.SectionCode,
+		CertificateNumber: c.CertificateNumber,
+		Rate:              c.Rate,
+		ValidFrom:         c.ValidFrom.Format(msmeDateLayout),
+		ValidTo:           c.ValidTo.Format(msmeDateLayout),
+		AmountLimit:       c.AmountLimit.Float64(),
+		AmountUsed:        c.AmountUsed.Float64(),
+		CreatedBy:         c.CreatedBy.String(),
+		CreatedAt:         timestamppb.New(c.CreatedAt),
+
+		AmountLimitExact: moneyProto(c.AmountLimit),
+		AmountUsedExact:  moneyProto(c.AmountUsed),
+	}
+}
+
+func toProtoTDSDeduction(d *domain.TDSDeduction) *vendorpb.TDSDeduction {
+	pd := &vendorpb.TDSDeduction{
+		VendorId:          d.VendorID.String(),
+		SectionCode:       d.SectionCode,
+		FinancialYear:     d.FinancialYear,
+		PaymentDate:       d.PaymentDate.Format(msmeDateLayout),
+		Amount:            d.Amount.Float64(),
+		AggregateBefore:   d.AggregateBefore.Float64(),
+		TaxableAmount:     d.TaxableAmount.Float64(),
+		Rate:              d.Rate,
+		Rule:              d.Rule,
+		CertificateAmount: d.CertificateAmount.Float64(),
+		CertificateRate:   d.CertificateRate,
+		TdsAmount:         d.TDSAmount.Float64(),
+		EffectiveRate:     d.EffectiveRate(),
+
+		AmountExact:            moneyProto(d.Amount),
+		AggregateBeforeExact:   moneyProto(d.AggregateBefore),
+		TaxableAmountExact:     moneyProto(d.TaxableAmount),
+		CertificateAmountExact: moneyProto(d.CertificateAmount),
+		TdsAmountExact:         moneyProto(d.TDSAmount),
+	}
+	if d.ReferenceID != "" {
+		pd.ReferenceId = &d.ReferenceID
+	}
+	if d.CertificateID != nil {
+		id := d.CertificateID.String()
+		pd.CertificateId = &id
+	}
+	return pd
+}
@@ -0,0 +1,245 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListVendorAccountChanges lists bank account change requests of the caller's tenant
+func (h *VendorGRPCHandler) ListVendorAccountChanges(ctx context.Context, req *vendorpb.ListVendorAccountChangesRequest) (*vendorpb.ListVendorAccountChangesResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	filters := ports.AccountChangeFilters{
+		Status: req.Status,
+		Limit:  int(pageSize),
+		Offset: int((page - 1) * pageSize),
+	}
+	if req.VendorId != nil && *req.VendorId != "" {
+		vendorUUID, err := uuid.Parse(*req.VendorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+		}
+		filters.VendorID = &vendorUUID
+	}
+
+	changes, total, err := h.vendorService.ListAccountChangeRequests(ctx, tenantUUID, filters)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	reveal := canRevealBankDetails(ctx)
+	protoChanges := make([]*vendorpb.VendorAccountChange, len(changes))
+	for i, c := range changes {
+		protoChanges[i] = toProtoAccountChange(c, reveal)
+	}
+
+	return &vendorpb.ListVendorAccountChangesResponse{
+		Changes:    protoChanges,
+		TotalCount: total,
+		Pagination: &vendorpb.PaginationMetadata{
+			CurrentPage: page,
+			PageSize:    pageSize,
+			TotalItems:  total,
+			TotalPages:  int32((total + int64(pageSize) - 1) / int64(pageSize)),
+		},
+	}, nil
+}
+
+// ApproveVendorAccountChange applies a pending bank account change. The
+// approver must hold approve-vendor-bank-changes and must not be the requester.
+func (h *VendorGRPCHandler) ApproveVendorAccountChange(ctx context.Context, req *vendorpb.ReviewVendorAccountChangeRequest) (*vendorpb.VendorAccountChangeResponse, error) {
+	return h.reviewAccountChange(ctx, req, h.vendorService.ApproveAccountChange)
+}
+
+// RejectVendorAccountChange discards a pending bank account change
+func (h *VendorGRPCHandler) RejectVendorAccountChange(ctx context.Context, req *vendorpb.ReviewVendorAccountChangeRequest) (*vendorpb.VendorAccountChangeResponse, error) {
+	return h.reviewAccountChange(ctx, req, h.vendorService.RejectAccountChange)
+}
+
+type reviewFunc func(ctx context.Context, tenantID, changeID, reviewerID uuid.UUID, remarks *string) (*domain.AccountChangeRequest, error)
+
+func (h *VendorGRPCHandler) reviewAccountChange(ctx context.Context, req *vendorpb.ReviewVendorAccountChangeRequest, review reviewFunc) (*vendorpb.VendorAccountChangeResponse, error) {
+	tenantUUID, reviewerID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !middleware.HasPermission(ctx, config.ApproveVendorBankChangesPermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission %s required", config.ApproveVendorBankChangesPermission)
+	}
+
+	changeUUID, err := uuid.Parse(req.ChangeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid change_id")
+	}
+
+	change, err := review(ctx, tenantUUID, changeUUID, reviewerID, req.Remarks)
+	if err != nil {
+		return nil, accountChangeError(err)
+	}
+
+	return &vendorpb.VendorAccountChangeResponse{
+		Change: toProtoAccountChange(change, canRevealBankDetails(ctx)),
+	}, nil
+}
+
+// VerifyPayeeAccount reports whether the tenant may pay the given bank account
+func (h *VendorGRPCHandler) VerifyPayeeAccount(ctx context.Context, req *vendorpb.VerifyPayeeAccountRequest) (*vendorpb.VerifyPayeeAccountResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.AccountNumber == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_number is required")
+	}
+
+	result, err := h.vendorService.VerifyPayeeAccount(ctx, tenantUUID, req.AccountNumber, req.IfscCode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &vendorpb.VerifyPayeeAccountResponse{
+		Payable:     result.Payable,
+		Reason:      result.Reason,
+		PayableFrom: toProtoTimestampPtr(result.PayableFrom),
+	}
+	if result.VendorID != nil {
+		vendorID := result.VendorID.String()
+		resp.VendorId = &vendorID
+	}
+	if result.AccountID != nil {
+		accountID := result.AccountID.String()
+		resp.AccountId = &accountID
+	}
+	return resp, nil
+}
+
+// callerFromMetadata returns the tenant and user of the caller from JWT metadata
+func callerFromMetadata(ctx context.Context) (uuid.UUID, uuid.UUID, error) {
+	var tenantID, userID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if tenantIDs := md.Get("tenant_id"); len(tenantIDs) > 0 {
+			tenantID = tenantIDs[0]
+		}
+		if userIDs := md.Get("user_id"); len(userIDs) > 0 {
+			userID = userIDs[0]
+		}
+	}
+
+	if tenantID == "" {
+		return uuid.Nil, uuid.Nil, status.Error(codes.Unauthenticated, "tenant_id not found in JWT")
+	}
+	if userID == "" {
+		return uuid.Nil, uuid.Nil, status.Error(codes.Unauthenticated, "user_id not found in JWT")
+	}
+
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid tenant_id in JWT")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user_id in JWT")
+	}
+	return tenantUUID, userUUID, nil
+}
+
+// vendorError maps vendor create/update/merge errors to gRPC status codes
+func vendorError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidGSTINFormat), errors.Is(err, domain.ErrInvalidGSTINStateCode),
+		errors.Is(err, domain.ErrInvalidGSTINEntityCode), errors.Is(err, domain.ErrInvalidGSTINChecksum),
+		errors.Is(err, domain.ErrGSTINPANMismatch), errors.Is(err, domain.ErrInvalidPANFormat),
+		errors.Is(err, domain.ErrInvalidEmailFormat), errors.Is(err, domain.ErrInvalidIFSCFormat):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrMergeSameVendor):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrVendorNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrVendorEmailExists), errors.Is(err, domain.ErrDuplicateVendor):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrVendorAlreadyMerged), errors.Is(err, domain.ErrMergeInvoiceConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// accountChangeError maps approval workflow errors to gRPC status codes
+func accountChangeError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAccountChangeNotFound), errors.Is(err, domain.ErrVendorAccountNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrSelfApproval):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrPendingChangeExists), errors.Is(err, domain.ErrChangeNotPending),
+		errors.Is(err, domain.ErrAccountNotApproved):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoAccountChange(c *domain.AccountChangeRequest, reveal bool) *vendorpb.VendorAccountChange {
+	pc := &vendorpb.VendorAccountChange{
+		Id:            c.ID.String(),
+		VendorId:      c.VendorID.String(),
+		AccountId:     c.AccountID.String(),
+		ChangeType:    c.ChangeType,
+		NewValues:     toProtoBankSnapshot(c.NewValues, reveal),
+		Status:        c.Status,
+		RequestedBy:   c.RequestedBy.String(),
+		RequestedAt:   timestamppb.New(c.RequestedAt),
+		ReviewedAt:    toProtoTimestampPtr(c.ReviewedAt),
+		ReviewRemarks: c.ReviewRemarks,
+	}
+	if c.OldValues != nil {
+		pc.OldValues = toProtoBankSnapshot(*c.OldValues, reveal)
+	}
+	if c.ReviewedBy != nil {
+		reviewedBy := c.ReviewedBy.String()
+		pc.ReviewedBy = &reviewedBy
+	}
+	return pc
+}
+
+func toProtoBankSnapshot(s domain.BankDetailsSnapshot, reveal bool) *vendorpb.BankDetailsSnapshot {
+	accountNumber, ifscCode := s.AccountNumber, s.IFSCCode
+	if !reveal {
+		accountNumber, ifscCode = fieldcrypt.Mask(accountNumber), fieldcrypt.Mask(ifscCode)
+	}
+
+	return &vendorpb.BankDetailsSnapshot{
+		AccountName:   s.AccountName,
+		AccountNumber: accountNumber,
+		AccountType:   s.AccountType,
+		NameOfBank:    s.NameOfBank,
+		BranchName:    s.BranchName,
+		IfscCode:      ifscCode,
+		SwiftCode:     s.SwiftCode,
+		IsPrimary:     s.IsPrimary,
+	}
+}
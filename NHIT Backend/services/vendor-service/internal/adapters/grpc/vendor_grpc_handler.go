@@ -10,6 +10,7 @@ import (
 
 	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
 	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/storage"
@@ -113,6 +114,7 @@ func (h *VendorGRPCHandler) CreateVendor(ctx context.Context, req *vendorpb.Crea
 		NameOfBank:             req.NameOfBank,
 		IFSCCode:               req.IfscCode,
 		Address:                req.Address,
+		AllowDuplicate:         req.AllowDuplicate,
 	}
 
 	// Parse MSME dates if provided
@@ -129,11 +131,11 @@ func (h *VendorGRPCHandler) CreateVendor(ctx context.Context, req *vendorpb.Crea
 
 	vendor, err := h.vendorService.CreateVendor(ctx, params)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, vendorError(err)
 	}
 
 	return &vendorpb.VendorResponse{
-		Vendor: toProtoVendor(vendor),
+		Vendor: toProtoVendor(vendor, canRevealBankDetails(ctx)),
 	}, nil
 }
 
@@ -186,44 +188,8 @@ func (h *VendorGRPCHandler) ListVendors(ctx context.Context, req *vendorpb.ListV
 	}
 
 	// Filter by Organization ID (if present in JWT) and Org has projects
-	// If Org is present, we MUST fetch projects for this org to filter vendors
-	if orgID != "" {
-		fmt.Printf("🔍 Filtering vendors by Organization ID: %s (fetching projects...)\n", orgID)
-
-		// 1. Connect to project-service (Port 50057)
-		// Note: Ideally use a shared client, but for now we dial locally to ensure isolation
-		conn, err := grpc.Dial("localhost:50057", grpc.WithTransportCredentials(insecure.NewCredentials()))
-		if err != nil {
-			fmt.Printf("⚠️ Failed to connect to project service for filtering: %v\n", err)
-			return nil, status.Error(codes.Internal, "failed to connect to project service for filtering")
-		}
-		defer conn.Close()
-		projectClient := projectpb.NewProjectServiceClient(conn)
-
-		// 2. Fetch projects
-		// Forward metadata for auth
-		outCtx := metadata.NewOutgoingContext(ctx, md) 
-		projectResp, err := projectClient.ListProjectsByOrganization(outCtx, &projectpb.ListProjectsByOrganizationRequest{
-			OrgId: orgID,
-		})
-		
-		if err != nil {
-			fmt.Printf("⚠️ Failed to fetch projects for filtering: %v\n", err)
-			return nil, status.Error(codes.Internal, "failed to fetch organization projects")
-		}
-
-		// 3. Extract IDs
-		var projectIDs []string
-		if projectResp != nil && len(projectResp.Projects) > 0 {
-			for _, p := range projectResp.Projects {
-				projectIDs = append(projectIDs, p.ProjectId)
-			}
-			fmt.Printf("✅ Found %d projects for Org: %v\n", len(projectIDs), projectIDs)
-			filters.ProjectIDs = projectIDs
-		} else {
-			fmt.Printf("⚠️ No projects found for Org: %s. Proceeding without project filter (showing unassigned/all).\n", orgID)
-			// Do NOT return empty. Allow repo to handle it (it will show unassigned vendors or all vendors)
-		}
+	if err := applyOrgProjectFilter(ctx, md, orgID, &filters); err != nil {
+		return nil, err
 	}
 
 	vendors, total, err := h.vendorService.ListVendors(ctx, tenantUUID, filters)
@@ -231,9 +197,10 @@ func (h *VendorGRPCHandler) ListVendors(ctx context.Context, req *vendorpb.ListV
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	reveal := canRevealBankDetails(ctx)
 	protoVendors := make([]*vendorpb.Vendor, len(vendors))
 	for i, v := range vendors {
-		protoVendors[i] = toProtoVendor(v)
+		protoVendors[i] = toProtoVendor(v, reveal)
 	}
 
 	// Calculate pagination if page/pageSize are used
@@ -373,7 +340,7 @@ func (h *VendorGRPCHandler) GetVendor(ctx context.Context, req *vendorpb.GetVend
 	}
 	
 	return &vendorpb.VendorResponse{
-		Vendor: toProtoVendor(vendor),
+		Vendor: toProtoVendor(vendor, canRevealBankDetails(ctx)),
 	}, nil
 }
 
@@ -406,7 +373,7 @@ func (h *VendorGRPCHandler) GetVendorByCode(ctx context.Context, req *vendorpb.G
 	}
 	
 	return &vendorpb.VendorResponse{
-		Vendor: toProtoVendor(vendor),
+		Vendor: toProtoVendor(vendor, canRevealBankDetails(ctx)),
 	}, nil
 }
 
@@ -438,6 +405,7 @@ func (h *VendorGRPCHandler) UpdateVendor(ctx context.Context, req *vendorpb.Upda
 		VendorName:             req.VendorName,
 		VendorEmail:            req.VendorEmail,
 		VendorMobile:           req.VendorMobile,
+		GSTIN:                  req.Gstin,
 		PAN:                    req.Pan,
 		BeneficiaryName:        req.BeneficiaryName,
 		// Add new fields
@@ -492,11 +460,11 @@ func (h *VendorGRPCHandler) UpdateVendor(ctx context.Context, req *vendorpb.Upda
 	
 	vendor, err := h.vendorService.UpdateVendor(ctx, tenantUUID, vendorUUID, params)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, vendorError(err)
 	}
 	
 	return &vendorpb.VendorResponse{
-		Vendor: toProtoVendor(vendor),
+		Vendor: toProtoVendor(vendor, canRevealBankDetails(ctx)),
 	}, nil
 }
 
@@ -565,7 +533,7 @@ func (h *VendorGRPCHandler) UpdateVendorCode(ctx context.Context, req *vendorpb.
 	}
 	
 	return &vendorpb.VendorResponse{
-		Vendor: toProtoVendor(vendor),
+		Vendor: toProtoVendor(vendor, canRevealBankDetails(ctx)),
 	}, nil
 }
 
@@ -599,23 +567,16 @@ func (h *VendorGRPCHandler) RegenerateVendorCode(ctx context.Context, req *vendo
 	}
 	
 	return &vendorpb.VendorResponse{
-		Vendor: toProtoVendor(vendor),
+		Vendor: toProtoVendor(vendor, canRevealBankDetails(ctx)),
 	}, nil
 }
 
 // Vendor Account methods
 func (h *VendorGRPCHandler) CreateVendorAccount(ctx context.Context, req *vendorpb.CreateVendorAccountRequest) (*vendorpb.VendorAccountResponse, error) {
-	// Extract user_id from JWT for created_by
-	var userID string
-	md, ok := metadata.FromIncomingContext(ctx)
-	if ok {
-		if userIDs := md.Get("user_id"); len(userIDs) > 0 {
-			userID = userIDs[0]
-		}
-	}
-	
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "user_id not found in JWT")
+	// Extract tenant_id and user_id (created_by) from JWT
+	tenantUUID, userID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
 	}
 	
 	vendorUUID, err := uuid.Parse(req.VendorId)
@@ -623,19 +584,15 @@ func (h *VendorGRPCHandler) CreateVendorAccount(ctx context.Context, req *vendor
 		return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
 	}
 	
-	createdByUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid user_id in JWT")
-	}
-	
 	params := domain.CreateVendorAccountParams{
+		TenantID:      tenantUUID,
 		VendorID:      vendorUUID,
 		AccountName:   req.AccountName,
 		AccountNumber: req.AccountNumber,
 		NameOfBank:    req.NameOfBank,
 		IFSCCode:      req.IfscCode,
 		IsPrimary:     req.IsPrimary,
-		CreatedBy:     createdByUUID,
+		CreatedBy:     userID,
 	}
 	
 	// Add optional fields
@@ -654,11 +611,11 @@ func (h *VendorGRPCHandler) CreateVendorAccount(ctx context.Context, req *vendor
 	
 	account, err := h.vendorService.CreateVendorAccount(ctx, params)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, accountChangeError(err)
 	}
 	
 	return &vendorpb.VendorAccountResponse{
-		Account: toProtoVendorAccount(account),
+		Account: toProtoVendorAccount(account, canRevealBankDetails(ctx)),
 	}, nil
 }
 
@@ -673,9 +630,10 @@ func (h *VendorGRPCHandler) GetVendorAccounts(ctx context.Context, req *vendorpb
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	
+	reveal := canRevealBankDetails(ctx)
 	protoAccounts := make([]*vendorpb.VendorAccount, len(accounts))
 	for i, acc := range accounts {
-		protoAccounts[i] = toProtoVendorAccount(acc)
+		protoAccounts[i] = toProtoVendorAccount(acc, reveal)
 	}
 	
 	return &vendorpb.GetVendorAccountsResponse{
@@ -703,14 +661,19 @@ func (h *VendorGRPCHandler) GetVendorBankingDetails(ctx context.Context, req *ve
 		return nil, status.Error(codes.NotFound, "banking details not found")
 	}
 	
+	accountNumber, ifscCode := bankingDetails.AccountNumber, bankingDetails.IFSCCode
+	if !canRevealBankDetails(ctx) {
+		accountNumber, ifscCode = fieldcrypt.Mask(accountNumber), fieldcrypt.Mask(ifscCode)
+	}
+
 	return &vendorpb.BankingDetailsResponse{
 		BankingDetails: &vendorpb.BankingDetails{
 			AccountName:   bankingDetails.AccountName,
-			AccountNumber: bankingDetails.AccountNumber,
+			AccountNumber: accountNumber,
 			AccountType:   bankingDetails.AccountType,
 			NameOfBank:    bankingDetails.NameOfBank,
 			BranchName:    bankingDetails.BranchName,
-			IfscCode:      bankingDetails.IFSCCode,
+			IfscCode:      ifscCode,
 			SwiftCode:     bankingDetails.SwiftCode,
 			Remarks:       bankingDetails.Remarks,
 		},
@@ -718,17 +681,10 @@ func (h *VendorGRPCHandler) GetVendorBankingDetails(ctx context.Context, req *ve
 }
 
 func (h *VendorGRPCHandler) UpdateVendorAccount(ctx context.Context, req *vendorpb.UpdateVendorAccountRequest) (*vendorpb.VendorAccountResponse, error) {
-	// Extract user_id from JWT for updated_by
-	var userID string
-	md, ok := metadata.FromIncomingContext(ctx)
-	if ok {
-		if userIDs := md.Get("user_id"); len(userIDs) > 0 {
-			userID = userIDs[0]
-		}
-	}
-	
-	if userID == "" {
-		return nil, status.Error(codes.Unauthenticated, "user_id not found in JWT")
+	// Extract tenant_id and user_id (updated_by) from JWT
+	tenantUUID, userID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
 	}
 	
 	accountUUID, err := uuid.Parse(req.AccountId)
@@ -747,44 +703,56 @@ func (h *VendorGRPCHandler) UpdateVendorAccount(ctx context.Context, req *vendor
 		IsPrimary:     req.IsPrimary,
 		IsActive:      req.IsActive,
 		Remarks:       req.Remarks,
+		TenantID:      tenantUUID,
+		UpdatedBy:     userID,
 	}
 	
 	account, err := h.vendorService.UpdateVendorAccount(ctx, accountUUID, params)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, accountChangeError(err)
 	}
 	
 	return &vendorpb.VendorAccountResponse{
-		Account: toProtoVendorAccount(account),
+		Account: toProtoVendorAccount(account, canRevealBankDetails(ctx)),
 	}, nil
 }
 
 func (h *VendorGRPCHandler) DeleteVendorAccount(ctx context.Context, req *vendorpb.DeleteVendorAccountRequest) (*emptypb.Empty, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	accountUUID, err := uuid.Parse(req.AccountId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid account_id")
 	}
 	
-	if err := h.vendorService.DeleteVendorAccount(ctx, accountUUID); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := h.vendorService.DeleteVendorAccount(ctx, tenantUUID, accountUUID); err != nil {
+		return nil, accountChangeError(err)
 	}
 	
 	return &emptypb.Empty{}, nil
 }
 
 func (h *VendorGRPCHandler) ToggleAccountStatus(ctx context.Context, req *vendorpb.ToggleAccountStatusRequest) (*vendorpb.VendorAccountResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	accountUUID, err := uuid.Parse(req.AccountId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid account_id")
 	}
 	
-	account, err := h.vendorService.ToggleAccountStatus(ctx, accountUUID)
+	account, err := h.vendorService.ToggleAccountStatus(ctx, tenantUUID, accountUUID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, accountChangeError(err)
 	}
 	
 	return &vendorpb.VendorAccountResponse{
-		Account: toProtoVendorAccount(account),
+		Account: toProtoVendorAccount(account, canRevealBankDetails(ctx)),
 	}, nil
 }
 
@@ -858,7 +826,53 @@ func (h *VendorGRPCHandler) UploadVendorSignature(ctx context.Context, req *vend
 }
 
 // Helper functions
-func toProtoVendor(v *domain.Vendor) *vendorpb.Vendor {
+
+// applyOrgProjectFilter restricts filters to the projects of the caller's
+// organization (org_id from JWT), fetched from project-service
+func applyOrgProjectFilter(ctx context.Context, md metadata.MD, orgID string, filters *ports.VendorListFilters) error {
+	if orgID != "" {
+		fmt.Printf("🔍 Filtering vendors by Organization ID: %s (fetching projects...)\n", orgID)
+
+		// 1. Connect to project-service (Port 50057)
+		// Note: Ideally use a shared client, but for now we dial locally to ensure isolation
+		conn, err := grpc.Dial("localhost:50057", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			fmt.Printf("⚠️ Failed to connect to project service for filtering: %v\n", err)
+			return status.Error(codes.Internal, "failed to connect to project service for filtering")
+		}
+		defer conn.Close()
+		projectClient := projectpb.NewProjectServiceClient(conn)
+
+		// 2. Fetch projects
+		// Forward metadata for auth
+		outCtx := metadata.NewOutgoingContext(ctx, md) 
+		projectResp, err := projectClient.ListProjectsByOrganization(outCtx, &projectpb.ListProjectsByOrganizationRequest{
+			OrgId: orgID,
+		})
+		
+		if err != nil {
+			fmt.Printf("⚠️ Failed to fetch projects for filtering: %v\n", err)
+			return status.Error(codes.Internal, "failed to fetch organization projects")
+		}
+
+		// 3. Extract IDs
+		var projectIDs []string
+		if projectResp != nil && len(projectResp.Projects) > 0 {
+			for _, p := range projectResp.Projects {
+				projectIDs = append(projectIDs, p.ProjectId)
+			}
+			fmt.Printf("✅ Found %d projects for Org: %v\n", len(projectIDs), projectIDs)
+			filters.ProjectIDs = projectIDs
+		} else {
+			fmt.Printf("⚠️ No projects found for Org: %s. Proceeding without project filter (showing unassigned/all).\n", orgID)
+			// Do NOT return empty. Allow repo to handle it (it will show unassigned vendors or all vendors)
+		}
+	}
+	return nil
+}
+
+// toProtoVendor converts a domain vendor to protobuf; bank fields are masked unless reveal is set
+func toProtoVendor(v *domain.Vendor, reveal bool) *vendorpb.Vendor {
 	protoVendor := &vendorpb.Vendor{
 		Id:                    v.ID.String(),
 		TenantId:              v.TenantID.String(),
@@ -905,6 +919,11 @@ func toProtoVendor(v *domain.Vendor) *vendorpb.Vendor {
 		SignatureUrl:          v.SignatureURL,
 	}
 
+	if !reveal {
+		protoVendor.AccountNumber = fieldcrypt.MaskPtr(v.AccountNumber)
+		protoVendor.IfscCode = fieldcrypt.MaskPtr(v.IFSCCode)
+	}
+
 	// Map MSME dates
 	if v.MSMEStartDate != nil {
 		protoVendor.MsmeStartDate = timestamppb.New(*v.MSMEStartDate)
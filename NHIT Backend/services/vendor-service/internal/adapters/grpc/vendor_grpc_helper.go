@@ -1,22 +1,38 @@
 package grpc
 
 import (
+	"context"
+	"time"
+
 	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/config"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// toProtoVendorAccount converts domain vendor account to protobuf
-func toProtoVendorAccount(acc *domain.VendorAccount) *vendorpb.VendorAccount {
+// canRevealBankDetails reports whether the caller may see unmasked bank details
+func canRevealBankDetails(ctx context.Context) bool {
+	return middleware.HasPermission(ctx, config.RevealBankDetailsPermission)
+}
+
+// toProtoVendorAccount converts domain vendor account to protobuf; bank fields are masked unless reveal is set
+func toProtoVendorAccount(acc *domain.VendorAccount, reveal bool) *vendorpb.VendorAccount {
+	accountNumber, ifscCode := acc.AccountNumber, acc.IFSCCode
+	if !reveal {
+		accountNumber, ifscCode = fieldcrypt.Mask(accountNumber), fieldcrypt.Mask(ifscCode)
+	}
+
 	return &vendorpb.VendorAccount{
 		Id:            acc.ID.String(),
 		VendorId:      acc.VendorID.String(),
 		AccountName:   acc.AccountName,
-		AccountNumber: acc.AccountNumber,
+		AccountNumber: accountNumber,
 		AccountType:   acc.AccountType,
 		NameOfBank:    acc.NameOfBank,
 		BranchName:    acc.BranchName,
-		IfscCode:      acc.IFSCCode,
+		IfscCode:      ifscCode,
 		SwiftCode:     acc.SwiftCode,
 		IsPrimary:     acc.IsPrimary,
 		IsActive:      acc.IsActive,
@@ -24,5 +40,15 @@ func toProtoVendorAccount(acc *domain.VendorAccount) *vendorpb.VendorAccount {
 		CreatedBy:     acc.CreatedBy.String(),
 		CreatedAt:     timestamppb.New(acc.CreatedAt),
 		UpdatedAt:     timestamppb.New(acc.UpdatedAt),
+
+		ApprovalStatus: acc.ApprovalStatus,
+		PayableFrom:    toProtoTimestampPtr(acc.PayableFrom),
+	}
+}
+
+func toProtoTimestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
 	}
+	return timestamppb.New(*t)
 }
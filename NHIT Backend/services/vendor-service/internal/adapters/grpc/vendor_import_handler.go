@@ -0,0 +1,313 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/spreadsheet"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// vendorFileColumns are the columns of vendor import and export files, in
+// export order. Import matches headers case-insensitively and ignores
+// vendor_code and unknown columns.
+var vendorFileColumns = []string{
+	"vendor_code", "vendor_name", "vendor_email", "vendor_mobile", "account_type",
+	"vendor_nick_name", "activity_type", "email", "mobile", "gstin", "pan", "pin",
+	"country_name", "state_name", "city_name", "address",
+	"msme_classification", "msme", "msme_registration_number", "msme_start_date", "msme_end_date",
+	"material_nature", "gst_defaulted", "section_206ab_verified", "beneficiary_name",
+	"remarks_address", "income_tax_type", "project", "status",
+	"from_account_type", "short_name", "parent",
+	"bank_account_name", "bank_account_number", "bank_account_type", "bank_name",
+	"bank_branch_name", "bank_ifsc_code", "bank_swift_code",
+}
+
+// requiredImportColumns must be present in the header of an import file
+var requiredImportColumns = []string{"vendor_name", "vendor_email", "pan", "beneficiary_name"}
+
+const vendorFileDateLayout = "2006-01-02"
+
+// ImportVendors creates vendors from a CSV or XLSX file
+func (h *VendorGRPCHandler) ImportVendors(ctx context.Context, req *vendorpb.ImportVendorsRequest) (*vendorpb.ImportVendorsResponse, error) {
+	tenantUUID, userUUID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.FileContent) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file_content is required")
+	}
+
+	format, err := spreadsheet.DetectFormat(req.Format, req.FileName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	records, err := spreadsheet.Read(format, req.FileContent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rows, err := parseVendorImportRows(records)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := h.vendorService.ImportVendors(ctx, tenantUUID, userUUID, rows, req.DryRun, req.AllowDuplicates)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &vendorpb.ImportVendorsResponse{
+		TotalRows:        int32(result.TotalRows),
+		ValidRows:        int32(result.ValidRows),
+		DryRun:           result.DryRun,
+		Committed:        result.Committed,
+		Errors:           make([]*vendorpb.VendorImportError, len(result.Errors)),
+		CreatedVendorIds: make([]string, len(result.CreatedVendorIDs)),
+	}
+	for i, e := range result.Errors {
+		resp.Errors[i] = &vendorpb.VendorImportError{
+			RowNumber: int32(e.RowNumber),
+			Field:     e.Field,
+			Message:   e.Message,
+		}
+	}
+	for i, id := range result.CreatedVendorIDs {
+		resp.CreatedVendorIds[i] = id.String()
+	}
+	return resp, nil
+}
+
+// ExportVendors writes the caller's vendors, filtered like ListVendors, to a
+// CSV or XLSX file in the import format. Bank details are masked unless the
+// caller may reveal them.
+func (h *VendorGRPCHandler) ExportVendors(ctx context.Context, req *vendorpb.ExportVendorsRequest) (*vendorpb.ExportVendorsResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	format := spreadsheet.FormatCSV
+	if req.Format != "" {
+		if format, err = spreadsheet.DetectFormat(req.Format, ""); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var orgID string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if orgIDs := md.Get("org_id"); len(orgIDs) > 0 {
+		orgID = orgIDs[0]
+	}
+	filters := ports.VendorListFilters{
+		IsActive:       req.IsActive,
+		OrganizationID: &orgID,
+		Project:        req.Project,
+		Search:         req.Search,
+	}
+	if err := applyOrgProjectFilter(ctx, md, orgID, &filters); err != nil {
+		return nil, err
+	}
+
+	vendors, err := h.vendorService.ExportVendors(ctx, tenantUUID, filters)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	reveal := canRevealBankDetails(ctx)
+	records := make([][]string, 0, len(vendors)+1)
+	records = append(records, vendorFileColumns)
+	for _, row := range vendors {
+		records = append(records, vendorExportRecord(row, reveal))
+	}
+
+	content, err := spreadsheet.Write(format, "Vendors", records)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &vendorpb.ExportVendorsResponse{
+		FileContent: content,
+		FileName:    fmt.Sprintf("vendors-%s.%s", time.Now().Format("20060102"), format),
+		ContentType: spreadsheet.ContentType(format),
+		RowCount:    int32(len(vendors)),
+	}, nil
+}
+
+// parseVendorImportRows maps file records to import rows by header name.
+// Row numbers are 1-based file lines, the header being line 1.
+func parseVendorImportRows(records [][]string) ([]domain.VendorImportRow, error) {
+	index := map[string]int{}
+	for i, name := range records[0] {
+		key := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+		if _, dup := index[key]; !dup {
+			index[key] = i
+		}
+	}
+	var missing []string
+	for _, col := range requiredImportColumns {
+		if _, ok := index[col]; !ok {
+			missing = append(missing, col)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required columns: %s", strings.Join(missing, ", "))
+	}
+
+	var rows []domain.VendorImportRow
+	for i, record := range records[1:] {
+		cells := importCells{index: index, record: record}
+		if cells.empty() {
+			continue
+		}
+		rows = append(rows, cells.toImportRow(i+2))
+	}
+	return rows, nil
+}
+
+// importCells reads named cells of one import record
+type importCells struct {
+	index  map[string]int
+	record []string
+	errors []string
+}
+
+func (c *importCells) get(col string) string {
+	if i, ok := c.index[col]; ok && i < len(c.record) {
+		return c.record[i]
+	}
+	return ""
+}
+
+func (c *importCells) ptr(col string) *string {
+	if v := c.get(col); v != "" {
+		return &v
+	}
+	return nil
+}
+
+func (c *importCells) date(col string) *time.Time {
+	v := c.get(col)
+	if v == "" {
+		return nil
+	}
+	t, err := time.Parse(vendorFileDateLayout, v)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Sprintf("%s: expected a date as YYYY-MM-DD, got %q", col, v))
+		return nil
+	}
+	return &t
+}
+
+func (c *importCells) empty() bool {
+	for _, v := range c.record {
+		if v != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *importCells) toImportRow(rowNumber int) domain.VendorImportRow {
+	row := domain.VendorImportRow{
+		RowNumber: rowNumber,
+		Vendor: domain.CreateVendorParams{
+			VendorName:             c.get("vendor_name"),
+			VendorEmail:            c.get("vendor_email"),
+			VendorMobile:           c.ptr("vendor_mobile"),
+			AccountType:            convertAccountType(c.get("account_type")),
+			VendorNickName:         c.ptr("vendor_nick_name"),
+			ActivityType:           c.ptr("activity_type"),
+			Email:                  c.ptr("email"),
+			Mobile:                 c.ptr("mobile"),
+			GSTIN:                  c.ptr("gstin"),
+			PAN:                    strings.ToUpper(c.get("pan")),
+			PIN:                    c.ptr("pin"),
+			CountryName:            c.ptr("country_name"),
+			StateName:              c.ptr("state_name"),
+			CityName:               c.ptr("city_name"),
+			Address:                c.ptr("address"),
+			MSMEClassification:     convertMSMEClassification(c.get("msme_classification")),
+			MSME:                   c.ptr("msme"),
+			MSMERegistrationNumber: c.ptr("msme_registration_number"),
+			MSMEStartDate:          c.date("msme_start_date"),
+			MSMEEndDate:            c.date("msme_end_date"),
+			MaterialNature:         c.ptr("material_nature"),
+			GSTDefaulted:           c.ptr("gst_defaulted"),
+			Section206ABVerified:   c.ptr("section_206ab_verified"),
+			BeneficiaryName:        c.get("beneficiary_name"),
+			RemarksAddress:         c.ptr("remarks_address"),
+			IncomeTaxType:          c.ptr("income_tax_type"),
+			ProjectID:              c.ptr("project"),
+			Status:                 convertVendorStatus(c.get("status")),
+			FromAccountType:        c.ptr("from_account_type"),
+			ShortName:              c.ptr("short_name"),
+			Parent:                 c.ptr("parent"),
+		},
+	}
+
+	if c.get("bank_account_number") != "" || c.get("bank_name") != "" || c.get("bank_ifsc_code") != "" {
+		row.Account = &domain.CreateVendorAccountParams{
+			AccountName:   c.get("bank_account_name"),
+			AccountNumber: strings.ReplaceAll(c.get("bank_account_number"), " ", ""),
+			AccountType:   c.ptr("bank_account_type"),
+			NameOfBank:    c.get("bank_name"),
+			BranchName:    c.ptr("bank_branch_name"),
+			IFSCCode:      strings.ToUpper(c.get("bank_ifsc_code")),
+			SwiftCode:     c.ptr("bank_swift_code"),
+		}
+	}
+
+	row.ParseErrors = c.errors
+	return row
+}
+
+// vendorExportRecord renders a vendor in vendorFileColumns order
+func vendorExportRecord(row domain.VendorExportRow, reveal bool) []string {
+	v := row.Vendor
+	str := func(p *string) string {
+		if p == nil {
+			return ""
+		}
+		return *p
+	}
+	date := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(vendorFileDateLayout)
+	}
+	msmeClassification := v.MSMEClassification
+	if msmeClassification == "MSME_CLASSIFICATION_UNSPECIFIED" {
+		msmeClassification = ""
+	}
+
+	record := []string{
+		v.VendorCode, v.VendorName, v.VendorEmail, str(v.VendorMobile), v.AccountType,
+		str(v.VendorNickName), str(v.ActivityType), str(v.Email), str(v.Mobile), str(v.GSTIN), v.PAN, str(v.PIN),
+		str(v.CountryName), str(v.StateName), str(v.CityName), str(v.Address),
+		msmeClassification, str(v.MSME), str(v.MSMERegistrationNumber), date(v.MSMEStartDate), date(v.MSMEEndDate),
+		str(v.MaterialNature), str(v.GSTDefaulted), str(v.Section206ABVerified), v.BeneficiaryName,
+		str(v.RemarksAddress), str(v.IncomeTaxType), str(v.ProjectID), v.Status,
+		str(v.FromAccountType), str(v.ShortName), str(v.Parent),
+	}
+
+	if a := row.PrimaryAccount; a != nil {
+		accountNumber, ifscCode := a.AccountNumber, a.IFSCCode
+		if !reveal {
+			accountNumber, ifscCode = fieldcrypt.Mask(accountNumber), fieldcrypt.Mask(ifscCode)
+		}
+		record = append(record, a.AccountName, accountNumber, str(a.AccountType), a.NameOfBank,
+			str(a.BranchName), ifscCode, str(a.SwiftCode))
+	} else {
+		record = append(record, "", "", "", "", "", "", "")
+	}
+	return record
+}
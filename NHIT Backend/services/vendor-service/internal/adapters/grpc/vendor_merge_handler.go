@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FindDuplicateVendors lists existing vendors that a new vendor with the given
+// details would duplicate
+func (h *VendorGRPCHandler) FindDuplicateVendors(ctx context.Context, req *vendorpb.FindDuplicateVendorsRequest) (*vendorpb.FindDuplicateVendorsResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Pan == "" && req.VendorName == "" && req.Gstin == nil && req.AccountNumber == nil {
+		return nil, status.Error(codes.InvalidArgument, "at least one of vendor_name, pan, gstin or account_number is required")
+	}
+
+	matches, err := h.vendorService.FindDuplicateVendors(ctx, domain.CreateVendorParams{
+		TenantID:      tenantUUID,
+		VendorName:    req.VendorName,
+		PAN:           req.Pan,
+		GSTIN:         req.Gstin,
+		AccountNumber: req.AccountNumber,
+		IFSCCode:      req.IfscCode,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &vendorpb.FindDuplicateVendorsResponse{
+		Matches: make([]*vendorpb.DuplicateVendorMatch, len(matches)),
+	}
+	for i, m := range matches {
+		resp.Matches[i] = &vendorpb.DuplicateVendorMatch{
+			VendorId:   m.VendorID.String(),
+			VendorCode: m.VendorCode,
+			VendorName: m.VendorName,
+			MatchedOn:  m.MatchedOn,
+			Blocking:   m.IsHard(),
+		}
+	}
+	return resp, nil
+}
+
+// MergeVendors merges a duplicate vendor into the survivor. Requires merge-vendors.
+func (h *VendorGRPCHandler) MergeVendors(ctx context.Context, req *vendorpb.MergeVendorsRequest) (*vendorpb.MergeVendorsResponse, error) {
+	tenantUUID, userUUID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !middleware.HasPermission(ctx, config.MergeVendorsPermission) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission %s required", config.MergeVendorsPermission)
+	}
+
+	survivorUUID, err := uuid.Parse(req.SurvivorVendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid survivor_vendor_id")
+	}
+	mergedUUID, err := uuid.Parse(req.MergedVendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid merged_vendor_id")
+	}
+
+	merge, err := h.vendorService.MergeVendors(ctx, tenantUUID, survivorUUID, mergedUUID, userUUID, req.Reason)
+	if err != nil {
+		return nil, vendorError(err)
+	}
+
+	return &vendorpb.MergeVendorsResponse{
+		Merge: &vendorpb.VendorMerge{
+			Id:               merge.ID.String(),
+			SurvivorVendorId: merge.SurvivorVendorID.String(),
+			MergedVendorId:   merge.MergedVendorID.String(),
+			MergedVendorCode: merge.MergedVendorCode,
+			MergedVendorName: merge.MergedVendorName,
+			AccountsMoved:    int32(merge.AccountsMoved),
+			Reason:           merge.Reason,
+			MergedBy:         merge.MergedBy.String(),
+			MergedAt:         timestamppb.New(merge.MergedAt),
+		},
+	}, nil
+}
@@ -0,0 +1,441 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/config"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// portalCaller resolves the vendor a portal caller acts for. The caller must
+// hold the vendor-portal permission and be linked to a vendor.
+func (h *VendorGRPCHandler) portalCaller(ctx context.Context) (tenantID, userID, vendorID uuid.UUID, err error) {
+	tenantID, userID, err = callerFromMetadata(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	if !middleware.HasPermission(ctx, config.VendorPortalPermission) {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.PermissionDenied, "vendor portal access required")
+	}
+
+	link, err := h.vendorService.GetVendorUser(ctx, tenantID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrVendorUserNotLinked) {
+			return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.Internal, err.Error())
+	}
+	return tenantID, userID, link.VendorID, nil
+}
+
+// GetPortalProfile returns the caller's own vendor record; bank details are masked
+func (h *VendorGRPCHandler) GetPortalProfile(ctx context.Context, req *vendorpb.GetPortalProfileRequest) (*vendorpb.VendorResponse, error) {
+	tenantUUID, _, vendorUUID, err := h.portalCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendor, err := h.vendorService.GetVendorByID(ctx, tenantUUID, vendorUUID)
+	if err != nil {
+		return nil, vendorError(err)
+	}
+	return &vendorpb.VendorResponse{Vendor: toProtoVendor(vendor, false)}, nil
+}
+
+// UpdatePortalContact lets a vendor update its own contact details
+func (h *VendorGRPCHandler) UpdatePortalContact(ctx context.Context, req *vendorpb.UpdatePortalContactRequest) (*vendorpb.VendorResponse, error) {
+	tenantUUID, _, vendorUUID, err := h.portalCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendor, err := h.vendorService.UpdateVendorContact(ctx, tenantUUID, vendorUUID, domain.VendorContactUpdate{
+		VendorMobile: req.VendorMobile,
+		Address:      req.Address,
+		CityName:     req.CityName,
+		StateName:    req.StateName,
+		CountryName:  req.CountryName,
+		PIN:          req.Pin,
+	})
+	if err != nil {
+		return nil, vendorError(err)
+	}
+	return &vendorpb.VendorResponse{Vendor: toProtoVendor(vendor, false)}, nil
+}
+
+// SubmitPortalInvoice submits an invoice on behalf of the caller's vendor
+func (h *VendorGRPCHandler) SubmitPortalInvoice(ctx context.Context, req *vendorpb.SubmitPortalInvoiceRequest) (*vendorpb.VendorInvoiceResponse, error) {
+	tenantUUID, userUUID, vendorUUID, err := h.portalCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceDate, err := parseMSMEDate("invoice_date", req.InvoiceDate)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := requestAmount("invoice_amount", req.InvoiceAmount, req.InvoiceAmountExact)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgUUID *uuid.UUID
+	if orgID, ok := middleware.GetOrgIDFromContext(ctx); ok {
+		parsed, err := uuid.Parse(orgID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid org_id in JWT")
+		}
+		orgUUID = &parsed
+	}
+
+	invoice, err := h.vendorService.SubmitVendorInvoice(ctx, domain.SubmitVendorInvoiceParams{
+		TenantID:      tenantUUID,
+		OrgID:         orgUUID,
+		VendorID:      vendorUUID,
+		InvoiceNumber: req.InvoiceNumber,
+		InvoiceDate:   invoiceDate,
+		InvoiceAmount: amount,
+		PONumber:      req.PoNumber,
+		Description:   req.Description,
+		SubmittedBy:   userUUID,
+	})
+	if err != nil {
+		return nil, vendorPortalError(err)
+	}
+	return &vendorpb.VendorInvoiceResponse{Invoice: toProtoVendorInvoice(invoice)}, nil
+}
+
+// UploadPortalInvoiceDocument attaches a supporting document to one of the caller's invoices
+func (h *VendorGRPCHandler) UploadPortalInvoiceDocument(ctx context.Context, req *vendorpb.UploadPortalInvoiceDocumentRequest) (*vendorpb.VendorInvoiceDocumentResponse, error) {
+	tenantUUID, userUUID, vendorUUID, err := h.portalCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceUUID, err := uuid.Parse(req.InvoiceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invoice_id")
+	}
+	contentType, err := domain.InvoiceDocumentContentType(req.FileName, len(req.FileContent))
+	if err != nil {
+		return nil, vendorPortalError(err)
+	}
+	if h.minioClient == nil {
+		return nil, status.Error(codes.Unavailable, "document storage is not configured")
+	}
+
+	// Check ownership and state before storing anything
+	invoice, err := h.vendorService.GetVendorInvoice(ctx, tenantUUID, invoiceUUID, &vendorUUID)
+	if err != nil {
+		return nil, vendorPortalError(err)
+	}
+	if !invoice.AcceptsDocuments() {
+		return nil, vendorPortalError(domain.ErrInvoiceClosed)
+	}
+
+	doc := &domain.VendorInvoiceDocument{
+		ID:          uuid.New(),
+		InvoiceID:   invoiceUUID,
+		FileName:    filepath.Base(req.FileName),
+		ContentType: contentType,
+		SizeBytes:   int64(len(req.FileContent)),
+		UploadedBy:  userUUID,
+		UploadedAt:  time.Now(),
+	}
+	objectName := doc.ID.String() + strings.ToLower(filepath.Ext(req.FileName))
+	doc.ObjectPath, err = h.minioClient.UploadDocument(ctx, vendorUUID.String(), objectName,
+		bytes.NewReader(req.FileContent), doc.SizeBytes, fmt.Sprintf("invoices/%s", invoiceUUID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upload document: %v", err)
+	}
+
+	if err := h.vendorService.AddVendorInvoiceDocument(ctx, tenantUUID, &vendorUUID, doc); err != nil {
+		return nil, vendorPortalError(err)
+	}
+	return &vendorpb.VendorInvoiceDocumentResponse{Document: toProtoVendorInvoiceDocument(doc)}, nil
+}
+
+// ListPortalInvoices lists the caller's invoices, newest first
+func (h *VendorGRPCHandler) ListPortalInvoices(ctx context.Context, req *vendorpb.ListPortalInvoicesRequest) (*vendorpb.ListVendorInvoicesResponse, error) {
+	tenantUUID, _, vendorUUID, err := h.portalCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.listVendorInvoices(ctx, tenantUUID, &vendorUUID, req.Status, req.Page, req.PageSize)
+}
+
+// GetPortalInvoice returns one of the caller's invoices with its documents
+func (h *VendorGRPCHandler) GetPortalInvoice(ctx context.Context, req *vendorpb.GetPortalInvoiceRequest) (*vendorpb.VendorInvoiceResponse, error) {
+	tenantUUID, _, vendorUUID, err := h.portalCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceUUID, err := uuid.Parse(req.InvoiceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invoice_id")
+	}
+	invoice, err := h.vendorService.GetVendorInvoice(ctx, tenantUUID, invoiceUUID, &vendorUUID)
+	if err != nil {
+		return nil, vendorPortalError(err)
+	}
+	return &vendorpb.VendorInvoiceResponse{Invoice: toProtoVendorInvoice(invoice)}, nil
+}
+
+// LinkVendorUser gives a user access to the vendor portal for a vendor
+func (h *VendorGRPCHandler) LinkVendorUser(ctx context.Context, req *vendorpb.LinkVendorUserRequest) (*vendorpb.VendorPortalUserResponse, error) {
+	tenantUUID, callerUUID, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendorUUID, err := uuid.Parse(req.VendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+	}
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	link, err := h.vendorService.LinkVendorUser(ctx, tenantUUID, vendorUUID, userUUID, callerUUID)
+	if err != nil {
+		return nil, vendorPortalError(err)
+	}
+	return &vendorpb.VendorPortalUserResponse{User: toProtoVendorPortalUser(link)}, nil
+}
+
+// UnlinkVendorUser revokes a user's vendor portal access
+func (h *VendorGRPCHandler) UnlinkVendorUser(ctx context.Context, req *vendorpb.UnlinkVendorUserRequest) (*emptypb.Empty, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	if err := h.vendorService.UnlinkVendorUser(ctx, tenantUUID, userUUID); err != nil {
+		return nil, vendorPortalError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListVendorPortalUsers lists the users linked to a vendor
+func (h *VendorGRPCHandler) ListVendorPortalUsers(ctx context.Context, req *vendorpb.ListVendorPortalUsersRequest) (*vendorpb.ListVendorPortalUsersResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vendorUUID, err := uuid.Parse(req.VendorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+	}
+	links, err := h.vendorService.ListVendorUsers(ctx, tenantUUID, vendorUUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	users := make([]*vendorpb.VendorPortalUser, len(links))
+	for i, link := range links {
+		users[i] = toProtoVendorPortalUser(link)
+	}
+	return &vendorpb.ListVendorPortalUsersResponse{Users: users}, nil
+}
+
+// ListVendorInvoices lists invoices submitted through the vendor portal
+func (h *VendorGRPCHandler) ListVendorInvoices(ctx context.Context, req *vendorpb.ListVendorInvoicesRequest) (*vendorpb.ListVendorInvoicesResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vendorUUID *uuid.UUID
+	if req.VendorId != nil && *req.VendorId != "" {
+		id, err := uuid.Parse(*req.VendorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid vendor_id")
+		}
+		vendorUUID = &id
+	}
+	return h.listVendorInvoices(ctx, tenantUUID, vendorUUID, req.Status, req.Page, req.PageSize)
+}
+
+// UpdateVendorInvoiceStatus records green note, payment note and payment progress of an invoice
+func (h *VendorGRPCHandler) UpdateVendorInvoiceStatus(ctx context.Context, req *vendorpb.UpdateVendorInvoiceStatusRequest) (*vendorpb.VendorInvoiceResponse, error) {
+	tenantUUID, _, err := callerFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceUUID, err := uuid.Parse(req.InvoiceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid invoice_id")
+	}
+	paidAt, err := parseOptionalMSMEDate("paid_date", req.PaidDate)
+	if err != nil {
+		return nil, err
+	}
+	var paidAmount *money.Amount
+	if req.PaidAmount != nil || req.PaidAmountExact != nil {
+		amount, err := requestAmount("paid_amount", req.GetPaidAmount(), req.PaidAmountExact)
+		if err != nil {
+			return nil, err
+		}
+		paidAmount = &amount
+	}
+
+	update := domain.VendorInvoiceStatusUpdate{
+		GreenNoteID:     req.GreenNoteId,
+		PaymentNoteID:   req.PaymentNoteId,
+		UTRNumber:       req.UtrNumber,
+		PaidAmount:      paidAmount,
+		PaidAt:          paidAt,
+		RejectionReason: req.RejectionReason,
+	}
+	if req.Status != nil {
+		update.Status = strings.ToUpper(*req.Status)
+	}
+
+	invoice, err := h.vendorService.UpdateVendorInvoiceStatus(ctx, tenantUUID, invoiceUUID, update)
+	if err != nil {
+		return nil, vendorPortalError(err)
+	}
+	return &vendorpb.VendorInvoiceResponse{Invoice: toProtoVendorInvoice(invoice)}, nil
+}
+
+func (h *VendorGRPCHandler) listVendorInvoices(ctx context.Context, tenantID uuid.UUID, vendorID *uuid.UUID, invoiceStatus *string, page, pageSize int32) (*vendorpb.ListVendorInvoicesResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	filters := ports.VendorInvoiceFilters{
+		VendorID: vendorID,
+		Limit:    int(pageSize),
+		Offset:   int((page - 1) * pageSize),
+	}
+	if invoiceStatus != nil && *invoiceStatus != "" {
+		switch *invoiceStatus {
+		case domain.VendorInvoiceSubmitted, domain.VendorInvoiceUnderReview, domain.VendorInvoiceApproved,
+			domain.VendorInvoiceRejected, domain.VendorInvoicePaid:
+		default:
+			return nil, status.Error(codes.InvalidArgument, "invalid status")
+		}
+		filters.Status = invoiceStatus
+	}
+
+	invoices, total, err := h.vendorService.ListVendorInvoices(ctx, tenantID, filters)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	protoInvoices := make([]*vendorpb.VendorInvoice, len(invoices))
+	for i, inv := range invoices {
+		protoInvoices[i] = toProtoVendorInvoice(inv)
+	}
+
+	return &vendorpb.ListVendorInvoicesResponse{
+		Invoices:   protoInvoices,
+		TotalCount: total,
+		Pagination: &vendorpb.PaginationMetadata{
+			CurrentPage: page,
+			PageSize:    pageSize,
+			TotalItems:  total,
+			TotalPages:  int32((total + int64(pageSize) - 1) / int64(pageSize)),
+		},
+	}, nil
+}
+
+// vendorPortalError maps vendor portal errors to gRPC status codes
+func vendorPortalError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInvoiceNumber), errors.Is(err, domain.ErrInvalidInvoiceAmount),
+		errors.Is(err, domain.ErrInvalidInvoiceDate), errors.Is(err, domain.ErrInvalidInvoiceDocument),
+		errors.Is(err, domain.ErrUTRRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrVendorNotFound), errors.Is(err, domain.ErrVendorInvoiceNotFound),
+		errors.Is(err, domain.ErrVendorUserNotLinked):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrVendorInvoiceExists), errors.Is(err, domain.ErrVendorUserAlreadyLinked):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrInvalidInvoiceTransition), errors.Is(err, domain.ErrInvoiceClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoVendorPortalUser(link *domain.VendorUser) *vendorpb.VendorPortalUser {
+	return &vendorpb.VendorPortalUser{
+		Id:       link.ID.String(),
+		VendorId: link.VendorID.String(),
+		UserId:   link.UserID.String(),
+		LinkedBy: link.LinkedBy.String(),
+		LinkedAt: timestamppb.New(link.LinkedAt),
+	}
+}
+
+func toProtoVendorInvoice(inv *domain.VendorInvoice) *vendorpb.VendorInvoice {
+	pi := &vendorpb.VendorInvoice{
+		Id:              inv.ID.String(),
+		VendorId:        inv.VendorID.String(),
+		InvoiceNumber:   inv.InvoiceNumber,
+		InvoiceDate:     inv.InvoiceDate.Format(msmeDateLayout),
+		InvoiceAmount:   inv.InvoiceAmount.Float64(),
+		PoNumber:        inv.PONumber,
+		Description:     inv.Description,
+		Status:          inv.Status,
+		GreenNoteId:     inv.GreenNoteID,
+		PaymentNoteId:   inv.PaymentNoteID,
+		UtrNumber:       inv.UTRNumber,
+		PaidAt:          toProtoTimestampPtr(inv.PaidAt),
+		RejectionReason: inv.RejectionReason,
+		SubmittedBy:     inv.SubmittedBy.String(),
+		SubmittedAt:     timestamppb.New(inv.SubmittedAt),
+		UpdatedAt:       timestamppb.New(inv.UpdatedAt),
+
+		InvoiceAmountExact: moneyProto(inv.InvoiceAmount),
+	}
+	if inv.PaidAmount != nil {
+		paid := inv.PaidAmount.Float64()
+		pi.PaidAmount = &paid
+		pi.PaidAmountExact = moneyProto(*inv.PaidAmount)
+	}
+	for _, doc := range inv.Documents {
+		pi.Documents = append(pi.Documents, toProtoVendorInvoiceDocument(doc))
+	}
+	return pi
+}
+
+func toProtoVendorInvoiceDocument(doc *domain.VendorInvoiceDocument) *vendorpb.VendorInvoiceDocument {
+	return &vendorpb.VendorInvoiceDocument{
+		Id:          doc.ID.String(),
+		InvoiceId:   doc.InvoiceID.String(),
+		FileName:    doc.FileName,
+		ContentType: doc.ContentType,
+		SizeBytes:   doc.SizeBytes,
+		ObjectPath:  doc.ObjectPath,
+		UploadedBy:  doc.UploadedBy.String(),
+		UploadedAt:  timestamppb.New(doc.UploadedAt),
+	}
+}
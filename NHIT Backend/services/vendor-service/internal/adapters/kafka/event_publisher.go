@@ -0,0 +1,155 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// EventPublisher records vendor domain events in the event outbox, in the
+// transaction of the context it is given; OutboxRelay publishes them to Kafka
+// after that transaction commits.
+type EventPublisher struct {
+	outbox ports.VendorRepository
+}
+
+// NewEventPublisher creates an outbox-backed ports.EventPublisher
+func NewEventPublisher(outbox ports.VendorRepository) *EventPublisher {
+	return &EventPublisher{outbox: outbox}
+}
+
+var _ ports.EventPublisher = (*EventPublisher)(nil)
+
+// PublishVendorCreated publishes vendor.created
+func (p *EventPublisher) PublishVendorCreated(ctx context.Context, vendor *domain.Vendor) error {
+	return p.publish(ctx, EventVendorCreated, vendor.TenantID.String(), newVendorPayload(vendor))
+}
+
+// PublishVendorUpdated publishes vendor.updated
+func (p *EventPublisher) PublishVendorUpdated(ctx context.Context, vendor *domain.Vendor) error {
+	return p.publish(ctx, EventVendorUpdated, vendor.TenantID.String(), newVendorPayload(vendor))
+}
+
+// PublishVendorDeleted publishes vendor.deleted
+func (p *EventPublisher) PublishVendorDeleted(ctx context.Context, tenantID, vendorID uuid.UUID) error {
+	return p.publish(ctx, EventVendorDeleted, tenantID.String(), VendorDeletedPayload{VendorID: vendorID.String()})
+}
+
+// PublishAccountCreated publishes vendor.account.created
+func (p *EventPublisher) PublishAccountCreated(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount) error {
+	return p.publish(ctx, EventAccountCreated, tenantID.String(), newAccountPayload(account))
+}
+
+// PublishAccountUpdated publishes vendor.account.updated
+func (p *EventPublisher) PublishAccountUpdated(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount) error {
+	return p.publish(ctx, EventAccountUpdated, tenantID.String(), newAccountPayload(account))
+}
+
+// PublishAccountDeleted publishes vendor.account.deleted
+func (p *EventPublisher) PublishAccountDeleted(ctx context.Context, tenantID, vendorID, accountID uuid.UUID) error {
+	return p.publish(ctx, EventAccountDeleted, tenantID.String(), AccountDeletedPayload{
+		AccountID: accountID.String(),
+		VendorID:  vendorID.String(),
+	})
+}
+
+// PublishVendorMerged publishes vendor.merged
+func (p *EventPublisher) PublishVendorMerged(ctx context.Context, merge *domain.VendorMerge) error {
+	return p.publish(ctx, EventVendorMerged, merge.TenantID.String(), VendorMergedPayload{
+		MergeID:            merge.ID.String(),
+		SurvivorVendorID:   merge.SurvivorVendorID.String(),
+		SurvivorVendorCode: merge.SurvivorVendorCode,
+		SurvivorVendorName: merge.SurvivorVendorName,
+		MergedVendorID:     merge.MergedVendorID.String(),
+		MergedVendorCode:   merge.MergedVendorCode,
+		MergedVendorName:   merge.MergedVendorName,
+		AccountsMoved:      merge.AccountsMoved,
+		MergedBy:           merge.MergedBy.String(),
+		MergedAt:           merge.MergedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// PublishMSMEInvoiceFlagged publishes vendor.msme_invoice.flagged
+func (p *EventPublisher) PublishMSMEInvoiceFlagged(ctx context.Context, invoice *domain.MSMEInvoice, status string, interest money.Amount) error {
+	return p.publish(ctx, EventMSMEInvoiceFlagged, invoice.TenantID.String(), MSMEInvoiceFlaggedPayload{
+		InvoiceID:         invoice.ID.String(),
+		VendorID:          invoice.VendorID.String(),
+		VendorCode:        invoice.VendorCode,
+		InvoiceNumber:     invoice.InvoiceNumber,
+		InvoiceAmount:     invoice.InvoiceAmount,
+		DueDate:           invoice.DueDate.Format("2006-01-02"),
+		Status:            status,
+		InterestLiability: interest,
+		GreenNoteID:       invoice.GreenNoteID,
+		PaymentNoteID:     invoice.PaymentNoteID,
+	})
+}
+
+// PublishVendorInvoiceSubmitted publishes vendor.invoice.submitted
+func (p *EventPublisher) PublishVendorInvoiceSubmitted(ctx context.Context, invoice *domain.VendorInvoice, vendor *domain.Vendor) error {
+	var orgID *string
+	if invoice.OrgID != nil {
+		id := invoice.OrgID.String()
+		orgID = &id
+	}
+	return p.publish(ctx, EventVendorInvoiceSubmitted, invoice.TenantID.String(), VendorInvoiceSubmittedPayload{
+		InvoiceID:     invoice.ID.String(),
+		OrgID:         orgID,
+		VendorID:      vendor.ID.String(),
+		VendorCode:    vendor.VendorCode,
+		VendorName:    vendor.VendorName,
+		ProjectID:     vendor.ProjectID,
+		InvoiceNumber: invoice.InvoiceNumber,
+		InvoiceDate:   invoice.InvoiceDate.Format("2006-01-02"),
+		InvoiceAmount: invoice.InvoiceAmount,
+		PONumber:      invoice.PONumber,
+		Description:   invoice.Description,
+		SubmittedBy:   invoice.SubmittedBy.String(),
+		SubmittedAt:   invoice.SubmittedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// PublishVendorInvoiceDocumentAdded publishes vendor.invoice.document_added
+func (p *EventPublisher) PublishVendorInvoiceDocumentAdded(ctx context.Context, invoice *domain.VendorInvoice, doc *domain.VendorInvoiceDocument) error {
+	return p.publish(ctx, EventVendorInvoiceDocumentAdded, invoice.TenantID.String(), VendorInvoiceDocumentAddedPayload{
+		InvoiceID:   invoice.ID.String(),
+		VendorID:    invoice.VendorID.String(),
+		GreenNoteID: invoice.GreenNoteID,
+		DocumentID:  doc.ID.String(),
+		FileName:    doc.FileName,
+		ContentType: doc.ContentType,
+		ObjectPath:  doc.ObjectPath,
+	})
+}
+
+func (p *EventPublisher) publish(ctx context.Context, eventType, tenantID string, payload interface{}) error {
+	eventID := uuid.New()
+	envelope := Envelope{
+		EventID:       eventID.String(),
+		EventType:     eventType,
+		SchemaVersion: SchemaVersion,
+		TenantID:      tenantID,
+		OccurredAt:    time.Now().UTC(),
+		Payload:       payload,
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+
+	return p.outbox.AddOutboxEvent(ctx, &ports.OutboxEvent{
+		EventID:       eventID,
+		EventType:     eventType,
+		SchemaVersion: SchemaVersion,
+		TenantID:      tenantID,
+		Payload:       data,
+		OccurredAt:    envelope.OccurredAt,
+	})
+}
@@ -0,0 +1,186 @@
+package kafka
+
+import (
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+)
+
+// Event types published on the vendor events topic.
+const (
+	EventVendorCreated              = "vendor.created"
+	EventVendorUpdated              = "vendor.updated"
+	EventVendorDeleted              = "vendor.deleted"
+	EventAccountCreated             = "vendor.account.created"
+	EventAccountUpdated             = "vendor.account.updated"
+	EventAccountDeleted             = "vendor.account.deleted"
+	EventVendorMerged               = "vendor.merged"
+	EventMSMEInvoiceFlagged         = "vendor.msme_invoice.flagged"
+	EventVendorInvoiceSubmitted     = "vendor.invoice.submitted"
+	EventVendorInvoiceDocumentAdded = "vendor.invoice.document_added"
+)
+
+// SchemaVersion is the version of the envelope and payload schemas below.
+// Additive changes keep the version; renaming or removing a field bumps it.
+const SchemaVersion = 1
+
+// Envelope wraps every vendor event. Consumers should switch on EventType and
+// ignore events whose SchemaVersion they do not understand.
+type Envelope struct {
+	EventID       string      `json:"event_id"`
+	EventType     string      `json:"event_type"`
+	SchemaVersion int         `json:"schema_version"`
+	TenantID      string      `json:"tenant_id"`
+	OccurredAt    time.Time   `json:"occurred_at"`
+	Payload       interface{} `json:"payload"`
+}
+
+// VendorPayload is the payload of vendor.created and vendor.updated (schema v1).
+type VendorPayload struct {
+	VendorID            string  `json:"vendor_id"`
+	VendorCode          string  `json:"vendor_code"`
+	VendorName          string  `json:"vendor_name"`
+	VendorEmail         string  `json:"vendor_email"`
+	VendorMobile        *string `json:"vendor_mobile,omitempty"`
+	AccountType         string  `json:"account_type"`
+	Status              string  `json:"status"`
+	ProjectID           *string `json:"project_id,omitempty"`
+	GSTIN               *string `json:"gstin,omitempty"`
+	PAN                 string  `json:"pan"`
+	BeneficiaryName     string  `json:"beneficiary_name"`
+	NameOfBank          *string `json:"name_of_bank,omitempty"`
+	MaskedAccountNumber *string `json:"masked_account_number,omitempty"`
+	MaskedIFSCCode      *string `json:"masked_ifsc_code,omitempty"`
+	UpdatedAt           string  `json:"updated_at"`
+}
+
+// VendorDeletedPayload is the payload of vendor.deleted (schema v1).
+type VendorDeletedPayload struct {
+	VendorID string `json:"vendor_id"`
+}
+
+// AccountPayload is the payload of vendor.account.created and vendor.account.updated (schema v1).
+// Full account numbers are never published; consumers that need them call
+// GetVendorBankingDetails with the reveal-bank-details permission.
+type AccountPayload struct {
+	AccountID           string `json:"account_id"`
+	VendorID            string `json:"vendor_id"`
+	AccountName         string `json:"account_name"`
+	NameOfBank          string `json:"name_of_bank"`
+	MaskedAccountNumber string `json:"masked_account_number"`
+	MaskedIFSCCode      string `json:"masked_ifsc_code"`
+	IsPrimary           bool   `json:"is_primary"`
+	IsActive            bool   `json:"is_active"`
+	UpdatedAt           string `json:"updated_at"`
+}
+
+// AccountDeletedPayload is the payload of vendor.account.deleted (schema v1).
+type AccountDeletedPayload struct {
+	AccountID string `json:"account_id"`
+	VendorID  string `json:"vendor_id"`
+}
+
+// VendorMergedPayload is the payload of vendor.merged (schema v1). Consumers
+// holding references to MergedVendorID should re-point them to SurvivorVendorID.
+// Services that reference vendors by code or name match on the merged
+// vendor's and rewrite them to the survivor's.
+type VendorMergedPayload struct {
+	MergeID            string `json:"merge_id"`
+	SurvivorVendorID   string `json:"survivor_vendor_id"`
+	SurvivorVendorCode string `json:"survivor_vendor_code"`
+	SurvivorVendorName string `json:"survivor_vendor_name"`
+	MergedVendorID     string `json:"merged_vendor_id"`
+	MergedVendorCode   string `json:"merged_vendor_code"`
+	MergedVendorName   string `json:"merged_vendor_name"`
+	AccountsMoved      int    `json:"accounts_moved"`
+	MergedBy           string `json:"merged_by"`
+	MergedAt           string `json:"merged_at"`
+}
+
+// MSMEInvoiceFlaggedPayload is the payload of vendor.msme_invoice.flagged
+// (schema v1), published when a tracked MSME invoice becomes AT_RISK or
+// OVERDUE, or is PAID_LATE. Green note and payment note services flag the
+// notes referenced by GreenNoteID and PaymentNoteID. Amounts are decimal
+// strings ("1234.50").
+type MSMEInvoiceFlaggedPayload struct {
+	InvoiceID         string       `json:"invoice_id"`
+	VendorID          string       `json:"vendor_id"`
+	VendorCode        string       `json:"vendor_code"`
+	InvoiceNumber     string       `json:"invoice_number"`
+	InvoiceAmount     money.Amount `json:"invoice_amount"`
+	DueDate           string       `json:"due_date"`
+	Status            string       `json:"status"`
+	InterestLiability money.Amount `json:"interest_liability"`
+	GreenNoteID       *string      `json:"green_note_id,omitempty"`
+	PaymentNoteID     *string      `json:"payment_note_id,omitempty"`
+}
+
+// VendorInvoiceSubmittedPayload is the payload of vendor.invoice.submitted
+// (schema v1), published when a vendor submits an invoice on the vendor
+// portal. The green note service opens a draft green note for it in OrgID,
+// once per InvoiceID, and progress is reported back through
+// UpdateVendorInvoiceStatus.
+type VendorInvoiceSubmittedPayload struct {
+	InvoiceID     string       `json:"invoice_id"`
+	OrgID         *string      `json:"org_id,omitempty"`
+	VendorID      string       `json:"vendor_id"`
+	VendorCode    string       `json:"vendor_code"`
+	VendorName    string       `json:"vendor_name"`
+	ProjectID     *string      `json:"project_id,omitempty"`
+	InvoiceNumber string       `json:"invoice_number"`
+	InvoiceDate   string       `json:"invoice_date"`
+	InvoiceAmount money.Amount `json:"invoice_amount"`
+	PONumber      *string      `json:"po_number,omitempty"`
+	Description   *string      `json:"description,omitempty"`
+	SubmittedBy   string       `json:"submitted_by"`
+	SubmittedAt   string       `json:"submitted_at"`
+}
+
+// VendorInvoiceDocumentAddedPayload is the payload of
+// vendor.invoice.document_added (schema v1). ObjectPath is the storage path
+// of the uploaded file.
+type VendorInvoiceDocumentAddedPayload struct {
+	InvoiceID   string  `json:"invoice_id"`
+	VendorID    string  `json:"vendor_id"`
+	GreenNoteID *string `json:"green_note_id,omitempty"`
+	DocumentID  string  `json:"document_id"`
+	FileName    string  `json:"file_name"`
+	ContentType string  `json:"content_type"`
+	ObjectPath  string  `json:"object_path"`
+}
+
+func newVendorPayload(v *domain.Vendor) VendorPayload {
+	return VendorPayload{
+		VendorID:            v.ID.String(),
+		VendorCode:          v.VendorCode,
+		VendorName:          v.VendorName,
+		VendorEmail:         v.VendorEmail,
+		VendorMobile:        v.VendorMobile,
+		AccountType:         v.AccountType,
+		Status:              v.Status,
+		ProjectID:           v.ProjectID,
+		GSTIN:               v.GSTIN,
+		PAN:                 v.PAN,
+		BeneficiaryName:     v.BeneficiaryName,
+		NameOfBank:          v.NameOfBank,
+		MaskedAccountNumber: fieldcrypt.MaskPtr(v.AccountNumber),
+		MaskedIFSCCode:      fieldcrypt.MaskPtr(v.IFSCCode),
+		UpdatedAt:           v.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func newAccountPayload(a *domain.VendorAccount) AccountPayload {
+	return AccountPayload{
+		AccountID:           a.ID.String(),
+		VendorID:            a.VendorID.String(),
+		AccountName:         a.AccountName,
+		NameOfBank:          a.NameOfBank,
+		MaskedAccountNumber: fieldcrypt.Mask(a.AccountNumber),
+		MaskedIFSCCode:      fieldcrypt.Mask(a.IFSCCode),
+		IsPrimary:           a.IsPrimary,
+		IsActive:            a.IsActive,
+		UpdatedAt:           a.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,129 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	relayInterval  = time.Second
+	relayBatchSize = 100
+)
+
+// OutboxRelay publishes the events in the event outbox to Kafka, oldest
+// first. Messages are keyed by tenant ID so that all events of a tenant land
+// on the same partition and are consumed in order. When a write fails the
+// rest of the batch waits for the next poll, so order is kept across retries;
+// an event Kafka accepted may be published again if it cannot be removed from
+// the outbox, and consumers de-duplicate on event_id.
+type OutboxRelay struct {
+	writer *kafka.Writer
+	outbox ports.VendorRepository
+}
+
+// NewOutboxRelay creates a relay from outbox to topic
+func NewOutboxRelay(brokers []string, topic string, outbox ports.VendorRepository) *OutboxRelay {
+	writer := &kafka.Writer{
+		Addr:                   kafka.TCP(brokers...),
+		Topic:                  topic,
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+	}
+
+	log.Printf("✅ Vendor event outbox relay using brokers %v, topic '%s'", brokers, topic)
+	return &OutboxRelay{writer: writer, outbox: outbox}
+}
+
+// Run relays events until ctx is cancelled. A full batch is followed by the
+// next one straight away.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(relayInterval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := r.relayBatch(ctx)
+		if err != nil {
+			log.Printf("⚠️  Vendor event outbox relay failed: %v", err)
+		}
+		if err == nil && sent == relayBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close flushes pending messages and closes the writer
+func (r *OutboxRelay) Close() error {
+	return r.writer.Close()
+}
+
+// relayBatch publishes one batch and removes the events Kafka accepted. The
+// first event Kafka rejected has the failure recorded and ends the batch.
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	sent := 0
+	var writeErr error
+	err := r.outbox.WithTransaction(ctx, func(txCtx context.Context) error {
+		events, err := r.outbox.ListPendingOutboxEvents(txCtx, relayBatchSize)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		msgs := make([]kafka.Message, len(events))
+		for i, e := range events {
+			msgs[i] = outboxMessage(e)
+		}
+
+		failed := len(events)
+		if writeErr = r.writer.WriteMessages(ctx, msgs...); writeErr != nil {
+			failed = 0
+			var errs kafka.WriteErrors
+			if errors.As(writeErr, &errs) {
+				for failed < len(errs) && errs[failed] == nil {
+					failed++
+				}
+			}
+			if failed == len(events) {
+				writeErr = nil
+			}
+		}
+
+		for _, e := range events[:failed] {
+			if err := r.outbox.DeleteOutboxEvent(txCtx, e.EventID); err != nil {
+				return err
+			}
+			sent++
+		}
+		if writeErr != nil {
+			return r.outbox.RecordOutboxEventFailure(txCtx, events[failed].EventID, writeErr.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sent, writeErr
+}
+
+func outboxMessage(e *ports.OutboxEvent) kafka.Message {
+	return kafka.Message{
+		Key:   []byte(e.TenantID),
+		Value: e.Payload,
+		Time:  e.OccurredAt,
+		Headers: []kafka.Header{
+			{Key: "event_type", Value: []byte(e.EventType)},
+			{Key: "schema_version", Value: []byte(strconv.Itoa(e.SchemaVersion))},
+			{Key: "tenant_id", Value: []byte(e.TenantID)},
+		},
+	}
+}
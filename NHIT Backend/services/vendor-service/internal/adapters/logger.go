@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
 	"github.com/google/uuid"
@@ -57,17 +58,37 @@ func (p *noOpEventPublisher) PublishVendorDeleted(ctx context.Context, tenantID,
 	return nil
 }
 
-func (p *noOpEventPublisher) PublishAccountCreated(ctx context.Context, account *domain.VendorAccount) error {
+func (p *noOpEventPublisher) PublishAccountCreated(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount) error {
 	fmt.Println("📢 Event: Account created (no-op)")
 	return nil
 }
 
-func (p *noOpEventPublisher) PublishAccountUpdated(ctx context.Context, account *domain.VendorAccount) error {
+func (p *noOpEventPublisher) PublishAccountUpdated(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount) error {
 	fmt.Println("📢 Event: Account updated (no-op)")
 	return nil
 }
 
-func (p *noOpEventPublisher) PublishAccountDeleted(ctx context.Context, accountID uuid.UUID) error {
+func (p *noOpEventPublisher) PublishAccountDeleted(ctx context.Context, tenantID, vendorID, accountID uuid.UUID) error {
 	fmt.Println("📢 Event: Account deleted (no-op)")
 	return nil
 }
+
+func (p *noOpEventPublisher) PublishVendorMerged(ctx context.Context, merge *domain.VendorMerge) error {
+	fmt.Println("📢 Event: Vendors merged (no-op)")
+	return nil
+}
+
+func (p *noOpEventPublisher) PublishMSMEInvoiceFlagged(ctx context.Context, invoice *domain.MSMEInvoice, status string, interest money.Amount) error {
+	fmt.Println("📢 Event: MSME invoice flagged (no-op)")
+	return nil
+}
+
+func (p *noOpEventPublisher) PublishVendorInvoiceSubmitted(ctx context.Context, invoice *domain.VendorInvoice, vendor *domain.Vendor) error {
+	fmt.Println("📢 Event: Vendor invoice submitted (no-op)")
+	return nil
+}
+
+func (p *noOpEventPublisher) PublishVendorInvoiceDocumentAdded(ctx context.Context, invoice *domain.VendorInvoice, doc *domain.VendorInvoiceDocument) error {
+	fmt.Println("📢 Event: Vendor invoice document added (no-op)")
+	return nil
+}
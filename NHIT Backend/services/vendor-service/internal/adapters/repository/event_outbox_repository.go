@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// outboxLockKey is the advisory lock a relay holds while it drains the outbox
+const outboxLockKey = "vendor_event_outbox"
+
+// AddOutboxEvent stores an event in the transaction of ctx
+func (r *vendorRepository) AddOutboxEvent(ctx context.Context, event *ports.OutboxEvent) error {
+	_, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO vendor_event_outbox (event_id, event_type, schema_version, tenant_id, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		event.EventID, event.EventType, event.SchemaVersion, event.TenantID, event.Payload, event.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add %s event to outbox: %w", event.EventType, err)
+	}
+	return nil
+}
+
+// ListPendingOutboxEvents returns up to limit events in the order they were
+// stored. It must run in a transaction, which holds the outbox lock.
+func (r *vendorRepository) ListPendingOutboxEvents(ctx context.Context, limit int) ([]*ports.OutboxEvent, error) {
+	tx := r.conn(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext($1))`, outboxLockKey).Scan(&locked); err != nil {
+		return nil, fmt.Errorf("failed to lock event outbox: %w", err)
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT event_id, event_type, schema_version, tenant_id, payload, occurred_at, attempts
+		FROM vendor_event_outbox
+		ORDER BY seq
+		LIMIT $1
+		FOR UPDATE`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*ports.OutboxEvent
+	for rows.Next() {
+		e := &ports.OutboxEvent{}
+		if err := rows.Scan(&e.EventID, &e.EventType, &e.SchemaVersion, &e.TenantID, &e.Payload, &e.OccurredAt, &e.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// DeleteOutboxEvent removes an event once it has been published
+func (r *vendorRepository) DeleteOutboxEvent(ctx context.Context, eventID uuid.UUID) error {
+	if _, err := r.conn(ctx).Exec(ctx, `DELETE FROM vendor_event_outbox WHERE event_id = $1`, eventID); err != nil {
+		return fmt.Errorf("failed to delete outbox event: %w", err)
+	}
+	return nil
+}
+
+// RecordOutboxEventFailure counts a failed publish of an event
+func (r *vendorRepository) RecordOutboxEventFailure(ctx context.Context, eventID uuid.UUID, reason string) error {
+	_, err := r.conn(ctx).Exec(ctx, `
+		UPDATE vendor_event_outbox
+		SET attempts = attempts + 1, last_error = $2, last_attempt_at = NOW()
+		WHERE event_id = $1`, eventID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to record outbox event failure: %w", err)
+	}
+	return nil
+}
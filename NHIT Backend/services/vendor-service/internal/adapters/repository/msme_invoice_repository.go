@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const msmeInvoiceColumns = `id, tenant_id, vendor_id, vendor_code, vendor_name, msme_classification,
+	msme_registration_number, invoice_number, invoice_date, acceptance_date, agreed_credit_days,
+	invoice_amount, due_date, green_note_id, payment_note_id, paid_date, payment_reference,
+	flagged_status, created_by, created_at, updated_at`
+
+// CreateMSMEInvoice stores a tracked MSME invoice. An invoice number can be
+// tracked once per vendor.
+func (r *vendorRepository) CreateMSMEInvoice(ctx context.Context, inv *domain.MSMEInvoice) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO msme_invoices (`+msmeInvoiceColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+		ON CONFLICT (tenant_id, vendor_id, invoice_number) DO NOTHING`,
+		inv.ID, inv.TenantID, inv.VendorID, inv.VendorCode, inv.VendorName, inv.MSMEClassification,
+		inv.MSMERegistrationNumber, inv.InvoiceNumber, inv.InvoiceDate, inv.AcceptanceDate, inv.AgreedCreditDays,
+		inv.InvoiceAmount, inv.DueDate, inv.GreenNoteID, inv.PaymentNoteID, inv.PaidDate, inv.PaymentReference,
+		inv.FlaggedStatus, inv.CreatedBy, inv.CreatedAt, inv.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create MSME invoice: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMSMEInvoiceExists
+	}
+	return nil
+}
+
+// GetMSMEInvoice retrieves a tracked invoice of the tenant
+func (r *vendorRepository) GetMSMEInvoice(ctx context.Context, tenantID, invoiceID uuid.UUID) (*domain.MSMEInvoice, error) {
+	row := r.conn(ctx).QueryRow(ctx, `SELECT `+msmeInvoiceColumns+`
+		FROM msme_invoices WHERE id = $1 AND tenant_id = $2`, invoiceID, tenantID)
+	return scanMSMEInvoice(row)
+}
+
+// UpdateMSMEInvoicePayment stores the settlement of an unpaid invoice
+func (r *vendorRepository) UpdateMSMEInvoicePayment(ctx context.Context, inv *domain.MSMEInvoice) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		UPDATE msme_invoices
+		SET paid_date = $2, payment_note_id = $3, payment_reference = $4, flagged_status = $5, updated_at = $6
+		WHERE id = $1 AND paid_date IS NULL`,
+		inv.ID, inv.PaidDate, inv.PaymentNoteID, inv.PaymentReference, inv.FlaggedStatus, inv.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update MSME invoice: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMSMEInvoiceAlreadyPaid
+	}
+	return nil
+}
+
+// ListMSMEInvoices lists tracked invoices of a tenant, earliest due first
+func (r *vendorRepository) ListMSMEInvoices(ctx context.Context, tenantID uuid.UUID, filters ports.MSMEInvoiceFilters) ([]*domain.MSMEInvoice, int64, error) {
+	where := " WHERE tenant_id = $1"
+	args := []interface{}{tenantID}
+	argIdx := 2
+
+	if filters.VendorID != nil {
+		where += fmt.Sprintf(" AND vendor_id = $%d", argIdx)
+		args = append(args, *filters.VendorID)
+		argIdx++
+	}
+	if filters.GreenNoteID != nil {
+		where += fmt.Sprintf(" AND green_note_id = $%d", argIdx)
+		args = append(args, *filters.GreenNoteID)
+		argIdx++
+	}
+	if filters.PaymentNoteID != nil {
+		where += fmt.Sprintf(" AND payment_note_id = $%d", argIdx)
+		args = append(args, *filters.PaymentNoteID)
+		argIdx++
+	}
+	if filters.Status != nil {
+		// Mirrors domain.MSMEInvoice.Status
+		asOf := filters.AsOf.Format("2006-01-02")
+		atRiskFrom := filters.AsOf.AddDate(0, 0, filters.AtRiskDays).Format("2006-01-02")
+		switch *filters.Status {
+		case domain.MSMEInvoicePaid:
+			where += " AND paid_date IS NOT NULL AND paid_date <= due_date"
+		case domain.MSMEInvoicePaidLate:
+			where += " AND paid_date IS NOT NULL AND paid_date > due_date"
+		case domain.MSMEInvoiceOverdue:
+			where += fmt.Sprintf(" AND paid_date IS NULL AND due_date < $%d::date", argIdx)
+			args = append(args, asOf)
+			argIdx++
+		case domain.MSMEInvoiceAtRisk:
+			where += fmt.Sprintf(" AND paid_date IS NULL AND due_date >= $%d::date AND due_date <= $%d::date", argIdx, argIdx+1)
+			args = append(args, asOf, atRiskFrom)
+			argIdx += 2
+		case domain.MSMEInvoiceOpen:
+			where += fmt.Sprintf(" AND paid_date IS NULL AND due_date > $%d::date", argIdx)
+			args = append(args, atRiskFrom)
+			argIdx++
+		}
+	}
+
+	var total int64
+	if err := r.conn(ctx).QueryRow(ctx, "SELECT COUNT(*) FROM msme_invoices"+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count MSME invoices: %w", err)
+	}
+
+	query := "SELECT " + msmeInvoiceColumns + " FROM msme_invoices" + where +
+		fmt.Sprintf(" ORDER BY due_date ASC, invoice_number ASC LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+	args = append(args, filters.Limit, filters.Offset)
+
+	invoices, err := r.queryMSMEInvoices(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return invoices, total, nil
+}
+
+// ListMSMEInvoicesForReport returns the tenant's invoices dated on or before
+// asOf that were unpaid or paid on or after periodStart (periodStart <= asOf)
+func (r *vendorRepository) ListMSMEInvoicesForReport(ctx context.Context, tenantID uuid.UUID, asOf, periodStart time.Time) ([]*domain.MSMEInvoice, error) {
+	return r.queryMSMEInvoices(ctx, `SELECT `+msmeInvoiceColumns+`
+		FROM msme_invoices
+		WHERE tenant_id = $1 AND invoice_date <= $2::date
+			AND (paid_date IS NULL OR paid_date >= $3::date)
+		ORDER BY vendor_id, due_date`,
+		tenantID, asOf.Format("2006-01-02"), periodStart.Format("2006-01-02"))
+}
+
+// ListMSMEInvoicesToFlag returns unpaid invoices of all tenants due on or
+// before dueBy that have not been flagged overdue yet
+func (r *vendorRepository) ListMSMEInvoicesToFlag(ctx context.Context, dueBy time.Time) ([]*domain.MSMEInvoice, error) {
+	return r.queryMSMEInvoices(ctx, `SELECT `+msmeInvoiceColumns+`
+		FROM msme_invoices
+		WHERE paid_date IS NULL AND due_date <= $1::date AND flagged_status <> 'OVERDUE'
+		ORDER BY due_date`, dueBy.Format("2006-01-02"))
+}
+
+// UpdateMSMEInvoiceFlag records the status last published for an invoice
+func (r *vendorRepository) UpdateMSMEInvoiceFlag(ctx context.Context, invoiceID uuid.UUID, flaggedStatus string) error {
+	_, err := r.conn(ctx).Exec(ctx, `
+		UPDATE msme_invoices SET flagged_status = $2, updated_at = $3 WHERE id = $1`,
+		invoiceID, flaggedStatus, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to update MSME invoice flag: %w", err)
+	}
+	return nil
+}
+
+func (r *vendorRepository) queryMSMEInvoices(ctx context.Context, query string, args ...interface{}) ([]*domain.MSMEInvoice, error) {
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MSME invoices: %w", err)
+	}
+	defer rows.Close()
+
+	invoices := []*domain.MSMEInvoice{}
+	for rows.Next() {
+		inv, err := scanMSMEInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, inv)
+	}
+	return invoices, rows.Err()
+}
+
+// scanMSMEInvoice scans one msme_invoices row selected with msmeInvoiceColumns
+func scanMSMEInvoice(row pgx.Row) (*domain.MSMEInvoice, error) {
+	var inv domain.MSMEInvoice
+	err := row.Scan(
+		&inv.ID, &inv.TenantID, &inv.VendorID, &inv.VendorCode, &inv.VendorName, &inv.MSMEClassification,
+		&inv.MSMERegistrationNumber, &inv.InvoiceNumber, &inv.InvoiceDate, &inv.AcceptanceDate, &inv.AgreedCreditDays,
+		&inv.InvoiceAmount, &inv.DueDate, &inv.GreenNoteID, &inv.PaymentNoteID, &inv.PaidDate, &inv.PaymentReference,
+		&inv.FlaggedStatus, &inv.CreatedBy, &inv.CreatedAt, &inv.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrMSMEInvoiceNotFound
+		}
+		return nil, err
+	}
+	return &inv, nil
+}
@@ -242,19 +242,24 @@ type Vendor struct {
 }
 
 type VendorAccount struct {
-	ID            uuid.UUID        `db:"id" json:"id"`
-	VendorID      uuid.UUID        `db:"vendor_id" json:"vendor_id"`
-	AccountName   string           `db:"account_name" json:"account_name"`
-	AccountNumber string           `db:"account_number" json:"account_number"`
-	AccountType   *string          `db:"account_type" json:"account_type"`
-	NameOfBank    string           `db:"name_of_bank" json:"name_of_bank"`
-	BranchName    *string          `db:"branch_name" json:"branch_name"`
-	IfscCode      string           `db:"ifsc_code" json:"ifsc_code"`
-	SwiftCode     *string          `db:"swift_code" json:"swift_code"`
-	IsPrimary     *bool            `db:"is_primary" json:"is_primary"`
-	IsActive      *bool            `db:"is_active" json:"is_active"`
-	Remarks       *string          `db:"remarks" json:"remarks"`
-	CreatedBy     uuid.UUID        `db:"created_by" json:"created_by"`
-	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+	ID                uuid.UUID        `db:"id" json:"id"`
+	VendorID          uuid.UUID        `db:"vendor_id" json:"vendor_id"`
+	AccountName       string           `db:"account_name" json:"account_name"`
+	AccountNumber     string           `db:"account_number" json:"account_number"`
+	AccountType       *string          `db:"account_type" json:"account_type"`
+	NameOfBank        string           `db:"name_of_bank" json:"name_of_bank"`
+	BranchName        *string          `db:"branch_name" json:"branch_name"`
+	IfscCode          string           `db:"ifsc_code" json:"ifsc_code"`
+	SwiftCode         *string          `db:"swift_code" json:"swift_code"`
+	IsPrimary         *bool            `db:"is_primary" json:"is_primary"`
+	IsActive          *bool            `db:"is_active" json:"is_active"`
+	Remarks           *string          `db:"remarks" json:"remarks"`
+	CreatedBy         uuid.UUID        `db:"created_by" json:"created_by"`
+	CreatedAt         pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+	AccountNumberHash *string          `db:"account_number_hash" json:"account_number_hash"`
+	ApprovalStatus    string           `db:"approval_status" json:"approval_status"`
+	ApprovedBy        pgtype.UUID      `db:"approved_by" json:"approved_by"`
+	ApprovedAt        pgtype.Timestamp `db:"approved_at" json:"approved_at"`
+	PayableFrom       pgtype.Timestamp `db:"payable_from" json:"payable_from"`
 }
@@ -16,26 +16,29 @@ const createVendorAccount = `-- name: CreateVendorAccount :exec
 INSERT INTO vendor_accounts (
     id, vendor_id, account_name, account_number, account_type,
     name_of_bank, branch_name, ifsc_code, swift_code,
-    is_primary, is_active, remarks, created_by
+    is_primary, is_active, remarks, created_by,
+    account_number_hash, approval_status
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 )
 `
 
 type CreateVendorAccountParams struct {
-	ID            uuid.UUID `db:"id" json:"id"`
-	VendorID      uuid.UUID `db:"vendor_id" json:"vendor_id"`
-	AccountName   string    `db:"account_name" json:"account_name"`
-	AccountNumber string    `db:"account_number" json:"account_number"`
-	AccountType   *string   `db:"account_type" json:"account_type"`
-	NameOfBank    string    `db:"name_of_bank" json:"name_of_bank"`
-	BranchName    *string   `db:"branch_name" json:"branch_name"`
-	IfscCode      string    `db:"ifsc_code" json:"ifsc_code"`
-	SwiftCode     *string   `db:"swift_code" json:"swift_code"`
-	IsPrimary     *bool     `db:"is_primary" json:"is_primary"`
-	IsActive      *bool     `db:"is_active" json:"is_active"`
-	Remarks       *string   `db:"remarks" json:"remarks"`
-	CreatedBy     uuid.UUID `db:"created_by" json:"created_by"`
+	ID                uuid.UUID `db:"id" json:"id"`
+	VendorID          uuid.UUID `db:"vendor_id" json:"vendor_id"`
+	AccountName       string    `db:"account_name" json:"account_name"`
+	AccountNumber     string    `db:"account_number" json:"account_number"`
+	AccountType       *string   `db:"account_type" json:"account_type"`
+	NameOfBank        string    `db:"name_of_bank" json:"name_of_bank"`
+	BranchName        *string   `db:"branch_name" json:"branch_name"`
+	IfscCode          string    `db:"ifsc_code" json:"ifsc_code"`
+	SwiftCode         *string   `db:"swift_code" json:"swift_code"`
+	IsPrimary         *bool     `db:"is_primary" json:"is_primary"`
+	IsActive          *bool     `db:"is_active" json:"is_active"`
+	Remarks           *string   `db:"remarks" json:"remarks"`
+	CreatedBy         uuid.UUID `db:"created_by" json:"created_by"`
+	AccountNumberHash *string   `db:"account_number_hash" json:"account_number_hash"`
+	ApprovalStatus    string    `db:"approval_status" json:"approval_status"`
 }
 
 func (q *Queries) CreateVendorAccount(ctx context.Context, arg CreateVendorAccountParams) error {
@@ -53,6 +56,8 @@ func (q *Queries) CreateVendorAccount(ctx context.Context, arg CreateVendorAccou
 		arg.IsActive,
 		arg.Remarks,
 		arg.CreatedBy,
+		arg.AccountNumberHash,
+		arg.ApprovalStatus,
 	)
 	return err
 }
@@ -68,7 +73,7 @@ func (q *Queries) DeleteVendorAccount(ctx context.Context, id uuid.UUID) error {
 }
 
 const getPrimaryVendorAccount = `-- name: GetPrimaryVendorAccount :one
-SELECT id, vendor_id, account_name, account_number, account_type, name_of_bank, branch_name, ifsc_code, swift_code, is_primary, is_active, remarks, created_by, created_at, updated_at FROM vendor_accounts 
+SELECT id, vendor_id, account_name, account_number, account_type, name_of_bank, branch_name, ifsc_code, swift_code, is_primary, is_active, remarks, created_by, created_at, updated_at, account_number_hash, approval_status, approved_by, approved_at, payable_from FROM vendor_accounts 
 WHERE vendor_id = $1 AND is_primary = true AND is_active = true
 LIMIT 1
 `
@@ -92,12 +97,17 @@ func (q *Queries) GetPrimaryVendorAccount(ctx context.Context, vendorID uuid.UUI
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AccountNumberHash,
+		&i.ApprovalStatus,
+		&i.ApprovedBy,
+		&i.ApprovedAt,
+		&i.PayableFrom,
 	)
 	return &i, err
 }
 
 const getVendorAccountByID = `-- name: GetVendorAccountByID :one
-SELECT id, vendor_id, account_name, account_number, account_type, name_of_bank, branch_name, ifsc_code, swift_code, is_primary, is_active, remarks, created_by, created_at, updated_at FROM vendor_accounts 
+SELECT id, vendor_id, account_name, account_number, account_type, name_of_bank, branch_name, ifsc_code, swift_code, is_primary, is_active, remarks, created_by, created_at, updated_at, account_number_hash, approval_status, approved_by, approved_at, payable_from FROM vendor_accounts 
 WHERE id = $1
 `
 
@@ -120,12 +130,17 @@ func (q *Queries) GetVendorAccountByID(ctx context.Context, id uuid.UUID) (*Vend
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AccountNumberHash,
+		&i.ApprovalStatus,
+		&i.ApprovedBy,
+		&i.ApprovedAt,
+		&i.PayableFrom,
 	)
 	return &i, err
 }
 
 const getVendorAccountsByVendorID = `-- name: GetVendorAccountsByVendorID :many
-SELECT id, vendor_id, account_name, account_number, account_type, name_of_bank, branch_name, ifsc_code, swift_code, is_primary, is_active, remarks, created_by, created_at, updated_at FROM vendor_accounts 
+SELECT id, vendor_id, account_name, account_number, account_type, name_of_bank, branch_name, ifsc_code, swift_code, is_primary, is_active, remarks, created_by, created_at, updated_at, account_number_hash, approval_status, approved_by, approved_at, payable_from FROM vendor_accounts 
 WHERE vendor_id = $1
 ORDER BY is_primary DESC, created_at ASC
 `
@@ -155,6 +170,11 @@ func (q *Queries) GetVendorAccountsByVendorID(ctx context.Context, vendorID uuid
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.AccountNumberHash,
+			&i.ApprovalStatus,
+			&i.ApprovedBy,
+			&i.ApprovedAt,
+			&i.PayableFrom,
 		); err != nil {
 			return nil, err
 		}
@@ -221,22 +241,32 @@ UPDATE vendor_accounts SET
     is_primary = COALESCE($9, is_primary),
     is_active = COALESCE($10, is_active),
     remarks = COALESCE($11, remarks),
+    account_number_hash = COALESCE($12, account_number_hash),
+    approval_status = COALESCE($13, approval_status),
+    approved_by = COALESCE($14, approved_by),
+    approved_at = COALESCE($15, approved_at),
+    payable_from = COALESCE($16, payable_from),
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateVendorAccountParams struct {
-	ID            uuid.UUID `db:"id" json:"id"`
-	AccountName   string    `db:"account_name" json:"account_name"`
-	AccountNumber string    `db:"account_number" json:"account_number"`
-	AccountType   *string   `db:"account_type" json:"account_type"`
-	NameOfBank    string    `db:"name_of_bank" json:"name_of_bank"`
-	BranchName    *string   `db:"branch_name" json:"branch_name"`
-	IfscCode      string    `db:"ifsc_code" json:"ifsc_code"`
-	SwiftCode     *string   `db:"swift_code" json:"swift_code"`
-	IsPrimary     *bool     `db:"is_primary" json:"is_primary"`
-	IsActive      *bool     `db:"is_active" json:"is_active"`
-	Remarks       *string   `db:"remarks" json:"remarks"`
+	ID                uuid.UUID        `db:"id" json:"id"`
+	AccountName       string           `db:"account_name" json:"account_name"`
+	AccountNumber     string           `db:"account_number" json:"account_number"`
+	AccountType       *string          `db:"account_type" json:"account_type"`
+	NameOfBank        string           `db:"name_of_bank" json:"name_of_bank"`
+	BranchName        *string          `db:"branch_name" json:"branch_name"`
+	IfscCode          string           `db:"ifsc_code" json:"ifsc_code"`
+	SwiftCode         *string          `db:"swift_code" json:"swift_code"`
+	IsPrimary         *bool            `db:"is_primary" json:"is_primary"`
+	IsActive          *bool            `db:"is_active" json:"is_active"`
+	Remarks           *string          `db:"remarks" json:"remarks"`
+	AccountNumberHash *string          `db:"account_number_hash" json:"account_number_hash"`
+	ApprovalStatus    string           `db:"approval_status" json:"approval_status"`
+	ApprovedBy        pgtype.UUID      `db:"approved_by" json:"approved_by"`
+	ApprovedAt        pgtype.Timestamp `db:"approved_at" json:"approved_at"`
+	PayableFrom       pgtype.Timestamp `db:"payable_from" json:"payable_from"`
 }
 
 func (q *Queries) UpdateVendorAccount(ctx context.Context, arg UpdateVendorAccountParams) error {
@@ -252,6 +282,11 @@ func (q *Queries) UpdateVendorAccount(ctx context.Context, arg UpdateVendorAccou
 		arg.IsPrimary,
 		arg.IsActive,
 		arg.Remarks,
+		arg.AccountNumberHash,
+		arg.ApprovalStatus,
+		arg.ApprovedBy,
+		arg.ApprovedAt,
+		arg.PayableFrom,
 	)
 	return err
 }
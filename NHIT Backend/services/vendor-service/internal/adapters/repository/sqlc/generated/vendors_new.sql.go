@@ -533,6 +533,7 @@ UPDATE vendors SET
     from_account_type = COALESCE($27, from_account_type),
     short_name = COALESCE($28, short_name),
     parent = COALESCE($29, parent),
+    gstin = COALESCE($30, gstin),
     updated_at = NOW()
 WHERE id = $1 AND tenant_id = $2
 `
@@ -567,6 +568,7 @@ type UpdateVendorParams struct {
 	FromAccountType        *string                    `db:"from_account_type" json:"from_account_type"`
 	ShortName              *string                    `db:"short_name" json:"short_name"`
 	Parent                 *string                    `db:"parent" json:"parent"`
+	Gstin                  *string                    `db:"gstin" json:"gstin"`
 }
 
 func (q *Queries) UpdateVendor(ctx context.Context, arg UpdateVendorParams) error {
@@ -600,6 +602,7 @@ func (q *Queries) UpdateVendor(ctx context.Context, arg UpdateVendorParams) erro
 		arg.FromAccountType,
 		arg.ShortName,
 		arg.Parent,
+		arg.Gstin,
 	)
 	return err
 }
@@ -2,9 +2,10 @@
 INSERT INTO vendor_accounts (
     id, vendor_id, account_name, account_number, account_type,
     name_of_bank, branch_name, ifsc_code, swift_code,
-    is_primary, is_active, remarks, created_by
+    is_primary, is_active, remarks, created_by,
+    account_number_hash, approval_status
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
 );
 
 -- name: GetVendorAccountByID :one
@@ -33,6 +34,11 @@ UPDATE vendor_accounts SET
     is_primary = COALESCE($9, is_primary),
     is_active = COALESCE($10, is_active),
     remarks = COALESCE($11, remarks),
+    account_number_hash = COALESCE($12, account_number_hash),
+    approval_status = COALESCE($13, approval_status),
+    approved_by = COALESCE($14, approved_by),
+    approved_at = COALESCE($15, approved_at),
+    payable_from = COALESCE($16, payable_from),
     updated_at = NOW()
 WHERE id = $1;
 
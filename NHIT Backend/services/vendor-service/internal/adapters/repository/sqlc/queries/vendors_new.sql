@@ -56,6 +56,7 @@ UPDATE vendors SET
     from_account_type = COALESCE($27, from_account_type),
     short_name = COALESCE($28, short_name),
     parent = COALESCE($29, parent),
+    gstin = COALESCE($30, gstin),
     updated_at = NOW()
 WHERE id = $1 AND tenant_id = $2;
 
@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const tdsSectionColumns = `code, description, rate_individual, rate_other, rate_no_pan,
+	single_threshold, annual_threshold, deduct_on_excess, is_active, updated_by, updated_at`
+
+// tdsCertificateColumns selects a certificate with the amount already used
+const tdsCertificateColumns = `c.id, c.tenant_id, c.vendor_id, c.section_code, c.certificate_number, c.rate,
+	c.valid_from, c.valid_to, c.amount_limit,
+	COALESCE((SELECT SUM(d.certificate_amount) FROM tds_deductions d WHERE d.certificate_id = c.id), 0) AS amount_used,
+	c.created_by, c.created_at`
+
+const tdsDeductionColumns = `id, tenant_id, vendor_id, section_code, financial_year, reference_id, payment_date,
+	amount, aggregate_before, taxable_amount, rate, rule, certificate_id, certificate_amount, certificate_rate,
+	tds_amount, created_by, created_at`
+
+// ListTDSSections returns the section master ordered by code
+func (r *vendorRepository) ListTDSSections(ctx context.Context, includeInactive bool) ([]*domain.TDSSection, error) {
+	rows, err := r.conn(ctx).Query(ctx, `SELECT `+tdsSectionColumns+`
+		FROM tds_sections WHERE is_active OR $1 ORDER BY code`, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list TDS sections: %w", err)
+	}
+	defer rows.Close()
+
+	sections := []*domain.TDSSection{}
+	for rows.Next() {
+		section, err := scanTDSSection(rows)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, section)
+	}
+	return sections, rows.Err()
+}
+
+// GetTDSSection returns a section by code
+func (r *vendorRepository) GetTDSSection(ctx context.Context, code string) (*domain.TDSSection, error) {
+	row := r.conn(ctx).QueryRow(ctx, `SELECT `+tdsSectionColumns+` FROM tds_sections WHERE code = $1`, code)
+	return scanTDSSection(row)
+}
+
+// UpsertTDSSection creates a section or replaces its rates and thresholds
+func (r *vendorRepository) UpsertTDSSection(ctx context.Context, s *domain.TDSSection) error {
+	_, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO tds_sections (`+tdsSectionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (code) DO UPDATE SET
+			description = EXCLUDED.description, rate_individual = EXCLUDED.rate_individual,
+			rate_other = EXCLUDED.rate_other, rate_no_pan = EXCLUDED.rate_no_pan,
+			single_threshold = EXCLUDED.single_threshold, annual_threshold = EXCLUDED.annual_threshold,
+			deduct_on_excess = EXCLUDED.deduct_on_excess, is_active = EXCLUDED.is_active,
+			updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at`,
+		s.Code, s.Description, s.RateIndividual, s.RateOther, s.RateNoPAN,
+		s.SingleThreshold, s.AnnualThreshold, s.DeductOnExcess, s.IsActive, s.UpdatedBy, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save TDS section: %w", err)
+	}
+	return nil
+}
+
+// CreateTDSCertificate records a lower deduction certificate. Certificate
+// numbers are unique per tenant.
+func (r *vendorRepository) CreateTDSCertificate(ctx context.Context, c *domain.TDSCertificate) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO tds_certificates (id, tenant_id, vendor_id, section_code, certificate_number, rate,
+			valid_from, valid_to, amount_limit, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (tenant_id, certificate_number) DO NOTHING`,
+		c.ID, c.TenantID, c.VendorID, c.SectionCode, c.CertificateNumber, c.Rate,
+		c.ValidFrom, c.ValidTo, c.AmountLimit, c.CreatedBy, c.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create TDS certificate: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTDSCertificateExists
+	}
+	return nil
+}
+
+// ListTDSCertificates returns a vendor's certificates, latest first
+func (r *vendorRepository) ListTDSCertificates(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.TDSCertificate, error) {
+	rows, err := r.conn(ctx).Query(ctx, `SELECT `+tdsCertificateColumns+`
+		FROM tds_certificates c WHERE c.tenant_id = $1 AND c.vendor_id = $2
+		ORDER BY c.valid_from DESC, c.created_at DESC`, tenantID, vendorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list TDS certificates: %w", err)
+	}
+	defer rows.Close()
+
+	certs := []*domain.TDSCertificate{}
+	for rows.Next() {
+		cert, err := scanTDSCertificate(rows)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, rows.Err()
+}
+
+// GetApplicableTDSCertificate returns the certificate valid on date with the
+// most amount left, or nil when there is none
+func (r *vendorRepository) GetApplicableTDSCertificate(ctx context.Context, tenantID, vendorID uuid.UUID, sectionCode string, date time.Time) (*domain.TDSCertificate, error) {
+	row := r.conn(ctx).QueryRow(ctx, `
+		SELECT * FROM (
+			SELECT `+tdsCertificateColumns+`
+			FROM tds_certificates c
+			WHERE c.tenant_id = $1 AND c.vendor_id = $2 AND c.section_code = $3
+				AND $4::date BETWEEN c.valid_from AND c.valid_to
+		) certs
+		WHERE amount_limit > amount_used
+		ORDER BY amount_limit - amount_used DESC
+		LIMIT 1`, tenantID, vendorID, sectionCode, date)
+	cert, err := scanTDSCertificate(row)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return cert, err
+}
+
+// LockVendorTDS locks the vendor row so concurrent deductions see each
+// other's aggregates
+func (r *vendorRepository) LockVendorTDS(ctx context.Context, vendorID uuid.UUID) error {
+	var id uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx, `SELECT id FROM vendors WHERE id = $1 FOR UPDATE`, vendorID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrVendorNotFound
+		}
+		return fmt.Errorf("failed to lock vendor: %w", err)
+	}
+	return nil
+}
+
+// GetTDSVendorHistory totals a vendor's recorded payments under a section in a financial year
+func (r *vendorRepository) GetTDSVendorHistory(ctx context.Context, tenantID, vendorID uuid.UUID, sectionCode, financialYear string) (domain.TDSVendorHistory, error) {
+	var h domain.TDSVendorHistory
+	err := r.conn(ctx).QueryRow(ctx, `
+		SELECT COALESCE(SUM(amount), 0),
+			COALESCE(SUM(amount) FILTER (WHERE rule = $5), 0)
+		FROM tds_deductions
+		WHERE tenant_id = $1 AND vendor_id = $2 AND section_code = $3 AND financial_year = $4`,
+		tenantID, vendorID, sectionCode, financialYear, domain.TDSRuleBelowThreshold,
+	).Scan(&h.AggregateAmount, &h.UntaxedAmount)
+	if err != nil {
+		return h, fmt.Errorf("failed to total TDS deductions: %w", err)
+	}
+	return h, nil
+}
+
+// CreateTDSDeduction records a deduction. A reference is recorded once per tenant.
+func (r *vendorRepository) CreateTDSDeduction(ctx context.Context, d *domain.TDSDeduction) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO tds_deductions (`+tdsDeductionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		ON CONFLICT (tenant_id, reference_id) DO NOTHING`,
+		d.ID, d.TenantID, d.VendorID, d.SectionCode, d.FinancialYear, d.ReferenceID, d.PaymentDate,
+		d.Amount, d.AggregateBefore, d.TaxableAmount, d.Rate, d.Rule, d.CertificateID, d.CertificateAmount, d.CertificateRate,
+		d.TDSAmount, d.CreatedBy, d.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record TDS deduction: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTDSDeductionExists
+	}
+	return nil
+}
+
+// GetTDSDeductionByReference returns the deduction recorded for a reference
+func (r *vendorRepository) GetTDSDeductionByReference(ctx context.Context, tenantID uuid.UUID, referenceID string) (*domain.TDSDeduction, error) {
+	var d domain.TDSDeduction
+	err := r.conn(ctx).QueryRow(ctx, `SELECT `+tdsDeductionColumns+`
+		FROM tds_deductions WHERE tenant_id = $1 AND reference_id = $2`, tenantID, referenceID,
+	).Scan(
+		&d.ID, &d.TenantID, &d.VendorID, &d.SectionCode, &d.FinancialYear, &d.ReferenceID, &d.PaymentDate,
+		&d.Amount, &d.AggregateBefore, &d.TaxableAmount, &d.Rate, &d.Rule, &d.CertificateID, &d.CertificateAmount, &d.CertificateRate,
+		&d.TDSAmount, &d.CreatedBy, &d.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrTDSDeductionNotFound
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+// DeleteTDSDeduction removes a recorded deduction so it no longer counts towards thresholds
+func (r *vendorRepository) DeleteTDSDeduction(ctx context.Context, tenantID uuid.UUID, referenceID string) error {
+	tag, err := r.conn(ctx).Exec(ctx, `DELETE FROM tds_deductions WHERE tenant_id = $1 AND reference_id = $2`, tenantID, referenceID)
+	if err != nil {
+		return fmt.Errorf("failed to delete TDS deduction: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTDSDeductionNotFound
+	}
+	return nil
+}
+
+// GetVendorTDSSummary totals a vendor's deductions per section for a financial year
+func (r *vendorRepository) GetVendorTDSSummary(ctx context.Context, tenantID, vendorID uuid.UUID, financialYear string) ([]*domain.TDSSectionSummary, error) {
+	rows, err := r.conn(ctx).Query(ctx, `
+		SELECT d.section_code, COUNT(*), SUM(d.amount), SUM(d.taxable_amount), SUM(d.tds_amount),
+			s.single_threshold, s.annual_threshold
+		FROM tds_deductions d
+		JOIN tds_sections s ON s.code = d.section_code
+		WHERE d.tenant_id = $1 AND d.vendor_id = $2 AND d.financial_year = $3
+		GROUP BY d.section_code, s.single_threshold, s.annual_threshold
+		ORDER BY d.section_code`, tenantID, vendorID, financialYear)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarise TDS deductions: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := []*domain.TDSSectionSummary{}
+	for rows.Next() {
+		var s domain.TDSSectionSummary
+		if err := rows.Scan(&s.SectionCode, &s.Deductions, &s.AggregateAmount, &s.TaxableAmount, &s.TDSAmount,
+			&s.SingleThreshold, &s.AnnualThreshold); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, &s)
+	}
+	return summaries, rows.Err()
+}
+
+func scanTDSSection(row pgx.Row) (*domain.TDSSection, error) {
+	var s domain.TDSSection
+	err := row.Scan(&s.Code, &s.Description, &s.RateIndividual, &s.RateOther, &s.RateNoPAN,
+		&s.SingleThreshold, &s.AnnualThreshold, &s.DeductOnExcess, &s.IsActive, &s.UpdatedBy, &s.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrTDSSectionNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+// scanTDSCertificate scans a row selected with tdsCertificateColumns;
+// pgx.ErrNoRows is returned as is
+func scanTDSCertificate(row pgx.Row) (*domain.TDSCertificate, error) {
+	var c domain.TDSCertificate
+	if err := row.Scan(&c.ID, &c.TenantID, &c.VendorID, &c.SectionCode, &c.CertificateNumber, &c.Rate,
+		&c.ValidFrom, &c.ValidTo, &c.AmountLimit, &c.AmountUsed, &c.CreatedBy, &c.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/adapters/repository/sqlc/generated"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const accountChangeColumns = `id, tenant_id, vendor_id, account_id, change_type, old_values, new_values,
+	status, requested_by, requested_at, reviewed_by, reviewed_at, review_remarks`
+
+// CreateAccountChangeRequest stores a pending bank account change.
+// Old and new bank detail snapshots are stored encrypted.
+func (r *vendorRepository) CreateAccountChangeRequest(ctx context.Context, change *domain.AccountChangeRequest) error {
+	var oldValues *string
+	if change.OldValues != nil {
+		enc, err := r.encryptSnapshot(ctx, *change.OldValues)
+		if err != nil {
+			return err
+		}
+		oldValues = &enc
+	}
+	newValues, err := r.encryptSnapshot(ctx, change.NewValues)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn(ctx).Exec(ctx, `
+		INSERT INTO vendor_account_change_requests (
+			id, tenant_id, vendor_id, account_id, change_type, old_values, new_values,
+			status, requested_by, requested_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		change.ID, change.TenantID, change.VendorID, change.AccountID, change.ChangeType,
+		oldValues, newValues, change.Status, change.RequestedBy, change.RequestedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create account change request: %w", err)
+	}
+	return nil
+}
+
+// GetAccountChangeRequest retrieves a change request of the tenant
+func (r *vendorRepository) GetAccountChangeRequest(ctx context.Context, tenantID, changeID uuid.UUID) (*domain.AccountChangeRequest, error) {
+	row := r.conn(ctx).QueryRow(ctx, `SELECT `+accountChangeColumns+`
+		FROM vendor_account_change_requests WHERE id = $1 AND tenant_id = $2`, changeID, tenantID)
+	return r.scanAccountChange(ctx, row)
+}
+
+// GetPendingAccountChange returns the open change request of an account, if any
+func (r *vendorRepository) GetPendingAccountChange(ctx context.Context, accountID uuid.UUID) (*domain.AccountChangeRequest, error) {
+	row := r.conn(ctx).QueryRow(ctx, `SELECT `+accountChangeColumns+`
+		FROM vendor_account_change_requests WHERE account_id = $1 AND status = 'PENDING'`, accountID)
+	return r.scanAccountChange(ctx, row)
+}
+
+// ListAccountChangeRequests lists change requests of a tenant, newest first
+func (r *vendorRepository) ListAccountChangeRequests(ctx context.Context, tenantID uuid.UUID, filters ports.AccountChangeFilters) ([]*domain.AccountChangeRequest, int64, error) {
+	where := " WHERE tenant_id = $1"
+	args := []interface{}{tenantID}
+	argIdx := 2
+
+	if filters.Status != nil {
+		where += fmt.Sprintf(" AND status = $%d", argIdx)
+		args = append(args, *filters.Status)
+		argIdx++
+	}
+	if filters.VendorID != nil {
+		where += fmt.Sprintf(" AND vendor_id = $%d", argIdx)
+		args = append(args, *filters.VendorID)
+		argIdx++
+	}
+
+	var total int64
+	if err := r.conn(ctx).QueryRow(ctx, "SELECT COUNT(*) FROM vendor_account_change_requests"+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count account change requests: %w", err)
+	}
+
+	query := "SELECT " + accountChangeColumns + " FROM vendor_account_change_requests" + where +
+		fmt.Sprintf(" ORDER BY requested_at DESC LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+	args = append(args, filters.Limit, filters.Offset)
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list account change requests: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*domain.AccountChangeRequest{}
+	for rows.Next() {
+		change, err := r.scanAccountChange(ctx, rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return changes, total, nil
+}
+
+// UpdateAccountChangeReview stores the review outcome of a change request
+func (r *vendorRepository) UpdateAccountChangeReview(ctx context.Context, change *domain.AccountChangeRequest) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		UPDATE vendor_account_change_requests
+		SET status = $2, reviewed_by = $3, reviewed_at = $4, review_remarks = $5
+		WHERE id = $1 AND status = 'PENDING'`,
+		change.ID, change.Status, change.ReviewedBy, change.ReviewedAt, change.ReviewRemarks,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update account change request: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrChangeNotPending
+	}
+	return nil
+}
+
+// FindVendorAccountsByNumber returns the tenant's vendor accounts with the given
+// account number, matched through the blind index.
+func (r *vendorRepository) FindVendorAccountsByNumber(ctx context.Context, tenantID uuid.UUID, accountNumber string) ([]*domain.VendorAccount, error) {
+	numberHash, err := r.crypt.BlindIndex(ctx, accountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to index account number: %w", err)
+	}
+
+	rows, err := r.conn(ctx).Query(ctx, `
+		SELECT va.id, va.vendor_id, va.account_name, va.account_number, va.account_type,
+			va.name_of_bank, va.branch_name, va.ifsc_code, va.swift_code, va.is_primary,
+			va.is_active, va.remarks, va.created_by, va.created_at, va.updated_at,
+			va.account_number_hash, va.approval_status, va.approved_by, va.approved_at, va.payable_from
+		FROM vendor_accounts va
+		JOIN vendors v ON v.id = va.vendor_id
+		WHERE v.tenant_id = $1 AND va.account_number_hash = $2
+		ORDER BY va.is_primary DESC, va.created_at ASC`, tenantID, numberHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vendor accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []*domain.VendorAccount
+	for rows.Next() {
+		var a sqlc.VendorAccount
+		if err := rows.Scan(
+			&a.ID, &a.VendorID, &a.AccountName, &a.AccountNumber, &a.AccountType,
+			&a.NameOfBank, &a.BranchName, &a.IfscCode, &a.SwiftCode, &a.IsPrimary,
+			&a.IsActive, &a.Remarks, &a.CreatedBy, &a.CreatedAt, &a.UpdatedAt,
+			&a.AccountNumberHash, &a.ApprovalStatus, &a.ApprovedBy, &a.ApprovedAt, &a.PayableFrom,
+		); err != nil {
+			return nil, err
+		}
+		account, err := r.toDomainVendorAccount(ctx, &a)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, rows.Err()
+}
+
+// scanAccountChange scans one change request row and decrypts its snapshots
+func (r *vendorRepository) scanAccountChange(ctx context.Context, row pgx.Row) (*domain.AccountChangeRequest, error) {
+	var (
+		change     domain.AccountChangeRequest
+		oldValues  *string
+		newValues  string
+		reviewedBy pgtype.UUID
+		reviewedAt pgtype.Timestamp
+	)
+	err := row.Scan(
+		&change.ID, &change.TenantID, &change.VendorID, &change.AccountID, &change.ChangeType,
+		&oldValues, &newValues, &change.Status, &change.RequestedBy, &change.RequestedAt,
+		&reviewedBy, &reviewedAt, &change.ReviewRemarks,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrAccountChangeNotFound
+		}
+		return nil, err
+	}
+
+	if oldValues != nil {
+		snapshot, err := r.decryptSnapshot(ctx, *oldValues)
+		if err != nil {
+			return nil, err
+		}
+		change.OldValues = &snapshot
+	}
+	if change.NewValues, err = r.decryptSnapshot(ctx, newValues); err != nil {
+		return nil, err
+	}
+	change.ReviewedBy = fromPgUUID(reviewedBy)
+	change.ReviewedAt = fromPgTimestamp(reviewedAt)
+	return &change, nil
+}
+
+func (r *vendorRepository) encryptSnapshot(ctx context.Context, s domain.BankDetailsSnapshot) (string, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal bank details: %w", err)
+	}
+	enc, err := r.crypt.Encrypt(ctx, string(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt bank details: %w", err)
+	}
+	return enc, nil
+}
+
+func (r *vendorRepository) decryptSnapshot(ctx context.Context, stored string) (domain.BankDetailsSnapshot, error) {
+	var s domain.BankDetailsSnapshot
+	data, err := r.crypt.Decrypt(ctx, stored)
+	if err != nil {
+		return s, fmt.Errorf("failed to decrypt bank details: %w", err)
+	}
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		return s, fmt.Errorf("failed to unmarshal bank details: %w", err)
+	}
+	return s, nil
+}
+
+func toPgUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func fromPgUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func toPgTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: *t, Valid: true}
+}
+
+func fromPgTimestamp(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
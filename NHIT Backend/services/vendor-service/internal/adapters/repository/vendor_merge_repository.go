@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// vendorNameKeySQL normalises a vendor name for duplicate matching. It must
+// stay identical to the expression of idx_vendors_tenant_name_key
+// (migrations/005_vendor_duplicates_and_merges.sql) for the index to be used.
+const vendorNameKeySQL = `regexp_replace(
+		regexp_replace(
+			regexp_replace(LOWER(%s), '[^a-z0-9]+', ' ', 'g'),
+			'\m(the|ms|pvt|private|ltd|limited|llp|inc|co|company|corp|corporation)\M', '', 'g'),
+		'[^a-z0-9]', '', 'g')`
+
+// FindDuplicateVendors returns the tenant's vendors matching the criteria on
+// PAN, GSTIN or normalised name. Vendors already merged away are ignored.
+func (r *vendorRepository) FindDuplicateVendors(ctx context.Context, tenantID uuid.UUID, criteria ports.DuplicateCriteria) ([]domain.DuplicateMatch, error) {
+	gstin := ""
+	if criteria.GSTIN != nil {
+		gstin = domain.NormalizeGSTIN(*criteria.GSTIN)
+	}
+	nameKey := fmt.Sprintf(vendorNameKeySQL, "v.vendor_name")
+	argKey := fmt.Sprintf(vendorNameKeySQL, "$4::text")
+
+	rows, err := r.conn(ctx).Query(ctx, `
+		SELECT v.id, v.vendor_code, v.vendor_name,
+			v.pan = $2 AS pan_match,
+			($3 <> '' AND UPPER(v.gstin) = $3) AS gstin_match,
+			(`+nameKey+` <> '' AND `+nameKey+` = `+argKey+`) AS name_match
+		FROM vendors v
+		WHERE v.tenant_id = $1
+			AND ($5::uuid IS NULL OR v.id <> $5)
+			AND NOT EXISTS (SELECT 1 FROM vendor_merges m WHERE m.merged_vendor_id = v.id)
+			AND (
+				v.pan = $2
+				OR ($3 <> '' AND UPPER(v.gstin) = $3)
+				OR (`+nameKey+` <> '' AND `+nameKey+` = `+argKey+`)
+			)
+		ORDER BY v.created_at ASC`,
+		tenantID, criteria.PAN, gstin, criteria.VendorName, criteria.ExcludeID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate vendors: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []domain.DuplicateMatch
+	for rows.Next() {
+		var (
+			m                               domain.DuplicateMatch
+			panMatch, gstinMatch, nameMatch bool
+		)
+		if err := rows.Scan(&m.VendorID, &m.VendorCode, &m.VendorName, &panMatch, &gstinMatch, &nameMatch); err != nil {
+			return nil, err
+		}
+		if panMatch {
+			m.MatchedOn = append(m.MatchedOn, domain.DuplicateOnPAN)
+		}
+		if gstinMatch {
+			m.MatchedOn = append(m.MatchedOn, domain.DuplicateOnGSTIN)
+		}
+		if nameMatch {
+			m.MatchedOn = append(m.MatchedOn, domain.DuplicateOnName)
+		}
+		matches = append(matches, m)
+	}
+	return matches, rows.Err()
+}
+
+// IsVendorMerged reports whether the vendor has been merged into another vendor
+func (r *vendorRepository) IsVendorMerged(ctx context.Context, vendorID uuid.UUID) (bool, error) {
+	var merged bool
+	err := r.conn(ctx).QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM vendor_merges WHERE merged_vendor_id = $1)`, vendorID,
+	).Scan(&merged)
+	return merged, err
+}
+
+// MergeVendors moves everything this service holds for the merged vendor to
+// the survivor: accounts, account change requests, MSME invoices, portal
+// users and invoices, TDS certificates and deductions. It also re-points
+// earlier merges into the merged vendor, deactivates the merged vendor and
+// records the merge, all in one transaction. Records other services hold are
+// re-pointed from the vendor.merged event.
+// merge.AccountsMoved is set to the number of accounts moved.
+func (r *vendorRepository) MergeVendors(ctx context.Context, merge *domain.VendorMerge) error {
+	return r.WithTransaction(ctx, func(ctx context.Context) error {
+		return r.mergeVendors(ctx, merge)
+	})
+}
+
+func (r *vendorRepository) mergeVendors(ctx context.Context, merge *domain.VendorMerge) error {
+	tx := r.conn(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE vendor_accounts SET vendor_id = $2, is_primary = FALSE, updated_at = NOW()
+		WHERE vendor_id = $1`, merge.MergedVendorID, merge.SurvivorVendorID)
+	if err != nil {
+		return fmt.Errorf("failed to move vendor accounts: %w", err)
+	}
+	merge.AccountsMoved = int(tag.RowsAffected())
+
+	// Invoice numbers are unique per vendor; moving a number the survivor
+	// already has would fail, so name the invoice for the user to resolve
+	for _, table := range []string{"msme_invoices", "vendor_invoices"} {
+		var number string
+		err := tx.QueryRow(ctx, `
+			SELECT m.invoice_number FROM `+table+` m
+			JOIN `+table+` s ON s.tenant_id = m.tenant_id AND s.invoice_number = m.invoice_number
+			WHERE m.vendor_id = $1 AND s.vendor_id = $2
+			LIMIT 1`, merge.MergedVendorID, merge.SurvivorVendorID).Scan(&number)
+		if err == nil {
+			return fmt.Errorf("%w: invoice %s", domain.ErrMergeInvoiceConflict, number)
+		}
+		if err != pgx.ErrNoRows {
+			return fmt.Errorf("failed to check %s: %w", table, err)
+		}
+	}
+
+	for _, table := range []string{
+		"vendor_account_change_requests",
+		"msme_invoices",
+		"vendor_users",
+		"vendor_invoices",
+		"tds_certificates",
+		"tds_deductions",
+	} {
+		if _, err := tx.Exec(ctx, `
+			UPDATE `+table+` SET vendor_id = $2
+			WHERE vendor_id = $1`, merge.MergedVendorID, merge.SurvivorVendorID); err != nil {
+			return fmt.Errorf("failed to move %s: %w", table, err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE vendor_merges SET survivor_vendor_id = $2
+		WHERE survivor_vendor_id = $1`, merge.MergedVendorID, merge.SurvivorVendorID); err != nil {
+		return fmt.Errorf("failed to re-point earlier merges: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE vendors SET status = 'INACTIVE', updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2`, merge.MergedVendorID, merge.TenantID); err != nil {
+		return fmt.Errorf("failed to deactivate merged vendor: %w", err)
+	}
+
+	tag, err = tx.Exec(ctx, `
+		INSERT INTO vendor_merges (
+			id, tenant_id, survivor_vendor_id, merged_vendor_id, merged_vendor_code,
+			merged_vendor_name, accounts_moved, reason, merged_by, merged_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (merged_vendor_id) DO NOTHING`,
+		merge.ID, merge.TenantID, merge.SurvivorVendorID, merge.MergedVendorID, merge.MergedVendorCode,
+		merge.MergedVendorName, merge.AccountsMoved, merge.Reason, merge.MergedBy, merge.MergedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record vendor merge: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVendorAlreadyMerged
+	}
+
+	return nil
+}
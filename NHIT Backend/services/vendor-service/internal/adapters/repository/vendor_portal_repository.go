@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const vendorInvoiceColumns = `id, tenant_id, vendor_id, invoice_number, invoice_date, invoice_amount,
+	po_number, description, status, green_note_id, payment_note_id, utr_number, paid_amount,
+	paid_at, rejection_reason, submitted_by, submitted_at, updated_at, org_id`
+
+// CreateVendorUser links a user to a vendor. A user can be linked to one vendor only.
+func (r *vendorRepository) CreateVendorUser(ctx context.Context, link *domain.VendorUser) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO vendor_users (id, tenant_id, vendor_id, user_id, linked_by, linked_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO NOTHING`,
+		link.ID, link.TenantID, link.VendorID, link.UserID, link.LinkedBy, link.LinkedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to link vendor user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVendorUserAlreadyLinked
+	}
+	return nil
+}
+
+// GetVendorUserByUserID returns the vendor link of a user
+func (r *vendorRepository) GetVendorUserByUserID(ctx context.Context, tenantID, userID uuid.UUID) (*domain.VendorUser, error) {
+	var link domain.VendorUser
+	err := r.conn(ctx).QueryRow(ctx, `
+		SELECT id, tenant_id, vendor_id, user_id, linked_by, linked_at
+		FROM vendor_users WHERE tenant_id = $1 AND user_id = $2`, tenantID, userID,
+	).Scan(&link.ID, &link.TenantID, &link.VendorID, &link.UserID, &link.LinkedBy, &link.LinkedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVendorUserNotLinked
+		}
+		return nil, err
+	}
+	return &link, nil
+}
+
+// ListVendorUsers returns the users linked to a vendor
+func (r *vendorRepository) ListVendorUsers(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.VendorUser, error) {
+	rows, err := r.conn(ctx).Query(ctx, `
+		SELECT id, tenant_id, vendor_id, user_id, linked_by, linked_at
+		FROM vendor_users WHERE tenant_id = $1 AND vendor_id = $2
+		ORDER BY linked_at`, tenantID, vendorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vendor users: %w", err)
+	}
+	defer rows.Close()
+
+	links := []*domain.VendorUser{}
+	for rows.Next() {
+		var link domain.VendorUser
+		if err := rows.Scan(&link.ID, &link.TenantID, &link.VendorID, &link.UserID, &link.LinkedBy, &link.LinkedAt); err != nil {
+			return nil, err
+		}
+		links = append(links, &link)
+	}
+	return links, rows.Err()
+}
+
+// DeleteVendorUser removes the vendor link of a user
+func (r *vendorRepository) DeleteVendorUser(ctx context.Context, tenantID, userID uuid.UUID) error {
+	tag, err := r.conn(ctx).Exec(ctx, `DELETE FROM vendor_users WHERE tenant_id = $1 AND user_id = $2`, tenantID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to unlink vendor user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVendorUserNotLinked
+	}
+	return nil
+}
+
+// CreateVendorInvoice stores a submitted invoice. An invoice number can be
+// submitted once per vendor.
+func (r *vendorRepository) CreateVendorInvoice(ctx context.Context, inv *domain.VendorInvoice) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO vendor_invoices (`+vendorInvoiceColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		ON CONFLICT (tenant_id, vendor_id, invoice_number) DO NOTHING`,
+		inv.ID, inv.TenantID, inv.VendorID, inv.InvoiceNumber, inv.InvoiceDate, inv.InvoiceAmount,
+		inv.PONumber, inv.Description, inv.Status, inv.GreenNoteID, inv.PaymentNoteID, inv.UTRNumber, inv.PaidAmount,
+		inv.PaidAt, inv.RejectionReason, inv.SubmittedBy, inv.SubmittedAt, inv.UpdatedAt, inv.OrgID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create vendor invoice: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVendorInvoiceExists
+	}
+	return nil
+}
+
+// GetVendorInvoice retrieves an invoice of the tenant
+func (r *vendorRepository) GetVendorInvoice(ctx context.Context, tenantID, invoiceID uuid.UUID) (*domain.VendorInvoice, error) {
+	row := r.conn(ctx).QueryRow(ctx, `SELECT `+vendorInvoiceColumns+`
+		FROM vendor_invoices WHERE id = $1 AND tenant_id = $2`, invoiceID, tenantID)
+	return scanVendorInvoice(row)
+}
+
+// ListVendorInvoices lists invoices of a tenant, newest first
+func (r *vendorRepository) ListVendorInvoices(ctx context.Context, tenantID uuid.UUID, filters ports.VendorInvoiceFilters) ([]*domain.VendorInvoice, int64, error) {
+	where := " WHERE tenant_id = $1"
+	args := []interface{}{tenantID}
+	argIdx := 2
+
+	if filters.VendorID != nil {
+		where += fmt.Sprintf(" AND vendor_id = $%d", argIdx)
+		args = append(args, *filters.VendorID)
+		argIdx++
+	}
+	if filters.Status != nil {
+		where += fmt.Sprintf(" AND status = $%d", argIdx)
+		args = append(args, *filters.Status)
+		argIdx++
+	}
+
+	var total int64
+	if err := r.conn(ctx).QueryRow(ctx, "SELECT COUNT(*) FROM vendor_invoices"+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count vendor invoices: %w", err)
+	}
+
+	query := "SELECT " + vendorInvoiceColumns + " FROM vendor_invoices" + where +
+		fmt.Sprintf(" ORDER BY submitted_at DESC LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+	args = append(args, filters.Limit, filters.Offset)
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list vendor invoices: %w", err)
+	}
+	defer rows.Close()
+
+	invoices := []*domain.VendorInvoice{}
+	for rows.Next() {
+		inv, err := scanVendorInvoice(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		invoices = append(invoices, inv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return invoices, total, nil
+}
+
+// UpdateVendorInvoiceStatus stores the status, note links and payment details of an invoice
+func (r *vendorRepository) UpdateVendorInvoiceStatus(ctx context.Context, inv *domain.VendorInvoice) error {
+	tag, err := r.conn(ctx).Exec(ctx, `
+		UPDATE vendor_invoices
+		SET status = $2, green_note_id = $3, payment_note_id = $4, utr_number = $5, paid_amount = $6,
+			paid_at = $7, rejection_reason = $8, updated_at = $9
+		WHERE id = $1`,
+		inv.ID, inv.Status, inv.GreenNoteID, inv.PaymentNoteID, inv.UTRNumber, inv.PaidAmount,
+		inv.PaidAt, inv.RejectionReason, inv.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update vendor invoice: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrVendorInvoiceNotFound
+	}
+	return nil
+}
+
+// CreateVendorInvoiceDocument records an uploaded supporting document
+func (r *vendorRepository) CreateVendorInvoiceDocument(ctx context.Context, doc *domain.VendorInvoiceDocument) error {
+	_, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO vendor_invoice_documents (id, invoice_id, file_name, content_type, size_bytes, object_path, uploaded_by, uploaded_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		doc.ID, doc.InvoiceID, doc.FileName, doc.ContentType, doc.SizeBytes, doc.ObjectPath, doc.UploadedBy, doc.UploadedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create invoice document: %w", err)
+	}
+	return nil
+}
+
+// GetVendorInvoiceDocuments returns the supporting documents of an invoice, oldest first
+func (r *vendorRepository) GetVendorInvoiceDocuments(ctx context.Context, invoiceID uuid.UUID) ([]*domain.VendorInvoiceDocument, error) {
+	rows, err := r.conn(ctx).Query(ctx, `
+		SELECT id, invoice_id, file_name, content_type, size_bytes, object_path, uploaded_by, uploaded_at
+		FROM vendor_invoice_documents WHERE invoice_id = $1
+		ORDER BY uploaded_at`, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoice documents: %w", err)
+	}
+	defer rows.Close()
+
+	docs := []*domain.VendorInvoiceDocument{}
+	for rows.Next() {
+		var doc domain.VendorInvoiceDocument
+		if err := rows.Scan(&doc.ID, &doc.InvoiceID, &doc.FileName, &doc.ContentType, &doc.SizeBytes,
+			&doc.ObjectPath, &doc.UploadedBy, &doc.UploadedAt); err != nil {
+			return nil, err
+		}
+		docs = append(docs, &doc)
+	}
+	return docs, rows.Err()
+}
+
+// scanVendorInvoice scans one vendor_invoices row selected with vendorInvoiceColumns
+func scanVendorInvoice(row pgx.Row) (*domain.VendorInvoice, error) {
+	var inv domain.VendorInvoice
+	err := row.Scan(
+		&inv.ID, &inv.TenantID, &inv.VendorID, &inv.InvoiceNumber, &inv.InvoiceDate, &inv.InvoiceAmount,
+		&inv.PONumber, &inv.Description, &inv.Status, &inv.GreenNoteID, &inv.PaymentNoteID, &inv.UTRNumber, &inv.PaidAmount,
+		&inv.PaidAt, &inv.RejectionReason, &inv.SubmittedBy, &inv.SubmittedAt, &inv.UpdatedAt, &inv.OrgID,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVendorInvoiceNotFound
+		}
+		return nil, err
+	}
+	return &inv, nil
+}
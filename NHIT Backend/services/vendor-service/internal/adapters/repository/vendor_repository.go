@@ -2,14 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/adapters/repository/sqlc/generated"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -18,18 +21,30 @@ import (
 type vendorRepository struct {
 	db      *pgxpool.Pool
 	queries *sqlc.Queries
+	crypt   *fieldcrypt.Encryptor
 }
 
-// NewVendorRepository creates a new vendor repository
-func NewVendorRepository(pool *pgxpool.Pool) ports.VendorRepository {
+// NewVendorRepository creates a new vendor repository.
+// Bank account numbers and IFSC codes are encrypted with crypt before they are stored.
+func NewVendorRepository(pool *pgxpool.Pool, crypt *fieldcrypt.Encryptor) ports.VendorRepository {
 	return &vendorRepository{
 		db:      pool,
 		queries: sqlc.New(pool),
+		crypt:   crypt,
 	}
 }
 
 // CreateVendor creates a new vendor
 func (r *vendorRepository) CreateVendor(ctx context.Context, vendor *domain.Vendor) error {
+	accountNumber, err := r.crypt.EncryptPtr(ctx, vendor.AccountNumber)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt account number: %w", err)
+	}
+	ifscCode, err := r.crypt.EncryptPtr(ctx, vendor.IFSCCode)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt IFSC code: %w", err)
+	}
+
 	codeAuto := vendor.CodeAutoGenerated
 	params := sqlc.CreateVendorParams{
 		ID:                     vendor.ID,
@@ -67,19 +82,19 @@ func (r *vendorRepository) CreateVendor(ctx context.Context, vendor *domain.Vend
 		Parent:                 vendor.Parent,
 		CodeAutoGenerated:      &codeAuto,
 		CreatedBy:              vendor.CreatedBy,
-		AccountNumber:          vendor.AccountNumber,
+		AccountNumber:          accountNumber,
 		NameOfBank:             vendor.NameOfBank,
-		IfscCode:               vendor.IFSCCode,
+		IfscCode:               ifscCode,
 		Address:                vendor.Address,
 		SignatureUrl:           vendor.SignatureURL,
 	}
 
-	return r.queries.CreateVendor(ctx, params)
+	return vendorCodeConflict(r.q(ctx).CreateVendor(ctx, params))
 }
 
 // GetVendorByID retrieves a vendor by ID
 func (r *vendorRepository) GetVendorByID(ctx context.Context, tenantID, vendorID uuid.UUID) (*domain.Vendor, error) {
-	vendor, err := r.queries.GetVendorByID(ctx, sqlc.GetVendorByIDParams{
+	vendor, err := r.q(ctx).GetVendorByID(ctx, sqlc.GetVendorByIDParams{
 		ID:       vendorID,
 		TenantID: tenantID,
 	})
@@ -90,12 +105,12 @@ func (r *vendorRepository) GetVendorByID(ctx context.Context, tenantID, vendorID
 		return nil, err
 	}
 
-	return toDomainVendor(vendor), nil
+	return r.decryptVendor(ctx, toDomainVendor(vendor))
 }
 
 // GetVendorByCode retrieves a vendor by code
 func (r *vendorRepository) GetVendorByCode(ctx context.Context, tenantID uuid.UUID, vendorCode string) (*domain.Vendor, error) {
-	vendor, err := r.queries.GetVendorByCode(ctx, sqlc.GetVendorByCodeParams{
+	vendor, err := r.q(ctx).GetVendorByCode(ctx, sqlc.GetVendorByCodeParams{
 		VendorCode: vendorCode,
 		TenantID:   tenantID,
 	})
@@ -106,12 +121,12 @@ func (r *vendorRepository) GetVendorByCode(ctx context.Context, tenantID uuid.UU
 		return nil, err
 	}
 
-	return toDomainVendor(vendor), nil
+	return r.decryptVendor(ctx, toDomainVendor(vendor))
 }
 
 // GetVendorByEmail retrieves a vendor by email
 func (r *vendorRepository) GetVendorByEmail(ctx context.Context, tenantID uuid.UUID, email string) (*domain.Vendor, error) {
-	vendor, err := r.queries.GetVendorByEmail(ctx, sqlc.GetVendorByEmailParams{
+	vendor, err := r.q(ctx).GetVendorByEmail(ctx, sqlc.GetVendorByEmailParams{
 		VendorEmail: email,
 		TenantID:    tenantID,
 	})
@@ -122,7 +137,7 @@ func (r *vendorRepository) GetVendorByEmail(ctx context.Context, tenantID uuid.U
 		return nil, err
 	}
 
-	return toDomainVendor(vendor), nil
+	return r.decryptVendor(ctx, toDomainVendor(vendor))
 }
 
 // UpdateVendor updates an existing vendor
@@ -157,14 +172,15 @@ func (r *vendorRepository) UpdateVendor(ctx context.Context, vendor *domain.Vend
 		FromAccountType:        vendor.FromAccountType,
 		ShortName:              vendor.ShortName,
 		Parent:                 vendor.Parent,
+		Gstin:                  vendor.GSTIN,
 	}
 
-	return r.queries.UpdateVendor(ctx, params)
+	return vendorCodeConflict(r.q(ctx).UpdateVendor(ctx, params))
 }
 
 // DeleteVendor deletes a vendor
 func (r *vendorRepository) DeleteVendor(ctx context.Context, tenantID, vendorID uuid.UUID) error {
-	return r.queries.DeleteVendor(ctx, sqlc.DeleteVendorParams{
+	return r.q(ctx).DeleteVendor(ctx, sqlc.DeleteVendorParams{
 		ID:       vendorID,
 		TenantID: tenantID,
 	})
@@ -213,7 +229,7 @@ func (r *vendorRepository) ListVendors(ctx context.Context, tenantID uuid.UUID,
 
 	var total int64
 	fmt.Printf("DEBUG: ListVendors Count Query: %s, Args: %v\n", countQuery, args)
-	err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total)
+	err := r.conn(ctx).QueryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		fmt.Printf("DEBUG: ListVendors Count Error: %v\n", err)
 		return nil, 0, fmt.Errorf("failed to count vendors: %w", err)
@@ -264,7 +280,7 @@ func (r *vendorRepository) ListVendors(ctx context.Context, tenantID uuid.UUID,
 	args = append(args, filters.Limit, filters.Offset)
 
     // Execute Query
-    rows, err := r.db.Query(ctx, listQuery, args...)
+    rows, err := r.conn(ctx).Query(ctx, listQuery, args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list vendors: %w", err)
 	}
@@ -286,7 +302,11 @@ func (r *vendorRepository) ListVendors(ctx context.Context, tenantID uuid.UUID,
 		); err != nil {
 			return nil, 0, err
 		}
-		domainVendors = append(domainVendors, toDomainVendor(&i))
+		v, err := r.decryptVendor(ctx, toDomainVendor(&i))
+		if err != nil {
+			return nil, 0, err
+		}
+		domainVendors = append(domainVendors, v)
 	}
 
 	return domainVendors, total, nil
@@ -298,8 +318,27 @@ func (r *vendorRepository) IsVendorCodeExists(ctx context.Context, tenantID uuid
 		VendorCode: code,
 		TenantID:   tenantID,
 	}
-	// Handle optional exclude ID
-	return r.queries.IsVendorCodeExists(ctx, params)
+	if excludeID != nil {
+		params.Column3 = pgtype.UUID{Bytes: *excludeID, Valid: true}
+	}
+	return r.q(ctx).IsVendorCodeExists(ctx, params)
+}
+
+// NextVendorCodeNumber increments the tenant's counter for baseCode
+func (r *vendorRepository) NextVendorCodeNumber(ctx context.Context, tenantID uuid.UUID, baseCode string) (int64, error) {
+	var n int64
+	err := r.conn(ctx).QueryRow(ctx, `
+		INSERT INTO vendor_code_sequences (tenant_id, base_code, last_value)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (tenant_id, base_code) DO UPDATE
+			SET last_value = vendor_code_sequences.last_value + 1
+		RETURNING last_value`,
+		tenantID, baseCode,
+	).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to draw vendor code number: %w", err)
+	}
+	return n, nil
 }
 
 // IsVendorEmailExists checks if vendor email exists
@@ -308,11 +347,20 @@ func (r *vendorRepository) IsVendorEmailExists(ctx context.Context, tenantID uui
 		VendorEmail: email,
 		TenantID:    tenantID,
 	}
-	return r.queries.IsVendorEmailExists(ctx, params)
+	return r.q(ctx).IsVendorEmailExists(ctx, params)
 }
 
-// WithTransaction executes a function within a transaction
+// txKey carries the transaction started by WithTransaction in the context
+type txKey struct{}
+
+// WithTransaction executes a function within a transaction. Repository calls
+// made with the context passed to fn run in that transaction; a nested call
+// joins the outer transaction.
 func (r *vendorRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -325,7 +373,7 @@ func (r *vendorRepository) WithTransaction(ctx context.Context, fn func(ctx cont
 		}
 	}()
 
-	if err := fn(ctx); err != nil {
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
 		tx.Rollback(ctx)
 		return err
 	}
@@ -333,6 +381,32 @@ func (r *vendorRepository) WithTransaction(ctx context.Context, fn func(ctx cont
 	return tx.Commit(ctx)
 }
 
+// conn returns the transaction of ctx, or the pool outside a transaction
+func (r *vendorRepository) conn(ctx context.Context) sqlc.DBTX {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return r.db
+}
+
+// vendorCodeConflict reports a clash on the tenant's vendor codes as
+// ErrVendorCodeExists
+func vendorCodeConflict(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "vendors_tenant_vendor_code_unique" {
+		return domain.ErrVendorCodeExists
+	}
+	return err
+}
+
+// q returns the queries bound to the transaction of ctx, if any
+func (r *vendorRepository) q(ctx context.Context) *sqlc.Queries {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return r.queries.WithTx(tx)
+	}
+	return r.queries
+}
+
 // Helper function to convert SQLC vendor to domain vendor
 func toDomainVendor(v *sqlc.Vendor) *domain.Vendor {
 	vendor := &domain.Vendor{
@@ -389,6 +463,18 @@ func toDomainVendor(v *sqlc.Vendor) *domain.Vendor {
 	return vendor
 }
 
+// decryptVendor replaces the stored bank fields of v with their plaintext.
+func (r *vendorRepository) decryptVendor(ctx context.Context, v *domain.Vendor) (*domain.Vendor, error) {
+	var err error
+	if v.AccountNumber, err = r.crypt.DecryptPtr(ctx, v.AccountNumber); err != nil {
+		return nil, fmt.Errorf("failed to decrypt account number: %w", err)
+	}
+	if v.IFSCCode, err = r.crypt.DecryptPtr(ctx, v.IFSCCode); err != nil {
+		return nil, fmt.Errorf("failed to decrypt IFSC code: %w", err)
+	}
+	return v, nil
+}
+
 // Helper functions
 func strPtr(s *string) string {
 	if s == nil {
@@ -441,52 +527,167 @@ func toNullVendorStatus(s string) sqlc.NullVendorStatusEnum {
 	}
 }
 
-// Vendor account methods (stub implementations for now - these need SQLC queries too)
 // CreateVendorAccount creates a vendor account
 func (r *vendorRepository) CreateVendorAccount(ctx context.Context, account *domain.VendorAccount) error {
+	accountNumber, err := r.crypt.Encrypt(ctx, account.AccountNumber)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt account number: %w", err)
+	}
+	ifscCode, err := r.crypt.Encrypt(ctx, account.IFSCCode)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt IFSC code: %w", err)
+	}
+	numberHash, err := r.crypt.BlindIndex(ctx, account.AccountNumber)
+	if err != nil {
+		return fmt.Errorf("failed to index account number: %w", err)
+	}
+
 	params := sqlc.CreateVendorAccountParams{
 		ID:            account.ID,
 		VendorID:      account.VendorID,
 		AccountName:   account.AccountName,
-		AccountNumber: account.AccountNumber,
+		AccountNumber: accountNumber,
 		AccountType:   account.AccountType,
 		NameOfBank:    account.NameOfBank,
 		BranchName:    account.BranchName,
-		IfscCode:      account.IFSCCode,
+		IfscCode:      ifscCode,
 		SwiftCode:     account.SwiftCode,
 		IsPrimary:     &account.IsPrimary,
 		IsActive:      &account.IsActive,
 		Remarks:       account.Remarks,
 		CreatedBy:     account.CreatedBy,
+
+		AccountNumberHash: &numberHash,
+		ApprovalStatus:    account.ApprovalStatus,
 	}
-    // Wait, check CreatedBy type in sqlc generated struct.
-    // In generated struct: CreatedBy string.
-    // In domain struct: CreatedBy uuid.UUID.
-    
-    // I need to confirm `account.CreatedBy` type. In `domain/vendor_new.go`:
-    // `CreatedBy             uuid.UUID`
-    
-    return r.queries.CreateVendorAccount(ctx, params)
+
+	return r.q(ctx).CreateVendorAccount(ctx, params)
 }
 
+// GetVendorAccountByID retrieves a vendor account by ID
 func (r *vendorRepository) GetVendorAccountByID(ctx context.Context, accountID uuid.UUID) (*domain.VendorAccount, error) {
-	return nil, fmt.Errorf("not implemented yet")
+	account, err := r.q(ctx).GetVendorAccountByID(ctx, accountID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVendorAccountNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomainVendorAccount(ctx, account)
 }
 
+// GetVendorAccountsByVendorID retrieves all accounts of a vendor
 func (r *vendorRepository) GetVendorAccountsByVendorID(ctx context.Context, vendorID uuid.UUID) ([]*domain.VendorAccount, error) {
-	return nil, fmt.Errorf("not implemented yet")
+	accounts, err := r.q(ctx).GetVendorAccountsByVendorID(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.VendorAccount, 0, len(accounts))
+	for _, a := range accounts {
+		account, err := r.toDomainVendorAccount(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, account)
+	}
+	return result, nil
 }
 
+// GetPrimaryVendorAccount retrieves the primary account of a vendor
 func (r *vendorRepository) GetPrimaryVendorAccount(ctx context.Context, vendorID uuid.UUID) (*domain.VendorAccount, error) {
-	return nil, fmt.Errorf("not implemented yet")
+	account, err := r.q(ctx).GetPrimaryVendorAccount(ctx, vendorID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVendorAccountNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomainVendorAccount(ctx, account)
 }
 
+// UpdateVendorAccount updates a vendor account
 func (r *vendorRepository) UpdateVendorAccount(ctx context.Context, account *domain.VendorAccount) error {
-	return fmt.Errorf("not implemented yet")
+	accountNumber, err := r.crypt.Encrypt(ctx, account.AccountNumber)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt account number: %w", err)
+	}
+	ifscCode, err := r.crypt.Encrypt(ctx, account.IFSCCode)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt IFSC code: %w", err)
+	}
+	numberHash, err := r.crypt.BlindIndex(ctx, account.AccountNumber)
+	if err != nil {
+		return fmt.Errorf("failed to index account number: %w", err)
+	}
+
+	return r.q(ctx).UpdateVendorAccount(ctx, sqlc.UpdateVendorAccountParams{
+		ID:            account.ID,
+		AccountName:   account.AccountName,
+		AccountNumber: accountNumber,
+		AccountType:   account.AccountType,
+		NameOfBank:    account.NameOfBank,
+		BranchName:    account.BranchName,
+		IfscCode:      ifscCode,
+		SwiftCode:     account.SwiftCode,
+		IsPrimary:     &account.IsPrimary,
+		IsActive:      &account.IsActive,
+		Remarks:       account.Remarks,
+
+		AccountNumberHash: &numberHash,
+		ApprovalStatus:    account.ApprovalStatus,
+		ApprovedBy:        toPgUUID(account.ApprovedBy),
+		ApprovedAt:        toPgTimestamp(account.ApprovedAt),
+		PayableFrom:       toPgTimestamp(account.PayableFrom),
+	})
 }
 
+// DeleteVendorAccount deletes a vendor account
 func (r *vendorRepository) DeleteVendorAccount(ctx context.Context, accountID uuid.UUID) error {
-	return fmt.Errorf("not implemented yet")
+	return r.q(ctx).DeleteVendorAccount(ctx, accountID)
+}
+
+// toDomainVendorAccount converts a SQLC vendor account to a domain vendor account,
+// decrypting the bank fields.
+func (r *vendorRepository) toDomainVendorAccount(ctx context.Context, a *sqlc.VendorAccount) (*domain.VendorAccount, error) {
+	accountNumber, err := r.crypt.Decrypt(ctx, a.AccountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt account number: %w", err)
+	}
+	ifscCode, err := r.crypt.Decrypt(ctx, a.IfscCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt IFSC code: %w", err)
+	}
+
+	account := &domain.VendorAccount{
+		ID:            a.ID,
+		VendorID:      a.VendorID,
+		AccountName:   a.AccountName,
+		AccountNumber: accountNumber,
+		AccountType:   a.AccountType,
+		NameOfBank:    a.NameOfBank,
+		BranchName:    a.BranchName,
+		IFSCCode:      ifscCode,
+		SwiftCode:     a.SwiftCode,
+		IsPrimary:     ptrBool(a.IsPrimary),
+		IsActive:      ptrBool(a.IsActive),
+		Remarks:       a.Remarks,
+		CreatedBy:     a.CreatedBy,
+
+		ApprovalStatus: a.ApprovalStatus,
+		ApprovedBy:     fromPgUUID(a.ApprovedBy),
+		ApprovedAt:     fromPgTimestamp(a.ApprovedAt),
+		PayableFrom:    fromPgTimestamp(a.PayableFrom),
+	}
+	if a.CreatedAt.Valid {
+		account.CreatedAt = a.CreatedAt.Time
+	}
+	if a.UpdatedAt.Valid {
+		account.UpdatedAt = a.UpdatedAt.Time
+	}
+	return account, nil
 }
 
 // UnsetPrimaryVendorAccounts unsets primary status for all accounts of a vendor
@@ -504,5 +705,5 @@ func (r *vendorRepository) UnsetPrimaryVendorAccounts(ctx context.Context, vendo
 		Column2:  excludeID,
 	}
 
-	return r.queries.UnsetPrimaryVendorAccounts(ctx, params)
+	return r.q(ctx).UnsetPrimaryVendorAccounts(ctx, params)
 }
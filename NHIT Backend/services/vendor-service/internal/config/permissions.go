@@ -1,5 +1,24 @@
 package config
 
+// RevealBankDetailsPermission allows a caller to see full bank account numbers
+// and IFSC codes; everyone else receives masked values.
+const RevealBankDetailsPermission = "reveal-bank-details"
+
+// ApproveVendorBankChangesPermission allows a caller to approve or reject
+// vendor bank account changes requested by another user.
+const ApproveVendorBankChangesPermission = "approve-vendor-bank-changes"
+
+// MergeVendorsPermission allows a caller to merge a duplicate vendor into another.
+const MergeVendorsPermission = "merge-vendors"
+
+// VendorPortalPermission allows a login linked to a vendor to use the vendor
+// self-service portal on behalf of that vendor.
+const VendorPortalPermission = "vendor-portal"
+
+// ManageTDSPermission allows a caller to maintain the TDS section master and
+// vendors' lower deduction certificates.
+const ManageTDSPermission = "manage-tds"
+
 // GetPermissionMap returns the permission requirements for each RPC method
 func GetPermissionMap() map[string][]string {
 	return map[string][]string{
@@ -24,6 +43,49 @@ func GetPermissionMap() map[string][]string {
 		"/vendor.VendorService/DeleteVendorAccount":     {"edit-vendors"},
 		"/vendor.VendorService/ToggleAccountStatus":     {"edit-vendors"},
 		
+		// Vendor bank account change approval
+		"/vendor.VendorService/ListVendorAccountChanges":   {"view-vendors"},
+		"/vendor.VendorService/ApproveVendorAccountChange": {ApproveVendorBankChangesPermission},
+		"/vendor.VendorService/RejectVendorAccountChange":  {ApproveVendorBankChangesPermission},
+		"/vendor.VendorService/VerifyPayeeAccount":         {"view-vendors"},
+		
+		// Duplicate detection and merging
+		"/vendor.VendorService/FindDuplicateVendors": {"view-vendors"},
+		"/vendor.VendorService/MergeVendors":         {MergeVendorsPermission},
+		
+		// Bulk import / export
+		"/vendor.VendorService/ImportVendors": {"create-vendors"},
+		"/vendor.VendorService/ExportVendors": {"view-vendors"},
+		
+		// MSME payment-due tracking
+		"/vendor.VendorService/RegisterMSMEInvoice":      {"edit-vendors"},
+		"/vendor.VendorService/RecordMSMEInvoicePayment": {"edit-vendors"},
+		"/vendor.VendorService/ListMSMEInvoices":         {"view-vendors"},
+		"/vendor.VendorService/GetMSMEOutstandingReport": {"view-vendors"},
+		
+		// Vendor self-service portal
+		"/vendor.VendorService/GetPortalProfile":            {VendorPortalPermission},
+		"/vendor.VendorService/UpdatePortalContact":         {VendorPortalPermission},
+		"/vendor.VendorService/SubmitPortalInvoice":         {VendorPortalPermission},
+		"/vendor.VendorService/UploadPortalInvoiceDocument": {VendorPortalPermission},
+		"/vendor.VendorService/ListPortalInvoices":          {VendorPortalPermission},
+		"/vendor.VendorService/GetPortalInvoice":            {VendorPortalPermission},
+		"/vendor.VendorService/LinkVendorUser":              {"edit-vendors"},
+		"/vendor.VendorService/UnlinkVendorUser":            {"edit-vendors"},
+		"/vendor.VendorService/ListVendorPortalUsers":       {"view-vendors"},
+		"/vendor.VendorService/ListVendorInvoices":          {"view-vendors"},
+		"/vendor.VendorService/UpdateVendorInvoiceStatus":   {"edit-vendors"},
+		
+		// TDS engine
+		"/vendor.VendorService/ListTDSSections":      {"view-vendors"},
+		"/vendor.VendorService/UpsertTDSSection":     {ManageTDSPermission},
+		"/vendor.VendorService/CreateTDSCertificate": {ManageTDSPermission},
+		"/vendor.VendorService/ListTDSCertificates":  {"view-vendors"},
+		"/vendor.VendorService/CalculateTDS":         {"view-vendors"},
+		"/vendor.VendorService/RecordTDSDeduction":   {"edit-vendors"},
+		"/vendor.VendorService/ReverseTDSDeduction":  {"edit-vendors"},
+		"/vendor.VendorService/GetVendorTDSSummary":  {"view-vendors"},
+		
 		// Dropdown operations
 		"/vendor.VendorService/GetProjectsDropdown":     {"view-vendors"},
 	}
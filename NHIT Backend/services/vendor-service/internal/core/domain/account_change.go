@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Vendor account approval states
+const (
+	AccountApprovalPending  = "PENDING"
+	AccountApprovalApproved = "APPROVED"
+	AccountApprovalRejected = "REJECTED"
+)
+
+// Kinds of bank account change that require approval
+const (
+	AccountChangeCreate     = "CREATE"
+	AccountChangeUpdate     = "UPDATE"
+	AccountChangeSetPrimary = "SET_PRIMARY"
+)
+
+// Change request states
+const (
+	ChangeStatusPending   = "PENDING"
+	ChangeStatusApproved  = "APPROVED"
+	ChangeStatusRejected  = "REJECTED"
+	ChangeStatusCancelled = "CANCELLED"
+)
+
+// BankDetailsSnapshot captures the bank details of an account before or after a change
+type BankDetailsSnapshot struct {
+	AccountName   string  `json:"account_name"`
+	AccountNumber string  `json:"account_number"`
+	AccountType   *string `json:"account_type,omitempty"`
+	NameOfBank    string  `json:"name_of_bank"`
+	BranchName    *string `json:"branch_name,omitempty"`
+	IFSCCode      string  `json:"ifsc_code"`
+	SwiftCode     *string `json:"swift_code,omitempty"`
+	IsPrimary     bool    `json:"is_primary"`
+}
+
+// AccountChangeRequest is a pending (maker) change to a vendor's bank account
+// that takes effect only once a different user (checker) approves it.
+type AccountChangeRequest struct {
+	ID            uuid.UUID            `json:"id"`
+	TenantID      uuid.UUID            `json:"tenant_id"`
+	VendorID      uuid.UUID            `json:"vendor_id"`
+	AccountID     uuid.UUID            `json:"account_id"`
+	ChangeType    string               `json:"change_type"`
+	OldValues     *BankDetailsSnapshot `json:"old_values,omitempty"`
+	NewValues     BankDetailsSnapshot  `json:"new_values"`
+	Status        string               `json:"status"`
+	RequestedBy   uuid.UUID            `json:"requested_by"`
+	RequestedAt   time.Time            `json:"requested_at"`
+	ReviewedBy    *uuid.UUID           `json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time           `json:"reviewed_at,omitempty"`
+	ReviewRemarks *string              `json:"review_remarks,omitempty"`
+}
+
+// NewAccountChangeRequest creates a pending change request for account
+func NewAccountChangeRequest(tenantID uuid.UUID, account *VendorAccount, changeType string, oldValues *BankDetailsSnapshot, newValues BankDetailsSnapshot, requestedBy uuid.UUID) (*AccountChangeRequest, error) {
+	if tenantID == uuid.Nil {
+		return nil, ErrInvalidTenantID
+	}
+	if requestedBy == uuid.Nil {
+		return nil, ErrInvalidCreatedBy
+	}
+
+	return &AccountChangeRequest{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		VendorID:    account.VendorID,
+		AccountID:   account.ID,
+		ChangeType:  changeType,
+		OldValues:   oldValues,
+		NewValues:   newValues,
+		Status:      ChangeStatusPending,
+		RequestedBy: requestedBy,
+		RequestedAt: time.Now(),
+	}, nil
+}
+
+// Review records the checker's decision. The requester may not review their own change.
+func (c *AccountChangeRequest) Review(reviewer uuid.UUID, approve bool, remarks *string) error {
+	if c.Status != ChangeStatusPending {
+		return ErrChangeNotPending
+	}
+	if reviewer == uuid.Nil {
+		return ErrInvalidCreatedBy
+	}
+	if reviewer == c.RequestedBy {
+		return ErrSelfApproval
+	}
+
+	now := time.Now()
+	c.Status = ChangeStatusRejected
+	if approve {
+		c.Status = ChangeStatusApproved
+	}
+	c.ReviewedBy = &reviewer
+	c.ReviewedAt = &now
+	c.ReviewRemarks = remarks
+	return nil
+}
+
+// ChangesDestination reports whether the change points payments at a different bank account
+func (c *AccountChangeRequest) ChangesDestination() bool {
+	if c.OldValues == nil {
+		return true
+	}
+	return c.OldValues.AccountNumber != c.NewValues.AccountNumber || c.OldValues.IFSCCode != c.NewValues.IFSCCode
+}
+
+// Snapshot returns the current bank details of the account
+func (va *VendorAccount) Snapshot() BankDetailsSnapshot {
+	return BankDetailsSnapshot{
+		AccountName:   va.AccountName,
+		AccountNumber: va.AccountNumber,
+		AccountType:   va.AccountType,
+		NameOfBank:    va.NameOfBank,
+		BranchName:    va.BranchName,
+		IFSCCode:      va.IFSCCode,
+		SwiftCode:     va.SwiftCode,
+		IsPrimary:     va.IsPrimary,
+	}
+}
+
+// ApplySnapshot overwrites the bank details of the account
+func (va *VendorAccount) ApplySnapshot(s BankDetailsSnapshot) {
+	va.AccountName = s.AccountName
+	va.AccountNumber = s.AccountNumber
+	va.AccountType = s.AccountType
+	va.NameOfBank = s.NameOfBank
+	va.BranchName = s.BranchName
+	va.IFSCCode = s.IFSCCode
+	va.SwiftCode = s.SwiftCode
+	va.UpdatedAt = time.Now()
+}
+
+// MarkPendingApproval puts a newly created account on hold until it is approved
+func (va *VendorAccount) MarkPendingApproval() {
+	va.ApprovalStatus = AccountApprovalPending
+	va.IsPrimary = false
+	va.ApprovedBy = nil
+	va.ApprovedAt = nil
+	va.PayableFrom = nil
+}
+
+// Approve marks the account approved; it becomes payable after coolingOff
+func (va *VendorAccount) Approve(approver uuid.UUID, coolingOff time.Duration) {
+	now := time.Now()
+	payableFrom := now.Add(coolingOff)
+	va.ApprovalStatus = AccountApprovalApproved
+	va.ApprovedBy = &approver
+	va.ApprovedAt = &now
+	va.PayableFrom = &payableFrom
+	va.UpdatedAt = now
+}
+
+// Reject marks a pending account rejected and deactivates it
+func (va *VendorAccount) Reject() {
+	va.ApprovalStatus = AccountApprovalRejected
+	va.IsActive = false
+	va.IsPrimary = false
+	va.UpdatedAt = time.Now()
+}
+
+// IsPayable reports whether payments may be made to the account at time now
+func (va *VendorAccount) IsPayable(now time.Time) bool {
+	if !va.IsActive || va.ApprovalStatus != AccountApprovalApproved {
+		return false
+	}
+	return va.PayableFrom == nil || !now.Before(*va.PayableFrom)
+}
+
+// HasBankDetailChanges reports whether params touch fields that need approval
+func (p UpdateVendorAccountParams) HasBankDetailChanges() bool {
+	return p.AccountName != nil || p.AccountNumber != nil || p.AccountType != nil ||
+		p.NameOfBank != nil || p.BranchName != nil || p.IFSCCode != nil || p.SwiftCode != nil
+}
+
+// ApplyTo returns snapshot s with the bank detail fields of params applied
+func (p UpdateVendorAccountParams) ApplyTo(s BankDetailsSnapshot) BankDetailsSnapshot {
+	if p.AccountName != nil {
+		s.AccountName = *p.AccountName
+	}
+	if p.AccountNumber != nil {
+		s.AccountNumber = *p.AccountNumber
+	}
+	if p.AccountType != nil {
+		s.AccountType = p.AccountType
+	}
+	if p.NameOfBank != nil {
+		s.NameOfBank = *p.NameOfBank
+	}
+	if p.BranchName != nil {
+		s.BranchName = p.BranchName
+	}
+	if p.IFSCCode != nil {
+		s.IFSCCode = *p.IFSCCode
+	}
+	if p.SwiftCode != nil {
+		s.SwiftCode = p.SwiftCode
+	}
+	return s
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/gstin"
+)
 
 // Domain errors for vendor business logic
 var (
@@ -15,6 +19,11 @@ var (
 	ErrInvalidPANFormat       = errors.New("invalid PAN format")
 	ErrInvalidBeneficiaryName = errors.New("invalid beneficiary name")
 	ErrInvalidCreatedBy       = errors.New("invalid created by user ID")
+	ErrInvalidGSTINFormat     = gstin.ErrInvalidFormat
+	ErrInvalidGSTINStateCode  = gstin.ErrInvalidStateCode
+	ErrInvalidGSTINEntityCode = gstin.ErrInvalidEntityCode
+	ErrInvalidGSTINChecksum   = gstin.ErrInvalidChecksum
+	ErrGSTINPANMismatch       = errors.New("GSTIN does not belong to the vendor PAN")
 	
 	// Account validation errors
 	ErrInvalidAccountID       = errors.New("invalid account ID")
@@ -31,7 +40,53 @@ var (
 	ErrVendorEmailExists      = errors.New("vendor email already exists")
 	ErrNoPrimaryAccount       = errors.New("no primary account found")
 	ErrCannotDeletePrimaryAccount = errors.New("cannot delete primary account without reassignment")
+	ErrDuplicateVendor        = errors.New("possible duplicate vendor")
+	ErrMergeSameVendor        = errors.New("cannot merge a vendor into itself")
+	ErrVendorAlreadyMerged    = errors.New("vendor has already been merged")
+	ErrMergeInvoiceConflict   = errors.New("both vendors have an invoice with the same number")
 	
+	// Bank account approval errors
+	ErrAccountChangeNotFound  = errors.New("account change request not found")
+	ErrPendingChangeExists    = errors.New("account already has a pending change request")
+	ErrChangeNotPending       = errors.New("account change request is not pending")
+	ErrSelfApproval           = errors.New("a change cannot be approved by the user who requested it")
+	ErrAccountNotApproved     = errors.New("vendor account is not approved")
+
+	// MSME payment tracking errors
+	ErrVendorNotMSME          = errors.New("vendor is not a registered micro or small enterprise on the invoice date")
+	ErrInvalidInvoiceNumber   = errors.New("invalid invoice number")
+	ErrInvalidInvoiceAmount   = errors.New("invoice amount must be positive")
+	ErrInvalidInvoiceDates    = errors.New("acceptance and payment dates cannot be before the invoice date")
+	ErrInvalidCreditDays      = errors.New("agreed credit days cannot be negative")
+	ErrMSMEInvoiceNotFound    = errors.New("MSME invoice not found")
+	ErrMSMEInvoiceExists      = errors.New("invoice is already tracked for this vendor")
+	ErrMSMEInvoiceAlreadyPaid = errors.New("MSME invoice is already paid")
+	ErrInvalidReportPeriod    = errors.New("report period cannot start after its as-of date")
+
+	// Vendor portal errors
+	ErrVendorUserNotLinked      = errors.New("user is not linked to a vendor")
+	ErrVendorUserAlreadyLinked  = errors.New("user is already linked to a vendor")
+	ErrVendorInvoiceNotFound    = errors.New("vendor invoice not found")
+	ErrVendorInvoiceExists      = errors.New("invoice number already submitted")
+	ErrInvalidInvoiceDate       = errors.New("invoice date is required and cannot be in the future")
+	ErrInvalidInvoiceTransition = errors.New("invalid invoice status transition")
+	ErrUTRRequired              = errors.New("UTR number is required to mark an invoice paid")
+	ErrInvalidInvoiceDocument   = errors.New("supporting documents must be PDF, PNG or JPEG files of at most 10 MB")
+	ErrInvoiceClosed            = errors.New("invoice no longer accepts documents")
+
+	// TDS errors
+	ErrInvalidTDSSection     = errors.New("TDS section code is required")
+	ErrInvalidTDSRate        = errors.New("TDS rates must be between 0 and 100 percent")
+	ErrInvalidTDSThreshold   = errors.New("TDS thresholds cannot be negative, and deduction on excess needs an annual threshold")
+	ErrInvalidTDSCertificate = errors.New("certificate needs a number, a positive amount limit and a valid period")
+	ErrInvalidFinancialYear  = errors.New("financial year must look like 2026-27")
+	ErrTDSSectionNotFound    = errors.New("TDS section not found")
+	ErrTDSSectionInactive    = errors.New("TDS section is inactive")
+	ErrTDSCertificateExists  = errors.New("certificate number is already recorded")
+	ErrTDSDeductionExists    = errors.New("a TDS deduction is already recorded for this reference")
+	ErrTDSDeductionNotFound  = errors.New("TDS deduction not found")
+	ErrInvalidTDSReference   = errors.New("TDS deduction reference is required")
+
 	// Transaction errors
 	ErrTransactionFailed      = errors.New("transaction failed")
 	ErrDatabaseConnection     = errors.New("database connection error")
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/gstin"
+)
+
+// NormalizeGSTIN upper-cases a GSTIN and strips surrounding whitespace
+func NormalizeGSTIN(number string) string {
+	return gstin.Normalize(number)
+}
+
+// ValidateGSTIN checks format, state code, entity code and check character of
+// a GSTIN. When pan is not empty the PAN embedded in the GSTIN must match it.
+func ValidateGSTIN(number, pan string) error {
+	number = gstin.Normalize(number)
+	if err := gstin.Validate(number); err != nil {
+		return err
+	}
+	if pan != "" && gstin.PAN(number) != strings.ToUpper(strings.TrimSpace(pan)) {
+		return ErrGSTINPANMismatch
+	}
+	return nil
+}
+
+// StateFromGSTIN returns the state name encoded in a GSTIN's first two digits
+func StateFromGSTIN(number string) (string, bool) {
+	return gstin.State(number)
+}
+
+// fillStateFromGSTIN sets state to the GSTIN's state when it is empty
+func fillStateFromGSTIN(state *string, number *string) *string {
+	if number == nil || *number == "" || (state != nil && strings.TrimSpace(*state) != "") {
+		return state
+	}
+	if name, ok := StateFromGSTIN(*number); ok {
+		return &name
+	}
+	return state
+}
+
+// Values stored in Vendor.GSTDefaulted and Vendor.Section206ABVerified
+const (
+	ComplianceFlagYes = "Yes"
+	ComplianceFlagNo  = "No"
+)
+
+// ApplyCompliance records the outcome of a compliance lookup. A nil result
+// leaves the current flag untouched.
+func (v *Vendor) ApplyCompliance(gstDefaulted, section206ABVerified *bool) {
+	if gstDefaulted != nil {
+		v.GSTDefaulted = complianceFlag(*gstDefaulted)
+	}
+	if section206ABVerified != nil {
+		v.Section206ABVerified = complianceFlag(*section206ABVerified)
+	}
+}
+
+func complianceFlag(b bool) *string {
+	flag := ComplianceFlagNo
+	if b {
+		flag = ComplianceFlagYes
+	}
+	return &flag
+}
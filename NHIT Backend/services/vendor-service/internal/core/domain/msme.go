@@ -0,0 +1,413 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Payment terms for micro and small enterprise suppliers under the MSMED Act, 2006
+const (
+	// MSMEDefaultCreditDays applies when there is no written agreement (Section 15)
+	MSMEDefaultCreditDays = 15
+	// MSMEMaxCreditDays caps any agreed credit period (Section 15)
+	MSMEMaxCreditDays = 45
+	// MSMEInterestMultiplier times the RBI bank rate is the rate of interest on late payments (Section 16)
+	MSMEInterestMultiplier = 3
+)
+
+// MSME invoice payment states. OPEN, AT_RISK and OVERDUE are unpaid.
+const (
+	MSMEInvoiceOpen     = "OPEN"
+	MSMEInvoiceAtRisk   = "AT_RISK"
+	MSMEInvoiceOverdue  = "OVERDUE"
+	MSMEInvoicePaid     = "PAID"
+	MSMEInvoicePaidLate = "PAID_LATE"
+)
+
+// MSMEPolicy holds the tenant-independent inputs of MSME due date tracking
+type MSMEPolicy struct {
+	// BankRate is the RBI bank rate in percent per annum
+	BankRate float64
+	// AtRiskDays is how many days before the due date an unpaid invoice is flagged at risk
+	AtRiskDays int
+}
+
+// IsMSMESupplier reports whether v is a registered micro or small enterprise
+// on the given date. Medium enterprises are not covered by Section 15.
+func IsMSMESupplier(v *Vendor, on time.Time) bool {
+	switch strings.ToUpper(v.MSMEClassification) {
+	case "MICRO", "SMALL":
+	default:
+		return false
+	}
+	if v.MSMERegistrationNumber == nil || strings.TrimSpace(*v.MSMERegistrationNumber) == "" {
+		return false
+	}
+	day := dateOnly(on)
+	if v.MSMEStartDate != nil && day.Before(dateOnly(*v.MSMEStartDate)) {
+		return false
+	}
+	if v.MSMEEndDate != nil && day.After(dateOnly(*v.MSMEEndDate)) {
+		return false
+	}
+	return true
+}
+
+// MSMEDueDate returns the last day on which an MSME invoice may be paid
+// without interest. The period runs from the day of acceptance (the invoice
+// date when acceptance is not recorded) and is agreedCreditDays, capped at 45,
+// or 15 days without an agreement.
+func MSMEDueDate(invoiceDate time.Time, acceptanceDate *time.Time, agreedCreditDays *int) time.Time {
+	start := dateOnly(invoiceDate)
+	if acceptanceDate != nil {
+		start = dateOnly(*acceptanceDate)
+	}
+
+	days := MSMEDefaultCreditDays
+	if agreedCreditDays != nil {
+		days = *agreedCreditDays
+		if days > MSMEMaxCreditDays {
+			days = MSMEMaxCreditDays
+		}
+	}
+	return start.AddDate(0, 0, days)
+}
+
+// MSMEHalfYearStart returns the first day of the MSME-1 return period
+// (April-September or October-March) containing t
+func MSMEHalfYearStart(t time.Time) time.Time {
+	year, month := t.Year(), t.Month()
+	switch {
+	case month >= time.October:
+		return time.Date(year, time.October, 1, 0, 0, 0, 0, time.UTC)
+	case month >= time.April:
+		return time.Date(year, time.April, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year-1, time.October, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// MSMEInvoice is an invoice of a micro or small enterprise vendor tracked for
+// payment within the statutory period. Vendor details are captured when the
+// invoice is registered, as the MSME-1 return reports them as of that time.
+type MSMEInvoice struct {
+	ID                     uuid.UUID    `json:"id"`
+	TenantID               uuid.UUID    `json:"tenant_id"`
+	VendorID               uuid.UUID    `json:"vendor_id"`
+	VendorCode             string       `json:"vendor_code"`
+	VendorName             string       `json:"vendor_name"`
+	MSMEClassification     string       `json:"msme_classification"`
+	MSMERegistrationNumber string       `json:"msme_registration_number"`
+	InvoiceNumber          string       `json:"invoice_number"`
+	InvoiceDate            time.Time    `json:"invoice_date"`
+	AcceptanceDate         *time.Time   `json:"acceptance_date,omitempty"`
+	AgreedCreditDays       *int         `json:"agreed_credit_days,omitempty"`
+	InvoiceAmount          money.Amount `json:"invoice_amount"`
+	DueDate                time.Time    `json:"due_date"`
+	GreenNoteID            *string      `json:"green_note_id,omitempty"`
+	PaymentNoteID          *string      `json:"payment_note_id,omitempty"`
+	PaidDate               *time.Time   `json:"paid_date,omitempty"`
+	PaymentReference       *string      `json:"payment_reference,omitempty"`
+	// FlaggedStatus is the last status announced to the note services
+	FlaggedStatus string    `json:"flagged_status"`
+	CreatedBy     uuid.UUID `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// RegisterMSMEInvoiceParams represents parameters for tracking an MSME invoice
+type RegisterMSMEInvoiceParams struct {
+	TenantID         uuid.UUID
+	VendorID         uuid.UUID
+	InvoiceNumber    string
+	InvoiceDate      time.Time
+	AcceptanceDate   *time.Time
+	AgreedCreditDays *int
+	InvoiceAmount    money.Amount
+	GreenNoteID      *string
+	PaymentNoteID    *string
+	CreatedBy        uuid.UUID
+}
+
+// NewMSMEInvoice creates a tracked invoice for vendor, which must be a
+// registered micro or small enterprise on the invoice date
+func NewMSMEInvoice(vendor *Vendor, params RegisterMSMEInvoiceParams) (*MSMEInvoice, error) {
+	if params.TenantID == uuid.Nil {
+		return nil, ErrInvalidTenantID
+	}
+	if params.CreatedBy == uuid.Nil {
+		return nil, ErrInvalidCreatedBy
+	}
+	invoiceNumber := strings.TrimSpace(params.InvoiceNumber)
+	if invoiceNumber == "" {
+		return nil, ErrInvalidInvoiceNumber
+	}
+	if params.InvoiceAmount.Sign() <= 0 {
+		return nil, ErrInvalidInvoiceAmount
+	}
+	if params.AcceptanceDate != nil && dateOnly(*params.AcceptanceDate).Before(dateOnly(params.InvoiceDate)) {
+		return nil, ErrInvalidInvoiceDates
+	}
+	if params.AgreedCreditDays != nil && *params.AgreedCreditDays < 0 {
+		return nil, ErrInvalidCreditDays
+	}
+	if !IsMSMESupplier(vendor, params.InvoiceDate) {
+		return nil, ErrVendorNotMSME
+	}
+
+	now := time.Now()
+	invoice := &MSMEInvoice{
+		ID:                     uuid.New(),
+		TenantID:               params.TenantID,
+		VendorID:               vendor.ID,
+		VendorCode:             vendor.VendorCode,
+		VendorName:             vendor.VendorName,
+		MSMEClassification:     strings.ToUpper(vendor.MSMEClassification),
+		MSMERegistrationNumber: strings.TrimSpace(*vendor.MSMERegistrationNumber),
+		InvoiceNumber:          invoiceNumber,
+		InvoiceDate:            dateOnly(params.InvoiceDate),
+		AgreedCreditDays:       params.AgreedCreditDays,
+		InvoiceAmount:          params.InvoiceAmount,
+		DueDate:                MSMEDueDate(params.InvoiceDate, params.AcceptanceDate, params.AgreedCreditDays),
+		GreenNoteID:            params.GreenNoteID,
+		PaymentNoteID:          params.PaymentNoteID,
+		FlaggedStatus:          MSMEInvoiceOpen,
+		CreatedBy:              params.CreatedBy,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}
+	if params.AcceptanceDate != nil {
+		acceptance := dateOnly(*params.AcceptanceDate)
+		invoice.AcceptanceDate = &acceptance
+	}
+	return invoice, nil
+}
+
+// MarkPaid records settlement of the invoice
+func (i *MSMEInvoice) MarkPaid(paidDate time.Time, paymentNoteID, reference *string) error {
+	if i.PaidDate != nil {
+		return ErrMSMEInvoiceAlreadyPaid
+	}
+	paid := dateOnly(paidDate)
+	if paid.Before(i.InvoiceDate) {
+		return ErrInvalidInvoiceDates
+	}
+
+	i.PaidDate = &paid
+	if paymentNoteID != nil {
+		i.PaymentNoteID = paymentNoteID
+	}
+	i.PaymentReference = reference
+	i.UpdatedAt = time.Now()
+	return nil
+}
+
+// Status returns the payment state of the invoice on asOf
+func (i *MSMEInvoice) Status(asOf time.Time, atRiskDays int) string {
+	if i.PaidDate != nil {
+		if i.PaidDate.After(i.DueDate) {
+			return MSMEInvoicePaidLate
+		}
+		return MSMEInvoicePaid
+	}
+
+	today := dateOnly(asOf)
+	switch {
+	case today.After(i.DueDate):
+		return MSMEInvoiceOverdue
+	case !today.AddDate(0, 0, atRiskDays).Before(i.DueDate):
+		return MSMEInvoiceAtRisk
+	default:
+		return MSMEInvoiceOpen
+	}
+}
+
+// DaysPastDue returns the days between the due date and payment (or asOf
+// while unpaid); negative values are days left before the due date
+func (i *MSMEInvoice) DaysPastDue(asOf time.Time) int {
+	end := dateOnly(asOf)
+	if i.PaidDate != nil {
+		end = *i.PaidDate
+	}
+	return int(end.Sub(i.DueDate).Hours() / 24)
+}
+
+// Interest returns the interest payable on the invoice under Section 16:
+// compound interest with monthly rests at three times bankRate (percent per
+// annum), from the day after the due date until payment, or asOf while unpaid.
+// Days after the last full month accrue simple interest. The growth factor is
+// exact; only the interest itself is rounded to paise. It fails with
+// money.ErrOverflow when the interest is too large for an amount.
+func (i *MSMEInvoice) Interest(asOf time.Time, bankRate float64) (money.Amount, error) {
+	end := dateOnly(asOf)
+	if i.PaidDate != nil {
+		end = *i.PaidDate
+	}
+	if !end.After(i.DueDate) || bankRate <= 0 {
+		return money.Zero, nil
+	}
+
+	annual := money.Rate(bankRate * MSMEInterestMultiplier)
+	months := 0
+	for !i.DueDate.AddDate(0, months+1, 0).After(end) {
+		months++
+	}
+	restDays := int64(end.Sub(i.DueDate.AddDate(0, months, 0)).Hours() / 24)
+
+	one := big.NewRat(1, 1)
+	monthly := new(big.Rat).Add(one, new(big.Rat).Quo(annual, big.NewRat(12, 1)))
+	factor := new(big.Rat).Set(one)
+	for m := 0; m < months; m++ {
+		factor.Mul(factor, monthly)
+	}
+	simple := new(big.Rat).Mul(annual, big.NewRat(restDays, 365))
+	factor.Mul(factor, simple.Add(simple, one))
+	interest, err := i.InvoiceAmount.MulRat(factor.Sub(factor, one), money.HalfUp)
+	if err != nil {
+		return money.Zero, fmt.Errorf("interest on invoice %s: %w", i.InvoiceNumber, err)
+	}
+	return interest, nil
+}
+
+// MSMEVendorOutstanding sums a vendor's MSME invoices for the outstanding report
+type MSMEVendorOutstanding struct {
+	VendorID               uuid.UUID    `json:"vendor_id"`
+	VendorCode             string       `json:"vendor_code"`
+	VendorName             string       `json:"vendor_name"`
+	MSMEClassification     string       `json:"msme_classification"`
+	MSMERegistrationNumber string       `json:"msme_registration_number"`
+	OutstandingInvoices    int          `json:"outstanding_invoices"`
+	OutstandingAmount      money.Amount `json:"outstanding_amount"`
+	OverdueInvoices        int          `json:"overdue_invoices"`
+	OverdueAmount          money.Amount `json:"overdue_amount"`
+	PaidLateInvoices       int          `json:"paid_late_invoices"`
+	PaidLateAmount         money.Amount `json:"paid_late_amount"`
+	InterestLiability      money.Amount `json:"interest_liability"`
+	OldestDueDate          *time.Time   `json:"oldest_due_date,omitempty"`
+}
+
+// MSMEOutstandingReport lists, per vendor, MSME invoices unpaid on AsOf and
+// invoices paid late between PeriodStart and AsOf, with the interest owed on
+// them. It carries the figures of the half-yearly MSME-1 return.
+type MSMEOutstandingReport struct {
+	AsOf                   time.Time               `json:"as_of"`
+	PeriodStart            time.Time               `json:"period_start"`
+	BankRate               float64                 `json:"bank_rate"`
+	Vendors                []MSMEVendorOutstanding `json:"vendors"`
+	TotalOutstandingAmount money.Amount            `json:"total_outstanding_amount"`
+	TotalOverdueAmount     money.Amount            `json:"total_overdue_amount"`
+	TotalPaidLateAmount    money.Amount            `json:"total_paid_late_amount"`
+	TotalInterestLiability money.Amount            `json:"total_interest_liability"`
+}
+
+// NewMSMEOutstandingReport builds the report from the invoices that were
+// unpaid on asOf or paid late within the period. Invoices paid after asOf
+// count as unpaid, so past periods can be reproduced. It fails with
+// money.ErrOverflow when a total is too large for an amount.
+func NewMSMEOutstandingReport(invoices []*MSMEInvoice, asOf, periodStart time.Time, policy MSMEPolicy) (*MSMEOutstandingReport, error) {
+	asOf, periodStart = dateOnly(asOf), dateOnly(periodStart)
+	report := &MSMEOutstandingReport{
+		AsOf:        asOf,
+		PeriodStart: periodStart,
+		BankRate:    policy.BankRate,
+		Vendors:     []MSMEVendorOutstanding{},
+	}
+
+	byVendor := map[uuid.UUID]*MSMEVendorOutstanding{}
+	for _, inv := range invoices {
+		if inv.InvoiceDate.After(asOf) {
+			continue
+		}
+
+		// View the invoice as it stood on asOf
+		view := *inv
+		if view.PaidDate != nil && view.PaidDate.After(asOf) {
+			view.PaidDate = nil
+		}
+		unpaid := view.PaidDate == nil
+		paidLateInPeriod := !unpaid && view.PaidDate.After(view.DueDate) && !view.PaidDate.Before(periodStart)
+		if !unpaid && !paidLateInPeriod {
+			continue
+		}
+
+		row, ok := byVendor[inv.VendorID]
+		if !ok {
+			row = &MSMEVendorOutstanding{
+				VendorID:               inv.VendorID,
+				VendorCode:             inv.VendorCode,
+				VendorName:             inv.VendorName,
+				MSMEClassification:     inv.MSMEClassification,
+				MSMERegistrationNumber: inv.MSMERegistrationNumber,
+			}
+			byVendor[inv.VendorID] = row
+		}
+
+		interest, err := view.Interest(asOf, policy.BankRate)
+		if err != nil {
+			return nil, err
+		}
+		if err := accumulate(interest, &row.InterestLiability, &report.TotalInterestLiability); err != nil {
+			return nil, err
+		}
+
+		if !unpaid {
+			row.PaidLateInvoices++
+			if err := accumulate(view.InvoiceAmount, &row.PaidLateAmount, &report.TotalPaidLateAmount); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		row.OutstandingInvoices++
+		if err := accumulate(view.InvoiceAmount, &row.OutstandingAmount, &report.TotalOutstandingAmount); err != nil {
+			return nil, err
+		}
+		if asOf.After(view.DueDate) {
+			row.OverdueInvoices++
+			if err := accumulate(view.InvoiceAmount, &row.OverdueAmount, &report.TotalOverdueAmount); err != nil {
+				return nil, err
+			}
+		}
+		if row.OldestDueDate == nil || view.DueDate.Before(*row.OldestDueDate) {
+			due := view.DueDate
+			row.OldestDueDate = &due
+		}
+	}
+
+	for _, row := range byVendor {
+		report.Vendors = append(report.Vendors, *row)
+	}
+	// Largest overdue exposure first
+	sort.Slice(report.Vendors, func(a, b int) bool {
+		va, vb := report.Vendors[a], report.Vendors[b]
+		if c := va.OverdueAmount.Cmp(vb.OverdueAmount); c != 0 {
+			return c > 0
+		}
+		return va.VendorCode < vb.VendorCode
+	})
+	return report, nil
+}
+
+// accumulate adds a to each of totals
+func accumulate(a money.Amount, totals ...*money.Amount) error {
+	for _, total := range totals {
+		sum, err := total.AddChecked(a)
+		if err != nil {
+			return fmt.Errorf("msme report totals: %w", err)
+		}
+		*total = sum
+	}
+	return nil
+}
+
+// dateOnly truncates t to midnight UTC of its calendar day
+func dateOnly(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,332 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Rules a TDS computation can apply
+const (
+	TDSRuleBelowThreshold = "BELOW_THRESHOLD"
+	TDSRuleNormal         = "NORMAL"
+	TDSRuleNoPAN          = "NO_PAN_206AA"
+	TDSRuleNonFiler       = "NON_FILER_206AB"
+	TDSRuleCertificate    = "LOWER_DEDUCTION_CERTIFICATE"
+)
+
+// Section 206AA rate floor for deductees without a valid PAN, used when a
+// section does not set its own
+const TDSDefaultNoPANRate = 20
+
+// Section 206AB: non-filers suffer twice the section rate, at least 5%
+const (
+	tdsNonFilerMultiplier = 2
+	tdsNonFilerMinRate    = 5
+)
+
+// TDSSection is an entry of the TDS section master (194C, 194J, ...).
+// Rates are percentages; a zero threshold means the section has none.
+type TDSSection struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	// RateIndividual applies to individuals and HUFs, RateOther to everyone else
+	RateIndividual float64 `json:"rate_individual"`
+	RateOther      float64 `json:"rate_other"`
+	// RateNoPAN is the Section 206AA floor when the vendor has no valid PAN
+	RateNoPAN float64 `json:"rate_no_pan"`
+	// SingleThreshold is the largest payment exempt on its own
+	SingleThreshold money.Amount `json:"single_threshold"`
+	// AnnualThreshold is the largest aggregate per vendor per financial year exempt
+	AnnualThreshold money.Amount `json:"annual_threshold"`
+	// DeductOnExcess limits TDS to the aggregate above AnnualThreshold (194Q);
+	// otherwise crossing the threshold makes the whole aggregate taxable
+	DeductOnExcess bool       `json:"deduct_on_excess"`
+	IsActive       bool       `json:"is_active"`
+	UpdatedBy      *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Validate checks the section code, rates and thresholds
+func (s *TDSSection) Validate() error {
+	s.Code = strings.ToUpper(strings.TrimSpace(s.Code))
+	if s.Code == "" {
+		return ErrInvalidTDSSection
+	}
+	for _, rate := range []float64{s.RateIndividual, s.RateOther, s.RateNoPAN} {
+		if rate < 0 || rate > 100 {
+			return ErrInvalidTDSRate
+		}
+	}
+	if s.SingleThreshold.IsNegative() || s.AnnualThreshold.IsNegative() {
+		return ErrInvalidTDSThreshold
+	}
+	if s.DeductOnExcess && s.AnnualThreshold.IsZero() {
+		return ErrInvalidTDSThreshold
+	}
+	if s.RateNoPAN == 0 {
+		s.RateNoPAN = TDSDefaultNoPANRate
+	}
+	return nil
+}
+
+// TDSCertificate is a Section 197 lower or nil deduction certificate issued
+// to a vendor for one section, valid for a period up to an amount
+type TDSCertificate struct {
+	ID                uuid.UUID `json:"id"`
+	TenantID          uuid.UUID `json:"tenant_id"`
+	VendorID          uuid.UUID `json:"vendor_id"`
+	SectionCode       string    `json:"section_code"`
+	CertificateNumber string    `json:"certificate_number"`
+	// Rate is the certificate rate; 0 for a nil deduction certificate
+	Rate        float64      `json:"rate"`
+	ValidFrom   time.Time    `json:"valid_from"`
+	ValidTo     time.Time    `json:"valid_to"`
+	AmountLimit money.Amount `json:"amount_limit"`
+	// AmountUsed is the part of AmountLimit already deducted at the certificate rate
+	AmountUsed money.Amount `json:"amount_used"`
+	CreatedBy  uuid.UUID    `json:"created_by"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// CreateTDSCertificateParams holds parameters for recording a certificate
+type CreateTDSCertificateParams struct {
+	TenantID          uuid.UUID
+	VendorID          uuid.UUID
+	SectionCode       string
+	CertificateNumber string
+	Rate              float64
+	ValidFrom         time.Time
+	ValidTo           time.Time
+	AmountLimit       money.Amount
+	CreatedBy         uuid.UUID
+}
+
+// NewTDSCertificate validates and creates a certificate
+func NewTDSCertificate(params CreateTDSCertificateParams) (*TDSCertificate, error) {
+	if params.TenantID == uuid.Nil {
+		return nil, ErrInvalidTenantID
+	}
+	if params.VendorID == uuid.Nil {
+		return nil, ErrInvalidVendorID
+	}
+	if params.CreatedBy == uuid.Nil {
+		return nil, ErrInvalidCreatedBy
+	}
+	number := strings.ToUpper(strings.TrimSpace(params.CertificateNumber))
+	if number == "" {
+		return nil, ErrInvalidTDSCertificate
+	}
+	if params.Rate < 0 || params.Rate > 100 {
+		return nil, ErrInvalidTDSRate
+	}
+	if params.AmountLimit.Sign() <= 0 {
+		return nil, ErrInvalidTDSCertificate
+	}
+	validFrom, validTo := dateOnly(params.ValidFrom), dateOnly(params.ValidTo)
+	if params.ValidFrom.IsZero() || params.ValidTo.IsZero() || validTo.Before(validFrom) {
+		return nil, ErrInvalidTDSCertificate
+	}
+
+	return &TDSCertificate{
+		ID:                uuid.New(),
+		TenantID:          params.TenantID,
+		VendorID:          params.VendorID,
+		SectionCode:       strings.ToUpper(strings.TrimSpace(params.SectionCode)),
+		CertificateNumber: number,
+		Rate:              params.Rate,
+		ValidFrom:         validFrom,
+		ValidTo:           validTo,
+		AmountLimit:       params.AmountLimit,
+		CreatedBy:         params.CreatedBy,
+		CreatedAt:         time.Now(),
+	}, nil
+}
+
+// ValidOn reports whether the certificate covers payments made on date
+func (c *TDSCertificate) ValidOn(date time.Time) bool {
+	d := dateOnly(date)
+	return !d.Before(c.ValidFrom) && !d.After(c.ValidTo)
+}
+
+// Remaining is the amount the certificate can still cover
+func (c *TDSCertificate) Remaining() money.Amount {
+	remaining := c.AmountLimit.Sub(c.AmountUsed)
+	if remaining.IsNegative() {
+		return money.Zero
+	}
+	return remaining
+}
+
+// FinancialYear returns the Indian financial year (April to March) containing
+// t, formatted as "2026-27"
+func FinancialYear(t time.Time) string {
+	start := t.Year()
+	if t.Month() < time.April {
+		start--
+	}
+	return fmt.Sprintf("%d-%02d", start, (start+1)%100)
+}
+
+// ValidateFinancialYear checks a financial year formatted as by FinancialYear
+func ValidateFinancialYear(fy string) error {
+	var start, end int
+	if _, err := fmt.Sscanf(fy, "%4d-%2d", &start, &end); err != nil || len(fy) != 7 || (start+1)%100 != end {
+		return ErrInvalidFinancialYear
+	}
+	return nil
+}
+
+// TDSVendorHistory is what a vendor has been paid under a section in the
+// financial year before the payment being computed
+type TDSVendorHistory struct {
+	AggregateAmount money.Amount
+	// UntaxedAmount is the part of AggregateAmount paid below threshold,
+	// which becomes taxable when the annual threshold is crossed
+	UntaxedAmount money.Amount
+}
+
+// TDSDeduction is a computed deduction. Recorded deductions carry a
+// ReferenceID (such as the payment note) and count towards thresholds.
+type TDSDeduction struct {
+	ID              uuid.UUID    `json:"id"`
+	TenantID        uuid.UUID    `json:"tenant_id"`
+	VendorID        uuid.UUID    `json:"vendor_id"`
+	SectionCode     string       `json:"section_code"`
+	FinancialYear   string       `json:"financial_year"`
+	ReferenceID     string       `json:"reference_id,omitempty"`
+	PaymentDate     time.Time    `json:"payment_date"`
+	Amount          money.Amount `json:"amount"`
+	AggregateBefore money.Amount `json:"aggregate_before"`
+	// TaxableAmount is the amount TDS is deducted on, including earlier
+	// untaxed payments when the annual threshold is crossed
+	TaxableAmount money.Amount `json:"taxable_amount"`
+	// Rate applies to the taxable amount not covered by the certificate
+	Rate              float64      `json:"rate"`
+	Rule              string       `json:"rule"`
+	CertificateID     *uuid.UUID   `json:"certificate_id,omitempty"`
+	CertificateAmount money.Amount `json:"certificate_amount"`
+	CertificateRate   float64      `json:"certificate_rate"`
+	TDSAmount         money.Amount `json:"tds_amount"`
+	CreatedBy         uuid.UUID    `json:"created_by"`
+	CreatedAt         time.Time    `json:"created_at"`
+}
+
+// EffectiveRate is the TDS amount as a percentage of the payment
+func (d *TDSDeduction) EffectiveRate() float64 {
+	if d.Amount.IsZero() {
+		return 0
+	}
+	rate, _ := new(big.Rat).Quo(d.TDSAmount.Rat(), d.Amount.Rat()).Float64()
+	return math.Round(rate*100*10000) / 10000
+}
+
+// ComputeTDS works out the TDS on a payment to vendor under section. history
+// covers earlier payments in the same financial year; cert, when not nil, is
+// a certificate of the vendor for the section. TDS is rounded to the rupee
+// (Section 288B).
+func ComputeTDS(section *TDSSection, vendor *Vendor, amount money.Amount, paymentDate time.Time, history TDSVendorHistory, cert *TDSCertificate) (*TDSDeduction, error) {
+	if !section.IsActive {
+		return nil, ErrTDSSectionInactive
+	}
+	if amount.Sign() <= 0 {
+		return nil, ErrInvalidInvoiceAmount
+	}
+
+	d := &TDSDeduction{
+		TenantID:        vendor.TenantID,
+		VendorID:        vendor.ID,
+		SectionCode:     section.Code,
+		FinancialYear:   FinancialYear(paymentDate),
+		PaymentDate:     dateOnly(paymentDate),
+		Amount:          amount,
+		AggregateBefore: history.AggregateAmount,
+	}
+
+	aggregate, err := history.AggregateAmount.AddChecked(amount)
+	if err != nil {
+		return nil, fmt.Errorf("tds aggregate: %w", err)
+	}
+	exceedsSingle := section.SingleThreshold.Sign() > 0 && amount.Cmp(section.SingleThreshold) > 0
+	exceedsAnnual := section.AnnualThreshold.Sign() > 0 && aggregate.Cmp(section.AnnualThreshold) > 0
+	hasThreshold := section.SingleThreshold.Sign() > 0 || section.AnnualThreshold.Sign() > 0
+	if hasThreshold && !exceedsSingle && !exceedsAnnual {
+		d.Rule = TDSRuleBelowThreshold
+		return d, nil
+	}
+
+	d.TaxableAmount = amount
+	if exceedsAnnual && history.AggregateAmount.Cmp(section.AnnualThreshold) <= 0 {
+		// This payment crosses the annual threshold
+		if section.DeductOnExcess {
+			d.TaxableAmount = aggregate.Sub(section.AnnualThreshold)
+		} else {
+			if d.TaxableAmount, err = amount.AddChecked(history.UntaxedAmount); err != nil {
+				return nil, fmt.Errorf("tds taxable amount: %w", err)
+			}
+		}
+	}
+
+	d.Rate, d.Rule = section.RateOther, TDSRuleNormal
+	if isIndividualPAN(vendor.PAN) {
+		d.Rate = section.RateIndividual
+	}
+	switch {
+	case !isValidPAN(vendor.PAN):
+		d.Rate, d.Rule = math.Max(d.Rate, section.RateNoPAN), TDSRuleNoPAN
+	case vendor.Section206ABVerified != nil && *vendor.Section206ABVerified == ComplianceFlagNo:
+		d.Rate, d.Rule = math.Max(d.Rate*tdsNonFilerMultiplier, tdsNonFilerMinRate), TDSRuleNonFiler
+	}
+
+	tds := new(big.Rat).Mul(d.TaxableAmount.Rat(), money.Rate(d.Rate))
+	if cert != nil && cert.SectionCode == section.Code && cert.ValidOn(paymentDate) && cert.Remaining().Sign() > 0 {
+		covered := d.TaxableAmount
+		if remaining := cert.Remaining(); remaining.Cmp(covered) < 0 {
+			covered = remaining
+		}
+		certID := cert.ID
+		d.CertificateID = &certID
+		d.CertificateAmount = covered
+		d.CertificateRate = cert.Rate
+		d.Rule = TDSRuleCertificate
+		tds.Mul(covered.Rat(), money.Rate(cert.Rate))
+		tds.Add(tds, new(big.Rat).Mul(d.TaxableAmount.Sub(covered).Rat(), money.Rate(d.Rate)))
+	}
+	if d.TDSAmount, err = roundToRupee(tds); err != nil {
+		return nil, fmt.Errorf("tds amount: %w", err)
+	}
+	return d, nil
+}
+
+// roundToRupee rounds an exact amount straight to whole rupees, half up, so
+// it is not rounded to paise first
+func roundToRupee(r *big.Rat) (money.Amount, error) {
+	rupees, err := money.FromRat(new(big.Rat).Quo(r, big.NewRat(100, 1)), money.HalfUp)
+	if err != nil {
+		return money.Zero, err
+	}
+	return rupees.MulChecked(100)
+}
+
+// isIndividualPAN reports whether the PAN holder is an individual or HUF,
+// from the fourth character of the PAN
+func isIndividualPAN(pan string) bool {
+	return len(pan) == 10 && (pan[3] == 'P' || pan[3] == 'H')
+}
+
+// TDSSectionSummary totals a vendor's recorded deductions under one section
+// for a financial year
+type TDSSectionSummary struct {
+	SectionCode     string       `json:"section_code"`
+	Deductions      int          `json:"deductions"`
+	AggregateAmount money.Amount `json:"aggregate_amount"`
+	TaxableAmount   money.Amount `json:"taxable_amount"`
+	TDSAmount       money.Amount `json:"tds_amount"`
+	SingleThreshold money.Amount `json:"single_threshold"`
+	AnnualThreshold money.Amount `json:"annual_threshold"`
+}
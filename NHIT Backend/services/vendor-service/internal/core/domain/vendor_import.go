@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// VendorImportRow is one parsed row of a bulk vendor import. Account is nil
+// when the row carries no bank details. ParseErrors holds problems found while
+// reading the row (bad dates, unknown enum values) before validation.
+type VendorImportRow struct {
+	RowNumber   int
+	Vendor      CreateVendorParams
+	Account     *CreateVendorAccountParams
+	ParseErrors []string
+}
+
+// VendorImportError is a problem with one row of an import
+type VendorImportError struct {
+	RowNumber int    `json:"row_number"`
+	Field     string `json:"field,omitempty"`
+	Message   string `json:"message"`
+}
+
+// VendorImportResult reports the outcome of a bulk vendor import. Nothing is
+// written when DryRun is set or when any row has errors.
+type VendorImportResult struct {
+	TotalRows        int                 `json:"total_rows"`
+	ValidRows        int                 `json:"valid_rows"`
+	DryRun           bool                `json:"dry_run"`
+	Committed        bool                `json:"committed"`
+	Errors           []VendorImportError `json:"errors"`
+	CreatedVendorIDs []uuid.UUID         `json:"created_vendor_ids"`
+}
+
+// AddError records an error for a row
+func (r *VendorImportResult) AddError(row int, field, message string) {
+	r.Errors = append(r.Errors, VendorImportError{RowNumber: row, Field: field, Message: message})
+}
+
+// VendorExportRow is a vendor with its primary bank account, if any
+type VendorExportRow struct {
+	Vendor         *Vendor
+	PrimaryAccount *VendorAccount
+}
+
+// importErrorFields maps validation errors to the import column they concern
+var importErrorFields = map[error]string{
+	ErrInvalidVendorName:      "vendor_name",
+	ErrInvalidVendorEmail:     "vendor_email",
+	ErrInvalidEmailFormat:     "vendor_email",
+	ErrVendorEmailExists:      "vendor_email",
+	ErrInvalidPAN:             "pan",
+	ErrInvalidPANFormat:       "pan",
+	ErrInvalidGSTINFormat:     "gstin",
+	ErrInvalidGSTINStateCode:  "gstin",
+	ErrInvalidGSTINEntityCode: "gstin",
+	ErrInvalidGSTINChecksum:   "gstin",
+	ErrGSTINPANMismatch:       "gstin",
+	ErrInvalidBeneficiaryName: "beneficiary_name",
+	ErrInvalidAccountName:     "bank_account_name",
+	ErrInvalidAccountNumber:   "bank_account_number",
+	ErrInvalidBankName:        "bank_name",
+	ErrInvalidIFSCCode:        "bank_ifsc_code",
+	ErrInvalidIFSCFormat:      "bank_ifsc_code",
+}
+
+// ImportErrorField returns the import column an error refers to, or "" if unknown
+func ImportErrorField(err error) string {
+	for target, field := range importErrorFields {
+		if errors.Is(err, target) {
+			return field
+		}
+	}
+	return ""
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attributes on which an existing vendor can match a new one
+const (
+	DuplicateOnPAN         = "PAN"
+	DuplicateOnGSTIN       = "GSTIN"
+	DuplicateOnBankAccount = "BANK_ACCOUNT"
+	DuplicateOnName        = "NAME"
+)
+
+// DuplicateMatch is an existing vendor that looks like the one being created
+type DuplicateMatch struct {
+	VendorID   uuid.UUID `json:"vendor_id"`
+	VendorCode string    `json:"vendor_code"`
+	VendorName string    `json:"vendor_name"`
+	MatchedOn  []string  `json:"matched_on"`
+}
+
+// IsHard reports whether the match is on an attribute that identifies a single
+// vendor (GSTIN or bank account). PAN and name matches may be legitimate, e.g.
+// one company registered for GST in several states.
+func (m DuplicateMatch) IsHard() bool {
+	for _, on := range m.MatchedOn {
+		if on == DuplicateOnGSTIN || on == DuplicateOnBankAccount {
+			return true
+		}
+	}
+	return false
+}
+
+// DuplicateVendorError is returned when a vendor being created matches
+// existing vendors. It satisfies errors.Is(err, ErrDuplicateVendor).
+type DuplicateVendorError struct {
+	Matches []DuplicateMatch
+}
+
+func (e *DuplicateVendorError) Error() string {
+	parts := make([]string, len(e.Matches))
+	for i, m := range e.Matches {
+		parts[i] = fmt.Sprintf("%s (%s)", m.VendorCode, strings.Join(m.MatchedOn, ", "))
+	}
+	return fmt.Sprintf("%s: %s", ErrDuplicateVendor.Error(), strings.Join(parts, "; "))
+}
+
+func (e *DuplicateVendorError) Is(target error) bool {
+	return target == ErrDuplicateVendor
+}
+
+// DuplicateCheck decides whether the matches block vendor creation. Hard
+// matches always block; PAN and name matches block unless allowDuplicate is set.
+func DuplicateCheck(matches []DuplicateMatch, allowDuplicate bool) error {
+	var blocking []DuplicateMatch
+	for _, m := range matches {
+		if m.IsHard() || !allowDuplicate {
+			blocking = append(blocking, m)
+		}
+	}
+	if len(blocking) == 0 {
+		return nil
+	}
+	return &DuplicateVendorError{Matches: blocking}
+}
+
+// VendorMerge records that one vendor was folded into another
+type VendorMerge struct {
+	ID                 uuid.UUID `json:"id"`
+	TenantID           uuid.UUID `json:"tenant_id"`
+	SurvivorVendorID   uuid.UUID `json:"survivor_vendor_id"`
+	SurvivorVendorCode string    `json:"survivor_vendor_code"`
+	SurvivorVendorName string    `json:"survivor_vendor_name"`
+	MergedVendorID     uuid.UUID `json:"merged_vendor_id"`
+	MergedVendorCode   string    `json:"merged_vendor_code"`
+	MergedVendorName   string    `json:"merged_vendor_name"`
+	AccountsMoved      int       `json:"accounts_moved"`
+	Reason             *string   `json:"reason,omitempty"`
+	MergedBy           uuid.UUID `json:"merged_by"`
+	MergedAt           time.Time `json:"merged_at"`
+}
+
+// NewVendorMerge validates and creates a merge of merged into survivor
+func NewVendorMerge(survivor, merged *Vendor, mergedBy uuid.UUID, reason *string) (*VendorMerge, error) {
+	if survivor.ID == merged.ID {
+		return nil, ErrMergeSameVendor
+	}
+	if survivor.TenantID != merged.TenantID {
+		return nil, ErrVendorNotFound
+	}
+	if mergedBy == uuid.Nil {
+		return nil, ErrInvalidCreatedBy
+	}
+
+	return &VendorMerge{
+		ID:                 uuid.New(),
+		TenantID:           survivor.TenantID,
+		SurvivorVendorID:   survivor.ID,
+		SurvivorVendorCode: survivor.VendorCode,
+		SurvivorVendorName: survivor.VendorName,
+		MergedVendorID:     merged.ID,
+		MergedVendorCode:   merged.VendorCode,
+		MergedVendorName:   merged.VendorName,
+		Reason:             reason,
+		MergedBy:           mergedBy,
+		MergedAt:           time.Now(),
+	}, nil
+}
@@ -77,6 +77,12 @@ type VendorAccount struct {
 	CreatedBy     uuid.UUID  `json:"created_by" db:"created_by"`
 	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt     time.Time  `json:"updated_at" db:"updated_at"`
+
+	// Maker-checker state; see AccountChangeRequest
+	ApprovalStatus string     `json:"approval_status" db:"approval_status"`
+	ApprovedBy     *uuid.UUID `json:"approved_by,omitempty" db:"approved_by"`
+	ApprovedAt     *time.Time `json:"approved_at,omitempty" db:"approved_at"`
+	PayableFrom    *time.Time `json:"payable_from,omitempty" db:"payable_from"`
 }
 
 // NewVendor creates a new vendor with business validation
@@ -132,6 +138,12 @@ func NewVendor(params CreateVendorParams) (*Vendor, error) {
 		UpdatedAt:         time.Now(),
 	}
 
+	if vendor.GSTIN != nil {
+		gstin := NormalizeGSTIN(*vendor.GSTIN)
+		vendor.GSTIN = &gstin
+	}
+	vendor.StateName = fillStateFromGSTIN(vendor.StateName, vendor.GSTIN)
+
 	// Generate vendor code
 	vendor.VendorCode = vendor.GenerateCode()
 	return vendor, nil
@@ -179,6 +191,9 @@ type CreateVendorParams struct {
 	IFSCCode                *string
 	SignatureURL            *string
 	CreatedBy               uuid.UUID
+	// AllowDuplicate lets the vendor be created even though an existing vendor
+	// has the same PAN or name. GSTIN and bank account matches always block.
+	AllowDuplicate          bool
 }
 
 // Validate validates the create vendor parameters
@@ -201,6 +216,11 @@ func (p CreateVendorParams) Validate() error {
 	if !isValidPAN(p.PAN) {
 		return ErrInvalidPANFormat
 	}
+	if p.GSTIN != nil && *p.GSTIN != "" {
+		if err := ValidateGSTIN(*p.GSTIN, p.PAN); err != nil {
+			return err
+		}
+	}
 	if p.BeneficiaryName == "" {
 		return ErrInvalidBeneficiaryName
 	}
@@ -272,6 +292,15 @@ func (v *Vendor) Update(updates UpdateVendorParams) error {
 	if updates.PAN != nil {
 		v.PAN = *updates.PAN
 	}
+	if updates.GSTIN != nil {
+		gstin := NormalizeGSTIN(*updates.GSTIN)
+		v.GSTIN = &gstin
+	}
+	if v.GSTIN != nil && *v.GSTIN != "" && (updates.GSTIN != nil || updates.PAN != nil) {
+		if err := ValidateGSTIN(*v.GSTIN, v.PAN); err != nil {
+			return err
+		}
+	}
 	if updates.BeneficiaryName != nil {
 		v.BeneficiaryName = *updates.BeneficiaryName
 	}
@@ -335,6 +364,7 @@ func (v *Vendor) Update(updates UpdateVendorParams) error {
 	if updates.StateName != nil {
 		v.StateName = updates.StateName
 	}
+	v.StateName = fillStateFromGSTIN(v.StateName, v.GSTIN)
 	if updates.CityName != nil {
 		v.CityName = updates.CityName
 	}
@@ -360,6 +390,7 @@ type UpdateVendorParams struct {
 	VendorName             *string
 	VendorEmail            *string
 	VendorMobile           *string
+	GSTIN                  *string
 	PAN                    *string
 	BeneficiaryName        *string
 	Status                 *string
@@ -398,6 +429,12 @@ func (p UpdateVendorParams) Validate() error {
 	if p.PAN != nil && !isValidPAN(*p.PAN) {
 		return ErrInvalidPANFormat
 	}
+	// The embedded PAN is checked against the vendor in Vendor.Update
+	if p.GSTIN != nil && *p.GSTIN != "" {
+		if err := ValidateGSTIN(*p.GSTIN, ""); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -423,11 +460,14 @@ func NewVendorAccount(params CreateVendorAccountParams) (*VendorAccount, error)
 		CreatedBy:     params.CreatedBy,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
+
+		ApprovalStatus: AccountApprovalApproved,
 	}, nil
 }
 
 // CreateVendorAccountParams holds parameters for creating vendor account
 type CreateVendorAccountParams struct {
+	TenantID      uuid.UUID
 	VendorID      uuid.UUID
 	AccountName   string
 	AccountNumber string
@@ -509,6 +549,9 @@ func (va *VendorAccount) Update(params UpdateVendorAccountParams) error {
 	if params.IsActive != nil {
 		va.IsActive = *params.IsActive
 	}
+	if params.Remarks != nil {
+		va.Remarks = params.Remarks
+	}
 
 	va.UpdatedAt = time.Now()
 	return nil
@@ -526,6 +569,8 @@ type UpdateVendorAccountParams struct {
 	IsPrimary     *bool
 	IsActive      *bool
 	Remarks       *string
+	TenantID      uuid.UUID
+	UpdatedBy     uuid.UUID
 }
 
 // Validate validates update account parameters
@@ -0,0 +1,256 @@
+package domain
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/google/uuid"
+)
+
+// Vendor invoice states. A submitted invoice becomes a draft green note; the
+// note and payment services then move it along.
+const (
+	VendorInvoiceSubmitted   = "SUBMITTED"
+	VendorInvoiceUnderReview = "UNDER_REVIEW"
+	VendorInvoiceApproved    = "APPROVED"
+	VendorInvoiceRejected    = "REJECTED"
+	VendorInvoicePaid        = "PAID"
+)
+
+// vendorInvoiceTransitions lists the states each invoice state may move to
+var vendorInvoiceTransitions = map[string][]string{
+	VendorInvoiceSubmitted:   {VendorInvoiceUnderReview, VendorInvoiceApproved, VendorInvoiceRejected},
+	VendorInvoiceUnderReview: {VendorInvoiceApproved, VendorInvoiceRejected},
+	VendorInvoiceApproved:    {VendorInvoicePaid, VendorInvoiceRejected},
+}
+
+// MaxInvoiceDocumentSize is the largest supporting document a vendor may upload
+const MaxInvoiceDocumentSize = 10 << 20
+
+// invoiceDocumentTypes are the accepted supporting document types by extension
+var invoiceDocumentTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+}
+
+// VendorUser links a login (user-service user) to the vendor it acts for on the vendor portal
+type VendorUser struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+	VendorID uuid.UUID `json:"vendor_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	LinkedBy uuid.UUID `json:"linked_by"`
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+// NewVendorUser links userID to vendorID
+func NewVendorUser(tenantID, vendorID, userID, linkedBy uuid.UUID) (*VendorUser, error) {
+	if tenantID == uuid.Nil {
+		return nil, ErrInvalidTenantID
+	}
+	if vendorID == uuid.Nil {
+		return nil, ErrInvalidVendorID
+	}
+	if userID == uuid.Nil || linkedBy == uuid.Nil {
+		return nil, ErrInvalidCreatedBy
+	}
+
+	return &VendorUser{
+		ID:       uuid.New(),
+		TenantID: tenantID,
+		VendorID: vendorID,
+		UserID:   userID,
+		LinkedBy: linkedBy,
+		LinkedAt: time.Now(),
+	}, nil
+}
+
+// VendorContactUpdate holds the contact details a vendor may change itself
+type VendorContactUpdate struct {
+	VendorMobile *string
+	Address      *string
+	CityName     *string
+	StateName    *string
+	CountryName  *string
+	PIN          *string
+}
+
+// UpdateParams converts the contact update to vendor update parameters
+func (u VendorContactUpdate) UpdateParams() UpdateVendorParams {
+	return UpdateVendorParams{
+		VendorMobile: u.VendorMobile,
+		Address:      u.Address,
+		CityName:     u.CityName,
+		StateName:    u.StateName,
+		CountryName:  u.CountryName,
+		PIN:          u.PIN,
+	}
+}
+
+// VendorInvoice is an invoice submitted by a vendor through the portal
+type VendorInvoice struct {
+	ID              uuid.UUID                `json:"id"`
+	TenantID        uuid.UUID                `json:"tenant_id"`
+	OrgID           *uuid.UUID               `json:"org_id,omitempty"`
+	VendorID        uuid.UUID                `json:"vendor_id"`
+	InvoiceNumber   string                   `json:"invoice_number"`
+	InvoiceDate     time.Time                `json:"invoice_date"`
+	InvoiceAmount   money.Amount             `json:"invoice_amount"`
+	PONumber        *string                  `json:"po_number,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	Status          string                   `json:"status"`
+	GreenNoteID     *string                  `json:"green_note_id,omitempty"`
+	PaymentNoteID   *string                  `json:"payment_note_id,omitempty"`
+	UTRNumber       *string                  `json:"utr_number,omitempty"`
+	PaidAmount      *money.Amount            `json:"paid_amount,omitempty"`
+	PaidAt          *time.Time               `json:"paid_at,omitempty"`
+	RejectionReason *string                  `json:"rejection_reason,omitempty"`
+	SubmittedBy     uuid.UUID                `json:"submitted_by"`
+	SubmittedAt     time.Time                `json:"submitted_at"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+	Documents       []*VendorInvoiceDocument `json:"documents,omitempty"`
+}
+
+// SubmitVendorInvoiceParams holds parameters for submitting an invoice
+type SubmitVendorInvoiceParams struct {
+	TenantID      uuid.UUID
+	OrgID         *uuid.UUID // organisation the vendor user submitted in
+	VendorID      uuid.UUID
+	InvoiceNumber string
+	InvoiceDate   time.Time
+	InvoiceAmount money.Amount
+	PONumber      *string
+	Description   *string
+	SubmittedBy   uuid.UUID
+}
+
+// NewVendorInvoice creates a submitted invoice
+func NewVendorInvoice(params SubmitVendorInvoiceParams) (*VendorInvoice, error) {
+	if params.TenantID == uuid.Nil {
+		return nil, ErrInvalidTenantID
+	}
+	if params.SubmittedBy == uuid.Nil {
+		return nil, ErrInvalidCreatedBy
+	}
+	invoiceNumber := strings.TrimSpace(params.InvoiceNumber)
+	if invoiceNumber == "" {
+		return nil, ErrInvalidInvoiceNumber
+	}
+	if params.InvoiceAmount.Sign() <= 0 {
+		return nil, ErrInvalidInvoiceAmount
+	}
+	if params.InvoiceDate.IsZero() || params.InvoiceDate.After(time.Now()) {
+		return nil, ErrInvalidInvoiceDate
+	}
+
+	now := time.Now()
+	return &VendorInvoice{
+		ID:            uuid.New(),
+		TenantID:      params.TenantID,
+		OrgID:         params.OrgID,
+		VendorID:      params.VendorID,
+		InvoiceNumber: invoiceNumber,
+		InvoiceDate:   dateOnly(params.InvoiceDate),
+		InvoiceAmount: params.InvoiceAmount,
+		PONumber:      params.PONumber,
+		Description:   params.Description,
+		Status:        VendorInvoiceSubmitted,
+		SubmittedBy:   params.SubmittedBy,
+		SubmittedAt:   now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// VendorInvoiceStatusUpdate is a status change reported by the note and
+// payment services. Nil fields are left unchanged.
+type VendorInvoiceStatusUpdate struct {
+	Status          string
+	GreenNoteID     *string
+	PaymentNoteID   *string
+	UTRNumber       *string
+	PaidAmount      *money.Amount
+	PaidAt          *time.Time
+	RejectionReason *string
+}
+
+// ApplyStatusUpdate moves the invoice to a new state. Linking a green or
+// payment note without a state change is allowed. Payment requires the UTR.
+func (i *VendorInvoice) ApplyStatusUpdate(u VendorInvoiceStatusUpdate) error {
+	if u.Status != "" && u.Status != i.Status {
+		allowed := false
+		for _, next := range vendorInvoiceTransitions[i.Status] {
+			if next == u.Status {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return ErrInvalidInvoiceTransition
+		}
+		if u.Status == VendorInvoicePaid && (u.UTRNumber == nil || strings.TrimSpace(*u.UTRNumber) == "") {
+			return ErrUTRRequired
+		}
+		i.Status = u.Status
+	}
+
+	if u.GreenNoteID != nil {
+		i.GreenNoteID = u.GreenNoteID
+	}
+	if u.PaymentNoteID != nil {
+		i.PaymentNoteID = u.PaymentNoteID
+	}
+	if u.UTRNumber != nil {
+		utr := strings.ToUpper(strings.TrimSpace(*u.UTRNumber))
+		i.UTRNumber = &utr
+	}
+	if u.PaidAmount != nil {
+		amount := *u.PaidAmount
+		i.PaidAmount = &amount
+	}
+	if i.Status == VendorInvoicePaid && i.PaidAt == nil {
+		paidAt := time.Now()
+		if u.PaidAt != nil {
+			paidAt = *u.PaidAt
+		}
+		i.PaidAt = &paidAt
+	}
+	if u.RejectionReason != nil {
+		i.RejectionReason = u.RejectionReason
+	}
+	i.UpdatedAt = time.Now()
+	return nil
+}
+
+// AcceptsDocuments reports whether supporting documents can still be added
+func (i *VendorInvoice) AcceptsDocuments() bool {
+	return i.Status == VendorInvoiceSubmitted || i.Status == VendorInvoiceUnderReview
+}
+
+// VendorInvoiceDocument is a supporting document of a vendor invoice
+type VendorInvoiceDocument struct {
+	ID          uuid.UUID `json:"id"`
+	InvoiceID   uuid.UUID `json:"invoice_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	ObjectPath  string    `json:"object_path"`
+	UploadedBy  uuid.UUID `json:"uploaded_by"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+// InvoiceDocumentContentType validates a supporting document and returns its
+// content type, derived from the file extension
+func InvoiceDocumentContentType(fileName string, size int) (string, error) {
+	if size == 0 || size > MaxInvoiceDocumentSize {
+		return "", ErrInvalidInvoiceDocument
+	}
+	contentType, ok := invoiceDocumentTypes[strings.ToLower(filepath.Ext(fileName))]
+	if !ok {
+		return "", ErrInvalidInvoiceDocument
+	}
+	return contentType, nil
+}
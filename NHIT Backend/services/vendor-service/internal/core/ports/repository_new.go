@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/google/uuid"
@@ -20,6 +21,9 @@ type VendorRepository interface {
 	
 	// Vendor validation operations
 	IsVendorCodeExists(ctx context.Context, tenantID uuid.UUID, code string, excludeID *uuid.UUID) (bool, error)
+	// NextVendorCodeNumber draws the tenant's next number for a generated
+	// code, starting at 1. The counter stays locked until the transaction ends.
+	NextVendorCodeNumber(ctx context.Context, tenantID uuid.UUID, baseCode string) (int64, error)
 	IsVendorEmailExists(ctx context.Context, tenantID uuid.UUID, email string, excludeID *uuid.UUID) (bool, error)
 	
 	// Vendor account CRUD operations
@@ -30,6 +34,66 @@ type VendorRepository interface {
 	UpdateVendorAccount(ctx context.Context, account *domain.VendorAccount) error
 	DeleteVendorAccount(ctx context.Context, accountID uuid.UUID) error
 	UnsetPrimaryVendorAccounts(ctx context.Context, vendorID uuid.UUID, excludeAccountID *uuid.UUID) error
+	FindVendorAccountsByNumber(ctx context.Context, tenantID uuid.UUID, accountNumber string) ([]*domain.VendorAccount, error)
+	
+	// Bank account change approval (maker-checker)
+	CreateAccountChangeRequest(ctx context.Context, change *domain.AccountChangeRequest) error
+	GetAccountChangeRequest(ctx context.Context, tenantID, changeID uuid.UUID) (*domain.AccountChangeRequest, error)
+	GetPendingAccountChange(ctx context.Context, accountID uuid.UUID) (*domain.AccountChangeRequest, error)
+	ListAccountChangeRequests(ctx context.Context, tenantID uuid.UUID, filters AccountChangeFilters) ([]*domain.AccountChangeRequest, int64, error)
+	UpdateAccountChangeReview(ctx context.Context, change *domain.AccountChangeRequest) error
+	
+	// Duplicate detection and merges
+	FindDuplicateVendors(ctx context.Context, tenantID uuid.UUID, criteria DuplicateCriteria) ([]domain.DuplicateMatch, error)
+	IsVendorMerged(ctx context.Context, vendorID uuid.UUID) (bool, error)
+	MergeVendors(ctx context.Context, merge *domain.VendorMerge) error
+	
+	// MSME payment-due tracking
+	CreateMSMEInvoice(ctx context.Context, invoice *domain.MSMEInvoice) error
+	GetMSMEInvoice(ctx context.Context, tenantID, invoiceID uuid.UUID) (*domain.MSMEInvoice, error)
+	UpdateMSMEInvoicePayment(ctx context.Context, invoice *domain.MSMEInvoice) error
+	ListMSMEInvoices(ctx context.Context, tenantID uuid.UUID, filters MSMEInvoiceFilters) ([]*domain.MSMEInvoice, int64, error)
+	ListMSMEInvoicesForReport(ctx context.Context, tenantID uuid.UUID, asOf, periodStart time.Time) ([]*domain.MSMEInvoice, error)
+	ListMSMEInvoicesToFlag(ctx context.Context, dueBy time.Time) ([]*domain.MSMEInvoice, error)
+	UpdateMSMEInvoiceFlag(ctx context.Context, invoiceID uuid.UUID, flaggedStatus string) error
+	
+	// Vendor portal
+	CreateVendorUser(ctx context.Context, link *domain.VendorUser) error
+	GetVendorUserByUserID(ctx context.Context, tenantID, userID uuid.UUID) (*domain.VendorUser, error)
+	ListVendorUsers(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.VendorUser, error)
+	DeleteVendorUser(ctx context.Context, tenantID, userID uuid.UUID) error
+	CreateVendorInvoice(ctx context.Context, invoice *domain.VendorInvoice) error
+	GetVendorInvoice(ctx context.Context, tenantID, invoiceID uuid.UUID) (*domain.VendorInvoice, error)
+	ListVendorInvoices(ctx context.Context, tenantID uuid.UUID, filters VendorInvoiceFilters) ([]*domain.VendorInvoice, int64, error)
+	UpdateVendorInvoiceStatus(ctx context.Context, invoice *domain.VendorInvoice) error
+	CreateVendorInvoiceDocument(ctx context.Context, doc *domain.VendorInvoiceDocument) error
+	GetVendorInvoiceDocuments(ctx context.Context, invoiceID uuid.UUID) ([]*domain.VendorInvoiceDocument, error)
+	
+	// TDS
+	ListTDSSections(ctx context.Context, includeInactive bool) ([]*domain.TDSSection, error)
+	GetTDSSection(ctx context.Context, code string) (*domain.TDSSection, error)
+	UpsertTDSSection(ctx context.Context, section *domain.TDSSection) error
+	CreateTDSCertificate(ctx context.Context, cert *domain.TDSCertificate) error
+	ListTDSCertificates(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.TDSCertificate, error)
+	// GetApplicableTDSCertificate returns the vendor's certificate for the
+	// section valid on date with the most amount left, or nil
+	GetApplicableTDSCertificate(ctx context.Context, tenantID, vendorID uuid.UUID, sectionCode string, date time.Time) (*domain.TDSCertificate, error)
+	// LockVendorTDS serialises deductions of a vendor until the transaction ends
+	LockVendorTDS(ctx context.Context, vendorID uuid.UUID) error
+	GetTDSVendorHistory(ctx context.Context, tenantID, vendorID uuid.UUID, sectionCode, financialYear string) (domain.TDSVendorHistory, error)
+	CreateTDSDeduction(ctx context.Context, deduction *domain.TDSDeduction) error
+	GetTDSDeductionByReference(ctx context.Context, tenantID uuid.UUID, referenceID string) (*domain.TDSDeduction, error)
+	DeleteTDSDeduction(ctx context.Context, tenantID uuid.UUID, referenceID string) error
+	GetVendorTDSSummary(ctx context.Context, tenantID, vendorID uuid.UUID, financialYear string) ([]*domain.TDSSectionSummary, error)
+	
+	// Event outbox
+	AddOutboxEvent(ctx context.Context, event *OutboxEvent) error
+	// ListPendingOutboxEvents returns the oldest events not yet relayed and
+	// locks them until the transaction ends. It returns none while another
+	// relay holds the outbox, so events are relayed in order.
+	ListPendingOutboxEvents(ctx context.Context, limit int) ([]*OutboxEvent, error)
+	DeleteOutboxEvent(ctx context.Context, eventID uuid.UUID) error
+	RecordOutboxEventFailure(ctx context.Context, eventID uuid.UUID, reason string) error
 	
 	// Transaction support for business operations
 	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
@@ -47,6 +111,56 @@ type VendorListFilters struct {
 	Offset         int
 }
 
+// DuplicateCriteria are the attributes of a vendor compared against existing vendors.
+// PAN, GSTIN and name are matched in the database; bank accounts are matched separately.
+type DuplicateCriteria struct {
+	PAN        string
+	GSTIN      *string
+	VendorName string
+	ExcludeID  *uuid.UUID
+}
+
+// AccountChangeFilters represents filters for listing bank account change requests
+type AccountChangeFilters struct {
+	Status   *string
+	VendorID *uuid.UUID
+	Limit    int
+	Offset   int
+}
+
+// MSMEInvoiceFilters represents filters for listing MSME invoices. Status is
+// evaluated on AsOf with the at-risk window AtRiskDays.
+type MSMEInvoiceFilters struct {
+	VendorID      *uuid.UUID
+	GreenNoteID   *string
+	PaymentNoteID *string
+	Status        *string
+	AsOf          time.Time
+	AtRiskDays    int
+	Limit         int
+	Offset        int
+}
+
+// VendorInvoiceFilters represents filters for listing portal invoices
+type VendorInvoiceFilters struct {
+	VendorID *uuid.UUID
+	Status   *string
+	Limit    int
+	Offset   int
+}
+
+// OutboxEvent is an encoded vendor event stored with the change that produced
+// it until the outbox relay publishes it
+type OutboxEvent struct {
+	EventID       uuid.UUID
+	EventType     string
+	SchemaVersion int
+	TenantID      string
+	Payload       []byte
+	OccurredAt    time.Time
+	Attempts      int
+}
+
 // DatabaseRepository defines database-specific operations
 type DatabaseRepository interface {
 	// Health check
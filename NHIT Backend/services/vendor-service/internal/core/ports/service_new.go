@@ -2,7 +2,9 @@ package ports
 
 import (
 	"context"
+	"time"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/google/uuid"
 )
@@ -17,6 +19,44 @@ type VendorService interface {
 	DeleteVendor(ctx context.Context, tenantID, vendorID uuid.UUID) error
 	ListVendors(ctx context.Context, tenantID uuid.UUID, filters VendorListFilters) ([]*domain.Vendor, int64, error)
 	
+	// Duplicate detection and merging
+	FindDuplicateVendors(ctx context.Context, params domain.CreateVendorParams) ([]domain.DuplicateMatch, error)
+	MergeVendors(ctx context.Context, tenantID, survivorID, mergedID, mergedBy uuid.UUID, reason *string) (*domain.VendorMerge, error)
+	
+	// Bulk import and export
+	ImportVendors(ctx context.Context, tenantID, createdBy uuid.UUID, rows []domain.VendorImportRow, dryRun, allowDuplicates bool) (*domain.VendorImportResult, error)
+	ExportVendors(ctx context.Context, tenantID uuid.UUID, filters VendorListFilters) ([]domain.VendorExportRow, error)
+	
+	// MSME payment-due tracking
+	RegisterMSMEInvoice(ctx context.Context, params domain.RegisterMSMEInvoiceParams) (*domain.MSMEInvoice, error)
+	RecordMSMEInvoicePayment(ctx context.Context, tenantID, invoiceID uuid.UUID, paidDate time.Time, paymentNoteID, reference *string) (*domain.MSMEInvoice, error)
+	ListMSMEInvoices(ctx context.Context, tenantID uuid.UUID, filters MSMEInvoiceFilters) ([]*domain.MSMEInvoice, int64, error)
+	GetMSMEOutstandingReport(ctx context.Context, tenantID uuid.UUID, asOf time.Time, periodStart *time.Time) (*domain.MSMEOutstandingReport, error)
+	FlagMSMEInvoices(ctx context.Context, asOf time.Time) (int, error)
+	MSMEPolicy() domain.MSMEPolicy
+	
+	// Vendor portal: vendorID scopes invoice access to one vendor, nil for staff
+	LinkVendorUser(ctx context.Context, tenantID, vendorID, userID, linkedBy uuid.UUID) (*domain.VendorUser, error)
+	UnlinkVendorUser(ctx context.Context, tenantID, userID uuid.UUID) error
+	ListVendorUsers(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.VendorUser, error)
+	GetVendorUser(ctx context.Context, tenantID, userID uuid.UUID) (*domain.VendorUser, error)
+	UpdateVendorContact(ctx context.Context, tenantID, vendorID uuid.UUID, update domain.VendorContactUpdate) (*domain.Vendor, error)
+	SubmitVendorInvoice(ctx context.Context, params domain.SubmitVendorInvoiceParams) (*domain.VendorInvoice, error)
+	AddVendorInvoiceDocument(ctx context.Context, tenantID uuid.UUID, vendorID *uuid.UUID, doc *domain.VendorInvoiceDocument) error
+	GetVendorInvoice(ctx context.Context, tenantID, invoiceID uuid.UUID, vendorID *uuid.UUID) (*domain.VendorInvoice, error)
+	ListVendorInvoices(ctx context.Context, tenantID uuid.UUID, filters VendorInvoiceFilters) ([]*domain.VendorInvoice, int64, error)
+	UpdateVendorInvoiceStatus(ctx context.Context, tenantID, invoiceID uuid.UUID, update domain.VendorInvoiceStatusUpdate) (*domain.VendorInvoice, error)
+	
+	// TDS: section master, lower deduction certificates and deductions
+	ListTDSSections(ctx context.Context, includeInactive bool) ([]*domain.TDSSection, error)
+	UpsertTDSSection(ctx context.Context, section *domain.TDSSection, updatedBy uuid.UUID) (*domain.TDSSection, error)
+	CreateTDSCertificate(ctx context.Context, params domain.CreateTDSCertificateParams) (*domain.TDSCertificate, error)
+	ListTDSCertificates(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.TDSCertificate, error)
+	CalculateTDS(ctx context.Context, req TDSRequest) (*domain.TDSDeduction, error)
+	RecordTDSDeduction(ctx context.Context, req TDSRequest, referenceID string, createdBy uuid.UUID) (*domain.TDSDeduction, error)
+	ReverseTDSDeduction(ctx context.Context, tenantID uuid.UUID, referenceID string) error
+	GetVendorTDSSummary(ctx context.Context, tenantID, vendorID uuid.UUID, financialYear string) ([]*domain.TDSSectionSummary, error)
+	
 	// Vendor code operations (equivalent to PHP VendorService code methods)
 	GenerateVendorCode(ctx context.Context, vendorName string, vendorType *string) (string, error)
 	UpdateVendorCode(ctx context.Context, tenantID, vendorID uuid.UUID, newCode string) (*domain.Vendor, error)
@@ -27,9 +67,26 @@ type VendorService interface {
 	GetVendorAccounts(ctx context.Context, vendorID uuid.UUID) ([]*domain.VendorAccount, error)
 	GetVendorBankingDetails(ctx context.Context, vendorID uuid.UUID, accountID *uuid.UUID) (*BankingDetails, error)
 	UpdateVendorAccount(ctx context.Context, accountID uuid.UUID, params domain.UpdateVendorAccountParams) (*domain.VendorAccount, error)
-	DeleteVendorAccount(ctx context.Context, accountID uuid.UUID) error
-	ToggleAccountStatus(ctx context.Context, accountID uuid.UUID) (*domain.VendorAccount, error)
-	SetPrimaryAccount(ctx context.Context, accountID uuid.UUID) (*domain.VendorAccount, error)
+	DeleteVendorAccount(ctx context.Context, tenantID, accountID uuid.UUID) error
+	ToggleAccountStatus(ctx context.Context, tenantID, accountID uuid.UUID) (*domain.VendorAccount, error)
+	SetPrimaryAccount(ctx context.Context, tenantID, accountID, requestedBy uuid.UUID) (*domain.VendorAccount, error)
+	
+	// Bank account change approval (maker-checker)
+	ListAccountChangeRequests(ctx context.Context, tenantID uuid.UUID, filters AccountChangeFilters) ([]*domain.AccountChangeRequest, int64, error)
+	ApproveAccountChange(ctx context.Context, tenantID, changeID, reviewerID uuid.UUID, remarks *string) (*domain.AccountChangeRequest, error)
+	RejectAccountChange(ctx context.Context, tenantID, changeID, reviewerID uuid.UUID, remarks *string) (*domain.AccountChangeRequest, error)
+	VerifyPayeeAccount(ctx context.Context, tenantID uuid.UUID, accountNumber, ifscCode string) (*PayeeVerification, error)
+}
+
+// TDSRequest identifies a payment to compute TDS on. The vendor is given by
+// VendorID or, when nil, VendorCode.
+type TDSRequest struct {
+	TenantID    uuid.UUID
+	VendorID    *uuid.UUID
+	VendorCode  string
+	SectionCode string
+	Amount      money.Amount
+	PaymentDate time.Time
 }
 
 // BankingDetails represents banking information for payment processing
@@ -44,11 +101,36 @@ type BankingDetails struct {
 	Remarks       *string `json:"remarks,omitempty"`
 }
 
+// PayeeVerification tells a payment processor whether a bank account may be paid
+type PayeeVerification struct {
+	Payable     bool
+	Reason      string
+	VendorID    *uuid.UUID
+	AccountID   *uuid.UUID
+	PayableFrom *time.Time
+}
+
 // VendorServiceConfig holds configuration for vendor service
 type VendorServiceConfig struct {
 	EnableCodeGeneration bool
 	DefaultVendorType    string
 	MaxAccountsPerVendor int
+	// AccountCoolingOff is how long a newly approved bank account waits before it can receive payments
+	AccountCoolingOff time.Duration
+	// MSME holds the bank rate and at-risk window for MSME payment tracking
+	MSME domain.MSMEPolicy
+}
+
+// ComplianceStatus is what a compliance registry reports about a vendor.
+// A nil field means the registry does not know.
+type ComplianceStatus struct {
+	GSTDefaulted         *bool
+	Section206ABVerified *bool
+}
+
+// ComplianceLookup checks a vendor's GST return filing and Section 206AB status
+type ComplianceLookup interface {
+	LookupCompliance(ctx context.Context, gstin *string, pan string) (*ComplianceStatus, error)
 }
 
 // Logger defines logging contract
@@ -59,12 +141,18 @@ type Logger interface {
 	Warn(ctx context.Context, msg string, fields map[string]interface{})
 }
 
-// EventPublisher defines event publishing contract
+// EventPublisher defines event publishing contract. Events are recorded with
+// the transaction of ctx and published once it commits, so publish inside
+// WithTransaction together with the change the event describes.
 type EventPublisher interface {
 	PublishVendorCreated(ctx context.Context, vendor *domain.Vendor) error
 	PublishVendorUpdated(ctx context.Context, vendor *domain.Vendor) error
 	PublishVendorDeleted(ctx context.Context, tenantID, vendorID uuid.UUID) error
-	PublishAccountCreated(ctx context.Context, account *domain.VendorAccount) error
-	PublishAccountUpdated(ctx context.Context, account *domain.VendorAccount) error
-	PublishAccountDeleted(ctx context.Context, accountID uuid.UUID) error
+	PublishAccountCreated(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount) error
+	PublishAccountUpdated(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount) error
+	PublishAccountDeleted(ctx context.Context, tenantID, vendorID, accountID uuid.UUID) error
+	PublishVendorMerged(ctx context.Context, merge *domain.VendorMerge) error
+	PublishMSMEInvoiceFlagged(ctx context.Context, invoice *domain.MSMEInvoice, status string, interest money.Amount) error
+	PublishVendorInvoiceSubmitted(ctx context.Context, invoice *domain.VendorInvoice, vendor *domain.Vendor) error
+	PublishVendorInvoiceDocumentAdded(ctx context.Context, invoice *domain.VendorInvoice, doc *domain.VendorInvoiceDocument) error
 }
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// MSMEPolicy returns the bank rate and at-risk window used for MSME tracking
+func (s *vendorService) MSMEPolicy() domain.MSMEPolicy {
+	return s.config.MSME
+}
+
+// RegisterMSMEInvoice starts tracking an invoice of a micro or small
+// enterprise vendor against its statutory due date
+func (s *vendorService) RegisterMSMEInvoice(ctx context.Context, params domain.RegisterMSMEInvoiceParams) (*domain.MSMEInvoice, error) {
+	vendor, err := s.repo.GetVendorByID(ctx, params.TenantID, params.VendorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor: %w", err)
+	}
+
+	invoice, err := domain.NewMSMEInvoice(vendor, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateMSMEInvoice(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "MSME invoice registered", map[string]interface{}{
+		"invoice_id":     invoice.ID.String(),
+		"vendor_id":      invoice.VendorID.String(),
+		"invoice_number": invoice.InvoiceNumber,
+		"due_date":       invoice.DueDate.Format("2006-01-02"),
+	})
+	return invoice, nil
+}
+
+// RecordMSMEInvoicePayment settles a tracked invoice. A late payment is
+// published with the interest owed so the payment note can carry it.
+func (s *vendorService) RecordMSMEInvoicePayment(ctx context.Context, tenantID, invoiceID uuid.UUID, paidDate time.Time, paymentNoteID, reference *string) (*domain.MSMEInvoice, error) {
+	invoice, err := s.repo.GetMSMEInvoice(ctx, tenantID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := invoice.MarkPaid(paidDate, paymentNoteID, reference); err != nil {
+		return nil, err
+	}
+
+	policy := s.config.MSME
+	invoice.FlaggedStatus = invoice.Status(paidDate, policy.AtRiskDays)
+	interest, err := invoice.Interest(paidDate, policy.BankRate)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.repo.UpdateMSMEInvoicePayment(txCtx, invoice); err != nil {
+			return err
+		}
+		if invoice.FlaggedStatus != domain.MSMEInvoicePaidLate {
+			return nil
+		}
+		return s.publishMSMEFlag(txCtx, invoice, invoice.FlaggedStatus, interest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.FlaggedStatus == domain.MSMEInvoicePaidLate {
+		s.logger.Warn(ctx, "MSME invoice paid after due date", map[string]interface{}{
+			"invoice_id":    invoice.ID.String(),
+			"days_past_due": invoice.DaysPastDue(paidDate),
+			"interest":      interest.String(),
+		})
+	}
+	return invoice, nil
+}
+
+// ListMSMEInvoices lists tracked invoices of a tenant
+func (s *vendorService) ListMSMEInvoices(ctx context.Context, tenantID uuid.UUID, filters ports.MSMEInvoiceFilters) ([]*domain.MSMEInvoice, int64, error) {
+	if filters.AsOf.IsZero() {
+		filters.AsOf = time.Now()
+	}
+	filters.AtRiskDays = s.config.MSME.AtRiskDays
+	return s.repo.ListMSMEInvoices(ctx, tenantID, filters)
+}
+
+// GetMSMEOutstandingReport reports MSME dues of a tenant on asOf. The period
+// defaults to the MSME-1 half year containing asOf.
+func (s *vendorService) GetMSMEOutstandingReport(ctx context.Context, tenantID uuid.UUID, asOf time.Time, periodStart *time.Time) (*domain.MSMEOutstandingReport, error) {
+	start := domain.MSMEHalfYearStart(asOf)
+	if periodStart != nil {
+		start = *periodStart
+	}
+	if start.After(asOf) {
+		return nil, domain.ErrInvalidReportPeriod
+	}
+
+	invoices, err := s.repo.ListMSMEInvoicesForReport(ctx, tenantID, asOf, start)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewMSMEOutstandingReport(invoices, asOf, start, s.config.MSME)
+}
+
+// FlagMSMEInvoices finds unpaid invoices of all tenants that became at risk or
+// overdue since they were last flagged and publishes their new status for the
+// green note and payment note services. It returns the number flagged.
+func (s *vendorService) FlagMSMEInvoices(ctx context.Context, asOf time.Time) (int, error) {
+	policy := s.config.MSME
+	invoices, err := s.repo.ListMSMEInvoicesToFlag(ctx, asOf.AddDate(0, 0, policy.AtRiskDays))
+	if err != nil {
+		return 0, err
+	}
+
+	flagged := 0
+	for _, invoice := range invoices {
+		status := invoice.Status(asOf, policy.AtRiskDays)
+		if status == invoice.FlaggedStatus || status == domain.MSMEInvoiceOpen {
+			continue
+		}
+		interest, err := invoice.Interest(asOf, policy.BankRate)
+		if err != nil {
+			return flagged, err
+		}
+		err = s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+			if err := s.repo.UpdateMSMEInvoiceFlag(txCtx, invoice.ID, status); err != nil {
+				return err
+			}
+			return s.publishMSMEFlag(txCtx, invoice, status, interest)
+		})
+		if err != nil {
+			return flagged, err
+		}
+		flagged++
+	}
+
+	if flagged > 0 {
+		s.logger.Info(ctx, "MSME invoices flagged", map[string]interface{}{
+			"count": flagged,
+		})
+	}
+	return flagged, nil
+}
+
+func (s *vendorService) publishMSMEFlag(ctx context.Context, invoice *domain.MSMEInvoice, status string, interest money.Amount) error {
+	if s.publisher == nil {
+		return nil
+	}
+	if err := s.publisher.PublishMSMEInvoiceFlagged(ctx, invoice, status, interest); err != nil {
+		return fmt.Errorf("failed to record MSME invoice flag event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// ListTDSSections returns the TDS section master
+func (s *vendorService) ListTDSSections(ctx context.Context, includeInactive bool) ([]*domain.TDSSection, error) {
+	return s.repo.ListTDSSections(ctx, includeInactive)
+}
+
+// UpsertTDSSection creates a section or changes its rates and thresholds
+func (s *vendorService) UpsertTDSSection(ctx context.Context, section *domain.TDSSection, updatedBy uuid.UUID) (*domain.TDSSection, error) {
+	if err := section.Validate(); err != nil {
+		return nil, err
+	}
+	section.UpdatedBy = &updatedBy
+	section.UpdatedAt = time.Now()
+	if err := s.repo.UpsertTDSSection(ctx, section); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "TDS section saved", map[string]interface{}{
+		"section":    section.Code,
+		"updated_by": updatedBy.String(),
+	})
+	return section, nil
+}
+
+// CreateTDSCertificate records a vendor's lower or nil deduction certificate
+func (s *vendorService) CreateTDSCertificate(ctx context.Context, params domain.CreateTDSCertificateParams) (*domain.TDSCertificate, error) {
+	if _, err := s.repo.GetVendorByID(ctx, params.TenantID, params.VendorID); err != nil {
+		return nil, fmt.Errorf("failed to get vendor: %w", err)
+	}
+
+	cert, err := domain.NewTDSCertificate(params)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetTDSSection(ctx, cert.SectionCode); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateTDSCertificate(ctx, cert); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "TDS certificate recorded", map[string]interface{}{
+		"vendor_id":          cert.VendorID.String(),
+		"section":            cert.SectionCode,
+		"certificate_number": cert.CertificateNumber,
+	})
+	return cert, nil
+}
+
+// ListTDSCertificates returns a vendor's certificates with the amount used
+func (s *vendorService) ListTDSCertificates(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.TDSCertificate, error) {
+	return s.repo.ListTDSCertificates(ctx, tenantID, vendorID)
+}
+
+// CalculateTDS suggests the TDS on a payment without recording it
+func (s *vendorService) CalculateTDS(ctx context.Context, req ports.TDSRequest) (*domain.TDSDeduction, error) {
+	vendor, err := s.tdsVendor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.computeTDS(ctx, vendor, req)
+}
+
+// RecordTDSDeduction computes and records the TDS on a payment so it counts
+// towards the vendor's thresholds and certificate limits. Recording the same
+// reference again returns the deduction already recorded.
+func (s *vendorService) RecordTDSDeduction(ctx context.Context, req ports.TDSRequest, referenceID string, createdBy uuid.UUID) (*domain.TDSDeduction, error) {
+	referenceID = strings.TrimSpace(referenceID)
+	if referenceID == "" {
+		return nil, domain.ErrInvalidTDSReference
+	}
+	vendor, err := s.tdsVendor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deduction *domain.TDSDeduction
+	err = s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.repo.LockVendorTDS(txCtx, vendor.ID); err != nil {
+			return err
+		}
+		existing, err := s.repo.GetTDSDeductionByReference(txCtx, req.TenantID, referenceID)
+		if err == nil {
+			deduction = existing
+			return nil
+		}
+		if !errors.Is(err, domain.ErrTDSDeductionNotFound) {
+			return err
+		}
+
+		deduction, err = s.computeTDS(txCtx, vendor, req)
+		if err != nil {
+			return err
+		}
+		deduction.ID = uuid.New()
+		deduction.ReferenceID = referenceID
+		deduction.CreatedBy = createdBy
+		deduction.CreatedAt = time.Now()
+		return s.repo.CreateTDSDeduction(txCtx, deduction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "TDS deduction recorded", map[string]interface{}{
+		"vendor_id":  deduction.VendorID.String(),
+		"section":    deduction.SectionCode,
+		"reference":  deduction.ReferenceID,
+		"rule":       deduction.Rule,
+		"tds_amount": deduction.TDSAmount,
+	})
+	return deduction, nil
+}
+
+// ReverseTDSDeduction removes a recorded deduction, e.g. when its payment
+// note is deleted
+func (s *vendorService) ReverseTDSDeduction(ctx context.Context, tenantID uuid.UUID, referenceID string) error {
+	return s.repo.DeleteTDSDeduction(ctx, tenantID, referenceID)
+}
+
+// GetVendorTDSSummary totals a vendor's recorded deductions per section for a
+// financial year, the current one when empty
+func (s *vendorService) GetVendorTDSSummary(ctx context.Context, tenantID, vendorID uuid.UUID, financialYear string) ([]*domain.TDSSectionSummary, error) {
+	if financialYear == "" {
+		financialYear = domain.FinancialYear(time.Now())
+	} else if err := domain.ValidateFinancialYear(financialYear); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetVendorByID(ctx, tenantID, vendorID); err != nil {
+		return nil, fmt.Errorf("failed to get vendor: %w", err)
+	}
+	return s.repo.GetVendorTDSSummary(ctx, tenantID, vendorID, financialYear)
+}
+
+// tdsVendor resolves the vendor of a TDS request by ID or code
+func (s *vendorService) tdsVendor(ctx context.Context, req ports.TDSRequest) (*domain.Vendor, error) {
+	var vendor *domain.Vendor
+	var err error
+	if req.VendorID != nil {
+		vendor, err = s.repo.GetVendorByID(ctx, req.TenantID, *req.VendorID)
+	} else {
+		vendor, err = s.repo.GetVendorByCode(ctx, req.TenantID, req.VendorCode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor: %w", err)
+	}
+	return vendor, nil
+}
+
+func (s *vendorService) computeTDS(ctx context.Context, vendor *domain.Vendor, req ports.TDSRequest) (*domain.TDSDeduction, error) {
+	section, err := s.repo.GetTDSSection(ctx, strings.ToUpper(strings.TrimSpace(req.SectionCode)))
+	if err != nil {
+		return nil, err
+	}
+	paymentDate := req.PaymentDate
+	if paymentDate.IsZero() {
+		paymentDate = time.Now()
+	}
+
+	history, err := s.repo.GetTDSVendorHistory(ctx, req.TenantID, vendor.ID, section.Code, domain.FinancialYear(paymentDate))
+	if err != nil {
+		return nil, err
+	}
+	cert, err := s.repo.GetApplicableTDSCertificate(ctx, req.TenantID, vendor.ID, section.Code, paymentDate)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ComputeTDS(section, vendor, req.Amount, paymentDate, history, cert)
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// createPendingAccount saves a new account on hold together with the CREATE
+// change request that releases it. A requested primary flag is applied on approval.
+func (s *vendorService) createPendingAccount(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount, requestedBy uuid.UUID) error {
+	newValues := account.Snapshot()
+	account.MarkPendingApproval()
+
+	if err := s.repo.CreateVendorAccount(ctx, account); err != nil {
+		return fmt.Errorf("failed to save account: %w", err)
+	}
+
+	_, err := s.requestAccountChange(ctx, tenantID, account, domain.AccountChangeCreate, nil, newValues, requestedBy)
+	return err
+}
+
+// requestAccountChange records a pending change for account. Only one change
+// per account may be open at a time.
+func (s *vendorService) requestAccountChange(ctx context.Context, tenantID uuid.UUID, account *domain.VendorAccount, changeType string, oldValues *domain.BankDetailsSnapshot, newValues domain.BankDetailsSnapshot, requestedBy uuid.UUID) (*domain.AccountChangeRequest, error) {
+	if _, err := s.repo.GetPendingAccountChange(ctx, account.ID); err == nil {
+		return nil, domain.ErrPendingChangeExists
+	} else if !errors.Is(err, domain.ErrAccountChangeNotFound) {
+		return nil, fmt.Errorf("failed to check pending changes: %w", err)
+	}
+
+	change, err := domain.NewAccountChangeRequest(tenantID, account, changeType, oldValues, newValues, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateAccountChangeRequest(ctx, change); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Vendor account change requested", map[string]interface{}{
+		"change_id":   change.ID.String(),
+		"account_id":  account.ID.String(),
+		"change_type": changeType,
+	})
+	return change, nil
+}
+
+// ListAccountChangeRequests lists bank account change requests of a tenant
+func (s *vendorService) ListAccountChangeRequests(ctx context.Context, tenantID uuid.UUID, filters ports.AccountChangeFilters) ([]*domain.AccountChangeRequest, int64, error) {
+	changes, total, err := s.repo.ListAccountChangeRequests(ctx, tenantID, filters)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list account change requests", err, map[string]interface{}{
+			"tenant_id": tenantID.String(),
+		})
+		return nil, 0, err
+	}
+	return changes, total, nil
+}
+
+// ApproveAccountChange applies a pending change. The reviewer must differ from
+// the requester. An account whose destination changed becomes payable only
+// after the configured cooling-off period.
+func (s *vendorService) ApproveAccountChange(ctx context.Context, tenantID, changeID, reviewerID uuid.UUID, remarks *string) (*domain.AccountChangeRequest, error) {
+	var change *domain.AccountChangeRequest
+	var account *domain.VendorAccount
+
+	err := s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		change, err = s.repo.GetAccountChangeRequest(txCtx, tenantID, changeID)
+		if err != nil {
+			return err
+		}
+		if err := change.Review(reviewerID, true, remarks); err != nil {
+			return err
+		}
+
+		account, err = s.repo.GetVendorAccountByID(txCtx, change.AccountID)
+		if err != nil {
+			return fmt.Errorf("failed to get account: %w", err)
+		}
+
+		switch change.ChangeType {
+		case domain.AccountChangeCreate, domain.AccountChangeUpdate:
+			account.ApplySnapshot(change.NewValues)
+			if change.ChangesDestination() {
+				account.Approve(reviewerID, s.config.AccountCoolingOff)
+			}
+		case domain.AccountChangeSetPrimary:
+			if account.ApprovalStatus != domain.AccountApprovalApproved {
+				return domain.ErrAccountNotApproved
+			}
+		}
+
+		if change.NewValues.IsPrimary && !account.IsPrimary {
+			if err := s.repo.UnsetPrimaryVendorAccounts(txCtx, account.VendorID, &account.ID); err != nil {
+				return fmt.Errorf("failed to unset primary accounts: %w", err)
+			}
+			account.SetPrimary()
+		}
+
+		if err := s.repo.UpdateVendorAccount(txCtx, account); err != nil {
+			return fmt.Errorf("failed to save approved account: %w", err)
+		}
+		if err := s.repo.UpdateAccountChangeReview(txCtx, change); err != nil {
+			return err
+		}
+
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishAccountUpdated(txCtx, tenantID, account); err != nil {
+				return fmt.Errorf("failed to record account updated event: %w", err)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		s.logger.Error(ctx, "Failed to approve account change", err, map[string]interface{}{
+			"change_id": changeID.String(),
+		})
+		return nil, err
+	}
+
+	fields := map[string]interface{}{
+		"change_id":   change.ID.String(),
+		"account_id":  account.ID.String(),
+		"approved_by": reviewerID.String(),
+	}
+	if account.PayableFrom != nil {
+		fields["payable_from"] = account.PayableFrom.Format(time.RFC3339)
+	}
+	s.logger.Info(ctx, "Vendor account change approved", fields)
+
+	return change, nil
+}
+
+// RejectAccountChange discards a pending change. Rejecting a new account
+// deactivates it.
+func (s *vendorService) RejectAccountChange(ctx context.Context, tenantID, changeID, reviewerID uuid.UUID, remarks *string) (*domain.AccountChangeRequest, error) {
+	var change *domain.AccountChangeRequest
+
+	err := s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		change, err = s.repo.GetAccountChangeRequest(txCtx, tenantID, changeID)
+		if err != nil {
+			return err
+		}
+		if err := change.Review(reviewerID, false, remarks); err != nil {
+			return err
+		}
+
+		if change.ChangeType == domain.AccountChangeCreate {
+			account, err := s.repo.GetVendorAccountByID(txCtx, change.AccountID)
+			if err != nil {
+				return fmt.Errorf("failed to get account: %w", err)
+			}
+			account.Reject()
+			if err := s.repo.UpdateVendorAccount(txCtx, account); err != nil {
+				return fmt.Errorf("failed to save rejected account: %w", err)
+			}
+		}
+
+		return s.repo.UpdateAccountChangeReview(txCtx, change)
+	})
+
+	if err != nil {
+		s.logger.Error(ctx, "Failed to reject account change", err, map[string]interface{}{
+			"change_id": changeID.String(),
+		})
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Vendor account change rejected", map[string]interface{}{
+		"change_id":   change.ID.String(),
+		"account_id":  change.AccountID.String(),
+		"rejected_by": reviewerID.String(),
+	})
+
+	return change, nil
+}
+
+// VerifyPayeeAccount tells a payment processor whether the tenant may pay the
+// given bank account: it must belong to a vendor, be approved and active, and
+// be past its cooling-off period.
+func (s *vendorService) VerifyPayeeAccount(ctx context.Context, tenantID uuid.UUID, accountNumber, ifscCode string) (*ports.PayeeVerification, error) {
+	accounts, err := s.repo.FindVendorAccountsByNumber(ctx, tenantID, accountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	ifscCode = strings.ToUpper(strings.TrimSpace(ifscCode))
+	now := time.Now()
+
+	var candidate *domain.VendorAccount
+	for _, acc := range accounts {
+		if ifscCode != "" && !strings.EqualFold(acc.IFSCCode, ifscCode) {
+			continue
+		}
+		if acc.IsPayable(now) {
+			return &ports.PayeeVerification{
+				Payable:     true,
+				VendorID:    &acc.VendorID,
+				AccountID:   &acc.ID,
+				PayableFrom: acc.PayableFrom,
+			}, nil
+		}
+		if candidate == nil {
+			candidate = acc
+		}
+	}
+
+	if candidate == nil {
+		return &ports.PayeeVerification{Reason: "account is not registered to any vendor"}, nil
+	}
+
+	result := &ports.PayeeVerification{
+		VendorID:    &candidate.VendorID,
+		AccountID:   &candidate.ID,
+		PayableFrom: candidate.PayableFrom,
+	}
+	switch {
+	case candidate.ApprovalStatus == domain.AccountApprovalPending:
+		result.Reason = "account is pending approval"
+	case candidate.ApprovalStatus == domain.AccountApprovalRejected:
+		result.Reason = "account was rejected"
+	case !candidate.IsActive:
+		result.Reason = "account is inactive"
+	default:
+		result.Reason = fmt.Sprintf("account is in its cooling-off period until %s", candidate.PayableFrom.Format(time.RFC3339))
+	}
+	return result, nil
+}
@@ -4,25 +4,28 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
 	"github.com/google/uuid"
 )
 
-// CreateVendorAccount creates a new vendor account (equivalent to PHP VendorService::createVendorAccount)
+// CreateVendorAccount creates a new vendor account (equivalent to PHP VendorService::createVendorAccount).
+// The account is created pending approval; it cannot be paid or made primary
+// until a different user approves the accompanying change request.
 func (s *vendorService) CreateVendorAccount(ctx context.Context, params domain.CreateVendorAccountParams) (*domain.VendorAccount, error) {
 	s.logger.Info(ctx, "Creating vendor account", map[string]interface{}{
 		"vendor_id":      params.VendorID.String(),
 		"account_name":   params.AccountName,
-		"account_number": params.AccountNumber,
+		"account_number": fieldcrypt.Mask(params.AccountNumber),
 		"is_primary":     params.IsPrimary,
 	})
 
 	var account *domain.VendorAccount
 
 	err := s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
-		// Verify vendor exists
-		_, err := s.repo.GetVendorByID(txCtx, uuid.Nil, params.VendorID) // We'll get tenant from vendor
+		// Verify vendor exists in the caller's tenant
+		_, err := s.repo.GetVendorByID(txCtx, params.TenantID, params.VendorID)
 		if err != nil {
 			return fmt.Errorf("vendor not found: %w", err)
 		}
@@ -33,20 +36,16 @@ func (s *vendorService) CreateVendorAccount(ctx context.Context, params domain.C
 			return fmt.Errorf("failed to create account entity: %w", err)
 		}
 
-		// If setting as primary, unset other primary accounts (equivalent to PHP logic)
-		if params.IsPrimary {
-			err = s.repo.UnsetPrimaryVendorAccounts(txCtx, params.VendorID, nil)
-			if err != nil {
-				return fmt.Errorf("failed to unset primary accounts: %w", err)
-			}
+		if err := s.createPendingAccount(txCtx, params.TenantID, account, params.CreatedBy); err != nil {
+			return err
 		}
 
-		// Save account to repository
-		err = s.repo.CreateVendorAccount(txCtx, account)
-		if err != nil {
-			return fmt.Errorf("failed to save account: %w", err)
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishAccountCreated(txCtx, params.TenantID, account); err != nil {
+				return fmt.Errorf("failed to record account created event: %w", err)
+			}
 		}
-
 		return nil
 	})
 
@@ -58,20 +57,9 @@ func (s *vendorService) CreateVendorAccount(ctx context.Context, params domain.C
 		return nil, err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishAccountCreated(ctx, account); err != nil {
-			s.logger.Warn(ctx, "Failed to publish account created event", map[string]interface{}{
-				"account_id": account.ID.String(),
-				"error":      err.Error(),
-			})
-		}
-	}
-
-	s.logger.Info(ctx, "Vendor account created successfully", map[string]interface{}{
-		"account_id":   account.ID.String(),
-		"vendor_id":    account.VendorID.String(),
-		"is_primary":   account.IsPrimary,
+	s.logger.Info(ctx, "Vendor account created pending approval", map[string]interface{}{
+		"account_id": account.ID.String(),
+		"vendor_id":  account.VendorID.String(),
 	})
 
 	return account, nil
@@ -150,13 +138,20 @@ func (s *vendorService) getVendorDirectBankingDetails(ctx context.Context, vendo
 	}, nil
 }
 
-// UpdateVendorAccount updates a vendor account (equivalent to PHP VendorService::updateVendorAccount)
+// UpdateVendorAccount updates a vendor account (equivalent to PHP VendorService::updateVendorAccount).
+// Status and remarks change immediately. Bank details and making the account
+// primary are recorded as a change request and only applied once approved.
 func (s *vendorService) UpdateVendorAccount(ctx context.Context, accountID uuid.UUID, params domain.UpdateVendorAccountParams) (*domain.VendorAccount, error) {
 	s.logger.Info(ctx, "Updating vendor account", map[string]interface{}{
 		"account_id": accountID.String(),
 	})
 
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
 	var account *domain.VendorAccount
+	var change *domain.AccountChangeRequest
 
 	err := s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
 		// Get existing account
@@ -165,18 +160,36 @@ func (s *vendorService) UpdateVendorAccount(ctx context.Context, accountID uuid.
 		if err != nil {
 			return fmt.Errorf("failed to get account: %w", err)
 		}
+		if _, err := s.repo.GetVendorByID(txCtx, params.TenantID, account.VendorID); err != nil {
+			return domain.ErrVendorAccountNotFound
+		}
+
+		wantsPrimary := params.IsPrimary != nil && *params.IsPrimary && !account.IsPrimary
+		if params.HasBankDetailChanges() || wantsPrimary {
+			changeType := domain.AccountChangeSetPrimary
+			newValues := account.Snapshot()
+			if params.HasBankDetailChanges() {
+				changeType = domain.AccountChangeUpdate
+				newValues = params.ApplyTo(newValues)
+			}
+			newValues.IsPrimary = account.IsPrimary || wantsPrimary
 
-		// If setting as primary and not already primary, unset other primary accounts
-		if params.IsPrimary != nil && *params.IsPrimary && !account.IsPrimary {
-			err = s.repo.UnsetPrimaryVendorAccounts(txCtx, account.VendorID, &accountID)
+			oldValues := account.Snapshot()
+			change, err = s.requestAccountChange(txCtx, params.TenantID, account, changeType, &oldValues, newValues, params.UpdatedBy)
 			if err != nil {
-				return fmt.Errorf("failed to unset primary accounts: %w", err)
+				return err
 			}
 		}
 
-		// Update account entity
-		err = account.Update(params)
-		if err != nil {
+		// Fields that do not redirect payments apply immediately
+		immediate := domain.UpdateVendorAccountParams{
+			IsActive: params.IsActive,
+			Remarks:  params.Remarks,
+		}
+		if params.IsPrimary != nil && !*params.IsPrimary {
+			immediate.IsPrimary = params.IsPrimary
+		}
+		if err := account.Update(immediate); err != nil {
 			return fmt.Errorf("failed to update account entity: %w", err)
 		}
 
@@ -186,6 +199,13 @@ func (s *vendorService) UpdateVendorAccount(ctx context.Context, accountID uuid.
 			return fmt.Errorf("failed to save updated account: %w", err)
 		}
 
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishAccountUpdated(txCtx, params.TenantID, account); err != nil {
+				return fmt.Errorf("failed to record account updated event: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -196,25 +216,20 @@ func (s *vendorService) UpdateVendorAccount(ctx context.Context, accountID uuid.
 		return nil, err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishAccountUpdated(ctx, account); err != nil {
-			s.logger.Warn(ctx, "Failed to publish account updated event", map[string]interface{}{
-				"account_id": account.ID.String(),
-				"error":      err.Error(),
-			})
-		}
-	}
-
-	s.logger.Info(ctx, "Vendor account updated successfully", map[string]interface{}{
+	fields := map[string]interface{}{
 		"account_id": account.ID.String(),
-	})
+	}
+	if change != nil {
+		fields["change_id"] = change.ID.String()
+		fields["change_type"] = change.ChangeType
+	}
+	s.logger.Info(ctx, "Vendor account updated successfully", fields)
 
 	return account, nil
 }
 
 // DeleteVendorAccount deletes a vendor account (equivalent to PHP VendorService::deleteVendorAccount)
-func (s *vendorService) DeleteVendorAccount(ctx context.Context, accountID uuid.UUID) error {
+func (s *vendorService) DeleteVendorAccount(ctx context.Context, tenantID, accountID uuid.UUID) error {
 	s.logger.Info(ctx, "Deleting vendor account", map[string]interface{}{
 		"account_id": accountID.String(),
 	})
@@ -228,6 +243,9 @@ func (s *vendorService) DeleteVendorAccount(ctx context.Context, accountID uuid.
 		if err != nil {
 			return fmt.Errorf("failed to get account: %w", err)
 		}
+		if _, err := s.repo.GetVendorByID(txCtx, tenantID, account.VendorID); err != nil {
+			return domain.ErrVendorAccountNotFound
+		}
 
 		vendorID = account.VendorID
 		wasPrimary = account.IsPrimary
@@ -247,7 +265,7 @@ func (s *vendorService) DeleteVendorAccount(ctx context.Context, accountID uuid.
 
 			// Find first active account to set as primary
 			for _, acc := range accounts {
-				if acc.IsActive {
+				if acc.IsActive && acc.ApprovalStatus == domain.AccountApprovalApproved {
 					acc.SetPrimary()
 					err = s.repo.UpdateVendorAccount(txCtx, acc)
 					if err != nil {
@@ -258,6 +276,13 @@ func (s *vendorService) DeleteVendorAccount(ctx context.Context, accountID uuid.
 			}
 		}
 
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishAccountDeleted(txCtx, tenantID, vendorID, accountID); err != nil {
+				return fmt.Errorf("failed to record account deleted event: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -268,16 +293,6 @@ func (s *vendorService) DeleteVendorAccount(ctx context.Context, accountID uuid.
 		return err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishAccountDeleted(ctx, accountID); err != nil {
-			s.logger.Warn(ctx, "Failed to publish account deleted event", map[string]interface{}{
-				"account_id": accountID.String(),
-				"error":      err.Error(),
-			})
-		}
-	}
-
 	s.logger.Info(ctx, "Vendor account deleted successfully", map[string]interface{}{
 		"account_id": accountID.String(),
 		"was_primary": wasPrimary,
@@ -287,7 +302,7 @@ func (s *vendorService) DeleteVendorAccount(ctx context.Context, accountID uuid.
 }
 
 // ToggleAccountStatus toggles account status (equivalent to PHP VendorService::toggleAccountStatus)
-func (s *vendorService) ToggleAccountStatus(ctx context.Context, accountID uuid.UUID) (*domain.VendorAccount, error) {
+func (s *vendorService) ToggleAccountStatus(ctx context.Context, tenantID, accountID uuid.UUID) (*domain.VendorAccount, error) {
 	s.logger.Info(ctx, "Toggling account status", map[string]interface{}{
 		"account_id": accountID.String(),
 	})
@@ -301,6 +316,9 @@ func (s *vendorService) ToggleAccountStatus(ctx context.Context, accountID uuid.
 		if err != nil {
 			return fmt.Errorf("failed to get account: %w", err)
 		}
+		if _, err := s.repo.GetVendorByID(txCtx, tenantID, account.VendorID); err != nil {
+			return domain.ErrVendorAccountNotFound
+		}
 
 		wasPrimary := account.IsPrimary
 		
@@ -316,7 +334,7 @@ func (s *vendorService) ToggleAccountStatus(ctx context.Context, accountID uuid.
 
 			// Find first active account to set as primary
 			for _, acc := range accounts {
-				if acc.ID != accountID && acc.IsActive {
+				if acc.ID != accountID && acc.IsActive && acc.ApprovalStatus == domain.AccountApprovalApproved {
 					acc.SetPrimary()
 					err = s.repo.UpdateVendorAccount(txCtx, acc)
 					if err != nil {
@@ -336,6 +354,13 @@ func (s *vendorService) ToggleAccountStatus(ctx context.Context, accountID uuid.
 			return fmt.Errorf("failed to save updated account: %w", err)
 		}
 
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishAccountUpdated(txCtx, tenantID, account); err != nil {
+				return fmt.Errorf("failed to record account updated event: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -346,16 +371,6 @@ func (s *vendorService) ToggleAccountStatus(ctx context.Context, accountID uuid.
 		return nil, err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishAccountUpdated(ctx, account); err != nil {
-			s.logger.Warn(ctx, "Failed to publish account updated event", map[string]interface{}{
-				"account_id": account.ID.String(),
-				"error":      err.Error(),
-			})
-		}
-	}
-
 	s.logger.Info(ctx, "Account status toggled successfully", map[string]interface{}{
 		"account_id": account.ID.String(),
 		"new_status": account.IsActive,
@@ -364,9 +379,11 @@ func (s *vendorService) ToggleAccountStatus(ctx context.Context, accountID uuid.
 	return account, nil
 }
 
-// SetPrimaryAccount sets an account as primary (equivalent to PHP VendorService::updateVendorAccount with is_primary=true)
-func (s *vendorService) SetPrimaryAccount(ctx context.Context, accountID uuid.UUID) (*domain.VendorAccount, error) {
-	s.logger.Info(ctx, "Setting account as primary", map[string]interface{}{
+// SetPrimaryAccount requests that an account becomes the vendor's primary account
+// (equivalent to PHP VendorService::updateVendorAccount with is_primary=true).
+// The switch happens when the change request is approved.
+func (s *vendorService) SetPrimaryAccount(ctx context.Context, tenantID, accountID, requestedBy uuid.UUID) (*domain.VendorAccount, error) {
+	s.logger.Info(ctx, "Requesting account as primary", map[string]interface{}{
 		"account_id": accountID.String(),
 	})
 
@@ -379,48 +396,31 @@ func (s *vendorService) SetPrimaryAccount(ctx context.Context, accountID uuid.UU
 		if err != nil {
 			return fmt.Errorf("failed to get account: %w", err)
 		}
+		if _, err := s.repo.GetVendorByID(txCtx, tenantID, account.VendorID); err != nil {
+			return domain.ErrVendorAccountNotFound
+		}
 
 		// Only proceed if not already primary
-		if !account.IsPrimary {
-			// Unset other primary accounts
-			err = s.repo.UnsetPrimaryVendorAccounts(txCtx, account.VendorID, &accountID)
-			if err != nil {
-				return fmt.Errorf("failed to unset primary accounts: %w", err)
-			}
-
-			// Set as primary
-			account.SetPrimary()
-
-			// Save updated account
-			err = s.repo.UpdateVendorAccount(txCtx, account)
-			if err != nil {
-				return fmt.Errorf("failed to save updated account: %w", err)
-			}
+		if account.IsPrimary {
+			return nil
+		}
+		if account.ApprovalStatus != domain.AccountApprovalApproved {
+			return domain.ErrAccountNotApproved
 		}
 
-		return nil
+		oldValues := account.Snapshot()
+		newValues := account.Snapshot()
+		newValues.IsPrimary = true
+		_, err = s.requestAccountChange(txCtx, tenantID, account, domain.AccountChangeSetPrimary, &oldValues, newValues, requestedBy)
+		return err
 	})
 
 	if err != nil {
-		s.logger.Error(ctx, "Failed to set account as primary", err, map[string]interface{}{
+		s.logger.Error(ctx, "Failed to request account as primary", err, map[string]interface{}{
 			"account_id": accountID.String(),
 		})
 		return nil, err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishAccountUpdated(ctx, account); err != nil {
-			s.logger.Warn(ctx, "Failed to publish account updated event", map[string]interface{}{
-				"account_id": account.ID.String(),
-				"error":      err.Error(),
-			})
-		}
-	}
-
-	s.logger.Info(ctx, "Account set as primary successfully", map[string]interface{}{
-		"account_id": account.ID.String(),
-	})
-
 	return account, nil
 }
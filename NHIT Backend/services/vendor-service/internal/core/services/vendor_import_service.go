@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// exportBatchSize is the page size used to read vendors for an export
+const exportBatchSize = 500
+
+// preparedImport is a validated import row ready to be saved
+type preparedImport struct {
+	rowNumber int
+	vendor    *domain.Vendor
+	account   *domain.CreateVendorAccountParams
+}
+
+// ImportVendors validates every row and, unless dryRun is set or a row
+// failed, creates all vendors and their bank accounts in one transaction.
+// Imported bank accounts wait for approval like any other new account.
+func (s *vendorService) ImportVendors(ctx context.Context, tenantID, createdBy uuid.UUID, rows []domain.VendorImportRow, dryRun, allowDuplicates bool) (*domain.VendorImportResult, error) {
+	s.logger.Info(ctx, "Importing vendors", map[string]interface{}{
+		"tenant_id": tenantID.String(),
+		"rows":      len(rows),
+		"dry_run":   dryRun,
+	})
+
+	result := &domain.VendorImportResult{
+		TotalRows:        len(rows),
+		DryRun:           dryRun,
+		Errors:           []domain.VendorImportError{},
+		CreatedVendorIDs: []uuid.UUID{},
+	}
+
+	prepared := make([]preparedImport, 0, len(rows))
+	seen := newImportSeen()
+	for _, row := range rows {
+		p, ok, err := s.prepareImportRow(ctx, tenantID, createdBy, row, allowDuplicates, seen, result)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			prepared = append(prepared, p)
+		}
+	}
+	result.ValidRows = len(prepared)
+
+	if dryRun || len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	err := s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		for _, p := range prepared {
+			if err := s.saveImportedVendor(txCtx, p, createdBy); err != nil {
+				return fmt.Errorf("row %d: %w", p.rowNumber, err)
+			}
+			if s.publisher != nil {
+				if err := s.publisher.PublishVendorCreated(txCtx, p.vendor); err != nil {
+					return fmt.Errorf("row %d: failed to record vendor created event: %w", p.rowNumber, err)
+				}
+			}
+			result.CreatedVendorIDs = append(result.CreatedVendorIDs, p.vendor.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Vendor import failed", err, map[string]interface{}{
+			"tenant_id": tenantID.String(),
+		})
+		return nil, err
+	}
+	result.Committed = true
+
+	s.logger.Info(ctx, "Vendors imported", map[string]interface{}{
+		"tenant_id": tenantID.String(),
+		"created":   len(result.CreatedVendorIDs),
+	})
+	return result, nil
+}
+
+// importSeen tracks identifiers already used by earlier rows of the same file
+type importSeen struct {
+	emails   map[string]int
+	pans     map[string]int
+	gstins   map[string]int
+	accounts map[string]int
+}
+
+func newImportSeen() *importSeen {
+	return &importSeen{
+		emails:   map[string]int{},
+		pans:     map[string]int{},
+		gstins:   map[string]int{},
+		accounts: map[string]int{},
+	}
+}
+
+// claim records key for row and returns the earlier row that used it, if any
+func claim(seen map[string]int, key string, row int) (int, bool) {
+	if key == "" {
+		return 0, false
+	}
+	if earlier, ok := seen[key]; ok {
+		return earlier, true
+	}
+	seen[key] = row
+	return 0, false
+}
+
+// prepareImportRow validates one row against the domain rules, earlier rows
+// and existing vendors. Problems are added to result; ok is false if any were found.
+func (s *vendorService) prepareImportRow(ctx context.Context, tenantID, createdBy uuid.UUID, row domain.VendorImportRow, allowDuplicates bool, seen *importSeen, result *domain.VendorImportResult) (preparedImport, bool, error) {
+	errCount := len(result.Errors)
+	for _, msg := range row.ParseErrors {
+		result.AddError(row.RowNumber, "", msg)
+	}
+
+	params := row.Vendor
+	params.TenantID = tenantID
+	params.CreatedBy = createdBy
+	params.AllowDuplicate = allowDuplicates
+
+	vendor, err := domain.NewVendor(params)
+	if err != nil {
+		result.AddError(row.RowNumber, domain.ImportErrorField(err), err.Error())
+		return preparedImport{}, false, nil
+	}
+
+	var account *domain.CreateVendorAccountParams
+	if row.Account != nil {
+		acc := *row.Account
+		acc.TenantID = tenantID
+		acc.VendorID = vendor.ID
+		acc.CreatedBy = createdBy
+		acc.IsPrimary = true
+		if acc.AccountName == "" {
+			acc.AccountName = vendor.BeneficiaryName
+		}
+		if err := acc.Validate(); err != nil {
+			result.AddError(row.RowNumber, domain.ImportErrorField(err), err.Error())
+		}
+		account = &acc
+	}
+
+	// Conflicts with earlier rows of the same file
+	if earlier, dup := claim(seen.emails, strings.ToLower(vendor.VendorEmail), row.RowNumber); dup {
+		result.AddError(row.RowNumber, "vendor_email", fmt.Sprintf("same vendor email as row %d", earlier))
+	}
+	if !allowDuplicates {
+		if earlier, dup := claim(seen.pans, strings.ToUpper(vendor.PAN), row.RowNumber); dup {
+			result.AddError(row.RowNumber, "pan", fmt.Sprintf("same PAN as row %d", earlier))
+		}
+	}
+	if vendor.GSTIN != nil {
+		if earlier, dup := claim(seen.gstins, *vendor.GSTIN, row.RowNumber); dup {
+			result.AddError(row.RowNumber, "gstin", fmt.Sprintf("same GSTIN as row %d", earlier))
+		}
+	}
+	if account != nil {
+		key := strings.ToUpper(strings.ReplaceAll(account.AccountNumber, " ", "")) + "/" + strings.ToUpper(account.IFSCCode)
+		if earlier, dup := claim(seen.accounts, key, row.RowNumber); dup {
+			result.AddError(row.RowNumber, "bank_account_number", fmt.Sprintf("same bank account as row %d", earlier))
+		}
+	}
+
+	// Conflicts with existing vendors
+	exists, err := s.repo.IsVendorEmailExists(ctx, tenantID, vendor.VendorEmail, nil)
+	if err != nil {
+		return preparedImport{}, false, fmt.Errorf("failed to check email existence: %w", err)
+	}
+	if exists {
+		result.AddError(row.RowNumber, "vendor_email", domain.ErrVendorEmailExists.Error())
+	}
+
+	check := params
+	check.GSTIN = vendor.GSTIN
+	if account != nil {
+		check.AccountNumber = &account.AccountNumber
+		check.IFSCCode = &account.IFSCCode
+	}
+	matches, err := s.FindDuplicateVendors(ctx, check)
+	if err != nil {
+		return preparedImport{}, false, fmt.Errorf("failed to check duplicate vendors: %w", err)
+	}
+	if err := domain.DuplicateCheck(matches, allowDuplicates); err != nil {
+		result.AddError(row.RowNumber, "", err.Error())
+	}
+
+	if len(result.Errors) > errCount {
+		return preparedImport{}, false, nil
+	}
+	return preparedImport{rowNumber: row.RowNumber, vendor: vendor, account: account}, true, nil
+}
+
+// saveImportedVendor stores a prepared vendor and its pending bank account
+func (s *vendorService) saveImportedVendor(ctx context.Context, p preparedImport, createdBy uuid.UUID) error {
+	if err := s.ensureUniqueVendorCode(ctx, p.vendor); err != nil {
+		return fmt.Errorf("failed to ensure unique vendor code: %w", err)
+	}
+	s.applyCompliance(ctx, p.vendor)
+
+	if err := s.repo.CreateVendor(ctx, p.vendor); err != nil {
+		return fmt.Errorf("failed to save vendor: %w", err)
+	}
+	if p.account == nil {
+		return nil
+	}
+
+	account, err := domain.NewVendorAccount(*p.account)
+	if err != nil {
+		return err
+	}
+	return s.createPendingAccount(ctx, p.vendor.TenantID, account, createdBy)
+}
+
+// ExportVendors returns every vendor matching filters with its primary bank
+// account (nil when the vendor has none). Limit and Offset of filters are ignored.
+func (s *vendorService) ExportVendors(ctx context.Context, tenantID uuid.UUID, filters ports.VendorListFilters) ([]domain.VendorExportRow, error) {
+	var rows []domain.VendorExportRow
+
+	filters.Limit = exportBatchSize
+	for offset := 0; ; offset += exportBatchSize {
+		filters.Offset = offset
+		vendors, total, err := s.repo.ListVendors(ctx, tenantID, filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list vendors: %w", err)
+		}
+
+		for _, v := range vendors {
+			account, err := s.repo.GetPrimaryVendorAccount(ctx, v.ID)
+			if err != nil && !errors.Is(err, domain.ErrVendorAccountNotFound) {
+				return nil, fmt.Errorf("failed to get primary account of vendor %s: %w", v.VendorCode, err)
+			}
+			rows = append(rows, domain.VendorExportRow{Vendor: v, PrimaryAccount: account})
+		}
+
+		if len(vendors) == 0 || int64(offset+len(vendors)) >= total {
+			break
+		}
+	}
+
+	s.logger.Info(ctx, "Vendors exported", map[string]interface{}{
+		"tenant_id": tenantID.String(),
+		"count":     len(rows),
+	})
+	return rows, nil
+}
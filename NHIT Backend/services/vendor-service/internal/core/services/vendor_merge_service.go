@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// FindDuplicateVendors returns existing vendors of the tenant that share PAN,
+// GSTIN, normalised name or bank account (number and IFSC) with params.
+func (s *vendorService) FindDuplicateVendors(ctx context.Context, params domain.CreateVendorParams) ([]domain.DuplicateMatch, error) {
+	matches, err := s.repo.FindDuplicateVendors(ctx, params.TenantID, ports.DuplicateCriteria{
+		PAN:        strings.ToUpper(strings.TrimSpace(params.PAN)),
+		GSTIN:      params.GSTIN,
+		VendorName: params.VendorName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if params.AccountNumber == nil || *params.AccountNumber == "" || params.IFSCCode == nil {
+		return matches, nil
+	}
+
+	accounts, err := s.repo.FindVendorAccountsByNumber(ctx, params.TenantID, *params.AccountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to match bank account: %w", err)
+	}
+	for _, acc := range accounts {
+		if !strings.EqualFold(acc.IFSCCode, strings.TrimSpace(*params.IFSCCode)) {
+			continue
+		}
+		matches, err = s.addBankAccountMatch(ctx, params.TenantID, matches, acc.VendorID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return matches, nil
+}
+
+// addBankAccountMatch marks vendorID as a bank account match, adding it to
+// matches if it is not there yet
+func (s *vendorService) addBankAccountMatch(ctx context.Context, tenantID uuid.UUID, matches []domain.DuplicateMatch, vendorID uuid.UUID) ([]domain.DuplicateMatch, error) {
+	for i := range matches {
+		if matches[i].VendorID == vendorID {
+			matches[i].MatchedOn = append(matches[i].MatchedOn, domain.DuplicateOnBankAccount)
+			return matches, nil
+		}
+	}
+
+	vendor, err := s.repo.GetVendorByID(ctx, tenantID, vendorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matching vendor: %w", err)
+	}
+	return append(matches, domain.DuplicateMatch{
+		VendorID:   vendor.ID,
+		VendorCode: vendor.VendorCode,
+		VendorName: vendor.VendorName,
+		MatchedOn:  []string{domain.DuplicateOnBankAccount},
+	}), nil
+}
+
+// MergeVendors folds mergedID into survivorID: accounts, change requests,
+// invoices, portal users and TDS records move to the survivor and the merged
+// vendor is deactivated. The merged vendor is kept so that records elsewhere
+// that reference it can be re-pointed from the vendor.merged event.
+func (s *vendorService) MergeVendors(ctx context.Context, tenantID, survivorID, mergedID, mergedBy uuid.UUID, reason *string) (*domain.VendorMerge, error) {
+	s.logger.Info(ctx, "Merging vendors", map[string]interface{}{
+		"tenant_id":          tenantID.String(),
+		"survivor_vendor_id": survivorID.String(),
+		"merged_vendor_id":   mergedID.String(),
+	})
+
+	survivor, err := s.repo.GetVendorByID(ctx, tenantID, survivorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get survivor vendor: %w", err)
+	}
+	merged, err := s.repo.GetVendorByID(ctx, tenantID, mergedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merged vendor: %w", err)
+	}
+
+	for _, id := range []uuid.UUID{survivorID, mergedID} {
+		isMerged, err := s.repo.IsVendorMerged(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check merge state: %w", err)
+		}
+		if isMerged {
+			return nil, domain.ErrVendorAlreadyMerged
+		}
+	}
+
+	merge, err := domain.NewVendorMerge(survivor, merged, mergedBy, reason)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.repo.MergeVendors(txCtx, merge); err != nil {
+			return err
+		}
+
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishVendorMerged(txCtx, merge); err != nil {
+				return fmt.Errorf("failed to record vendor merged event: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrVendorAlreadyMerged) {
+			s.logger.Error(ctx, "Failed to merge vendors", err, map[string]interface{}{
+				"survivor_vendor_id": survivorID.String(),
+				"merged_vendor_id":   mergedID.String(),
+			})
+		}
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Vendors merged", map[string]interface{}{
+		"merge_id":           merge.ID.String(),
+		"survivor_vendor_id": survivorID.String(),
+		"merged_vendor_code": merge.MergedVendorCode,
+		"accounts_moved":     merge.AccountsMoved,
+	})
+	return merge, nil
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
+	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// LinkVendorUser lets userID act for vendorID on the vendor portal
+func (s *vendorService) LinkVendorUser(ctx context.Context, tenantID, vendorID, userID, linkedBy uuid.UUID) (*domain.VendorUser, error) {
+	if _, err := s.repo.GetVendorByID(ctx, tenantID, vendorID); err != nil {
+		return nil, fmt.Errorf("failed to get vendor: %w", err)
+	}
+
+	link, err := domain.NewVendorUser(tenantID, vendorID, userID, linkedBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateVendorUser(ctx, link); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Vendor user linked", map[string]interface{}{
+		"vendor_id": vendorID.String(),
+		"user_id":   userID.String(),
+	})
+	return link, nil
+}
+
+// UnlinkVendorUser revokes a user's access to the vendor portal
+func (s *vendorService) UnlinkVendorUser(ctx context.Context, tenantID, userID uuid.UUID) error {
+	return s.repo.DeleteVendorUser(ctx, tenantID, userID)
+}
+
+// ListVendorUsers returns the users linked to a vendor
+func (s *vendorService) ListVendorUsers(ctx context.Context, tenantID, vendorID uuid.UUID) ([]*domain.VendorUser, error) {
+	return s.repo.ListVendorUsers(ctx, tenantID, vendorID)
+}
+
+// GetVendorUser returns the vendor a user acts for, or ErrVendorUserNotLinked
+func (s *vendorService) GetVendorUser(ctx context.Context, tenantID, userID uuid.UUID) (*domain.VendorUser, error) {
+	return s.repo.GetVendorUserByUserID(ctx, tenantID, userID)
+}
+
+// UpdateVendorContact changes the contact details of a vendor on its own behalf
+func (s *vendorService) UpdateVendorContact(ctx context.Context, tenantID, vendorID uuid.UUID, update domain.VendorContactUpdate) (*domain.Vendor, error) {
+	return s.UpdateVendor(ctx, tenantID, vendorID, update.UpdateParams())
+}
+
+// SubmitVendorInvoice records an invoice submitted by a vendor and publishes
+// it for the green note service to open a draft green note
+func (s *vendorService) SubmitVendorInvoice(ctx context.Context, params domain.SubmitVendorInvoiceParams) (*domain.VendorInvoice, error) {
+	vendor, err := s.repo.GetVendorByID(ctx, params.TenantID, params.VendorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vendor: %w", err)
+	}
+
+	invoice, err := domain.NewVendorInvoice(params)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.repo.CreateVendorInvoice(txCtx, invoice); err != nil {
+			return err
+		}
+
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishVendorInvoiceSubmitted(txCtx, invoice, vendor); err != nil {
+				return fmt.Errorf("failed to record vendor invoice submitted event: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Vendor invoice submitted", map[string]interface{}{
+		"invoice_id":     invoice.ID.String(),
+		"vendor_id":      vendor.ID.String(),
+		"invoice_number": invoice.InvoiceNumber,
+	})
+	return invoice, nil
+}
+
+// AddVendorInvoiceDocument records a supporting document already uploaded to
+// storage. With vendorID set, the invoice must belong to that vendor.
+func (s *vendorService) AddVendorInvoiceDocument(ctx context.Context, tenantID uuid.UUID, vendorID *uuid.UUID, doc *domain.VendorInvoiceDocument) error {
+	invoice, err := s.getScopedVendorInvoice(ctx, tenantID, doc.InvoiceID, vendorID)
+	if err != nil {
+		return err
+	}
+	if !invoice.AcceptsDocuments() {
+		return domain.ErrInvoiceClosed
+	}
+	return s.repo.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.repo.CreateVendorInvoiceDocument(txCtx, doc); err != nil {
+			return err
+		}
+
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishVendorInvoiceDocumentAdded(txCtx, invoice, doc); err != nil {
+				return fmt.Errorf("failed to record invoice document event: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetVendorInvoice returns an invoice with its documents. With vendorID set,
+// invoices of other vendors are reported as not found.
+func (s *vendorService) GetVendorInvoice(ctx context.Context, tenantID, invoiceID uuid.UUID, vendorID *uuid.UUID) (*domain.VendorInvoice, error) {
+	invoice, err := s.getScopedVendorInvoice(ctx, tenantID, invoiceID, vendorID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Documents, err = s.repo.GetVendorInvoiceDocuments(ctx, invoice.ID); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// ListVendorInvoices lists portal invoices of a tenant
+func (s *vendorService) ListVendorInvoices(ctx context.Context, tenantID uuid.UUID, filters ports.VendorInvoiceFilters) ([]*domain.VendorInvoice, int64, error) {
+	return s.repo.ListVendorInvoices(ctx, tenantID, filters)
+}
+
+// UpdateVendorInvoiceStatus applies progress reported by the green note and
+// payment services, such as the linked green note or the payment UTR
+func (s *vendorService) UpdateVendorInvoiceStatus(ctx context.Context, tenantID, invoiceID uuid.UUID, update domain.VendorInvoiceStatusUpdate) (*domain.VendorInvoice, error) {
+	invoice, err := s.repo.GetVendorInvoice(ctx, tenantID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := invoice.ApplyStatusUpdate(update); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateVendorInvoiceStatus(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Vendor invoice status updated", map[string]interface{}{
+		"invoice_id": invoice.ID.String(),
+		"status":     invoice.Status,
+	})
+	return invoice, nil
+}
+
+func (s *vendorService) getScopedVendorInvoice(ctx context.Context, tenantID, invoiceID uuid.UUID, vendorID *uuid.UUID) (*domain.VendorInvoice, error) {
+	invoice, err := s.repo.GetVendorInvoice(ctx, tenantID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if vendorID != nil && invoice.VendorID != *vendorID {
+		return nil, domain.ErrVendorInvoiceNotFound
+	}
+	return invoice, nil
+}
@@ -3,7 +3,6 @@ package services
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/domain"
 	"github.com/ShristiRnr/NHIT_Backend/services/vendor-service/internal/core/ports"
@@ -14,8 +13,9 @@ import (
 type vendorService struct {
 	repo      ports.VendorRepository
 	logger    ports.Logger
-	publisher ports.EventPublisher
-	config    ports.VendorServiceConfig
+	publisher  ports.EventPublisher
+	compliance ports.ComplianceLookup
+	config     ports.VendorServiceConfig
 }
 
 // NewVendorService creates a new vendor service instance
@@ -23,13 +23,15 @@ func NewVendorService(
 	repo ports.VendorRepository,
 	logger ports.Logger,
 	publisher ports.EventPublisher,
+	compliance ports.ComplianceLookup,
 	config ports.VendorServiceConfig,
 ) ports.VendorService {
 	return &vendorService{
-		repo:      repo,
-		logger:    logger,
-		publisher: publisher,
-		config:    config,
+		repo:       repo,
+		logger:     logger,
+		publisher:  publisher,
+		compliance: compliance,
+		config:     config,
 	}
 }
 
@@ -55,11 +57,21 @@ func (s *vendorService) CreateVendor(ctx context.Context, params domain.CreateVe
 			return domain.ErrVendorEmailExists
 		}
 
+		// Reject likely duplicates (same PAN, GSTIN, name or bank account)
+		matches, err := s.FindDuplicateVendors(txCtx, params)
+		if err != nil {
+			return fmt.Errorf("failed to check duplicate vendors: %w", err)
+		}
+		if err := domain.DuplicateCheck(matches, params.AllowDuplicate); err != nil {
+			return err
+		}
+
 		// Create vendor domain entity
 		vendor, err = domain.NewVendor(params)
 		if err != nil {
 			return fmt.Errorf("failed to create vendor entity: %w", err)
 		}
+		s.applyCompliance(txCtx, vendor)
 
 		// Ensure vendor code is unique
 		err = s.ensureUniqueVendorCode(txCtx, vendor)
@@ -73,9 +85,11 @@ func (s *vendorService) CreateVendor(ctx context.Context, params domain.CreateVe
 			return fmt.Errorf("failed to save vendor: %w", err)
 		}
 
-		// Create primary account if banking details provided (equivalent to PHP logic)
+		// Create primary account if banking details provided (equivalent to PHP logic).
+		// Like any other account it waits for approval before it becomes primary.
 		if params.AccountNumber != nil && params.NameOfBank != nil && params.IFSCCode != nil {
 			accountParams := domain.CreateVendorAccountParams{
+				TenantID:      vendor.TenantID,
 				VendorID:      vendor.ID,
 				AccountName:   vendor.VendorName,
 				AccountNumber: *params.AccountNumber,
@@ -90,12 +104,19 @@ func (s *vendorService) CreateVendor(ctx context.Context, params domain.CreateVe
 				return fmt.Errorf("failed to create primary account: %w", err)
 			}
 
-			err = s.repo.CreateVendorAccount(txCtx, primaryAccount)
+			err = s.createPendingAccount(txCtx, vendor.TenantID, primaryAccount, params.CreatedBy)
 			if err != nil {
 				return fmt.Errorf("failed to save primary account: %w", err)
 			}
 		}
 
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishVendorCreated(txCtx, vendor); err != nil {
+				return fmt.Errorf("failed to record vendor created event: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -107,16 +128,6 @@ func (s *vendorService) CreateVendor(ctx context.Context, params domain.CreateVe
 		return nil, err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishVendorCreated(ctx, vendor); err != nil {
-			s.logger.Warn(ctx, "Failed to publish vendor created event", map[string]interface{}{
-				"vendor_id": vendor.ID.String(),
-				"error":     err.Error(),
-			})
-		}
-	}
-
 	s.logger.Info(ctx, "Vendor created successfully", map[string]interface{}{
 		"vendor_id":   vendor.ID.String(),
 		"vendor_code": vendor.VendorCode,
@@ -125,6 +136,23 @@ func (s *vendorService) CreateVendor(ctx context.Context, params domain.CreateVe
 	return vendor, nil
 }
 
+// applyCompliance flags GST default and Section 206AB status from the
+// compliance lookup. Lookup failures keep the flags as supplied.
+func (s *vendorService) applyCompliance(ctx context.Context, vendor *domain.Vendor) {
+	if s.compliance == nil {
+		return
+	}
+	result, err := s.compliance.LookupCompliance(ctx, vendor.GSTIN, vendor.PAN)
+	if err != nil {
+		s.logger.Warn(ctx, "Compliance lookup failed", map[string]interface{}{
+			"vendor_id": vendor.ID.String(),
+			"error":     err.Error(),
+		})
+		return
+	}
+	vendor.ApplyCompliance(result.GSTDefaulted, result.Section206ABVerified)
+}
+
 // GetVendorByID retrieves a vendor by ID
 func (s *vendorService) GetVendorByID(ctx context.Context, tenantID, vendorID uuid.UUID) (*domain.Vendor, error) {
 	vendor, err := s.repo.GetVendorByID(ctx, tenantID, vendorID)
@@ -186,6 +214,9 @@ func (s *vendorService) UpdateVendor(ctx context.Context, tenantID, vendorID uui
 		if err != nil {
 			return fmt.Errorf("failed to update vendor entity: %w", err)
 		}
+		if params.GSTIN != nil || params.PAN != nil {
+			s.applyCompliance(txCtx, vendor)
+		}
 
 		// Save updated vendor
 		err = s.repo.UpdateVendor(txCtx, vendor)
@@ -193,6 +224,13 @@ func (s *vendorService) UpdateVendor(ctx context.Context, tenantID, vendorID uui
 			return fmt.Errorf("failed to save updated vendor: %w", err)
 		}
 
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishVendorUpdated(txCtx, vendor); err != nil {
+				return fmt.Errorf("failed to record vendor updated event: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -203,16 +241,6 @@ func (s *vendorService) UpdateVendor(ctx context.Context, tenantID, vendorID uui
 		return nil, err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishVendorUpdated(ctx, vendor); err != nil {
-			s.logger.Warn(ctx, "Failed to publish vendor updated event", map[string]interface{}{
-				"vendor_id": vendor.ID.String(),
-				"error":     err.Error(),
-			})
-		}
-	}
-
 	s.logger.Info(ctx, "Vendor updated successfully", map[string]interface{}{
 		"vendor_id": vendor.ID.String(),
 	})
@@ -240,6 +268,13 @@ func (s *vendorService) DeleteVendor(ctx context.Context, tenantID, vendorID uui
 			return fmt.Errorf("failed to delete vendor: %w", err)
 		}
 
+		// Publish domain event
+		if s.publisher != nil {
+			if err := s.publisher.PublishVendorDeleted(txCtx, tenantID, vendorID); err != nil {
+				return fmt.Errorf("failed to record vendor deleted event: %w", err)
+			}
+		}
+
 		return nil
 	})
 
@@ -250,16 +285,6 @@ func (s *vendorService) DeleteVendor(ctx context.Context, tenantID, vendorID uui
 		return err
 	}
 
-	// Publish domain event
-	if s.publisher != nil {
-		if err := s.publisher.PublishVendorDeleted(ctx, tenantID, vendorID); err != nil {
-			s.logger.Warn(ctx, "Failed to publish vendor deleted event", map[string]interface{}{
-				"vendor_id": vendorID.String(),
-				"error":     err.Error(),
-			})
-		}
-	}
-
 	s.logger.Info(ctx, "Vendor deleted successfully", map[string]interface{}{
 		"vendor_id": vendorID.String(),
 	})
@@ -409,25 +434,32 @@ func (s *vendorService) RegenerateVendorCode(ctx context.Context, tenantID, vend
 	return vendor, nil
 }
 
-// ensureUniqueVendorCode ensures vendor code is unique by adding suffix if needed
+// ensureUniqueVendorCode numbers a generated vendor code from the tenant's
+// counter for it: the first vendor keeps the code, later ones get code-2,
+// code-3 and so on. Must run in the transaction that saves the vendor, which
+// holds the counter until it commits. Numbers whose code is already taken,
+// by a hand-set code or one from before the counter, are skipped.
 func (s *vendorService) ensureUniqueVendorCode(ctx context.Context, vendor *domain.Vendor) error {
-	originalCode := vendor.VendorCode
-	attempts := 0
-	maxAttempts := 10
+	baseCode := vendor.VendorCode
+	maxAttempts := 100
 
-	for attempts < maxAttempts {
-		exists, err := s.repo.IsVendorCodeExists(ctx, vendor.TenantID, vendor.VendorCode, &vendor.ID)
+	for attempts := 0; attempts < maxAttempts; attempts++ {
+		n, err := s.repo.NextVendorCodeNumber(ctx, vendor.TenantID, baseCode)
 		if err != nil {
 			return err
 		}
+		vendor.VendorCode = baseCode
+		if n > 1 {
+			vendor.VendorCode = fmt.Sprintf("%s-%d", baseCode, n)
+		}
 
+		exists, err := s.repo.IsVendorCodeExists(ctx, vendor.TenantID, vendor.VendorCode, &vendor.ID)
+		if err != nil {
+			return err
+		}
 		if !exists {
 			return nil
 		}
-
-		// Add suffix to make it unique
-		attempts++
-		vendor.VendorCode = fmt.Sprintf("%s-%d", originalCode, time.Now().Unix()%1000+int64(attempts))
 	}
 
 	return fmt.Errorf("failed to generate unique vendor code after %d attempts", maxAttempts)
@@ -0,0 +1,18 @@
+-- Store vendor bank account numbers and IFSC codes encrypted at rest.
+-- Values are written in the fieldcrypt envelope format
+-- (enc:v1:<key id>:<wrapped data key>:<ciphertext>), which does not fit the
+-- original VARCHAR limits.
+--
+-- Existing plaintext rows keep working (reads pass them through unchanged) and
+-- are encrypted by running: go run ./cmd/encrypt-bank-data
+
+ALTER TABLE vendors ALTER COLUMN account_number TYPE TEXT;
+ALTER TABLE vendors ALTER COLUMN ifsc_code TYPE TEXT;
+
+ALTER TABLE vendor_accounts ALTER COLUMN account_number TYPE TEXT;
+ALTER TABLE vendor_accounts ALTER COLUMN ifsc_code TYPE TEXT;
+
+COMMENT ON COLUMN vendors.account_number IS 'Bank account number (envelope encrypted)';
+COMMENT ON COLUMN vendors.ifsc_code IS 'IFSC code (envelope encrypted)';
+COMMENT ON COLUMN vendor_accounts.account_number IS 'Bank account number (envelope encrypted)';
+COMMENT ON COLUMN vendor_accounts.ifsc_code IS 'IFSC code (envelope encrypted)';
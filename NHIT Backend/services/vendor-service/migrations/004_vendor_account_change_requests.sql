@@ -0,0 +1,48 @@
+-- Maker-checker approval for vendor bank account changes.
+--
+-- Creating an account, changing its bank details or making it primary no
+-- longer takes effect immediately: a change request is recorded and must be
+-- approved by a different user. Approved accounts only become payable after
+-- a cooling-off period (payable_from).
+
+-- Filled for existing rows by running: go run ./cmd/encrypt-bank-data
+ALTER TABLE vendor_accounts ADD COLUMN IF NOT EXISTS account_number_hash VARCHAR(64);
+-- Existing accounts were in use before approvals existed and stay payable
+ALTER TABLE vendor_accounts ADD COLUMN IF NOT EXISTS approval_status VARCHAR(20) NOT NULL DEFAULT 'APPROVED';
+ALTER TABLE vendor_accounts ADD COLUMN IF NOT EXISTS approved_by UUID;
+ALTER TABLE vendor_accounts ADD COLUMN IF NOT EXISTS approved_at TIMESTAMP;
+ALTER TABLE vendor_accounts ADD COLUMN IF NOT EXISTS payable_from TIMESTAMP;
+
+ALTER TABLE vendor_accounts ADD CONSTRAINT chk_vendor_accounts_approval_status
+    CHECK (approval_status IN ('PENDING', 'APPROVED', 'REJECTED'));
+
+COMMENT ON COLUMN vendor_accounts.account_number_hash IS 'Blind index (HMAC-SHA256) of the account number for lookups';
+COMMENT ON COLUMN vendor_accounts.payable_from IS 'Payments to this account are refused before this time';
+
+CREATE INDEX IF NOT EXISTS idx_vendor_accounts_number_hash ON vendor_accounts(account_number_hash);
+
+CREATE TABLE IF NOT EXISTS vendor_account_change_requests (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    vendor_id UUID NOT NULL REFERENCES vendors(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES vendor_accounts(id) ON DELETE CASCADE,
+    change_type VARCHAR(20) NOT NULL CHECK (change_type IN ('CREATE', 'UPDATE', 'SET_PRIMARY')),
+    -- JSON snapshots of the bank details, envelope encrypted
+    old_values TEXT,
+    new_values TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED', 'CANCELLED')),
+    requested_by UUID NOT NULL,
+    requested_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP,
+    review_remarks TEXT,
+    CONSTRAINT chk_vendor_account_change_reviewer CHECK (reviewed_by IS NULL OR reviewed_by <> requested_by)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vendor_account_changes_tenant_status ON vendor_account_change_requests(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_vendor_account_changes_vendor ON vendor_account_change_requests(vendor_id);
+
+-- At most one open change per account
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vendor_account_changes_one_pending
+ON vendor_account_change_requests(account_id)
+WHERE status = 'PENDING';
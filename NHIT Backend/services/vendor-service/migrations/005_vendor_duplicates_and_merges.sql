@@ -0,0 +1,37 @@
+-- Duplicate vendor detection and vendor merges.
+--
+-- Vendor names are compared on a normalised key: lower-cased, punctuation
+-- removed and legal-form words (pvt, ltd, llp, ...) dropped, so that
+-- "ABC Pvt. Ltd." and "abc private limited" collide. The same expression is
+-- used by the duplicate lookup query and must be kept in sync with it.
+
+CREATE INDEX IF NOT EXISTS idx_vendors_tenant_pan ON vendors(tenant_id, pan);
+CREATE INDEX IF NOT EXISTS idx_vendors_tenant_gstin ON vendors(tenant_id, UPPER(gstin));
+CREATE INDEX IF NOT EXISTS idx_vendors_tenant_name_key ON vendors(
+    tenant_id,
+    regexp_replace(
+        regexp_replace(
+            regexp_replace(LOWER(vendor_name), '[^a-z0-9]+', ' ', 'g'),
+            '\m(the|ms|pvt|private|ltd|limited|llp|inc|co|company|corp|corporation)\M', '', 'g'),
+        '[^a-z0-9]', '', 'g')
+);
+
+-- One row per vendor merged into another. The merged vendor is kept (set
+-- INACTIVE) so historical references by ID still resolve.
+CREATE TABLE IF NOT EXISTS vendor_merges (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    survivor_vendor_id UUID NOT NULL REFERENCES vendors(id),
+    merged_vendor_id UUID NOT NULL REFERENCES vendors(id),
+    merged_vendor_code VARCHAR(100) NOT NULL,
+    merged_vendor_name VARCHAR(255) NOT NULL,
+    accounts_moved INTEGER NOT NULL DEFAULT 0,
+    reason TEXT,
+    merged_by UUID NOT NULL,
+    merged_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_vendor_merges_distinct CHECK (survivor_vendor_id <> merged_vendor_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vendor_merges_merged ON vendor_merges(merged_vendor_id);
+CREATE INDEX IF NOT EXISTS idx_vendor_merges_survivor ON vendor_merges(survivor_vendor_id);
+CREATE INDEX IF NOT EXISTS idx_vendor_merges_tenant ON vendor_merges(tenant_id, merged_at DESC);
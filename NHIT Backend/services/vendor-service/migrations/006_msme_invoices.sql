@@ -0,0 +1,49 @@
+-- MSME payment-due tracking (MSMED Act, 2006, Sections 15 and 16).
+--
+-- Invoices of micro and small enterprise vendors are tracked against their
+-- statutory due date: the agreed credit period (at most 45 days) or 15 days
+-- from acceptance. Green notes and payment notes are linked by ID so the note
+-- services can show at-risk and overdue flags.
+
+CREATE TABLE IF NOT EXISTS msme_invoices (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    vendor_id UUID NOT NULL REFERENCES vendors(id),
+    -- Vendor details as of registration, reported in the MSME-1 return
+    vendor_code VARCHAR(100) NOT NULL,
+    vendor_name VARCHAR(255) NOT NULL,
+    msme_classification VARCHAR(20) NOT NULL CHECK (msme_classification IN ('MICRO', 'SMALL')),
+    msme_registration_number VARCHAR(100) NOT NULL,
+    invoice_number VARCHAR(100) NOT NULL,
+    invoice_date DATE NOT NULL,
+    acceptance_date DATE,
+    agreed_credit_days INTEGER CHECK (agreed_credit_days >= 0),
+    invoice_amount NUMERIC(18, 2) NOT NULL CHECK (invoice_amount > 0),
+    due_date DATE NOT NULL,
+    green_note_id VARCHAR(100),
+    payment_note_id VARCHAR(100),
+    paid_date DATE,
+    payment_reference VARCHAR(100),
+    flagged_status VARCHAR(20) NOT NULL DEFAULT 'OPEN'
+        CHECK (flagged_status IN ('OPEN', 'AT_RISK', 'OVERDUE', 'PAID', 'PAID_LATE')),
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_msme_invoices_dates CHECK (
+        (acceptance_date IS NULL OR acceptance_date >= invoice_date)
+        AND (paid_date IS NULL OR paid_date >= invoice_date)
+    )
+);
+
+COMMENT ON COLUMN msme_invoices.due_date IS 'Last day to pay without Section 16 interest';
+COMMENT ON COLUMN msme_invoices.flagged_status IS 'Last status published to the note services';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_msme_invoices_vendor_invoice
+ON msme_invoices(tenant_id, vendor_id, invoice_number);
+
+CREATE INDEX IF NOT EXISTS idx_msme_invoices_tenant_due ON msme_invoices(tenant_id, due_date);
+CREATE INDEX IF NOT EXISTS idx_msme_invoices_green_note ON msme_invoices(green_note_id) WHERE green_note_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_msme_invoices_payment_note ON msme_invoices(payment_note_id) WHERE payment_note_id IS NOT NULL;
+
+-- Unpaid invoices scanned for at-risk and overdue flags
+CREATE INDEX IF NOT EXISTS idx_msme_invoices_unpaid_due ON msme_invoices(due_date) WHERE paid_date IS NULL;
@@ -0,0 +1,60 @@
+-- Vendor self-service portal.
+--
+-- A vendor user is a regular login (user-service) linked to exactly one
+-- vendor; portal APIs are scoped to that vendor. Invoices submitted through
+-- the portal become draft green notes in the green note service, which
+-- reports progress, payment status and the UTR back.
+
+CREATE TABLE IF NOT EXISTS vendor_users (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    vendor_id UUID NOT NULL REFERENCES vendors(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    linked_by UUID NOT NULL,
+    linked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A login acts for one vendor only
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vendor_users_user ON vendor_users(user_id);
+CREATE INDEX IF NOT EXISTS idx_vendor_users_vendor ON vendor_users(vendor_id);
+
+CREATE TABLE IF NOT EXISTS vendor_invoices (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    vendor_id UUID NOT NULL REFERENCES vendors(id),
+    invoice_number VARCHAR(100) NOT NULL,
+    invoice_date DATE NOT NULL,
+    invoice_amount NUMERIC(18, 2) NOT NULL CHECK (invoice_amount > 0),
+    po_number VARCHAR(100),
+    description TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'SUBMITTED'
+        CHECK (status IN ('SUBMITTED', 'UNDER_REVIEW', 'APPROVED', 'REJECTED', 'PAID')),
+    green_note_id VARCHAR(100),
+    payment_note_id VARCHAR(100),
+    utr_number VARCHAR(50),
+    paid_amount NUMERIC(18, 2),
+    paid_at TIMESTAMP,
+    rejection_reason TEXT,
+    submitted_by UUID NOT NULL,
+    submitted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_vendor_invoices_paid_utr CHECK (status <> 'PAID' OR utr_number IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_vendor_invoices_number
+ON vendor_invoices(tenant_id, vendor_id, invoice_number);
+CREATE INDEX IF NOT EXISTS idx_vendor_invoices_tenant_status ON vendor_invoices(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_vendor_invoices_green_note ON vendor_invoices(green_note_id) WHERE green_note_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS vendor_invoice_documents (
+    id UUID PRIMARY KEY,
+    invoice_id UUID NOT NULL REFERENCES vendor_invoices(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    object_path TEXT NOT NULL,
+    uploaded_by UUID NOT NULL,
+    uploaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_vendor_invoice_documents_invoice ON vendor_invoice_documents(invoice_id);
@@ -0,0 +1,78 @@
+-- TDS engine: section master, Section 197 lower/nil deduction certificates
+-- and the ledger of recorded deductions used for threshold tracking.
+
+CREATE TABLE IF NOT EXISTS tds_sections (
+    code VARCHAR(20) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL,
+    -- Individuals and HUFs (fourth PAN character P or H)
+    rate_individual NUMERIC(6, 3) NOT NULL CHECK (rate_individual BETWEEN 0 AND 100),
+    rate_other NUMERIC(6, 3) NOT NULL CHECK (rate_other BETWEEN 0 AND 100),
+    -- Section 206AA floor when the vendor has no valid PAN
+    rate_no_pan NUMERIC(6, 3) NOT NULL DEFAULT 20 CHECK (rate_no_pan BETWEEN 0 AND 100),
+    -- 0 means no threshold
+    single_threshold NUMERIC(18, 2) NOT NULL DEFAULT 0 CHECK (single_threshold >= 0),
+    annual_threshold NUMERIC(18, 2) NOT NULL DEFAULT 0 CHECK (annual_threshold >= 0),
+    -- Deduct only on the aggregate above annual_threshold (194Q)
+    deduct_on_excess BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by UUID,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Rates and thresholds as of FY 2025-26; maintain through UpsertTDSSection
+INSERT INTO tds_sections (code, description, rate_individual, rate_other, rate_no_pan, single_threshold, annual_threshold, deduct_on_excess)
+VALUES
+    ('194A', 'Interest other than interest on securities', 10, 10, 20, 0, 10000, FALSE),
+    ('194C', 'Payments to contractors and sub-contractors', 1, 2, 20, 30000, 100000, FALSE),
+    ('194H', 'Commission or brokerage', 2, 2, 20, 0, 20000, FALSE),
+    ('194I-A', 'Rent on plant, machinery or equipment', 2, 2, 20, 50000, 0, FALSE),
+    ('194I-B', 'Rent on land, building or furniture', 10, 10, 20, 50000, 0, FALSE),
+    ('194J-A', 'Fees for technical services', 2, 2, 20, 0, 50000, FALSE),
+    ('194J-B', 'Fees for professional services', 10, 10, 20, 0, 50000, FALSE),
+    ('194Q', 'Purchase of goods', 0.1, 0.1, 5, 0, 5000000, TRUE)
+ON CONFLICT (code) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS tds_certificates (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    vendor_id UUID NOT NULL REFERENCES vendors(id) ON DELETE CASCADE,
+    section_code VARCHAR(20) NOT NULL REFERENCES tds_sections(code),
+    certificate_number VARCHAR(50) NOT NULL,
+    -- 0 for a nil deduction certificate
+    rate NUMERIC(6, 3) NOT NULL CHECK (rate BETWEEN 0 AND 100),
+    valid_from DATE NOT NULL,
+    valid_to DATE NOT NULL,
+    amount_limit NUMERIC(18, 2) NOT NULL CHECK (amount_limit > 0),
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_tds_certificates_period CHECK (valid_to >= valid_from)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tds_certificates_number ON tds_certificates(tenant_id, certificate_number);
+CREATE INDEX IF NOT EXISTS idx_tds_certificates_vendor ON tds_certificates(vendor_id, section_code);
+
+CREATE TABLE IF NOT EXISTS tds_deductions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    vendor_id UUID NOT NULL REFERENCES vendors(id),
+    section_code VARCHAR(20) NOT NULL REFERENCES tds_sections(code),
+    financial_year VARCHAR(7) NOT NULL,
+    -- Caller's reference, e.g. "payment-note:PN-0001"
+    reference_id VARCHAR(100) NOT NULL,
+    payment_date DATE NOT NULL,
+    amount NUMERIC(18, 2) NOT NULL CHECK (amount > 0),
+    aggregate_before NUMERIC(18, 2) NOT NULL,
+    taxable_amount NUMERIC(18, 2) NOT NULL,
+    rate NUMERIC(6, 3) NOT NULL,
+    rule VARCHAR(40) NOT NULL,
+    certificate_id UUID REFERENCES tds_certificates(id),
+    certificate_amount NUMERIC(18, 2) NOT NULL DEFAULT 0,
+    certificate_rate NUMERIC(6, 3) NOT NULL DEFAULT 0,
+    tds_amount NUMERIC(18, 2) NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tds_deductions_reference ON tds_deductions(tenant_id, reference_id);
+CREATE INDEX IF NOT EXISTS idx_tds_deductions_vendor_year ON tds_deductions(tenant_id, vendor_id, section_code, financial_year);
+CREATE INDEX IF NOT EXISTS idx_tds_deductions_certificate ON tds_deductions(certificate_id) WHERE certificate_id IS NOT NULL;
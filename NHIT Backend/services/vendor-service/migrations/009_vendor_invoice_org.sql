@@ -0,0 +1,4 @@
+-- Organisation a portal invoice was submitted in. The green note service opens
+-- the invoice's draft green note in this organisation.
+
+ALTER TABLE vendor_invoices ADD COLUMN IF NOT EXISTS org_id UUID;
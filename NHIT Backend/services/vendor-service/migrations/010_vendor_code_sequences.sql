@@ -0,0 +1,14 @@
+-- Vendor codes are unique within a tenant rather than across all tenants,
+-- matching how they are checked and looked up (and the consolidated schema).
+ALTER TABLE vendors DROP CONSTRAINT IF EXISTS vendors_vendor_code_key;
+CREATE UNIQUE INDEX IF NOT EXISTS vendors_tenant_vendor_code_unique ON vendors(tenant_id, vendor_code);
+
+-- Counter per tenant and generated code. The n-th vendor given a code keeps
+-- it for n = 1 and gets code-n after that; drawing the number locks the row,
+-- so concurrent creations cannot get the same one.
+CREATE TABLE IF NOT EXISTS vendor_code_sequences (
+    tenant_id UUID NOT NULL,
+    base_code VARCHAR(100) NOT NULL,
+    last_value BIGINT NOT NULL,
+    PRIMARY KEY (tenant_id, base_code)
+);
@@ -0,0 +1,18 @@
+-- Transactional outbox for vendor events.
+--
+-- Events are inserted in the transaction that makes the change, so an event
+-- exists exactly when its change was committed. The outbox relay publishes
+-- them to Kafka in insertion order and deletes them once Kafka has them; a
+-- failed write is retried on the next poll.
+CREATE TABLE IF NOT EXISTS vendor_event_outbox (
+    seq BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    event_type VARCHAR(100) NOT NULL,
+    schema_version INTEGER NOT NULL,
+    tenant_id VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_attempt_at TIMESTAMP
+);
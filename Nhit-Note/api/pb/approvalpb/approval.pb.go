@@ -29,8 +29,13 @@ type InitiateApprovalRequest struct {
 	ProjectId       string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	CreatedByUserId string                 `protobuf:"bytes,5,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
 	SourceType      string                 `protobuf:"bytes,6,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	ExpenseCategory string                 `protobuf:"bytes,7,opt,name=expense_category,json=expenseCategory,proto3" json:"expense_category,omitempty"`
+	ApprovalFor     string                 `protobuf:"bytes,8,opt,name=approval_for,json=approvalFor,proto3" json:"approval_for,omitempty"` // INVOICE, ADVANCE or ADHOC
+	// Designations whose delegated financial powers cover the amount, most
+	// junior first; empty when the organization has no powers matrix
+	ApproverDesignationIds []string `protobuf:"bytes,9,rep,name=approver_designation_ids,json=approverDesignationIds,proto3" json:"approver_designation_ids,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *InitiateApprovalRequest) Reset() {
@@ -105,6 +110,27 @@ func (x *InitiateApprovalRequest) GetSourceType() string {
 	return ""
 }
 
+func (x *InitiateApprovalRequest) GetExpenseCategory() string {
+	if x != nil {
+		return x.ExpenseCategory
+	}
+	return ""
+}
+
+func (x *InitiateApprovalRequest) GetApprovalFor() string {
+	if x != nil {
+		return x.ApprovalFor
+	}
+	return ""
+}
+
+func (x *InitiateApprovalRequest) GetApproverDesignationIds() []string {
+	if x != nil {
+		return x.ApproverDesignationIds
+	}
+	return nil
+}
+
 type InitiateApprovalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -169,7 +195,7 @@ var File_api_proto_approval_proto protoreflect.FileDescriptor
 
 const file_api_proto_approval_proto_rawDesc = "" +
 	"\n" +
-	"\x18api/proto/approval.proto\x12\bapproval\"\xd8\x01\n" +
+	"\x18api/proto/approval.proto\x12\bapproval\"\xe0\x02\n" +
 	"\x17InitiateApprovalRequest\x12\x13\n" +
 	"\x05sl_no\x18\x01 \x01(\tR\x04slNo\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12#\n" +
@@ -178,7 +204,10 @@ const file_api_proto_approval_proto_rawDesc = "" +
 	"project_id\x18\x04 \x01(\tR\tprojectId\x12+\n" +
 	"\x12created_by_user_id\x18\x05 \x01(\tR\x0fcreatedByUserId\x12\x1f\n" +
 	"\vsource_type\x18\x06 \x01(\tR\n" +
-	"sourceType\"f\n" +
+	"sourceType\x12)\n" +
+	"\x10expense_category\x18\a \x01(\tR\x0fexpenseCategory\x12!\n" +
+	"\fapproval_for\x18\b \x01(\tR\vapprovalFor\x128\n" +
+	"\x18approver_designation_ids\x18\t \x03(\tR\x16approverDesignationIds\"f\n" +
 	"\x18InitiateApprovalResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
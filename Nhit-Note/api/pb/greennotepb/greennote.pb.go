@@ -23,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SupplyType int32
+
+const (
+	SupplyType_SUPPLY_TYPE_UNSPECIFIED SupplyType = 0
+	SupplyType_SUPPLY_TYPE_INTRA_STATE SupplyType = 1 // CGST + SGST
+	SupplyType_SUPPLY_TYPE_INTER_STATE SupplyType = 2 // IGST
+)
+
+// Enum value maps for SupplyType.
+var (
+	SupplyType_name = map[int32]string{
+		0: "SUPPLY_TYPE_UNSPECIFIED",
+		1: "SUPPLY_TYPE_INTRA_STATE",
+		2: "SUPPLY_TYPE_INTER_STATE",
+	}
+	SupplyType_value = map[string]int32{
+		"SUPPLY_TYPE_UNSPECIFIED": 0,
+		"SUPPLY_TYPE_INTRA_STATE": 1,
+		"SUPPLY_TYPE_INTER_STATE": 2,
+	}
+)
+
+func (x SupplyType) Enum() *SupplyType {
+	p := new(SupplyType)
+	*p = x
+	return p
+}
+
+func (x SupplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SupplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_greennote_proto_enumTypes[0].Descriptor()
+}
+
+func (SupplyType) Type() protoreflect.EnumType {
+	return &file_api_proto_greennote_proto_enumTypes[0]
+}
+
+func (x SupplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SupplyType.Descriptor instead.
+func (SupplyType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{0}
+}
+
 type ApprovalFor int32
 
 const (
@@ -59,11 +108,11 @@ func (x ApprovalFor) String() string {
 }
 
 func (ApprovalFor) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_greennote_proto_enumTypes[0].Descriptor()
+	return file_api_proto_greennote_proto_enumTypes[1].Descriptor()
 }
 
 func (ApprovalFor) Type() protoreflect.EnumType {
-	return &file_api_proto_greennote_proto_enumTypes[0]
+	return &file_api_proto_greennote_proto_enumTypes[1]
 }
 
 func (x ApprovalFor) Number() protoreflect.EnumNumber {
@@ -72,7 +121,7 @@ func (x ApprovalFor) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApprovalFor.Descriptor instead.
 func (ApprovalFor) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{1}
 }
 
 type ExpenseCategoryType int32
@@ -117,11 +166,11 @@ func (x ExpenseCategoryType) String() string {
 }
 
 func (ExpenseCategoryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_greennote_proto_enumTypes[1].Descriptor()
+	return file_api_proto_greennote_proto_enumTypes[2].Descriptor()
 }
 
 func (ExpenseCategoryType) Type() protoreflect.EnumType {
-	return &file_api_proto_greennote_proto_enumTypes[1]
+	return &file_api_proto_greennote_proto_enumTypes[2]
 }
 
 func (x ExpenseCategoryType) Number() protoreflect.EnumNumber {
@@ -130,7 +179,7 @@ func (x ExpenseCategoryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExpenseCategoryType.Descriptor instead.
 func (ExpenseCategoryType) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{2}
 }
 
 type NatureOfExpenses int32
@@ -169,11 +218,11 @@ func (x NatureOfExpenses) String() string {
 }
 
 func (NatureOfExpenses) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_greennote_proto_enumTypes[2].Descriptor()
+	return file_api_proto_greennote_proto_enumTypes[3].Descriptor()
 }
 
 func (NatureOfExpenses) Type() protoreflect.EnumType {
-	return &file_api_proto_greennote_proto_enumTypes[2]
+	return &file_api_proto_greennote_proto_enumTypes[3]
 }
 
 func (x NatureOfExpenses) Number() protoreflect.EnumNumber {
@@ -182,7 +231,7 @@ func (x NatureOfExpenses) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NatureOfExpenses.Descriptor instead.
 func (NatureOfExpenses) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{3}
 }
 
 type Status int32
@@ -224,11 +273,11 @@ func (x Status) String() string {
 }
 
 func (Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_greennote_proto_enumTypes[3].Descriptor()
+	return file_api_proto_greennote_proto_enumTypes[4].Descriptor()
 }
 
 func (Status) Type() protoreflect.EnumType {
-	return &file_api_proto_greennote_proto_enumTypes[3]
+	return &file_api_proto_greennote_proto_enumTypes[4]
 }
 
 func (x Status) Number() protoreflect.EnumNumber {
@@ -237,7 +286,7 @@ func (x Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Status.Descriptor instead.
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{4}
 }
 
 type YesNo int32
@@ -273,11 +322,11 @@ func (x YesNo) String() string {
 }
 
 func (YesNo) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_greennote_proto_enumTypes[4].Descriptor()
+	return file_api_proto_greennote_proto_enumTypes[5].Descriptor()
 }
 
 func (YesNo) Type() protoreflect.EnumType {
-	return &file_api_proto_greennote_proto_enumTypes[4]
+	return &file_api_proto_greennote_proto_enumTypes[5]
 }
 
 func (x YesNo) Number() protoreflect.EnumNumber {
@@ -286,7 +335,7 @@ func (x YesNo) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use YesNo.Descriptor instead.
 func (YesNo) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{5}
 }
 
 // =======================
@@ -563,6 +612,7 @@ type GreenNoteListItem struct {
 	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`                      // total_amount
 	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`                            // formatted created_at
 	Status        Status                 `protobuf:"varint,6,opt,name=status,proto3,enum=greennote.Status" json:"status,omitempty"` // P, A, R, D
+	AmountExact   *Money                 `protobuf:"bytes,7,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -639,6 +689,83 @@ func (x *GreenNoteListItem) GetStatus() Status {
 	return Status_STATUS_APPROVED
 }
 
+func (x *GreenNoteListItem) GetAmountExact() *Money {
+	if x != nil {
+		return x.AmountExact
+	}
+	return nil
+}
+
+// =======================
+// MONEY
+// =======================
+// Money is an exact rupee amount with two decimal places. value is the
+// decimal string ("1234.50"); units and nanos carry the same amount like
+// google.type.Money. On input value wins over units/nanos when both are set.
+//
+// Amount doubles on notes, invoices and invoice lines have a Money twin named
+// <field>_exact. Requests may set either; when the twin is set the double is
+// ignored. Responses always set both, so clients reading doubles keep working.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_api_proto_greennote_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Money) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
 // =======================
 // FULL PAYLOAD (DETAIL VIEW)
 // =======================
@@ -704,13 +831,35 @@ type GreenNotePayload struct {
 	NewDocuments                   []*SupportingDocumentUpload `protobuf:"bytes,53,rep,name=new_documents,json=newDocuments,proto3" json:"new_documents,omitempty"`
 	ExistingDocuments              []*SupportingDocument       `protobuf:"bytes,54,rep,name=existing_documents,json=existingDocuments,proto3" json:"existing_documents,omitempty"`
 	DetailedStatus                 string                      `protobuf:"bytes,55,opt,name=detailed_status,json=detailedStatus,proto3" json:"detailed_status,omitempty"`
-	unknownFields                  protoimpl.UnknownFields
-	sizeCache                      protoimpl.SizeCache
+	// GSTIN of the organisation registration the invoices are billed to; its
+	// state decides between CGST+SGST and IGST
+	OrganizationGstin                   string `protobuf:"bytes,56,opt,name=organization_gstin,json=organizationGstin,proto3" json:"organization_gstin,omitempty"`
+	BaseValueExact                      *Money `protobuf:"bytes,57,opt,name=base_value_exact,json=baseValueExact,proto3" json:"base_value_exact,omitempty"`
+	OtherChargesExact                   *Money `protobuf:"bytes,58,opt,name=other_charges_exact,json=otherChargesExact,proto3" json:"other_charges_exact,omitempty"`
+	GstExact                            *Money `protobuf:"bytes,59,opt,name=gst_exact,json=gstExact,proto3" json:"gst_exact,omitempty"`
+	TotalAmountExact                    *Money `protobuf:"bytes,60,opt,name=total_amount_exact,json=totalAmountExact,proto3" json:"total_amount_exact,omitempty"`
+	BudgetExpenditureExact              *Money `protobuf:"bytes,61,opt,name=budget_expenditure_exact,json=budgetExpenditureExact,proto3" json:"budget_expenditure_exact,omitempty"`
+	ActualExpenditureExact              *Money `protobuf:"bytes,62,opt,name=actual_expenditure_exact,json=actualExpenditureExact,proto3" json:"actual_expenditure_exact,omitempty"`
+	ExpenditureOverBudgetExact          *Money `protobuf:"bytes,63,opt,name=expenditure_over_budget_exact,json=expenditureOverBudgetExact,proto3" json:"expenditure_over_budget_exact,omitempty"`
+	AmountRetainedForNonSubmissionExact *Money `protobuf:"bytes,64,opt,name=amount_retained_for_non_submission_exact,json=amountRetainedForNonSubmissionExact,proto3" json:"amount_retained_for_non_submission_exact,omitempty"`
+	// Approval history, oldest first; filled on read and ignored on write
+	ApprovalLogs []*ApprovalLogEntry `protobuf:"bytes,65,rep,name=approval_logs,json=approvalLogs,proto3" json:"approval_logs,omitempty"`
+	// Latest MSME payment warning from vendor-service; filled on read and
+	// ignored on write
+	MsmeFlag *MSMEFlag `protobuf:"bytes,66,opt,name=msme_flag,json=msmeFlag,proto3" json:"msme_flag,omitempty"`
+	// vendor-service ID of the supplier; looked up from supplier_name when not
+	// set, and moved to the surviving vendor when vendors are merged
+	SupplierId string `protobuf:"bytes,67,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	// Vendor portal invoice the note was opened from; set by the service and
+	// ignored on write
+	VendorInvoiceId string `protobuf:"bytes,68,opt,name=vendor_invoice_id,json=vendorInvoiceId,proto3" json:"vendor_invoice_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GreenNotePayload) Reset() {
 	*x = GreenNotePayload{}
-	mi := &file_api_proto_greennote_proto_msgTypes[6]
+	mi := &file_api_proto_greennote_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +871,7 @@ func (x *GreenNotePayload) String() string {
 func (*GreenNotePayload) ProtoMessage() {}
 
 func (x *GreenNotePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[6]
+	mi := &file_api_proto_greennote_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +884,7 @@ func (x *GreenNotePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GreenNotePayload.ProtoReflect.Descriptor instead.
 func (*GreenNotePayload) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GreenNotePayload) GetProjectName() string {
@@ -1095,118 +1244,126 @@ func (x *GreenNotePayload) GetDetailedStatus() string {
 	return ""
 }
 
-type InvoiceInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InvoiceNumber string                 `protobuf:"bytes,1,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
-	InvoiceDate   string                 `protobuf:"bytes,2,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
-	TaxableValue  float64                `protobuf:"fixed64,3,opt,name=taxable_value,json=taxableValue,proto3" json:"taxable_value,omitempty"`
-	Gst           float64                `protobuf:"fixed64,4,opt,name=gst,proto3" json:"gst,omitempty"`
-	OtherCharges  float64                `protobuf:"fixed64,5,opt,name=other_charges,json=otherCharges,proto3" json:"other_charges,omitempty"`
-	InvoiceValue  float64                `protobuf:"fixed64,6,opt,name=invoice_value,json=invoiceValue,proto3" json:"invoice_value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GreenNotePayload) GetOrganizationGstin() string {
+	if x != nil {
+		return x.OrganizationGstin
+	}
+	return ""
 }
 
-func (x *InvoiceInput) Reset() {
-	*x = InvoiceInput{}
-	mi := &file_api_proto_greennote_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GreenNotePayload) GetBaseValueExact() *Money {
+	if x != nil {
+		return x.BaseValueExact
+	}
+	return nil
 }
 
-func (x *InvoiceInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GreenNotePayload) GetOtherChargesExact() *Money {
+	if x != nil {
+		return x.OtherChargesExact
+	}
+	return nil
 }
 
-func (*InvoiceInput) ProtoMessage() {}
+func (x *GreenNotePayload) GetGstExact() *Money {
+	if x != nil {
+		return x.GstExact
+	}
+	return nil
+}
 
-func (x *InvoiceInput) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[7]
+func (x *GreenNotePayload) GetTotalAmountExact() *Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TotalAmountExact
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use InvoiceInput.ProtoReflect.Descriptor instead.
-func (*InvoiceInput) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{7}
+func (x *GreenNotePayload) GetBudgetExpenditureExact() *Money {
+	if x != nil {
+		return x.BudgetExpenditureExact
+	}
+	return nil
 }
 
-func (x *InvoiceInput) GetInvoiceNumber() string {
+func (x *GreenNotePayload) GetActualExpenditureExact() *Money {
 	if x != nil {
-		return x.InvoiceNumber
+		return x.ActualExpenditureExact
 	}
-	return ""
+	return nil
 }
 
-func (x *InvoiceInput) GetInvoiceDate() string {
+func (x *GreenNotePayload) GetExpenditureOverBudgetExact() *Money {
 	if x != nil {
-		return x.InvoiceDate
+		return x.ExpenditureOverBudgetExact
 	}
-	return ""
+	return nil
 }
 
-func (x *InvoiceInput) GetTaxableValue() float64 {
+func (x *GreenNotePayload) GetAmountRetainedForNonSubmissionExact() *Money {
 	if x != nil {
-		return x.TaxableValue
+		return x.AmountRetainedForNonSubmissionExact
 	}
-	return 0
+	return nil
 }
 
-func (x *InvoiceInput) GetGst() float64 {
+func (x *GreenNotePayload) GetApprovalLogs() []*ApprovalLogEntry {
 	if x != nil {
-		return x.Gst
+		return x.ApprovalLogs
 	}
-	return 0
+	return nil
 }
 
-func (x *InvoiceInput) GetOtherCharges() float64 {
+func (x *GreenNotePayload) GetMsmeFlag() *MSMEFlag {
 	if x != nil {
-		return x.OtherCharges
+		return x.MsmeFlag
 	}
-	return 0
+	return nil
 }
 
-func (x *InvoiceInput) GetInvoiceValue() float64 {
+func (x *GreenNotePayload) GetSupplierId() string {
 	if x != nil {
-		return x.InvoiceValue
+		return x.SupplierId
 	}
-	return 0
+	return ""
 }
 
-type SupportingDocument struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OriginalFilename string                 `protobuf:"bytes,3,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
-	MimeType         string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	FileSize         int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	ObjectKey        string                 `protobuf:"bytes,6,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GreenNotePayload) GetVendorInvoiceId() string {
+	if x != nil {
+		return x.VendorInvoiceId
+	}
+	return ""
 }
 
-func (x *SupportingDocument) Reset() {
-	*x = SupportingDocument{}
+// vendor-service's warning that an MSME invoice on the note is close to or
+// past its statutory payment date (MSMED Act, Section 15), or was paid late.
+type MSMEFlag struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId              string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	InvoiceNumber          string                 `protobuf:"bytes,2,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	Status                 string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                                  // AT_RISK, OVERDUE or PAID_LATE
+	DueDate                string                 `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`                                 // YYYY-MM-DD
+	InterestLiability      float64                `protobuf:"fixed64,5,opt,name=interest_liability,json=interestLiability,proto3" json:"interest_liability,omitempty"` // Section 16 interest owed
+	InterestLiabilityExact *Money                 `protobuf:"bytes,6,opt,name=interest_liability_exact,json=interestLiabilityExact,proto3" json:"interest_liability_exact,omitempty"`
+	FlaggedAt              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=flagged_at,json=flaggedAt,proto3" json:"flagged_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MSMEFlag) Reset() {
+	*x = MSMEFlag{}
 	mi := &file_api_proto_greennote_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SupportingDocument) String() string {
+func (x *MSMEFlag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SupportingDocument) ProtoMessage() {}
+func (*MSMEFlag) ProtoMessage() {}
 
-func (x *SupportingDocument) ProtoReflect() protoreflect.Message {
+func (x *MSMEFlag) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_greennote_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1218,91 +1375,91 @@ func (x *SupportingDocument) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SupportingDocument.ProtoReflect.Descriptor instead.
-func (*SupportingDocument) Descriptor() ([]byte, []int) {
+// Deprecated: Use MSMEFlag.ProtoReflect.Descriptor instead.
+func (*MSMEFlag) Descriptor() ([]byte, []int) {
 	return file_api_proto_greennote_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SupportingDocument) GetId() string {
+func (x *MSMEFlag) GetInvoiceId() string {
 	if x != nil {
-		return x.Id
+		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *SupportingDocument) GetName() string {
+func (x *MSMEFlag) GetInvoiceNumber() string {
 	if x != nil {
-		return x.Name
+		return x.InvoiceNumber
 	}
 	return ""
 }
 
-func (x *SupportingDocument) GetOriginalFilename() string {
+func (x *MSMEFlag) GetStatus() string {
 	if x != nil {
-		return x.OriginalFilename
+		return x.Status
 	}
 	return ""
 }
 
-func (x *SupportingDocument) GetMimeType() string {
+func (x *MSMEFlag) GetDueDate() string {
 	if x != nil {
-		return x.MimeType
+		return x.DueDate
 	}
 	return ""
 }
 
-func (x *SupportingDocument) GetFileSize() int64 {
+func (x *MSMEFlag) GetInterestLiability() float64 {
 	if x != nil {
-		return x.FileSize
+		return x.InterestLiability
 	}
 	return 0
 }
 
-func (x *SupportingDocument) GetObjectKey() string {
-	if x != nil {
-		return x.ObjectKey
-	}
-	return ""
-}
-
-func (x *SupportingDocument) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MSMEFlag) GetInterestLiabilityExact() *Money {
 	if x != nil {
-		return x.CreatedAt
+		return x.InterestLiabilityExact
 	}
 	return nil
 }
 
-func (x *SupportingDocument) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *MSMEFlag) GetFlaggedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.FlaggedAt
 	}
 	return nil
 }
 
-type SupportingDocumentUpload struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	OriginalFilename string                 `protobuf:"bytes,2,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
-	MimeType         string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	FileContent      []byte                 `protobuf:"bytes,4,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *SupportingDocumentUpload) Reset() {
-	*x = SupportingDocumentUpload{}
+// One status change reported by the approval service. A delegate approving for
+// a user on leave is recorded with the user they acted for.
+type ApprovalLogEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ActorId        string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorName      string                 `protobuf:"bytes,3,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	OnBehalfOfId   string                 `protobuf:"bytes,4,opt,name=on_behalf_of_id,json=onBehalfOfId,proto3" json:"on_behalf_of_id,omitempty"`
+	OnBehalfOfName string                 `protobuf:"bytes,5,opt,name=on_behalf_of_name,json=onBehalfOfName,proto3" json:"on_behalf_of_name,omitempty"`
+	DelegationId   string                 `protobuf:"bytes,6,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id,omitempty"`
+	Comments       string                 `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	Description    string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"` // e.g. "APPROVED by A on behalf of B"
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApprovalLogEntry) Reset() {
+	*x = ApprovalLogEntry{}
 	mi := &file_api_proto_greennote_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SupportingDocumentUpload) String() string {
+func (x *ApprovalLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SupportingDocumentUpload) ProtoMessage() {}
+func (*ApprovalLogEntry) ProtoMessage() {}
 
-func (x *SupportingDocumentUpload) ProtoReflect() protoreflect.Message {
+func (x *ApprovalLogEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_greennote_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1314,37 +1471,613 @@ func (x *SupportingDocumentUpload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SupportingDocumentUpload.ProtoReflect.Descriptor instead.
-func (*SupportingDocumentUpload) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApprovalLogEntry.ProtoReflect.Descriptor instead.
+func (*ApprovalLogEntry) Descriptor() ([]byte, []int) {
 	return file_api_proto_greennote_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SupportingDocumentUpload) GetName() string {
+func (x *ApprovalLogEntry) GetStatus() string {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
 	return ""
 }
 
-func (x *SupportingDocumentUpload) GetOriginalFilename() string {
+func (x *ApprovalLogEntry) GetActorId() string {
 	if x != nil {
-		return x.OriginalFilename
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *SupportingDocumentUpload) GetMimeType() string {
+func (x *ApprovalLogEntry) GetActorName() string {
 	if x != nil {
-		return x.MimeType
+		return x.ActorName
 	}
 	return ""
 }
 
-func (x *SupportingDocumentUpload) GetFileContent() []byte {
+func (x *ApprovalLogEntry) GetOnBehalfOfId() string {
 	if x != nil {
-		return x.FileContent
+		return x.OnBehalfOfId
 	}
-	return nil
+	return ""
+}
+
+func (x *ApprovalLogEntry) GetOnBehalfOfName() string {
+	if x != nil {
+		return x.OnBehalfOfName
+	}
+	return ""
+}
+
+func (x *ApprovalLogEntry) GetDelegationId() string {
+	if x != nil {
+		return x.DelegationId
+	}
+	return ""
+}
+
+func (x *ApprovalLogEntry) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *ApprovalLogEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApprovalLogEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// invoice_value must equal taxable_value + gst + other_charges. When lines are
+// given, taxable_value and gst are derived from them; gst then excludes tax on
+// reverse-charge lines, which the organisation pays directly.
+type InvoiceInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceNumber string                 `protobuf:"bytes,1,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	InvoiceDate   string                 `protobuf:"bytes,2,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"` // YYYY-MM-DD when GST details are given
+	TaxableValue  float64                `protobuf:"fixed64,3,opt,name=taxable_value,json=taxableValue,proto3" json:"taxable_value,omitempty"`
+	Gst           float64                `protobuf:"fixed64,4,opt,name=gst,proto3" json:"gst,omitempty"`
+	OtherCharges  float64                `protobuf:"fixed64,5,opt,name=other_charges,json=otherCharges,proto3" json:"other_charges,omitempty"`
+	InvoiceValue  float64                `protobuf:"fixed64,6,opt,name=invoice_value,json=invoiceValue,proto3" json:"invoice_value,omitempty"`
+	// GST breakup for input tax credit
+	SupplierGstin     string         `protobuf:"bytes,7,opt,name=supplier_gstin,json=supplierGstin,proto3" json:"supplier_gstin,omitempty"`                   // defaults to the vendor's GSTIN
+	PlaceOfSupply     string         `protobuf:"bytes,8,opt,name=place_of_supply,json=placeOfSupply,proto3" json:"place_of_supply,omitempty"`                 // GST state code; defaults to the organisation's state
+	SupplyType        SupplyType     `protobuf:"varint,9,opt,name=supply_type,json=supplyType,proto3,enum=greennote.SupplyType" json:"supply_type,omitempty"` // derived
+	Cgst              float64        `protobuf:"fixed64,10,opt,name=cgst,proto3" json:"cgst,omitempty"`                                                       // totals over all lines, including reverse charge
+	Sgst              float64        `protobuf:"fixed64,11,opt,name=sgst,proto3" json:"sgst,omitempty"`
+	Igst              float64        `protobuf:"fixed64,12,opt,name=igst,proto3" json:"igst,omitempty"`
+	Cess              float64        `protobuf:"fixed64,13,opt,name=cess,proto3" json:"cess,omitempty"`
+	Lines             []*InvoiceLine `protobuf:"bytes,14,rep,name=lines,proto3" json:"lines,omitempty"`
+	TaxableValueExact *Money         `protobuf:"bytes,15,opt,name=taxable_value_exact,json=taxableValueExact,proto3" json:"taxable_value_exact,omitempty"`
+	GstExact          *Money         `protobuf:"bytes,16,opt,name=gst_exact,json=gstExact,proto3" json:"gst_exact,omitempty"`
+	OtherChargesExact *Money         `protobuf:"bytes,17,opt,name=other_charges_exact,json=otherChargesExact,proto3" json:"other_charges_exact,omitempty"`
+	InvoiceValueExact *Money         `protobuf:"bytes,18,opt,name=invoice_value_exact,json=invoiceValueExact,proto3" json:"invoice_value_exact,omitempty"`
+	CgstExact         *Money         `protobuf:"bytes,19,opt,name=cgst_exact,json=cgstExact,proto3" json:"cgst_exact,omitempty"`
+	SgstExact         *Money         `protobuf:"bytes,20,opt,name=sgst_exact,json=sgstExact,proto3" json:"sgst_exact,omitempty"`
+	IgstExact         *Money         `protobuf:"bytes,21,opt,name=igst_exact,json=igstExact,proto3" json:"igst_exact,omitempty"`
+	CessExact         *Money         `protobuf:"bytes,22,opt,name=cess_exact,json=cessExact,proto3" json:"cess_exact,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InvoiceInput) Reset() {
+	*x = InvoiceInput{}
+	mi := &file_api_proto_greennote_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceInput) ProtoMessage() {}
+
+func (x *InvoiceInput) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceInput.ProtoReflect.Descriptor instead.
+func (*InvoiceInput) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InvoiceInput) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *InvoiceInput) GetInvoiceDate() string {
+	if x != nil {
+		return x.InvoiceDate
+	}
+	return ""
+}
+
+func (x *InvoiceInput) GetTaxableValue() float64 {
+	if x != nil {
+		return x.TaxableValue
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetGst() float64 {
+	if x != nil {
+		return x.Gst
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetOtherCharges() float64 {
+	if x != nil {
+		return x.OtherCharges
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetInvoiceValue() float64 {
+	if x != nil {
+		return x.InvoiceValue
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetSupplierGstin() string {
+	if x != nil {
+		return x.SupplierGstin
+	}
+	return ""
+}
+
+func (x *InvoiceInput) GetPlaceOfSupply() string {
+	if x != nil {
+		return x.PlaceOfSupply
+	}
+	return ""
+}
+
+func (x *InvoiceInput) GetSupplyType() SupplyType {
+	if x != nil {
+		return x.SupplyType
+	}
+	return SupplyType_SUPPLY_TYPE_UNSPECIFIED
+}
+
+func (x *InvoiceInput) GetCgst() float64 {
+	if x != nil {
+		return x.Cgst
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetSgst() float64 {
+	if x != nil {
+		return x.Sgst
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetIgst() float64 {
+	if x != nil {
+		return x.Igst
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetCess() float64 {
+	if x != nil {
+		return x.Cess
+	}
+	return 0
+}
+
+func (x *InvoiceInput) GetLines() []*InvoiceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetTaxableValueExact() *Money {
+	if x != nil {
+		return x.TaxableValueExact
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetGstExact() *Money {
+	if x != nil {
+		return x.GstExact
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetOtherChargesExact() *Money {
+	if x != nil {
+		return x.OtherChargesExact
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetInvoiceValueExact() *Money {
+	if x != nil {
+		return x.InvoiceValueExact
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetCgstExact() *Money {
+	if x != nil {
+		return x.CgstExact
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetSgstExact() *Money {
+	if x != nil {
+		return x.SgstExact
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetIgstExact() *Money {
+	if x != nil {
+		return x.IgstExact
+	}
+	return nil
+}
+
+func (x *InvoiceInput) GetCessExact() *Money {
+	if x != nil {
+		return x.CessExact
+	}
+	return nil
+}
+
+type InvoiceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	HsnSac        string                 `protobuf:"bytes,2,opt,name=hsn_sac,json=hsnSac,proto3" json:"hsn_sac,omitempty"` // 4, 6 or 8 digit HSN or SAC code
+	TaxableValue  float64                `protobuf:"fixed64,3,opt,name=taxable_value,json=taxableValue,proto3" json:"taxable_value,omitempty"`
+	GstRate       float64                `protobuf:"fixed64,4,opt,name=gst_rate,json=gstRate,proto3" json:"gst_rate,omitempty"`    // combined GST rate in percent, e.g. 18
+	CessRate      float64                `protobuf:"fixed64,5,opt,name=cess_rate,json=cessRate,proto3" json:"cess_rate,omitempty"` // percent of taxable_value
+	ReverseCharge bool                   `protobuf:"varint,6,opt,name=reverse_charge,json=reverseCharge,proto3" json:"reverse_charge,omitempty"`
+	// Derived from gst_rate and the supply type
+	Cgst              float64 `protobuf:"fixed64,7,opt,name=cgst,proto3" json:"cgst,omitempty"`
+	Sgst              float64 `protobuf:"fixed64,8,opt,name=sgst,proto3" json:"sgst,omitempty"`
+	Igst              float64 `protobuf:"fixed64,9,opt,name=igst,proto3" json:"igst,omitempty"`
+	Cess              float64 `protobuf:"fixed64,10,opt,name=cess,proto3" json:"cess,omitempty"`
+	TaxableValueExact *Money  `protobuf:"bytes,11,opt,name=taxable_value_exact,json=taxableValueExact,proto3" json:"taxable_value_exact,omitempty"`
+	CgstExact         *Money  `protobuf:"bytes,12,opt,name=cgst_exact,json=cgstExact,proto3" json:"cgst_exact,omitempty"`
+	SgstExact         *Money  `protobuf:"bytes,13,opt,name=sgst_exact,json=sgstExact,proto3" json:"sgst_exact,omitempty"`
+	IgstExact         *Money  `protobuf:"bytes,14,opt,name=igst_exact,json=igstExact,proto3" json:"igst_exact,omitempty"`
+	CessExact         *Money  `protobuf:"bytes,15,opt,name=cess_exact,json=cessExact,proto3" json:"cess_exact,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	mi := &file_api_proto_greennote_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLine) ProtoMessage() {}
+
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InvoiceLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetHsnSac() string {
+	if x != nil {
+		return x.HsnSac
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetTaxableValue() float64 {
+	if x != nil {
+		return x.TaxableValue
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetGstRate() float64 {
+	if x != nil {
+		return x.GstRate
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetCessRate() float64 {
+	if x != nil {
+		return x.CessRate
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetReverseCharge() bool {
+	if x != nil {
+		return x.ReverseCharge
+	}
+	return false
+}
+
+func (x *InvoiceLine) GetCgst() float64 {
+	if x != nil {
+		return x.Cgst
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetSgst() float64 {
+	if x != nil {
+		return x.Sgst
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetIgst() float64 {
+	if x != nil {
+		return x.Igst
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetCess() float64 {
+	if x != nil {
+		return x.Cess
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetTaxableValueExact() *Money {
+	if x != nil {
+		return x.TaxableValueExact
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetCgstExact() *Money {
+	if x != nil {
+		return x.CgstExact
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetSgstExact() *Money {
+	if x != nil {
+		return x.SgstExact
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetIgstExact() *Money {
+	if x != nil {
+		return x.IgstExact
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetCessExact() *Money {
+	if x != nil {
+		return x.CessExact
+	}
+	return nil
+}
+
+type SupportingDocument struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OriginalFilename string                 `protobuf:"bytes,3,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
+	MimeType         string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	FileSize         int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	ObjectKey        string                 `protobuf:"bytes,6,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SupportingDocument) Reset() {
+	*x = SupportingDocument{}
+	mi := &file_api_proto_greennote_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportingDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportingDocument) ProtoMessage() {}
+
+func (x *SupportingDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportingDocument.ProtoReflect.Descriptor instead.
+func (*SupportingDocument) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SupportingDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SupportingDocument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SupportingDocument) GetOriginalFilename() string {
+	if x != nil {
+		return x.OriginalFilename
+	}
+	return ""
+}
+
+func (x *SupportingDocument) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *SupportingDocument) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *SupportingDocument) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *SupportingDocument) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SupportingDocument) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SupportingDocumentUpload struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OriginalFilename string                 `protobuf:"bytes,2,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
+	MimeType         string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	FileContent      []byte                 `protobuf:"bytes,4,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SupportingDocumentUpload) Reset() {
+	*x = SupportingDocumentUpload{}
+	mi := &file_api_proto_greennote_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportingDocumentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportingDocumentUpload) ProtoMessage() {}
+
+func (x *SupportingDocumentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportingDocumentUpload.ProtoReflect.Descriptor instead.
+func (*SupportingDocumentUpload) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SupportingDocumentUpload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SupportingDocumentUpload) GetOriginalFilename() string {
+	if x != nil {
+		return x.OriginalFilename
+	}
+	return ""
+}
+
+func (x *SupportingDocumentUpload) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *SupportingDocumentUpload) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
 }
 
 type GetOrganizationProjectsRequest struct {
@@ -1353,21 +2086,517 @@ type GetOrganizationProjectsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrganizationProjectsRequest) Reset() {
-	*x = GetOrganizationProjectsRequest{}
-	mi := &file_api_proto_greennote_proto_msgTypes[10]
+func (x *GetOrganizationProjectsRequest) Reset() {
+	*x = GetOrganizationProjectsRequest{}
+	mi := &file_api_proto_greennote_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationProjectsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationProjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{14}
+}
+
+type GetOrganizationProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationProjectsResponse) Reset() {
+	*x = GetOrganizationProjectsResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationProjectsResponse) ProtoMessage() {}
+
+func (x *GetOrganizationProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationProjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetOrganizationProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetOrganizationProjectsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetOrganizationVendorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationVendorsRequest) Reset() {
+	*x = GetOrganizationVendorsRequest{}
+	mi := &file_api_proto_greennote_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationVendorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationVendorsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationVendorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationVendorsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationVendorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{16}
+}
+
+type GetOrganizationVendorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vendors       []*Vendor              `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationVendorsResponse) Reset() {
+	*x = GetOrganizationVendorsResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationVendorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationVendorsResponse) ProtoMessage() {}
+
+func (x *GetOrganizationVendorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationVendorsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationVendorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetOrganizationVendorsResponse) GetVendors() []*Vendor {
+	if x != nil {
+		return x.Vendors
+	}
+	return nil
+}
+
+func (x *GetOrganizationVendorsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetOrganizationDepartmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationDepartmentsRequest) Reset() {
+	*x = GetOrganizationDepartmentsRequest{}
+	mi := &file_api_proto_greennote_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationDepartmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationDepartmentsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationDepartmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationDepartmentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{18}
+}
+
+type GetOrganizationDepartmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationDepartmentsResponse) Reset() {
+	*x = GetOrganizationDepartmentsResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationDepartmentsResponse) ProtoMessage() {}
+
+func (x *GetOrganizationDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOrganizationDepartmentsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+func (x *GetOrganizationDepartmentsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_api_proto_greennote_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Project) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Project) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Vendor struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code               string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Email              string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone              string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	ContactPerson      string                 `protobuf:"bytes,6,opt,name=contact_person,json=contactPerson,proto3" json:"contact_person,omitempty"`
+	Status             string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Description        string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	MsmeClassification string                 `protobuf:"bytes,11,opt,name=msme_classification,json=msmeClassification,proto3" json:"msme_classification,omitempty"`
+	ActivityType       string                 `protobuf:"bytes,12,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Vendor) Reset() {
+	*x = Vendor{}
+	mi := &file_api_proto_greennote_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vendor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vendor) ProtoMessage() {}
+
+func (x *Vendor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vendor.ProtoReflect.Descriptor instead.
+func (*Vendor) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Vendor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vendor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Vendor) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Vendor) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Vendor) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Vendor) GetContactPerson() string {
+	if x != nil {
+		return x.ContactPerson
+	}
+	return ""
+}
+
+func (x *Vendor) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Vendor) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Vendor) GetMsmeClassification() string {
+	if x != nil {
+		return x.MsmeClassification
+	}
+	return ""
+}
+
+func (x *Vendor) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *Vendor) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Vendor) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Department struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	HeadName      string                 `protobuf:"bytes,5,opt,name=head_name,json=headName,proto3" json:"head_name,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Department) Reset() {
+	*x = Department{}
+	mi := &file_api_proto_greennote_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationProjectsRequest) String() string {
+func (x *Department) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationProjectsRequest) ProtoMessage() {}
+func (*Department) ProtoMessage() {}
 
-func (x *GetOrganizationProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[10]
+func (x *Department) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,34 +2607,154 @@ func (x *GetOrganizationProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationProjectsRequest.ProtoReflect.Descriptor instead.
-func (*GetOrganizationProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Department.ProtoReflect.Descriptor instead.
+func (*Department) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{22}
 }
 
-type GetOrganizationProjectsResponse struct {
+func (x *Department) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Department) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Department) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Department) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Department) GetHeadName() string {
+	if x != nil {
+		return x.HeadName
+	}
+	return ""
+}
+
+func (x *Department) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Department) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// =======================
+// RESPONSES
+// =======================
+type GreenNoteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GreenNoteResponse) Reset() {
+	*x = GreenNoteResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GreenNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GreenNoteResponse) ProtoMessage() {}
+
+func (x *GreenNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GreenNoteResponse.ProtoReflect.Descriptor instead.
+func (*GreenNoteResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GreenNoteResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GreenNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GreenNoteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GreenNoteDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GreenNotePayload      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrganizationProjectsResponse) Reset() {
-	*x = GetOrganizationProjectsResponse{}
-	mi := &file_api_proto_greennote_proto_msgTypes[11]
+func (x *GreenNoteDetailResponse) Reset() {
+	*x = GreenNoteDetailResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationProjectsResponse) String() string {
+func (x *GreenNoteDetailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationProjectsResponse) ProtoMessage() {}
+func (*GreenNoteDetailResponse) ProtoMessage() {}
 
-func (x *GetOrganizationProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[11]
+func (x *GreenNoteDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,46 +2765,57 @@ func (x *GetOrganizationProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationProjectsResponse.ProtoReflect.Descriptor instead.
-func (*GetOrganizationProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GreenNoteDetailResponse.ProtoReflect.Descriptor instead.
+func (*GreenNoteDetailResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetOrganizationProjectsResponse) GetProjects() []*Project {
+func (x *GreenNoteDetailResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Projects
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *GetOrganizationProjectsResponse) GetMessage() string {
+func (x *GreenNoteDetailResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetOrganizationVendorsRequest struct {
+func (x *GreenNoteDetailResponse) GetData() *GreenNotePayload {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PaginationMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalItems    int64                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrganizationVendorsRequest) Reset() {
-	*x = GetOrganizationVendorsRequest{}
-	mi := &file_api_proto_greennote_proto_msgTypes[12]
+func (x *PaginationMetadata) Reset() {
+	*x = PaginationMetadata{}
+	mi := &file_api_proto_greennote_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationVendorsRequest) String() string {
+func (x *PaginationMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationVendorsRequest) ProtoMessage() {}
+func (*PaginationMetadata) ProtoMessage() {}
 
-func (x *GetOrganizationVendorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[12]
+func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,34 +2826,65 @@ func (x *GetOrganizationVendorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationVendorsRequest.ProtoReflect.Descriptor instead.
-func (*GetOrganizationVendorsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
+func (*PaginationMetadata) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{25}
 }
 
-type GetOrganizationVendorsResponse struct {
+func (x *PaginationMetadata) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type ListGreenNotesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vendors       []*Vendor              `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Notes         []*GreenNoteListItem   `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Pagination    *PaginationMetadata    `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrganizationVendorsResponse) Reset() {
-	*x = GetOrganizationVendorsResponse{}
-	mi := &file_api_proto_greennote_proto_msgTypes[13]
+func (x *ListGreenNotesResponse) Reset() {
+	*x = ListGreenNotesResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationVendorsResponse) String() string {
+func (x *ListGreenNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationVendorsResponse) ProtoMessage() {}
+func (*ListGreenNotesResponse) ProtoMessage() {}
 
-func (x *GetOrganizationVendorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[13]
+func (x *ListGreenNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,84 +2895,72 @@ func (x *GetOrganizationVendorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationVendorsResponse.ProtoReflect.Descriptor instead.
-func (*GetOrganizationVendorsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListGreenNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListGreenNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetOrganizationVendorsResponse) GetVendors() []*Vendor {
+func (x *ListGreenNotesResponse) GetNotes() []*GreenNoteListItem {
 	if x != nil {
-		return x.Vendors
+		return x.Notes
 	}
 	return nil
 }
 
-func (x *GetOrganizationVendorsResponse) GetMessage() string {
+func (x *ListGreenNotesResponse) GetPage() int32 {
 	if x != nil {
-		return x.Message
+		return x.Page
 	}
-	return ""
-}
-
-type GetOrganizationDepartmentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetOrganizationDepartmentsRequest) Reset() {
-	*x = GetOrganizationDepartmentsRequest{}
-	mi := &file_api_proto_greennote_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *GetOrganizationDepartmentsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListGreenNotesResponse) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
 }
 
-func (*GetOrganizationDepartmentsRequest) ProtoMessage() {}
-
-func (x *GetOrganizationDepartmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[14]
+func (x *ListGreenNotesResponse) GetTotal() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Total
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetOrganizationDepartmentsRequest.ProtoReflect.Descriptor instead.
-func (*GetOrganizationDepartmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{14}
+func (x *ListGreenNotesResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-type GetOrganizationDepartmentsResponse struct {
+// ====================
+// GSTR-2B Export Messages
+// ====================
+type ExportGSTR2BDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FromDate      string                 `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // invoice date range, YYYY-MM-DD, inclusive
+	ToDate        string                 `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrganizationDepartmentsResponse) Reset() {
-	*x = GetOrganizationDepartmentsResponse{}
-	mi := &file_api_proto_greennote_proto_msgTypes[15]
+func (x *ExportGSTR2BDataRequest) Reset() {
+	*x = ExportGSTR2BDataRequest{}
+	mi := &file_api_proto_greennote_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationDepartmentsResponse) String() string {
+func (x *ExportGSTR2BDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationDepartmentsResponse) ProtoMessage() {}
+func (*ExportGSTR2BDataRequest) ProtoMessage() {}
 
-func (x *GetOrganizationDepartmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[15]
+func (x *ExportGSTR2BDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,53 +2971,61 @@ func (x *GetOrganizationDepartmentsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationDepartmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetOrganizationDepartmentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ExportGSTR2BDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportGSTR2BDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetOrganizationDepartmentsResponse) GetDepartments() []*Department {
+func (x *ExportGSTR2BDataRequest) GetFromDate() string {
 	if x != nil {
-		return x.Departments
+		return x.FromDate
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrganizationDepartmentsResponse) GetMessage() string {
+func (x *ExportGSTR2BDataRequest) GetToDate() string {
 	if x != nil {
-		return x.Message
+		return x.ToDate
 	}
 	return ""
 }
 
-type Project struct {
+// One row per invoice, GST rate and reverse-charge flag, as in GSTR-2B B2B
+type GSTR2BRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SupplierGstin string                 `protobuf:"bytes,1,opt,name=supplier_gstin,json=supplierGstin,proto3" json:"supplier_gstin,omitempty"`
+	SupplierName  string                 `protobuf:"bytes,2,opt,name=supplier_name,json=supplierName,proto3" json:"supplier_name,omitempty"`
+	InvoiceNumber string                 `protobuf:"bytes,3,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	InvoiceDate   string                 `protobuf:"bytes,4,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
+	InvoiceValue  float64                `protobuf:"fixed64,5,opt,name=invoice_value,json=invoiceValue,proto3" json:"invoice_value,omitempty"`
+	PlaceOfSupply string                 `protobuf:"bytes,6,opt,name=place_of_supply,json=placeOfSupply,proto3" json:"place_of_supply,omitempty"`
+	ReverseCharge bool                   `protobuf:"varint,7,opt,name=reverse_charge,json=reverseCharge,proto3" json:"reverse_charge,omitempty"`
+	GstRate       float64                `protobuf:"fixed64,8,opt,name=gst_rate,json=gstRate,proto3" json:"gst_rate,omitempty"`
+	TaxableValue  float64                `protobuf:"fixed64,9,opt,name=taxable_value,json=taxableValue,proto3" json:"taxable_value,omitempty"`
+	Igst          float64                `protobuf:"fixed64,10,opt,name=igst,proto3" json:"igst,omitempty"`
+	Cgst          float64                `protobuf:"fixed64,11,opt,name=cgst,proto3" json:"cgst,omitempty"`
+	Sgst          float64                `protobuf:"fixed64,12,opt,name=sgst,proto3" json:"sgst,omitempty"`
+	Cess          float64                `protobuf:"fixed64,13,opt,name=cess,proto3" json:"cess,omitempty"`
+	GreenNoteId   string                 `protobuf:"bytes,14,opt,name=green_note_id,json=greenNoteId,proto3" json:"green_note_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
-	mi := &file_api_proto_greennote_proto_msgTypes[16]
+func (x *GSTR2BRow) Reset() {
+	*x = GSTR2BRow{}
+	mi := &file_api_proto_greennote_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Project) String() string {
+func (x *GSTR2BRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Project) ProtoMessage() {}
+func (*GSTR2BRow) ProtoMessage() {}
 
-func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[16]
+func (x *GSTR2BRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,221 +3036,194 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GSTR2BRow.ProtoReflect.Descriptor instead.
+func (*GSTR2BRow) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *Project) GetId() string {
+func (x *GSTR2BRow) GetSupplierGstin() string {
 	if x != nil {
-		return x.Id
+		return x.SupplierGstin
 	}
 	return ""
 }
 
-func (x *Project) GetName() string {
+func (x *GSTR2BRow) GetSupplierName() string {
 	if x != nil {
-		return x.Name
+		return x.SupplierName
 	}
 	return ""
 }
 
-func (x *Project) GetCode() string {
+func (x *GSTR2BRow) GetInvoiceNumber() string {
 	if x != nil {
-		return x.Code
+		return x.InvoiceNumber
 	}
 	return ""
 }
 
-func (x *Project) GetStatus() string {
+func (x *GSTR2BRow) GetInvoiceDate() string {
 	if x != nil {
-		return x.Status
+		return x.InvoiceDate
 	}
 	return ""
 }
 
-func (x *Project) GetDescription() string {
+func (x *GSTR2BRow) GetInvoiceValue() float64 {
 	if x != nil {
-		return x.Description
+		return x.InvoiceValue
 	}
-	return ""
+	return 0
 }
 
-func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GSTR2BRow) GetPlaceOfSupply() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.PlaceOfSupply
 	}
-	return nil
+	return ""
 }
 
-func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GSTR2BRow) GetReverseCharge() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ReverseCharge
 	}
-	return nil
-}
-
-type Vendor struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code               string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Email              string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Phone              string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	ContactPerson      string                 `protobuf:"bytes,6,opt,name=contact_person,json=contactPerson,proto3" json:"contact_person,omitempty"`
-	Status             string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	Description        string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	MsmeClassification string                 `protobuf:"bytes,11,opt,name=msme_classification,json=msmeClassification,proto3" json:"msme_classification,omitempty"`
-	ActivityType       string                 `protobuf:"bytes,12,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
-	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *Vendor) Reset() {
-	*x = Vendor{}
-	mi := &file_api_proto_greennote_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Vendor) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return false
 }
 
-func (*Vendor) ProtoMessage() {}
-
-func (x *Vendor) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[17]
+func (x *GSTR2BRow) GetGstRate() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GstRate
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Vendor.ProtoReflect.Descriptor instead.
-func (*Vendor) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{17}
+	return 0
 }
 
-func (x *Vendor) GetId() string {
+func (x *GSTR2BRow) GetTaxableValue() float64 {
 	if x != nil {
-		return x.Id
+		return x.TaxableValue
 	}
-	return ""
+	return 0
 }
 
-func (x *Vendor) GetName() string {
+func (x *GSTR2BRow) GetIgst() float64 {
 	if x != nil {
-		return x.Name
+		return x.Igst
 	}
-	return ""
+	return 0
 }
 
-func (x *Vendor) GetCode() string {
+func (x *GSTR2BRow) GetCgst() float64 {
 	if x != nil {
-		return x.Code
+		return x.Cgst
 	}
-	return ""
+	return 0
 }
 
-func (x *Vendor) GetEmail() string {
+func (x *GSTR2BRow) GetSgst() float64 {
 	if x != nil {
-		return x.Email
+		return x.Sgst
 	}
-	return ""
+	return 0
 }
 
-func (x *Vendor) GetPhone() string {
+func (x *GSTR2BRow) GetCess() float64 {
 	if x != nil {
-		return x.Phone
+		return x.Cess
 	}
-	return ""
+	return 0
 }
 
-func (x *Vendor) GetContactPerson() string {
+func (x *GSTR2BRow) GetGreenNoteId() string {
 	if x != nil {
-		return x.ContactPerson
+		return x.GreenNoteId
 	}
 	return ""
 }
 
-func (x *Vendor) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type ExportGSTR2BDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*GSTR2BRow           `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	CsvContent    []byte                 `protobuf:"bytes,2,opt,name=csv_content,json=csvContent,proto3" json:"csv_content,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Vendor) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ExportGSTR2BDataResponse) Reset() {
+	*x = ExportGSTR2BDataResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Vendor) GetMsmeClassification() string {
-	if x != nil {
-		return x.MsmeClassification
-	}
-	return ""
+func (x *ExportGSTR2BDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Vendor) GetActivityType() string {
+func (*ExportGSTR2BDataResponse) ProtoMessage() {}
+
+func (x *ExportGSTR2BDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[29]
 	if x != nil {
-		return x.ActivityType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Vendor) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ExportGSTR2BDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportGSTR2BDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportGSTR2BDataResponse) GetRows() []*GSTR2BRow {
 	if x != nil {
-		return x.CreatedAt
+		return x.Rows
 	}
 	return nil
 }
 
-func (x *Vendor) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ExportGSTR2BDataResponse) GetCsvContent() []byte {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CsvContent
 	}
 	return nil
 }
 
-type Department struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	HeadName      string                 `protobuf:"bytes,5,opt,name=head_name,json=headName,proto3" json:"head_name,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+func (x *ExportGSTR2BDataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// ====================
+// Project Spend Messages
+// ====================
+type GetProjectSpendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Department) Reset() {
-	*x = Department{}
-	mi := &file_api_proto_greennote_proto_msgTypes[18]
+func (x *GetProjectSpendRequest) Reset() {
+	*x = GetProjectSpendRequest{}
+	mi := &file_api_proto_greennote_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Department) String() string {
+func (x *GetProjectSpendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Department) ProtoMessage() {}
+func (*GetProjectSpendRequest) ProtoMessage() {}
 
-func (x *Department) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[18]
+func (x *GetProjectSpendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1874,94 +3234,136 @@ func (x *Department) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Department.ProtoReflect.Descriptor instead.
-func (*Department) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetProjectSpendRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectSpendRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Department) GetId() string {
+func (x *GetProjectSpendRequest) GetProjectId() string {
 	if x != nil {
-		return x.Id
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *Department) GetName() string {
+// Spend on one expense category. Approved counts notes that cleared approval,
+// which then sit in draft until their payment note is raised; pending counts
+// notes awaiting approval. Rejected and cancelled notes are left out.
+type ProjectSpendHead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Sanctioned    *Money                 `protobuf:"bytes,2,opt,name=sanctioned,proto3" json:"sanctioned,omitempty"` // zero for categories without a budget head
+	Approved      *Money                 `protobuf:"bytes,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	Pending       *Money                 `protobuf:"bytes,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	Available     *Money                 `protobuf:"bytes,5,opt,name=available,proto3" json:"available,omitempty"` // sanctioned - approved - pending, negative when overspent
+	NoteCount     int32                  `protobuf:"varint,6,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectSpendHead) Reset() {
+	*x = ProjectSpendHead{}
+	mi := &file_api_proto_greennote_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectSpendHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectSpendHead) ProtoMessage() {}
+
+func (x *ProjectSpendHead) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[31]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Department) GetCode() string {
+// Deprecated: Use ProjectSpendHead.ProtoReflect.Descriptor instead.
+func (*ProjectSpendHead) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProjectSpendHead) GetCategory() string {
 	if x != nil {
-		return x.Code
+		return x.Category
 	}
 	return ""
 }
 
-func (x *Department) GetDescription() string {
+func (x *ProjectSpendHead) GetSanctioned() *Money {
 	if x != nil {
-		return x.Description
+		return x.Sanctioned
 	}
-	return ""
+	return nil
 }
 
-func (x *Department) GetHeadName() string {
+func (x *ProjectSpendHead) GetApproved() *Money {
 	if x != nil {
-		return x.HeadName
+		return x.Approved
 	}
-	return ""
+	return nil
 }
 
-func (x *Department) GetStatus() string {
+func (x *ProjectSpendHead) GetPending() *Money {
 	if x != nil {
-		return x.Status
+		return x.Pending
 	}
-	return ""
+	return nil
 }
 
-func (x *Department) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ProjectSpendHead) GetAvailable() *Money {
 	if x != nil {
-		return x.CreatedAt
+		return x.Available
 	}
 	return nil
 }
 
-func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ProjectSpendHead) GetNoteCount() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NoteCount
 	}
-	return nil
+	return 0
 }
 
-// =======================
-// RESPONSES
-// =======================
-type GreenNoteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetProjectSpendResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId        string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName      string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectCode      string                 `protobuf:"bytes,3,opt,name=project_code,json=projectCode,proto3" json:"project_code,omitempty"`
+	ProjectStatus    string                 `protobuf:"bytes,4,opt,name=project_status,json=projectStatus,proto3" json:"project_status,omitempty"`
+	SanctionedBudget *Money                 `protobuf:"bytes,5,opt,name=sanctioned_budget,json=sanctionedBudget,proto3" json:"sanctioned_budget,omitempty"`
+	Approved         *Money                 `protobuf:"bytes,6,opt,name=approved,proto3" json:"approved,omitempty"`
+	Pending          *Money                 `protobuf:"bytes,7,opt,name=pending,proto3" json:"pending,omitempty"`
+	Available        *Money                 `protobuf:"bytes,8,opt,name=available,proto3" json:"available,omitempty"` // sanctioned_budget - approved - pending
+	NoteCount        int32                  `protobuf:"varint,9,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	Heads            []*ProjectSpendHead    `protobuf:"bytes,10,rep,name=heads,proto3" json:"heads,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GreenNoteResponse) Reset() {
-	*x = GreenNoteResponse{}
-	mi := &file_api_proto_greennote_proto_msgTypes[19]
+func (x *GetProjectSpendResponse) Reset() {
+	*x = GetProjectSpendResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GreenNoteResponse) String() string {
+func (x *GetProjectSpendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GreenNoteResponse) ProtoMessage() {}
+func (*GetProjectSpendResponse) ProtoMessage() {}
 
-func (x *GreenNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[19]
+func (x *GetProjectSpendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,117 +3374,106 @@ func (x *GreenNoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GreenNoteResponse.ProtoReflect.Descriptor instead.
-func (*GreenNoteResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetProjectSpendResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectSpendResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GreenNoteResponse) GetId() string {
+func (x *GetProjectSpendResponse) GetProjectId() string {
 	if x != nil {
-		return x.Id
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *GreenNoteResponse) GetSuccess() bool {
+func (x *GetProjectSpendResponse) GetProjectName() string {
 	if x != nil {
-		return x.Success
+		return x.ProjectName
 	}
-	return false
+	return ""
 }
 
-func (x *GreenNoteResponse) GetMessage() string {
+func (x *GetProjectSpendResponse) GetProjectCode() string {
 	if x != nil {
-		return x.Message
+		return x.ProjectCode
 	}
 	return ""
 }
 
-type GreenNoteDetailResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *GreenNotePayload      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GreenNoteDetailResponse) Reset() {
-	*x = GreenNoteDetailResponse{}
-	mi := &file_api_proto_greennote_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetProjectSpendResponse) GetProjectStatus() string {
+	if x != nil {
+		return x.ProjectStatus
+	}
+	return ""
 }
 
-func (x *GreenNoteDetailResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetProjectSpendResponse) GetSanctionedBudget() *Money {
+	if x != nil {
+		return x.SanctionedBudget
+	}
+	return nil
 }
 
-func (*GreenNoteDetailResponse) ProtoMessage() {}
-
-func (x *GreenNoteDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[20]
+func (x *GetProjectSpendResponse) GetApproved() *Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Approved
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GreenNoteDetailResponse.ProtoReflect.Descriptor instead.
-func (*GreenNoteDetailResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{20}
+func (x *GetProjectSpendResponse) GetPending() *Money {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
 }
 
-func (x *GreenNoteDetailResponse) GetSuccess() bool {
+func (x *GetProjectSpendResponse) GetAvailable() *Money {
 	if x != nil {
-		return x.Success
+		return x.Available
 	}
-	return false
+	return nil
 }
 
-func (x *GreenNoteDetailResponse) GetMessage() string {
+func (x *GetProjectSpendResponse) GetNoteCount() int32 {
 	if x != nil {
-		return x.Message
+		return x.NoteCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GreenNoteDetailResponse) GetData() *GreenNotePayload {
+func (x *GetProjectSpendResponse) GetHeads() []*ProjectSpendHead {
 	if x != nil {
-		return x.Data
+		return x.Heads
 	}
 	return nil
 }
 
-type PaginationMetadata struct {
+// ====================
+// Organization Dependency Messages
+// ====================
+type CountOrganizationGreenNotesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalItems    int64                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMetadata) Reset() {
-	*x = PaginationMetadata{}
-	mi := &file_api_proto_greennote_proto_msgTypes[21]
+func (x *CountOrganizationGreenNotesRequest) Reset() {
+	*x = CountOrganizationGreenNotesRequest{}
+	mi := &file_api_proto_greennote_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMetadata) String() string {
+func (x *CountOrganizationGreenNotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationMetadata) ProtoMessage() {}
+func (*CountOrganizationGreenNotesRequest) ProtoMessage() {}
 
-func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[21]
+func (x *CountOrganizationGreenNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,65 +3484,40 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
-func (*PaginationMetadata) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *PaginationMetadata) GetCurrentPage() int32 {
-	if x != nil {
-		return x.CurrentPage
-	}
-	return 0
-}
-
-func (x *PaginationMetadata) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *PaginationMetadata) GetTotalItems() int64 {
-	if x != nil {
-		return x.TotalItems
-	}
-	return 0
+// Deprecated: Use CountOrganizationGreenNotesRequest.ProtoReflect.Descriptor instead.
+func (*CountOrganizationGreenNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *PaginationMetadata) GetTotalPages() int32 {
+func (x *CountOrganizationGreenNotesRequest) GetOrgId() string {
 	if x != nil {
-		return x.TotalPages
+		return x.OrgId
 	}
-	return 0
+	return ""
 }
 
-type ListGreenNotesResponse struct {
+type CountOrganizationGreenNotesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notes         []*GreenNoteListItem   `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Pagination    *PaginationMetadata    `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGreenNotesResponse) Reset() {
-	*x = ListGreenNotesResponse{}
-	mi := &file_api_proto_greennote_proto_msgTypes[22]
+func (x *CountOrganizationGreenNotesResponse) Reset() {
+	*x = CountOrganizationGreenNotesResponse{}
+	mi := &file_api_proto_greennote_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGreenNotesResponse) String() string {
+func (x *CountOrganizationGreenNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGreenNotesResponse) ProtoMessage() {}
+func (*CountOrganizationGreenNotesResponse) ProtoMessage() {}
 
-func (x *ListGreenNotesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[22]
+func (x *CountOrganizationGreenNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_greennote_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,46 +3528,18 @@ func (x *ListGreenNotesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGreenNotesResponse.ProtoReflect.Descriptor instead.
-func (*ListGreenNotesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ListGreenNotesResponse) GetNotes() []*GreenNoteListItem {
-	if x != nil {
-		return x.Notes
-	}
-	return nil
-}
-
-func (x *ListGreenNotesResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListGreenNotesResponse) GetPerPage() int32 {
-	if x != nil {
-		return x.PerPage
-	}
-	return 0
+// Deprecated: Use CountOrganizationGreenNotesResponse.ProtoReflect.Descriptor instead.
+func (*CountOrganizationGreenNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListGreenNotesResponse) GetTotal() int64 {
+func (x *CountOrganizationGreenNotesResponse) GetCount() int64 {
 	if x != nil {
-		return x.Total
+		return x.Count
 	}
 	return 0
 }
 
-func (x *ListGreenNotesResponse) GetPagination() *PaginationMetadata {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
 // ====================
 // Document Upload Messages
 // ====================
@@ -2215,7 +3553,7 @@ type UploadGreenNoteDocumentsRequest struct {
 
 func (x *UploadGreenNoteDocumentsRequest) Reset() {
 	*x = UploadGreenNoteDocumentsRequest{}
-	mi := &file_api_proto_greennote_proto_msgTypes[23]
+	mi := &file_api_proto_greennote_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2227,7 +3565,7 @@ func (x *UploadGreenNoteDocumentsRequest) String() string {
 func (*UploadGreenNoteDocumentsRequest) ProtoMessage() {}
 
 func (x *UploadGreenNoteDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[23]
+	mi := &file_api_proto_greennote_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2240,7 +3578,7 @@ func (x *UploadGreenNoteDocumentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadGreenNoteDocumentsRequest.ProtoReflect.Descriptor instead.
 func (*UploadGreenNoteDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{23}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UploadGreenNoteDocumentsRequest) GetNoteId() string {
@@ -2271,7 +3609,7 @@ type UploadGreenNoteDocumentsResponse struct {
 
 func (x *UploadGreenNoteDocumentsResponse) Reset() {
 	*x = UploadGreenNoteDocumentsResponse{}
-	mi := &file_api_proto_greennote_proto_msgTypes[24]
+	mi := &file_api_proto_greennote_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2283,7 +3621,7 @@ func (x *UploadGreenNoteDocumentsResponse) String() string {
 func (*UploadGreenNoteDocumentsResponse) ProtoMessage() {}
 
 func (x *UploadGreenNoteDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_greennote_proto_msgTypes[24]
+	mi := &file_api_proto_greennote_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,7 +3634,7 @@ func (x *UploadGreenNoteDocumentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadGreenNoteDocumentsResponse.ProtoReflect.Descriptor instead.
 func (*UploadGreenNoteDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_greennote_proto_rawDescGZIP(), []int{24}
+	return file_api_proto_greennote_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UploadGreenNoteDocumentsResponse) GetSuccess() bool {
@@ -2361,7 +3699,7 @@ const file_api_proto_greennote_proto_rawDesc = "" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
 	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x1f\n" +
 	"\vinclude_all\x18\x04 \x01(\bR\n" +
-	"includeAll\"\xbe\x01\n" +
+	"includeAll\"\xf3\x01\n" +
 	"\x11GreenNoteListItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12\x1f\n" +
@@ -2369,7 +3707,12 @@ const file_api_proto_greennote_proto_rawDesc = "" +
 	"vendorName\x12\x16\n" +
 	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
 	"\x04date\x18\x05 \x01(\tR\x04date\x12)\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x11.greennote.StatusR\x06status\"\xa3\x15\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x11.greennote.StatusR\x06status\x123\n" +
+	"\famount_exact\x18\a \x01(\v2\x10.greennote.MoneyR\vamountExact\"I\n" +
+	"\x05Money\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"\xd6\x1b\n" +
 	"\x10GreenNotePayload\x12!\n" +
 	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12#\n" +
 	"\rsupplier_name\x18\x02 \x01(\tR\fsupplierName\x12)\n" +
@@ -2425,14 +3768,93 @@ const file_api_proto_greennote_proto_rawDesc = "" +
 	"updated_at\x184 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12H\n" +
 	"\rnew_documents\x185 \x03(\v2#.greennote.SupportingDocumentUploadR\fnewDocuments\x12L\n" +
 	"\x12existing_documents\x186 \x03(\v2\x1d.greennote.SupportingDocumentR\x11existingDocuments\x12'\n" +
-	"\x0fdetailed_status\x187 \x01(\tR\x0edetailedStatus\"\xd9\x01\n" +
+	"\x0fdetailed_status\x187 \x01(\tR\x0edetailedStatus\x12-\n" +
+	"\x12organization_gstin\x188 \x01(\tR\x11organizationGstin\x12:\n" +
+	"\x10base_value_exact\x189 \x01(\v2\x10.greennote.MoneyR\x0ebaseValueExact\x12@\n" +
+	"\x13other_charges_exact\x18: \x01(\v2\x10.greennote.MoneyR\x11otherChargesExact\x12-\n" +
+	"\tgst_exact\x18; \x01(\v2\x10.greennote.MoneyR\bgstExact\x12>\n" +
+	"\x12total_amount_exact\x18< \x01(\v2\x10.greennote.MoneyR\x10totalAmountExact\x12J\n" +
+	"\x18budget_expenditure_exact\x18= \x01(\v2\x10.greennote.MoneyR\x16budgetExpenditureExact\x12J\n" +
+	"\x18actual_expenditure_exact\x18> \x01(\v2\x10.greennote.MoneyR\x16actualExpenditureExact\x12S\n" +
+	"\x1dexpenditure_over_budget_exact\x18? \x01(\v2\x10.greennote.MoneyR\x1aexpenditureOverBudgetExact\x12g\n" +
+	"(amount_retained_for_non_submission_exact\x18@ \x01(\v2\x10.greennote.MoneyR#amountRetainedForNonSubmissionExact\x12@\n" +
+	"\rapproval_logs\x18A \x03(\v2\x1b.greennote.ApprovalLogEntryR\fapprovalLogs\x120\n" +
+	"\tmsme_flag\x18B \x01(\v2\x13.greennote.MSMEFlagR\bmsmeFlag\x12\x1f\n" +
+	"\vsupplier_id\x18C \x01(\tR\n" +
+	"supplierId\x12*\n" +
+	"\x11vendor_invoice_id\x18D \x01(\tR\x0fvendorInvoiceId\"\xb9\x02\n" +
+	"\bMSMEFlag\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12%\n" +
+	"\x0einvoice_number\x18\x02 \x01(\tR\rinvoiceNumber\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\tR\adueDate\x12-\n" +
+	"\x12interest_liability\x18\x05 \x01(\x01R\x11interestLiability\x12J\n" +
+	"\x18interest_liability_exact\x18\x06 \x01(\v2\x10.greennote.MoneyR\x16interestLiabilityExact\x129\n" +
+	"\n" +
+	"flagged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tflaggedAt\"\xd4\x02\n" +
+	"\x10ApprovalLogEntry\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\x03 \x01(\tR\tactorName\x12%\n" +
+	"\x0fon_behalf_of_id\x18\x04 \x01(\tR\fonBehalfOfId\x12)\n" +
+	"\x11on_behalf_of_name\x18\x05 \x01(\tR\x0eonBehalfOfName\x12#\n" +
+	"\rdelegation_id\x18\x06 \x01(\tR\fdelegationId\x12\x1a\n" +
+	"\bcomments\x18\a \x01(\tR\bcomments\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x97\a\n" +
 	"\fInvoiceInput\x12%\n" +
 	"\x0einvoice_number\x18\x01 \x01(\tR\rinvoiceNumber\x12!\n" +
 	"\finvoice_date\x18\x02 \x01(\tR\vinvoiceDate\x12#\n" +
 	"\rtaxable_value\x18\x03 \x01(\x01R\ftaxableValue\x12\x10\n" +
 	"\x03gst\x18\x04 \x01(\x01R\x03gst\x12#\n" +
 	"\rother_charges\x18\x05 \x01(\x01R\fotherCharges\x12#\n" +
-	"\rinvoice_value\x18\x06 \x01(\x01R\finvoiceValue\"\xb4\x02\n" +
+	"\rinvoice_value\x18\x06 \x01(\x01R\finvoiceValue\x12%\n" +
+	"\x0esupplier_gstin\x18\a \x01(\tR\rsupplierGstin\x12&\n" +
+	"\x0fplace_of_supply\x18\b \x01(\tR\rplaceOfSupply\x126\n" +
+	"\vsupply_type\x18\t \x01(\x0e2\x15.greennote.SupplyTypeR\n" +
+	"supplyType\x12\x12\n" +
+	"\x04cgst\x18\n" +
+	" \x01(\x01R\x04cgst\x12\x12\n" +
+	"\x04sgst\x18\v \x01(\x01R\x04sgst\x12\x12\n" +
+	"\x04igst\x18\f \x01(\x01R\x04igst\x12\x12\n" +
+	"\x04cess\x18\r \x01(\x01R\x04cess\x12,\n" +
+	"\x05lines\x18\x0e \x03(\v2\x16.greennote.InvoiceLineR\x05lines\x12@\n" +
+	"\x13taxable_value_exact\x18\x0f \x01(\v2\x10.greennote.MoneyR\x11taxableValueExact\x12-\n" +
+	"\tgst_exact\x18\x10 \x01(\v2\x10.greennote.MoneyR\bgstExact\x12@\n" +
+	"\x13other_charges_exact\x18\x11 \x01(\v2\x10.greennote.MoneyR\x11otherChargesExact\x12@\n" +
+	"\x13invoice_value_exact\x18\x12 \x01(\v2\x10.greennote.MoneyR\x11invoiceValueExact\x12/\n" +
+	"\n" +
+	"cgst_exact\x18\x13 \x01(\v2\x10.greennote.MoneyR\tcgstExact\x12/\n" +
+	"\n" +
+	"sgst_exact\x18\x14 \x01(\v2\x10.greennote.MoneyR\tsgstExact\x12/\n" +
+	"\n" +
+	"igst_exact\x18\x15 \x01(\v2\x10.greennote.MoneyR\tigstExact\x12/\n" +
+	"\n" +
+	"cess_exact\x18\x16 \x01(\v2\x10.greennote.MoneyR\tcessExact\"\xa2\x04\n" +
+	"\vInvoiceLine\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x17\n" +
+	"\ahsn_sac\x18\x02 \x01(\tR\x06hsnSac\x12#\n" +
+	"\rtaxable_value\x18\x03 \x01(\x01R\ftaxableValue\x12\x19\n" +
+	"\bgst_rate\x18\x04 \x01(\x01R\agstRate\x12\x1b\n" +
+	"\tcess_rate\x18\x05 \x01(\x01R\bcessRate\x12%\n" +
+	"\x0ereverse_charge\x18\x06 \x01(\bR\rreverseCharge\x12\x12\n" +
+	"\x04cgst\x18\a \x01(\x01R\x04cgst\x12\x12\n" +
+	"\x04sgst\x18\b \x01(\x01R\x04sgst\x12\x12\n" +
+	"\x04igst\x18\t \x01(\x01R\x04igst\x12\x12\n" +
+	"\x04cess\x18\n" +
+	" \x01(\x01R\x04cess\x12@\n" +
+	"\x13taxable_value_exact\x18\v \x01(\v2\x10.greennote.MoneyR\x11taxableValueExact\x12/\n" +
+	"\n" +
+	"cgst_exact\x18\f \x01(\v2\x10.greennote.MoneyR\tcgstExact\x12/\n" +
+	"\n" +
+	"sgst_exact\x18\r \x01(\v2\x10.greennote.MoneyR\tsgstExact\x12/\n" +
+	"\n" +
+	"igst_exact\x18\x0e \x01(\v2\x10.greennote.MoneyR\tigstExact\x12/\n" +
+	"\n" +
+	"cess_exact\x18\x0f \x01(\v2\x10.greennote.MoneyR\tcessExact\"\xb4\x02\n" +
 	"\x12SupportingDocument\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
@@ -2522,7 +3944,62 @@ const file_api_proto_greennote_proto_rawDesc = "" +
 	"\x05total\x18\x04 \x01(\x03R\x05total\x12=\n" +
 	"\n" +
 	"pagination\x18\x05 \x01(\v2\x1d.greennote.PaginationMetadataR\n" +
-	"pagination\"}\n" +
+	"pagination\"O\n" +
+	"\x17ExportGSTR2BDataRequest\x12\x1b\n" +
+	"\tfrom_date\x18\x01 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x02 \x01(\tR\x06toDate\"\xc9\x03\n" +
+	"\tGSTR2BRow\x12%\n" +
+	"\x0esupplier_gstin\x18\x01 \x01(\tR\rsupplierGstin\x12#\n" +
+	"\rsupplier_name\x18\x02 \x01(\tR\fsupplierName\x12%\n" +
+	"\x0einvoice_number\x18\x03 \x01(\tR\rinvoiceNumber\x12!\n" +
+	"\finvoice_date\x18\x04 \x01(\tR\vinvoiceDate\x12#\n" +
+	"\rinvoice_value\x18\x05 \x01(\x01R\finvoiceValue\x12&\n" +
+	"\x0fplace_of_supply\x18\x06 \x01(\tR\rplaceOfSupply\x12%\n" +
+	"\x0ereverse_charge\x18\a \x01(\bR\rreverseCharge\x12\x19\n" +
+	"\bgst_rate\x18\b \x01(\x01R\agstRate\x12#\n" +
+	"\rtaxable_value\x18\t \x01(\x01R\ftaxableValue\x12\x12\n" +
+	"\x04igst\x18\n" +
+	" \x01(\x01R\x04igst\x12\x12\n" +
+	"\x04cgst\x18\v \x01(\x01R\x04cgst\x12\x12\n" +
+	"\x04sgst\x18\f \x01(\x01R\x04sgst\x12\x12\n" +
+	"\x04cess\x18\r \x01(\x01R\x04cess\x12\"\n" +
+	"\rgreen_note_id\x18\x0e \x01(\tR\vgreenNoteId\"\x81\x01\n" +
+	"\x18ExportGSTR2BDataResponse\x12(\n" +
+	"\x04rows\x18\x01 \x03(\v2\x14.greennote.GSTR2BRowR\x04rows\x12\x1f\n" +
+	"\vcsv_content\x18\x02 \x01(\fR\n" +
+	"csvContent\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"7\n" +
+	"\x16GetProjectSpendRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"\x89\x02\n" +
+	"\x10ProjectSpendHead\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x120\n" +
+	"\n" +
+	"sanctioned\x18\x02 \x01(\v2\x10.greennote.MoneyR\n" +
+	"sanctioned\x12,\n" +
+	"\bapproved\x18\x03 \x01(\v2\x10.greennote.MoneyR\bapproved\x12*\n" +
+	"\apending\x18\x04 \x01(\v2\x10.greennote.MoneyR\apending\x12.\n" +
+	"\tavailable\x18\x05 \x01(\v2\x10.greennote.MoneyR\tavailable\x12\x1d\n" +
+	"\n" +
+	"note_count\x18\x06 \x01(\x05R\tnoteCount\"\xc0\x03\n" +
+	"\x17GetProjectSpendResponse\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12!\n" +
+	"\fproject_code\x18\x03 \x01(\tR\vprojectCode\x12%\n" +
+	"\x0eproject_status\x18\x04 \x01(\tR\rprojectStatus\x12=\n" +
+	"\x11sanctioned_budget\x18\x05 \x01(\v2\x10.greennote.MoneyR\x10sanctionedBudget\x12,\n" +
+	"\bapproved\x18\x06 \x01(\v2\x10.greennote.MoneyR\bapproved\x12*\n" +
+	"\apending\x18\a \x01(\v2\x10.greennote.MoneyR\apending\x12.\n" +
+	"\tavailable\x18\b \x01(\v2\x10.greennote.MoneyR\tavailable\x12\x1d\n" +
+	"\n" +
+	"note_count\x18\t \x01(\x05R\tnoteCount\x121\n" +
+	"\x05heads\x18\n" +
+	" \x03(\v2\x1b.greennote.ProjectSpendHeadR\x05heads\";\n" +
+	"\"CountOrganizationGreenNotesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\";\n" +
+	"#CountOrganizationGreenNotesResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"}\n" +
 	"\x1fUploadGreenNoteDocumentsRequest\x12\x17\n" +
 	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12A\n" +
 	"\tdocuments\x18\x02 \x03(\v2#.greennote.SupportingDocumentUploadR\tdocuments\"\x8e\x02\n" +
@@ -2533,7 +4010,12 @@ const file_api_proto_greennote_proto_rawDesc = "" +
 	"\x12uploaded_documents\x18\x04 \x03(\v2\x1d.greennote.SupportingDocumentR\x11uploadedDocuments\x12%\n" +
 	"\x0etotal_uploaded\x18\x05 \x01(\x05R\rtotalUploaded\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x06 \x01(\x03R\ttotalSize*w\n" +
+	"total_size\x18\x06 \x01(\x03R\ttotalSize*c\n" +
+	"\n" +
+	"SupplyType\x12\x1b\n" +
+	"\x17SUPPLY_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SUPPLY_TYPE_INTRA_STATE\x10\x01\x12\x1b\n" +
+	"\x17SUPPLY_TYPE_INTER_STATE\x10\x02*w\n" +
 	"\vApprovalFor\x12\x1c\n" +
 	"\x18APPROVAL_FOR_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14APPROVAL_FOR_INVOICE\x10\x01\x12\x18\n" +
@@ -2560,7 +4042,7 @@ const file_api_proto_greennote_proto_rawDesc = "" +
 	"\x05YesNo\x12\x16\n" +
 	"\x12YES_NO_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03YES\x10\x01\x12\x06\n" +
-	"\x02NO\x10\x022\xe9\t\n" +
+	"\x02NO\x10\x022\xc2\r\n" +
 	"\x10GreenNoteService\x12r\n" +
 	"\x0fCreateGreenNote\x12!.greennote.CreateGreenNoteRequest\x1a\x1c.greennote.GreenNoteResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/green-notes\x12t\n" +
 	"\fGetGreenNote\x12\x1e.greennote.GetGreenNoteRequest\x1a\".greennote.GreenNoteDetailResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/green-notes/{id}\x12r\n" +
@@ -2569,7 +4051,10 @@ const file_api_proto_greennote_proto_rawDesc = "" +
 	"\x0fCancelGreenNote\x12!.greennote.CancelGreenNoteRequest\x1a\x1c.greennote.GreenNoteResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/green-notes/{id}/cancel\x12\x97\x01\n" +
 	"\x17GetOrganizationProjects\x12).greennote.GetOrganizationProjectsRequest\x1a*.greennote.GetOrganizationProjectsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/organization/projects\x12\x93\x01\n" +
 	"\x16GetOrganizationVendors\x12(.greennote.GetOrganizationVendorsRequest\x1a).greennote.GetOrganizationVendorsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/organization/vendors\x12\xa3\x01\n" +
-	"\x1aGetOrganizationDepartments\x12,.greennote.GetOrganizationDepartmentsRequest\x1a-.greennote.GetOrganizationDepartmentsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/organization/departments\x12\xa7\x01\n" +
+	"\x1aGetOrganizationDepartments\x12,.greennote.GetOrganizationDepartmentsRequest\x1a-.greennote.GetOrganizationDepartmentsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/organization/departments\x12\x8a\x01\n" +
+	"\x10ExportGSTR2BData\x12\".greennote.ExportGSTR2BDataRequest\x1a#.greennote.ExportGSTR2BDataResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/green-notes/gst/gstr2b-export\x12\x90\x01\n" +
+	"\x0fGetProjectSpend\x12!.greennote.GetProjectSpendRequest\x1a\".greennote.GetProjectSpendResponse\"6\x82\xd3\xe4\x93\x020\x12./api/v1/green-notes/project-spend/{project_id}\x12\xb6\x01\n" +
+	"\x1bCountOrganizationGreenNotes\x12-.greennote.CountOrganizationGreenNotesRequest\x1a..greennote.CountOrganizationGreenNotesResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/organizations/{org_id}/green-notes/count\x12\xa7\x01\n" +
 	"\x18UploadGreenNoteDocuments\x12*.greennote.UploadGreenNoteDocumentsRequest\x1a+.greennote.UploadGreenNoteDocumentsResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/green-notes/{note_id}/documentsB@Z>github.com/ShristiRnr/Nhit-Note/api/pb/greennotepb;greennotepbb\x06proto3"
 
 var (
@@ -2584,103 +4069,161 @@ func file_api_proto_greennote_proto_rawDescGZIP() []byte {
 	return file_api_proto_greennote_proto_rawDescData
 }
 
-var file_api_proto_greennote_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_api_proto_greennote_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_api_proto_greennote_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_proto_greennote_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_api_proto_greennote_proto_goTypes = []any{
-	(ApprovalFor)(0),                           // 0: greennote.ApprovalFor
-	(ExpenseCategoryType)(0),                   // 1: greennote.ExpenseCategoryType
-	(NatureOfExpenses)(0),                      // 2: greennote.NatureOfExpenses
-	(Status)(0),                                // 3: greennote.Status
-	(YesNo)(0),                                 // 4: greennote.YesNo
-	(*CreateGreenNoteRequest)(nil),             // 5: greennote.CreateGreenNoteRequest
-	(*UpdateGreenNoteRequest)(nil),             // 6: greennote.UpdateGreenNoteRequest
-	(*GetGreenNoteRequest)(nil),                // 7: greennote.GetGreenNoteRequest
-	(*CancelGreenNoteRequest)(nil),             // 8: greennote.CancelGreenNoteRequest
-	(*ListGreenNotesRequest)(nil),              // 9: greennote.ListGreenNotesRequest
-	(*GreenNoteListItem)(nil),                  // 10: greennote.GreenNoteListItem
-	(*GreenNotePayload)(nil),                   // 11: greennote.GreenNotePayload
-	(*InvoiceInput)(nil),                       // 12: greennote.InvoiceInput
-	(*SupportingDocument)(nil),                 // 13: greennote.SupportingDocument
-	(*SupportingDocumentUpload)(nil),           // 14: greennote.SupportingDocumentUpload
-	(*GetOrganizationProjectsRequest)(nil),     // 15: greennote.GetOrganizationProjectsRequest
-	(*GetOrganizationProjectsResponse)(nil),    // 16: greennote.GetOrganizationProjectsResponse
-	(*GetOrganizationVendorsRequest)(nil),      // 17: greennote.GetOrganizationVendorsRequest
-	(*GetOrganizationVendorsResponse)(nil),     // 18: greennote.GetOrganizationVendorsResponse
-	(*GetOrganizationDepartmentsRequest)(nil),  // 19: greennote.GetOrganizationDepartmentsRequest
-	(*GetOrganizationDepartmentsResponse)(nil), // 20: greennote.GetOrganizationDepartmentsResponse
-	(*Project)(nil),                            // 21: greennote.Project
-	(*Vendor)(nil),                             // 22: greennote.Vendor
-	(*Department)(nil),                         // 23: greennote.Department
-	(*GreenNoteResponse)(nil),                  // 24: greennote.GreenNoteResponse
-	(*GreenNoteDetailResponse)(nil),            // 25: greennote.GreenNoteDetailResponse
-	(*PaginationMetadata)(nil),                 // 26: greennote.PaginationMetadata
-	(*ListGreenNotesResponse)(nil),             // 27: greennote.ListGreenNotesResponse
-	(*UploadGreenNoteDocumentsRequest)(nil),    // 28: greennote.UploadGreenNoteDocumentsRequest
-	(*UploadGreenNoteDocumentsResponse)(nil),   // 29: greennote.UploadGreenNoteDocumentsResponse
-	(*timestamppb.Timestamp)(nil),              // 30: google.protobuf.Timestamp
+	(SupplyType)(0),                             // 0: greennote.SupplyType
+	(ApprovalFor)(0),                            // 1: greennote.ApprovalFor
+	(ExpenseCategoryType)(0),                    // 2: greennote.ExpenseCategoryType
+	(NatureOfExpenses)(0),                       // 3: greennote.NatureOfExpenses
+	(Status)(0),                                 // 4: greennote.Status
+	(YesNo)(0),                                  // 5: greennote.YesNo
+	(*CreateGreenNoteRequest)(nil),              // 6: greennote.CreateGreenNoteRequest
+	(*UpdateGreenNoteRequest)(nil),              // 7: greennote.UpdateGreenNoteRequest
+	(*GetGreenNoteRequest)(nil),                 // 8: greennote.GetGreenNoteRequest
+	(*CancelGreenNoteRequest)(nil),              // 9: greennote.CancelGreenNoteRequest
+	(*ListGreenNotesRequest)(nil),               // 10: greennote.ListGreenNotesRequest
+	(*GreenNoteListItem)(nil),                   // 11: greennote.GreenNoteListItem
+	(*Money)(nil),                               // 12: greennote.Money
+	(*GreenNotePayload)(nil),                    // 13: greennote.GreenNotePayload
+	(*MSMEFlag)(nil),                            // 14: greennote.MSMEFlag
+	(*ApprovalLogEntry)(nil),                    // 15: greennote.ApprovalLogEntry
+	(*InvoiceInput)(nil),                        // 16: greennote.InvoiceInput
+	(*InvoiceLine)(nil),                         // 17: greennote.InvoiceLine
+	(*SupportingDocument)(nil),                  // 18: greennote.SupportingDocument
+	(*SupportingDocumentUpload)(nil),            // 19: greennote.SupportingDocumentUpload
+	(*GetOrganizationProjectsRequest)(nil),      // 20: greennote.GetOrganizationProjectsRequest
+	(*GetOrganizationProjectsResponse)(nil),     // 21: greennote.GetOrganizationProjectsResponse
+	(*GetOrganizationVendorsRequest)(nil),       // 22: greennote.GetOrganizationVendorsRequest
+	(*GetOrganizationVendorsResponse)(nil),      // 23: greennote.GetOrganizationVendorsResponse
+	(*GetOrganizationDepartmentsRequest)(nil),   // 24: greennote.GetOrganizationDepartmentsRequest
+	(*GetOrganizationDepartmentsResponse)(nil),  // 25: greennote.GetOrganizationDepartmentsResponse
+	(*Project)(nil),                             // 26: greennote.Project
+	(*Vendor)(nil),                              // 27: greennote.Vendor
+	(*Department)(nil),                          // 28: greennote.Department
+	(*GreenNoteResponse)(nil),                   // 29: greennote.GreenNoteResponse
+	(*GreenNoteDetailResponse)(nil),             // 30: greennote.GreenNoteDetailResponse
+	(*PaginationMetadata)(nil),                  // 31: greennote.PaginationMetadata
+	(*ListGreenNotesResponse)(nil),              // 32: greennote.ListGreenNotesResponse
+	(*ExportGSTR2BDataRequest)(nil),             // 33: greennote.ExportGSTR2BDataRequest
+	(*GSTR2BRow)(nil),                           // 34: greennote.GSTR2BRow
+	(*ExportGSTR2BDataResponse)(nil),            // 35: greennote.ExportGSTR2BDataResponse
+	(*GetProjectSpendRequest)(nil),              // 36: greennote.GetProjectSpendRequest
+	(*ProjectSpendHead)(nil),                    // 37: greennote.ProjectSpendHead
+	(*GetProjectSpendResponse)(nil),             // 38: greennote.GetProjectSpendResponse
+	(*CountOrganizationGreenNotesRequest)(nil),  // 39: greennote.CountOrganizationGreenNotesRequest
+	(*CountOrganizationGreenNotesResponse)(nil), // 40: greennote.CountOrganizationGreenNotesResponse
+	(*UploadGreenNoteDocumentsRequest)(nil),     // 41: greennote.UploadGreenNoteDocumentsRequest
+	(*UploadGreenNoteDocumentsResponse)(nil),    // 42: greennote.UploadGreenNoteDocumentsResponse
+	(*timestamppb.Timestamp)(nil),               // 43: google.protobuf.Timestamp
 }
 var file_api_proto_greennote_proto_depIdxs = []int32{
-	11, // 0: greennote.CreateGreenNoteRequest.note:type_name -> greennote.GreenNotePayload
-	11, // 1: greennote.UpdateGreenNoteRequest.note:type_name -> greennote.GreenNotePayload
-	3,  // 2: greennote.ListGreenNotesRequest.status:type_name -> greennote.Status
-	3,  // 3: greennote.GreenNoteListItem.status:type_name -> greennote.Status
-	4,  // 4: greennote.GreenNotePayload.protest_note_raised:type_name -> greennote.YesNo
-	4,  // 5: greennote.GreenNotePayload.whether_contract:type_name -> greennote.YesNo
-	4,  // 6: greennote.GreenNotePayload.extension_of_contract_period_executed:type_name -> greennote.YesNo
-	4,  // 7: greennote.GreenNotePayload.expense_amount_within_contract:type_name -> greennote.YesNo
-	4,  // 8: greennote.GreenNotePayload.milestone_achieved:type_name -> greennote.YesNo
-	4,  // 9: greennote.GreenNotePayload.payment_approved_with_deviation:type_name -> greennote.YesNo
-	4,  // 10: greennote.GreenNotePayload.required_documents_submitted:type_name -> greennote.YesNo
-	12, // 11: greennote.GreenNotePayload.invoice:type_name -> greennote.InvoiceInput
-	12, // 12: greennote.GreenNotePayload.invoices:type_name -> greennote.InvoiceInput
-	3,  // 13: greennote.GreenNotePayload.status:type_name -> greennote.Status
-	0,  // 14: greennote.GreenNotePayload.approval_for:type_name -> greennote.ApprovalFor
-	1,  // 15: greennote.GreenNotePayload.expense_category_type:type_name -> greennote.ExpenseCategoryType
-	2,  // 16: greennote.GreenNotePayload.nature_of_expenses:type_name -> greennote.NatureOfExpenses
-	4,  // 17: greennote.GreenNotePayload.contract_period_completed:type_name -> greennote.YesNo
-	30, // 18: greennote.GreenNotePayload.created_at:type_name -> google.protobuf.Timestamp
-	30, // 19: greennote.GreenNotePayload.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 20: greennote.GreenNotePayload.new_documents:type_name -> greennote.SupportingDocumentUpload
-	13, // 21: greennote.GreenNotePayload.existing_documents:type_name -> greennote.SupportingDocument
-	30, // 22: greennote.SupportingDocument.created_at:type_name -> google.protobuf.Timestamp
-	30, // 23: greennote.SupportingDocument.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 24: greennote.GetOrganizationProjectsResponse.projects:type_name -> greennote.Project
-	22, // 25: greennote.GetOrganizationVendorsResponse.vendors:type_name -> greennote.Vendor
-	23, // 26: greennote.GetOrganizationDepartmentsResponse.departments:type_name -> greennote.Department
-	30, // 27: greennote.Project.created_at:type_name -> google.protobuf.Timestamp
-	30, // 28: greennote.Project.updated_at:type_name -> google.protobuf.Timestamp
-	30, // 29: greennote.Vendor.created_at:type_name -> google.protobuf.Timestamp
-	30, // 30: greennote.Vendor.updated_at:type_name -> google.protobuf.Timestamp
-	30, // 31: greennote.Department.created_at:type_name -> google.protobuf.Timestamp
-	30, // 32: greennote.Department.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 33: greennote.GreenNoteDetailResponse.data:type_name -> greennote.GreenNotePayload
-	10, // 34: greennote.ListGreenNotesResponse.notes:type_name -> greennote.GreenNoteListItem
-	26, // 35: greennote.ListGreenNotesResponse.pagination:type_name -> greennote.PaginationMetadata
-	14, // 36: greennote.UploadGreenNoteDocumentsRequest.documents:type_name -> greennote.SupportingDocumentUpload
-	13, // 37: greennote.UploadGreenNoteDocumentsResponse.uploaded_documents:type_name -> greennote.SupportingDocument
-	5,  // 38: greennote.GreenNoteService.CreateGreenNote:input_type -> greennote.CreateGreenNoteRequest
-	7,  // 39: greennote.GreenNoteService.GetGreenNote:input_type -> greennote.GetGreenNoteRequest
-	9,  // 40: greennote.GreenNoteService.ListGreenNotes:input_type -> greennote.ListGreenNotesRequest
-	6,  // 41: greennote.GreenNoteService.UpdateGreenNote:input_type -> greennote.UpdateGreenNoteRequest
-	8,  // 42: greennote.GreenNoteService.CancelGreenNote:input_type -> greennote.CancelGreenNoteRequest
-	15, // 43: greennote.GreenNoteService.GetOrganizationProjects:input_type -> greennote.GetOrganizationProjectsRequest
-	17, // 44: greennote.GreenNoteService.GetOrganizationVendors:input_type -> greennote.GetOrganizationVendorsRequest
-	19, // 45: greennote.GreenNoteService.GetOrganizationDepartments:input_type -> greennote.GetOrganizationDepartmentsRequest
-	28, // 46: greennote.GreenNoteService.UploadGreenNoteDocuments:input_type -> greennote.UploadGreenNoteDocumentsRequest
-	24, // 47: greennote.GreenNoteService.CreateGreenNote:output_type -> greennote.GreenNoteResponse
-	25, // 48: greennote.GreenNoteService.GetGreenNote:output_type -> greennote.GreenNoteDetailResponse
-	27, // 49: greennote.GreenNoteService.ListGreenNotes:output_type -> greennote.ListGreenNotesResponse
-	24, // 50: greennote.GreenNoteService.UpdateGreenNote:output_type -> greennote.GreenNoteResponse
-	24, // 51: greennote.GreenNoteService.CancelGreenNote:output_type -> greennote.GreenNoteResponse
-	16, // 52: greennote.GreenNoteService.GetOrganizationProjects:output_type -> greennote.GetOrganizationProjectsResponse
-	18, // 53: greennote.GreenNoteService.GetOrganizationVendors:output_type -> greennote.GetOrganizationVendorsResponse
-	20, // 54: greennote.GreenNoteService.GetOrganizationDepartments:output_type -> greennote.GetOrganizationDepartmentsResponse
-	29, // 55: greennote.GreenNoteService.UploadGreenNoteDocuments:output_type -> greennote.UploadGreenNoteDocumentsResponse
-	47, // [47:56] is the sub-list for method output_type
-	38, // [38:47] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
+	13, // 0: greennote.CreateGreenNoteRequest.note:type_name -> greennote.GreenNotePayload
+	13, // 1: greennote.UpdateGreenNoteRequest.note:type_name -> greennote.GreenNotePayload
+	4,  // 2: greennote.ListGreenNotesRequest.status:type_name -> greennote.Status
+	4,  // 3: greennote.GreenNoteListItem.status:type_name -> greennote.Status
+	12, // 4: greennote.GreenNoteListItem.amount_exact:type_name -> greennote.Money
+	5,  // 5: greennote.GreenNotePayload.protest_note_raised:type_name -> greennote.YesNo
+	5,  // 6: greennote.GreenNotePayload.whether_contract:type_name -> greennote.YesNo
+	5,  // 7: greennote.GreenNotePayload.extension_of_contract_period_executed:type_name -> greennote.YesNo
+	5,  // 8: greennote.GreenNotePayload.expense_amount_within_contract:type_name -> greennote.YesNo
+	5,  // 9: greennote.GreenNotePayload.milestone_achieved:type_name -> greennote.YesNo
+	5,  // 10: greennote.GreenNotePayload.payment_approved_with_deviation:type_name -> greennote.YesNo
+	5,  // 11: greennote.GreenNotePayload.required_documents_submitted:type_name -> greennote.YesNo
+	16, // 12: greennote.GreenNotePayload.invoice:type_name -> greennote.InvoiceInput
+	16, // 13: greennote.GreenNotePayload.invoices:type_name -> greennote.InvoiceInput
+	4,  // 14: greennote.GreenNotePayload.status:type_name -> greennote.Status
+	1,  // 15: greennote.GreenNotePayload.approval_for:type_name -> greennote.ApprovalFor
+	2,  // 16: greennote.GreenNotePayload.expense_category_type:type_name -> greennote.ExpenseCategoryType
+	3,  // 17: greennote.GreenNotePayload.nature_of_expenses:type_name -> greennote.NatureOfExpenses
+	5,  // 18: greennote.GreenNotePayload.contract_period_completed:type_name -> greennote.YesNo
+	43, // 19: greennote.GreenNotePayload.created_at:type_name -> google.protobuf.Timestamp
+	43, // 20: greennote.GreenNotePayload.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 21: greennote.GreenNotePayload.new_documents:type_name -> greennote.SupportingDocumentUpload
+	18, // 22: greennote.GreenNotePayload.existing_documents:type_name -> greennote.SupportingDocument
+	12, // 23: greennote.GreenNotePayload.base_value_exact:type_name -> greennote.Money
+	12, // 24: greennote.GreenNotePayload.other_charges_exact:type_name -> greennote.Money
+	12, // 25: greennote.GreenNotePayload.gst_exact:type_name -> greennote.Money
+	12, // 26: greennote.GreenNotePayload.total_amount_exact:type_name -> greennote.Money
+	12, // 27: greennote.GreenNotePayload.budget_expenditure_exact:type_name -> greennote.Money
+	12, // 28: greennote.GreenNotePayload.actual_expenditure_exact:type_name -> greennote.Money
+	12, // 29: greennote.GreenNotePayload.expenditure_over_budget_exact:type_name -> greennote.Money
+	12, // 30: greennote.GreenNotePayload.amount_retained_for_non_submission_exact:type_name -> greennote.Money
+	15, // 31: greennote.GreenNotePayload.approval_logs:type_name -> greennote.ApprovalLogEntry
+	14, // 32: greennote.GreenNotePayload.msme_flag:type_name -> greennote.MSMEFlag
+	12, // 33: greennote.MSMEFlag.interest_liability_exact:type_name -> greennote.Money
+	43, // 34: greennote.MSMEFlag.flagged_at:type_name -> google.protobuf.Timestamp
+	43, // 35: greennote.ApprovalLogEntry.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 36: greennote.InvoiceInput.supply_type:type_name -> greennote.SupplyType
+	17, // 37: greennote.InvoiceInput.lines:type_name -> greennote.InvoiceLine
+	12, // 38: greennote.InvoiceInput.taxable_value_exact:type_name -> greennote.Money
+	12, // 39: greennote.InvoiceInput.gst_exact:type_name -> greennote.Money
+	12, // 40: greennote.InvoiceInput.other_charges_exact:type_name -> greennote.Money
+	12, // 41: greennote.InvoiceInput.invoice_value_exact:type_name -> greennote.Money
+	12, // 42: greennote.InvoiceInput.cgst_exact:type_name -> greennote.Money
+	12, // 43: greennote.InvoiceInput.sgst_exact:type_name -> greennote.Money
+	12, // 44: greennote.InvoiceInput.igst_exact:type_name -> greennote.Money
+	12, // 45: greennote.InvoiceInput.cess_exact:type_name -> greennote.Money
+	12, // 46: greennote.InvoiceLine.taxable_value_exact:type_name -> greennote.Money
+	12, // 47: greennote.InvoiceLine.cgst_exact:type_name -> greennote.Money
+	12, // 48: greennote.InvoiceLine.sgst_exact:type_name -> greennote.Money
+	12, // 49: greennote.InvoiceLine.igst_exact:type_name -> greennote.Money
+	12, // 50: greennote.InvoiceLine.cess_exact:type_name -> greennote.Money
+	43, // 51: greennote.SupportingDocument.created_at:type_name -> google.protobuf.Timestamp
+	43, // 52: greennote.SupportingDocument.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 53: greennote.GetOrganizationProjectsResponse.projects:type_name -> greennote.Project
+	27, // 54: greennote.GetOrganizationVendorsResponse.vendors:type_name -> greennote.Vendor
+	28, // 55: greennote.GetOrganizationDepartmentsResponse.departments:type_name -> greennote.Department
+	43, // 56: greennote.Project.created_at:type_name -> google.protobuf.Timestamp
+	43, // 57: greennote.Project.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 58: greennote.Vendor.created_at:type_name -> google.protobuf.Timestamp
+	43, // 59: greennote.Vendor.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 60: greennote.Department.created_at:type_name -> google.protobuf.Timestamp
+	43, // 61: greennote.Department.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 62: greennote.GreenNoteDetailResponse.data:type_name -> greennote.GreenNotePayload
+	11, // 63: greennote.ListGreenNotesResponse.notes:type_name -> greennote.GreenNoteListItem
+	31, // 64: greennote.ListGreenNotesResponse.pagination:type_name -> greennote.PaginationMetadata
+	34, // 65: greennote.ExportGSTR2BDataResponse.rows:type_name -> greennote.GSTR2BRow
+	12, // 66: greennote.ProjectSpendHead.sanctioned:type_name -> greennote.Money
+	12, // 67: greennote.ProjectSpendHead.approved:type_name -> greennote.Money
+	12, // 68: greennote.ProjectSpendHead.pending:type_name -> greennote.Money
+	12, // 69: greennote.ProjectSpendHead.available:type_name -> greennote.Money
+	12, // 70: greennote.GetProjectSpendResponse.sanctioned_budget:type_name -> greennote.Money
+	12, // 71: greennote.GetProjectSpendResponse.approved:type_name -> greennote.Money
+	12, // 72: greennote.GetProjectSpendResponse.pending:type_name -> greennote.Money
+	12, // 73: greennote.GetProjectSpendResponse.available:type_name -> greennote.Money
+	37, // 74: greennote.GetProjectSpendResponse.heads:type_name -> greennote.ProjectSpendHead
+	19, // 75: greennote.UploadGreenNoteDocumentsRequest.documents:type_name -> greennote.SupportingDocumentUpload
+	18, // 76: greennote.UploadGreenNoteDocumentsResponse.uploaded_documents:type_name -> greennote.SupportingDocument
+	6,  // 77: greennote.GreenNoteService.CreateGreenNote:input_type -> greennote.CreateGreenNoteRequest
+	8,  // 78: greennote.GreenNoteService.GetGreenNote:input_type -> greennote.GetGreenNoteRequest
+	10, // 79: greennote.GreenNoteService.ListGreenNotes:input_type -> greennote.ListGreenNotesRequest
+	7,  // 80: greennote.GreenNoteService.UpdateGreenNote:input_type -> greennote.UpdateGreenNoteRequest
+	9,  // 81: greennote.GreenNoteService.CancelGreenNote:input_type -> greennote.CancelGreenNoteRequest
+	20, // 82: greennote.GreenNoteService.GetOrganizationProjects:input_type -> greennote.GetOrganizationProjectsRequest
+	22, // 83: greennote.GreenNoteService.GetOrganizationVendors:input_type -> greennote.GetOrganizationVendorsRequest
+	24, // 84: greennote.GreenNoteService.GetOrganizationDepartments:input_type -> greennote.GetOrganizationDepartmentsRequest
+	33, // 85: greennote.GreenNoteService.ExportGSTR2BData:input_type -> greennote.ExportGSTR2BDataRequest
+	36, // 86: greennote.GreenNoteService.GetProjectSpend:input_type -> greennote.GetProjectSpendRequest
+	39, // 87: greennote.GreenNoteService.CountOrganizationGreenNotes:input_type -> greennote.CountOrganizationGreenNotesRequest
+	41, // 88: greennote.GreenNoteService.UploadGreenNoteDocuments:input_type -> greennote.UploadGreenNoteDocumentsRequest
+	29, // 89: greennote.GreenNoteService.CreateGreenNote:output_type -> greennote.GreenNoteResponse
+	30, // 90: greennote.GreenNoteService.GetGreenNote:output_type -> greennote.GreenNoteDetailResponse
+	32, // 91: greennote.GreenNoteService.ListGreenNotes:output_type -> greennote.ListGreenNotesResponse
+	29, // 92: greennote.GreenNoteService.UpdateGreenNote:output_type -> greennote.GreenNoteResponse
+	29, // 93: greennote.GreenNoteService.CancelGreenNote:output_type -> greennote.GreenNoteResponse
+	21, // 94: greennote.GreenNoteService.GetOrganizationProjects:output_type -> greennote.GetOrganizationProjectsResponse
+	23, // 95: greennote.GreenNoteService.GetOrganizationVendors:output_type -> greennote.GetOrganizationVendorsResponse
+	25, // 96: greennote.GreenNoteService.GetOrganizationDepartments:output_type -> greennote.GetOrganizationDepartmentsResponse
+	35, // 97: greennote.GreenNoteService.ExportGSTR2BData:output_type -> greennote.ExportGSTR2BDataResponse
+	38, // 98: greennote.GreenNoteService.GetProjectSpend:output_type -> greennote.GetProjectSpendResponse
+	40, // 99: greennote.GreenNoteService.CountOrganizationGreenNotes:output_type -> greennote.CountOrganizationGreenNotesResponse
+	42, // 100: greennote.GreenNoteService.UploadGreenNoteDocuments:output_type -> greennote.UploadGreenNoteDocumentsResponse
+	89, // [89:101] is the sub-list for method output_type
+	77, // [77:89] is the sub-list for method input_type
+	77, // [77:77] is the sub-list for extension type_name
+	77, // [77:77] is the sub-list for extension extendee
+	0,  // [0:77] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_greennote_proto_init() }
@@ -2693,8 +4236,8 @@ func file_api_proto_greennote_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_greennote_proto_rawDesc), len(file_api_proto_greennote_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   25,
+			NumEnums:      6,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
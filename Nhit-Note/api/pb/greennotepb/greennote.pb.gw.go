@@ -289,6 +289,119 @@ func local_request_GreenNoteService_GetOrganizationDepartments_0(ctx context.Con
 	return msg, metadata, err
 }
 
+var filter_GreenNoteService_ExportGSTR2BData_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_GreenNoteService_ExportGSTR2BData_0(ctx context.Context, marshaler runtime.Marshaler, client GreenNoteServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ExportGSTR2BDataRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_GreenNoteService_ExportGSTR2BData_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.ExportGSTR2BData(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_GreenNoteService_ExportGSTR2BData_0(ctx context.Context, marshaler runtime.Marshaler, server GreenNoteServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ExportGSTR2BDataRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_GreenNoteService_ExportGSTR2BData_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.ExportGSTR2BData(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_GreenNoteService_GetProjectSpend_0(ctx context.Context, marshaler runtime.Marshaler, client GreenNoteServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetProjectSpendRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["project_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "project_id")
+	}
+	protoReq.ProjectId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "project_id", err)
+	}
+	msg, err := client.GetProjectSpend(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_GreenNoteService_GetProjectSpend_0(ctx context.Context, marshaler runtime.Marshaler, server GreenNoteServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetProjectSpendRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["project_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "project_id")
+	}
+	protoReq.ProjectId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "project_id", err)
+	}
+	msg, err := server.GetProjectSpend(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_GreenNoteService_CountOrganizationGreenNotes_0(ctx context.Context, marshaler runtime.Marshaler, client GreenNoteServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CountOrganizationGreenNotesRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["org_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "org_id")
+	}
+	protoReq.OrgId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "org_id", err)
+	}
+	msg, err := client.CountOrganizationGreenNotes(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_GreenNoteService_CountOrganizationGreenNotes_0(ctx context.Context, marshaler runtime.Marshaler, server GreenNoteServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CountOrganizationGreenNotesRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["org_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "org_id")
+	}
+	protoReq.OrgId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "org_id", err)
+	}
+	msg, err := server.CountOrganizationGreenNotes(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_GreenNoteService_UploadGreenNoteDocuments_0(ctx context.Context, marshaler runtime.Marshaler, client GreenNoteServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq UploadGreenNoteDocumentsRequest
@@ -500,6 +613,66 @@ func RegisterGreenNoteServiceHandlerServer(ctx context.Context, mux *runtime.Ser
 		}
 		forward_GreenNoteService_GetOrganizationDepartments_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_GreenNoteService_ExportGSTR2BData_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/greennote.GreenNoteService/ExportGSTR2BData", runtime.WithHTTPPathPattern("/api/v1/green-notes/gst/gstr2b-export"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_GreenNoteService_ExportGSTR2BData_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_GreenNoteService_ExportGSTR2BData_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_GreenNoteService_GetProjectSpend_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/greennote.GreenNoteService/GetProjectSpend", runtime.WithHTTPPathPattern("/api/v1/green-notes/project-spend/{project_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_GreenNoteService_GetProjectSpend_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_GreenNoteService_GetProjectSpend_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_GreenNoteService_CountOrganizationGreenNotes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/greennote.GreenNoteService/CountOrganizationGreenNotes", runtime.WithHTTPPathPattern("/api/v1/organizations/{org_id}/green-notes/count"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_GreenNoteService_CountOrganizationGreenNotes_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_GreenNoteService_CountOrganizationGreenNotes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_GreenNoteService_UploadGreenNoteDocuments_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -696,6 +869,57 @@ func RegisterGreenNoteServiceHandlerClient(ctx context.Context, mux *runtime.Ser
 		}
 		forward_GreenNoteService_GetOrganizationDepartments_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_GreenNoteService_ExportGSTR2BData_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/greennote.GreenNoteService/ExportGSTR2BData", runtime.WithHTTPPathPattern("/api/v1/green-notes/gst/gstr2b-export"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_GreenNoteService_ExportGSTR2BData_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_GreenNoteService_ExportGSTR2BData_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_GreenNoteService_GetProjectSpend_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/greennote.GreenNoteService/GetProjectSpend", runtime.WithHTTPPathPattern("/api/v1/green-notes/project-spend/{project_id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_GreenNoteService_GetProjectSpend_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_GreenNoteService_GetProjectSpend_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodGet, pattern_GreenNoteService_CountOrganizationGreenNotes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/greennote.GreenNoteService/CountOrganizationGreenNotes", runtime.WithHTTPPathPattern("/api/v1/organizations/{org_id}/green-notes/count"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_GreenNoteService_CountOrganizationGreenNotes_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_GreenNoteService_CountOrganizationGreenNotes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_GreenNoteService_UploadGreenNoteDocuments_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -717,25 +941,31 @@ func RegisterGreenNoteServiceHandlerClient(ctx context.Context, mux *runtime.Ser
 }
 
 var (
-	pattern_GreenNoteService_CreateGreenNote_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "green-notes"}, ""))
-	pattern_GreenNoteService_GetGreenNote_0               = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "green-notes", "id"}, ""))
-	pattern_GreenNoteService_ListGreenNotes_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "green-notes"}, ""))
-	pattern_GreenNoteService_UpdateGreenNote_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "green-notes", "id"}, ""))
-	pattern_GreenNoteService_CancelGreenNote_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "green-notes", "id", "cancel"}, ""))
-	pattern_GreenNoteService_GetOrganizationProjects_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "organization", "projects"}, ""))
-	pattern_GreenNoteService_GetOrganizationVendors_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "organization", "vendors"}, ""))
-	pattern_GreenNoteService_GetOrganizationDepartments_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "organization", "departments"}, ""))
-	pattern_GreenNoteService_UploadGreenNoteDocuments_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "green-notes", "note_id", "documents"}, ""))
+	pattern_GreenNoteService_CreateGreenNote_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "green-notes"}, ""))
+	pattern_GreenNoteService_GetGreenNote_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "green-notes", "id"}, ""))
+	pattern_GreenNoteService_ListGreenNotes_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"api", "v1", "green-notes"}, ""))
+	pattern_GreenNoteService_UpdateGreenNote_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"api", "v1", "green-notes", "id"}, ""))
+	pattern_GreenNoteService_CancelGreenNote_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "green-notes", "id", "cancel"}, ""))
+	pattern_GreenNoteService_GetOrganizationProjects_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "organization", "projects"}, ""))
+	pattern_GreenNoteService_GetOrganizationVendors_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "organization", "vendors"}, ""))
+	pattern_GreenNoteService_GetOrganizationDepartments_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "organization", "departments"}, ""))
+	pattern_GreenNoteService_ExportGSTR2BData_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "green-notes", "gst", "gstr2b-export"}, ""))
+	pattern_GreenNoteService_GetProjectSpend_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "green-notes", "project-spend", "project_id"}, ""))
+	pattern_GreenNoteService_CountOrganizationGreenNotes_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 2, 5}, []string{"api", "v1", "organizations", "org_id", "green-notes", "count"}, ""))
+	pattern_GreenNoteService_UploadGreenNoteDocuments_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "green-notes", "note_id", "documents"}, ""))
 )
 
 var (
-	forward_GreenNoteService_CreateGreenNote_0            = runtime.ForwardResponseMessage
-	forward_GreenNoteService_GetGreenNote_0               = runtime.ForwardResponseMessage
-	forward_GreenNoteService_ListGreenNotes_0             = runtime.ForwardResponseMessage
-	forward_GreenNoteService_UpdateGreenNote_0            = runtime.ForwardResponseMessage
-	forward_GreenNoteService_CancelGreenNote_0            = runtime.ForwardResponseMessage
-	forward_GreenNoteService_GetOrganizationProjects_0    = runtime.ForwardResponseMessage
-	forward_GreenNoteService_GetOrganizationVendors_0     = runtime.ForwardResponseMessage
-	forward_GreenNoteService_GetOrganizationDepartments_0 = runtime.ForwardResponseMessage
-	forward_GreenNoteService_UploadGreenNoteDocuments_0   = runtime.ForwardResponseMessage
+	forward_GreenNoteService_CreateGreenNote_0             = runtime.ForwardResponseMessage
+	forward_GreenNoteService_GetGreenNote_0                = runtime.ForwardResponseMessage
+	forward_GreenNoteService_ListGreenNotes_0              = runtime.ForwardResponseMessage
+	forward_GreenNoteService_UpdateGreenNote_0             = runtime.ForwardResponseMessage
+	forward_GreenNoteService_CancelGreenNote_0             = runtime.ForwardResponseMessage
+	forward_GreenNoteService_GetOrganizationProjects_0     = runtime.ForwardResponseMessage
+	forward_GreenNoteService_GetOrganizationVendors_0      = runtime.ForwardResponseMessage
+	forward_GreenNoteService_GetOrganizationDepartments_0  = runtime.ForwardResponseMessage
+	forward_GreenNoteService_ExportGSTR2BData_0            = runtime.ForwardResponseMessage
+	forward_GreenNoteService_GetProjectSpend_0             = runtime.ForwardResponseMessage
+	forward_GreenNoteService_CountOrganizationGreenNotes_0 = runtime.ForwardResponseMessage
+	forward_GreenNoteService_UploadGreenNoteDocuments_0    = runtime.ForwardResponseMessage
 )
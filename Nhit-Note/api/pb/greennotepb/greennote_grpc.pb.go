@@ -19,15 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GreenNoteService_CreateGreenNote_FullMethodName            = "/greennote.GreenNoteService/CreateGreenNote"
-	GreenNoteService_GetGreenNote_FullMethodName               = "/greennote.GreenNoteService/GetGreenNote"
-	GreenNoteService_ListGreenNotes_FullMethodName             = "/greennote.GreenNoteService/ListGreenNotes"
-	GreenNoteService_UpdateGreenNote_FullMethodName            = "/greennote.GreenNoteService/UpdateGreenNote"
-	GreenNoteService_CancelGreenNote_FullMethodName            = "/greennote.GreenNoteService/CancelGreenNote"
-	GreenNoteService_GetOrganizationProjects_FullMethodName    = "/greennote.GreenNoteService/GetOrganizationProjects"
-	GreenNoteService_GetOrganizationVendors_FullMethodName     = "/greennote.GreenNoteService/GetOrganizationVendors"
-	GreenNoteService_GetOrganizationDepartments_FullMethodName = "/greennote.GreenNoteService/GetOrganizationDepartments"
-	GreenNoteService_UploadGreenNoteDocuments_FullMethodName   = "/greennote.GreenNoteService/UploadGreenNoteDocuments"
+	GreenNoteService_CreateGreenNote_FullMethodName             = "/greennote.GreenNoteService/CreateGreenNote"
+	GreenNoteService_GetGreenNote_FullMethodName                = "/greennote.GreenNoteService/GetGreenNote"
+	GreenNoteService_ListGreenNotes_FullMethodName              = "/greennote.GreenNoteService/ListGreenNotes"
+	GreenNoteService_UpdateGreenNote_FullMethodName             = "/greennote.GreenNoteService/UpdateGreenNote"
+	GreenNoteService_CancelGreenNote_FullMethodName             = "/greennote.GreenNoteService/CancelGreenNote"
+	GreenNoteService_GetOrganizationProjects_FullMethodName     = "/greennote.GreenNoteService/GetOrganizationProjects"
+	GreenNoteService_GetOrganizationVendors_FullMethodName      = "/greennote.GreenNoteService/GetOrganizationVendors"
+	GreenNoteService_GetOrganizationDepartments_FullMethodName  = "/greennote.GreenNoteService/GetOrganizationDepartments"
+	GreenNoteService_ExportGSTR2BData_FullMethodName            = "/greennote.GreenNoteService/ExportGSTR2BData"
+	GreenNoteService_GetProjectSpend_FullMethodName             = "/greennote.GreenNoteService/GetProjectSpend"
+	GreenNoteService_CountOrganizationGreenNotes_FullMethodName = "/greennote.GreenNoteService/CountOrganizationGreenNotes"
+	GreenNoteService_UploadGreenNoteDocuments_FullMethodName    = "/greennote.GreenNoteService/UploadGreenNoteDocuments"
 )
 
 // GreenNoteServiceClient is the client API for GreenNoteService service.
@@ -54,6 +57,13 @@ type GreenNoteServiceClient interface {
 	GetOrganizationVendors(ctx context.Context, in *GetOrganizationVendorsRequest, opts ...grpc.CallOption) (*GetOrganizationVendorsResponse, error)
 	// Get departments for the logged-in user's organization
 	GetOrganizationDepartments(ctx context.Context, in *GetOrganizationDepartmentsRequest, opts ...grpc.CallOption) (*GetOrganizationDepartmentsResponse, error)
+	// Export invoice GST breakup in GSTR-2B layout for ITC matching
+	ExportGSTR2BData(ctx context.Context, in *ExportGSTR2BDataRequest, opts ...grpc.CallOption) (*ExportGSTR2BDataResponse, error)
+	// Roll up a project's green note spend against its sanctioned budget heads
+	GetProjectSpend(ctx context.Context, in *GetProjectSpendRequest, opts ...grpc.CallOption) (*GetProjectSpendResponse, error)
+	// Count an organization's green notes, cancelled ones included, so the
+	// organization is not purged while notes still reference it
+	CountOrganizationGreenNotes(ctx context.Context, in *CountOrganizationGreenNotesRequest, opts ...grpc.CallOption) (*CountOrganizationGreenNotesResponse, error)
 	// Upload GreenNote Documents
 	UploadGreenNoteDocuments(ctx context.Context, in *UploadGreenNoteDocumentsRequest, opts ...grpc.CallOption) (*UploadGreenNoteDocumentsResponse, error)
 }
@@ -146,6 +156,36 @@ func (c *greenNoteServiceClient) GetOrganizationDepartments(ctx context.Context,
 	return out, nil
 }
 
+func (c *greenNoteServiceClient) ExportGSTR2BData(ctx context.Context, in *ExportGSTR2BDataRequest, opts ...grpc.CallOption) (*ExportGSTR2BDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGSTR2BDataResponse)
+	err := c.cc.Invoke(ctx, GreenNoteService_ExportGSTR2BData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greenNoteServiceClient) GetProjectSpend(ctx context.Context, in *GetProjectSpendRequest, opts ...grpc.CallOption) (*GetProjectSpendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectSpendResponse)
+	err := c.cc.Invoke(ctx, GreenNoteService_GetProjectSpend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greenNoteServiceClient) CountOrganizationGreenNotes(ctx context.Context, in *CountOrganizationGreenNotesRequest, opts ...grpc.CallOption) (*CountOrganizationGreenNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountOrganizationGreenNotesResponse)
+	err := c.cc.Invoke(ctx, GreenNoteService_CountOrganizationGreenNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *greenNoteServiceClient) UploadGreenNoteDocuments(ctx context.Context, in *UploadGreenNoteDocumentsRequest, opts ...grpc.CallOption) (*UploadGreenNoteDocumentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadGreenNoteDocumentsResponse)
@@ -180,6 +220,13 @@ type GreenNoteServiceServer interface {
 	GetOrganizationVendors(context.Context, *GetOrganizationVendorsRequest) (*GetOrganizationVendorsResponse, error)
 	// Get departments for the logged-in user's organization
 	GetOrganizationDepartments(context.Context, *GetOrganizationDepartmentsRequest) (*GetOrganizationDepartmentsResponse, error)
+	// Export invoice GST breakup in GSTR-2B layout for ITC matching
+	ExportGSTR2BData(context.Context, *ExportGSTR2BDataRequest) (*ExportGSTR2BDataResponse, error)
+	// Roll up a project's green note spend against its sanctioned budget heads
+	GetProjectSpend(context.Context, *GetProjectSpendRequest) (*GetProjectSpendResponse, error)
+	// Count an organization's green notes, cancelled ones included, so the
+	// organization is not purged while notes still reference it
+	CountOrganizationGreenNotes(context.Context, *CountOrganizationGreenNotesRequest) (*CountOrganizationGreenNotesResponse, error)
 	// Upload GreenNote Documents
 	UploadGreenNoteDocuments(context.Context, *UploadGreenNoteDocumentsRequest) (*UploadGreenNoteDocumentsResponse, error)
 	mustEmbedUnimplementedGreenNoteServiceServer()
@@ -216,6 +263,15 @@ func (UnimplementedGreenNoteServiceServer) GetOrganizationVendors(context.Contex
 func (UnimplementedGreenNoteServiceServer) GetOrganizationDepartments(context.Context, *GetOrganizationDepartmentsRequest) (*GetOrganizationDepartmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationDepartments not implemented")
 }
+func (UnimplementedGreenNoteServiceServer) ExportGSTR2BData(context.Context, *ExportGSTR2BDataRequest) (*ExportGSTR2BDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGSTR2BData not implemented")
+}
+func (UnimplementedGreenNoteServiceServer) GetProjectSpend(context.Context, *GetProjectSpendRequest) (*GetProjectSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectSpend not implemented")
+}
+func (UnimplementedGreenNoteServiceServer) CountOrganizationGreenNotes(context.Context, *CountOrganizationGreenNotesRequest) (*CountOrganizationGreenNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountOrganizationGreenNotes not implemented")
+}
 func (UnimplementedGreenNoteServiceServer) UploadGreenNoteDocuments(context.Context, *UploadGreenNoteDocumentsRequest) (*UploadGreenNoteDocumentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadGreenNoteDocuments not implemented")
 }
@@ -384,6 +440,60 @@ func _GreenNoteService_GetOrganizationDepartments_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GreenNoteService_ExportGSTR2BData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGSTR2BDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreenNoteServiceServer).ExportGSTR2BData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreenNoteService_ExportGSTR2BData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreenNoteServiceServer).ExportGSTR2BData(ctx, req.(*ExportGSTR2BDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreenNoteService_GetProjectSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreenNoteServiceServer).GetProjectSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreenNoteService_GetProjectSpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreenNoteServiceServer).GetProjectSpend(ctx, req.(*GetProjectSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GreenNoteService_CountOrganizationGreenNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountOrganizationGreenNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreenNoteServiceServer).CountOrganizationGreenNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GreenNoteService_CountOrganizationGreenNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreenNoteServiceServer).CountOrganizationGreenNotes(ctx, req.(*CountOrganizationGreenNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GreenNoteService_UploadGreenNoteDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadGreenNoteDocumentsRequest)
 	if err := dec(in); err != nil {
@@ -441,6 +551,18 @@ var GreenNoteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrganizationDepartments",
 			Handler:    _GreenNoteService_GetOrganizationDepartments_Handler,
 		},
+		{
+			MethodName: "ExportGSTR2BData",
+			Handler:    _GreenNoteService_ExportGSTR2BData_Handler,
+		},
+		{
+			MethodName: "GetProjectSpend",
+			Handler:    _GreenNoteService_GetProjectSpend_Handler,
+		},
+		{
+			MethodName: "CountOrganizationGreenNotes",
+			Handler:    _GreenNoteService_CountOrganizationGreenNotes_Handler,
+		},
 		{
 			MethodName: "UploadGreenNoteDocuments",
 			Handler:    _GreenNoteService_UploadGreenNoteDocuments_Handler,
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	common "nhit-note/api/pb/common"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -856,35 +857,198 @@ func (x *CreatePaymentNoteForSuperAdminRequest) GetActorId() int64 {
 	return 0
 }
 
+type CountOrganizationPaymentNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountOrganizationPaymentNotesRequest) Reset() {
+	*x = CountOrganizationPaymentNotesRequest{}
+	mi := &file_paymentnote_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountOrganizationPaymentNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOrganizationPaymentNotesRequest) ProtoMessage() {}
+
+func (x *CountOrganizationPaymentNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentnote_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOrganizationPaymentNotesRequest.ProtoReflect.Descriptor instead.
+func (*CountOrganizationPaymentNotesRequest) Descriptor() ([]byte, []int) {
+	return file_paymentnote_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CountOrganizationPaymentNotesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// ---------- MONEY ----------
+// Money is an exact rupee amount with two decimal places. value is the
+// decimal string ("1234.50"); units and nanos carry the same amount like
+// google.type.Money. On input value wins over units/nanos when both are set.
+//
+// Amount doubles have a Money twin named <field>_exact. Requests may set
+// either; when the twin is set the double is ignored. Responses always set
+// both, so clients reading doubles keep working.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_paymentnote_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentnote_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_paymentnote_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Money) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
 // ---------- SHARED INPUT STRUCTURES ----------
 type PaymentNotePayload struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	UserId                  int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GreenNoteId             int64                  `protobuf:"varint,2,opt,name=green_note_id,json=greenNoteId,proto3" json:"green_note_id,omitempty"`
-	ReimbursementNoteId     int64                  `protobuf:"varint,3,opt,name=reimbursement_note_id,json=reimbursementNoteId,proto3" json:"reimbursement_note_id,omitempty"`
-	NetPayableRoundOff      float64                `protobuf:"fixed64,4,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
-	Subject                 string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
-	NoteNo                  string                 `protobuf:"bytes,6,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
-	RecommendationOfPayment string                 `protobuf:"bytes,7,opt,name=recommendation_of_payment,json=recommendationOfPayment,proto3" json:"recommendation_of_payment,omitempty"`
-	AddParticulars          []*PaymentParticular   `protobuf:"bytes,8,rep,name=add_particulars,json=addParticulars,proto3" json:"add_particulars,omitempty"`
-	LessParticulars         []*PaymentParticular   `protobuf:"bytes,9,rep,name=less_particulars,json=lessParticulars,proto3" json:"less_particulars,omitempty"`
-	Status                  string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // D, P, A, R, S, PD, etc.
-	IsDraft                 bool                   `protobuf:"varint,11,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
-	AutoCreated             bool                   `protobuf:"varint,12,opt,name=auto_created,json=autoCreated,proto3" json:"auto_created,omitempty"`
-	CreatedBy               int64                  `protobuf:"varint,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	HoldReason              string                 `protobuf:"bytes,14,opt,name=hold_reason,json=holdReason,proto3" json:"hold_reason,omitempty"`
-	HoldDate                string                 `protobuf:"bytes,15,opt,name=hold_date,json=holdDate,proto3" json:"hold_date,omitempty"`
-	HoldBy                  int64                  `protobuf:"varint,16,opt,name=hold_by,json=holdBy,proto3" json:"hold_by,omitempty"`
-	UtrNo                   string                 `protobuf:"bytes,17,opt,name=utr_no,json=utrNo,proto3" json:"utr_no,omitempty"`
-	UtrDate                 string                 `protobuf:"bytes,18,opt,name=utr_date,json=utrDate,proto3" json:"utr_date,omitempty"`
-	SendNotifications       bool                   `protobuf:"varint,19,opt,name=send_notifications,json=sendNotifications,proto3" json:"send_notifications,omitempty"`
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GreenNoteId         int64                  `protobuf:"varint,2,opt,name=green_note_id,json=greenNoteId,proto3" json:"green_note_id,omitempty"`
+	ReimbursementNoteId int64                  `protobuf:"varint,3,opt,name=reimbursement_note_id,json=reimbursementNoteId,proto3" json:"reimbursement_note_id,omitempty"`
+	// Payment Note Details
+	NoteNo     string `protobuf:"bytes,4,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
+	Subject    string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Date       string `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"` // ISO-8601 date
+	Department string `protobuf:"bytes,7,opt,name=department,proto3" json:"department,omitempty"`
+	// Green Note Reference
+	GreenNoteNo       string `protobuf:"bytes,8,opt,name=green_note_no,json=greenNoteNo,proto3" json:"green_note_no,omitempty"`
+	GreenNoteApprover string `protobuf:"bytes,9,opt,name=green_note_approver,json=greenNoteApprover,proto3" json:"green_note_approver,omitempty"`
+	GreenNoteAppDate  string `protobuf:"bytes,10,opt,name=green_note_app_date,json=greenNoteAppDate,proto3" json:"green_note_app_date,omitempty"`
+	// Vendor Details
+	VendorCode string `protobuf:"bytes,11,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	VendorName string `protobuf:"bytes,12,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	// Project Details
+	ProjectName string `protobuf:"bytes,13,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	// Invoice Details
+	InvoiceNo         string  `protobuf:"bytes,14,opt,name=invoice_no,json=invoiceNo,proto3" json:"invoice_no,omitempty"`
+	InvoiceDate       string  `protobuf:"bytes,15,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
+	InvoiceAmount     float64 `protobuf:"fixed64,16,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
+	InvoiceApprovedBy string  `protobuf:"bytes,17,opt,name=invoice_approved_by,json=invoiceApprovedBy,proto3" json:"invoice_approved_by,omitempty"`
+	// LOA/PO Details
+	LoaPoNo     string  `protobuf:"bytes,18,opt,name=loa_po_no,json=loaPoNo,proto3" json:"loa_po_no,omitempty"`
+	LoaPoAmount float64 `protobuf:"fixed64,19,opt,name=loa_po_amount,json=loaPoAmount,proto3" json:"loa_po_amount,omitempty"`
+	LoaPoDate   string  `protobuf:"bytes,20,opt,name=loa_po_date,json=loaPoDate,proto3" json:"loa_po_date,omitempty"`
+	// Financial Calculations
+	GrossAmount        float64 `protobuf:"fixed64,21,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	TotalAdditions     float64 `protobuf:"fixed64,22,opt,name=total_additions,json=totalAdditions,proto3" json:"total_additions,omitempty"`
+	TotalDeductions    float64 `protobuf:"fixed64,23,opt,name=total_deductions,json=totalDeductions,proto3" json:"total_deductions,omitempty"`
+	NetPayableAmount   float64 `protobuf:"fixed64,24,opt,name=net_payable_amount,json=netPayableAmount,proto3" json:"net_payable_amount,omitempty"`
+	NetPayableRoundOff float64 `protobuf:"fixed64,25,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
+	NetPayableWords    string  `protobuf:"bytes,26,opt,name=net_payable_words,json=netPayableWords,proto3" json:"net_payable_words,omitempty"`
+	// TDS Details
+	TdsPercentage float64 `protobuf:"fixed64,27,opt,name=tds_percentage,json=tdsPercentage,proto3" json:"tds_percentage,omitempty"` // e.g., 2.00 for 2%
+	TdsSection    string  `protobuf:"bytes,28,opt,name=tds_section,json=tdsSection,proto3" json:"tds_section,omitempty"`            // e.g., "194C"
+	TdsAmount     float64 `protobuf:"fixed64,29,opt,name=tds_amount,json=tdsAmount,proto3" json:"tds_amount,omitempty"`
+	// Bank Details
+	AccountHolderName string `protobuf:"bytes,30,opt,name=account_holder_name,json=accountHolderName,proto3" json:"account_holder_name,omitempty"`
+	BankName          string `protobuf:"bytes,31,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	AccountNumber     string `protobuf:"bytes,32,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	IfscCode          string `protobuf:"bytes,33,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
+	// Particulars and Recommendation
+	AddParticulars          []*PaymentParticular `protobuf:"bytes,34,rep,name=add_particulars,json=addParticulars,proto3" json:"add_particulars,omitempty"`
+	LessParticulars         []*PaymentParticular `protobuf:"bytes,35,rep,name=less_particulars,json=lessParticulars,proto3" json:"less_particulars,omitempty"`
+	RecommendationOfPayment string               `protobuf:"bytes,36,opt,name=recommendation_of_payment,json=recommendationOfPayment,proto3" json:"recommendation_of_payment,omitempty"`
+	// Status and Flags
+	Status      string `protobuf:"bytes,37,opt,name=status,proto3" json:"status,omitempty"` // D, P, A, R, S, PD, etc.
+	IsDraft     bool   `protobuf:"varint,38,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
+	AutoCreated bool   `protobuf:"varint,39,opt,name=auto_created,json=autoCreated,proto3" json:"auto_created,omitempty"`
+	CreatedBy   int64  `protobuf:"varint,40,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Hold Information
+	HoldReason string `protobuf:"bytes,41,opt,name=hold_reason,json=holdReason,proto3" json:"hold_reason,omitempty"`
+	HoldDate   string `protobuf:"bytes,42,opt,name=hold_date,json=holdDate,proto3" json:"hold_date,omitempty"`
+	HoldBy     int64  `protobuf:"varint,43,opt,name=hold_by,json=holdBy,proto3" json:"hold_by,omitempty"`
+	// UTR Information
+	UtrNo             string `protobuf:"bytes,44,opt,name=utr_no,json=utrNo,proto3" json:"utr_no,omitempty"`
+	UtrDate           string `protobuf:"bytes,45,opt,name=utr_date,json=utrDate,proto3" json:"utr_date,omitempty"`
+	SendNotifications bool   `protobuf:"varint,46,opt,name=send_notifications,json=sendNotifications,proto3" json:"send_notifications,omitempty"`
+	// Supporting Documents
+	NewDocuments []*PaymentNoteDocumentUpload `protobuf:"bytes,47,rep,name=new_documents,json=newDocuments,proto3" json:"new_documents,omitempty"`
+	// Exact twins of the amount doubles above
+	InvoiceAmountExact      *Money `protobuf:"bytes,48,opt,name=invoice_amount_exact,json=invoiceAmountExact,proto3" json:"invoice_amount_exact,omitempty"`
+	LoaPoAmountExact        *Money `protobuf:"bytes,49,opt,name=loa_po_amount_exact,json=loaPoAmountExact,proto3" json:"loa_po_amount_exact,omitempty"`
+	GrossAmountExact        *Money `protobuf:"bytes,50,opt,name=gross_amount_exact,json=grossAmountExact,proto3" json:"gross_amount_exact,omitempty"`
+	TotalAdditionsExact     *Money `protobuf:"bytes,51,opt,name=total_additions_exact,json=totalAdditionsExact,proto3" json:"total_additions_exact,omitempty"`
+	TotalDeductionsExact    *Money `protobuf:"bytes,52,opt,name=total_deductions_exact,json=totalDeductionsExact,proto3" json:"total_deductions_exact,omitempty"`
+	NetPayableAmountExact   *Money `protobuf:"bytes,53,opt,name=net_payable_amount_exact,json=netPayableAmountExact,proto3" json:"net_payable_amount_exact,omitempty"`
+	NetPayableRoundOffExact *Money `protobuf:"bytes,54,opt,name=net_payable_round_off_exact,json=netPayableRoundOffExact,proto3" json:"net_payable_round_off_exact,omitempty"`
+	TdsAmountExact          *Money `protobuf:"bytes,55,opt,name=tds_amount_exact,json=tdsAmountExact,proto3" json:"tds_amount_exact,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
 func (x *PaymentNotePayload) Reset() {
 	*x = PaymentNotePayload{}
-	mi := &file_paymentnote_proto_msgTypes[14]
+	mi := &file_paymentnote_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -896,7 +1060,7 @@ func (x *PaymentNotePayload) String() string {
 func (*PaymentNotePayload) ProtoMessage() {}
 
 func (x *PaymentNotePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[14]
+	mi := &file_paymentnote_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1073,7 @@ func (x *PaymentNotePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentNotePayload.ProtoReflect.Descriptor instead.
 func (*PaymentNotePayload) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{14}
+	return file_paymentnote_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PaymentNotePayload) GetUserId() int64 {
@@ -933,6 +1097,153 @@ func (x *PaymentNotePayload) GetReimbursementNoteId() int64 {
 	return 0
 }
 
+func (x *PaymentNotePayload) GetNoteNo() string {
+	if x != nil {
+		return x.NoteNo
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetGreenNoteNo() string {
+	if x != nil {
+		return x.GreenNoteNo
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetGreenNoteApprover() string {
+	if x != nil {
+		return x.GreenNoteApprover
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetGreenNoteAppDate() string {
+	if x != nil {
+		return x.GreenNoteAppDate
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetInvoiceNo() string {
+	if x != nil {
+		return x.InvoiceNo
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetInvoiceDate() string {
+	if x != nil {
+		return x.InvoiceDate
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetInvoiceAmount() float64 {
+	if x != nil {
+		return x.InvoiceAmount
+	}
+	return 0
+}
+
+func (x *PaymentNotePayload) GetInvoiceApprovedBy() string {
+	if x != nil {
+		return x.InvoiceApprovedBy
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetLoaPoNo() string {
+	if x != nil {
+		return x.LoaPoNo
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetLoaPoAmount() float64 {
+	if x != nil {
+		return x.LoaPoAmount
+	}
+	return 0
+}
+
+func (x *PaymentNotePayload) GetLoaPoDate() string {
+	if x != nil {
+		return x.LoaPoDate
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetGrossAmount() float64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *PaymentNotePayload) GetTotalAdditions() float64 {
+	if x != nil {
+		return x.TotalAdditions
+	}
+	return 0
+}
+
+func (x *PaymentNotePayload) GetTotalDeductions() float64 {
+	if x != nil {
+		return x.TotalDeductions
+	}
+	return 0
+}
+
+func (x *PaymentNotePayload) GetNetPayableAmount() float64 {
+	if x != nil {
+		return x.NetPayableAmount
+	}
+	return 0
+}
+
 func (x *PaymentNotePayload) GetNetPayableRoundOff() float64 {
 	if x != nil {
 		return x.NetPayableRoundOff
@@ -940,23 +1251,58 @@ func (x *PaymentNotePayload) GetNetPayableRoundOff() float64 {
 	return 0
 }
 
-func (x *PaymentNotePayload) GetSubject() string {
+func (x *PaymentNotePayload) GetNetPayableWords() string {
 	if x != nil {
-		return x.Subject
+		return x.NetPayableWords
 	}
 	return ""
 }
 
-func (x *PaymentNotePayload) GetNoteNo() string {
+func (x *PaymentNotePayload) GetTdsPercentage() float64 {
 	if x != nil {
-		return x.NoteNo
+		return x.TdsPercentage
+	}
+	return 0
+}
+
+func (x *PaymentNotePayload) GetTdsSection() string {
+	if x != nil {
+		return x.TdsSection
 	}
 	return ""
 }
 
-func (x *PaymentNotePayload) GetRecommendationOfPayment() string {
+func (x *PaymentNotePayload) GetTdsAmount() float64 {
 	if x != nil {
-		return x.RecommendationOfPayment
+		return x.TdsAmount
+	}
+	return 0
+}
+
+func (x *PaymentNotePayload) GetAccountHolderName() string {
+	if x != nil {
+		return x.AccountHolderName
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *PaymentNotePayload) GetIfscCode() string {
+	if x != nil {
+		return x.IfscCode
 	}
 	return ""
 }
@@ -975,6 +1321,13 @@ func (x *PaymentNotePayload) GetLessParticulars() []*PaymentParticular {
 	return nil
 }
 
+func (x *PaymentNotePayload) GetRecommendationOfPayment() string {
+	if x != nil {
+		return x.RecommendationOfPayment
+	}
+	return ""
+}
+
 func (x *PaymentNotePayload) GetStatus() string {
 	if x != nil {
 		return x.Status
@@ -1045,17 +1398,81 @@ func (x *PaymentNotePayload) GetSendNotifications() bool {
 	return false
 }
 
+func (x *PaymentNotePayload) GetNewDocuments() []*PaymentNoteDocumentUpload {
+	if x != nil {
+		return x.NewDocuments
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetInvoiceAmountExact() *Money {
+	if x != nil {
+		return x.InvoiceAmountExact
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetLoaPoAmountExact() *Money {
+	if x != nil {
+		return x.LoaPoAmountExact
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetGrossAmountExact() *Money {
+	if x != nil {
+		return x.GrossAmountExact
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetTotalAdditionsExact() *Money {
+	if x != nil {
+		return x.TotalAdditionsExact
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetTotalDeductionsExact() *Money {
+	if x != nil {
+		return x.TotalDeductionsExact
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetNetPayableAmountExact() *Money {
+	if x != nil {
+		return x.NetPayableAmountExact
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetNetPayableRoundOffExact() *Money {
+	if x != nil {
+		return x.NetPayableRoundOffExact
+	}
+	return nil
+}
+
+func (x *PaymentNotePayload) GetTdsAmountExact() *Money {
+	if x != nil {
+		return x.TdsAmountExact
+	}
+	return nil
+}
+
 type PaymentParticular struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Particular    string                 `protobuf:"bytes,1,opt,name=particular,proto3" json:"particular,omitempty"`
 	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountExact   *Money                 `protobuf:"bytes,3,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaymentParticular) Reset() {
 	*x = PaymentParticular{}
-	mi := &file_paymentnote_proto_msgTypes[15]
+	mi := &file_paymentnote_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1067,7 +1484,7 @@ func (x *PaymentParticular) String() string {
 func (*PaymentParticular) ProtoMessage() {}
 
 func (x *PaymentParticular) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[15]
+	mi := &file_paymentnote_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,7 +1497,7 @@ func (x *PaymentParticular) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentParticular.ProtoReflect.Descriptor instead.
 func (*PaymentParticular) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{15}
+	return file_paymentnote_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PaymentParticular) GetParticular() string {
@@ -1097,18 +1514,25 @@ func (x *PaymentParticular) GetAmount() float64 {
 	return 0
 }
 
+func (x *PaymentParticular) GetAmountExact() *Money {
+	if x != nil {
+		return x.AmountExact
+	}
+	return nil
+}
+
 // ---------- RESPONSE MESSAGES ----------
 type ListPaymentNotesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Notes         []*PaymentNoteSummary  `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination    *common.Pagination     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPaymentNotesResponse) Reset() {
 	*x = ListPaymentNotesResponse{}
-	mi := &file_paymentnote_proto_msgTypes[16]
+	mi := &file_paymentnote_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1120,7 +1544,7 @@ func (x *ListPaymentNotesResponse) String() string {
 func (*ListPaymentNotesResponse) ProtoMessage() {}
 
 func (x *ListPaymentNotesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[16]
+	mi := &file_paymentnote_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,7 +1557,7 @@ func (x *ListPaymentNotesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentNotesResponse.ProtoReflect.Descriptor instead.
 func (*ListPaymentNotesResponse) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{16}
+	return file_paymentnote_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListPaymentNotesResponse) GetNotes() []*PaymentNoteSummary {
@@ -1143,7 +1567,7 @@ func (x *ListPaymentNotesResponse) GetNotes() []*PaymentNoteSummary {
 	return nil
 }
 
-func (x *ListPaymentNotesResponse) GetPagination() *Pagination {
+func (x *ListPaymentNotesResponse) GetPagination() *common.Pagination {
 	if x != nil {
 		return x.Pagination
 	}
@@ -1159,7 +1583,7 @@ type PaymentNoteResponse struct {
 
 func (x *PaymentNoteResponse) Reset() {
 	*x = PaymentNoteResponse{}
-	mi := &file_paymentnote_proto_msgTypes[17]
+	mi := &file_paymentnote_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1171,7 +1595,7 @@ func (x *PaymentNoteResponse) String() string {
 func (*PaymentNoteResponse) ProtoMessage() {}
 
 func (x *PaymentNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[17]
+	mi := &file_paymentnote_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,7 +1608,7 @@ func (x *PaymentNoteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentNoteResponse.ProtoReflect.Descriptor instead.
 func (*PaymentNoteResponse) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{17}
+	return file_paymentnote_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PaymentNoteResponse) GetNote() *PaymentNote {
@@ -1205,7 +1629,7 @@ type GeneratePaymentNoteOrderNumberResponse struct {
 
 func (x *GeneratePaymentNoteOrderNumberResponse) Reset() {
 	*x = GeneratePaymentNoteOrderNumberResponse{}
-	mi := &file_paymentnote_proto_msgTypes[18]
+	mi := &file_paymentnote_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1217,7 +1641,7 @@ func (x *GeneratePaymentNoteOrderNumberResponse) String() string {
 func (*GeneratePaymentNoteOrderNumberResponse) ProtoMessage() {}
 
 func (x *GeneratePaymentNoteOrderNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[18]
+	mi := &file_paymentnote_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +1654,7 @@ func (x *GeneratePaymentNoteOrderNumberResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GeneratePaymentNoteOrderNumberResponse.ProtoReflect.Descriptor instead.
 func (*GeneratePaymentNoteOrderNumberResponse) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{18}
+	return file_paymentnote_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GeneratePaymentNoteOrderNumberResponse) GetOrderNumber() string {
@@ -1265,7 +1689,7 @@ type DownloadPaymentNotePdfResponse struct {
 
 func (x *DownloadPaymentNotePdfResponse) Reset() {
 	*x = DownloadPaymentNotePdfResponse{}
-	mi := &file_paymentnote_proto_msgTypes[19]
+	mi := &file_paymentnote_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1277,7 +1701,7 @@ func (x *DownloadPaymentNotePdfResponse) String() string {
 func (*DownloadPaymentNotePdfResponse) ProtoMessage() {}
 
 func (x *DownloadPaymentNotePdfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[19]
+	mi := &file_paymentnote_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +1714,7 @@ func (x *DownloadPaymentNotePdfResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadPaymentNotePdfResponse.ProtoReflect.Descriptor instead.
 func (*DownloadPaymentNotePdfResponse) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{19}
+	return file_paymentnote_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DownloadPaymentNotePdfResponse) GetFileContent() []byte {
@@ -1314,6 +1738,50 @@ func (x *DownloadPaymentNotePdfResponse) GetContentType() string {
 	return ""
 }
 
+type CountOrganizationPaymentNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountOrganizationPaymentNotesResponse) Reset() {
+	*x = CountOrganizationPaymentNotesResponse{}
+	mi := &file_paymentnote_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountOrganizationPaymentNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOrganizationPaymentNotesResponse) ProtoMessage() {}
+
+func (x *CountOrganizationPaymentNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentnote_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOrganizationPaymentNotesResponse.ProtoReflect.Descriptor instead.
+func (*CountOrganizationPaymentNotesResponse) Descriptor() ([]byte, []int) {
+	return file_paymentnote_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CountOrganizationPaymentNotesResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type TestPaymentNoteAPIResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`        // e.g. "ok"
@@ -1325,7 +1793,7 @@ type TestPaymentNoteAPIResponse struct {
 
 func (x *TestPaymentNoteAPIResponse) Reset() {
 	*x = TestPaymentNoteAPIResponse{}
-	mi := &file_paymentnote_proto_msgTypes[20]
+	mi := &file_paymentnote_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1337,7 +1805,7 @@ func (x *TestPaymentNoteAPIResponse) String() string {
 func (*TestPaymentNoteAPIResponse) ProtoMessage() {}
 
 func (x *TestPaymentNoteAPIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[20]
+	mi := &file_paymentnote_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,7 +1818,7 @@ func (x *TestPaymentNoteAPIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestPaymentNoteAPIResponse.ProtoReflect.Descriptor instead.
 func (*TestPaymentNoteAPIResponse) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{20}
+	return file_paymentnote_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TestPaymentNoteAPIResponse) GetStatus() string {
@@ -1376,24 +1844,25 @@ func (x *TestPaymentNoteAPIResponse) GetTimestamp() int64 {
 
 // ---------- DATA STRUCTURES ----------
 type PaymentNoteSummary struct {
-	state              protoimpl.MessageState      `protogen:"open.v1"`
-	Id                 int64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NoteNo             string                      `protobuf:"bytes,2,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
-	Status             string                      `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	NetPayableRoundOff float64                     `protobuf:"fixed64,4,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
-	IsDraft            bool                        `protobuf:"varint,5,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
-	CreatedAt          string                      `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          string                      `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	GreenNote          *GreenNoteReference         `protobuf:"bytes,8,opt,name=green_note,json=greenNote,proto3" json:"green_note,omitempty"`
-	ReimbursementNote  *ReimbursementNoteReference `protobuf:"bytes,9,opt,name=reimbursement_note,json=reimbursementNote,proto3" json:"reimbursement_note,omitempty"`
-	Owner              *RelatedUser                `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                   protoimpl.MessageState                    `protogen:"open.v1"`
+	Id                      int64                                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NoteNo                  string                                    `protobuf:"bytes,2,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
+	Status                  string                                    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	NetPayableRoundOff      float64                                   `protobuf:"fixed64,4,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
+	IsDraft                 bool                                      `protobuf:"varint,5,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
+	CreatedAt               string                                    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               string                                    `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	GreenNote               *common.PaymentGreenNoteReference         `protobuf:"bytes,8,opt,name=green_note,json=greenNote,proto3" json:"green_note,omitempty"`
+	ReimbursementNote       *common.PaymentReimbursementNoteReference `protobuf:"bytes,9,opt,name=reimbursement_note,json=reimbursementNote,proto3" json:"reimbursement_note,omitempty"`
+	Owner                   *common.RelatedUser                       `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`
+	NetPayableRoundOffExact *Money                                    `protobuf:"bytes,11,opt,name=net_payable_round_off_exact,json=netPayableRoundOffExact,proto3" json:"net_payable_round_off_exact,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *PaymentNoteSummary) Reset() {
 	*x = PaymentNoteSummary{}
-	mi := &file_paymentnote_proto_msgTypes[21]
+	mi := &file_paymentnote_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1405,7 +1874,7 @@ func (x *PaymentNoteSummary) String() string {
 func (*PaymentNoteSummary) ProtoMessage() {}
 
 func (x *PaymentNoteSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[21]
+	mi := &file_paymentnote_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1887,7 @@ func (x *PaymentNoteSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentNoteSummary.ProtoReflect.Descriptor instead.
 func (*PaymentNoteSummary) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{21}
+	return file_paymentnote_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PaymentNoteSummary) GetId() int64 {
@@ -1470,61 +1939,119 @@ func (x *PaymentNoteSummary) GetUpdatedAt() string {
 	return ""
 }
 
-func (x *PaymentNoteSummary) GetGreenNote() *GreenNoteReference {
+func (x *PaymentNoteSummary) GetGreenNote() *common.PaymentGreenNoteReference {
 	if x != nil {
 		return x.GreenNote
 	}
 	return nil
 }
 
-func (x *PaymentNoteSummary) GetReimbursementNote() *ReimbursementNoteReference {
+func (x *PaymentNoteSummary) GetReimbursementNote() *common.PaymentReimbursementNoteReference {
 	if x != nil {
 		return x.ReimbursementNote
 	}
 	return nil
 }
 
-func (x *PaymentNoteSummary) GetOwner() *RelatedUser {
+func (x *PaymentNoteSummary) GetOwner() *common.RelatedUser {
 	if x != nil {
 		return x.Owner
 	}
 	return nil
 }
 
+func (x *PaymentNoteSummary) GetNetPayableRoundOffExact() *Money {
+	if x != nil {
+		return x.NetPayableRoundOffExact
+	}
+	return nil
+}
+
 type PaymentNote struct {
-	state                   protoimpl.MessageState      `protogen:"open.v1"`
-	Id                      int64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId                  int64                       `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GreenNoteId             int64                       `protobuf:"varint,3,opt,name=green_note_id,json=greenNoteId,proto3" json:"green_note_id,omitempty"`
-	ReimbursementNoteId     int64                       `protobuf:"varint,4,opt,name=reimbursement_note_id,json=reimbursementNoteId,proto3" json:"reimbursement_note_id,omitempty"`
-	NoteNo                  string                      `protobuf:"bytes,5,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
-	Subject                 string                      `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
-	RecommendationOfPayment string                      `protobuf:"bytes,7,opt,name=recommendation_of_payment,json=recommendationOfPayment,proto3" json:"recommendation_of_payment,omitempty"`
-	NetPayableRoundOff      float64                     `protobuf:"fixed64,8,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
-	AddParticulars          []*PaymentParticular        `protobuf:"bytes,9,rep,name=add_particulars,json=addParticulars,proto3" json:"add_particulars,omitempty"`
-	LessParticulars         []*PaymentParticular        `protobuf:"bytes,10,rep,name=less_particulars,json=lessParticulars,proto3" json:"less_particulars,omitempty"`
-	Status                  string                      `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	IsDraft                 bool                        `protobuf:"varint,12,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
-	AutoCreated             bool                        `protobuf:"varint,13,opt,name=auto_created,json=autoCreated,proto3" json:"auto_created,omitempty"`
-	CreatedBy               int64                       `protobuf:"varint,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Hold                    *HoldDetails                `protobuf:"bytes,15,opt,name=hold,proto3" json:"hold,omitempty"`
-	UtrNo                   string                      `protobuf:"bytes,16,opt,name=utr_no,json=utrNo,proto3" json:"utr_no,omitempty"`
-	UtrDate                 string                      `protobuf:"bytes,17,opt,name=utr_date,json=utrDate,proto3" json:"utr_date,omitempty"`
-	ApprovalLogs            []*PaymentApprovalLog       `protobuf:"bytes,18,rep,name=approval_logs,json=approvalLogs,proto3" json:"approval_logs,omitempty"`
-	Comments                []*PaymentNoteComment       `protobuf:"bytes,19,rep,name=comments,proto3" json:"comments,omitempty"`
-	GreenNote               *GreenNoteReference         `protobuf:"bytes,20,opt,name=green_note,json=greenNote,proto3" json:"green_note,omitempty"`
-	ReimbursementNote       *ReimbursementNoteReference `protobuf:"bytes,21,opt,name=reimbursement_note,json=reimbursementNote,proto3" json:"reimbursement_note,omitempty"`
-	Owner                   *RelatedUser                `protobuf:"bytes,22,opt,name=owner,proto3" json:"owner,omitempty"`
-	CreatedByUser           *RelatedUser                `protobuf:"bytes,23,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
-	CreatedAt               string                      `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt               string                      `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId              int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GreenNoteId         int64                  `protobuf:"varint,3,opt,name=green_note_id,json=greenNoteId,proto3" json:"green_note_id,omitempty"`
+	ReimbursementNoteId int64                  `protobuf:"varint,4,opt,name=reimbursement_note_id,json=reimbursementNoteId,proto3" json:"reimbursement_note_id,omitempty"`
+	NoteNo              string                 `protobuf:"bytes,5,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
+	Subject             string                 `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	Date                string                 `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
+	Department          string                 `protobuf:"bytes,8,opt,name=department,proto3" json:"department,omitempty"`
+	// Green Note Reference
+	GreenNoteNo       string `protobuf:"bytes,9,opt,name=green_note_no,json=greenNoteNo,proto3" json:"green_note_no,omitempty"`
+	GreenNoteApprover string `protobuf:"bytes,10,opt,name=green_note_approver,json=greenNoteApprover,proto3" json:"green_note_approver,omitempty"`
+	GreenNoteAppDate  string `protobuf:"bytes,11,opt,name=green_note_app_date,json=greenNoteAppDate,proto3" json:"green_note_app_date,omitempty"`
+	// Vendor Details
+	VendorCode string `protobuf:"bytes,12,opt,name=vendor_code,json=vendorCode,proto3" json:"vendor_code,omitempty"`
+	VendorName string `protobuf:"bytes,13,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	// Project Details
+	ProjectName string `protobuf:"bytes,14,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	// Invoice Details
+	InvoiceNo         string  `protobuf:"bytes,15,opt,name=invoice_no,json=invoiceNo,proto3" json:"invoice_no,omitempty"`
+	InvoiceDate       string  `protobuf:"bytes,16,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
+	InvoiceAmount     float64 `protobuf:"fixed64,17,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
+	InvoiceApprovedBy string  `protobuf:"bytes,18,opt,name=invoice_approved_by,json=invoiceApprovedBy,proto3" json:"invoice_approved_by,omitempty"`
+	// LOA/PO Details
+	LoaPoNo     string  `protobuf:"bytes,19,opt,name=loa_po_no,json=loaPoNo,proto3" json:"loa_po_no,omitempty"`
+	LoaPoAmount float64 `protobuf:"fixed64,20,opt,name=loa_po_amount,json=loaPoAmount,proto3" json:"loa_po_amount,omitempty"`
+	LoaPoDate   string  `protobuf:"bytes,21,opt,name=loa_po_date,json=loaPoDate,proto3" json:"loa_po_date,omitempty"`
+	// Financial Calculations
+	GrossAmount        float64 `protobuf:"fixed64,22,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	TotalAdditions     float64 `protobuf:"fixed64,23,opt,name=total_additions,json=totalAdditions,proto3" json:"total_additions,omitempty"`
+	TotalDeductions    float64 `protobuf:"fixed64,24,opt,name=total_deductions,json=totalDeductions,proto3" json:"total_deductions,omitempty"`
+	NetPayableAmount   float64 `protobuf:"fixed64,25,opt,name=net_payable_amount,json=netPayableAmount,proto3" json:"net_payable_amount,omitempty"`
+	NetPayableRoundOff float64 `protobuf:"fixed64,26,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
+	NetPayableWords    string  `protobuf:"bytes,27,opt,name=net_payable_words,json=netPayableWords,proto3" json:"net_payable_words,omitempty"`
+	// TDS Details
+	TdsPercentage float64 `protobuf:"fixed64,28,opt,name=tds_percentage,json=tdsPercentage,proto3" json:"tds_percentage,omitempty"`
+	TdsSection    string  `protobuf:"bytes,29,opt,name=tds_section,json=tdsSection,proto3" json:"tds_section,omitempty"`
+	TdsAmount     float64 `protobuf:"fixed64,30,opt,name=tds_amount,json=tdsAmount,proto3" json:"tds_amount,omitempty"`
+	// Bank Details
+	AccountHolderName string `protobuf:"bytes,31,opt,name=account_holder_name,json=accountHolderName,proto3" json:"account_holder_name,omitempty"`
+	BankName          string `protobuf:"bytes,32,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	AccountNumber     string `protobuf:"bytes,33,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	IfscCode          string `protobuf:"bytes,34,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
+	// Particulars and Recommendation
+	AddParticulars          []*PaymentParticular `protobuf:"bytes,35,rep,name=add_particulars,json=addParticulars,proto3" json:"add_particulars,omitempty"`
+	LessParticulars         []*PaymentParticular `protobuf:"bytes,36,rep,name=less_particulars,json=lessParticulars,proto3" json:"less_particulars,omitempty"`
+	RecommendationOfPayment string               `protobuf:"bytes,37,opt,name=recommendation_of_payment,json=recommendationOfPayment,proto3" json:"recommendation_of_payment,omitempty"`
+	// Status and Flags
+	Status      string `protobuf:"bytes,38,opt,name=status,proto3" json:"status,omitempty"`
+	IsDraft     bool   `protobuf:"varint,39,opt,name=is_draft,json=isDraft,proto3" json:"is_draft,omitempty"`
+	AutoCreated bool   `protobuf:"varint,40,opt,name=auto_created,json=autoCreated,proto3" json:"auto_created,omitempty"`
+	CreatedBy   int64  `protobuf:"varint,41,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Hold Details
+	Hold *common.HoldDetails `protobuf:"bytes,42,opt,name=hold,proto3" json:"hold,omitempty"`
+	// UTR Information
+	UtrNo   string `protobuf:"bytes,43,opt,name=utr_no,json=utrNo,proto3" json:"utr_no,omitempty"`
+	UtrDate string `protobuf:"bytes,44,opt,name=utr_date,json=utrDate,proto3" json:"utr_date,omitempty"`
+	// Related Entities
+	ApprovalLogs      []*PaymentApprovalLog                     `protobuf:"bytes,45,rep,name=approval_logs,json=approvalLogs,proto3" json:"approval_logs,omitempty"`
+	Comments          []*PaymentNoteComment                     `protobuf:"bytes,46,rep,name=comments,proto3" json:"comments,omitempty"`
+	Documents         []*PaymentNoteDocument                    `protobuf:"bytes,47,rep,name=documents,proto3" json:"documents,omitempty"`
+	GreenNote         *common.PaymentGreenNoteReference         `protobuf:"bytes,48,opt,name=green_note,json=greenNote,proto3" json:"green_note,omitempty"`
+	ReimbursementNote *common.PaymentReimbursementNoteReference `protobuf:"bytes,49,opt,name=reimbursement_note,json=reimbursementNote,proto3" json:"reimbursement_note,omitempty"`
+	Owner             *common.RelatedUser                       `protobuf:"bytes,50,opt,name=owner,proto3" json:"owner,omitempty"`
+	CreatedByUser     *common.RelatedUser                       `protobuf:"bytes,51,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
+	// Timestamps
+	CreatedAt string `protobuf:"bytes,52,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,53,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Exact twins of the amount doubles above
+	InvoiceAmountExact      *Money `protobuf:"bytes,54,opt,name=invoice_amount_exact,json=invoiceAmountExact,proto3" json:"invoice_amount_exact,omitempty"`
+	LoaPoAmountExact        *Money `protobuf:"bytes,55,opt,name=loa_po_amount_exact,json=loaPoAmountExact,proto3" json:"loa_po_amount_exact,omitempty"`
+	GrossAmountExact        *Money `protobuf:"bytes,56,opt,name=gross_amount_exact,json=grossAmountExact,proto3" json:"gross_amount_exact,omitempty"`
+	TotalAdditionsExact     *Money `protobuf:"bytes,57,opt,name=total_additions_exact,json=totalAdditionsExact,proto3" json:"total_additions_exact,omitempty"`
+	TotalDeductionsExact    *Money `protobuf:"bytes,58,opt,name=total_deductions_exact,json=totalDeductionsExact,proto3" json:"total_deductions_exact,omitempty"`
+	NetPayableAmountExact   *Money `protobuf:"bytes,59,opt,name=net_payable_amount_exact,json=netPayableAmountExact,proto3" json:"net_payable_amount_exact,omitempty"`
+	NetPayableRoundOffExact *Money `protobuf:"bytes,60,opt,name=net_payable_round_off_exact,json=netPayableRoundOffExact,proto3" json:"net_payable_round_off_exact,omitempty"`
+	TdsAmountExact          *Money `protobuf:"bytes,61,opt,name=tds_amount_exact,json=tdsAmountExact,proto3" json:"tds_amount_exact,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
 func (x *PaymentNote) Reset() {
 	*x = PaymentNote{}
-	mi := &file_paymentnote_proto_msgTypes[22]
+	mi := &file_paymentnote_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1536,7 +2063,7 @@ func (x *PaymentNote) String() string {
 func (*PaymentNote) ProtoMessage() {}
 
 func (x *PaymentNote) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[22]
+	mi := &file_paymentnote_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +2076,7 @@ func (x *PaymentNote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentNote.ProtoReflect.Descriptor instead.
 func (*PaymentNote) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{22}
+	return file_paymentnote_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PaymentNote) GetId() int64 {
@@ -1594,539 +2121,422 @@ func (x *PaymentNote) GetSubject() string {
 	return ""
 }
 
-func (x *PaymentNote) GetRecommendationOfPayment() string {
+func (x *PaymentNote) GetDate() string {
 	if x != nil {
-		return x.RecommendationOfPayment
+		return x.Date
 	}
 	return ""
 }
 
-func (x *PaymentNote) GetNetPayableRoundOff() float64 {
+func (x *PaymentNote) GetDepartment() string {
 	if x != nil {
-		return x.NetPayableRoundOff
+		return x.Department
 	}
-	return 0
+	return ""
 }
 
-func (x *PaymentNote) GetAddParticulars() []*PaymentParticular {
+func (x *PaymentNote) GetGreenNoteNo() string {
 	if x != nil {
-		return x.AddParticulars
+		return x.GreenNoteNo
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentNote) GetLessParticulars() []*PaymentParticular {
+func (x *PaymentNote) GetGreenNoteApprover() string {
 	if x != nil {
-		return x.LessParticulars
+		return x.GreenNoteApprover
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentNote) GetStatus() string {
+func (x *PaymentNote) GetGreenNoteAppDate() string {
 	if x != nil {
-		return x.Status
+		return x.GreenNoteAppDate
 	}
 	return ""
 }
 
-func (x *PaymentNote) GetIsDraft() bool {
+func (x *PaymentNote) GetVendorCode() string {
 	if x != nil {
-		return x.IsDraft
+		return x.VendorCode
 	}
-	return false
+	return ""
 }
 
-func (x *PaymentNote) GetAutoCreated() bool {
+func (x *PaymentNote) GetVendorName() string {
 	if x != nil {
-		return x.AutoCreated
+		return x.VendorName
 	}
-	return false
+	return ""
 }
 
-func (x *PaymentNote) GetCreatedBy() int64 {
+func (x *PaymentNote) GetProjectName() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.ProjectName
 	}
-	return 0
+	return ""
 }
 
-func (x *PaymentNote) GetHold() *HoldDetails {
+func (x *PaymentNote) GetInvoiceNo() string {
 	if x != nil {
-		return x.Hold
+		return x.InvoiceNo
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentNote) GetUtrNo() string {
+func (x *PaymentNote) GetInvoiceDate() string {
 	if x != nil {
-		return x.UtrNo
+		return x.InvoiceDate
 	}
 	return ""
 }
 
-func (x *PaymentNote) GetUtrDate() string {
+func (x *PaymentNote) GetInvoiceAmount() float64 {
 	if x != nil {
-		return x.UtrDate
+		return x.InvoiceAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *PaymentNote) GetApprovalLogs() []*PaymentApprovalLog {
+func (x *PaymentNote) GetInvoiceApprovedBy() string {
 	if x != nil {
-		return x.ApprovalLogs
+		return x.InvoiceApprovedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentNote) GetComments() []*PaymentNoteComment {
+func (x *PaymentNote) GetLoaPoNo() string {
 	if x != nil {
-		return x.Comments
+		return x.LoaPoNo
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentNote) GetGreenNote() *GreenNoteReference {
+func (x *PaymentNote) GetLoaPoAmount() float64 {
 	if x != nil {
-		return x.GreenNote
+		return x.LoaPoAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *PaymentNote) GetReimbursementNote() *ReimbursementNoteReference {
+func (x *PaymentNote) GetLoaPoDate() string {
 	if x != nil {
-		return x.ReimbursementNote
+		return x.LoaPoDate
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentNote) GetOwner() *RelatedUser {
+func (x *PaymentNote) GetGrossAmount() float64 {
 	if x != nil {
-		return x.Owner
+		return x.GrossAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *PaymentNote) GetCreatedByUser() *RelatedUser {
+func (x *PaymentNote) GetTotalAdditions() float64 {
 	if x != nil {
-		return x.CreatedByUser
+		return x.TotalAdditions
 	}
-	return nil
+	return 0
 }
 
-func (x *PaymentNote) GetCreatedAt() string {
+func (x *PaymentNote) GetTotalDeductions() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TotalDeductions
 	}
-	return ""
+	return 0
 }
 
-func (x *PaymentNote) GetUpdatedAt() string {
+func (x *PaymentNote) GetNetPayableAmount() float64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NetPayableAmount
 	}
-	return ""
-}
-
-type HoldDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	AppliedBy     *RelatedUser           `protobuf:"bytes,3,opt,name=applied_by,json=appliedBy,proto3" json:"applied_by,omitempty"`
-	AppliedAt     string                 `protobuf:"bytes,4,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *HoldDetails) Reset() {
-	*x = HoldDetails{}
-	mi := &file_paymentnote_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *HoldDetails) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*HoldDetails) ProtoMessage() {}
-
-func (x *HoldDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[23]
+func (x *PaymentNote) GetNetPayableRoundOff() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NetPayableRoundOff
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use HoldDetails.ProtoReflect.Descriptor instead.
-func (*HoldDetails) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{23}
+func (x *PaymentNote) GetNetPayableWords() string {
+	if x != nil {
+		return x.NetPayableWords
+	}
+	return ""
 }
 
-func (x *HoldDetails) GetActive() bool {
+func (x *PaymentNote) GetTdsPercentage() float64 {
 	if x != nil {
-		return x.Active
+		return x.TdsPercentage
 	}
-	return false
+	return 0
 }
 
-func (x *HoldDetails) GetReason() string {
+func (x *PaymentNote) GetTdsSection() string {
 	if x != nil {
-		return x.Reason
+		return x.TdsSection
 	}
 	return ""
 }
 
-func (x *HoldDetails) GetAppliedBy() *RelatedUser {
+func (x *PaymentNote) GetTdsAmount() float64 {
 	if x != nil {
-		return x.AppliedBy
+		return x.TdsAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *HoldDetails) GetAppliedAt() string {
+func (x *PaymentNote) GetAccountHolderName() string {
 	if x != nil {
-		return x.AppliedAt
+		return x.AccountHolderName
 	}
 	return ""
 }
 
-type PaymentApprovalLog struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Id            int64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Comments      string                     `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
-	Reviewer      *RelatedUser               `protobuf:"bytes,4,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
-	CreatedAt     string                     `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Priorities    []*PaymentApprovalPriority `protobuf:"bytes,6,rep,name=priorities,proto3" json:"priorities,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PaymentApprovalLog) Reset() {
-	*x = PaymentApprovalLog{}
-	mi := &file_paymentnote_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PaymentApprovalLog) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PaymentApprovalLog) ProtoMessage() {}
-
-func (x *PaymentApprovalLog) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[24]
+func (x *PaymentNote) GetBankName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BankName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PaymentApprovalLog.ProtoReflect.Descriptor instead.
-func (*PaymentApprovalLog) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{24}
+	return ""
 }
 
-func (x *PaymentApprovalLog) GetId() int64 {
+func (x *PaymentNote) GetAccountNumber() string {
 	if x != nil {
-		return x.Id
+		return x.AccountNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *PaymentApprovalLog) GetStatus() string {
+func (x *PaymentNote) GetIfscCode() string {
 	if x != nil {
-		return x.Status
+		return x.IfscCode
 	}
 	return ""
 }
 
-func (x *PaymentApprovalLog) GetComments() string {
+func (x *PaymentNote) GetAddParticulars() []*PaymentParticular {
 	if x != nil {
-		return x.Comments
+		return x.AddParticulars
 	}
-	return ""
+	return nil
 }
 
-func (x *PaymentApprovalLog) GetReviewer() *RelatedUser {
+func (x *PaymentNote) GetLessParticulars() []*PaymentParticular {
 	if x != nil {
-		return x.Reviewer
+		return x.LessParticulars
 	}
 	return nil
 }
 
-func (x *PaymentApprovalLog) GetCreatedAt() string {
+func (x *PaymentNote) GetRecommendationOfPayment() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RecommendationOfPayment
 	}
 	return ""
 }
 
-func (x *PaymentApprovalLog) GetPriorities() []*PaymentApprovalPriority {
+func (x *PaymentNote) GetStatus() string {
 	if x != nil {
-		return x.Priorities
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type PaymentApprovalPriority struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApproverLevel int32                  `protobuf:"varint,2,opt,name=approver_level,json=approverLevel,proto3" json:"approver_level,omitempty"`
-	Reviewer      *RelatedUser           `protobuf:"bytes,3,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PaymentNote) GetIsDraft() bool {
+	if x != nil {
+		return x.IsDraft
+	}
+	return false
 }
 
-func (x *PaymentApprovalPriority) Reset() {
-	*x = PaymentApprovalPriority{}
-	mi := &file_paymentnote_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PaymentNote) GetAutoCreated() bool {
+	if x != nil {
+		return x.AutoCreated
+	}
+	return false
 }
 
-func (x *PaymentApprovalPriority) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PaymentNote) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
 }
 
-func (*PaymentApprovalPriority) ProtoMessage() {}
-
-func (x *PaymentApprovalPriority) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[25]
+func (x *PaymentNote) GetHold() *common.HoldDetails {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Hold
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PaymentApprovalPriority.ProtoReflect.Descriptor instead.
-func (*PaymentApprovalPriority) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{25}
+func (x *PaymentNote) GetUtrNo() string {
+	if x != nil {
+		return x.UtrNo
+	}
+	return ""
 }
 
-func (x *PaymentApprovalPriority) GetId() int64 {
+func (x *PaymentNote) GetUtrDate() string {
 	if x != nil {
-		return x.Id
+		return x.UtrDate
 	}
-	return 0
+	return ""
 }
 
-func (x *PaymentApprovalPriority) GetApproverLevel() int32 {
+func (x *PaymentNote) GetApprovalLogs() []*PaymentApprovalLog {
 	if x != nil {
-		return x.ApproverLevel
+		return x.ApprovalLogs
 	}
-	return 0
+	return nil
 }
 
-func (x *PaymentApprovalPriority) GetReviewer() *RelatedUser {
+func (x *PaymentNote) GetComments() []*PaymentNoteComment {
 	if x != nil {
-		return x.Reviewer
+		return x.Comments
 	}
 	return nil
 }
 
-type PaymentNoteComment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	User          *RelatedUser           `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PaymentNoteComment) Reset() {
-	*x = PaymentNoteComment{}
-	mi := &file_paymentnote_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PaymentNoteComment) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PaymentNoteComment) ProtoMessage() {}
-
-func (x *PaymentNoteComment) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[26]
+func (x *PaymentNote) GetDocuments() []*PaymentNoteDocument {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Documents
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PaymentNoteComment.ProtoReflect.Descriptor instead.
-func (*PaymentNoteComment) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{26}
+	return nil
 }
 
-func (x *PaymentNoteComment) GetId() int64 {
+func (x *PaymentNote) GetGreenNote() *common.PaymentGreenNoteReference {
 	if x != nil {
-		return x.Id
+		return x.GreenNote
 	}
-	return 0
+	return nil
 }
 
-func (x *PaymentNoteComment) GetComment() string {
+func (x *PaymentNote) GetReimbursementNote() *common.PaymentReimbursementNoteReference {
 	if x != nil {
-		return x.Comment
+		return x.ReimbursementNote
 	}
-	return ""
+	return nil
 }
 
-func (x *PaymentNoteComment) GetStatus() string {
+func (x *PaymentNote) GetOwner() *common.RelatedUser {
 	if x != nil {
-		return x.Status
+		return x.Owner
 	}
-	return ""
+	return nil
 }
 
-func (x *PaymentNoteComment) GetUser() *RelatedUser {
+func (x *PaymentNote) GetCreatedByUser() *common.RelatedUser {
 	if x != nil {
-		return x.User
+		return x.CreatedByUser
 	}
 	return nil
 }
 
-func (x *PaymentNoteComment) GetCreatedAt() string {
+func (x *PaymentNote) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-type GreenNoteReference struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderNo          string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	FormattedOrderNo string                 `protobuf:"bytes,3,opt,name=formatted_order_no,json=formattedOrderNo,proto3" json:"formatted_order_no,omitempty"`
-	InvoiceValue     float64                `protobuf:"fixed64,4,opt,name=invoice_value,json=invoiceValue,proto3" json:"invoice_value,omitempty"`
-	Vendor           *RelatedVendor         `protobuf:"bytes,5,opt,name=vendor,proto3" json:"vendor,omitempty"`
-	Supplier         *RelatedSupplier       `protobuf:"bytes,6,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *GreenNoteReference) Reset() {
-	*x = GreenNoteReference{}
-	mi := &file_paymentnote_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GreenNoteReference) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PaymentNote) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
 }
 
-func (*GreenNoteReference) ProtoMessage() {}
-
-func (x *GreenNoteReference) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[27]
+func (x *PaymentNote) GetInvoiceAmountExact() *Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InvoiceAmountExact
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GreenNoteReference.ProtoReflect.Descriptor instead.
-func (*GreenNoteReference) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{27}
+func (x *PaymentNote) GetLoaPoAmountExact() *Money {
+	if x != nil {
+		return x.LoaPoAmountExact
+	}
+	return nil
 }
 
-func (x *GreenNoteReference) GetId() int64 {
+func (x *PaymentNote) GetGrossAmountExact() *Money {
 	if x != nil {
-		return x.Id
+		return x.GrossAmountExact
 	}
-	return 0
+	return nil
 }
 
-func (x *GreenNoteReference) GetOrderNo() string {
+func (x *PaymentNote) GetTotalAdditionsExact() *Money {
 	if x != nil {
-		return x.OrderNo
+		return x.TotalAdditionsExact
 	}
-	return ""
+	return nil
 }
 
-func (x *GreenNoteReference) GetFormattedOrderNo() string {
+func (x *PaymentNote) GetTotalDeductionsExact() *Money {
 	if x != nil {
-		return x.FormattedOrderNo
+		return x.TotalDeductionsExact
 	}
-	return ""
+	return nil
 }
 
-func (x *GreenNoteReference) GetInvoiceValue() float64 {
+func (x *PaymentNote) GetNetPayableAmountExact() *Money {
 	if x != nil {
-		return x.InvoiceValue
+		return x.NetPayableAmountExact
 	}
-	return 0
+	return nil
 }
 
-func (x *GreenNoteReference) GetVendor() *RelatedVendor {
+func (x *PaymentNote) GetNetPayableRoundOffExact() *Money {
 	if x != nil {
-		return x.Vendor
+		return x.NetPayableRoundOffExact
 	}
 	return nil
 }
 
-func (x *GreenNoteReference) GetSupplier() *RelatedSupplier {
+func (x *PaymentNote) GetTdsAmountExact() *Money {
 	if x != nil {
-		return x.Supplier
+		return x.TdsAmountExact
 	}
 	return nil
 }
 
-type ReimbursementNoteReference struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NoteNo          string                 `protobuf:"bytes,2,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
-	ProjectName     string                 `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	SelectUser      *RelatedUser           `protobuf:"bytes,4,opt,name=select_user,json=selectUser,proto3" json:"select_user,omitempty"`
-	Owner           *RelatedUser           `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
-	TotalPayable    float64                `protobuf:"fixed64,6,opt,name=total_payable,json=totalPayable,proto3" json:"total_payable,omitempty"`
-	AdvanceAdjusted float64                `protobuf:"fixed64,7,opt,name=advance_adjusted,json=advanceAdjusted,proto3" json:"advance_adjusted,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type PaymentApprovalLog struct {
+	state      protoimpl.MessageState            `protogen:"open.v1"`
+	Id         int64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status     string                            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Comments   string                            `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	Reviewer   *common.RelatedUser               `protobuf:"bytes,4,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	CreatedAt  string                            `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Priorities []*common.PaymentApprovalPriority `protobuf:"bytes,6,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	// Set when the reviewer acted as the delegate of an approver on leave
+	OnBehalfOfId   string `protobuf:"bytes,7,opt,name=on_behalf_of_id,json=onBehalfOfId,proto3" json:"on_behalf_of_id,omitempty"`
+	OnBehalfOfName string `protobuf:"bytes,8,opt,name=on_behalf_of_name,json=onBehalfOfName,proto3" json:"on_behalf_of_name,omitempty"`
+	DelegationId   string `protobuf:"bytes,9,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ReimbursementNoteReference) Reset() {
-	*x = ReimbursementNoteReference{}
-	mi := &file_paymentnote_proto_msgTypes[28]
+func (x *PaymentApprovalLog) Reset() {
+	*x = PaymentApprovalLog{}
+	mi := &file_paymentnote_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReimbursementNoteReference) String() string {
+func (x *PaymentApprovalLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReimbursementNoteReference) ProtoMessage() {}
+func (*PaymentApprovalLog) ProtoMessage() {}
 
-func (x *ReimbursementNoteReference) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[28]
+func (x *PaymentApprovalLog) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentnote_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2137,84 +2547,100 @@ func (x *ReimbursementNoteReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReimbursementNoteReference.ProtoReflect.Descriptor instead.
-func (*ReimbursementNoteReference) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use PaymentApprovalLog.ProtoReflect.Descriptor instead.
+func (*PaymentApprovalLog) Descriptor() ([]byte, []int) {
+	return file_paymentnote_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ReimbursementNoteReference) GetId() int64 {
+func (x *PaymentApprovalLog) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ReimbursementNoteReference) GetNoteNo() string {
+func (x *PaymentApprovalLog) GetStatus() string {
 	if x != nil {
-		return x.NoteNo
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ReimbursementNoteReference) GetProjectName() string {
+func (x *PaymentApprovalLog) GetComments() string {
 	if x != nil {
-		return x.ProjectName
+		return x.Comments
 	}
 	return ""
 }
 
-func (x *ReimbursementNoteReference) GetSelectUser() *RelatedUser {
+func (x *PaymentApprovalLog) GetReviewer() *common.RelatedUser {
 	if x != nil {
-		return x.SelectUser
+		return x.Reviewer
 	}
 	return nil
 }
 
-func (x *ReimbursementNoteReference) GetOwner() *RelatedUser {
+func (x *PaymentApprovalLog) GetCreatedAt() string {
 	if x != nil {
-		return x.Owner
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PaymentApprovalLog) GetPriorities() []*common.PaymentApprovalPriority {
+	if x != nil {
+		return x.Priorities
 	}
 	return nil
 }
 
-func (x *ReimbursementNoteReference) GetTotalPayable() float64 {
+func (x *PaymentApprovalLog) GetOnBehalfOfId() string {
 	if x != nil {
-		return x.TotalPayable
+		return x.OnBehalfOfId
 	}
-	return 0
+	return ""
 }
 
-func (x *ReimbursementNoteReference) GetAdvanceAdjusted() float64 {
+func (x *PaymentApprovalLog) GetOnBehalfOfName() string {
 	if x != nil {
-		return x.AdvanceAdjusted
+		return x.OnBehalfOfName
 	}
-	return 0
+	return ""
+}
+
+func (x *PaymentApprovalLog) GetDelegationId() string {
+	if x != nil {
+		return x.DelegationId
+	}
+	return ""
 }
 
-type RelatedUser struct {
+type PaymentNoteComment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	User          *common.RelatedUser    `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelatedUser) Reset() {
-	*x = RelatedUser{}
-	mi := &file_paymentnote_proto_msgTypes[29]
+func (x *PaymentNoteComment) Reset() {
+	*x = PaymentNoteComment{}
+	mi := &file_paymentnote_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelatedUser) String() string {
+func (x *PaymentNoteComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelatedUser) ProtoMessage() {}
+func (*PaymentNoteComment) ProtoMessage() {}
 
-func (x *RelatedUser) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[29]
+func (x *PaymentNoteComment) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentnote_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,56 +2651,76 @@ func (x *RelatedUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelatedUser.ProtoReflect.Descriptor instead.
-func (*RelatedUser) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PaymentNoteComment.ProtoReflect.Descriptor instead.
+func (*PaymentNoteComment) Descriptor() ([]byte, []int) {
+	return file_paymentnote_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RelatedUser) GetId() int64 {
+func (x *PaymentNoteComment) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *RelatedUser) GetName() string {
+func (x *PaymentNoteComment) GetComment() string {
 	if x != nil {
-		return x.Name
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *RelatedUser) GetEmail() string {
+func (x *PaymentNoteComment) GetStatus() string {
 	if x != nil {
-		return x.Email
+		return x.Status
 	}
 	return ""
 }
 
-type RelatedVendor struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Project       string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
-	VendorName    string                 `protobuf:"bytes,3,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PaymentNoteComment) GetUser() *common.RelatedUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PaymentNoteComment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PaymentNoteDocument struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileName         string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	OriginalFilename string                 `protobuf:"bytes,3,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
+	MimeType         string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	FileSize         int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	ObjectKey        string                 `protobuf:"bytes,6,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	UploadedBy       int64                  `protobuf:"varint,7,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	UploadedByName   string                 `protobuf:"bytes,8,opt,name=uploaded_by_name,json=uploadedByName,proto3" json:"uploaded_by_name,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *RelatedVendor) Reset() {
-	*x = RelatedVendor{}
-	mi := &file_paymentnote_proto_msgTypes[30]
+func (x *PaymentNoteDocument) Reset() {
+	*x = PaymentNoteDocument{}
+	mi := &file_paymentnote_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelatedVendor) String() string {
+func (x *PaymentNoteDocument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelatedVendor) ProtoMessage() {}
+func (*PaymentNoteDocument) ProtoMessage() {}
 
-func (x *RelatedVendor) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[30]
+func (x *PaymentNoteDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentnote_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2285,117 +2731,99 @@ func (x *RelatedVendor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelatedVendor.ProtoReflect.Descriptor instead.
-func (*RelatedVendor) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PaymentNoteDocument.ProtoReflect.Descriptor instead.
+func (*PaymentNoteDocument) Descriptor() ([]byte, []int) {
+	return file_paymentnote_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RelatedVendor) GetId() int64 {
+func (x *PaymentNoteDocument) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *RelatedVendor) GetProject() string {
+func (x *PaymentNoteDocument) GetFileName() string {
 	if x != nil {
-		return x.Project
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *RelatedVendor) GetVendorName() string {
+func (x *PaymentNoteDocument) GetOriginalFilename() string {
 	if x != nil {
-		return x.VendorName
+		return x.OriginalFilename
 	}
 	return ""
 }
 
-type RelatedSupplier struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VendorName    string                 `protobuf:"bytes,2,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
-	VendorEmail   string                 `protobuf:"bytes,3,opt,name=vendor_email,json=vendorEmail,proto3" json:"vendor_email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RelatedSupplier) Reset() {
-	*x = RelatedSupplier{}
-	mi := &file_paymentnote_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RelatedSupplier) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PaymentNoteDocument) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
 }
 
-func (*RelatedSupplier) ProtoMessage() {}
-
-func (x *RelatedSupplier) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[31]
+func (x *PaymentNoteDocument) GetFileSize() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FileSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RelatedSupplier.ProtoReflect.Descriptor instead.
-func (*RelatedSupplier) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{31}
+func (x *PaymentNoteDocument) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
 }
 
-func (x *RelatedSupplier) GetId() int64 {
+func (x *PaymentNoteDocument) GetUploadedBy() int64 {
 	if x != nil {
-		return x.Id
+		return x.UploadedBy
 	}
 	return 0
 }
 
-func (x *RelatedSupplier) GetVendorName() string {
+func (x *PaymentNoteDocument) GetUploadedByName() string {
 	if x != nil {
-		return x.VendorName
+		return x.UploadedByName
 	}
 	return ""
 }
 
-func (x *RelatedSupplier) GetVendorEmail() string {
+func (x *PaymentNoteDocument) GetCreatedAt() string {
 	if x != nil {
-		return x.VendorEmail
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type Pagination struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	TotalItems    int64                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PaymentNoteDocumentUpload struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FileName         string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	OriginalFilename string                 `protobuf:"bytes,2,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
+	MimeType         string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	FileContent      []byte                 `protobuf:"bytes,4,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Pagination) Reset() {
-	*x = Pagination{}
-	mi := &file_paymentnote_proto_msgTypes[32]
+func (x *PaymentNoteDocumentUpload) Reset() {
+	*x = PaymentNoteDocumentUpload{}
+	mi := &file_paymentnote_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pagination) String() string {
+func (x *PaymentNoteDocumentUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pagination) ProtoMessage() {}
+func (*PaymentNoteDocumentUpload) ProtoMessage() {}
 
-func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentnote_proto_msgTypes[32]
+func (x *PaymentNoteDocumentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentnote_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,44 +2834,44 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
-func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_paymentnote_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use PaymentNoteDocumentUpload.ProtoReflect.Descriptor instead.
+func (*PaymentNoteDocumentUpload) Descriptor() ([]byte, []int) {
+	return file_paymentnote_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Pagination) GetPage() int32 {
+func (x *PaymentNoteDocumentUpload) GetFileName() string {
 	if x != nil {
-		return x.Page
+		return x.FileName
 	}
-	return 0
+	return ""
 }
 
-func (x *Pagination) GetPerPage() int32 {
+func (x *PaymentNoteDocumentUpload) GetOriginalFilename() string {
 	if x != nil {
-		return x.PerPage
+		return x.OriginalFilename
 	}
-	return 0
+	return ""
 }
 
-func (x *Pagination) GetTotalItems() int64 {
+func (x *PaymentNoteDocumentUpload) GetMimeType() string {
 	if x != nil {
-		return x.TotalItems
+		return x.MimeType
 	}
-	return 0
+	return ""
 }
 
-func (x *Pagination) GetTotalPages() int32 {
+func (x *PaymentNoteDocumentUpload) GetFileContent() []byte {
 	if x != nil {
-		return x.TotalPages
+		return x.FileContent
 	}
-	return 0
+	return nil
 }
 
 var File_paymentnote_proto protoreflect.FileDescriptor
 
 const file_paymentnote_proto_rawDesc = "" +
 	"\n" +
-	"\x11paymentnote.proto\x12\vpaymentnote\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"\xe0\x02\n" +
+	"\x11paymentnote.proto\x12\vpaymentnote\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"\xe0\x02\n" +
 	"\x17ListPaymentNotesRequest\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vinclude_all\x18\x02 \x01(\bR\n" +
@@ -2508,39 +2936,89 @@ const file_paymentnote_proto_rawDesc = "" +
 	"\asubject\x18\x02 \x01(\tR\asubject\x12:\n" +
 	"\x19recommendation_of_payment\x18\x03 \x01(\tR\x17recommendationOfPayment\x12&\n" +
 	"\x0fcreate_as_draft\x18\x04 \x01(\bR\rcreateAsDraft\x12\x19\n" +
-	"\bactor_id\x18\x05 \x01(\x03R\aactorId\"\xe8\x05\n" +
+	"\bactor_id\x18\x05 \x01(\x03R\aactorId\"=\n" +
+	"$CountOrganizationPaymentNotesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"I\n" +
+	"\x05Money\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"\xcd\x12\n" +
 	"\x12PaymentNotePayload\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
 	"\rgreen_note_id\x18\x02 \x01(\x03R\vgreenNoteId\x122\n" +
-	"\x15reimbursement_note_id\x18\x03 \x01(\x03R\x13reimbursementNoteId\x121\n" +
-	"\x15net_payable_round_off\x18\x04 \x01(\x01R\x12netPayableRoundOff\x12\x18\n" +
-	"\asubject\x18\x05 \x01(\tR\asubject\x12\x17\n" +
-	"\anote_no\x18\x06 \x01(\tR\x06noteNo\x12:\n" +
-	"\x19recommendation_of_payment\x18\a \x01(\tR\x17recommendationOfPayment\x12G\n" +
-	"\x0fadd_particulars\x18\b \x03(\v2\x1e.paymentnote.PaymentParticularR\x0eaddParticulars\x12I\n" +
-	"\x10less_particulars\x18\t \x03(\v2\x1e.paymentnote.PaymentParticularR\x0flessParticulars\x12\x16\n" +
-	"\x06status\x18\n" +
-	" \x01(\tR\x06status\x12\x19\n" +
-	"\bis_draft\x18\v \x01(\bR\aisDraft\x12!\n" +
-	"\fauto_created\x18\f \x01(\bR\vautoCreated\x12\x1d\n" +
+	"\x15reimbursement_note_id\x18\x03 \x01(\x03R\x13reimbursementNoteId\x12\x17\n" +
+	"\anote_no\x18\x04 \x01(\tR\x06noteNo\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x12\n" +
+	"\x04date\x18\x06 \x01(\tR\x04date\x12\x1e\n" +
+	"\n" +
+	"department\x18\a \x01(\tR\n" +
+	"department\x12\"\n" +
+	"\rgreen_note_no\x18\b \x01(\tR\vgreenNoteNo\x12.\n" +
+	"\x13green_note_approver\x18\t \x01(\tR\x11greenNoteApprover\x12-\n" +
+	"\x13green_note_app_date\x18\n" +
+	" \x01(\tR\x10greenNoteAppDate\x12\x1f\n" +
+	"\vvendor_code\x18\v \x01(\tR\n" +
+	"vendorCode\x12\x1f\n" +
+	"\vvendor_name\x18\f \x01(\tR\n" +
+	"vendorName\x12!\n" +
+	"\fproject_name\x18\r \x01(\tR\vprojectName\x12\x1d\n" +
+	"\n" +
+	"invoice_no\x18\x0e \x01(\tR\tinvoiceNo\x12!\n" +
+	"\finvoice_date\x18\x0f \x01(\tR\vinvoiceDate\x12%\n" +
+	"\x0einvoice_amount\x18\x10 \x01(\x01R\rinvoiceAmount\x12.\n" +
+	"\x13invoice_approved_by\x18\x11 \x01(\tR\x11invoiceApprovedBy\x12\x1a\n" +
+	"\tloa_po_no\x18\x12 \x01(\tR\aloaPoNo\x12\"\n" +
+	"\rloa_po_amount\x18\x13 \x01(\x01R\vloaPoAmount\x12\x1e\n" +
+	"\vloa_po_date\x18\x14 \x01(\tR\tloaPoDate\x12!\n" +
+	"\fgross_amount\x18\x15 \x01(\x01R\vgrossAmount\x12'\n" +
+	"\x0ftotal_additions\x18\x16 \x01(\x01R\x0etotalAdditions\x12)\n" +
+	"\x10total_deductions\x18\x17 \x01(\x01R\x0ftotalDeductions\x12,\n" +
+	"\x12net_payable_amount\x18\x18 \x01(\x01R\x10netPayableAmount\x121\n" +
+	"\x15net_payable_round_off\x18\x19 \x01(\x01R\x12netPayableRoundOff\x12*\n" +
+	"\x11net_payable_words\x18\x1a \x01(\tR\x0fnetPayableWords\x12%\n" +
+	"\x0etds_percentage\x18\x1b \x01(\x01R\rtdsPercentage\x12\x1f\n" +
+	"\vtds_section\x18\x1c \x01(\tR\n" +
+	"tdsSection\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\r \x01(\x03R\tcreatedBy\x12\x1f\n" +
-	"\vhold_reason\x18\x0e \x01(\tR\n" +
+	"tds_amount\x18\x1d \x01(\x01R\ttdsAmount\x12.\n" +
+	"\x13account_holder_name\x18\x1e \x01(\tR\x11accountHolderName\x12\x1b\n" +
+	"\tbank_name\x18\x1f \x01(\tR\bbankName\x12%\n" +
+	"\x0eaccount_number\x18  \x01(\tR\raccountNumber\x12\x1b\n" +
+	"\tifsc_code\x18! \x01(\tR\bifscCode\x12G\n" +
+	"\x0fadd_particulars\x18\" \x03(\v2\x1e.paymentnote.PaymentParticularR\x0eaddParticulars\x12I\n" +
+	"\x10less_particulars\x18# \x03(\v2\x1e.paymentnote.PaymentParticularR\x0flessParticulars\x12:\n" +
+	"\x19recommendation_of_payment\x18$ \x01(\tR\x17recommendationOfPayment\x12\x16\n" +
+	"\x06status\x18% \x01(\tR\x06status\x12\x19\n" +
+	"\bis_draft\x18& \x01(\bR\aisDraft\x12!\n" +
+	"\fauto_created\x18' \x01(\bR\vautoCreated\x12\x1d\n" +
+	"\n" +
+	"created_by\x18( \x01(\x03R\tcreatedBy\x12\x1f\n" +
+	"\vhold_reason\x18) \x01(\tR\n" +
 	"holdReason\x12\x1b\n" +
-	"\thold_date\x18\x0f \x01(\tR\bholdDate\x12\x17\n" +
-	"\ahold_by\x18\x10 \x01(\x03R\x06holdBy\x12\x15\n" +
-	"\x06utr_no\x18\x11 \x01(\tR\x05utrNo\x12\x19\n" +
-	"\butr_date\x18\x12 \x01(\tR\autrDate\x12-\n" +
-	"\x12send_notifications\x18\x13 \x01(\bR\x11sendNotifications\"K\n" +
+	"\thold_date\x18* \x01(\tR\bholdDate\x12\x17\n" +
+	"\ahold_by\x18+ \x01(\x03R\x06holdBy\x12\x15\n" +
+	"\x06utr_no\x18, \x01(\tR\x05utrNo\x12\x19\n" +
+	"\butr_date\x18- \x01(\tR\autrDate\x12-\n" +
+	"\x12send_notifications\x18. \x01(\bR\x11sendNotifications\x12K\n" +
+	"\rnew_documents\x18/ \x03(\v2&.paymentnote.PaymentNoteDocumentUploadR\fnewDocuments\x12D\n" +
+	"\x14invoice_amount_exact\x180 \x01(\v2\x12.paymentnote.MoneyR\x12invoiceAmountExact\x12A\n" +
+	"\x13loa_po_amount_exact\x181 \x01(\v2\x12.paymentnote.MoneyR\x10loaPoAmountExact\x12@\n" +
+	"\x12gross_amount_exact\x182 \x01(\v2\x12.paymentnote.MoneyR\x10grossAmountExact\x12F\n" +
+	"\x15total_additions_exact\x183 \x01(\v2\x12.paymentnote.MoneyR\x13totalAdditionsExact\x12H\n" +
+	"\x16total_deductions_exact\x184 \x01(\v2\x12.paymentnote.MoneyR\x14totalDeductionsExact\x12K\n" +
+	"\x18net_payable_amount_exact\x185 \x01(\v2\x12.paymentnote.MoneyR\x15netPayableAmountExact\x12P\n" +
+	"\x1bnet_payable_round_off_exact\x186 \x01(\v2\x12.paymentnote.MoneyR\x17netPayableRoundOffExact\x12<\n" +
+	"\x10tds_amount_exact\x187 \x01(\v2\x12.paymentnote.MoneyR\x0etdsAmountExact\"\x82\x01\n" +
 	"\x11PaymentParticular\x12\x1e\n" +
 	"\n" +
 	"particular\x18\x01 \x01(\tR\n" +
 	"particular\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\x8a\x01\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x125\n" +
+	"\famount_exact\x18\x03 \x01(\v2\x12.paymentnote.MoneyR\vamountExact\"\x85\x01\n" +
 	"\x18ListPaymentNotesResponse\x125\n" +
-	"\x05notes\x18\x01 \x03(\v2\x1f.paymentnote.PaymentNoteSummaryR\x05notes\x127\n" +
+	"\x05notes\x18\x01 \x03(\v2\x1f.paymentnote.PaymentNoteSummaryR\x05notes\x122\n" +
 	"\n" +
-	"pagination\x18\x02 \x01(\v2\x17.paymentnote.PaginationR\n" +
+	"pagination\x18\x02 \x01(\v2\x12.common.PaginationR\n" +
 	"pagination\"C\n" +
 	"\x13PaymentNoteResponse\x12,\n" +
 	"\x04note\x18\x01 \x01(\v2\x18.paymentnote.PaymentNoteR\x04note\"\x8a\x01\n" +
@@ -2551,11 +3029,13 @@ const file_paymentnote_proto_rawDesc = "" +
 	"\x1eDownloadPaymentNotePdfResponse\x12!\n" +
 	"\ffile_content\x18\x01 \x01(\fR\vfileContent\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
-	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"l\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"=\n" +
+	"%CountOrganizationPaymentNotesResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"l\n" +
 	"\x1aTestPaymentNoteAPIResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\xa9\x03\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\xfa\x03\n" +
 	"\x12PaymentNoteSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\anote_no\x18\x02 \x01(\tR\x06noteNo\x12\x16\n" +
@@ -2565,109 +3045,125 @@ const file_paymentnote_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\x12>\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12@\n" +
 	"\n" +
-	"green_note\x18\b \x01(\v2\x1f.paymentnote.GreenNoteReferenceR\tgreenNote\x12V\n" +
-	"\x12reimbursement_note\x18\t \x01(\v2'.paymentnote.ReimbursementNoteReferenceR\x11reimbursementNote\x12.\n" +
+	"green_note\x18\b \x01(\v2!.common.PaymentGreenNoteReferenceR\tgreenNote\x12X\n" +
+	"\x12reimbursement_note\x18\t \x01(\v2).common.PaymentReimbursementNoteReferenceR\x11reimbursementNote\x12)\n" +
 	"\x05owner\x18\n" +
-	" \x01(\v2\x18.paymentnote.RelatedUserR\x05owner\"\xe4\b\n" +
+	" \x01(\v2\x13.common.RelatedUserR\x05owner\x12P\n" +
+	"\x1bnet_payable_round_off_exact\x18\v \x01(\v2\x12.paymentnote.MoneyR\x17netPayableRoundOffExact\"\xb1\x15\n" +
 	"\vPaymentNote\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\"\n" +
 	"\rgreen_note_id\x18\x03 \x01(\x03R\vgreenNoteId\x122\n" +
 	"\x15reimbursement_note_id\x18\x04 \x01(\x03R\x13reimbursementNoteId\x12\x17\n" +
 	"\anote_no\x18\x05 \x01(\tR\x06noteNo\x12\x18\n" +
-	"\asubject\x18\x06 \x01(\tR\asubject\x12:\n" +
-	"\x19recommendation_of_payment\x18\a \x01(\tR\x17recommendationOfPayment\x121\n" +
-	"\x15net_payable_round_off\x18\b \x01(\x01R\x12netPayableRoundOff\x12G\n" +
-	"\x0fadd_particulars\x18\t \x03(\v2\x1e.paymentnote.PaymentParticularR\x0eaddParticulars\x12I\n" +
-	"\x10less_particulars\x18\n" +
-	" \x03(\v2\x1e.paymentnote.PaymentParticularR\x0flessParticulars\x12\x16\n" +
-	"\x06status\x18\v \x01(\tR\x06status\x12\x19\n" +
-	"\bis_draft\x18\f \x01(\bR\aisDraft\x12!\n" +
-	"\fauto_created\x18\r \x01(\bR\vautoCreated\x12\x1d\n" +
+	"\asubject\x18\x06 \x01(\tR\asubject\x12\x12\n" +
+	"\x04date\x18\a \x01(\tR\x04date\x12\x1e\n" +
+	"\n" +
+	"department\x18\b \x01(\tR\n" +
+	"department\x12\"\n" +
+	"\rgreen_note_no\x18\t \x01(\tR\vgreenNoteNo\x12.\n" +
+	"\x13green_note_approver\x18\n" +
+	" \x01(\tR\x11greenNoteApprover\x12-\n" +
+	"\x13green_note_app_date\x18\v \x01(\tR\x10greenNoteAppDate\x12\x1f\n" +
+	"\vvendor_code\x18\f \x01(\tR\n" +
+	"vendorCode\x12\x1f\n" +
+	"\vvendor_name\x18\r \x01(\tR\n" +
+	"vendorName\x12!\n" +
+	"\fproject_name\x18\x0e \x01(\tR\vprojectName\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x0e \x01(\x03R\tcreatedBy\x12,\n" +
-	"\x04hold\x18\x0f \x01(\v2\x18.paymentnote.HoldDetailsR\x04hold\x12\x15\n" +
-	"\x06utr_no\x18\x10 \x01(\tR\x05utrNo\x12\x19\n" +
-	"\butr_date\x18\x11 \x01(\tR\autrDate\x12D\n" +
-	"\rapproval_logs\x18\x12 \x03(\v2\x1f.paymentnote.PaymentApprovalLogR\fapprovalLogs\x12;\n" +
-	"\bcomments\x18\x13 \x03(\v2\x1f.paymentnote.PaymentNoteCommentR\bcomments\x12>\n" +
+	"invoice_no\x18\x0f \x01(\tR\tinvoiceNo\x12!\n" +
+	"\finvoice_date\x18\x10 \x01(\tR\vinvoiceDate\x12%\n" +
+	"\x0einvoice_amount\x18\x11 \x01(\x01R\rinvoiceAmount\x12.\n" +
+	"\x13invoice_approved_by\x18\x12 \x01(\tR\x11invoiceApprovedBy\x12\x1a\n" +
+	"\tloa_po_no\x18\x13 \x01(\tR\aloaPoNo\x12\"\n" +
+	"\rloa_po_amount\x18\x14 \x01(\x01R\vloaPoAmount\x12\x1e\n" +
+	"\vloa_po_date\x18\x15 \x01(\tR\tloaPoDate\x12!\n" +
+	"\fgross_amount\x18\x16 \x01(\x01R\vgrossAmount\x12'\n" +
+	"\x0ftotal_additions\x18\x17 \x01(\x01R\x0etotalAdditions\x12)\n" +
+	"\x10total_deductions\x18\x18 \x01(\x01R\x0ftotalDeductions\x12,\n" +
+	"\x12net_payable_amount\x18\x19 \x01(\x01R\x10netPayableAmount\x121\n" +
+	"\x15net_payable_round_off\x18\x1a \x01(\x01R\x12netPayableRoundOff\x12*\n" +
+	"\x11net_payable_words\x18\x1b \x01(\tR\x0fnetPayableWords\x12%\n" +
+	"\x0etds_percentage\x18\x1c \x01(\x01R\rtdsPercentage\x12\x1f\n" +
+	"\vtds_section\x18\x1d \x01(\tR\n" +
+	"tdsSection\x12\x1d\n" +
 	"\n" +
-	"green_note\x18\x14 \x01(\v2\x1f.paymentnote.GreenNoteReferenceR\tgreenNote\x12V\n" +
-	"\x12reimbursement_note\x18\x15 \x01(\v2'.paymentnote.ReimbursementNoteReferenceR\x11reimbursementNote\x12.\n" +
-	"\x05owner\x18\x16 \x01(\v2\x18.paymentnote.RelatedUserR\x05owner\x12@\n" +
-	"\x0fcreated_by_user\x18\x17 \x01(\v2\x18.paymentnote.RelatedUserR\rcreatedByUser\x12\x1d\n" +
+	"tds_amount\x18\x1e \x01(\x01R\ttdsAmount\x12.\n" +
+	"\x13account_holder_name\x18\x1f \x01(\tR\x11accountHolderName\x12\x1b\n" +
+	"\tbank_name\x18  \x01(\tR\bbankName\x12%\n" +
+	"\x0eaccount_number\x18! \x01(\tR\raccountNumber\x12\x1b\n" +
+	"\tifsc_code\x18\" \x01(\tR\bifscCode\x12G\n" +
+	"\x0fadd_particulars\x18# \x03(\v2\x1e.paymentnote.PaymentParticularR\x0eaddParticulars\x12I\n" +
+	"\x10less_particulars\x18$ \x03(\v2\x1e.paymentnote.PaymentParticularR\x0flessParticulars\x12:\n" +
+	"\x19recommendation_of_payment\x18% \x01(\tR\x17recommendationOfPayment\x12\x16\n" +
+	"\x06status\x18& \x01(\tR\x06status\x12\x19\n" +
+	"\bis_draft\x18' \x01(\bR\aisDraft\x12!\n" +
+	"\fauto_created\x18( \x01(\bR\vautoCreated\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x18 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"created_by\x18) \x01(\x03R\tcreatedBy\x12'\n" +
+	"\x04hold\x18* \x01(\v2\x13.common.HoldDetailsR\x04hold\x12\x15\n" +
+	"\x06utr_no\x18+ \x01(\tR\x05utrNo\x12\x19\n" +
+	"\butr_date\x18, \x01(\tR\autrDate\x12D\n" +
+	"\rapproval_logs\x18- \x03(\v2\x1f.paymentnote.PaymentApprovalLogR\fapprovalLogs\x12;\n" +
+	"\bcomments\x18. \x03(\v2\x1f.paymentnote.PaymentNoteCommentR\bcomments\x12>\n" +
+	"\tdocuments\x18/ \x03(\v2 .paymentnote.PaymentNoteDocumentR\tdocuments\x12@\n" +
 	"\n" +
-	"updated_at\x18\x19 \x01(\tR\tupdatedAt\"\x95\x01\n" +
-	"\vHoldDetails\x12\x16\n" +
-	"\x06active\x18\x01 \x01(\bR\x06active\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\x127\n" +
+	"green_note\x180 \x01(\v2!.common.PaymentGreenNoteReferenceR\tgreenNote\x12X\n" +
+	"\x12reimbursement_note\x181 \x01(\v2).common.PaymentReimbursementNoteReferenceR\x11reimbursementNote\x12)\n" +
+	"\x05owner\x182 \x01(\v2\x13.common.RelatedUserR\x05owner\x12;\n" +
+	"\x0fcreated_by_user\x183 \x01(\v2\x13.common.RelatedUserR\rcreatedByUser\x12\x1d\n" +
 	"\n" +
-	"applied_by\x18\x03 \x01(\v2\x18.paymentnote.RelatedUserR\tappliedBy\x12\x1d\n" +
+	"created_at\x184 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"applied_at\x18\x04 \x01(\tR\tappliedAt\"\xf3\x01\n" +
+	"updated_at\x185 \x01(\tR\tupdatedAt\x12D\n" +
+	"\x14invoice_amount_exact\x186 \x01(\v2\x12.paymentnote.MoneyR\x12invoiceAmountExact\x12A\n" +
+	"\x13loa_po_amount_exact\x187 \x01(\v2\x12.paymentnote.MoneyR\x10loaPoAmountExact\x12@\n" +
+	"\x12gross_amount_exact\x188 \x01(\v2\x12.paymentnote.MoneyR\x10grossAmountExact\x12F\n" +
+	"\x15total_additions_exact\x189 \x01(\v2\x12.paymentnote.MoneyR\x13totalAdditionsExact\x12H\n" +
+	"\x16total_deductions_exact\x18: \x01(\v2\x12.paymentnote.MoneyR\x14totalDeductionsExact\x12K\n" +
+	"\x18net_payable_amount_exact\x18; \x01(\v2\x12.paymentnote.MoneyR\x15netPayableAmountExact\x12P\n" +
+	"\x1bnet_payable_round_off_exact\x18< \x01(\v2\x12.paymentnote.MoneyR\x17netPayableRoundOffExact\x12<\n" +
+	"\x10tds_amount_exact\x18= \x01(\v2\x12.paymentnote.MoneyR\x0etdsAmountExact\"\xe0\x02\n" +
 	"\x12PaymentApprovalLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
-	"\bcomments\x18\x03 \x01(\tR\bcomments\x124\n" +
-	"\breviewer\x18\x04 \x01(\v2\x18.paymentnote.RelatedUserR\breviewer\x12\x1d\n" +
+	"\bcomments\x18\x03 \x01(\tR\bcomments\x12/\n" +
+	"\breviewer\x18\x04 \x01(\v2\x13.common.RelatedUserR\breviewer\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12D\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12?\n" +
 	"\n" +
-	"priorities\x18\x06 \x03(\v2$.paymentnote.PaymentApprovalPriorityR\n" +
-	"priorities\"\x86\x01\n" +
-	"\x17PaymentApprovalPriority\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
-	"\x0eapprover_level\x18\x02 \x01(\x05R\rapproverLevel\x124\n" +
-	"\breviewer\x18\x03 \x01(\v2\x18.paymentnote.RelatedUserR\breviewer\"\xa3\x01\n" +
+	"priorities\x18\x06 \x03(\v2\x1f.common.PaymentApprovalPriorityR\n" +
+	"priorities\x12%\n" +
+	"\x0fon_behalf_of_id\x18\a \x01(\tR\fonBehalfOfId\x12)\n" +
+	"\x11on_behalf_of_name\x18\b \x01(\tR\x0eonBehalfOfName\x12#\n" +
+	"\rdelegation_id\x18\t \x01(\tR\fdelegationId\"\x9e\x01\n" +
 	"\x12PaymentNoteComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
 	"\acomment\x18\x02 \x01(\tR\acomment\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12,\n" +
-	"\x04user\x18\x04 \x01(\v2\x18.paymentnote.RelatedUserR\x04user\x12\x1d\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x04user\x18\x04 \x01(\v2\x13.common.RelatedUserR\x04user\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x80\x02\n" +
-	"\x12GreenNoteReference\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\border_no\x18\x02 \x01(\tR\aorderNo\x12,\n" +
-	"\x12formatted_order_no\x18\x03 \x01(\tR\x10formattedOrderNo\x12#\n" +
-	"\rinvoice_value\x18\x04 \x01(\x01R\finvoiceValue\x122\n" +
-	"\x06vendor\x18\x05 \x01(\v2\x1a.paymentnote.RelatedVendorR\x06vendor\x128\n" +
-	"\bsupplier\x18\x06 \x01(\v2\x1c.paymentnote.RelatedSupplierR\bsupplier\"\xa3\x02\n" +
-	"\x1aReimbursementNoteReference\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
-	"\anote_no\x18\x02 \x01(\tR\x06noteNo\x12!\n" +
-	"\fproject_name\x18\x03 \x01(\tR\vprojectName\x129\n" +
-	"\vselect_user\x18\x04 \x01(\v2\x18.paymentnote.RelatedUserR\n" +
-	"selectUser\x12.\n" +
-	"\x05owner\x18\x05 \x01(\v2\x18.paymentnote.RelatedUserR\x05owner\x12#\n" +
-	"\rtotal_payable\x18\x06 \x01(\x01R\ftotalPayable\x12)\n" +
-	"\x10advance_adjusted\x18\a \x01(\x01R\x0fadvanceAdjusted\"G\n" +
-	"\vRelatedUser\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"Z\n" +
-	"\rRelatedVendor\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
-	"\aproject\x18\x02 \x01(\tR\aproject\x12\x1f\n" +
-	"\vvendor_name\x18\x03 \x01(\tR\n" +
-	"vendorName\"e\n" +
-	"\x0fRelatedSupplier\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
-	"\vvendor_name\x18\x02 \x01(\tR\n" +
-	"vendorName\x12!\n" +
-	"\fvendor_email\x18\x03 \x01(\tR\vvendorEmail\"}\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xb2\x02\n" +
+	"\x13PaymentNoteDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12+\n" +
+	"\x11original_filename\x18\x03 \x01(\tR\x10originalFilename\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x03R\bfileSize\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x06 \x01(\tR\tobjectKey\x12\x1f\n" +
+	"\vuploaded_by\x18\a \x01(\x03R\n" +
+	"uploadedBy\x12(\n" +
+	"\x10uploaded_by_name\x18\b \x01(\tR\x0euploadedByName\x12\x1d\n" +
 	"\n" +
-	"Pagination\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1f\n" +
-	"\vtotal_items\x18\x03 \x01(\x03R\n" +
-	"totalItems\x12\x1f\n" +
-	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages2\xe9\x11\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xa5\x01\n" +
+	"\x19PaymentNoteDocumentUpload\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12+\n" +
+	"\x11original_filename\x18\x02 \x01(\tR\x10originalFilename\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12!\n" +
+	"\ffile_content\x18\x04 \x01(\fR\vfileContent2\xae\x13\n" +
 	"\x12PaymentNoteService\x12~\n" +
 	"\x10ListPaymentNotes\x12$.paymentnote.ListPaymentNotesRequest\x1a%.paymentnote.ListPaymentNotesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/payment-notes\x12\x8f\x01\n" +
 	"\x15ListDraftPaymentNotes\x12).paymentnote.ListDraftPaymentNotesRequest\x1a%.paymentnote.ListPaymentNotesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/payment-notes/drafts\x12z\n" +
@@ -2683,7 +3179,8 @@ const file_paymentnote_proto_rawDesc = "" +
 	"\x14UpdatePaymentNoteUtr\x12(.paymentnote.UpdatePaymentNoteUtrRequest\x1a .paymentnote.PaymentNoteResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/payment-notes/{id}/utr\x12\x99\x01\n" +
 	"\x1eGeneratePaymentNoteOrderNumber\x12\x16.google.protobuf.Empty\x1a3.paymentnote.GeneratePaymentNoteOrderNumberResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/payment-notes/order-number\x12\x99\x01\n" +
 	"\x16DownloadPaymentNotePdf\x12*.paymentnote.DownloadPaymentNotePdfRequest\x1a+.paymentnote.DownloadPaymentNotePdfResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/payment-notes/{id}/pdf\x12\xa3\x01\n" +
-	"\x1eCreatePaymentNoteForSuperAdmin\x122.paymentnote.CreatePaymentNoteForSuperAdminRequest\x1a .paymentnote.PaymentNoteResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/payment-notes/superadmin\x12y\n" +
+	"\x1eCreatePaymentNoteForSuperAdmin\x122.paymentnote.CreatePaymentNoteForSuperAdminRequest\x1a .paymentnote.PaymentNoteResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/payment-notes/superadmin\x12\xc2\x01\n" +
+	"\x1dCountOrganizationPaymentNotes\x121.paymentnote.CountOrganizationPaymentNotesRequest\x1a2.paymentnote.CountOrganizationPaymentNotesResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/organizations/{org_id}/payment-notes/count\x12y\n" +
 	"\x12TestPaymentNoteAPI\x12\x16.google.protobuf.Empty\x1a'.paymentnote.TestPaymentNoteAPIResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/payment-notes/testB'Z%nhit/services/paymentnote;paymentnoteb\x06proto3"
 
 var (
@@ -2698,109 +3195,128 @@ func file_paymentnote_proto_rawDescGZIP() []byte {
 	return file_paymentnote_proto_rawDescData
 }
 
-var file_paymentnote_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_paymentnote_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_paymentnote_proto_goTypes = []any{
-	(*ListPaymentNotesRequest)(nil),                // 0: paymentnote.ListPaymentNotesRequest
-	(*ListDraftPaymentNotesRequest)(nil),           // 1: paymentnote.ListDraftPaymentNotesRequest
-	(*GetPaymentNoteRequest)(nil),                  // 2: paymentnote.GetPaymentNoteRequest
-	(*CreatePaymentNoteRequest)(nil),               // 3: paymentnote.CreatePaymentNoteRequest
-	(*UpdatePaymentNoteRequest)(nil),               // 4: paymentnote.UpdatePaymentNoteRequest
-	(*DeletePaymentNoteRequest)(nil),               // 5: paymentnote.DeletePaymentNoteRequest
-	(*CreateDraftFromGreenNoteRequest)(nil),        // 6: paymentnote.CreateDraftFromGreenNoteRequest
-	(*ConvertDraftToActiveRequest)(nil),            // 7: paymentnote.ConvertDraftToActiveRequest
-	(*DeleteDraftPaymentNoteRequest)(nil),          // 8: paymentnote.DeleteDraftPaymentNoteRequest
-	(*PutPaymentNoteOnHoldRequest)(nil),            // 9: paymentnote.PutPaymentNoteOnHoldRequest
-	(*RemovePaymentNoteFromHoldRequest)(nil),       // 10: paymentnote.RemovePaymentNoteFromHoldRequest
-	(*UpdatePaymentNoteUtrRequest)(nil),            // 11: paymentnote.UpdatePaymentNoteUtrRequest
-	(*DownloadPaymentNotePdfRequest)(nil),          // 12: paymentnote.DownloadPaymentNotePdfRequest
-	(*CreatePaymentNoteForSuperAdminRequest)(nil),  // 13: paymentnote.CreatePaymentNoteForSuperAdminRequest
-	(*PaymentNotePayload)(nil),                     // 14: paymentnote.PaymentNotePayload
-	(*PaymentParticular)(nil),                      // 15: paymentnote.PaymentParticular
-	(*ListPaymentNotesResponse)(nil),               // 16: paymentnote.ListPaymentNotesResponse
-	(*PaymentNoteResponse)(nil),                    // 17: paymentnote.PaymentNoteResponse
-	(*GeneratePaymentNoteOrderNumberResponse)(nil), // 18: paymentnote.GeneratePaymentNoteOrderNumberResponse
-	(*DownloadPaymentNotePdfResponse)(nil),         // 19: paymentnote.DownloadPaymentNotePdfResponse
-	(*TestPaymentNoteAPIResponse)(nil),             // 20: paymentnote.TestPaymentNoteAPIResponse
-	(*PaymentNoteSummary)(nil),                     // 21: paymentnote.PaymentNoteSummary
-	(*PaymentNote)(nil),                            // 22: paymentnote.PaymentNote
-	(*HoldDetails)(nil),                            // 23: paymentnote.HoldDetails
-	(*PaymentApprovalLog)(nil),                     // 24: paymentnote.PaymentApprovalLog
-	(*PaymentApprovalPriority)(nil),                // 25: paymentnote.PaymentApprovalPriority
-	(*PaymentNoteComment)(nil),                     // 26: paymentnote.PaymentNoteComment
-	(*GreenNoteReference)(nil),                     // 27: paymentnote.GreenNoteReference
-	(*ReimbursementNoteReference)(nil),             // 28: paymentnote.ReimbursementNoteReference
-	(*RelatedUser)(nil),                            // 29: paymentnote.RelatedUser
-	(*RelatedVendor)(nil),                          // 30: paymentnote.RelatedVendor
-	(*RelatedSupplier)(nil),                        // 31: paymentnote.RelatedSupplier
-	(*Pagination)(nil),                             // 32: paymentnote.Pagination
-	(*emptypb.Empty)(nil),                          // 33: google.protobuf.Empty
+	(*ListPaymentNotesRequest)(nil),               // 0: paymentnote.ListPaymentNotesRequest
+	(*ListDraftPaymentNotesRequest)(nil),          // 1: paymentnote.ListDraftPaymentNotesRequest
+	(*GetPaymentNoteRequest)(nil),                 // 2: paymentnote.GetPaymentNoteRequest
+	(*CreatePaymentNoteRequest)(nil),              // 3: paymentnote.CreatePaymentNoteRequest
+	(*UpdatePaymentNoteRequest)(nil),              // 4: paymentnote.UpdatePaymentNoteRequest
+	(*DeletePaymentNoteRequest)(nil),              // 5: paymentnote.DeletePaymentNoteRequest
+	(*CreateDraftFromGreenNoteRequest)(nil),       // 6: paymentnote.CreateDraftFromGreenNoteRequest
+	(*ConvertDraftToActiveRequest)(nil),           // 7: paymentnote.ConvertDraftToActiveRequest
+	(*DeleteDraftPaymentNoteRequest)(nil),         // 8: paymentnote.DeleteDraftPaymentNoteRequest
+	(*PutPaymentNoteOnHoldRequest)(nil),           // 9: paymentnote.PutPaymentNoteOnHoldRequest
+	(*RemovePaymentNoteFromHoldRequest)(nil),      // 10: paymentnote.RemovePaymentNoteFromHoldRequest
+	(*UpdatePaymentNoteUtrRequest)(nil),           // 11: paymentnote.UpdatePaymentNoteUtrRequest
+	(*DownloadPaymentNotePdfRequest)(nil),         // 12: paymentnote.DownloadPaymentNotePdfRequest
+	(*CreatePaymentNoteForSuperAdminRequest)(nil), // 13: paymentnote.CreatePaymentNoteForSuperAdminRequest
+	(*CountOrganizationPaymentNotesRequest)(nil),  // 14: paymentnote.CountOrganizationPaymentNotesRequest
+	(*Money)(nil),                                    // 15: paymentnote.Money
+	(*PaymentNotePayload)(nil),                       // 16: paymentnote.PaymentNotePayload
+	(*PaymentParticular)(nil),                        // 17: paymentnote.PaymentParticular
+	(*ListPaymentNotesResponse)(nil),                 // 18: paymentnote.ListPaymentNotesResponse
+	(*PaymentNoteResponse)(nil),                      // 19: paymentnote.PaymentNoteResponse
+	(*GeneratePaymentNoteOrderNumberResponse)(nil),   // 20: paymentnote.GeneratePaymentNoteOrderNumberResponse
+	(*DownloadPaymentNotePdfResponse)(nil),           // 21: paymentnote.DownloadPaymentNotePdfResponse
+	(*CountOrganizationPaymentNotesResponse)(nil),    // 22: paymentnote.CountOrganizationPaymentNotesResponse
+	(*TestPaymentNoteAPIResponse)(nil),               // 23: paymentnote.TestPaymentNoteAPIResponse
+	(*PaymentNoteSummary)(nil),                       // 24: paymentnote.PaymentNoteSummary
+	(*PaymentNote)(nil),                              // 25: paymentnote.PaymentNote
+	(*PaymentApprovalLog)(nil),                       // 26: paymentnote.PaymentApprovalLog
+	(*PaymentNoteComment)(nil),                       // 27: paymentnote.PaymentNoteComment
+	(*PaymentNoteDocument)(nil),                      // 28: paymentnote.PaymentNoteDocument
+	(*PaymentNoteDocumentUpload)(nil),                // 29: paymentnote.PaymentNoteDocumentUpload
+	(*common.Pagination)(nil),                        // 30: common.Pagination
+	(*common.PaymentGreenNoteReference)(nil),         // 31: common.PaymentGreenNoteReference
+	(*common.PaymentReimbursementNoteReference)(nil), // 32: common.PaymentReimbursementNoteReference
+	(*common.RelatedUser)(nil),                       // 33: common.RelatedUser
+	(*common.HoldDetails)(nil),                       // 34: common.HoldDetails
+	(*common.PaymentApprovalPriority)(nil),           // 35: common.PaymentApprovalPriority
+	(*emptypb.Empty)(nil),                            // 36: google.protobuf.Empty
 }
 var file_paymentnote_proto_depIdxs = []int32{
-	14, // 0: paymentnote.CreatePaymentNoteRequest.note:type_name -> paymentnote.PaymentNotePayload
-	14, // 1: paymentnote.UpdatePaymentNoteRequest.note:type_name -> paymentnote.PaymentNotePayload
-	15, // 2: paymentnote.PaymentNotePayload.add_particulars:type_name -> paymentnote.PaymentParticular
-	15, // 3: paymentnote.PaymentNotePayload.less_particulars:type_name -> paymentnote.PaymentParticular
-	21, // 4: paymentnote.ListPaymentNotesResponse.notes:type_name -> paymentnote.PaymentNoteSummary
-	32, // 5: paymentnote.ListPaymentNotesResponse.pagination:type_name -> paymentnote.Pagination
-	22, // 6: paymentnote.PaymentNoteResponse.note:type_name -> paymentnote.PaymentNote
-	27, // 7: paymentnote.PaymentNoteSummary.green_note:type_name -> paymentnote.GreenNoteReference
-	28, // 8: paymentnote.PaymentNoteSummary.reimbursement_note:type_name -> paymentnote.ReimbursementNoteReference
-	29, // 9: paymentnote.PaymentNoteSummary.owner:type_name -> paymentnote.RelatedUser
-	15, // 10: paymentnote.PaymentNote.add_particulars:type_name -> paymentnote.PaymentParticular
-	15, // 11: paymentnote.PaymentNote.less_particulars:type_name -> paymentnote.PaymentParticular
-	23, // 12: paymentnote.PaymentNote.hold:type_name -> paymentnote.HoldDetails
-	24, // 13: paymentnote.PaymentNote.approval_logs:type_name -> paymentnote.PaymentApprovalLog
-	26, // 14: paymentnote.PaymentNote.comments:type_name -> paymentnote.PaymentNoteComment
-	27, // 15: paymentnote.PaymentNote.green_note:type_name -> paymentnote.GreenNoteReference
-	28, // 16: paymentnote.PaymentNote.reimbursement_note:type_name -> paymentnote.ReimbursementNoteReference
-	29, // 17: paymentnote.PaymentNote.owner:type_name -> paymentnote.RelatedUser
-	29, // 18: paymentnote.PaymentNote.created_by_user:type_name -> paymentnote.RelatedUser
-	29, // 19: paymentnote.HoldDetails.applied_by:type_name -> paymentnote.RelatedUser
-	29, // 20: paymentnote.PaymentApprovalLog.reviewer:type_name -> paymentnote.RelatedUser
-	25, // 21: paymentnote.PaymentApprovalLog.priorities:type_name -> paymentnote.PaymentApprovalPriority
-	29, // 22: paymentnote.PaymentApprovalPriority.reviewer:type_name -> paymentnote.RelatedUser
-	29, // 23: paymentnote.PaymentNoteComment.user:type_name -> paymentnote.RelatedUser
-	30, // 24: paymentnote.GreenNoteReference.vendor:type_name -> paymentnote.RelatedVendor
-	31, // 25: paymentnote.GreenNoteReference.supplier:type_name -> paymentnote.RelatedSupplier
-	29, // 26: paymentnote.ReimbursementNoteReference.select_user:type_name -> paymentnote.RelatedUser
-	29, // 27: paymentnote.ReimbursementNoteReference.owner:type_name -> paymentnote.RelatedUser
-	0,  // 28: paymentnote.PaymentNoteService.ListPaymentNotes:input_type -> paymentnote.ListPaymentNotesRequest
-	1,  // 29: paymentnote.PaymentNoteService.ListDraftPaymentNotes:input_type -> paymentnote.ListDraftPaymentNotesRequest
-	2,  // 30: paymentnote.PaymentNoteService.GetPaymentNote:input_type -> paymentnote.GetPaymentNoteRequest
-	3,  // 31: paymentnote.PaymentNoteService.CreatePaymentNote:input_type -> paymentnote.CreatePaymentNoteRequest
-	4,  // 32: paymentnote.PaymentNoteService.UpdatePaymentNote:input_type -> paymentnote.UpdatePaymentNoteRequest
-	5,  // 33: paymentnote.PaymentNoteService.DeletePaymentNote:input_type -> paymentnote.DeletePaymentNoteRequest
-	6,  // 34: paymentnote.PaymentNoteService.CreateDraftFromGreenNote:input_type -> paymentnote.CreateDraftFromGreenNoteRequest
-	7,  // 35: paymentnote.PaymentNoteService.ConvertDraftToActive:input_type -> paymentnote.ConvertDraftToActiveRequest
-	8,  // 36: paymentnote.PaymentNoteService.DeleteDraftPaymentNote:input_type -> paymentnote.DeleteDraftPaymentNoteRequest
-	9,  // 37: paymentnote.PaymentNoteService.PutPaymentNoteOnHold:input_type -> paymentnote.PutPaymentNoteOnHoldRequest
-	10, // 38: paymentnote.PaymentNoteService.RemovePaymentNoteFromHold:input_type -> paymentnote.RemovePaymentNoteFromHoldRequest
-	11, // 39: paymentnote.PaymentNoteService.UpdatePaymentNoteUtr:input_type -> paymentnote.UpdatePaymentNoteUtrRequest
-	33, // 40: paymentnote.PaymentNoteService.GeneratePaymentNoteOrderNumber:input_type -> google.protobuf.Empty
-	12, // 41: paymentnote.PaymentNoteService.DownloadPaymentNotePdf:input_type -> paymentnote.DownloadPaymentNotePdfRequest
-	13, // 42: paymentnote.PaymentNoteService.CreatePaymentNoteForSuperAdmin:input_type -> paymentnote.CreatePaymentNoteForSuperAdminRequest
-	33, // 43: paymentnote.PaymentNoteService.TestPaymentNoteAPI:input_type -> google.protobuf.Empty
-	16, // 44: paymentnote.PaymentNoteService.ListPaymentNotes:output_type -> paymentnote.ListPaymentNotesResponse
-	16, // 45: paymentnote.PaymentNoteService.ListDraftPaymentNotes:output_type -> paymentnote.ListPaymentNotesResponse
-	17, // 46: paymentnote.PaymentNoteService.GetPaymentNote:output_type -> paymentnote.PaymentNoteResponse
-	17, // 47: paymentnote.PaymentNoteService.CreatePaymentNote:output_type -> paymentnote.PaymentNoteResponse
-	17, // 48: paymentnote.PaymentNoteService.UpdatePaymentNote:output_type -> paymentnote.PaymentNoteResponse
-	33, // 49: paymentnote.PaymentNoteService.DeletePaymentNote:output_type -> google.protobuf.Empty
-	17, // 50: paymentnote.PaymentNoteService.CreateDraftFromGreenNote:output_type -> paymentnote.PaymentNoteResponse
-	17, // 51: paymentnote.PaymentNoteService.ConvertDraftToActive:output_type -> paymentnote.PaymentNoteResponse
-	33, // 52: paymentnote.PaymentNoteService.DeleteDraftPaymentNote:output_type -> google.protobuf.Empty
-	17, // 53: paymentnote.PaymentNoteService.PutPaymentNoteOnHold:output_type -> paymentnote.PaymentNoteResponse
-	17, // 54: paymentnote.PaymentNoteService.RemovePaymentNoteFromHold:output_type -> paymentnote.PaymentNoteResponse
-	17, // 55: paymentnote.PaymentNoteService.UpdatePaymentNoteUtr:output_type -> paymentnote.PaymentNoteResponse
-	18, // 56: paymentnote.PaymentNoteService.GeneratePaymentNoteOrderNumber:output_type -> paymentnote.GeneratePaymentNoteOrderNumberResponse
-	19, // 57: paymentnote.PaymentNoteService.DownloadPaymentNotePdf:output_type -> paymentnote.DownloadPaymentNotePdfResponse
-	17, // 58: paymentnote.PaymentNoteService.CreatePaymentNoteForSuperAdmin:output_type -> paymentnote.PaymentNoteResponse
-	20, // 59: paymentnote.PaymentNoteService.TestPaymentNoteAPI:output_type -> paymentnote.TestPaymentNoteAPIResponse
-	44, // [44:60] is the sub-list for method output_type
-	28, // [28:44] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	16, // 0: paymentnote.CreatePaymentNoteRequest.note:type_name -> paymentnote.PaymentNotePayload
+	16, // 1: paymentnote.UpdatePaymentNoteRequest.note:type_name -> paymentnote.PaymentNotePayload
+	17, // 2: paymentnote.PaymentNotePayload.add_particulars:type_name -> paymentnote.PaymentParticular
+	17, // 3: paymentnote.PaymentNotePayload.less_particulars:type_name -> paymentnote.PaymentParticular
+	29, // 4: paymentnote.PaymentNotePayload.new_documents:type_name -> paymentnote.PaymentNoteDocumentUpload
+	15, // 5: paymentnote.PaymentNotePayload.invoice_amount_exact:type_name -> paymentnote.Money
+	15, // 6: paymentnote.PaymentNotePayload.loa_po_amount_exact:type_name -> paymentnote.Money
+	15, // 7: paymentnote.PaymentNotePayload.gross_amount_exact:type_name -> paymentnote.Money
+	15, // 8: paymentnote.PaymentNotePayload.total_additions_exact:type_name -> paymentnote.Money
+	15, // 9: paymentnote.PaymentNotePayload.total_deductions_exact:type_name -> paymentnote.Money
+	15, // 10: paymentnote.PaymentNotePayload.net_payable_amount_exact:type_name -> paymentnote.Money
+	15, // 11: paymentnote.PaymentNotePayload.net_payable_round_off_exact:type_name -> paymentnote.Money
+	15, // 12: paymentnote.PaymentNotePayload.tds_amount_exact:type_name -> paymentnote.Money
+	15, // 13: paymentnote.PaymentParticular.amount_exact:type_name -> paymentnote.Money
+	24, // 14: paymentnote.ListPaymentNotesResponse.notes:type_name -> paymentnote.PaymentNoteSummary
+	30, // 15: paymentnote.ListPaymentNotesResponse.pagination:type_name -> common.Pagination
+	25, // 16: paymentnote.PaymentNoteResponse.note:type_name -> paymentnote.PaymentNote
+	31, // 17: paymentnote.PaymentNoteSummary.green_note:type_name -> common.PaymentGreenNoteReference
+	32, // 18: paymentnote.PaymentNoteSummary.reimbursement_note:type_name -> common.PaymentReimbursementNoteReference
+	33, // 19: paymentnote.PaymentNoteSummary.owner:type_name -> common.RelatedUser
+	15, // 20: paymentnote.PaymentNoteSummary.net_payable_round_off_exact:type_name -> paymentnote.Money
+	17, // 21: paymentnote.PaymentNote.add_particulars:type_name -> paymentnote.PaymentParticular
+	17, // 22: paymentnote.PaymentNote.less_particulars:type_name -> paymentnote.PaymentParticular
+	34, // 23: paymentnote.PaymentNote.hold:type_name -> common.HoldDetails
+	26, // 24: paymentnote.PaymentNote.approval_logs:type_name -> paymentnote.PaymentApprovalLog
+	27, // 25: paymentnote.PaymentNote.comments:type_name -> paymentnote.PaymentNoteComment
+	28, // 26: paymentnote.PaymentNote.documents:type_name -> paymentnote.PaymentNoteDocument
+	31, // 27: paymentnote.PaymentNote.green_note:type_name -> common.PaymentGreenNoteReference
+	32, // 28: paymentnote.PaymentNote.reimbursement_note:type_name -> common.PaymentReimbursementNoteReference
+	33, // 29: paymentnote.PaymentNote.owner:type_name -> common.RelatedUser
+	33, // 30: paymentnote.PaymentNote.created_by_user:type_name -> common.RelatedUser
+	15, // 31: paymentnote.PaymentNote.invoice_amount_exact:type_name -> paymentnote.Money
+	15, // 32: paymentnote.PaymentNote.loa_po_amount_exact:type_name -> paymentnote.Money
+	15, // 33: paymentnote.PaymentNote.gross_amount_exact:type_name -> paymentnote.Money
+	15, // 34: paymentnote.PaymentNote.total_additions_exact:type_name -> paymentnote.Money
+	15, // 35: paymentnote.PaymentNote.total_deductions_exact:type_name -> paymentnote.Money
+	15, // 36: paymentnote.PaymentNote.net_payable_amount_exact:type_name -> paymentnote.Money
+	15, // 37: paymentnote.PaymentNote.net_payable_round_off_exact:type_name -> paymentnote.Money
+	15, // 38: paymentnote.PaymentNote.tds_amount_exact:type_name -> paymentnote.Money
+	33, // 39: paymentnote.PaymentApprovalLog.reviewer:type_name -> common.RelatedUser
+	35, // 40: paymentnote.PaymentApprovalLog.priorities:type_name -> common.PaymentApprovalPriority
+	33, // 41: paymentnote.PaymentNoteComment.user:type_name -> common.RelatedUser
+	0,  // 42: paymentnote.PaymentNoteService.ListPaymentNotes:input_type -> paymentnote.ListPaymentNotesRequest
+	1,  // 43: paymentnote.PaymentNoteService.ListDraftPaymentNotes:input_type -> paymentnote.ListDraftPaymentNotesRequest
+	2,  // 44: paymentnote.PaymentNoteService.GetPaymentNote:input_type -> paymentnote.GetPaymentNoteRequest
+	3,  // 45: paymentnote.PaymentNoteService.CreatePaymentNote:input_type -> paymentnote.CreatePaymentNoteRequest
+	4,  // 46: paymentnote.PaymentNoteService.UpdatePaymentNote:input_type -> paymentnote.UpdatePaymentNoteRequest
+	5,  // 47: paymentnote.PaymentNoteService.DeletePaymentNote:input_type -> paymentnote.DeletePaymentNoteRequest
+	6,  // 48: paymentnote.PaymentNoteService.CreateDraftFromGreenNote:input_type -> paymentnote.CreateDraftFromGreenNoteRequest
+	7,  // 49: paymentnote.PaymentNoteService.ConvertDraftToActive:input_type -> paymentnote.ConvertDraftToActiveRequest
+	8,  // 50: paymentnote.PaymentNoteService.DeleteDraftPaymentNote:input_type -> paymentnote.DeleteDraftPaymentNoteRequest
+	9,  // 51: paymentnote.PaymentNoteService.PutPaymentNoteOnHold:input_type -> paymentnote.PutPaymentNoteOnHoldRequest
+	10, // 52: paymentnote.PaymentNoteService.RemovePaymentNoteFromHold:input_type -> paymentnote.RemovePaymentNoteFromHoldRequest
+	11, // 53: paymentnote.PaymentNoteService.UpdatePaymentNoteUtr:input_type -> paymentnote.UpdatePaymentNoteUtrRequest
+	36, // 54: paymentnote.PaymentNoteService.GeneratePaymentNoteOrderNumber:input_type -> google.protobuf.Empty
+	12, // 55: paymentnote.PaymentNoteService.DownloadPaymentNotePdf:input_type -> paymentnote.DownloadPaymentNotePdfRequest
+	13, // 56: paymentnote.PaymentNoteService.CreatePaymentNoteForSuperAdmin:input_type -> paymentnote.CreatePaymentNoteForSuperAdminRequest
+	14, // 57: paymentnote.PaymentNoteService.CountOrganizationPaymentNotes:input_type -> paymentnote.CountOrganizationPaymentNotesRequest
+	36, // 58: paymentnote.PaymentNoteService.TestPaymentNoteAPI:input_type -> google.protobuf.Empty
+	18, // 59: paymentnote.PaymentNoteService.ListPaymentNotes:output_type -> paymentnote.ListPaymentNotesResponse
+	18, // 60: paymentnote.PaymentNoteService.ListDraftPaymentNotes:output_type -> paymentnote.ListPaymentNotesResponse
+	19, // 61: paymentnote.PaymentNoteService.GetPaymentNote:output_type -> paymentnote.PaymentNoteResponse
+	19, // 62: paymentnote.PaymentNoteService.CreatePaymentNote:output_type -> paymentnote.PaymentNoteResponse
+	19, // 63: paymentnote.PaymentNoteService.UpdatePaymentNote:output_type -> paymentnote.PaymentNoteResponse
+	36, // 64: paymentnote.PaymentNoteService.DeletePaymentNote:output_type -> google.protobuf.Empty
+	19, // 65: paymentnote.PaymentNoteService.CreateDraftFromGreenNote:output_type -> paymentnote.PaymentNoteResponse
+	19, // 66: paymentnote.PaymentNoteService.ConvertDraftToActive:output_type -> paymentnote.PaymentNoteResponse
+	36, // 67: paymentnote.PaymentNoteService.DeleteDraftPaymentNote:output_type -> google.protobuf.Empty
+	19, // 68: paymentnote.PaymentNoteService.PutPaymentNoteOnHold:output_type -> paymentnote.PaymentNoteResponse
+	19, // 69: paymentnote.PaymentNoteService.RemovePaymentNoteFromHold:output_type -> paymentnote.PaymentNoteResponse
+	19, // 70: paymentnote.PaymentNoteService.UpdatePaymentNoteUtr:output_type -> paymentnote.PaymentNoteResponse
+	20, // 71: paymentnote.PaymentNoteService.GeneratePaymentNoteOrderNumber:output_type -> paymentnote.GeneratePaymentNoteOrderNumberResponse
+	21, // 72: paymentnote.PaymentNoteService.DownloadPaymentNotePdf:output_type -> paymentnote.DownloadPaymentNotePdfResponse
+	19, // 73: paymentnote.PaymentNoteService.CreatePaymentNoteForSuperAdmin:output_type -> paymentnote.PaymentNoteResponse
+	22, // 74: paymentnote.PaymentNoteService.CountOrganizationPaymentNotes:output_type -> paymentnote.CountOrganizationPaymentNotesResponse
+	23, // 75: paymentnote.PaymentNoteService.TestPaymentNoteAPI:output_type -> paymentnote.TestPaymentNoteAPIResponse
+	59, // [59:76] is the sub-list for method output_type
+	42, // [42:59] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_paymentnote_proto_init() }
@@ -2814,7 +3330,7 @@ func file_paymentnote_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paymentnote_proto_rawDesc), len(file_paymentnote_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   33,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -603,6 +603,45 @@ func local_request_PaymentNoteService_CreatePaymentNoteForSuperAdmin_0(ctx conte
 	return msg, metadata, err
 }
 
+func request_PaymentNoteService_CountOrganizationPaymentNotes_0(ctx context.Context, marshaler runtime.Marshaler, client PaymentNoteServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CountOrganizationPaymentNotesRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	val, ok := pathParams["org_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "org_id")
+	}
+	protoReq.OrgId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "org_id", err)
+	}
+	msg, err := client.CountOrganizationPaymentNotes(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_PaymentNoteService_CountOrganizationPaymentNotes_0(ctx context.Context, marshaler runtime.Marshaler, server PaymentNoteServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CountOrganizationPaymentNotesRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["org_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "org_id")
+	}
+	protoReq.OrgId, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "org_id", err)
+	}
+	msg, err := server.CountOrganizationPaymentNotes(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_PaymentNoteService_TestPaymentNoteAPI_0(ctx context.Context, marshaler runtime.Marshaler, client PaymentNoteServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq emptypb.Empty
@@ -930,6 +969,26 @@ func RegisterPaymentNoteServiceHandlerServer(ctx context.Context, mux *runtime.S
 		}
 		forward_PaymentNoteService_CreatePaymentNoteForSuperAdmin_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_PaymentNoteService_CountOrganizationPaymentNotes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/paymentnote.PaymentNoteService/CountOrganizationPaymentNotes", runtime.WithHTTPPathPattern("/api/v1/organizations/{org_id}/payment-notes/count"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_PaymentNoteService_CountOrganizationPaymentNotes_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_PaymentNoteService_CountOrganizationPaymentNotes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_PaymentNoteService_TestPaymentNoteAPI_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -1245,6 +1304,23 @@ func RegisterPaymentNoteServiceHandlerClient(ctx context.Context, mux *runtime.S
 		}
 		forward_PaymentNoteService_CreatePaymentNoteForSuperAdmin_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_PaymentNoteService_CountOrganizationPaymentNotes_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/paymentnote.PaymentNoteService/CountOrganizationPaymentNotes", runtime.WithHTTPPathPattern("/api/v1/organizations/{org_id}/payment-notes/count"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_PaymentNoteService_CountOrganizationPaymentNotes_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_PaymentNoteService_CountOrganizationPaymentNotes_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_PaymentNoteService_TestPaymentNoteAPI_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -1281,6 +1357,7 @@ var (
 	pattern_PaymentNoteService_GeneratePaymentNoteOrderNumber_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "payment-notes", "order-number"}, ""))
 	pattern_PaymentNoteService_DownloadPaymentNotePdf_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"api", "v1", "payment-notes", "id", "pdf"}, ""))
 	pattern_PaymentNoteService_CreatePaymentNoteForSuperAdmin_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "payment-notes", "superadmin"}, ""))
+	pattern_PaymentNoteService_CountOrganizationPaymentNotes_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4, 2, 5}, []string{"api", "v1", "organizations", "org_id", "payment-notes", "count"}, ""))
 	pattern_PaymentNoteService_TestPaymentNoteAPI_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "payment-notes", "test"}, ""))
 )
 
@@ -1300,5 +1377,6 @@ var (
 	forward_PaymentNoteService_GeneratePaymentNoteOrderNumber_0 = runtime.ForwardResponseMessage
 	forward_PaymentNoteService_DownloadPaymentNotePdf_0         = runtime.ForwardResponseMessage
 	forward_PaymentNoteService_CreatePaymentNoteForSuperAdmin_0 = runtime.ForwardResponseMessage
+	forward_PaymentNoteService_CountOrganizationPaymentNotes_0  = runtime.ForwardResponseMessage
 	forward_PaymentNoteService_TestPaymentNoteAPI_0             = runtime.ForwardResponseMessage
 )
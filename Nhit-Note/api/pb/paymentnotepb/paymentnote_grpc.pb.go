@@ -35,6 +35,7 @@ const (
 	PaymentNoteService_GeneratePaymentNoteOrderNumber_FullMethodName = "/paymentnote.PaymentNoteService/GeneratePaymentNoteOrderNumber"
 	PaymentNoteService_DownloadPaymentNotePdf_FullMethodName         = "/paymentnote.PaymentNoteService/DownloadPaymentNotePdf"
 	PaymentNoteService_CreatePaymentNoteForSuperAdmin_FullMethodName = "/paymentnote.PaymentNoteService/CreatePaymentNoteForSuperAdmin"
+	PaymentNoteService_CountOrganizationPaymentNotes_FullMethodName  = "/paymentnote.PaymentNoteService/CountOrganizationPaymentNotes"
 	PaymentNoteService_TestPaymentNoteAPI_FullMethodName             = "/paymentnote.PaymentNoteService/TestPaymentNoteAPI"
 )
 
@@ -74,6 +75,9 @@ type PaymentNoteServiceClient interface {
 	DownloadPaymentNotePdf(ctx context.Context, in *DownloadPaymentNotePdfRequest, opts ...grpc.CallOption) (*DownloadPaymentNotePdfResponse, error)
 	// Super admin creation helper (PaymentNoteController@storeForSuperAdmin)
 	CreatePaymentNoteForSuperAdmin(ctx context.Context, in *CreatePaymentNoteForSuperAdminRequest, opts ...grpc.CallOption) (*PaymentNoteResponse, error)
+	// Count an organization's payment notes, drafts included, for the
+	// organization purge check
+	CountOrganizationPaymentNotes(ctx context.Context, in *CountOrganizationPaymentNotesRequest, opts ...grpc.CallOption) (*CountOrganizationPaymentNotesResponse, error)
 	// Simple gRPC health/test endpoint for automated tests
 	TestPaymentNoteAPI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TestPaymentNoteAPIResponse, error)
 }
@@ -236,6 +240,16 @@ func (c *paymentNoteServiceClient) CreatePaymentNoteForSuperAdmin(ctx context.Co
 	return out, nil
 }
 
+func (c *paymentNoteServiceClient) CountOrganizationPaymentNotes(ctx context.Context, in *CountOrganizationPaymentNotesRequest, opts ...grpc.CallOption) (*CountOrganizationPaymentNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountOrganizationPaymentNotesResponse)
+	err := c.cc.Invoke(ctx, PaymentNoteService_CountOrganizationPaymentNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentNoteServiceClient) TestPaymentNoteAPI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TestPaymentNoteAPIResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TestPaymentNoteAPIResponse)
@@ -282,6 +296,9 @@ type PaymentNoteServiceServer interface {
 	DownloadPaymentNotePdf(context.Context, *DownloadPaymentNotePdfRequest) (*DownloadPaymentNotePdfResponse, error)
 	// Super admin creation helper (PaymentNoteController@storeForSuperAdmin)
 	CreatePaymentNoteForSuperAdmin(context.Context, *CreatePaymentNoteForSuperAdminRequest) (*PaymentNoteResponse, error)
+	// Count an organization's payment notes, drafts included, for the
+	// organization purge check
+	CountOrganizationPaymentNotes(context.Context, *CountOrganizationPaymentNotesRequest) (*CountOrganizationPaymentNotesResponse, error)
 	// Simple gRPC health/test endpoint for automated tests
 	TestPaymentNoteAPI(context.Context, *emptypb.Empty) (*TestPaymentNoteAPIResponse, error)
 	mustEmbedUnimplementedPaymentNoteServiceServer()
@@ -339,6 +356,9 @@ func (UnimplementedPaymentNoteServiceServer) DownloadPaymentNotePdf(context.Cont
 func (UnimplementedPaymentNoteServiceServer) CreatePaymentNoteForSuperAdmin(context.Context, *CreatePaymentNoteForSuperAdminRequest) (*PaymentNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentNoteForSuperAdmin not implemented")
 }
+func (UnimplementedPaymentNoteServiceServer) CountOrganizationPaymentNotes(context.Context, *CountOrganizationPaymentNotesRequest) (*CountOrganizationPaymentNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountOrganizationPaymentNotes not implemented")
+}
 func (UnimplementedPaymentNoteServiceServer) TestPaymentNoteAPI(context.Context, *emptypb.Empty) (*TestPaymentNoteAPIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestPaymentNoteAPI not implemented")
 }
@@ -633,6 +653,24 @@ func _PaymentNoteService_CreatePaymentNoteForSuperAdmin_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentNoteService_CountOrganizationPaymentNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountOrganizationPaymentNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentNoteServiceServer).CountOrganizationPaymentNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentNoteService_CountOrganizationPaymentNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentNoteServiceServer).CountOrganizationPaymentNotes(ctx, req.(*CountOrganizationPaymentNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentNoteService_TestPaymentNoteAPI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -718,6 +756,10 @@ var PaymentNoteService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePaymentNoteForSuperAdmin",
 			Handler:    _PaymentNoteService_CreatePaymentNoteForSuperAdmin_Handler,
 		},
+		{
+			MethodName: "CountOrganizationPaymentNotes",
+			Handler:    _PaymentNoteService_CountOrganizationPaymentNotes_Handler,
+		},
 		{
 			MethodName: "TestPaymentNoteAPI",
 			Handler:    _PaymentNoteService_TestPaymentNoteAPI_Handler,
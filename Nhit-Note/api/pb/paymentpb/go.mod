@@ -0,0 +1,21 @@
+module nhit-note/api/pb/paymentpb
+
+go 1.24.2
+
+require (
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3
+	google.golang.org/genproto/googleapis/api v0.0.0-20251022142026-3a174f9686a8
+	google.golang.org/grpc v1.77.0
+	google.golang.org/protobuf v1.36.10
+)
+
+require (
+	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
+	golang.org/x/sys v0.37.0 // indirect
+	golang.org/x/text v0.30.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251022142026-3a174f9686a8 // indirect
+)
+
+require nhit-note/api/pb/common v0.0.0
+
+replace nhit-note/api/pb/common => ../common
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	common "nhit-note/api/pb/common"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -1101,12 +1102,13 @@ func (x *CreateBankLetterFromNotesRequest) GetActorId() int64 {
 }
 
 type CreateBankLetterFromNotesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SlNo          string                 `protobuf:"bytes,1,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
-	PaymentsCount int32                  `protobuf:"varint,2,opt,name=payments_count,json=paymentsCount,proto3" json:"payments_count,omitempty"`
-	TotalAmount   float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SlNo             string                 `protobuf:"bytes,1,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
+	PaymentsCount    int32                  `protobuf:"varint,2,opt,name=payments_count,json=paymentsCount,proto3" json:"payments_count,omitempty"`
+	TotalAmount      float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalAmountExact *Money                 `protobuf:"bytes,4,opt,name=total_amount_exact,json=totalAmountExact,proto3" json:"total_amount_exact,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateBankLetterFromNotesResponse) Reset() {
@@ -1160,6 +1162,13 @@ func (x *CreateBankLetterFromNotesResponse) GetTotalAmount() float64 {
 	return 0
 }
 
+func (x *CreateBankLetterFromNotesResponse) GetTotalAmountExact() *Money {
+	if x != nil {
+		return x.TotalAmountExact
+	}
+	return nil
+}
+
 type ProcessBankLetterLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SlNo          string                 `protobuf:"bytes,1,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
@@ -1324,6 +1333,118 @@ func (x *GeneratePaymentSerialNumberResponse) GetSlNo() string {
 	return ""
 }
 
+type CountOrganizationPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountOrganizationPaymentsRequest) Reset() {
+	*x = CountOrganizationPaymentsRequest{}
+	mi := &file_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountOrganizationPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOrganizationPaymentsRequest) ProtoMessage() {}
+
+func (x *CountOrganizationPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOrganizationPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*CountOrganizationPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CountOrganizationPaymentsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// ---------- MONEY ----------
+// Money is an exact rupee amount with two decimal places. value is the
+// decimal string ("1234.50"); units and nanos carry the same amount like
+// google.type.Money. On input value wins over units/nanos when both are set.
+//
+// Amount doubles have a Money twin named <field>_exact. Requests may set
+// either; when the twin is set the double is ignored. Responses always set
+// both, so clients reading doubles keep working.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Money) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
 // ---------- SHARED STRUCTURES ----------
 type PaymentRequestItem struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
@@ -1340,13 +1461,14 @@ type PaymentRequestItem struct {
 	Amount                   float64                `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount,omitempty"`
 	Purpose                  string                 `protobuf:"bytes,12,opt,name=purpose,proto3" json:"purpose,omitempty"`
 	PaymentNoteId            int64                  `protobuf:"varint,13,opt,name=payment_note_id,json=paymentNoteId,proto3" json:"payment_note_id,omitempty"`
+	AmountExact              *Money                 `protobuf:"bytes,14,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *PaymentRequestItem) Reset() {
 	*x = PaymentRequestItem{}
-	mi := &file_payment_proto_msgTypes[21]
+	mi := &file_payment_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +1480,7 @@ func (x *PaymentRequestItem) String() string {
 func (*PaymentRequestItem) ProtoMessage() {}
 
 func (x *PaymentRequestItem) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[21]
+	mi := &file_payment_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +1493,7 @@ func (x *PaymentRequestItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentRequestItem.ProtoReflect.Descriptor instead.
 func (*PaymentRequestItem) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{21}
+	return file_payment_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PaymentRequestItem) GetTemplateType() string {
@@ -1465,6 +1587,13 @@ func (x *PaymentRequestItem) GetPaymentNoteId() int64 {
 	return 0
 }
 
+func (x *PaymentRequestItem) GetAmountExact() *Money {
+	if x != nil {
+		return x.AmountExact
+	}
+	return nil
+}
+
 type PaymentUpdateItem struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	Id                       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1481,13 +1610,14 @@ type PaymentUpdateItem struct {
 	Amount                   float64                `protobuf:"fixed64,12,opt,name=amount,proto3" json:"amount,omitempty"`
 	Purpose                  string                 `protobuf:"bytes,13,opt,name=purpose,proto3" json:"purpose,omitempty"`
 	Status                   string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	AmountExact              *Money                 `protobuf:"bytes,15,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *PaymentUpdateItem) Reset() {
 	*x = PaymentUpdateItem{}
-	mi := &file_payment_proto_msgTypes[22]
+	mi := &file_payment_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1499,7 +1629,7 @@ func (x *PaymentUpdateItem) String() string {
 func (*PaymentUpdateItem) ProtoMessage() {}
 
 func (x *PaymentUpdateItem) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[22]
+	mi := &file_payment_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,7 +1642,7 @@ func (x *PaymentUpdateItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentUpdateItem.ProtoReflect.Descriptor instead.
 func (*PaymentUpdateItem) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{22}
+	return file_payment_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PaymentUpdateItem) GetId() int64 {
@@ -1613,6 +1743,13 @@ func (x *PaymentUpdateItem) GetStatus() string {
 	return ""
 }
 
+func (x *PaymentUpdateItem) GetAmountExact() *Money {
+	if x != nil {
+		return x.AmountExact
+	}
+	return nil
+}
+
 type PaymentShortcut struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1627,7 +1764,7 @@ type PaymentShortcut struct {
 
 func (x *PaymentShortcut) Reset() {
 	*x = PaymentShortcut{}
-	mi := &file_payment_proto_msgTypes[23]
+	mi := &file_payment_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1639,7 +1776,7 @@ func (x *PaymentShortcut) String() string {
 func (*PaymentShortcut) ProtoMessage() {}
 
 func (x *PaymentShortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[23]
+	mi := &file_payment_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1652,7 +1789,7 @@ func (x *PaymentShortcut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentShortcut.ProtoReflect.Descriptor instead.
 func (*PaymentShortcut) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{23}
+	return file_payment_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PaymentShortcut) GetId() int64 {
@@ -1706,7 +1843,7 @@ type PaymentShortcutResponse struct {
 
 func (x *PaymentShortcutResponse) Reset() {
 	*x = PaymentShortcutResponse{}
-	mi := &file_payment_proto_msgTypes[24]
+	mi := &file_payment_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1718,7 +1855,7 @@ func (x *PaymentShortcutResponse) String() string {
 func (*PaymentShortcutResponse) ProtoMessage() {}
 
 func (x *PaymentShortcutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[24]
+	mi := &file_payment_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,7 +1868,7 @@ func (x *PaymentShortcutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentShortcutResponse.ProtoReflect.Descriptor instead.
 func (*PaymentShortcutResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{24}
+	return file_payment_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PaymentShortcutResponse) GetShortcut() *PaymentShortcut {
@@ -1742,24 +1879,25 @@ func (x *PaymentShortcutResponse) GetShortcut() *PaymentShortcut {
 }
 
 type PaymentGroup struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	SlNo               string                 `protobuf:"bytes,1,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
-	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	TemplateType       string                 `protobuf:"bytes,3,opt,name=template_type,json=templateType,proto3" json:"template_type,omitempty"`
-	NetPayableRoundOff float64                `protobuf:"fixed64,4,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
-	CreatedAt          string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Owner              *RelatedUser           `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
-	PaymentNote        *PaymentNoteReference  `protobuf:"bytes,8,opt,name=payment_note,json=paymentNote,proto3" json:"payment_note,omitempty"`
-	Payments           []*Payment             `protobuf:"bytes,9,rep,name=payments,proto3" json:"payments,omitempty"`
-	Shortcut           *PaymentShortcut       `protobuf:"bytes,10,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                   protoimpl.MessageState       `protogen:"open.v1"`
+	SlNo                    string                       `protobuf:"bytes,1,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
+	Status                  string                       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	TemplateType            string                       `protobuf:"bytes,3,opt,name=template_type,json=templateType,proto3" json:"template_type,omitempty"`
+	NetPayableRoundOff      float64                      `protobuf:"fixed64,4,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
+	CreatedAt               string                       `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               string                       `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Owner                   *common.RelatedUser          `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	PaymentNote             *common.PaymentNoteReference `protobuf:"bytes,8,opt,name=payment_note,json=paymentNote,proto3" json:"payment_note,omitempty"`
+	Payments                []*Payment                   `protobuf:"bytes,9,rep,name=payments,proto3" json:"payments,omitempty"`
+	Shortcut                *PaymentShortcut             `protobuf:"bytes,10,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	NetPayableRoundOffExact *Money                       `protobuf:"bytes,11,opt,name=net_payable_round_off_exact,json=netPayableRoundOffExact,proto3" json:"net_payable_round_off_exact,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *PaymentGroup) Reset() {
 	*x = PaymentGroup{}
-	mi := &file_payment_proto_msgTypes[25]
+	mi := &file_payment_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1771,7 +1909,7 @@ func (x *PaymentGroup) String() string {
 func (*PaymentGroup) ProtoMessage() {}
 
 func (x *PaymentGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[25]
+	mi := &file_payment_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,7 +1922,7 @@ func (x *PaymentGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentGroup.ProtoReflect.Descriptor instead.
 func (*PaymentGroup) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{25}
+	return file_payment_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PaymentGroup) GetSlNo() string {
@@ -1829,14 +1967,14 @@ func (x *PaymentGroup) GetUpdatedAt() string {
 	return ""
 }
 
-func (x *PaymentGroup) GetOwner() *RelatedUser {
+func (x *PaymentGroup) GetOwner() *common.RelatedUser {
 	if x != nil {
 		return x.Owner
 	}
 	return nil
 }
 
-func (x *PaymentGroup) GetPaymentNote() *PaymentNoteReference {
+func (x *PaymentGroup) GetPaymentNote() *common.PaymentNoteReference {
 	if x != nil {
 		return x.PaymentNote
 	}
@@ -1857,36 +1995,44 @@ func (x *PaymentGroup) GetShortcut() *PaymentShortcut {
 	return nil
 }
 
+func (x *PaymentGroup) GetNetPayableRoundOffExact() *Money {
+	if x != nil {
+		return x.NetPayableRoundOffExact
+	}
+	return nil
+}
+
 type Payment struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SlNo              string                 `protobuf:"bytes,2,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
-	TemplateType      string                 `protobuf:"bytes,3,opt,name=template_type,json=templateType,proto3" json:"template_type,omitempty"`
-	Project           string                 `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
-	AccountFullName   string                 `protobuf:"bytes,5,opt,name=account_full_name,json=accountFullName,proto3" json:"account_full_name,omitempty"`
-	FromAccountType   string                 `protobuf:"bytes,6,opt,name=from_account_type,json=fromAccountType,proto3" json:"from_account_type,omitempty"`
-	FullAccountNumber string                 `protobuf:"bytes,7,opt,name=full_account_number,json=fullAccountNumber,proto3" json:"full_account_number,omitempty"`
-	To                string                 `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
-	ToAccountType     string                 `protobuf:"bytes,9,opt,name=to_account_type,json=toAccountType,proto3" json:"to_account_type,omitempty"`
-	NameOfBeneficiary string                 `protobuf:"bytes,10,opt,name=name_of_beneficiary,json=nameOfBeneficiary,proto3" json:"name_of_beneficiary,omitempty"`
-	AccountNumber     string                 `protobuf:"bytes,11,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	NameOfBank        string                 `protobuf:"bytes,12,opt,name=name_of_bank,json=nameOfBank,proto3" json:"name_of_bank,omitempty"`
-	IfscCode          string                 `protobuf:"bytes,13,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
-	Amount            float64                `protobuf:"fixed64,14,opt,name=amount,proto3" json:"amount,omitempty"`
-	Purpose           string                 `protobuf:"bytes,15,opt,name=purpose,proto3" json:"purpose,omitempty"`
-	Status            string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`
-	UserId            int64                  `protobuf:"varint,17,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PaymentNoteId     int64                  `protobuf:"varint,18,opt,name=payment_note_id,json=paymentNoteId,proto3" json:"payment_note_id,omitempty"`
-	CreatedAt         string                 `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         string                 `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	PaymentNote       *PaymentNoteReference  `protobuf:"bytes,21,opt,name=payment_note,json=paymentNote,proto3" json:"payment_note,omitempty"`
+	state             protoimpl.MessageState       `protogen:"open.v1"`
+	Id                int64                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlNo              string                       `protobuf:"bytes,2,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
+	TemplateType      string                       `protobuf:"bytes,3,opt,name=template_type,json=templateType,proto3" json:"template_type,omitempty"`
+	Project           string                       `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	AccountFullName   string                       `protobuf:"bytes,5,opt,name=account_full_name,json=accountFullName,proto3" json:"account_full_name,omitempty"`
+	FromAccountType   string                       `protobuf:"bytes,6,opt,name=from_account_type,json=fromAccountType,proto3" json:"from_account_type,omitempty"`
+	FullAccountNumber string                       `protobuf:"bytes,7,opt,name=full_account_number,json=fullAccountNumber,proto3" json:"full_account_number,omitempty"`
+	To                string                       `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+	ToAccountType     string                       `protobuf:"bytes,9,opt,name=to_account_type,json=toAccountType,proto3" json:"to_account_type,omitempty"`
+	NameOfBeneficiary string                       `protobuf:"bytes,10,opt,name=name_of_beneficiary,json=nameOfBeneficiary,proto3" json:"name_of_beneficiary,omitempty"`
+	AccountNumber     string                       `protobuf:"bytes,11,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	NameOfBank        string                       `protobuf:"bytes,12,opt,name=name_of_bank,json=nameOfBank,proto3" json:"name_of_bank,omitempty"`
+	IfscCode          string                       `protobuf:"bytes,13,opt,name=ifsc_code,json=ifscCode,proto3" json:"ifsc_code,omitempty"`
+	Amount            float64                      `protobuf:"fixed64,14,opt,name=amount,proto3" json:"amount,omitempty"`
+	Purpose           string                       `protobuf:"bytes,15,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Status            string                       `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`
+	UserId            int64                        `protobuf:"varint,17,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaymentNoteId     int64                        `protobuf:"varint,18,opt,name=payment_note_id,json=paymentNoteId,proto3" json:"payment_note_id,omitempty"`
+	CreatedAt         string                       `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                       `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PaymentNote       *common.PaymentNoteReference `protobuf:"bytes,21,opt,name=payment_note,json=paymentNote,proto3" json:"payment_note,omitempty"`
+	AmountExact       *Money                       `protobuf:"bytes,22,opt,name=amount_exact,json=amountExact,proto3" json:"amount_exact,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Payment) Reset() {
 	*x = Payment{}
-	mi := &file_payment_proto_msgTypes[26]
+	mi := &file_payment_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1898,7 +2044,7 @@ func (x *Payment) String() string {
 func (*Payment) ProtoMessage() {}
 
 func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[26]
+	mi := &file_payment_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,7 +2057,7 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Payment.ProtoReflect.Descriptor instead.
 func (*Payment) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{26}
+	return file_payment_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Payment) GetId() int64 {
@@ -2054,317 +2200,40 @@ func (x *Payment) GetUpdatedAt() string {
 	return ""
 }
 
-func (x *Payment) GetPaymentNote() *PaymentNoteReference {
+func (x *Payment) GetPaymentNote() *common.PaymentNoteReference {
 	if x != nil {
 		return x.PaymentNote
 	}
 	return nil
 }
 
-type PaymentNoteReference struct {
-	state              protoimpl.MessageState      `protogen:"open.v1"`
-	Id                 int64                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NoteNo             string                      `protobuf:"bytes,2,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
-	NetPayableRoundOff float64                     `protobuf:"fixed64,3,opt,name=net_payable_round_off,json=netPayableRoundOff,proto3" json:"net_payable_round_off,omitempty"`
-	Status             string                      `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	GreenNote          *GreenNoteReference         `protobuf:"bytes,5,opt,name=green_note,json=greenNote,proto3" json:"green_note,omitempty"`
-	ReimbursementNote  *ReimbursementNoteReference `protobuf:"bytes,6,opt,name=reimbursement_note,json=reimbursementNote,proto3" json:"reimbursement_note,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *PaymentNoteReference) Reset() {
-	*x = PaymentNoteReference{}
-	mi := &file_payment_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PaymentNoteReference) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PaymentNoteReference) ProtoMessage() {}
-
-func (x *PaymentNoteReference) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[27]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PaymentNoteReference.ProtoReflect.Descriptor instead.
-func (*PaymentNoteReference) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *PaymentNoteReference) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *PaymentNoteReference) GetNoteNo() string {
-	if x != nil {
-		return x.NoteNo
-	}
-	return ""
-}
-
-func (x *PaymentNoteReference) GetNetPayableRoundOff() float64 {
-	if x != nil {
-		return x.NetPayableRoundOff
-	}
-	return 0
-}
-
-func (x *PaymentNoteReference) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *PaymentNoteReference) GetGreenNote() *GreenNoteReference {
-	if x != nil {
-		return x.GreenNote
-	}
-	return nil
-}
-
-func (x *PaymentNoteReference) GetReimbursementNote() *ReimbursementNoteReference {
+func (x *Payment) GetAmountExact() *Money {
 	if x != nil {
-		return x.ReimbursementNote
+		return x.AmountExact
 	}
 	return nil
 }
 
-type GreenNoteReference struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderNo       string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	VendorName    string                 `protobuf:"bytes,3,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
-	InvoiceValue  float64                `protobuf:"fixed64,4,opt,name=invoice_value,json=invoiceValue,proto3" json:"invoice_value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GreenNoteReference) Reset() {
-	*x = GreenNoteReference{}
-	mi := &file_payment_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GreenNoteReference) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GreenNoteReference) ProtoMessage() {}
-
-func (x *GreenNoteReference) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[28]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GreenNoteReference.ProtoReflect.Descriptor instead.
-func (*GreenNoteReference) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *GreenNoteReference) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *GreenNoteReference) GetOrderNo() string {
-	if x != nil {
-		return x.OrderNo
-	}
-	return ""
-}
-
-func (x *GreenNoteReference) GetVendorName() string {
-	if x != nil {
-		return x.VendorName
-	}
-	return ""
-}
-
-func (x *GreenNoteReference) GetInvoiceValue() float64 {
-	if x != nil {
-		return x.InvoiceValue
-	}
-	return 0
-}
-
-type ReimbursementNoteReference struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NoteNo        string                 `protobuf:"bytes,2,opt,name=note_no,json=noteNo,proto3" json:"note_no,omitempty"`
-	Project       string                 `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
-	Requester     string                 `protobuf:"bytes,4,opt,name=requester,proto3" json:"requester,omitempty"`
-	TotalPayable  float64                `protobuf:"fixed64,5,opt,name=total_payable,json=totalPayable,proto3" json:"total_payable,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ReimbursementNoteReference) Reset() {
-	*x = ReimbursementNoteReference{}
-	mi := &file_payment_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ReimbursementNoteReference) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReimbursementNoteReference) ProtoMessage() {}
-
-func (x *ReimbursementNoteReference) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[29]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReimbursementNoteReference.ProtoReflect.Descriptor instead.
-func (*ReimbursementNoteReference) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *ReimbursementNoteReference) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ReimbursementNoteReference) GetNoteNo() string {
-	if x != nil {
-		return x.NoteNo
-	}
-	return ""
-}
-
-func (x *ReimbursementNoteReference) GetProject() string {
-	if x != nil {
-		return x.Project
-	}
-	return ""
-}
-
-func (x *ReimbursementNoteReference) GetRequester() string {
-	if x != nil {
-		return x.Requester
-	}
-	return ""
-}
-
-func (x *ReimbursementNoteReference) GetTotalPayable() float64 {
-	if x != nil {
-		return x.TotalPayable
-	}
-	return 0
-}
-
-type RelatedUser struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RelatedUser) Reset() {
-	*x = RelatedUser{}
-	mi := &file_payment_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RelatedUser) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RelatedUser) ProtoMessage() {}
-
-func (x *RelatedUser) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[30]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RelatedUser.ProtoReflect.Descriptor instead.
-func (*RelatedUser) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *RelatedUser) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *RelatedUser) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RelatedUser) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
 type BankLetterApprovalLog struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Id            int64                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SlNo          string                        `protobuf:"bytes,2,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
-	Status        string                        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Comments      string                        `protobuf:"bytes,4,opt,name=comments,proto3" json:"comments,omitempty"`
-	Reviewer      *RelatedUser                  `protobuf:"bytes,5,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
-	CreatedAt     string                        `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Priorities    []*BankLetterApprovalPriority `protobuf:"bytes,7,rep,name=priorities,proto3" json:"priorities,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState            `protogen:"open.v1"`
+	Id         int64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlNo       string                            `protobuf:"bytes,2,opt,name=sl_no,json=slNo,proto3" json:"sl_no,omitempty"`
+	Status     string                            `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Comments   string                            `protobuf:"bytes,4,opt,name=comments,proto3" json:"comments,omitempty"`
+	Reviewer   *common.RelatedUser               `protobuf:"bytes,5,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	CreatedAt  string                            `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Priorities []*common.PaymentApprovalPriority `protobuf:"bytes,7,rep,name=priorities,proto3" json:"priorities,omitempty"`
+	// Set when the reviewer acted as the delegate of an approver on leave
+	OnBehalfOfId   string `protobuf:"bytes,8,opt,name=on_behalf_of_id,json=onBehalfOfId,proto3" json:"on_behalf_of_id,omitempty"`
+	OnBehalfOfName string `protobuf:"bytes,9,opt,name=on_behalf_of_name,json=onBehalfOfName,proto3" json:"on_behalf_of_name,omitempty"`
+	DelegationId   string `protobuf:"bytes,10,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BankLetterApprovalLog) Reset() {
 	*x = BankLetterApprovalLog{}
-	mi := &file_payment_proto_msgTypes[31]
+	mi := &file_payment_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2376,7 +2245,7 @@ func (x *BankLetterApprovalLog) String() string {
 func (*BankLetterApprovalLog) ProtoMessage() {}
 
 func (x *BankLetterApprovalLog) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[31]
+	mi := &file_payment_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +2258,7 @@ func (x *BankLetterApprovalLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BankLetterApprovalLog.ProtoReflect.Descriptor instead.
 func (*BankLetterApprovalLog) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{31}
+	return file_payment_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BankLetterApprovalLog) GetId() int64 {
@@ -2420,7 +2289,7 @@ func (x *BankLetterApprovalLog) GetComments() string {
 	return ""
 }
 
-func (x *BankLetterApprovalLog) GetReviewer() *RelatedUser {
+func (x *BankLetterApprovalLog) GetReviewer() *common.RelatedUser {
 	if x != nil {
 		return x.Reviewer
 	}
@@ -2434,71 +2303,32 @@ func (x *BankLetterApprovalLog) GetCreatedAt() string {
 	return ""
 }
 
-func (x *BankLetterApprovalLog) GetPriorities() []*BankLetterApprovalPriority {
+func (x *BankLetterApprovalLog) GetPriorities() []*common.PaymentApprovalPriority {
 	if x != nil {
 		return x.Priorities
 	}
 	return nil
 }
 
-type BankLetterApprovalPriority struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApproverLevel int32                  `protobuf:"varint,2,opt,name=approver_level,json=approverLevel,proto3" json:"approver_level,omitempty"`
-	Reviewer      *RelatedUser           `protobuf:"bytes,3,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BankLetterApprovalPriority) Reset() {
-	*x = BankLetterApprovalPriority{}
-	mi := &file_payment_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BankLetterApprovalPriority) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BankLetterApprovalPriority) ProtoMessage() {}
-
-func (x *BankLetterApprovalPriority) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[32]
+func (x *BankLetterApprovalLog) GetOnBehalfOfId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OnBehalfOfId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BankLetterApprovalPriority.ProtoReflect.Descriptor instead.
-func (*BankLetterApprovalPriority) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *BankLetterApprovalPriority) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+	return ""
 }
 
-func (x *BankLetterApprovalPriority) GetApproverLevel() int32 {
+func (x *BankLetterApprovalLog) GetOnBehalfOfName() string {
 	if x != nil {
-		return x.ApproverLevel
+		return x.OnBehalfOfName
 	}
-	return 0
+	return ""
 }
 
-func (x *BankLetterApprovalPriority) GetReviewer() *RelatedUser {
+func (x *BankLetterApprovalLog) GetDelegationId() string {
 	if x != nil {
-		return x.Reviewer
+		return x.DelegationId
 	}
-	return nil
+	return ""
 }
 
 type VendorInfo struct {
@@ -2523,7 +2353,7 @@ type VendorInfo struct {
 
 func (x *VendorInfo) Reset() {
 	*x = VendorInfo{}
-	mi := &file_payment_proto_msgTypes[33]
+	mi := &file_payment_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2535,7 +2365,7 @@ func (x *VendorInfo) String() string {
 func (*VendorInfo) ProtoMessage() {}
 
 func (x *VendorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[33]
+	mi := &file_payment_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2548,7 +2378,7 @@ func (x *VendorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VendorInfo.ProtoReflect.Descriptor instead.
 func (*VendorInfo) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{33}
+	return file_payment_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *VendorInfo) GetId() int64 {
@@ -2659,7 +2489,7 @@ type AccountOption struct {
 
 func (x *AccountOption) Reset() {
 	*x = AccountOption{}
-	mi := &file_payment_proto_msgTypes[34]
+	mi := &file_payment_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2671,7 +2501,7 @@ func (x *AccountOption) String() string {
 func (*AccountOption) ProtoMessage() {}
 
 func (x *AccountOption) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[34]
+	mi := &file_payment_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +2514,7 @@ func (x *AccountOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountOption.ProtoReflect.Descriptor instead.
 func (*AccountOption) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{34}
+	return file_payment_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AccountOption) GetName() string {
@@ -2710,7 +2540,7 @@ type CartSnapshotResponse struct {
 
 func (x *CartSnapshotResponse) Reset() {
 	*x = CartSnapshotResponse{}
-	mi := &file_payment_proto_msgTypes[35]
+	mi := &file_payment_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2722,7 +2552,7 @@ func (x *CartSnapshotResponse) String() string {
 func (*CartSnapshotResponse) ProtoMessage() {}
 
 func (x *CartSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[35]
+	mi := &file_payment_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2735,7 +2565,7 @@ func (x *CartSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*CartSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{35}
+	return file_payment_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CartSnapshotResponse) GetItems() []*PaymentRequestItem {
@@ -2749,14 +2579,14 @@ func (x *CartSnapshotResponse) GetItems() []*PaymentRequestItem {
 type ListPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Groups        []*PaymentGroup        `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination    *common.Pagination     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPaymentsResponse) Reset() {
 	*x = ListPaymentsResponse{}
-	mi := &file_payment_proto_msgTypes[36]
+	mi := &file_payment_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2768,7 +2598,7 @@ func (x *ListPaymentsResponse) String() string {
 func (*ListPaymentsResponse) ProtoMessage() {}
 
 func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[36]
+	mi := &file_payment_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2781,7 +2611,7 @@ func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
 func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{36}
+	return file_payment_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListPaymentsResponse) GetGroups() []*PaymentGroup {
@@ -2791,7 +2621,7 @@ func (x *ListPaymentsResponse) GetGroups() []*PaymentGroup {
 	return nil
 }
 
-func (x *ListPaymentsResponse) GetPagination() *Pagination {
+func (x *ListPaymentsResponse) GetPagination() *common.Pagination {
 	if x != nil {
 		return x.Pagination
 	}
@@ -2810,7 +2640,7 @@ type ListPaymentsDataTableResponse struct {
 
 func (x *ListPaymentsDataTableResponse) Reset() {
 	*x = ListPaymentsDataTableResponse{}
-	mi := &file_payment_proto_msgTypes[37]
+	mi := &file_payment_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2822,7 +2652,7 @@ func (x *ListPaymentsDataTableResponse) String() string {
 func (*ListPaymentsDataTableResponse) ProtoMessage() {}
 
 func (x *ListPaymentsDataTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[37]
+	mi := &file_payment_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,7 +2665,7 @@ func (x *ListPaymentsDataTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentsDataTableResponse.ProtoReflect.Descriptor instead.
 func (*ListPaymentsDataTableResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{37}
+	return file_payment_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListPaymentsDataTableResponse) GetDraw() int32 {
@@ -2875,7 +2705,7 @@ type PaymentGroupResponse struct {
 
 func (x *PaymentGroupResponse) Reset() {
 	*x = PaymentGroupResponse{}
-	mi := &file_payment_proto_msgTypes[38]
+	mi := &file_payment_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2887,7 +2717,7 @@ func (x *PaymentGroupResponse) String() string {
 func (*PaymentGroupResponse) ProtoMessage() {}
 
 func (x *PaymentGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[38]
+	mi := &file_payment_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,7 +2730,7 @@ func (x *PaymentGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentGroupResponse.ProtoReflect.Descriptor instead.
 func (*PaymentGroupResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{38}
+	return file_payment_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PaymentGroupResponse) GetGroup() *PaymentGroup {
@@ -2919,7 +2749,7 @@ type SearchVendorsResponse struct {
 
 func (x *SearchVendorsResponse) Reset() {
 	*x = SearchVendorsResponse{}
-	mi := &file_payment_proto_msgTypes[39]
+	mi := &file_payment_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2931,7 +2761,7 @@ func (x *SearchVendorsResponse) String() string {
 func (*SearchVendorsResponse) ProtoMessage() {}
 
 func (x *SearchVendorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[39]
+	mi := &file_payment_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,7 +2774,7 @@ func (x *SearchVendorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchVendorsResponse.ProtoReflect.Descriptor instead.
 func (*SearchVendorsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{39}
+	return file_payment_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SearchVendorsResponse) GetVendors() []*VendorInfo {
@@ -2963,7 +2793,7 @@ type SearchProjectsResponse struct {
 
 func (x *SearchProjectsResponse) Reset() {
 	*x = SearchProjectsResponse{}
-	mi := &file_payment_proto_msgTypes[40]
+	mi := &file_payment_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2975,7 +2805,7 @@ func (x *SearchProjectsResponse) String() string {
 func (*SearchProjectsResponse) ProtoMessage() {}
 
 func (x *SearchProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[40]
+	mi := &file_payment_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2988,7 +2818,7 @@ func (x *SearchProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchProjectsResponse.ProtoReflect.Descriptor instead.
 func (*SearchProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{40}
+	return file_payment_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SearchProjectsResponse) GetProjects() []string {
@@ -3007,7 +2837,7 @@ type GetFromAccountOptionsResponse struct {
 
 func (x *GetFromAccountOptionsResponse) Reset() {
 	*x = GetFromAccountOptionsResponse{}
-	mi := &file_payment_proto_msgTypes[41]
+	mi := &file_payment_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3019,7 +2849,7 @@ func (x *GetFromAccountOptionsResponse) String() string {
 func (*GetFromAccountOptionsResponse) ProtoMessage() {}
 
 func (x *GetFromAccountOptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[41]
+	mi := &file_payment_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,7 +2862,7 @@ func (x *GetFromAccountOptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFromAccountOptionsResponse.ProtoReflect.Descriptor instead.
 func (*GetFromAccountOptionsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{41}
+	return file_payment_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetFromAccountOptionsResponse) GetAccounts() []*AccountOption {
@@ -3042,30 +2872,28 @@ func (x *GetFromAccountOptionsResponse) GetAccounts() []*AccountOption {
 	return nil
 }
 
-type TestPaymentAPIResponse struct {
+type CountOrganizationPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`        // e.g. "ok"
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`      // Diagnostic string
-	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix epoch seconds
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestPaymentAPIResponse) Reset() {
-	*x = TestPaymentAPIResponse{}
-	mi := &file_payment_proto_msgTypes[42]
+func (x *CountOrganizationPaymentsResponse) Reset() {
+	*x = CountOrganizationPaymentsResponse{}
+	mi := &file_payment_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestPaymentAPIResponse) String() string {
+func (x *CountOrganizationPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestPaymentAPIResponse) ProtoMessage() {}
+func (*CountOrganizationPaymentsResponse) ProtoMessage() {}
 
-func (x *TestPaymentAPIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[42]
+func (x *CountOrganizationPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3076,57 +2904,42 @@ func (x *TestPaymentAPIResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestPaymentAPIResponse.ProtoReflect.Descriptor instead.
-func (*TestPaymentAPIResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *TestPaymentAPIResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *TestPaymentAPIResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use CountOrganizationPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*CountOrganizationPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TestPaymentAPIResponse) GetTimestamp() int64 {
+func (x *CountOrganizationPaymentsResponse) GetCount() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.Count
 	}
 	return 0
 }
 
-type Pagination struct {
+type TestPaymentAPIResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	TotalItems    int64                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`        // e.g. "ok"
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`      // Diagnostic string
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix epoch seconds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Pagination) Reset() {
-	*x = Pagination{}
-	mi := &file_payment_proto_msgTypes[43]
+func (x *TestPaymentAPIResponse) Reset() {
+	*x = TestPaymentAPIResponse{}
+	mi := &file_payment_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Pagination) String() string {
+func (x *TestPaymentAPIResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pagination) ProtoMessage() {}
+func (*TestPaymentAPIResponse) ProtoMessage() {}
 
-func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[43]
+func (x *TestPaymentAPIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,35 +2950,28 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
-func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *Pagination) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use TestPaymentAPIResponse.ProtoReflect.Descriptor instead.
+func (*TestPaymentAPIResponse) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Pagination) GetPerPage() int32 {
+func (x *TestPaymentAPIResponse) GetStatus() string {
 	if x != nil {
-		return x.PerPage
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *Pagination) GetTotalItems() int64 {
+func (x *TestPaymentAPIResponse) GetMessage() string {
 	if x != nil {
-		return x.TotalItems
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *Pagination) GetTotalPages() int32 {
+func (x *TestPaymentAPIResponse) GetTimestamp() int64 {
 	if x != nil {
-		return x.TotalPages
+		return x.Timestamp
 	}
 	return 0
 }
@@ -3174,7 +2980,7 @@ var File_payment_proto protoreflect.FileDescriptor
 
 const file_payment_proto_rawDesc = "" +
 	"\n" +
-	"\rpayment.proto\x12\apayment\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"\xfb\x02\n" +
+	"\rpayment.proto\x12\apayment\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\fcommon.proto\"\xfb\x02\n" +
 	"\x13ListPaymentsRequest\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vinclude_all\x18\x02 \x01(\bR\n" +
@@ -3262,11 +3068,12 @@ const file_payment_proto_rawDesc = "" +
 	"\bactor_id\x18\x02 \x01(\x03R\aactorId\"g\n" +
 	" CreateBankLetterFromNotesRequest\x12(\n" +
 	"\x10payment_note_ids\x18\x01 \x03(\x03R\x0epaymentNoteIds\x12\x19\n" +
-	"\bactor_id\x18\x02 \x01(\x03R\aactorId\"\x82\x01\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\"\xc0\x01\n" +
 	"!CreateBankLetterFromNotesResponse\x12\x13\n" +
 	"\x05sl_no\x18\x01 \x01(\tR\x04slNo\x12%\n" +
 	"\x0epayments_count\x18\x02 \x01(\x05R\rpaymentsCount\x12!\n" +
-	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\"\x85\x01\n" +
+	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\x12<\n" +
+	"\x12total_amount_exact\x18\x04 \x01(\v2\x0e.payment.MoneyR\x10totalAmountExact\"\x85\x01\n" +
 	"\x1bProcessBankLetterLogRequest\x12\x13\n" +
 	"\x05sl_no\x18\x01 \x01(\tR\x04slNo\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
@@ -3277,7 +3084,13 @@ const file_payment_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x120\n" +
 	"\x03log\x18\x02 \x01(\v2\x1e.payment.BankLetterApprovalLogR\x03log\":\n" +
 	"#GeneratePaymentSerialNumberResponse\x12\x13\n" +
-	"\x05sl_no\x18\x01 \x01(\tR\x04slNo\"\x97\x04\n" +
+	"\x05sl_no\x18\x01 \x01(\tR\x04slNo\"9\n" +
+	" CountOrganizationPaymentsRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"I\n" +
+	"\x05Money\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"\xca\x04\n" +
 	"\x12PaymentRequestItem\x12#\n" +
 	"\rtemplate_type\x18\x01 \x01(\tR\ftemplateType\x12\x18\n" +
 	"\aproject\x18\x02 \x01(\tR\aproject\x12*\n" +
@@ -3292,7 +3105,8 @@ const file_payment_proto_rawDesc = "" +
 	" \x01(\tR\bifscCode\x12\x16\n" +
 	"\x06amount\x18\v \x01(\x01R\x06amount\x12\x18\n" +
 	"\apurpose\x18\f \x01(\tR\apurpose\x12&\n" +
-	"\x0fpayment_note_id\x18\r \x01(\x03R\rpaymentNoteId\"\x96\x04\n" +
+	"\x0fpayment_note_id\x18\r \x01(\x03R\rpaymentNoteId\x121\n" +
+	"\famount_exact\x18\x0e \x01(\v2\x0e.payment.MoneyR\vamountExact\"\xc9\x04\n" +
 	"\x11PaymentUpdateItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\rtemplate_type\x18\x02 \x01(\tR\ftemplateType\x12\x18\n" +
@@ -3308,7 +3122,8 @@ const file_payment_proto_rawDesc = "" +
 	"\tifsc_code\x18\v \x01(\tR\bifscCode\x12\x16\n" +
 	"\x06amount\x18\f \x01(\x01R\x06amount\x12\x18\n" +
 	"\apurpose\x18\r \x01(\tR\apurpose\x12\x16\n" +
-	"\x06status\x18\x0e \x01(\tR\x06status\"\xc5\x01\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x121\n" +
+	"\famount_exact\x18\x0f \x01(\v2\x0e.payment.MoneyR\vamountExact\"\xc5\x01\n" +
 	"\x0fPaymentShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x13\n" +
 	"\x05sl_no\x18\x02 \x01(\tR\x04slNo\x12#\n" +
@@ -3319,7 +3134,7 @@ const file_payment_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"O\n" +
 	"\x17PaymentShortcutResponse\x124\n" +
-	"\bshortcut\x18\x01 \x01(\v2\x18.payment.PaymentShortcutR\bshortcut\"\xa3\x03\n" +
+	"\bshortcut\x18\x01 \x01(\v2\x18.payment.PaymentShortcutR\bshortcut\"\xef\x03\n" +
 	"\fPaymentGroup\x12\x13\n" +
 	"\x05sl_no\x18\x01 \x01(\tR\x04slNo\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
@@ -3328,12 +3143,13 @@ const file_payment_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12*\n" +
-	"\x05owner\x18\a \x01(\v2\x14.payment.RelatedUserR\x05owner\x12@\n" +
-	"\fpayment_note\x18\b \x01(\v2\x1d.payment.PaymentNoteReferenceR\vpaymentNote\x12,\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12)\n" +
+	"\x05owner\x18\a \x01(\v2\x13.common.RelatedUserR\x05owner\x12?\n" +
+	"\fpayment_note\x18\b \x01(\v2\x1c.common.PaymentNoteReferenceR\vpaymentNote\x12,\n" +
 	"\bpayments\x18\t \x03(\v2\x10.payment.PaymentR\bpayments\x124\n" +
 	"\bshortcut\x18\n" +
-	" \x01(\v2\x18.payment.PaymentShortcutR\bshortcut\"\xce\x05\n" +
+	" \x01(\v2\x18.payment.PaymentShortcutR\bshortcut\x12L\n" +
+	"\x1bnet_payable_round_off_exact\x18\v \x01(\v2\x0e.payment.MoneyR\x17netPayableRoundOffExact\"\x80\x06\n" +
 	"\aPayment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x13\n" +
 	"\x05sl_no\x18\x02 \x01(\tR\x04slNo\x12#\n" +
@@ -3358,47 +3174,24 @@ const file_payment_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x13 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x14 \x01(\tR\tupdatedAt\x12@\n" +
-	"\fpayment_note\x18\x15 \x01(\v2\x1d.payment.PaymentNoteReferenceR\vpaymentNote\"\x9a\x02\n" +
-	"\x14PaymentNoteReference\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
-	"\anote_no\x18\x02 \x01(\tR\x06noteNo\x121\n" +
-	"\x15net_payable_round_off\x18\x03 \x01(\x01R\x12netPayableRoundOff\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\x12:\n" +
-	"\n" +
-	"green_note\x18\x05 \x01(\v2\x1b.payment.GreenNoteReferenceR\tgreenNote\x12R\n" +
-	"\x12reimbursement_note\x18\x06 \x01(\v2#.payment.ReimbursementNoteReferenceR\x11reimbursementNote\"\x85\x01\n" +
-	"\x12GreenNoteReference\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\border_no\x18\x02 \x01(\tR\aorderNo\x12\x1f\n" +
-	"\vvendor_name\x18\x03 \x01(\tR\n" +
-	"vendorName\x12#\n" +
-	"\rinvoice_value\x18\x04 \x01(\x01R\finvoiceValue\"\xa2\x01\n" +
-	"\x1aReimbursementNoteReference\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
-	"\anote_no\x18\x02 \x01(\tR\x06noteNo\x12\x18\n" +
-	"\aproject\x18\x03 \x01(\tR\aproject\x12\x1c\n" +
-	"\trequester\x18\x04 \x01(\tR\trequester\x12#\n" +
-	"\rtotal_payable\x18\x05 \x01(\x01R\ftotalPayable\"G\n" +
-	"\vRelatedUser\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"\x86\x02\n" +
+	"updated_at\x18\x14 \x01(\tR\tupdatedAt\x12?\n" +
+	"\fpayment_note\x18\x15 \x01(\v2\x1c.common.PaymentNoteReferenceR\vpaymentNote\x121\n" +
+	"\famount_exact\x18\x16 \x01(\v2\x0e.payment.MoneyR\vamountExact\"\xf8\x02\n" +
 	"\x15BankLetterApprovalLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x13\n" +
 	"\x05sl_no\x18\x02 \x01(\tR\x04slNo\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
-	"\bcomments\x18\x04 \x01(\tR\bcomments\x120\n" +
-	"\breviewer\x18\x05 \x01(\v2\x14.payment.RelatedUserR\breviewer\x12\x1d\n" +
+	"\bcomments\x18\x04 \x01(\tR\bcomments\x12/\n" +
+	"\breviewer\x18\x05 \x01(\v2\x13.common.RelatedUserR\breviewer\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12C\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12?\n" +
 	"\n" +
-	"priorities\x18\a \x03(\v2#.payment.BankLetterApprovalPriorityR\n" +
-	"priorities\"\x85\x01\n" +
-	"\x1aBankLetterApprovalPriority\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
-	"\x0eapprover_level\x18\x02 \x01(\x05R\rapproverLevel\x120\n" +
-	"\breviewer\x18\x03 \x01(\v2\x14.payment.RelatedUserR\breviewer\"\xd5\x03\n" +
+	"priorities\x18\a \x03(\v2\x1f.common.PaymentApprovalPriorityR\n" +
+	"priorities\x12%\n" +
+	"\x0fon_behalf_of_id\x18\b \x01(\tR\fonBehalfOfId\x12)\n" +
+	"\x11on_behalf_of_name\x18\t \x01(\tR\x0eonBehalfOfName\x12#\n" +
+	"\rdelegation_id\x18\n" +
+	" \x01(\tR\fdelegationId\"\xd5\x03\n" +
 	"\n" +
 	"VendorInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
@@ -3425,11 +3218,11 @@ const file_payment_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\"I\n" +
 	"\x14CartSnapshotResponse\x121\n" +
-	"\x05items\x18\x01 \x03(\v2\x1b.payment.PaymentRequestItemR\x05items\"z\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.payment.PaymentRequestItemR\x05items\"y\n" +
 	"\x14ListPaymentsResponse\x12-\n" +
-	"\x06groups\x18\x01 \x03(\v2\x15.payment.PaymentGroupR\x06groups\x123\n" +
+	"\x06groups\x18\x01 \x03(\v2\x15.payment.PaymentGroupR\x06groups\x122\n" +
 	"\n" +
-	"pagination\x18\x02 \x01(\v2\x13.payment.PaginationR\n" +
+	"pagination\x18\x02 \x01(\v2\x12.common.PaginationR\n" +
 	"pagination\"\xba\x01\n" +
 	"\x1dListPaymentsDataTableResponse\x12\x12\n" +
 	"\x04draw\x18\x01 \x01(\x05R\x04draw\x12#\n" +
@@ -3443,19 +3236,13 @@ const file_payment_proto_rawDesc = "" +
 	"\x16SearchProjectsResponse\x12\x1a\n" +
 	"\bprojects\x18\x01 \x03(\tR\bprojects\"S\n" +
 	"\x1dGetFromAccountOptionsResponse\x122\n" +
-	"\baccounts\x18\x01 \x03(\v2\x16.payment.AccountOptionR\baccounts\"h\n" +
+	"\baccounts\x18\x01 \x03(\v2\x16.payment.AccountOptionR\baccounts\"9\n" +
+	"!CountOrganizationPaymentsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"h\n" +
 	"\x16TestPaymentAPIResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"}\n" +
-	"\n" +
-	"Pagination\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x1f\n" +
-	"\vtotal_items\x18\x03 \x01(\x03R\n" +
-	"totalItems\x12\x1f\n" +
-	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages2\xa6\x15\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp2\xd2\x16\n" +
 	"\x0ePaymentService\x12e\n" +
 	"\fListPayments\x12\x1c.payment.ListPaymentsRequest\x1a\x1d.payment.ListPaymentsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/payments\x12\x8a\x01\n" +
 	"\x15ListPaymentsDataTable\x12%.payment.ListPaymentsDataTableRequest\x1a&.payment.ListPaymentsDataTableResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/payments/datatable\x12s\n" +
@@ -3476,7 +3263,8 @@ const file_payment_proto_rawDesc = "" +
 	"\x16ExecutePaymentShortcut\x12&.payment.ExecutePaymentShortcutRequest\x1a\x1d.payment.PaymentGroupResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/payments/shortcuts/{shortcut_id}/execute\x12\x9c\x01\n" +
 	"\x19CreateBankLetterFromNotes\x12).payment.CreateBankLetterFromNotesRequest\x1a*.payment.CreateBankLetterFromNotesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/payments/bank-letters\x12\x99\x01\n" +
 	"\x14ProcessBankLetterLog\x12$.payment.ProcessBankLetterLogRequest\x1a%.payment.ProcessBankLetterLogResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/payments/bank-letters/{sl_no}/log\x12\x8a\x01\n" +
-	"\x1bGeneratePaymentSerialNumber\x12\x16.google.protobuf.Empty\x1a,.payment.GeneratePaymentSerialNumberResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/payments/order-number\x12h\n" +
+	"\x1bGeneratePaymentSerialNumber\x12\x16.google.protobuf.Empty\x1a,.payment.GeneratePaymentSerialNumberResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/payments/order-number\x12\xa9\x01\n" +
+	"\x19CountOrganizationPayments\x12).payment.CountOrganizationPaymentsRequest\x1a*.payment.CountOrganizationPaymentsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/organizations/{org_id}/payments/count\x12h\n" +
 	"\x0eTestPaymentAPI\x12\x16.google.protobuf.Empty\x1a\x1f.payment.TestPaymentAPIResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/payments/testB\x1fZ\x1dnhit/services/payment;paymentb\x06proto3"
 
 var (
@@ -3491,7 +3279,7 @@ func file_payment_proto_rawDescGZIP() []byte {
 	return file_payment_proto_rawDescData
 }
 
-var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_payment_proto_goTypes = []any{
 	(*ListPaymentsRequest)(nil),                 // 0: payment.ListPaymentsRequest
 	(*ListPaymentsDataTableRequest)(nil),        // 1: payment.ListPaymentsDataTableRequest
@@ -3514,102 +3302,107 @@ var file_payment_proto_goTypes = []any{
 	(*ProcessBankLetterLogRequest)(nil),         // 18: payment.ProcessBankLetterLogRequest
 	(*ProcessBankLetterLogResponse)(nil),        // 19: payment.ProcessBankLetterLogResponse
 	(*GeneratePaymentSerialNumberResponse)(nil), // 20: payment.GeneratePaymentSerialNumberResponse
-	(*PaymentRequestItem)(nil),                  // 21: payment.PaymentRequestItem
-	(*PaymentUpdateItem)(nil),                   // 22: payment.PaymentUpdateItem
-	(*PaymentShortcut)(nil),                     // 23: payment.PaymentShortcut
-	(*PaymentShortcutResponse)(nil),             // 24: payment.PaymentShortcutResponse
-	(*PaymentGroup)(nil),                        // 25: payment.PaymentGroup
-	(*Payment)(nil),                             // 26: payment.Payment
-	(*PaymentNoteReference)(nil),                // 27: payment.PaymentNoteReference
-	(*GreenNoteReference)(nil),                  // 28: payment.GreenNoteReference
-	(*ReimbursementNoteReference)(nil),          // 29: payment.ReimbursementNoteReference
-	(*RelatedUser)(nil),                         // 30: payment.RelatedUser
-	(*BankLetterApprovalLog)(nil),               // 31: payment.BankLetterApprovalLog
-	(*BankLetterApprovalPriority)(nil),          // 32: payment.BankLetterApprovalPriority
-	(*VendorInfo)(nil),                          // 33: payment.VendorInfo
-	(*AccountOption)(nil),                       // 34: payment.AccountOption
-	(*CartSnapshotResponse)(nil),                // 35: payment.CartSnapshotResponse
-	(*ListPaymentsResponse)(nil),                // 36: payment.ListPaymentsResponse
-	(*ListPaymentsDataTableResponse)(nil),       // 37: payment.ListPaymentsDataTableResponse
-	(*PaymentGroupResponse)(nil),                // 38: payment.PaymentGroupResponse
-	(*SearchVendorsResponse)(nil),               // 39: payment.SearchVendorsResponse
-	(*SearchProjectsResponse)(nil),              // 40: payment.SearchProjectsResponse
-	(*GetFromAccountOptionsResponse)(nil),       // 41: payment.GetFromAccountOptionsResponse
-	(*TestPaymentAPIResponse)(nil),              // 42: payment.TestPaymentAPIResponse
-	(*Pagination)(nil),                          // 43: payment.Pagination
-	(*emptypb.Empty)(nil),                       // 44: google.protobuf.Empty
+	(*CountOrganizationPaymentsRequest)(nil),    // 21: payment.CountOrganizationPaymentsRequest
+	(*Money)(nil),                               // 22: payment.Money
+	(*PaymentRequestItem)(nil),                  // 23: payment.PaymentRequestItem
+	(*PaymentUpdateItem)(nil),                   // 24: payment.PaymentUpdateItem
+	(*PaymentShortcut)(nil),                     // 25: payment.PaymentShortcut
+	(*PaymentShortcutResponse)(nil),             // 26: payment.PaymentShortcutResponse
+	(*PaymentGroup)(nil),                        // 27: payment.PaymentGroup
+	(*Payment)(nil),                             // 28: payment.Payment
+	(*BankLetterApprovalLog)(nil),               // 29: payment.BankLetterApprovalLog
+	(*VendorInfo)(nil),                          // 30: payment.VendorInfo
+	(*AccountOption)(nil),                       // 31: payment.AccountOption
+	(*CartSnapshotResponse)(nil),                // 32: payment.CartSnapshotResponse
+	(*ListPaymentsResponse)(nil),                // 33: payment.ListPaymentsResponse
+	(*ListPaymentsDataTableResponse)(nil),       // 34: payment.ListPaymentsDataTableResponse
+	(*PaymentGroupResponse)(nil),                // 35: payment.PaymentGroupResponse
+	(*SearchVendorsResponse)(nil),               // 36: payment.SearchVendorsResponse
+	(*SearchProjectsResponse)(nil),              // 37: payment.SearchProjectsResponse
+	(*GetFromAccountOptionsResponse)(nil),       // 38: payment.GetFromAccountOptionsResponse
+	(*CountOrganizationPaymentsResponse)(nil),   // 39: payment.CountOrganizationPaymentsResponse
+	(*TestPaymentAPIResponse)(nil),              // 40: payment.TestPaymentAPIResponse
+	(*common.RelatedUser)(nil),                  // 41: common.RelatedUser
+	(*common.PaymentNoteReference)(nil),         // 42: common.PaymentNoteReference
+	(*common.PaymentApprovalPriority)(nil),      // 43: common.PaymentApprovalPriority
+	(*common.Pagination)(nil),                   // 44: common.Pagination
+	(*emptypb.Empty)(nil),                       // 45: google.protobuf.Empty
 }
 var file_payment_proto_depIdxs = []int32{
-	21, // 0: payment.CreatePaymentRequestsRequest.vendors:type_name -> payment.PaymentRequestItem
-	22, // 1: payment.UpdatePaymentGroupRequest.vendors:type_name -> payment.PaymentUpdateItem
-	21, // 2: payment.AddRequestToQueueRequest.item:type_name -> payment.PaymentRequestItem
-	21, // 3: payment.CreatePaymentShortcutRequest.request_items:type_name -> payment.PaymentRequestItem
-	31, // 4: payment.ProcessBankLetterLogResponse.log:type_name -> payment.BankLetterApprovalLog
-	23, // 5: payment.PaymentShortcutResponse.shortcut:type_name -> payment.PaymentShortcut
-	30, // 6: payment.PaymentGroup.owner:type_name -> payment.RelatedUser
-	27, // 7: payment.PaymentGroup.payment_note:type_name -> payment.PaymentNoteReference
-	26, // 8: payment.PaymentGroup.payments:type_name -> payment.Payment
-	23, // 9: payment.PaymentGroup.shortcut:type_name -> payment.PaymentShortcut
-	27, // 10: payment.Payment.payment_note:type_name -> payment.PaymentNoteReference
-	28, // 11: payment.PaymentNoteReference.green_note:type_name -> payment.GreenNoteReference
-	29, // 12: payment.PaymentNoteReference.reimbursement_note:type_name -> payment.ReimbursementNoteReference
-	30, // 13: payment.BankLetterApprovalLog.reviewer:type_name -> payment.RelatedUser
-	32, // 14: payment.BankLetterApprovalLog.priorities:type_name -> payment.BankLetterApprovalPriority
-	30, // 15: payment.BankLetterApprovalPriority.reviewer:type_name -> payment.RelatedUser
-	21, // 16: payment.CartSnapshotResponse.items:type_name -> payment.PaymentRequestItem
-	25, // 17: payment.ListPaymentsResponse.groups:type_name -> payment.PaymentGroup
-	43, // 18: payment.ListPaymentsResponse.pagination:type_name -> payment.Pagination
-	26, // 19: payment.ListPaymentsDataTableResponse.payments:type_name -> payment.Payment
-	25, // 20: payment.PaymentGroupResponse.group:type_name -> payment.PaymentGroup
-	33, // 21: payment.SearchVendorsResponse.vendors:type_name -> payment.VendorInfo
-	34, // 22: payment.GetFromAccountOptionsResponse.accounts:type_name -> payment.AccountOption
-	0,  // 23: payment.PaymentService.ListPayments:input_type -> payment.ListPaymentsRequest
-	1,  // 24: payment.PaymentService.ListPaymentsDataTable:input_type -> payment.ListPaymentsDataTableRequest
-	2,  // 25: payment.PaymentService.GetPaymentGroup:input_type -> payment.GetPaymentGroupRequest
-	3,  // 26: payment.PaymentService.CreatePaymentRequests:input_type -> payment.CreatePaymentRequestsRequest
-	4,  // 27: payment.PaymentService.UpdatePaymentGroup:input_type -> payment.UpdatePaymentGroupRequest
-	5,  // 28: payment.PaymentService.DeletePayment:input_type -> payment.DeletePaymentRequest
-	6,  // 29: payment.PaymentService.DeletePaymentItem:input_type -> payment.DeletePaymentItemRequest
-	7,  // 30: payment.PaymentService.SearchVendors:input_type -> payment.SearchVendorsRequest
-	8,  // 31: payment.PaymentService.SearchInternalVendors:input_type -> payment.SearchInternalVendorsRequest
-	9,  // 32: payment.PaymentService.SearchProjects:input_type -> payment.SearchProjectsRequest
-	10, // 33: payment.PaymentService.GetFromAccountOptions:input_type -> payment.GetFromAccountOptionsRequest
-	44, // 34: payment.PaymentService.GetAllVendors:input_type -> google.protobuf.Empty
-	11, // 35: payment.PaymentService.AddRequestToQueue:input_type -> payment.AddRequestToQueueRequest
-	12, // 36: payment.PaymentService.RemoveRequestFromQueue:input_type -> payment.RemoveRequestFromQueueRequest
-	13, // 37: payment.PaymentService.GetQueueSnapshot:input_type -> payment.QueueSnapshotRequest
-	14, // 38: payment.PaymentService.CreatePaymentShortcut:input_type -> payment.CreatePaymentShortcutRequest
-	15, // 39: payment.PaymentService.ExecutePaymentShortcut:input_type -> payment.ExecutePaymentShortcutRequest
-	16, // 40: payment.PaymentService.CreateBankLetterFromNotes:input_type -> payment.CreateBankLetterFromNotesRequest
-	18, // 41: payment.PaymentService.ProcessBankLetterLog:input_type -> payment.ProcessBankLetterLogRequest
-	44, // 42: payment.PaymentService.GeneratePaymentSerialNumber:input_type -> google.protobuf.Empty
-	44, // 43: payment.PaymentService.TestPaymentAPI:input_type -> google.protobuf.Empty
-	36, // 44: payment.PaymentService.ListPayments:output_type -> payment.ListPaymentsResponse
-	37, // 45: payment.PaymentService.ListPaymentsDataTable:output_type -> payment.ListPaymentsDataTableResponse
-	38, // 46: payment.PaymentService.GetPaymentGroup:output_type -> payment.PaymentGroupResponse
-	38, // 47: payment.PaymentService.CreatePaymentRequests:output_type -> payment.PaymentGroupResponse
-	38, // 48: payment.PaymentService.UpdatePaymentGroup:output_type -> payment.PaymentGroupResponse
-	44, // 49: payment.PaymentService.DeletePayment:output_type -> google.protobuf.Empty
-	44, // 50: payment.PaymentService.DeletePaymentItem:output_type -> google.protobuf.Empty
-	39, // 51: payment.PaymentService.SearchVendors:output_type -> payment.SearchVendorsResponse
-	39, // 52: payment.PaymentService.SearchInternalVendors:output_type -> payment.SearchVendorsResponse
-	40, // 53: payment.PaymentService.SearchProjects:output_type -> payment.SearchProjectsResponse
-	41, // 54: payment.PaymentService.GetFromAccountOptions:output_type -> payment.GetFromAccountOptionsResponse
-	39, // 55: payment.PaymentService.GetAllVendors:output_type -> payment.SearchVendorsResponse
-	35, // 56: payment.PaymentService.AddRequestToQueue:output_type -> payment.CartSnapshotResponse
-	35, // 57: payment.PaymentService.RemoveRequestFromQueue:output_type -> payment.CartSnapshotResponse
-	35, // 58: payment.PaymentService.GetQueueSnapshot:output_type -> payment.CartSnapshotResponse
-	24, // 59: payment.PaymentService.CreatePaymentShortcut:output_type -> payment.PaymentShortcutResponse
-	38, // 60: payment.PaymentService.ExecutePaymentShortcut:output_type -> payment.PaymentGroupResponse
-	17, // 61: payment.PaymentService.CreateBankLetterFromNotes:output_type -> payment.CreateBankLetterFromNotesResponse
-	19, // 62: payment.PaymentService.ProcessBankLetterLog:output_type -> payment.ProcessBankLetterLogResponse
-	20, // 63: payment.PaymentService.GeneratePaymentSerialNumber:output_type -> payment.GeneratePaymentSerialNumberResponse
-	42, // 64: payment.PaymentService.TestPaymentAPI:output_type -> payment.TestPaymentAPIResponse
-	44, // [44:65] is the sub-list for method output_type
-	23, // [23:44] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	23, // 0: payment.CreatePaymentRequestsRequest.vendors:type_name -> payment.PaymentRequestItem
+	24, // 1: payment.UpdatePaymentGroupRequest.vendors:type_name -> payment.PaymentUpdateItem
+	23, // 2: payment.AddRequestToQueueRequest.item:type_name -> payment.PaymentRequestItem
+	23, // 3: payment.CreatePaymentShortcutRequest.request_items:type_name -> payment.PaymentRequestItem
+	22, // 4: payment.CreateBankLetterFromNotesResponse.total_amount_exact:type_name -> payment.Money
+	29, // 5: payment.ProcessBankLetterLogResponse.log:type_name -> payment.BankLetterApprovalLog
+	22, // 6: payment.PaymentRequestItem.amount_exact:type_name -> payment.Money
+	22, // 7: payment.PaymentUpdateItem.amount_exact:type_name -> payment.Money
+	25, // 8: payment.PaymentShortcutResponse.shortcut:type_name -> payment.PaymentShortcut
+	41, // 9: payment.PaymentGroup.owner:type_name -> common.RelatedUser
+	42, // 10: payment.PaymentGroup.payment_note:type_name -> common.PaymentNoteReference
+	28, // 11: payment.PaymentGroup.payments:type_name -> payment.Payment
+	25, // 12: payment.PaymentGroup.shortcut:type_name -> payment.PaymentShortcut
+	22, // 13: payment.PaymentGroup.net_payable_round_off_exact:type_name -> payment.Money
+	42, // 14: payment.Payment.payment_note:type_name -> common.PaymentNoteReference
+	22, // 15: payment.Payment.amount_exact:type_name -> payment.Money
+	41, // 16: payment.BankLetterApprovalLog.reviewer:type_name -> common.RelatedUser
+	43, // 17: payment.BankLetterApprovalLog.priorities:type_name -> common.PaymentApprovalPriority
+	23, // 18: payment.CartSnapshotResponse.items:type_name -> payment.PaymentRequestItem
+	27, // 19: payment.ListPaymentsResponse.groups:type_name -> payment.PaymentGroup
+	44, // 20: payment.ListPaymentsResponse.pagination:type_name -> common.Pagination
+	28, // 21: payment.ListPaymentsDataTableResponse.payments:type_name -> payment.Payment
+	27, // 22: payment.PaymentGroupResponse.group:type_name -> payment.PaymentGroup
+	30, // 23: payment.SearchVendorsResponse.vendors:type_name -> payment.VendorInfo
+	31, // 24: payment.GetFromAccountOptionsResponse.accounts:type_name -> payment.AccountOption
+	0,  // 25: payment.PaymentService.ListPayments:input_type -> payment.ListPaymentsRequest
+	1,  // 26: payment.PaymentService.ListPaymentsDataTable:input_type -> payment.ListPaymentsDataTableRequest
+	2,  // 27: payment.PaymentService.GetPaymentGroup:input_type -> payment.GetPaymentGroupRequest
+	3,  // 28: payment.PaymentService.CreatePaymentRequests:input_type -> payment.CreatePaymentRequestsRequest
+	4,  // 29: payment.PaymentService.UpdatePaymentGroup:input_type -> payment.UpdatePaymentGroupRequest
+	5,  // 30: payment.PaymentService.DeletePayment:input_type -> payment.DeletePaymentRequest
+	6,  // 31: payment.PaymentService.DeletePaymentItem:input_type -> payment.DeletePaymentItemRequest
+	7,  // 32: payment.PaymentService.SearchVendors:input_type -> payment.SearchVendorsRequest
+	8,  // 33: payment.PaymentService.SearchInternalVendors:input_type -> payment.SearchInternalVendorsRequest
+	9,  // 34: payment.PaymentService.SearchProjects:input_type -> payment.SearchProjectsRequest
+	10, // 35: payment.PaymentService.GetFromAccountOptions:input_type -> payment.GetFromAccountOptionsRequest
+	45, // 36: payment.PaymentService.GetAllVendors:input_type -> google.protobuf.Empty
+	11, // 37: payment.PaymentService.AddRequestToQueue:input_type -> payment.AddRequestToQueueRequest
+	12, // 38: payment.PaymentService.RemoveRequestFromQueue:input_type -> payment.RemoveRequestFromQueueRequest
+	13, // 39: payment.PaymentService.GetQueueSnapshot:input_type -> payment.QueueSnapshotRequest
+	14, // 40: payment.PaymentService.CreatePaymentShortcut:input_type -> payment.CreatePaymentShortcutRequest
+	15, // 41: payment.PaymentService.ExecutePaymentShortcut:input_type -> payment.ExecutePaymentShortcutRequest
+	16, // 42: payment.PaymentService.CreateBankLetterFromNotes:input_type -> payment.CreateBankLetterFromNotesRequest
+	18, // 43: payment.PaymentService.ProcessBankLetterLog:input_type -> payment.ProcessBankLetterLogRequest
+	45, // 44: payment.PaymentService.GeneratePaymentSerialNumber:input_type -> google.protobuf.Empty
+	21, // 45: payment.PaymentService.CountOrganizationPayments:input_type -> payment.CountOrganizationPaymentsRequest
+	45, // 46: payment.PaymentService.TestPaymentAPI:input_type -> google.protobuf.Empty
+	33, // 47: payment.PaymentService.ListPayments:output_type -> payment.ListPaymentsResponse
+	34, // 48: payment.PaymentService.ListPaymentsDataTable:output_type -> payment.ListPaymentsDataTableResponse
+	35, // 49: payment.PaymentService.GetPaymentGroup:output_type -> payment.PaymentGroupResponse
+	35, // 50: payment.PaymentService.CreatePaymentRequests:output_type -> payment.PaymentGroupResponse
+	35, // 51: payment.PaymentService.UpdatePaymentGroup:output_type -> payment.PaymentGroupResponse
+	45, // 52: payment.PaymentService.DeletePayment:output_type -> google.protobuf.Empty
+	45, // 53: payment.PaymentService.DeletePaymentItem:output_type -> google.protobuf.Empty
+	36, // 54: payment.PaymentService.SearchVendors:output_type -> payment.SearchVendorsResponse
+	36, // 55: payment.PaymentService.SearchInternalVendors:output_type -> payment.SearchVendorsResponse
+	37, // 56: payment.PaymentService.SearchProjects:output_type -> payment.SearchProjectsResponse
+	38, // 57: payment.PaymentService.GetFromAccountOptions:output_type -> payment.GetFromAccountOptionsResponse
+	36, // 58: payment.PaymentService.GetAllVendors:output_type -> payment.SearchVendorsResponse
+	32, // 59: payment.PaymentService.AddRequestToQueue:output_type -> payment.CartSnapshotResponse
+	32, // 60: payment.PaymentService.RemoveRequestFromQueue:output_type -> payment.CartSnapshotResponse
+	32, // 61: payment.PaymentService.GetQueueSnapshot:output_type -> payment.CartSnapshotResponse
+	26, // 62: payment.PaymentService.CreatePaymentShortcut:output_type -> payment.PaymentShortcutResponse
+	35, // 63: payment.PaymentService.ExecutePaymentShortcut:output_type -> payment.PaymentGroupResponse
+	17, // 64: payment.PaymentService.CreateBankLetterFromNotes:output_type -> payment.CreateBankLetterFromNotesResponse
+	19, // 65: payment.PaymentService.ProcessBankLetterLog:output_type -> payment.ProcessBankLetterLogResponse
+	20, // 66: payment.PaymentService.GeneratePaymentSerialNumber:output_type -> payment.GeneratePaymentSerialNumberResponse
+	39, // 67: payment.PaymentService.CountOrganizationPayments:output_type -> payment.CountOrganizationPaymentsResponse
+	40, // 68: payment.PaymentService.TestPaymentAPI:output_type -> payment.TestPaymentAPIResponse
+	47, // [47:69] is the sub-list for method output_type
+	25, // [25:47] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_payment_proto_init() }
@@ -3623,7 +3416,7 @@ func file_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_proto_rawDesc), len(file_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   44,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
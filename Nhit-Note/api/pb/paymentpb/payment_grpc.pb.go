@@ -40,6 +40,7 @@ const (
 	PaymentService_CreateBankLetterFromNotes_FullMethodName   = "/payment.PaymentService/CreateBankLetterFromNotes"
 	PaymentService_ProcessBankLetterLog_FullMethodName        = "/payment.PaymentService/ProcessBankLetterLog"
 	PaymentService_GeneratePaymentSerialNumber_FullMethodName = "/payment.PaymentService/GeneratePaymentSerialNumber"
+	PaymentService_CountOrganizationPayments_FullMethodName   = "/payment.PaymentService/CountOrganizationPayments"
 	PaymentService_TestPaymentAPI_FullMethodName              = "/payment.PaymentService/TestPaymentAPI"
 )
 
@@ -89,6 +90,9 @@ type PaymentServiceClient interface {
 	ProcessBankLetterLog(ctx context.Context, in *ProcessBankLetterLogRequest, opts ...grpc.CallOption) (*ProcessBankLetterLogResponse, error)
 	// Generate payment serial/order number (generateSerialNumber helper)
 	GeneratePaymentSerialNumber(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GeneratePaymentSerialNumberResponse, error)
+	// Count an organization's payments, drafts included, for the organization
+	// purge check
+	CountOrganizationPayments(ctx context.Context, in *CountOrganizationPaymentsRequest, opts ...grpc.CallOption) (*CountOrganizationPaymentsResponse, error)
 	// Simple gRPC health/test endpoint for automated tests
 	TestPaymentAPI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TestPaymentAPIResponse, error)
 }
@@ -301,6 +305,16 @@ func (c *paymentServiceClient) GeneratePaymentSerialNumber(ctx context.Context,
 	return out, nil
 }
 
+func (c *paymentServiceClient) CountOrganizationPayments(ctx context.Context, in *CountOrganizationPaymentsRequest, opts ...grpc.CallOption) (*CountOrganizationPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountOrganizationPaymentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CountOrganizationPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) TestPaymentAPI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TestPaymentAPIResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TestPaymentAPIResponse)
@@ -357,6 +371,9 @@ type PaymentServiceServer interface {
 	ProcessBankLetterLog(context.Context, *ProcessBankLetterLogRequest) (*ProcessBankLetterLogResponse, error)
 	// Generate payment serial/order number (generateSerialNumber helper)
 	GeneratePaymentSerialNumber(context.Context, *emptypb.Empty) (*GeneratePaymentSerialNumberResponse, error)
+	// Count an organization's payments, drafts included, for the organization
+	// purge check
+	CountOrganizationPayments(context.Context, *CountOrganizationPaymentsRequest) (*CountOrganizationPaymentsResponse, error)
 	// Simple gRPC health/test endpoint for automated tests
 	TestPaymentAPI(context.Context, *emptypb.Empty) (*TestPaymentAPIResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
@@ -429,6 +446,9 @@ func (UnimplementedPaymentServiceServer) ProcessBankLetterLog(context.Context, *
 func (UnimplementedPaymentServiceServer) GeneratePaymentSerialNumber(context.Context, *emptypb.Empty) (*GeneratePaymentSerialNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeneratePaymentSerialNumber not implemented")
 }
+func (UnimplementedPaymentServiceServer) CountOrganizationPayments(context.Context, *CountOrganizationPaymentsRequest) (*CountOrganizationPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountOrganizationPayments not implemented")
+}
 func (UnimplementedPaymentServiceServer) TestPaymentAPI(context.Context, *emptypb.Empty) (*TestPaymentAPIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestPaymentAPI not implemented")
 }
@@ -813,6 +833,24 @@ func _PaymentService_GeneratePaymentSerialNumber_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_CountOrganizationPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountOrganizationPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CountOrganizationPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CountOrganizationPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CountOrganizationPayments(ctx, req.(*CountOrganizationPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_TestPaymentAPI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -918,6 +956,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GeneratePaymentSerialNumber",
 			Handler:    _PaymentService_GeneratePaymentSerialNumber_Handler,
 		},
+		{
+			MethodName: "CountOrganizationPayments",
+			Handler:    _PaymentService_CountOrganizationPayments_Handler,
+		},
 		{
 			MethodName: "TestPaymentAPI",
 			Handler:    _PaymentService_TestPaymentAPI_Handler,
@@ -5,9 +5,13 @@ go 1.24.2
 require (
 	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb v0.0.0-00010101000000-000000000000
+	github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb v0.0.0-00010101000000-000000000000
 	github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb v0.0.0-00010101000000-000000000000
 	github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb v0.0.0-00010101000000-000000000000
+	github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/gstin v0.0.0
 	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/money v0.0.0
 	github.com/google/uuid v1.6.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3
 	github.com/joho/godotenv v1.5.1
@@ -50,6 +54,14 @@ replace github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb => "../NHIT Backend/a
 
 replace github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb => "../NHIT Backend/api/pb/departmentpb"
 
+replace github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb => "../NHIT Backend/api/pb/designationpb"
+
 replace github.com/ShristiRnr/NHIT_Backend/pkg/middleware => "../NHIT Backend/pkg/middleware"
 
+replace github.com/ShristiRnr/NHIT_Backend/pkg/money => "../NHIT Backend/pkg/money"
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/gstin => "../NHIT Backend/pkg/gstin"
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer => "../NHIT Backend/pkg/eventconsumer"
+
 replace github.com/ShristiRnr/NHIT_Backend/api/pb/authpb => "../NHIT Backend/api/pb/authpb"
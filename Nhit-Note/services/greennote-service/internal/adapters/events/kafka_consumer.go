@@ -19,7 +19,14 @@ type ApprovalEvent struct {
 	SourceType string `json:"source_type"` // "GREENNOTE"
 	Status     string `json:"status"`      // "APPROVED", "REJECTED", "PENDING_LEVEL_2"
 	ActorID    string `json:"actor_id"`
+	ActorName  string `json:"actor_name"`
 	Comments   string `json:"comments"`
+
+	// Set when the actor voted as the delegate of an approver on leave, from
+	// the delegation the RBAC interceptor admitted the vote under
+	OnBehalfOfID   string `json:"on_behalf_of_id"`
+	OnBehalfOfName string `json:"on_behalf_of_name"`
+	DelegationID   string `json:"delegation_id"`
 }
 
 // KafkaConsumer listens to approval events and updates GreenNote status.
@@ -120,6 +127,20 @@ func (c *KafkaConsumer) handleMessage(ctx context.Context, m kafka.Message) {
 		return 
 	}
 
+	// Record the change in the approval history, with the approver acted for
+	// when a delegate voted
+	if err := c.repo.AddApprovalLog(ctx, greenNoteId, &greennotepb.ApprovalLogEntry{
+		Status:         payload.Status,
+		ActorId:        payload.ActorID,
+		ActorName:      payload.ActorName,
+		OnBehalfOfId:   payload.OnBehalfOfID,
+		OnBehalfOfName: payload.OnBehalfOfName,
+		DelegationId:   payload.DelegationID,
+		Comments:       payload.Comments,
+	}); err != nil {
+		log.Printf("⚠️ Failed to record approval log for GreenNote %s: %v", greenNoteId, err)
+	}
+
 	// Update fields
 	finalStatus := payload.Status
 	// If fully approved, transition to draft for payment notes
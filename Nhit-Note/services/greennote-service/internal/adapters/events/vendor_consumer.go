@@ -0,0 +1,180 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"nhit-note/services/greennote-service/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// Vendor event types handled by the green note service
+const (
+	VendorEventMerged             = "vendor.merged"
+	VendorEventMSMEInvoiceFlagged = "vendor.msme_invoice.flagged"
+	VendorEventInvoiceSubmitted   = "vendor.invoice.submitted"
+)
+
+// vendorEventSchemaVersion is the vendor events schema this consumer understands
+const vendorEventSchemaVersion = 1
+
+// VendorMergedEvent is the payload of vendor.merged
+type VendorMergedEvent struct {
+	MergeID            string `json:"merge_id"`
+	SurvivorVendorID   string `json:"survivor_vendor_id"`
+	SurvivorVendorCode string `json:"survivor_vendor_code"`
+	SurvivorVendorName string `json:"survivor_vendor_name"`
+	MergedVendorID     string `json:"merged_vendor_id"`
+	MergedVendorCode   string `json:"merged_vendor_code"`
+	MergedVendorName   string `json:"merged_vendor_name"`
+}
+
+// MSMEInvoiceFlaggedEvent is the payload of vendor.msme_invoice.flagged
+type MSMEInvoiceFlaggedEvent struct {
+	InvoiceID         string       `json:"invoice_id"`
+	VendorCode        string       `json:"vendor_code"`
+	InvoiceNumber     string       `json:"invoice_number"`
+	InvoiceAmount     money.Amount `json:"invoice_amount"`
+	DueDate           string       `json:"due_date"`
+	Status            string       `json:"status"`
+	InterestLiability money.Amount `json:"interest_liability"`
+	GreenNoteID       *string      `json:"green_note_id,omitempty"`
+}
+
+// VendorInvoiceSubmittedEvent is the payload of vendor.invoice.submitted
+type VendorInvoiceSubmittedEvent struct {
+	InvoiceID     string       `json:"invoice_id"`
+	OrgID         *string      `json:"org_id,omitempty"`
+	VendorID      string       `json:"vendor_id"`
+	VendorCode    string       `json:"vendor_code"`
+	VendorName    string       `json:"vendor_name"`
+	InvoiceNumber string       `json:"invoice_number"`
+	InvoiceDate   string       `json:"invoice_date"`
+	InvoiceAmount money.Amount `json:"invoice_amount"`
+	PONumber      *string      `json:"po_number,omitempty"`
+	Description   *string      `json:"description,omitempty"`
+}
+
+// VendorEventConsumer applies vendor-service events to green notes
+type VendorEventConsumer struct {
+	consumer *eventconsumer.Consumer
+	repo     ports.GreenNoteRepository
+	intake   ports.VendorInvoiceIntake
+}
+
+// NewVendorEventConsumer creates a consumer of the vendor events topic
+func NewVendorEventConsumer(brokers []string, topic string, groupID string, repo ports.GreenNoteRepository, intake ports.VendorInvoiceIntake) *VendorEventConsumer {
+	consumer := eventconsumer.New(eventconsumer.Config{
+		Brokers:       brokers,
+		Topic:         topic,
+		GroupID:       groupID,
+		SchemaVersion: vendorEventSchemaVersion,
+	})
+	if consumer == nil {
+		return nil
+	}
+
+	c := &VendorEventConsumer{consumer: consumer, repo: repo, intake: intake}
+	eventconsumer.On(consumer, VendorEventMerged, c.handleVendorMerged)
+	eventconsumer.On(consumer, VendorEventMSMEInvoiceFlagged, c.handleMSMEInvoiceFlagged)
+	eventconsumer.On(consumer, VendorEventInvoiceSubmitted, c.handleInvoiceSubmitted)
+	return c
+}
+
+// Start consumes vendor events until ctx is cancelled. It should be run in a
+// goroutine.
+func (c *VendorEventConsumer) Start(ctx context.Context) {
+	c.consumer.Start(ctx)
+}
+
+// handleVendorMerged moves green notes of the merged vendor to the survivor
+func (c *VendorEventConsumer) handleVendorMerged(ctx context.Context, tenantID string, evt VendorMergedEvent) error {
+	if _, err := uuid.Parse(evt.MergedVendorID); err != nil {
+		log.Printf("⚠️ Vendor merge %s names invalid merged vendor %q, green notes left unchanged", evt.MergeID, evt.MergedVendorID)
+		return nil
+	}
+	if _, err := uuid.Parse(evt.SurvivorVendorID); err != nil {
+		log.Printf("⚠️ Vendor merge %s names invalid survivor vendor %q, green notes left unchanged", evt.MergeID, evt.SurvivorVendorID)
+		return nil
+	}
+
+	n, err := c.repo.ReassignSupplier(ctx, tenantID, evt.MergedVendorID, evt.SurvivorVendorID, evt.SurvivorVendorName)
+	if err != nil {
+		return err
+	}
+	log.Printf("🔀 Vendor merge %s: %d green notes moved from %s to %s", evt.MergeID, n, evt.MergedVendorID, evt.SurvivorVendorID)
+	return nil
+}
+
+// handleMSMEInvoiceFlagged records an MSME payment warning on the green note
+// the invoice was registered against
+func (c *VendorEventConsumer) handleMSMEInvoiceFlagged(ctx context.Context, tenantID string, evt MSMEInvoiceFlaggedEvent) error {
+	if evt.GreenNoteID == nil || *evt.GreenNoteID == "" {
+		return nil
+	}
+	if _, err := uuid.Parse(*evt.GreenNoteID); err != nil {
+		log.Printf("⚠️ MSME invoice %s names invalid green note %q, skipped", evt.InvoiceID, *evt.GreenNoteID)
+		return nil
+	}
+
+	err := c.repo.FlagMSMEInvoice(ctx, tenantID, *evt.GreenNoteID, ports.MSMEFlag{
+		InvoiceID:         evt.InvoiceID,
+		InvoiceNumber:     evt.InvoiceNumber,
+		Status:            evt.Status,
+		DueDate:           evt.DueDate,
+		InterestLiability: evt.InterestLiability,
+	})
+	if errors.Is(err, ports.ErrNotFound) {
+		log.Printf("⚠️ MSME invoice %s: green note %s not found, skipped", evt.InvoiceID, *evt.GreenNoteID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("🚩 Green note %s flagged %s: MSME invoice %s of %s due %s, interest %s", *evt.GreenNoteID, evt.Status, evt.InvoiceNumber, evt.VendorCode, evt.DueDate, evt.InterestLiability)
+	return nil
+}
+
+// handleInvoiceSubmitted opens a draft green note for an invoice a vendor
+// submitted on the vendor portal. Redelivered events find the draft opened the
+// first time and open no other.
+func (c *VendorEventConsumer) handleInvoiceSubmitted(ctx context.Context, tenantID string, evt VendorInvoiceSubmittedEvent) error {
+	if _, err := uuid.Parse(evt.InvoiceID); err != nil {
+		log.Printf("⚠️ Vendor invoice event names invalid invoice %q, skipped", evt.InvoiceID)
+		return nil
+	}
+	if evt.OrgID == nil || *evt.OrgID == "" {
+		log.Printf("⚠️ Vendor invoice %s carries no organisation, no green note opened", evt.InvoiceID)
+		return nil
+	}
+
+	id, created, err := c.intake.OpenVendorInvoiceDraft(ctx, tenantID, ports.VendorInvoice{
+		InvoiceID:     evt.InvoiceID,
+		OrgID:         *evt.OrgID,
+		VendorID:      evt.VendorID,
+		VendorName:    evt.VendorName,
+		InvoiceNumber: evt.InvoiceNumber,
+		InvoiceDate:   evt.InvoiceDate,
+		Amount:        evt.InvoiceAmount,
+		PONumber:      deref(evt.PONumber),
+		Description:   deref(evt.Description),
+	})
+	if err != nil {
+		return err
+	}
+	if !created {
+		log.Printf("🔁 Vendor invoice %s already has green note %s, duplicate event skipped", evt.InvoiceID, id)
+	}
+	return nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
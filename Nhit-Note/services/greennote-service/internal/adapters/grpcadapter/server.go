@@ -49,3 +49,15 @@ func (s *Server) GetOrganizationVendors(ctx context.Context, req *greennotepb.Ge
 func (s *Server) GetOrganizationDepartments(ctx context.Context, req *greennotepb.GetOrganizationDepartmentsRequest) (*greennotepb.GetOrganizationDepartmentsResponse, error) {
 	return s.app.GetOrganizationDepartments(ctx, req)
 }
+
+func (s *Server) ExportGSTR2BData(ctx context.Context, req *greennotepb.ExportGSTR2BDataRequest) (*greennotepb.ExportGSTR2BDataResponse, error) {
+	return s.app.ExportGSTR2BData(ctx, req)
+}
+
+func (s *Server) GetProjectSpend(ctx context.Context, req *greennotepb.GetProjectSpendRequest) (*greennotepb.GetProjectSpendResponse, error) {
+	return s.app.GetProjectSpend(ctx, req)
+}
+
+func (s *Server) CountOrganizationGreenNotes(ctx context.Context, req *greennotepb.CountOrganizationGreenNotesRequest) (*greennotepb.CountOrganizationGreenNotesResponse, error) {
+	return s.app.CountOrganizationGreenNotes(ctx, req)
+}
@@ -2,14 +2,17 @@ package memory
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	greennotepb "nhit-note/api/pb/greennotepb"
 	"nhit-note/services/greennote-service/internal/core/ports"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Repository is an in-memory implementation of GreenNoteRepository.
@@ -21,9 +24,11 @@ type Repository struct {
 }
 
 type noteRecord struct {
-	payload   *greennotepb.GreenNotePayload
-	createdAt time.Time
-	updatedAt time.Time
+	payload      *greennotepb.GreenNotePayload
+	approvalLogs []*greennotepb.ApprovalLogEntry
+	msmeFlag     *greennotepb.MSMEFlag
+	createdAt    time.Time
+	updatedAt    time.Time
 }
 
 // NewInMemoryGreenNoteRepository constructs an in-memory repository.
@@ -112,7 +117,11 @@ func (r *Repository) Get(ctx context.Context, id string) (*greennotepb.GreenNote
 	if cloned == nil {
 		return nil, "", "", ports.ErrNotFound
 	}
-	return cloned.(*greennotepb.GreenNotePayload), "", "", nil
+	payload := cloned.(*greennotepb.GreenNotePayload)
+	if rec.msmeFlag != nil {
+		payload.MsmeFlag = proto.Clone(rec.msmeFlag).(*greennotepb.MSMEFlag)
+	}
+	return payload, "", "", nil
 }
 
 func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNotePayload, orgID, tenantID string) (string, error) {
@@ -124,6 +133,12 @@ func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNoteP
 		return "", nil
 	}
 
+	if invoiceID := payload.GetVendorInvoiceId(); invoiceID != "" {
+		if _, ok := r.findByVendorInvoice(invoiceID); ok {
+			return "", ports.ErrAlreadyExists
+		}
+	}
+
 	id := uuid.NewString()
 	cloned := proto.Clone(payload).(*greennotepb.GreenNotePayload)
 	now := time.Now().UTC()
@@ -151,6 +166,7 @@ func (r *Repository) Update(ctx context.Context, id string, payload *greennotepb
 	}
 
 	cloned := proto.Clone(payload).(*greennotepb.GreenNotePayload)
+	cloned.VendorInvoiceId = rec.payload.GetVendorInvoiceId()
 	rec.payload = cloned
 	rec.updatedAt = time.Now().UTC()
 	return nil
@@ -172,3 +188,180 @@ func (r *Repository) Cancel(ctx context.Context, id string, reason string, orgID
 	rec.updatedAt = time.Now().UTC()
 	return nil
 }
+
+func (r *Repository) ListGSTInvoices(ctx context.Context, orgID, fromDate, toDate string) ([]ports.GSTInvoice, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_ = ctx
+	_ = orgID
+
+	var result []ports.GSTInvoice
+	for id, rec := range r.notes {
+		if rec == nil || rec.payload == nil {
+			continue
+		}
+		switch rec.payload.GetStatus() {
+		case greennotepb.Status_STATUS_CANCELLED, greennotepb.Status_STATUS_REJECTED:
+			continue
+		}
+		invoices := append([]*greennotepb.InvoiceInput{rec.payload.GetInvoice()}, rec.payload.GetInvoices()...)
+		for _, inv := range invoices {
+			if inv.GetSupplierGstin() == "" || inv.GetInvoiceDate() < fromDate || inv.GetInvoiceDate() > toDate {
+				continue
+			}
+			result = append(result, ports.GSTInvoice{
+				GreenNoteID:  id,
+				SupplierName: rec.payload.GetSupplierName(),
+				Invoice:      proto.Clone(inv).(*greennotepb.InvoiceInput),
+			})
+		}
+	}
+	return result, nil
+}
+
+func (r *Repository) CountByOrganization(ctx context.Context, orgID string) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_ = ctx
+	_ = orgID
+
+	return int64(len(r.notes)), nil
+}
+
+func (r *Repository) ProjectSpend(ctx context.Context, orgID, projectName string) ([]ports.ProjectSpendRow, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_ = ctx
+	_ = orgID
+
+	type key struct {
+		category string
+		approved bool
+	}
+	totals := make(map[key]*ports.ProjectSpendRow)
+	var result []ports.ProjectSpendRow
+	var order []key
+	for _, rec := range r.notes {
+		if rec == nil || rec.payload == nil || !strings.EqualFold(strings.TrimSpace(rec.payload.GetProjectName()), strings.TrimSpace(projectName)) {
+			continue
+		}
+		var approved bool
+		switch strings.ToLower(rec.payload.GetDetailedStatus()) {
+		case "cancelled", "rejected":
+			continue
+		case "approved", "draft":
+			approved = true
+		}
+		k := key{category: strings.TrimSpace(rec.payload.GetExpenseCategory()), approved: approved}
+		row, ok := totals[k]
+		if !ok {
+			row = &ports.ProjectSpendRow{ExpenseCategory: k.category, Approved: approved}
+			totals[k] = row
+			order = append(order, k)
+		}
+		amount, _ := money.FromFloat(rec.payload.GetTotalAmount(), money.HalfUp)
+		row.NoteCount++
+		row.Total = row.Total.Add(amount)
+	}
+	for _, k := range order {
+		result = append(result, *totals[k])
+	}
+	return result, nil
+}
+
+func (r *Repository) AddApprovalLog(ctx context.Context, noteID string, entry *greennotepb.ApprovalLogEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_ = ctx
+
+	rec, ok := r.notes[noteID]
+	if !ok || rec == nil {
+		return ports.ErrNotFound
+	}
+	entry = proto.Clone(entry).(*greennotepb.ApprovalLogEntry)
+	entry.CreatedAt = timestamppb.Now()
+	rec.approvalLogs = append(rec.approvalLogs, entry)
+	return nil
+}
+
+func (r *Repository) ListApprovalLogs(ctx context.Context, noteID string) ([]*greennotepb.ApprovalLogEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_ = ctx
+
+	rec, ok := r.notes[noteID]
+	if !ok || rec == nil {
+		return nil, ports.ErrNotFound
+	}
+	result := make([]*greennotepb.ApprovalLogEntry, len(rec.approvalLogs))
+	for i, entry := range rec.approvalLogs {
+		result[i] = proto.Clone(entry).(*greennotepb.ApprovalLogEntry)
+	}
+	return result, nil
+}
+
+func (r *Repository) ReassignSupplier(ctx context.Context, tenantID, fromVendorID, toVendorID, toName string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_ = ctx
+	_ = tenantID
+
+	var moved int64
+	for _, rec := range r.notes {
+		if rec == nil || rec.payload == nil || rec.payload.GetSupplierId() != fromVendorID {
+			continue
+		}
+		rec.payload.SupplierId = toVendorID
+		if toName != "" {
+			rec.payload.SupplierName = toName
+		}
+		rec.updatedAt = time.Now()
+		moved++
+	}
+	return moved, nil
+}
+
+func (r *Repository) FindByVendorInvoice(ctx context.Context, tenantID, invoiceID string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_ = ctx
+	_ = tenantID
+
+	id, ok := r.findByVendorInvoice(invoiceID)
+	if !ok {
+		return "", ports.ErrNotFound
+	}
+	return id, nil
+}
+
+// findByVendorInvoice looks up the note opened from a vendor portal invoice;
+// the caller holds the lock
+func (r *Repository) findByVendorInvoice(invoiceID string) (string, bool) {
+	for id, rec := range r.notes {
+		if rec != nil && rec.payload != nil && rec.payload.GetVendorInvoiceId() == invoiceID {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+func (r *Repository) FlagMSMEInvoice(ctx context.Context, tenantID, noteID string, flag ports.MSMEFlag) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_ = ctx
+	_ = tenantID
+
+	rec, ok := r.notes[noteID]
+	if !ok || rec == nil {
+		return ports.ErrNotFound
+	}
+	rec.msmeFlag = &greennotepb.MSMEFlag{
+		InvoiceId:         flag.InvoiceID,
+		InvoiceNumber:     flag.InvoiceNumber,
+		Status:            flag.Status,
+		DueDate:           flag.DueDate,
+		InterestLiability: flag.InterestLiability.Float64(),
+		FlaggedAt:         timestamppb.Now(),
+	}
+	return nil
+}
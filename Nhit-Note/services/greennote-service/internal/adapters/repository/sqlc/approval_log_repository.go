@@ -0,0 +1,62 @@
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+
+	greennotepb "nhit-note/api/pb/greennotepb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddApprovalLog implements ports.GreenNoteRepository.
+func (r *Repository) AddApprovalLog(ctx context.Context, noteID string, entry *greennotepb.ApprovalLogEntry) error {
+	if r == nil || r.db == nil {
+		return nil
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO green_note_approval_logs (
+			green_note_id, status, actor_id, actor_name,
+			on_behalf_of_id, on_behalf_of_name, delegation_id, comments
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, noteID, entry.GetStatus(), toNullString(entry.GetActorId()), toNullString(entry.GetActorName()),
+		toNullString(entry.GetOnBehalfOfId()), toNullString(entry.GetOnBehalfOfName()),
+		toNullString(entry.GetDelegationId()), toNullString(entry.GetComments()))
+	return err
+}
+
+// ListApprovalLogs implements ports.GreenNoteRepository.
+func (r *Repository) ListApprovalLogs(ctx context.Context, noteID string) ([]*greennotepb.ApprovalLogEntry, error) {
+	if r == nil || r.db == nil {
+		return nil, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT status, COALESCE(actor_id::text, ''), COALESCE(actor_name, ''),
+			COALESCE(on_behalf_of_id::text, ''), COALESCE(on_behalf_of_name, ''),
+			COALESCE(delegation_id::text, ''), COALESCE(comments, ''), created_at
+		FROM green_note_approval_logs
+		WHERE green_note_id = $1
+		ORDER BY created_at, id
+	`, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*greennotepb.ApprovalLogEntry
+	for rows.Next() {
+		entry := &greennotepb.ApprovalLogEntry{}
+		var createdAt sql.NullTime
+		if err := rows.Scan(&entry.Status, &entry.ActorId, &entry.ActorName,
+			&entry.OnBehalfOfId, &entry.OnBehalfOfName, &entry.DelegationId, &entry.Comments, &createdAt); err != nil {
+			return nil, err
+		}
+		if createdAt.Valid {
+			entry.CreatedAt = timestamppb.New(createdAt.Time)
+		}
+		result = append(result, entry)
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,177 @@
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	greennotepb "nhit-note/api/pb/greennotepb"
+	"nhit-note/services/greennote-service/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// GST breakup columns and invoice lines are written with manual SQL, like the
+// org_id/tenant_id columns, as the sqlc queries are not regenerated for them.
+
+// saveOrganizationGSTINTx stores the GSTIN the note's invoices are billed to
+func saveOrganizationGSTINTx(ctx context.Context, tx *sql.Tx, noteID, gstin string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE green_notes SET organization_gstin = $2 WHERE id = $1`, noteID, toNullString(gstin))
+	return err
+}
+
+// saveInvoiceGSTTx stores an invoice's GST breakup and lines after the invoice
+// row itself is inserted
+func saveInvoiceGSTTx(ctx context.Context, tx *sql.Tx, invoiceID string, inv *greennotepb.InvoiceInput) error {
+	if inv.GetSupplierGstin() == "" {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE green_note_invoices SET
+			supplier_gstin = $2, place_of_supply = $3, supply_type = $4,
+			cgst = $5, sgst = $6, igst = $7, cess = $8
+		WHERE id = $1
+	`, invoiceID, inv.GetSupplierGstin(), toNullString(inv.GetPlaceOfSupply()), inv.GetSupplyType().String(),
+		formatDecimal(inv.GetCgst()), formatDecimal(inv.GetSgst()), formatDecimal(inv.GetIgst()), formatDecimal(inv.GetCess()))
+	if err != nil {
+		return fmt.Errorf("failed to save GST breakup of invoice %s: %w", inv.GetInvoiceNumber(), err)
+	}
+
+	for i, line := range inv.GetLines() {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO green_note_invoice_lines (
+				id, invoice_id, line_no, description, hsn_sac, taxable_value,
+				gst_rate, cess_rate, reverse_charge, cgst, sgst, igst, cess
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		`, uuid.NewString(), invoiceID, i+1, toNullString(line.GetDescription()), line.GetHsnSac(), formatDecimal(line.GetTaxableValue()),
+			line.GetGstRate(), line.GetCessRate(), line.GetReverseCharge(),
+			formatDecimal(line.GetCgst()), formatDecimal(line.GetSgst()), formatDecimal(line.GetIgst()), formatDecimal(line.GetCess()))
+		if err != nil {
+			return fmt.Errorf("failed to save line %d of invoice %s: %w", i+1, inv.GetInvoiceNumber(), err)
+		}
+	}
+	return nil
+}
+
+// loadInvoiceGST hydrates the GST breakup and lines of invoices keyed by ID
+func (r *Repository) loadInvoiceGST(ctx context.Context, invoices map[string]*greennotepb.InvoiceInput) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(invoices))
+	for id := range invoices {
+		ids = append(ids, id)
+	}
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+	in := strings.Join(placeholders, ", ")
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, COALESCE(supplier_gstin, ''), COALESCE(place_of_supply, ''), COALESCE(supply_type, ''),
+			cgst, sgst, igst, cess
+		FROM green_note_invoices
+		WHERE id IN (`+in+`)
+	`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, supplyType, cgst, sgst, igst, cess string
+		var supplierGSTIN, placeOfSupply string
+		if err := rows.Scan(&id, &supplierGSTIN, &placeOfSupply, &supplyType, &cgst, &sgst, &igst, &cess); err != nil {
+			return err
+		}
+		inv := invoices[id]
+		inv.SupplierGstin = supplierGSTIN
+		inv.PlaceOfSupply = placeOfSupply
+		inv.SupplyType = greennotepb.SupplyType(greennotepb.SupplyType_value[supplyType])
+		inv.Cgst, inv.Sgst, inv.Igst, inv.Cess = parseDecimal(cgst), parseDecimal(sgst), parseDecimal(igst), parseDecimal(cess)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	lineRows, err := r.db.QueryContext(ctx, `
+		SELECT invoice_id, COALESCE(description, ''), hsn_sac, taxable_value, gst_rate, cess_rate,
+			reverse_charge, cgst, sgst, igst, cess
+		FROM green_note_invoice_lines
+		WHERE invoice_id IN (`+in+`)
+		ORDER BY invoice_id, line_no
+	`, args...)
+	if err != nil {
+		return err
+	}
+	defer lineRows.Close()
+	for lineRows.Next() {
+		var (
+			invoiceID, taxable, cgst, sgst, igst, cess string
+			line                                       greennotepb.InvoiceLine
+		)
+		if err := lineRows.Scan(&invoiceID, &line.Description, &line.HsnSac, &taxable, &line.GstRate, &line.CessRate,
+			&line.ReverseCharge, &cgst, &sgst, &igst, &cess); err != nil {
+			return err
+		}
+		line.TaxableValue = parseDecimal(taxable)
+		line.Cgst, line.Sgst, line.Igst, line.Cess = parseDecimal(cgst), parseDecimal(sgst), parseDecimal(igst), parseDecimal(cess)
+		inv := invoices[invoiceID]
+		inv.Lines = append(inv.Lines, &line)
+	}
+	return lineRows.Err()
+}
+
+// ListGSTInvoices implements ports.GreenNoteRepository
+func (r *Repository) ListGSTInvoices(ctx context.Context, orgID, fromDate, toDate string) ([]ports.GSTInvoice, error) {
+	if r == nil || r.db == nil {
+		return nil, nil
+	}
+
+	// invoice_date is TEXT; invoices with GST details always carry YYYY-MM-DD
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT i.id, n.id, COALESCE(n.supplier_name, ''), i.invoice_number, COALESCE(i.invoice_date, ''),
+			i.taxable_value, i.gst, i.other_charges, i.invoice_value
+		FROM green_note_invoices i
+		JOIN green_notes n ON n.id = i.green_note_id
+		WHERE n.org_id = $1
+			AND n.status NOT IN ('cancelled', 'rejected')
+			AND i.supplier_gstin IS NOT NULL
+			AND i.invoice_date >= $2 AND i.invoice_date <= $3
+		ORDER BY i.invoice_date, i.invoice_number
+	`, orgID, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []ports.GSTInvoice
+	byID := make(map[string]*greennotepb.InvoiceInput)
+	for rows.Next() {
+		var (
+			invoiceID, taxable, gst, other, value string
+			gi                                    ports.GSTInvoice
+			inv                                   greennotepb.InvoiceInput
+		)
+		if err := rows.Scan(&invoiceID, &gi.GreenNoteID, &gi.SupplierName, &inv.InvoiceNumber, &inv.InvoiceDate,
+			&taxable, &gst, &other, &value); err != nil {
+			return nil, err
+		}
+		inv.TaxableValue, inv.Gst, inv.OtherCharges, inv.InvoiceValue = parseDecimal(taxable), parseDecimal(gst), parseDecimal(other), parseDecimal(value)
+		gi.Invoice = &inv
+		byID[invoiceID] = &inv
+		result = append(result, gi)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadInvoiceGST(ctx, byID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	greennotepb "nhit-note/api/pb/greennotepb"
+	"nhit-note/services/greennote-service/internal/core/ports"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FlagMSMEInvoice implements ports.GreenNoteRepository.
+func (r *Repository) FlagMSMEInvoice(ctx context.Context, tenantID, noteID string, flag ports.MSMEFlag) error {
+	if r == nil || r.db == nil {
+		return ports.ErrNotFound
+	}
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE green_notes
+		SET msme_invoice_id = $3, msme_invoice_number = $4, msme_status = $5,
+			msme_due_date = $6, msme_interest_liability = $7, msme_flagged_at = NOW()
+		WHERE id = $1 AND tenant_id = $2
+	`, noteID, tenantID, toNullString(flag.InvoiceID), flag.InvoiceNumber, flag.Status,
+		toNullString(flag.DueDate), flag.InterestLiability)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ports.ErrNotFound
+	}
+	return nil
+}
+
+// loadMSMEFlag returns the MSME warning recorded on a note, or nil
+func (r *Repository) loadMSMEFlag(ctx context.Context, noteID string) (*greennotepb.MSMEFlag, error) {
+	var (
+		invoiceID, invoiceNumber, status, dueDate sql.NullString
+		interest                                  money.Amount
+		flaggedAt                                 sql.NullTime
+	)
+	err := r.db.QueryRowContext(ctx, `
+		SELECT msme_invoice_id::text, msme_invoice_number, msme_status,
+			TO_CHAR(msme_due_date, 'YYYY-MM-DD'), msme_interest_liability, msme_flagged_at
+		FROM green_notes WHERE id = $1
+	`, noteID).Scan(&invoiceID, &invoiceNumber, &status, &dueDate, &interest, &flaggedAt)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Valid {
+		return nil, nil
+	}
+
+	flag := &greennotepb.MSMEFlag{
+		InvoiceId:         invoiceID.String,
+		InvoiceNumber:     invoiceNumber.String,
+		Status:            status.String,
+		DueDate:           dueDate.String,
+		InterestLiability: interest.Float64(),
+	}
+	if flaggedAt.Valid {
+		flag.FlaggedAt = timestamppb.New(flaggedAt.Time.In(time.UTC))
+	}
+	return flag, nil
+}
@@ -0,0 +1,14 @@
+package sqlc
+
+import "context"
+
+// CountByOrganization implements ports.GreenNoteRepository.
+func (r *Repository) CountByOrganization(ctx context.Context, orgID string) (int64, error) {
+	if r == nil || r.db == nil {
+		return 0, nil
+	}
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM green_notes WHERE org_id = $1`, orgID).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,41 @@
+package sqlc
+
+import (
+	"context"
+
+	"nhit-note/services/greennote-service/internal/core/ports"
+)
+
+// ProjectSpend implements ports.GreenNoteRepository. Approval moves a note to
+// draft while its payment note is raised, so drafts count as approved.
+func (r *Repository) ProjectSpend(ctx context.Context, orgID, projectName string) ([]ports.ProjectSpendRow, error) {
+	if r == nil || r.db == nil {
+		return nil, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT COALESCE(TRIM(expense_category), ''),
+			LOWER(status) IN ('approved', 'draft') AS approved,
+			COUNT(*), COALESCE(SUM(total_amount), 0)
+		FROM green_notes
+		WHERE org_id = $1
+			AND LOWER(TRIM(project_name)) = LOWER(TRIM($2))
+			AND LOWER(status) NOT IN ('cancelled', 'rejected')
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`, orgID, projectName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []ports.ProjectSpendRow
+	for rows.Next() {
+		var row ports.ProjectSpendRow
+		if err := rows.Scan(&row.ExpenseCategory, &row.Approved, &row.NoteCount, &row.Total); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +14,7 @@ import (
 
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -92,7 +92,7 @@ func (r *Repository) List(ctx context.Context, req *greennotepb.ListGreenNotesRe
 			id          string
 			projectName sql.NullString
 			vendorName  sql.NullString
-			amount      float64
+			amount      money.Amount
 			created     time.Time
 			status      string
 		)
@@ -103,7 +103,7 @@ func (r *Repository) List(ctx context.Context, req *greennotepb.ListGreenNotesRe
 			Id:          id,
 			ProjectName: projectName.String,
 			VendorName:  vendorName.String,
-			Amount:      amount,
+			Amount:      amount.Float64(),
 			Date:        created.Format(time.RFC3339),
 			Status:      mapDBStatusToProtoEnum(status),
 		}
@@ -146,9 +146,9 @@ func (r *Repository) Get(ctx context.Context, id string) (*greennotepb.GreenNote
 		return nil, "", "", ports.ErrNotFound
 	}
 
-	var orgID, tenantID string
-	query := `SELECT org_id, tenant_id FROM green_notes WHERE id = $1`
-	err := r.db.QueryRowContext(ctx, query, id).Scan(&orgID, &tenantID)
+	var orgID, tenantID, organizationGSTIN string
+	query := `SELECT org_id, tenant_id, COALESCE(organization_gstin, '') FROM green_notes WHERE id = $1`
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&orgID, &tenantID, &organizationGSTIN)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, "", "", ports.ErrNotFound
@@ -260,6 +260,7 @@ func (r *Repository) Get(ctx context.Context, id string) (*greennotepb.GreenNote
 		}
 	}
 
+	invoicesByID := make(map[string]*greennotepb.InvoiceInput, len(invoicesRows))
 	for _, in := range invoicesRows {
 		inv := &greennotepb.InvoiceInput{
 			InvoiceNumber: in.InvoiceNumber,
@@ -269,6 +270,7 @@ func (r *Repository) Get(ctx context.Context, id string) (*greennotepb.GreenNote
 			OtherCharges:  parseDecimal(in.OtherCharges),
 			InvoiceValue:  parseDecimal(in.InvoiceValue),
 		}
+		invoicesByID[in.ID] = inv
 		isPrimary := in.IsPrimary.Valid && in.IsPrimary.Bool
 		fmt.Printf(" DEBUG HYDRATION: Invoice %s, RawIsPrimary(Valid=%v, Bool=%v) -> Evaluated=%v\n", in.InvoiceNumber, in.IsPrimary.Valid, in.IsPrimary.Bool, isPrimary)
 		if isPrimary {
@@ -281,6 +283,18 @@ func (r *Repository) Get(ctx context.Context, id string) (*greennotepb.GreenNote
 		}
 	}
 	
+	if err := r.loadInvoiceGST(ctx, invoicesByID); err != nil {
+		fmt.Printf(" Failed to load invoice GST breakup for hydration: %v\n", err)
+	}
+	p.OrganizationGstin = organizationGSTIN
+
+	if p.MsmeFlag, err = r.loadMSMEFlag(ctx, id); err != nil {
+		return nil, "", "", err
+	}
+	if p.SupplierId, p.VendorInvoiceId, err = r.loadVendorLinks(ctx, id); err != nil {
+		return nil, "", "", err
+	}
+
 	if p.Invoice == nil && len(p.Invoices) > 0 {
 		fmt.Printf("DEBUG HYDRATION: No primary invoice flagged for GN-%s. Promoting first invoice (%s) to primary.\n", id, p.Invoices[0].InvoiceNumber)
 		p.Invoice = p.Invoices[0]
@@ -372,11 +386,11 @@ func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNoteP
 			budget_expenditure, actual_expenditure, expenditure_over_budget,
 			milestone_remarks, specify_deviation, documents_workdone_supply,
 			documents_discrepancy, remarks, auditor_remarks, 
-			amount_retained_for_non_submission, org_id, tenant_id
+			amount_retained_for_non_submission, org_id, tenant_id, organization_gstin
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, 
 			$19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, 
-			$35, $36, $37, $38, $39, $40, $41, $42, $43, $44, $45, $46, $47
+			$35, $36, $37, $38, $39, $40, $41, $42, $43, $44, $45, $46, $47, $48
 		) RETURNING id
 	`
 	id := uuid.NewString()
@@ -395,7 +409,7 @@ func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNoteP
 		formatDecimal(payload.GetBudgetExpenditure()), formatDecimal(payload.GetActualExpenditure()), formatDecimal(payload.GetExpenditureOverBudget()),
 		toNullString(payload.GetMilestoneRemarks()), toNullString(payload.GetSpecifyDeviation()), toNullString(payload.GetDocumentsWorkdoneSupply()),
 		toNullString(payload.GetDocumentsDiscrepancy()), toNullString(payload.GetRemarks()), toNullString(payload.GetAuditorRemarks()),
-		formatDecimal(payload.GetAmountRetainedForNonSubmission()), orgID, tenantID,
+		formatDecimal(payload.GetAmountRetainedForNonSubmission()), orgID, tenantID, toNullString(payload.GetOrganizationGstin()),
 	).Scan(&returnedID)
 
 	if err != nil {
@@ -409,8 +423,9 @@ func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNoteP
 	if inv := payload.GetInvoice(); inv != nil {
 		primaryNumber = strings.TrimSpace(inv.GetInvoiceNumber())
 		fmt.Printf("PERSISTENCE: Inserting primary invoice %s for GN-%s\n", primaryNumber, returnedID)
+		invoiceID := uuid.NewString()
 		_, err := qtx.InsertInvoice(ctx, sqlcgen.InsertInvoiceParams{
-			ID:            uuid.NewString(),
+			ID:            invoiceID,
 			GreenNoteID:   returnedID,
 			InvoiceNumber: primaryNumber,
 			InvoiceDate:   toNullString(inv.GetInvoiceDate()),
@@ -427,6 +442,10 @@ func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNoteP
 			_ = tx.Rollback()
 			return "", err
 		}
+		if err := saveInvoiceGSTTx(ctx, tx, invoiceID, inv); err != nil {
+			_ = tx.Rollback()
+			return "", err
+		}
 	}
 
 	// 2. Multiple Invoices (with de-duplication)
@@ -447,8 +466,9 @@ func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNoteP
 		insertedNumbers[invNum] = true
 
 		fmt.Printf("PERSISTENCE: Inserting multiple invoice %s for GN-%s\n", invNum, returnedID)
+		invoiceID := uuid.NewString()
 		_, err := qtx.InsertInvoice(ctx, sqlcgen.InsertInvoiceParams{
-			ID:            uuid.NewString(),
+			ID:            invoiceID,
 			GreenNoteID:   returnedID,
 			InvoiceNumber: invNum,
 			InvoiceDate:   toNullString(in.GetInvoiceDate()),
@@ -465,8 +485,20 @@ func (r *Repository) Create(ctx context.Context, payload *greennotepb.GreenNoteP
 			_ = tx.Rollback()
 			return "", err
 		}
+		if err := saveInvoiceGSTTx(ctx, tx, invoiceID, in); err != nil {
+			_ = tx.Rollback()
+			return "", err
+		}
 	}
 
+	if err := saveSupplierIDTx(ctx, tx, returnedID, payload.GetSupplierId()); err != nil {
+		_ = tx.Rollback()
+		return "", err
+	}
+	if err := saveVendorInvoiceIDTx(ctx, tx, returnedID, payload.GetVendorInvoiceId()); err != nil {
+		_ = tx.Rollback()
+		return "", err
+	}
 	if err := r.insertDocumentsTx(ctx, tx, returnedID, payload.GetNewDocuments(), orgID, tenantID); err != nil {
 		_ = tx.Rollback()
 		return "", err
@@ -581,6 +613,10 @@ func (r *Repository) Update(ctx context.Context, id string, payload *greennotepb
 		_ = tx.Rollback()
 		return err
 	}
+	if err := saveOrganizationGSTINTx(ctx, tx, id, payload.GetOrganizationGstin()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 
 	// Handle Combo Payload in Update
 	_ = qtx.DeleteInvoicesByGreenNoteID(ctx, id)
@@ -590,8 +626,9 @@ func (r *Repository) Update(ctx context.Context, id string, payload *greennotepb
 	if inv := payload.GetInvoice(); inv != nil {
 		primaryNumber = strings.TrimSpace(inv.GetInvoiceNumber())
 		fmt.Printf("💾 PERSISTENCE: Updating/Inserting primary invoice %s for GN-%s\n", primaryNumber, id)
+		invoiceID := uuid.NewString()
 		_, err := qtx.InsertInvoice(ctx, sqlcgen.InsertInvoiceParams{
-			ID:            uuid.NewString(),
+			ID:            invoiceID,
 			GreenNoteID:   id,
 			InvoiceNumber: primaryNumber,
 			InvoiceDate:   toNullString(inv.GetInvoiceDate()),
@@ -608,6 +645,10 @@ func (r *Repository) Update(ctx context.Context, id string, payload *greennotepb
 			_ = tx.Rollback()
 			return err
 		}
+		if err := saveInvoiceGSTTx(ctx, tx, invoiceID, inv); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
 	}
 
 	// 2. Multiple Invoices (with de-duplication)
@@ -628,8 +669,9 @@ func (r *Repository) Update(ctx context.Context, id string, payload *greennotepb
 		insertedNumbers[invNum] = true
 
 		fmt.Printf("💾 PERSISTENCE: Updating/Inserting multiple invoice %s for GN-%s\n", invNum, id)
+		invoiceID := uuid.NewString()
 		_, err := qtx.InsertInvoice(ctx, sqlcgen.InsertInvoiceParams{
-			ID:            uuid.NewString(),
+			ID:            invoiceID,
 			GreenNoteID:   id,
 			InvoiceNumber: invNum,
 			InvoiceDate:   toNullString(in.GetInvoiceDate()),
@@ -646,8 +688,16 @@ func (r *Repository) Update(ctx context.Context, id string, payload *greennotepb
 			_ = tx.Rollback()
 			return err
 		}
+		if err := saveInvoiceGSTTx(ctx, tx, invoiceID, in); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
 	}
 
+	if err := saveSupplierIDTx(ctx, tx, id, payload.GetSupplierId()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 	if err := r.insertDocumentsTx(ctx, tx, id, payload.GetNewDocuments(), orgID, tenantID); err != nil {
 		_ = tx.Rollback()
 		return err
@@ -785,31 +835,42 @@ func mapDBStatusToProtoEnum(dbStatus string) greennotepb.Status {
 }
 
 func sumInvoiceInputs(inputs []*greennotepb.InvoiceInput) (base, gst, other, total float64) {
+	var b, g, o, t money.Amount
 	for _, in := range inputs {
 		if in == nil {
 			continue
 		}
-		if in.InvoiceValue == 0 {
-			in.InvoiceValue = in.TaxableValue + in.Gst + in.OtherCharges
+		value := decimalOf(in.InvoiceValue)
+		if value.IsZero() {
+			value = money.Sum(decimalOf(in.TaxableValue), decimalOf(in.Gst), decimalOf(in.OtherCharges))
+			in.InvoiceValue = value.Float64()
 		}
-		base += in.TaxableValue
-		gst += in.Gst
-		other += in.OtherCharges
-		total += in.InvoiceValue
+		b = b.Add(decimalOf(in.TaxableValue))
+		g = g.Add(decimalOf(in.Gst))
+		o = o.Add(decimalOf(in.OtherCharges))
+		t = t.Add(value)
 	}
-	return
+	return b.Float64(), g.Float64(), o.Float64(), t.Float64()
+}
+
+// decimalOf reads an amount the service normalized to whole paise
+func decimalOf(v float64) money.Amount {
+	a, _ := money.FromFloat(v, money.HalfUp)
+	return a
 }
 
 func formatDecimal(v float64) string {
-	return fmt.Sprintf("%.2f", v)
+	return decimalOf(v).String()
 }
 
+// parseDecimal reads a DECIMAL(20,2) column; the float64 it returns holds
+// whole paise
 func parseDecimal(s string) float64 {
-	if strings.TrimSpace(s) == "" {
+	a, err := money.ParseRound(s, money.HalfUp)
+	if err != nil {
 		return 0
 	}
-	f, _ := strconv.ParseFloat(s, 64)
-	return f
+	return a.Float64()
 }
 func toUUID(s string) uuid.NullUUID {
 	u, err := uuid.Parse(s)
@@ -0,0 +1,40 @@
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ReassignSupplier implements ports.GreenNoteRepository.
+func (r *Repository) ReassignSupplier(ctx context.Context, tenantID, fromVendorID, toVendorID, toName string) (int64, error) {
+	if r == nil || r.db == nil {
+		return 0, nil
+	}
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE green_notes
+		SET supplier_id = $3, supplier_name = COALESCE(NULLIF($4, ''), supplier_name), updated_at = NOW()
+		WHERE tenant_id = $1 AND supplier_id = $2
+	`, tenantID, fromVendorID, toVendorID, toName)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// saveSupplierIDTx stores the vendor ID of a note's supplier; an empty ID
+// clears it
+func saveSupplierIDTx(ctx context.Context, tx *sql.Tx, noteID, supplierID string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE green_notes SET supplier_id = $2 WHERE id = $1`, noteID, toNullString(supplierID))
+	return err
+}
+
+// loadVendorLinks returns the vendor ID of a note's supplier and the vendor
+// portal invoice it was opened from; either is "" when not recorded
+func (r *Repository) loadVendorLinks(ctx context.Context, noteID string) (supplierID, vendorInvoiceID string, err error) {
+	var supplier, invoice sql.NullString
+	err = r.db.QueryRowContext(ctx, `
+		SELECT supplier_id::text, vendor_invoice_id::text FROM green_notes WHERE id = $1
+	`, noteID).Scan(&supplier, &invoice)
+	return supplier.String, invoice.String, err
+}
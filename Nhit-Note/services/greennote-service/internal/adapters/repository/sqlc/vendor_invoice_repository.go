@@ -0,0 +1,44 @@
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"nhit-note/services/greennote-service/internal/core/ports"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique index conflict
+const uniqueViolation = "23505"
+
+// FindByVendorInvoice implements ports.GreenNoteRepository.
+func (r *Repository) FindByVendorInvoice(ctx context.Context, tenantID, invoiceID string) (string, error) {
+	if r == nil || r.db == nil {
+		return "", ports.ErrNotFound
+	}
+
+	var id string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id FROM green_notes WHERE tenant_id = $1 AND vendor_invoice_id = $2
+	`, tenantID, invoiceID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ports.ErrNotFound
+	}
+	return id, err
+}
+
+// saveVendorInvoiceIDTx records the vendor portal invoice a new note was
+// opened from. A second note for the same invoice is ports.ErrAlreadyExists.
+func saveVendorInvoiceIDTx(ctx context.Context, tx *sql.Tx, noteID, invoiceID string) error {
+	if invoiceID == "" {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, `UPDATE green_notes SET vendor_invoice_id = $2 WHERE id = $1`, noteID, invoiceID)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return ports.ErrAlreadyExists
+	}
+	return err
+}
@@ -14,6 +14,15 @@ func GetPermissionMap() map[string][]string {
 		"/greennote.GreenNoteService/GetOrganizationProjects":    {"create-note"}, // Need to create note to pick project
 		"/greennote.GreenNoteService/GetOrganizationVendors":     {"create-note"},
 		"/greennote.GreenNoteService/GetOrganizationDepartments": {"create-note"},
+
+		// GST input tax credit reconciliation across the organization
+		"/greennote.GreenNoteService/ExportGSTR2BData": {"view-all-notes"},
+
+		// Project spend roll-up against sanctioned budget heads
+		"/greennote.GreenNoteService/GetProjectSpend": {"view-all-notes"},
+
+		// Organization-service counts an organization's notes before purging it
+		"/greennote.GreenNoteService/CountOrganizationGreenNotes": {"delete-organizations"},
 	}
 }
 
@@ -4,12 +4,17 @@ import (
 	"context"
 	"errors"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	greennotepb "nhit-note/api/pb/greennotepb"
 )
 
 // ErrNotFound is a sentinel error used when an entity cannot be found.
 var ErrNotFound = errors.New("not found")
 
+// ErrAlreadyExists is returned when a green note was already opened from the
+// same vendor portal invoice.
+var ErrAlreadyExists = errors.New("already exists")
+
 // GreenNoteRepository defines the persistence contract for GreenNotes in terms of the
 // minimal greennote.proto API. It is responsible for persisting the core payload and
 // returning list/detail views used by the service layer.
@@ -32,6 +37,88 @@ type GreenNoteRepository interface {
 
 	// Cancel transitions a green note into the cancelled status.
 	Cancel(ctx context.Context, id string, reason string, orgID, tenantID string) error
+
+	// ListGSTInvoices returns the invoices with GST details, dated between
+	// fromDate and toDate (YYYY-MM-DD, inclusive), on the organization's green
+	// notes that are not cancelled or rejected.
+	ListGSTInvoices(ctx context.Context, orgID, fromDate, toDate string) ([]GSTInvoice, error)
+
+	// ProjectSpend totals the organization's green notes on a project, matched
+	// by name case-insensitively, per expense category and approval stage.
+	// Rejected and cancelled notes are left out.
+	ProjectSpend(ctx context.Context, orgID, projectName string) ([]ProjectSpendRow, error)
+
+	// CountByOrganization counts the organization's green notes in every
+	// status, cancelled and rejected ones included.
+	CountByOrganization(ctx context.Context, orgID string) (int64, error)
+
+	// AddApprovalLog records a status change reported by the approval service.
+	AddApprovalLog(ctx context.Context, noteID string, entry *greennotepb.ApprovalLogEntry) error
+
+	// ListApprovalLogs returns a note's approval history, oldest first.
+	ListApprovalLogs(ctx context.Context, noteID string) ([]*greennotepb.ApprovalLogEntry, error)
+
+	// ReassignSupplier moves the tenant's green notes whose supplier is vendor
+	// fromVendorID to vendor toVendorID, renaming the supplier to toName, e.g.
+	// after vendor-service merged vendors. It returns the number of notes
+	// changed.
+	ReassignSupplier(ctx context.Context, tenantID, fromVendorID, toVendorID, toName string) (int64, error)
+
+	// FindByVendorInvoice returns the ID of the tenant's green note opened
+	// from a vendor portal invoice, or ErrNotFound.
+	FindByVendorInvoice(ctx context.Context, tenantID, invoiceID string) (string, error)
+
+	// FlagMSMEInvoice records vendor-service's latest MSME payment warning on
+	// the tenant's green note, replacing any earlier one. It returns
+	// ErrNotFound when the tenant has no such note.
+	FlagMSMEInvoice(ctx context.Context, tenantID, noteID string, flag MSMEFlag) error
+}
+
+// MSMEFlag is vendor-service's warning that an MSME invoice on a note is at
+// risk of missing, or has missed, its statutory payment date.
+type MSMEFlag struct {
+	InvoiceID         string
+	InvoiceNumber     string
+	Status            string // AT_RISK, OVERDUE or PAID_LATE
+	DueDate           string // YYYY-MM-DD
+	InterestLiability money.Amount
+}
+
+// VendorInvoice is an invoice a vendor submitted on the vendor portal
+type VendorInvoice struct {
+	InvoiceID     string
+	OrgID         string
+	VendorID      string
+	VendorName    string
+	InvoiceNumber string
+	InvoiceDate   string // YYYY-MM-DD
+	Amount        money.Amount
+	PONumber      string
+	Description   string
+}
+
+// VendorInvoiceIntake opens draft green notes for vendor portal invoices.
+type VendorInvoiceIntake interface {
+	// OpenVendorInvoiceDraft opens a draft green note for the invoice and
+	// returns its ID. When a note was already opened from the invoice, its ID
+	// is returned with created false.
+	OpenVendorInvoiceDraft(ctx context.Context, tenantID string, inv VendorInvoice) (id string, created bool, err error)
+}
+
+// ProjectSpendRow is the total of a project's green notes in one expense
+// category and approval stage.
+type ProjectSpendRow struct {
+	ExpenseCategory string
+	Approved        bool // cleared approval, otherwise awaiting it
+	NoteCount       int
+	Total           money.Amount
+}
+
+// GSTInvoice is an invoice with its GST breakup and the green note it belongs to.
+type GSTInvoice struct {
+	GreenNoteID  string
+	SupplierName string
+	Invoice      *greennotepb.InvoiceInput
 }
 
 // GreenNoteApprovedEvent is emitted when a GreenNote is fully approved and a payment note draft is created.
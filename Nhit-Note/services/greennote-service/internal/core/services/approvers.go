@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+
+	designationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb"
+	greennotepb "nhit-note/api/pb/greennotepb"
+)
+
+// approverDesignations asks designation-service which of the organization's
+// designations hold delegated financial powers covering the note, most
+// junior first, so the approval workflow can pick its approvers from them
+func (s *GreenNoteService) approverDesignations(ctx context.Context, note *greennotepb.GreenNotePayload) ([]string, error) {
+	if s.designationClient == nil {
+		return nil, nil
+	}
+	resp, err := s.designationClient.ListApprovingDesignations(s.ensureOutgoingContext(ctx), &designationpb.ListApprovingDesignationsRequest{
+		Amount:          amountOf(note.GetTotalAmount()).String(),
+		ExpenseCategory: budgetCategory(note),
+		ApprovalFor:     designationApprovalFor(note.GetApprovalFor()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(resp.GetDesignations()))
+	for _, d := range resp.GetDesignations() {
+		ids = append(ids, d.GetDesignation().GetId())
+	}
+	return ids, nil
+}
+
+func designationApprovalFor(a greennotepb.ApprovalFor) designationpb.ApprovalFor {
+	switch a {
+	case greennotepb.ApprovalFor_APPROVAL_FOR_INVOICE:
+		return designationpb.ApprovalFor_APPROVAL_FOR_INVOICE
+	case greennotepb.ApprovalFor_APPROVAL_FOR_ADVANCE:
+		return designationpb.ApprovalFor_APPROVAL_FOR_ADVANCE
+	case greennotepb.ApprovalFor_APPROVAL_FOR_ADHOC:
+		return designationpb.ApprovalFor_APPROVAL_FOR_ADHOC
+	}
+	return designationpb.ApprovalFor_APPROVAL_FOR_ANY
+}
+
+// approvalForLabel is the approval type as the approval workflow names it
+func approvalForLabel(a greennotepb.ApprovalFor) string {
+	if a == greennotepb.ApprovalFor_APPROVAL_FOR_UNSPECIFIED {
+		return ""
+	}
+	return designationApprovalFor(a).String()[len("APPROVAL_FOR_"):]
+}
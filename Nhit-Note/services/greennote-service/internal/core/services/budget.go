@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	greennotepb "nhit-note/api/pb/greennotepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// budgetSourceType identifies green notes in project-service's commitment ledger
+const budgetSourceType = "GREENNOTE"
+
+// The commitment ledger lives in project-service: a note reserves its total on
+// its project's budget head when submitted, and project-service turns the
+// reservation into actual spend or releases it as approval events come in.
+// budget_expenditure, actual_expenditure and expenditure_over_budget are read
+// from the head, never taken from the client.
+
+// reserveBudget reserves the note's total on the budget head of its project
+// and expense category for the current financial year, and fills the note's
+// budget fields from the head's position after the reservation.
+func (s *GreenNoteService) reserveBudget(ctx context.Context, orgID, noteID string, note *greennotepb.GreenNotePayload) error {
+	if s.projectClient == nil {
+		return nil
+	}
+	category := budgetCategory(note)
+	amount := amountOf(note.GetTotalAmount())
+	if category == "" || amount.Sign() <= 0 {
+		return nil
+	}
+	project, err := s.findProject(ctx, orgID, note.GetProjectName())
+	if err != nil {
+		return err
+	}
+	if project == nil {
+		return nil // reported by the approval lookup
+	}
+
+	resp, err := s.projectClient.ReserveBudget(s.ensureOutgoingContext(ctx), &projectpb.ReserveBudgetRequest{
+		ProjectId:  project.GetProjectId(),
+		Category:   category,
+		SourceType: budgetSourceType,
+		SourceId:   noteID,
+		Amount:     amount.String(),
+	})
+	if err != nil {
+		return err
+	}
+	return applyBudgetPosition(note, resp.GetPosition())
+}
+
+// reserveBudgetError reports a failed reservation, keeping project-service's
+// code so that an exhausted budget head stays a FailedPrecondition
+func reserveBudgetError(err error) error {
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return status.Errorf(st.Code(), "failed to reserve budget: %s", st.Message())
+	}
+	return status.Errorf(codes.Internal, "failed to reserve budget: %v", err)
+}
+
+// releaseBudget gives a cancelled note's reservation back. Notes that never
+// reserved budget have nothing to release.
+func (s *GreenNoteService) releaseBudget(ctx context.Context, noteID, reason string) error {
+	if s.projectClient == nil {
+		return nil
+	}
+	_, err := s.projectClient.ReleaseBudgetCommitment(s.ensureOutgoingContext(ctx), &projectpb.ReleaseBudgetCommitmentRequest{
+		SourceType: budgetSourceType,
+		SourceId:   noteID,
+		Reason:     reason,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+	return nil
+}
+
+// applyBudgetPosition sets the note's budget as the head's sanctioned amount
+// and its actual expenditure as everything committed and spent on the head,
+// this note included
+func applyBudgetPosition(note *greennotepb.GreenNotePayload, head *projectpb.BudgetHead) error {
+	budget, err := money.Parse(head.GetSanctionedAmount())
+	if err != nil {
+		return fmt.Errorf("invalid sanctioned amount %q: %w", head.GetSanctionedAmount(), err)
+	}
+	committed, err := money.Parse(head.GetCommittedAmount())
+	if err != nil {
+		return fmt.Errorf("invalid committed amount %q: %w", head.GetCommittedAmount(), err)
+	}
+	actual, err := money.Parse(head.GetActualAmount())
+	if err != nil {
+		return fmt.Errorf("invalid actual amount %q: %w", head.GetActualAmount(), err)
+	}
+	setBudgetFields(note, budget, committed.Add(actual))
+	return nil
+}
+
+// setBudgetFields sets the budget, actual and over-budget amounts and their
+// Money twins
+func setBudgetFields(note *greennotepb.GreenNotePayload, budget, actual money.Amount) {
+	over := actual.Sub(budget)
+	note.BudgetExpenditure, note.BudgetExpenditureExact = budget.Float64(), moneyProto(budget)
+	note.ActualExpenditure, note.ActualExpenditureExact = actual.Float64(), moneyProto(actual)
+	note.ExpenditureOverBudget, note.ExpenditureOverBudgetExact = over.Float64(), moneyProto(over)
+}
+
+// keepBudgetFields carries the ledger-filled budget fields of a stored note
+// over client input
+func keepBudgetFields(existing, note *greennotepb.GreenNotePayload) {
+	setBudgetFields(note, amountOf(existing.GetBudgetExpenditure()), amountOf(existing.GetActualExpenditure()))
+}
+
+// budgetCategory is the budget head a note is charged to: its expense
+// category, or the category type for notes without one
+func budgetCategory(note *greennotepb.GreenNotePayload) string {
+	if c := strings.TrimSpace(note.GetExpenseCategory()); c != "" {
+		return c
+	}
+	if t := note.GetExpenseCategoryType(); t != greennotepb.ExpenseCategoryType_EXPENSE_CATEGORY_UNSPECIFIED {
+		return t.String()
+	}
+	return ""
+}
@@ -15,8 +15,10 @@ import (
 	approvalpb "nhit-note/api/pb/approvalpb"
 
 	departmentpb "github.com/ShristiRnr/NHIT_Backend/api/pb/departmentpb"
+	designationpb "github.com/ShristiRnr/NHIT_Backend/api/pb/designationpb"
 	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
 	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -32,6 +34,9 @@ type GreenNoteService struct {
 	vendorClient   vendorpb.VendorServiceClient
 	deptClient     departmentpb.DepartmentServiceClient
 	approvalClient approvalpb.ApprovalServiceClient
+	rounding       money.RoundingMode
+
+	designationClient designationpb.DesignationServiceClient
 }
 
 const (
@@ -67,6 +72,18 @@ func NewGreenNoteService(repo ports.GreenNoteRepository, events ports.EventPubli
 	}
 }
 
+// SetDesignationClient sets the designation-service client consulted for the
+// designations whose financial powers cover a note when initiating approval
+func (s *GreenNoteService) SetDesignationClient(client designationpb.DesignationServiceClient) {
+	s.designationClient = client
+}
+
+// SetRoundingMode sets how amounts finer than a paisa are rounded: legacy
+// double amounts, and tax computed on invoice lines. The default is half-up.
+func (s *GreenNoteService) SetRoundingMode(mode money.RoundingMode) {
+	s.rounding = mode
+}
+
 // UserContext represents authenticated user information
 type UserContext struct {
 	UserID   string
@@ -180,7 +197,12 @@ func (s *GreenNoteService) ListGreenNotes(ctx context.Context, req *greennotepb.
 	// TODO: Add business logic based on user type
 	// For example: VENDORS can only see their own notes, USERS can see notes within their org
 
-	return s.repo.List(ctx, req, userCtx.OrgID, userCtx.TenantID)
+	resp, err := s.repo.List(ctx, req, userCtx.OrgID, userCtx.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	syncExactAmounts(resp)
+	return resp, nil
 }
 
 func (s *GreenNoteService) GetGreenNote(ctx context.Context, req *greennotepb.GetGreenNoteRequest) (*greennotepb.GreenNoteDetailResponse, error) {
@@ -194,6 +216,17 @@ func (s *GreenNoteService) GetGreenNote(ctx context.Context, req *greennotepb.Ge
 		}
 		return nil, err
 	}
+	syncExactAmounts(payload)
+
+	logs, err := s.repo.ListApprovalLogs(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range logs {
+		entry.Description = describeApproval(entry)
+	}
+	payload.ApprovalLogs = logs
+
 	return &greennotepb.GreenNoteDetailResponse{
 		Success: true,
 		Message: "ok",
@@ -201,6 +234,27 @@ func (s *GreenNoteService) GetGreenNote(ctx context.Context, req *greennotepb.Ge
 	}, nil
 }
 
+// describeApproval renders an approval log entry as "APPROVED by A on behalf
+// of B" for entries recorded from a delegate's vote
+func describeApproval(entry *greennotepb.ApprovalLogEntry) string {
+	actor := entry.GetActorName()
+	if actor == "" {
+		actor = entry.GetActorId()
+	}
+	description := entry.GetStatus()
+	if actor != "" {
+		description += " by " + actor
+	}
+	if entry.GetOnBehalfOfId() != "" {
+		onBehalfOf := entry.GetOnBehalfOfName()
+		if onBehalfOf == "" {
+			onBehalfOf = entry.GetOnBehalfOfId()
+		}
+		description += " on behalf of " + onBehalfOf
+	}
+	return description
+}
+
 func (s *GreenNoteService) CreateGreenNote(ctx context.Context, req *greennotepb.CreateGreenNoteRequest) (*greennotepb.GreenNoteResponse, error) {
 	if req == nil || req.Note == nil {
 		return nil, status.Error(codes.InvalidArgument, "note payload is required")
@@ -220,13 +274,27 @@ func (s *GreenNoteService) CreateGreenNote(ctx context.Context, req *greennotepb
 	// For example: VENDORS can only create notes for themselves, USERS can create notes for their org
 
 	note := req.Note
+	// Only drafts opened from vendor portal invoices are linked to one
+	note.VendorInvoiceId = ""
 
+	if err := normalizeAmounts(note, s.rounding); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+	// Budget fields come from the project's commitment ledger on submission
+	setBudgetFields(note, money.Zero, money.Zero)
+	if err := s.applyGSTBreakup(ctx, note, userCtx.TenantID); err != nil {
+		return nil, err
+	}
 	applyDerivedFields(note)
 	normalizeStatusOnCreate(note)
 
 	if err := validateGreenNotePayload(note, true); err != nil {
 		return nil, err
 	}
+	if err := s.ensureProjectOpen(ctx, userCtx.OrgID, note.ProjectName); err != nil {
+		return nil, err
+	}
+	s.resolveSupplierID(ctx, note, userCtx.TenantID)
 	id, err := s.repo.Create(ctx, note, userCtx.OrgID, userCtx.TenantID)
 	if err != nil {
 		return nil, err
@@ -241,6 +309,20 @@ func (s *GreenNoteService) CreateGreenNote(ctx context.Context, req *greennotepb
 		}, nil
 	}
 
+	if err := s.reserveBudget(ctx, userCtx.OrgID, id, note); err != nil {
+		// Not submitted without a budget commitment: the note stays a draft
+		// to be resubmitted once the budget allows
+		note.DetailedStatus = statusDraft
+		note.Status = greennotepb.Status_STATUS_DRAFT
+		if err := s.repo.Update(ctx, id, note, userCtx.OrgID, userCtx.TenantID); err != nil {
+			log.Printf("⚠️ Failed to return green note %s to draft: %v", id, err)
+		}
+		return nil, reserveBudgetError(err)
+	}
+	if err := s.repo.Update(ctx, id, note, userCtx.OrgID, userCtx.TenantID); err != nil {
+		log.Printf("⚠️ Failed to save budget position for green note %s: %v", id, err)
+	}
+
 	// Call Approval Service to initiate approval flow
 	if s.approvalClient != nil {
 		// Ensure Auth Token is propagated
@@ -259,6 +341,15 @@ func (s *GreenNoteService) CreateGreenNote(ctx context.Context, req *greennotepb
 			for _, d := range deptResp.Departments {
 				availableDepts = append(availableDepts, fmt.Sprintf("'%s'", d.Name))
 				if strings.EqualFold(strings.TrimSpace(d.Name), strings.TrimSpace(note.DepartmentName)) {
+					if d.Status == departmentpb.DepartmentStatus_DEPARTMENT_STATUS_INACTIVE {
+						msg := fmt.Sprintf("Department '%s' is closed", d.Name)
+						log.Printf("❌ [ERROR] %s", msg)
+						return &greennotepb.GreenNoteResponse{
+							Success: false,
+							Message: msg,
+							Id:      id,
+						}, nil
+					}
 					departmentID = d.Id
 					log.Printf("✅ [DEBUG] Found Department ID: %s for Name: %s", departmentID, d.Name)
 					break
@@ -281,27 +372,19 @@ func (s *GreenNoteService) CreateGreenNote(ctx context.Context, req *greennotepb
 
 		// 2. Project ID
 		var projectID string
-		availableProjects := []string{}
 		log.Printf("🔍 [DEBUG] Looking up Project Name: '%s' in Org: %s", note.ProjectName, userCtx.OrgID)
-		projResp, err := s.projectClient.ListProjectsByOrganization(ctx, &projectpb.ListProjectsByOrganizationRequest{
-			OrgId: userCtx.OrgID,
-		})
-		if err == nil {
-			for _, p := range projResp.Projects {
-				availableProjects = append(availableProjects, fmt.Sprintf("'%s'", p.ProjectName))
-				if strings.EqualFold(strings.TrimSpace(p.ProjectName), strings.TrimSpace(note.ProjectName)) {
-					projectID = p.ProjectId
-					log.Printf("✅ [DEBUG] Found Project ID: %s for Name: %s", projectID, p.ProjectName)
-					break
-				}
-			}
-		} else {
+		project, err := s.findProject(ctx, userCtx.OrgID, note.ProjectName)
+		if err != nil {
 			log.Printf("⚠️ [DEBUG] Failed to list projects: %v", err)
 			return nil, status.Errorf(codes.Internal, "failed to fetch projects for validation: %v", err)
 		}
+		if project != nil {
+			projectID = project.ProjectId
+			log.Printf("✅ [DEBUG] Found Project ID: %s for Name: %s", projectID, project.ProjectName)
+		}
 
 		if projectID == "" {
-			msg := fmt.Sprintf("Project '%s' not found in Org %s. Available: [%s]", note.ProjectName, userCtx.OrgID, strings.Join(availableProjects, ", "))
+			msg := fmt.Sprintf("Project '%s' not found in Org %s", note.ProjectName, userCtx.OrgID)
 			log.Printf("❌ [ERROR] %s", msg)
 			return &greennotepb.GreenNoteResponse{
 				Success: false,
@@ -310,41 +393,25 @@ func (s *GreenNoteService) CreateGreenNote(ctx context.Context, req *greennotepb
 			}, nil
 		}
 
-
-		// 3. Vendor ID (Requested by user)
-		var vendorID string
-		if note.SupplierName != "" {
-			log.Printf("🔍 [DEBUG] Looking up Vendor Name: '%s' for Tenant: %s", note.SupplierName, userCtx.TenantID)
-			vendorResp, err := s.vendorClient.ListVendors(ctx, &vendorpb.ListVendorsRequest{
-				TenantId: userCtx.TenantID,
-				Search:   &note.SupplierName,
-				Limit:    10, 
-			})
-			if err == nil {
-				// Search is fuzzy, so iterate to find exact match if possible, or take first valid one
-				for _, v := range vendorResp.Vendors {
-					if strings.EqualFold(strings.TrimSpace(v.VendorName), strings.TrimSpace(note.SupplierName)) {
-						vendorID = v.Id
-						log.Printf("✅ [DEBUG] Found Vendor ID: %s", vendorID)
-						break
-					}
-				}
-				if vendorID == "" && len(vendorResp.Vendors) > 0 {
-					log.Printf("⚠️ [DEBUG] Exact match not found for Vendor '%s', ignoring partial matches.", note.SupplierName)
-				}
-			} else {
-				log.Printf("⚠️ [DEBUG] Failed to list vendors: %v", err)
-			}
+		// 3. Designations whose delegated financial powers cover the note (approvers)
+		approverDesignationIDs, err := s.approverDesignations(ctx, note)
+		if err != nil {
+			log.Printf("⚠️ [DEBUG] Failed to look up approving designations: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to fetch approving designations: %v", err)
 		}
+		log.Printf("✅ [DEBUG] %d designation(s) can approve %.2f", len(approverDesignationIDs), note.TotalAmount)
 
-		// 3. Initiate Approval via gRPC
+		// 4. Initiate Approval via gRPC
 		approvalReq := &approvalpb.InitiateApprovalRequest{
-			SlNo:            id,
-			Amount:          note.TotalAmount,
-			DepartmentId:    departmentID,
-			ProjectId:       projectID,
-			CreatedByUserId: userCtx.UserID,
-			SourceType:      "GREENNOTE",
+			SlNo:                   id,
+			Amount:                 note.TotalAmount,
+			DepartmentId:           departmentID,
+			ProjectId:              projectID,
+			CreatedByUserId:        userCtx.UserID,
+			SourceType:             "GREENNOTE",
+			ExpenseCategory:        budgetCategory(note),
+			ApprovalFor:            approvalForLabel(note.GetApprovalFor()),
+			ApproverDesignationIds: approverDesignationIDs,
 		}
 
 		approvalResp, err := s.approvalClient.InitiateApproval(ctx, approvalReq)
@@ -400,19 +467,33 @@ func (s *GreenNoteService) UpdateGreenNote(ctx context.Context, req *greennotepb
 		return nil, status.Error(codes.FailedPrecondition, "only draft notes can be updated")
 	}
 
-	note := req.Note
-	applyDerivedFields(note)
-	normalizeStatusOnUpdate(existing, note)
-
 	// Validate JWT token
 	userCtx, err := s.validateJWT(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	note := req.Note
+	if err := normalizeAmounts(note, s.rounding); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+	if err := s.applyGSTBreakup(ctx, note, userCtx.TenantID); err != nil {
+		return nil, err
+	}
+	keepBudgetFields(existing, note)
+	keepSupplierID(existing, note)
+	s.resolveSupplierID(ctx, note, userCtx.TenantID)
+	applyDerivedFields(note)
+	normalizeStatusOnUpdate(existing, note)
+
 	if err := validateGreenNotePayload(note, false); err != nil {
 		return nil, err
 	}
+	if note.DetailedStatus != statusDraft {
+		if err := s.reserveBudget(ctx, userCtx.OrgID, req.GetId(), note); err != nil {
+			return nil, reserveBudgetError(err)
+		}
+	}
 	if err := s.repo.Update(ctx, req.GetId(), note, userCtx.OrgID, userCtx.TenantID); err != nil {
 		return nil, err
 	}
@@ -447,6 +528,47 @@ func (s *GreenNoteService) UpdateGreenNote(ctx context.Context, req *greennotepb
 	}, nil
 }
 
+// resolveSupplierID fills in the vendor ID of the note's supplier from
+// vendor-service when the client did not send one. Only an exact name match
+// is taken; when none is found the note is saved without a supplier ID.
+func (s *GreenNoteService) resolveSupplierID(ctx context.Context, note *greennotepb.GreenNotePayload, tenantID string) {
+	name := strings.TrimSpace(note.GetSupplierName())
+	if note.GetSupplierId() != "" || name == "" || s.vendorClient == nil {
+		return
+	}
+
+	log.Printf("🔍 [DEBUG] Looking up Vendor Name: '%s' for Tenant: %s", name, tenantID)
+	resp, err := s.vendorClient.ListVendors(s.ensureOutgoingContext(ctx), &vendorpb.ListVendorsRequest{
+		TenantId: tenantID,
+		Search:   &name,
+		Limit:    10,
+	})
+	if err != nil {
+		log.Printf("⚠️ [DEBUG] Failed to list vendors: %v", err)
+		return
+	}
+	// Search is fuzzy, so only an exact match is taken
+	for _, v := range resp.Vendors {
+		if strings.EqualFold(strings.TrimSpace(v.VendorName), name) {
+			note.SupplierId = v.Id
+			log.Printf("✅ [DEBUG] Found Vendor ID: %s", v.Id)
+			return
+		}
+	}
+	log.Printf("⚠️ [DEBUG] Exact match not found for Vendor '%s', ignoring partial matches.", name)
+}
+
+// keepSupplierID carries the stored supplier ID over to an update that names
+// the same supplier without sending its ID
+func keepSupplierID(existing, note *greennotepb.GreenNotePayload) {
+	if note.GetSupplierId() != "" {
+		return
+	}
+	if strings.EqualFold(strings.TrimSpace(existing.GetSupplierName()), strings.TrimSpace(note.GetSupplierName())) {
+		note.SupplierId = existing.GetSupplierId()
+	}
+}
+
 func (s *GreenNoteService) CancelGreenNote(ctx context.Context, req *greennotepb.CancelGreenNoteRequest) (*greennotepb.GreenNoteResponse, error) {
 	if req == nil || strings.TrimSpace(req.GetId()) == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
@@ -466,6 +588,9 @@ func (s *GreenNoteService) CancelGreenNote(ctx context.Context, req *greennotepb
 		}
 		return nil, err
 	}
+	if err := s.releaseBudget(ctx, req.GetId(), req.GetCancelReason()); err != nil {
+		log.Printf("⚠️ Failed to release budget for green note %s: %v", req.GetId(), err)
+	}
 
 	return &greennotepb.GreenNoteResponse{
 		Success: true,
@@ -503,9 +628,11 @@ func (s *GreenNoteService) GetOrganizationProjects(ctx context.Context, req *gre
 		projects[i] = &greennotepb.Project{
 			Id:          p.ProjectId,
 			Name:        p.ProjectName,
-			Code:        "", // Not available in project-service
-			Status:      "Active", // Default
-			Description: "", // Not available in project-service
+			Code:        p.ProjectCode,
+			Status:      projectStatusLabel(p.Status),
+			Description: p.Description,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   p.UpdatedAt,
 		}
 	}
 
@@ -598,13 +725,17 @@ func (s *GreenNoteService) GetOrganizationDepartments(ctx context.Context, req *
 	// Map external departments to local proto
 	departments := make([]*greennotepb.Department, len(resp.Departments))
 	for i, d := range resp.Departments {
+		departmentStatus := "Active"
+		if d.Status == departmentpb.DepartmentStatus_DEPARTMENT_STATUS_INACTIVE {
+			departmentStatus = "Inactive"
+		}
 		departments[i] = &greennotepb.Department{
 			Id:          d.Id,
 			Name:        d.Name,
-			Code:        "", // Not available in department-service
+			Code:        d.CostCentreCode,
 			Description: d.Description,
-			HeadName:    "", // Not available in department-service
-			Status:      "Active",
+			HeadName:    "", // department-service only knows the head's user ID
+			Status:      departmentStatus,
 		}
 	}
 
@@ -628,7 +759,7 @@ func validateGreenNotePayload(p *greennotepb.GreenNotePayload, isCreate bool) er
 		return status.Error(codes.InvalidArgument, "expense_category is required")
 	}
 	_ = isCreate
-	return nil
+	return validateInvoiceAmounts(p)
 }
 
 // noopEventPublisher is used internally when no publisher is provided.
@@ -760,9 +891,7 @@ func applyDerivedFields(p *greennotepb.GreenNotePayload) {
 	// Single invoice: ensure invoice_value is populated, and if top-level
 	// amounts are zero, derive them from the primary invoice.
 	if inv := p.Invoice; inv != nil {
-		if inv.InvoiceValue == 0 {
-			inv.InvoiceValue = inv.TaxableValue + inv.Gst + inv.OtherCharges
-		}
+		fillInvoiceValue(inv)
 		if p.BaseValue == 0 && p.OtherCharges == 0 && p.Gst == 0 {
 			p.BaseValue = inv.TaxableValue
 			p.Gst = inv.Gst
@@ -771,44 +900,39 @@ func applyDerivedFields(p *greennotepb.GreenNotePayload) {
 	}
 
 	// Multiple invoices: aggregate into order amount when enabled.
+	// Totals are summed in exact paise; amounts were normalized on entry.
 	if p.EnableMultipleInvoices {
-		var baseTotal, gstTotal, otherTotal, valueTotal float64
+		var baseTotal, gstTotal, otherTotal, valueTotal money.Amount
 		
-		// Include primary invoice if present
-		if inv := p.Invoice; inv != nil {
-			if inv.InvoiceValue == 0 {
-				inv.InvoiceValue = inv.TaxableValue + inv.Gst + inv.OtherCharges
-			}
-			baseTotal += inv.TaxableValue
-			gstTotal += inv.Gst
-			otherTotal += inv.OtherCharges
-			valueTotal += inv.InvoiceValue
-		}
-
-		// Include multiple invoices
-		for _, inv := range p.Invoices {
+		// Include primary invoice if present, then the multiple invoices
+		for _, inv := range append([]*greennotepb.InvoiceInput{p.Invoice}, p.Invoices...) {
 			if inv == nil {
 				continue
 			}
-			if inv.InvoiceValue == 0 {
-				inv.InvoiceValue = inv.TaxableValue + inv.Gst + inv.OtherCharges
-			}
-			baseTotal += inv.TaxableValue
-			gstTotal += inv.Gst
-			otherTotal += inv.OtherCharges
-			valueTotal += inv.InvoiceValue
+			fillInvoiceValue(inv)
+			baseTotal = baseTotal.Add(amountOf(inv.TaxableValue))
+			gstTotal = gstTotal.Add(amountOf(inv.Gst))
+			otherTotal = otherTotal.Add(amountOf(inv.OtherCharges))
+			valueTotal = valueTotal.Add(amountOf(inv.InvoiceValue))
 		}
-		p.BaseValue = baseTotal
-		p.Gst = gstTotal
-		p.OtherCharges = otherTotal
-		p.TotalAmount = valueTotal
+		p.BaseValue = baseTotal.Float64()
+		p.Gst = gstTotal.Float64()
+		p.OtherCharges = otherTotal.Float64()
+		p.TotalAmount = valueTotal.Float64()
 	} else {
 		// No multi-invoice aggregation: compute total from order amount fields.
-		p.TotalAmount = p.BaseValue + p.OtherCharges + p.Gst
+		p.TotalAmount = money.Sum(amountOf(p.BaseValue), amountOf(p.OtherCharges), amountOf(p.Gst)).Float64()
 	}
 
 	// Budget: over/under budget is always actual - budget.
-	p.ExpenditureOverBudget = p.ActualExpenditure - p.BudgetExpenditure
+	p.ExpenditureOverBudget = amountOf(p.ActualExpenditure).Sub(amountOf(p.BudgetExpenditure)).Float64()
+}
+
+// fillInvoiceValue derives a missing invoice_value from its components
+func fillInvoiceValue(inv *greennotepb.InvoiceInput) {
+	if inv.InvoiceValue == 0 {
+		inv.InvoiceValue = money.Sum(amountOf(inv.TaxableValue), amountOf(inv.Gst), amountOf(inv.OtherCharges)).Float64()
+	}
 }
 
 // publishApprovedIfNeeded emits a GreenNoteApprovedEvent when a note
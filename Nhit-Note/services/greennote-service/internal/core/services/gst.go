@@ -0,0 +1,404 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"nhit-note/services/greennote-service/internal/core/ports"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/gstin"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	greennotepb "nhit-note/api/pb/greennotepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gstDateLayout is the invoice date format required once GST details are given
+const gstDateLayout = "2006-01-02"
+
+// hsnSacRegex accepts 4, 6 or 8 digit HSN codes and 6 digit SAC codes
+var hsnSacRegex = regexp.MustCompile(`^[0-9]{4}([0-9]{2}){0,2}$`)
+
+// applyGSTBreakup validates GSTINs and computes the CGST/SGST/IGST/cess split
+// of every invoice that carries GST details. It runs before applyDerivedFields
+// so the taxable value and GST derived from invoice lines flow into the note
+// totals.
+func (s *GreenNoteService) applyGSTBreakup(ctx context.Context, p *greennotepb.GreenNotePayload, tenantID string) error {
+	orgGSTIN := gstin.Normalize(p.GetOrganizationGstin())
+	if orgGSTIN != "" {
+		if err := validateGSTIN("organization_gstin", orgGSTIN); err != nil {
+			return err
+		}
+	}
+	p.OrganizationGstin = orgGSTIN
+
+	var vendorGSTIN *string
+	for _, inv := range noteInvoices(p) {
+		if !hasGSTDetails(inv, orgGSTIN) {
+			continue
+		}
+
+		supplierGSTIN := gstin.Normalize(inv.GetSupplierGstin())
+		if supplierGSTIN == "" {
+			if vendorGSTIN == nil {
+				number := s.vendorGSTIN(ctx, p.GetSupplierName(), tenantID)
+				vendorGSTIN = &number
+			}
+			supplierGSTIN = *vendorGSTIN
+		}
+		if supplierGSTIN == "" {
+			return status.Errorf(codes.InvalidArgument, "invoice %s: supplier_gstin is required as vendor '%s' has no GSTIN", inv.GetInvoiceNumber(), p.GetSupplierName())
+		}
+		if err := validateGSTIN(fmt.Sprintf("invoice %s: supplier_gstin", inv.GetInvoiceNumber()), supplierGSTIN); err != nil {
+			return err
+		}
+		inv.SupplierGstin = supplierGSTIN
+
+		placeOfSupply := strings.TrimSpace(inv.GetPlaceOfSupply())
+		if placeOfSupply == "" && orgGSTIN != "" {
+			placeOfSupply = orgGSTIN[:2]
+		}
+		if placeOfSupply == "" {
+			return status.Errorf(codes.InvalidArgument, "invoice %s: place_of_supply or organization_gstin is required", inv.GetInvoiceNumber())
+		}
+		if _, ok := gstin.StateName(placeOfSupply); !ok {
+			return status.Errorf(codes.InvalidArgument, "invoice %s: unknown place_of_supply state code %q", inv.GetInvoiceNumber(), placeOfSupply)
+		}
+		inv.PlaceOfSupply = placeOfSupply
+
+		if _, err := time.Parse(gstDateLayout, strings.TrimSpace(inv.GetInvoiceDate())); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invoice %s: invoice_date must be YYYY-MM-DD", inv.GetInvoiceNumber())
+		}
+
+		if err := computeInvoiceGST(inv, supplierGSTIN[:2] != placeOfSupply, s.rounding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// vendorGSTIN looks up the GSTIN of the note's vendor by exact name; empty when
+// the vendor is unknown or has no GSTIN
+func (s *GreenNoteService) vendorGSTIN(ctx context.Context, supplierName, tenantID string) string {
+	if s.vendorClient == nil || strings.TrimSpace(supplierName) == "" {
+		return ""
+	}
+	resp, err := s.vendorClient.ListVendors(s.ensureOutgoingContext(ctx), &vendorpb.ListVendorsRequest{
+		TenantId: tenantID,
+		Search:   &supplierName,
+		Limit:    10,
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to look up GSTIN of vendor '%s': %v", supplierName, err)
+		return ""
+	}
+	for _, v := range resp.Vendors {
+		if strings.EqualFold(strings.TrimSpace(v.VendorName), strings.TrimSpace(supplierName)) {
+			return gstin.Normalize(v.GetGstin())
+		}
+	}
+	return ""
+}
+
+// computeInvoiceGST derives the tax split of an invoice. With lines, each line
+// is taxed at its rate and the invoice's taxable value and GST are their sums;
+// GST excludes reverse-charge lines as that tax is not paid to the supplier.
+// Without lines, the invoice GST is split by supply type. Tax on each line is
+// rounded to the paisa with mode.
+func computeInvoiceGST(inv *greennotepb.InvoiceInput, interState bool, mode money.RoundingMode) error {
+	inv.SupplyType = greennotepb.SupplyType_SUPPLY_TYPE_INTRA_STATE
+	if interState {
+		inv.SupplyType = greennotepb.SupplyType_SUPPLY_TYPE_INTER_STATE
+	}
+	if len(inv.GetLines()) == 0 {
+		return splitInvoiceGST(inv, interState)
+	}
+
+	var taxable, cgst, sgst, igst, cess, forwardTax money.Amount
+	var sumErr error
+	add := func(total *money.Amount, a money.Amount) {
+		if sumErr == nil {
+			*total, sumErr = total.AddChecked(a)
+		}
+	}
+	for i, line := range inv.GetLines() {
+		if line == nil {
+			return status.Errorf(codes.InvalidArgument, "invoice %s: line %d is empty", inv.GetInvoiceNumber(), i+1)
+		}
+		line.HsnSac = strings.TrimSpace(line.GetHsnSac())
+		if !hsnSacRegex.MatchString(line.HsnSac) {
+			return status.Errorf(codes.InvalidArgument, "invoice %s line %d: hsn_sac must be a 4, 6 or 8 digit HSN/SAC code", inv.GetInvoiceNumber(), i+1)
+		}
+		if line.GetTaxableValue() < 0 {
+			return status.Errorf(codes.InvalidArgument, "invoice %s line %d: taxable_value must not be negative", inv.GetInvoiceNumber(), i+1)
+		}
+		if line.GetGstRate() < 0 || line.GetGstRate() > 100 || line.GetCessRate() < 0 || line.GetCessRate() > 100 {
+			return status.Errorf(codes.InvalidArgument, "invoice %s line %d: gst_rate and cess_rate must be between 0 and 100", inv.GetInvoiceNumber(), i+1)
+		}
+
+		base := amountOf(line.GetTaxableValue())
+		var lineCGST, lineSGST, lineIGST, lineCess money.Amount
+		var err error
+		if interState {
+			lineIGST, err = base.Percent(line.GetGstRate(), mode)
+		} else {
+			lineCGST, err = base.Percent(line.GetGstRate()/2, mode)
+			lineSGST = lineCGST
+		}
+		if err == nil {
+			lineCess, err = base.Percent(line.GetCessRate(), mode)
+		}
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invoice %s line %d: %v", inv.GetInvoiceNumber(), i+1, err)
+		}
+
+		line.Cgst, line.Sgst, line.Igst, line.Cess = lineCGST.Float64(), lineSGST.Float64(), lineIGST.Float64(), lineCess.Float64()
+
+		add(&taxable, base)
+		add(&cgst, lineCGST)
+		add(&sgst, lineSGST)
+		add(&igst, lineIGST)
+		add(&cess, lineCess)
+		if !line.GetReverseCharge() {
+			for _, tax := range []money.Amount{lineCGST, lineSGST, lineIGST, lineCess} {
+				add(&forwardTax, tax)
+			}
+		}
+	}
+	if sumErr != nil {
+		return status.Errorf(codes.InvalidArgument, "invoice %s: %v", inv.GetInvoiceNumber(), sumErr)
+	}
+
+	inv.TaxableValue = taxable.Float64()
+	inv.Cgst, inv.Sgst, inv.Igst, inv.Cess = cgst.Float64(), sgst.Float64(), igst.Float64(), cess.Float64()
+	inv.Gst = forwardTax.Float64()
+	return nil
+}
+
+// splitInvoiceGST checks a split given on an invoice without lines against its
+// supply type, or splits the invoice GST when none is given
+func splitInvoiceGST(inv *greennotepb.InvoiceInput, interState bool) error {
+	cgst, sgst, igst, cess := amountOf(inv.GetCgst()), amountOf(inv.GetSgst()), amountOf(inv.GetIgst()), amountOf(inv.GetCess())
+	if money.Sum(cgst.Abs(), sgst.Abs(), igst.Abs(), cess.Abs()).IsZero() {
+		gst := amountOf(inv.GetGst())
+		if interState {
+			inv.Igst = gst.Float64()
+		} else {
+			// an odd paisa goes to CGST so the halves always add up
+			half := money.FromPaise(gst.Paise() / 2)
+			inv.Cgst = gst.Sub(half).Float64()
+			inv.Sgst = half.Float64()
+		}
+		return nil
+	}
+
+	if interState && !(cgst.IsZero() && sgst.IsZero()) {
+		return status.Errorf(codes.InvalidArgument, "invoice %s: inter-state supply is taxed as IGST only", inv.GetInvoiceNumber())
+	}
+	if !interState && !igst.IsZero() {
+		return status.Errorf(codes.InvalidArgument, "invoice %s: intra-state supply is taxed as CGST and SGST only", inv.GetInvoiceNumber())
+	}
+	inv.Gst = money.Sum(cgst, sgst, igst, cess).Float64()
+	return nil
+}
+
+// validateInvoiceAmounts checks that every invoice's taxable value, taxes and
+// other charges add up to its invoice value to the paisa
+func validateInvoiceAmounts(p *greennotepb.GreenNotePayload) error {
+	for _, inv := range noteInvoices(p) {
+		sum, err := money.SumChecked(amountOf(inv.GetTaxableValue()), amountOf(inv.GetGst()), amountOf(inv.GetOtherCharges()))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invoice %s: %v", inv.GetInvoiceNumber(), err)
+		}
+		if value := amountOf(inv.GetInvoiceValue()); !sum.Equal(value) {
+			return status.Errorf(codes.InvalidArgument,
+				"invoice %s: taxable_value + taxes + other_charges (%s) must equal invoice_value (%s)",
+				inv.GetInvoiceNumber(), sum, value)
+		}
+	}
+	return nil
+}
+
+// hasGSTDetails reports whether an invoice's GST breakup should be computed:
+// it has lines or a supplier GSTIN or place of supply, or carries GST on a note
+// billed to a GST-registered organisation
+func hasGSTDetails(inv *greennotepb.InvoiceInput, orgGSTIN string) bool {
+	return len(inv.GetLines()) > 0 ||
+		strings.TrimSpace(inv.GetSupplierGstin()) != "" ||
+		strings.TrimSpace(inv.GetPlaceOfSupply()) != "" ||
+		(orgGSTIN != "" && inv.GetGst() != 0)
+}
+
+// noteInvoices returns the primary and additional invoices of a note
+func noteInvoices(p *greennotepb.GreenNotePayload) []*greennotepb.InvoiceInput {
+	var invoices []*greennotepb.InvoiceInput
+	if p.GetInvoice() != nil {
+		invoices = append(invoices, p.GetInvoice())
+	}
+	for _, inv := range p.GetInvoices() {
+		if inv != nil {
+			invoices = append(invoices, inv)
+		}
+	}
+	return invoices
+}
+
+// validateGSTIN checks format, state code, entity code and check character
+func validateGSTIN(field, number string) error {
+	if err := gstin.Validate(number); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", field, err)
+	}
+	return nil
+}
+
+// ExportGSTR2BData exports the organization's invoice GST breakup in the
+// GSTR-2B B2B layout so it can be matched against the GST portal statement
+func (s *GreenNoteService) ExportGSTR2BData(ctx context.Context, req *greennotepb.ExportGSTR2BDataRequest) (*greennotepb.ExportGSTR2BDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	userCtx, err := s.validateJWT(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := time.Parse(gstDateLayout, strings.TrimSpace(req.GetFromDate()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "from_date must be YYYY-MM-DD")
+	}
+	to, err := time.Parse(gstDateLayout, strings.TrimSpace(req.GetToDate()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "to_date must be YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return nil, status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+
+	invoices, err := s.repo.ListGSTInvoices(ctx, userCtx.OrgID, from.Format(gstDateLayout), to.Format(gstDateLayout))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list GST invoices: %v", err)
+	}
+	rows := gstr2bRows(invoices)
+	content, err := gstr2bCSV(rows)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write GSTR-2B export: %v", err)
+	}
+
+	fmt.Printf("📤 ExportGSTR2BData - Org: %s, %s to %s, %d invoices, %d rows\n",
+		userCtx.OrgID, from.Format(gstDateLayout), to.Format(gstDateLayout), len(invoices), len(rows))
+
+	return &greennotepb.ExportGSTR2BDataResponse{
+		Rows:       rows,
+		CsvContent: content,
+		Filename:   fmt.Sprintf("gstr2b-%s-to-%s.csv", from.Format(gstDateLayout), to.Format(gstDateLayout)),
+	}, nil
+}
+
+// gstr2bRows groups invoice lines by GST rate and reverse-charge flag, one row
+// per group as GSTR-2B reports them. An invoice without lines is one row at its
+// effective rate.
+func gstr2bRows(invoices []ports.GSTInvoice) []*greennotepb.GSTR2BRow {
+	type rateKey struct {
+		rate          float64
+		reverseCharge bool
+	}
+
+	var rows []*greennotepb.GSTR2BRow
+	for _, gi := range invoices {
+		inv := gi.Invoice
+		newRow := func(rate float64, reverseCharge bool) *greennotepb.GSTR2BRow {
+			return &greennotepb.GSTR2BRow{
+				SupplierGstin: inv.GetSupplierGstin(),
+				SupplierName:  gi.SupplierName,
+				InvoiceNumber: inv.GetInvoiceNumber(),
+				InvoiceDate:   inv.GetInvoiceDate(),
+				InvoiceValue:  inv.GetInvoiceValue(),
+				PlaceOfSupply: inv.GetPlaceOfSupply(),
+				ReverseCharge: reverseCharge,
+				GstRate:       rate,
+				GreenNoteId:   gi.GreenNoteID,
+			}
+		}
+
+		if len(inv.GetLines()) == 0 {
+			row := newRow(0, false)
+			if taxable := amountOf(inv.GetTaxableValue()); taxable.Sign() > 0 {
+				tax := money.Sum(amountOf(inv.GetCgst()), amountOf(inv.GetSgst()), amountOf(inv.GetIgst()))
+				rate, _ := new(big.Rat).Quo(tax.Rat(), taxable.Rat()).Float64()
+				row.GstRate = math.Round(rate*10000) / 100
+			}
+			row.TaxableValue, row.Igst, row.Cgst, row.Sgst, row.Cess = inv.GetTaxableValue(), inv.GetIgst(), inv.GetCgst(), inv.GetSgst(), inv.GetCess()
+			rows = append(rows, row)
+			continue
+		}
+
+		groups := make(map[rateKey]*greennotepb.GSTR2BRow)
+		for _, line := range inv.GetLines() {
+			key := rateKey{rate: line.GetGstRate(), reverseCharge: line.GetReverseCharge()}
+			row, ok := groups[key]
+			if !ok {
+				row = newRow(key.rate, key.reverseCharge)
+				groups[key] = row
+				rows = append(rows, row)
+			}
+			row.TaxableValue = amountOf(row.TaxableValue).Add(amountOf(line.GetTaxableValue())).Float64()
+			row.Igst = amountOf(row.Igst).Add(amountOf(line.GetIgst())).Float64()
+			row.Cgst = amountOf(row.Cgst).Add(amountOf(line.GetCgst())).Float64()
+			row.Sgst = amountOf(row.Sgst).Add(amountOf(line.GetSgst())).Float64()
+			row.Cess = amountOf(row.Cess).Add(amountOf(line.GetCess())).Float64()
+		}
+	}
+	return rows
+}
+
+// gstr2bCSV writes rows with GSTR-2B B2B column headings, dates as DD-MM-YYYY
+// and place of supply as the state name
+func gstr2bCSV(rows []*greennotepb.GSTR2BRow) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := []string{
+		"GSTIN of supplier", "Trade/Legal name", "Invoice number", "Invoice Date", "Invoice Value (₹)",
+		"Place of supply", "Supply Attract Reverse Charge", "Rate (%)", "Taxable Value (₹)",
+		"Integrated Tax (₹)", "Central Tax (₹)", "State/UT Tax (₹)", "Cess (₹)", "Green Note ID",
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	amount := func(v float64) string { return amountOf(v).String() }
+	for _, r := range rows {
+		invoiceDate := r.GetInvoiceDate()
+		if t, err := time.Parse(gstDateLayout, invoiceDate); err == nil {
+			invoiceDate = t.Format("02-01-2006")
+		}
+		placeOfSupply := r.GetPlaceOfSupply()
+		if name, ok := gstin.StateName(placeOfSupply); ok {
+			placeOfSupply = name
+		}
+		reverseCharge := "N"
+		if r.GetReverseCharge() {
+			reverseCharge = "Y"
+		}
+		record := []string{
+			r.GetSupplierGstin(), r.GetSupplierName(), r.GetInvoiceNumber(), invoiceDate, amount(r.GetInvoiceValue()),
+			placeOfSupply, reverseCharge, strconv.FormatFloat(r.GetGstRate(), 'f', -1, 64), amount(r.GetTaxableValue()),
+			amount(r.GetIgst()), amount(r.GetCgst()), amount(r.GetSgst()), amount(r.GetCess()), r.GetGreenNoteId(),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	greennotepb "nhit-note/api/pb/greennotepb"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// exactSuffix names the Money twin of a double amount field
+const exactSuffix = "_exact"
+
+// normalizeAmounts brings every amount in a request to whole paise. Amounts
+// given as Money twins win over their doubles; doubles from older clients are
+// read through their shortest decimal form and rounded with mode. Both
+// representations hold the same value afterwards.
+func normalizeAmounts(m protoreflect.ProtoMessage, mode money.RoundingMode) error {
+	return reconcileAmounts(m.ProtoReflect(), mode, true)
+}
+
+// syncExactAmounts sets every Money twin from its double, after the doubles
+// were derived or loaded from storage
+func syncExactAmounts(m protoreflect.ProtoMessage) {
+	// doubles hold whole paise by now, so the rounding mode never applies
+	_ = reconcileAmounts(m.ProtoReflect(), money.HalfUp, false)
+}
+
+func reconcileAmounts(m protoreflect.Message, mode money.RoundingMode, fromExact bool) error {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case fd.Kind() == protoreflect.DoubleKind && fd.Cardinality() != protoreflect.Repeated:
+			exact := fields.ByName(fd.Name() + exactSuffix)
+			if exact == nil || exact.Message() == nil || exact.Message().FullName() != moneyName {
+				continue
+			}
+			a, err := readAmount(m, fd, exact, mode, fromExact)
+			if err != nil {
+				return err
+			}
+			m.Set(fd, protoreflect.ValueOfFloat64(a.Float64()))
+			m.Set(exact, protoreflect.ValueOfMessage(moneyProto(a).ProtoReflect()))
+
+		case fd.Message() != nil && fd.Message().FullName() != moneyName && !fd.IsMap():
+			if !m.Has(fd) {
+				continue
+			}
+			if fd.IsList() {
+				list := m.Get(fd).List()
+				for j := 0; j < list.Len(); j++ {
+					if err := reconcileAmounts(list.Get(j).Message(), mode, fromExact); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := reconcileAmounts(m.Get(fd).Message(), mode, fromExact); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var moneyName = (&greennotepb.Money{}).ProtoReflect().Descriptor().FullName()
+
+func readAmount(m protoreflect.Message, fd, exact protoreflect.FieldDescriptor, mode money.RoundingMode, fromExact bool) (money.Amount, error) {
+	if fromExact && m.Has(exact) {
+		mp := m.Get(exact).Message().Interface().(*greennotepb.Money)
+		a, err := amountFromProto(mp, mode)
+		if err != nil {
+			return money.Zero, fmt.Errorf("%s: %w", exact.Name(), err)
+		}
+		return a, nil
+	}
+	a, err := money.FromFloat(m.Get(fd).Float(), mode)
+	if err != nil {
+		return money.Zero, fmt.Errorf("%s: %w", fd.Name(), err)
+	}
+	return a, nil
+}
+
+// amountFromProto reads a Money message, preferring its decimal string
+func amountFromProto(mp *greennotepb.Money, mode money.RoundingMode) (money.Amount, error) {
+	if v := strings.TrimSpace(mp.GetValue()); v != "" {
+		return money.ParseRound(v, mode)
+	}
+	return money.FromUnitsNanos(mp.GetUnits(), mp.GetNanos(), mode)
+}
+
+func moneyProto(a money.Amount) *greennotepb.Money {
+	units, nanos := a.UnitsNanos()
+	return &greennotepb.Money{Value: a.String(), Units: units, Nanos: nanos}
+}
+
+// amountOf reads a double amount that has been normalized to whole paise
+func amountOf(v float64) money.Amount {
+	a, _ := money.FromFloat(v, money.HalfUp)
+	return a
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
+	greennotepb "nhit-note/api/pb/greennotepb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CountOrganizationGreenNotes counts an organization's green notes in every
+// status. Organization-service refuses to purge an organization while any
+// remain, since notes name their project rather than reference it.
+func (s *GreenNoteService) CountOrganizationGreenNotes(ctx context.Context, req *greennotepb.CountOrganizationGreenNotesRequest) (*greennotepb.CountOrganizationGreenNotesResponse, error) {
+	orgID := strings.TrimSpace(req.GetOrgId())
+	if orgID == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !middleware.Authorize(ctx, policy.Resource{OrgID: orgID}, "delete-organizations").Allowed {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions on organization")
+	}
+
+	count, err := s.repo.CountByOrganization(ctx, orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count green notes: %v", err)
+	}
+	return &greennotepb.CountOrganizationGreenNotesResponse{Count: count}, nil
+}
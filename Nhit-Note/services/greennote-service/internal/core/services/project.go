@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	projectpb "github.com/ShristiRnr/NHIT_Backend/api/pb/projectpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	greennotepb "nhit-note/api/pb/greennotepb"
+	"nhit-note/services/greennote-service/internal/core/ports"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// findProject looks a project up by name in the organization, including
+// closed and archived ones. It returns nil when there is no such project.
+func (s *GreenNoteService) findProject(ctx context.Context, orgID, name string) (*projectpb.Project, error) {
+	resp, err := s.projectClient.ListProjectsByOrganization(s.ensureOutgoingContext(ctx), &projectpb.ListProjectsByOrganizationRequest{
+		OrgId:           orgID,
+		ProjectName:     strings.TrimSpace(name),
+		IncludeArchived: true,
+		Page:            1,
+		PageSize:        1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetProjects()) == 0 {
+		return nil, nil
+	}
+	return resp.GetProjects()[0], nil
+}
+
+// ensureProjectOpen rejects notes against closed or archived projects. Unknown
+// projects are left to the approval lookup, which reports them.
+func (s *GreenNoteService) ensureProjectOpen(ctx context.Context, orgID, projectName string) error {
+	if s.projectClient == nil || strings.TrimSpace(projectName) == "" {
+		return nil
+	}
+	project, err := s.findProject(ctx, orgID, projectName)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to check project '%s': %v", projectName, err)
+	}
+	if project == nil {
+		return nil
+	}
+	switch project.GetStatus() {
+	case projectpb.ProjectStatus_PROJECT_STATUS_CLOSED, projectpb.ProjectStatus_PROJECT_STATUS_ARCHIVED:
+		return status.Errorf(codes.FailedPrecondition, "project '%s' is %s and accepts no new green notes",
+			project.GetProjectName(), strings.ToLower(projectStatusLabel(project.GetStatus())))
+	}
+	return nil
+}
+
+// GetProjectSpend rolls the organization's green notes on a project up by
+// expense category against the project's sanctioned budget heads
+func (s *GreenNoteService) GetProjectSpend(ctx context.Context, req *greennotepb.GetProjectSpendRequest) (*greennotepb.GetProjectSpendResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetProjectId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	userCtx, err := s.validateJWT(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.projectClient == nil {
+		return nil, status.Error(codes.Unavailable, "project service is not configured")
+	}
+
+	projResp, err := s.projectClient.GetProject(s.ensureOutgoingContext(ctx), &projectpb.GetProjectRequest{ProjectId: req.GetProjectId()})
+	if err != nil {
+		return nil, err
+	}
+	project := projResp.GetProject()
+	if project.GetOrgId() != userCtx.OrgID {
+		return nil, status.Error(codes.NotFound, "project not found")
+	}
+	if !middleware.Can(ctx, policy.Resource{OrgID: project.GetOrgId(), ProjectID: project.GetProjectId()}, "view-all-notes") {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions on project")
+	}
+
+	rows, err := s.repo.ProjectSpend(ctx, userCtx.OrgID, project.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to total project spend: %v", err)
+	}
+
+	sanctioned, err := money.Parse(project.GetSanctionedBudget())
+	if err != nil && project.GetSanctionedBudget() != "" {
+		return nil, status.Errorf(codes.Internal, "invalid sanctioned budget %q: %v", project.GetSanctionedBudget(), err)
+	}
+	heads, err := projectSpendHeads(project.GetBudgetHeads(), rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var approved, pending money.Amount
+	var notes int32
+	for _, r := range rows {
+		if r.Approved {
+			approved = approved.Add(r.Total)
+		} else {
+			pending = pending.Add(r.Total)
+		}
+		notes += int32(r.NoteCount)
+	}
+
+	fmt.Printf("📊 GetProjectSpend - Org: %s, Project: %s, %d notes, approved %s, pending %s of %s\n",
+		userCtx.OrgID, project.GetProjectName(), notes, approved, pending, sanctioned)
+
+	return &greennotepb.GetProjectSpendResponse{
+		ProjectId:        project.GetProjectId(),
+		ProjectName:      project.GetProjectName(),
+		ProjectCode:      project.GetProjectCode(),
+		ProjectStatus:    projectStatusLabel(project.GetStatus()),
+		SanctionedBudget: moneyProto(sanctioned),
+		Approved:         moneyProto(approved),
+		Pending:          moneyProto(pending),
+		Available:        moneyProto(sanctioned.Sub(approved).Sub(pending)),
+		NoteCount:        notes,
+		Heads:            heads,
+	}, nil
+}
+
+// projectSpendHeads lays the spend rows over the budget heads, matching
+// categories case-insensitively. Budget heads come first in their order,
+// followed by categories that were spent on without a head.
+func projectSpendHeads(budget []*projectpb.BudgetHead, rows []ports.ProjectSpendRow) ([]*greennotepb.ProjectSpendHead, error) {
+	type head struct {
+		category                      string
+		sanctioned, approved, pending money.Amount
+		notes                         int32
+	}
+	byKey := make(map[string]*head)
+	var order []*head
+	get := func(category string) *head {
+		key := strings.ToLower(strings.TrimSpace(category))
+		h, ok := byKey[key]
+		if !ok {
+			h = &head{category: strings.TrimSpace(category)}
+			byKey[key] = h
+			order = append(order, h)
+		}
+		return h
+	}
+
+	for _, b := range budget {
+		amount, err := money.Parse(b.GetSanctionedAmount())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid amount %q for budget head %q: %v", b.GetSanctionedAmount(), b.GetCategory(), err)
+		}
+		h := get(b.GetCategory())
+		h.sanctioned = h.sanctioned.Add(amount)
+	}
+	headCount := len(order)
+
+	for _, r := range rows {
+		h := get(r.ExpenseCategory)
+		if r.Approved {
+			h.approved = h.approved.Add(r.Total)
+		} else {
+			h.pending = h.pending.Add(r.Total)
+		}
+		h.notes += int32(r.NoteCount)
+	}
+	unbudgeted := order[headCount:]
+	sort.SliceStable(unbudgeted, func(i, j int) bool { return unbudgeted[i].category < unbudgeted[j].category })
+
+	result := make([]*greennotepb.ProjectSpendHead, 0, len(order))
+	for _, h := range order {
+		result = append(result, &greennotepb.ProjectSpendHead{
+			Category:   h.category,
+			Sanctioned: moneyProto(h.sanctioned),
+			Approved:   moneyProto(h.approved),
+			Pending:    moneyProto(h.pending),
+			Available:  moneyProto(h.sanctioned.Sub(h.approved).Sub(h.pending)),
+			NoteCount:  h.notes,
+		})
+	}
+	return result, nil
+}
+
+// projectStatusLabel is the status shown for a project, "Active" for projects
+// created before project statuses existed
+func projectStatusLabel(s projectpb.ProjectStatus) string {
+	switch s {
+	case projectpb.ProjectStatus_PROJECT_STATUS_CLOSED:
+		return "Closed"
+	case projectpb.ProjectStatus_PROJECT_STATUS_ARCHIVED:
+		return "Archived"
+	}
+	return "Active"
+}
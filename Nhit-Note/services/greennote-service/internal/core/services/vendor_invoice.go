@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	greennotepb "nhit-note/api/pb/greennotepb"
+	"nhit-note/services/greennote-service/internal/core/ports"
+)
+
+// OpenVendorInvoiceDraft implements ports.VendorInvoiceIntake. The draft
+// carries what the vendor submitted: supplier, invoice number, date and
+// amount, PO number and description. Project, department and expense
+// category are left for the reviewer to fill in before submitting the note.
+func (s *GreenNoteService) OpenVendorInvoiceDraft(ctx context.Context, tenantID string, inv ports.VendorInvoice) (string, bool, error) {
+	id, err := s.repo.FindByVendorInvoice(ctx, tenantID, inv.InvoiceID)
+	if err == nil {
+		return id, false, nil
+	}
+	if !errors.Is(err, ports.ErrNotFound) {
+		return "", false, err
+	}
+
+	amount := moneyProto(inv.Amount)
+	note := &greennotepb.GreenNotePayload{
+		SupplierName:         inv.VendorName,
+		SupplierId:           inv.VendorID,
+		VendorInvoiceId:      inv.InvoiceID,
+		WorkOrderNo:          inv.PONumber,
+		PoNumber:             inv.PONumber,
+		BriefOfGoodsServices: inv.Description,
+		Status:               greennotepb.Status_STATUS_DRAFT,
+		DetailedStatus:       statusDraft,
+		BaseValueExact:       amount,
+		Invoice: &greennotepb.InvoiceInput{
+			InvoiceNumber:     inv.InvoiceNumber,
+			InvoiceDate:       inv.InvoiceDate,
+			TaxableValueExact: amount,
+			InvoiceValueExact: amount,
+		},
+	}
+	if err := normalizeAmounts(note, s.rounding); err != nil {
+		return "", false, fmt.Errorf("invalid invoice amount: %w", err)
+	}
+	applyDerivedFields(note)
+
+	id, err = s.repo.Create(ctx, note, inv.OrgID, tenantID)
+	if errors.Is(err, ports.ErrAlreadyExists) {
+		// Opened concurrently from a redelivered event
+		id, err = s.repo.FindByVendorInvoice(ctx, tenantID, inv.InvoiceID)
+		return id, false, err
+	}
+	if err != nil {
+		return "", false, err
+	}
+	log.Printf("📝 Draft green note %s opened from vendor invoice %s of %s", id, inv.InvoiceNumber, inv.VendorName)
+	return id, true, nil
+}
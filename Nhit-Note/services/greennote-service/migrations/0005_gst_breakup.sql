@@ -0,0 +1,40 @@
+-- GST breakup and input tax credit data on green note invoices
+
+-- GSTIN of the organisation registration the invoices are billed to
+ALTER TABLE green_notes ADD COLUMN IF NOT EXISTS organization_gstin VARCHAR(15);
+
+ALTER TABLE green_note_invoices ADD COLUMN IF NOT EXISTS supplier_gstin VARCHAR(15);
+ALTER TABLE green_note_invoices ADD COLUMN IF NOT EXISTS place_of_supply VARCHAR(2);
+ALTER TABLE green_note_invoices ADD COLUMN IF NOT EXISTS supply_type TEXT;
+ALTER TABLE green_note_invoices ADD COLUMN IF NOT EXISTS cgst DECIMAL(20, 2) NOT NULL DEFAULT 0;
+ALTER TABLE green_note_invoices ADD COLUMN IF NOT EXISTS sgst DECIMAL(20, 2) NOT NULL DEFAULT 0;
+ALTER TABLE green_note_invoices ADD COLUMN IF NOT EXISTS igst DECIMAL(20, 2) NOT NULL DEFAULT 0;
+ALTER TABLE green_note_invoices ADD COLUMN IF NOT EXISTS cess DECIMAL(20, 2) NOT NULL DEFAULT 0;
+
+-- Lookup for GSTR-2B matching
+CREATE INDEX IF NOT EXISTS idx_green_note_invoices_supplier_gstin ON green_note_invoices(supplier_gstin, invoice_number) WHERE supplier_gstin IS NOT NULL;
+
+-- ================
+-- green_note_invoice_lines (UUID PK + FK -> green_note_invoices.id)
+-- ================
+CREATE TABLE IF NOT EXISTS green_note_invoice_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    invoice_id UUID NOT NULL REFERENCES green_note_invoices(id) ON DELETE CASCADE,
+    line_no INT NOT NULL,
+
+    description TEXT,
+    hsn_sac VARCHAR(8) NOT NULL,
+    taxable_value DECIMAL(20, 2) NOT NULL DEFAULT 0,
+    gst_rate DECIMAL(6, 3) NOT NULL DEFAULT 0,
+    cess_rate DECIMAL(6, 3) NOT NULL DEFAULT 0,
+    reverse_charge BOOLEAN NOT NULL DEFAULT FALSE,
+    cgst DECIMAL(20, 2) NOT NULL DEFAULT 0,
+    sgst DECIMAL(20, 2) NOT NULL DEFAULT 0,
+    igst DECIMAL(20, 2) NOT NULL DEFAULT 0,
+    cess DECIMAL(20, 2) NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (invoice_id, line_no)
+);
+
+CREATE INDEX IF NOT EXISTS idx_green_note_invoice_lines_invoice_id ON green_note_invoice_lines(invoice_id);
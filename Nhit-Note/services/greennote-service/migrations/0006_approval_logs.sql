@@ -0,0 +1,17 @@
+-- Approval history of green notes as reported by the approval service
+
+CREATE TABLE IF NOT EXISTS green_note_approval_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    green_note_id UUID NOT NULL REFERENCES green_notes(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    actor_id UUID,
+    actor_name TEXT,
+    -- Set when the actor approved as the delegate of a user on leave
+    on_behalf_of_id UUID,
+    on_behalf_of_name TEXT,
+    delegation_id UUID,
+    comments TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_green_note_approval_logs_note ON green_note_approval_logs(green_note_id, created_at);
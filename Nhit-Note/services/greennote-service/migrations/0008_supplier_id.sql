@@ -0,0 +1,6 @@
+-- vendor-service ID of a green note's supplier, so vendor merges re-point
+-- notes by vendor rather than by supplier name
+
+ALTER TABLE green_notes ADD COLUMN IF NOT EXISTS supplier_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_green_notes_supplier_id ON green_notes(tenant_id, supplier_id) WHERE supplier_id IS NOT NULL;
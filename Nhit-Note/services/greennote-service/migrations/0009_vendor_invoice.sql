@@ -0,0 +1,7 @@
+-- Vendor portal invoice a draft green note was opened from. One note per
+-- invoice, so a redelivered vendor.invoice.submitted event opens no second
+-- draft.
+
+ALTER TABLE green_notes ADD COLUMN IF NOT EXISTS vendor_invoice_id UUID;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_green_notes_vendor_invoice ON green_notes(tenant_id, vendor_invoice_id) WHERE vendor_invoice_id IS NOT NULL;
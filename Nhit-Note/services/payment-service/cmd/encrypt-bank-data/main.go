@@ -0,0 +1,54 @@
+// Command encrypt-bank-data encrypts payment bank details that were stored
+// before field encryption was introduced. It is idempotent: values that are
+// already encrypted are left untouched, so it can be re-run safely. Run it
+// with -dry-run first to check the keys against every pending row.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	_ "github.com/lib/pq"
+)
+
+var tables = []fieldcrypt.Table{
+	{Name: "payments", Key: "id", Columns: []string{"full_account_number", "account_number", "ifsc_code"}},
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report the rows that would be encrypted without changing them")
+	batchSize := flag.Int("batch-size", fieldcrypt.DefaultBatchSize, "rows updated per transaction")
+	flag.Parse()
+
+	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		getEnv("DB_HOST", "localhost"), getEnv("DB_PORT", "5432"), getEnv("DB_USER", "postgres"),
+		getEnv("DB_PASSWORD", "postgres"), getEnv("DB_NAME", "nhit_payments"))
+
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	keyManager, err := fieldcrypt.NewLocalKeyManagerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize field encryption: %v", err)
+	}
+
+	opts := fieldcrypt.BackfillOptions{BatchSize: *batchSize, DryRun: *dryRun}
+	if err := fieldcrypt.BackfillAll(context.Background(), db, fieldcrypt.NewEncryptor(keyManager), tables, nil, opts); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
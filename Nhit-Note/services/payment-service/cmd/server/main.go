@@ -1,19 +1,29 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"time"
 
+	authpb "github.com/ShristiRnr/NHIT_Backend/api/pb/authpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	sharedmiddleware "github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	paymentpb "nhit-note/api/pb/paymentpb"
+	"nhit-note/services/payment-service/internal/adapters/grpc/handler"
 	"nhit-note/services/payment-service/internal/adapters/repository"
+	"nhit-note/services/payment-service/internal/adapters/vendorclient"
 	"nhit-note/services/payment-service/internal/config"
+	"nhit-note/services/payment-service/internal/core/services"
 )
 
 func main() {
@@ -24,6 +34,7 @@ func main() {
 	dbPassword := getEnv("DB_PASSWORD", "postgres")
 	dbName := getEnv("DB_NAME", "nhit_payments")
 	grpcPort := getEnv("GRPC_PORT", "50054")
+	httpPort := getEnv("HTTP_PORT", "8084")
 
 	// Connect to database
 	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
@@ -48,16 +59,65 @@ func main() {
 	}
 	log.Println("✅ Connected to database")
 
+	// Field encryption for bank details
+	keyManager, err := fieldcrypt.NewLocalKeyManagerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize field encryption: %v", err)
+	}
+	encryptor := fieldcrypt.NewEncryptor(keyManager)
+
+	// Vendor-service verifies that beneficiary accounts are approved
+	vendorAddr := getEnv("VENDOR_SERVICE_ADDR", "localhost:50058")
+	vendorConn, err := grpc.Dial(vendorAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to vendor-service: %v", err)
+	}
+	defer vendorConn.Close()
+	log.Printf("✅ Payee verification via vendor-service at %s", vendorAddr)
+
 	// Initialize layers
-	repo := repository.NewPaymentRepository(db)
-	_ = repo // service := services.NewPaymentService(repo)
-	// handler := grpcadapter.NewPaymentHandler(service)
+	repo := repository.NewPaymentRepository(db, encryptor)
+	service := services.NewPaymentService(repo, vendorclient.NewPayeeVerifier(vendorConn))
+	paymentHandler := handler.NewPaymentHandler(service)
+
+	// Auth-service validates tokens for the RBAC interceptor
+	authAddr := getEnv("AUTH_SERVICE_ADDR", "localhost:50052")
+	authConn, err := grpc.Dial(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to auth-service: %v", err)
+	}
+	defer authConn.Close()
+
+	rbacInterceptor := sharedmiddleware.NewRBACInterceptor(authpb.NewAuthServiceClient(authConn))
+	for method, perms := range config.GetPermissionMap() {
+		rbacInterceptor.RegisterPermissions(method, perms)
+	}
+	for _, method := range config.GetPublicMethods() {
+		rbacInterceptor.RegisterPublicMethod(method)
+	}
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(rbacInterceptor.UnaryServerInterceptor()),
+	)
 
 	// Register service
-	// paymentpb.RegisterPaymentServiceServer(grpcServer, handler)
+	paymentpb.RegisterPaymentServiceServer(grpcServer, paymentHandler)
+	log.Println("✅ Payment Service registered")
+
+	// HTTP gateway, proxied through the gRPC server so REST calls pass the
+	// RBAC interceptor and the payee checks alike
+	gatewayMux := runtime.NewServeMux()
+	gatewayOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := paymentpb.RegisterPaymentServiceHandlerFromEndpoint(context.Background(), gatewayMux, "localhost:"+grpcPort, gatewayOpts); err != nil {
+		log.Fatalf("Failed to register HTTP gateway: %v", err)
+	}
+	go func() {
+		log.Printf("🌐 HTTP gateway listening on port %s", httpPort)
+		if err := http.ListenAndServe(":"+httpPort, gatewayMux); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("HTTP gateway failed: %v", err)
+		}
+	}()
 
 	// Start listening
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", grpcPort))
@@ -69,8 +129,6 @@ func main() {
 	log.Printf("📝 Permissions configured for %d endpoints", len(config.GetPermissionMap()))
 	log.Printf("🔓 Public endpoints: %d", len(config.GetPublicMethods()))
 
-	_ = paymentpb.PaymentServiceServer(nil) // Placeholder to avoid unused import error
-
 	if err := grpcServer.Serve(listener); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
@@ -1,12 +1,39 @@
 module nhit-note/services/payment-service
 
-go 1.21
+go 1.24.2
 
 require (
-	google.golang.org/grpc v1.59.0
-	google.golang.org/protobuf v1.31.0
+	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0-00010101000000-000000000000
+	github.com/ShristiRnr/NHIT_Backend/pkg/money v0.0.0
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3
 	github.com/lib/pq v1.10.9
+	google.golang.org/grpc v1.77.0
+	google.golang.org/protobuf v1.36.10
+	nhit-note/api/pb/common v0.0.0
 	nhit-note/api/pb/paymentpb v0.0.0
 )
 
+require (
+	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
+	golang.org/x/sys v0.37.0 // indirect
+	golang.org/x/text v0.30.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20251124214823-79d6a2a48846 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba // indirect
+)
+
 replace nhit-note/api/pb/paymentpb => ../../api/pb/paymentpb
+
+replace nhit-note/api/pb/common => ../../api/pb/common
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt => "../../../NHIT Backend/pkg/fieldcrypt"
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/money => "../../../NHIT Backend/pkg/money"
+
+replace github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb => "../../../NHIT Backend/api/pb/vendorpb"
+
+replace github.com/ShristiRnr/NHIT_Backend/api/pb/authpb => "../../../NHIT Backend/api/pb/authpb"
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/middleware => "../../../NHIT Backend/pkg/middleware"
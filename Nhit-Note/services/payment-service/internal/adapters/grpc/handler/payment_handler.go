@@ -0,0 +1,425 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware/policy"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	commonpb "nhit-note/api/pb/common"
+	paymentpb "nhit-note/api/pb/paymentpb"
+	"nhit-note/services/payment-service/internal/config"
+	"nhit-note/services/payment-service/internal/core/domain"
+	"nhit-note/services/payment-service/internal/core/services"
+)
+
+type PaymentHandler struct {
+	paymentpb.UnimplementedPaymentServiceServer
+	service *services.PaymentService
+}
+
+// NewPaymentHandler creates a new payment handler
+func NewPaymentHandler(service *services.PaymentService) *PaymentHandler {
+	return &PaymentHandler{
+		service: service,
+	}
+}
+
+// ListPayments lists payment groups
+func (h *PaymentHandler) ListPayments(ctx context.Context, req *paymentpb.ListPaymentsRequest) (*paymentpb.ListPaymentsResponse, error) {
+	filters := domain.PaymentFilters{
+		OnlyAssigned: req.GetOnlyAssigned(),
+		UserID:       req.GetAuthUserId(),
+		Page:         req.GetPage(),
+		PerPage:      req.GetPerPage(),
+	}
+	statusFilter := req.GetStatus()
+	if req.GetDraftsOnly() {
+		statusFilter = domain.PaymentStatusDraft
+	} else if statusFilter == "" {
+		statusFilter = domain.PaymentStatusSubmitted
+	}
+	filters.Status = &statusFilter
+	if search := strings.TrimSpace(req.GetSearch()); search != "" {
+		filters.Search = &search
+	}
+
+	groups, total, err := h.service.ListPaymentGroups(ctx, filters)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payments: %v", err)
+	}
+
+	resp := &paymentpb.ListPaymentsResponse{
+		Pagination: &commonpb.Pagination{
+			Page:       max(filters.Page, 1),
+			PerPage:    filters.PerPage,
+			TotalItems: total,
+		},
+	}
+	if filters.PerPage > 0 {
+		resp.Pagination.TotalPages = int32((total + int64(filters.PerPage) - 1) / int64(filters.PerPage))
+	}
+	reveal := canRevealBankDetails(ctx)
+	for _, g := range groups {
+		resp.Groups = append(resp.Groups, groupToProto(g, reveal))
+	}
+	return resp, nil
+}
+
+// GetPaymentGroup returns the payments of a group
+func (h *PaymentHandler) GetPaymentGroup(ctx context.Context, req *paymentpb.GetPaymentGroupRequest) (*paymentpb.PaymentGroupResponse, error) {
+	if req.GetSlNo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "sl_no is required")
+	}
+	group, err := h.service.GetPaymentGroup(ctx, req.GetSlNo())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "payment group %s not found", req.GetSlNo())
+	}
+	return &paymentpb.PaymentGroupResponse{Group: groupToProto(group, canRevealBankDetails(ctx))}, nil
+}
+
+// CreatePaymentRequests creates a payment group. Bank transfers are checked
+// against the approved vendor accounts before anything is stored.
+func (h *PaymentHandler) CreatePaymentRequests(ctx context.Context, req *paymentpb.CreatePaymentRequestsRequest) (*paymentpb.PaymentGroupResponse, error) {
+	if len(req.GetVendors()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one payment is required")
+	}
+
+	slNo, err := h.service.GenerateSerialNumber(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate serial number: %v", err)
+	}
+
+	payments := make([]domain.Payment, 0, len(req.GetVendors()))
+	for i, item := range req.GetVendors() {
+		amount, err := itemAmount(item.GetAmountExact(), item.GetAmount())
+		if err != nil || amount.Sign() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "payment %d: amount must be positive", i+1)
+		}
+		p := domain.Payment{
+			SlNo:              slNo,
+			TemplateType:      item.GetTemplateType(),
+			Project:           optional(item.GetProject()),
+			AccountFullName:   optional(item.GetAccountFullName()),
+			FromAccountType:   optional(item.GetFromAccountType()),
+			FullAccountNumber: optional(item.GetFromAccountNumber()),
+			ToAccountType:     optional(item.GetToAccountType()),
+			NameOfBeneficiary: optional(item.GetBeneficiaryName()),
+			AccountNumber:     optional(item.GetBeneficiaryAccountNumber()),
+			NameOfBank:        optional(item.GetBeneficiaryBankName()),
+			IfscCode:          optional(item.GetIfscCode()),
+			Amount:            amount,
+			Purpose:           optional(item.GetPurpose()),
+			Status:            domain.PaymentStatusSubmitted,
+			UserID:            req.GetCreatedBy(),
+		}
+		if item.GetPaymentNoteId() != 0 {
+			id := item.GetPaymentNoteId()
+			p.PaymentNoteID = &id
+		}
+		payments = append(payments, p)
+	}
+
+	if err := h.service.CreatePaymentRequests(ctx, slNo, payments); err != nil {
+		return nil, paymentError(err)
+	}
+
+	group, err := h.service.GetPaymentGroup(ctx, slNo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load payment group: %v", err)
+	}
+	return &paymentpb.PaymentGroupResponse{Group: groupToProto(group, canRevealBankDetails(ctx))}, nil
+}
+
+// UpdatePaymentGroup updates the payments of a group and/or its status.
+// Beneficiary accounts are re-checked on every change that can lead to money
+// leaving the bank.
+func (h *PaymentHandler) UpdatePaymentGroup(ctx context.Context, req *paymentpb.UpdatePaymentGroupRequest) (*paymentpb.PaymentGroupResponse, error) {
+	if req.GetSlNo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "sl_no is required")
+	}
+
+	if !req.GetStatusOnly() && len(req.GetVendors()) > 0 {
+		current, err := h.service.GetPaymentGroup(ctx, req.GetSlNo())
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "payment group %s not found", req.GetSlNo())
+		}
+		byID := make(map[int64]domain.Payment, len(current.Payments))
+		for _, p := range current.Payments {
+			byID[p.ID] = p
+		}
+
+		payments := make([]domain.Payment, 0, len(req.GetVendors()))
+		for _, item := range req.GetVendors() {
+			p, ok := byID[item.GetId()]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "payment %d is not part of group %s", item.GetId(), req.GetSlNo())
+			}
+			amount, err := itemAmount(item.GetAmountExact(), item.GetAmount())
+			if err != nil || amount.Sign() <= 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "payment %d: amount must be positive", item.GetId())
+			}
+			p.TemplateType = item.GetTemplateType()
+			p.Project = optional(item.GetProject())
+			p.AccountFullName = optional(item.GetAccountFullName())
+			p.FromAccountType = optional(item.GetFromAccountType())
+			p.FullAccountNumber = optional(item.GetFromAccountNumber())
+			p.ToAccountType = optional(item.GetToAccountType())
+			p.NameOfBeneficiary = optional(item.GetBeneficiaryName())
+			p.AccountNumber = optional(item.GetBeneficiaryAccountNumber())
+			p.NameOfBank = optional(item.GetBeneficiaryBankName())
+			p.IfscCode = optional(item.GetIfscCode())
+			p.Amount = amount
+			p.Purpose = optional(item.GetPurpose())
+			if item.GetStatus() != "" {
+				p.Status = item.GetStatus()
+			}
+			payments = append(payments, p)
+		}
+
+		if err := h.service.UpdatePayments(ctx, req.GetSlNo(), payments); err != nil {
+			return nil, paymentError(err)
+		}
+	}
+
+	if req.GetStatus() != "" {
+		if err := h.service.UpdatePaymentGroupStatus(ctx, req.GetSlNo(), req.GetStatus()); err != nil {
+			return nil, paymentError(err)
+		}
+	}
+
+	group, err := h.service.GetPaymentGroup(ctx, req.GetSlNo())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load payment group: %v", err)
+	}
+	return &paymentpb.PaymentGroupResponse{Group: groupToProto(group, canRevealBankDetails(ctx))}, nil
+}
+
+// DeletePayment deletes a payment group
+func (h *PaymentHandler) DeletePayment(ctx context.Context, req *paymentpb.DeletePaymentRequest) (*emptypb.Empty, error) {
+	if req.GetSlNo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "sl_no is required")
+	}
+	if err := h.service.DeletePaymentGroup(ctx, req.GetSlNo()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete payment group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeletePaymentItem deletes one payment of a group
+func (h *PaymentHandler) DeletePaymentItem(ctx context.Context, req *paymentpb.DeletePaymentItemRequest) (*emptypb.Empty, error) {
+	if req.GetSlNo() == "" || req.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sl_no and id are required")
+	}
+	if err := h.service.DeletePayment(ctx, req.GetSlNo(), req.GetId()); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ProcessBankLetterLog records an approval or rejection of a group's bank
+// letter. When the RBAC interceptor admitted the call under a delegation, the
+// log names the delegator the reviewer acted for.
+func (h *PaymentHandler) ProcessBankLetterLog(ctx context.Context, req *paymentpb.ProcessBankLetterLogRequest) (*paymentpb.ProcessBankLetterLogResponse, error) {
+	if req.GetSlNo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "sl_no is required")
+	}
+	logStatus := strings.ToUpper(strings.TrimSpace(req.GetStatus()))
+	if logStatus != "A" && logStatus != "R" {
+		return nil, status.Error(codes.InvalidArgument, "status must be A or R")
+	}
+
+	entry := &domain.BankLetterApprovalLog{
+		SlNo:       req.GetSlNo(),
+		Status:     logStatus,
+		Comments:   optional(req.GetRemarks()),
+		ReviewerID: req.GetReviewerId(),
+	}
+	if name, err := middleware.GetUserNameFromContext(ctx); err == nil {
+		entry.ReviewerName = &name
+	}
+	if email, err := middleware.GetUserEmailFromContext(ctx); err == nil {
+		entry.ReviewerEmail = &email
+	}
+	if grant, ok := middleware.GetOnBehalfOfFromContext(ctx); ok {
+		entry.OnBehalfOfID = optional(grant.GetDelegatorId())
+		entry.OnBehalfOfName = optional(grant.GetDelegatorName())
+		entry.DelegationID = optional(grant.GetDelegationId())
+	}
+
+	created, err := h.service.AddBankLetterLog(ctx, entry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record bank letter log: %v", err)
+	}
+	message := "Bank letter approved"
+	if logStatus == "R" {
+		message = "Bank letter rejected"
+	}
+	return &paymentpb.ProcessBankLetterLogResponse{
+		Message: message,
+		Log:     bankLetterLogToProto(created),
+	}, nil
+}
+
+// GeneratePaymentSerialNumber returns the next payment group serial number
+func (h *PaymentHandler) GeneratePaymentSerialNumber(ctx context.Context, _ *emptypb.Empty) (*paymentpb.GeneratePaymentSerialNumberResponse, error) {
+	slNo, err := h.service.GenerateSerialNumber(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate serial number: %v", err)
+	}
+	return &paymentpb.GeneratePaymentSerialNumberResponse{SlNo: slNo}, nil
+}
+
+// CountOrganizationPayments counts an organization's payments. Callers outside
+// the organization need SUPER_ADMIN.
+func (h *PaymentHandler) CountOrganizationPayments(ctx context.Context, req *paymentpb.CountOrganizationPaymentsRequest) (*paymentpb.CountOrganizationPaymentsResponse, error) {
+	if req.GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	if !middleware.Authorize(ctx, policy.Resource{OrgID: req.GetOrgId()}, "delete-organizations").Allowed {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions on organization")
+	}
+
+	count, err := h.service.CountOrganizationPayments(ctx, req.GetOrgId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count payments: %v", err)
+	}
+	return &paymentpb.CountOrganizationPaymentsResponse{Count: count}, nil
+}
+
+// TestPaymentAPI reports that the service is up
+func (h *PaymentHandler) TestPaymentAPI(ctx context.Context, _ *emptypb.Empty) (*paymentpb.TestPaymentAPIResponse, error) {
+	return &paymentpb.TestPaymentAPIResponse{
+		Status:    "ok",
+		Message:   "payment service is running",
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+// paymentError maps service errors to gRPC status codes
+func paymentError(err error) error {
+	var notApproved *domain.PayeeNotApprovedError
+	if errors.As(err, &notApproved) {
+		return status.Error(codes.FailedPrecondition, notApproved.Error())
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+// groupToProto converts a payment group to protobuf; bank fields are masked unless reveal is set
+func groupToProto(g *domain.PaymentGroup, reveal bool) *paymentpb.PaymentGroup {
+	pg := &paymentpb.PaymentGroup{
+		SlNo:      g.SlNo,
+		Status:    g.Status,
+		CreatedAt: g.CreatedAt.Format(time.RFC3339),
+	}
+	var total money.Amount
+	for i := range g.Payments {
+		p := &g.Payments[i]
+		total = total.Add(p.Amount)
+		pg.Payments = append(pg.Payments, paymentToProto(p, reveal))
+		if pg.TemplateType == "" {
+			pg.TemplateType = p.TemplateType
+		}
+		if p.UpdatedAt.After(g.CreatedAt) {
+			pg.UpdatedAt = p.UpdatedAt.Format(time.RFC3339)
+		}
+	}
+	pg.NetPayableRoundOff = total.Float64()
+	pg.NetPayableRoundOffExact = moneyProto(total)
+	return pg
+}
+
+func bankLetterLogToProto(l *domain.BankLetterApprovalLog) *paymentpb.BankLetterApprovalLog {
+	return &paymentpb.BankLetterApprovalLog{
+		Id:       l.ID,
+		SlNo:     l.SlNo,
+		Status:   l.Status,
+		Comments: deref(l.Comments),
+		Reviewer: &commonpb.RelatedUser{
+			Id:    l.ReviewerID,
+			Name:  deref(l.ReviewerName),
+			Email: deref(l.ReviewerEmail),
+		},
+		CreatedAt:      l.CreatedAt.Format(time.RFC3339),
+		OnBehalfOfId:   deref(l.OnBehalfOfID),
+		OnBehalfOfName: deref(l.OnBehalfOfName),
+		DelegationId:   deref(l.DelegationID),
+	}
+}
+
+// canRevealBankDetails reports whether the caller may see unmasked bank details
+func canRevealBankDetails(ctx context.Context) bool {
+	return middleware.HasPermission(ctx, config.RevealBankDetailsPermission)
+}
+
+func paymentToProto(p *domain.Payment, reveal bool) *paymentpb.Payment {
+	fullAccountNumber, accountNumber, ifscCode := deref(p.FullAccountNumber), deref(p.AccountNumber), deref(p.IfscCode)
+	if !reveal {
+		fullAccountNumber = fieldcrypt.Mask(fullAccountNumber)
+		accountNumber, ifscCode = fieldcrypt.Mask(accountNumber), fieldcrypt.Mask(ifscCode)
+	}
+	pb := &paymentpb.Payment{
+		Id:                p.ID,
+		SlNo:              p.SlNo,
+		TemplateType:      p.TemplateType,
+		Project:           deref(p.Project),
+		AccountFullName:   deref(p.AccountFullName),
+		FromAccountType:   deref(p.FromAccountType),
+		FullAccountNumber: fullAccountNumber,
+		To:                deref(p.ToAccount),
+		ToAccountType:     deref(p.ToAccountType),
+		NameOfBeneficiary: deref(p.NameOfBeneficiary),
+		AccountNumber:     accountNumber,
+		NameOfBank:        deref(p.NameOfBank),
+		IfscCode:          ifscCode,
+		Amount:            p.Amount.Float64(),
+		AmountExact:       moneyProto(p.Amount),
+		Purpose:           deref(p.Purpose),
+		Status:            p.Status,
+		UserId:            p.UserID,
+		CreatedAt:         p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         p.UpdatedAt.Format(time.RFC3339),
+	}
+	if p.PaymentNoteID != nil {
+		pb.PaymentNoteId = *p.PaymentNoteID
+	}
+	return pb
+}
+
+// itemAmount reads a request amount; the exact twin wins over the double when set
+func itemAmount(exact *paymentpb.Money, amount float64) (money.Amount, error) {
+	if exact == nil {
+		return money.FromFloat(amount, money.HalfUp)
+	}
+	if v := strings.TrimSpace(exact.GetValue()); v != "" {
+		return money.ParseRound(v, money.HalfUp)
+	}
+	return money.FromUnitsNanos(exact.GetUnits(), exact.GetNanos(), money.HalfUp)
+}
+
+func moneyProto(a money.Amount) *paymentpb.Money {
+	units, nanos := a.UnitsNanos()
+	return &paymentpb.Money{Value: a.String(), Units: units, Nanos: nanos}
+}
+
+func optional(s string) *string {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil
+	}
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+
 	"nhit-note/services/payment-service/internal/adapters/repository/sqlc/generated"
 	"nhit-note/services/payment-service/internal/core/domain"
 	"nhit-note/services/payment-service/internal/core/ports"
@@ -14,13 +17,16 @@ import (
 type paymentRepository struct {
 	db      *sql.DB
 	queries *generated.Queries
+	crypt   *fieldcrypt.Encryptor
 }
 
-// NewPaymentRepository creates a new payment repository
-func NewPaymentRepository(db *sql.DB) ports.PaymentRepository {
+// NewPaymentRepository creates a new payment repository.
+// Account numbers and IFSC codes are encrypted with crypt before they are stored.
+func NewPaymentRepository(db *sql.DB, crypt *fieldcrypt.Encryptor) ports.PaymentRepository {
 	return &paymentRepository{
 		db:      db,
 		queries: generated.New(db),
+		crypt:   crypt,
 	}
 }
 
@@ -35,28 +41,38 @@ func (r *paymentRepository) CreatePaymentRequests(ctx context.Context, slNo stri
 	qtx := r.queries.WithTx(tx)
 
 	for _, payment := range payments {
-		_, err := qtx.CreatePayment(ctx, generated.CreatePaymentParams{
+		bank, err := r.encryptBankDetails(ctx, &payment)
+		if err != nil {
+			return err
+		}
+
+		created, err := qtx.CreatePayment(ctx, generated.CreatePaymentParams{
 			SlNo:              slNo,
 			TemplateType:      generated.TemplateType(payment.TemplateType),
 			Project:           sqlNullString(payment.Project),
 			AccountFullName:   sqlNullString(payment.AccountFullName),
 			FromAccountType:   sqlNullString(payment.FromAccountType),
-			FullAccountNumber: sqlNullString(payment.FullAccountNumber),
+			FullAccountNumber: sqlNullString(bank.FullAccountNumber),
 			ToAccount:         sqlNullString(payment.ToAccount),
 			ToAccountType:     sqlNullString(payment.ToAccountType),
 			NameOfBeneficiary: sqlNullString(payment.NameOfBeneficiary),
-			AccountNumber:     sqlNullString(payment.AccountNumber),
+			AccountNumber:     sqlNullString(bank.AccountNumber),
 			NameOfBank:        sqlNullString(payment.NameOfBank),
-			IfscCode:          sqlNullString(payment.IfscCode),
-			Amount:            fmt.Sprintf("%.2f", payment.Amount),
+			IfscCode:          sqlNullString(bank.IfscCode),
+			Amount:            payment.Amount.String(),
 			Purpose:           sqlNullString(payment.Purpose),
-			Status:            generated.PaymentStatus(payment.Status),
+			Status:            generated.NullPaymentStatus{PaymentStatus: generated.PaymentStatus(payment.Status), Valid: true},
 			UserID:            payment.UserID,
 			PaymentNoteID:     sqlNullInt64(payment.PaymentNoteID),
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create payment: %w", err)
 		}
+		if payment.OrgID != nil {
+			if err := setOrganization(ctx, tx, created.ID, *payment.OrgID); err != nil {
+				return err
+			}
+		}
 	}
 
 	return tx.Commit()
@@ -81,7 +97,9 @@ func (r *paymentRepository) GetPaymentGroup(ctx context.Context, slNo string) (*
 	}
 
 	for i, p := range payments {
-		group.Payments[i] = r.toDomain(&p)
+		if group.Payments[i], err = r.toDomain(ctx, &p); err != nil {
+			return nil, err
+		}
 	}
 
 	return group, nil
@@ -97,7 +115,10 @@ func (r *paymentRepository) GetPaymentByID(ctx context.Context, id int64) (*doma
 		return nil, fmt.Errorf("failed to get payment: %w", err)
 	}
 
-	result := r.toDomain(&payment)
+	result, err := r.toDomain(ctx, &payment)
+	if err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
@@ -105,8 +126,8 @@ func (r *paymentRepository) GetPaymentByID(ctx context.Context, id int64) (*doma
 func (r *paymentRepository) List(ctx context.Context, filters domain.PaymentFilters) ([]*domain.PaymentGroup, int64, error) {
 	// Count total groups
 	count, err := r.queries.CountPaymentGroups(ctx, generated.CountPaymentGroupsParams{
-		Column1: sqlNullString(filters.Status),
-		Column2: sql.NullBool{Bool: filters.OnlyAssigned, Valid: filters.OnlyAssigned},
+		Column1: sqlNullString(filters.Status).String,
+		Column2: filters.OnlyAssigned,
 		UserID:  filters.UserID,
 	})
 	if err != nil {
@@ -116,8 +137,8 @@ func (r *paymentRepository) List(ctx context.Context, filters domain.PaymentFilt
 	// Get groups
 	offset := (filters.Page - 1) * filters.PerPage
 	rows, err := r.queries.ListPayments(ctx, generated.ListPaymentsParams{
-		Column1: sqlNullString(filters.Status),
-		Column2: sql.NullBool{Bool: filters.OnlyAssigned, Valid: filters.OnlyAssigned},
+		Column1: sqlNullString(filters.Status).String,
+		Column2: filters.OnlyAssigned,
 		UserID:  filters.UserID,
 		Limit:   filters.PerPage,
 		Offset:  offset,
@@ -141,22 +162,27 @@ func (r *paymentRepository) List(ctx context.Context, filters domain.PaymentFilt
 
 // UpdatePayment updates a single payment
 func (r *paymentRepository) UpdatePayment(ctx context.Context, payment *domain.Payment) error {
-	_, err := r.queries.UpdatePayment(ctx, generated.UpdatePaymentParams{
+	bank, err := r.encryptBankDetails(ctx, payment)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.UpdatePayment(ctx, generated.UpdatePaymentParams{
 		ID:                payment.ID,
 		TemplateType:      generated.TemplateType(payment.TemplateType),
 		Project:           sqlNullString(payment.Project),
 		AccountFullName:   sqlNullString(payment.AccountFullName),
 		FromAccountType:   sqlNullString(payment.FromAccountType),
-		FullAccountNumber: sqlNullString(payment.FullAccountNumber),
+		FullAccountNumber: sqlNullString(bank.FullAccountNumber),
 		ToAccount:         sqlNullString(payment.ToAccount),
 		ToAccountType:     sqlNullString(payment.ToAccountType),
 		NameOfBeneficiary: sqlNullString(payment.NameOfBeneficiary),
-		AccountNumber:     sqlNullString(payment.AccountNumber),
+		AccountNumber:     sqlNullString(bank.AccountNumber),
 		NameOfBank:        sqlNullString(payment.NameOfBank),
-		IfscCode:          sqlNullString(payment.IfscCode),
-		Amount:            fmt.Sprintf("%.2f", payment.Amount),
+		IfscCode:          sqlNullString(bank.IfscCode),
+		Amount:            payment.Amount.String(),
 		Purpose:           sqlNullString(payment.Purpose),
-		Status:            generated.PaymentStatus(payment.Status),
+		Status:            generated.NullPaymentStatus{PaymentStatus: generated.PaymentStatus(payment.Status), Valid: true},
 	})
 	return err
 }
@@ -165,7 +191,7 @@ func (r *paymentRepository) UpdatePayment(ctx context.Context, payment *domain.P
 func (r *paymentRepository) UpdatePaymentGroupStatus(ctx context.Context, slNo string, status string) error {
 	return r.queries.UpdatePaymentStatus(ctx, generated.UpdatePaymentStatusParams{
 		SlNo:   slNo,
-		Status: generated.PaymentStatus(status),
+		Status: generated.NullPaymentStatus{PaymentStatus: generated.PaymentStatus(status), Valid: true},
 	})
 }
 
@@ -255,7 +281,7 @@ func (r *paymentRepository) ListShortcuts(ctx context.Context, userID int64) ([]
 
 // GenerateSerialNumber generates the next payment serial number
 func (r *paymentRepository) GenerateSerialNumber(ctx context.Context, prefix string) (string, error) {
-	nextNum, err := r.queries.GenerateSerialNumber(ctx, prefix)
+	nextNum, err := r.queries.GenerateSerialNumber(ctx, sql.NullString{String: prefix, Valid: true})
 	if err != nil {
 		return "", err
 	}
@@ -267,17 +293,23 @@ func (r *paymentRepository) GenerateSerialNumber(ctx context.Context, prefix str
 }
 
 // AddBankLetterLog adds a bank letter approval log
-func (r *paymentRepository) AddBankLetterLog(ctx context.Context, log *domain.BankLetterApprovalLog) error {
-	_, err := r.queries.InsertBankLetterLog(ctx, generated.InsertBankLetterLogParams{
-		SlNo:          log.SlNo,
-		Status:        log.Status,
-		Comments:      sqlNullString(log.Comments),
-		ReviewerID:    log.ReviewerID,
-		ReviewerName:  sqlNullString(log.ReviewerName),
-		ReviewerEmail: sqlNullString(log.ReviewerEmail),
-		ApproverLevel: sqlNullInt32(log.ApproverLevel),
+func (r *paymentRepository) AddBankLetterLog(ctx context.Context, log *domain.BankLetterApprovalLog) (*domain.BankLetterApprovalLog, error) {
+	created, err := r.queries.InsertBankLetterLog(ctx, generated.InsertBankLetterLogParams{
+		SlNo:           log.SlNo,
+		Status:         log.Status,
+		Comments:       sqlNullString(log.Comments),
+		ReviewerID:     log.ReviewerID,
+		ReviewerName:   sqlNullString(log.ReviewerName),
+		ReviewerEmail:  sqlNullString(log.ReviewerEmail),
+		ApproverLevel:  sqlNullInt32(log.ApproverLevel),
+		OnBehalfOfID:   sqlNullString(log.OnBehalfOfID),
+		OnBehalfOfName: sqlNullString(log.OnBehalfOfName),
+		DelegationID:   sqlNullString(log.DelegationID),
 	})
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return bankLetterLogFromRow(created), nil
 }
 
 // GetBankLetterLogs retrieves bank letter logs
@@ -289,26 +321,64 @@ func (r *paymentRepository) GetBankLetterLogs(ctx context.Context, slNo string)
 
 	result := make([]*domain.BankLetterApprovalLog, len(logs))
 	for i, log := range logs {
-		result[i] = &domain.BankLetterApprovalLog{
-			ID:            log.ID,
-			SlNo:          log.SlNo,
-			Status:        log.Status,
-			Comments:      nullStringToPtr(log.Comments),
-			ReviewerID:    log.ReviewerID,
-			ReviewerName:  nullStringToPtr(log.ReviewerName),
-			ReviewerEmail: nullStringToPtr(log.ReviewerEmail),
-			ApproverLevel: nullInt32ToPtr(log.ApproverLevel),
-			CreatedAt:     log.CreatedAt,
-		}
+		result[i] = bankLetterLogFromRow(log)
 	}
 
 	return result, nil
 }
 
+func bankLetterLogFromRow(log generated.BankLetterApprovalLog) *domain.BankLetterApprovalLog {
+	return &domain.BankLetterApprovalLog{
+		ID:             log.ID,
+		SlNo:           log.SlNo,
+		Status:         log.Status,
+		Comments:       nullStringToPtr(log.Comments),
+		ReviewerID:     log.ReviewerID,
+		ReviewerName:   nullStringToPtr(log.ReviewerName),
+		ReviewerEmail:  nullStringToPtr(log.ReviewerEmail),
+		ApproverLevel:  nullInt32ToPtr(log.ApproverLevel),
+		OnBehalfOfID:   nullStringToPtr(log.OnBehalfOfID),
+		OnBehalfOfName: nullStringToPtr(log.OnBehalfOfName),
+		DelegationID:   nullStringToPtr(log.DelegationID),
+		CreatedAt:      log.CreatedAt,
+	}
+}
+
+// encryptBankDetails returns a copy of the payment's bank fields in their stored (encrypted) form
+func (r *paymentRepository) encryptBankDetails(ctx context.Context, payment *domain.Payment) (*domain.Payment, error) {
+	var (
+		bank domain.Payment
+		err  error
+	)
+	if bank.FullAccountNumber, err = r.crypt.EncryptPtr(ctx, payment.FullAccountNumber); err != nil {
+		return nil, fmt.Errorf("failed to encrypt account number: %w", err)
+	}
+	if bank.AccountNumber, err = r.crypt.EncryptPtr(ctx, payment.AccountNumber); err != nil {
+		return nil, fmt.Errorf("failed to encrypt account number: %w", err)
+	}
+	if bank.IfscCode, err = r.crypt.EncryptPtr(ctx, payment.IfscCode); err != nil {
+		return nil, fmt.Errorf("failed to encrypt IFSC code: %w", err)
+	}
+	return &bank, nil
+}
+
 // Helper functions
-func (r *paymentRepository) toDomain(p *generated.Payment) domain.Payment {
+func (r *paymentRepository) toDomain(ctx context.Context, p *generated.Payment) (domain.Payment, error) {
 	amount, _ := parseDecimal(p.Amount)
 
+	fullAccountNumber, err := r.crypt.DecryptPtr(ctx, nullStringToPtr(p.FullAccountNumber))
+	if err != nil {
+		return domain.Payment{}, fmt.Errorf("failed to decrypt account number: %w", err)
+	}
+	accountNumber, err := r.crypt.DecryptPtr(ctx, nullStringToPtr(p.AccountNumber))
+	if err != nil {
+		return domain.Payment{}, fmt.Errorf("failed to decrypt account number: %w", err)
+	}
+	ifscCode, err := r.crypt.DecryptPtr(ctx, nullStringToPtr(p.IfscCode))
+	if err != nil {
+		return domain.Payment{}, fmt.Errorf("failed to decrypt IFSC code: %w", err)
+	}
+
 	return domain.Payment{
 		ID:                p.ID,
 		SlNo:              p.SlNo,
@@ -316,13 +386,13 @@ func (r *paymentRepository) toDomain(p *generated.Payment) domain.Payment {
 		Project:           nullStringToPtr(p.Project),
 		AccountFullName:   nullStringToPtr(p.AccountFullName),
 		FromAccountType:   nullStringToPtr(p.FromAccountType),
-		FullAccountNumber: nullStringToPtr(p.FullAccountNumber),
+		FullAccountNumber: fullAccountNumber,
 		ToAccount:         nullStringToPtr(p.ToAccount),
 		ToAccountType:     nullStringToPtr(p.ToAccountType),
 		NameOfBeneficiary: nullStringToPtr(p.NameOfBeneficiary),
-		AccountNumber:     nullStringToPtr(p.AccountNumber),
+		AccountNumber:     accountNumber,
 		NameOfBank:        nullStringToPtr(p.NameOfBank),
-		IfscCode:          nullStringToPtr(p.IfscCode),
+		IfscCode:          ifscCode,
 		Amount:            amount,
 		Purpose:           nullStringToPtr(p.Purpose),
 		Status:            string(p.Status.PaymentStatus),
@@ -330,7 +400,7 @@ func (r *paymentRepository) toDomain(p *generated.Payment) domain.Payment {
 		PaymentNoteID:     nullInt64ToPtr(p.PaymentNoteID),
 		CreatedAt:         p.CreatedAt,
 		UpdatedAt:         p.UpdatedAt,
-	}
+	}, nil
 }
 
 func sqlNullString(s *string) sql.NullString {
@@ -375,8 +445,7 @@ func nullInt32ToPtr(ni sql.NullInt32) *int32 {
 	return &ni.Int32
 }
 
-func parseDecimal(s string) (float64, error) {
-	var f float64
-	_, err := fmt.Sscanf(s, "%f", &f)
-	return f, err
+// parseDecimal reads a DECIMAL(20,2) amount exactly
+func parseDecimal(s string) (money.Amount, error) {
+	return money.ParseRound(s, money.HalfUp)
 }
@@ -103,15 +103,18 @@ func (ns NullTemplateType) Value() (driver.Value, error) {
 }
 
 type BankLetterApprovalLog struct {
-	ID            int64          `json:"id"`
-	SlNo          string         `json:"sl_no"`
-	Status        string         `json:"status"`
-	Comments      sql.NullString `json:"comments"`
-	ReviewerID    int64          `json:"reviewer_id"`
-	ReviewerName  sql.NullString `json:"reviewer_name"`
-	ReviewerEmail sql.NullString `json:"reviewer_email"`
-	ApproverLevel sql.NullInt32  `json:"approver_level"`
-	CreatedAt     time.Time      `json:"created_at"`
+	ID             int64          `json:"id"`
+	SlNo           string         `json:"sl_no"`
+	Status         string         `json:"status"`
+	Comments       sql.NullString `json:"comments"`
+	ReviewerID     int64          `json:"reviewer_id"`
+	ReviewerName   sql.NullString `json:"reviewer_name"`
+	ReviewerEmail  sql.NullString `json:"reviewer_email"`
+	ApproverLevel  sql.NullInt32  `json:"approver_level"`
+	CreatedAt      time.Time      `json:"created_at"`
+	OnBehalfOfID   sql.NullString `json:"on_behalf_of_id"`
+	OnBehalfOfName sql.NullString `json:"on_behalf_of_name"`
+	DelegationID   sql.NullString `json:"delegation_id"`
 }
 
 type Payment struct {
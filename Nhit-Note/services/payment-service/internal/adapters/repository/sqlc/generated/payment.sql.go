@@ -345,21 +345,27 @@ INSERT INTO bank_letter_approval_logs (
     reviewer_id,
     reviewer_name,
     reviewer_email,
-    approver_level
+    approver_level,
+    on_behalf_of_id,
+    on_behalf_of_name,
+    delegation_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
-RETURNING id, sl_no, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at
+RETURNING id, sl_no, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at, on_behalf_of_id, on_behalf_of_name, delegation_id
 `
 
 type InsertBankLetterLogParams struct {
-	SlNo          string         `json:"sl_no"`
-	Status        string         `json:"status"`
-	Comments      sql.NullString `json:"comments"`
-	ReviewerID    int64          `json:"reviewer_id"`
-	ReviewerName  sql.NullString `json:"reviewer_name"`
-	ReviewerEmail sql.NullString `json:"reviewer_email"`
-	ApproverLevel sql.NullInt32  `json:"approver_level"`
+	SlNo           string         `json:"sl_no"`
+	Status         string         `json:"status"`
+	Comments       sql.NullString `json:"comments"`
+	ReviewerID     int64          `json:"reviewer_id"`
+	ReviewerName   sql.NullString `json:"reviewer_name"`
+	ReviewerEmail  sql.NullString `json:"reviewer_email"`
+	ApproverLevel  sql.NullInt32  `json:"approver_level"`
+	OnBehalfOfID   sql.NullString `json:"on_behalf_of_id"`
+	OnBehalfOfName sql.NullString `json:"on_behalf_of_name"`
+	DelegationID   sql.NullString `json:"delegation_id"`
 }
 
 func (q *Queries) InsertBankLetterLog(ctx context.Context, arg InsertBankLetterLogParams) (BankLetterApprovalLog, error) {
@@ -371,6 +377,9 @@ func (q *Queries) InsertBankLetterLog(ctx context.Context, arg InsertBankLetterL
 		arg.ReviewerName,
 		arg.ReviewerEmail,
 		arg.ApproverLevel,
+		arg.OnBehalfOfID,
+		arg.OnBehalfOfName,
+		arg.DelegationID,
 	)
 	var i BankLetterApprovalLog
 	err := row.Scan(
@@ -383,6 +392,9 @@ func (q *Queries) InsertBankLetterLog(ctx context.Context, arg InsertBankLetterL
 		&i.ReviewerEmail,
 		&i.ApproverLevel,
 		&i.CreatedAt,
+		&i.OnBehalfOfID,
+		&i.OnBehalfOfName,
+		&i.DelegationID,
 	)
 	return i, err
 }
@@ -419,7 +431,7 @@ func (q *Queries) InsertPaymentVendorAccount(ctx context.Context, arg InsertPaym
 }
 
 const listBankLetterLogs = `-- name: ListBankLetterLogs :many
-SELECT id, sl_no, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at
+SELECT id, sl_no, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at, on_behalf_of_id, on_behalf_of_name, delegation_id
 FROM bank_letter_approval_logs
 WHERE sl_no = $1
 ORDER BY created_at DESC
@@ -444,6 +456,9 @@ func (q *Queries) ListBankLetterLogs(ctx context.Context, slNo string) ([]BankLe
 			&i.ReviewerEmail,
 			&i.ApproverLevel,
 			&i.CreatedAt,
+			&i.OnBehalfOfID,
+			&i.OnBehalfOfName,
+			&i.DelegationID,
 		); err != nil {
 			return nil, err
 		}
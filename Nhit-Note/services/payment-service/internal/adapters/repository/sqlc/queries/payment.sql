@@ -139,9 +139,12 @@ INSERT INTO bank_letter_approval_logs (
     reviewer_id,
     reviewer_name,
     reviewer_email,
-    approver_level
+    approver_level,
+    on_behalf_of_id,
+    on_behalf_of_name,
+    delegation_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
 RETURNING *;
 
@@ -0,0 +1,59 @@
+package vendorclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"nhit-note/services/payment-service/internal/core/domain"
+	"nhit-note/services/payment-service/internal/core/ports"
+)
+
+// PayeeVerifier asks vendor-service whether a beneficiary account is an
+// approved vendor account past its cooling-off period.
+type PayeeVerifier struct {
+	client  vendorpb.VendorServiceClient
+	timeout time.Duration
+}
+
+// NewPayeeVerifier creates a ports.PayeeVerifier backed by vendor-service
+func NewPayeeVerifier(conn *grpc.ClientConn) *PayeeVerifier {
+	return &PayeeVerifier{
+		client:  vendorpb.NewVendorServiceClient(conn),
+		timeout: 5 * time.Second,
+	}
+}
+
+var _ ports.PayeeVerifier = (*PayeeVerifier)(nil)
+
+// VerifyPayee implements ports.PayeeVerifier. The caller's metadata (token,
+// tenant) is forwarded so the lookup is scoped to the caller's tenant.
+func (v *PayeeVerifier) VerifyPayee(ctx context.Context, accountNumber, ifscCode string) (*domain.PayeeVerification, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	resp, err := v.client.VerifyPayeeAccount(ctx, &vendorpb.VerifyPayeeAccountRequest{
+		AccountNumber: accountNumber,
+		IfscCode:      ifscCode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("vendor-service VerifyPayeeAccount: %w", err)
+	}
+
+	result := &domain.PayeeVerification{
+		Payable: resp.Payable,
+		Reason:  resp.Reason,
+	}
+	if resp.PayableFrom != nil {
+		payableFrom := resp.PayableFrom.AsTime()
+		result.PayableFrom = &payableFrom
+	}
+	return result, nil
+}
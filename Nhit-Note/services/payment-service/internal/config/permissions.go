@@ -1,5 +1,9 @@
 package config
 
+// RevealBankDetailsPermission allows a caller to see full bank account numbers
+// and IFSC codes on payments; everyone else receives masked values.
+const RevealBankDetailsPermission = "reveal-bank-details"
+
 // GetPermissionMap returns the permission requirements for each RPC method
 func GetPermissionMap() map[string][]string {
 	return map[string][]string{
@@ -23,6 +27,9 @@ func GetPermissionMap() map[string][]string {
 		"/payment.PaymentService/CreatePaymentShortcut":        {"create-payment"},
 		"/payment.PaymentService/ExecutePaymentShortcut":       {"create-payment"},
 		
+		// Organization-service counts an organization's payments before purging it
+		"/payment.PaymentService/CountOrganizationPayments":    {"delete-organizations"},
+		
 		// Bank letter operations
 		"/payment.PaymentService/CreateBankLetterFromNotes":    {"create-bank-letter"},
 		"/payment.PaymentService/ProcessBankLetterLog":         {"approve-bank-letter"},
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Payment statuses
+const (
+	PaymentStatusDraft     = "D"
+	PaymentStatusSubmitted = "S"
+	PaymentStatusApproved  = "A"
+	PaymentStatusRejected  = "R"
+	PaymentStatusPending   = "P"
+	PaymentStatusCompleted = "C"
+)
+
+// PaymentSerialPrefix prefixes the serial numbers of payment groups
+const PaymentSerialPrefix = "PAY"
+
+// PayeeVerification is the vendor-service verdict on a beneficiary bank account
+type PayeeVerification struct {
+	Payable     bool
+	Reason      string
+	PayableFrom *time.Time
+}
+
+// PayeeNotApprovedError is returned when a payment targets a bank account that
+// has not been approved, or is still in its cooling-off period.
+type PayeeNotApprovedError struct {
+	PaymentID     int64
+	AccountNumber string
+	Reason        string
+}
+
+func (e *PayeeNotApprovedError) Error() string {
+	return fmt.Sprintf("payment %d: beneficiary account %s cannot be paid: %s", e.PaymentID, e.AccountNumber, e.Reason)
+}
+
+// RequiresPayeeVerification reports whether the payment is a bank transfer to
+// a beneficiary account, which must be an approved vendor account.
+func (p Payment) RequiresPayeeVerification() bool {
+	if p.TemplateType != "RTGS" && p.TemplateType != "NEFT" {
+		return false
+	}
+	return p.AccountNumber != nil && *p.AccountNumber != ""
+}
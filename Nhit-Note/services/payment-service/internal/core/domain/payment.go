@@ -2,6 +2,8 @@ package domain
 
 import (
 	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 )
 
 // Payment represents a payment domain model
@@ -19,11 +21,12 @@ type Payment struct {
 	AccountNumber       *string
 	NameOfBank          *string
 	IfscCode            *string
-	Amount              float64
+	Amount              money.Amount
 	Purpose             *string
 	Status              string
 	UserID              int64
 	PaymentNoteID       *int64
+	OrgID               *string // recorded on create, not loaded
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
@@ -66,7 +69,11 @@ type BankLetterApprovalLog struct {
 	ReviewerName  *string
 	ReviewerEmail *string
 	ApproverLevel *int32
-	CreatedAt     time.Time
+	// Set when the reviewer acted as the delegate of an approver on leave
+	OnBehalfOfID   *string
+	OnBehalfOfName *string
+	DelegationID   *string
+	CreatedAt      time.Time
 }
 
 // PaymentFilters represents filter criteria for listing payments
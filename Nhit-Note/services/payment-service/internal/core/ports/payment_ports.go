@@ -43,12 +43,23 @@ type PaymentRepository interface {
 	// ListShortcuts lists shortcuts for a user
 	ListShortcuts(ctx context.Context, userID int64) ([]*domain.PaymentShortcut, error)
 	
+	// CountByOrganization counts the organization's payments in every status.
+	// Payments created before organizations were recorded are not counted.
+	CountByOrganization(ctx context.Context, orgID string) (int64, error)
+	
 	// GenerateSerialNumber generates the next payment serial number
 	GenerateSerialNumber(ctx context.Context, prefix string) (string, error)
 	
-	// AddBankLetterLog adds a bank letter approval log
-	AddBankLetterLog(ctx context.Context, log *domain.BankLetterApprovalLog) error
+	// AddBankLetterLog adds a bank letter approval log, including the approver
+	// acted for when a delegate reviewed
+	AddBankLetterLog(ctx context.Context, log *domain.BankLetterApprovalLog) (*domain.BankLetterApprovalLog, error)
 	
 	// GetBankLetterLogs retrieves bank letter logs
 	GetBankLetterLogs(ctx context.Context, slNo string) ([]*domain.BankLetterApprovalLog, error)
 }
+
+// PayeeVerifier checks beneficiary bank accounts against the approved vendor accounts
+type PayeeVerifier interface {
+	// VerifyPayee reports whether payments may be made to the given account
+	VerifyPayee(ctx context.Context, accountNumber, ifscCode string) (*domain.PayeeVerification, error)
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/fieldcrypt"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"nhit-note/services/payment-service/internal/core/domain"
+	"nhit-note/services/payment-service/internal/core/ports"
+)
+
+// PaymentService holds the payment business rules
+type PaymentService struct {
+	repo     ports.PaymentRepository
+	verifier ports.PayeeVerifier
+}
+
+// NewPaymentService creates a new payment service.
+// Bank transfers are only accepted to accounts the verifier reports as payable.
+func NewPaymentService(repo ports.PaymentRepository, verifier ports.PayeeVerifier) *PaymentService {
+	return &PaymentService{
+		repo:     repo,
+		verifier: verifier,
+	}
+}
+
+// CreatePaymentRequests creates a payment group after checking every beneficiary
+// account. The payments are recorded in the caller's organization.
+func (s *PaymentService) CreatePaymentRequests(ctx context.Context, slNo string, payments []domain.Payment) error {
+	if err := s.verifyPayees(ctx, payments); err != nil {
+		return err
+	}
+	if orgID, ok := middleware.GetOrgIDFromContext(ctx); ok {
+		for i := range payments {
+			payments[i].OrgID = &orgID
+		}
+	}
+	return s.repo.CreatePaymentRequests(ctx, slNo, payments)
+}
+
+// UpdatePaymentGroupStatus changes the status of a payment group. Beneficiary
+// accounts are re-checked before a group is approved or completed, since an
+// account may have been changed or not yet cleared its cooling-off period.
+func (s *PaymentService) UpdatePaymentGroupStatus(ctx context.Context, slNo string, status string) error {
+	if status == domain.PaymentStatusApproved || status == domain.PaymentStatusCompleted {
+		group, err := s.repo.GetPaymentGroup(ctx, slNo)
+		if err != nil {
+			return fmt.Errorf("failed to get payment group: %w", err)
+		}
+		if err := s.verifyPayees(ctx, group.Payments); err != nil {
+			return err
+		}
+	}
+	return s.repo.UpdatePaymentGroupStatus(ctx, slNo, status)
+}
+
+// UpdatePayments rewrites payments of a group. Every row is checked before any
+// is written, so a refused beneficiary leaves the group unchanged.
+func (s *PaymentService) UpdatePayments(ctx context.Context, slNo string, payments []domain.Payment) error {
+	group, err := s.repo.GetPaymentGroup(ctx, slNo)
+	if err != nil {
+		return fmt.Errorf("failed to get payment group: %w", err)
+	}
+	inGroup := make(map[int64]bool, len(group.Payments))
+	for _, p := range group.Payments {
+		inGroup[p.ID] = true
+	}
+	for _, p := range payments {
+		if !inGroup[p.ID] {
+			return fmt.Errorf("payment %d is not part of group %s", p.ID, slNo)
+		}
+	}
+
+	if err := s.verifyPayees(ctx, payments); err != nil {
+		return err
+	}
+	for i := range payments {
+		if err := s.repo.UpdatePayment(ctx, &payments[i]); err != nil {
+			return fmt.Errorf("failed to update payment %d: %w", payments[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// GetPaymentGroup returns the payments of a group
+func (s *PaymentService) GetPaymentGroup(ctx context.Context, slNo string) (*domain.PaymentGroup, error) {
+	return s.repo.GetPaymentGroup(ctx, slNo)
+}
+
+// ListPaymentGroups returns a page of payment groups and the total number of groups
+func (s *PaymentService) ListPaymentGroups(ctx context.Context, filters domain.PaymentFilters) ([]*domain.PaymentGroup, int64, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PerPage < 1 {
+		filters.PerPage = 10
+	}
+	return s.repo.List(ctx, filters)
+}
+
+// DeletePaymentGroup deletes every payment of a group
+func (s *PaymentService) DeletePaymentGroup(ctx context.Context, slNo string) error {
+	return s.repo.DeletePaymentGroup(ctx, slNo)
+}
+
+// DeletePayment deletes one payment of a group
+func (s *PaymentService) DeletePayment(ctx context.Context, slNo string, id int64) error {
+	payment, err := s.repo.GetPaymentByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if payment.SlNo != slNo {
+		return fmt.Errorf("payment %d is not part of group %s", id, slNo)
+	}
+	return s.repo.DeletePayment(ctx, id)
+}
+
+// AddBankLetterLog records a review of a group's bank letter. A reviewer
+// acting as a delegate is recorded together with the approver acted for.
+func (s *PaymentService) AddBankLetterLog(ctx context.Context, entry *domain.BankLetterApprovalLog) (*domain.BankLetterApprovalLog, error) {
+	if _, err := s.repo.GetPaymentGroup(ctx, entry.SlNo); err != nil {
+		return nil, fmt.Errorf("failed to get payment group: %w", err)
+	}
+	return s.repo.AddBankLetterLog(ctx, entry)
+}
+
+// CountOrganizationPayments counts an organization's payments for
+// organization-service's purge check
+func (s *PaymentService) CountOrganizationPayments(ctx context.Context, orgID string) (int64, error) {
+	return s.repo.CountByOrganization(ctx, orgID)
+}
+
+// GenerateSerialNumber returns the next payment group serial number
+func (s *PaymentService) GenerateSerialNumber(ctx context.Context) (string, error) {
+	return s.repo.GenerateSerialNumber(ctx, domain.PaymentSerialPrefix)
+}
+
+// verifyPayees returns a *domain.PayeeNotApprovedError for the first payment
+// whose beneficiary account is not payable.
+func (s *PaymentService) verifyPayees(ctx context.Context, payments []domain.Payment) error {
+	for _, p := range payments {
+		if !p.RequiresPayeeVerification() {
+			continue
+		}
+
+		ifscCode := ""
+		if p.IfscCode != nil {
+			ifscCode = *p.IfscCode
+		}
+
+		result, err := s.verifier.VerifyPayee(ctx, *p.AccountNumber, ifscCode)
+		if err != nil {
+			return fmt.Errorf("failed to verify beneficiary account: %w", err)
+		}
+		if !result.Payable {
+			log.Printf("🚫 Payment %d refused: beneficiary account %s %s", p.ID, fieldcrypt.Mask(*p.AccountNumber), result.Reason)
+			return &domain.PayeeNotApprovedError{
+				PaymentID:     p.ID,
+				AccountNumber: fieldcrypt.Mask(*p.AccountNumber),
+				Reason:        result.Reason,
+			}
+		}
+	}
+	return nil
+}
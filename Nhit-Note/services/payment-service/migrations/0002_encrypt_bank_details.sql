@@ -0,0 +1,10 @@
+-- Payment rows copy bank account numbers and IFSC codes from vendors; store them
+-- encrypted at rest in the fieldcrypt envelope format
+-- (enc:v1:<key id>:<wrapped data key>:<ciphertext>). The columns are already TEXT.
+--
+-- Existing plaintext rows keep working (reads pass them through unchanged) and
+-- are encrypted by running: go run ./cmd/encrypt-bank-data
+
+COMMENT ON COLUMN payments.full_account_number IS 'Source bank account number (envelope encrypted)';
+COMMENT ON COLUMN payments.account_number IS 'Beneficiary bank account number (envelope encrypted)';
+COMMENT ON COLUMN payments.ifsc_code IS 'Beneficiary IFSC code (envelope encrypted)';
@@ -0,0 +1,7 @@
+-- Bank letter approvals cast by a delegate record the approver acted for, from
+-- the delegation the RBAC interceptor admitted the call under
+
+ALTER TABLE bank_letter_approval_logs
+    ADD COLUMN IF NOT EXISTS on_behalf_of_id TEXT,
+    ADD COLUMN IF NOT EXISTS on_behalf_of_name TEXT,
+    ADD COLUMN IF NOT EXISTS delegation_id TEXT;
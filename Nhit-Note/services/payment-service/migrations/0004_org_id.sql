@@ -0,0 +1,7 @@
+-- Organization a payment was made in, so organization-service can see an
+-- organization's payments before purging it. Payments created before this
+-- migration have no organization recorded.
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS org_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_payments_org_id ON payments(org_id);
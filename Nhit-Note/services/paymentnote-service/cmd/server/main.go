@@ -8,15 +8,21 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"nhit-note/services/paymentnote-service/internal/adapters/events"
 	"nhit-note/services/paymentnote-service/internal/adapters/grpc/handler"
 	"nhit-note/services/paymentnote-service/internal/adapters/repository"
+	"nhit-note/services/paymentnote-service/internal/adapters/vendorclient"
+	"nhit-note/services/paymentnote-service/internal/core/ports"
 	"nhit-note/services/paymentnote-service/internal/core/services"
 	"nhit-note/services/paymentnote-service/internal/storage"
 	paymentnotepb "nhit-note/api/pb/paymentnotepb"
@@ -30,6 +36,9 @@ func main() {
 	dbPassword := getEnv("DB_PASSWORD", "postgres")
 	dbName := getEnv("DB_NAME", "nhit_payment_notes")
 	grpcPort := getEnv("GRPC_PORT", "50053")
+	vendorServiceAddr := getEnv("VENDOR_SERVICE_ADDR", "")
+	kafkaBrokers := getEnv("KAFKA_BROKERS", "")
+	vendorEventsTopic := getEnv("VENDOR_EVENTS_TOPIC", "vendor.events")
 
 	// MinIO configuration
 	minioEndpoint := getEnv("MINIO_ENDPOINT", "play.min.io:9443")
@@ -87,8 +96,40 @@ func main() {
 	paymentNoteRepo := repository.NewPaymentNoteRepository(db, minioClient)
 	log.Println("✅ Repository initialized")
 
+	// TDS engine in vendor-service (optional - TDS falls back to the
+	// percentage on the request when not configured)
+	var tdsAdvisor ports.TDSAdvisor
+	if vendorServiceAddr != "" {
+		vendorConn, err := grpc.Dial(vendorServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("Failed to connect to vendor service: %v", err)
+		}
+		defer vendorConn.Close()
+		tdsAdvisor = vendorclient.NewTDSAdvisor(vendorConn)
+		log.Printf("✅ TDS engine at vendor service %s", vendorServiceAddr)
+	} else {
+		log.Println("⚠️  VENDOR_SERVICE_ADDR not set - automatic TDS suggestion disabled")
+	}
+
+	// Vendor events (optional - payment notes keep the merged vendor's code
+	// after a vendor merge and get no MSME flags when not configured)
+	consumerCtx, stopConsumers := context.WithCancel(context.Background())
+	defer stopConsumers()
+	if kafkaBrokers != "" {
+		vendorConsumer := events.NewVendorEventConsumer(strings.Split(kafkaBrokers, ","), vendorEventsTopic, "paymentnote-service-vendor-group", paymentNoteRepo)
+		go vendorConsumer.Start(consumerCtx)
+		log.Printf("✅ Consuming vendor events from %s", vendorEventsTopic)
+	} else {
+		log.Println("⚠️  KAFKA_BROKERS not set - vendor merges and MSME flags will not reach payment notes")
+	}
+
 	// Initialize service
-	paymentNoteService := services.NewPaymentNoteService(paymentNoteRepo)
+	paymentNoteService := services.NewPaymentNoteService(paymentNoteRepo, tdsAdvisor)
+	rounding, err := money.RoundingFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", money.RoundingEnv, err)
+	}
+	paymentNoteService.SetRoundingMode(rounding)
 	log.Println("✅ Service layer initialized")
 
 	// Initialize gRPC handler
@@ -118,6 +159,7 @@ func main() {
 		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 		<-sigChan
 		log.Println("\n🛑 Shutting down gracefully...")
+		stopConsumers()
 		grpcServer.GracefulStop()
 		db.Close()
 		log.Println("✅ Server stopped")
@@ -129,6 +171,9 @@ func main() {
 	log.Println("Service Features:")
 	log.Println("- Payment Note CRUD operations")
 	log.Println("- Financial calculations (TDS, Net Payable, Number-to-Words)")
+	if tdsAdvisor != nil {
+		log.Println("- Automatic TDS suggestion (vendor-service TDS engine)")
+	}
 	if minioClient != nil {
 		log.Println("- Document upload/download (MinIO)")
 	}
@@ -1,20 +1,29 @@
 module nhit-note/services/paymentnote-service
 
-go 1.21
+go 1.24.2
 
 require (
+	github.com/ShristiRnr/NHIT_Backend/pkg/middleware v0.0.0-00010101000000-000000000000
 	github.com/lib/pq v1.10.9
 	github.com/minio/minio-go/v7 v7.0.63
-	google.golang.org/grpc v1.59.0
-	google.golang.org/protobuf v1.31.0
+	google.golang.org/grpc v1.77.0
+	google.golang.org/protobuf v1.36.10
+	nhit-note/api/pb/common v0.0.0
 	nhit-note/api/pb/paymentnotepb v0.0.0
 )
 
 require (
+	github.com/ShristiRnr/NHIT_Backend/api/pb/authpb v0.0.0 // indirect
+	github.com/segmentio/kafka-go v0.4.49 // indirect
+)
+
+require (
+	github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer v0.0.0
+	github.com/ShristiRnr/NHIT_Backend/pkg/money v0.0.0
 	github.com/dustin/go-humanize v1.0.1 // indirect
-	github.com/golang/protobuf v1.5.3 // indirect
-	github.com/google/uuid v1.3.1 // indirect
-	github.com/grpc-ecosystem/grpc-gateway/v2 v2.18.0 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.3 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/klauspost/compress v1.16.7 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.5 // indirect
@@ -22,15 +31,15 @@ require (
 	github.com/minio/sha256-simd v1.0.1 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
 	github.com/modern-go/reflect2 v1.0.2 // indirect
+	github.com/pierrec/lz4/v4 v4.1.15 // indirect
 	github.com/rs/xid v1.5.0 // indirect
 	github.com/sirupsen/logrus v1.9.3 // indirect
-	golang.org/x/crypto v0.13.0 // indirect
-	golang.org/x/net v0.15.0 // indirect
-	golang.org/x/sys v0.12.0 // indirect
-	golang.org/x/text v0.13.0 // indirect
-	google.golang.org/genproto v0.0.0-20230822172742-b8732ec3820d // indirect
-	google.golang.org/genproto/googleapis/api v0.0.0-20230822172742-b8732ec3820d // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20230822172742-b8732ec3820d // indirect
+	golang.org/x/crypto v0.43.0 // indirect
+	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
+	golang.org/x/sys v0.37.0 // indirect
+	golang.org/x/text v0.30.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20251124214823-79d6a2a48846 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251111163417-95abcf5c77ba // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 )
 
@@ -38,3 +47,13 @@ replace (
 	nhit-note/api/pb/common => ../../api/pb/common
 	nhit-note/api/pb/paymentnotepb => ../../api/pb/paymentnotepb
 )
+
+replace github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb => "../../../NHIT Backend/api/pb/vendorpb"
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/money => "../../../NHIT Backend/pkg/money"
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer => "../../../NHIT Backend/pkg/eventconsumer"
+
+replace github.com/ShristiRnr/NHIT_Backend/pkg/middleware => "../../../NHIT Backend/pkg/middleware"
+
+replace github.com/ShristiRnr/NHIT_Backend/api/pb/authpb => "../../../NHIT Backend/api/pb/authpb"
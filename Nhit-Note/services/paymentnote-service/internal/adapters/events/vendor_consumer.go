@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/eventconsumer"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"nhit-note/services/paymentnote-service/internal/core/domain"
+	"nhit-note/services/paymentnote-service/internal/core/ports"
+)
+
+// Vendor event types handled by the payment note service
+const (
+	VendorEventMerged             = "vendor.merged"
+	VendorEventMSMEInvoiceFlagged = "vendor.msme_invoice.flagged"
+)
+
+// vendorEventSchemaVersion is the vendor events schema this consumer understands
+const vendorEventSchemaVersion = 1
+
+// VendorMergedEvent is the payload of vendor.merged
+type VendorMergedEvent struct {
+	MergeID            string `json:"merge_id"`
+	SurvivorVendorID   string `json:"survivor_vendor_id"`
+	SurvivorVendorCode string `json:"survivor_vendor_code"`
+	SurvivorVendorName string `json:"survivor_vendor_name"`
+	MergedVendorID     string `json:"merged_vendor_id"`
+	MergedVendorCode   string `json:"merged_vendor_code"`
+	MergedVendorName   string `json:"merged_vendor_name"`
+}
+
+// MSMEInvoiceFlaggedEvent is the payload of vendor.msme_invoice.flagged
+type MSMEInvoiceFlaggedEvent struct {
+	InvoiceID         string       `json:"invoice_id"`
+	VendorCode        string       `json:"vendor_code"`
+	InvoiceNumber     string       `json:"invoice_number"`
+	InvoiceAmount     money.Amount `json:"invoice_amount"`
+	DueDate           string       `json:"due_date"`
+	Status            string       `json:"status"`
+	InterestLiability money.Amount `json:"interest_liability"`
+	PaymentNoteID     *string      `json:"payment_note_id,omitempty"`
+}
+
+// VendorEventConsumer applies vendor-service events to payment notes
+type VendorEventConsumer struct {
+	consumer *eventconsumer.Consumer
+	repo     ports.PaymentNoteRepository
+}
+
+// NewVendorEventConsumer creates a consumer of the vendor events topic
+func NewVendorEventConsumer(brokers []string, topic string, groupID string, repo ports.PaymentNoteRepository) *VendorEventConsumer {
+	consumer := eventconsumer.New(eventconsumer.Config{
+		Brokers:       brokers,
+		Topic:         topic,
+		GroupID:       groupID,
+		SchemaVersion: vendorEventSchemaVersion,
+	})
+	if consumer == nil {
+		return nil
+	}
+
+	c := &VendorEventConsumer{consumer: consumer, repo: repo}
+	eventconsumer.On(consumer, VendorEventMerged, c.handleVendorMerged)
+	eventconsumer.On(consumer, VendorEventMSMEInvoiceFlagged, c.handleMSMEInvoiceFlagged)
+	return c
+}
+
+// Start consumes vendor events until ctx is cancelled. It should be run in a
+// goroutine.
+func (c *VendorEventConsumer) Start(ctx context.Context) {
+	c.consumer.Start(ctx)
+}
+
+// handleVendorMerged moves payment notes of the merged vendor to the survivor.
+// Payment notes carry no tenant, and vendor codes are unique across tenants.
+func (c *VendorEventConsumer) handleVendorMerged(ctx context.Context, _ string, evt VendorMergedEvent) error {
+	if evt.MergedVendorCode == "" || evt.SurvivorVendorCode == "" {
+		log.Printf("⚠️ Vendor merge %s carries no vendor codes, payment notes left unchanged", evt.MergeID)
+		return nil
+	}
+
+	n, err := c.repo.RenameVendor(ctx, evt.MergedVendorCode, evt.SurvivorVendorCode, evt.SurvivorVendorName)
+	if err != nil {
+		return err
+	}
+	log.Printf("🔀 Vendor merge %s: %d payment notes moved from %s to %s", evt.MergeID, n, evt.MergedVendorCode, evt.SurvivorVendorCode)
+	return nil
+}
+
+// handleMSMEInvoiceFlagged records an MSME payment warning on the payment note
+// the invoice was registered against
+func (c *VendorEventConsumer) handleMSMEInvoiceFlagged(ctx context.Context, _ string, evt MSMEInvoiceFlaggedEvent) error {
+	if evt.PaymentNoteID == nil || *evt.PaymentNoteID == "" {
+		return nil
+	}
+	id, err := strconv.ParseInt(*evt.PaymentNoteID, 10, 64)
+	if err != nil {
+		log.Printf("⚠️ MSME invoice %s names invalid payment note %q, skipped", evt.InvoiceID, *evt.PaymentNoteID)
+		return nil
+	}
+
+	err = c.repo.FlagMSMEInvoice(ctx, id, domain.MSMEFlag{
+		InvoiceID:         evt.InvoiceID,
+		InvoiceNumber:     evt.InvoiceNumber,
+		Status:            evt.Status,
+		DueDate:           evt.DueDate,
+		InterestLiability: evt.InterestLiability,
+	})
+	if errors.Is(err, domain.ErrPaymentNoteNotFound) {
+		log.Printf("⚠️ MSME invoice %s: payment note %d not found, skipped", evt.InvoiceID, id)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("🚩 Payment note %d flagged %s: MSME invoice %s of %s due %s, interest %s", id, evt.Status, evt.InvoiceNumber, evt.VendorCode, evt.DueDate, evt.InterestLiability)
+	return nil
+}
@@ -2,9 +2,22 @@ package handler
 
 import (
 	"context"
-	
-	"nhit-note/services/paymentnote-service/internal/core/services"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	commonpb "nhit-note/api/pb/common"
 	paymentnotepb "nhit-note/api/pb/paymentnotepb"
+	"nhit-note/services/paymentnote-service/internal/core/domain"
+	"nhit-note/services/paymentnote-service/internal/core/services"
+)
+
+const (
+	defaultPerPage = 10
+	maxPerPage     = 100
 )
 
 type PaymentNoteHandler struct {
@@ -19,124 +32,224 @@ func NewPaymentNoteHandler(service *services.PaymentNoteService) *PaymentNoteHan
 	}
 }
 
-// CreatePaymentNote creates a new payment note
-func (h *PaymentNoteHandler) CreatePaymentNote(ctx context.Context, req *paymentnotepb.CreatePaymentNoteRequest) (*paymentnotepb.CreatePaymentNoteResponse, error) {
-	note, err := h.service.CreatePaymentNote(ctx, req.GetNote())
-	if err != nil {
-		return nil, err
+// ListPaymentNotes lists payment notes with filters
+func (h *PaymentNoteHandler) ListPaymentNotes(ctx context.Context, req *paymentnotepb.ListPaymentNotesRequest) (*paymentnotepb.ListPaymentNotesResponse, error) {
+	filters := domain.PaymentNoteFilters{
+		Page:    req.GetPage(),
+		PerPage: req.GetPerPage(),
 	}
-	
-	return &paymentnotepb.CreatePaymentNoteResponse{
-		Note: h.service.DomainToProto(note),
-	}, nil
+	if s := req.GetStatus(); s != "" && !req.GetIncludeAll() {
+		filters.Status = &s
+	}
+	if req.GetDraftsOnly() {
+		isDraft := true
+		filters.IsDraft = &isDraft
+	}
+	if q := req.GetSearch(); q != "" {
+		filters.Search = &q
+	}
+
+	return h.list(ctx, filters)
+}
+
+// ListDraftPaymentNotes lists draft payment notes
+func (h *PaymentNoteHandler) ListDraftPaymentNotes(ctx context.Context, req *paymentnotepb.ListDraftPaymentNotesRequest) (*paymentnotepb.ListPaymentNotesResponse, error) {
+	isDraft := true
+	return h.list(ctx, domain.PaymentNoteFilters{
+		IsDraft: &isDraft,
+		Page:    1,
+		PerPage: maxPerPage,
+	})
 }
 
 // GetPaymentNote retrieves a payment note by ID
-func (h *PaymentNoteHandler) GetPaymentNote(ctx context.Context, req *paymentnotepb.GetPaymentNoteRequest) (*paymentnotepb.GetPaymentNoteResponse, error) {
+func (h *PaymentNoteHandler) GetPaymentNote(ctx context.Context, req *paymentnotepb.GetPaymentNoteRequest) (*paymentnotepb.PaymentNoteResponse, error) {
 	note, err := h.service.GetPaymentNoteByID(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
-	
-	return &paymentnotepb.GetPaymentNoteResponse{
-		Note: h.service.DomainToProto(note),
-	}, nil
+
+	return h.response(note), nil
 }
 
-// ListPaymentNotes lists payment notes with filters
-func (h *PaymentNoteHandler) ListPaymentNotes(ctx context.Context, req *paymentnotepb.ListPaymentNotesRequest) (*paymentnotepb.ListPaymentNotesResponse, error) {
-	filters := domain.PaymentNoteFilters{
-		Status:  &req.Status,
-		IsDraft: &req.IsDraft,
-		Search:  &req.Search,
-		Page:    req.GetPage(),
-		PerPage: req.GetPerPage(),
+// CreatePaymentNote creates a new payment note
+func (h *PaymentNoteHandler) CreatePaymentNote(ctx context.Context, req *paymentnotepb.CreatePaymentNoteRequest) (*paymentnotepb.PaymentNoteResponse, error) {
+	if req.GetNote() == nil {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
 	}
-	
-	notes, total, err := h.service.ListPaymentNotes(ctx, filters)
+
+	note, err := h.service.CreatePaymentNote(ctx, req.GetNote())
 	if err != nil {
 		return nil, err
 	}
-	
-	protoNotes := make([]*paymentnotepb.PaymentNote, len(notes))
-	for i, note := range notes {
-		protoNotes[i] = h.service.DomainToProto(note)
-	}
-	
-	return &paymentnotepb.ListPaymentNotesResponse{
-		Notes: protoNotes,
-		Total: total,
-	}, nil
+
+	return h.response(note), nil
 }
 
 // UpdatePaymentNote updates a payment note
-func (h *PaymentNoteHandler) UpdatePaymentNote(ctx context.Context, req *paymentnotepb.UpdatePaymentNoteRequest) (*paymentnotepb.UpdatePaymentNoteResponse, error) {
+func (h *PaymentNoteHandler) UpdatePaymentNote(ctx context.Context, req *paymentnotepb.UpdatePaymentNoteRequest) (*paymentnotepb.PaymentNoteResponse, error) {
+	if req.GetNote() == nil {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
+	}
+
 	note, err := h.service.UpdatePaymentNote(ctx, req.GetId(), req.GetNote())
 	if err != nil {
 		return nil, err
 	}
-	
-	return &paymentnotepb.UpdatePaymentNoteResponse{
-		Note: h.service.DomainToProto(note),
-	}, nil
+
+	return h.response(note), nil
 }
 
 // DeletePaymentNote deletes a payment note
-func (h *PaymentNoteHandler) DeletePaymentNote(ctx context.Context, req *paymentnotepb.DeletePaymentNoteRequest) (*paymentnotepb.DeletePaymentNoteResponse, error) {
-	err := h.service.DeletePaymentNote(ctx, req.GetId())
+func (h *PaymentNoteHandler) DeletePaymentNote(ctx context.Context, req *paymentnotepb.DeletePaymentNoteRequest) (*emptypb.Empty, error) {
+	if err := h.service.DeletePaymentNote(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreateDraftFromGreenNote creates a draft payment note for an approved green note
+func (h *PaymentNoteHandler) CreateDraftFromGreenNote(ctx context.Context, req *paymentnotepb.CreateDraftFromGreenNoteRequest) (*paymentnotepb.PaymentNoteResponse, error) {
+	if req.GetGreenNoteId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "green_note_id is required")
+	}
+
+	greenNoteID := strconv.FormatInt(req.GetGreenNoteId(), 10)
+	note, err := h.service.CreateDraftFromGreenNote(ctx, greenNoteID, &commonpb.PaymentGreenNoteReference{})
 	if err != nil {
 		return nil, err
 	}
-	
-	return &paymentnotepb.DeletePaymentNoteResponse{
-		Success: true,
-	}, nil
+
+	return h.response(note), nil
 }
 
-// GeneratePaymentNoteOrderNumber generates an order number
-func (h *PaymentNoteHandler) GeneratePaymentNoteOrderNumber(ctx context.Context, req *paymentnotepb.GeneratePaymentNoteOrderNumberRequest) (*paymentnotepb.GeneratePaymentNoteOrderNumberResponse, error) {
-	orderNo, err := h.service.GeneratePaymentNoteOrderNumber(ctx)
+// ConvertDraftToActive converts a draft payment note to active status
+func (h *PaymentNoteHandler) ConvertDraftToActive(ctx context.Context, req *paymentnotepb.ConvertDraftToActiveRequest) (*paymentnotepb.PaymentNoteResponse, error) {
+	note, err := h.service.ConvertDraftToActive(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
-	
-	return &paymentnotepb.GeneratePaymentNoteOrderNumberResponse{
-		OrderNumber: orderNo,
-	}, nil
+
+	return h.response(note), nil
+}
+
+// DeleteDraftPaymentNote deletes a payment note that is still a draft
+func (h *PaymentNoteHandler) DeleteDraftPaymentNote(ctx context.Context, req *paymentnotepb.DeleteDraftPaymentNoteRequest) (*emptypb.Empty, error) {
+	note, err := h.service.GetPaymentNoteByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if !note.IsDraft {
+		return nil, status.Error(codes.FailedPrecondition, "payment note is not a draft")
+	}
+
+	if err := h.service.DeletePaymentNote(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 // PutPaymentNoteOnHold puts a payment note on hold
-func (h *PaymentNoteHandler) PutPaymentNoteOnHold(ctx context.Context, req *paymentnotepb.PutPaymentNoteOnHoldRequest) (*paymentnotepb.PutPaymentNoteOnHoldResponse, error) {
-	note, err := h.service.PutOnHold(ctx, req.GetId(), req.GetReason(), req.GetUserId())
+func (h *PaymentNoteHandler) PutPaymentNoteOnHold(ctx context.Context, req *paymentnotepb.PutPaymentNoteOnHoldRequest) (*paymentnotepb.PaymentNoteResponse, error) {
+	note, err := h.service.PutOnHold(ctx, req.GetId(), req.GetHoldReason(), req.GetUserId())
 	if err != nil {
 		return nil, err
 	}
-	
-	return &paymentnotepb.PutPaymentNoteOnHoldResponse{
-		Note: h.service.DomainToProto(note),
-	}, nil
+
+	return h.response(note), nil
 }
 
 // RemovePaymentNoteFromHold removes a payment note from hold
-func (h *PaymentNoteHandler) RemovePaymentNoteFromHold(ctx context.Context, req *paymentnotepb.RemovePaymentNoteFromHoldRequest) (*paymentnotepb.RemovePaymentNoteFromHoldResponse, error) {
+func (h *PaymentNoteHandler) RemovePaymentNoteFromHold(ctx context.Context, req *paymentnotepb.RemovePaymentNoteFromHoldRequest) (*paymentnotepb.PaymentNoteResponse, error) {
 	note, err := h.service.RemoveFromHold(ctx, req.GetId(), req.GetNewStatus())
 	if err != nil {
 		return nil, err
 	}
-	
-	return &paymentnotepb.RemovePaymentNoteFromHoldResponse{
-		Note: h.service.DomainToProto(note),
-	}, nil
+
+	return h.response(note), nil
 }
 
-// UpdatePaymentNoteUTR updates UTR information
-func (h *PaymentNoteHandler) UpdatePaymentNoteUTR(ctx context.Context, req *paymentnotepb.UpdatePaymentNoteUTRRequest) (*paymentnotepb.UpdatePaymentNoteUTRResponse, error) {
+// UpdatePaymentNoteUtr updates UTR information
+func (h *PaymentNoteHandler) UpdatePaymentNoteUtr(ctx context.Context, req *paymentnotepb.UpdatePaymentNoteUtrRequest) (*paymentnotepb.PaymentNoteResponse, error) {
 	note, err := h.service.UpdateUTR(ctx, req.GetId(), req.GetUtrNo(), req.GetUtrDate())
 	if err != nil {
 		return nil, err
 	}
-	
-	return &paymentnotepb.UpdatePaymentNoteUTRResponse{
-		Note: h.service.DomainToProto(note),
+
+	return h.response(note), nil
+}
+
+// GeneratePaymentNoteOrderNumber generates an order number
+func (h *PaymentNoteHandler) GeneratePaymentNoteOrderNumber(ctx context.Context, _ *emptypb.Empty) (*paymentnotepb.GeneratePaymentNoteOrderNumberResponse, error) {
+	orderNo, err := h.service.GeneratePaymentNoteOrderNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paymentnotepb.GeneratePaymentNoteOrderNumberResponse{
+		OrderNumber: orderNo,
+	}, nil
+}
+
+// CountOrganizationPaymentNotes counts an organization's payment notes
+func (h *PaymentNoteHandler) CountOrganizationPaymentNotes(ctx context.Context, req *paymentnotepb.CountOrganizationPaymentNotesRequest) (*paymentnotepb.CountOrganizationPaymentNotesResponse, error) {
+	if req.GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+
+	count, err := h.service.CountOrganizationPaymentNotes(ctx, req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &paymentnotepb.CountOrganizationPaymentNotesResponse{Count: count}, nil
+}
+
+// TestPaymentNoteAPI reports that the service is reachable
+func (h *PaymentNoteHandler) TestPaymentNoteAPI(ctx context.Context, _ *emptypb.Empty) (*paymentnotepb.TestPaymentNoteAPIResponse, error) {
+	return &paymentnotepb.TestPaymentNoteAPIResponse{
+		Status:    "ok",
+		Message:   "payment note service is running",
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+func (h *PaymentNoteHandler) list(ctx context.Context, filters domain.PaymentNoteFilters) (*paymentnotepb.ListPaymentNotesResponse, error) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PerPage < 1 {
+		filters.PerPage = defaultPerPage
+	}
+	if filters.PerPage > maxPerPage {
+		filters.PerPage = maxPerPage
+	}
+
+	notes, total, err := h.service.ListPaymentNotes(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*paymentnotepb.PaymentNoteSummary, len(notes))
+	for i, note := range notes {
+		summaries[i] = h.service.DomainToSummary(note)
+	}
+
+	return &paymentnotepb.ListPaymentNotesResponse{
+		Notes: summaries,
+		Pagination: &commonpb.Pagination{
+			Page:       filters.Page,
+			PerPage:    filters.PerPage,
+			TotalItems: total,
+			TotalPages: int32((total + int64(filters.PerPage) - 1) / int64(filters.PerPage)),
+		},
 	}, nil
 }
+
+func (h *PaymentNoteHandler) response(note *domain.PaymentNote) *paymentnotepb.PaymentNoteResponse {
+	return &paymentnotepb.PaymentNoteResponse{
+		Note: h.service.DomainToProto(note),
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// setOrganization records the organization a new payment note was raised in
+func setOrganization(ctx context.Context, tx *sql.Tx, id int64, orgID string) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE payment_notes SET org_id = $2 WHERE id = $1`, id, orgID); err != nil {
+		return fmt.Errorf("failed to record organization of payment note %d: %w", id, err)
+	}
+	return nil
+}
+
+// CountByOrganization counts the payment notes of an organization
+func (r *paymentNoteRepository) CountByOrganization(ctx context.Context, orgID string) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM payment_notes WHERE org_id = $1`, orgID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count payment notes of organization %s: %w", orgID, err)
+	}
+	return count, nil
+}
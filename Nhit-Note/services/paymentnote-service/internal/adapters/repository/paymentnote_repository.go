@@ -10,6 +10,8 @@ import (
 	"nhit-note/services/paymentnote-service/internal/core/domain"
 	"nhit-note/services/paymentnote-service/internal/core/ports"
 	"nhit-note/services/paymentnote-service/internal/storage"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 )
 
 type paymentNoteRepository struct {
@@ -54,26 +56,26 @@ func (r *paymentNoteRepository) Create(ctx context.Context, note *domain.Payment
 		ProjectName:            sqlNullString(note.ProjectName),
 		InvoiceNo:              sqlNullString(note.InvoiceNo),
 		InvoiceDate:            sqlNullString(note.InvoiceDate),
-		InvoiceAmount:          fmt.Sprintf("%.2f", note.InvoiceAmount),
+		InvoiceAmount:          sqlNullAmount(note.InvoiceAmount),
 		InvoiceApprovedBy:      sqlNullString(note.InvoiceApprovedBy),
 		LoaPoNo:                sqlNullString(note.LoaPoNo),
-		LoaPoAmount:            fmt.Sprintf("%.2f", note.LoaPoAmount),
+		LoaPoAmount:            sqlNullAmount(note.LoaPoAmount),
 		LoaPoDate:              sqlNullString(note.LoaPoDate),
-		GrossAmount:            fmt.Sprintf("%.2f", note.GrossAmount),
-		TotalAdditions:         fmt.Sprintf("%.2f", note.TotalAdditions),
-		TotalDeductions:        fmt.Sprintf("%.2f", note.TotalDeductions),
-		NetPayableAmount:       fmt.Sprintf("%.2f", note.NetPayableAmount),
-		NetPayableRoundOff:     fmt.Sprintf("%.2f", note.NetPayableRoundOff),
+		GrossAmount:            note.GrossAmount.String(),
+		TotalAdditions:         note.TotalAdditions.String(),
+		TotalDeductions:        note.TotalDeductions.String(),
+		NetPayableAmount:       note.NetPayableAmount.String(),
+		NetPayableRoundOff:     note.NetPayableRoundOff.String(),
 		NetPayableWords:        sqlNullString(note.NetPayableWords),
-		TdsPercentage:          fmt.Sprintf("%.2f", note.TdsPercentage),
+		TdsPercentage:          sql.NullString{String: fmt.Sprintf("%.2f", note.TdsPercentage), Valid: true},
 		TdsSection:             sqlNullString(note.TdsSection),
-		TdsAmount:              fmt.Sprintf("%.2f", note.TdsAmount),
+		TdsAmount:              sqlNullAmount(note.TdsAmount),
 		AccountHolderName:      sqlNullString(note.AccountHolderName),
 		BankName:               sqlNullString(note.BankName),
 		AccountNumber:          sqlNullString(note.AccountNumber),
 		IfscCode:               sqlNullString(note.IfscCode),
 		RecommendationOfPayment: sqlNullString(note.RecommendationOfPayment),
-		Status:                 generated.PaymentNoteStatus(note.Status),
+		Status:                 sqlNullStatus(note.Status),
 		IsDraft:                note.IsDraft,
 		AutoCreated:            note.AutoCreated,
 		CreatedBy:              sqlNullInt64(note.CreatedBy),
@@ -81,6 +83,11 @@ func (r *paymentNoteRepository) Create(ctx context.Context, note *domain.Payment
 	if err != nil {
 		return nil, fmt.Errorf("failed to create payment note: %w", err)
 	}
+	if note.OrgID != nil {
+		if err := setOrganization(ctx, tx, createdNote.ID, *note.OrgID); err != nil {
+			return nil, err
+		}
+	}
 
 	// Insert add particulars
 	for _, particular := range note.AddParticulars {
@@ -88,7 +95,7 @@ func (r *paymentNoteRepository) Create(ctx context.Context, note *domain.Payment
 			PaymentNoteID:  createdNote.ID,
 			ParticularType: "ADD",
 			Particular:     particular.Particular,
-			Amount:         fmt.Sprintf("%.2f", particular.Amount),
+			Amount:         particular.Amount.String(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to insert add particular: %w", err)
@@ -101,7 +108,7 @@ func (r *paymentNoteRepository) Create(ctx context.Context, note *domain.Payment
 			PaymentNoteID:  createdNote.ID,
 			ParticularType: "LESS",
 			Particular:     particular.Particular,
-			Amount:         fmt.Sprintf("%.2f", particular.Amount),
+			Amount:         particular.Amount.String(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to insert less particular: %w", err)
@@ -115,7 +122,7 @@ func (r *paymentNoteRepository) Create(ctx context.Context, note *domain.Payment
 			FileName:         doc.FileName,
 			OriginalFilename: doc.OriginalFilename,
 			MimeType:         sqlNullString(doc.MimeType),
-			FileSize:         doc.FileSize,
+			FileSize:         sql.NullInt64{Int64: doc.FileSize, Valid: true},
 			ObjectKey:        doc.ObjectKey,
 			UploadedBy:       doc.UploadedBy,
 			UploadedByName:   sqlNullString(doc.UploadedByName),
@@ -199,9 +206,9 @@ func (r *paymentNoteRepository) GetByNoteNo(ctx context.Context, noteNo string)
 func (r *paymentNoteRepository) List(ctx context.Context, filters domain.PaymentNoteFilters) ([]*domain.PaymentNote, int64, error) {
 	// Count total
 	count, err := r.queries.CountPaymentNotes(ctx, generated.CountPaymentNotesParams{
-		Column1: sqlNullString(filters.Status),
-		Column2: sqlNullBool(filters.IsDraft),
-		Column3: sqlNullString(filters.Search),
+		Status:  sqlNullString(filters.Status),
+		IsDraft: sqlNullBool(filters.IsDraft),
+		Search:  sqlNullString(filters.Search),
 	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count payment notes: %w", err)
@@ -210,9 +217,9 @@ func (r *paymentNoteRepository) List(ctx context.Context, filters domain.Payment
 	// Get notes
 	offset := (filters.Page - 1) * filters.PerPage
 	notes, err := r.queries.ListPaymentNotes(ctx, generated.ListPaymentNotesParams{
-		Column1: sqlNullString(filters.Status),
-		Column2: sqlNullBool(filters.IsDraft),
-		Column3: sqlNullString(filters.Search),
+		Status:  sqlNullString(filters.Status),
+		IsDraft: sqlNullBool(filters.IsDraft),
+		Search:  sqlNullString(filters.Search),
 		Limit:   filters.PerPage,
 		Offset:  offset,
 	})
@@ -256,26 +263,26 @@ func (r *paymentNoteRepository) Update(ctx context.Context, note *domain.Payment
 		ProjectName:            sqlNullString(note.ProjectName),
 		InvoiceNo:              sqlNullString(note.InvoiceNo),
 		InvoiceDate:            sqlNullString(note.InvoiceDate),
-		InvoiceAmount:          fmt.Sprintf("%.2f", note.InvoiceAmount),
+		InvoiceAmount:          sqlNullAmount(note.InvoiceAmount),
 		InvoiceApprovedBy:      sqlNullString(note.InvoiceApprovedBy),
 		LoaPoNo:                sqlNullString(note.LoaPoNo),
-		LoaPoAmount:            fmt.Sprintf("%.2f", note.LoaPoAmount),
+		LoaPoAmount:            sqlNullAmount(note.LoaPoAmount),
 		LoaPoDate:              sqlNullString(note.LoaPoDate),
-		GrossAmount:            fmt.Sprintf("%.2f", note.GrossAmount),
-		TotalAdditions:         fmt.Sprintf("%.2f", note.TotalAdditions),
-		TotalDeductions:        fmt.Sprintf("%.2f", note.TotalDeductions),
-		NetPayableAmount:       fmt.Sprintf("%.2f", note.NetPayableAmount),
-		NetPayableRoundOff:     fmt.Sprintf("%.2f", note.NetPayableRoundOff),
+		GrossAmount:            note.GrossAmount.String(),
+		TotalAdditions:         note.TotalAdditions.String(),
+		TotalDeductions:        note.TotalDeductions.String(),
+		NetPayableAmount:       note.NetPayableAmount.String(),
+		NetPayableRoundOff:     note.NetPayableRoundOff.String(),
 		NetPayableWords:        sqlNullString(note.NetPayableWords),
-		TdsPercentage:          fmt.Sprintf("%.2f", note.TdsPercentage),
+		TdsPercentage:          sql.NullString{String: fmt.Sprintf("%.2f", note.TdsPercentage), Valid: true},
 		TdsSection:             sqlNullString(note.TdsSection),
-		TdsAmount:              fmt.Sprintf("%.2f", note.TdsAmount),
+		TdsAmount:              sqlNullAmount(note.TdsAmount),
 		AccountHolderName:      sqlNullString(note.AccountHolderName),
 		BankName:               sqlNullString(note.BankName),
 		AccountNumber:          sqlNullString(note.AccountNumber),
 		IfscCode:               sqlNullString(note.IfscCode),
 		RecommendationOfPayment: sqlNullString(note.RecommendationOfPayment),
-		Status:                 generated.PaymentNoteStatus(note.Status),
+		Status:                 sqlNullStatus(note.Status),
 		IsDraft:                note.IsDraft,
 	})
 	if err != nil {
@@ -294,7 +301,7 @@ func (r *paymentNoteRepository) Update(ctx context.Context, note *domain.Payment
 			PaymentNoteID:  note.ID,
 			ParticularType: "ADD",
 			Particular:     particular.Particular,
-			Amount:         fmt.Sprintf("%.2f", particular.Amount),
+			Amount:         particular.Amount.String(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to insert add particular: %w", err)
@@ -307,7 +314,7 @@ func (r *paymentNoteRepository) Update(ctx context.Context, note *domain.Payment
 			PaymentNoteID:  note.ID,
 			ParticularType: "LESS",
 			Particular:     particular.Particular,
-			Amount:         fmt.Sprintf("%.2f", particular.Amount),
+			Amount:         particular.Amount.String(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to insert less particular: %w", err)
@@ -325,7 +332,7 @@ func (r *paymentNoteRepository) Update(ctx context.Context, note *domain.Payment
 func (r *paymentNoteRepository) UpdateStatus(ctx context.Context, id int64, status string, isDraft bool) (*domain.PaymentNote, error) {
 	_, err := r.queries.UpdatePaymentNoteStatus(ctx, generated.UpdatePaymentNoteStatusParams{
 		ID:      id,
-		Status:  generated.PaymentNoteStatus(status),
+		Status:  sqlNullStatus(status),
 		IsDraft: isDraft,
 	})
 	if err != nil {
@@ -371,7 +378,7 @@ func (r *paymentNoteRepository) PutOnHold(ctx context.Context, id int64, reason
 func (r *paymentNoteRepository) RemoveFromHold(ctx context.Context, id int64, newStatus string) (*domain.PaymentNote, error) {
 	_, err := r.queries.RemovePaymentNoteFromHold(ctx, generated.RemovePaymentNoteFromHoldParams{
 		ID:     id,
-		Status: generated.PaymentNoteStatus(newStatus),
+		Status: sqlNullStatus(newStatus),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to remove from hold: %w", err)
@@ -423,29 +430,39 @@ func (r *paymentNoteRepository) AddComment(ctx context.Context, comment *domain.
 // AddApprovalLog adds an approval log entry
 func (r *paymentNoteRepository) AddApprovalLog(ctx context.Context, log *domain.PaymentApprovalLog) (*domain.PaymentApprovalLog, error) {
 	created, err := r.queries.InsertApprovalLog(ctx, generated.InsertApprovalLogParams{
-		PaymentNoteID: log.PaymentNoteID,
-		Status:        log.Status,
-		Comments:      sqlNullString(log.Comments),
-		ReviewerID:    log.ReviewerID,
-		ReviewerName:  sqlNullString(log.ReviewerName),
-		ReviewerEmail: sqlNullString(log.ReviewerEmail),
-		ApproverLevel: sqlNullInt32(log.ApproverLevel),
+		PaymentNoteID:  log.PaymentNoteID,
+		Status:         log.Status,
+		Comments:       sqlNullString(log.Comments),
+		ReviewerID:     log.ReviewerID,
+		ReviewerName:   sqlNullString(log.ReviewerName),
+		ReviewerEmail:  sqlNullString(log.ReviewerEmail),
+		ApproverLevel:  sqlNullInt32(log.ApproverLevel),
+		OnBehalfOfID:   sqlNullString(log.OnBehalfOfID),
+		OnBehalfOfName: sqlNullString(log.OnBehalfOfName),
+		DelegationID:   sqlNullString(log.DelegationID),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to add approval log: %w", err)
 	}
 
+	return approvalLogFromRow(created), nil
+}
+
+func approvalLogFromRow(log generated.PaymentNoteApprovalLog) *domain.PaymentApprovalLog {
 	return &domain.PaymentApprovalLog{
-		ID:            created.ID,
-		PaymentNoteID: created.PaymentNoteID,
-		Status:        created.Status,
-		Comments:      nullStringToPtr(created.Comments),
-		ReviewerID:    created.ReviewerID,
-		ReviewerName:  nullStringToPtr(created.ReviewerName),
-		ReviewerEmail: nullStringToPtr(created.ReviewerEmail),
-		ApproverLevel: nullInt32ToPtr(created.ApproverLevel),
-		CreatedAt:     created.CreatedAt,
-	}, nil
+		ID:             log.ID,
+		PaymentNoteID:  log.PaymentNoteID,
+		Status:         log.Status,
+		Comments:       nullStringToPtr(log.Comments),
+		ReviewerID:     log.ReviewerID,
+		ReviewerName:   nullStringToPtr(log.ReviewerName),
+		ReviewerEmail:  nullStringToPtr(log.ReviewerEmail),
+		ApproverLevel:  nullInt32ToPtr(log.ApproverLevel),
+		OnBehalfOfID:   nullStringToPtr(log.OnBehalfOfID),
+		OnBehalfOfName: nullStringToPtr(log.OnBehalfOfName),
+		DelegationID:   nullStringToPtr(log.DelegationID),
+		CreatedAt:      log.CreatedAt,
+	}
 }
 
 // UploadDocument uploads a document to MinIO and saves metadata
@@ -469,7 +486,7 @@ func (r *paymentNoteRepository) UploadDocument(ctx context.Context, paymentNoteI
 		FileName:         filename,
 		OriginalFilename: filename,
 		MimeType:         sql.NullString{String: mimeType, Valid: true},
-		FileSize:         size,
+		FileSize:         sql.NullInt64{Int64: size, Valid: true},
 		ObjectKey:        objectKey,
 		UploadedBy:       uploadedBy,
 		UploadedByName:   sql.NullString{String: uploadedByName, Valid: true},
@@ -486,7 +503,7 @@ func (r *paymentNoteRepository) UploadDocument(ctx context.Context, paymentNoteI
 		FileName:         doc.FileName,
 		OriginalFilename: doc.OriginalFilename,
 		MimeType:         nullStringToPtr(doc.MimeType),
-		FileSize:         doc.FileSize,
+		FileSize:         doc.FileSize.Int64,
 		ObjectKey:        doc.ObjectKey,
 		UploadedBy:       doc.UploadedBy,
 		UploadedByName:   nullStringToPtr(doc.UploadedByName),
@@ -529,7 +546,7 @@ func (r *paymentNoteRepository) DeleteDocument(ctx context.Context, documentID i
 
 // GenerateOrderNumber generates the next payment note order number
 func (r *paymentNoteRepository) GenerateOrderNumber(ctx context.Context, prefix string) (string, error) {
-	nextNum, err := r.queries.GetNextPaymentNoteNumber(ctx, prefix)
+	nextNum, err := r.queries.GetNextPaymentNoteNumber(ctx, sql.NullString{String: prefix, Valid: true})
 	if err != nil {
 		return "", fmt.Errorf("failed to generate order number: %w", err)
 	}
@@ -565,20 +582,20 @@ func (r *paymentNoteRepository) toDomain(
 		ProjectName:            nullStringToPtr(note.ProjectName),
 		InvoiceNo:              nullStringToPtr(note.InvoiceNo),
 		InvoiceDate:            nullStringToPtr(note.InvoiceDate),
-		InvoiceAmount:          parseDecimalSafe(note.InvoiceAmount),
+		InvoiceAmount:          parseAmountSafe(note.InvoiceAmount.String),
 		InvoiceApprovedBy:      nullStringToPtr(note.InvoiceApprovedBy),
 		LoaPoNo:                nullStringToPtr(note.LoaPoNo),
-		LoaPoAmount:            parseDecimalSafe(note.LoaPoAmount),
+		LoaPoAmount:            parseAmountSafe(note.LoaPoAmount.String),
 		LoaPoDate:              nullStringToPtr(note.LoaPoDate),
-		GrossAmount:            parseDecimalSafe(note.GrossAmount),
-		TotalAdditions:         parseDecimalSafe(note.TotalAdditions),
-		TotalDeductions:        parseDecimalSafe(note.TotalDeductions),
-		NetPayableAmount:       parseDecimalSafe(note.NetPayableAmount),
-		NetPayableRoundOff:     parseDecimalSafe(note.NetPayableRoundOff),
+		GrossAmount:            parseAmountSafe(note.GrossAmount),
+		TotalAdditions:         parseAmountSafe(note.TotalAdditions),
+		TotalDeductions:        parseAmountSafe(note.TotalDeductions),
+		NetPayableAmount:       parseAmountSafe(note.NetPayableAmount),
+		NetPayableRoundOff:     parseAmountSafe(note.NetPayableRoundOff),
 		NetPayableWords:        nullStringToPtr(note.NetPayableWords),
-		TdsPercentage:          parseDecimalSafe(note.TdsPercentage),
+		TdsPercentage:          parseDecimalSafe(note.TdsPercentage.String),
 		TdsSection:             nullStringToPtr(note.TdsSection),
-		TdsAmount:              parseDecimalSafe(note.TdsAmount),
+		TdsAmount:              parseAmountSafe(note.TdsAmount.String),
 		AccountHolderName:      nullStringToPtr(note.AccountHolderName),
 		BankName:               nullStringToPtr(note.BankName),
 		AccountNumber:          nullStringToPtr(note.AccountNumber),
@@ -605,7 +622,7 @@ func (r *paymentNoteRepository) toDomain(
 				PaymentNoteID:  p.PaymentNoteID,
 				ParticularType: p.ParticularType,
 				Particular:     p.Particular,
-				Amount:         parseDecimalSafe(p.Amount),
+				Amount:         parseAmountSafe(p.Amount),
 				CreatedAt:      p.CreatedAt,
 			}
 			if p.ParticularType == "ADD" {
@@ -619,17 +636,7 @@ func (r *paymentNoteRepository) toDomain(
 	// Convert approval logs
 	if approvalLogs != nil {
 		for _, log := range approvalLogs {
-			result.ApprovalLogs = append(result.ApprovalLogs, domain.PaymentApprovalLog{
-				ID:            log.ID,
-				PaymentNoteID: log.PaymentNoteID,
-				Status:        log.Status,
-				Comments:      nullStringToPtr(log.Comments),
-				ReviewerID:    log.ReviewerID,
-				ReviewerName:  nullStringToPtr(log.ReviewerName),
-				ReviewerEmail: nullStringToPtr(log.ReviewerEmail),
-				ApproverLevel: nullInt32ToPtr(log.ApproverLevel),
-				CreatedAt:     log.CreatedAt,
-			})
+			result.ApprovalLogs = append(result.ApprovalLogs, *approvalLogFromRow(log))
 		}
 	}
 
@@ -658,7 +665,7 @@ func (r *paymentNoteRepository) toDomain(
 				FileName:         d.FileName,
 				OriginalFilename: d.OriginalFilename,
 				MimeType:         nullStringToPtr(d.MimeType),
-				FileSize:         d.FileSize,
+				FileSize:         d.FileSize.Int64,
 				ObjectKey:        d.ObjectKey,
 				UploadedBy:       d.UploadedBy,
 				UploadedByName:   nullStringToPtr(d.UploadedByName),
@@ -748,6 +755,16 @@ func sqlNullTime(t *time.Time) sql.NullTime {
 	return sql.NullTime{Time: *t, Valid: true}
 }
 
+// sqlNullAmount stores an amount in a nullable DECIMAL column
+func sqlNullAmount(a money.Amount) sql.NullString {
+	return sql.NullString{String: a.String(), Valid: true}
+}
+
+// sqlNullStatus stores a status, NULL when empty
+func sqlNullStatus(s string) generated.NullPaymentNoteStatus {
+	return generated.NullPaymentNoteStatus{PaymentNoteStatus: generated.PaymentNoteStatus(s), Valid: s != ""}
+}
+
 func sqlNullBool(b *bool) sql.NullBool {
 	if b == nil {
 		return sql.NullBool{Valid: false}
@@ -760,3 +777,12 @@ func parseDecimalSafe(s string) float64 {
 	fmt.Sscanf(s, "%f", &f)
 	return f
 }
+
+// parseAmountSafe reads a DECIMAL(20,2) amount exactly; malformed values read as zero
+func parseAmountSafe(s string) money.Amount {
+	a, err := money.ParseRound(s, money.HalfUp)
+	if err != nil {
+		return money.Zero
+	}
+	return a
+}
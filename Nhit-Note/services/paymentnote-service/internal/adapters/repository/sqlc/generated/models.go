@@ -108,15 +108,18 @@ type PaymentNote struct {
 }
 
 type PaymentNoteApprovalLog struct {
-	ID            int64          `json:"id"`
-	PaymentNoteID int64          `json:"payment_note_id"`
-	Status        string         `json:"status"`
-	Comments      sql.NullString `json:"comments"`
-	ReviewerID    int64          `json:"reviewer_id"`
-	ReviewerName  sql.NullString `json:"reviewer_name"`
-	ReviewerEmail sql.NullString `json:"reviewer_email"`
-	ApproverLevel sql.NullInt32  `json:"approver_level"`
-	CreatedAt     time.Time      `json:"created_at"`
+	ID             int64          `json:"id"`
+	PaymentNoteID  int64          `json:"payment_note_id"`
+	Status         string         `json:"status"`
+	Comments       sql.NullString `json:"comments"`
+	ReviewerID     int64          `json:"reviewer_id"`
+	ReviewerName   sql.NullString `json:"reviewer_name"`
+	ReviewerEmail  sql.NullString `json:"reviewer_email"`
+	ApproverLevel  sql.NullInt32  `json:"approver_level"`
+	CreatedAt      time.Time      `json:"created_at"`
+	OnBehalfOfID   sql.NullString `json:"on_behalf_of_id"`
+	OnBehalfOfName sql.NullString `json:"on_behalf_of_name"`
+	DelegationID   sql.NullString `json:"delegation_id"`
 }
 
 type PaymentNoteComment struct {
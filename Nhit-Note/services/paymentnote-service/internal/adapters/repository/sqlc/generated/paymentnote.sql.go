@@ -13,7 +13,7 @@ import (
 const countPaymentNotes = `-- name: CountPaymentNotes :one
 SELECT COUNT(*) FROM payment_notes
 WHERE
-    ($1::text IS NULL OR status = $1) AND
+    ($1::text IS NULL OR status::text = $1) AND
     ($2::boolean IS NULL OR is_draft = $2) AND
     ($3::text IS NULL OR (
         note_no ILIKE '%' || $3 || '%' OR
@@ -24,13 +24,13 @@ WHERE
 `
 
 type CountPaymentNotesParams struct {
-	Column1 string `json:"column_1"`
-	Column2 bool   `json:"column_2"`
-	Column3 string `json:"column_3"`
+	Status  sql.NullString `json:"status"`
+	IsDraft sql.NullBool   `json:"is_draft"`
+	Search  sql.NullString `json:"search"`
 }
 
 func (q *Queries) CountPaymentNotes(ctx context.Context, arg CountPaymentNotesParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPaymentNotes, arg.Column1, arg.Column2, arg.Column3)
+	row := q.db.QueryRowContext(ctx, countPaymentNotes, arg.Status, arg.IsDraft, arg.Search)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -450,21 +450,27 @@ INSERT INTO payment_note_approval_logs (
     reviewer_id,
     reviewer_name,
     reviewer_email,
-    approver_level
+    approver_level,
+    on_behalf_of_id,
+    on_behalf_of_name,
+    delegation_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
-RETURNING id, payment_note_id, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at
+RETURNING id, payment_note_id, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at, on_behalf_of_id, on_behalf_of_name, delegation_id
 `
 
 type InsertApprovalLogParams struct {
-	PaymentNoteID int64          `json:"payment_note_id"`
-	Status        string         `json:"status"`
-	Comments      sql.NullString `json:"comments"`
-	ReviewerID    int64          `json:"reviewer_id"`
-	ReviewerName  sql.NullString `json:"reviewer_name"`
-	ReviewerEmail sql.NullString `json:"reviewer_email"`
-	ApproverLevel sql.NullInt32  `json:"approver_level"`
+	PaymentNoteID  int64          `json:"payment_note_id"`
+	Status         string         `json:"status"`
+	Comments       sql.NullString `json:"comments"`
+	ReviewerID     int64          `json:"reviewer_id"`
+	ReviewerName   sql.NullString `json:"reviewer_name"`
+	ReviewerEmail  sql.NullString `json:"reviewer_email"`
+	ApproverLevel  sql.NullInt32  `json:"approver_level"`
+	OnBehalfOfID   sql.NullString `json:"on_behalf_of_id"`
+	OnBehalfOfName sql.NullString `json:"on_behalf_of_name"`
+	DelegationID   sql.NullString `json:"delegation_id"`
 }
 
 func (q *Queries) InsertApprovalLog(ctx context.Context, arg InsertApprovalLogParams) (PaymentNoteApprovalLog, error) {
@@ -476,6 +482,9 @@ func (q *Queries) InsertApprovalLog(ctx context.Context, arg InsertApprovalLogPa
 		arg.ReviewerName,
 		arg.ReviewerEmail,
 		arg.ApproverLevel,
+		arg.OnBehalfOfID,
+		arg.OnBehalfOfName,
+		arg.DelegationID,
 	)
 	var i PaymentNoteApprovalLog
 	err := row.Scan(
@@ -488,6 +497,9 @@ func (q *Queries) InsertApprovalLog(ctx context.Context, arg InsertApprovalLogPa
 		&i.ReviewerEmail,
 		&i.ApproverLevel,
 		&i.CreatedAt,
+		&i.OnBehalfOfID,
+		&i.OnBehalfOfName,
+		&i.DelegationID,
 	)
 	return i, err
 }
@@ -631,7 +643,7 @@ func (q *Queries) InsertPaymentParticular(ctx context.Context, arg InsertPayment
 }
 
 const listApprovalLogs = `-- name: ListApprovalLogs :many
-SELECT id, payment_note_id, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at FROM payment_note_approval_logs
+SELECT id, payment_note_id, status, comments, reviewer_id, reviewer_name, reviewer_email, approver_level, created_at, on_behalf_of_id, on_behalf_of_name, delegation_id FROM payment_note_approval_logs
 WHERE payment_note_id = $1
 ORDER BY created_at DESC
 `
@@ -655,6 +667,9 @@ func (q *Queries) ListApprovalLogs(ctx context.Context, paymentNoteID int64) ([]
 			&i.ReviewerEmail,
 			&i.ApproverLevel,
 			&i.CreatedAt,
+			&i.OnBehalfOfID,
+			&i.OnBehalfOfName,
+			&i.DelegationID,
 		); err != nil {
 			return nil, err
 		}
@@ -750,7 +765,7 @@ func (q *Queries) ListPaymentNoteDocuments(ctx context.Context, paymentNoteID in
 const listPaymentNotes = `-- name: ListPaymentNotes :many
 SELECT id, user_id, green_note_id, green_note_no, green_note_approver, green_note_app_date, reimbursement_note_id, note_no, subject, date, department, vendor_code, vendor_name, project_name, invoice_no, invoice_date, invoice_amount, invoice_approved_by, loa_po_no, loa_po_amount, loa_po_date, gross_amount, total_additions, total_deductions, net_payable_amount, net_payable_round_off, net_payable_words, tds_percentage, tds_section, tds_amount, account_holder_name, bank_name, account_number, ifsc_code, recommendation_of_payment, status, is_draft, auto_created, created_by, hold_reason, hold_date, hold_by, utr_no, utr_date, created_at, updated_at FROM payment_notes
 WHERE
-    ($1::text IS NULL OR status = $1) AND
+    ($1::text IS NULL OR status::text = $1) AND
     ($2::boolean IS NULL OR is_draft = $2) AND
     ($3::text IS NULL OR (
         note_no ILIKE '%' || $3 || '%' OR
@@ -763,18 +778,18 @@ LIMIT $4 OFFSET $5
 `
 
 type ListPaymentNotesParams struct {
-	Column1 string `json:"column_1"`
-	Column2 bool   `json:"column_2"`
-	Column3 string `json:"column_3"`
-	Limit   int32  `json:"limit"`
-	Offset  int32  `json:"offset"`
+	Status  sql.NullString `json:"status"`
+	IsDraft sql.NullBool   `json:"is_draft"`
+	Search  sql.NullString `json:"search"`
+	Limit   int32          `json:"limit"`
+	Offset  int32          `json:"offset"`
 }
 
 func (q *Queries) ListPaymentNotes(ctx context.Context, arg ListPaymentNotesParams) ([]PaymentNote, error) {
 	rows, err := q.db.QueryContext(ctx, listPaymentNotes,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
+		arg.Status,
+		arg.IsDraft,
+		arg.Search,
 		arg.Limit,
 		arg.Offset,
 	)
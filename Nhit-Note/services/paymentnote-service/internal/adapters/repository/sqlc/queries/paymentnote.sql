@@ -64,27 +64,27 @@ LIMIT 1;
 -- name: ListPaymentNotes :many
 SELECT * FROM payment_notes
 WHERE
-    ($1::text IS NULL OR status = $1) AND
-    ($2::boolean IS NULL OR is_draft = $2) AND
-    ($3::text IS NULL OR (
-        note_no ILIKE '%' || $3 || '%' OR
-        subject ILIKE '%' || $3 || '%' OR
-        vendor_name ILIKE '%' || $3 || '%' OR
-        project_name ILIKE '%' || $3 || '%'
+    (sqlc.narg('status')::text IS NULL OR status::text = sqlc.narg('status')) AND
+    (sqlc.narg('is_draft')::boolean IS NULL OR is_draft = sqlc.narg('is_draft')) AND
+    (sqlc.narg('search')::text IS NULL OR (
+        note_no ILIKE '%' || sqlc.narg('search') || '%' OR
+        subject ILIKE '%' || sqlc.narg('search') || '%' OR
+        vendor_name ILIKE '%' || sqlc.narg('search') || '%' OR
+        project_name ILIKE '%' || sqlc.narg('search') || '%'
     ))
 ORDER BY created_at DESC
-LIMIT $4 OFFSET $5;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountPaymentNotes :one
 SELECT COUNT(*) FROM payment_notes
 WHERE
-    ($1::text IS NULL OR status = $1) AND
-    ($2::boolean IS NULL OR is_draft = $2) AND
-    ($3::text IS NULL OR (
-        note_no ILIKE '%' || $3 || '%' OR
-        subject ILIKE '%' || $3 || '%' OR
-        vendor_name ILIKE '%' || $3 || '%' OR
-        project_name ILIKE '%' || $3 || '%'
+    (sqlc.narg('status')::text IS NULL OR status::text = sqlc.narg('status')) AND
+    (sqlc.narg('is_draft')::boolean IS NULL OR is_draft = sqlc.narg('is_draft')) AND
+    (sqlc.narg('search')::text IS NULL OR (
+        note_no ILIKE '%' || sqlc.narg('search') || '%' OR
+        subject ILIKE '%' || sqlc.narg('search') || '%' OR
+        vendor_name ILIKE '%' || sqlc.narg('search') || '%' OR
+        project_name ILIKE '%' || sqlc.narg('search') || '%'
     ));
 
 -- name: UpdatePaymentNote :one
@@ -219,9 +219,12 @@ INSERT INTO payment_note_approval_logs (
     reviewer_id,
     reviewer_name,
     reviewer_email,
-    approver_level
+    approver_level,
+    on_behalf_of_id,
+    on_behalf_of_name,
+    delegation_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 )
 RETURNING *;
 
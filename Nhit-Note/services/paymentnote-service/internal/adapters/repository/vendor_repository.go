@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"nhit-note/services/paymentnote-service/internal/core/domain"
+)
+
+// RenameVendor moves payment notes of one vendor code to another vendor
+func (r *paymentNoteRepository) RenameVendor(ctx context.Context, fromCode, toCode, toName string) (int64, error) {
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE payment_notes SET vendor_code = $2, vendor_name = $3 WHERE vendor_code = $1`,
+		fromCode, toCode, toName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rename vendor %s: %w", fromCode, err)
+	}
+	return res.RowsAffected()
+}
+
+// FlagMSMEInvoice records an MSME payment warning on a payment note
+func (r *paymentNoteRepository) FlagMSMEInvoice(ctx context.Context, id int64, flag domain.MSMEFlag) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE payment_notes
+		SET msme_invoice_id = NULLIF($2, '')::uuid, msme_invoice_number = $3, msme_status = $4,
+			msme_due_date = NULLIF($5, '')::date, msme_interest_liability = $6, msme_flagged_at = NOW()
+		WHERE id = $1`,
+		id, flag.InvoiceID, flag.InvoiceNumber, flag.Status, flag.DueDate, flag.InterestLiability)
+	if err != nil {
+		return fmt.Errorf("failed to flag payment note %d: %w", id, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return domain.ErrPaymentNoteNotFound
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package vendorclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	vendorpb "github.com/ShristiRnr/NHIT_Backend/api/pb/vendorpb"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"nhit-note/services/paymentnote-service/internal/core/domain"
+	"nhit-note/services/paymentnote-service/internal/core/ports"
+)
+
+const paymentDateLayout = "2006-01-02"
+
+// TDSAdvisor asks vendor-service's TDS engine for the TDS on a payment
+type TDSAdvisor struct {
+	client  vendorpb.VendorServiceClient
+	timeout time.Duration
+}
+
+// NewTDSAdvisor creates a ports.TDSAdvisor backed by vendor-service
+func NewTDSAdvisor(conn *grpc.ClientConn) *TDSAdvisor {
+	return &TDSAdvisor{
+		client:  vendorpb.NewVendorServiceClient(conn),
+		timeout: 5 * time.Second,
+	}
+}
+
+var _ ports.TDSAdvisor = (*TDSAdvisor)(nil)
+
+// SuggestTDS implements ports.TDSAdvisor
+func (a *TDSAdvisor) SuggestTDS(ctx context.Context, vendorCode, sectionCode string, amount money.Amount, paymentDate time.Time) (*domain.TDSSuggestion, error) {
+	ctx, cancel := a.outgoing(ctx)
+	defer cancel()
+
+	resp, err := a.client.CalculateTDS(ctx, &vendorpb.CalculateTDSRequest{
+		VendorCode:  &vendorCode,
+		SectionCode: sectionCode,
+		Amount:      amount.Float64(),
+		AmountExact: moneyProto(amount),
+		PaymentDate: formatPaymentDate(paymentDate),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("vendor-service CalculateTDS: %w", err)
+	}
+	return toTDSSuggestion(resp.Deduction), nil
+}
+
+// RecordTDS implements ports.TDSAdvisor
+func (a *TDSAdvisor) RecordTDS(ctx context.Context, vendorCode, sectionCode string, amount money.Amount, paymentDate time.Time, reference string) (*domain.TDSSuggestion, error) {
+	ctx, cancel := a.outgoing(ctx)
+	defer cancel()
+
+	resp, err := a.client.RecordTDSDeduction(ctx, &vendorpb.RecordTDSDeductionRequest{
+		VendorCode:  &vendorCode,
+		SectionCode: sectionCode,
+		Amount:      amount.Float64(),
+		AmountExact: moneyProto(amount),
+		PaymentDate: formatPaymentDate(paymentDate),
+		ReferenceId: reference,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("vendor-service RecordTDSDeduction: %w", err)
+	}
+	return toTDSSuggestion(resp.Deduction), nil
+}
+
+// ReverseTDS implements ports.TDSAdvisor. A reference with nothing recorded
+// against it is not an error.
+func (a *TDSAdvisor) ReverseTDS(ctx context.Context, reference string) error {
+	ctx, cancel := a.outgoing(ctx)
+	defer cancel()
+
+	_, err := a.client.ReverseTDSDeduction(ctx, &vendorpb.ReverseTDSDeductionRequest{ReferenceId: reference})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("vendor-service ReverseTDSDeduction: %w", err)
+	}
+	return nil
+}
+
+// outgoing forwards the caller's metadata (token, tenant) so the vendor lookup
+// is scoped to the caller's tenant
+func (a *TDSAdvisor) outgoing(ctx context.Context) (context.Context, context.CancelFunc) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	return context.WithTimeout(ctx, a.timeout)
+}
+
+func formatPaymentDate(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	s := t.Format(paymentDateLayout)
+	return &s
+}
+
+func moneyProto(a money.Amount) *vendorpb.Money {
+	units, nanos := a.UnitsNanos()
+	return &vendorpb.Money{Value: a.String(), Units: units, Nanos: nanos}
+}
+
+// exactAmount reads an amount from its Money twin, falling back to the double
+// for vendor-service versions that do not set it
+func exactAmount(exact *vendorpb.Money, legacy float64) money.Amount {
+	if exact.GetValue() != "" {
+		if a, err := money.Parse(exact.GetValue()); err == nil {
+			return a
+		}
+	}
+	a, _ := money.FromFloat(legacy, money.HalfUp)
+	return a
+}
+
+func toTDSSuggestion(d *vendorpb.TDSDeduction) *domain.TDSSuggestion {
+	taxable := exactAmount(d.GetTaxableAmountExact(), d.GetTaxableAmount())
+	tds := exactAmount(d.GetTdsAmountExact(), d.GetTdsAmount())
+	return &domain.TDSSuggestion{
+		SectionCode:   d.GetSectionCode(),
+		Rule:          d.GetRule(),
+		Rate:          d.GetRate(),
+		EffectiveRate: d.GetEffectiveRate(),
+		TaxableAmount: taxable,
+		TDSAmount:     tds,
+	}
+}
@@ -22,6 +22,9 @@ func GetPermissionMap() map[string][]string {
 		// UTR operations
 		"/paymentnote.PaymentNoteService/UpdatePaymentNoteUtr":      {"update-payment-utr"},
 		
+		// Organization-service counts an organization's notes before purging it
+		"/paymentnote.PaymentNoteService/CountOrganizationPaymentNotes": {"delete-organizations"},
+		
 		// Admin operations
 		"/paymentnote.PaymentNoteService/CreatePaymentNoteForSuperAdmin": {}, // No permission check (SUPER_ADMIN only)
 	}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+)
+
+// ErrPaymentNoteNotFound is returned when a payment note does not exist
+var ErrPaymentNoteNotFound = errors.New("payment note not found")
+
+// MSMEFlag is vendor-service's warning that an MSME invoice paid by a note is
+// at risk of missing, or has missed, its statutory payment date
+type MSMEFlag struct {
+	InvoiceID         string
+	InvoiceNumber     string
+	Status            string // AT_RISK, OVERDUE or PAID_LATE
+	DueDate           string // YYYY-MM-DD
+	InterestLiability money.Amount
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"time"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 )
 
 // PaymentNote represents a payment note domain model
@@ -9,6 +11,9 @@ type PaymentNote struct {
 	ID                    int64
 	UserID                int64
 	
+	// Organization the note was raised in; recorded on create, not loaded
+	OrgID                 *string
+	
 	// Green Note Reference
 	GreenNoteID           *string
 	GreenNoteNo           *string
@@ -34,26 +39,26 @@ type PaymentNote struct {
 	// Invoice Details
 	InvoiceNo             *string
 	InvoiceDate           *string
-	InvoiceAmount         float64
+	InvoiceAmount         money.Amount
 	InvoiceApprovedBy     *string
 	
 	// LOA/PO Details
 	LoaPoNo               *string
-	LoaPoAmount           float64
+	LoaPoAmount           money.Amount
 	LoaPoDate             *string
 	
 	// Financial Calculations
-	GrossAmount           float64
-	TotalAdditions        float64
-	TotalDeductions       float64
-	NetPayableAmount      float64
-	NetPayableRoundOff    float64
+	GrossAmount           money.Amount
+	TotalAdditions        money.Amount
+	TotalDeductions       money.Amount
+	NetPayableAmount      money.Amount
+	NetPayableRoundOff    money.Amount
 	NetPayableWords       *string
 	
 	// TDS Details
 	TdsPercentage         float64
 	TdsSection            *string
-	TdsAmount             float64
+	TdsAmount             money.Amount
 	
 	// Bank Details
 	AccountHolderName     *string
@@ -97,7 +102,7 @@ type PaymentParticular struct {
 	PaymentNoteID  int64
 	ParticularType string // 'ADD' or 'LESS'
 	Particular     string
-	Amount         float64
+	Amount         money.Amount
 	CreatedAt      time.Time
 }
 
@@ -111,7 +116,11 @@ type PaymentApprovalLog struct {
 	ReviewerName  *string
 	ReviewerEmail *string
 	ApproverLevel *int32
-	CreatedAt     time.Time
+	// Set when the reviewer acted as the delegate of an approver on leave
+	OnBehalfOfID   *string
+	OnBehalfOfName *string
+	DelegationID   *string
+	CreatedAt      time.Time
 }
 
 // PaymentComment represents a comment on a payment note
@@ -0,0 +1,19 @@
+package domain
+
+import "github.com/ShristiRnr/NHIT_Backend/pkg/money"
+
+// TDSSuggestion is the TDS computed by vendor-service's TDS engine for a
+// payment against a vendor and TDS section
+type TDSSuggestion struct {
+	SectionCode   string
+	Rule          string
+	Rate          float64
+	EffectiveRate float64
+	TaxableAmount money.Amount
+	TDSAmount     money.Amount
+}
+
+// TDSReference is the reference a payment note's deduction is recorded under
+func TDSReference(noteNo string) string {
+	return "payment-note:" + noteNo
+}
@@ -2,7 +2,11 @@ package ports
 
 import (
 	"context"
+	"time"
+
 	"nhit-note/services/paymentnote-service/internal/core/domain"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 )
 
 // PaymentNoteRepository defines the interface for payment note data operations
@@ -46,6 +50,18 @@ type PaymentNoteRepository interface {
 	// AddApprovalLog adds an approval log entry
 	AddApprovalLog(ctx context.Context, log *domain.PaymentApprovalLog) (*domain.PaymentApprovalLog, error)
 	
+	// RenameVendor moves payment notes of one vendor code to another vendor,
+	// e.g. after a vendor merge, and returns how many notes were moved
+	RenameVendor(ctx context.Context, fromCode, toCode, toName string) (int64, error)
+	
+	// FlagMSMEInvoice records vendor-service's latest MSME payment warning on
+	// a payment note, replacing any earlier one
+	FlagMSMEInvoice(ctx context.Context, id int64, flag domain.MSMEFlag) error
+	
+	// CountByOrganization counts the organization's payment notes, drafts
+	// included. Notes created before organizations were recorded are not counted.
+	CountByOrganization(ctx context.Context, orgID string) (int64, error)
+	
 	// GenerateOrderNumber generates the next payment note order number
 	GenerateOrderNumber(ctx context.Context, prefix string) (string, error)
 	
@@ -60,6 +76,20 @@ type PaymentNoteRepository interface {
 	DeleteDocument(ctx context.Context, documentID int64) error
 }
 
+// TDSAdvisor computes and records TDS against a vendor's section thresholds,
+// PAN status and lower deduction certificates
+type TDSAdvisor interface {
+	// SuggestTDS computes the TDS on a payment without recording it
+	SuggestTDS(ctx context.Context, vendorCode, sectionCode string, amount money.Amount, paymentDate time.Time) (*domain.TDSSuggestion, error)
+
+	// RecordTDS records the TDS on a payment under reference so it counts
+	// towards the vendor's thresholds; recording a reference again is a no-op
+	RecordTDS(ctx context.Context, vendorCode, sectionCode string, amount money.Amount, paymentDate time.Time, reference string) (*domain.TDSSuggestion, error)
+
+	// ReverseTDS removes the deduction recorded under reference, if any
+	ReverseTDS(ctx context.Context, reference string) error
+}
+
 // PaymentNoteService defines the business logic interface
 type PaymentNoteService interface {
 	// Business logic methods will be defined here
@@ -3,32 +3,59 @@ package services
 import (
 	"context"
 	"fmt"
+	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"nhit-note/services/paymentnote-service/internal/core/domain"
 	"nhit-note/services/paymentnote-service/internal/core/ports"
 	"nhit-note/services/paymentnote-service/internal/utils"
+	commonpb "nhit-note/api/pb/common"
 	paymentnotepb "nhit-note/api/pb/paymentnotepb"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/middleware"
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
+	"google.golang.org/grpc/metadata"
 )
 
-type paymentNoteService struct {
-	repo ports.PaymentNoteRepository
+type PaymentNoteService struct {
+	repo     ports.PaymentNoteRepository
+	tds      ports.TDSAdvisor
+	rounding money.RoundingMode
 }
 
-// NewPaymentNoteService creates a new payment note service
-func NewPaymentNoteService(repo ports.PaymentNoteRepository) *paymentNoteService {
-	return &paymentNoteService{
+// NewPaymentNoteService creates a new payment note service. tds may be nil,
+// in which case TDS is only computed from the percentage on the request.
+func NewPaymentNoteService(repo ports.PaymentNoteRepository, tds ports.TDSAdvisor) *PaymentNoteService {
+	return &PaymentNoteService{
 		repo: repo,
+		tds:  tds,
 	}
 }
 
+// SetRoundingMode sets how TDS, the rupee round-off and legacy double amounts
+// are rounded. The default is half-up.
+func (s *PaymentNoteService) SetRoundingMode(mode money.RoundingMode) {
+	s.rounding = mode
+}
+
 // CreatePaymentNote creates a new payment note with financial calculations
-func (s *paymentNoteService) CreatePaymentNote(ctx context.Context, payload *paymentnotepb.PaymentNotePayload) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) CreatePaymentNote(ctx context.Context, payload *paymentnotepb.PaymentNotePayload) (*domain.PaymentNote, error) {
 	// Convert proto to domain
 	note := s.protoToDomain(payload)
+	note.OrgID = orgIDFromContext(ctx)
+	
+	// Suggest TDS from the vendor's section, thresholds and certificates
+	if err := s.suggestTDS(ctx, note); err != nil {
+		return nil, err
+	}
 	
 	// Calculate financial fields if not provided
-	note = s.calculateFinancials(note)
+	note, err := s.calculateFinancials(note)
+	if err != nil {
+		return nil, err
+	}
 	
 	// Generate note number if not provided
 	if note.NoteNo == "" {
@@ -45,11 +72,13 @@ func (s *paymentNoteService) CreatePaymentNote(ctx context.Context, payload *pay
 		return nil, fmt.Errorf("failed to create payment note: %w", err)
 	}
 	
+	s.recordTDS(ctx, created)
+	
 	return created, nil
 }
 
 // UpdatePaymentNote updates an existing payment note
-func (s *paymentNoteService) UpdatePaymentNote(ctx context.Context, id int64, payload *paymentnotepb.PaymentNotePayload) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) UpdatePaymentNote(ctx context.Context, id int64, payload *paymentnotepb.PaymentNotePayload) (*domain.PaymentNote, error) {
 	// Get existing note
 	existing, err := s.repo.GetByID(ctx, id)
 	if err != nil {
@@ -62,8 +91,17 @@ func (s *paymentNoteService) UpdatePaymentNote(ctx context.Context, id int64, pa
 	updated.NoteNo = existing.NoteNo // Keep existing note number
 	updated.CreatedAt = existing.CreatedAt
 	
+	// Re-suggest TDS; the note's earlier deduction is reversed after the update
+	// so it does not count towards the vendor's aggregate
+	if err := s.suggestTDS(ctx, updated); err != nil {
+		return nil, err
+	}
+	
 	// Recalculate financials
-	updated = s.calculateFinancials(updated)
+	updated, err = s.calculateFinancials(updated)
+	if err != nil {
+		return nil, err
+	}
 	
 	// Update in database
 	result, err := s.repo.Update(ctx, updated)
@@ -71,31 +109,48 @@ func (s *paymentNoteService) UpdatePaymentNote(ctx context.Context, id int64, pa
 		return nil, fmt.Errorf("failed to update payment note: %w", err)
 	}
 	
+	if s.tds != nil {
+		s.reverseTDS(ctx, existing.NoteNo)
+		s.recordTDS(ctx, result)
+	}
+	
 	return result, nil
 }
 
 // GetPaymentNoteByID retrieves a payment note by ID
-func (s *paymentNoteService) GetPaymentNoteByID(ctx context.Context, id int64) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) GetPaymentNoteByID(ctx context.Context, id int64) (*domain.PaymentNote, error) {
 	return s.repo.GetByID(ctx, id)
 }
 
 // GetPaymentNoteByNoteNo retrieves a payment note by note number
-func (s *paymentNoteService) GetPaymentNoteByNoteNo(ctx context.Context, noteNo string) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) GetPaymentNoteByNoteNo(ctx context.Context, noteNo string) (*domain.PaymentNote, error) {
 	return s.repo.GetByNoteNo(ctx, noteNo)
 }
 
 // ListPaymentNotes retrieves payment notes with filters
-func (s *paymentNoteService) ListPaymentNotes(ctx context.Context, filters domain.PaymentNoteFilters) ([]*domain.PaymentNote, int64, error) {
+func (s *PaymentNoteService) ListPaymentNotes(ctx context.Context, filters domain.PaymentNoteFilters) ([]*domain.PaymentNote, int64, error) {
 	return s.repo.List(ctx, filters)
 }
 
 // DeletePaymentNote deletes a payment note
-func (s *paymentNoteService) DeletePaymentNote(ctx context.Context, id int64) error {
-	return s.repo.Delete(ctx, id)
+func (s *PaymentNoteService) DeletePaymentNote(ctx context.Context, id int64) error {
+	if s.tds == nil {
+		return s.repo.Delete(ctx, id)
+	}
+	
+	note, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.reverseTDS(ctx, note.NoteNo)
+	return nil
 }
 
 // CreateDraftFromGreenNote creates a draft payment note from an approved green note
-func (s *paymentNoteService) CreateDraftFromGreenNote(ctx context.Context, greenNoteID string, greenNoteData *paymentnotepb.PaymentGreenNoteReference) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) CreateDraftFromGreenNote(ctx context.Context, greenNoteID string, greenNoteData *commonpb.PaymentGreenNoteReference) (*domain.PaymentNote, error) {
 	// Check if draft already exists
 	existing, err := s.repo.GetByGreenNoteID(ctx, greenNoteID)
 	if err != nil {
@@ -106,15 +161,19 @@ func (s *paymentNoteService) CreateDraftFromGreenNote(ctx context.Context, green
 	}
 	
 	// Create draft payment note
+	greenNoteNo := greenNoteData.GetFormattedOrderNo()
+	if greenNoteNo == "" {
+		greenNoteNo = greenNoteData.GetOrderNo()
+	}
 	note := &domain.PaymentNote{
-		GreenNoteID:       &greenNoteID,
-		GreenNoteNo:       stringPtr(greenNoteData.GetGreenNoteNo()),
-		GreenNoteApprover: stringPtr(greenNoteData.GetApproverName()),
-		Status:            "D", // Draft
-		IsDraft:           true,
-		AutoCreated:       true,
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
+		OrgID:       orgIDFromContext(ctx),
+		GreenNoteID: &greenNoteID,
+		GreenNoteNo: stringPtr(greenNoteNo),
+		Status:      "D", // Draft
+		IsDraft:     true,
+		AutoCreated: true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 	
 	// Generate note number
@@ -134,7 +193,7 @@ func (s *paymentNoteService) CreateDraftFromGreenNote(ctx context.Context, green
 }
 
 // ConvertDraftToActive converts a draft payment note to active status
-func (s *paymentNoteService) ConvertDraftToActive(ctx context.Context, id int64) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) ConvertDraftToActive(ctx context.Context, id int64) (*domain.PaymentNote, error) {
 	note, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -149,22 +208,22 @@ func (s *paymentNoteService) ConvertDraftToActive(ctx context.Context, id int64)
 }
 
 // PutOnHold puts a payment note on hold
-func (s *paymentNoteService) PutOnHold(ctx context.Context, id int64, reason string, userID int64) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) PutOnHold(ctx context.Context, id int64, reason string, userID int64) (*domain.PaymentNote, error) {
 	return s.repo.PutOnHold(ctx, id, reason, userID)
 }
 
 // RemoveFromHold removes a payment note from hold
-func (s *paymentNoteService) RemoveFromHold(ctx context.Context, id int64, newStatus string) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) RemoveFromHold(ctx context.Context, id int64, newStatus string) (*domain.PaymentNote, error) {
 	return s.repo.RemoveFromHold(ctx, id, newStatus)
 }
 
 // UpdateUTR updates the UTR information
-func (s *paymentNoteService) UpdateUTR(ctx context.Context, id int64, utrNo string, utrDate string) (*domain.PaymentNote, error) {
+func (s *PaymentNoteService) UpdateUTR(ctx context.Context, id int64, utrNo string, utrDate string) (*domain.PaymentNote, error) {
 	return s.repo.UpdateUTR(ctx, id, utrNo, utrDate)
 }
 
 // AddComment adds a comment to a payment note
-func (s *paymentNoteService) AddComment(ctx context.Context, paymentNoteID int64, comment string, status string, userID int64, userName string, userEmail string) (*domain.PaymentComment, error) {
+func (s *PaymentNoteService) AddComment(ctx context.Context, paymentNoteID int64, comment string, status string, userID int64, userName string, userEmail string) (*domain.PaymentComment, error) {
 	c := &domain.PaymentComment{
 		PaymentNoteID: paymentNoteID,
 		Comment:       comment,
@@ -176,56 +235,194 @@ func (s *paymentNoteService) AddComment(ctx context.Context, paymentNoteID int64
 	return s.repo.AddComment(ctx, c)
 }
 
+// AddApprovalLog records a review of a payment note. When the RBAC
+// interceptor admitted the call under a delegation, the log names the
+// delegator the reviewer acted for.
+func (s *PaymentNoteService) AddApprovalLog(ctx context.Context, entry *domain.PaymentApprovalLog) (*domain.PaymentApprovalLog, error) {
+	if grant, ok := middleware.GetOnBehalfOfFromContext(ctx); ok {
+		entry.OnBehalfOfID = stringPtr(grant.GetDelegatorId())
+		entry.OnBehalfOfName = stringPtr(grant.GetDelegatorName())
+		entry.DelegationID = stringPtr(grant.GetDelegationId())
+	}
+	return s.repo.AddApprovalLog(ctx, entry)
+}
+
+// CountOrganizationPaymentNotes counts an organization's payment notes for
+// organization-service's purge check
+func (s *PaymentNoteService) CountOrganizationPaymentNotes(ctx context.Context, orgID string) (int64, error) {
+	return s.repo.CountByOrganization(ctx, orgID)
+}
+
+// orgIDFromContext returns the caller's organization: the one the RBAC
+// interceptor validated, else the org_id the API gateway forwards
+func orgIDFromContext(ctx context.Context) *string {
+	if orgID, ok := middleware.GetOrgIDFromContext(ctx); ok {
+		return &orgID
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("org_id"); len(values) > 0 && values[0] != "" {
+			return &values[0]
+		}
+	}
+	return nil
+}
+
 // GeneratePaymentNoteOrderNumber generates a payment note order number
-func (s *paymentNoteService) GeneratePaymentNoteOrderNumber(ctx context.Context) (string, error) {
+func (s *PaymentNoteService) GeneratePaymentNoteOrderNumber(ctx context.Context) (string, error) {
 	return s.repo.GenerateOrderNumber(ctx, "PN")
 }
 
 // UploadDocument uploads a document to a payment note
-func (s *paymentNoteService) UploadDocument(ctx context.Context, paymentNoteID int64, filename string, data []byte, mimeType string, uploadedBy int64, uploadedByName string) (*domain.PaymentNoteDocument, error) {
+func (s *PaymentNoteService) UploadDocument(ctx context.Context, paymentNoteID int64, filename string, data []byte, mimeType string, uploadedBy int64, uploadedByName string) (*domain.PaymentNoteDocument, error) {
 	return s.repo.UploadDocument(ctx, paymentNoteID, filename, data, mimeType, uploadedBy, uploadedByName)
 }
 
-// calculateFinancials calculates all financial fields for a payment note
-func (s *paymentNoteService) calculateFinancials(note *domain.PaymentNote) *domain.PaymentNote {
+// calculateFinancials calculates all financial fields for a payment note. It
+// fails with money.ErrOverflow when an amount is too large to represent.
+func (s *PaymentNoteService) calculateFinancials(note *domain.PaymentNote) (*domain.PaymentNote, error) {
+	var err error
+	addAmounts := make([]money.Amount, len(note.AddParticulars))
+	for i, p := range note.AddParticulars {
+		addAmounts[i] = p.Amount
+	}
+	lessAmounts := make([]money.Amount, len(note.LessParticulars))
+	for i, p := range note.LessParticulars {
+		lessAmounts[i] = p.Amount
+	}
+
 	// Calculate total additions
-	totalAdd := 0.0
-	for _, p := range note.AddParticulars {
-		totalAdd += p.Amount
+	if note.TotalAdditions, err = money.SumChecked(addAmounts...); err != nil {
+		return nil, fmt.Errorf("total additions: %w", err)
 	}
-	note.TotalAdditions = totalAdd
-	
-	// Calculate total deductions (including TDS)
-	totalDeduct := 0.0
-	for _, p := range note.LessParticulars {
-		totalDeduct += p.Amount
+
+	// Calculate TDS if percentage is provided, unless the TDS engine already
+	// suggested the amount
+	if note.TdsPercentage > 0 && note.TdsAmount.IsZero() {
+		if note.TdsAmount, err = utils.CalculateTDS(note.GrossAmount, note.TdsPercentage, s.rounding); err != nil {
+			return nil, fmt.Errorf("tds amount: %w", err)
+		}
 	}
-	
-	// Calculate TDS if percentage is provided
-	if note.TdsPercentage > 0 {
-		note.TdsAmount = utils.CalculateTDS(note.GrossAmount, note.TdsPercentage)
-		totalDeduct += note.TdsAmount
+
+	// Calculate total deductions (including TDS)
+	if note.TotalDeductions, err = money.SumChecked(append(lessAmounts, note.TdsAmount)...); err != nil {
+		return nil, fmt.Errorf("total deductions: %w", err)
 	}
-	note.TotalDeductions = totalDeduct
-	
+
 	// Calculate net payable: Gross + Additions - Deductions
-	note.NetPayableAmount = utils.CalculateNetPayable(note.GrossAmount, note.TotalAdditions, note.TotalDeductions)
-	
+	if note.NetPayableAmount, err = utils.CalculateNetPayable(note.GrossAmount, note.TotalAdditions, note.TotalDeductions); err != nil {
+		return nil, fmt.Errorf("net payable amount: %w", err)
+	}
+
 	// Round off
-	note.NetPayableRoundOff = utils.RoundOff(note.NetPayableAmount)
-	
+	if note.NetPayableRoundOff, err = utils.RoundOff(note.NetPayableAmount, s.rounding); err != nil {
+		return nil, fmt.Errorf("net payable round off: %w", err)
+	}
+
 	// Convert to words
 	words := utils.NumberToWords(note.NetPayableRoundOff)
 	note.NetPayableWords = &words
-	
-	return note
+
+	return note, nil
+}
+
+// tracksTDS reports whether a note's payment is tracked by vendor-service's TDS
+// engine: the engine is configured and a vendor and section are given
+func (s *PaymentNoteService) tracksTDS(note *domain.PaymentNote) bool {
+	return s.tds != nil && note.GrossAmount.Sign() > 0 &&
+		note.VendorCode != nil && strings.TrimSpace(*note.VendorCode) != "" &&
+		note.TdsSection != nil && strings.TrimSpace(*note.TdsSection) != ""
+}
+
+// suggestTDS fills in the TDS amount and effective rate from the TDS engine,
+// unless a percentage on the request overrides it
+func (s *PaymentNoteService) suggestTDS(ctx context.Context, note *domain.PaymentNote) error {
+	if !s.tracksTDS(note) || note.TdsPercentage > 0 {
+		return nil
+	}
+	suggestion, err := s.tds.SuggestTDS(ctx, *note.VendorCode, *note.TdsSection, note.GrossAmount, tdsPaymentDate(note))
+	if err != nil {
+		return fmt.Errorf("failed to suggest TDS: %w", err)
+	}
+	note.TdsAmount = suggestion.TDSAmount
+	note.TdsPercentage = suggestion.EffectiveRate
+	return nil
+}
+
+// recordTDS records a saved note's payment so it counts towards the vendor's
+// thresholds, including payments below them. The note is already saved, so
+// failures are only logged.
+func (s *PaymentNoteService) recordTDS(ctx context.Context, note *domain.PaymentNote) {
+	if !s.tracksTDS(note) {
+		return
+	}
+	recorded, err := s.tds.RecordTDS(ctx, *note.VendorCode, *note.TdsSection, note.GrossAmount, tdsPaymentDate(note), domain.TDSReference(note.NoteNo))
+	if err != nil {
+		log.Printf("⚠️  Failed to record TDS for payment note %s: %v", note.NoteNo, err)
+		return
+	}
+	if !recorded.TDSAmount.Equal(note.TdsAmount) {
+		log.Printf("⚠️  Recorded TDS %s differs from payment note %s TDS %s", recorded.TDSAmount, note.NoteNo, note.TdsAmount)
+	}
+}
+
+// reverseTDS removes the deduction recorded for a note, if any
+func (s *PaymentNoteService) reverseTDS(ctx context.Context, noteNo string) {
+	if err := s.tds.ReverseTDS(ctx, domain.TDSReference(noteNo)); err != nil {
+		log.Printf("⚠️  Failed to reverse TDS for payment note %s: %v", noteNo, err)
+	}
+}
+
+// tdsPaymentDate is the note date, or zero to let the TDS engine use today
+func tdsPaymentDate(note *domain.PaymentNote) time.Time {
+	if note.Date != nil {
+		return *note.Date
+	}
+	return time.Time{}
+}
+
+// amount reads a double amount from the wire. The shortest decimal form of
+// the double is used, so only genuinely sub-paisa values are rounded.
+func (s *PaymentNoteService) amount(v float64) money.Amount {
+	a, err := money.FromFloat(v, s.rounding)
+	if err != nil {
+		log.Printf("⚠️  Ignoring invalid amount %v: %v", v, err)
+		return money.Zero
+	}
+	return a
+}
+
+// exactAmount reads an amount whose Money twin, when set, wins over the double
+func (s *PaymentNoteService) exactAmount(exact *paymentnotepb.Money, v float64) money.Amount {
+	if exact == nil {
+		return s.amount(v)
+	}
+	var (
+		a   money.Amount
+		err error
+	)
+	if value := strings.TrimSpace(exact.GetValue()); value != "" {
+		a, err = money.ParseRound(value, s.rounding)
+	} else {
+		a, err = money.FromUnitsNanos(exact.GetUnits(), exact.GetNanos(), s.rounding)
+	}
+	if err != nil {
+		log.Printf("⚠️  Ignoring invalid amount %q: %v", exact.GetValue(), err)
+		return money.Zero
+	}
+	return a
+}
+
+// moneyProto converts an amount to its exact wire form
+func moneyProto(a money.Amount) *paymentnotepb.Money {
+	units, nanos := a.UnitsNanos()
+	return &paymentnotepb.Money{Value: a.String(), Units: units, Nanos: nanos}
 }
 
 // protoToDomain converts proto payload to domain model
-func (s *paymentNoteService) protoToDomain(proto *paymentnotepb.PaymentNotePayload) *domain.PaymentNote {
+func (s *PaymentNoteService) protoToDomain(proto *paymentnotepb.PaymentNotePayload) *domain.PaymentNote {
 	note := &domain.PaymentNote{
 		UserID:                 proto.UserId,
-		GreenNoteID:            protoStringPtr(proto.GreenNoteId),
+		GreenNoteID:            protoInt64String(proto.GreenNoteId),
 		GreenNoteNo:            protoStringPtr(proto.GreenNoteNo),
 		GreenNoteApprover:      protoStringPtr(proto.GreenNoteApprover),
 		GreenNoteAppDate:       protoStringPtr(proto.GreenNoteAppDate),
@@ -238,12 +435,12 @@ func (s *paymentNoteService) protoToDomain(proto *paymentnotepb.PaymentNotePaylo
 		ProjectName:            protoStringPtr(proto.ProjectName),
 		InvoiceNo:              protoStringPtr(proto.InvoiceNo),
 		InvoiceDate:            protoStringPtr(proto.InvoiceDate),
-		InvoiceAmount:          proto.InvoiceAmount,
+		InvoiceAmount:          s.exactAmount(proto.InvoiceAmountExact, proto.InvoiceAmount),
 		InvoiceApprovedBy:      protoStringPtr(proto.InvoiceApprovedBy),
 		LoaPoNo:                protoStringPtr(proto.LoaPoNo),
-		LoaPoAmount:            proto.LoaPoAmount,
+		LoaPoAmount:            s.exactAmount(proto.LoaPoAmountExact, proto.LoaPoAmount),
 		LoaPoDate:              protoStringPtr(proto.LoaPoDate),
-		GrossAmount:            proto.GrossAmount,
+		GrossAmount:            s.exactAmount(proto.GrossAmountExact, proto.GrossAmount),
 		TdsPercentage:          proto.TdsPercentage,
 		TdsSection:             protoStringPtr(proto.TdsSection),
 		AccountHolderName:      protoStringPtr(proto.AccountHolderName),
@@ -269,7 +466,7 @@ func (s *paymentNoteService) protoToDomain(proto *paymentnotepb.PaymentNotePaylo
 		note.AddParticulars = append(note.AddParticulars, domain.PaymentParticular{
 			ParticularType: "ADD",
 			Particular:     p.Particular,
-			Amount:         p.Amount,
+			Amount:         s.exactAmount(p.AmountExact, p.Amount),
 		})
 	}
 	
@@ -278,7 +475,7 @@ func (s *paymentNoteService) protoToDomain(proto *paymentnotepb.PaymentNotePaylo
 		note.LessParticulars = append(note.LessParticulars, domain.PaymentParticular{
 			ParticularType: "LESS",
 			Particular:     p.Particular,
-			Amount:         p.Amount,
+			Amount:         s.exactAmount(p.AmountExact, p.Amount),
 		})
 	}
 	
@@ -288,7 +485,7 @@ func (s *paymentNoteService) protoToDomain(proto *paymentnotepb.PaymentNotePaylo
 }
 
 // domainToProto converts domain model to proto response
-func (s *paymentNoteService) DomainToProto(note *domain.PaymentNote) *paymentnotepb.PaymentNote {
+func (s *PaymentNoteService) DomainToProto(note *domain.PaymentNote) *paymentnotepb.PaymentNote {
 	proto := &paymentnotepb.PaymentNote{
 		Id:                     note.ID,
 		UserId:                 note.UserID,
@@ -306,20 +503,20 @@ func (s *paymentNoteService) DomainToProto(note *domain.PaymentNote) *paymentnot
 		ProjectName:            stringPtrToProto(note.ProjectName),
 		InvoiceNo:              stringPtrToProto(note.InvoiceNo),
 		InvoiceDate:            stringPtrToProto(note.InvoiceDate),
-		InvoiceAmount:          note.InvoiceAmount,
+		InvoiceAmount:          note.InvoiceAmount.Float64(),
 		InvoiceApprovedBy:      stringPtrToProto(note.InvoiceApprovedBy),
 		LoaPoNo:                stringPtrToProto(note.LoaPoNo),
-		LoaPoAmount:            note.LoaPoAmount,
+		LoaPoAmount:            note.LoaPoAmount.Float64(),
 		LoaPoDate:              stringPtrToProto(note.LoaPoDate),
-		GrossAmount:            note.GrossAmount,
-		TotalAdditions:         note.TotalAdditions,
-		TotalDeductions:        note.TotalDeductions,
-		NetPayableAmount:       note.NetPayableAmount,
-		NetPayableRoundOff:     note.NetPayableRoundOff,
+		GrossAmount:            note.GrossAmount.Float64(),
+		TotalAdditions:         note.TotalAdditions.Float64(),
+		TotalDeductions:        note.TotalDeductions.Float64(),
+		NetPayableAmount:       note.NetPayableAmount.Float64(),
+		NetPayableRoundOff:     note.NetPayableRoundOff.Float64(),
 		NetPayableWords:        stringPtrToProto(note.NetPayableWords),
 		TdsPercentage:          note.TdsPercentage,
 		TdsSection:             stringPtrToProto(note.TdsSection),
-		TdsAmount:              note.TdsAmount,
+		TdsAmount:              note.TdsAmount.Float64(),
 		AccountHolderName:      stringPtrToProto(note.AccountHolderName),
 		BankName:               stringPtrToProto(note.BankName),
 		AccountNumber:          stringPtrToProto(note.AccountNumber),
@@ -331,37 +528,50 @@ func (s *paymentNoteService) DomainToProto(note *domain.PaymentNote) *paymentnot
 		CreatedBy:              int64PtrToProto(note.CreatedBy),
 		CreatedAt:              note.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:              note.UpdatedAt.Format(time.RFC3339),
+
+		InvoiceAmountExact:      moneyProto(note.InvoiceAmount),
+		LoaPoAmountExact:        moneyProto(note.LoaPoAmount),
+		GrossAmountExact:        moneyProto(note.GrossAmount),
+		TotalAdditionsExact:     moneyProto(note.TotalAdditions),
+		TotalDeductionsExact:    moneyProto(note.TotalDeductions),
+		NetPayableAmountExact:   moneyProto(note.NetPayableAmount),
+		NetPayableRoundOffExact: moneyProto(note.NetPayableRoundOff),
+		TdsAmountExact:          moneyProto(note.TdsAmount),
 	}
 	
 	// Convert add particulars
 	for _, p := range note.AddParticulars {
 		proto.AddParticulars = append(proto.AddParticulars, &paymentnotepb.PaymentParticular{
-			Id:         p.ID,
-			Particular: p.Particular,
-			Amount:     p.Amount,
+			Particular:  p.Particular,
+			Amount:      p.Amount.Float64(),
+			AmountExact: moneyProto(p.Amount),
 		})
 	}
 	
 	// Convert less particulars
 	for _, p := range note.LessParticulars {
 		proto.LessParticulars = append(proto.LessParticulars, &paymentnotepb.PaymentParticular{
-			Id:         p.ID,
-			Particular: p.Particular,
-			Amount:     p.Amount,
+			Particular:  p.Particular,
+			Amount:      p.Amount.Float64(),
+			AmountExact: moneyProto(p.Amount),
 		})
 	}
 	
 	// Convert approval logs
 	for _, log := range note.ApprovalLogs {
 		proto.ApprovalLogs = append(proto.ApprovalLogs, &paymentnotepb.PaymentApprovalLog{
-			Id:            log.ID,
-			Status:        log.Status,
-			Comments:      stringPtrToProto(log.Comments),
-			ReviewerId:    log.ReviewerID,
-			ReviewerName:  stringPtrToProto(log.ReviewerName),
-			ReviewerEmail: stringPtrToProto(log.ReviewerEmail),
-			ApproverLevel: int32PtrToProto(log.ApproverLevel),
-			CreatedAt:     log.CreatedAt.Format(time.RFC3339),
+			Id:       log.ID,
+			Status:   log.Status,
+			Comments: stringPtrToProto(log.Comments),
+			Reviewer: &commonpb.RelatedUser{
+				Id:    log.ReviewerID,
+				Name:  stringPtrToProto(log.ReviewerName),
+				Email: stringPtrToProto(log.ReviewerEmail),
+			},
+			CreatedAt:      log.CreatedAt.Format(time.RFC3339),
+			OnBehalfOfId:   stringPtrToProto(log.OnBehalfOfID),
+			OnBehalfOfName: stringPtrToProto(log.OnBehalfOfName),
+			DelegationId:   stringPtrToProto(log.DelegationID),
 		})
 	}
 	
@@ -389,10 +599,24 @@ func (s *paymentNoteService) DomainToProto(note *domain.PaymentNote) *paymentnot
 			CreatedAt:        d.CreatedAt.Format(time.RFC3339),
 		})
 	}
-	
+
 	return proto
 }
 
+// DomainToSummary converts domain model to the list view summary
+func (s *PaymentNoteService) DomainToSummary(note *domain.PaymentNote) *paymentnotepb.PaymentNoteSummary {
+	return &paymentnotepb.PaymentNoteSummary{
+		Id:                      note.ID,
+		NoteNo:                  note.NoteNo,
+		Status:                  note.Status,
+		NetPayableRoundOff:      note.NetPayableRoundOff.Float64(),
+		NetPayableRoundOffExact: moneyProto(note.NetPayableRoundOff),
+		IsDraft:                 note.IsDraft,
+		CreatedAt:               note.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:               note.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
 // Helper functions
 func stringPtr(s string) *string {
 	if s == "" {
@@ -443,8 +667,24 @@ func timePtrToProto(t *time.Time) string {
 	return t.Format(time.RFC3339)
 }
 
+// protoInt64String stores a numeric proto ID as text, nil when unset
+func protoInt64String(i int64) *string {
+	if i == 0 {
+		return nil
+	}
+	s := strconv.FormatInt(i, 10)
+	return &s
+}
+
+// protoStringToInt64 reads a text ID back as a number; IDs that are not
+// numeric, such as green note UUIDs, have no int64 form and read as unset
 func protoStringToInt64(s *string) *int64 {
-	// This is a placeholder - you may need to parse string to int64
-	// For now, return nil
-	return nil
+	if s == nil {
+		return nil
+	}
+	i, err := strconv.ParseInt(*s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &i
 }
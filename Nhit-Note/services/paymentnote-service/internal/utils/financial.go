@@ -1,39 +1,43 @@
 package utils
 
 import (
-	"fmt"
-	"math"
 	"strings"
+
+	"github.com/ShristiRnr/NHIT_Backend/pkg/money"
 )
 
-// CalculateTDS calculates TDS amount based on gross amount and percentage
-func CalculateTDS(grossAmount float64, tdsPercentage float64) float64 {
+// CalculateTDS calculates TDS amount based on gross amount and percentage,
+// rounded to the paisa with mode
+func CalculateTDS(grossAmount money.Amount, tdsPercentage float64, mode money.RoundingMode) (money.Amount, error) {
 	if tdsPercentage <= 0 {
-		return 0
+		return money.Zero, nil
 	}
-	return math.Round((grossAmount * tdsPercentage / 100) * 100) / 100
+	return grossAmount.Percent(tdsPercentage, mode)
 }
 
 // CalculateNetPayable calculates net payable amount
 // Formula: Gross + Additions - Deductions
-func CalculateNetPayable(gross, additions, deductions float64) float64 {
-	return math.Round((gross + additions - deductions) * 100) / 100
+func CalculateNetPayable(gross, additions, deductions money.Amount) (money.Amount, error) {
+	total, err := gross.AddChecked(additions)
+	if err != nil {
+		return money.Zero, err
+	}
+	return total.SubChecked(deductions)
 }
 
-// RoundOff rounds amount to nearest integer
-func RoundOff(amount float64) float64 {
-	return math.Round(amount)
+// RoundOff rounds amount to a whole rupee with mode
+func RoundOff(amount money.Amount, mode money.RoundingMode) (money.Amount, error) {
+	return amount.RoundToRupee(mode)
 }
 
-// NumberToWords converts a number to words (Indian numbering system)
-func NumberToWords(num float64) string {
-	if num == 0 {
+// NumberToWords converts an amount to words (Indian numbering system)
+func NumberToWords(amount money.Amount) string {
+	if amount.IsZero() {
 		return "Zero only"
 	}
 
-	// Split into integer and decimal parts
-	intPart := int64(num)
-	decimalPart := int64(math.Round((num - float64(intPart)) * 100))
+	// Split into rupees and paise
+	intPart, decimalPart := amount.Abs().Rupees()
 
 	words := convertToWords(intPart)
 	
@@ -81,8 +85,8 @@ func convertToWords(num int64) string {
 }
 
 // FormatCurrency formats amount as Indian currency
-func FormatCurrency(amount float64) string {
-	return fmt.Sprintf("₹%.2f", amount)
+func FormatCurrency(amount money.Amount) string {
+	return "₹" + amount.String()
 }
 
 // CleanString removes extra spaces and trims
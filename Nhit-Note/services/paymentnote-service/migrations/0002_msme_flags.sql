@@ -0,0 +1,10 @@
+-- Latest MSME payment warning reported by vendor-service for a payment note
+
+ALTER TABLE payment_notes ADD COLUMN IF NOT EXISTS msme_invoice_id UUID;
+ALTER TABLE payment_notes ADD COLUMN IF NOT EXISTS msme_invoice_number TEXT;
+ALTER TABLE payment_notes ADD COLUMN IF NOT EXISTS msme_status TEXT;
+ALTER TABLE payment_notes ADD COLUMN IF NOT EXISTS msme_due_date DATE;
+ALTER TABLE payment_notes ADD COLUMN IF NOT EXISTS msme_interest_liability DECIMAL(20,2);
+ALTER TABLE payment_notes ADD COLUMN IF NOT EXISTS msme_flagged_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_payment_notes_msme_status ON payment_notes(msme_status) WHERE msme_status IS NOT NULL;
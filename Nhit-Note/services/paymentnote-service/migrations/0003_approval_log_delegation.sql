@@ -0,0 +1,7 @@
+-- Approvals cast by a delegate record the approver acted for, from the
+-- delegation the RBAC interceptor admitted the call under
+
+ALTER TABLE payment_note_approval_logs
+    ADD COLUMN IF NOT EXISTS on_behalf_of_id TEXT,
+    ADD COLUMN IF NOT EXISTS on_behalf_of_name TEXT,
+    ADD COLUMN IF NOT EXISTS delegation_id TEXT;
@@ -0,0 +1,7 @@
+-- Organization a payment note was raised in, so organization-service can see
+-- an organization's payment notes before purging it. Notes created before
+-- this migration have no organization recorded.
+
+ALTER TABLE payment_notes ADD COLUMN IF NOT EXISTS org_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_payment_notes_org_id ON payment_notes(org_id);